@@ -0,0 +1,315 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/davetashner/stringer/internal/ghaction"
+	"github.com/davetashner/stringer/internal/gitcli"
+	"github.com/davetashner/stringer/internal/output"
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+// Action command flags.
+var (
+	actionCollectors    string
+	actionMinConfidence float64
+	actionBudget        int
+	actionFull          bool
+	actionLabel         string
+	actionResults       string
+)
+
+// actionGetenv reads the runner environment. Replaced in tests.
+var actionGetenv = os.Getenv
+
+// actionCmd runs stringer as a GitHub Actions step.
+var actionCmd = &cobra.Command{
+	Use:   "action [path]",
+	Short: "Run as a GitHub Actions step (diff-aware scan, summary, outputs)",
+	Long: `Run stringer as a GitHub Actions step with no shell glue.
+
+Reads the runner environment (GITHUB_EVENT_NAME, GITHUB_EVENT_PATH, ...).
+On pull request events only the files the pull request changed are scanned;
+other events, or --full, scan the whole tree. Diff mode needs the base
+commit, so check out with fetch-depth: 0.
+
+Writes a Markdown summary to GITHUB_STEP_SUMMARY, the same Markdown to a
+file in RUNNER_TEMP for posting as a PR comment, and sets these step
+outputs in GITHUB_OUTPUT:
+
+  signals        number of signals found
+  mode           diff or full
+  changed-files  number of files the pull request changed (diff mode)
+  over-budget    true when --budget is set and signals exceed it
+  comment-file   path of the Markdown summary
+  pr-number      pull request number (pull request events)
+
+In a matrix, give each job a --label and --results file, upload the files
+as artifacts, then run 'stringer action merge' in a follow-up job.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runAction,
+}
+
+// actionMergeCmd combines matrix results.
+var actionMergeCmd = &cobra.Command{
+	Use:   "merge <results.json>...",
+	Short: "Merge result files from matrix jobs into one summary and outputs",
+	Long: `Merge the --results files written by 'stringer action' in matrix jobs.
+
+Writes one job summary with a row per matrix job and the combined counts by
+kind, and sets the signals, mode, changed-files, and over-budget outputs
+for the whole matrix. Arguments may be globs.`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: runActionMerge,
+}
+
+func init() {
+	actionCmd.Flags().StringVarP(&actionCollectors, "collectors", "c", "", "comma-separated list of collectors to run")
+	actionCmd.Flags().Float64Var(&actionMinConfidence, "min-confidence", 0, "filter signals below this confidence threshold (0.0-1.0)")
+	actionCmd.Flags().IntVar(&actionBudget, "budget", 0, "maximum signals allowed before over-budget is set (0 = no budget)")
+	actionCmd.Flags().BoolVar(&actionFull, "full", false, "scan the whole tree even on pull request events")
+	actionCmd.Flags().StringVar(&actionLabel, "label", "", "name of this matrix job in merged summaries")
+	actionCmd.Flags().StringVar(&actionResults, "results", "", "write a result file for 'stringer action merge'")
+
+	actionCmd.AddCommand(actionMergeCmd)
+	rootCmd.AddCommand(actionCmd)
+}
+
+// resetActionFlags resets action command flags for testing.
+func resetActionFlags() {
+	actionCollectors = ""
+	actionMinConfidence = 0
+	actionBudget = 0
+	actionFull = false
+	actionLabel = ""
+	actionResults = ""
+
+	actionCmd.Flags().VisitAll(func(f *pflag.Flag) {
+		_ = f.Value.Set(f.DefValue)
+		f.Changed = false
+	})
+}
+
+func runAction(cmd *cobra.Command, args []string) error {
+	env, ok := ghaction.LoadEnv(actionGetenv)
+	if !ok {
+		return exitError(ExitInvalidArgs, "stringer: not running in GitHub Actions (GITHUB_ACTIONS is not \"true\"); use 'stringer scan' instead")
+	}
+	if actionMinConfidence < 0 || actionMinConfidence > 1.0 {
+		return exitError(ExitInvalidArgs,
+			"stringer: --min-confidence must be between 0.0 and 1.0 (got %.2f)", actionMinConfidence)
+	}
+	if actionBudget < 0 {
+		return exitError(ExitInvalidArgs, "stringer: --budget must be non-negative (got %d)", actionBudget)
+	}
+
+	repoPath := "."
+	if len(args) > 0 {
+		repoPath = args[0]
+	}
+	absPath, gitRoot, err := resolveScanPath(repoPath)
+	if err != nil {
+		return err
+	}
+
+	event := &ghaction.Event{}
+	if env.EventPath != "" {
+		if event, err = ghaction.ReadEvent(env.EventPath); err != nil {
+			return exitError(ExitInvalidArgs, "stringer: %v", err)
+		}
+	}
+
+	// Diff-aware mode on pull requests: scope the scan to changed files.
+	mode := ghaction.ModeFull
+	var changed []string
+	if env.IsPullRequest() && !actionFull {
+		changed, err = prChangedFiles(cmd, absPath, env, event)
+		if err != nil {
+			slog.Warn("cannot diff against the pull request base, scanning the whole tree (check out with fetch-depth: 0)", "error", err)
+		} else {
+			mode = ghaction.ModeDiff
+		}
+	}
+
+	var signals []signal.RawSignal
+	if mode == ghaction.ModeFull || len(changed) > 0 {
+		cfg := signal.ScanConfig{RepoPath: absPath, Collectors: splitCollectors(actionCollectors)}
+		if mode == ghaction.ModeDiff {
+			cfg.Scope = signal.NewFileScope(changed)
+		}
+		result, scanErr := runConfiguredScan(cmd.Context(), gitRoot, cfg)
+		if scanErr != nil {
+			return scanErr
+		}
+		for _, s := range result.Signals {
+			if s.Confidence >= actionMinConfidence {
+				signals = append(signals, s)
+			}
+		}
+	}
+
+	kinds := make([]string, len(signals))
+	for i, s := range signals {
+		kinds[i] = s.Kind
+	}
+	res := ghaction.NewResult(actionLabel, mode, len(changed), kinds, actionBudget)
+
+	// Render the summary with the PR comment formatter.
+	pf := output.NewPRCommentFormatter()
+	pf.Budget = actionBudget
+	if mode == ghaction.ModeDiff {
+		pf.Scope = fmt.Sprintf("%d changed files", len(changed))
+	}
+	var md bytes.Buffer
+	if err := pf.Format(signals, &md); err != nil {
+		return exitError(ExitTotalFailure, "stringer: %v", err)
+	}
+	summary := md.String()
+	if actionLabel != "" {
+		summary = strings.Replace(summary, "### Stringer", "### Stringer: "+actionLabel, 1)
+	}
+
+	outputs := res.Outputs()
+	if event.PRNumber > 0 {
+		outputs["pr-number"] = strconv.Itoa(event.PRNumber)
+	}
+	if env.RunnerTemp != "" {
+		name := "stringer-comment.md"
+		if actionLabel != "" {
+			name = "stringer-comment-" + sanitizeLabel(actionLabel) + ".md"
+		}
+		commentFile := filepath.Join(env.RunnerTemp, name)
+		if err := os.WriteFile(commentFile, []byte(summary), 0o600); err != nil {
+			return exitError(ExitTotalFailure, "stringer: cannot write comment file (%v)", err)
+		}
+		outputs["comment-file"] = commentFile
+	}
+
+	if actionResults != "" {
+		if err := ghaction.WriteResult(actionResults, res); err != nil {
+			return exitError(ExitInvalidArgs, "stringer: cannot write results file %q (%v)", actionResults, err)
+		}
+	}
+	if err := publishAction(cmd, env, summary, outputs); err != nil {
+		return err
+	}
+
+	_, _ = fmt.Fprintf(cmd.OutOrStdout(), "stringer: %d signals (%s mode", res.Signals, mode)
+	if mode == ghaction.ModeDiff {
+		_, _ = fmt.Fprintf(cmd.OutOrStdout(), ", %d changed files", len(changed))
+	}
+	_, _ = fmt.Fprintln(cmd.OutOrStdout(), ")")
+	return nil
+}
+
+func runActionMerge(cmd *cobra.Command, args []string) error {
+	env, ok := ghaction.LoadEnv(actionGetenv)
+	if !ok {
+		return exitError(ExitInvalidArgs, "stringer: not running in GitHub Actions (GITHUB_ACTIONS is not \"true\")")
+	}
+
+	var results []*ghaction.Result
+	for _, arg := range args {
+		paths, err := filepath.Glob(arg)
+		if err != nil {
+			return exitError(ExitInvalidArgs, "stringer: invalid pattern %q (%v)", arg, err)
+		}
+		if len(paths) == 0 {
+			return exitError(ExitInvalidArgs, "stringer: no result files match %q", arg)
+		}
+		for _, p := range paths {
+			r, err := ghaction.ReadResult(p)
+			if err != nil {
+				return exitError(ExitInvalidArgs, "stringer: %v", err)
+			}
+			results = append(results, r)
+		}
+	}
+
+	merged := ghaction.Merge(results)
+	if err := publishAction(cmd, env, ghaction.RenderMergedSummary(results), merged.Outputs()); err != nil {
+		return err
+	}
+	_, _ = fmt.Fprintf(cmd.OutOrStdout(), "stringer: %d signals across %d matrix jobs\n", merged.Signals, len(results))
+	return nil
+}
+
+// publishAction writes the job summary and step outputs. Either file may be
+// unset when running outside a real runner; that part is skipped.
+func publishAction(cmd *cobra.Command, env *ghaction.Env, summary string, outputs map[string]string) error {
+	if env.StepSummary != "" {
+		if err := ghaction.AppendSummary(env.StepSummary, summary); err != nil {
+			return exitError(ExitTotalFailure, "stringer: cannot write step summary (%v)", err)
+		}
+	} else {
+		_, _ = fmt.Fprint(cmd.OutOrStdout(), summary)
+	}
+	if env.Output != "" {
+		if err := ghaction.SetOutputs(env.Output, outputs); err != nil {
+			return exitError(ExitTotalFailure, "stringer: cannot set step outputs (%v)", err)
+		}
+	}
+	return nil
+}
+
+// prChangedFiles lists the files a pull request added or modified, relative
+// to absPath. The base is the event's base SHA, falling back to the base
+// branch from GITHUB_BASE_REF.
+func prChangedFiles(cmd *cobra.Command, absPath string, env *ghaction.Env, event *ghaction.Event) ([]string, error) {
+	base := event.BaseSHA
+	if base == "" && env.BaseRef != "" {
+		base = "origin/" + env.BaseRef
+	}
+	if base == "" {
+		return nil, fmt.Errorf("no pull request base in the event payload or GITHUB_BASE_REF")
+	}
+	out, err := gitcli.Exec(cmd.Context(), absPath, "diff", "--name-only", "--relative", "--diff-filter=ACMRT", base+"...HEAD")
+	if err != nil {
+		return nil, err
+	}
+	var files []string
+	for _, line := range strings.Split(out, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			files = append(files, line)
+		}
+	}
+	return files, nil
+}
+
+// splitCollectors parses a comma-separated collector list.
+func splitCollectors(list string) []string {
+	if list == "" {
+		return nil
+	}
+	var names []string
+	for _, name := range strings.Split(list, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// sanitizeLabel makes a matrix label safe for use in a file name.
+func sanitizeLabel(label string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '-', r == '_', r == '.':
+			return r
+		default:
+			return '-'
+		}
+	}, label)
+}
@@ -0,0 +1,195 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// actionRunner is a fake GitHub Actions runner environment.
+type actionRunner struct {
+	env     map[string]string
+	summary string
+	output  string
+	temp    string
+}
+
+// stubActionEnv installs a runner environment for the event and returns it.
+func stubActionEnv(t *testing.T, eventName, eventJSON string) *actionRunner {
+	t.Helper()
+	dir := t.TempDir()
+	r := &actionRunner{
+		summary: filepath.Join(dir, "summary.md"),
+		output:  filepath.Join(dir, "output"),
+		temp:    filepath.Join(dir, "tmp"),
+	}
+	require.NoError(t, os.MkdirAll(r.temp, 0o750))
+	eventPath := filepath.Join(dir, "event.json")
+	require.NoError(t, os.WriteFile(eventPath, []byte(eventJSON), 0o600))
+	r.env = map[string]string{
+		"GITHUB_ACTIONS":      "true",
+		"GITHUB_EVENT_NAME":   eventName,
+		"GITHUB_EVENT_PATH":   eventPath,
+		"GITHUB_STEP_SUMMARY": r.summary,
+		"GITHUB_OUTPUT":       r.output,
+		"RUNNER_TEMP":         r.temp,
+	}
+
+	orig := actionGetenv
+	actionGetenv = func(k string) string { return r.env[k] }
+	t.Cleanup(func() { actionGetenv = orig })
+	return r
+}
+
+func (r *actionRunner) read(t *testing.T, path string) string {
+	t.Helper()
+	data, err := os.ReadFile(path) //nolint:gosec // test-controlled path
+	require.NoError(t, err)
+	return string(data)
+}
+
+func gitHead(t *testing.T, dir string) string {
+	t.Helper()
+	out, err := exec.Command("git", "-C", dir, "rev-parse", "HEAD").Output()
+	require.NoError(t, err)
+	return strings.TrimSpace(string(out))
+}
+
+func TestActionCmd_IsRegistered(t *testing.T) {
+	found := false
+	for _, cmd := range rootCmd.Commands() {
+		if cmd.Name() == "action" {
+			found = true
+			break
+		}
+	}
+	assert.True(t, found, "action command should be registered on rootCmd")
+}
+
+func TestActionCmd_PullRequestDiffMode(t *testing.T) {
+	resetActionFlags()
+	root := initTestRepo(t)
+	base := gitHead(t, root)
+	writeTestFile(t, root, "feature.go", "package main\n\n// TODO: handle retries\nfunc feature() {}\n")
+	runGitCmd(t, root, "add", ".")
+	runGitCmd(t, root, "commit", "-m", "Add feature")
+
+	r := stubActionEnv(t, "pull_request",
+		`{"pull_request":{"number":7,"base":{"sha":"`+base+`"},"head":{"sha":"x"}}}`)
+
+	cmd, stdout, _ := newTestCmd()
+	cmd.SetArgs([]string{"action", root, "-c", "todos", "--budget", "5", "--results", filepath.Join(r.temp, "result.json")})
+	require.NoError(t, cmd.Execute())
+	assert.Contains(t, stdout.String(), "stringer: 1 signals (diff mode, 1 changed files)")
+
+	summary := r.read(t, r.summary)
+	assert.Contains(t, summary, "**1 new** · **0 resolved** (1 changed files)")
+	assert.Contains(t, summary, "TODO: handle retries")
+	assert.NotContains(t, summary, "Refactor this utility function", "unchanged files are not scanned")
+	assert.Contains(t, summary, "Within budget")
+
+	outputs := r.read(t, r.output)
+	assert.Contains(t, outputs, "signals=1\n")
+	assert.Contains(t, outputs, "mode=diff\n")
+	assert.Contains(t, outputs, "changed-files=1\n")
+	assert.Contains(t, outputs, "over-budget=false\n")
+	assert.Contains(t, outputs, "pr-number=7\n")
+	assert.Contains(t, outputs, "comment-file="+filepath.Join(r.temp, "stringer-comment.md")+"\n")
+	assert.Equal(t, summary, r.read(t, filepath.Join(r.temp, "stringer-comment.md")))
+
+	assert.Contains(t, r.read(t, filepath.Join(r.temp, "result.json")), `"mode": "diff"`)
+}
+
+func TestActionCmd_PushFullMode(t *testing.T) {
+	resetActionFlags()
+	root := initTestRepo(t)
+	r := stubActionEnv(t, "push", `{"ref":"refs/heads/main"}`)
+
+	cmd, _, _ := newTestCmd()
+	cmd.SetArgs([]string{"action", root, "-c", "todos", "--label", "linux/amd64", "--budget", "1"})
+	require.NoError(t, cmd.Execute())
+
+	summary := r.read(t, r.summary)
+	assert.Contains(t, summary, "### Stringer: linux/amd64")
+	assert.Contains(t, summary, "(full scan)")
+	assert.Contains(t, summary, "Refactor this utility function")
+
+	outputs := r.read(t, r.output)
+	assert.Contains(t, outputs, "mode=full\n")
+	assert.Contains(t, outputs, "over-budget=true\n")
+	assert.NotContains(t, outputs, "pr-number")
+	assert.Contains(t, outputs, "stringer-comment-linux-amd64.md")
+}
+
+func TestActionCmd_MissingBaseFallsBackToFull(t *testing.T) {
+	resetActionFlags()
+	root := initTestRepo(t)
+	r := stubActionEnv(t, "pull_request",
+		`{"pull_request":{"number":7,"base":{"sha":"0000000000000000000000000000000000000000"}}}`)
+
+	cmd, _, _ := newTestCmd()
+	cmd.SetArgs([]string{"action", root, "-c", "todos"})
+	require.NoError(t, cmd.Execute())
+	assert.Contains(t, r.read(t, r.output), "mode=full\n")
+}
+
+func TestActionCmd_InvalidArgs(t *testing.T) {
+	resetActionFlags()
+	orig := actionGetenv
+	actionGetenv = func(string) string { return "" }
+	cmd, _, _ := newTestCmd()
+	cmd.SetArgs([]string{"action", "."})
+	err := cmd.Execute()
+	actionGetenv = orig
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not running in GitHub Actions")
+	requireExitCode(t, err, ExitInvalidArgs)
+
+	stubActionEnv(t, "push", `{}`)
+	for _, args := range [][]string{
+		{"action", ".", "--min-confidence", "2"},
+		{"action", ".", "--budget", "-1"},
+	} {
+		resetActionFlags()
+		cmd, _, _ := newTestCmd()
+		cmd.SetArgs(args)
+		err := cmd.Execute()
+		require.Error(t, err, "%v", args)
+		requireExitCode(t, err, ExitInvalidArgs)
+	}
+}
+
+func TestActionMergeCmd(t *testing.T) {
+	resetActionFlags()
+	r := stubActionEnv(t, "pull_request", `{}`)
+	dir := t.TempDir()
+	writeTestFile(t, dir, "linux.json", `{"label":"linux","mode":"diff","changed_files":2,"signals":3,"by_kind":{"todo":3}}`)
+	writeTestFile(t, dir, "macos.json", `{"label":"macos","mode":"diff","changed_files":2,"signals":1,"by_kind":{"fixme":1},"budget":0}`)
+
+	cmd, stdout, _ := newTestCmd()
+	cmd.SetArgs([]string{"action", "merge", filepath.Join(dir, "*.json")})
+	require.NoError(t, cmd.Execute())
+	assert.Contains(t, stdout.String(), "stringer: 4 signals across 2 matrix jobs")
+
+	summary := r.read(t, r.summary)
+	assert.Contains(t, summary, "| linux | diff | 2 | 3 | — |")
+	assert.Contains(t, summary, "| macos | diff | 2 | 1 | — |")
+	outputs := r.read(t, r.output)
+	assert.Contains(t, outputs, "signals=4\n")
+	assert.Contains(t, outputs, "changed-files=4\n")
+
+	cmd, _, _ = newTestCmd()
+	cmd.SetArgs([]string{"action", "merge", filepath.Join(dir, "none-*.json")})
+	err := cmd.Execute()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no result files match")
+	requireExitCode(t, err, ExitInvalidArgs)
+}
@@ -0,0 +1,145 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"fmt"
+	"log/slog"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/davetashner/stringer/internal/analysis"
+	"github.com/davetashner/stringer/internal/beads"
+	"github.com/davetashner/stringer/internal/config"
+	"github.com/davetashner/stringer/internal/estimate"
+	"github.com/davetashner/stringer/internal/output"
+	"github.com/davetashner/stringer/internal/pipeline"
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+// Backlog command flags.
+var (
+	backlogCollectors    string
+	backlogOutput        string
+	backlogMinConfidence float64
+	backlogNoRollup      bool
+)
+
+// backlogCmd seeds a Beads backlog in one step.
+var backlogCmd = &cobra.Command{
+	Use:   "backlog [path]",
+	Short: "Scan and export untracked work items as Beads JSONL",
+	Long: `Build a Beads backlog in one step: scan the repository, drop signals
+already tracked in .beads/, roll related TODOs up into epics, and write
+Beads JSONL ready for 'bd import'.
+
+This is 'stringer scan --format beads --epics' with only the flags that
+matter for seeding a tracker. Use 'stringer scan' for anything else.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runBacklog,
+}
+
+func init() {
+	backlogCmd.Flags().StringVarP(&backlogCollectors, "collectors", "c", "", "comma-separated list of collectors to run")
+	backlogCmd.Flags().StringVarP(&backlogOutput, "output", "o", "", "output file path (default: stdout)")
+	backlogCmd.Flags().Float64Var(&backlogMinConfidence, "min-confidence", 0, "leave out signals below this confidence threshold (0.0-1.0)")
+	backlogCmd.Flags().BoolVar(&backlogNoRollup, "no-rollup", false, "do not group related TODOs or signals into epics")
+
+	rootCmd.AddCommand(backlogCmd)
+}
+
+// resetBacklogFlags resets backlog command flags for testing.
+func resetBacklogFlags() {
+	backlogCollectors = ""
+	backlogOutput = ""
+	backlogMinConfidence = 0
+	backlogNoRollup = false
+
+	backlogCmd.Flags().VisitAll(func(f *pflag.Flag) {
+		_ = f.Value.Set(f.DefValue)
+		f.Changed = false
+	})
+}
+
+func runBacklog(cmd *cobra.Command, args []string) error {
+	if backlogMinConfidence < 0 || backlogMinConfidence > 1.0 {
+		return exitError(ExitInvalidArgs,
+			"stringer: --min-confidence must be between 0.0 and 1.0 (got %.2f)", backlogMinConfidence)
+	}
+
+	repoPath := "."
+	if len(args) > 0 {
+		repoPath = args[0]
+	}
+	absPath, gitRoot, err := resolveScanPath(repoPath)
+	if err != nil {
+		return err
+	}
+
+	// 1. Scan. The pipeline already removes duplicate signals.
+	result, err := runConfiguredScan(cmd.Context(), gitRoot, signal.ScanConfig{RepoPath: absPath, Collectors: splitCollectors(backlogCollectors)})
+	if err != nil {
+		return err
+	}
+	pipeline.BoostColocatedSignals(result.Signals)
+	all := result.Signals
+	var signals []signal.RawSignal
+	for _, s := range all {
+		if s.Confidence >= backlogMinConfidence {
+			signals = append(signals, s)
+		}
+	}
+
+	// 2. Drop what the tracker already has.
+	fileCfg, err := config.Load(absPath)
+	if err != nil {
+		return exitError(ExitInvalidArgs, "stringer: failed to load %s (%v)", config.FileName, err)
+	}
+	formatter := output.NewBeadsFormatter()
+	formatter.SetLabelMap(config.LabelMap(fileCfg))
+	tracked := 0
+	if fileCfg.BeadsAware == nil || *fileCfg.BeadsAware {
+		existing, beadsErr := beads.LoadBeads(absPath)
+		if beadsErr != nil {
+			slog.Warn("failed to load existing beads", "error", beadsErr)
+		}
+		if existing != nil {
+			before := len(signals)
+			signals = beads.FilterAgainstExisting(signals, existing)
+			tracked = before - len(signals)
+			formatter.SetConventions(beads.DetectConventions(existing))
+		}
+	}
+
+	// 3. Roll related TODOs, then signals of similar kinds in one module,
+	// up into epics.
+	var epics []signal.RawSignal
+	if !backlogNoRollup {
+		ec, _ := config.Epics(fileCfg)
+		epics = analysis.BuildEpics(signals, ec)
+		signals = append(signals, epics...)
+	}
+	estimate.Annotate(signals, all, absPath)
+
+	// 4. Export.
+	w := cmd.OutOrStdout()
+	if backlogOutput != "" {
+		f, createErr := cmdFS.Create(backlogOutput)
+		if createErr != nil {
+			return exitError(ExitInvalidArgs, "stringer: cannot create output file %q (%v)", backlogOutput, createErr)
+		}
+		defer f.Close() //nolint:errcheck // best-effort close on output file
+		w = f
+	}
+	if err := formatter.Format(signals, w); err != nil {
+		return exitError(ExitTotalFailure, "stringer: formatting failed (%v)", err)
+	}
+
+	if !quiet {
+		_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "stringer: %d backlog items (%d epics), %d already tracked\n",
+			len(signals), len(epics), tracked)
+	}
+	return nil
+}
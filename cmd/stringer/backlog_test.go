@@ -0,0 +1,143 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davetashner/stringer/internal/collectors"
+)
+
+// backlogTitles decodes beads JSONL and returns each record's title.
+func backlogTitles(t *testing.T, data []byte) []string {
+	t.Helper()
+	var titles []string
+	sc := bufio.NewScanner(bytes.NewReader(data))
+	for sc.Scan() {
+		var rec struct {
+			Title string `json:"title"`
+		}
+		require.NoError(t, json.Unmarshal(sc.Bytes(), &rec))
+		titles = append(titles, rec.Title)
+	}
+	return titles
+}
+
+func TestBacklogCmd(t *testing.T) {
+	resetBacklogFlags()
+	root := initTestRepo(t)
+
+	cmd, stdout, stderr := newTestCmd()
+	cmd.SetArgs([]string{"backlog", root, "-c", "todos"})
+	require.NoError(t, cmd.Execute())
+
+	titles := backlogTitles(t, stdout.Bytes())
+	assert.Contains(t, titles, "TODO: Add proper CLI argument parsing")
+	assert.Contains(t, titles, "FIXME: This will panic on nil input")
+	assert.Contains(t, stderr.String(), "already tracked")
+}
+
+func TestBacklogCmd_SkipsTrackedBeads(t *testing.T) {
+	resetBacklogFlags()
+	root := initTestRepo(t)
+	writeTestFile(t, root, ".beads/issues.jsonl",
+		`{"id":"app-1","title":"TODO: Add proper CLI argument parsing","status":"open"}
+`)
+	out := filepath.Join(t.TempDir(), "backlog.jsonl")
+
+	cmd, stdout, stderr := newTestCmd()
+	cmd.SetArgs([]string{"backlog", root, "-c", "todos", "-o", out})
+	require.NoError(t, cmd.Execute())
+	assert.Empty(t, stdout.String())
+
+	data, err := os.ReadFile(out)
+	require.NoError(t, err)
+	titles := backlogTitles(t, data)
+	assert.NotContains(t, titles, "TODO: Add proper CLI argument parsing")
+	assert.Contains(t, titles, "FIXME: This will panic on nil input")
+	assert.Contains(t, stderr.String(), "1 already tracked")
+}
+
+func TestBacklogCmd_ScoringProfile(t *testing.T) {
+	resetBacklogFlags()
+	root := initTestRepo(t)
+	writeTestFile(t, root, ".stringer.yaml", "scoring_profile: no-fixmes\nscoring_profiles:\n  no-fixmes:\n    weights:\n      - kinds: [fixme]\n        factor: 0\n")
+
+	cmd, stdout, _ := newTestCmd()
+	cmd.SetArgs([]string{"backlog", root, "-c", "todos"})
+	require.NoError(t, cmd.Execute())
+
+	titles := backlogTitles(t, stdout.Bytes())
+	assert.Contains(t, titles, "TODO: Add proper CLI argument parsing")
+	assert.NotContains(t, titles, "FIXME: This will panic on nil input", "the configured profile drops fixme signals")
+}
+
+func TestBacklogCmd_Rollup(t *testing.T) {
+	resetBacklogFlags()
+	root := initTestRepo(t)
+	writeTestFile(t, root, "internal/cache/cache.go", `package cache
+
+// TODO: evict expired entries
+// TODO: cap the entry count
+// TODO: expose hit and miss counters
+func Get() {}
+`)
+
+	cmd, stdout, _ := newTestCmd()
+	cmd.SetArgs([]string{"backlog", root, "-c", "todos"})
+	require.NoError(t, cmd.Execute())
+	withRollup := backlogTitles(t, stdout.Bytes())
+
+	resetBacklogFlags()
+	cmd, stdout, _ = newTestCmd()
+	cmd.SetArgs([]string{"backlog", root, "-c", "todos", "--no-rollup"})
+	require.NoError(t, cmd.Execute())
+	without := backlogTitles(t, stdout.Bytes())
+
+	assert.Greater(t, len(withRollup), len(without), "TODOs in one package roll up into an epic")
+}
+
+func TestBacklogCmd_PluginsOptIn(t *testing.T) {
+	t.Cleanup(func() {
+		allowPlugins = false
+		_ = collectors.RegisterPlugins(nil)
+	})
+	root := initTestRepo(t)
+	writeTestFile(t, root, ".stringer.yaml", "plugins:\n  - name: planted\n    command: ./planted.sh\n")
+	require.NoError(t, os.WriteFile(filepath.Join(root, "planted.sh"), []byte(`#!/bin/sh
+touch ran
+echo '{"Kind": "planted", "Title": "planted signal", "Confidence": 0.9}'
+`), 0o755)) //nolint:gosec // test plugin must be executable
+
+	resetBacklogFlags()
+	allowPlugins = false
+	cmd, _, _ := newTestCmd()
+	cmd.SetArgs([]string{"backlog", root, "-c", "todos,planted"})
+	err := cmd.Execute()
+	require.Error(t, err, "the repo's config cannot opt itself in")
+	assert.Contains(t, err.Error(), "planted")
+	assert.NoFileExists(t, filepath.Join(root, "ran"))
+
+	resetBacklogFlags()
+	cmd, stdout, _ := newTestCmd()
+	cmd.SetArgs([]string{"backlog", root, "-c", "todos,planted", "--allow-plugins"})
+	require.NoError(t, cmd.Execute())
+	assert.Contains(t, backlogTitles(t, stdout.Bytes()), "planted signal")
+	assert.FileExists(t, filepath.Join(root, "ran"))
+}
+
+func TestBacklogCmd_InvalidArgs(t *testing.T) {
+	resetBacklogFlags()
+	cmd, _, _ := newTestCmd()
+	cmd.SetArgs([]string{"backlog", t.TempDir(), "--min-confidence", "2"})
+	requireExitCode(t, cmd.Execute(), ExitInvalidArgs)
+}
@@ -17,7 +17,7 @@ import (
 
 	"github.com/davetashner/stringer/internal/baseline"
 	"github.com/davetashner/stringer/internal/collector"
-	_ "github.com/davetashner/stringer/internal/collectors"
+	"github.com/davetashner/stringer/internal/collectors"
 	"github.com/davetashner/stringer/internal/config"
 	"github.com/davetashner/stringer/internal/output"
 	"github.com/davetashner/stringer/internal/pipeline"
@@ -200,11 +200,11 @@ func runBaselineCreate(cmd *cobra.Command, args []string) error {
 	}
 
 	// Build scan config.
-	var collectors []string
+	var names []string
 	if baselineCollectors != "" {
-		collectors = strings.Split(baselineCollectors, ",")
-		for i := range collectors {
-			collectors[i] = strings.TrimSpace(collectors[i])
+		names = strings.Split(baselineCollectors, ",")
+		for i := range names {
+			names[i] = strings.TrimSpace(names[i])
 		}
 	}
 
@@ -215,7 +215,7 @@ func runBaselineCreate(cmd *cobra.Command, args []string) error {
 
 	scanCfg := signal.ScanConfig{
 		RepoPath:   absPath,
-		Collectors: collectors,
+		Collectors: names,
 	}
 	scanCfg = config.Merge(fileCfg, scanCfg)
 
@@ -238,7 +238,8 @@ func runBaselineCreate(cmd *cobra.Command, args []string) error {
 		return exitError(ExitInvalidArgs, "stringer: %v (available: %s)", err, strings.Join(available, ", "))
 	}
 
-	result, err := p.Run(cmd.Context())
+	ctx := collectors.WithFileTrees(collectors.WithGitHubData(cmd.Context(), collectors.NewGitHubData(0)), collectors.NewFileTrees())
+	result, err := p.Run(ctx)
 	if err != nil {
 		return exitError(ExitTotalFailure, "stringer: scan failed (%v)", err)
 	}
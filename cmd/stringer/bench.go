@@ -0,0 +1,262 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"log/slog"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/davetashner/stringer/internal/bench"
+	"github.com/davetashner/stringer/internal/gitcli"
+)
+
+// Bench command flags.
+var (
+	benchInput     string
+	benchPattern   string
+	benchCount     int
+	benchPackages  []string
+	benchCommit    string
+	benchBase      string
+	benchHead      string
+	benchThreshold float64
+	benchFail      bool
+)
+
+// benchCmd is the parent command for benchmark subcommands.
+var benchCmd = &cobra.Command{
+	Use:   "bench",
+	Short: "Record and compare Go benchmark results per commit",
+	Long: `Record go test -bench results per commit and compare them.
+
+Results are stored in .stringer/bench/<commit>.json. The bench collector
+compares the results recorded at HEAD with those of a baseline commit
+during scans and emits perf-regression signals. Timings are only compared
+between runs recorded on the same OS, architecture, and CPU.`,
+}
+
+// benchRecordCmd runs benchmarks, or reads their output, and stores it.
+var benchRecordCmd = &cobra.Command{
+	Use:   "record [path]",
+	Short: "Run go test -bench and store the results for HEAD",
+	Long: `Run go test -run '^$' -bench <pattern> -benchmem -count <n> over the
+given packages and store the results for HEAD, or --commit.
+
+With --input, read existing go test -bench output (text or -json) from a
+file, or from stdin with "-", instead of running the benchmarks.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runBenchRecord,
+}
+
+// benchCompareCmd compares the results recorded at two commits.
+var benchCompareCmd = &cobra.Command{
+	Use:   "compare [path]",
+	Short: "Compare benchmark results recorded at two commits",
+	Long: `Compare the results recorded at --head (default HEAD) with those of --base,
+by default the nearest first-parent ancestor with recorded results.
+
+Every benchmark measured at both commits is listed. Benchmarks that got
+slower or allocate more by over --threshold are marked as regressions;
+with --fail, any regression exits with code 4.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runBenchCompare,
+}
+
+func init() {
+	benchRecordCmd.Flags().StringVar(&benchInput, "input", "",
+		"read go test -bench output from a file (- for stdin) instead of running go test")
+	benchRecordCmd.Flags().StringVar(&benchPattern, "bench", ".", "benchmarks to run (go test -bench regexp)")
+	benchRecordCmd.Flags().IntVar(&benchCount, "count", 5, "runs of each benchmark (go test -count)")
+	benchRecordCmd.Flags().StringSliceVar(&benchPackages, "packages", []string{"./..."}, "packages to benchmark")
+	benchRecordCmd.Flags().StringVar(&benchCommit, "commit", "HEAD", "commit to record the results for")
+
+	benchCompareCmd.Flags().StringVar(&benchBase, "base", "", "baseline commit (default: nearest ancestor with results)")
+	benchCompareCmd.Flags().StringVar(&benchHead, "head", "HEAD", "commit to compare with the baseline")
+	benchCompareCmd.Flags().Float64Var(&benchThreshold, "threshold", bench.DefaultThreshold,
+		"relative growth reported as a regression (0.1 = 10%)")
+	benchCompareCmd.Flags().BoolVar(&benchFail, "fail", false, "exit with code 4 when a benchmark regressed")
+
+	benchCmd.AddCommand(benchRecordCmd, benchCompareCmd)
+	rootCmd.AddCommand(benchCmd)
+}
+
+// resetBenchFlags resets bench command flags for testing.
+func resetBenchFlags() {
+	benchInput = ""
+	benchPattern = "."
+	benchCount = 5
+	benchPackages = []string{"./..."}
+	benchCommit = "HEAD"
+	benchBase = ""
+	benchHead = "HEAD"
+	benchThreshold = bench.DefaultThreshold
+	benchFail = false
+	for _, c := range []*cobra.Command{benchRecordCmd, benchCompareCmd} {
+		c.Flags().VisitAll(func(f *pflag.Flag) {
+			_ = f.Value.Set(f.DefValue)
+			f.Changed = false
+		})
+	}
+}
+
+func runBenchRecord(cmd *cobra.Command, args []string) error {
+	repoPath := "."
+	if len(args) > 0 {
+		repoPath = args[0]
+	}
+	absPath, gitRoot, err := resolveScanPath(repoPath)
+	if err != nil {
+		return err
+	}
+	if err := gitcli.Available(); err != nil {
+		return exitError(ExitInvalidArgs, "stringer: %v", err)
+	}
+	if benchCount < 1 {
+		return exitError(ExitInvalidArgs, "stringer: --count must be at least 1")
+	}
+	ctx := cmd.Context()
+
+	commit, err := bench.ResolveCommit(ctx, gitRoot, benchCommit)
+	if err != nil {
+		return exitError(ExitInvalidArgs, "stringer: %v", err)
+	}
+
+	var output io.Reader
+	switch benchInput {
+	case "":
+		if dirty, _ := gitcli.Exec(ctx, gitRoot, "status", "--porcelain", "--untracked-files=no"); strings.TrimSpace(dirty) != "" && benchCommit == "HEAD" {
+			slog.Warn("working tree has uncommitted changes; the results may not match HEAD")
+		}
+		args := []string{"test", "-run", "^$", "-bench", benchPattern, "-benchmem", "-count", strconv.Itoa(benchCount)}
+		args = append(args, benchPackages...)
+		slog.Info("running benchmarks", "command", "go "+strings.Join(args, " "))
+		var stdout bytes.Buffer
+		goCmd := exec.CommandContext(ctx, "go", args...) //nolint:gosec // fixed tool, arguments from flags
+		goCmd.Dir = absPath
+		goCmd.Stdout = &stdout
+		goCmd.Stderr = cmd.ErrOrStderr()
+		if err := goCmd.Run(); err != nil {
+			return exitError(ExitTotalFailure, "stringer: go test -bench failed (%v)", err)
+		}
+		output = &stdout
+	case "-":
+		output = cmd.InOrStdin()
+	default:
+		data, err := cmdFS.ReadFile(benchInput)
+		if err != nil {
+			return exitError(ExitInvalidArgs, "stringer: cannot read --input (%v)", err)
+		}
+		output = bytes.NewReader(data)
+	}
+
+	run, err := bench.Parse(output)
+	if err != nil {
+		return exitError(ExitTotalFailure, "stringer: %v", err)
+	}
+	if len(run.Benchmarks) == 0 {
+		return exitError(ExitTotalFailure, "stringer: no benchmark results found")
+	}
+	run.Commit = commit
+	run.RecordedAt = time.Now().UTC()
+	if err := bench.Save(gitRoot, run); err != nil {
+		return exitError(ExitTotalFailure, "stringer: %v", err)
+	}
+	_, _ = fmt.Fprintf(cmd.OutOrStdout(), "Recorded %d benchmarks at %s\n", len(run.Benchmarks), shortSHA(commit))
+	return nil
+}
+
+func runBenchCompare(cmd *cobra.Command, args []string) error {
+	repoPath := "."
+	if len(args) > 0 {
+		repoPath = args[0]
+	}
+	_, gitRoot, err := resolveScanPath(repoPath)
+	if err != nil {
+		return err
+	}
+	if err := gitcli.Available(); err != nil {
+		return exitError(ExitInvalidArgs, "stringer: %v", err)
+	}
+	if benchThreshold < 0 {
+		return exitError(ExitInvalidArgs, "stringer: --threshold must not be negative")
+	}
+	ctx := cmd.Context()
+
+	head, err := bench.ResolveCommit(ctx, gitRoot, benchHead)
+	if err != nil {
+		return exitError(ExitInvalidArgs, "stringer: %v", err)
+	}
+	var base string
+	if benchBase != "" {
+		base, err = bench.ResolveCommit(ctx, gitRoot, benchBase)
+	} else {
+		base, err = bench.NearestRecorded(ctx, gitRoot, head)
+	}
+	if err != nil {
+		return exitError(ExitInvalidArgs, "stringer: %v", err)
+	}
+	if base == "" {
+		return exitError(ExitInvalidArgs, "stringer: no ancestor of %s has recorded benchmark results; pass --base", shortSHA(head))
+	}
+
+	headRun, err := loadBenchRun(gitRoot, head)
+	if err != nil {
+		return err
+	}
+	baseRun, err := loadBenchRun(gitRoot, base)
+	if err != nil {
+		return err
+	}
+	if err := bench.Comparable(baseRun, headRun); err != nil {
+		return exitError(ExitInvalidArgs, "stringer: cannot compare %s with %s: %v", shortSHA(base), shortSHA(head), err)
+	}
+
+	changes := bench.Compare(baseRun, headRun)
+	regressed := make(map[bench.Change]bool)
+	regressions := bench.Regressions(changes, benchThreshold)
+	for _, c := range regressions {
+		regressed[c] = true
+	}
+
+	out := cmd.OutOrStdout()
+	_, _ = fmt.Fprintf(out, "Benchmarks %s → %s\n\n", shortSHA(base), shortSHA(head))
+	for _, c := range changes {
+		mark := " "
+		if regressed[c] {
+			mark = "!"
+		}
+		_, _ = fmt.Fprintf(out, "%s %s %s  %s  %s\n", mark, c.Package, c.Name, c.Unit, c)
+	}
+	if len(changes) == 0 {
+		_, _ = fmt.Fprintln(out, "No benchmark was recorded at both commits.")
+	}
+	_, _ = fmt.Fprintf(out, "\n%d regression(s) over %.0f%%\n", len(regressions), benchThreshold*100)
+
+	if benchFail && len(regressions) > 0 {
+		return exitError(ExitExpectation, "stringer: %d benchmark regression(s)", len(regressions))
+	}
+	return nil
+}
+
+// loadBenchRun loads the results recorded at commit, failing when there
+// are none.
+func loadBenchRun(gitRoot, commit string) (*bench.Run, error) {
+	run, err := bench.Load(gitRoot, commit)
+	if err != nil {
+		return nil, exitError(ExitTotalFailure, "stringer: %v", err)
+	}
+	if run == nil {
+		return nil, exitError(ExitInvalidArgs, "stringer: no benchmark results recorded at %s (run stringer bench record)", shortSHA(commit))
+	}
+	return run, nil
+}
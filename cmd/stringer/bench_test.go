@@ -0,0 +1,102 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBenchCmd_IsRegistered(t *testing.T) {
+	found := false
+	for _, cmd := range rootCmd.Commands() {
+		if cmd.Name() == "bench" {
+			found = true
+			break
+		}
+	}
+	assert.True(t, found, "bench command should be registered on rootCmd")
+}
+
+const benchBaseOutput = `goos: linux
+goarch: amd64
+cpu: EPYC
+pkg: example.com/app
+BenchmarkParse-8   1000   100 ns/op   32 B/op   1 allocs/op
+BenchmarkParse-8   1000   104 ns/op   32 B/op   1 allocs/op
+`
+
+const benchHeadOutput = `goos: linux
+goarch: amd64
+cpu: EPYC
+pkg: example.com/app
+BenchmarkParse-8   1000   150 ns/op   32 B/op   1 allocs/op
+BenchmarkParse-8   1000   154 ns/op   32 B/op   1 allocs/op
+`
+
+func TestBench_RecordAndCompare(t *testing.T) {
+	dir := initTestRepo(t)
+	writeTestFile(t, dir, "base.txt", benchBaseOutput)
+	writeTestFile(t, dir, "head.txt", benchHeadOutput)
+
+	resetBenchFlags()
+	cmd, stdout, _ := newTestCmd()
+	cmd.SetArgs([]string{"bench", "record", dir, "--input", filepath.Join(dir, "base.txt")})
+	require.NoError(t, cmd.Execute())
+	assert.Contains(t, stdout.String(), "Recorded 1 benchmarks at ")
+
+	writeTestFile(t, dir, "new.go", "package main\n")
+	runGitCmd(t, dir, "add", "new.go")
+	runGitCmd(t, dir, "commit", "-m", "add new.go")
+
+	resetBenchFlags()
+	cmd, _, _ = newTestCmd()
+	cmd.SetIn(strings.NewReader(benchHeadOutput))
+	cmd.SetArgs([]string{"bench", "record", dir, "--input", "-"})
+	require.NoError(t, cmd.Execute())
+
+	resetBenchFlags()
+	cmd, stdout, _ = newTestCmd()
+	cmd.SetArgs([]string{"bench", "compare", dir})
+	require.NoError(t, cmd.Execute())
+	out := stdout.String()
+	assert.Contains(t, out, "! example.com/app BenchmarkParse  ns/op  102ns → 152ns (+49.0%)")
+	assert.Contains(t, out, "  example.com/app BenchmarkParse  B/op  32B → 32B (+0.0%)")
+	assert.Contains(t, out, "1 regression(s) over 10%")
+
+	resetBenchFlags()
+	cmd, _, _ = newTestCmd()
+	cmd.SetArgs([]string{"bench", "compare", dir, "--base", "HEAD~1", "--fail"})
+	requireExitCode(t, cmd.Execute(), ExitExpectation)
+
+	resetBenchFlags()
+	cmd, _, _ = newTestCmd()
+	cmd.SetArgs([]string{"bench", "compare", dir, "--threshold", "0.6", "--fail"})
+	require.NoError(t, cmd.Execute())
+}
+
+func TestBench_CompareWithoutResults(t *testing.T) {
+	dir := initTestRepo(t)
+	resetBenchFlags()
+	cmd, _, _ := newTestCmd()
+	cmd.SetArgs([]string{"bench", "compare", dir})
+	err := cmd.Execute()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no ancestor of")
+}
+
+func TestBench_RecordNoResults(t *testing.T) {
+	dir := initTestRepo(t)
+	writeTestFile(t, dir, "out.txt", "PASS\nok  \texample.com/app\t0.1s\n")
+	resetBenchFlags()
+	cmd, _, _ := newTestCmd()
+	cmd.SetArgs([]string{"bench", "record", dir, "--input", filepath.Join(dir, "out.txt")})
+	err := cmd.Execute()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no benchmark results found")
+}
@@ -0,0 +1,150 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/davetashner/stringer/internal/bundle"
+	"github.com/davetashner/stringer/internal/config"
+	"github.com/davetashner/stringer/internal/gitcli"
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+// Bundle command flags.
+var (
+	bundleCollectors string
+	bundleOutput     string
+)
+
+// defaultBundleFile is where the bundle is written without --output.
+const defaultBundleFile = "stringer-bundle.zip"
+
+// bundleCmd packages scan inputs and results for a bug report.
+var bundleCmd = &cobra.Command{
+	Use:   "bundle [path]",
+	Short: "Package scan inputs and results into a zip for bug reports",
+	Long: `Run a scan and package everything needed to reproduce it into a zip
+archive to attach to a stringer bug report:
+
+  manifest.json       stringer, Go, git, and OS versions
+  config/repo.yaml    the repository's .stringer.yaml
+  config/global.yaml  the global config
+  settings.json       effective settings after merging config and flags
+  collectors.json     each collector's duration, error, and metrics
+  signals.json        the signal list
+
+Nothing is uploaded. Tokens from the environment and email addresses are
+redacted, author names are replaced with stable labels (author-1, ...),
+and signal titles and descriptions, which quote source code, are left
+out. File paths are kept. Review the archive before sharing it.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runBundle,
+}
+
+func init() {
+	bundleCmd.Flags().StringVarP(&bundleCollectors, "collectors", "c", "",
+		"comma-separated list of collectors to run")
+	bundleCmd.Flags().StringVarP(&bundleOutput, "output", "o", defaultBundleFile, "output zip path")
+
+	rootCmd.AddCommand(bundleCmd)
+}
+
+// resetBundleFlags resets bundle command flags for testing.
+func resetBundleFlags() {
+	bundleCollectors = ""
+	bundleOutput = defaultBundleFile
+
+	bundleCmd.Flags().VisitAll(func(f *pflag.Flag) {
+		_ = f.Value.Set(f.DefValue)
+		f.Changed = false
+	})
+}
+
+func runBundle(cmd *cobra.Command, args []string) error {
+	repoPath := "."
+	if len(args) > 0 {
+		repoPath = args[0]
+	}
+	absPath, gitRoot, err := resolveScanPath(repoPath)
+	if err != nil {
+		return err
+	}
+
+	repoCfg, err := readOptionalFile(filepath.Join(absPath, config.FileName))
+	if err != nil {
+		return exitError(ExitInvalidArgs, "stringer: cannot read %s (%v)", config.FileName, err)
+	}
+	globalCfg, err := readOptionalFile(config.GlobalConfigPath())
+	if err != nil {
+		return exitError(ExitInvalidArgs, "stringer: cannot read global config (%v)", err)
+	}
+
+	fileCfg, err := config.Load(absPath)
+	if err != nil {
+		return exitError(ExitInvalidArgs, "stringer: failed to load config (%v)", err)
+	}
+
+	base := signal.ScanConfig{RepoPath: absPath, Collectors: splitCollectors(bundleCollectors)}
+	result, err := runConfiguredScan(cmd.Context(), gitRoot, base)
+	if err != nil {
+		return err
+	}
+
+	f, err := cmdFS.Create(bundleOutput)
+	if err != nil {
+		return exitError(ExitInvalidArgs, "stringer: cannot create output file %q (%v)", bundleOutput, err)
+	}
+	defer f.Close() //nolint:errcheck // best-effort close on output file
+
+	err = bundle.Write(f, bundle.Input{
+		Env:          bundleEnvironment(cmd, absPath),
+		GeneratedAt:  time.Now(),
+		RepoConfig:   repoCfg,
+		GlobalConfig: globalCfg,
+		Settings:     config.Merge(fileCfg, base),
+		Result:       result,
+	})
+	if err != nil {
+		return exitError(ExitTotalFailure, "stringer: writing bundle failed (%v)", err)
+	}
+	_, _ = fmt.Fprintf(cmd.OutOrStdout(), "Wrote %s (%d signal(s) from %d collector(s)). Review it before attaching it to a bug report.\n",
+		bundleOutput, len(result.Signals), len(result.Results))
+	return nil
+}
+
+// bundleEnvironment describes the running stringer, Go runtime, and git.
+func bundleEnvironment(cmd *cobra.Command, repoPath string) bundle.Environment {
+	env := bundle.Environment{
+		StringerVersion: Version,
+		GoVersion:       runtime.Version(),
+		OS:              runtime.GOOS,
+		Arch:            runtime.GOARCH,
+		NumCPU:          runtime.NumCPU(),
+	}
+	if out, err := gitcli.Exec(cmd.Context(), repoPath, "--version"); err == nil {
+		env.GitVersion = strings.TrimPrefix(strings.TrimSpace(out), "git version ")
+	}
+	return env
+}
+
+// readOptionalFile returns the contents of path, or nil when it does not
+// exist.
+func readOptionalFile(path string) ([]byte, error) {
+	data, err := os.ReadFile(path) //nolint:gosec // user config path
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	return data, err
+}
@@ -0,0 +1,80 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davetashner/stringer/internal/bundle"
+)
+
+func TestBundleCmd_IsRegistered(t *testing.T) {
+	found := false
+	for _, cmd := range rootCmd.Commands() {
+		if cmd.Name() == "bundle" {
+			found = true
+			break
+		}
+	}
+	assert.True(t, found, "bundle command should be registered on rootCmd")
+}
+
+func TestBundleCmd(t *testing.T) {
+	resetBundleFlags()
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	root := initTestRepo(t)
+	writeTestFile(t, root, ".stringer.yaml", "identities:\n  Jane Doe:\n    - jane@example.com\n")
+	outFile := filepath.Join(t.TempDir(), "report.zip")
+
+	cmd, stdout, _ := newTestCmd()
+	cmd.SetArgs([]string{"bundle", root, "-c", "todos", "-o", outFile})
+	require.NoError(t, cmd.Execute())
+	assert.Contains(t, stdout.String(), "Wrote "+outFile)
+
+	zr, err := zip.OpenReader(outFile)
+	require.NoError(t, err)
+	defer zr.Close() //nolint:errcheck // test cleanup
+	files := make(map[string]string)
+	for _, f := range zr.File {
+		rc, openErr := f.Open()
+		require.NoError(t, openErr)
+		body, readErr := io.ReadAll(rc)
+		require.NoError(t, readErr)
+		_ = rc.Close()
+		files[f.Name] = string(body)
+	}
+
+	var manifest bundle.Manifest
+	require.NoError(t, json.Unmarshal([]byte(files[bundle.ManifestFile]), &manifest))
+	assert.Equal(t, Version, manifest.Environment.StringerVersion)
+	assert.NotEmpty(t, manifest.Environment.GitVersion)
+	assert.Contains(t, manifest.Files, bundle.RepoConfigFile)
+	assert.NotContains(t, manifest.Files, bundle.GlobalConfigFile, "no global config present")
+
+	assert.NotContains(t, files[bundle.RepoConfigFile], "jane@example.com")
+	assert.Contains(t, files[bundle.SettingsFile], `"todos"`)
+	assert.Contains(t, files[bundle.CollectorsFile], `"name": "todos"`)
+	assert.Contains(t, files[bundle.SignalsFile], `"kind": "todo"`)
+	assert.NotContains(t, files[bundle.SettingsFile], root)
+}
+
+func TestBundleCmd_BadOutput(t *testing.T) {
+	resetBundleFlags()
+	root := initTestRepo(t)
+
+	cmd, _, _ := newTestCmd()
+	cmd.SetArgs([]string{"bundle", root, "-c", "todos", "-o", filepath.Join(root, "missing", "dir", "b.zip")})
+	err := cmd.Execute()
+	requireExitCode(t, err, ExitInvalidArgs)
+	_, statErr := os.Stat(filepath.Join(root, "missing"))
+	assert.True(t, os.IsNotExist(statErr))
+}
@@ -0,0 +1,75 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/davetashner/stringer/internal/scancache"
+)
+
+// cacheCmd is the parent command for cache subcommands.
+var cacheCmd = &cobra.Command{
+	Use:   "cache",
+	Short: "Manage the per-file scan cache",
+	Long: `Manage the per-file scan cache.
+
+The todos and patterns collectors record what they found in each file in
+.stringer/` + scancache.FileName + `. The next scan replays those results for
+files whose size and modification time are unchanged instead of reading
+them again. Use --no-cache on scan or report to bypass the cache for one run.`,
+}
+
+// cacheClearCmd deletes the scan cache.
+var cacheClearCmd = &cobra.Command{
+	Use:   "clear [path]",
+	Short: "Delete the scan cache",
+	Long: `Delete the scan cache of a repository and of each monorepo workspace
+in it, so the next scan reads every file.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runCacheClear,
+}
+
+func init() {
+	cacheCmd.AddCommand(cacheClearCmd)
+	rootCmd.AddCommand(cacheCmd)
+}
+
+func runCacheClear(cmd *cobra.Command, args []string) error {
+	repoPath := "."
+	if len(args) > 0 {
+		repoPath = args[0]
+	}
+	absPath, _, err := resolveScanPath(repoPath)
+	if err != nil {
+		return err
+	}
+
+	// Workspace scans keep a cache in each workspace's .stringer directory.
+	dirs := []string{absPath}
+	for _, ws := range resolveWorkspaces(absPath, false, "") {
+		if ws.Path != absPath {
+			dirs = append(dirs, ws.Path)
+		}
+	}
+
+	out := cmd.OutOrStdout()
+	cleared := 0
+	for _, dir := range dirs {
+		ok, err := scancache.Clear(dir)
+		if err != nil {
+			return exitError(ExitTotalFailure, "stringer: %v", err)
+		}
+		if ok {
+			cleared++
+			_, _ = fmt.Fprintf(out, "Removed %s\n", scancache.Path(dir))
+		}
+	}
+	if cleared == 0 {
+		_, _ = fmt.Fprintln(out, "No scan cache to clear")
+	}
+	return nil
+}
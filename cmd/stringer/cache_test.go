@@ -0,0 +1,63 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davetashner/stringer/internal/scancache"
+)
+
+func TestCacheCmd_IsRegistered(t *testing.T) {
+	found := false
+	for _, cmd := range rootCmd.Commands() {
+		if cmd.Name() == "cache" {
+			found = true
+			break
+		}
+	}
+	assert.True(t, found, "cache command should be registered on rootCmd")
+}
+
+func TestScanCache_WriteAndClear(t *testing.T) {
+	dir := initTestRepo(t)
+	old := time.Now().Add(-time.Hour)
+	require.NoError(t, os.Chtimes(filepath.Join(dir, "main.go"), old, old))
+
+	// --no-cache leaves no cache behind.
+	resetScanFlags()
+	cmd, _, _ := newTestCmd()
+	cmd.SetArgs([]string{"scan", dir, "-c", "todos", "--dry-run", "--no-cache"})
+	require.NoError(t, cmd.Execute())
+	assert.NoFileExists(t, scancache.Path(dir))
+
+	resetScanFlags()
+	cmd, _, _ = newTestCmd()
+	cmd.SetArgs([]string{"scan", dir, "-c", "todos", "--dry-run"})
+	require.NoError(t, cmd.Execute())
+	assert.FileExists(t, scancache.Path(dir))
+
+	cmd, stdout, _ := newTestCmd()
+	cmd.SetArgs([]string{"cache", "clear", dir})
+	require.NoError(t, cmd.Execute())
+	assert.Contains(t, stdout.String(), "Removed "+scancache.Path(dir))
+	assert.NoFileExists(t, scancache.Path(dir))
+
+	cmd, stdout, _ = newTestCmd()
+	cmd.SetArgs([]string{"cache", "clear", dir})
+	require.NoError(t, cmd.Execute())
+	assert.Contains(t, stdout.String(), "No scan cache to clear")
+}
+
+func TestCacheClear_InvalidPath(t *testing.T) {
+	cmd, _, _ := newTestCmd()
+	cmd.SetArgs([]string{"cache", "clear", "/nonexistent/path/that/does/not/exist"})
+	requireExitCode(t, cmd.Execute(), ExitInvalidArgs)
+}
@@ -0,0 +1,155 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	ossignal "os/signal"
+	"sort"
+	"strings"
+	"syscall"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/davetashner/stringer/internal/checkpoint"
+	"github.com/davetashner/stringer/internal/config"
+	"github.com/davetashner/stringer/internal/gitcli"
+	"github.com/davetashner/stringer/internal/pipeline"
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+// errInterrupted is the cancellation cause of a scan stopped by Ctrl-C or
+// SIGTERM.
+var errInterrupted = errors.New("scan interrupted")
+
+// interruptible returns a context cancelled, with errInterrupted as its
+// cause, on the first SIGINT or SIGTERM. A second signal gets the default
+// behavior and ends the process. Call stop once the context is no longer
+// needed.
+func interruptible(ctx context.Context) (context.Context, func()) {
+	ctx, cancel := context.WithCancelCause(ctx)
+	ch := make(chan os.Signal, 1)
+	ossignal.Notify(ch, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		select {
+		case <-ch:
+			ossignal.Stop(ch)
+			slog.Warn("interrupted, stopping collectors (interrupt again to exit now)")
+			cancel(errInterrupted)
+		case <-ctx.Done():
+		}
+	}()
+	return ctx, func() {
+		ossignal.Stop(ch)
+		cancel(nil)
+	}
+}
+
+// openCheckpoint starts the checkpoint of a scan of sc.absPath, or returns
+// nil when neither --checkpoint nor --resume asks for one. With --resume,
+// the results of an earlier, interrupted scan are reused when its
+// checkpoint passes the integrity check and was written at the same HEAD
+// by the same scan; otherwise the scan starts over.
+func (sc *scanContext) openCheckpoint() *checkpoint.Checkpoint {
+	if !scanCheckpoint && !scanResume {
+		return nil
+	}
+	head, _ := gitcli.Exec(sc.cmd.Context(), sc.gitRoot, "rev-parse", "HEAD") // "" outside git
+	head = strings.TrimSpace(head)
+	fingerprint := scanFingerprint(sc.cmd, sc.absPath, sc.fileCfg)
+
+	var resumed *checkpoint.State
+	if scanResume {
+		state, err := checkpoint.Load(sc.absPath)
+		if err == nil && state != nil {
+			err = state.Matches(head, fingerprint)
+		}
+		switch {
+		case err != nil:
+			slog.Warn("cannot resume, running the full scan", "path", checkpoint.Path(sc.absPath), "reason", err)
+		case state == nil:
+			slog.Warn("no interrupted scan to resume, running the full scan", "path", checkpoint.Path(sc.absPath))
+		default:
+			resumed = state
+			slog.Info("resuming interrupted scan", "completed_collectors", len(state.Results), "started", state.StartedAt)
+		}
+	} else if state, err := checkpoint.Load(sc.absPath); err == nil && state != nil && state.Matches(head, fingerprint) == nil {
+		slog.Info("an interrupted scan left a checkpoint; pass --resume to reuse its results", "completed_collectors", len(state.Results))
+	}
+	return checkpoint.New(sc.absPath, head, fingerprint, resumed)
+}
+
+// closeCheckpoint removes the checkpoint cp once the scan ran every
+// collector it could. It is kept, for --resume, when collectors or
+// workspaces were cut off by the time budget or collectors timed out.
+func (sc *scanContext) closeCheckpoint(cp *checkpoint.Checkpoint) {
+	if cp == nil {
+		return
+	}
+	if len(sc.skippedWS) == 0 && !resumable(sc.result.Results) {
+		if err := checkpoint.Remove(sc.absPath); err != nil {
+			slog.Warn("cannot remove scan checkpoint", "error", err)
+		}
+		return
+	}
+	slog.Info("some collectors did not finish; run the scan again with --resume to complete them",
+		"checkpoint", checkpoint.Path(sc.absPath))
+}
+
+// resumable reports whether a --resume scan could complete collectors
+// that did not finish: those skipped over the --quick budget or stopped
+// by a timeout.
+func resumable(results []signal.CollectorResult) bool {
+	for _, cr := range results {
+		if cr.SkipReason == pipeline.OverBudgetReason || (cr.Err != nil && cr.Category() == signal.CategoryTimeout) {
+			return true
+		}
+	}
+	return false
+}
+
+// interruptedError is returned by a scan stopped by Ctrl-C or SIGTERM,
+// given its checkpoint, nil when checkpointing is off.
+func interruptedError(cp *checkpoint.Checkpoint) error {
+	if cp == nil {
+		return exitError(ExitTotalFailure, "stringer: scan interrupted; run it with --checkpoint to save collector results an interrupted scan can resume from")
+	}
+	saved := cp.Len()
+	if saved == 0 {
+		return exitError(ExitTotalFailure, "stringer: scan interrupted before any collector completed")
+	}
+	return exitError(ExitTotalFailure,
+		"stringer: scan interrupted with %d collector result(s) saved; run the same command with --resume to continue", saved)
+}
+
+// scanFingerprint identifies a scan by what decides its collector results:
+// the scanned directory, the scan flags given, the configuration, and the
+// stringer version. Global flags such as --verbose, --checkpoint, and
+// --resume itself do not count.
+func scanFingerprint(cmd *cobra.Command, absPath string, fileCfg *config.Config) string {
+	var flags []string
+	cmd.LocalFlags().VisitAll(func(f *pflag.Flag) {
+		if f.Changed && f.Name != "resume" && f.Name != "checkpoint" {
+			flags = append(flags, f.Name+"="+f.Value.String())
+		}
+	})
+	sort.Strings(flags)
+
+	cfg, err := json.Marshal(fileCfg)
+	if err != nil {
+		cfg = []byte(err.Error())
+	}
+	h := sha256.New()
+	_, _ = fmt.Fprintf(h, "%s\x00%s\x00%q\x00", Version, absPath, flags)
+	_, _ = h.Write(cfg)
+	return hex.EncodeToString(h.Sum(nil))
+}
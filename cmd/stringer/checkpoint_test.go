@@ -0,0 +1,108 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davetashner/stringer/internal/checkpoint"
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+// dryRunCollectors runs a dry-run JSON scan and returns its per-collector
+// resumed flags.
+func dryRunCollectors(t *testing.T, args ...string) map[string]bool {
+	t.Helper()
+	resetScanFlags()
+	cmd, stdout, _ := newTestCmd()
+	cmd.SetArgs(append([]string{"scan", "--dry-run", "--json", "--quiet"}, args...))
+	require.NoError(t, cmd.Execute())
+
+	var parsed struct {
+		Collectors []struct {
+			Name    string `json:"name"`
+			Resumed bool   `json:"resumed"`
+		} `json:"collectors"`
+	}
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &parsed))
+	resumed := make(map[string]bool)
+	for _, c := range parsed.Collectors {
+		resumed[c.Name] = c.Resumed
+	}
+	return resumed
+}
+
+func TestScan_ResumeReusesCheckpoint(t *testing.T) {
+	dir := initTestRepo(t)
+	// patterns always times out, so the scan keeps its checkpoint.
+	writeTestFile(t, dir, ".stringer.yaml", "collectors:\n  patterns:\n    timeout: 1ns\n")
+
+	got := dryRunCollectors(t, dir, "-c", "todos,patterns", "--checkpoint")
+	assert.False(t, got["todos"])
+	assert.FileExists(t, checkpoint.Path(dir))
+
+	got = dryRunCollectors(t, dir, "-c", "todos,patterns", "--resume")
+	assert.True(t, got["todos"], "todos completed and is resumed")
+	assert.False(t, got["patterns"], "patterns timed out and runs again")
+
+	// A different command line does not reuse the checkpoint.
+	got = dryRunCollectors(t, dir, "-c", "todos,patterns", "--min-confidence=0.9", "--resume")
+	assert.False(t, got["todos"])
+}
+
+func TestScan_CompletedScanRemovesCheckpoint(t *testing.T) {
+	dir := initTestRepo(t)
+	writeTestFile(t, dir, ".stringer.yaml", "collectors:\n  patterns:\n    timeout: 1ns\n")
+	dryRunCollectors(t, dir, "-c", "todos,patterns", "--checkpoint")
+	require.FileExists(t, checkpoint.Path(dir))
+
+	require.NoError(t, os.Remove(filepath.Join(dir, ".stringer.yaml")))
+	dryRunCollectors(t, dir, "-c", "todos,patterns", "--checkpoint")
+	assert.NoFileExists(t, checkpoint.Path(dir))
+}
+
+func TestScan_CheckpointOptIn(t *testing.T) {
+	dir := initTestRepo(t)
+	writeTestFile(t, dir, ".stringer.yaml", "collectors:\n  patterns:\n    timeout: 1ns\n")
+	dryRunCollectors(t, dir, "-c", "todos,patterns")
+	assert.NoFileExists(t, checkpoint.Path(dir), "scans without --checkpoint write none")
+}
+
+func TestScan_ResumeCorruptCheckpoint(t *testing.T) {
+	dir := initTestRepo(t)
+	writeTestFile(t, dir, ".stringer.yaml", "collectors:\n  patterns:\n    timeout: 1ns\n")
+	dryRunCollectors(t, dir, "-c", "todos,patterns", "--checkpoint")
+
+	writeTestFile(t, dir, filepath.Join(".stringer", checkpoint.FileName), "{\"checksum\":\"sha256:00\",\"data\":{}}\n")
+	got := dryRunCollectors(t, dir, "-c", "todos,patterns", "--resume")
+	assert.False(t, got["todos"], "a checkpoint failing its integrity check is not used")
+}
+
+func TestScan_ResumeRejectsRemote(t *testing.T) {
+	resetScanFlags()
+	cmd, _, _ := newTestCmd()
+	cmd.SetArgs([]string{"scan", "--remote", "https://github.com/o/r", "--resume"})
+	err := cmd.Execute()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "--resume")
+}
+
+func TestInterruptedError(t *testing.T) {
+	dir := t.TempDir()
+	cp := checkpoint.New(dir, "abc123", "fp", nil)
+	requireExitCode(t, interruptedError(cp), ExitTotalFailure)
+	assert.NotContains(t, interruptedError(cp).Error(), "--resume", "nothing was saved")
+
+	require.NoError(t, cp.Record("", signal.CollectorResult{Collector: "todos"}))
+	require.NoError(t, cp.Record("", signal.CollectorResult{Collector: "gitlog"}))
+	assert.Contains(t, interruptedError(cp).Error(), "2 collector result(s) saved")
+
+	assert.Contains(t, interruptedError(nil).Error(), "--checkpoint")
+}
@@ -37,7 +37,7 @@ var knownCollectors = map[string]collectorMeta{
 	},
 	"gitlog": {
 		Description:  "Detects reverts, high-churn files, and stale branches from git history",
-		SignalKinds:  []string{"revert", "churn", "stale-branch"},
+		SignalKinds:  []string{"revert", "churn", "churn-quality", "stale-branch"},
 		ConfigFields: []string{"git_depth", "git_since"},
 	},
 	"patterns": {
@@ -46,9 +46,14 @@ var knownCollectors = map[string]collectorMeta{
 		ConfigFields: []string{"large_file_threshold"},
 	},
 	"github": {
-		Description:  "Imports open issues, pull requests, and actionable review comments from GitHub",
-		SignalKinds:  []string{"github-issue", "github-pr", "github-review-todo"},
-		ConfigFields: []string{"include_prs", "comment_depth", "max_issues_per_collector", "include_closed", "history_depth"},
+		Description:  "Imports open issues, pull requests, and actionable review comments from GitHub, and flags modules with oversized merged PRs",
+		SignalKinds:  []string{"github-issue", "github-pr", "github-review-todo", "large-batch-pattern"},
+		ConfigFields: []string{"include_prs", "comment_depth", "max_issues_per_collector", "include_closed", "history_depth", "large_batch_threshold"},
+	},
+	"gitlab": {
+		Description:  "Imports open issues, merge requests, and actionable review discussions from GitLab (gitlab.com or self-hosted)",
+		SignalKinds:  []string{"gitlab-issue", "gitlab-mr", "gitlab-review-todo"},
+		ConfigFields: []string{"max_issues_per_collector", "include_closed", "history_depth"},
 	},
 	"lotteryrisk": {
 		Description:  "Analyzes git blame and commit history to find single-author risk areas (accuracy improves with full git history; shallow clones may underreport)",
@@ -61,8 +66,8 @@ var knownCollectors = map[string]collectorMeta{
 		ConfigFields: []string{},
 	},
 	"dephealth": {
-		Description:  "Detects deprecated, yanked, archived, and stale dependencies",
-		SignalKinds:  []string{"deprecated-dependency", "yanked-dependency", "archived-dependency", "stale-dependency"},
+		Description:  "Detects deprecated, yanked, archived, and stale dependencies, and version skew across workspaces",
+		SignalKinds:  []string{"deprecated-dependency", "yanked-dependency", "archived-dependency", "stale-dependency", "version-skew"},
 		ConfigFields: []string{},
 	},
 	"complexity": {
@@ -71,8 +76,8 @@ var knownCollectors = map[string]collectorMeta{
 		ConfigFields: []string{"min_function_lines", "min_complexity_score"},
 	},
 	"deadcode": {
-		Description:  "Detects unused functions and types via regex heuristic and reference search",
-		SignalKinds:  []string{"unused-function", "unused-type"},
+		Description:  "Detects unused functions and types via regex heuristic and reference search, and unreachable Go code",
+		SignalKinds:  []string{"unused-function", "unused-type", "unreachable-code"},
 		ConfigFields: []string{},
 	},
 	"duplication": {
@@ -81,8 +86,8 @@ var knownCollectors = map[string]collectorMeta{
 		ConfigFields: []string{},
 	},
 	"githygiene": {
-		Description:  "Detects large binaries, merge conflict markers, committed secrets, and mixed line endings",
-		SignalKinds:  []string{"large-binary", "merge-conflict-marker", "committed-secret", "mixed-line-endings"},
+		Description:  "Detects large binaries, merge conflict markers, committed secrets, mixed line endings, and tracked stringer state",
+		SignalKinds:  []string{"large-binary", "merge-conflict-marker", "committed-secret", "mixed-line-endings", "tooling-hygiene"},
 		ConfigFields: []string{},
 	},
 	"docstale": {
@@ -105,6 +110,66 @@ var knownCollectors = map[string]collectorMeta{
 		SignalKinds:  []string{"circular-dependency", "high-coupling"},
 		ConfigFields: []string{},
 	},
+	"architecture": {
+		Description:  "Checks Go and JS/TS imports against layer and forbidden-import rules",
+		SignalKinds:  []string{"architecture-violation"},
+		ConfigFields: []string{"architecture_rules"},
+	},
+	"testtiming": {
+		Description:  "Flags tests over their package's latency budget from go test -json or JUnit reports",
+		SignalKinds:  []string{"slow-test"},
+		ConfigFields: []string{"test_reports", "slow_test_threshold", "slow_test_budgets"},
+	},
+	"assets": {
+		Description:  "Flags large binaries and LFS candidates in git, committed build output, and large files left in history",
+		SignalKinds:  []string{"repo-hygiene"},
+		ConfigFields: []string{"large_binary_threshold", "git_depth"},
+	},
+	"releases": {
+		Description:  "Compares version tags with the changelog, GitHub releases, and merged PRs for unreleased work and missing notes",
+		SignalKinds:  []string{"release-hygiene"},
+		ConfigFields: []string{},
+	},
+	"apicompat": {
+		Description:  "Compares the exported Go API at HEAD with the latest release tag for removed or changed symbols",
+		SignalKinds:  []string{"breaking-change"},
+		ConfigFields: []string{},
+	},
+	"testquality": {
+		Description:  "Finds Go tests skipped for a long time, packages where many tests skip, assertion-free tests, and ignored test files",
+		SignalKinds:  []string{"test-debt"},
+		ConfigFields: []string{},
+	},
+	"bench": {
+		Description:  "Compares benchmark results recorded by stringer bench at HEAD with a baseline commit and reports regressions",
+		SignalKinds:  []string{"perf-regression"},
+		ConfigFields: []string{"bench_base", "bench_threshold"},
+	},
+	"deprecation": {
+		Description:  "Finds uses of deprecated Go APIs, configured deprecated APIs, and imports of archived GitHub repos",
+		SignalKinds:  []string{"deprecated-usage"},
+		ConfigFields: []string{"deprecated_apis"},
+	},
+	"lint": {
+		Description:  "Turns go vet and staticcheck diagnostics into signals, from JSON reports or by running the tools",
+		SignalKinds:  []string{"vet-finding", "staticcheck-bug", "staticcheck-unused", "staticcheck-simplify", "staticcheck-style"},
+		ConfigFields: []string{"lint_reports", "lint_tools"},
+	},
+	"lint-report": {
+		Description:  "Ingests ESLint, Ruff, Clippy, and SARIF reports from a report directory",
+		SignalKinds:  []string{"lint-finding"},
+		ConfigFields: []string{"lint_report_dir"},
+	},
+	"coverage": {
+		Description:  "Flags files under a coverage threshold from Go cover profiles, LCOV, or Cobertura XML reports",
+		SignalKinds:  []string{"low-coverage"},
+		ConfigFields: []string{"coverage_reports", "coverage_threshold"},
+	},
+	"workflows": {
+		Description:  "Lints GitHub Actions workflows for unpinned actions, pull_request_target checkouts, missing permissions, and workflows left switched off",
+		SignalKinds:  []string{"ci-risk"},
+		ConfigFields: []string{},
+	},
 }
 
 // Common config fields that apply to every collector.
@@ -147,6 +212,12 @@ var collectorThresholds = map[string][]struct {
 	"githygiene": {
 		{"large_binary_threshold", "1000000"},
 	},
+	"assets": {
+		{"large_binary_threshold", "1000000"},
+	},
+	"github": {
+		{"large_batch_threshold", "400"},
+	},
 	"patterns": {
 		{"large_file_threshold", "1500"},
 		{"test_ratio_threshold", "0.1"},
@@ -159,6 +230,9 @@ var collectorThresholds = map[string][]struct {
 	"lotteryrisk": {
 		{"lottery_risk_threshold", "1"},
 	},
+	"testtiming": {
+		{"slow_test_threshold", "2s"},
+	},
 }
 
 // collectorsInfoJSON controls --json output for the info subcommand.
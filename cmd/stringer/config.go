@@ -12,11 +12,15 @@ import (
 	"github.com/spf13/cobra"
 	"gopkg.in/yaml.v3"
 
+	"github.com/davetashner/stringer/internal/bootstrap"
 	"github.com/davetashner/stringer/internal/config"
 )
 
 // Config command flags.
-var configGlobal bool
+var (
+	configGlobal    bool
+	configInitForce bool
+)
 
 // configCmd is the parent command for config subcommands.
 var configCmd = &cobra.Command{
@@ -82,22 +86,77 @@ from the repo config (.stringer.yaml) or global config
 	RunE: runConfigList,
 }
 
+// configValidateCmd checks a config file against the schema.
+var configValidateCmd = &cobra.Command{
+	Use:   "validate [file]",
+	Short: "Validate a config file against the schema",
+	Long: `Validate a config file against the stringer config schema.
+
+Reports unknown keys (with a suggestion for likely typos), duplicate keys,
+values of the wrong type, and invalid settings such as out-of-range
+confidences or unknown collectors, each with its line and column.
+Exits non-zero when any problem is found.
+
+Defaults to .stringer.yaml in the current directory.
+
+Examples:
+  stringer config validate
+  stringer config validate path/to/.stringer.yaml
+  stringer config validate --global`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runConfigValidate,
+}
+
+// configInitCmd writes a commented starter config.
+var configInitCmd = &cobra.Command{
+	Use:   "init [path]",
+	Short: "Generate a commented starter .stringer.yaml",
+	Long: `Generate a commented starter .stringer.yaml in the repository root.
+
+Writes the same config as 'stringer init' without touching AGENTS.md or
+other files. The GitHub collector is enabled when the repository has a
+GitHub remote. Refuses to overwrite an existing file unless --force is set.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runConfigInit,
+}
+
+// configSchemaCmd prints the JSON Schema for the config file.
+var configSchemaCmd = &cobra.Command{
+	Use:   "schema",
+	Short: "Print the JSON Schema for .stringer.yaml",
+	Long: `Print the JSON Schema (draft 2020-12) for .stringer.yaml.
+
+The schema is generated from the config types, so it always matches the
+running binary. It is also published at:
+  ` + config.SchemaID,
+	Args: cobra.NoArgs,
+	RunE: runConfigSchema,
+}
+
 func init() {
 	configGetCmd.Flags().BoolVar(&configGlobal, "global", false, "use global config (~/.config/stringer/config.yaml)")
 	configSetCmd.Flags().BoolVar(&configGlobal, "global", false, "write to global config (~/.config/stringer/config.yaml)")
+	configValidateCmd.Flags().BoolVar(&configGlobal, "global", false, "validate global config (~/.config/stringer/config.yaml)")
+	configInitCmd.Flags().BoolVar(&configInitForce, "force", false, "overwrite an existing .stringer.yaml")
 
 	configCmd.AddCommand(configGetCmd)
 	configCmd.AddCommand(configSetCmd)
 	configCmd.AddCommand(configListCmd)
+	configCmd.AddCommand(configValidateCmd)
+	configCmd.AddCommand(configInitCmd)
+	configCmd.AddCommand(configSchemaCmd)
 }
 
 // resetConfigFlags resets config command flags for testing.
 func resetConfigFlags() {
 	configGlobal = false
-	if f := configGetCmd.Flags().Lookup("global"); f != nil {
-		_ = f.Value.Set("false")
+	configInitForce = false
+	for _, c := range []*cobra.Command{configGetCmd, configSetCmd, configValidateCmd} {
+		if f := c.Flags().Lookup("global"); f != nil {
+			_ = f.Value.Set("false")
+		}
 	}
-	if f := configSetCmd.Flags().Lookup("global"); f != nil {
+	if f := configInitCmd.Flags().Lookup("force"); f != nil {
 		_ = f.Value.Set("false")
 	}
 }
@@ -242,6 +301,60 @@ func runConfigList(cmd *cobra.Command, _ []string) error {
 	return nil
 }
 
+func runConfigValidate(cmd *cobra.Command, args []string) error {
+	path := config.FileName
+	switch {
+	case len(args) > 0:
+		path = args[0]
+	case configGlobal:
+		path = config.GlobalConfigPath()
+	}
+
+	problems, err := config.ValidateFile(path)
+	if err != nil {
+		return exitError(ExitInvalidArgs, "stringer: cannot read config %q (%v)", path, err)
+	}
+	if len(problems) == 0 {
+		_, _ = fmt.Fprintf(cmd.OutOrStdout(), "%s: valid\n", path)
+		return nil
+	}
+
+	w := cmd.ErrOrStderr()
+	for _, p := range problems {
+		_, _ = fmt.Fprintf(w, "%s:%s\n", path, p.Error())
+	}
+	_, _ = fmt.Fprintf(w, "\n%d problem(s) found in %s\n", len(problems), path)
+	return exitError(ExitInvalidArgs, "stringer: invalid config %s", path)
+}
+
+func runConfigInit(cmd *cobra.Command, args []string) error {
+	repoPath := "."
+	if len(args) > 0 {
+		repoPath = args[0]
+	}
+	info, err := cmdFS.Stat(repoPath)
+	if err != nil || !info.IsDir() {
+		return exitError(ExitInvalidArgs, "stringer: %q is not a directory", repoPath)
+	}
+
+	hasGitHub := bootstrap.DetectGitHubRemote(repoPath) != nil
+	action, err := bootstrap.GenerateConfig(repoPath, hasGitHub, configInitForce, nil)
+	if err != nil {
+		return fmt.Errorf("stringer: config init failed (%v)", err)
+	}
+	_, _ = fmt.Fprintf(cmd.OutOrStdout(), "%s %s (%s)\n", action.File, action.Operation, action.Description)
+	return nil
+}
+
+func runConfigSchema(cmd *cobra.Command, _ []string) error {
+	data, err := config.Schema()
+	if err != nil {
+		return fmt.Errorf("generating schema: %w", err)
+	}
+	_, err = cmd.OutOrStdout().Write(data)
+	return err
+}
+
 // printValue outputs a value: scalars as plain text, maps/slices as YAML.
 func printValue(cmd *cobra.Command, val any) error {
 	switch v := val.(type) {
@@ -265,6 +378,9 @@ func mergeConfigs(global, repo *config.Config) *config.Config {
 	if repo.OutputFormat != "" {
 		merged.OutputFormat = repo.OutputFormat
 	}
+	if repo.Lang != "" {
+		merged.Lang = repo.Lang
+	}
 	if repo.MaxIssues != 0 {
 		merged.MaxIssues = repo.MaxIssues
 	}
@@ -277,6 +393,160 @@ func mergeConfigs(global, repo *config.Config) *config.Config {
 	if len(repo.PriorityOverrides) > 0 {
 		merged.PriorityOverrides = repo.PriorityOverrides
 	}
+	if len(repo.Labels) > 0 {
+		merged.Labels = repo.Labels
+	}
+	if len(repo.CSVColumns) > 0 {
+		merged.CSVColumns = repo.CSVColumns
+	}
+	if len(repo.Plugins) > 0 {
+		merged.Plugins = repo.Plugins
+	}
+	if len(repo.Rules) > 0 {
+		merged.Rules = repo.Rules
+	}
+	if repo.ScoringProfile != "" {
+		merged.ScoringProfile = repo.ScoringProfile
+	}
+
+	// Merge exit codes: repo overrides global per condition.
+	if repo.ExitCodes != nil {
+		ec := config.ExitCodesConfig{}
+		if global.ExitCodes != nil {
+			ec = *global.ExitCodes
+		}
+		for _, f := range []struct{ dst, src **int }{
+			{&ec.PartialFailure, &repo.ExitCodes.PartialFailure},
+			{&ec.TotalFailure, &repo.ExitCodes.TotalFailure},
+			{&ec.Expectation, &repo.ExitCodes.Expectation},
+			{&ec.BudgetExceeded, &repo.ExitCodes.BudgetExceeded},
+			{&ec.Secrets, &repo.ExitCodes.Secrets},
+		} {
+			if *f.src != nil {
+				*f.dst = *f.src
+			}
+		}
+		merged.ExitCodes = &ec
+	}
+
+	// Merge hotspot thresholds: repo overrides global per field.
+	if repo.Hotspots != nil {
+		hs := config.HotspotsConfig{}
+		if global.Hotspots != nil {
+			hs = *global.Hotspots
+		}
+		if repo.Hotspots.Enabled != nil {
+			hs.Enabled = repo.Hotspots.Enabled
+		}
+		if repo.Hotspots.MinChanges != 0 {
+			hs.MinChanges = repo.Hotspots.MinChanges
+		}
+		if repo.Hotspots.MinLines != 0 {
+			hs.MinLines = repo.Hotspots.MinLines
+		}
+		if repo.Hotspots.MinComplexity != 0 {
+			hs.MinComplexity = repo.Hotspots.MinComplexity
+		}
+		if repo.Hotspots.MinScore != 0 {
+			hs.MinScore = repo.Hotspots.MinScore
+		}
+		if repo.Hotspots.MaxSignals != 0 {
+			hs.MaxSignals = repo.Hotspots.MaxSignals
+		}
+		merged.Hotspots = &hs
+	}
+
+	// Merge priority settings: repo overrides global per field.
+	if repo.Priority != nil {
+		pc := config.PriorityConfig{}
+		if global.Priority != nil {
+			pc = *global.Priority
+		}
+		if repo.Priority.Enabled != nil {
+			pc.Enabled = repo.Priority.Enabled
+		}
+		if repo.Priority.P1 != 0 {
+			pc.P1 = repo.Priority.P1
+		}
+		if repo.Priority.P2 != 0 {
+			pc.P2 = repo.Priority.P2
+		}
+		if repo.Priority.P3 != 0 {
+			pc.P3 = repo.Priority.P3
+		}
+		if repo.Priority.AgeWeight != nil {
+			pc.AgeWeight = repo.Priority.AgeWeight
+		}
+		if repo.Priority.AgeDays != 0 {
+			pc.AgeDays = repo.Priority.AgeDays
+		}
+		if repo.Priority.HotspotWeight != nil {
+			pc.HotspotWeight = repo.Priority.HotspotWeight
+		}
+		merged.Priority = &pc
+	}
+
+	// Merge LLM settings: repo overrides global per field, except base_url
+	// and enrich, which only the global config sets.
+	if repo.LLM != nil {
+		lc := config.LLMConfig{}
+		if global.LLM != nil {
+			lc = *global.LLM
+		}
+		if repo.LLM.Provider != "" {
+			lc.Provider = repo.LLM.Provider
+		}
+		if repo.LLM.Model != "" {
+			lc.Model = repo.LLM.Model
+		}
+		if repo.LLM.DuplicateThreshold != 0 {
+			lc.DuplicateThreshold = repo.LLM.DuplicateThreshold
+		}
+		merged.LLM = &lc
+	}
+
+	// Merge epic settings: repo overrides global per field.
+	if repo.Epics != nil {
+		ec := config.EpicsConfig{}
+		if global.Epics != nil {
+			ec = *global.Epics
+		}
+		if repo.Epics.Enabled {
+			ec.Enabled = true
+		}
+		if repo.Epics.ModuleMinSize != 0 {
+			ec.ModuleMinSize = repo.Epics.ModuleMinSize
+		}
+		if repo.Epics.ModuleDepth != 0 {
+			ec.ModuleDepth = repo.Epics.ModuleDepth
+		}
+		if repo.Epics.KindSimilarity != 0 {
+			ec.KindSimilarity = repo.Epics.KindSimilarity
+		}
+		merged.Epics = &ec
+	}
+
+	// Merge identities: repo overrides global per canonical name.
+	if len(repo.Identities) > 0 {
+		merged.Identities = make(map[string][]string, len(global.Identities)+len(repo.Identities))
+		for name, aliases := range global.Identities {
+			merged.Identities[name] = aliases
+		}
+		for name, aliases := range repo.Identities {
+			merged.Identities[name] = aliases
+		}
+	}
+
+	// Merge scoring profiles: repo overrides global per profile name.
+	if len(repo.ScoringProfiles) > 0 {
+		merged.ScoringProfiles = make(map[string]config.ScoringProfileConfig, len(global.ScoringProfiles)+len(repo.ScoringProfiles))
+		for name, p := range global.ScoringProfiles {
+			merged.ScoringProfiles[name] = p
+		}
+		for name, p := range repo.ScoringProfiles {
+			merged.ScoringProfiles[name] = p
+		}
+	}
 
 	// Merge collector configs: repo overrides global per collector.
 	if len(repo.Collectors) > 0 {
@@ -34,6 +34,9 @@ func TestConfigSubcommands_AreRegistered(t *testing.T) {
 	assert.True(t, subs["get"], "get subcommand should be registered")
 	assert.True(t, subs["set"], "set subcommand should be registered")
 	assert.True(t, subs["list"], "list subcommand should be registered")
+	assert.True(t, subs["validate"], "validate subcommand should be registered")
+	assert.True(t, subs["init"], "init subcommand should be registered")
+	assert.True(t, subs["schema"], "schema subcommand should be registered")
 }
 
 func TestConfigGet_TopLevel(t *testing.T) {
@@ -416,3 +419,184 @@ func TestConfigSetCmd_GlobalFlag(t *testing.T) {
 	require.NotNil(t, f)
 	assert.Equal(t, "false", f.DefValue)
 }
+
+func TestMergeConfigs_ExitCodes(t *testing.T) {
+	code := func(v int) *int { return &v }
+	global := &config.Config{ExitCodes: &config.ExitCodesConfig{Secrets: code(5), BudgetExceeded: code(4)}}
+	repo := &config.Config{ExitCodes: &config.ExitCodesConfig{BudgetExceeded: code(0)}}
+
+	merged := mergeConfigs(global, repo)
+	require.NotNil(t, merged.ExitCodes)
+	assert.Equal(t, 5, *merged.ExitCodes.Secrets, "global codes the repo does not set are kept")
+	assert.Equal(t, 0, *merged.ExitCodes.BudgetExceeded, "repo codes win")
+	assert.Nil(t, merged.ExitCodes.PartialFailure)
+	assert.Equal(t, 4, *global.ExitCodes.BudgetExceeded, "global config is not modified")
+}
+
+func TestMergeConfigs_Hotspots(t *testing.T) {
+	off := false
+	global := &config.Config{Hotspots: &config.HotspotsConfig{MinChanges: 8, MaxSignals: 5}}
+	repo := &config.Config{Hotspots: &config.HotspotsConfig{Enabled: &off, MaxSignals: 20}}
+
+	merged := mergeConfigs(global, repo)
+	require.NotNil(t, merged.Hotspots)
+	assert.Equal(t, 8, merged.Hotspots.MinChanges, "global thresholds the repo does not set are kept")
+	assert.Equal(t, 20, merged.Hotspots.MaxSignals, "repo thresholds win")
+	assert.False(t, *merged.Hotspots.Enabled)
+	assert.Equal(t, 5, global.Hotspots.MaxSignals, "global config is not modified")
+}
+
+func TestMergeConfigs_Epics(t *testing.T) {
+	global := &config.Config{Epics: &config.EpicsConfig{ModuleMinSize: 8, ModuleDepth: 3}}
+	repo := &config.Config{Epics: &config.EpicsConfig{Enabled: true, ModuleMinSize: 4}}
+
+	merged := mergeConfigs(global, repo)
+	require.NotNil(t, merged.Epics)
+	assert.Equal(t, 4, merged.Epics.ModuleMinSize, "repo settings win")
+	assert.Equal(t, 3, merged.Epics.ModuleDepth, "global settings the repo does not set are kept")
+	assert.True(t, merged.Epics.Enabled)
+	assert.Equal(t, 8, global.Epics.ModuleMinSize, "global config is not modified")
+}
+
+func TestMergeConfigs_LLM(t *testing.T) {
+	global := &config.Config{LLM: &config.LLMConfig{Provider: "openai", Model: "gpt-4o", BaseURL: "https://proxy.internal/v1"}}
+	repo := &config.Config{LLM: &config.LLMConfig{Model: "gpt-4o-mini", Enrich: true, BaseURL: "https://attacker.example/v1"}}
+
+	merged := mergeConfigs(global, repo)
+	require.NotNil(t, merged.LLM)
+	assert.Equal(t, "openai", merged.LLM.Provider, "global settings the repo does not set are kept")
+	assert.Equal(t, "gpt-4o-mini", merged.LLM.Model, "repo settings win")
+	assert.False(t, merged.LLM.Enrich, "only the global config turns enrichment on")
+	assert.Equal(t, "https://proxy.internal/v1", merged.LLM.BaseURL, "only the global config sets the base URL")
+	assert.Equal(t, "gpt-4o", global.LLM.Model, "global config is not modified")
+}
+
+func TestMergeConfigs_Priority(t *testing.T) {
+	weight := 0.2
+	global := &config.Config{Priority: &config.PriorityConfig{P1: 0.9, HotspotWeight: &weight}}
+	repo := &config.Config{Priority: &config.PriorityConfig{P1: 0.85, AgeDays: 90}}
+
+	merged := mergeConfigs(global, repo)
+	require.NotNil(t, merged.Priority)
+	assert.Equal(t, 0.85, merged.Priority.P1, "repo settings win")
+	assert.Equal(t, 90, merged.Priority.AgeDays)
+	assert.Equal(t, 0.2, *merged.Priority.HotspotWeight, "global settings the repo does not set are kept")
+	assert.Equal(t, 0.9, global.Priority.P1, "global config is not modified")
+}
+
+func TestMergeConfigs_ScoringProfiles(t *testing.T) {
+	global := &config.Config{
+		ScoringProfile: "ours",
+		ScoringProfiles: map[string]config.ScoringProfileConfig{
+			"ours":   {Description: "global"},
+			"shared": {Description: "global"},
+		},
+	}
+	repo := &config.Config{
+		ScoringProfile:  "security-first",
+		ScoringProfiles: map[string]config.ScoringProfileConfig{"ours": {Description: "repo"}},
+	}
+
+	merged := mergeConfigs(global, repo)
+	assert.Equal(t, "security-first", merged.ScoringProfile, "repo selection wins")
+	assert.Equal(t, "repo", merged.ScoringProfiles["ours"].Description, "repo profiles win per name")
+	assert.Equal(t, "global", merged.ScoringProfiles["shared"].Description, "global profiles the repo does not define are kept")
+	assert.Equal(t, "global", global.ScoringProfiles["ours"].Description, "global config is not modified")
+	assert.Equal(t, "ours", mergeConfigs(global, &config.Config{}).ScoringProfile)
+}
+
+func TestMergeConfigs_CSVColumns(t *testing.T) {
+	global := &config.Config{CSVColumns: []string{"id", "title"}}
+
+	assert.Equal(t, []string{"id", "title"}, mergeConfigs(global, &config.Config{}).CSVColumns)
+	merged := mergeConfigs(global, &config.Config{CSVColumns: []string{"title", "author"}})
+	assert.Equal(t, []string{"title", "author"}, merged.CSVColumns, "repo columns win")
+}
+
+func TestConfigValidate_Valid(t *testing.T) {
+	resetConfigFlags()
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, config.FileName), []byte("output_format: json\n"), 0o600))
+	origDir, _ := os.Getwd()
+	require.NoError(t, os.Chdir(dir))
+	t.Cleanup(func() { _ = os.Chdir(origDir) })
+
+	stdout := new(bytes.Buffer)
+	rootCmd.SetOut(stdout)
+	rootCmd.SetArgs([]string{"config", "validate"})
+
+	require.NoError(t, rootCmd.Execute())
+	assert.Contains(t, stdout.String(), ".stringer.yaml: valid")
+}
+
+func TestConfigValidate_ReportsLocations(t *testing.T) {
+	resetConfigFlags()
+	path := filepath.Join(t.TempDir(), "custom.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("collectors:\n  todos:\n    min_confidance: 0.5\n"), 0o600))
+
+	stderr := new(bytes.Buffer)
+	rootCmd.SetOut(new(bytes.Buffer))
+	rootCmd.SetErr(stderr)
+	rootCmd.SetArgs([]string{"config", "validate", path})
+	t.Cleanup(func() { rootCmd.SetErr(nil) })
+
+	err := rootCmd.Execute()
+	require.Error(t, err)
+	var ec *exitCodeError
+	require.ErrorAs(t, err, &ec)
+	assert.Equal(t, ExitInvalidArgs, ec.code)
+	assert.Contains(t, stderr.String(), path+`:3:5: collectors.todos.min_confidance: unknown key; did you mean "min_confidence"?`)
+	assert.Contains(t, stderr.String(), "1 problem(s) found")
+}
+
+func TestConfigValidate_MissingFile(t *testing.T) {
+	resetConfigFlags()
+	rootCmd.SetArgs([]string{"config", "validate", filepath.Join(t.TempDir(), "missing.yaml")})
+
+	err := rootCmd.Execute()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "cannot read config")
+}
+
+func TestConfigInit_WritesValidConfig(t *testing.T) {
+	resetConfigFlags()
+	dir := t.TempDir()
+
+	stdout := new(bytes.Buffer)
+	rootCmd.SetOut(stdout)
+	rootCmd.SetArgs([]string{"config", "init", dir})
+	require.NoError(t, rootCmd.Execute())
+	assert.Contains(t, stdout.String(), ".stringer.yaml created")
+
+	data, err := os.ReadFile(filepath.Join(dir, config.FileName))
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "$schema="+config.SchemaID)
+	assert.Empty(t, config.ValidateBytes(data), "generated config should validate")
+
+	// A second run leaves the file alone unless --force is set.
+	require.NoError(t, os.WriteFile(filepath.Join(dir, config.FileName), []byte("max_issues: 3\n"), 0o600))
+	stdout.Reset()
+	rootCmd.SetArgs([]string{"config", "init", dir})
+	require.NoError(t, rootCmd.Execute())
+	assert.Contains(t, stdout.String(), "skipped")
+
+	resetConfigFlags()
+	rootCmd.SetArgs([]string{"config", "init", "--force", dir})
+	require.NoError(t, rootCmd.Execute())
+	data, err = os.ReadFile(filepath.Join(dir, config.FileName))
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "collectors:")
+	resetConfigFlags()
+}
+
+func TestConfigSchema_PrintsSchema(t *testing.T) {
+	resetConfigFlags()
+	stdout := new(bytes.Buffer)
+	rootCmd.SetOut(stdout)
+	rootCmd.SetArgs([]string{"config", "schema"})
+
+	require.NoError(t, rootCmd.Execute())
+	want, err := config.Schema()
+	require.NoError(t, err)
+	assert.Equal(t, string(want), stdout.String())
+}
@@ -5,9 +5,13 @@ package main
 
 import (
 	"log/slog"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/davetashner/stringer/internal/collector"
+	"github.com/davetashner/stringer/internal/collectors"
+	"github.com/davetashner/stringer/internal/config"
 	"github.com/davetashner/stringer/internal/signal"
 )
 
@@ -33,11 +37,25 @@ type flagOverrides struct {
 	// Paths restricts scanning to specific files/directories (all collectors).
 	Paths []string
 
+	// MaxDepth limits directory levels scanned below the root or each path.
+	MaxDepth int
+
 	// IncludeClosed includes closed/merged GitHub issues (scan-only).
 	IncludeClosed bool
 
 	// HistoryDepth filters closed items older than this duration (scan-only).
 	HistoryDepth string
+
+	// TestReports lists test report paths for the testtiming collector (scan-only).
+	TestReports []string
+
+	// LintReports lists go vet and staticcheck JSON reports for the lint
+	// collector (scan-only).
+	LintReports []string
+
+	// GitHubRepo is the owner/repo of scan --remote, read by the github
+	// collector in place of the origin remote (scan-only).
+	GitHubRepo string
 }
 
 // applyFlagOverrides wires CLI flag values into the per-collector options map
@@ -61,15 +79,38 @@ func applyFlagOverrides(cfg *signal.ScanConfig, flags flagOverrides) {
 		}
 	}
 
-	// 2. --include-closed / --history-depth → github (scan-only; zero when called from report).
+	// 2. --include-closed / --history-depth → github, gitlab (scan-only; zero when called from report).
 	if flags.IncludeClosed || flags.HistoryDepth != "" {
-		co := cfg.CollectorOpts["github"]
-		if flags.IncludeClosed {
-			co.IncludeClosed = true
-		}
-		if flags.HistoryDepth != "" && co.HistoryDepth == "" {
-			co.HistoryDepth = flags.HistoryDepth
+		for _, name := range []string{"github", "gitlab"} {
+			co := cfg.CollectorOpts[name]
+			if flags.IncludeClosed {
+				co.IncludeClosed = true
+			}
+			if flags.HistoryDepth != "" && co.HistoryDepth == "" {
+				co.HistoryDepth = flags.HistoryDepth
+			}
+			cfg.CollectorOpts[name] = co
 		}
+	}
+
+	// 2b. --test-report → testtiming (scan-only), replacing configured reports.
+	if len(flags.TestReports) > 0 {
+		co := cfg.CollectorOpts["testtiming"]
+		co.TestReports = flags.TestReports
+		cfg.CollectorOpts["testtiming"] = co
+	}
+
+	// 2c. --lint-report → lint (scan-only), replacing configured reports.
+	if len(flags.LintReports) > 0 {
+		co := cfg.CollectorOpts["lint"]
+		co.LintReports = flags.LintReports
+		cfg.CollectorOpts["lint"] = co
+	}
+
+	// 2d. --remote → github, which has no origin remote to read.
+	if flags.GitHubRepo != "" {
+		co := cfg.CollectorOpts["github"]
+		co.GitHubRepo = flags.GitHubRepo
 		cfg.CollectorOpts["github"] = co
 	}
 
@@ -112,13 +153,10 @@ func applyFlagOverrides(cfg *signal.ScanConfig, flags flagOverrides) {
 		}
 	}
 
-	// 7. --paths → IncludePatterns on all collectors.
-	if len(flags.Paths) > 0 {
-		for _, name := range collector.List() {
-			co := cfg.CollectorOpts[name]
-			co.IncludePatterns = append(co.IncludePatterns, flags.Paths...)
-			cfg.CollectorOpts[name] = co
-		}
+	// 7. --paths / --max-depth → scan-wide scope. The pipeline passes it to
+	// every collector and drops signals outside it.
+	if len(flags.Paths) > 0 || flags.MaxDepth > 0 {
+		cfg.Scope = signal.NewScope(flags.Paths, flags.MaxDepth)
 	}
 }
 
@@ -128,3 +166,62 @@ func ensureOpts(cfg *signal.ScanConfig) {
 		cfg.CollectorOpts = make(map[string]signal.CollectorOpts)
 	}
 }
+
+// untrustConfig drops the settings of a config the user did not write that
+// reach beyond the scan: the files rules route signals to, and the llm
+// section, which picks the host that receives the API key and the code.
+// source names the config in the warning.
+func untrustConfig(cfg *config.Config, source string) {
+	var dropped []string
+	for i := range cfg.Rules {
+		if cfg.Rules[i].Output != "" {
+			cfg.Rules[i].Output = ""
+			dropped = append(dropped, "rules[].output")
+		}
+	}
+	if cfg.LLM != nil {
+		cfg.LLM = nil
+		dropped = append(dropped, "llm")
+	}
+	if len(dropped) > 0 {
+		slog.Warn("untrusted config settings ignored", "config", source, "settings", strings.Join(slices.Compact(dropped), ", "))
+	}
+}
+
+// registerPlugins registers the plugin collectors cfg declares, replacing
+// any registered for an earlier scan. Relative plugin commands and modules
+// resolve against dir, the directory the config was loaded from. Timeouts
+// are validated by config.Validate.
+//
+// A repository's config cannot opt itself in: unless --allow-plugins is
+// set, declared plugins are skipped and any registered earlier are removed.
+func registerPlugins(cfg *config.Config, dir string) error {
+	if !allowPlugins {
+		if len(cfg.Plugins) > 0 {
+			slog.Warn("plugin collectors skipped without --allow-plugins", "count", len(cfg.Plugins))
+		}
+		cfg = &config.Config{}
+	}
+	specs := make([]collectors.PluginSpec, 0, len(cfg.Plugins))
+	for _, p := range cfg.Plugins {
+		timeout, _ := time.ParseDuration(p.Timeout)
+		specs = append(specs, collectors.PluginSpec{
+			Name:    p.Name,
+			Command: p.Command,
+			Args:    p.Args,
+			Timeout: timeout,
+			Dir:     dir,
+
+			WASM:     p.WASM,
+			ReadRepo: p.FS == "read",
+			MemoryMB: p.MemoryMB,
+		})
+	}
+	if err := collectors.RegisterPlugins(specs); err != nil {
+		return exitError(ExitInvalidArgs, "stringer: %v", err)
+	}
+	if len(specs) > 0 {
+		slog.Info("plugin collectors registered", "count", len(specs))
+	}
+	return nil
+}
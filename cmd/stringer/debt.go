@@ -0,0 +1,210 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/davetashner/stringer/internal/output"
+	"github.com/davetashner/stringer/internal/pipeline"
+	"github.com/davetashner/stringer/internal/signal"
+	"github.com/davetashner/stringer/internal/state"
+)
+
+// Debt command flags.
+var (
+	debtCollectors string
+	debtOutput     string
+	debtTop        int
+	debtNoHistory  bool
+)
+
+// debtCmd summarizes technical debt and how it is trending.
+var debtCmd = &cobra.Command{
+	Use:   "debt [path]",
+	Short: "Score technical debt, record its trend, and print a Markdown summary",
+	Long: `Track technical debt in one step: scan the repository, score the
+signals, append the score to the scan history in .stringer/, and write a
+Markdown summary with the trend since the previous scan and the modules
+carrying the most debt.
+
+The debt score weighs each signal by priority: 4 for P1 down to 1 for P4.
+Run it on a schedule, or use --no-history for a one-off look.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runDebt,
+}
+
+func init() {
+	debtCmd.Flags().StringVarP(&debtCollectors, "collectors", "c", "", "comma-separated list of collectors to run")
+	debtCmd.Flags().StringVarP(&debtOutput, "output", "o", "", "output file path (default: stdout)")
+	debtCmd.Flags().IntVar(&debtTop, "top", 10, "number of modules to list")
+	debtCmd.Flags().BoolVar(&debtNoHistory, "no-history", false, "do not record this scan in the scan history")
+
+	rootCmd.AddCommand(debtCmd)
+}
+
+// resetDebtFlags resets debt command flags for testing.
+func resetDebtFlags() {
+	debtCollectors = ""
+	debtOutput = ""
+	debtTop = 10
+	debtNoHistory = false
+
+	debtCmd.Flags().VisitAll(func(f *pflag.Flag) {
+		_ = f.Value.Set(f.DefValue)
+		f.Changed = false
+	})
+}
+
+// debtModule is one module's share of the debt score.
+type debtModule struct {
+	Name    string
+	Signals int
+	Score   int
+}
+
+// debtSummary is what the debt command reports.
+type debtSummary struct {
+	Score      int
+	Signals    int
+	Collectors int
+	Priorities [4]int           // signals per priority, P1 first
+	Trend      *state.TrendLine // nil without an earlier scored scan
+	Modules    []debtModule
+}
+
+func runDebt(cmd *cobra.Command, args []string) error {
+	if debtTop < 0 {
+		return exitError(ExitInvalidArgs, "stringer: --top must be non-negative (got %d)", debtTop)
+	}
+
+	repoPath := "."
+	if len(args) > 0 {
+		repoPath = args[0]
+	}
+	absPath, gitRoot, err := resolveScanPath(repoPath)
+	if err != nil {
+		return err
+	}
+
+	// 1. Scan.
+	result, err := runConfiguredScan(cmd.Context(), gitRoot, signal.ScanConfig{RepoPath: absPath, Collectors: splitCollectors(debtCollectors)})
+	if err != nil {
+		return err
+	}
+	pipeline.BoostColocatedSignals(result.Signals)
+
+	// 2. Score.
+	sum := summarizeDebt(result, debtTop)
+
+	// 3. Update the trend.
+	if !debtNoHistory {
+		if err := saveHistoryForWorkspace(absPath, "", result); err != nil {
+			slog.Warn("failed to save scan history", "error", err)
+		} else if h, err := state.LoadHistory(absPath); err != nil {
+			slog.Warn("failed to load scan history", "error", err)
+		} else {
+			sum.Trend = state.DebtTrend(h)
+		}
+	}
+
+	// 4. Summarize.
+	w := cmd.OutOrStdout()
+	if debtOutput != "" {
+		f, createErr := cmdFS.Create(debtOutput)
+		if createErr != nil {
+			return exitError(ExitInvalidArgs, "stringer: cannot create output file %q (%v)", debtOutput, createErr)
+		}
+		defer f.Close() //nolint:errcheck // best-effort close on output file
+		w = f
+	}
+	if err := writeDebtSummary(w, sum); err != nil {
+		return exitError(ExitTotalFailure, "stringer: %v", err)
+	}
+	return nil
+}
+
+// summarizeDebt scores the scan and ranks its modules by debt, keeping the
+// top n.
+func summarizeDebt(result *signal.ScanResult, n int) *debtSummary {
+	sum := &debtSummary{
+		Score:      state.DebtScore(result.Signals),
+		Signals:    len(result.Signals),
+		Collectors: len(result.Results),
+	}
+	modules := make(map[string]*debtModule)
+	for _, s := range result.Signals {
+		score := state.DebtScore([]signal.RawSignal{s})
+		sum.Priorities[4-score]++ // a P1 scores 4, a P4 scores 1
+
+		// Modules are named as in the other formats; signals without a
+		// file count toward the root.
+		name := output.SignalModule(s.FilePath)
+		if name == "" {
+			name = "(root)"
+		}
+		m, ok := modules[name]
+		if !ok {
+			m = &debtModule{Name: name}
+			modules[name] = m
+		}
+		m.Signals++
+		m.Score += score
+	}
+	for _, m := range modules {
+		sum.Modules = append(sum.Modules, *m)
+	}
+	sort.Slice(sum.Modules, func(i, j int) bool {
+		a, b := sum.Modules[i], sum.Modules[j]
+		if a.Score != b.Score {
+			return a.Score > b.Score
+		}
+		return a.Name < b.Name
+	})
+	if len(sum.Modules) > n {
+		sum.Modules = sum.Modules[:n]
+	}
+	return sum
+}
+
+// writeDebtSummary writes the debt summary as Markdown.
+func writeDebtSummary(w io.Writer, sum *debtSummary) error {
+	var b strings.Builder
+	b.WriteString("# Technical Debt\n\n")
+	fmt.Fprintf(&b, "**Debt score: %d**", sum.Score)
+	if sum.Trend != nil {
+		fmt.Fprintf(&b, " (%+d since the previous scan, %s)", sum.Trend.Delta, sum.Trend.Direction)
+	}
+	fmt.Fprintf(&b, " from %d signals across %d collectors.\n\n", sum.Signals, sum.Collectors)
+
+	b.WriteString("| Priority | Signals | Weight | Score |\n")
+	b.WriteString("|----------|---------|--------|-------|\n")
+	for i, n := range sum.Priorities {
+		weight := 4 - i
+		fmt.Fprintf(&b, "| P%d | %d | %d | %d |\n", i+1, n, weight, n*weight)
+	}
+	b.WriteString("\n")
+
+	if len(sum.Modules) > 0 {
+		b.WriteString("## Modules With the Most Debt\n\n")
+		b.WriteString("| Module | Signals | Score |\n")
+		b.WriteString("|--------|---------|-------|\n")
+		for _, m := range sum.Modules {
+			fmt.Fprintf(&b, "| `%s` | %d | %d |\n", m.Name, m.Signals, m.Score)
+		}
+		b.WriteString("\n")
+	}
+
+	if _, err := io.WriteString(w, b.String()); err != nil {
+		return fmt.Errorf("write debt summary: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davetashner/stringer/internal/signal"
+	"github.com/davetashner/stringer/internal/state"
+)
+
+func TestDebtCmd_RecordsTrend(t *testing.T) {
+	resetDebtFlags()
+	root := initTestRepo(t)
+
+	cmd, stdout, _ := newTestCmd()
+	cmd.SetArgs([]string{"debt", root, "-c", "todos"})
+	require.NoError(t, cmd.Execute())
+	first := stdout.String()
+	assert.Contains(t, first, "# Technical Debt")
+	assert.Contains(t, first, "**Debt score: ")
+	assert.NotContains(t, first, "since the previous scan", "no trend on the first run")
+	assert.Contains(t, first, "| Module | Signals | Score |")
+
+	writeTestFile(t, root, "internal/core/more.go", "package core\n\n// TODO: one more thing\n// FIXME: and another\n")
+	resetDebtFlags()
+	cmd, stdout, _ = newTestCmd()
+	cmd.SetArgs([]string{"debt", root, "-c", "todos"})
+	require.NoError(t, cmd.Execute())
+	assert.Contains(t, stdout.String(), "since the previous scan, degrading")
+
+	h, err := state.LoadHistory(root)
+	require.NoError(t, err)
+	require.Len(t, h.Entries, 2)
+	assert.Greater(t, h.Entries[1].DebtScore, h.Entries[0].DebtScore)
+}
+
+func TestDebtCmd_NoHistory(t *testing.T) {
+	resetDebtFlags()
+	root := initTestRepo(t)
+
+	cmd, _, _ := newTestCmd()
+	cmd.SetArgs([]string{"debt", root, "-c", "todos", "--no-history"})
+	require.NoError(t, cmd.Execute())
+
+	h, err := state.LoadHistory(root)
+	require.NoError(t, err)
+	assert.Nil(t, h)
+}
+
+func TestDebtCmd_InvalidArgs(t *testing.T) {
+	resetDebtFlags()
+	cmd, _, _ := newTestCmd()
+	cmd.SetArgs([]string{"debt", t.TempDir(), "--top", "-1"})
+	requireExitCode(t, cmd.Execute(), ExitInvalidArgs)
+}
+
+func TestSummarizeDebt(t *testing.T) {
+	result := &signal.ScanResult{
+		Signals: []signal.RawSignal{
+			{FilePath: "internal/db/pool/pool.go", Confidence: 0.9},
+			{FilePath: "internal/db/db.go", Confidence: 0.5},
+			{FilePath: "main.go", Confidence: 0.1},
+			{Confidence: 0.65},
+		},
+		Results: []signal.CollectorResult{{Collector: "todos"}, {Collector: "gitlog"}},
+	}
+
+	sum := summarizeDebt(result, 1)
+	assert.Equal(t, 10, sum.Score)
+	assert.Equal(t, [4]int{1, 1, 1, 1}, sum.Priorities)
+	assert.Equal(t, 2, sum.Collectors)
+	assert.Equal(t, []debtModule{{Name: "internal/db", Signals: 2, Score: 6}}, sum.Modules)
+
+	sum = summarizeDebt(&signal.ScanResult{Signals: []signal.RawSignal{
+		{Confidence: 0.9},
+		{FilePath: "main.go", Confidence: 0.9},
+	}}, 5)
+	assert.Equal(t, []debtModule{{Name: "(root)", Signals: 2, Score: 8}}, sum.Modules, "signals without a file count toward the root")
+}
@@ -0,0 +1,115 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"bytes"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/davetashner/stringer/internal/scandiff"
+)
+
+// Diff command flags.
+var (
+	diffFormat        string
+	diffOutput        string
+	diffMinConfidence float64
+	diffFailOnNew     bool
+)
+
+// diffCmd compares two saved scan outputs.
+var diffCmd = &cobra.Command{
+	Use:   "diff <old> <new>",
+	Short: "Compare two scan outputs",
+	Long: `Compare two files written by 'stringer scan' and list the signals the
+newer scan added, the signals it no longer has, and how many persisted.
+
+Both files may be beads JSONL (the scan default) or --format json output.
+Signals match by their stringer ID, then by title, so a TODO that moved to
+another line persists. Beads output records priority rather than
+confidence; its signals count as the lowest confidence of their priority
+(P1 = 0.8, P2 = 0.6, P3 = 0.4, P4 = 0).
+
+With --fail-on-new, the command exits 4 when any signal at or above
+--min-confidence was added, for CI gates such as:
+
+  stringer diff main.jsonl pr.jsonl --min-confidence 0.8 --fail-on-new`,
+	Args: cobra.ExactArgs(2),
+	RunE: runDiff,
+}
+
+func init() {
+	diffCmd.Flags().StringVarP(&diffFormat, "format", "f", "markdown", "output format (markdown, json)")
+	diffCmd.Flags().StringVarP(&diffOutput, "output", "o", "", "output file path (default: stdout)")
+	diffCmd.Flags().Float64Var(&diffMinConfidence, "min-confidence", 0, "ignore signals below this confidence threshold (0.0-1.0)")
+	diffCmd.Flags().BoolVar(&diffFailOnNew, "fail-on-new", false, "exit 4 if the new scan added any signal")
+
+	rootCmd.AddCommand(diffCmd)
+}
+
+// resetDiffFlags resets diff command flags for testing.
+func resetDiffFlags() {
+	diffCmd.Flags().VisitAll(func(f *pflag.Flag) {
+		_ = f.Value.Set(f.DefValue)
+		f.Changed = false
+	})
+}
+
+func runDiff(cmd *cobra.Command, args []string) error {
+	if diffFormat != "markdown" && diffFormat != "json" {
+		return exitError(ExitInvalidArgs, "stringer: unsupported format %q (supported: markdown, json)", diffFormat)
+	}
+	if diffMinConfidence < 0 || diffMinConfidence > 1 {
+		return exitError(ExitInvalidArgs, "stringer: --min-confidence must be between 0.0 and 1.0 (got %.2f)", diffMinConfidence)
+	}
+
+	old, err := readScanOutput(args[0])
+	if err != nil {
+		return err
+	}
+	cur, err := readScanOutput(args[1])
+	if err != nil {
+		return err
+	}
+
+	res := scandiff.Compute(old, cur)
+	res.Filter(diffMinConfidence)
+
+	w := cmd.OutOrStdout()
+	if diffOutput != "" {
+		f, createErr := cmdFS.Create(diffOutput)
+		if createErr != nil {
+			return exitError(ExitInvalidArgs, "stringer: cannot create output file %q (%v)", diffOutput, createErr)
+		}
+		defer f.Close() //nolint:errcheck // best-effort close on output file
+		w = f
+	}
+	render := scandiff.Render
+	if diffFormat == "json" {
+		render = scandiff.RenderJSON
+	}
+	if err := render(res, w); err != nil {
+		return exitError(ExitTotalFailure, "stringer: rendering failed (%v)", err)
+	}
+
+	if diffFailOnNew && len(res.Added) > 0 {
+		return exitError(ExitExpectation, "stringer: %d new signal(s) since %s", len(res.Added), args[0])
+	}
+	return nil
+}
+
+// readScanOutput reads the signals of a beads or json scan output file.
+func readScanOutput(path string) ([]scandiff.Entry, error) {
+	data, err := cmdFS.ReadFile(path)
+	if err != nil {
+		return nil, exitError(ExitInvalidArgs, "stringer: cannot read %q (%v)", path, err)
+	}
+	entries, err := scandiff.Read(bytes.NewReader(data))
+	if err != nil {
+		return nil, exitError(ExitInvalidArgs, "stringer: %s: %v", path, err)
+	}
+	return entries, nil
+}
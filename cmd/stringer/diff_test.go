@@ -0,0 +1,169 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davetashner/stringer/internal/output"
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+// writeScanFile writes signals in format to a file in dir and returns its path.
+func writeScanFile(t *testing.T, dir, name, format string, signals []signal.RawSignal) string {
+	t.Helper()
+	f, err := output.GetFormatter(format)
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	require.NoError(t, f.Format(signals, &buf))
+	path := filepath.Join(dir, name)
+	require.NoError(t, os.WriteFile(path, buf.Bytes(), 0o600))
+	return path
+}
+
+// diffFixture writes an old beads scan and a new json scan that keeps one
+// signal, drops one, and adds a low- and a high-confidence one.
+func diffFixture(t *testing.T) (oldPath, newPath string) {
+	t.Helper()
+	dir := t.TempDir()
+	kept := signal.RawSignal{Source: "todos", Kind: "todo", FilePath: "main.go", Line: 3, Title: "TODO: keep me", Confidence: 0.5}
+	gone := signal.RawSignal{Source: "todos", Kind: "fixme", FilePath: "main.go", Line: 9, Title: "FIXME: fixed now", Confidence: 0.6}
+	low := signal.RawSignal{Source: "todos", Kind: "todo", FilePath: "util.go", Line: 1, Title: "TODO: minor", Confidence: 0.3}
+	high := signal.RawSignal{Source: "patterns", Kind: "large-file", FilePath: "big.go", Title: "Large file: big.go", Confidence: 0.85}
+	oldPath = writeScanFile(t, dir, "old.jsonl", "beads", []signal.RawSignal{kept, gone})
+	newPath = writeScanFile(t, dir, "new.json", "json", []signal.RawSignal{kept, low, high})
+	return oldPath, newPath
+}
+
+func TestDiffCmd_Markdown(t *testing.T) {
+	resetDiffFlags()
+	oldPath, newPath := diffFixture(t)
+
+	cmd, stdout, _ := newTestCmd()
+	cmd.SetArgs([]string{"diff", oldPath, newPath})
+	require.NoError(t, cmd.Execute())
+
+	out := stdout.String()
+	assert.Contains(t, out, "**2 added** · **1 removed** · 1 persisted")
+	assert.Contains(t, out, "Large file: big.go")
+	assert.Contains(t, out, "FIXME: fixed now")
+}
+
+func TestDiffCmd_JSON(t *testing.T) {
+	resetDiffFlags()
+	oldPath, newPath := diffFixture(t)
+	out := filepath.Join(t.TempDir(), "diff.json")
+
+	cmd, _, _ := newTestCmd()
+	cmd.SetArgs([]string{"diff", oldPath, newPath, "-f", "json", "--min-confidence", "0.8", "-o", out})
+	require.NoError(t, cmd.Execute())
+
+	data, err := os.ReadFile(out) //nolint:gosec // test-controlled path
+	require.NoError(t, err)
+	var got struct {
+		Summary map[string]int `json:"summary"`
+		Added   []struct {
+			Title string `json:"title"`
+		} `json:"added"`
+	}
+	require.NoError(t, json.Unmarshal(data, &got))
+	assert.Equal(t, map[string]int{"added": 1, "removed": 0, "persisted": 0}, got.Summary)
+	require.Len(t, got.Added, 1)
+	assert.Equal(t, "Large file: big.go", got.Added[0].Title)
+}
+
+func TestDiffCmd_FailOnNew(t *testing.T) {
+	oldPath, newPath := diffFixture(t)
+
+	resetDiffFlags()
+	cmd, stdout, _ := newTestCmd()
+	cmd.SetArgs([]string{"diff", oldPath, newPath, "--fail-on-new", "--min-confidence", "0.8"})
+	err := cmd.Execute()
+	requireExitCode(t, err, ExitExpectation)
+	assert.Contains(t, err.Error(), "1 new signal(s)")
+	assert.Contains(t, stdout.String(), "Large file: big.go", "the diff is written before failing")
+
+	resetDiffFlags()
+	cmd, _, _ = newTestCmd()
+	cmd.SetArgs([]string{"diff", oldPath, newPath, "--fail-on-new", "--min-confidence", "0.9"})
+	assert.NoError(t, cmd.Execute())
+
+	resetDiffFlags()
+	cmd, _, _ = newTestCmd()
+	cmd.SetArgs([]string{"diff", oldPath, oldPath, "--fail-on-new"})
+	assert.NoError(t, cmd.Execute())
+}
+
+func TestDiffCmd_InvalidArgs(t *testing.T) {
+	oldPath, newPath := diffFixture(t)
+	notScan := filepath.Join(t.TempDir(), "notes.md")
+	require.NoError(t, os.WriteFile(notScan, []byte("# Notes\n"), 0o600))
+
+	tests := []struct {
+		name string
+		args []string
+		want string
+	}{
+		{"format", []string{oldPath, newPath, "-f", "sarif"}, "unsupported format"},
+		{"confidence", []string{oldPath, newPath, "--min-confidence", "2"}, "--min-confidence must be between"},
+		{"missing", []string{oldPath, filepath.Join(t.TempDir(), "nope.jsonl")}, "cannot read"},
+		{"not a scan", []string{notScan, newPath}, "notes.md"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resetDiffFlags()
+			cmd, _, _ := newTestCmd()
+			cmd.SetArgs(append([]string{"diff"}, tt.args...))
+			err := cmd.Execute()
+			requireExitCode(t, err, ExitInvalidArgs)
+			assert.Contains(t, err.Error(), tt.want)
+		})
+	}
+}
+
+func TestScanCmd_Baseline(t *testing.T) {
+	dir := initTestRepo(t)
+	base := filepath.Join(t.TempDir(), "base.jsonl")
+
+	resetScanFlags()
+	cmd, _, _ := newTestCmd()
+	cmd.SetArgs([]string{"scan", dir, "-c", "todos", "--no-cache", "-o", base})
+	require.NoError(t, cmd.Execute())
+
+	writeTestFile(t, dir, "extra.go", "package main\n\n// TODO: Brand new work\n")
+
+	resetScanFlags()
+	cmd, stdout, stderr := newTestCmd()
+	cmd.SetArgs([]string{"scan", dir, "-c", "todos", "--no-cache", "--baseline", base})
+	require.NoError(t, cmd.Execute())
+
+	lines := strings.Split(strings.TrimSpace(stdout.String()), "\n")
+	require.Len(t, lines, 1, "only signals missing from the baseline are written")
+	assert.Contains(t, lines[0], "Brand new work")
+	assert.Contains(t, stderr.String(), "Baseline comparison: +1 added, -0 removed,")
+}
+
+func TestScanCmd_BaselineInvalid(t *testing.T) {
+	resetScanFlags()
+	cmd, _, _ := newTestCmd()
+	cmd.SetArgs([]string{"scan", fixtureDir(t), "--baseline", "base.jsonl", "--delta"})
+	err := cmd.Execute()
+	requireExitCode(t, err, ExitInvalidArgs)
+	assert.Contains(t, err.Error(), "cannot be combined")
+
+	resetScanFlags()
+	cmd, _, _ = newTestCmd()
+	cmd.SetArgs([]string{"scan", fixtureDir(t), "--baseline", filepath.Join(t.TempDir(), "missing.jsonl")})
+	err = cmd.Execute()
+	requireExitCode(t, err, ExitInvalidArgs)
+	assert.Contains(t, err.Error(), "cannot read")
+}
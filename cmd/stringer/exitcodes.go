@@ -9,4 +9,5 @@ const (
 	ExitInvalidArgs    = 1 // Invalid arguments or bad path.
 	ExitPartialFailure = 2 // Some collectors failed, partial output written.
 	ExitTotalFailure   = 3 // No output produced.
+	ExitExpectation    = 4 // A scan --expect-zero or --fail-on condition matched, diff --fail-on-new found a new signal, or bench compare --fail found a regression.
 )
@@ -0,0 +1,177 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/davetashner/stringer/internal/config"
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+// exitPolicy maps each condition a scan can end in to the exit code it
+// returns. The exit_codes block of .stringer.yaml overrides the defaults so
+// each CI system can get the semantics it expects.
+type exitPolicy struct {
+	PartialFailure int
+	TotalFailure   int
+	Expectation    int
+	BudgetExceeded int
+	Secrets        int
+
+	// sources records where each code came from, for --print-exit-policy.
+	sources map[string]string
+}
+
+// exitConditions are the conditions a finished scan met.
+type exitConditions struct {
+	PartialFailure bool
+	TotalFailure   bool
+	Expectation    bool
+	BudgetExceeded bool
+	Secrets        bool
+}
+
+// defaultExitPolicy is the documented default matrix. Partial collector
+// failure succeeds unless strict; budget overruns and secrets are reported
+// but do not change the exit code.
+func defaultExitPolicy(strict bool) exitPolicy {
+	p := exitPolicy{
+		PartialFailure: ExitOK,
+		TotalFailure:   ExitTotalFailure,
+		Expectation:    ExitExpectation,
+		BudgetExceeded: ExitOK,
+		Secrets:        ExitOK,
+		sources:        make(map[string]string),
+	}
+	if strict {
+		p.PartialFailure = ExitPartialFailure
+		p.sources["partial_failure"] = "--strict"
+	}
+	return p
+}
+
+// resolveExitPolicy applies the config's exit_codes over the defaults.
+// --strict still fails partial failures that the config maps to 0.
+func resolveExitPolicy(cfg *config.Config, strict bool) exitPolicy {
+	p := defaultExitPolicy(strict)
+	if cfg == nil || cfg.ExitCodes == nil {
+		return p
+	}
+	for _, f := range p.fields(cfg.ExitCodes) {
+		if f.override == nil || (strict && f.key == "partial_failure" && *f.override == ExitOK) {
+			continue
+		}
+		*f.code = *f.override
+		p.sources[f.key] = config.FileName
+	}
+	return p
+}
+
+// exitPolicyField ties a condition's key to its code and config override.
+type exitPolicyField struct {
+	key      string
+	code     *int
+	override *int
+}
+
+// fields lists the conditions in precedence order: when a scan meets
+// several, the first with a non-zero code decides the exit code.
+func (p *exitPolicy) fields(ec *config.ExitCodesConfig) []exitPolicyField {
+	if ec == nil {
+		ec = &config.ExitCodesConfig{}
+	}
+	return []exitPolicyField{
+		{key: "total_failure", code: &p.TotalFailure, override: ec.TotalFailure},
+		{key: "expectation", code: &p.Expectation, override: ec.Expectation},
+		{key: "secrets", code: &p.Secrets, override: ec.Secrets},
+		{key: "budget_exceeded", code: &p.BudgetExceeded, override: ec.BudgetExceeded},
+		{key: "partial_failure", code: &p.PartialFailure, override: ec.PartialFailure},
+	}
+}
+
+// code returns the exit code for the conditions c.
+func (p exitPolicy) code(c exitConditions) int {
+	met := map[string]bool{
+		"total_failure":   c.TotalFailure,
+		"expectation":     c.Expectation,
+		"secrets":         c.Secrets,
+		"budget_exceeded": c.BudgetExceeded,
+		"partial_failure": c.PartialFailure,
+	}
+	for _, f := range p.fields(nil) {
+		if met[f.key] && *f.code != ExitOK {
+			return *f.code
+		}
+	}
+	return ExitOK
+}
+
+// printExitPolicy writes the active mapping, in precedence order, to w.
+func printExitPolicy(w io.Writer, p exitPolicy) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(tw, "CONDITION\tEXIT CODE\tSOURCE")
+	for _, f := range p.fields(nil) {
+		source := p.sources[f.key]
+		if source == "" {
+			source = "default"
+		}
+		_, _ = fmt.Fprintf(tw, "%s\t%d\t%s\n", f.key, *f.code, source)
+	}
+	return tw.Flush()
+}
+
+// exitConditions returns the conditions the finished scan met.
+func (sc *scanContext) exitConditions() exitConditions {
+	partial, total := collectorConditions(sc.result)
+	budget := sc.effectiveBudget()
+	return exitConditions{
+		PartialFailure: partial,
+		TotalFailure:   total,
+		Expectation:    len(sc.violations) > 0 || len(sc.failOnHits) > 0,
+		BudgetExceeded: budget > 0 && newSignalCount(sc.result.Signals) > budget,
+		Secrets:        hasSecrets(sc.result.Signals),
+	}
+}
+
+// collectorConditions reports whether some or all of the collectors that
+// ran failed. Collectors skipped by the capability check did not run, so
+// they are neither failures nor part of the total.
+func collectorConditions(result *signal.ScanResult) (partial, total bool) {
+	ran, failCount := 0, 0
+	for _, cr := range result.Results {
+		if cr.SkipReason != "" {
+			continue
+		}
+		ran++
+		if cr.Err != nil {
+			failCount++
+		}
+	}
+	return failCount > 0 && failCount < ran, failCount > 0 && failCount == ran
+}
+
+// hasSecrets reports whether any signal is a committed secret.
+func hasSecrets(signals []signal.RawSignal) bool {
+	for _, s := range signals {
+		if s.Kind == "committed-secret" {
+			return true
+		}
+	}
+	return false
+}
+
+// newSignalCount counts signals that are new work, leaving out pre-closed
+// ones, the same way the pr-comment budget line does.
+func newSignalCount(signals []signal.RawSignal) int {
+	n := 0
+	for _, s := range signals {
+		if s.ClosedAt.IsZero() {
+			n++
+		}
+	}
+	return n
+}
@@ -0,0 +1,194 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/davetashner/stringer/internal/baseline"
+	"github.com/davetashner/stringer/internal/collector"
+	"github.com/davetashner/stringer/internal/output"
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+// Fields an --expect-zero condition can match on.
+const (
+	expectKind      = "kind"
+	expectCollector = "collector"
+	expectTag       = "tag"
+)
+
+// maxReportedViolations caps the signals listed when an expectation fails.
+const maxReportedViolations = 10
+
+// expectation is one --expect-zero condition: no signal may have Field set
+// to any of Values.
+type expectation struct {
+	Field  string
+	Values []string
+}
+
+// parseExpectations parses --expect-zero values of the form
+// field=value[,value...].
+func parseExpectations(specs []string) ([]expectation, error) {
+	exps := make([]expectation, 0, len(specs))
+	for _, spec := range specs {
+		field, values, ok := strings.Cut(spec, "=")
+		field = strings.ToLower(strings.TrimSpace(field))
+		if !ok || (field != expectKind && field != expectCollector && field != expectTag) {
+			return nil, fmt.Errorf("invalid --expect-zero %q (want kind=, collector=, or tag=)", spec)
+		}
+		e := expectation{Field: field}
+		for _, v := range strings.Split(values, ",") {
+			if v = strings.TrimSpace(v); v != "" {
+				e.Values = append(e.Values, v)
+			}
+		}
+		if len(e.Values) == 0 {
+			return nil, fmt.Errorf("invalid --expect-zero %q: no values", spec)
+		}
+		if field == expectCollector {
+			for _, name := range e.Values {
+				if collector.Get(name) == nil {
+					return nil, fmt.Errorf("invalid --expect-zero %q: unknown collector %q", spec, name)
+				}
+			}
+		}
+		exps = append(exps, e)
+	}
+	return exps, nil
+}
+
+// matches reports whether sig violates e.
+func (e expectation) matches(sig signal.RawSignal) bool {
+	for _, v := range e.Values {
+		switch e.Field {
+		case expectKind:
+			if strings.EqualFold(sig.Kind, v) {
+				return true
+			}
+		case expectCollector:
+			if sig.Source == v {
+				return true
+			}
+		case expectTag:
+			for _, t := range sig.Tags {
+				if t == v {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// expectationChecker decides which signals violate the --expect-zero
+// conditions. Signals suppressed in the baseline or below --min-confidence
+// never count.
+type expectationChecker struct {
+	exps          []expectation
+	suppressed    map[string]baseline.Suppression
+	minConfidence float64
+}
+
+// violates reports whether sig breaks any expectation.
+func (c *expectationChecker) violates(sig signal.RawSignal) bool {
+	if sig.Confidence < c.minConfidence {
+		return false
+	}
+	matched := false
+	for _, e := range c.exps {
+		if e.matches(sig) {
+			matched = true
+			break
+		}
+	}
+	if !matched {
+		return false
+	}
+	if sup, ok := c.suppressed[output.SignalID(sig, "str-")]; ok && !baseline.IsExpired(sup) {
+		return false
+	}
+	return true
+}
+
+// collectorsForExpectations returns the collectors able to produce a
+// violation, so --fail-fast can skip the rest. It returns nil, meaning run
+// everything, when a condition matches on tags or names a kind no known
+// collector emits.
+func collectorsForExpectations(exps []expectation) []string {
+	seen := make(map[string]bool)
+	for _, e := range exps {
+		switch e.Field {
+		case expectCollector:
+			for _, name := range e.Values {
+				seen[name] = true
+			}
+		case expectKind:
+			for _, kind := range e.Values {
+				producers := collectorsEmitting(kind)
+				if len(producers) == 0 {
+					return nil
+				}
+				for _, name := range producers {
+					seen[name] = true
+				}
+			}
+		default:
+			return nil
+		}
+	}
+	names := make([]string, 0, len(seen))
+	for name := range seen {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// collectorsEmitting returns the registered collectors documented to emit
+// kind.
+func collectorsEmitting(kind string) []string {
+	var names []string
+	for name, meta := range knownCollectors {
+		if collector.Get(name) == nil {
+			continue
+		}
+		for _, k := range meta.SignalKinds {
+			if strings.EqualFold(k, kind) {
+				names = append(names, name)
+				break
+			}
+		}
+	}
+	return names
+}
+
+// reportViolations writes the signals that broke an expectation.
+func reportViolations(w io.Writer, violations []signal.RawSignal, specs []string, stoppedEarly bool) {
+	_, _ = fmt.Fprintf(w, "stringer: %d signal(s) match --expect-zero %s", len(violations), strings.Join(specs, " "))
+	if stoppedEarly {
+		_, _ = fmt.Fprint(w, " (scan stopped at the first match)")
+	}
+	_, _ = fmt.Fprintln(w)
+	writeSignalList(w, violations)
+}
+
+// writeSignalList writes up to maxReportedViolations signals, one per line.
+func writeSignalList(w io.Writer, signals []signal.RawSignal) {
+	for i, sig := range signals {
+		if i == maxReportedViolations {
+			_, _ = fmt.Fprintf(w, "  ... and %d more\n", len(signals)-i)
+			break
+		}
+		loc := sig.FilePath
+		if sig.Line > 0 {
+			loc = fmt.Sprintf("%s:%d", sig.FilePath, sig.Line)
+		}
+		_, _ = fmt.Fprintf(w, "  %s  %s  %s\n", sig.Kind, loc, sig.Title)
+	}
+}
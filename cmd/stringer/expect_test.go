@@ -0,0 +1,146 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davetashner/stringer/internal/baseline"
+	"github.com/davetashner/stringer/internal/output"
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+func TestParseExpectations(t *testing.T) {
+	exps, err := parseExpectations([]string{"kind=committed-secret, todo", "Collector=todos", "tag=security"})
+	require.NoError(t, err)
+	assert.Equal(t, []expectation{
+		{Field: expectKind, Values: []string{"committed-secret", "todo"}},
+		{Field: expectCollector, Values: []string{"todos"}},
+		{Field: expectTag, Values: []string{"security"}},
+	}, exps)
+
+	for spec, want := range map[string]string{
+		"committed-secret":  "want kind=, collector=, or tag=",
+		"file=main.go":      "want kind=, collector=, or tag=",
+		"kind= , ":          "no values",
+		"collector=nothere": `unknown collector "nothere"`,
+	} {
+		_, err := parseExpectations([]string{spec})
+		assert.ErrorContains(t, err, want, spec)
+	}
+}
+
+func TestExpectationChecker_Violates(t *testing.T) {
+	secret := signal.RawSignal{Source: "githygiene", Kind: "committed-secret", FilePath: "a.env", Line: 1, Title: "AWS key", Confidence: 0.9}
+	todo := signal.RawSignal{Source: "todos", Kind: "todo", FilePath: "a.go", Line: 2, Title: "TODO: x", Confidence: 0.9, Tags: []string{"security"}}
+	weak := secret
+	weak.Confidence = 0.2
+	past := time.Now().Add(-time.Hour)
+
+	c := &expectationChecker{
+		exps:          []expectation{{Field: expectKind, Values: []string{"Committed-Secret"}}},
+		minConfidence: 0.5,
+	}
+	assert.True(t, c.violates(secret), "kind matches case-insensitively")
+	assert.False(t, c.violates(todo))
+	assert.False(t, c.violates(weak), "below --min-confidence")
+
+	c.suppressed = map[string]baseline.Suppression{output.SignalID(secret, "str-"): {}}
+	assert.False(t, c.violates(secret), "suppressed in baseline")
+	c.suppressed[output.SignalID(secret, "str-")] = baseline.Suppression{ExpiresAt: &past}
+	assert.True(t, c.violates(secret), "expired suppression no longer hides it")
+
+	c = &expectationChecker{exps: []expectation{{Field: expectTag, Values: []string{"security"}}}}
+	assert.True(t, c.violates(todo))
+	c = &expectationChecker{exps: []expectation{{Field: expectCollector, Values: []string{"githygiene"}}}}
+	assert.True(t, c.violates(secret))
+	assert.False(t, c.violates(todo))
+}
+
+func TestCollectorsForExpectations(t *testing.T) {
+	tests := []struct {
+		name string
+		exps []expectation
+		want []string
+	}{
+		{"kind", []expectation{{Field: expectKind, Values: []string{"committed-secret"}}}, []string{"githygiene"}},
+		{"kind and collector", []expectation{
+			{Field: expectKind, Values: []string{"committed-secret"}},
+			{Field: expectCollector, Values: []string{"todos"}},
+		}, []string{"githygiene", "todos"}},
+		{"tag runs everything", []expectation{{Field: expectTag, Values: []string{"security"}}}, nil},
+		{"unknown kind runs everything", []expectation{{Field: expectKind, Values: []string{"custom"}}}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, collectorsForExpectations(tt.exps))
+		})
+	}
+}
+
+func TestReportViolations(t *testing.T) {
+	var violations []signal.RawSignal
+	for i := 1; i <= maxReportedViolations+2; i++ {
+		violations = append(violations, signal.RawSignal{Kind: "todo", FilePath: "a.go", Line: i, Title: fmt.Sprintf("TODO %d", i)})
+	}
+	var buf bytes.Buffer
+	reportViolations(&buf, violations, []string{"kind=todo"}, true)
+
+	out := buf.String()
+	assert.Contains(t, out, "stringer: 12 signal(s) match --expect-zero kind=todo (scan stopped at the first match)\n")
+	assert.Contains(t, out, "  todo  a.go:1  TODO 1\n")
+	assert.NotContains(t, out, "TODO 11")
+	assert.Contains(t, out, "  ... and 2 more\n")
+}
+
+func TestRunScan_ExpectZeroMatch(t *testing.T) {
+	resetScanFlags()
+	cmd, stdout, stderr := newTestCmd()
+	cmd.SetArgs([]string{"scan", fixtureDir(t), "--quiet", "--collectors=todos", "--expect-zero=kind=todo"})
+
+	err := cmd.Execute()
+	requireExitCode(t, err, ExitExpectation)
+	assert.NotEmpty(t, stdout.String(), "output is still written without --fail-fast")
+	assert.Contains(t, stderr.String(), "match --expect-zero kind=todo")
+	assert.Contains(t, stderr.String(), "TODO: Add proper CLI argument parsing")
+}
+
+func TestRunScan_ExpectZeroNoMatch(t *testing.T) {
+	resetScanFlags()
+	cmd, _, stderr := newTestCmd()
+	cmd.SetArgs([]string{"scan", fixtureDir(t), "--quiet", "--collectors=todos", "--expect-zero=kind=committed-secret"})
+
+	require.NoError(t, cmd.Execute())
+	assert.NotContains(t, stderr.String(), "--expect-zero")
+}
+
+func TestRunScan_FailFast(t *testing.T) {
+	resetScanFlags()
+	cmd, stdout, stderr := newTestCmd()
+	cmd.SetArgs([]string{"scan", fixtureDir(t), "--quiet", "--fail-fast", "--expect-zero=kind=todo"})
+
+	err := cmd.Execute()
+	requireExitCode(t, err, ExitExpectation)
+	assert.Empty(t, stdout.String(), "no output after a fail-fast stop")
+	assert.Contains(t, stderr.String(), "match --expect-zero kind=todo (scan stopped at the first match)")
+}
+
+func TestRunScan_FailFastInvalidArgs(t *testing.T) {
+	resetScanFlags()
+	cmd, _, _ := newTestCmd()
+	cmd.SetArgs([]string{"scan", fixtureDir(t), "--quiet", "--fail-fast"})
+	err := cmd.Execute()
+	requireExitCode(t, err, ExitInvalidArgs)
+	assert.Contains(t, err.Error(), "--fail-fast requires --expect-zero")
+
+	resetScanFlags()
+	cmd.SetArgs([]string{"scan", fixtureDir(t), "--quiet", "--expect-zero=secret"})
+	requireExitCode(t, cmd.Execute(), ExitInvalidArgs)
+}
@@ -0,0 +1,272 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+
+	"github.com/davetashner/stringer/internal/config"
+	"github.com/davetashner/stringer/internal/feedback"
+	"github.com/davetashner/stringer/internal/output"
+	"github.com/davetashner/stringer/internal/pipeline"
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+// Explain command flags.
+var (
+	explainFrom string
+	explainJSON bool
+)
+
+// explainCmd breaks a signal's confidence down into the steps behind it.
+var explainCmd = &cobra.Command{
+	Use:   "explain <signal-id>",
+	Short: "Show how a signal's confidence was computed",
+	Long: `Show how a signal's confidence was computed: the collector's base score
+and what it was based on, then each adjustment made after collection —
+co-location with risky signals, hot paths from production profiles,
+calibration from recorded feedback, and the configured scoring profile. The collector settings and feedback
+that shaped the signal are listed after the breakdown.
+
+The signal is found by scanning the current directory, or in a
+--format json scan output given with --from. Hot-path boosts only appear
+with --from, since they need the scan's --profile.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runExplain,
+}
+
+func init() {
+	explainCmd.Flags().StringVar(&explainFrom, "from", "", "scan output file (--format json) to find the signal in")
+	explainCmd.Flags().BoolVar(&explainJSON, "json", false, "machine-readable JSON output")
+	rootCmd.AddCommand(explainCmd)
+}
+
+// explanation is the breakdown explain prints for one signal.
+type explanation struct {
+	ID         string                    `json:"id"`
+	Collector  string                    `json:"collector"`
+	Kind       string                    `json:"kind"`
+	Title      string                    `json:"title"`
+	Location   string                    `json:"location,omitempty"`
+	Confidence float64                   `json:"confidence"`
+	Factors    []signal.ConfidenceFactor `json:"factors"`
+	Options    []explainOption           `json:"options"`
+}
+
+// explainOption is a setting or piece of recorded feedback that shaped a
+// signal.
+type explainOption struct {
+	Key    string `json:"key"`
+	Value  string `json:"value"`
+	Effect string `json:"effect"`
+}
+
+func runExplain(cmd *cobra.Command, args []string) error {
+	id := args[0]
+	if !signalIDPattern.MatchString(id) {
+		return exitError(ExitInvalidArgs,
+			"stringer: invalid signal ID %q — must match str-[0-9a-f]{8}", id)
+	}
+
+	absPath, gitRoot, err := resolveScanPath(".")
+	if err != nil {
+		return err
+	}
+	fileCfg, err := config.Load(absPath)
+	if err != nil {
+		return exitError(ExitInvalidArgs, "stringer: failed to load config (%v)", err)
+	}
+	entries, err := feedback.Load(absPath)
+	if err != nil {
+		return exitError(ExitTotalFailure, "stringer: failed to load feedback (%v)", err)
+	}
+
+	signals, where, err := explainSignals(cmd, absPath, gitRoot, fileCfg, entries)
+	if err != nil {
+		return err
+	}
+	var found *signal.RawSignal
+	for i := range signals {
+		if output.SignalID(signals[i], "str-") == id {
+			found = &signals[i]
+			break
+		}
+	}
+	if found == nil {
+		return exitError(ExitInvalidArgs, "stringer: signal %s not found in %s", id, where)
+	}
+
+	e := explainSignal(id, *found, fileCfg, entries)
+	w := cmd.OutOrStdout()
+	if explainJSON {
+		data, err := json.MarshalIndent(e, "", "  ")
+		if err != nil {
+			return exitError(ExitTotalFailure, "stringer: JSON marshal failed (%v)", err)
+		}
+		_, _ = fmt.Fprintln(w, string(data))
+		return nil
+	}
+	renderExplanation(w, e)
+	return nil
+}
+
+// explainSignals returns the signals to look the ID up in and where they
+// came from. A scan applies the same adjustments as 'stringer scan',
+// except hot paths, which need --profile.
+func explainSignals(cmd *cobra.Command, absPath, gitRoot string, cfg *config.Config, entries []feedback.Entry) ([]signal.RawSignal, string, error) {
+	if explainFrom != "" {
+		data, err := cmdFS.ReadFile(explainFrom)
+		if err != nil {
+			return nil, "", exitError(ExitInvalidArgs, "stringer: cannot read %q (%v)", explainFrom, err)
+		}
+		var env output.JSONEnvelope
+		if err := json.Unmarshal(data, &env); err != nil {
+			return nil, "", exitError(ExitInvalidArgs, "stringer: %s is not a --format json scan output (%v)", explainFrom, err)
+		}
+		signals := make([]signal.RawSignal, len(env.Signals))
+		for i, s := range env.Signals {
+			signals[i] = s.RawSignal
+		}
+		return signals, explainFrom, nil
+	}
+
+	// The profile applies after boosts and calibration, as in a scan.
+	result, _, err := runUnweightedScan(cmd.Context(), gitRoot, signal.ScanConfig{RepoPath: absPath})
+	if err != nil {
+		return nil, "", err
+	}
+	pipeline.BoostColocatedSignals(result.Signals)
+	feedback.Calibrate(entries).Apply(result.Signals)
+	profile, err := config.ScoringProfile(cfg.ScoringProfile, cfg.ScoringProfiles)
+	if err != nil {
+		return nil, "", exitError(ExitInvalidArgs, "stringer: %v", err)
+	}
+	signals, _ := profile.Apply(result.Signals)
+	return signals, "a scan of " + absPath, nil
+}
+
+// explainSignal builds the explanation for sig. Signals from scan output
+// written before factors were recorded get a single base factor.
+func explainSignal(id string, sig signal.RawSignal, cfg *config.Config, entries []feedback.Entry) explanation {
+	e := explanation{
+		ID:         id,
+		Collector:  sig.Source,
+		Kind:       sig.Kind,
+		Title:      sig.Title,
+		Confidence: sig.Confidence,
+		Factors:    sig.Factors,
+		Options:    []explainOption{},
+	}
+	if sig.FilePath != "" {
+		e.Location = sig.FilePath
+		if sig.Line > 0 {
+			e.Location = fmt.Sprintf("%s:%d", sig.FilePath, sig.Line)
+		}
+	}
+	if len(e.Factors) == 0 {
+		e.Factors = []signal.ConfidenceFactor{{Name: "base", Delta: sig.Confidence, Detail: "not recorded in this scan output"}}
+	}
+
+	name := sig.Source
+	if d, ok := derivedSources[name]; ok {
+		name = d
+	}
+	e.Options = append(e.Options, collectorOptions(name, cfg)...)
+	e.Options = append(e.Options, feedbackOption(sig.Kind, entries))
+	if cfg.ScoringProfile != "" {
+		e.Options = append(e.Options, explainOption{
+			Key:    "scoring_profile",
+			Value:  cfg.ScoringProfile,
+			Effect: "re-weights confidence by kind and tag",
+		})
+	}
+	return e
+}
+
+// collectorOptions lists the collector's settings from .stringer.yaml.
+// min_confidence is called out since it decides whether a signal is kept.
+func collectorOptions(name string, cfg *config.Config) []explainOption {
+	cc, ok := cfg.Collectors[name]
+	if !ok {
+		return nil
+	}
+	data, err := yaml.Marshal(cc)
+	if err != nil {
+		return nil
+	}
+	var settings map[string]any
+	if err := yaml.Unmarshal(data, &settings); err != nil {
+		return nil
+	}
+	keys := make([]string, 0, len(settings))
+	for k := range settings {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	opts := make([]explainOption, 0, len(keys))
+	for _, k := range keys {
+		effect := "shapes what " + name + " collects"
+		if k == "min_confidence" {
+			effect = "signals below it are dropped"
+		}
+		opts = append(opts, explainOption{
+			Key:    "collectors." + name + "." + k,
+			Value:  fmt.Sprint(settings[k]),
+			Effect: effect,
+		})
+	}
+	return opts
+}
+
+// feedbackOption reports the recorded feedback on kind and the calibration
+// it leads to.
+func feedbackOption(kind string, entries []feedback.Entry) explainOption {
+	opt := explainOption{Key: "feedback", Value: "none recorded"}
+	for _, ks := range feedback.Stats(entries) {
+		if ks.Kind != kind {
+			continue
+		}
+		opt.Value = fmt.Sprintf("%d accepted, %d rejected", ks.Accepted, ks.Rejected)
+		if n := ks.Accepted + ks.Rejected; n < feedback.MinDecisions {
+			opt.Effect = fmt.Sprintf("no calibration until %d decisions", feedback.MinDecisions)
+		} else {
+			opt.Effect = fmt.Sprintf("calibrates %s signals by %+.2f", kind, ks.Adjustment)
+		}
+		return opt
+	}
+	opt.Effect = "record decisions with 'stringer feedback' to calibrate " + kind + " signals"
+	return opt
+}
+
+// renderExplanation writes the explanation as text.
+func renderExplanation(w io.Writer, e explanation) {
+	_, _ = fmt.Fprintf(w, "%s  %s\n", e.ID, e.Title)
+	where := []string{e.Collector, e.Kind}
+	if e.Location != "" {
+		where = append(where, e.Location)
+	}
+	_, _ = fmt.Fprintf(w, "  %s\n\n", strings.Join(where, " · "))
+
+	_, _ = fmt.Fprintf(w, "Confidence %.2f\n", e.Confidence)
+	for i, f := range e.Factors {
+		delta := fmt.Sprintf("%+.2f", f.Delta)
+		if i == 0 {
+			delta = fmt.Sprintf("%.2f", f.Delta)
+		}
+		_, _ = fmt.Fprintf(w, "  %-12s %6s  %s\n", f.Name, delta, f.Detail)
+	}
+
+	_, _ = fmt.Fprintln(w, "\nOptions")
+	for _, o := range e.Options {
+		_, _ = fmt.Fprintf(w, "  %s: %s — %s\n", o.Key, o.Value, o.Effect)
+	}
+}
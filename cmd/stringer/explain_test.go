@@ -0,0 +1,163 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davetashner/stringer/internal/config"
+	"github.com/davetashner/stringer/internal/feedback"
+	"github.com/davetashner/stringer/internal/output"
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+func resetExplainFlags() {
+	explainFrom = ""
+	explainJSON = false
+	explainCmd.Flags().VisitAll(func(f *pflag.Flag) {
+		_ = f.Value.Set(f.DefValue)
+		f.Changed = false
+	})
+}
+
+// configTodoID is the ID of the TODO initTestRepo commits in config.go.
+var configTodoID = output.SignalID(signal.RawSignal{
+	Source: "todos", Kind: "todo", FilePath: "config.go", Line: 3, Title: "TODO: Load config from file",
+}, "str-")
+
+func TestExplain_Scan(t *testing.T) {
+	resetExplainFlags()
+	dir := initTestRepo(t)
+	writeTestFile(t, dir, ".stringer.yaml", "collectors:\n  todos:\n    min_confidence: 0.3\n")
+	chdirTest(t, dir)
+
+	cmd, stdout, _ := newTestCmd()
+	cmd.SetContext(context.Background())
+	cmd.SetArgs([]string{"explain", configTodoID})
+	require.NoError(t, cmd.Execute())
+
+	out := stdout.String()
+	assert.Contains(t, out, configTodoID+"  TODO: Load config from file\n")
+	assert.Contains(t, out, "todos · todo · config.go:3")
+	assert.Contains(t, out, "Confidence 0.60\n")
+	assert.Contains(t, out, "  base           0.50  TODO keyword\n")
+	assert.Contains(t, out, "  recency       +0.10  added 0 days ago (under 30)\n")
+	assert.Contains(t, out, "collectors.todos.min_confidence: 0.3 — signals below it are dropped")
+	assert.Contains(t, out, "feedback: none recorded")
+}
+
+func TestExplain_ScoringProfile(t *testing.T) {
+	resetExplainFlags()
+	dir := initTestRepo(t)
+	writeTestFile(t, dir, ".stringer.yaml", `scoring_profile: todo-first
+scoring_profiles:
+  todo-first:
+    weights:
+      - kinds: [todo]
+        factor: 1.5
+`)
+	chdirTest(t, dir)
+
+	cmd, stdout, _ := newTestCmd()
+	cmd.SetContext(context.Background())
+	cmd.SetArgs([]string{"explain", configTodoID})
+	require.NoError(t, cmd.Execute())
+
+	out := stdout.String()
+	assert.Contains(t, out, "Confidence 0.90\n")
+	assert.Contains(t, out, "  profile       +0.30  todo-first profile weights todo signals ×1.5\n")
+	assert.Contains(t, out, "scoring_profile: todo-first — re-weights confidence by kind and tag")
+}
+
+func TestExplain_FromScanOutputJSON(t *testing.T) {
+	resetExplainFlags()
+	dir := t.TempDir()
+	chdirTest(t, dir)
+	sig := signal.RawSignal{
+		Source: "todos", Kind: "todo", FilePath: "a.go", Line: 7, Title: "TODO: a", Confidence: 0.8,
+		Factors: []signal.ConfidenceFactor{
+			{Name: "base", Delta: 0.5, Detail: "TODO keyword"},
+			{Name: "hot-path", Delta: 0.1, Detail: "file is hot in a production profile"},
+			{Name: "co-location", Delta: 0.1, Detail: "shares a file with a churn signal"},
+			{Name: "calibration", Delta: 0.1, Detail: "recorded feedback on todo signals adjusts them by +0.10"},
+		},
+	}
+	id := output.SignalID(sig, "str-")
+	data, err := json.Marshal(output.JSONEnvelope{Signals: output.NewJSONSignals([]signal.RawSignal{sig})})
+	require.NoError(t, err)
+	writeTestFile(t, dir, "scan.json", string(data))
+	require.NoError(t, feedback.Append(dir,
+		feedback.Entry{SignalID: id, Decision: feedback.Accept, Kind: "todo", At: time.Now()},
+		feedback.Entry{SignalID: "str-00000001", Decision: feedback.Accept, Kind: "todo", At: time.Now()},
+		feedback.Entry{SignalID: "str-00000002", Decision: feedback.Accept, Kind: "todo", At: time.Now()},
+	))
+
+	cmd, stdout, _ := newTestCmd()
+	cmd.SetArgs([]string{"explain", id, "--from", filepath.Join(dir, "scan.json"), "--json"})
+	require.NoError(t, cmd.Execute())
+
+	var e explanation
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &e), "output: %s", stdout.String())
+	assert.Equal(t, id, e.ID)
+	assert.Equal(t, "a.go:7", e.Location)
+	assert.Equal(t, 0.8, e.Confidence)
+	assert.Equal(t, sig.Factors, e.Factors)
+	require.Len(t, e.Options, 1)
+	assert.Equal(t, "3 accepted, 0 rejected", e.Options[0].Value)
+	assert.Contains(t, e.Options[0].Effect, "calibrates todo signals by +")
+}
+
+func TestExplainSignal_NoRecordedFactors(t *testing.T) {
+	sig := signal.RawSignal{Source: "hotspot", Kind: "hotspot", FilePath: "a.go", Title: "Hotspot", Confidence: 0.7}
+	cfg := &config.Config{Collectors: map[string]config.CollectorConfig{
+		"gitlog": {GitDepth: 500, ExcludePatterns: []string{"vendor/**"}},
+	}}
+	entries := []feedback.Entry{{SignalID: "str-00000001", Decision: feedback.Reject, Kind: "hotspot"}}
+
+	e := explainSignal("str-12345678", sig, cfg, entries)
+
+	assert.Equal(t, "a.go", e.Location)
+	assert.Equal(t, []signal.ConfidenceFactor{{Name: "base", Delta: 0.7, Detail: "not recorded in this scan output"}}, e.Factors)
+	assert.Equal(t, []explainOption{
+		{Key: "collectors.gitlog.exclude_patterns", Value: "[vendor/**]", Effect: "shapes what gitlog collects"},
+		{Key: "collectors.gitlog.git_depth", Value: "500", Effect: "shapes what gitlog collects"},
+		{Key: "feedback", Value: "0 accepted, 1 rejected", Effect: "no calibration until 3 decisions"},
+	}, e.Options, "hotspot settings live under gitlog")
+}
+
+func TestExplain_Errors(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		args []string
+		want string
+	}{
+		{"invalid ID", []string{"explain", "abc"}, `invalid signal ID "abc"`},
+		{"not found", []string{"explain", "str-00000000", "--from", "scan.json"}, "signal str-00000000 not found in scan.json"},
+		{"not json output", []string{"explain", "str-00000000", "--from", "scan.jsonl"}, "scan.jsonl is not a --format json scan output"},
+		{"missing file", []string{"explain", "str-00000000", "--from", "missing.json"}, `cannot read "missing.json"`},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			resetExplainFlags()
+			dir := t.TempDir()
+			chdirTest(t, dir)
+			writeTestFile(t, dir, "scan.json", `{"signals": [], "metadata": {}}`)
+			writeTestFile(t, dir, "scan.jsonl", `{"id": "str-00000000"}`+"\n"+`{"id": "str-00000001"}`)
+
+			cmd, _, _ := newTestCmd()
+			cmd.SetArgs(tc.args)
+			err := cmd.Execute()
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.want)
+			requireExitCode(t, err, ExitInvalidArgs)
+		})
+	}
+}
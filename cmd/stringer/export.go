@@ -0,0 +1,347 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/davetashner/stringer/internal/collector"
+	"github.com/davetashner/stringer/internal/collectors"
+	"github.com/davetashner/stringer/internal/config"
+	"github.com/davetashner/stringer/internal/output"
+	"github.com/davetashner/stringer/internal/pipeline"
+	"github.com/davetashner/stringer/internal/reconcile"
+	"github.com/davetashner/stringer/internal/routing"
+	"github.com/davetashner/stringer/internal/sbom"
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+// Export command flags.
+var (
+	exportSBOMFormat string
+	exportSBOMOutput string
+
+	exportReviewersStrategy string
+	exportReviewersMax      int
+	exportReviewersOutput   string
+
+	exportGitHubCollectors    string
+	exportGitHubMinConfidence float64
+	exportGitHubLabel         string
+	exportGitHubDryRun        bool
+)
+
+// newIssueAPI builds the GitHub client used by export github. Replaced in
+// tests.
+var newIssueAPI = reconcile.NewGitHubIssueAPI
+
+// exportCmd is the parent command for export subcommands.
+var exportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export scan data in standard formats",
+	Long:  "Export data stringer computes in formats other tools consume.",
+}
+
+// exportSBOMCmd writes a software bill of materials.
+var exportSBOMCmd = &cobra.Command{
+	Use:   "sbom [path]",
+	Short: "Export dependencies as a CycloneDX or SPDX SBOM",
+	Long: `Export the repository's dependencies as a software bill of materials.
+
+Dependencies are read from the same manifests the dephealth and vuln
+collectors inspect. Each component is annotated with stringer's findings:
+known vulnerabilities from OSV.dev and health issues such as deprecated,
+archived, stale, or yanked packages.
+
+Formats:
+  cyclonedx  CycloneDX 1.5 JSON (default). Health findings are component
+             properties named stringer:health:<kind>.
+  spdx       SPDX 2.3 JSON. Vulnerabilities are SECURITY advisory
+             references and health findings are REVIEW annotations.
+
+Annotations that need the network are skipped when it is unavailable; the
+inventory itself is always complete.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runExportSBOM,
+}
+
+// exportReviewersCmd writes a review routing table for auto-assignment bots.
+var exportReviewersCmd = &cobra.Command{
+	Use:   "reviewers [path]",
+	Short: "Export ranked reviewer candidates per directory as JSON",
+	Long: `Export a review routing table: each directory mapped to ranked reviewer
+candidates, built from git ownership and GitHub review participation (the
+lotteryrisk collector's data). Auto-assignment bots can consume it to route
+pull requests.
+
+Strategies:
+  spread     Exclude each directory's top owner and rank secondary owners
+             and active reviewers (default). Routing reviews away from the
+             dominant owner spreads knowledge and lowers lottery risk.
+  expertise  Rank everyone, top owner included.
+
+Candidates are scored from their ownership share and their share of recent
+reviews. Routes are listed deepest path first; use the first route whose
+path is a changed file's directory or one of its ancestors. Review data
+needs GITHUB_TOKEN; without it candidates come from ownership alone. Map git
+author names to GitHub logins with the identities config so both sources
+merge.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runExportReviewers,
+}
+
+// exportGitHubCmd files signals as issues in the origin repository.
+var exportGitHubCmd = &cobra.Command{
+	Use:   "github [path]",
+	Short: "Create and update GitHub issues for the current signals",
+	Long: `Scan the repository and file its signals as issues in the GitHub
+repository of the origin remote:
+
+  - signals that match no issue, open or closed, are filed as new issues,
+  - open issues whose signal is still detected get its current title and
+    body, and any labels they lack.
+
+Issues are created with the same title, body, and labels the github-issues
+format writes: --label (default stringer-generated), the github label rules
+in .stringer.yaml, or the signal's kind when no rule matches. Each body ends
+with a hidden <!-- stringer:id=str-XXXXXXXX --> marker, and existing issues
+carrying --label are matched by it, by any signal ID they mention, or by
+title, so running the export again never files duplicates. Closed issues
+are never reopened or edited, so won't-fix decisions stick; use reconcile
+to find open issues whose signal is gone.
+
+Requires GITHUB_TOKEN with permission to write issues. Requests that hit a
+GitHub rate limit wait for it to lift and are retried when it lifts within
+a couple of minutes. Use --dry-run to list the changes without making
+them. The github collector is left out unless -c names it, since its
+signals are the repository's issues.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runExportGitHub,
+}
+
+func init() {
+	exportSBOMCmd.Flags().StringVarP(&exportSBOMFormat, "format", "f", sbom.FormatCycloneDX,
+		"SBOM format (cyclonedx, spdx)")
+	exportSBOMCmd.Flags().StringVarP(&exportSBOMOutput, "output", "o", "", "output file path (default: stdout)")
+
+	exportReviewersCmd.Flags().StringVar(&exportReviewersStrategy, "strategy", routing.StrategySpread,
+		"ranking strategy (spread, expertise)")
+	exportReviewersCmd.Flags().IntVarP(&exportReviewersMax, "max", "n", routing.DefaultMaxCandidates,
+		"maximum candidates per path")
+	exportReviewersCmd.Flags().StringVarP(&exportReviewersOutput, "output", "o", "", "output file path (default: stdout)")
+
+	exportGitHubCmd.Flags().StringVarP(&exportGitHubCollectors, "collectors", "c", "",
+		"comma-separated list of collectors to run (default: all but github)")
+	exportGitHubCmd.Flags().Float64Var(&exportGitHubMinConfidence, "min-confidence", 0,
+		"do not file signals below this confidence threshold (0.0-1.0)")
+	exportGitHubCmd.Flags().StringVar(&exportGitHubLabel, "label", reconcile.DefaultGitHubLabel,
+		"label that marks stringer's issues")
+	exportGitHubCmd.Flags().BoolVar(&exportGitHubDryRun, "dry-run", false, "list the changes without making them")
+
+	exportCmd.AddCommand(exportSBOMCmd)
+	exportCmd.AddCommand(exportReviewersCmd)
+	exportCmd.AddCommand(exportGitHubCmd)
+	rootCmd.AddCommand(exportCmd)
+}
+
+// resetExportFlags resets export command flags for testing.
+func resetExportFlags() {
+	exportSBOMFormat = sbom.FormatCycloneDX
+	exportSBOMOutput = ""
+	exportReviewersStrategy = routing.StrategySpread
+	exportReviewersMax = routing.DefaultMaxCandidates
+	exportReviewersOutput = ""
+	exportGitHubCollectors = ""
+	exportGitHubMinConfidence = 0
+	exportGitHubLabel = reconcile.DefaultGitHubLabel
+	exportGitHubDryRun = false
+
+	for _, c := range []*cobra.Command{exportSBOMCmd, exportReviewersCmd, exportGitHubCmd} {
+		c.Flags().VisitAll(func(f *pflag.Flag) {
+			_ = f.Value.Set(f.DefValue)
+			f.Changed = false
+		})
+	}
+}
+
+func runExportSBOM(cmd *cobra.Command, args []string) error {
+	format := strings.ToLower(exportSBOMFormat)
+	if format != sbom.FormatCycloneDX && format != sbom.FormatSPDX {
+		return exitError(ExitInvalidArgs, "stringer: unsupported format %q (supported: %s)",
+			exportSBOMFormat, strings.Join(sbom.Formats, ", "))
+	}
+
+	repoPath := "."
+	if len(args) > 0 {
+		repoPath = args[0]
+	}
+	absPath, gitRoot, err := resolveScanPath(repoPath)
+	if err != nil {
+		return err
+	}
+
+	deps, err := collectors.ListDependencies(absPath)
+	if err != nil {
+		return exitError(ExitInvalidArgs, "stringer: %v", err)
+	}
+
+	result, err := runConfiguredScan(cmd.Context(), gitRoot, signal.ScanConfig{
+		RepoPath:   absPath,
+		Collectors: []string{"dephealth", "vuln"},
+	})
+	if err != nil {
+		return err
+	}
+	vulns, _ := result.Metrics["vuln"].(*collectors.VulnMetrics)
+
+	bom := sbom.Build(filepath.Base(absPath), Version, time.Now(), deps, result.Signals, vulns)
+
+	w := cmd.OutOrStdout()
+	if exportSBOMOutput != "" {
+		f, createErr := cmdFS.Create(exportSBOMOutput)
+		if createErr != nil {
+			return exitError(ExitInvalidArgs, "stringer: cannot create output file %q (%v)", exportSBOMOutput, createErr)
+		}
+		defer f.Close() //nolint:errcheck // best-effort close on output file
+		w = f
+	}
+	if err := sbom.Write(w, bom, format); err != nil {
+		return exitError(ExitTotalFailure, "stringer: writing SBOM failed (%v)", err)
+	}
+	return nil
+}
+
+func runExportReviewers(cmd *cobra.Command, args []string) error {
+	strategy := strings.ToLower(exportReviewersStrategy)
+	if strategy != routing.StrategySpread && strategy != routing.StrategyExpertise {
+		return exitError(ExitInvalidArgs, "stringer: unsupported strategy %q (supported: %s)",
+			exportReviewersStrategy, strings.Join(routing.Strategies, ", "))
+	}
+	if exportReviewersMax < 1 {
+		return exitError(ExitInvalidArgs, "stringer: --max must be at least 1")
+	}
+
+	repoPath := "."
+	if len(args) > 0 {
+		repoPath = args[0]
+	}
+	absPath, gitRoot, err := resolveScanPath(repoPath)
+	if err != nil {
+		return err
+	}
+
+	result, err := runConfiguredScan(cmd.Context(), gitRoot, signal.ScanConfig{
+		RepoPath:   absPath,
+		Collectors: []string{"lotteryrisk"},
+	})
+	if err != nil {
+		return err
+	}
+	metrics, _ := result.Metrics["lotteryrisk"].(*collectors.LotteryRiskMetrics)
+	if metrics == nil {
+		return exitError(ExitTotalFailure, "stringer: no ownership data for %s (is it a git repository with history?)", absPath)
+	}
+
+	table, err := routing.Build(metrics, routing.Config{Strategy: strategy, MaxCandidates: exportReviewersMax}, time.Now())
+	if err != nil {
+		return exitError(ExitInvalidArgs, "stringer: %v", err)
+	}
+
+	w := cmd.OutOrStdout()
+	if exportReviewersOutput != "" {
+		f, createErr := cmdFS.Create(exportReviewersOutput)
+		if createErr != nil {
+			return exitError(ExitInvalidArgs, "stringer: cannot create output file %q (%v)", exportReviewersOutput, createErr)
+		}
+		defer f.Close() //nolint:errcheck // best-effort close on output file
+		w = f
+	}
+	if err := routing.Write(w, table); err != nil {
+		return exitError(ExitTotalFailure, "stringer: writing routing table failed (%v)", err)
+	}
+	return nil
+}
+
+func runExportGitHub(cmd *cobra.Command, args []string) error {
+	if exportGitHubMinConfidence < 0 || exportGitHubMinConfidence > 1.0 {
+		return exitError(ExitInvalidArgs,
+			"stringer: --min-confidence must be between 0.0 and 1.0 (got %.2f)", exportGitHubMinConfidence)
+	}
+	if strings.TrimSpace(exportGitHubLabel) == "" {
+		return exitError(ExitInvalidArgs, "stringer: --label must not be empty")
+	}
+
+	repoPath := "."
+	if len(args) > 0 {
+		repoPath = args[0]
+	}
+	absPath, gitRoot, err := resolveScanPath(repoPath)
+	if err != nil {
+		return err
+	}
+
+	// 1. List the issues first so a missing token fails before the scan.
+	api, err := newIssueAPI(absPath)
+	if err != nil {
+		return exitError(ExitInvalidArgs, "stringer: export github requires GITHUB_TOKEN and a GitHub origin remote (%v)", err)
+	}
+	existing, err := api.ListIssues(cmd.Context(), exportGitHubLabel)
+	if err != nil {
+		return exitError(ExitTotalFailure, "stringer: cannot list GitHub issues (%v)", err)
+	}
+
+	// 2. Scan.
+	names := splitCollectors(exportGitHubCollectors)
+	if len(names) == 0 {
+		for _, name := range collector.List() {
+			if name != "github" {
+				names = append(names, name)
+			}
+		}
+	}
+	result, err := runConfiguredScan(cmd.Context(), gitRoot, signal.ScanConfig{RepoPath: absPath, Collectors: names})
+	if err != nil {
+		return err
+	}
+	pipeline.BoostColocatedSignals(result.Signals)
+
+	// 3. Plan.
+	fileCfg, err := config.Load(absPath)
+	if err != nil {
+		return exitError(ExitInvalidArgs, "stringer: failed to load %s (%v)", config.FileName, err)
+	}
+	formatter := output.NewGitHubIssuesFormatter()
+	formatter.Label = exportGitHubLabel
+	formatter.SetLabelMap(config.LabelMap(fileCfg))
+	synced := reconcile.PlanGitHubIssues(existing, result.Signals, formatter, exportGitHubMinConfidence)
+
+	// 4. Apply.
+	var applyErr error
+	if !exportGitHubDryRun {
+		applyErr = reconcile.ApplyGitHubIssues(cmd.Context(), api, synced)
+	}
+	if exportGitHubDryRun || !quiet {
+		_ = reconcile.RenderIssuesSync(synced, cmd.OutOrStdout())
+	}
+	if applyErr != nil {
+		return exitError(ExitTotalFailure, "stringer: export to GitHub failed after %d created and %d updated (%v)",
+			len(synced.Created), len(synced.Updated), applyErr)
+	}
+
+	if !quiet {
+		verb := "exported"
+		if exportGitHubDryRun {
+			verb = "would export"
+		}
+		_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "stringer: %s to GitHub: %d created, %d updated, %d unchanged\n",
+			verb, len(synced.Created), len(synced.Updated), synced.Unchanged)
+	}
+	return nil
+}
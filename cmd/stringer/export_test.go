@@ -0,0 +1,307 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davetashner/stringer/internal/output"
+	"github.com/davetashner/stringer/internal/reconcile"
+)
+
+func TestExportCmd_IsRegistered(t *testing.T) {
+	found := false
+	for _, cmd := range rootCmd.Commands() {
+		if cmd.Name() == "export" {
+			found = true
+			break
+		}
+	}
+	assert.True(t, found, "export command should be registered on rootCmd")
+
+	subs := map[string]bool{}
+	for _, cmd := range exportCmd.Commands() {
+		subs[cmd.Name()] = true
+	}
+	assert.True(t, subs["sbom"], "sbom subcommand should be registered")
+	assert.True(t, subs["reviewers"], "reviewers subcommand should be registered")
+	assert.True(t, subs["github"], "github subcommand should be registered")
+}
+
+// initSBOMRepo creates a repo with a Go and an npm dependency.
+func initSBOMRepo(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	writeTestFile(t, dir, "go.mod", "module example.com/app\n\ngo 1.22\n\nrequire github.com/spf13/cobra v1.9.1\n")
+	writeTestFile(t, dir, "package.json", `{"name": "app", "dependencies": {"lodash": "4.17.21"}}`)
+	return dir
+}
+
+func TestExportSBOM_CycloneDX(t *testing.T) {
+	resetExportFlags()
+	dir := initSBOMRepo(t)
+
+	cmd, stdout, _ := newTestCmd()
+	cmd.SetArgs([]string{"export", "sbom", dir})
+	require.NoError(t, cmd.Execute())
+
+	var doc struct {
+		BOMFormat  string `json:"bomFormat"`
+		Components []struct {
+			Name string `json:"name"`
+			PURL string `json:"purl"`
+		} `json:"components"`
+	}
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &doc), "output: %s", stdout.String())
+	assert.Equal(t, "CycloneDX", doc.BOMFormat)
+	require.Len(t, doc.Components, 2)
+	assert.Equal(t, "pkg:golang/github.com/spf13/cobra@v1.9.1", doc.Components[0].PURL)
+	assert.Equal(t, "pkg:npm/lodash@4.17.21", doc.Components[1].PURL)
+}
+
+func TestExportSBOM_SPDXToFile(t *testing.T) {
+	resetExportFlags()
+	dir := initSBOMRepo(t)
+	outFile := filepath.Join(t.TempDir(), "sbom.spdx.json")
+
+	cmd, _, _ := newTestCmd()
+	cmd.SetArgs([]string{"export", "sbom", dir, "--format", "spdx", "-o", outFile})
+	require.NoError(t, cmd.Execute())
+
+	data, err := os.ReadFile(outFile) //nolint:gosec // test-controlled path
+	require.NoError(t, err)
+	var doc struct {
+		SPDXVersion string `json:"spdxVersion"`
+		Packages    []struct {
+			Name string `json:"name"`
+		} `json:"packages"`
+	}
+	require.NoError(t, json.Unmarshal(data, &doc))
+	assert.Equal(t, "SPDX-2.3", doc.SPDXVersion)
+	assert.Len(t, doc.Packages, 3, "root package plus two dependencies")
+}
+
+func TestExportSBOM_InvalidArgs(t *testing.T) {
+	resetExportFlags()
+	cmd, _, _ := newTestCmd()
+	cmd.SetArgs([]string{"export", "sbom", ".", "--format", "swid"})
+	err := cmd.Execute()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `unsupported format "swid" (supported: cyclonedx, spdx)`)
+	requireExitCode(t, err, ExitInvalidArgs)
+
+	resetExportFlags()
+	dir := t.TempDir()
+	writeTestFile(t, dir, "go.mod", "not a go.mod {{{")
+	cmd, _, _ = newTestCmd()
+	cmd.SetArgs([]string{"export", "sbom", dir})
+	err = cmd.Execute()
+	require.Error(t, err)
+	requireExitCode(t, err, ExitInvalidArgs)
+}
+
+// reviewerRoutes is the subset of the routing table the tests inspect.
+type reviewerRoutes struct {
+	Strategy string `json:"strategy"`
+	Routes   []struct {
+		Path       string `json:"path"`
+		TopOwner   string `json:"top_owner"`
+		Candidates []struct {
+			Name  string  `json:"name"`
+			Score float64 `json:"score"`
+		} `json:"candidates"`
+	} `json:"routes"`
+}
+
+func TestExportReviewers(t *testing.T) {
+	t.Setenv("GITHUB_TOKEN", "")
+	resetExportFlags()
+	dir := initTestRepo(t)
+
+	cmd, stdout, _ := newTestCmd()
+	cmd.SetArgs([]string{"export", "reviewers", dir, "--strategy", "expertise"})
+	require.NoError(t, cmd.Execute())
+
+	var table reviewerRoutes
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &table), "output: %s", stdout.String())
+	assert.Equal(t, "expertise", table.Strategy)
+	require.NotEmpty(t, table.Routes)
+	root := table.Routes[len(table.Routes)-1]
+	assert.Equal(t, ".", root.Path, "root route is listed last")
+	require.NotEmpty(t, root.Candidates)
+	assert.Equal(t, root.TopOwner, root.Candidates[0].Name, "expertise ranks the top owner first")
+
+	// The default spread strategy never routes to the top owner.
+	resetExportFlags()
+	outFile := filepath.Join(t.TempDir(), "reviewers.json")
+	cmd, _, _ = newTestCmd()
+	cmd.SetArgs([]string{"export", "reviewers", dir, "-o", outFile})
+	require.NoError(t, cmd.Execute())
+
+	data, err := os.ReadFile(outFile) //nolint:gosec // test-controlled path
+	require.NoError(t, err)
+	table = reviewerRoutes{}
+	require.NoError(t, json.Unmarshal(data, &table))
+	assert.Equal(t, "spread", table.Strategy)
+	require.NotEmpty(t, table.Routes, "the second author is a candidate")
+	for _, route := range table.Routes {
+		for _, c := range route.Candidates {
+			assert.NotEqual(t, route.TopOwner, c.Name, "route %s", route.Path)
+		}
+	}
+}
+
+func TestExportReviewers_InvalidArgs(t *testing.T) {
+	resetExportFlags()
+	cmd, _, _ := newTestCmd()
+	cmd.SetArgs([]string{"export", "reviewers", ".", "--strategy", "random"})
+	err := cmd.Execute()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `unsupported strategy "random" (supported: spread, expertise)`)
+	requireExitCode(t, err, ExitInvalidArgs)
+
+	resetExportFlags()
+	cmd, _, _ = newTestCmd()
+	cmd.SetArgs([]string{"export", "reviewers", ".", "--max", "0"})
+	err = cmd.Execute()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "--max must be at least 1")
+	requireExitCode(t, err, ExitInvalidArgs)
+}
+
+// fakeIssueAPI is an in-memory reconcile.IssueAPI.
+type fakeIssueAPI struct {
+	label   string
+	issues  []reconcile.RemoteIssue
+	created []output.GitHubIssue
+	updated map[int]output.GitHubIssue
+	err     error
+}
+
+func (f *fakeIssueAPI) ListIssues(_ context.Context, label string) ([]reconcile.RemoteIssue, error) {
+	f.label = label
+	return f.issues, nil
+}
+
+func (f *fakeIssueAPI) CreateIssue(_ context.Context, issue output.GitHubIssue) (reconcile.RemoteIssue, error) {
+	if f.err != nil {
+		return reconcile.RemoteIssue{}, f.err
+	}
+	f.created = append(f.created, issue)
+	return reconcile.RemoteIssue{Number: 100 + len(f.created)}, nil
+}
+
+func (f *fakeIssueAPI) UpdateIssue(_ context.Context, number int, issue output.GitHubIssue) error {
+	if f.updated == nil {
+		f.updated = make(map[int]output.GitHubIssue)
+	}
+	f.updated[number] = issue
+	return nil
+}
+
+// useFakeIssueAPI makes export github talk to api.
+func useFakeIssueAPI(t *testing.T, api *fakeIssueAPI) {
+	t.Helper()
+	orig := newIssueAPI
+	newIssueAPI = func(string) (reconcile.IssueAPI, error) { return api, nil }
+	t.Cleanup(func() { newIssueAPI = orig })
+}
+
+func TestExportGitHub(t *testing.T) {
+	resetExportFlags()
+	root := initTestRepo(t)
+	api := &fakeIssueAPI{issues: []reconcile.RemoteIssue{
+		{Number: 3, Title: "TODO: Add proper CLI argument parsing", Body: "old", State: "open", Labels: []string{"debt"}},
+		{Number: 4, Title: "FIXME: This will panic on nil input", State: "closed"},
+	}}
+	useFakeIssueAPI(t, api)
+
+	cmd, stdout, stderr := newTestCmd()
+	cmd.SetArgs([]string{"export", "github", root, "-c", "todos", "--label", "debt"})
+	require.NoError(t, cmd.Execute())
+
+	assert.Equal(t, "debt", api.label)
+	require.Contains(t, api.updated, 3)
+	assert.Contains(t, api.updated[3].Body, "<!-- stringer:id=str-")
+	assert.Equal(t, []string{"debt", "todo"}, api.updated[3].Labels)
+	assert.NotContains(t, api.updated, 4, "closed issues are never edited")
+	require.NotEmpty(t, api.created)
+	for _, issue := range api.created {
+		assert.NotContains(t, issue.Title, "panic on nil input", "closed issues count as filed")
+		assert.Equal(t, "debt", issue.Labels[0])
+		assert.Equal(t, issue.ID, output.IssueMarkerID(issue.Body))
+	}
+	assert.Contains(t, stdout.String(), "  create  #101  ")
+	assert.Contains(t, stdout.String(), "  update  #3  TODO: Add proper CLI argument parsing (body, labels)\n")
+	assert.Contains(t, stderr.String(), "stringer: exported to GitHub: ")
+}
+
+func TestExportGitHub_DryRun(t *testing.T) {
+	resetExportFlags()
+	root := initTestRepo(t)
+	api := &fakeIssueAPI{}
+	useFakeIssueAPI(t, api)
+
+	cmd, stdout, stderr := newTestCmd()
+	cmd.SetArgs([]string{"export", "github", root, "-c", "todos", "--dry-run"})
+	require.NoError(t, cmd.Execute())
+
+	assert.Empty(t, api.created)
+	assert.Empty(t, api.updated)
+	assert.Equal(t, reconcile.DefaultGitHubLabel, api.label)
+	assert.Contains(t, stdout.String(), "  create  str-")
+	assert.Contains(t, stderr.String(), "stringer: would export to GitHub: ")
+}
+
+func TestExportGitHub_MinConfidence(t *testing.T) {
+	resetExportFlags()
+	root := initTestRepo(t)
+	api := &fakeIssueAPI{}
+	useFakeIssueAPI(t, api)
+
+	cmd, _, _ := newTestCmd()
+	cmd.SetArgs([]string{"export", "github", root, "-c", "todos", "--min-confidence", "1"})
+	require.NoError(t, cmd.Execute())
+	assert.Empty(t, api.created)
+}
+
+func TestExportGitHub_ApplyFailure(t *testing.T) {
+	resetExportFlags()
+	root := initTestRepo(t)
+	useFakeIssueAPI(t, &fakeIssueAPI{err: errors.New("403 Resource not accessible by integration")})
+
+	cmd, _, _ := newTestCmd()
+	cmd.SetArgs([]string{"export", "github", root, "-c", "todos"})
+	err := cmd.Execute()
+	requireExitCode(t, err, ExitTotalFailure)
+	assert.Contains(t, err.Error(), "Resource not accessible")
+}
+
+func TestExportGitHub_InvalidArgs(t *testing.T) {
+	resetExportFlags()
+	cmd, _, _ := newTestCmd()
+	cmd.SetArgs([]string{"export", "github", fixtureDir(t), "--min-confidence", "2"})
+	requireExitCode(t, cmd.Execute(), ExitInvalidArgs)
+
+	resetExportFlags()
+	cmd.SetArgs([]string{"export", "github", fixtureDir(t), "--label", " "})
+	requireExitCode(t, cmd.Execute(), ExitInvalidArgs)
+
+	resetExportFlags()
+	orig := newIssueAPI
+	newIssueAPI = func(string) (reconcile.IssueAPI, error) { return nil, errors.New("GITHUB_TOKEN is not set") }
+	t.Cleanup(func() { newIssueAPI = orig })
+	cmd.SetArgs([]string{"export", "github", fixtureDir(t)})
+	err := cmd.Execute()
+	requireExitCode(t, err, ExitInvalidArgs)
+	assert.Contains(t, err.Error(), "GITHUB_TOKEN")
+}
@@ -0,0 +1,184 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/davetashner/stringer/internal/collector"
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+// failOnRule is one --fail-on threshold: the scan fails when more than
+// maxCount of the signals it outputs match every filter.
+type failOnRule struct {
+	spec          string
+	kinds         []string
+	collectors    []string
+	tags          []string
+	paths         signal.Scope
+	minConfidence float64
+	maxCount      int
+}
+
+// failOnHit is a rule whose threshold the scan output exceeded.
+type failOnHit struct {
+	rule    failOnRule
+	signals []signal.RawSignal
+}
+
+// parseFailOn parses --fail-on values: comma-separated clauses that must
+// all hold, such as "kind=fixme,path=internal/payments,count>0". kind,
+// collector, tag, and path take alternatives separated by "|"; the count
+// clause defaults to count>0.
+func parseFailOn(specs []string) ([]failOnRule, error) {
+	rules := make([]failOnRule, 0, len(specs))
+	for _, spec := range specs {
+		r := failOnRule{spec: spec}
+		var paths []string
+		for _, clause := range strings.Split(spec, ",") {
+			clause = strings.TrimSpace(clause)
+			if clause == "" {
+				continue
+			}
+			if rest, ok := strings.CutPrefix(clause, "count"); ok {
+				n, err := parseFailOnCount(rest)
+				if err != nil {
+					return nil, fmt.Errorf("invalid --fail-on %q: %v", spec, err)
+				}
+				r.maxCount = n
+				continue
+			}
+			key, value, ok := strings.Cut(clause, "=")
+			key = strings.ToLower(strings.TrimSpace(key))
+			value = strings.TrimSpace(value)
+			if !ok || value == "" {
+				return nil, fmt.Errorf("invalid --fail-on %q: clause %q (want key=value or count>N)", spec, clause)
+			}
+			switch key {
+			case "kind":
+				r.kinds = append(r.kinds, splitAlternatives(value)...)
+			case "collector":
+				for _, name := range splitAlternatives(value) {
+					if collector.Get(name) == nil {
+						return nil, fmt.Errorf("invalid --fail-on %q: unknown collector %q", spec, name)
+					}
+					r.collectors = append(r.collectors, name)
+				}
+			case "tag":
+				r.tags = append(r.tags, splitAlternatives(value)...)
+			case "path":
+				paths = append(paths, splitAlternatives(value)...)
+			case "min-confidence":
+				f, err := strconv.ParseFloat(value, 64)
+				if err != nil || f < 0 || f > 1 {
+					return nil, fmt.Errorf("invalid --fail-on %q: min-confidence must be between 0.0 and 1.0", spec)
+				}
+				r.minConfidence = f
+			default:
+				return nil, fmt.Errorf("invalid --fail-on %q: unknown key %q (want kind, collector, tag, path, min-confidence, or count)", spec, key)
+			}
+		}
+		r.paths = signal.NewScope(paths, 0)
+		rules = append(rules, r)
+	}
+	return rules, nil
+}
+
+// parseFailOnCount parses the rest of a count clause, ">N" or ">=N", into
+// the largest number of matches that still passes.
+func parseFailOnCount(rest string) (int, error) {
+	op, least := ">", 0
+	if strings.HasPrefix(rest, ">=") {
+		op, least = ">=", 1
+	}
+	n, err := strconv.Atoi(strings.TrimSpace(strings.TrimPrefix(rest, op)))
+	if !strings.HasPrefix(rest, ">") || err != nil || n < least {
+		return 0, fmt.Errorf("count must be count>N or count>=N with N >= %d", least)
+	}
+	if op == ">=" {
+		return n - 1, nil
+	}
+	return n, nil
+}
+
+func splitAlternatives(value string) []string {
+	var out []string
+	for _, v := range strings.Split(value, "|") {
+		if v = strings.TrimSpace(v); v != "" {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+// matches reports whether sig passes every filter of r.
+func (r failOnRule) matches(sig signal.RawSignal) bool {
+	if sig.Confidence < r.minConfidence {
+		return false
+	}
+	if len(r.kinds) > 0 && !containsFold(r.kinds, sig.Kind) {
+		return false
+	}
+	if len(r.collectors) > 0 && !slices.Contains(r.collectors, sig.Source) {
+		return false
+	}
+	if len(r.tags) > 0 && !anyTag(sig.Tags, r.tags) {
+		return false
+	}
+	if !r.paths.IsZero() && (sig.FilePath == "" || !r.paths.Contains(sig.FilePath)) {
+		return false
+	}
+	return true
+}
+
+// checkFailOn returns the rules the scan output breaks. Signals already
+// closed, such as TODOs resolved since a --delta scan, never count, and
+// neither do epics, whose children are counted on their own.
+func checkFailOn(rules []failOnRule, signals []signal.RawSignal) []failOnHit {
+	var hits []failOnHit
+	for _, r := range rules {
+		var matched []signal.RawSignal
+		for _, sig := range signals {
+			if slices.Contains(sig.Tags, "pre-closed") || len(sig.Children) > 0 || !r.matches(sig) {
+				continue
+			}
+			matched = append(matched, sig)
+		}
+		if len(matched) > r.maxCount {
+			hits = append(hits, failOnHit{rule: r, signals: matched})
+		}
+	}
+	return hits
+}
+
+// reportFailOn writes each broken --fail-on rule and the signals it matched.
+func reportFailOn(w io.Writer, hits []failOnHit) {
+	for _, h := range hits {
+		_, _ = fmt.Fprintf(w, "stringer: %d signal(s) match --fail-on %s (allowed %d)\n", len(h.signals), h.rule.spec, h.rule.maxCount)
+		writeSignalList(w, h.signals)
+	}
+}
+
+func containsFold(values []string, s string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, s) {
+			return true
+		}
+	}
+	return false
+}
+
+func anyTag(tags, want []string) bool {
+	for _, t := range tags {
+		if slices.Contains(want, t) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,161 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+func TestParseFailOn(t *testing.T) {
+	rules, err := parseFailOn([]string{
+		"kind=bug|FIXME, min-confidence=0.7, count>0",
+		"path=internal/payments,tag=security,collector=todos,count>=5",
+		"kind=todo",
+	})
+	require.NoError(t, err)
+	require.Len(t, rules, 3)
+
+	assert.Equal(t, []string{"bug", "FIXME"}, rules[0].kinds)
+	assert.InDelta(t, 0.7, rules[0].minConfidence, 1e-9)
+	assert.Equal(t, 0, rules[0].maxCount)
+
+	assert.Equal(t, []string{"internal/payments"}, rules[1].paths.Paths)
+	assert.Equal(t, []string{"security"}, rules[1].tags)
+	assert.Equal(t, []string{"todos"}, rules[1].collectors)
+	assert.Equal(t, 4, rules[1].maxCount, "count>=5 allows 4")
+
+	assert.Equal(t, 0, rules[2].maxCount, "count defaults to count>0")
+}
+
+func TestParseFailOn_Invalid(t *testing.T) {
+	tests := []struct {
+		spec string
+		want string
+	}{
+		{"kind", "want key=value"},
+		{"kind=", "want key=value"},
+		{"owner=alice", `unknown key "owner"`},
+		{"collector=nope", `unknown collector "nope"`},
+		{"min-confidence=1.5", "min-confidence must be between"},
+		{"count<3", "count must be"},
+		{"count>-1", "count must be"},
+		{"count>=0", "count must be"},
+		{"count>many", "count must be"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.spec, func(t *testing.T) {
+			_, err := parseFailOn([]string{tt.spec})
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.want)
+			assert.Contains(t, err.Error(), "invalid --fail-on")
+		})
+	}
+}
+
+func TestFailOnRule_Matches(t *testing.T) {
+	sig := signal.RawSignal{
+		Source: "todos", Kind: "fixme", FilePath: "internal/payments/charge.go",
+		Confidence: 0.75, Tags: []string{"security"},
+	}
+	tests := []struct {
+		spec string
+		want bool
+	}{
+		{"kind=fixme", true},
+		{"kind=FIXME|bug", true},
+		{"kind=todo", false},
+		{"collector=todos", true},
+		{"collector=gitlog", false},
+		{"tag=security", true},
+		{"tag=perf", false},
+		{"path=internal/payments", true},
+		{"path=internal/pay", false},
+		{"path=internal/**", true},
+		{"path=*.go", true},
+		{"path=cmd|internal/payments", true},
+		{"min-confidence=0.7", true},
+		{"min-confidence=0.8", false},
+		{"kind=fixme,path=internal/payments,min-confidence=0.7", true},
+		{"kind=fixme,path=cmd", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.spec, func(t *testing.T) {
+			rules, err := parseFailOn([]string{tt.spec})
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, rules[0].matches(sig))
+		})
+	}
+
+	rules, err := parseFailOn([]string{"path=internal"})
+	require.NoError(t, err)
+	assert.False(t, rules[0].matches(signal.RawSignal{Kind: "github-issue"}), "signals without a file never match a path")
+}
+
+func TestCheckFailOn(t *testing.T) {
+	signals := []signal.RawSignal{
+		{Kind: "todo", Title: "a"},
+		{Kind: "todo", Title: "b"},
+		{Kind: "todo", Title: "resolved", Tags: []string{"pre-closed"}},
+		{Kind: "fixme", Title: "c"},
+		{Kind: "epic", Title: "epic", Children: []string{"str-1", "str-2"}},
+	}
+	rules, err := parseFailOn([]string{"kind=todo,count>2", "kind=todo,count>1", "kind=fixme", "kind=bug", "kind=epic"})
+	require.NoError(t, err)
+
+	hits := checkFailOn(rules, signals)
+	require.Len(t, hits, 2, "closed signals and epics do not count toward a threshold")
+	assert.Equal(t, "kind=todo,count>1", hits[0].rule.spec)
+	assert.Len(t, hits[0].signals, 2)
+	assert.Equal(t, "kind=fixme", hits[1].rule.spec)
+
+	var buf strings.Builder
+	reportFailOn(&buf, hits)
+	assert.Contains(t, buf.String(), "stringer: 2 signal(s) match --fail-on kind=todo,count>1 (allowed 1)\n")
+	assert.Contains(t, buf.String(), "stringer: 1 signal(s) match --fail-on kind=fixme (allowed 0)\n")
+}
+
+func TestRunScan_FailOn(t *testing.T) {
+	resetScanFlags()
+	cmd, stdout, stderr := newTestCmd()
+	cmd.SetArgs([]string{"scan", fixtureDir(t), "--quiet", "-c", "todos", "--fail-on", "kind=fixme,path=*.sql"})
+
+	err := cmd.Execute()
+	requireExitCode(t, err, ExitExpectation)
+	assert.NotEmpty(t, stdout.String(), "output is still written")
+	assert.Contains(t, stderr.String(), "stringer: 1 signal(s) match --fail-on kind=fixme,path=*.sql (allowed 0)")
+	assert.Contains(t, stderr.String(), "Missing index on created_at")
+}
+
+func TestRunScan_FailOnBelowThreshold(t *testing.T) {
+	resetScanFlags()
+	cmd, _, stderr := newTestCmd()
+	cmd.SetArgs([]string{"scan", fixtureDir(t), "--quiet", "-c", "todos", "--fail-on", "kind=todo,count>100", "--fail-on", "kind=committed-secret"})
+
+	require.NoError(t, cmd.Execute())
+	assert.NotContains(t, stderr.String(), "--fail-on")
+}
+
+func TestRunScan_FailOnAppliesAfterFilters(t *testing.T) {
+	resetScanFlags()
+	cmd, _, _ := newTestCmd()
+	cmd.SetArgs([]string{"scan", fixtureDir(t), "--quiet", "-c", "todos", "--kind", "todo", "--fail-on", "kind=fixme"})
+
+	require.NoError(t, cmd.Execute(), "signals filtered out of the output do not count")
+}
+
+func TestRunScan_FailOnInvalid(t *testing.T) {
+	resetScanFlags()
+	cmd, _, _ := newTestCmd()
+	cmd.SetArgs([]string{"scan", fixtureDir(t), "--fail-on", "severity=high"})
+
+	err := cmd.Execute()
+	requireExitCode(t, err, ExitInvalidArgs)
+	assert.Contains(t, err.Error(), `unknown key "severity"`)
+}
@@ -0,0 +1,224 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/davetashner/stringer/internal/feedback"
+	"github.com/davetashner/stringer/internal/output"
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+// Feedback command flags.
+var (
+	feedbackKind    string
+	feedbackFrom    string
+	feedbackComment string
+	feedbackJSON    bool
+)
+
+// feedbackCmd is the parent command for feedback subcommands.
+var feedbackCmd = &cobra.Command{
+	Use:   "feedback",
+	Short: "Record accept/reject decisions that calibrate signal confidence",
+	Long: `Record whether emitted signals were worth acting on. Decisions are
+appended to .stringer/feedback.jsonl, which is intended to be
+version-controlled.
+
+Once a signal kind has at least 3 decisions, scan raises or lowers the
+confidence of that kind by up to 0.20 according to its acceptance rate.
+Use 'stringer feedback stats' to see the adjustments, and
+'stringer scan --no-calibration' to scan without them.`,
+}
+
+// feedbackAcceptCmd records signals as real work.
+var feedbackAcceptCmd = &cobra.Command{
+	Use:   "accept <signal-id>...",
+	Short: "Mark signals as real work",
+	Long: `Record an accept decision for each signal ID.
+
+Calibration is per kind, so each signal's kind is looked up: from --kind,
+from a scan output file in json format given with --from, or else by
+scanning the current directory.`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runFeedbackDecision(cmd, args, feedback.Accept)
+	},
+}
+
+// feedbackRejectCmd records signals as noise.
+var feedbackRejectCmd = &cobra.Command{
+	Use:   "reject <signal-id>...",
+	Short: "Mark signals as noise",
+	Long: `Record a reject decision for each signal ID. Signal kinds are looked
+up as for 'stringer feedback accept'.`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runFeedbackDecision(cmd, args, feedback.Reject)
+	},
+}
+
+// feedbackStatsCmd shows per-kind acceptance and calibration.
+var feedbackStatsCmd = &cobra.Command{
+	Use:   "stats",
+	Short: "Show acceptance rates and confidence adjustments per kind",
+	Args:  cobra.NoArgs,
+	RunE:  runFeedbackStats,
+}
+
+func init() {
+	for _, c := range []*cobra.Command{feedbackAcceptCmd, feedbackRejectCmd} {
+		c.Flags().StringVar(&feedbackKind, "kind", "", "signal kind of every ID (skips the lookup)")
+		c.Flags().StringVar(&feedbackFrom, "from", "", "scan output file (--format json) to look up signal kinds in")
+		c.Flags().StringVar(&feedbackComment, "comment", "", "free-text comment")
+	}
+	feedbackStatsCmd.Flags().BoolVar(&feedbackJSON, "json", false, "machine-readable JSON output")
+
+	feedbackCmd.AddCommand(feedbackAcceptCmd)
+	feedbackCmd.AddCommand(feedbackRejectCmd)
+	feedbackCmd.AddCommand(feedbackStatsCmd)
+	rootCmd.AddCommand(feedbackCmd)
+}
+
+// signalRef is what feedback records about a signal besides its ID.
+type signalRef struct {
+	kind      string
+	collector string
+}
+
+func runFeedbackDecision(cmd *cobra.Command, ids []string, decision feedback.Decision) error {
+	for _, id := range ids {
+		if !signalIDPattern.MatchString(id) {
+			return exitError(ExitInvalidArgs,
+				"stringer: invalid signal ID %q — must match str-[0-9a-f]{8}", id)
+		}
+	}
+
+	absPath, gitRoot, err := resolveScanPath(".")
+	if err != nil {
+		return err
+	}
+	refs, err := lookupSignalRefs(cmd, absPath, gitRoot, ids)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	user := gitUserName()
+	entries := make([]feedback.Entry, 0, len(ids))
+	for _, id := range ids {
+		entries = append(entries, feedback.Entry{
+			SignalID:  id,
+			Decision:  decision,
+			Kind:      refs[id].kind,
+			Collector: refs[id].collector,
+			Comment:   feedbackComment,
+			By:        user,
+			At:        now,
+		})
+	}
+	if err := feedback.Append(absPath, entries...); err != nil {
+		return exitError(ExitTotalFailure, "stringer: failed to save feedback (%v)", err)
+	}
+
+	verb := "Accepted"
+	if decision == feedback.Reject {
+		verb = "Rejected"
+	}
+	for _, e := range entries {
+		_, _ = fmt.Fprintf(cmd.OutOrStdout(), "%s %s (%s)\n", verb, e.SignalID, e.Kind)
+	}
+	return nil
+}
+
+// lookupSignalRefs finds the kind and collector of each signal ID. All IDs
+// must be found; otherwise nothing is recorded.
+func lookupSignalRefs(cmd *cobra.Command, absPath, gitRoot string, ids []string) (map[string]signalRef, error) {
+	refs := make(map[string]signalRef, len(ids))
+	switch {
+	case feedbackKind != "":
+		for _, id := range ids {
+			refs[id] = signalRef{kind: feedbackKind}
+		}
+		return refs, nil
+	case feedbackFrom != "":
+		entries, err := readScanOutput(feedbackFrom)
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range entries {
+			refs[e.ID] = signalRef{kind: e.Kind, collector: e.Collector}
+		}
+	default:
+		result, err := runConfiguredScan(cmd.Context(), gitRoot, signal.ScanConfig{RepoPath: absPath})
+		if err != nil {
+			return nil, err
+		}
+		for _, sig := range result.Signals {
+			refs[output.SignalID(sig, "str-")] = signalRef{kind: sig.Kind, collector: sig.Source}
+		}
+	}
+
+	var missing []string
+	for _, id := range ids {
+		if refs[id].kind == "" {
+			missing = append(missing, id)
+		}
+	}
+	if len(missing) > 0 {
+		where := "a scan of " + absPath
+		if feedbackFrom != "" {
+			where = feedbackFrom
+		}
+		return nil, exitError(ExitInvalidArgs, "stringer: signal(s) %s not found in %s — pass --kind to record them anyway",
+			strings.Join(missing, ", "), where)
+	}
+	return refs, nil
+}
+
+func runFeedbackStats(cmd *cobra.Command, _ []string) error {
+	absPath, err := cmdFS.Abs(".")
+	if err != nil {
+		return exitError(ExitInvalidArgs, "stringer: cannot resolve path (%v)", err)
+	}
+	entries, err := feedback.Load(absPath)
+	if err != nil {
+		return exitError(ExitTotalFailure, "stringer: failed to load feedback (%v)", err)
+	}
+	stats := feedback.Stats(entries)
+
+	w := cmd.OutOrStdout()
+	if feedbackJSON {
+		if stats == nil {
+			stats = []feedback.KindStats{}
+		}
+		data, err := json.MarshalIndent(stats, "", "  ")
+		if err != nil {
+			return exitError(ExitTotalFailure, "stringer: JSON marshal failed (%v)", err)
+		}
+		_, _ = fmt.Fprintln(w, string(data))
+		return nil
+	}
+
+	if len(stats) == 0 {
+		_, _ = fmt.Fprintln(w, "No feedback — run `stringer feedback accept` or `reject` on emitted signals")
+		return nil
+	}
+	_, _ = fmt.Fprintf(w, "%-24s %8s %8s %8s %10s\n", "Kind", "Accepted", "Rejected", "Rate", "Adjustment")
+	_, _ = fmt.Fprintf(w, "%-24s %8s %8s %8s %10s\n", "----", "--------", "--------", "----", "----------")
+	for _, s := range stats {
+		adj := "-"
+		if s.Accepted+s.Rejected >= feedback.MinDecisions {
+			adj = fmt.Sprintf("%+.2f", s.Adjustment)
+		}
+		_, _ = fmt.Fprintf(w, "%-24s %8d %8d %7.0f%% %10s\n", s.Kind, s.Accepted, s.Rejected, s.Rate*100, adj)
+	}
+	return nil
+}
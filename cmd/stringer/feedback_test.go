@@ -0,0 +1,160 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davetashner/stringer/internal/feedback"
+	"github.com/davetashner/stringer/internal/output"
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+func resetFeedbackFlags() {
+	feedbackKind = ""
+	feedbackFrom = ""
+	feedbackComment = ""
+	feedbackJSON = false
+	for _, cmd := range []*cobra.Command{feedbackAcceptCmd, feedbackRejectCmd, feedbackStatsCmd} {
+		cmd.Flags().VisitAll(func(f *pflag.Flag) {
+			_ = f.Value.Set(f.DefValue)
+			f.Changed = false
+		})
+	}
+}
+
+// chdirTest changes into dir for the rest of the test.
+func chdirTest(t *testing.T, dir string) {
+	t.Helper()
+	origDir, _ := os.Getwd()
+	require.NoError(t, os.Chdir(dir))
+	t.Cleanup(func() { _ = os.Chdir(origDir) })
+}
+
+func TestFeedbackAccept_LooksUpKindByScanning(t *testing.T) {
+	resetFeedbackFlags()
+	dir := initTestRepo(t)
+	chdirTest(t, dir)
+	id := output.SignalID(signal.RawSignal{
+		Source: "todos", Kind: "todo", FilePath: "config.go", Line: 3, Title: "TODO: Load config from file",
+	}, "str-")
+
+	cmd, stdout, _ := newTestCmd()
+	cmd.SetContext(context.Background())
+	cmd.SetArgs([]string{"feedback", "accept", id, "--comment", "real work"})
+	require.NoError(t, cmd.Execute())
+	assert.Contains(t, stdout.String(), "Accepted "+id+" (todo)")
+
+	entries, err := feedback.Load(dir)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, feedback.Accept, entries[0].Decision)
+	assert.Equal(t, "todos", entries[0].Collector)
+	assert.Equal(t, "real work", entries[0].Comment)
+}
+
+func TestFeedbackReject_FromScanOutput(t *testing.T) {
+	resetFeedbackFlags()
+	dir := t.TempDir()
+	chdirTest(t, dir)
+	churn := signal.RawSignal{Source: "gitlog", Kind: "churn", FilePath: "a.go", Title: "High churn"}
+	id := output.SignalID(churn, "str-")
+	data, err := json.Marshal(map[string]any{"signals": []signal.RawSignal{churn}})
+	require.NoError(t, err)
+	writeTestFile(t, dir, "scan.json", string(data))
+
+	cmd, stdout, _ := newTestCmd()
+	cmd.SetArgs([]string{"feedback", "reject", id, "--from", "scan.json"})
+	require.NoError(t, cmd.Execute())
+	assert.Contains(t, stdout.String(), "Rejected "+id+" (churn)")
+
+	resetFeedbackFlags()
+	cmd.SetArgs([]string{"feedback", "reject", id, "str-0000000b", "--from", "scan.json"})
+	err = cmd.Execute()
+	requireExitCode(t, err, ExitInvalidArgs)
+	assert.Contains(t, err.Error(), "str-0000000b not found in scan.json")
+
+	entries, err := feedback.Load(dir)
+	require.NoError(t, err)
+	require.Len(t, entries, 1, "nothing is recorded when an ID is not found")
+	assert.Equal(t, "gitlog", entries[0].Collector)
+}
+
+func TestFeedbackAccept_InvalidID(t *testing.T) {
+	resetFeedbackFlags()
+	chdirTest(t, t.TempDir())
+	cmd, _, _ := newTestCmd()
+	cmd.SetArgs([]string{"feedback", "accept", "not-an-id", "--kind", "todo"})
+	requireExitCode(t, cmd.Execute(), ExitInvalidArgs)
+}
+
+func TestFeedbackStats(t *testing.T) {
+	resetFeedbackFlags()
+	dir := t.TempDir()
+	chdirTest(t, dir)
+
+	cmd, stdout, _ := newTestCmd()
+	cmd.SetArgs([]string{"feedback", "stats"})
+	require.NoError(t, cmd.Execute())
+	assert.Contains(t, stdout.String(), "No feedback")
+
+	resetFeedbackFlags()
+	stdout.Reset()
+	cmd.SetArgs([]string{"feedback", "reject", "str-00000001", "str-00000002", "str-00000003", "--kind", "churn"})
+	require.NoError(t, cmd.Execute())
+
+	resetFeedbackFlags()
+	stdout.Reset()
+	cmd.SetArgs([]string{"feedback", "stats"})
+	require.NoError(t, cmd.Execute())
+	assert.Contains(t, stdout.String(), "churn")
+	assert.Contains(t, stdout.String(), "-0.09")
+
+	resetFeedbackFlags()
+	stdout.Reset()
+	cmd.SetArgs([]string{"feedback", "stats", "--json"})
+	require.NoError(t, cmd.Execute())
+	var stats []feedback.KindStats
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &stats))
+	require.Len(t, stats, 1)
+	assert.Equal(t, 3, stats[0].Rejected)
+}
+
+func TestRunScan_AppliesCalibration(t *testing.T) {
+	dir := t.TempDir()
+	writeTestFile(t, dir, "main.go", "package main\n\n// TODO: Calibrate me\n")
+	var entries []feedback.Entry
+	for _, id := range []string{"str-00000001", "str-00000002", "str-00000003", "str-00000004"} {
+		entries = append(entries, feedback.Entry{SignalID: id, Decision: feedback.Reject, Kind: "todo", At: time.Now()})
+	}
+	require.NoError(t, feedback.Append(dir, entries...))
+
+	confidence := func(extra ...string) float64 {
+		resetScanFlags()
+		cmd, stdout, _ := newTestCmd()
+		cmd.SetArgs(append([]string{"scan", dir, "-c", "todos", "--format", "json", "--quiet"}, extra...))
+		require.NoError(t, cmd.Execute())
+		var out struct {
+			Signals []struct {
+				Confidence float64 `json:"confidence"`
+			} `json:"signals"`
+		}
+		require.NoError(t, json.Unmarshal(stdout.Bytes(), &out))
+		require.Len(t, out.Signals, 1)
+		return out.Signals[0].Confidence
+	}
+	raw := confidence("--no-calibration")
+	assert.InDelta(t, raw-0.1, confidence(), 0.001)
+	assert.FileExists(t, filepath.Join(dir, ".stringer", "feedback.jsonl"))
+}
@@ -0,0 +1,389 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/davetashner/stringer/internal/collector"
+	"github.com/davetashner/stringer/internal/collectors"
+	"github.com/davetashner/stringer/internal/config"
+	"github.com/davetashner/stringer/internal/fix"
+	"github.com/davetashner/stringer/internal/labels"
+	"github.com/davetashner/stringer/internal/pipeline"
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+// Fix command flags.
+var (
+	fixFixers       string
+	fixDryRun       bool
+	fixList         bool
+	fixDepsNames    []string
+	fixDepsRunTests bool
+	fixDepsCreatePR bool
+	fixDepsBase     string
+	fixDepsDryRun   bool
+)
+
+// newPullRequestAPI builds the GitHub client used by fix deps --create-pr.
+// Replaced in tests.
+var newPullRequestAPI = fix.NewPullRequestAPI
+
+// fixCmd applies machine-applicable fixes and hosts fix subcommands.
+var fixCmd = &cobra.Command{
+	Use:   "fix [path]",
+	Short: "Apply changes that resolve detected signals",
+	Long: `Apply machine-applicable fixes for trivially fixable signals.
+
+Runs the collectors each fixer needs, lets every selected fixer edit an
+in-memory copy of the affected files, then writes the combined result and
+prints a changed-files summary. Use --dry-run to preview the changes as a
+unified diff without touching the working tree, and --list to see the
+available fixers.
+
+Unlike scan and report, fix modifies the working tree of the target
+repository. Review the result before committing.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runFix,
+}
+
+// fixDepsCmd bumps outdated direct dependencies.
+var fixDepsCmd = &cobra.Command{
+	Use:   "deps [path]",
+	Short: "Bump outdated direct dependencies and optionally open a PR",
+	Long: `Bump outdated direct dependencies using each ecosystem's own tooling
+(go get for Go modules, npm install for npm).
+
+By default every outdated direct dependency is updated. Use --dep to
+select specific packages. With --run-tests the project is built and tested
+after the bump and the command fails if either step fails.
+
+With --create-pr the updated manifests and lock files are committed to a
+stringer/deps/ branch, pushed to origin, and a GitHub pull request is
+opened. Requires GITHUB_TOKEN and a working tree without uncommitted
+changes to tracked files; a rerun resets and re-pushes the same branch.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runFixDeps,
+}
+
+func init() {
+	fixCmd.Flags().StringVar(&fixFixers, "fixers", "",
+		"comma-separated list of fixers to run (default: all)")
+	fixCmd.Flags().BoolVar(&fixDryRun, "dry-run", false,
+		"print a unified diff of the changes without writing them")
+	fixCmd.Flags().BoolVar(&fixList, "list", false,
+		"list available fixers and exit")
+
+	fixDepsCmd.Flags().StringSliceVar(&fixDepsNames, "dep", nil,
+		"dependency to update (repeatable; default: all outdated direct dependencies)")
+	fixDepsCmd.Flags().BoolVar(&fixDepsRunTests, "run-tests", false,
+		"build and test the project after updating")
+	fixDepsCmd.Flags().BoolVar(&fixDepsCreatePR, "create-pr", false,
+		"commit to a new branch, push, and open a GitHub pull request")
+	fixDepsCmd.Flags().StringVar(&fixDepsBase, "base", "",
+		"base branch for the pull request (default: repository default branch)")
+	fixDepsCmd.Flags().BoolVar(&fixDepsDryRun, "dry-run", false,
+		"list the updates that would be applied without changing anything")
+
+	fixCmd.AddCommand(fixDepsCmd)
+	rootCmd.AddCommand(fixCmd)
+}
+
+// resetFixFlags resets fix command flags for testing.
+func resetFixFlags() {
+	fixFixers = ""
+	fixDryRun = false
+	fixList = false
+	fixDepsNames = nil
+	fixDepsRunTests = false
+	fixDepsCreatePR = false
+	fixDepsBase = ""
+	fixDepsDryRun = false
+
+	for _, cmd := range []*cobra.Command{fixCmd, fixDepsCmd} {
+		cmd.Flags().VisitAll(func(f *pflag.Flag) {
+			if sv, ok := f.Value.(pflag.SliceValue); ok {
+				_ = sv.Replace(nil)
+			} else {
+				_ = f.Value.Set(f.DefValue)
+			}
+			f.Changed = false
+		})
+	}
+}
+
+func runFix(cmd *cobra.Command, args []string) error {
+	out := cmd.OutOrStdout()
+
+	if fixList {
+		for _, name := range fix.List() {
+			_, _ = fmt.Fprintf(out, "  %-16s %s\n", name, fix.Get(name).Description())
+		}
+		return nil
+	}
+
+	fixers, err := selectFixers(fixFixers)
+	if err != nil {
+		return err
+	}
+
+	repoPath := "."
+	if len(args) > 0 {
+		repoPath = args[0]
+	}
+	absPath, gitRoot, err := resolveScanPath(repoPath)
+	if err != nil {
+		return err
+	}
+
+	signals, err := collectFixSignals(cmd, absPath, gitRoot, fixers)
+	if err != nil {
+		return err
+	}
+
+	ws := fix.NewWorkspace(absPath)
+	if err := fix.Run(cmd.Context(), ws, fixers, signals); err != nil {
+		return exitError(ExitTotalFailure, "stringer: %v", err)
+	}
+
+	changes := ws.Changes()
+	if len(changes) == 0 {
+		_, _ = fmt.Fprintln(out, "Nothing to fix.")
+		return nil
+	}
+
+	if fixDryRun {
+		for _, c := range changes {
+			_, _ = fmt.Fprint(out, fix.UnifiedDiff(c))
+		}
+		_, _ = fmt.Fprintf(out, "\n%d file(s) would change (dry run):\n", len(changes))
+		_, _ = fmt.Fprint(out, fix.Summary(changes))
+		return nil
+	}
+
+	if err := fix.Apply(absPath, changes); err != nil {
+		return exitError(ExitTotalFailure, "stringer: %v", err)
+	}
+	_, _ = fmt.Fprintf(out, "Changed %d file(s):\n", len(changes))
+	_, _ = fmt.Fprint(out, fix.Summary(changes))
+	return nil
+}
+
+// selectFixers resolves a comma-separated fixer list. Empty selects all
+// registered fixers.
+func selectFixers(list string) ([]fix.Fixer, error) {
+	names := fix.List()
+	if list != "" {
+		names = strings.Split(list, ",")
+	}
+	fixers := make([]fix.Fixer, 0, len(names))
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		f := fix.Get(name)
+		if f == nil {
+			return nil, exitError(ExitInvalidArgs, "stringer: unknown fixer %q (available: %s)",
+				name, strings.Join(fix.List(), ", "))
+		}
+		fixers = append(fixers, f)
+	}
+	return fixers, nil
+}
+
+// collectFixSignals runs the collectors required by fixers and returns their
+// signals. Returns nil without scanning when no fixer needs signals.
+func collectFixSignals(cmd *cobra.Command, absPath, gitRoot string, fixers []fix.Fixer) ([]signal.RawSignal, error) {
+	needed := make(map[string]bool)
+	for _, f := range fixers {
+		for _, c := range f.Collectors() {
+			needed[c] = true
+		}
+	}
+	if len(needed) == 0 {
+		return nil, nil
+	}
+	names := make([]string, 0, len(needed))
+	for name := range needed {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	result, err := runConfiguredScan(cmd.Context(), gitRoot, signal.ScanConfig{RepoPath: absPath, Collectors: names})
+	if err != nil {
+		return nil, err
+	}
+	return result.Signals, nil
+}
+
+// runConfiguredScan runs a scan of base.RepoPath with the repository's config
+// applied, including its scoring profile. base.Collectors selects the
+// collectors (all when empty).
+func runConfiguredScan(ctx context.Context, gitRoot string, base signal.ScanConfig) (*signal.ScanResult, error) {
+	result, fileCfg, err := runUnweightedScan(ctx, gitRoot, base)
+	if err != nil {
+		return nil, err
+	}
+	profile, err := config.ScoringProfile(fileCfg.ScoringProfile, fileCfg.ScoringProfiles)
+	if err != nil {
+		return nil, exitError(ExitInvalidArgs, "stringer: %v", err)
+	}
+	result.Signals, _ = profile.Apply(result.Signals)
+	return result, nil
+}
+
+// runUnweightedScan is runConfiguredScan without the scoring profile, for
+// callers that apply it themselves after other adjustments. It also returns
+// the repository's config.
+func runUnweightedScan(ctx context.Context, gitRoot string, base signal.ScanConfig) (*signal.ScanResult, *config.Config, error) {
+	fileCfg, err := config.Load(base.RepoPath)
+	if err != nil {
+		return nil, nil, exitError(ExitInvalidArgs, "stringer: failed to load config (%v)", err)
+	}
+	if err := registerPlugins(fileCfg, base.RepoPath); err != nil {
+		return nil, nil, err
+	}
+	scanCfg := config.Merge(fileCfg, base)
+	names := base.Collectors
+	if gitRoot != base.RepoPath {
+		if scanCfg.CollectorOpts == nil {
+			scanCfg.CollectorOpts = make(map[string]signal.CollectorOpts)
+		}
+		gitNames := names
+		if len(gitNames) == 0 {
+			gitNames = collector.List()
+		}
+		for _, name := range gitNames {
+			co := scanCfg.CollectorOpts[name]
+			co.GitRoot = gitRoot
+			scanCfg.CollectorOpts[name] = co
+		}
+	}
+
+	p, err := pipeline.New(scanCfg)
+	if err != nil {
+		available := collector.List()
+		sort.Strings(available)
+		return nil, nil, exitError(ExitInvalidArgs, "stringer: %v (available: %s)", err, strings.Join(available, ", "))
+	}
+	ctx = collectors.WithFileTrees(collectors.WithGitHubData(ctx, collectors.NewGitHubData(0)), collectors.NewFileTrees())
+	result, err := p.Run(ctx)
+	if err != nil {
+		return nil, nil, exitError(ExitTotalFailure, "stringer: scan failed (%v)", err)
+	}
+	return result, fileCfg, nil
+}
+
+func runFixDeps(cmd *cobra.Command, args []string) error {
+	repoPath := "."
+	if len(args) > 0 {
+		repoPath = args[0]
+	}
+
+	absPath, _, err := resolveScanPath(repoPath)
+	if err != nil {
+		return err
+	}
+
+	if len(fix.Ecosystems(absPath)) == 0 {
+		return exitError(ExitInvalidArgs, "stringer: no supported manifest (go.mod, package.json) in %s", absPath)
+	}
+
+	// Validate PR prerequisites before touching the working tree.
+	var owner, repo, token string
+	if fixDepsCreatePR && !fixDepsDryRun {
+		token = os.Getenv("GITHUB_TOKEN")
+		if token == "" {
+			return exitError(ExitInvalidArgs, "stringer: --create-pr requires GITHUB_TOKEN")
+		}
+		owner, repo, err = collectors.ParseGitHubRemote(absPath)
+		if err != nil {
+			return exitError(ExitInvalidArgs, "stringer: --create-pr requires a GitHub origin remote (%v)", err)
+		}
+		if err := fix.CheckClean(cmd.Context(), absPath); err != nil {
+			return exitError(ExitInvalidArgs, "stringer: --create-pr: %v", err)
+		}
+	}
+
+	ctx := cmd.Context()
+	out := cmd.OutOrStdout()
+
+	outdated, err := fix.FindOutdatedDeps(ctx, absPath)
+	if err != nil {
+		return exitError(ExitTotalFailure, "stringer: failed to list outdated dependencies (%v)", err)
+	}
+
+	updates, unknown := fix.SelectUpdates(outdated, fixDepsNames)
+	if len(unknown) > 0 {
+		return exitError(ExitInvalidArgs, "stringer: not an outdated direct dependency: %v", unknown)
+	}
+	if len(updates) == 0 {
+		_, _ = fmt.Fprintln(out, "All direct dependencies are up to date.")
+		return nil
+	}
+
+	for _, u := range updates {
+		_, _ = fmt.Fprintf(out, "  %-4s %s %s -> %s\n", u.Ecosystem, u.Name, u.Current, u.Latest)
+	}
+	if fixDepsDryRun {
+		_, _ = fmt.Fprintf(out, "\n%d update(s) would be applied (dry run).\n", len(updates))
+		return nil
+	}
+
+	if err := fix.ApplyUpdates(ctx, absPath, updates); err != nil {
+		return exitError(ExitTotalFailure, "stringer: %v", err)
+	}
+	_, _ = fmt.Fprintf(out, "\nApplied %d update(s).\n", len(updates))
+
+	if fixDepsRunTests {
+		if err := fix.RunChecks(ctx, absPath, updates); err != nil {
+			return exitError(ExitTotalFailure, "stringer: checks failed after update (%v)", err)
+		}
+		_, _ = fmt.Fprintln(out, "Build and tests passed.")
+	}
+
+	if !fixDepsCreatePR {
+		return nil
+	}
+
+	branch := fix.BranchName(updates)
+	title := fix.PRTitle(updates)
+	if err := fix.CommitAndPush(ctx, absPath, branch, title, fix.UpdatedFiles(absPath, updates)); err != nil {
+		return exitError(ExitTotalFailure, "stringer: %v", err)
+	}
+
+	fileCfg, err := config.Load(absPath)
+	if err != nil {
+		return exitError(ExitInvalidArgs, "stringer: failed to load config (%v)", err)
+	}
+	labelMap := config.LabelMap(fileCfg)
+	var prLabels []string
+	for _, u := range updates {
+		prLabels = labelMap.Append(labels.GitHub, fix.UpdateSignal(u), prLabels)
+	}
+
+	url, err := fix.OpenPullRequest(ctx, newPullRequestAPI(token), fix.PROptions{
+		Owner:  owner,
+		Repo:   repo,
+		Branch: branch,
+		Base:   fixDepsBase,
+		Title:  title,
+		Body:   fix.PRBody(updates, fixDepsRunTests),
+		Labels: prLabels,
+	})
+	if err != nil {
+		if url == "" {
+			return exitError(ExitTotalFailure, "stringer: %v", err)
+		}
+		slog.Warn("pull request opened without labels", "url", url, "error", err)
+	}
+	_, _ = fmt.Fprintf(out, "Opened pull request: %s\n", url)
+	return nil
+}
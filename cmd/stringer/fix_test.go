@@ -0,0 +1,289 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davetashner/stringer/internal/fix"
+	"github.com/davetashner/stringer/internal/testable"
+)
+
+const fixGoListOutput = `{"Path":"testrepo","Main":true} ` +
+	`{"Path":"github.com/a/direct","Version":"v1.0.0","Update":{"Path":"github.com/a/direct","Version":"v1.2.0"}}`
+
+func withFixExecutor(t *testing.T, m *testable.MockCommandExecutor) {
+	t.Helper()
+	fix.SetExecutor(m)
+	t.Cleanup(func() { fix.SetExecutor(nil) })
+}
+
+type fakePRAPI struct {
+	created *fix.NewPullRequest
+	labels  []string
+}
+
+func (f *fakePRAPI) DefaultBranch(context.Context, string, string) (string, error) {
+	return "main", nil
+}
+
+func (f *fakePRAPI) CreatePullRequest(_ context.Context, _, _ string, pr fix.NewPullRequest) (*fix.PullRequest, error) {
+	f.created = &pr
+	return &fix.PullRequest{Number: 7, URL: "https://github.com/o/r/pull/7"}, nil
+}
+
+func (f *fakePRAPI) EnsureLabel(context.Context, string, string, string) error { return nil }
+
+func (f *fakePRAPI) AddLabels(_ context.Context, _, _ string, _ int, labels []string) error {
+	f.labels = labels
+	return nil
+}
+
+func TestFixCmd_IsRegistered(t *testing.T) {
+	found := false
+	for _, cmd := range rootCmd.Commands() {
+		if cmd.Name() == "fix" {
+			found = true
+			break
+		}
+	}
+	assert.True(t, found, "fix command should be registered on rootCmd")
+
+	subs := map[string]bool{}
+	for _, cmd := range fixCmd.Commands() {
+		subs[cmd.Name()] = true
+	}
+	assert.True(t, subs["deps"], "deps subcommand should be registered")
+}
+
+func TestFixDeps_NoManifest(t *testing.T) {
+	resetFixFlags()
+	cmd, _, _ := newTestCmd()
+	cmd.SetArgs([]string{"fix", "deps", t.TempDir()})
+
+	err := cmd.Execute()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no supported manifest")
+}
+
+func TestFixDeps_DryRun(t *testing.T) {
+	resetFixFlags()
+	dir := initTestRepo(t)
+	m := &testable.MockCommandExecutor{
+		CommandOutputs: map[string]string{"go list -m -u -json all": fixGoListOutput},
+	}
+	withFixExecutor(t, m)
+
+	cmd, stdout, _ := newTestCmd()
+	cmd.SetArgs([]string{"fix", "deps", dir, "--dry-run"})
+	require.NoError(t, cmd.Execute())
+
+	assert.Contains(t, stdout.String(), "github.com/a/direct v1.0.0 -> v1.2.0")
+	assert.Contains(t, stdout.String(), "dry run")
+	assert.Equal(t, []string{"go list -m -u -json all"}, m.Calls)
+}
+
+func TestFixDeps_UpToDate(t *testing.T) {
+	resetFixFlags()
+	dir := initTestRepo(t)
+	withFixExecutor(t, &testable.MockCommandExecutor{
+		CommandOutputs: map[string]string{"go list -m -u -json all": `{"Path":"testrepo","Main":true}`},
+	})
+
+	cmd, stdout, _ := newTestCmd()
+	cmd.SetArgs([]string{"fix", "deps", dir})
+	require.NoError(t, cmd.Execute())
+	assert.Contains(t, stdout.String(), "up to date")
+}
+
+func TestFixDeps_UnknownDep(t *testing.T) {
+	resetFixFlags()
+	dir := initTestRepo(t)
+	withFixExecutor(t, &testable.MockCommandExecutor{
+		CommandOutputs: map[string]string{"go list -m -u -json all": fixGoListOutput},
+	})
+
+	cmd, _, _ := newTestCmd()
+	cmd.SetArgs([]string{"fix", "deps", dir, "--dep", "github.com/nope/nope"})
+	err := cmd.Execute()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "github.com/nope/nope")
+}
+
+func TestFixDeps_ApplyAndRunTests(t *testing.T) {
+	resetFixFlags()
+	dir := initTestRepo(t)
+	m := &testable.MockCommandExecutor{
+		CommandOutputs: map[string]string{"go list -m -u -json all": fixGoListOutput},
+	}
+	withFixExecutor(t, m)
+
+	cmd, stdout, _ := newTestCmd()
+	cmd.SetArgs([]string{"fix", "deps", dir, "--run-tests"})
+	require.NoError(t, cmd.Execute())
+
+	assert.Contains(t, stdout.String(), "Applied 1 update(s)")
+	assert.Contains(t, stdout.String(), "Build and tests passed")
+	assert.Contains(t, m.Calls, "go get github.com/a/direct@v1.2.0")
+	assert.Contains(t, m.Calls, "go test ./...")
+}
+
+func TestFixDeps_CreatePR_RequiresToken(t *testing.T) {
+	resetFixFlags()
+	t.Setenv("GITHUB_TOKEN", "")
+	dir := initTestRepo(t)
+
+	cmd, _, _ := newTestCmd()
+	cmd.SetArgs([]string{"fix", "deps", dir, "--create-pr"})
+	err := cmd.Execute()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "GITHUB_TOKEN")
+}
+
+func TestFixDeps_CreatePR(t *testing.T) {
+	resetFixFlags()
+	t.Setenv("GITHUB_TOKEN", "test-token")
+	dir := initTestRepo(t)
+	runGitCmd(t, dir, "remote", "add", "origin", "https://github.com/o/r.git")
+
+	m := &testable.MockCommandExecutor{
+		CommandOutputs: map[string]string{"go list -m -u -json all": fixGoListOutput},
+	}
+	withFixExecutor(t, m)
+
+	api := &fakePRAPI{}
+	orig := newPullRequestAPI
+	newPullRequestAPI = func(string) fix.PullRequestAPI { return api }
+	t.Cleanup(func() { newPullRequestAPI = orig })
+
+	cmd, stdout, _ := newTestCmd()
+	cmd.SetArgs([]string{"fix", "deps", dir, "--create-pr"})
+	require.NoError(t, cmd.Execute())
+
+	assert.Contains(t, stdout.String(), "https://github.com/o/r/pull/7")
+	assert.Contains(t, m.Calls, "git add -- go.mod")
+	assert.Contains(t, m.Calls, "git push --force-with-lease -u origin stringer/deps/github.com-a-direct-1.2.0")
+	require.NotNil(t, api.created)
+	assert.Equal(t, "main", api.created.Base)
+	assert.Equal(t, "Bump github.com/a/direct from v1.0.0 to v1.2.0", api.created.Title)
+	assert.Nil(t, api.labels, "no label rules configured")
+}
+
+func TestFixDeps_CreatePR_DirtyTree(t *testing.T) {
+	resetFixFlags()
+	t.Setenv("GITHUB_TOKEN", "test-token")
+	dir := initTestRepo(t)
+	runGitCmd(t, dir, "remote", "add", "origin", "https://github.com/o/r.git")
+
+	m := &testable.MockCommandExecutor{
+		CommandOutputs: map[string]string{"git status --porcelain --untracked-files=no": " M main.go"},
+	}
+	withFixExecutor(t, m)
+
+	cmd, _, _ := newTestCmd()
+	cmd.SetArgs([]string{"fix", "deps", dir, "--create-pr"})
+	err := cmd.Execute()
+	requireExitCode(t, err, ExitInvalidArgs)
+	assert.Contains(t, err.Error(), "uncommitted changes")
+	assert.NotContains(t, m.Calls, "go list -m -u -json all", "nothing is updated")
+}
+
+func TestFixDeps_CreatePR_Labels(t *testing.T) {
+	resetFixFlags()
+	t.Setenv("GITHUB_TOKEN", "test-token")
+	dir := initTestRepo(t)
+	runGitCmd(t, dir, "remote", "add", "origin", "https://github.com/o/r.git")
+	writeTestFile(t, dir, ".stringer.yaml", `labels:
+  - kinds: ["*-dependency"]
+    labels: [tech-debt]
+    github: [dependencies]
+  - tags: [go]
+    labels: [lang/go]
+  - collectors: [todos]
+    labels: [todo]
+`)
+	runGitCmd(t, dir, "add", "-A")
+	runGitCmd(t, dir, "commit", "-m", "config")
+
+	m := &testable.MockCommandExecutor{
+		CommandOutputs: map[string]string{"go list -m -u -json all": fixGoListOutput},
+	}
+	withFixExecutor(t, m)
+
+	api := &fakePRAPI{}
+	orig := newPullRequestAPI
+	newPullRequestAPI = func(string) fix.PullRequestAPI { return api }
+	t.Cleanup(func() { newPullRequestAPI = orig })
+
+	cmd, _, _ := newTestCmd()
+	cmd.SetArgs([]string{"fix", "deps", dir, "--create-pr"})
+	require.NoError(t, cmd.Execute())
+	assert.Equal(t, []string{"dependencies", "lang/go"}, api.labels)
+}
+
+func TestFix_List(t *testing.T) {
+	resetFixFlags()
+	cmd, stdout, _ := newTestCmd()
+	cmd.SetArgs([]string{"fix", "--list"})
+	require.NoError(t, cmd.Execute())
+
+	assert.Contains(t, stdout.String(), "resolved-todo")
+	assert.Contains(t, stdout.String(), "license-header")
+	assert.Contains(t, stdout.String(), "line-endings")
+	assert.Contains(t, stdout.String(), "generated-marker")
+}
+
+func TestFix_UnknownFixer(t *testing.T) {
+	resetFixFlags()
+	cmd, _, _ := newTestCmd()
+	cmd.SetArgs([]string{"fix", t.TempDir(), "--fixers", "bogus"})
+	err := cmd.Execute()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `unknown fixer "bogus"`)
+}
+
+func TestFix_DryRunThenApply(t *testing.T) {
+	resetFixFlags()
+	dir := t.TempDir()
+	header := "// Copyright 2026 Example\n// SPDX-License-Identifier: MIT\n\n"
+	writeTestFile(t, dir, "a.go", header+"package a\n")
+	writeTestFile(t, dir, "b.go", header+"package a\n")
+	writeTestFile(t, dir, "c.go", "package a\n")
+
+	cmd, stdout, _ := newTestCmd()
+	cmd.SetArgs([]string{"fix", dir, "--fixers", "license-header", "--dry-run"})
+	require.NoError(t, cmd.Execute())
+	assert.Contains(t, stdout.String(), "+++ b/c.go")
+	assert.Contains(t, stdout.String(), "1 file(s) would change (dry run)")
+	assert.Contains(t, stdout.String(), "c.go (+3 -0) [license-header]")
+
+	data, err := os.ReadFile(filepath.Join(dir, "c.go"))
+	require.NoError(t, err)
+	assert.Equal(t, "package a\n", string(data), "dry run must not write")
+
+	resetFixFlags()
+	cmd, stdout, _ = newTestCmd()
+	cmd.SetArgs([]string{"fix", dir, "--fixers", "license-header"})
+	require.NoError(t, cmd.Execute())
+	assert.Contains(t, stdout.String(), "Changed 1 file(s)")
+
+	data, err = os.ReadFile(filepath.Join(dir, "c.go"))
+	require.NoError(t, err)
+	assert.Equal(t, header+"package a\n", string(data))
+}
+
+func TestFix_NothingToFix(t *testing.T) {
+	resetFixFlags()
+	dir := initTestRepo(t)
+	cmd, stdout, _ := newTestCmd()
+	cmd.SetArgs([]string{"fix", dir, "--fixers", "line-endings"})
+	require.NoError(t, cmd.Execute())
+	assert.Contains(t, stdout.String(), "Nothing to fix.")
+}
@@ -0,0 +1,204 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/davetashner/stringer/internal/collector"
+	"github.com/davetashner/stringer/internal/lifecycle"
+	"github.com/davetashner/stringer/internal/output"
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+// History command flags.
+var (
+	historyBy    string
+	historySince string
+	historyJSON  bool
+)
+
+// historyCmd reports signal resolution from the lifecycle history.
+var historyCmd = &cobra.Command{
+	Use:   "history",
+	Short: "Show resolution rates and time-to-resolution per kind or module",
+	Long: `Every scan records when each signal first appeared and when it went
+away in .stringer/history.jsonl. This command summarizes that history:
+how many signals were opened, how many have been resolved, how many are
+still open, and the mean time from opening to resolution.
+
+A signal that a scan could not have found — because its collector did not
+run or failed, its workspace was not scanned, or its file was outside the
+scan's scope, as with --since-ref — is not marked resolved.
+A resolved signal that comes back is counted as opened again.`,
+	Args: cobra.NoArgs,
+	RunE: runHistory,
+}
+
+func init() {
+	historyCmd.Flags().StringVar(&historyBy, "by", "kind", "group by: kind, module, collector, or workspace")
+	historyCmd.Flags().StringVar(&historySince, "since", "", "only signals opened within this duration (e.g. 30d, 12w, 6m)")
+	historyCmd.Flags().BoolVar(&historyJSON, "json", false, "machine-readable JSON output")
+	rootCmd.AddCommand(historyCmd)
+}
+
+// historyGroupKeys maps --by values to the grouping of lifecycles.
+var historyGroupKeys = map[string]func(lifecycle.Lifecycle) string{
+	"kind":      func(l lifecycle.Lifecycle) string { return l.Kind },
+	"module":    func(l lifecycle.Lifecycle) string { return output.SignalModule(l.FilePath) },
+	"collector": func(l lifecycle.Lifecycle) string { return l.Collector },
+	"workspace": func(l lifecycle.Lifecycle) string { return l.Workspace },
+}
+
+func runHistory(cmd *cobra.Command, _ []string) error {
+	key, ok := historyGroupKeys[historyBy]
+	if !ok {
+		return exitError(ExitInvalidArgs, "stringer: invalid --by %q (use kind, module, collector, or workspace)", historyBy)
+	}
+	var since time.Time
+	if historySince != "" {
+		d, err := parseDuration(historySince)
+		if err != nil {
+			return exitError(ExitInvalidArgs, "stringer: invalid --since (%v)", err)
+		}
+		since = time.Now().Add(-d)
+	}
+
+	absPath, err := cmdFS.Abs(".")
+	if err != nil {
+		return exitError(ExitInvalidArgs, "stringer: cannot resolve path (%v)", err)
+	}
+	events, err := lifecycle.Load(absPath)
+	if err != nil {
+		return exitError(ExitTotalFailure, "stringer: failed to load signal history (%v)", err)
+	}
+
+	var lifecycles []lifecycle.Lifecycle
+	for _, lc := range lifecycle.Lifecycles(events) {
+		if !lc.At.Before(since) {
+			lifecycles = append(lifecycles, lc)
+		}
+	}
+	stats := lifecycle.Stats(lifecycles, key)
+
+	w := cmd.OutOrStdout()
+	if historyJSON {
+		data, err := json.MarshalIndent(stats, "", "  ")
+		if err != nil {
+			return exitError(ExitTotalFailure, "stringer: JSON marshal failed (%v)", err)
+		}
+		_, _ = fmt.Fprintln(w, string(data))
+		return nil
+	}
+
+	if len(stats) == 0 {
+		_, _ = fmt.Fprintln(w, "No signal history — run `stringer scan` to start recording it")
+		return nil
+	}
+	_, _ = fmt.Fprintf(w, "%-32s %8s %8s %8s %8s %10s\n", strings.ToUpper(historyBy[:1])+historyBy[1:], "Opened", "Resolved", "Open", "Rate", "MTTR")
+	_, _ = fmt.Fprintf(w, "%-32s %8s %8s %8s %8s %10s\n", "----", "------", "--------", "----", "----", "----")
+	for _, s := range stats {
+		mttr := "-"
+		if s.Resolved > 0 {
+			mttr = formatMTTR(s.MeanTimeToResolve)
+		}
+		_, _ = fmt.Fprintf(w, "%-32s %8d %8d %8d %7.0f%% %10s\n", s.Key, s.Opened, s.Resolved, s.Open, s.ResolutionRate*100, mttr)
+	}
+	return nil
+}
+
+// formatMTTR renders a mean time-to-resolution in days, or in hours when
+// it is under two days.
+func formatMTTR(d time.Duration) string {
+	if d < 48*time.Hour {
+		return fmt.Sprintf("%.1fh", d.Hours())
+	}
+	return fmt.Sprintf("%.1fd", d.Hours()/24)
+}
+
+// derivedSources maps signal sources that are not collectors to the
+// collector whose results they are derived from.
+var derivedSources = map[string]string{"hotspot": "gitlog"}
+
+// wsCoverage is what a scan of one workspace could have found: the
+// collectors that ran there without error, each with the scope it covered
+// (zero for the whole workspace).
+type wsCoverage struct {
+	rel    string
+	scopes map[string]signal.Scope
+}
+
+// recordCoverage notes the collectors that ran without error in ws under
+// cfg, for recordLifecycle.
+func (sc *scanContext) recordCoverage(ws workspaceEntry, cfg signal.ScanConfig, results []signal.CollectorResult) {
+	cov := wsCoverage{rel: ws.Rel, scopes: make(map[string]signal.Scope)}
+	for _, cr := range results {
+		if cr.Err != nil || cr.SkipReason != "" {
+			continue
+		}
+		scope := cfg.CollectorOpts[cr.Collector].Scope
+		if scope.IsZero() {
+			scope = cfg.Scope
+		}
+		cov.scopes[cr.Collector] = scope
+	}
+	if sc.covered == nil {
+		sc.covered = make(map[string]wsCoverage)
+	}
+	sc.covered[ws.Name] = cov
+}
+
+// recordLifecycle appends the signals the scan opened and resolved to
+// .stringer/history.jsonl. Signals a scoring profile or rule drops still
+// count as found. Open signals are only resolved when this scan could have
+// found them: their workspace was scanned, their collector (or, for
+// hotspots, gitlog) ran there without error, and their file is inside the
+// scope it covered, such as the files changed since --since-ref.
+func (sc *scanContext) recordLifecycle(absPath string) error {
+	events, err := lifecycle.Load(absPath)
+	if err != nil {
+		return err
+	}
+
+	inScope := func(e lifecycle.Event) bool {
+		cov, ok := sc.covered[e.Workspace]
+		if !ok {
+			return false
+		}
+		name := e.Collector
+		if src, ok := derivedSources[name]; ok {
+			name = src
+		} else if collector.Get(name) == nil {
+			return true
+		}
+		scope, ok := cov.scopes[name]
+		if !ok {
+			return false
+		}
+		if e.FilePath == "" || scope.IsZero() {
+			return true
+		}
+		rel, err := filepath.Rel(cov.rel, e.FilePath)
+		if err != nil {
+			return false
+		}
+		return scope.Contains(filepath.ToSlash(rel))
+	}
+
+	changes := lifecycle.Changes(events, sc.collected, inScope, time.Now().UTC())
+	if err := lifecycle.Append(absPath, changes...); err != nil {
+		return err
+	}
+	if len(changes) > 0 {
+		slog.Info("signal history updated", "events", len(changes))
+	}
+	return nil
+}
@@ -0,0 +1,146 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davetashner/stringer/internal/lifecycle"
+)
+
+func resetHistoryFlags() {
+	historyBy = "kind"
+	historySince = ""
+	historyJSON = false
+	historyCmd.Flags().VisitAll(func(f *pflag.Flag) {
+		_ = f.Value.Set(f.DefValue)
+		f.Changed = false
+	})
+}
+
+func countEvents(events []lifecycle.Event, typ lifecycle.EventType) int {
+	n := 0
+	for _, e := range events {
+		if e.Event == typ {
+			n++
+		}
+	}
+	return n
+}
+
+func TestScan_RecordsSignalLifecycle(t *testing.T) {
+	root := initTestRepo(t)
+
+	resetScanFlags()
+	cmd, _, _ := newTestCmd()
+	cmd.SetArgs([]string{"scan", root, "-c", "todos", "-q"})
+	require.NoError(t, cmd.Execute())
+
+	events, err := lifecycle.Load(root)
+	require.NoError(t, err)
+	opened := countEvents(events, lifecycle.Opened)
+	require.Positive(t, opened)
+	assert.Zero(t, countEvents(events, lifecycle.Resolved))
+
+	// Removing main.go resolves its signals; a gitlog-only scan
+	// resolves nothing, since it could not have found them.
+	require.NoError(t, os.Remove(filepath.Join(root, "main.go")))
+	resetScanFlags()
+	cmd, _, _ = newTestCmd()
+	cmd.SetArgs([]string{"scan", root, "-c", "gitlog", "-q"})
+	require.NoError(t, cmd.Execute())
+	events, err = lifecycle.Load(root)
+	require.NoError(t, err)
+	assert.Zero(t, countEvents(events, lifecycle.Resolved))
+
+	resetScanFlags()
+	cmd, _, _ = newTestCmd()
+	cmd.SetArgs([]string{"scan", root, "-c", "todos", "-q"})
+	require.NoError(t, cmd.Execute())
+	events, err = lifecycle.Load(root)
+	require.NoError(t, err)
+	assert.Positive(t, countEvents(events, lifecycle.Resolved))
+	for _, e := range events {
+		if e.Event == lifecycle.Resolved {
+			assert.Equal(t, "main.go", e.FilePath)
+		}
+	}
+}
+
+func TestScan_LifecycleKeepsSignalsOutsideScan(t *testing.T) {
+	root := initTestRepo(t)
+	scan := func(args ...string) []lifecycle.Event {
+		t.Helper()
+		resetScanFlags()
+		cmd, _, _ := newTestCmd()
+		cmd.SetArgs(append([]string{"scan", root, "-c", "todos", "-q"}, args...))
+		require.NoError(t, cmd.Execute())
+		events, err := lifecycle.Load(root)
+		require.NoError(t, err)
+		return events
+	}
+	require.Positive(t, countEvents(scan(), lifecycle.Opened))
+
+	events := scan("--since-ref", "HEAD~1")
+	assert.Zero(t, countEvents(events, lifecycle.Resolved), "files unchanged since the ref were not scanned")
+
+	writeTestFile(t, root, ".stringer.yaml", "rules:\n  - match: {kinds: [hack]}\n    drop: true\n")
+	events = scan()
+	assert.Zero(t, countEvents(events, lifecycle.Resolved), "a dropped signal was still found")
+}
+
+func TestHistoryCmd(t *testing.T) {
+	dir := t.TempDir()
+	chdirTest(t, dir)
+	now := time.Now().UTC()
+	at := func(days int) time.Time { return now.AddDate(0, 0, -days) }
+	require.NoError(t, lifecycle.Append(dir,
+		lifecycle.Event{SignalID: "str-00000001", Event: lifecycle.Opened, Kind: "todo", FilePath: "internal/api/a.go", At: at(10)},
+		lifecycle.Event{SignalID: "str-00000002", Event: lifecycle.Opened, Kind: "todo", FilePath: "main.go", At: at(10)},
+		lifecycle.Event{SignalID: "str-00000003", Event: lifecycle.Opened, Kind: "churn", FilePath: "internal/api/b.go", At: at(100)},
+		lifecycle.Event{SignalID: "str-00000001", Event: lifecycle.Resolved, Kind: "todo", At: at(6)},
+	))
+
+	resetHistoryFlags()
+	cmd, stdout, _ := newTestCmd()
+	cmd.SetArgs([]string{"history"})
+	require.NoError(t, cmd.Execute())
+	out := stdout.String()
+	assert.Contains(t, out, "Kind ")
+	assert.Regexp(t, `churn\s+1\s+0\s+1\s+0%\s+-`, out)
+	assert.Regexp(t, `todo\s+2\s+1\s+1\s+50%\s+4\.0d`, out)
+
+	resetHistoryFlags()
+	cmd, stdout, _ = newTestCmd()
+	cmd.SetArgs([]string{"history", "--by", "module", "--since", "30d", "--json"})
+	require.NoError(t, cmd.Execute())
+	var stats []lifecycle.GroupStats
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &stats))
+	require.Len(t, stats, 2, "the churn signal opened before --since")
+	assert.Equal(t, "(root)", stats[0].Key)
+	assert.Equal(t, "internal/api", stats[1].Key)
+	assert.Equal(t, 96.0, stats[1].MTTRHours)
+
+	resetHistoryFlags()
+	cmd, _, _ = newTestCmd()
+	cmd.SetArgs([]string{"history", "--by", "author"})
+	requireExitCode(t, cmd.Execute(), ExitInvalidArgs)
+}
+
+func TestHistoryCmd_Empty(t *testing.T) {
+	chdirTest(t, t.TempDir())
+	resetHistoryFlags()
+	cmd, stdout, _ := newTestCmd()
+	cmd.SetArgs([]string{"history"})
+	require.NoError(t, cmd.Execute())
+	assert.Contains(t, stdout.String(), "No signal history")
+}
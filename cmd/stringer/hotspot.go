@@ -0,0 +1,85 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"bytes"
+	"log/slog"
+	"path/filepath"
+	"strings"
+
+	"github.com/davetashner/stringer/internal/collectors"
+	"github.com/davetashner/stringer/internal/config"
+	"github.com/davetashner/stringer/internal/hotspot"
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+// hotspotSignals derives hotspot signals for one workspace from its scan:
+// churn from the gitlog collector's metrics, line counts from the files on
+// disk, and complexity from the complexity collector's metrics when it ran.
+// Without gitlog metrics there is no churn, so there are no hotspots.
+func hotspotSignals(result *signal.ScanResult, wsPath, gitRoot string, cfg *config.HotspotsConfig) []signal.RawSignal {
+	if cfg == nil {
+		cfg = &config.HotspotsConfig{}
+	}
+	if cfg.Enabled != nil && !*cfg.Enabled {
+		return nil
+	}
+	gm, ok := result.Metrics["gitlog"].(*collectors.GitlogMetrics)
+	if !ok || gm == nil {
+		return nil
+	}
+	hc := hotspot.Config{
+		MinChanges:    cfg.MinChanges,
+		MinLines:      cfg.MinLines,
+		MinComplexity: cfg.MinComplexity,
+		MinScore:      cfg.MinScore,
+		MaxSignals:    cfg.MaxSignals,
+	}
+	minChanges := hc.MinChanges
+	if minChanges <= 0 {
+		minChanges = hotspot.DefaultMinChanges
+	}
+
+	complexity := make(map[string]float64)
+	if cm, ok := result.Metrics["complexity"].(*collectors.ComplexityMetrics); ok && cm != nil {
+		for _, fn := range cm.Functions {
+			complexity[fn.FilePath] += fn.Score
+		}
+	}
+
+	// Churn paths are relative to the git root; the workspace's signals are
+	// relative to the workspace.
+	prefix := ""
+	if rel, err := filepath.Rel(gitRoot, wsPath); err == nil && rel != "." {
+		prefix = filepath.ToSlash(rel) + "/"
+	}
+
+	var files []hotspot.File
+	for _, fc := range gm.FileChurns {
+		if fc.ChangeCount < minChanges {
+			continue
+		}
+		path, ok := strings.CutPrefix(fc.Path, prefix)
+		if !ok {
+			continue
+		}
+		data, err := cmdFS.ReadFile(filepath.Join(wsPath, filepath.FromSlash(path)))
+		if err != nil {
+			continue // deleted or renamed since
+		}
+		files = append(files, hotspot.File{
+			Path:       path,
+			Changes:    fc.ChangeCount,
+			Lines:      bytes.Count(data, []byte("\n")),
+			Complexity: complexity[path],
+		})
+	}
+
+	sigs := hotspot.Signals(hotspot.Find(files, hc))
+	if len(sigs) > 0 {
+		slog.Info("hotspots found", "path", wsPath, "count", len(sigs))
+	}
+	return sigs
+}
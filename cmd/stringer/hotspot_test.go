@@ -0,0 +1,53 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davetashner/stringer/internal/collectors"
+	"github.com/davetashner/stringer/internal/config"
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+func TestHotspotSignals(t *testing.T) {
+	root := t.TempDir()
+	ws := filepath.Join(root, "svc")
+	require.NoError(t, os.MkdirAll(ws, 0o750))
+	writeTestFile(t, ws, "big.go", strings.Repeat("x\n", 300))
+	writeTestFile(t, ws, "small.go", "x\n")
+
+	result := &signal.ScanResult{Metrics: map[string]any{
+		"gitlog": &collectors.GitlogMetrics{FileChurns: []collectors.FileChurn{
+			{Path: "svc/big.go", ChangeCount: 7},
+			{Path: "svc/small.go", ChangeCount: 9},
+			{Path: "svc/deleted.go", ChangeCount: 9},
+			{Path: "other/big.go", ChangeCount: 50},
+		}},
+		"complexity": &collectors.ComplexityMetrics{Functions: []collectors.FunctionComplexity{
+			{FilePath: "small.go", Score: 6},
+			{FilePath: "small.go", Score: 5},
+		}},
+	}}
+
+	sigs := hotspotSignals(result, ws, root, nil)
+	require.Len(t, sigs, 2)
+	assert.Equal(t, "small.go", sigs[0].FilePath, "paths are relative to the workspace")
+	assert.Equal(t, "Hotspot: small.go (9 changes, 1 lines, complexity 11.0)", sigs[0].Title)
+	assert.Equal(t, "big.go", sigs[1].FilePath)
+
+	sigs = hotspotSignals(result, ws, root, &config.HotspotsConfig{MinChanges: 8})
+	require.Len(t, sigs, 1)
+	assert.Equal(t, "small.go", sigs[0].FilePath)
+
+	off := false
+	assert.Nil(t, hotspotSignals(result, ws, root, &config.HotspotsConfig{Enabled: &off}))
+	assert.Nil(t, hotspotSignals(&signal.ScanResult{}, ws, root, nil), "no churn without gitlog")
+}
@@ -0,0 +1,135 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"fmt"
+	"log/slog"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/davetashner/stringer/internal/blameindex"
+	"github.com/davetashner/stringer/internal/gitcli"
+)
+
+// Index command flags.
+var (
+	indexFull    bool
+	indexWorkers int
+)
+
+// indexCmd is the parent command for index subcommands.
+var indexCmd = &cobra.Command{
+	Use:   "index",
+	Short: "Manage the precomputed blame index",
+	Long: `Manage the precomputed line-ownership (blame) index.
+
+On very large repositories git blame dominates scan time. The index stores
+blame for every tracked text file at a given commit in
+.stringer/blame-index.json.gz; the todos and lotteryrisk collectors consult
+it automatically and fall back to live blame for files with uncommitted
+changes.`,
+}
+
+// indexBuildCmd builds or incrementally updates the blame index.
+var indexBuildCmd = &cobra.Command{
+	Use:   "build [path]",
+	Short: "Build or update the blame index at HEAD",
+	Long: `Blame every tracked text file at HEAD and persist the result.
+
+If an index already exists, only files changed since the indexed commit
+are re-blamed. Use --full to discard the existing index and rebuild.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runIndexBuild,
+}
+
+func init() {
+	indexBuildCmd.Flags().BoolVar(&indexFull, "full", false,
+		"rebuild from scratch instead of updating incrementally")
+	indexBuildCmd.Flags().IntVar(&indexWorkers, "workers", blameindex.DefaultWorkers,
+		"number of concurrent git blame processes")
+
+	indexCmd.AddCommand(indexBuildCmd)
+	rootCmd.AddCommand(indexCmd)
+}
+
+// resetIndexFlags resets index command flags for testing.
+func resetIndexFlags() {
+	indexFull = false
+	indexWorkers = blameindex.DefaultWorkers
+	indexBuildCmd.Flags().VisitAll(func(f *pflag.Flag) {
+		_ = f.Value.Set(f.DefValue)
+		f.Changed = false
+	})
+}
+
+func runIndexBuild(cmd *cobra.Command, args []string) error {
+	repoPath := "."
+	if len(args) > 0 {
+		repoPath = args[0]
+	}
+
+	_, gitRoot, err := resolveScanPath(repoPath)
+	if err != nil {
+		return err
+	}
+	if err := gitcli.Available(); err != nil {
+		return exitError(ExitInvalidArgs, "stringer: %v", err)
+	}
+	if indexWorkers < 1 {
+		return exitError(ExitInvalidArgs, "stringer: --workers must be at least 1")
+	}
+
+	ctx := cmd.Context()
+	out := cmd.OutOrStdout()
+	progress := func(msg string) { slog.Info(msg) }
+
+	var existing *blameindex.Index
+	if !indexFull {
+		existing, err = blameindex.Load(gitRoot)
+		if err != nil {
+			slog.Warn("ignoring unreadable blame index, rebuilding", "error", err)
+			existing = nil
+		}
+	}
+
+	var (
+		idx   *blameindex.Index
+		stats blameindex.Stats
+	)
+	if existing != nil {
+		stats, err = blameindex.Update(ctx, gitRoot, existing, indexWorkers, progress)
+		if err != nil {
+			slog.Warn("incremental update failed, rebuilding", "error", err)
+		} else {
+			idx = existing
+		}
+	}
+	if idx == nil {
+		idx, stats, err = blameindex.Build(ctx, gitRoot, indexWorkers, progress)
+		if err != nil {
+			return exitError(ExitTotalFailure, "stringer: index build failed (%v)", err)
+		}
+	}
+
+	if err := blameindex.Save(gitRoot, idx); err != nil {
+		return exitError(ExitTotalFailure, "stringer: %v", err)
+	}
+
+	commit := idx.Commit
+	if len(commit) > 12 {
+		commit = commit[:12]
+	}
+	switch {
+	case stats.Full:
+		_, _ = fmt.Fprintf(out, "Built blame index at %s: %d files\n", commit, len(idx.Files))
+	case stats.Files == 0 && stats.Removed == 0:
+		_, _ = fmt.Fprintf(out, "Blame index is up to date at %s (%d files)\n", commit, len(idx.Files))
+	default:
+		_, _ = fmt.Fprintf(out, "Updated blame index to %s: %d re-blamed, %d removed (%d files)\n",
+			commit, stats.Files, stats.Removed, len(idx.Files))
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davetashner/stringer/internal/blameindex"
+)
+
+func TestIndexCmd_IsRegistered(t *testing.T) {
+	found := false
+	for _, cmd := range rootCmd.Commands() {
+		if cmd.Name() == "index" {
+			found = true
+			break
+		}
+	}
+	assert.True(t, found, "index command should be registered on rootCmd")
+}
+
+func TestIndexBuild_FullThenIncremental(t *testing.T) {
+	resetIndexFlags()
+	dir := initTestRepo(t)
+
+	cmd, stdout, _ := newTestCmd()
+	cmd.SetArgs([]string{"index", "build", dir})
+	require.NoError(t, cmd.Execute())
+	assert.Contains(t, stdout.String(), "Built blame index at")
+
+	idx, err := blameindex.Load(dir)
+	require.NoError(t, err)
+	require.NotNil(t, idx)
+	assert.Contains(t, idx.Files, "main.go")
+
+	// Second run with no new commits is a no-op.
+	resetIndexFlags()
+	cmd, stdout, _ = newTestCmd()
+	cmd.SetArgs([]string{"index", "build", dir})
+	require.NoError(t, cmd.Execute())
+	assert.Contains(t, stdout.String(), "up to date")
+
+	// A new commit triggers an incremental update.
+	writeTestFile(t, dir, "new.go", "package main\n")
+	runGitCmd(t, dir, "add", "new.go")
+	runGitCmd(t, dir, "commit", "-m", "add new.go")
+
+	resetIndexFlags()
+	cmd, stdout, _ = newTestCmd()
+	cmd.SetArgs([]string{"index", "build", dir})
+	require.NoError(t, cmd.Execute())
+	assert.Contains(t, stdout.String(), "Updated blame index")
+	assert.Contains(t, stdout.String(), "1 re-blamed")
+
+	// --full rebuilds regardless.
+	resetIndexFlags()
+	cmd, stdout, _ = newTestCmd()
+	cmd.SetArgs([]string{"index", "build", dir, "--full"})
+	require.NoError(t, cmd.Execute())
+	assert.Contains(t, stdout.String(), "Built blame index at")
+}
+
+func TestIndexBuild_InvalidWorkers(t *testing.T) {
+	resetIndexFlags()
+	dir := initTestRepo(t)
+	cmd, _, _ := newTestCmd()
+	cmd.SetArgs([]string{"index", "build", dir, "--workers", "0"})
+	err := cmd.Execute()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "--workers")
+}
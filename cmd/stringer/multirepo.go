@@ -0,0 +1,192 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	"github.com/spf13/cobra"
+
+	"github.com/davetashner/stringer/internal/config"
+	"github.com/davetashner/stringer/internal/multirepo"
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+// defaultCloneDepth is how many commits a multi-repo scan clones when
+// --git-depth is not set: as many as the gitlog collector examines.
+const defaultCloneDepth = 1000
+
+// singleRepoFlags name the scan flags that refer to one repository's
+// files, refs, or state, and so cannot apply to a multi-repo scan.
+var singleRepoFlags = []string{
+	"delta", "since-ref", "baseline", "sarif-baseline", "split-by-workspace",
+	"paths", "workspace", "fail-fast", "quick", "profile", "coverage",
+}
+
+// checkMultiRepoFlags rejects a path argument and the flags a scan of
+// --repos-file or --github-org cannot honor.
+func checkMultiRepoFlags(cmd *cobra.Command, args []string) error {
+	if len(args) > 0 {
+		return exitError(ExitInvalidArgs, "stringer: --repos-file and --github-org choose the repositories to scan; do not also give a path")
+	}
+	for _, name := range singleRepoFlags {
+		if cmd.Flags().Changed(name) {
+			return exitError(ExitInvalidArgs, "stringer: --%s cannot be combined with --repos-file or --github-org", name)
+		}
+	}
+	return nil
+}
+
+// loadRepos returns the repositories of --repos-file followed by those of
+// --github-org, each once.
+func loadRepos(cmd *cobra.Command) ([]multirepo.Repo, error) {
+	var repos []multirepo.Repo
+	if scanReposFile != "" {
+		data, err := cmdFS.ReadFile(scanReposFile)
+		if err != nil {
+			return nil, exitError(ExitInvalidArgs, "stringer: cannot read --repos-file (%v)", err)
+		}
+		if repos, err = multirepo.ParseList(bytes.NewReader(data)); err != nil {
+			return nil, exitError(ExitInvalidArgs, "stringer: --repos-file %s: %v", scanReposFile, err)
+		}
+	}
+	if scanGitHubOrg != "" {
+		found, err := multirepo.DiscoverGitHubOrg(cmd.Context(), scanGitHubOrg)
+		if err != nil {
+			return nil, exitError(ExitTotalFailure, "stringer: cannot list the repositories of %s (%v)", scanGitHubOrg, err)
+		}
+		slog.Info("repositories discovered", "org", scanGitHubOrg, "count", len(found))
+		repos = multirepo.Merge(repos, found)
+	}
+	if len(repos) == 0 {
+		return nil, exitError(ExitInvalidArgs, "stringer: no repositories to scan")
+	}
+	return repos, nil
+}
+
+// scanRepos clones or updates each repository of --repos-file and
+// --github-org in the clone cache and scans it with its own .stringer.yaml,
+// as a scan of that repository alone would. Signals are stamped with their
+// repository and gathered into sc.result. A repository that cannot be
+// cloned or scanned is reported as a failed result, and the others are
+// still scanned.
+func (sc *scanContext) scanRepos() error {
+	repos, err := loadRepos(sc.cmd)
+	if err != nil {
+		return err
+	}
+	cacheDir := scanReposCache
+	if cacheDir == "" {
+		if cacheDir, err = multirepo.DefaultCacheDir(); err != nil {
+			return exitError(ExitInvalidArgs, "stringer: cannot find a cache directory for clones (%v); set --repos-cache", err)
+		}
+	}
+	depth := scanGitDepth
+	if depth == 0 {
+		depth = defaultCloneDepth
+	}
+
+	for i, repo := range repos {
+		slog.Info("scanning repository", "repo", repo.Name, "n", i+1, "of", len(repos))
+		rsc, err := sc.scanRepo(repo, cacheDir, depth)
+		if err != nil {
+			slog.Error("repository scan failed", "repo", repo.Name, "error", err)
+			category, ok := signal.CategoryOf(err)
+			if !ok {
+				category = signal.CategoryInternal
+			}
+			sc.result.Results = append(sc.result.Results, signal.CollectorResult{
+				Collector:   "repo",
+				Err:         fmt.Errorf("%s: %w", repo.Name, err),
+				ErrCategory: category,
+			})
+			continue
+		}
+
+		for _, cr := range rsc.result.Results {
+			cr.Signals = stampRepo(repo, cr.Signals)
+			if cr.Err != nil {
+				cr.Err = fmt.Errorf("%s: %w", repo.Name, cr.Err)
+			}
+			for i, w := range cr.Warnings {
+				cr.Warnings[i] = repo.Name + ": " + w
+			}
+			sc.result.Results = append(sc.result.Results, cr)
+		}
+		sc.result.Signals = append(sc.result.Signals, stampRepo(repo, rsc.result.Signals)...)
+		sc.result.Duration += rsc.result.Duration
+		sc.allSignals = append(sc.allSignals, stampRepo(repo, rsc.allSignals)...)
+		sc.violations = append(sc.violations, stampRepo(repo, rsc.violations)...)
+		sc.suppressedCount += rsc.suppressedCount
+		for _, name := range rsc.collectorNames {
+			if !slices.Contains(sc.collectorNames, name) {
+				sc.collectorNames = append(sc.collectorNames, name)
+			}
+		}
+		slog.Info("repository scanned", "repo", repo.Name, "signals", len(rsc.result.Signals))
+	}
+
+	delete(sc.result.Metrics, signal.ErrorsMetric)
+	if counts := sc.result.ErrorCounts(); len(counts) > 0 {
+		sc.result.Metrics[signal.ErrorsMetric] = counts
+	}
+	return nil
+}
+
+// scanRepo brings the clone of repo up to date and scans and analyzes it.
+// Unless --trust-repos is set, the repository's rule outputs and llm
+// settings are ignored; its plugins run only with --allow-plugins.
+func (sc *scanContext) scanRepo(repo multirepo.Repo, cacheDir string, depth int) (*scanContext, error) {
+	dir, err := multirepo.Sync(sc.cmd.Context(), repo, cacheDir, depth)
+	if err != nil {
+		return nil, signal.NewCollectorError(signal.CategoryNetwork, err)
+	}
+
+	rsc := &scanContext{
+		cmd:        sc.cmd,
+		absPath:    dir,
+		gitRoot:    dir,
+		workspaces: resolveWorkspaces(dir, scanNoWorkspaces, ""),
+		result:     &signal.ScanResult{Metrics: make(map[string]any)},
+		policy:     sc.policy,
+		expect:     sc.expect,
+	}
+	if rsc.scanCfg, rsc.fileCfg, err = loadScanConfig(sc.cmd, dir, dir, sc.policy); err != nil {
+		return nil, err
+	}
+	if !scanTrustRepos {
+		untrustConfig(rsc.fileCfg, repo.Name)
+	}
+	if rsc.scoring, err = rsc.loadScoringProfile(); err != nil {
+		return nil, err
+	}
+	if rsc.rules, err = config.Rules(rsc.fileCfg); err != nil {
+		return nil, err
+	}
+	if err := rsc.runPipeline(); err != nil {
+		return nil, err
+	}
+	if err := rsc.analyze(nil, nil); err != nil {
+		return nil, err
+	}
+	return rsc, nil
+}
+
+// stampRepo returns copies of signals that name the repository they came
+// from, with file paths prefixed by it so signals of different
+// repositories keep distinct IDs.
+func stampRepo(repo multirepo.Repo, signals []signal.RawSignal) []signal.RawSignal {
+	out := make([]signal.RawSignal, len(signals))
+	for i, sig := range signals {
+		sig.Repo = repo.Name
+		if sig.FilePath != "" {
+			sig.FilePath = repo.Name + "/" + sig.FilePath
+		}
+		out[i] = sig
+	}
+	return out
+}
@@ -0,0 +1,171 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davetashner/stringer/internal/config"
+	"github.com/davetashner/stringer/internal/multirepo"
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+func TestRunScan_ReposFile(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+	resetScanFlags()
+	root := t.TempDir()
+	for _, name := range []string{"api", "web"} {
+		dir := filepath.Join(root, "acme", name)
+		writeTestFile(t, dir, "main.go", "package main\n\n// TODO: Handle errors in "+name+"\nfunc main() {}\n")
+		runGitCmd(t, dir, "init")
+		runGitCmd(t, dir, "add", ".")
+		runGitCmd(t, dir, "-c", "user.name=Alice", "-c", "user.email=alice@test.com", "commit", "-m", "Initial commit")
+	}
+	list := filepath.Join(root, "repos.txt")
+	require.NoError(t, os.WriteFile(list, []byte("# nightly feed\n"+
+		filepath.Join(root, "acme", "api")+"\n"+
+		filepath.Join(root, "acme", "web")+"\n"), 0o600))
+
+	work := t.TempDir()
+	origDir, _ := os.Getwd()
+	require.NoError(t, os.Chdir(work))
+	t.Cleanup(func() { _ = os.Chdir(origDir) })
+
+	cmd, stdout, _ := newTestCmd()
+	cmd.SetArgs([]string{"scan", "--repos-file", list, "--repos-cache", filepath.Join(work, "cache"),
+		"--collectors=todos", "--format=json", "--quiet"})
+	require.NoError(t, cmd.Execute())
+
+	var out struct {
+		Signals []struct {
+			Repo     string `json:"repo"`
+			FilePath string `json:"FilePath"`
+			Title    string `json:"Title"`
+		} `json:"signals"`
+	}
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &out))
+	require.Len(t, out.Signals, 2)
+	for _, sig := range out.Signals {
+		assert.Contains(t, []string{"acme/api", "acme/web"}, sig.Repo)
+		assert.Equal(t, sig.Repo+"/main.go", sig.FilePath)
+		assert.True(t, strings.HasSuffix(sig.Title, strings.TrimPrefix(sig.Repo, "acme/")), sig.Title)
+	}
+	assert.DirExists(t, filepath.Join(work, "cache", "local", "acme", "api"))
+}
+
+func TestRunScan_ReposFileIgnoresRepoLLMAndOutputs(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+	resetScanFlags()
+	var hits atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		hits.Add(1)
+		http.Error(w, "no", http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	root := t.TempDir()
+	dir := filepath.Join(root, "acme", "api")
+	writeTestFile(t, dir, "main.go", "package main\n\n// TODO: Handle errors\nfunc main() {}\n")
+	writeTestFile(t, dir, ".stringer.yaml", "llm:\n  provider: ollama\n  enrich: true\n  base_url: "+srv.URL+
+		"\nrules:\n  - match:\n      kinds: [todo]\n    output: planted.json\n")
+	runGitCmd(t, dir, "init")
+	runGitCmd(t, dir, "add", ".")
+	runGitCmd(t, dir, "-c", "user.name=Alice", "-c", "user.email=alice@test.com", "commit", "-m", "Initial commit")
+	list := filepath.Join(root, "repos.txt")
+	require.NoError(t, os.WriteFile(list, []byte(dir+"\n"), 0o600))
+
+	cmd, stdout, _ := newTestCmd()
+	cmd.SetArgs([]string{"scan", "--repos-file", list, "--repos-cache", filepath.Join(root, "cache"),
+		"--collectors=todos", "--format=json", "--quiet"})
+	require.NoError(t, cmd.Execute())
+	assert.Zero(t, hits.Load(), "the repository's llm settings are ignored")
+	assert.Contains(t, stdout.String(), "TODO: Handle errors")
+
+	cfg := &config.Config{
+		LLM:   &config.LLMConfig{BaseURL: srv.URL},
+		Rules: []config.RuleConfig{{Output: "planted.json", AddTags: []string{"x"}}},
+	}
+	untrustConfig(cfg, "acme/api")
+	assert.Nil(t, cfg.LLM)
+	assert.Equal(t, []config.RuleConfig{{AddTags: []string{"x"}}}, cfg.Rules, "other rule actions still apply")
+}
+
+func TestRunScan_ReposFileUnreachableRepo(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+	resetScanFlags()
+	root := t.TempDir()
+	list := filepath.Join(root, "repos.txt")
+	require.NoError(t, os.WriteFile(list, []byte(filepath.Join(root, "acme", "gone")+"\n"), 0o600))
+
+	cmd, stdout, _ := newTestCmd()
+	cmd.SetArgs([]string{"scan", "--repos-file", list, "--repos-cache", filepath.Join(root, "cache"),
+		"--collectors=todos", "--format=json", "--quiet"})
+	requireExitCode(t, cmd.Execute(), ExitTotalFailure)
+
+	var out struct {
+		Metadata struct {
+			Errors []struct {
+				Collector string `json:"collector"`
+				Message   string `json:"message"`
+			} `json:"errors"`
+		} `json:"metadata"`
+	}
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &out))
+	require.Len(t, out.Metadata.Errors, 1)
+	assert.Equal(t, "repo", out.Metadata.Errors[0].Collector)
+	assert.Contains(t, out.Metadata.Errors[0].Message, "acme/gone")
+}
+
+func TestRunScan_ReposFileFlagConflicts(t *testing.T) {
+	list := filepath.Join(t.TempDir(), "repos.txt")
+	require.NoError(t, os.WriteFile(list, []byte("acme/api\n"), 0o600))
+
+	tests := []struct {
+		args []string
+		want string
+	}{
+		{[]string{"scan", ".", "--repos-file", list}, "do not also give a path"},
+		{[]string{"scan", "--repos-file", list, "--delta"}, "--delta cannot be combined"},
+		{[]string{"scan", "--github-org", "acme", "--paths", "src"}, "--paths cannot be combined"},
+		{[]string{"scan", ".", "--repos-cache", t.TempDir()}, "--repos-cache requires --repos-file or --github-org"},
+		{[]string{"scan", ".", "--trust-repos"}, "--trust-repos requires --repos-file or --github-org"},
+		{[]string{"scan", "--repos-file", filepath.Join(t.TempDir(), "missing.txt")}, "cannot read --repos-file"},
+	}
+	for _, tt := range tests {
+		resetScanFlags()
+		cmd, _, _ := newTestCmd()
+		cmd.SetArgs(tt.args)
+		err := cmd.Execute()
+		requireExitCode(t, err, ExitInvalidArgs)
+		assert.Contains(t, err.Error(), tt.want, strings.Join(tt.args, " "))
+	}
+}
+
+func TestStampRepo(t *testing.T) {
+	repo := multirepo.Repo{Name: "acme/api"}
+	in := []signal.RawSignal{{FilePath: "main.go", Title: "x"}, {Title: "repo-wide"}}
+
+	out := stampRepo(repo, in)
+	assert.Equal(t, "acme/api/main.go", out[0].FilePath)
+	assert.Equal(t, "acme/api", out[0].Repo)
+	assert.Empty(t, out[1].FilePath, "signals without a file stay without one")
+	assert.Equal(t, "main.go", in[0].FilePath, "the input is not modified")
+}
@@ -0,0 +1,77 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"os"
+
+	"github.com/davetashner/stringer/internal/baseline"
+	"github.com/davetashner/stringer/internal/config"
+	"github.com/davetashner/stringer/internal/policy"
+)
+
+// policyHTTPClient fetches org policies. Nil uses the policy package default.
+// Override in tests.
+var policyHTTPClient *http.Client
+
+// loadPolicy fetches and verifies the org policy named by --policy-url. It
+// returns nil when no policy URL is set. Any failure to load the policy is
+// fatal: a compliance check that silently falls back to local config would
+// defeat its purpose.
+func loadPolicy(ctx context.Context) (*policy.Policy, error) {
+	if scanPolicyURL == "" {
+		return nil, nil
+	}
+
+	keyText := scanPolicyKey
+	if keyText == "" {
+		keyText = os.Getenv(policy.KeyEnvVar)
+	}
+	if keyText == "" {
+		return nil, exitError(ExitInvalidArgs,
+			"stringer: --policy-url requires --policy-key or %s", policy.KeyEnvVar)
+	}
+	key, err := policy.ParsePublicKey(keyText)
+	if err != nil {
+		return nil, exitError(ExitInvalidArgs, "stringer: %v", err)
+	}
+
+	pol, err := policy.Fetch(ctx, policyHTTPClient, scanPolicyURL, key)
+	if err != nil {
+		return nil, exitError(ExitInvalidArgs, "stringer: failed to load policy (%v)", err)
+	}
+	slog.Info("org policy loaded", "url", scanPolicyURL,
+		"mandatory_collectors", len(pol.MandatoryCollectors), "budget", pol.Budget)
+	return pol, nil
+}
+
+// applyPolicy checks that the local setup keeps every mandatory collector
+// enabled and returns the local config with the policy config merged above
+// it. selected is the command-line collector list after exclusions. A nil
+// policy returns local unchanged.
+func applyPolicy(pol *policy.Policy, selected []string, local *config.Config) (*config.Config, error) {
+	if pol == nil {
+		return local, nil
+	}
+	if err := pol.CheckMandatory(selected, local); err != nil {
+		return nil, exitError(ExitInvalidArgs, "stringer: %v", err)
+	}
+	return mergeConfigs(local, &pol.Config), nil
+}
+
+// applyPolicySuppressions drops baseline suppressions whose reason the
+// policy does not allow.
+func applyPolicySuppressions(pol *policy.Policy, st *baseline.BaselineState) *baseline.BaselineState {
+	if pol == nil {
+		return st
+	}
+	filtered, dropped := pol.FilterSuppressions(st)
+	if dropped > 0 {
+		slog.Warn("ignoring baseline suppressions with reasons not allowed by policy", "count", dropped)
+	}
+	return filtered
+}
@@ -0,0 +1,156 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davetashner/stringer/internal/baseline"
+	"github.com/davetashner/stringer/internal/config"
+	"github.com/davetashner/stringer/internal/policy"
+)
+
+// servePolicy publishes doc, signed with a fresh key, and returns its URL
+// and the base64 public key.
+func servePolicy(t *testing.T, doc string) (url, key string) {
+	t.Helper()
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	sig := base64.StdEncoding.EncodeToString(ed25519.Sign(priv, []byte(doc)))
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/policy.yaml":
+			_, _ = w.Write([]byte(doc))
+		case "/policy.yaml" + policy.SignatureSuffix:
+			_, _ = w.Write([]byte(sig))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return srv.URL + "/policy.yaml", base64.StdEncoding.EncodeToString(pub)
+}
+
+func requireExitCode(t *testing.T, err error, code int) {
+	t.Helper()
+	var ece *exitCodeError
+	require.True(t, errors.As(err, &ece), "expected exitCodeError, got %v", err)
+	assert.Equal(t, code, ece.ExitCode())
+}
+
+func TestRunScan_PolicyMandatoryCollectorExcluded(t *testing.T) {
+	resetScanFlags()
+	url, key := servePolicy(t, "version: 1\nmandatory_collectors: [todos]\n")
+
+	cmd, _, _ := newTestCmd()
+	cmd.SetArgs([]string{"scan", fixtureDir(t), "--dry-run", "--quiet", "--collectors=gitlog",
+		"--policy-url=" + url, "--policy-key=" + key})
+
+	err := cmd.Execute()
+	require.Error(t, err)
+	requireExitCode(t, err, ExitInvalidArgs)
+	assert.Contains(t, err.Error(), "todos is mandatory but was not selected")
+}
+
+func TestRunScan_PolicyBudgetApplied(t *testing.T) {
+	resetScanFlags()
+	url, key := servePolicy(t, "version: 1\nmandatory_collectors: [todos]\nbudget: 1\n")
+
+	cmd, stdout, _ := newTestCmd()
+	cmd.SetArgs([]string{"scan", fixtureDir(t), "--format=pr-comment", "--quiet", "--collectors=todos",
+		"--policy-url=" + url, "--policy-key=" + key})
+
+	require.NoError(t, cmd.Execute())
+	assert.Contains(t, stdout.String(), "Over budget")
+}
+
+func TestRunScan_PolicyKeyFromEnv(t *testing.T) {
+	resetScanFlags()
+	url, key := servePolicy(t, "version: 1\n")
+	t.Setenv(policy.KeyEnvVar, key)
+
+	cmd, stdout, _ := newTestCmd()
+	cmd.SetArgs([]string{"scan", fixtureDir(t), "--dry-run", "--quiet", "--collectors=todos", "--policy-url=" + url})
+
+	require.NoError(t, cmd.Execute())
+	assert.Contains(t, stdout.String(), "signal(s) found")
+}
+
+func TestRunScan_PolicyErrors(t *testing.T) {
+	url, key := servePolicy(t, "version: 1\n")
+	otherURL, _ := servePolicy(t, "version: 1\n")
+
+	tests := []struct {
+		name string
+		args []string
+		want string
+	}{
+		{"missing key", []string{"--policy-url=" + url}, "--policy-url requires --policy-key"},
+		{"malformed key", []string{"--policy-url=" + url, "--policy-key=c2hvcnQ="}, "policy key must be 32 bytes"},
+		{"wrong key", []string{"--policy-url=" + otherURL, "--policy-key=" + key}, "signature verification failed"},
+		{"unreachable", []string{"--policy-url=" + strings.TrimSuffix(url, "/policy.yaml") + "/missing.yaml", "--policy-key=" + key}, "server returned 404"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resetScanFlags()
+			t.Setenv(policy.KeyEnvVar, "")
+			cmd, _, _ := newTestCmd()
+			cmd.SetArgs(append([]string{"scan", fixtureDir(t), "--dry-run", "--quiet", "--collectors=todos"}, tt.args...))
+
+			err := cmd.Execute()
+			require.Error(t, err)
+			requireExitCode(t, err, ExitInvalidArgs)
+			assert.Contains(t, err.Error(), tt.want)
+		})
+	}
+}
+
+func TestApplyPolicy(t *testing.T) {
+	disabled := false
+	local := &config.Config{
+		MaxIssues: 100,
+		Collectors: map[string]config.CollectorConfig{
+			"todos": {Enabled: &disabled},
+		},
+	}
+
+	got, err := applyPolicy(nil, nil, local)
+	require.NoError(t, err)
+	assert.Same(t, local, got)
+
+	_, err = applyPolicy(&policy.Policy{MandatoryCollectors: []string{"todos"}}, nil, local)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "todos is mandatory but disabled")
+
+	got, err = applyPolicy(&policy.Policy{Config: config.Config{MaxIssues: 20}}, nil, local)
+	require.NoError(t, err)
+	assert.Equal(t, 20, got.MaxIssues, "policy config wins over local")
+	assert.Contains(t, got.Collectors, "todos", "local settings not in the policy are kept")
+}
+
+func TestApplyPolicySuppressions(t *testing.T) {
+	st := &baseline.BaselineState{Suppressions: []baseline.Suppression{
+		{SignalID: "a", Reason: baseline.ReasonWontFix},
+		{SignalID: "b", Reason: baseline.ReasonFalsePositive},
+	}}
+
+	assert.Same(t, st, applyPolicySuppressions(nil, st))
+
+	got := applyPolicySuppressions(&policy.Policy{
+		AllowedSuppressionReasons: []baseline.Reason{baseline.ReasonFalsePositive},
+	}, st)
+	require.Len(t, got.Suppressions, 1)
+	assert.Equal(t, "b", got.Suppressions[0].SignalID)
+}
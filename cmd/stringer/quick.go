@@ -0,0 +1,105 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/davetashner/stringer/internal/collector"
+	"github.com/davetashner/stringer/internal/pipeline"
+	"github.com/davetashner/stringer/internal/policy"
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+// quickCollectors are the collectors scan --quick runs: they work locally
+// and their cost grows with the files in the tree, not with git history or
+// network round trips.
+var quickCollectors = []string{"architecture", "configdrift", "githygiene", "patterns", "todos"}
+
+// Caps applied to every collector by scan --quick.
+const (
+	defaultQuickBudget = 10 * time.Second
+	quickGitDepth      = 100
+	quickMaxFiles      = 2000
+	quickMaxFileSize   = 1 << 20 // 1 MiB
+	quickFileTimeout   = time.Second
+)
+
+// quickCollectorsFor returns the collectors scan --quick selects, plus any
+// the policy makes mandatory.
+func quickCollectorsFor(pol *policy.Policy) []string {
+	seen := make(map[string]bool)
+	var names []string
+	add := func(name string) {
+		if !seen[name] && collector.Get(name) != nil {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+	for _, name := range quickCollectors {
+		add(name)
+	}
+	if pol != nil {
+		for _, name := range pol.MandatoryCollectors {
+			add(name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// applyQuickCaps lowers git depth, file caps, and per-file limits on every
+// collector so each finishes quickly. Configured values that are already
+// lower are kept.
+func applyQuickCaps(cfg *signal.ScanConfig) {
+	ensureOpts(cfg)
+	for _, name := range collector.List() {
+		co := cfg.CollectorOpts[name]
+		co.GitDepth = capInt(co.GitDepth, quickGitDepth)
+		co.DuplicationMaxFiles = capInt(co.DuplicationMaxFiles, quickMaxFiles)
+		co.DeadcodeMaxFiles = capInt(co.DeadcodeMaxFiles, quickMaxFiles)
+		co.CouplingMaxFiles = capInt(co.CouplingMaxFiles, quickMaxFiles)
+		if co.MaxFileSize == 0 || co.MaxFileSize > quickMaxFileSize {
+			co.MaxFileSize = quickMaxFileSize
+		}
+		if co.FileTimeout == 0 || co.FileTimeout > quickFileTimeout {
+			co.FileTimeout = quickFileTimeout
+		}
+		cfg.CollectorOpts[name] = co
+	}
+}
+
+// capInt returns limit when v is unset (zero) or above it.
+func capInt(v, limit int) int {
+	if v == 0 || v > limit {
+		return limit
+	}
+	return v
+}
+
+// reportQuickSkips tells the user what a quick scan left out because its
+// time budget ran out. It writes nothing when the scan finished in time.
+func reportQuickSkips(w io.Writer, budget time.Duration, results []signal.CollectorResult, skippedWorkspaces []string) {
+	var skipped []string
+	for _, cr := range results {
+		if cr.SkipReason == pipeline.OverBudgetReason {
+			skipped = append(skipped, cr.Collector)
+		}
+	}
+	if len(skipped) == 0 && len(skippedWorkspaces) == 0 {
+		return
+	}
+	msg := fmt.Sprintf("stringer: quick scan hit its %s budget; results are partial", budget)
+	if len(skipped) > 0 {
+		msg += "; skipped collectors: " + strings.Join(skipped, ", ")
+	}
+	if len(skippedWorkspaces) > 0 {
+		msg += "; workspaces not scanned: " + strings.Join(skippedWorkspaces, ", ")
+	}
+	_, _ = fmt.Fprintln(w, msg)
+}
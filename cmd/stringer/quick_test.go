@@ -0,0 +1,94 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davetashner/stringer/internal/pipeline"
+	"github.com/davetashner/stringer/internal/policy"
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+func TestQuickCollectorsFor(t *testing.T) {
+	assert.Equal(t, quickCollectors, quickCollectorsFor(nil))
+
+	pol := &policy.Policy{MandatoryCollectors: []string{"gitlog", "todos"}}
+	assert.Equal(t, []string{"architecture", "configdrift", "githygiene", "gitlog", "patterns", "todos"}, quickCollectorsFor(pol),
+		"mandatory collectors still run")
+}
+
+func TestApplyQuickCaps(t *testing.T) {
+	cfg := signal.ScanConfig{CollectorOpts: map[string]signal.CollectorOpts{
+		"gitlog":      {GitDepth: 50},
+		"duplication": {DuplicationMaxFiles: 50000},
+		"todos":       {FileTimeout: 10 * time.Second},
+	}}
+	applyQuickCaps(&cfg)
+
+	assert.Equal(t, 50, cfg.CollectorOpts["gitlog"].GitDepth, "lower configured values are kept")
+	assert.Equal(t, quickGitDepth, cfg.CollectorOpts["lotteryrisk"].GitDepth)
+	assert.Equal(t, quickMaxFiles, cfg.CollectorOpts["duplication"].DuplicationMaxFiles)
+	assert.Equal(t, quickFileTimeout, cfg.CollectorOpts["todos"].FileTimeout)
+	assert.Equal(t, int64(quickMaxFileSize), cfg.CollectorOpts["patterns"].MaxFileSize)
+}
+
+func TestReportQuickSkips(t *testing.T) {
+	var buf bytes.Buffer
+	reportQuickSkips(&buf, 10*time.Second, []signal.CollectorResult{{Collector: "todos"}}, nil)
+	assert.Empty(t, buf.String(), "nothing to report when the scan finished in time")
+
+	reportQuickSkips(&buf, 10*time.Second, []signal.CollectorResult{
+		{Collector: "todos"},
+		{Collector: "patterns", SkipReason: pipeline.OverBudgetReason},
+		{Collector: "github", SkipReason: "no token"},
+	}, []string{"web"})
+	assert.Equal(t, "stringer: quick scan hit its 10s budget; results are partial; skipped collectors: patterns; workspaces not scanned: web\n", buf.String())
+}
+
+func TestRunScan_Quick(t *testing.T) {
+	resetScanFlags()
+	cmd, stdout, _ := newTestCmd()
+	cmd.SetArgs([]string{"scan", fixtureDir(t), "--quick", "--dry-run", "--json", "--quiet"})
+	require.NoError(t, cmd.Execute())
+
+	var out struct {
+		Collectors []struct {
+			Name string `json:"name"`
+		} `json:"collectors"`
+	}
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &out))
+	var names []string
+	for _, c := range out.Collectors {
+		names = append(names, c.Name)
+	}
+	assert.Equal(t, quickCollectors, names)
+}
+
+func TestRunScan_QuickBudgetExceeded(t *testing.T) {
+	resetScanFlags()
+	cmd, _, stderr := newTestCmd()
+	cmd.SetArgs([]string{"scan", fixtureDir(t), "--quick", "--quick-budget=1ns", "--dry-run"})
+	require.NoError(t, cmd.Execute(), "collectors cut off by the budget are not failures")
+	assert.Contains(t, stderr.String(), "stringer: quick scan hit its 1ns budget; results are partial")
+}
+
+func TestRunScan_QuickInvalidArgs(t *testing.T) {
+	resetScanFlags()
+	cmd, _, _ := newTestCmd()
+	cmd.SetArgs([]string{"scan", fixtureDir(t), "--quiet", "--quick-budget=5s"})
+	err := cmd.Execute()
+	requireExitCode(t, err, ExitInvalidArgs)
+	assert.Contains(t, err.Error(), "--quick-budget requires --quick")
+
+	resetScanFlags()
+	cmd.SetArgs([]string{"scan", fixtureDir(t), "--quiet", "--quick", "--quick-budget=0s"})
+	requireExitCode(t, cmd.Execute(), ExitInvalidArgs)
+}
@@ -0,0 +1,157 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/davetashner/stringer/internal/collector"
+	"github.com/davetashner/stringer/internal/reconcile"
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+// Reconcile command flags.
+var (
+	reconcileTracker    string
+	reconcileLabel      string
+	reconcileCollectors string
+	reconcileFormat     string
+	reconcileOutput     string
+)
+
+// newIssueLister builds the GitHub client used by reconcile --tracker github.
+// Replaced in tests.
+var newIssueLister = reconcile.NewGitHubLister
+
+// reconcileCmd compares a fresh scan with previously exported tracker items.
+var reconcileCmd = &cobra.Command{
+	Use:   "reconcile [path]",
+	Short: "Compare current signals with issues exported to a tracker",
+	Long: `Scan the repository and cross-reference the signals with the issues
+stringer exported to a tracker earlier. The report lists:
+
+  - open issues whose signal is still detected,
+  - open issues whose signal is gone (candidates to close), and
+  - signals that were never filed.
+
+Nothing in the tracker is changed.
+
+--tracker beads reads .beads/issues.jsonl and considers beads labeled
+stringer-generated or with a str- ID. --tracker github lists the
+repository's issues carrying --label (default stringer-generated) and
+requires GITHUB_TOKEN. Issues are matched to signals by the stringer ID
+(str-XXXXXXXX) in the bead ID or issue body, then by title. Closed issues
+count as filed.
+
+An open issue whose signal is gone but whose collector (a bead's label, or
+the collector an issue body records) failed, was skipped, or did not run
+is listed as not checked instead of as a close candidate.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runReconcile,
+}
+
+func init() {
+	reconcileCmd.Flags().StringVar(&reconcileTracker, "tracker", "beads", "tracker to reconcile against (beads, github)")
+	reconcileCmd.Flags().StringVar(&reconcileLabel, "label", reconcile.DefaultGitHubLabel,
+		"label that marks stringer's issues (github tracker)")
+	reconcileCmd.Flags().StringVarP(&reconcileCollectors, "collectors", "c", "",
+		"comma-separated list of collectors to run (default: all but github)")
+	reconcileCmd.Flags().StringVarP(&reconcileFormat, "format", "f", "", "output format (json)")
+	reconcileCmd.Flags().StringVarP(&reconcileOutput, "output", "o", "", "output file path (default: stdout)")
+
+	rootCmd.AddCommand(reconcileCmd)
+}
+
+// resetReconcileFlags resets reconcile command flags for testing.
+func resetReconcileFlags() {
+	reconcileTracker = "beads"
+	reconcileLabel = reconcile.DefaultGitHubLabel
+	reconcileCollectors = ""
+	reconcileFormat = ""
+	reconcileOutput = ""
+
+	reconcileCmd.Flags().VisitAll(func(f *pflag.Flag) {
+		_ = f.Value.Set(f.DefValue)
+		f.Changed = false
+	})
+}
+
+func runReconcile(cmd *cobra.Command, args []string) error {
+	if reconcileTracker != "beads" && reconcileTracker != "github" {
+		return exitError(ExitInvalidArgs, "stringer: unsupported --tracker %q (supported: beads, github)", reconcileTracker)
+	}
+	if reconcileFormat != "" && reconcileFormat != "json" {
+		return exitError(ExitInvalidArgs, "stringer: unsupported format %q (supported: json)", reconcileFormat)
+	}
+
+	repoPath := "."
+	if len(args) > 0 {
+		repoPath = args[0]
+	}
+	absPath, gitRoot, err := resolveScanPath(repoPath)
+	if err != nil {
+		return err
+	}
+
+	// Load the tracker first so a missing token fails before the scan.
+	var items []reconcile.Item
+	switch reconcileTracker {
+	case "beads":
+		items, err = reconcile.BeadsItems(absPath)
+		if err != nil {
+			return exitError(ExitInvalidArgs, "stringer: cannot read beads (%v)", err)
+		}
+	case "github":
+		lister, listerErr := newIssueLister(absPath)
+		if listerErr != nil {
+			return exitError(ExitInvalidArgs, "stringer: --tracker github requires GITHUB_TOKEN and a GitHub origin remote (%v)", listerErr)
+		}
+		items, err = lister.ListIssues(cmd.Context(), reconcileLabel)
+		if err != nil {
+			return exitError(ExitTotalFailure, "stringer: cannot list GitHub issues (%v)", err)
+		}
+	}
+
+	names := splitCollectors(reconcileCollectors)
+	if len(names) == 0 {
+		// The github collector imports the tracker itself; its signals
+		// would only match their own issues.
+		for _, name := range collector.List() {
+			if name != "github" {
+				names = append(names, name)
+			}
+		}
+	}
+	scan, err := runConfiguredScan(cmd.Context(), gitRoot, signal.ScanConfig{RepoPath: absPath, Collectors: names})
+	if err != nil {
+		return err
+	}
+	var signals []signal.RawSignal
+	for _, s := range scan.Signals {
+		if s.Source != "github" {
+			signals = append(signals, s)
+		}
+	}
+
+	rep := reconcile.Build(reconcileTracker, signals, items, collectorsRanOK(scan.Results))
+
+	w := cmd.OutOrStdout()
+	if reconcileOutput != "" {
+		f, createErr := cmdFS.Create(reconcileOutput)
+		if createErr != nil {
+			return exitError(ExitInvalidArgs, "stringer: cannot create output file %q (%v)", reconcileOutput, createErr)
+		}
+		defer f.Close() //nolint:errcheck // best-effort close on output file
+		w = f
+	}
+	render := reconcile.Render
+	if reconcileFormat == "json" {
+		render = reconcile.RenderJSON
+	}
+	if err := render(rep, w); err != nil {
+		return exitError(ExitTotalFailure, "stringer: rendering failed (%v)", err)
+	}
+	return nil
+}
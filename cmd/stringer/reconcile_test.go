@@ -0,0 +1,100 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davetashner/stringer/internal/reconcile"
+)
+
+type fakeIssueLister struct {
+	label  string
+	issues []reconcile.Item
+}
+
+func (f *fakeIssueLister) ListIssues(_ context.Context, label string) ([]reconcile.Item, error) {
+	f.label = label
+	return f.issues, nil
+}
+
+func TestReconcileCmd_Beads(t *testing.T) {
+	resetReconcileFlags()
+	root := initTestRepo(t)
+	writeTestFile(t, root, ".beads/issues.jsonl",
+		`{"id":"str-0a1b2c3d","title":"TODO: Add proper CLI argument parsing","status":"open","labels":["stringer-generated"]}
+{"id":"str-00000000","title":"TODO: Remove the legacy flag","status":"open"}
+{"id":"str-11111111","title":"FIXME: This will panic on nil input","status":"closed"}
+{"id":"app-1","title":"Write release notes","status":"open"}
+{"id":"str-22222222","title":"Dependency is unmaintained","status":"open","labels":["stringer-generated","dephealth"]}
+`)
+
+	cmd, stdout, _ := newTestCmd()
+	cmd.SetArgs([]string{"reconcile", root, "-c", "todos", "--format", "json"})
+	require.NoError(t, cmd.Execute())
+
+	var rep reconcile.Report
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &rep))
+	assert.Equal(t, "beads", rep.Tracker)
+	require.Len(t, rep.StillOpen, 1)
+	assert.Equal(t, "str-0a1b2c3d", rep.StillOpen[0].Item.ID)
+	require.Len(t, rep.CloseCandidates, 1, "hand-filed beads are never close candidates")
+	assert.Equal(t, "str-00000000", rep.CloseCandidates[0].ID)
+	require.Len(t, rep.Unchecked, 1, "dephealth did not run, so its beads are not close candidates")
+	assert.Equal(t, "str-22222222", rep.Unchecked[0].ID)
+	assert.Equal(t, 1, rep.Closed)
+	for _, f := range rep.Unfiled {
+		assert.NotContains(t, f.Title, "panic on nil input", "closed items count as filed")
+	}
+	assert.NotEmpty(t, rep.Unfiled)
+}
+
+func TestReconcileCmd_GitHub(t *testing.T) {
+	resetReconcileFlags()
+	root := initTestRepo(t)
+	lister := &fakeIssueLister{issues: []reconcile.Item{
+		{ID: "#3", Title: "Add proper CLI argument parsing", Status: "open"},
+		{ID: "#4", Title: "Drop Python 2 support", Status: "open"},
+	}}
+	orig := newIssueLister
+	newIssueLister = func(string) (reconcile.IssueLister, error) { return lister, nil }
+	t.Cleanup(func() { newIssueLister = orig })
+
+	cmd, stdout, _ := newTestCmd()
+	cmd.SetArgs([]string{"reconcile", root, "-c", "todos", "--tracker", "github", "--label", "debt"})
+	require.NoError(t, cmd.Execute())
+
+	assert.Equal(t, "debt", lister.label)
+	out := stdout.String()
+	assert.Contains(t, out, "Tracker Reconciliation (github)")
+	assert.Contains(t, out, "  1 open and still detected\n")
+	assert.Contains(t, out, "  Close candidates:\n    #4  Drop Python 2 support\n")
+	assert.Contains(t, out, "  Detected but never filed:\n")
+}
+
+func TestReconcileCmd_InvalidArgs(t *testing.T) {
+	resetReconcileFlags()
+	cmd, _, _ := newTestCmd()
+	cmd.SetArgs([]string{"reconcile", fixtureDir(t), "--tracker", "jira"})
+	requireExitCode(t, cmd.Execute(), ExitInvalidArgs)
+
+	resetReconcileFlags()
+	cmd.SetArgs([]string{"reconcile", fixtureDir(t), "--format", "markdown"})
+	requireExitCode(t, cmd.Execute(), ExitInvalidArgs)
+
+	resetReconcileFlags()
+	orig := newIssueLister
+	newIssueLister = func(string) (reconcile.IssueLister, error) { return nil, errors.New("GITHUB_TOKEN is not set") }
+	t.Cleanup(func() { newIssueLister = orig })
+	cmd.SetArgs([]string{"reconcile", fixtureDir(t), "--tracker", "github"})
+	err := cmd.Execute()
+	requireExitCode(t, err, ExitInvalidArgs)
+	assert.Contains(t, err.Error(), "GITHUB_TOKEN")
+}
@@ -0,0 +1,79 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/davetashner/stringer/internal/remote"
+)
+
+// remoteDownload unpacks a repository's tarball. Tests replace it to serve
+// a tarball without GitHub.
+var remoteDownload = remote.Download
+
+// remoteConflicts name the scan flags a --remote scan cannot honor: the
+// tarball has no git history or saved state, and it is the only repository
+// scanned.
+var remoteConflicts = []string{"delta", "since-ref", "repos-file", "github-org", "repos-cache", "checkpoint", "resume"}
+
+// checkRemoteFlags rejects a path argument and the flags --remote cannot
+// be combined with.
+func checkRemoteFlags(cmd *cobra.Command, args []string) error {
+	if len(args) > 0 {
+		return exitError(ExitInvalidArgs, "stringer: --remote chooses the repository to scan; do not also give a path")
+	}
+	for _, name := range remoteConflicts {
+		if cmd.Flags().Changed(name) {
+			return exitError(ExitInvalidArgs, "stringer: --%s cannot be combined with --remote", name)
+		}
+	}
+	return nil
+}
+
+// fetchRemote unpacks the tarball of the --remote repository into a
+// temporary directory named after it, and returns the directory and a
+// function that removes it.
+func fetchRemote(ctx context.Context, s string) (string, func(), error) {
+	spec, err := remote.ParseSpec(s)
+	if err != nil {
+		return "", nil, exitError(ExitInvalidArgs, "stringer: --remote: %v", err)
+	}
+	tmp, err := os.MkdirTemp("", "stringer-remote-")
+	if err != nil {
+		return "", nil, exitError(ExitTotalFailure, "stringer: cannot create a directory for %s (%v)", spec, err)
+	}
+	cleanup := func() {
+		if err := os.RemoveAll(tmp); err != nil {
+			slog.Warn("failed to remove downloaded repository", "dir", tmp, "error", err)
+		}
+	}
+
+	dir := filepath.Join(tmp, spec.Repo)
+	if err := os.Mkdir(dir, 0o750); err != nil {
+		cleanup()
+		return "", nil, exitError(ExitTotalFailure, "stringer: cannot create a directory for %s (%v)", spec, err)
+	}
+	slog.Info("downloading repository", "repo", spec.String())
+	if err := remoteDownload(ctx, spec, dir); err != nil {
+		cleanup()
+		return "", nil, exitError(ExitTotalFailure, "stringer: cannot download %s (%v)", spec, err)
+	}
+	return dir, cleanup, nil
+}
+
+// remoteGitHubRepo returns the owner/repo of a --remote value, or "" for
+// none.
+func remoteGitHubRepo(s string) string {
+	spec, err := remote.ParseSpec(s)
+	if err != nil {
+		return ""
+	}
+	return spec.Owner + "/" + spec.Repo
+}
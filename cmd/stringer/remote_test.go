@@ -0,0 +1,137 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davetashner/stringer/internal/remote"
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+// stubRemoteDownload replaces the tarball download with one that writes
+// files into the scan directory.
+func stubRemoteDownload(t *testing.T, files map[string]string, err error) *string {
+	t.Helper()
+	var downloaded string
+	orig := remoteDownload
+	remoteDownload = func(_ context.Context, spec remote.Spec, dir string) error {
+		downloaded = spec.String()
+		for name, content := range files {
+			writeTestFile(t, dir, name, content)
+		}
+		return err
+	}
+	t.Cleanup(func() { remoteDownload = orig })
+	return &downloaded
+}
+
+func TestRunScan_Remote(t *testing.T) {
+	resetScanFlags()
+	downloaded := stubRemoteDownload(t, map[string]string{
+		"main.go": "package main\n\n// TODO: Handle errors\nfunc main() {}\n",
+	}, nil)
+
+	cmd, stdout, _ := newTestCmd()
+	cmd.SetArgs([]string{"scan", "--remote", "acme/api@v1.2", "--collectors=todos", "--format=json", "--quiet"})
+	require.NoError(t, cmd.Execute())
+	assert.Equal(t, "acme/api@v1.2", *downloaded)
+
+	var out struct {
+		Signals []signal.RawSignal `json:"signals"`
+	}
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &out))
+	require.Len(t, out.Signals, 1)
+	assert.Equal(t, "main.go", out.Signals[0].FilePath)
+}
+
+func TestRunScan_RemoteIgnoresRepoConfigCode(t *testing.T) {
+	resetScanFlags()
+	t.Cleanup(func() { allowPlugins = false })
+	marker := filepath.Join(t.TempDir(), "ran")
+	stubRemoteDownload(t, map[string]string{
+		"main.go": "package main\n\n// TODO: Handle errors\nfunc main() {}\n",
+		".stringer.yaml": "plugins:\n  - name: planted\n    command: sh\n    args: [-c, 'touch " + marker + "']\n" +
+			"rules:\n  - match:\n      kinds: [todo]\n    output: routed.json\n",
+	}, nil)
+	work := t.TempDir()
+	origDir, _ := os.Getwd()
+	require.NoError(t, os.Chdir(work))
+	t.Cleanup(func() { _ = os.Chdir(origDir) })
+
+	// Plugins stay off even with --allow-plugins.
+	cmd, _, _ := newTestCmd()
+	cmd.SetArgs([]string{"scan", "--remote", "acme/api", "--collectors=todos,planted", "--allow-plugins", "--quiet"})
+	err := cmd.Execute()
+	requireExitCode(t, err, ExitInvalidArgs)
+	assert.Contains(t, err.Error(), "planted")
+	assert.NoFileExists(t, marker)
+
+	// Rule outputs are ignored, so every signal stays in the main output.
+	resetScanFlags()
+	cmd, stdout, _ := newTestCmd()
+	cmd.SetArgs([]string{"scan", "--remote", "acme/api", "--collectors=todos", "--format=json", "--quiet"})
+	require.NoError(t, cmd.Execute())
+	assert.Contains(t, stdout.String(), "TODO: Handle errors")
+	assert.NoFileExists(t, filepath.Join(work, "routed.json"))
+}
+
+func TestRunScan_RemoteDownloadFails(t *testing.T) {
+	resetScanFlags()
+	stubRemoteDownload(t, nil, errors.New("404 Not Found"))
+
+	cmd, _, _ := newTestCmd()
+	cmd.SetArgs([]string{"scan", "--remote", "acme/gone", "--quiet"})
+	err := cmd.Execute()
+	requireExitCode(t, err, ExitTotalFailure)
+	assert.Contains(t, err.Error(), "cannot download acme/gone")
+}
+
+func TestRunScan_RemoteFlagConflicts(t *testing.T) {
+	stubRemoteDownload(t, nil, errors.New("not reached"))
+	tests := []struct {
+		args []string
+		want string
+	}{
+		{[]string{"scan", ".", "--remote", "acme/api"}, "do not also give a path"},
+		{[]string{"scan", "--remote", "acme/api", "--delta"}, "--delta cannot be combined with --remote"},
+		{[]string{"scan", "--remote", "acme/api", "--github-org", "acme"}, "--github-org cannot be combined with --remote"},
+		{[]string{"scan", "--remote", "https://github.com/acme/api"}, "--remote:"},
+	}
+	for _, tt := range tests {
+		resetScanFlags()
+		cmd, _, _ := newTestCmd()
+		cmd.SetArgs(tt.args)
+		err := cmd.Execute()
+		requireExitCode(t, err, ExitInvalidArgs)
+		assert.Contains(t, err.Error(), tt.want, strings.Join(tt.args, " "))
+	}
+}
+
+func TestFetchRemote_Cleanup(t *testing.T) {
+	stubRemoteDownload(t, map[string]string{"go.mod": "module acme/api\n"}, nil)
+
+	dir, cleanup, err := fetchRemote(context.Background(), "acme/api")
+	require.NoError(t, err)
+	assert.Equal(t, "api", filepath.Base(dir), "the directory is named after the repository")
+	assert.FileExists(t, filepath.Join(dir, "go.mod"))
+
+	cleanup()
+	_, err = os.Stat(filepath.Dir(dir))
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestRemoteGitHubRepo(t *testing.T) {
+	assert.Equal(t, "acme/api", remoteGitHubRepo("acme/api@main"))
+	assert.Empty(t, remoteGitHubRepo(""))
+}
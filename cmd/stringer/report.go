@@ -15,12 +15,14 @@ import (
 	"github.com/fatih/color"
 	"github.com/spf13/cobra"
 
+	"github.com/davetashner/stringer/internal/baseline"
 	"github.com/davetashner/stringer/internal/collector"
-	_ "github.com/davetashner/stringer/internal/collectors"
+	"github.com/davetashner/stringer/internal/collectors"
 	"github.com/davetashner/stringer/internal/config"
 	"github.com/davetashner/stringer/internal/output"
 	"github.com/davetashner/stringer/internal/pipeline"
 	"github.com/davetashner/stringer/internal/report"
+	"github.com/davetashner/stringer/internal/scancache"
 	"github.com/davetashner/stringer/internal/signal"
 )
 
@@ -36,9 +38,21 @@ var (
 	reportExcludeCollectors string
 	reportCollectorTimeout  string
 	reportPaths             []string
+	reportMaxDepth          int
 	reportNoLLM             bool
 	reportWorkspace         string
 	reportNoWorkspaces      bool
+	reportNoCache           bool
+	reportNoGitignore       bool
+	reportJobs              int
+
+	reportResolvedSince  string
+	reportResolvedFormat string
+	reportResolvedOutput string
+
+	reportNoiseCollectors string
+	reportNoiseFormat     string
+	reportNoiseOutput     string
 )
 
 // reportCmd is the subcommand for generating a repository health report.
@@ -53,7 +67,53 @@ For machine-readable output to import into an issue tracker, use 'stringer scan'
 	RunE: runReport,
 }
 
+// reportResolvedCmd lists debt paid down over a period.
+var reportResolvedCmd = &cobra.Command{
+	Use:   "resolved [path]",
+	Short: "List debt resolved since a git ref or date",
+	Long: `List debt paid down since a git ref, date (2006-01-02), or duration
+(e.g. 14d, 2w): TODO comments removed, test files added, and dependencies
+updated, taken from the git diff between that point and HEAD. When scan
+history exists (.stringer/scan-history.json), signal kinds whose count fell
+over the period are listed too.
+
+Useful as a "debt paid down" section for sprint reviews.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runReportResolved,
+}
+
+// reportNoiseCmd ranks kinds and collectors by how often they are dismissed.
+var reportNoiseCmd = &cobra.Command{
+	Use:   "noise [path]",
+	Short: "Rank signal kinds and collectors by suppression rate",
+	Long: `Rank signal kinds and collectors by how often their signals are dismissed.
+
+Runs a scan and matches the signals against the suppressions in
+.stringer/baseline.json. Signals suppressed as false-positive or won't-fix
+count as noise; acknowledged signals are accepted work and are shown
+separately. For each kind and collector the report lists the noise rate,
+the number of unsuppressed low-confidence (P4) signals, and the median
+days from a signal's timestamp to its dismissal.
+
+Where a collector's dismissals follow a pattern, a .stringer.yaml change is
+recommended: a min_confidence above every dismissed signal, an
+exclude_patterns entry for a directory that is almost entirely dismissed,
+or disabling the collector outright.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runReportNoise,
+}
+
 func init() {
+	reportNoiseCmd.Flags().StringVarP(&reportNoiseCollectors, "collectors", "c", "", "comma-separated list of collectors to run")
+	reportNoiseCmd.Flags().StringVarP(&reportNoiseFormat, "format", "f", "", "output format (json)")
+	reportNoiseCmd.Flags().StringVarP(&reportNoiseOutput, "output", "o", "", "output file path (default: stdout)")
+	reportCmd.AddCommand(reportNoiseCmd)
+
+	reportResolvedCmd.Flags().StringVar(&reportResolvedSince, "since", "", "git ref, date (2006-01-02), or duration (e.g. 14d, 2w) to start from")
+	reportResolvedCmd.Flags().StringVarP(&reportResolvedFormat, "format", "f", "", "output format (json)")
+	reportResolvedCmd.Flags().StringVarP(&reportResolvedOutput, "output", "o", "", "output file path (default: stdout)")
+	reportCmd.AddCommand(reportResolvedCmd)
+
 	reportCmd.Flags().StringVarP(&reportCollectors, "collectors", "c", "", "comma-separated list of collectors to run")
 	reportCmd.Flags().StringVar(&reportSections, "sections", "", "comma-separated list of report sections to include")
 	reportCmd.Flags().StringVarP(&reportOutput, "output", "o", "", "output file path (default: stdout)")
@@ -64,9 +124,13 @@ func init() {
 	reportCmd.Flags().StringVarP(&reportExcludeCollectors, "exclude-collectors", "x", "", "comma-separated list of collectors to skip")
 	reportCmd.Flags().StringVar(&reportCollectorTimeout, "collector-timeout", "", "per-collector timeout (e.g. 60s, 2m); 0 or empty = no timeout")
 	reportCmd.Flags().StringSliceVar(&reportPaths, "paths", nil, "restrict scanning to specific files or directories (comma-separated)")
+	reportCmd.Flags().IntVar(&reportMaxDepth, "max-depth", 0, "max directory levels to scan below the root or each --paths entry (0 = unlimited)")
 	reportCmd.Flags().BoolVar(&reportNoLLM, "no-llm", false, "skip LLM clustering pass (noop for MVP)")
 	reportCmd.Flags().StringVar(&reportWorkspace, "workspace", "", "report only named workspace(s) (comma-separated)")
 	reportCmd.Flags().BoolVar(&reportNoWorkspaces, "no-workspaces", false, "disable monorepo auto-detection, scan root as single directory")
+	reportCmd.Flags().IntVarP(&reportJobs, "jobs", "j", 0, "maximum collectors to run at once (0 = all at once)")
+	reportCmd.Flags().BoolVar(&reportNoCache, "no-cache", false, "read every file again instead of replaying unchanged files from .stringer/"+scancache.FileName)
+	reportCmd.Flags().BoolVar(&reportNoGitignore, "no-gitignore", false, "also scan files listed in .gitignore and .git/info/exclude")
 }
 
 func runReport(cmd *cobra.Command, args []string) error {
@@ -74,6 +138,12 @@ func runReport(cmd *cobra.Command, args []string) error {
 	if reportFormat != "" && reportFormat != "json" && reportFormat != "html-dir" {
 		return fmt.Errorf("stringer: unsupported report format %q (supported: json, html-dir)", reportFormat)
 	}
+	if reportMaxDepth < 0 {
+		return fmt.Errorf("stringer: --max-depth must be non-negative (got %d)", reportMaxDepth)
+	}
+	if reportJobs < 0 {
+		return fmt.Errorf("stringer: --jobs must be non-negative (got %d)", reportJobs)
+	}
 
 	// 1. Parse path argument.
 	repoPath := "."
@@ -121,6 +191,7 @@ func runReport(cmd *cobra.Command, args []string) error {
 		result         = &signal.ScanResult{Metrics: make(map[string]any)}
 		collectorNames []string
 	)
+	ctx := collectors.WithFileTrees(collectors.WithGitHubData(cmd.Context(), collectors.NewGitHubData(0)), collectors.NewFileTrees())
 	for _, ws := range workspaces {
 		wsPath := ws.Path
 		if ws.Name != "" {
@@ -146,9 +217,12 @@ func runReport(cmd *cobra.Command, args []string) error {
 		}
 
 		scanCfg := signal.ScanConfig{
-			RepoPath:   wsPath,
-			Collectors: collectors,
-			NoLLM:      reportNoLLM,
+			RepoPath:    wsPath,
+			Collectors:  collectors,
+			NoLLM:       reportNoLLM,
+			NoCache:     reportNoCache,
+			NoGitignore: reportNoGitignore,
+			Jobs:        reportJobs,
 		}
 		scanCfg = config.Merge(fileCfg, scanCfg)
 
@@ -170,6 +244,7 @@ func runReport(cmd *cobra.Command, args []string) error {
 			AnonymizeChanged: cmd.Flags().Changed("anonymize"),
 			CollectorTimeout: reportCollectorTimeout,
 			Paths:            reportPaths,
+			MaxDepth:         reportMaxDepth,
 		})
 
 		p, err := pipeline.New(scanCfg)
@@ -189,7 +264,7 @@ func runReport(cmd *cobra.Command, args []string) error {
 		}
 		slog.Info("generating report", "collectors", len(cn))
 
-		wsResult, err := p.Run(cmd.Context())
+		wsResult, err := p.Run(ctx)
 		if err != nil {
 			return fmt.Errorf("stringer: report failed (%v)", err)
 		}
@@ -206,6 +281,10 @@ func runReport(cmd *cobra.Command, args []string) error {
 			result.Metrics[k] = v
 		}
 	}
+	delete(result.Metrics, signal.ErrorsMetric)
+	if counts := result.ErrorCounts(); len(counts) > 0 {
+		result.Metrics[signal.ErrorsMetric] = counts
+	}
 
 	// 4. Load scan history for trend section.
 	loadAndInjectHistory(absPath, result)
@@ -267,6 +346,95 @@ func runReport(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+func runReportResolved(cmd *cobra.Command, args []string) error {
+	if reportResolvedSince == "" {
+		return exitError(ExitInvalidArgs, "stringer: --since is required (git ref, date, or duration such as 14d)")
+	}
+	if reportResolvedFormat != "" && reportResolvedFormat != "json" {
+		return exitError(ExitInvalidArgs, "stringer: unsupported format %q (supported: json)", reportResolvedFormat)
+	}
+
+	repoPath := "."
+	if len(args) > 0 {
+		repoPath = args[0]
+	}
+	absPath, _, err := resolveScanPath(repoPath)
+	if err != nil {
+		return err
+	}
+
+	resolved, err := report.BuildResolved(cmd.Context(), absPath, reportResolvedSince, time.Now())
+	if err != nil {
+		return exitError(ExitInvalidArgs, "stringer: %v", err)
+	}
+
+	w := cmd.OutOrStdout()
+	if reportResolvedOutput != "" {
+		f, createErr := cmdFS.Create(reportResolvedOutput)
+		if createErr != nil {
+			return exitError(ExitInvalidArgs, "stringer: cannot create output file %q (%v)", reportResolvedOutput, createErr)
+		}
+		defer f.Close() //nolint:errcheck // best-effort close on output file
+		w = f
+	}
+
+	render := report.RenderResolved
+	if reportResolvedFormat == "json" {
+		render = report.RenderResolvedJSON
+	}
+	if err := render(resolved, w); err != nil {
+		return exitError(ExitTotalFailure, "stringer: rendering failed (%v)", err)
+	}
+	return nil
+}
+
+func runReportNoise(cmd *cobra.Command, args []string) error {
+	if reportNoiseFormat != "" && reportNoiseFormat != "json" {
+		return exitError(ExitInvalidArgs, "stringer: unsupported format %q (supported: json)", reportNoiseFormat)
+	}
+
+	repoPath := "."
+	if len(args) > 0 {
+		repoPath = args[0]
+	}
+	absPath, gitRoot, err := resolveScanPath(repoPath)
+	if err != nil {
+		return err
+	}
+
+	bl, err := baseline.Load(absPath)
+	if err != nil {
+		return exitError(ExitInvalidArgs, "stringer: %v", err)
+	}
+	result, err := runConfiguredScan(cmd.Context(), gitRoot, signal.ScanConfig{
+		RepoPath:   absPath,
+		Collectors: splitCollectors(reportNoiseCollectors),
+	})
+	if err != nil {
+		return err
+	}
+	noise := report.BuildNoise(result.Signals, bl, time.Now())
+
+	w := cmd.OutOrStdout()
+	if reportNoiseOutput != "" {
+		f, createErr := cmdFS.Create(reportNoiseOutput)
+		if createErr != nil {
+			return exitError(ExitInvalidArgs, "stringer: cannot create output file %q (%v)", reportNoiseOutput, createErr)
+		}
+		defer f.Close() //nolint:errcheck // best-effort close on output file
+		w = f
+	}
+
+	render := report.RenderNoise
+	if reportNoiseFormat == "json" {
+		render = report.RenderNoiseJSON
+	}
+	if err := render(noise, w); err != nil {
+		return exitError(ExitTotalFailure, "stringer: rendering failed (%v)", err)
+	}
+	return nil
+}
+
 // renderReport writes a terminal-friendly summary of the scan results.
 func renderReport(result *signal.ScanResult, repoPath string, collectorNames []string, sections []string, w interface{ Write([]byte) (int, error) }) error {
 	// Header.
@@ -282,9 +450,19 @@ func renderReport(result *signal.ScanResult, repoPath string, collectorNames []s
 	_, _ = fmt.Fprintf(w, "Collector Results\n")
 	_, _ = fmt.Fprintf(w, "-----------------\n")
 	for _, cr := range result.Results {
+		if cr.SkipReason != "" {
+			_, _ = fmt.Fprintf(w, "  %-15s skipped — %s\n", cr.Collector, cr.SkipReason)
+			continue
+		}
 		status := fmt.Sprintf("%d signals", len(cr.Signals))
 		if cr.Err != nil {
-			status = fmt.Sprintf("error: %v", cr.Err)
+			status = fmt.Sprintf("error (%s): %v", cr.Category(), cr.Err)
+		}
+		if cr.RetryReason != "" {
+			status += fmt.Sprintf(", retried after: %s", cr.RetryReason)
+		}
+		if n := len(cr.Warnings); n > 0 {
+			status += fmt.Sprintf(", %d warning(s)", n)
 		}
 		metricsStatus := "no"
 		if cr.Metrics != nil {
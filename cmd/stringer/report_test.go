@@ -17,6 +17,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/davetashner/stringer/internal/baseline"
 	"github.com/davetashner/stringer/internal/report"
 	"github.com/davetashner/stringer/internal/signal"
 )
@@ -43,6 +44,15 @@ func resetReportFlags() {
 		_ = h.Value.Set("false")
 	}
 
+	reportResolvedCmd.Flags().VisitAll(func(f *pflag.Flag) {
+		f.Changed = false
+		_ = f.Value.Set(f.DefValue)
+	})
+	reportNoiseCmd.Flags().VisitAll(func(f *pflag.Flag) {
+		f.Changed = false
+		_ = f.Value.Set(f.DefValue)
+	})
+
 	// Reset slices AFTER VisitAll — pflag's StringSlice.Set("[]") appends a
 	// literal "[]" entry rather than clearing.
 	reportPaths = nil
@@ -145,6 +155,7 @@ func TestReportCmd_FlagsRegistered(t *testing.T) {
 		{"git-since", ""},
 		{"anonymize", ""},
 		{"paths", ""},
+		{"max-depth", ""},
 	}
 
 	for _, ff := range flags {
@@ -639,7 +650,7 @@ func TestRenderReport_WithCollectorError(t *testing.T) {
 	require.NoError(t, err)
 
 	out := buf.String()
-	assert.Contains(t, out, "error: file not found")
+	assert.Contains(t, out, "error (internal): file not found")
 	assert.Contains(t, out, "metrics: no")
 	assert.Contains(t, out, "metrics: yes")
 }
@@ -738,3 +749,114 @@ func TestReportCmd_ExcludeCollectorsFromEmpty(t *testing.T) {
 	require.NoError(t, err)
 	assert.Contains(t, stdout.String(), "Stringer Report")
 }
+
+func TestReportResolvedCmd(t *testing.T) {
+	resetReportFlags()
+	root := initTestRepo(t)
+	runGitCmd(t, root, "tag", "sprint-start")
+
+	writeTestFile(t, root, "util.go", "package main\n\nfunc normalize(s string) string { return s }\n")
+	writeTestFile(t, root, "util_test.go", "package main\n")
+	runGitCmd(t, root, "add", ".")
+	runGitCmd(t, root, "commit", "-m", "Pay down debt")
+
+	cmd, stdout, _ := newTestCmd()
+	cmd.SetArgs([]string{"report", "resolved", root, "--since", "sprint-start", "--no-color"})
+	require.NoError(t, cmd.Execute())
+
+	out := stdout.String()
+	assert.Contains(t, out, "Debt Paid Down since sprint-start")
+	assert.Contains(t, out, "1 TODOs removed")
+	assert.Contains(t, out, "util.go  TODO: Refactor this utility function")
+	assert.Contains(t, out, "util_test.go  Added util_test.go")
+
+	resetReportFlags()
+	cmd, stdout, _ = newTestCmd()
+	cmd.SetArgs([]string{"report", "resolved", root, "--since", "sprint-start", "--format", "json"})
+	require.NoError(t, cmd.Execute())
+
+	var decoded report.ResolvedReport
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &decoded), "output: %s", stdout.String())
+	assert.Len(t, decoded.TodosRemoved, 1)
+	assert.Len(t, decoded.TestsAdded, 1)
+}
+
+func TestReportResolvedCmd_InvalidArgs(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		args []string
+		want string
+	}{
+		{"missing since", []string{"report", "resolved", "."}, "--since is required"},
+		{"bad format", []string{"report", "resolved", ".", "--since", "14d", "--format", "html"}, `unsupported format "html"`},
+		{"bad ref", []string{"report", "resolved", ".", "--since", "no-such-ref-xyz"}, "is not a date, duration, or git ref"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			resetReportFlags()
+			cmd, _, _ := newTestCmd()
+			cmd.SetArgs(tc.args)
+			err := cmd.Execute()
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.want)
+			requireExitCode(t, err, ExitInvalidArgs)
+		})
+	}
+}
+
+func TestReportNoiseCmd(t *testing.T) {
+	resetReportFlags()
+	resetScanFlags()
+	root := initTestRepo(t)
+
+	// Scan once to learn the signal IDs, then dismiss one of them.
+	cmd, stdout, _ := newTestCmd()
+	cmd.SetArgs([]string{"scan", root, "-c", "todos", "--quiet"})
+	require.NoError(t, cmd.Execute())
+	var first struct {
+		ID string `json:"id"`
+	}
+	line, _, _ := strings.Cut(stdout.String(), "\n")
+	require.NoError(t, json.Unmarshal([]byte(line), &first), "output: %s", stdout.String())
+	require.NotEmpty(t, first.ID)
+
+	require.NoError(t, baseline.Save(root, &baseline.BaselineState{
+		Version: "1",
+		Suppressions: []baseline.Suppression{
+			{SignalID: first.ID, Reason: baseline.ReasonFalsePositive, SuppressedAt: time.Now()},
+			{SignalID: "str-00000000", Reason: baseline.ReasonWontFix, SuppressedAt: time.Now()},
+		},
+	}))
+
+	resetReportFlags()
+	cmd, stdout, _ = newTestCmd()
+	cmd.SetArgs([]string{"report", "noise", root, "-c", "todos", "--no-color"})
+	require.NoError(t, cmd.Execute())
+	out := stdout.String()
+	assert.Contains(t, out, "Signal Noise")
+	assert.Contains(t, out, "1 dismissed")
+	assert.Contains(t, out, "1 suppressions match no current signal")
+	assert.Contains(t, out, "By collector:")
+
+	resetReportFlags()
+	cmd, stdout, _ = newTestCmd()
+	cmd.SetArgs([]string{"report", "noise", root, "-c", "todos", "--format", "json"})
+	require.NoError(t, cmd.Execute())
+
+	var decoded report.NoiseReport
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &decoded), "output: %s", stdout.String())
+	assert.Equal(t, 1, decoded.Dismissed)
+	assert.Equal(t, 1, decoded.Stale)
+	require.Len(t, decoded.ByCollector, 1)
+	assert.Equal(t, "todos", decoded.ByCollector[0].Name)
+	assert.Equal(t, 1, decoded.ByCollector[0].FalsePositives)
+}
+
+func TestReportNoiseCmd_InvalidFormat(t *testing.T) {
+	resetReportFlags()
+	cmd, _, _ := newTestCmd()
+	cmd.SetArgs([]string{"report", "noise", ".", "--format", "html"})
+	err := cmd.Execute()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `unsupported format "html"`)
+	requireExitCode(t, err, ExitInvalidArgs)
+}
@@ -12,9 +12,12 @@ import (
 
 // Global flag values.
 var (
-	verbose bool
-	quiet   bool
-	noColor bool
+	verbose   bool
+	quiet     bool
+	noColor   bool
+	logFormat string
+
+	allowPlugins bool
 )
 
 // rootCmd is the base command for stringer.
@@ -32,9 +35,14 @@ Get started:
   stringer report .      View a health dashboard`,
 	SilenceUsage:  true,
 	SilenceErrors: true,
-	PersistentPreRun: func(_ *cobra.Command, _ []string) {
-		stringerlog.Setup(verbose, quiet)
+	PersistentPreRunE: func(_ *cobra.Command, _ []string) error {
+		format, err := stringerlog.ParseFormat(logFormat)
+		if err != nil {
+			return exitError(ExitInvalidArgs, "stringer: --log-format: %v", err)
+		}
+		stringerlog.SetupFormat(verbose, quiet, format)
 		color.NoColor = noColor
+		return nil
 	},
 }
 
@@ -42,6 +50,8 @@ func init() {
 	rootCmd.PersistentFlags().BoolVarP(&verbose, "verbose", "v", false, "enable verbose output")
 	rootCmd.PersistentFlags().BoolVarP(&quiet, "quiet", "q", false, "suppress non-essential output")
 	rootCmd.PersistentFlags().BoolVar(&noColor, "no-color", false, "disable colored output")
+	rootCmd.PersistentFlags().StringVar(&logFormat, "log-format", string(stringerlog.FormatText), "log format on stderr: text or json")
+	rootCmd.PersistentFlags().BoolVar(&allowPlugins, "allow-plugins", false, "run plugin collectors declared in .stringer.yaml (they run with your permissions)")
 
 	rootCmd.AddCommand(initCmd)
 	rootCmd.AddCommand(scanCmd)
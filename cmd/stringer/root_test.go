@@ -42,6 +42,7 @@ func TestGlobalFlags(t *testing.T) {
 		{"verbose", "--verbose"},
 		{"quiet", "--quiet"},
 		{"no-color", "--no-color"},
+		{"log-format", "--log-format"},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,141 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path/filepath"
+
+	"github.com/davetashner/stringer/internal/output"
+	"github.com/davetashner/stringer/internal/signal"
+	"github.com/davetashner/stringer/internal/statedir"
+)
+
+// ruleOutputsFile records, under .stringer, the files config rules routed
+// signals to and a hash of each, so a later scan overwrites only files
+// stringer wrote and nobody has changed since.
+const ruleOutputsFile = "rule-outputs.json"
+
+// dirOutput marks a directory output, such as html-dir, in the record.
+const dirOutput = "dir"
+
+// ruleOutputs writes the files config rules route signals to, confined to
+// the scanned directory: a path cannot leave it, through ".." or a
+// symbolic link.
+type ruleOutputs struct {
+	dir     string
+	root    *os.Root
+	written map[string]string // slash-separated path -> content hash or dirOutput
+}
+
+// openRuleOutputs opens dir for rule outputs and reads the record of
+// earlier ones.
+func openRuleOutputs(dir string) (*ruleOutputs, error) {
+	root, err := os.OpenRoot(dir)
+	if err != nil {
+		return nil, err
+	}
+	o := &ruleOutputs{dir: dir, root: root, written: make(map[string]string)}
+	data, err := root.ReadFile(filepath.Join(statedir.Name, ruleOutputsFile))
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+	case err != nil:
+		slog.Warn("cannot read the record of rule outputs", "error", err)
+	case json.Unmarshal(data, &o.written) != nil:
+		slog.Warn("ignoring a malformed record of rule outputs", "file", ruleOutputsFile)
+		o.written = make(map[string]string)
+	}
+	return o, nil
+}
+
+// close releases the directory.
+func (o *ruleOutputs) close() {
+	_ = o.root.Close()
+}
+
+// free reports whether the output at rel may be written: it does not exist,
+// or an earlier scan wrote it and it has not changed since.
+func (o *ruleOutputs) free(rel string) (bool, error) {
+	info, err := o.root.Lstat(rel)
+	if errors.Is(err, fs.ErrNotExist) {
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	want, ok := o.written[filepath.ToSlash(rel)]
+	switch {
+	case !ok:
+		return false, nil
+	case info.IsDir():
+		return want == dirOutput, nil
+	case !info.Mode().IsRegular():
+		return false, nil
+	}
+	data, err := o.root.ReadFile(rel)
+	if err != nil {
+		return false, err
+	}
+	return want == outputHash(data), nil
+}
+
+// write formats signals into the output at rel.
+func (o *ruleOutputs) write(formatter output.Formatter, signals []signal.RawSignal, rel string) error {
+	if ok, err := o.free(rel); err != nil {
+		return exitError(ExitInvalidArgs, "stringer: cannot write rules output %q (%v)", rel, err)
+	} else if !ok {
+		return exitError(ExitInvalidArgs, "stringer: rules output %q exists and was not written by stringer; remove it or choose another path", rel)
+	}
+
+	if df, ok := formatter.(output.DirectoryFormatter); ok {
+		if err := o.root.MkdirAll(rel, 0o750); err != nil {
+			return exitError(ExitInvalidArgs, "stringer: cannot create rules output %q (%v)", rel, err)
+		}
+		if err := df.FormatDir(signals, filepath.Join(o.dir, rel)); err != nil {
+			return exitError(ExitTotalFailure, "stringer: formatting %s failed (%v)", rel, err)
+		}
+		o.written[filepath.ToSlash(rel)] = dirOutput
+		return nil
+	}
+
+	var buf bytes.Buffer
+	if err := formatter.Format(signals, &buf); err != nil {
+		return exitError(ExitTotalFailure, "stringer: formatting %s failed (%v)", rel, err)
+	}
+	if err := o.root.MkdirAll(filepath.Dir(rel), 0o750); err != nil {
+		return exitError(ExitInvalidArgs, "stringer: cannot create rules output %q (%v)", rel, err)
+	}
+	if err := o.root.WriteFile(rel, buf.Bytes(), 0o600); err != nil {
+		return exitError(ExitInvalidArgs, "stringer: cannot create rules output %q (%v)", rel, err)
+	}
+	o.written[filepath.ToSlash(rel)] = outputHash(buf.Bytes())
+	return nil
+}
+
+// save records the outputs written so far.
+func (o *ruleOutputs) save() error {
+	data, err := json.MarshalIndent(o.written, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := o.root.MkdirAll(statedir.Name, 0o750); err != nil {
+		return fmt.Errorf("create state directory: %w", err)
+	}
+	statedir.EnsureIgnore(cmdFS, o.dir)
+	return o.root.WriteFile(filepath.Join(statedir.Name, ruleOutputsFile), data, 0o600)
+}
+
+// outputHash identifies the content of an output file.
+func outputHash(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,102 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSampleCmd_IsRegistered(t *testing.T) {
+	found := false
+	for _, cmd := range rootCmd.Commands() {
+		if cmd.Name() == "sample" {
+			found = true
+			break
+		}
+	}
+	assert.True(t, found, "sample command should be registered on rootCmd")
+}
+
+func TestSampleCmd(t *testing.T) {
+	resetSampleFlags()
+	root := initTestRepo(t)
+
+	cmd, stdout, _ := newTestCmd()
+	cmd.SetArgs([]string{"sample", root, "-c", "todos", "--n", "2", "--stratify", "kind"})
+	require.NoError(t, cmd.Execute())
+
+	out := stdout.String()
+	assert.Contains(t, out, "# Stringer audit sample")
+	assert.Contains(t, out, "- Seed: HEAD ")
+	assert.Contains(t, out, "stratified by kind")
+	assert.Equal(t, 2, strings.Count(out, "- [ ] "))
+	assert.Contains(t, out, "Precision = checked items / 2")
+
+	// Same commit, same sample.
+	resetSampleFlags()
+	cmd, again, _ := newTestCmd()
+	cmd.SetArgs([]string{"sample", root, "-c", "todos", "--n", "2", "--stratify", "kind"})
+	require.NoError(t, cmd.Execute())
+	assert.Equal(t, out, again.String())
+}
+
+func TestSampleCmd_SeedAndOutput(t *testing.T) {
+	resetSampleFlags()
+	root := initTestRepo(t)
+	outFile := filepath.Join(t.TempDir(), "audit.md")
+
+	cmd, _, _ := newTestCmd()
+	cmd.SetArgs([]string{"sample", root, "-c", "todos", "--seed", "release-1", "-o", outFile})
+	require.NoError(t, cmd.Execute())
+
+	data, err := os.ReadFile(outFile) //nolint:gosec // test-controlled path
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "- Seed: release-1")
+}
+
+func TestSampleCmd_NoGitNeedsSeed(t *testing.T) {
+	resetSampleFlags()
+	dir := t.TempDir()
+	writeTestFile(t, dir, "main.go", "package main\n\n// TODO: wire flags\n")
+
+	cmd, _, _ := newTestCmd()
+	cmd.SetArgs([]string{"sample", dir, "-c", "todos"})
+	err := cmd.Execute()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "pass --seed")
+	requireExitCode(t, err, ExitInvalidArgs)
+
+	resetSampleFlags()
+	cmd, stdout, _ := newTestCmd()
+	cmd.SetArgs([]string{"sample", dir, "-c", "todos", "--seed", "x"})
+	require.NoError(t, cmd.Execute())
+	assert.Contains(t, stdout.String(), "**TODO: wire flags**")
+}
+
+func TestSampleCmd_InvalidArgs(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		args []string
+		want string
+	}{
+		{"zero n", []string{"sample", ".", "--n", "0"}, "--n must be positive"},
+		{"bad stratify", []string{"sample", ".", "--stratify", "file"}, `unsupported --stratify "file"`},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			resetSampleFlags()
+			cmd, _, _ := newTestCmd()
+			cmd.SetArgs(tc.args)
+			err := cmd.Execute()
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.want)
+			requireExitCode(t, err, ExitInvalidArgs)
+		})
+	}
+}
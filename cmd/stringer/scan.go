@@ -4,24 +4,46 @@
 package main
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"log/slog"
 	"path/filepath"
+	"slices"
 	"sort"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	"github.com/davetashner/stringer/internal/analysis"
 	"github.com/davetashner/stringer/internal/baseline"
 	"github.com/davetashner/stringer/internal/beads"
+	"github.com/davetashner/stringer/internal/checkpoint"
+	"github.com/davetashner/stringer/internal/codeowners"
 	"github.com/davetashner/stringer/internal/collector"
-	_ "github.com/davetashner/stringer/internal/collectors"
+	"github.com/davetashner/stringer/internal/collectors"
 	"github.com/davetashner/stringer/internal/config"
+	"github.com/davetashner/stringer/internal/coverage"
+	"github.com/davetashner/stringer/internal/estimate"
+	"github.com/davetashner/stringer/internal/feedback"
+	"github.com/davetashner/stringer/internal/forge"
+	"github.com/davetashner/stringer/internal/hotpath"
+	"github.com/davetashner/stringer/internal/i18n"
 	"github.com/davetashner/stringer/internal/llm"
 	"github.com/davetashner/stringer/internal/output"
 	"github.com/davetashner/stringer/internal/pipeline"
+	"github.com/davetashner/stringer/internal/policy"
+	"github.com/davetashner/stringer/internal/priority"
+	"github.com/davetashner/stringer/internal/promexport"
+	"github.com/davetashner/stringer/internal/rules"
+	"github.com/davetashner/stringer/internal/scancache"
+	"github.com/davetashner/stringer/internal/scandiff"
+	"github.com/davetashner/stringer/internal/scoring"
 	"github.com/davetashner/stringer/internal/signal"
 	"github.com/davetashner/stringer/internal/state"
 )
@@ -31,8 +53,11 @@ var (
 	scanCollectors        string
 	scanFormat            string
 	scanOutput            string
+	scanOutputDir         string
+	scanSplitByWorkspace  bool
 	scanDryRun            bool
 	scanDelta             bool
+	scanSinceRef          string
 	scanNoLLM             bool
 	scanJSON              bool
 	scanMaxIssues         int
@@ -49,14 +74,52 @@ var (
 	scanExcludeCollectors string
 	scanIncludeDemoPaths  bool
 	scanPaths             []string
+	scanMaxDepth          int
 	scanCluster           bool
 	scanClusterThreshold  float64
 	scanInferPriority     bool
 	scanInferDeps         bool
+	scanEpics             bool
+	scanEnrich            bool
 	scanWorkspace         string
 	scanNoWorkspaces      bool
 	scanNoBaseline        bool
+	scanNoCalibration     bool
 	scanSARIFBaseline     string
+	scanBaseline          string
+	scanBudget            int
+	scanPolicyURL         string
+	scanPolicyKey         string
+	scanTestReports       []string
+	scanLintReports       []string
+	scanProfiles          []string
+	scanHotPathShare      float64
+	scanCoverage          []string
+	scanFailFast          bool
+	scanExpectZero        []string
+	scanFailOn            []string
+	scanQuick             bool
+	scanQuickBudget       time.Duration
+	scanGitHubBudget      int
+	scanPrintExitPolicy   bool
+	scanLang              string
+	scanColumns           string
+	scanJUnitSkipBelow    float64
+	scanScoringProfile    string
+	scanNoPlugins         bool
+	scanNoCache           bool
+	scanNoGitignore       bool
+	scanCheckpoint        bool
+	scanResume            bool
+	scanStream            bool
+	scanJobs              int
+	scanMetricsOut        string
+	scanTrace             string
+	scanReposFile         string
+	scanGitHubOrg         string
+	scanReposCache        string
+	scanTrustRepos        bool
+	scanRemote            string
 )
 
 // scanCmd is the subcommand for scanning a repository.
@@ -73,11 +136,12 @@ For a human-readable health dashboard, use 'stringer report' instead.`,
 
 func init() {
 	scanCmd.Flags().StringVarP(&scanCollectors, "collectors", "c", "", "comma-separated list of collectors to run")
-	scanCmd.Flags().StringVarP(&scanFormat, "format", "f", "beads", "output format (beads, html, html-dir, json, markdown, sarif, tasks)")
+	scanCmd.Flags().StringVarP(&scanFormat, "format", "f", "beads", "output format (beads, csv, github-issues, html, html-dir, json, junit, markdown, pr-comment, rdjson, sarif, tasks)")
 	scanCmd.Flags().StringVarP(&scanOutput, "output", "o", "", "output file path (default: stdout)")
 	scanCmd.Flags().BoolVar(&scanDryRun, "dry-run", false, "show signal count without producing output")
 	scanCmd.Flags().BoolVar(&scanDelta, "delta", false, "only output new signals since last scan")
-	scanCmd.Flags().BoolVar(&scanNoLLM, "no-llm", false, "skip LLM clustering pass (noop for MVP)")
+	scanCmd.Flags().StringVar(&scanSinceRef, "since-ref", "", "limit file-based collectors (todos, patterns) to files changed since this commit, branch, or tag")
+	scanCmd.Flags().BoolVar(&scanNoLLM, "no-llm", false, "skip all LLM passes, even when requested by other flags or config")
 	scanCmd.Flags().BoolVar(&scanJSON, "json", false, "machine-readable output for --dry-run")
 	scanCmd.Flags().IntVar(&scanMaxIssues, "max-issues", 0, "cap output count (0 = unlimited)")
 	scanCmd.Flags().Float64Var(&scanMinConfidence, "min-confidence", 0, "filter signals below this confidence threshold (0.0-1.0)")
@@ -86,21 +150,61 @@ func init() {
 	scanCmd.Flags().IntVar(&scanGitDepth, "git-depth", 0, "max commits to examine (default 1000)")
 	scanCmd.Flags().StringVar(&scanGitSince, "git-since", "", "only examine commits after this duration (e.g., 90d, 6m, 1y)")
 	scanCmd.Flags().StringSliceVarP(&scanExclude, "exclude", "e", nil, "glob patterns to exclude from scanning (e.g. \"tests/**,docs/**\")")
-	scanCmd.Flags().BoolVar(&scanIncludeClosed, "include-closed", false, "include closed/merged issues and PRs from GitHub")
+	scanCmd.Flags().BoolVar(&scanIncludeClosed, "include-closed", false, "include closed/merged issues and PRs from GitHub and GitLab")
 	scanCmd.Flags().StringVar(&scanHistoryDepth, "history-depth", "", "filter closed items older than this duration (e.g., 90d, 6m, 1y)")
 	scanCmd.Flags().StringVar(&scanAnonymize, "anonymize", "auto", "anonymize author names: auto, always, or never")
 	scanCmd.Flags().StringVar(&scanCollectorTimeout, "collector-timeout", "", "per-collector timeout (e.g. 60s, 2m); 0 or empty = no timeout")
 	scanCmd.Flags().StringVarP(&scanExcludeCollectors, "exclude-collectors", "x", "", "comma-separated list of collectors to skip")
 	scanCmd.Flags().BoolVar(&scanIncludeDemoPaths, "include-demo-paths", false, "include demo/example/tutorial paths in noise-prone signals")
 	scanCmd.Flags().StringSliceVar(&scanPaths, "paths", nil, "restrict scanning to specific files or directories (comma-separated)")
+	scanCmd.Flags().IntVar(&scanMaxDepth, "max-depth", 0, "max directory levels to scan below the root or each --paths entry (0 = unlimited)")
 	scanCmd.Flags().BoolVar(&scanCluster, "cluster", false, "enable LLM-based signal clustering")
 	scanCmd.Flags().Float64Var(&scanClusterThreshold, "cluster-threshold", 0.7, "similarity threshold for signal pre-filtering (0.0-1.0)")
 	scanCmd.Flags().BoolVar(&scanInferPriority, "infer-priority", false, "use LLM to assign P1-P4 priorities to signals")
 	scanCmd.Flags().BoolVar(&scanInferDeps, "infer-deps", false, "use LLM to detect dependencies between signals")
+	scanCmd.Flags().BoolVar(&scanEpics, "epics", false, "group related TODOs and similar signals in one module into suggested epic signals")
+	scanCmd.Flags().BoolVar(&scanEnrich, "enrich", false, "use LLM to rewrite signal titles, draft acceptance criteria, and merge near-duplicates")
 	scanCmd.Flags().StringVar(&scanWorkspace, "workspace", "", "scan only named workspace(s) (comma-separated)")
 	scanCmd.Flags().BoolVar(&scanNoWorkspaces, "no-workspaces", false, "disable monorepo auto-detection, scan root as single directory")
+	scanCmd.Flags().BoolVar(&scanSplitByWorkspace, "split-by-workspace", false, "write one output per workspace, with workspace-relative paths, into --output-dir")
+	scanCmd.Flags().StringVar(&scanOutputDir, "output-dir", "", "directory for --split-by-workspace outputs and their index.json")
 	scanCmd.Flags().BoolVar(&scanNoBaseline, "no-baseline", false, "skip baseline suppression filtering")
+	scanCmd.Flags().BoolVar(&scanNoCalibration, "no-calibration", false, "skip confidence calibration from .stringer/feedback.jsonl")
+	scanCmd.Flags().BoolVar(&scanNoPlugins, "no-plugins", false, "do not run plugin collectors, even with --allow-plugins")
 	scanCmd.Flags().StringVar(&scanSARIFBaseline, "sarif-baseline", "", "previous SARIF file for baseline comparison (requires --format sarif)")
+	scanCmd.Flags().StringVar(&scanBaseline, "baseline", "", "previous scan output (beads or json); output only signals it does not have")
+	scanCmd.Flags().IntVar(&scanBudget, "budget", 0, "maximum new signals allowed, reported by --format pr-comment (0 = no budget)")
+	scanCmd.Flags().StringSliceVar(&scanTestReports, "test-report", nil, "go test -json or JUnit XML report(s) for the testtiming collector (globs allowed)")
+	scanCmd.Flags().StringSliceVar(&scanLintReports, "lint-report", nil, "go vet -json or staticcheck -f json output for the lint collector (globs allowed)")
+	scanCmd.Flags().StringSliceVar(&scanProfiles, "profile", nil, "pprof or Go coverage profile(s) from production; signals in hot files are boosted and tagged hot-path (globs allowed)")
+	scanCmd.Flags().Float64Var(&scanHotPathShare, "hot-path-share", hotpath.DefaultMinShare, "share of a profile's samples a file needs to count as hot (0.0-1.0)")
+	scanCmd.Flags().StringSliceVar(&scanCoverage, "coverage", nil, "Go cover profile(s), LCOV, or Cobertura XML report(s); files with high churn and low coverage get risk-quadrant signals (globs allowed)")
+	scanCmd.Flags().StringVar(&scanPolicyURL, "policy-url", "", "URL of a signed org policy enforced above local config")
+	scanCmd.Flags().StringVar(&scanPolicyKey, "policy-key", "", "base64 Ed25519 public key that signs the --policy-url document (default $"+policy.KeyEnvVar+")")
+	scanCmd.Flags().StringArrayVar(&scanExpectZero, "expect-zero", nil, "exit 4 if any signal matches kind=, collector=, or tag= (comma-separated values; repeatable)")
+	scanCmd.Flags().StringArrayVar(&scanFailOn, "fail-on", nil, "exit 4 if output signals match every clause: kind=, collector=, tag=, path=, min-confidence=, count>N (comma-separated; repeatable)")
+	scanCmd.Flags().BoolVar(&scanFailFast, "fail-fast", false, "with --expect-zero, stop at the first matching signal and run only collectors that can produce it")
+	scanCmd.Flags().BoolVar(&scanQuick, "quick", false, "run only fast local collectors with tighter caps and stop at --quick-budget")
+	scanCmd.Flags().DurationVar(&scanQuickBudget, "quick-budget", defaultQuickBudget, "time budget for --quick; collectors still running are skipped")
+	scanCmd.Flags().BoolVar(&scanPrintExitPolicy, "print-exit-policy", false, "print the exit code for each condition (from defaults, exit_codes config, and --strict) and exit")
+	scanCmd.Flags().StringVar(&scanLang, "lang", "", "language of report headings and summaries for markdown, html, and pr-comment ("+strings.Join(i18n.Languages(), ", ")+", or a catalog .yaml file)")
+	scanCmd.Flags().StringVar(&scanColumns, "columns", "", "comma-separated columns for --format csv (default: "+strings.Join(output.DefaultCSVColumns, ",")+")")
+	scanCmd.Flags().Float64Var(&scanJUnitSkipBelow, "junit-skip-below", 0, "report signals below this confidence as skipped instead of failed in --format junit (0.0-1.0)")
+	scanCmd.Flags().StringVar(&scanScoringProfile, "scoring-profile", "", "re-weight confidence with a scoring profile: "+strings.Join(scoring.Names(), ", ")+", or one defined in config")
+	scanCmd.Flags().IntVarP(&scanJobs, "jobs", "j", 0, "maximum collectors to run at once (0 = all at once)")
+	scanCmd.Flags().BoolVar(&scanNoCache, "no-cache", false, "read every file again instead of replaying unchanged files from .stringer/"+scancache.FileName)
+	scanCmd.Flags().BoolVar(&scanNoGitignore, "no-gitignore", false, "also scan files listed in .gitignore and .git/info/exclude")
+	scanCmd.Flags().BoolVar(&scanCheckpoint, "checkpoint", false, "save each collector's results to .stringer/"+checkpoint.FileName+" so an interrupted scan can be resumed")
+	scanCmd.Flags().BoolVar(&scanResume, "resume", false, "resume an interrupted --checkpoint scan, reusing its saved collector results (implies --checkpoint)")
+	scanCmd.Flags().BoolVar(&scanStream, "stream", false, "write signals as they are found instead of holding them all in memory (beads, csv, and json formats; skips cross-signal analysis)")
+	scanCmd.Flags().StringVar(&scanMetricsOut, "metrics-out", "", "also write signal counts and collector timings in Prometheus text format to this file")
+	scanCmd.Flags().StringVar(&scanTrace, "trace", "", "write an OpenTelemetry trace of the scan (OTLP/JSON) to this file")
+	scanCmd.Flags().IntVar(&scanGitHubBudget, "github-budget", collectors.DefaultGitHubAPIBudget, "maximum GitHub API requests per scan, shared by all collectors and workspaces")
+	scanCmd.Flags().StringVar(&scanReposFile, "repos-file", "", "scan every repository listed in this file (owner/name, clone URL, or local path per line) into one output")
+	scanCmd.Flags().StringVar(&scanGitHubOrg, "github-org", "", "scan every repository of this GitHub organization into one output (needs GITHUB_TOKEN)")
+	scanCmd.Flags().StringVar(&scanReposCache, "repos-cache", "", "directory for the shallow clones of --repos-file and --github-org (default: the user cache directory)")
+	scanCmd.Flags().BoolVar(&scanTrustRepos, "trust-repos", false, "honor the rule outputs and llm settings in each repository's .stringer.yaml in a multi-repo scan")
+	scanCmd.Flags().StringVar(&scanRemote, "remote", "", "scan a GitHub repository (owner/repo or owner/repo@ref) from its tarball and the API, without a clone")
 }
 
 // scanContext holds shared state across the scan lifecycle, reducing parameter
@@ -115,16 +219,47 @@ type scanContext struct {
 	result          *signal.ScanResult
 	collectorNames  []string
 	allSignals      []signal.RawSignal      // pre-filter signals for delta state
+	collected       []signal.RawSignal      // signals before scoring-profile and rule drops, for the signal history
+	covered         map[string]wsCoverage   // by workspace name: collectors that ran and their scope
 	suppressedCount int                     // count of baseline-suppressed signals
 	baselineState   *baseline.BaselineState // retained for SARIF suppression mapping
+	previousScan    []scandiff.Entry        // signals of the --baseline scan output
+	sinceBase       string                  // merge base of --since-ref and HEAD; empty without it
+	resolved        []state.SignalMeta      // signals removed since the previous delta scan
+	policy          *policy.Policy          // org policy from --policy-url, if any
+	expect          *expectationChecker     // --expect-zero conditions, if any
+	violations      []signal.RawSignal      // signals matching --expect-zero
+	failOnHits      []failOnHit             // --fail-on thresholds the output exceeded
+	deadline        time.Time               // --quick budget end; zero without --quick
+	skippedWS       []string                // workspaces not scanned before the deadline
+	exitPolicy      exitPolicy              // exit code per condition, from config and --strict
+	catalog         *i18n.Catalog           // report language, from --lang or config
+	csvColumns      []string                // csv format columns, from --columns or config; nil for defaults
+	quadrants       *coverage.Quadrants     // churn × coverage grid, with --coverage
+	scoring         scoring.Profile         // confidence weights, from --scoring-profile or config
+	rules           rules.Set               // post-processing rules, from config
+	llm             *config.LLMConfig       // resolved by llmConfig
 }
 
-func runScan(cmd *cobra.Command, args []string) error {
+func runScan(cmd *cobra.Command, args []string) (err error) {
+	start := time.Now()
+
 	// 1. Resolve scan path and find git root.
 	repoPath := "."
 	if len(args) > 0 {
 		repoPath = args[0]
 	}
+	if scanRemote != "" {
+		if err := checkRemoteFlags(cmd, args); err != nil {
+			return err
+		}
+		dir, cleanup, err := fetchRemote(cmd.Context(), scanRemote)
+		if err != nil {
+			return err
+		}
+		defer cleanup()
+		repoPath = dir
+	}
 	absPath, gitRoot, err := resolveScanPath(repoPath)
 	if err != nil {
 		return err
@@ -135,6 +270,52 @@ func runScan(cmd *cobra.Command, args []string) error {
 			"stringer: --min-confidence must be between 0.0 and 1.0 (got %.2f)", scanMinConfidence)
 	}
 
+	if scanJUnitSkipBelow < 0 || scanJUnitSkipBelow > 1.0 {
+		return exitError(ExitInvalidArgs,
+			"stringer: --junit-skip-below must be between 0.0 and 1.0 (got %.2f)", scanJUnitSkipBelow)
+	}
+
+	if scanMaxDepth < 0 {
+		return exitError(ExitInvalidArgs,
+			"stringer: --max-depth must be non-negative (got %d)", scanMaxDepth)
+	}
+	if scanJobs < 0 {
+		return exitError(ExitInvalidArgs,
+			"stringer: --jobs must be non-negative (got %d)", scanJobs)
+	}
+	if scanHotPathShare <= 0 || scanHotPathShare > 1.0 {
+		return exitError(ExitInvalidArgs,
+			"stringer: --hot-path-share must be greater than 0.0 and at most 1.0 (got %.2f)", scanHotPathShare)
+	}
+	profiles, err := loadProfiles(scanProfiles)
+	if err != nil {
+		return err
+	}
+	coverageReport, err := loadCoverage(scanCoverage)
+	if err != nil {
+		return err
+	}
+	if scanFailFast && len(scanExpectZero) == 0 {
+		return exitError(ExitInvalidArgs, "stringer: --fail-fast requires --expect-zero")
+	}
+	exps, err := parseExpectations(scanExpectZero)
+	if err != nil {
+		return exitError(ExitInvalidArgs, "stringer: %v", err)
+	}
+	failOn, err := parseFailOn(scanFailOn)
+	if err != nil {
+		return exitError(ExitInvalidArgs, "stringer: %v", err)
+	}
+	if cmd.Flags().Changed("quick-budget") && !scanQuick {
+		return exitError(ExitInvalidArgs, "stringer: --quick-budget requires --quick")
+	}
+	if scanQuick && scanQuickBudget <= 0 {
+		return exitError(ExitInvalidArgs, "stringer: --quick-budget must be positive (got %s)", scanQuickBudget)
+	}
+	if scanGitHubBudget <= 0 {
+		return exitError(ExitInvalidArgs, "stringer: --github-budget must be positive (got %d)", scanGitHubBudget)
+	}
+
 	// Validate --sarif-baseline requires --format sarif.
 	if scanSARIFBaseline != "" {
 		effectiveFormat := scanFormat
@@ -147,56 +328,193 @@ func runScan(cmd *cobra.Command, args []string) error {
 		}
 	}
 
+	if scanSplitByWorkspace && scanOutputDir == "" {
+		return exitError(ExitInvalidArgs, "stringer: --split-by-workspace requires --output-dir")
+	}
+	if scanOutputDir != "" && !scanSplitByWorkspace {
+		return exitError(ExitInvalidArgs, "stringer: --output-dir requires --split-by-workspace")
+	}
+	if scanSplitByWorkspace && scanOutput != "" {
+		return exitError(ExitInvalidArgs, "stringer: --split-by-workspace and --output cannot be combined")
+	}
+
+	multiRepo := scanReposFile != "" || scanGitHubOrg != ""
+	if multiRepo {
+		if err := checkMultiRepoFlags(cmd, args); err != nil {
+			return err
+		}
+	} else if scanReposCache != "" {
+		return exitError(ExitInvalidArgs, "stringer: --repos-cache requires --repos-file or --github-org")
+	} else if scanTrustRepos {
+		return exitError(ExitInvalidArgs, "stringer: --trust-repos requires --repos-file or --github-org")
+	}
+
+	if scanStream {
+		if err := checkStreamFlags(cmd); err != nil {
+			return err
+		}
+	}
+
+	if scanBaseline != "" && scanDelta {
+		return exitError(ExitInvalidArgs, "stringer: --baseline and --delta cannot be combined")
+	}
+	if scanSinceRef != "" && scanDelta {
+		return exitError(ExitInvalidArgs, "stringer: --since-ref and --delta cannot be combined")
+	}
+
+	// 1b. Trace the scan when --trace or the OTEL_* environment asks for it.
+	finishTrace, err := startScanTrace(cmd, absPath, scanTrace)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if traceErr := finishTrace(err); err == nil {
+			err = traceErr
+		}
+	}()
+
 	sc := &scanContext{
 		cmd:        cmd,
 		absPath:    absPath,
 		gitRoot:    gitRoot,
-		workspaces: resolveWorkspaces(absPath, scanNoWorkspaces, scanWorkspace),
+		workspaces: resolveWorkspaces(absPath, scanNoWorkspaces || multiRepo, scanWorkspace),
 		result:     &signal.ScanResult{Metrics: make(map[string]any)},
 	}
+	if scanQuick {
+		sc.deadline = start.Add(scanQuickBudget)
+	}
+	if scanBaseline != "" {
+		if sc.previousScan, err = readScanOutput(scanBaseline); err != nil {
+			return err
+		}
+	}
+	if scanSinceRef != "" {
+		if sc.sinceBase, err = resolveSinceRef(cmd.Context(), absPath, scanSinceRef); err != nil {
+			return exitError(ExitInvalidArgs, "stringer: --since-ref: %v", err)
+		}
+	}
 
-	// 2. Load root config for output format and filters.
-	sc.scanCfg, sc.fileCfg, err = loadScanConfig(cmd, absPath, gitRoot)
+	// 2. Fetch the org policy, then load root config for output format and filters.
+	sc.policy, err = loadPolicy(cmd.Context())
 	if err != nil {
 		return err
 	}
-
-	// 3. Run pipeline per workspace and aggregate results.
-	if err := sc.runPipeline(); err != nil {
+	sc.scanCfg, sc.fileCfg, err = loadScanConfig(cmd, absPath, gitRoot, sc.policy)
+	if err != nil {
+		return err
+	}
+	if scanRemote != "" {
+		untrustConfig(sc.fileCfg, scanRemote)
+	}
+	sc.exitPolicy = resolveExitPolicy(sc.fileCfg, scanStrict)
+	if scanPrintExitPolicy {
+		return printExitPolicy(cmd.OutOrStdout(), sc.exitPolicy)
+	}
+	if sc.catalog, err = sc.loadCatalog(); err != nil {
 		return err
 	}
+	if sc.csvColumns, err = sc.loadCSVColumns(); err != nil {
+		return err
+	}
+	if sc.scoring, err = sc.loadScoringProfile(); err != nil {
+		return err
+	}
+	if sc.rules, err = config.Rules(sc.fileCfg); err != nil {
+		return exitError(ExitInvalidArgs, "stringer: %v", err)
+	}
+	if len(exps) > 0 {
+		sc.expect = sc.newExpectationChecker(exps)
+	}
 
-	// 3b. Cross-signal confidence enrichment.
-	pipeline.BoostColocatedSignals(sc.result.Signals)
+	// 2b. With --stream, signals go straight from the collectors to the
+	// output, skipping the analysis below that needs all of them at once.
+	if scanStream {
+		return sc.runStream()
+	}
 
-	// 4. Filter results (delta, beads dedup, confidence, kind).
-	sc.allSignals = sc.result.Signals
-	if err := sc.filterResults(); err != nil {
-		return err
+	// 3. Run pipeline per workspace and aggregate results. With --repos-file
+	// or --github-org, each repository is scanned and analyzed on its own.
+	if multiRepo {
+		if err := sc.scanRepos(); err != nil {
+			return err
+		}
+		if !quiet {
+			reportCollectorWarnings(cmd.ErrOrStderr(), sc.result.Results)
+		}
+	} else {
+		if err := sc.runPipeline(); err != nil {
+			return err
+		}
+		if !quiet {
+			reportCollectorWarnings(cmd.ErrOrStderr(), sc.result.Results)
+		}
+		if scanQuick && !quiet {
+			reportQuickSkips(cmd.ErrOrStderr(), scanQuickBudget, sc.result.Results, sc.skippedWS)
+		}
+
+		// 3a. With --fail-fast, a violation ends the scan before any output.
+		if scanFailFast && len(sc.violations) > 0 {
+			reportViolations(cmd.ErrOrStderr(), sc.violations, scanExpectZero, sc.result.StoppedEarly)
+			if sc.exitPolicy.Expectation == ExitOK {
+				return nil
+			}
+			return exitError(sc.exitPolicy.Expectation, "stringer: --expect-zero check failed")
+		}
+
+		if err := sc.analyze(profiles, coverageReport); err != nil {
+			return err
+		}
 	}
 
-	// 5. LLM-based analysis (priority inference, dependency detection).
-	if err := sc.runLLMAnalysis(); err != nil {
-		return err
+	// 6. Determine exit code from the conditions the scan met and the
+	// active exit policy. --fail-on judges the signals about to be written.
+	sc.failOnHits = checkFailOn(failOn, sc.result.Signals)
+	exitCode := sc.exitPolicy.code(sc.exitConditions())
+	if len(sc.violations) > 0 {
+		reportViolations(cmd.ErrOrStderr(), sc.violations, scanExpectZero, false)
 	}
+	reportFailOn(cmd.ErrOrStderr(), sc.failOnHits)
 
-	// 6. Determine exit code based on collector results.
-	exitCode := computeExitCode(sc.result, scanStrict)
+	// 6b. Prometheus metrics, written even on dry runs.
+	if scanMetricsOut != "" {
+		if err := sc.writeMetrics(scanMetricsOut); err != nil {
+			return err
+		}
+	}
 
 	// 7. Handle dry-run.
 	if scanDryRun {
 		return printDryRun(cmd, sc.result, exitCode, sc.suppressedCount, sc.workspaces)
 	}
 
-	// 8. Configure SARIF formatter with baseline state if applicable.
+	// 8. Configure formatters with label rules and scan state if applicable.
+	sc.configureLabelMap()
+	sc.configureCatalog()
+	sc.configureCSVColumns()
+	sc.configureJUnitFormatter()
+	sc.configureQuadrants()
+	sc.configureResults()
 	if sc.scanCfg.OutputFormat == "sarif" {
 		if err := sc.configureSARIFFormatter(); err != nil {
 			return err
 		}
 	}
 
-	// 9. Write formatted output.
-	if err := writeScanOutput(cmd, sc.result, sc.scanCfg); err != nil {
+	if sc.scanCfg.OutputFormat == "pr-comment" {
+		sc.configurePRCommentFormatter()
+	}
+
+	// 9. Write formatted output, one file per workspace with --split-by-workspace.
+	// Signals a rule routes elsewhere go to their own files first.
+	mainResult, err := sc.writeRoutedOutputs()
+	if err != nil {
+		return err
+	}
+	if scanSplitByWorkspace {
+		if err := writeWorkspaceOutputs(cmd, sc.scannedWorkspaces(), mainResult, sc.scanCfg, scanOutputDir); err != nil {
+			return err
+		}
+	} else if err := writeScanOutput(cmd, mainResult, sc.scanCfg); err != nil {
 		return err
 	}
 
@@ -207,9 +525,15 @@ func runScan(cmd *cobra.Command, args []string) error {
 		}
 	}
 
-	// 11. Save scan history (best-effort).
-	if err := saveHistory(absPath, sc.result, sc.workspaces); err != nil {
-		slog.Warn("failed to save scan history", "error", err)
+	// 11. Save scan history (best-effort). A multi-repo or --remote scan
+	// has no repository of its own to keep history in.
+	if !multiRepo && scanRemote == "" {
+		if err := saveHistory(absPath, sc.result, sc.workspaces); err != nil {
+			slog.Warn("failed to save scan history", "error", err)
+		}
+		if err := sc.recordLifecycle(absPath); err != nil {
+			slog.Warn("failed to record signal history", "error", err)
+		}
 	}
 
 	if exitCode != ExitOK {
@@ -218,18 +542,142 @@ func runScan(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// analyze post-processes the signals the pipeline collected: it resolves
+// cross-signal references, annotates, scores, filters, and optionally
+// enriches them with an LLM, then estimates their effort.
+func (sc *scanContext) analyze(profiles []*hotpath.Profile, coverageReport *coverage.Report) error {
+	// 3b. Cross-signal confidence enrichment, and TODOs resolved against
+	// the issues they reference.
+	pipeline.BoostColocatedSignals(sc.result.Signals)
+	sc.resolveReferences()
+
+	// 3c. Churn × coverage quadrants; the danger quadrant becomes signals.
+	if coverageReport != nil {
+		sc.classifyQuadrants(coverageReport)
+	}
+
+	// 3d. Hot path annotation from production profiles.
+	if len(profiles) > 0 {
+		n := hotpath.Annotate(sc.result.Signals, profiles, scanHotPathShare)
+		slog.Info("hot path annotation complete", "profiles", len(profiles), "signals", n)
+	}
+
+	// 3e. Deep links to the forge hosting the repository.
+	if n, err := forge.Annotate(sc.cmd.Context(), sc.result.Signals, sc.absPath, sc.gitRoot); err != nil {
+		slog.Warn("failed to link signals to the forge", "error", err)
+	} else if n > 0 {
+		slog.Info("forge links added", "signals", n)
+	}
+
+	// 3f. Owners from the repository's CODEOWNERS file.
+	if n, err := codeowners.Annotate(sc.cmd.Context(), sc.result.Signals, sc.absPath, sc.gitRoot); err != nil {
+		slog.Warn("failed to read CODEOWNERS", "error", err)
+	} else if n > 0 {
+		slog.Info("owners assigned from CODEOWNERS", "signals", n)
+	}
+
+	// 3g. Per-kind confidence calibration from recorded feedback.
+	if !scanNoCalibration {
+		if entries, err := feedback.Load(sc.absPath); err != nil {
+			slog.Warn("failed to load feedback", "error", err)
+		} else if n := feedback.Calibrate(entries).Apply(sc.result.Signals); n > 0 {
+			slog.Info("confidence calibrated from feedback", "signals", n)
+		}
+	}
+
+	// 3h. Scoring profile weights by kind and tag. Kinds weighted zero are
+	// dropped with the other output filters, so the signal history and
+	// delta state still see them.
+	sc.collected = slices.Clone(sc.result.Signals)
+	if len(sc.scoring.Weights) > 0 {
+		n := sc.scoring.Weigh(sc.result.Signals)
+		slog.Info("scoring profile applied", "profile", sc.scoring.Name, "signals", n)
+	}
+
+	// 3i. Priority from confidence, age, and file churn, so rules can
+	// override it.
+	if pc, ok := config.Priority(sc.fileCfg); ok {
+		n := priority.Annotate(sc.result.Signals, pc, time.Now())
+		slog.Info("priorities assigned", "signals", n)
+	}
+
+	// 3j. Config rules tag, prioritize, and drop signals.
+	if len(sc.rules) > 0 {
+		var dropped int
+		sc.result.Signals, dropped = sc.rules.Apply(sc.result.Signals)
+		slog.Info("rules applied", "rules", len(sc.rules), "dropped", dropped)
+	}
+
+	// 4. Filter results (delta, beads dedup, confidence, kind).
+	sc.allSignals = sc.result.Signals
+	if err := sc.filterResults(); err != nil {
+		return err
+	}
+
+	// 4a. Optional LLM enrichment of titles and descriptions.
+	if err := sc.runEnrichment(); err != nil {
+		return err
+	}
+
+	// 4b. Group related TODOs, then signals of similar kinds in one
+	// module, into suggested epics.
+	if ec, enabled := config.Epics(sc.fileCfg); scanEpics || enabled {
+		epics := analysis.BuildEpics(sc.result.Signals, ec)
+		sc.result.Signals = append(sc.result.Signals, epics...)
+		slog.Info("epics suggested", "count", len(epics))
+	}
+
+	// 5. LLM-based analysis (priority inference, dependency detection).
+	if err := sc.runLLMAnalysis(); err != nil {
+		return err
+	}
+
+	// 5b. Rough effort bucket per signal for the exporters' estimate fields.
+	n := estimate.Annotate(sc.result.Signals, sc.allSignals, sc.absPath)
+	slog.Info("effort estimated", "signals", n)
+	return nil
+}
+
 // runPipeline runs the scan pipeline for each workspace and aggregates results.
 func (sc *scanContext) runPipeline() error {
-	for _, ws := range sc.workspaces {
+	// Every workspace shares one GitHub cache and request budget, and the
+	// collectors walking source files share each directory's walk.
+	gh := collectors.NewGitHubData(scanGitHubBudget)
+	ctx, stop := interruptible(collectors.WithFileTrees(collectors.WithGitHubData(sc.cmd.Context(), gh), collectors.NewFileTrees()))
+	defer stop()
+	cp := sc.openCheckpoint()
+	defer func() {
+		slog.Debug("GitHub API requests", "count", gh.Requests())
+		if gh.Exhausted() {
+			slog.Warn("GitHub API request budget used up; GitHub results are partial (raise --github-budget)", "budget", scanGitHubBudget)
+		}
+	}()
+
+	for i, ws := range sc.workspaces {
+		if i > 0 && sc.pastDeadline() {
+			sc.skipWorkspaces(sc.workspaces[i:])
+			break
+		}
 		wsPath := ws.Path
 		if ws.Name != "" {
 			slog.Info("scanning workspace", "name", ws.Name, "path", ws.Rel)
 		}
 
-		wsCfg, _, err := loadScanConfig(sc.cmd, wsPath, sc.gitRoot)
+		wsCfg, wsFileCfg, err := loadScanConfig(sc.cmd, wsPath, sc.gitRoot, sc.policy)
 		if err != nil {
 			return err
 		}
+		if sc.sinceBase != "" {
+			files, err := sinceRefFiles(ctx, wsPath, sc.sinceBase)
+			if err != nil {
+				return exitError(ExitTotalFailure, "stringer: cannot list files changed since %s (%v)", scanSinceRef, err)
+			}
+			slog.Info("changed files since ref", "ref", scanSinceRef, "files", len(files))
+			if !applySinceRef(&wsCfg, files) {
+				slog.Info("no changed files to scan", "path", wsPath)
+				continue
+			}
+		}
 
 		p, err := pipeline.New(wsCfg)
 		if err != nil {
@@ -237,6 +685,20 @@ func (sc *scanContext) runPipeline() error {
 			sort.Strings(available)
 			return exitError(ExitInvalidArgs, "stringer: %v (available: %s)", err, strings.Join(available, ", "))
 		}
+		if scanFailFast && sc.expect != nil {
+			p.StopWhen(func(s signal.RawSignal) bool {
+				stamped := []signal.RawSignal{s}
+				stampWorkspace(ws, stamped)
+				return sc.expect.violates(stamped[0])
+			})
+		}
+		if !sc.deadline.IsZero() {
+			// The first workspace always runs, even if startup spent the budget.
+			p.StopAfter(max(time.Until(sc.deadline), time.Nanosecond))
+		}
+		if cp != nil {
+			p.Checkpoint(cp.Workspace(ws.Rel))
+		}
 
 		cn := wsCfg.Collectors
 		if len(cn) == 0 {
@@ -248,16 +710,23 @@ func (sc *scanContext) runPipeline() error {
 		}
 		slog.Info("scanning", "collectors", len(cn))
 
-		wsResult, err := p.Run(sc.cmd.Context())
+		wsResult, err := p.Run(ctx)
+		if errors.Is(context.Cause(ctx), errInterrupted) {
+			return interruptedError(cp)
+		}
 		if err != nil {
 			return exitError(ExitTotalFailure, "stringer: scan failed (%v)", err)
 		}
 
+		// Files both changed often and large or complex become hotspots.
+		wsResult.Signals = append(wsResult.Signals, hotspotSignals(wsResult, wsPath, sc.gitRoot, wsFileCfg.Hotspots)...)
+
 		// Stamp workspace on signals and adjust file paths.
 		stampWorkspace(ws, wsResult.Signals)
 		for i := range wsResult.Results {
 			stampWorkspace(ws, wsResult.Results[i].Signals)
 		}
+		sc.recordCoverage(ws, wsCfg, wsResult.Results)
 
 		// Aggregate into combined result.
 		sc.result.Signals = append(sc.result.Signals, wsResult.Signals...)
@@ -266,20 +735,51 @@ func (sc *scanContext) runPipeline() error {
 		for k, v := range wsResult.Metrics {
 			sc.result.Metrics[k] = v
 		}
+
+		if sc.expect != nil {
+			for _, s := range wsResult.Signals {
+				if sc.expect.violates(s) {
+					sc.violations = append(sc.violations, s)
+				}
+			}
+		}
+		if wsResult.StoppedEarly {
+			sc.result.StoppedEarly = true
+			if sc.pastDeadline() {
+				sc.skipWorkspaces(sc.workspaces[i+1:])
+			}
+			break
+		}
+	}
+
+	// Each workspace's pipeline counted its own errors; count them again
+	// across all workspaces.
+	delete(sc.result.Metrics, signal.ErrorsMetric)
+	if counts := sc.result.ErrorCounts(); len(counts) > 0 {
+		sc.result.Metrics[signal.ErrorsMetric] = counts
 	}
+	sc.closeCheckpoint(cp)
 
 	for _, cr := range sc.result.Results {
+		if cr.SkipReason != "" {
+			continue // already logged by the capability check phase
+		}
 		if cr.Err != nil {
-			slog.Error("collector failed", "name", cr.Collector, "error", cr.Err, "duration", cr.Duration)
+			slog.Error("collector failed", "name", cr.Collector, "category", cr.Category(), "error", cr.Err, "duration", cr.Duration)
 		} else {
 			slog.Info("collector complete", "name", cr.Collector, "signals", len(cr.Signals), "duration", cr.Duration)
 		}
 	}
 
-	// Warn when an explicitly requested collector produced no signals and no error.
+	// Warn when an explicitly requested collector produced no signals and no
+	// error. Skipped collectors are reported with their reason instead.
 	if scanCollectors != "" {
 		resultByName := make(map[string]bool)
 		for _, cr := range sc.result.Results {
+			if cr.SkipReason != "" {
+				slog.Warn("requested collector was skipped", "name", cr.Collector, "reason", cr.SkipReason)
+				resultByName[cr.Collector] = true
+			}
 			if cr.Err == nil && len(cr.Signals) > 0 {
 				resultByName[cr.Collector] = true
 			}
@@ -295,16 +795,118 @@ func (sc *scanContext) runPipeline() error {
 	return nil
 }
 
+// resolveReferences drops TODOs whose referenced issue is open and active,
+// boosts those whose issue is stale or closed, and links the issues and
+// tracker keys TODOs mention. "#123" links to the origin forge's issues
+// and "PROJ-456" to collectors.todos.tracker_url.
+func (sc *scanContext) resolveReferences() {
+	repo, hasForge := forge.Origin(sc.cmd.Context(), sc.gitRoot)
+	trackerURL := ""
+	if sc.fileCfg != nil {
+		trackerURL = strings.TrimRight(sc.fileCfg.Collectors["todos"].TrackerURL, "/")
+	}
+	link := func(ref string) string {
+		if n, err := strconv.Atoi(strings.TrimPrefix(ref, "#")); err == nil && strings.HasPrefix(ref, "#") {
+			if hasForge {
+				return repo.IssueURL(n)
+			}
+			return ""
+		}
+		if trackerURL != "" && !strings.Contains(ref, ".") {
+			return trackerURL + "/" + ref
+		}
+		return ""
+	}
+
+	var tracked, boosted int
+	sc.result.Signals, tracked, boosted = pipeline.ResolveReferences(sc.result.Signals, link)
+	if tracked > 0 || boosted > 0 {
+		slog.Info("TODO references resolved", "tracked", tracked, "stale", boosted)
+	}
+}
+
+// llmConfig returns the scan's llm settings. base_url decides which host
+// gets the API key and the signals, and enrich whether a scan calls the LLM
+// at all, so both come only from the global config: a repository's
+// .stringer.yaml sets the provider, model, and duplicate threshold.
+func (sc *scanContext) llmConfig() config.LLMConfig {
+	if sc.llm != nil {
+		return *sc.llm
+	}
+	var lc config.LLMConfig
+	if sc.fileCfg != nil && sc.fileCfg.LLM != nil {
+		lc = *sc.fileCfg.LLM
+		if lc.BaseURL != "" || lc.Enrich {
+			slog.Warn("llm.base_url and llm.enrich are ignored in "+config.FileName+"; set them in the global config", "path", config.GlobalConfigPath())
+		}
+		lc.BaseURL, lc.Enrich = "", false
+	}
+	global, err := config.LoadGlobal()
+	if err != nil {
+		slog.Warn("failed to load global config", "path", config.GlobalConfigPath(), "error", err)
+	} else if global.LLM != nil {
+		lc.BaseURL, lc.Enrich = global.LLM.BaseURL, global.LLM.Enrich
+	}
+	sc.llm = &lc
+	return lc
+}
+
+// llmProvider creates the configured LLM provider, Anthropic by default.
+func (sc *scanContext) llmProvider() (llm.Provider, error) {
+	lc := sc.llmConfig()
+	provider, err := llm.New(lc.Provider, lc.Model, lc.BaseURL)
+	if err != nil {
+		return nil, exitError(ExitInvalidArgs, "stringer: cannot set up the LLM provider (%v)", err)
+	}
+	return provider, nil
+}
+
+// runEnrichment rewrites signal titles, drafts acceptance criteria, and
+// merges near-duplicates with an LLM when --enrich or llm.enrich asks for
+// it. Enrichments are cached in .stringer/llm-cache.json. An LLM failure
+// is a warning; the signals enriched so far are kept.
+func (sc *scanContext) runEnrichment() error {
+	lc := sc.llmConfig()
+	if sc.scanCfg.NoLLM || (!scanEnrich && !lc.Enrich) {
+		return nil
+	}
+
+	provider, err := sc.llmProvider()
+	if err != nil {
+		return err
+	}
+
+	name := lc.Provider
+	if name == "" {
+		name = "anthropic"
+	}
+	cache := analysis.LoadEnrichCache(sc.absPath)
+	var stats analysis.EnrichStats
+	sc.result.Signals, stats, err = analysis.EnrichSignals(sc.cmd.Context(), sc.result.Signals, provider, analysis.EnrichConfig{
+		Model:              name + "/" + lc.Model,
+		DuplicateThreshold: lc.DuplicateThreshold,
+		Cache:              cache,
+	})
+	if err != nil {
+		slog.Warn("signal enrichment error", "error", err)
+	}
+	if err := cache.Save(); err != nil {
+		slog.Warn("failed to save LLM cache", "error", err)
+	}
+	slog.Info("signals enriched", "enriched", stats.Enriched, "cached", stats.Cached, "merged", stats.Merged)
+	return nil
+}
+
 // runLLMAnalysis runs optional LLM-based priority inference and dependency
 // detection on the scan results.
 func (sc *scanContext) runLLMAnalysis() error {
-	if !scanInferPriority && !scanInferDeps {
+	if sc.scanCfg.NoLLM || (!scanInferPriority && !scanInferDeps) {
 		return nil
 	}
 
-	provider, provErr := llm.NewAnthropicProvider()
-	if provErr != nil {
-		return exitError(ExitInvalidArgs, "stringer: LLM features require ANTHROPIC_API_KEY (%v)", provErr)
+	provider, err := sc.llmProvider()
+	if err != nil {
+		return err
 	}
 
 	if scanInferPriority {
@@ -338,6 +940,80 @@ func (sc *scanContext) runLLMAnalysis() error {
 	return nil
 }
 
+// loadProfiles reads the --profile files. Patterns may be globs; a pattern
+// matching nothing is an error so a typo does not silently skip annotation.
+func loadProfiles(patterns []string) ([]*hotpath.Profile, error) {
+	var profiles []*hotpath.Profile
+	for _, pattern := range patterns {
+		matches, err := filepath.Glob(pattern)
+		if err != nil {
+			return nil, exitError(ExitInvalidArgs, "stringer: invalid --profile pattern %q (%v)", pattern, err)
+		}
+		if len(matches) == 0 {
+			return nil, exitError(ExitInvalidArgs, "stringer: no profile matches %q", pattern)
+		}
+		for _, m := range matches {
+			p, err := hotpath.Load(m)
+			if err != nil {
+				return nil, exitError(ExitInvalidArgs, "stringer: cannot read profile (%v)", err)
+			}
+			profiles = append(profiles, p)
+		}
+	}
+	return profiles, nil
+}
+
+// loadCoverage reads and merges the --coverage reports. As with --profile,
+// patterns may be globs and a pattern matching nothing is an error. It
+// returns nil when no reports were given.
+func loadCoverage(patterns []string) (*coverage.Report, error) {
+	var reports []*coverage.Report
+	for _, pattern := range patterns {
+		matches, err := filepath.Glob(pattern)
+		if err != nil {
+			return nil, exitError(ExitInvalidArgs, "stringer: invalid --coverage pattern %q (%v)", pattern, err)
+		}
+		if len(matches) == 0 {
+			return nil, exitError(ExitInvalidArgs, "stringer: no coverage report matches %q", pattern)
+		}
+		for _, m := range matches {
+			r, err := coverage.Load(m)
+			if err != nil {
+				return nil, exitError(ExitInvalidArgs, "stringer: cannot read coverage report (%v)", err)
+			}
+			reports = append(reports, r)
+		}
+	}
+	if len(reports) == 0 {
+		return nil, nil
+	}
+	return coverage.Merge(reports...), nil
+}
+
+// classifyQuadrants places the churned files on the churn × coverage grid
+// and appends a risk-quadrant signal for each file in the danger quadrant.
+// Churn comes from the gitlog collector's metrics, so without it there is
+// nothing to classify.
+func (sc *scanContext) classifyQuadrants(report *coverage.Report) {
+	gm, ok := sc.result.Metrics["gitlog"].(*collectors.GitlogMetrics)
+	if !ok || gm == nil {
+		slog.Warn("--coverage needs the gitlog collector for churn data; skipping risk quadrants")
+		return
+	}
+	churn := make([]coverage.Churn, len(gm.FileChurns))
+	for i, fc := range gm.FileChurns {
+		churn[i] = coverage.Churn{Path: fc.Path, Changes: fc.ChangeCount}
+	}
+	sc.quadrants = coverage.Classify(churn, report)
+	if len(sc.quadrants.Files) == 0 {
+		slog.Warn("no churned file appears in the coverage reports; check that they cover this repository")
+		return
+	}
+	sigs := sc.quadrants.Signals()
+	sc.result.Signals = append(sc.result.Signals, sigs...)
+	slog.Info("risk quadrants classified", "files", len(sc.quadrants.Files), "danger", len(sigs))
+}
+
 // resolveScanPath resolves the given path argument into an absolute path and
 // finds the nearest git root by walking up the directory tree. For non-git
 // directories, gitRoot equals absPath.
@@ -381,8 +1057,9 @@ func resolveScanPath(repoPath string) (absPath, gitRoot string, err error) {
 // loadScanConfig builds the merged ScanConfig from CLI flags and file config.
 // It parses the collectors flag, loads the config file, merges them, sets the
 // git root for subdirectory scans, applies defaults, validates the output
-// format, and wires CLI flag overrides into per-collector options.
-func loadScanConfig(cmd *cobra.Command, absPath, gitRoot string) (signal.ScanConfig, *config.Config, error) {
+// format, and wires CLI flag overrides into per-collector options. A non-nil
+// pol is enforced against the file config and merged above it.
+func loadScanConfig(cmd *cobra.Command, absPath, gitRoot string, pol *policy.Policy) (signal.ScanConfig, *config.Config, error) {
 	// Parse collectors flag.
 	var collectors []string
 	if scanCollectors != "" {
@@ -391,6 +1068,17 @@ func loadScanConfig(cmd *cobra.Command, absPath, gitRoot string) (signal.ScanCon
 			collectors[i] = strings.TrimSpace(collectors[i])
 		}
 	}
+	// --fail-fast runs only the collectors that can break an expectation.
+	// A policy's mandatory collectors must still run, so it disables this.
+	if len(collectors) == 0 && scanFailFast && pol == nil {
+		if exps, err := parseExpectations(scanExpectZero); err == nil {
+			collectors = collectorsForExpectations(exps)
+		}
+	}
+	// --quick runs only fast local collectors unless --collectors says otherwise.
+	if len(collectors) == 0 && scanQuick {
+		collectors = quickCollectorsFor(pol)
+	}
 	collectors = applyCollectorExclusions(collectors, scanExcludeCollectors)
 
 	// Load config file.
@@ -401,6 +1089,17 @@ func loadScanConfig(cmd *cobra.Command, absPath, gitRoot string) (signal.ScanCon
 	if err := config.Validate(fileCfg); err != nil {
 		return signal.ScanConfig{}, nil, exitError(ExitInvalidArgs, "stringer: %v", err)
 	}
+	if fileCfg, err = applyPolicy(pol, collectors, fileCfg); err != nil {
+		return signal.ScanConfig{}, nil, err
+	}
+	// A --remote tarball's config is never trusted to run code.
+	plugins := fileCfg
+	if scanNoPlugins || scanRemote != "" {
+		plugins = &config.Config{}
+	}
+	if err := registerPlugins(plugins, absPath); err != nil {
+		return signal.ScanConfig{}, nil, err
+	}
 
 	// Build CLI scan config (only set OutputFormat if explicitly passed).
 	cliFormat := ""
@@ -414,6 +1113,9 @@ func loadScanConfig(cmd *cobra.Command, absPath, gitRoot string) (signal.ScanCon
 		NoLLM:           scanNoLLM,
 		ExcludePatterns: scanExclude,
 		MaxIssues:       scanMaxIssues,
+		NoCache:         scanNoCache,
+		NoGitignore:     scanNoGitignore,
+		Jobs:            scanJobs,
 	}
 
 	// Merge file config into CLI config.
@@ -479,9 +1181,16 @@ func loadScanConfig(cmd *cobra.Command, absPath, gitRoot string) (signal.ScanCon
 		IncludeDemoPaths: scanIncludeDemoPaths,
 		CollectorTimeout: scanCollectorTimeout,
 		Paths:            scanPaths,
+		MaxDepth:         scanMaxDepth,
 		IncludeClosed:    scanIncludeClosed,
 		HistoryDepth:     scanHistoryDepth,
+		TestReports:      scanTestReports,
+		LintReports:      scanLintReports,
+		GitHubRepo:       remoteGitHubRepo(scanRemote),
 	})
+	if scanQuick {
+		applyQuickCaps(&scanCfg)
+	}
 
 	return scanCfg, fileCfg, nil
 }
@@ -508,6 +1217,7 @@ func (sc *scanContext) filterResults() error {
 		if prevState != nil {
 			currentState := state.Build(sc.absPath, sc.collectorNames, sc.allSignals)
 			diff := state.ComputeDiff(prevState, currentState)
+			sc.resolved = diff.Removed
 			if err := state.FormatDiff(diff, sc.absPath, sc.cmd.ErrOrStderr()); err != nil {
 				slog.Warn("failed to write diff summary", "error", err)
 			}
@@ -521,6 +1231,13 @@ func (sc *scanContext) filterResults() error {
 		}
 	}
 
+	// Scoring profile: drop the kinds it weights zero.
+	if len(sc.scoring.Weights) > 0 {
+		var dropped int
+		sc.result.Signals, dropped = sc.scoring.Filter(sc.result.Signals)
+		slog.Info("scoring profile filter", "profile", sc.scoring.Name, "dropped", dropped)
+	}
+
 	// Beads-aware dedup: filter signals already tracked as beads.
 	beadsAwareEnabled := sc.fileCfg.BeadsAware == nil || *sc.fileCfg.BeadsAware
 	if beadsAwareEnabled {
@@ -566,6 +1283,7 @@ func (sc *scanContext) filterResults() error {
 	// SARIF suppressions instead of filtering signals out.
 	if !scanNoBaseline {
 		blState, blErr := baseline.Load(sc.absPath)
+		blState = applyPolicySuppressions(sc.policy, blState)
 		if blErr != nil {
 			slog.Warn("failed to load baseline", "error", blErr)
 		} else if blState != nil {
@@ -611,6 +1329,33 @@ func (sc *scanContext) filterResults() error {
 		sc.result.Signals = filtered
 	}
 
+	// Baseline comparison: keep only signals the --baseline output lacks.
+	if scanBaseline != "" {
+		added, diff := scandiff.NewSignals(sc.previousScan, sc.result.Signals)
+		slog.Info("baseline comparison", "total", len(sc.result.Signals), "new", len(added))
+		sc.result.Signals = added
+		if err := scandiff.RenderSummary(diff, sc.cmd.ErrOrStderr()); err != nil {
+			slog.Warn("failed to write baseline comparison", "error", err)
+		}
+	}
+
+	return nil
+}
+
+// writeMetrics writes the scan's Prometheus metrics to path. Signal counts
+// cover every signal found, before delta, baseline, and output filters, so
+// graphs follow the repository rather than the flags of each run.
+func (sc *scanContext) writeMetrics(path string) error {
+	all := *sc.result
+	all.Signals = sc.allSignals
+	var buf bytes.Buffer
+	if err := promexport.Write(&buf, &all); err != nil {
+		return exitError(ExitTotalFailure, "stringer: failed to render metrics (%v)", err)
+	}
+	if err := cmdFS.WriteFile(path, buf.Bytes(), 0o600); err != nil {
+		return exitError(ExitInvalidArgs, "stringer: cannot write metrics file %q (%v)", path, err)
+	}
+	slog.Info("metrics written", "file", path)
 	return nil
 }
 
@@ -649,31 +1394,45 @@ func writeScanOutput(cmd *cobra.Command, result *signal.ScanResult, scanCfg sign
 	return nil
 }
 
-// computeExitCode returns the appropriate exit code based on collector results.
-// When strict is true, partial failures return ExitPartialFailure instead of ExitOK.
-func computeExitCode(result *signal.ScanResult, strict bool) int {
-	if len(result.Results) == 0 {
-		return ExitOK
+// writeRoutedOutputs writes the signals config rules route to other files,
+// in the scan's output format, and returns the result with the signals
+// left for the main output. Outputs are relative to the scanned directory,
+// and only files an earlier scan wrote are overwritten. History and delta
+// state still see every signal.
+func (sc *scanContext) writeRoutedOutputs() (*signal.ScanResult, error) {
+	main, routed := sc.rules.Route(sc.result.Signals)
+	if len(routed) == 0 {
+		return sc.result, nil
 	}
-
-	failCount := 0
-	for _, cr := range result.Results {
-		if cr.Err != nil {
-			failCount++
+	formatter, _ := output.GetFormatter(sc.scanCfg.OutputFormat) // already validated in loadScanConfig
+	outs, err := openRuleOutputs(sc.absPath)
+	if err != nil {
+		return nil, exitError(ExitTotalFailure, "stringer: cannot open %s for rules outputs (%v)", sc.absPath, err)
+	}
+	defer outs.close()
+	for _, out := range sc.rules.Outputs() {
+		signals, ok := routed[out]
+		if !ok {
+			continue
 		}
+		if err := outs.write(formatter, signals, filepath.FromSlash(out)); err != nil {
+			return nil, err
+		}
+		slog.Info("signals routed by rules", "output", out, "issues", len(signals))
 	}
-
-	switch {
-	case failCount == 0:
-		return ExitOK
-	case failCount == len(result.Results):
-		return ExitTotalFailure
-	case strict:
-		return ExitPartialFailure
-	default:
-		// Non-strict: partial failures are OK, exit 0.
-		return ExitOK
+	if err := outs.save(); err != nil {
+		slog.Warn("failed to record rules outputs", "error", err)
 	}
+	mainResult := *sc.result
+	mainResult.Signals = main
+	return &mainResult, nil
+}
+
+// computeExitCode returns the appropriate exit code based on collector results.
+// When strict is true, partial failures return ExitPartialFailure instead of ExitOK.
+func computeExitCode(result *signal.ScanResult, strict bool) int {
+	partial, total := collectorConditions(result)
+	return defaultExitPolicy(strict).code(exitConditions{PartialFailure: partial, TotalFailure: total})
 }
 
 // workspaceSummary describes a workspace for dry-run output.
@@ -686,23 +1445,30 @@ type workspaceSummary struct {
 func printDryRun(cmd *cobra.Command, result *signal.ScanResult, exitCode int, suppressedCount int, workspaces []workspaceEntry) error {
 	if scanJSON {
 		type collectorSummary struct {
-			Name     string `json:"name"`
-			Signals  int    `json:"signals"`
-			Duration string `json:"duration"`
-			Error    string `json:"error,omitempty"`
+			Name     string   `json:"name"`
+			Signals  int      `json:"signals"`
+			Duration string   `json:"duration"`
+			Error    string   `json:"error,omitempty"`
+			Category string   `json:"error_category,omitempty"`
+			Skipped  string   `json:"skipped,omitempty"`
+			Retried  string   `json:"retried,omitempty"`
+			Warnings []string `json:"warnings,omitempty"`
+			Resumed  bool     `json:"resumed,omitempty"`
 		}
 		type dryRunOutput struct {
-			TotalSignals    int                `json:"total_signals"`
-			SuppressedCount int                `json:"suppressed_count"`
-			Collectors      []collectorSummary `json:"collectors"`
-			Workspaces      []workspaceSummary `json:"workspaces,omitempty"`
-			Duration        string             `json:"duration"`
-			ExitCode        int                `json:"exit_code"`
+			TotalSignals    int                          `json:"total_signals"`
+			SuppressedCount int                          `json:"suppressed_count"`
+			Collectors      []collectorSummary           `json:"collectors"`
+			ErrorCounts     map[signal.ErrorCategory]int `json:"error_counts,omitempty"`
+			Workspaces      []workspaceSummary           `json:"workspaces,omitempty"`
+			Duration        string                       `json:"duration"`
+			ExitCode        int                          `json:"exit_code"`
 		}
 
 		out := dryRunOutput{
 			TotalSignals:    len(result.Signals),
 			SuppressedCount: suppressedCount,
+			ErrorCounts:     result.ErrorCounts(),
 			Duration:        result.Duration.String(),
 			ExitCode:        exitCode,
 		}
@@ -714,7 +1480,12 @@ func printDryRun(cmd *cobra.Command, result *signal.ScanResult, exitCode int, su
 			}
 			if cr.Err != nil {
 				cs.Error = cr.Err.Error()
+				cs.Category = string(cr.Category())
 			}
+			cs.Skipped = cr.SkipReason
+			cs.Retried = cr.RetryReason
+			cs.Warnings = cr.Warnings
+			cs.Resumed = cr.Resumed
 			out.Collectors = append(out.Collectors, cs)
 		}
 		for _, ws := range workspaces {
@@ -734,9 +1505,22 @@ func printDryRun(cmd *cobra.Command, result *signal.ScanResult, exitCode int, su
 	} else {
 		_, _ = fmt.Fprintf(cmd.OutOrStdout(), "stringer: dry run — %d signal(s) found\n", len(result.Signals))
 		for _, cr := range result.Results {
+			if cr.SkipReason != "" {
+				_, _ = fmt.Fprintf(cmd.OutOrStdout(), "  %s: skipped — %s\n", cr.Collector, cr.SkipReason)
+				continue
+			}
 			status := fmt.Sprintf("%d signals", len(cr.Signals))
 			if cr.Err != nil {
-				status = fmt.Sprintf("error: %v", cr.Err)
+				status = fmt.Sprintf("error (%s): %v", cr.Category(), cr.Err)
+			}
+			if cr.RetryReason != "" {
+				status += fmt.Sprintf(", retried after: %s", cr.RetryReason)
+			}
+			if n := len(cr.Warnings); n > 0 {
+				status += fmt.Sprintf(", %d warning(s)", n)
+			}
+			if cr.Resumed {
+				status += ", resumed from checkpoint"
 			}
 			_, _ = fmt.Fprintf(cmd.OutOrStdout(), "  %s: %s (%s)\n", cr.Collector, status, cr.Duration.Round(1_000_000))
 		}
@@ -759,6 +1543,171 @@ func printDryRun(cmd *cobra.Command, result *signal.ScanResult, exitCode int, su
 	return nil
 }
 
+// maxListedWarnings caps the warnings reportCollectorWarnings lists per
+// collector; the rest are counted.
+const maxListedWarnings = 5
+
+// reportCollectorWarnings summarizes the warnings each collector logged,
+// which are easy to miss among the scan's other log output.
+func reportCollectorWarnings(w io.Writer, results []signal.CollectorResult) {
+	var order []string
+	byCollector := make(map[string][]string)
+	for _, cr := range results {
+		if len(cr.Warnings) == 0 {
+			continue
+		}
+		if _, seen := byCollector[cr.Collector]; !seen {
+			order = append(order, cr.Collector)
+		}
+		byCollector[cr.Collector] = append(byCollector[cr.Collector], cr.Warnings...)
+	}
+	for _, name := range order {
+		warnings := byCollector[name]
+		noun := "warnings"
+		if len(warnings) == 1 {
+			noun = "warning"
+		}
+		_, _ = fmt.Fprintf(w, "stringer: %d %s from %s collector:\n", len(warnings), noun, name)
+		for _, msg := range warnings[:min(len(warnings), maxListedWarnings)] {
+			_, _ = fmt.Fprintf(w, "  - %s\n", msg)
+		}
+		if rest := len(warnings) - maxListedWarnings; rest > 0 {
+			_, _ = fmt.Fprintf(w, "  ... and %d more (see the log above)\n", rest)
+		}
+	}
+}
+
+// pastDeadline reports whether the --quick time budget is spent.
+func (sc *scanContext) pastDeadline() bool {
+	return !sc.deadline.IsZero() && !time.Now().Before(sc.deadline)
+}
+
+// skipWorkspaces records workspaces left unscanned by --quick.
+func (sc *scanContext) skipWorkspaces(rest []workspaceEntry) {
+	for _, ws := range rest {
+		if ws.Name != "" {
+			sc.skippedWS = append(sc.skippedWS, ws.Name)
+		}
+	}
+}
+
+// newExpectationChecker prepares the --expect-zero check, ignoring signals
+// suppressed in the baseline unless --no-baseline is set.
+func (sc *scanContext) newExpectationChecker(exps []expectation) *expectationChecker {
+	c := &expectationChecker{exps: exps, minConfidence: scanMinConfidence}
+	if scanNoBaseline {
+		return c
+	}
+	blState, err := baseline.Load(sc.absPath)
+	if err != nil {
+		slog.Warn("failed to load baseline for --expect-zero", "error", err)
+		return c
+	}
+	c.suppressed = baseline.Lookup(applyPolicySuppressions(sc.policy, blState))
+	return c
+}
+
+// configureLabelMap passes the configured label rules to the output
+// formatter. Formatters are registered once per process, so the map is
+// always set, clearing any rules left from an earlier scan.
+func (sc *scanContext) configureLabelMap() {
+	formatter, _ := output.GetFormatter(sc.scanCfg.OutputFormat)
+	if lm, ok := formatter.(output.LabelMapper); ok {
+		lm.SetLabelMap(config.LabelMap(sc.fileCfg))
+	}
+}
+
+// loadCatalog returns the message catalog for the report language. --lang
+// wins over the lang config key; a catalog file named in the config is
+// relative to the scanned directory.
+func (sc *scanContext) loadCatalog() (*i18n.Catalog, error) {
+	lang, source := scanLang, "--lang"
+	if lang == "" {
+		lang, source = sc.fileCfg.Lang, "lang"
+		if i18n.IsCatalogPath(lang) && !filepath.IsAbs(lang) {
+			lang = filepath.Join(sc.absPath, lang)
+		}
+	}
+	c, err := i18n.Load(lang)
+	if err != nil {
+		return nil, exitError(ExitInvalidArgs, "stringer: %s: %v", source, err)
+	}
+	return c, nil
+}
+
+// configureCatalog passes the report language to the output formatter. As
+// with label rules, it is always set so an earlier scan's language does not
+// carry over.
+func (sc *scanContext) configureCatalog() {
+	formatter, _ := output.GetFormatter(sc.scanCfg.OutputFormat)
+	if l, ok := formatter.(output.Localizer); ok {
+		l.SetCatalog(sc.catalog)
+	}
+}
+
+// loadCSVColumns returns the columns for the csv format, or nil for the
+// defaults. --columns wins over the csv_columns config key.
+func (sc *scanContext) loadCSVColumns() ([]string, error) {
+	if scanColumns != "" {
+		cols, err := output.ParseCSVColumns(scanColumns)
+		if err != nil {
+			return nil, exitError(ExitInvalidArgs, "stringer: --columns: %v", err)
+		}
+		return cols, nil
+	}
+	return sc.fileCfg.CSVColumns, nil
+}
+
+// loadScoringProfile resolves the scoring profile from --scoring-profile,
+// falling back to the config file's scoring_profile.
+func (sc *scanContext) loadScoringProfile() (scoring.Profile, error) {
+	if scanScoringProfile != "" {
+		p, err := config.ScoringProfile(scanScoringProfile, sc.fileCfg.ScoringProfiles)
+		if err != nil {
+			return scoring.Profile{}, exitError(ExitInvalidArgs, "stringer: --scoring-profile: %v", err)
+		}
+		return p, nil
+	}
+	p, _ := config.ScoringProfile(sc.fileCfg.ScoringProfile, sc.fileCfg.ScoringProfiles) // validated by config.Validate
+	return p, nil
+}
+
+// configureCSVColumns passes the column selection to the csv formatter,
+// restoring the defaults when none is configured.
+func (sc *scanContext) configureCSVColumns() {
+	formatter, _ := output.GetFormatter("csv")
+	if cf, ok := formatter.(*output.CSVFormatter); ok {
+		_ = cf.SetColumns(sc.csvColumns) // validated by loadCSVColumns and config.Validate
+	}
+}
+
+// configureJUnitFormatter passes the --junit-skip-below threshold to the
+// junit formatter.
+func (sc *scanContext) configureJUnitFormatter() {
+	formatter, _ := output.GetFormatter("junit")
+	if jf, ok := formatter.(*output.JUnitFormatter); ok {
+		jf.SkipBelow = scanJUnitSkipBelow
+	}
+}
+
+// configureQuadrants passes the churn × coverage grid to the output
+// formatter, clearing it when --coverage was not given.
+func (sc *scanContext) configureQuadrants() {
+	formatter, _ := output.GetFormatter(sc.scanCfg.OutputFormat)
+	if qr, ok := formatter.(output.QuadrantReporter); ok {
+		qr.SetQuadrants(sc.quadrants)
+	}
+}
+
+// configureResults passes the collector results to the output formatter so
+// it can report failed collectors and their error categories.
+func (sc *scanContext) configureResults() {
+	formatter, _ := output.GetFormatter(sc.scanCfg.OutputFormat)
+	if rr, ok := formatter.(output.ResultsReporter); ok {
+		rr.SetResults(sc.result.Results)
+	}
+}
+
 // configureSARIFFormatter sets baseline state and SARIF baseline on the
 // registered SARIF formatter so it can emit suppressions and baselineState.
 func (sc *scanContext) configureSARIFFormatter() error {
@@ -786,6 +1735,37 @@ func (sc *scanContext) configureSARIFFormatter() error {
 	return nil
 }
 
+// configurePRCommentFormatter passes delta results and the signal budget to
+// the registered pr-comment formatter.
+func (sc *scanContext) configurePRCommentFormatter() {
+	formatter, _ := output.GetFormatter("pr-comment")
+	pf, ok := formatter.(*output.PRCommentFormatter)
+	if !ok {
+		return
+	}
+
+	pf.Delta = scanDelta
+	pf.Budget = sc.effectiveBudget()
+	pf.Resolved = make([]signal.RawSignal, 0, len(sc.resolved))
+	for _, m := range sc.resolved {
+		pf.Resolved = append(pf.Resolved, signal.RawSignal{
+			Source:   m.Source,
+			Kind:     m.Kind,
+			FilePath: m.FilePath,
+			Line:     m.Line,
+			Title:    m.Title,
+		})
+	}
+}
+
+// effectiveBudget is the new-signal budget after the org policy's cap.
+func (sc *scanContext) effectiveBudget() int {
+	if sc.policy != nil {
+		return sc.policy.EffectiveBudget(scanBudget)
+	}
+	return scanBudget
+}
+
 // applyCollectorExclusions removes excluded collectors from the include list.
 // If include is empty, it starts from the full registry (collector.List()).
 func applyCollectorExclusions(include []string, exclude string) []string {
@@ -850,6 +1830,8 @@ func inferFormatFromExt(path string) string {
 		".json":  "json",
 		".jsonl": "beads",
 		".md":    "markdown",
+		".csv":   "csv",
+		".xml":   "junit",
 	}
 	return extMap[filepath.Ext(path)]
 }
@@ -171,7 +171,7 @@ func TestFlagCombo_FormatInvalid(t *testing.T) {
 	dir := fixtureDir(t)
 
 	cmd, _, _ := newTestCmd()
-	cmd.SetArgs([]string{"scan", dir, "--format=csv"})
+	cmd.SetArgs([]string{"scan", dir, "--format=xml"})
 
 	err := cmd.Execute()
 	require.Error(t, err)
@@ -574,19 +574,19 @@ func TestFlagCombo_StrictWithPartialFailure(t *testing.T) {
 	binary := buildBinary(t)
 	dir := t.TempDir()
 
-	// Create a temp directory (no git repo) and scan with gitlog + strict.
-	// gitlog will fail since there is no git repo, but todos should work.
+	// Scan with testtiming pointed at a missing report + strict.
+	// testtiming will fail since the report does not exist, but todos should work.
 	require.NoError(t, os.WriteFile(filepath.Join(dir, "main.go"),
 		[]byte("package main\n// TODO: strict test\n"), 0o600))
 
 	cmd := exec.Command(binary, "scan", dir, //nolint:gosec // test helper
-		"--collectors=todos,gitlog", "--strict", "--dry-run", "--quiet")
+		"--collectors=todos,testtiming", "--test-report=missing.json", "--strict", "--dry-run", "--quiet")
 	var stderr bytes.Buffer
 	cmd.Stderr = &stderr
 	err := cmd.Run()
 
 	// With --strict, partial failure should cause non-zero exit.
-	// gitlog fails (no git repo), todos succeeds, so exit code should be non-zero.
+	// testtiming fails (no readable report), todos succeeds, so exit code should be non-zero.
 	assert.Error(t, err, "strict mode should exit non-zero on partial failure")
 }
 
@@ -722,3 +722,28 @@ func TestFlagCombo_PathsWithCollectors(t *testing.T) {
 	require.NoError(t, json.Unmarshal(stdout, &parsed))
 	assert.Equal(t, 1, parsed.TotalSignals, "only cmd/ TODOs should be found")
 }
+
+// -----------------------------------------------------------------------
+// --no-gitignore
+// -----------------------------------------------------------------------
+
+func TestFlagCombo_NoGitignore(t *testing.T) {
+	root := initTestRepo(t)
+	writeTestFile(t, root, ".gitignore", "dist/\n")
+	writeTestFile(t, root, "dist/bundle.js", "// TODO: built output\n// TODO: more built output\n")
+
+	count := func(args ...string) int {
+		resetScanFlags()
+		cmd, stdout, _ := newTestCmd()
+		cmd.SetArgs(append([]string{"scan", root, "-c", "todos", "--dry-run", "--json", "--quiet", "--no-cache"}, args...))
+		require.NoError(t, cmd.Execute())
+		var parsed struct {
+			TotalSignals int `json:"total_signals"`
+		}
+		require.NoError(t, json.Unmarshal(stdout.Bytes(), &parsed), "output: %s", stdout.String())
+		return parsed.TotalSignals
+	}
+
+	ignored := count()
+	assert.Equal(t, ignored+2, count("--no-gitignore"), "--no-gitignore scans the ignored dist/ directory")
+}
@@ -0,0 +1,46 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+//go:build !nonetwork
+
+package main
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunScan_DryRunShowsSkipReason(t *testing.T) {
+	resetScanFlags()
+	t.Setenv("GITHUB_TOKEN", "")
+
+	cmd, stdout, _ := newTestCmd()
+	cmd.SetArgs([]string{"scan", fixtureDir(t), "--dry-run", "--quiet", "--collectors=todos,github"})
+
+	require.NoError(t, cmd.Execute(), "a skipped collector is not a failure")
+	assert.Contains(t, stdout.String(), "github: skipped — no token (set GITHUB_TOKEN)")
+}
+
+func TestRunScan_DryRunJSONShowsSkipReason(t *testing.T) {
+	resetScanFlags()
+	t.Setenv("GITHUB_TOKEN", "")
+
+	cmd, stdout, _ := newTestCmd()
+	cmd.SetArgs([]string{"scan", fixtureDir(t), "--dry-run", "--json", "--quiet", "--collectors=todos,github"})
+	require.NoError(t, cmd.Execute())
+
+	var parsed struct {
+		Collectors []struct {
+			Name    string `json:"name"`
+			Skipped string `json:"skipped"`
+		} `json:"collectors"`
+	}
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &parsed), "output: %s", stdout.String())
+	require.Len(t, parsed.Collectors, 2)
+	assert.Empty(t, parsed.Collectors[0].Skipped)
+	assert.Equal(t, "github", parsed.Collectors[1].Name)
+	assert.Equal(t, "no token (set GITHUB_TOKEN)", parsed.Collectors[1].Skipped)
+}
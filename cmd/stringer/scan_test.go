@@ -269,6 +269,8 @@ func TestInferFormatFromExt(t *testing.T) {
 		{"out.json", "json"},
 		{"out.jsonl", "beads"},
 		{"report.md", "markdown"},
+		{"triage.csv", "csv"},
+		{"report.xml", "junit"},
 		{"out.html", ""},
 		{"out.txt", ""},
 		{"", ""},
@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -20,7 +22,10 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/davetashner/stringer/internal/collectors"
+	"github.com/davetashner/stringer/internal/output"
 	"github.com/davetashner/stringer/internal/signal"
+	"github.com/davetashner/stringer/internal/state"
 )
 
 // resetScanFlags resets all package-level scan flags to their default values.
@@ -32,6 +37,7 @@ func resetScanFlags() {
 	scanOutput = ""
 	scanDryRun = false
 	scanDelta = false
+	scanSinceRef = ""
 	scanNoLLM = false
 	scanJSON = false
 	scanMaxIssues = 0
@@ -43,6 +49,37 @@ func resetScanFlags() {
 	scanExcludeCollectors = ""
 	scanWorkspace = ""
 	scanNoWorkspaces = false
+	scanBudget = 0
+	scanEpics = false
+	scanEnrich = false
+	scanPolicyURL = ""
+	scanPolicyKey = ""
+	scanFailFast = false
+	scanQuick = false
+	scanQuickBudget = defaultQuickBudget
+	scanGitHubBudget = collectors.DefaultGitHubAPIBudget
+	scanPrintExitPolicy = false
+	scanLang = ""
+	scanColumns = ""
+	scanJUnitSkipBelow = 0
+	scanScoringProfile = ""
+	scanNoPlugins = false
+	scanNoCache = false
+	scanNoGitignore = false
+	scanCheckpoint = false
+	scanResume = false
+	scanStream = false
+	scanJobs = 0
+	scanMetricsOut = ""
+	scanTrace = ""
+	scanBaseline = ""
+	scanOutputDir = ""
+	scanSplitByWorkspace = false
+	scanNoCalibration = false
+	scanReposFile = ""
+	scanGitHubOrg = ""
+	scanReposCache = ""
+	scanRemote = ""
 
 	// Reset cobra flag "Changed" state and values to avoid test contamination.
 	scanCmd.Flags().VisitAll(func(f *pflag.Flag) {
@@ -59,6 +96,12 @@ func resetScanFlags() {
 	// after the VisitAll loop.
 	scanExclude = nil
 	scanPaths = nil
+	scanTestReports = nil
+	scanLintReports = nil
+	scanProfiles = nil
+	scanCoverage = nil
+	scanExpectZero = nil
+	scanFailOn = nil
 }
 
 // fixtureDir returns the testdata/fixtures/sample-repo path (a small directory
@@ -158,6 +201,19 @@ func TestComputeExitCode_TotalFailure(t *testing.T) {
 	assert.Equal(t, ExitTotalFailure, computeExitCode(result, false))
 }
 
+func TestComputeExitCode_SkippedCollectors(t *testing.T) {
+	result := &signal.ScanResult{
+		Results: []signal.CollectorResult{
+			{Collector: "todos", Err: errors.New("failed")},
+			{Collector: "github", SkipReason: "no token"},
+		},
+	}
+	assert.Equal(t, ExitTotalFailure, computeExitCode(result, false), "skipped collectors do not count toward the total")
+
+	result.Results[0].Err = nil
+	assert.Equal(t, ExitOK, computeExitCode(result, true), "a skip is not a failure, even with --strict")
+}
+
 func TestComputeExitCode_SingleCollectorSuccess(t *testing.T) {
 	result := &signal.ScanResult{
 		Results: []signal.CollectorResult{
@@ -260,7 +316,32 @@ func TestPrintDryRun_TextModeWithError(t *testing.T) {
 
 	out := buf.String()
 	assert.Contains(t, out, "0 signal(s) found")
-	assert.Contains(t, out, "error: read error")
+	assert.Contains(t, out, "error (internal): read error")
+}
+
+func TestPrintDryRun_Retried(t *testing.T) {
+	result := &signal.ScanResult{
+		Results: []signal.CollectorResult{
+			{Collector: "gitlog", Duration: 10 * time.Millisecond, RetryReason: "index.lock exists"},
+		},
+	}
+
+	for _, jsonMode := range []bool{false, true} {
+		resetScanFlags()
+		scanDryRun = true
+		scanJSON = jsonMode
+
+		cmd := &cobra.Command{}
+		buf := new(bytes.Buffer)
+		cmd.SetOut(buf)
+		require.NoError(t, printDryRun(cmd, result, ExitOK, 0, nil))
+
+		if jsonMode {
+			assert.Contains(t, buf.String(), `"retried": "index.lock exists"`)
+		} else {
+			assert.Contains(t, buf.String(), "gitlog: 0 signals, retried after: index.lock exists")
+		}
+	}
 }
 
 func TestPrintDryRun_JSONMode(t *testing.T) {
@@ -344,6 +425,12 @@ func TestPrintDryRun_JSONModeWithErrors(t *testing.T) {
 				Duration:  30 * time.Millisecond,
 				Err:       errors.New("permission denied"),
 			},
+			{
+				Collector:   "github",
+				Duration:    10 * time.Millisecond,
+				Err:         errors.New("401 Bad credentials"),
+				ErrCategory: signal.CategoryAuth,
+			},
 		},
 		Duration: 60 * time.Millisecond,
 	}
@@ -357,16 +444,22 @@ func TestPrintDryRun_JSONModeWithErrors(t *testing.T) {
 
 	var parsed struct {
 		Collectors []struct {
-			Name  string `json:"name"`
-			Error string `json:"error,omitempty"`
+			Name     string `json:"name"`
+			Error    string `json:"error,omitempty"`
+			Category string `json:"error_category,omitempty"`
 		} `json:"collectors"`
-		ExitCode int `json:"exit_code"`
+		ErrorCounts map[string]int `json:"error_counts"`
+		ExitCode    int            `json:"exit_code"`
 	}
 	require.NoError(t, json.Unmarshal(buf.Bytes(), &parsed))
 	assert.Equal(t, ExitPartialFailure, parsed.ExitCode)
-	require.Len(t, parsed.Collectors, 2)
+	require.Len(t, parsed.Collectors, 3)
 	assert.Equal(t, "", parsed.Collectors[0].Error)
+	assert.Equal(t, "", parsed.Collectors[0].Category)
 	assert.Equal(t, "permission denied", parsed.Collectors[1].Error)
+	assert.Equal(t, "internal", parsed.Collectors[1].Category)
+	assert.Equal(t, "auth", parsed.Collectors[2].Category)
+	assert.Equal(t, map[string]int{"internal": 1, "auth": 1}, parsed.ErrorCounts)
 }
 
 func TestPrintDryRun_ExitOKReturnsNil(t *testing.T) {
@@ -937,6 +1030,7 @@ func TestScanCmd_FlagsRegistered(t *testing.T) {
 		{"exclude", "e"},
 		{"exclude-collectors", "x"},
 		{"paths", ""},
+		{"max-depth", ""},
 	}
 
 	for _, ff := range flags {
@@ -1411,6 +1505,67 @@ func TestScanCmd_PathsFlag_MultiplePaths(t *testing.T) {
 	assert.Contains(t, out, "signal(s) found")
 }
 
+func TestScanCmd_PathsFlag_DirectoryWithMaxDepth(t *testing.T) {
+	resetScanFlags()
+	dir := t.TempDir()
+	for _, rel := range []string{"main.go", "internal/api/handler.go", "internal/api/v2/handler.go", "cmd/main.go"} {
+		require.NoError(t, os.MkdirAll(filepath.Dir(filepath.Join(dir, rel)), 0o750))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, rel), []byte("package x\n// TODO: something\n"), 0o600))
+	}
+
+	cmd, stdout, _ := newTestCmd()
+	cmd.SetArgs([]string{"scan", dir, "--paths=internal/api", "--max-depth=1", "--dry-run", "--json", "--quiet", "--collectors=todos"})
+	require.NoError(t, cmd.Execute())
+
+	var result struct {
+		TotalSignals int `json:"total_signals"`
+	}
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &result), "output: %s", stdout.String())
+	assert.Equal(t, 1, result.TotalSignals, "only internal/api/handler.go is in scope")
+}
+
+func TestScanCmd_MaxDepthNegative(t *testing.T) {
+	resetScanFlags()
+	cmd, _, _ := newTestCmd()
+	cmd.SetArgs([]string{"scan", t.TempDir(), "--max-depth=-1", "--dry-run"})
+
+	err := cmd.Execute()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "--max-depth must be non-negative")
+	requireExitCode(t, err, ExitInvalidArgs)
+}
+
+func TestScanCmd_JobsNegative(t *testing.T) {
+	resetScanFlags()
+	cmd, _, _ := newTestCmd()
+	cmd.SetArgs([]string{"scan", t.TempDir(), "--jobs=-1", "--dry-run"})
+
+	err := cmd.Execute()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "--jobs must be non-negative")
+	requireExitCode(t, err, ExitInvalidArgs)
+}
+
+func TestScanCmd_Jobs(t *testing.T) {
+	resetScanFlags()
+	dir := initTestRepo(t)
+	cmd, stdout, _ := newTestCmd()
+	cmd.SetArgs([]string{"scan", dir, "-c", "todos,patterns", "-j", "1", "--dry-run", "--json"})
+	require.NoError(t, cmd.Execute())
+
+	var result struct {
+		Collectors []struct {
+			Name  string `json:"name"`
+			Error string `json:"error"`
+		} `json:"collectors"`
+	}
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &result), "output: %s", stdout.String())
+	require.Len(t, result.Collectors, 2)
+	for _, c := range result.Collectors {
+		assert.Empty(t, c.Error, c.Name)
+	}
+}
+
 func TestScanCmd_PathsFlag_Integration(t *testing.T) {
 	binary := buildBinary(t)
 
@@ -1562,6 +1717,21 @@ func TestRunScan_TasksFormatInProcess(t *testing.T) {
 	assert.NotEmpty(t, stdout.String())
 }
 
+func TestRunScan_PRCommentFormatInProcess(t *testing.T) {
+	resetScanFlags()
+	dir := fixtureDir(t)
+
+	cmd, stdout, _ := newTestCmd()
+	cmd.SetArgs([]string{"scan", dir, "--format=pr-comment", "--budget=1", "--quiet", "--collectors=todos"})
+
+	err := cmd.Execute()
+	require.NoError(t, err)
+	out := stdout.String()
+	assert.True(t, strings.HasPrefix(out, output.PRCommentMarker))
+	assert.Contains(t, out, "Over budget")
+	assert.Contains(t, out, "(full scan)")
+}
+
 // -----------------------------------------------------------------------
 // --strict flag integration tests
 // -----------------------------------------------------------------------
@@ -1710,6 +1880,332 @@ func TestRunScan_DisabledCollectorInConfig(t *testing.T) {
 	}
 }
 
+func TestRunScan_LabelRulesInConfig(t *testing.T) {
+	resetScanFlags()
+	dir := t.TempDir()
+	configContent := `labels:
+  - kinds: [todo]
+    labels: [tech-debt]
+    beads: [tech-debt, triage]
+`
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".stringer.yaml"), []byte(configContent), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "main.go"),
+		[]byte("package main\n// TODO: label test\n"), 0o600))
+
+	cmd, stdout, _ := newTestCmd()
+	cmd.SetArgs([]string{"scan", dir, "--quiet", "--collectors=todos", "--format=beads"})
+	require.NoError(t, cmd.Execute())
+
+	var bead struct {
+		Labels []string `json:"labels"`
+	}
+	line, _, _ := strings.Cut(stdout.String(), "\n")
+	require.NoError(t, json.Unmarshal([]byte(line), &bead), "output: %s", stdout.String())
+	assert.Contains(t, bead.Labels, "tech-debt")
+	assert.Contains(t, bead.Labels, "triage")
+
+	// A later scan without rules must not inherit them.
+	resetScanFlags()
+	require.NoError(t, os.Remove(filepath.Join(dir, ".stringer.yaml")))
+	cmd, stdout, _ = newTestCmd()
+	cmd.SetArgs([]string{"scan", dir, "--quiet", "--collectors=todos", "--format=beads"})
+	require.NoError(t, cmd.Execute())
+	assert.NotContains(t, stdout.String(), "triage")
+}
+
+func TestRunScan_ScoringProfile(t *testing.T) {
+	resetScanFlags()
+	dir := t.TempDir()
+	configContent := `scoring_profile: quiet-todos
+scoring_profiles:
+  quiet-todos:
+    weights:
+      - kinds: [todo]
+        factor: 0
+`
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".stringer.yaml"), []byte(configContent), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "main.go"),
+		[]byte("package main\n// TODO: weighted away\n// FIXME: kept\n"), 0o600))
+
+	cmd, stdout, _ := newTestCmd()
+	cmd.SetArgs([]string{"scan", dir, "--quiet", "--collectors=todos", "--format=json"})
+	require.NoError(t, cmd.Execute())
+	assert.NotContains(t, stdout.String(), "weighted away", "the configured profile drops todo signals")
+	assert.Contains(t, stdout.String(), "FIXME: kept")
+
+	// Delta state records the dropped signals, which were still found.
+	resetScanFlags()
+	cmd, stdout, _ = newTestCmd()
+	cmd.SetArgs([]string{"scan", dir, "--quiet", "--collectors=todos", "--format=json", "--delta"})
+	require.NoError(t, cmd.Execute())
+	assert.NotContains(t, stdout.String(), "weighted away")
+	st, err := state.Load(dir)
+	require.NoError(t, err)
+	require.NotNil(t, st)
+	assert.Equal(t, 2, st.SignalCount)
+
+	// --scoring-profile wins over the config file.
+	resetScanFlags()
+	cmd, stdout, _ = newTestCmd()
+	cmd.SetArgs([]string{"scan", dir, "--quiet", "--collectors=todos", "--format=json", "--scoring-profile=default"})
+	require.NoError(t, cmd.Execute())
+	assert.Contains(t, stdout.String(), "weighted away")
+
+	resetScanFlags()
+	cmd, _, _ = newTestCmd()
+	cmd.SetArgs([]string{"scan", dir, "--quiet", "--collectors=todos", "--scoring-profile=nope"})
+	err = cmd.Execute()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `--scoring-profile: unknown scoring profile "nope"`)
+	requireExitCode(t, err, ExitInvalidArgs)
+}
+
+func TestRunScan_Rules(t *testing.T) {
+	resetScanFlags()
+	dir := t.TempDir()
+	routed := filepath.Join(dir, "reports", "auth.json")
+	configContent := `rules:
+  - match: {paths: ["internal/auth/**"]}
+    add_tags: [security]
+    set_priority: 1
+  - match: {tags: [security]}
+    output: reports/auth.json
+  - match: {kinds: [hack]}
+    drop: true
+`
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".stringer.yaml"), []byte(configContent), 0o600))
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "internal", "auth"), 0o750))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "internal", "auth", "token.go"),
+		[]byte("package auth\n// TODO: rotate keys\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "main.go"),
+		[]byte("package main\n// FIXME: kept\n// HACK: dropped\n"), 0o600))
+
+	cmd, stdout, _ := newTestCmd()
+	cmd.SetArgs([]string{"scan", dir, "--quiet", "--collectors=todos", "--format=json"})
+	require.NoError(t, cmd.Execute())
+	assert.Contains(t, stdout.String(), "FIXME: kept")
+	assert.NotContains(t, stdout.String(), "HACK: dropped", "drop rules remove signals")
+	assert.NotContains(t, stdout.String(), "rotate keys", "routed signals leave the main output")
+
+	data, err := os.ReadFile(routed)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "rotate keys")
+	assert.Contains(t, string(data), `"security"`)
+	assert.NotContains(t, string(data), "FIXME")
+}
+
+func TestRunScan_RulesOutputOwnership(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "main.go"), []byte("package main\n// TODO: route me\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".stringer.yaml"),
+		[]byte("rules:\n  - match: {kinds: [todo]}\n    output: go.mod\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "go.mod"), []byte("module acme\n"), 0o600))
+	scan := func() error {
+		resetScanFlags()
+		cmd, _, _ := newTestCmd()
+		cmd.SetArgs([]string{"scan", dir, "--quiet", "--collectors=todos", "--format=json"})
+		return cmd.Execute()
+	}
+
+	err := scan()
+	requireExitCode(t, err, ExitInvalidArgs)
+	assert.Contains(t, err.Error(), `rules output "go.mod" exists and was not written by stringer`)
+	data, _ := os.ReadFile(filepath.Join(dir, "go.mod"))
+	assert.Equal(t, "module acme\n", string(data), "a file stringer did not write is left alone")
+
+	// A file stringer wrote is overwritten by later scans until someone
+	// edits it.
+	require.NoError(t, os.Remove(filepath.Join(dir, "go.mod")))
+	require.NoError(t, scan())
+	require.NoError(t, scan())
+	assert.FileExists(t, filepath.Join(dir, ".stringer", ruleOutputsFile))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "go.mod"), []byte("module acme\n"), 0o600))
+	requireExitCode(t, scan(), ExitInvalidArgs)
+
+	// A symbolic link cannot lead an output out of the scanned directory.
+	outside := t.TempDir()
+	require.NoError(t, os.Symlink(outside, filepath.Join(dir, "reports")))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".stringer.yaml"),
+		[]byte("rules:\n  - match: {kinds: [todo]}\n    output: reports/todo.json\n"), 0o600))
+	requireExitCode(t, scan(), ExitInvalidArgs)
+	assert.NoFileExists(t, filepath.Join(outside, "todo.json"))
+}
+
+func TestRunScan_Priority(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "main.go"),
+		[]byte("package main\n// TODO: low-key cleanup\n"), 0o600))
+
+	scan := func(config string) string {
+		t.Helper()
+		resetScanFlags()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, ".stringer.yaml"), []byte(config), 0o600))
+		cmd, stdout, _ := newTestCmd()
+		cmd.SetArgs([]string{"scan", dir, "--quiet", "--collectors=todos", "--format=json"})
+		require.NoError(t, cmd.Execute())
+		return stdout.String()
+	}
+
+	assert.Contains(t, scan("priority: {p1: 0.3, p2: 0.2, p3: 0.1}\n"), `"Priority": 1`,
+		"tuned thresholds map the TODO to P1")
+	assert.Contains(t, scan("priority: {enabled: false}\n"), `"Priority": null`)
+}
+
+func TestRunScan_ModuleEpics(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "internal", "store"), 0o750))
+	for i, word := range []string{"alpha", "bravo", "charlie"} {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "internal", "store", fmt.Sprintf("f%d.go", i)),
+			[]byte(fmt.Sprintf("package store\n// HACK: %s\n", word)), 0o600))
+	}
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".stringer.yaml"), []byte("epics: {module_min_size: 3}\n"), 0o600))
+
+	scan := func(extra ...string) string {
+		t.Helper()
+		resetScanFlags()
+		cmd, stdout, _ := newTestCmd()
+		cmd.SetArgs(append([]string{"scan", dir, "--quiet", "--collectors=todos", "--format=beads"}, extra...))
+		require.NoError(t, cmd.Execute())
+		return stdout.String()
+	}
+
+	assert.NotContains(t, scan(), "Epic:", "epics are opt-in")
+
+	out := scan("--epics")
+	assert.Contains(t, out, `"title":"Epic: hack signals in internal/store"`)
+	assert.Contains(t, out, `"type":"epic"`)
+	assert.Contains(t, out, `"children":[`)
+	for _, line := range strings.Split(strings.TrimSpace(out), "\n") {
+		var rec map[string]any
+		require.NoError(t, json.Unmarshal([]byte(line), &rec))
+		if rec["type"] == "epic" {
+			assert.NotContains(t, rec["description"], "Location:", "an epic is not located at a directory")
+		}
+	}
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".stringer.yaml"),
+		[]byte("epics: {enabled: true, module_min_size: 3}\n"), 0o600))
+	assert.Contains(t, scan(), "Epic: hack signals in internal/store", "the config turns epics on")
+}
+
+func TestRunScan_Enrich(t *testing.T) {
+	calls := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		_, _ = w.Write([]byte(`{"choices": [{"message": {"content": ` +
+			`"{\"signals\": [{\"id\": \"sig-0\", \"title\": \"Add retries to the fetcher\", \"acceptance_criteria\": [\"Failed fetches are retried\"]}]}"}}]}`))
+	}))
+	defer srv.Close()
+	planted := 0
+	evil := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) { planted++ }))
+	defer evil.Close()
+	t.Setenv("OPENAI_API_KEY", "test-key")
+
+	// The base URL comes from the global config; the repository's own
+	// base_url and enrich are ignored.
+	home := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", home)
+	writeTestFile(t, home, "stringer/config.yaml", "llm:\n  base_url: "+srv.URL+"\n")
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "fetch.go"), []byte("package fetch\n// TODO: retry\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".stringer.yaml"),
+		[]byte("llm:\n  provider: openai\n  enrich: true\n  base_url: "+evil.URL+"\n"), 0o600))
+
+	scan := func(extra ...string) string {
+		t.Helper()
+		resetScanFlags()
+		cmd, stdout, _ := newTestCmd()
+		cmd.SetArgs(append([]string{"scan", dir, "--quiet", "--collectors=todos", "--format=json"}, extra...))
+		require.NoError(t, cmd.Execute())
+		return stdout.String()
+	}
+
+	assert.Contains(t, scan(), `"Title": "TODO: retry"`)
+	assert.Zero(t, calls, "the repository's enrich does not turn enrichment on")
+
+	out := scan("--no-llm", "--enrich")
+	assert.Contains(t, out, `"Title": "TODO: retry"`)
+	assert.Zero(t, calls, "--no-llm wins over --enrich")
+
+	var plain struct {
+		Signals []struct {
+			ID string `json:"id"`
+		} `json:"signals"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(out), &plain))
+	require.Len(t, plain.Signals, 1)
+
+	out = scan("--enrich")
+	assert.Contains(t, out, `"Title": "Add retries to the fetcher"`)
+	assert.Contains(t, out, `"original_title": "TODO: retry"`)
+	assert.Contains(t, out, `"id": "`+plain.Signals[0].ID+`"`, "the rewrite keeps the signal ID")
+	assert.Contains(t, out, "- [ ] Failed fetches are retried")
+	assert.Equal(t, 1, calls)
+
+	scan("--enrich")
+	assert.Equal(t, 1, calls, "the second scan is served from the cache")
+	assert.FileExists(t, filepath.Join(dir, ".stringer", "llm-cache.json"))
+	assert.Zero(t, planted, "the repository's base_url is never called")
+}
+
+func TestRunScan_TodoReferences(t *testing.T) {
+	resetScanFlags()
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "fetch.go"),
+		[]byte("package fetch\n// TODO[OPS-12]: retry, see client.go:1\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "client.go"), []byte("package fetch\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".stringer.yaml"),
+		[]byte("collectors:\n  todos:\n    tracker_url: https://acme.atlassian.net/browse/\n"), 0o600))
+
+	cmd, stdout, _ := newTestCmd()
+	cmd.SetArgs([]string{"scan", dir, "--quiet", "--collectors=todos", "--format=json"})
+	require.NoError(t, cmd.Execute())
+
+	var out struct {
+		Signals []signal.RawSignal `json:"signals"`
+	}
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &out))
+	require.Len(t, out.Signals, 1)
+	assert.Equal(t, []string{"OPS-12", "client.go:1"}, out.Signals[0].References)
+	assert.Equal(t, "See client.go:1.\nTracked in OPS-12: https://acme.atlassian.net/browse/OPS-12", out.Signals[0].Description)
+}
+
+func TestRunScan_Plugins(t *testing.T) {
+	resetScanFlags()
+	t.Cleanup(func() { _ = collectors.RegisterPlugins(nil) })
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "tools"), 0o750))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "tools", "license-check"), []byte(`#!/bin/sh
+echo '{"Kind": "missing-license", "FilePath": "main.go", "Title": "No license header", "Confidence": 0.8}'
+`), 0o755)) //nolint:gosec // test plugin must be executable
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".stringer.yaml"), []byte(`plugins:
+  - name: license-check
+    command: ./tools/license-check
+    timeout: 10s
+`), 0o600))
+
+	cmd, stdout, _ := newTestCmd()
+	cmd.SetArgs([]string{"scan", dir, "--quiet", "--collectors=license-check", "--format=json", "--allow-plugins"})
+	require.NoError(t, cmd.Execute())
+	assert.Contains(t, stdout.String(), "No license header")
+	assert.Contains(t, stdout.String(), `"Source": "license-check"`)
+
+	// Without --allow-plugins, or with --no-plugins, the plugin is not
+	// registered, and one registered by an earlier scan is removed.
+	for _, args := range [][]string{
+		{"scan", dir, "--quiet", "--collectors=license-check"},
+		{"scan", dir, "--quiet", "--collectors=license-check", "--allow-plugins", "--no-plugins"},
+	} {
+		resetScanFlags()
+		cmd, _, _ = newTestCmd()
+		cmd.SetArgs(args)
+		err := cmd.Execute()
+		require.Error(t, err, args)
+		assert.Contains(t, err.Error(), "license-check")
+		requireExitCode(t, err, ExitInvalidArgs)
+	}
+}
+
 // stderr is a helper that captures stderr from a failed exec.Command.
 // It returns an empty string if the command has not been run yet.
 func stderr(cmd *exec.Cmd) string {
@@ -1721,3 +2217,424 @@ func stderr(cmd *exec.Cmd) string {
 	}
 	return ""
 }
+
+func TestRunScan_Epics(t *testing.T) {
+	resetScanFlags()
+	dir := t.TempDir()
+	writeTestFile(t, dir, "client/http.go", "package client\n\n// TODO: retry logic for 503 responses\nfunc Get() {}\n")
+	writeTestFile(t, dir, "client/grpc.go", "package client\n\n// TODO: retry logic on unavailable\nfunc Dial() {}\n")
+	writeTestFile(t, dir, "worker/queue.go", "package worker\n\n// FIXME: retry logic drops messages\nfunc Run() {}\n")
+
+	cmd, stdout, _ := newTestCmd()
+	cmd.SetArgs([]string{"scan", dir, "-c", "todos", "--epics", "--quiet"})
+	require.NoError(t, cmd.Execute())
+
+	var epic map[string]any
+	var ids []string
+	for _, line := range strings.Split(strings.TrimSpace(stdout.String()), "\n") {
+		var rec map[string]any
+		require.NoError(t, json.Unmarshal([]byte(line), &rec), "line: %s", line)
+		if rec["type"] == "epic" {
+			epic = rec
+			continue
+		}
+		ids = append(ids, rec["id"].(string))
+	}
+	require.NotNil(t, epic, "output: %s", stdout.String())
+	assert.Equal(t, `Epic: TODOs mentioning "retry logic"`, epic["title"])
+	children, _ := epic["children"].([]any)
+	require.Len(t, children, 3)
+	for _, c := range children {
+		assert.Contains(t, ids, c, "children reference emitted beads")
+	}
+}
+
+func TestRunScan_Profile(t *testing.T) {
+	resetScanFlags()
+	dir := t.TempDir()
+	writeTestFile(t, dir, "hot/loop.go", "package hot\n\n// OPTIMIZE: avoid allocating per iteration\nfunc Loop() {}\n")
+	writeTestFile(t, dir, "cold/init.go", "package cold\n\n// OPTIMIZE: cache the config\nfunc Init() {}\n")
+	profile := filepath.Join(t.TempDir(), "prod.cover")
+	require.NoError(t, os.WriteFile(profile, []byte(
+		"mode: count\nexample.com/svc/hot/loop.go:4.14,4.16 1 9000\nexample.com/svc/cold/init.go:4.14,4.16 1 3\n"), 0o600))
+
+	cmd, stdout, _ := newTestCmd()
+	cmd.SetArgs([]string{"scan", dir, "-c", "todos", "-f", "json", "--profile", profile, "--quiet"})
+	require.NoError(t, cmd.Execute())
+
+	var out struct {
+		Signals []struct {
+			FilePath string
+			Tags     []string
+		} `json:"signals"`
+	}
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &out), "output: %s", stdout.String())
+	require.Len(t, out.Signals, 2)
+	for _, s := range out.Signals {
+		if s.FilePath == "hot/loop.go" {
+			assert.Contains(t, s.Tags, "hot-path")
+		} else {
+			assert.NotContains(t, s.Tags, "hot-path")
+		}
+	}
+}
+
+func TestRunScan_Coverage(t *testing.T) {
+	resetScanFlags()
+	dir := t.TempDir()
+	runGitCmd(t, dir, "init", "-q")
+	for i := range 6 {
+		writeTestFile(t, dir, "db/db.go", fmt.Sprintf("package db\n\nconst version = %d\n", i))
+		if i < 2 {
+			writeTestFile(t, dir, "db/util.go", fmt.Sprintf("package db\n\nconst build = %d\n", i))
+		}
+		runGitCmd(t, dir, "add", ".")
+		runGitCmd(t, dir, "-c", "user.name=Test", "-c", "user.email=test@test.com", "commit", "-q", "-m", fmt.Sprintf("change %d", i))
+	}
+	report := filepath.Join(t.TempDir(), "cover.out")
+	require.NoError(t, os.WriteFile(report, []byte(
+		"mode: set\nexample.com/svc/db/db.go:3.1,3.20 9 0\nexample.com/svc/db/db.go:4.1,4.20 1 1\nexample.com/svc/db/util.go:1.1,1.10 1 0\n"), 0o600))
+
+	cmd, stdout, _ := newTestCmd()
+	cmd.SetArgs([]string{"scan", dir, "-c", "gitlog", "-f", "json", "--coverage", report, "--kind", "risk-quadrant", "--quiet"})
+	require.NoError(t, cmd.Execute())
+
+	var out struct {
+		Signals []struct {
+			Kind     string
+			FilePath string
+			Title    string
+		} `json:"signals"`
+	}
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &out), "output: %s", stdout.String())
+	require.Len(t, out.Signals, 1, "only the high-churn file is in the danger quadrant")
+	assert.Equal(t, "db/db.go", out.Signals[0].FilePath)
+	assert.Equal(t, "High churn, low coverage: db/db.go (5 changes, 10% covered)", out.Signals[0].Title)
+
+	resetScanFlags()
+	cmd, stdout, _ = newTestCmd()
+	cmd.SetArgs([]string{"scan", dir, "-c", "gitlog", "-f", "markdown", "--coverage", report, "--quiet"})
+	require.NoError(t, cmd.Execute())
+	assert.Contains(t, stdout.String(), "| **High churn (≥3 changes)** | **Danger: 1** | Guarded: 0 |")
+	assert.Contains(t, stdout.String(), "| **Low churn** | Untested: 1 | Stable: 0 |")
+	assert.Contains(t, stdout.String(), "| `db/db.go` | 5 | 10% |")
+}
+
+func TestRunScan_CoverageErrors(t *testing.T) {
+	bad := filepath.Join(t.TempDir(), "coverage.json")
+	require.NoError(t, os.WriteFile(bad, []byte(`{"files": []}`), 0o600))
+	for _, tc := range []struct {
+		name string
+		args []string
+		want string
+	}{
+		{"missing report", []string{"--coverage", "/nonexistent/*.out"}, "no coverage report matches"},
+		{"unknown format", []string{"--coverage", bad}, "unrecognized coverage report"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			resetScanFlags()
+			cmd, _, _ := newTestCmd()
+			cmd.SetArgs(append([]string{"scan", t.TempDir()}, tc.args...))
+			err := cmd.Execute()
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.want)
+			requireExitCode(t, err, ExitInvalidArgs)
+		})
+	}
+}
+
+func TestRunScan_ProfileErrors(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		args []string
+		want string
+	}{
+		{"missing profile", []string{"--profile", "/nonexistent/*.pprof"}, "no profile matches"},
+		{"bad share", []string{"--hot-path-share", "1.5"}, "--hot-path-share"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			resetScanFlags()
+			cmd, _, _ := newTestCmd()
+			cmd.SetArgs(append([]string{"scan", t.TempDir()}, tc.args...))
+			err := cmd.Execute()
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.want)
+			requireExitCode(t, err, ExitInvalidArgs)
+		})
+	}
+}
+
+func TestRunScan_Lang(t *testing.T) {
+	resetScanFlags()
+	dir := t.TempDir()
+	writeTestFile(t, dir, "main.go", "package main\n\n// TODO: handle errors\nfunc main() {}\n")
+
+	cmd, stdout, _ := newTestCmd()
+	cmd.SetArgs([]string{"scan", dir, "-c", "todos", "-f", "markdown", "--lang", "de", "--quiet"})
+	require.NoError(t, cmd.Execute())
+	assert.Contains(t, stdout.String(), "# Stringer-Scanergebnisse\n")
+	assert.Contains(t, stdout.String(), "handle errors")
+
+	// A catalog file named in the config is relative to the scanned
+	// directory, and the formatter does not keep the previous language.
+	resetScanFlags()
+	writeTestFile(t, dir, ".stringer.yaml", "lang: i18n/fr.yaml\n")
+	writeTestFile(t, dir, "i18n/fr.yaml", "markdown.title: \"Résultats de Stringer\"\n")
+	cmd, stdout, _ = newTestCmd()
+	cmd.SetArgs([]string{"scan", dir, "-c", "todos", "-f", "markdown", "--quiet"})
+	require.NoError(t, cmd.Execute())
+	assert.Contains(t, stdout.String(), "# Résultats de Stringer\n")
+	assert.Contains(t, stdout.String(), "**Total signals:** 1", "keys missing from the file fall back to English")
+
+	resetScanFlags()
+	cmd, stdout, _ = newTestCmd()
+	cmd.SetArgs([]string{"scan", dir, "-c", "todos", "-f", "markdown", "--lang", "en", "--quiet"})
+	require.NoError(t, cmd.Execute())
+	assert.Contains(t, stdout.String(), "# Stringer Scan Results\n", "--lang wins over the config")
+}
+
+func TestRunScan_LangErrors(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		args   []string
+		config string
+		want   string
+	}{
+		{"unknown flag language", []string{"--lang", "xx"}, "", `--lang: unknown language "xx"`},
+		{"missing catalog", []string{"--lang", "/nonexistent/fr.yaml"}, "", "--lang: reading catalog"},
+		{"unknown config language", nil, "lang: xx\n", `lang: unknown language "xx"`},
+		{"missing config catalog", nil, "lang: fr.yaml\n", "lang: reading catalog"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			resetScanFlags()
+			dir := t.TempDir()
+			if tc.config != "" {
+				writeTestFile(t, dir, ".stringer.yaml", tc.config)
+			}
+			cmd, _, _ := newTestCmd()
+			cmd.SetArgs(append([]string{"scan", dir, "-c", "todos"}, tc.args...))
+			err := cmd.Execute()
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.want)
+			requireExitCode(t, err, ExitInvalidArgs)
+		})
+	}
+}
+
+func TestRunScan_CSV(t *testing.T) {
+	resetScanFlags()
+	dir := t.TempDir()
+	writeTestFile(t, dir, "main.go", "package main\n\n// TODO: split on commas, then trim\nfunc main() {}\n")
+
+	cmd, stdout, _ := newTestCmd()
+	cmd.SetArgs([]string{"scan", dir, "-c", "todos", "-f", "csv", "--quiet"})
+	require.NoError(t, cmd.Execute())
+	assert.True(t, strings.HasPrefix(stdout.String(), "id,kind,confidence,path,line,title,author,age,module,tags\r\n"))
+	assert.Contains(t, stdout.String(), `,todo,`)
+	assert.Contains(t, stdout.String(), `,main.go,3,"TODO: split on commas, then trim",`)
+
+	// csv_columns in the config picks the columns; --columns wins over it,
+	// and the formatter does not keep an earlier selection.
+	resetScanFlags()
+	writeTestFile(t, dir, ".stringer.yaml", "csv_columns: [path, line]\n")
+	cmd, stdout, _ = newTestCmd()
+	cmd.SetArgs([]string{"scan", dir, "-c", "todos", "-f", "csv", "--quiet"})
+	require.NoError(t, cmd.Execute())
+	assert.Equal(t, "path,line\r\nmain.go,3\r\n", stdout.String())
+
+	resetScanFlags()
+	cmd, stdout, _ = newTestCmd()
+	cmd.SetArgs([]string{"scan", dir, "-c", "todos", "-f", "csv", "--columns", "Kind, Line", "--quiet"})
+	require.NoError(t, cmd.Execute())
+	assert.Equal(t, "kind,line\r\ntodo,3\r\n", stdout.String())
+}
+
+func TestRunScan_CSVColumnErrors(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		args   []string
+		config string
+		want   string
+	}{
+		{"unknown flag column", []string{"--columns", "id,severity"}, "", `--columns: unknown column "severity"`},
+		{"empty flag columns", []string{"--columns", ","}, "", "--columns: no columns given"},
+		{"unknown config column", nil, "csv_columns: [id, severity]\n", `csv_columns: unknown column "severity"`},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			resetScanFlags()
+			dir := t.TempDir()
+			if tc.config != "" {
+				writeTestFile(t, dir, ".stringer.yaml", tc.config)
+			}
+			cmd, _, _ := newTestCmd()
+			cmd.SetArgs(append([]string{"scan", dir, "-c", "todos", "-f", "csv"}, tc.args...))
+			err := cmd.Execute()
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.want)
+			requireExitCode(t, err, ExitInvalidArgs)
+		})
+	}
+}
+
+func TestRunScan_JUnit(t *testing.T) {
+	resetScanFlags()
+	dir := t.TempDir()
+	writeTestFile(t, dir, "main.go", "package main\n\n// TODO: handle errors\nfunc main() {}\n")
+	out := filepath.Join(t.TempDir(), "report.xml")
+
+	cmd, _, _ := newTestCmd()
+	cmd.SetArgs([]string{"scan", dir, "-c", "todos", "-o", out, "--quiet"})
+	require.NoError(t, cmd.Execute())
+	data, err := os.ReadFile(out) //nolint:gosec // test path
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `<testsuite name="todos" tests="1" failures="1" skipped="0">`)
+	assert.Contains(t, string(data), `<testcase name="TODO: handle errors" classname="main.go" file="main.go" line="3">`)
+
+	// Every signal is below the threshold, so none fail.
+	resetScanFlags()
+	cmd, stdout, _ := newTestCmd()
+	cmd.SetArgs([]string{"scan", dir, "-c", "todos", "-f", "junit", "--junit-skip-below", "1", "--quiet"})
+	require.NoError(t, cmd.Execute())
+	assert.Contains(t, stdout.String(), `<testsuite name="todos" tests="1" failures="0" skipped="1">`)
+
+	resetScanFlags()
+	cmd, _, _ = newTestCmd()
+	cmd.SetArgs([]string{"scan", dir, "-c", "todos", "-f", "junit", "--junit-skip-below", "1.5"})
+	err = cmd.Execute()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "--junit-skip-below must be between 0.0 and 1.0")
+	requireExitCode(t, err, ExitInvalidArgs)
+}
+
+func TestRunScan_RDJSON(t *testing.T) {
+	resetScanFlags()
+	dir := t.TempDir()
+	writeTestFile(t, dir, "main.go", "package main\n\n// TODO: handle errors\nfunc main() {}\n")
+
+	cmd, stdout, _ := newTestCmd()
+	cmd.SetArgs([]string{"scan", dir, "-c", "todos", "-f", "rdjson", "--quiet"})
+	require.NoError(t, cmd.Execute())
+	var out struct {
+		Source struct {
+			Name string `json:"name"`
+		} `json:"source"`
+		Diagnostics []struct {
+			Message  string `json:"message"`
+			Location struct {
+				Path  string `json:"path"`
+				Range struct {
+					Start struct {
+						Line int `json:"line"`
+					} `json:"start"`
+				} `json:"range"`
+			} `json:"location"`
+		} `json:"diagnostics"`
+	}
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &out), "output: %s", stdout.String())
+	assert.Equal(t, "stringer", out.Source.Name)
+	require.Len(t, out.Diagnostics, 1)
+	assert.Equal(t, "main.go", out.Diagnostics[0].Location.Path)
+	assert.Equal(t, 3, out.Diagnostics[0].Location.Range.Start.Line)
+	assert.Contains(t, out.Diagnostics[0].Message, "TODO: handle errors")
+}
+
+func TestRunScan_Effort(t *testing.T) {
+	resetScanFlags()
+	dir := t.TempDir()
+	writeTestFile(t, dir, "main.go", "package main\n\n// TODO: handle errors\nfunc main() {}\n")
+
+	cmd, stdout, _ := newTestCmd()
+	cmd.SetArgs([]string{"scan", dir, "-c", "todos", "-f", "json", "--quiet"})
+	require.NoError(t, cmd.Execute())
+	var out struct {
+		Signals []struct {
+			Effort string `json:"effort"`
+		} `json:"signals"`
+	}
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &out), "output: %s", stdout.String())
+	require.Len(t, out.Signals, 1)
+	assert.Equal(t, "S", out.Signals[0].Effort)
+
+	resetScanFlags()
+	cmd, stdout, _ = newTestCmd()
+	cmd.SetArgs([]string{"scan", dir, "-c", "todos", "--quiet"})
+	require.NoError(t, cmd.Execute())
+	assert.Contains(t, stdout.String(), `"estimated_minutes":60`)
+}
+
+func TestRunScan_MetricsOut(t *testing.T) {
+	resetScanFlags()
+	dir := t.TempDir()
+	writeTestFile(t, dir, "internal/api/main.go", "package api\n\n// TODO: handle errors\n// TODO: and retries\n")
+	metrics := filepath.Join(t.TempDir(), "prom.txt")
+
+	cmd, _, _ := newTestCmd()
+	cmd.SetArgs([]string{"scan", dir, "-c", "todos", "--dry-run", "--quiet", "--min-confidence", "1", "--metrics-out", metrics})
+	require.NoError(t, cmd.Execute())
+	data, err := os.ReadFile(metrics) //nolint:gosec // test output
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `stringer_signals{collector="todos",kind="todo",module="internal/api"} 2`,
+		"counts ignore output filters")
+	assert.Contains(t, string(data), `stringer_collector_duration_seconds{collector="todos"} `)
+
+	resetScanFlags()
+	cmd, _, _ = newTestCmd()
+	cmd.SetArgs([]string{"scan", dir, "-c", "todos", "--quiet", "--metrics-out", filepath.Join(dir, "missing", "prom.txt")})
+	requireExitCode(t, cmd.Execute(), ExitInvalidArgs)
+}
+
+func TestRunScan_LintReport(t *testing.T) {
+	resetScanFlags()
+	dir := t.TempDir()
+	writeTestFile(t, dir, "main.go", "package main\n\nfunc main() {}\n")
+	writeTestFile(t, dir, "lint/staticcheck.json",
+		`{"code":"SA4006","severity":"error","location":{"file":"main.go","line":3,"column":2},"message":"this value of err is never used"}`+"\n")
+
+	cmd, stdout, _ := newTestCmd()
+	cmd.SetArgs([]string{"scan", dir, "-c", "lint", "--lint-report", "lint/*.json", "--format", "json", "--quiet"})
+	require.NoError(t, cmd.Execute())
+	assert.Contains(t, stdout.String(), `"Kind": "staticcheck-bug"`)
+	assert.Contains(t, stdout.String(), "SA4006: this value of err is never used")
+}
+
+func TestReportCollectorWarnings(t *testing.T) {
+	var buf bytes.Buffer
+	reportCollectorWarnings(&buf, []signal.CollectorResult{
+		{Collector: "todos"},
+		{Collector: "github", Warnings: []string{"rate limited (retry_after=30s)", "no access to issues"}},
+		{Collector: "vuln", Warnings: []string{"a", "b", "c", "d", "e", "f", "g"}},
+		{Collector: "github", Warnings: []string{"api/: page 3 failed"}},
+		{Collector: "dephealth", Warnings: []string{"registry unreachable"}},
+	})
+	assert.Equal(t, `stringer: 3 warnings from github collector:
+  - rate limited (retry_after=30s)
+  - no access to issues
+  - api/: page 3 failed
+stringer: 7 warnings from vuln collector:
+  - a
+  - b
+  - c
+  - d
+  - e
+  ... and 2 more (see the log above)
+stringer: 1 warning from dephealth collector:
+  - registry unreachable
+`, buf.String())
+
+	buf.Reset()
+	reportCollectorWarnings(&buf, []signal.CollectorResult{{Collector: "todos"}})
+	assert.Empty(t, buf.String())
+}
+
+func TestRootCmd_InvalidLogFormat(t *testing.T) {
+	resetScanFlags()
+	cmd, _, _ := newTestCmd()
+	cmd.SetArgs([]string{"scan", ".", "--dry-run", "--log-format", "xml"})
+	err := cmd.Execute()
+	resetScanFlags()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `--log-format: unknown log format "xml"`)
+	var ece *exitCodeError
+	require.True(t, errors.As(err, &ece))
+	assert.Equal(t, ExitInvalidArgs, ece.ExitCode())
+}
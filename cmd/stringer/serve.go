@@ -0,0 +1,143 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/davetashner/stringer/internal/apiserver"
+	"github.com/davetashner/stringer/internal/codeowners"
+	"github.com/davetashner/stringer/internal/estimate"
+	"github.com/davetashner/stringer/internal/forge"
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+// Serve command flags.
+var (
+	serveAddr        string
+	serveAllowHosts  []string
+	serveCollectors  string
+	serveDashboard   bool
+	serveNoCache     bool
+	serveNoGitignore bool
+)
+
+// serveCmd runs the local HTTP API.
+var serveCmd = &cobra.Command{
+	Use:   "serve [path]",
+	Short: "Serve scan results over a local HTTP JSON API",
+	Long: `Run a local HTTP server that scans a repository on demand and serves
+the latest result as JSON:
+
+  POST /scan     run a scan (?collectors=todos,gitlog) and return its summary
+  GET  /scan     summary of the latest scan
+  GET  /signals  signals of the latest scan, filtered by ?kind=, collector=,
+                 path=, owner=, min_confidence=, and limit=
+  GET  /metrics  report JSON of the latest scan (?sections=churn,todo-age)
+  GET  /metrics/prometheus
+                 signal counts and collector timings in Prometheus text format
+
+GET requests run a first scan if none has completed. One scan runs at a
+time; POST /scan answers 409 while another is in progress. With
+--dashboard, GET / serves the HTML dashboard of the latest scan.
+
+The server listens on localhost by default. It has no authentication, so
+only bind it to other addresses on trusted networks. It answers only
+requests addressed to localhost, the --addr host, or an --allow-host name,
+and POST requests must set Content-Type: application/json, so web pages
+cannot drive it. Stop with Ctrl-C.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runServe,
+}
+
+func init() {
+	serveCmd.Flags().StringVar(&serveAddr, "addr", "127.0.0.1:7681", "address to listen on")
+	serveCmd.Flags().StringSliceVar(&serveAllowHosts, "allow-host", nil, "also answer requests addressed to this host name (repeatable)")
+	serveCmd.Flags().StringVarP(&serveCollectors, "collectors", "c", "", "comma-separated list of collectors to run (default: all, or the config file's)")
+	serveCmd.Flags().BoolVar(&serveDashboard, "dashboard", false, "serve the HTML dashboard of the latest scan at /")
+	serveCmd.Flags().BoolVar(&serveNoCache, "no-cache", false, "read every file on each scan instead of replaying the per-file scan cache")
+	serveCmd.Flags().BoolVar(&serveNoGitignore, "no-gitignore", false, "also scan files listed in .gitignore and .git/info/exclude")
+	rootCmd.AddCommand(serveCmd)
+}
+
+func runServe(cmd *cobra.Command, args []string) error {
+	repoPath := "."
+	if len(args) > 0 {
+		repoPath = args[0]
+	}
+	absPath, gitRoot, err := resolveScanPath(repoPath)
+	if err != nil {
+		return err
+	}
+
+	ln, err := net.Listen("tcp", serveAddr)
+	if err != nil {
+		return exitError(ExitInvalidArgs, "stringer: cannot listen on %s (%v)", serveAddr, err)
+	}
+	hosts := slices.Clone(serveAllowHosts)
+	if host, _, err := net.SplitHostPort(serveAddr); err == nil && host != "" {
+		hosts = append(hosts, host)
+	}
+	api := apiserver.New(serveScanFunc(absPath, gitRoot), apiserver.Options{
+		Repository: absPath,
+		Dashboard:  serveDashboard,
+		Hosts:      hosts,
+	})
+	srv := &http.Server{Handler: api.Handler(), ReadHeaderTimeout: 10 * time.Second}
+
+	ctx := cmd.Context()
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := srv.Shutdown(shutdownCtx); err != nil {
+			slog.Warn("server shutdown failed", "error", err)
+		}
+	}()
+
+	if !quiet {
+		_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "stringer: serving %s on http://%s\n", absPath, ln.Addr())
+	}
+	if err := srv.Serve(ln); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return exitError(ExitTotalFailure, "stringer: server failed (%v)", err)
+	}
+	return nil
+}
+
+// serveScanFunc scans the served repository the way scan does without
+// filters: the config file applies, and signals get forge links, owners,
+// and effort estimates.
+func serveScanFunc(absPath, gitRoot string) apiserver.ScanFunc {
+	return func(ctx context.Context, names []string) (*signal.ScanResult, error) {
+		if len(names) == 0 {
+			names = splitCollectors(serveCollectors)
+		}
+		result, err := runConfiguredScan(ctx, gitRoot, signal.ScanConfig{
+			RepoPath:    absPath,
+			Collectors:  names,
+			NoCache:     serveNoCache,
+			NoGitignore: serveNoGitignore,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if _, err := forge.Annotate(ctx, result.Signals, absPath, gitRoot); err != nil {
+			slog.Warn("failed to link signals to the forge", "error", err)
+		}
+		if _, err := codeowners.Annotate(ctx, result.Signals, absPath, gitRoot); err != nil {
+			slog.Warn("failed to read CODEOWNERS", "error", err)
+		}
+		estimate.Annotate(result.Signals, result.Signals, absPath)
+		return result, nil
+	}
+}
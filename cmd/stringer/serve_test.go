@@ -0,0 +1,78 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func resetServeFlags() {
+	serveAddr = "127.0.0.1:7681"
+	serveAllowHosts = nil
+	serveCollectors = ""
+	serveDashboard = false
+	serveNoCache = false
+	serveNoGitignore = false
+}
+
+func TestServeScanFunc(t *testing.T) {
+	resetServeFlags()
+	serveCollectors = "patterns"
+	dir := fixtureDir(t)
+	absPath, gitRoot, err := resolveScanPath(dir)
+	require.NoError(t, err)
+
+	result, err := serveScanFunc(absPath, gitRoot)(context.Background(), []string{"todos"})
+	require.NoError(t, err)
+	require.NotEmpty(t, result.Signals)
+	require.Len(t, result.Results, 1, "requested collectors replace --collectors")
+	assert.Equal(t, "todos", result.Results[0].Collector)
+	assert.NotEmpty(t, result.Signals[0].Effort, "signals get effort estimates")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	result, err = serveScanFunc(absPath, gitRoot)(ctx, []string{"todos"})
+	require.NoError(t, err)
+	require.Len(t, result.Results, 1)
+	assert.ErrorIs(t, result.Results[0].Err, context.Canceled, "the scan runs under the request's context")
+}
+
+func TestServeCmd_StopsOnCancel(t *testing.T) {
+	resetServeFlags()
+	ctx, cancel := context.WithCancel(context.Background())
+	cmd, _, _ := newTestCmd()
+	cmd.SetArgs([]string{"serve", fixtureDir(t), "--addr", "127.0.0.1:0", "--quiet"})
+
+	done := make(chan error, 1)
+	go func() { done <- cmd.ExecuteContext(ctx) }()
+	time.Sleep(50 * time.Millisecond)
+	cancel()
+	select {
+	case err := <-done:
+		assert.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("serve did not stop after cancel")
+	}
+	// ExecuteContext keeps ctx on the shared root command.
+	rootCmd.SetContext(context.Background())
+}
+
+func TestServeCmd_AddrInUse(t *testing.T) {
+	resetServeFlags()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer ln.Close() //nolint:errcheck // test cleanup
+
+	cmd, _, _ := newTestCmd()
+	cmd.SetArgs([]string{"serve", fixtureDir(t), "--addr", ln.Addr().String()})
+	err = cmd.Execute()
+	requireExitCode(t, err, ExitInvalidArgs)
+	assert.Contains(t, err.Error(), "cannot listen")
+}
@@ -0,0 +1,103 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/davetashner/stringer/internal/collector"
+	"github.com/davetashner/stringer/internal/gitcli"
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+// sinceRefCollectors read the tree file by file, so --since-ref can limit
+// them to the files that changed. Other collectors still see the whole tree.
+var sinceRefCollectors = []string{"todos", "patterns"}
+
+// resolveSinceRef returns the commit that --since-ref compares against: the
+// merge base of ref and HEAD, so a branch is compared with the point it
+// forked from rather than with everything merged to ref since.
+func resolveSinceRef(ctx context.Context, absPath, ref string) (string, error) {
+	if strings.HasPrefix(ref, "-") {
+		return "", fmt.Errorf("invalid ref %q", ref)
+	}
+	if _, err := gitcli.Exec(ctx, absPath, "rev-parse", "--verify", "--quiet", ref+"^{commit}"); err != nil {
+		return "", fmt.Errorf("unknown ref %q", ref)
+	}
+	out, err := gitcli.Exec(ctx, absPath, "merge-base", ref, "HEAD")
+	if err != nil {
+		return "", fmt.Errorf("no common history between %q and HEAD", ref)
+	}
+	return strings.TrimSpace(out), nil
+}
+
+// sinceRefFiles lists the files under dir that were added or modified since
+// base, relative to dir. Uncommitted changes and untracked files that are
+// not ignored count as changed; deleted files are left out.
+func sinceRefFiles(ctx context.Context, dir, base string) ([]string, error) {
+	diff, err := gitcli.Exec(ctx, dir, "diff", "--name-only", "-z", "--relative", "--diff-filter=ACMRT", base)
+	if err != nil {
+		return nil, err
+	}
+	untracked, err := gitcli.Exec(ctx, dir, "ls-files", "-z", "--others", "--exclude-standard")
+	if err != nil {
+		return nil, err
+	}
+	seen := make(map[string]bool)
+	var files []string
+	for _, name := range strings.Split(diff+"\x00"+untracked, "\x00") {
+		if name != "" && !seen[name] {
+			seen[name] = true
+			files = append(files, name)
+		}
+	}
+	sort.Strings(files)
+	return files, nil
+}
+
+// applySinceRef restricts the file-based collectors in cfg to files, within
+// any scope they already have. A file-based collector with no changed files
+// in its scope is dropped from the run. It reports whether any collector is
+// left to run.
+func applySinceRef(cfg *signal.ScanConfig, files []string) bool {
+	names := cfg.Collectors
+	if len(names) == 0 {
+		names = collector.List()
+		sort.Strings(names)
+	}
+	if cfg.CollectorOpts == nil {
+		cfg.CollectorOpts = make(map[string]signal.CollectorOpts)
+	}
+
+	kept := make([]string, 0, len(names))
+	for _, name := range names {
+		if !slices.Contains(sinceRefCollectors, name) {
+			kept = append(kept, name)
+			continue
+		}
+		co := cfg.CollectorOpts[name]
+		base := co.Scope
+		if base.IsZero() {
+			base = cfg.Scope
+		}
+		var inScope []string
+		for _, f := range files {
+			if base.Contains(f) {
+				inScope = append(inScope, f)
+			}
+		}
+		if len(inScope) == 0 {
+			continue
+		}
+		co.Scope = signal.NewFileScope(inScope)
+		cfg.CollectorOpts[name] = co
+		kept = append(kept, name)
+	}
+	cfg.Collectors = kept
+	return len(kept) > 0
+}
@@ -0,0 +1,121 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+func TestSinceRefFiles(t *testing.T) {
+	dir := initTestRepo(t)
+	ctx := context.Background()
+
+	base, err := resolveSinceRef(ctx, dir, "HEAD~2")
+	require.NoError(t, err)
+
+	writeTestFile(t, dir, "util.go", "package main\n\n// TODO: Uncommitted change\n")
+	writeTestFile(t, dir, "internal/core/new.go", "package core\n")
+	writeTestFile(t, dir, ".gitignore", "ignored.go\n")
+	writeTestFile(t, dir, "ignored.go", "package main\n")
+	require.NoError(t, os.Remove(filepath.Join(dir, "handler.go")))
+
+	files, err := sinceRefFiles(ctx, dir, base)
+	require.NoError(t, err)
+	assert.Equal(t, []string{".gitignore", "config.go", "internal/core/new.go", "main.go", "util.go"}, files)
+
+	files, err = sinceRefFiles(ctx, filepath.Join(dir, "internal"), base)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"core/new.go"}, files, "paths are relative to the scanned directory")
+}
+
+func TestResolveSinceRef_Errors(t *testing.T) {
+	dir := initTestRepo(t)
+	_, err := resolveSinceRef(context.Background(), dir, "no-such-branch")
+	assert.ErrorContains(t, err, `unknown ref "no-such-branch"`)
+	_, err = resolveSinceRef(context.Background(), dir, "--all")
+	assert.ErrorContains(t, err, "invalid ref")
+}
+
+func TestApplySinceRef(t *testing.T) {
+	cfg := signal.ScanConfig{
+		Collectors: []string{"todos", "patterns", "gitlog"},
+		Scope:      signal.NewScope([]string{"src"}, 0),
+		CollectorOpts: map[string]signal.CollectorOpts{
+			"patterns": {Scope: signal.NewScope([]string{"lib"}, 0)},
+		},
+	}
+	ok := applySinceRef(&cfg, []string{"docs/a.md", "src/a.go", "src/b.go"})
+	require.True(t, ok)
+	assert.Equal(t, []string{"todos", "gitlog"}, cfg.Collectors, "patterns has no changed files in its scope")
+	assert.Equal(t, []string{"src/a.go", "src/b.go"}, cfg.CollectorOpts["todos"].Scope.Paths)
+	assert.True(t, cfg.CollectorOpts["gitlog"].Scope.IsZero(), "other collectors keep the scan-wide scope")
+
+	cfg = signal.ScanConfig{Collectors: []string{"todos"}}
+	assert.False(t, applySinceRef(&cfg, nil), "nothing left to run")
+
+	cfg = signal.ScanConfig{}
+	require.True(t, applySinceRef(&cfg, nil))
+	assert.NotContains(t, cfg.Collectors, "todos")
+	assert.Contains(t, cfg.Collectors, "gitlog", "an empty list means every collector")
+}
+
+func TestRunScan_SinceRef(t *testing.T) {
+	resetScanFlags()
+	dir := initTestRepo(t)
+
+	cmd, stdout, _ := newTestCmd()
+	cmd.SetArgs([]string{"scan", dir, "--since-ref", "HEAD~1", "--collectors=todos", "--format=json", "--quiet"})
+	require.NoError(t, cmd.Execute())
+	assert.Contains(t, stdout.String(), "TODO: Load config from file")
+	assert.NotContains(t, stdout.String(), "Refactor this utility function", "unchanged files are not scanned")
+}
+
+func TestRunScan_SinceRefBracketPath(t *testing.T) {
+	resetScanFlags()
+	dir := initTestRepo(t)
+	writeTestFile(t, dir, "app/[id]/page.tsx", "// TODO: Load the record by id\n")
+	runGitCmd(t, dir, "add", "-A")
+	runGitCmd(t, dir, "commit", "-m", "add route")
+
+	cmd, stdout, _ := newTestCmd()
+	cmd.SetArgs([]string{"scan", dir, "--since-ref", "HEAD~1", "--collectors=todos", "--format=json", "--quiet"})
+	require.NoError(t, cmd.Execute())
+	assert.Contains(t, stdout.String(), "TODO: Load the record by id", "changed paths are not globs")
+}
+
+func TestRunScan_SinceRefNothingChanged(t *testing.T) {
+	resetScanFlags()
+	dir := initTestRepo(t)
+
+	cmd, stdout, _ := newTestCmd()
+	cmd.SetArgs([]string{"scan", dir, "--since-ref", "HEAD", "--collectors=todos", "--dry-run", "--quiet"})
+	require.NoError(t, cmd.Execute())
+	assert.Contains(t, stdout.String(), "0 signal(s) found")
+}
+
+func TestRunScan_SinceRefInvalid(t *testing.T) {
+	resetScanFlags()
+	dir := initTestRepo(t)
+
+	cmd, _, _ := newTestCmd()
+	cmd.SetArgs([]string{"scan", dir, "--since-ref", "no-such-tag"})
+	err := cmd.Execute()
+	requireExitCode(t, err, ExitInvalidArgs)
+	assert.Contains(t, err.Error(), "--since-ref")
+
+	resetScanFlags()
+	cmd, _, _ = newTestCmd()
+	cmd.SetArgs([]string{"scan", dir, "--since-ref", "HEAD", "--delta"})
+	err = cmd.Execute()
+	requireExitCode(t, err, ExitInvalidArgs)
+	assert.Contains(t, err.Error(), "cannot be combined")
+}
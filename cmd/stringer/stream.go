@@ -0,0 +1,343 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/davetashner/stringer/internal/baseline"
+	"github.com/davetashner/stringer/internal/beads"
+	"github.com/davetashner/stringer/internal/collector"
+	"github.com/davetashner/stringer/internal/collectors"
+	"github.com/davetashner/stringer/internal/output"
+	"github.com/davetashner/stringer/internal/pipeline"
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+// streamConflicts name the scan flags a --stream scan cannot honor: each
+// needs every signal of the scan at once, which streaming never holds.
+var streamConflicts = []string{
+	"dry-run", "delta", "baseline", "max-issues", "infer-priority", "infer-deps", "enrich", "epics",
+	"split-by-workspace", "output-dir", "repos-file", "github-org", "repos-cache",
+	"expect-zero", "fail-fast", "fail-on", "quick", "quick-budget", "checkpoint", "resume",
+	"coverage", "profile", "metrics-out", "sarif-baseline",
+}
+
+// checkStreamFlags rejects the flags --stream cannot be combined with.
+func checkStreamFlags(cmd *cobra.Command) error {
+	for _, name := range streamConflicts {
+		if cmd.Flags().Changed(name) {
+			return exitError(ExitInvalidArgs, "stringer: --%s cannot be combined with --stream", name)
+		}
+	}
+	return nil
+}
+
+// streamFormatter returns the formatter of a --stream scan, or an error
+// for formats that need every signal before they write anything.
+func (sc *scanContext) streamFormatter() (output.StreamFormatter, error) {
+	sf, ok := sc.formatter().(output.StreamFormatter)
+	if !ok {
+		return nil, exitError(ExitInvalidArgs,
+			"stringer: --stream cannot write %s; use --format beads, csv, or json", sc.scanCfg.OutputFormat)
+	}
+	if sc.scanCfg.MaxIssues > 0 {
+		return nil, exitError(ExitInvalidArgs, "stringer: max_issues cannot be combined with --stream")
+	}
+	if len(sc.rules.Outputs()) > 0 {
+		return nil, exitError(ExitInvalidArgs, "stringer: rules with an output cannot be combined with --stream")
+	}
+	return sf, nil
+}
+
+// formatter returns the formatter of the scan's output format.
+func (sc *scanContext) formatter() output.Formatter {
+	f, _ := output.GetFormatter(sc.scanCfg.OutputFormat) // already validated in loadScanConfig
+	return f
+}
+
+// runStream scans with --stream: signals go from the collectors through
+// the per-signal filters to the formatter as they are found, through
+// bounded buffers, so memory use does not grow with their number. Steps
+// that compare signals with each other are skipped: co-location boosts,
+// reference resolution, hotspots, churn-based priorities, epics, and
+// effort estimates. Delta state and scan history are not saved.
+func (sc *scanContext) runStream() error {
+	sf, err := sc.streamFormatter()
+	if err != nil {
+		return err
+	}
+	sc.configureLabelMap()
+	sc.configureCSVColumns()
+
+	w := sc.cmd.OutOrStdout()
+	if scanOutput != "" {
+		f, err := cmdFS.Create(scanOutput)
+		if err != nil {
+			return exitError(ExitInvalidArgs, "stringer: cannot create output file %q (%v)", scanOutput, err)
+		}
+		defer f.Close() //nolint:errcheck // best-effort close on output file
+		w = f
+	}
+
+	gh := collectors.NewGitHubData(scanGitHubBudget)
+	ctx, stop := interruptible(collectors.WithFileTrees(collectors.WithGitHubData(sc.cmd.Context(), gh), collectors.NewFileTrees()))
+	defer stop()
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+
+	filter := sc.newStreamFilter()
+	kept := make(chan signal.RawSignal, pipeline.StreamBuffer)
+	formatted := make(chan error, 1)
+	go func() {
+		err := sf.FormatStream(kept, w)
+		if err != nil {
+			cancel(err) // stop the collectors, then let them drain
+			for range kept {
+			}
+		}
+		formatted <- err
+	}()
+
+	scanErr := sc.streamWorkspaces(ctx, filter, kept)
+	// Formats ending in a summary read the results once kept is closed.
+	if rr, ok := sf.(output.ResultsReporter); ok {
+		rr.SetResults(sc.result.Results)
+	}
+	close(kept)
+	if err := <-formatted; err != nil {
+		return exitError(ExitTotalFailure, "stringer: formatting failed (%v)", err)
+	}
+	if errors.Is(context.Cause(ctx), errInterrupted) {
+		return exitError(ExitTotalFailure, "stringer: scan interrupted; the output holds the signals found until then")
+	}
+	if scanErr != nil {
+		return scanErr
+	}
+	if gh.Exhausted() {
+		slog.Warn("GitHub API request budget used up; GitHub results are partial (raise --github-budget)", "budget", scanGitHubBudget)
+	}
+	if !quiet {
+		reportCollectorWarnings(sc.cmd.ErrOrStderr(), sc.result.Results)
+	}
+	filter.log()
+
+	partial, total := collectorConditions(sc.result)
+	budget := sc.effectiveBudget()
+	exitCode := sc.exitPolicy.code(exitConditions{
+		PartialFailure: partial,
+		TotalFailure:   total,
+		BudgetExceeded: budget > 0 && filter.open > budget,
+		Secrets:        filter.secrets,
+	})
+	slog.Info("scan complete", "issues", filter.written, "duration", sc.result.Duration)
+	if exitCode != ExitOK {
+		return exitError(exitCode, "")
+	}
+	return nil
+}
+
+// streamWorkspaces streams each workspace's signals through filter into
+// kept, in turn.
+func (sc *scanContext) streamWorkspaces(ctx context.Context, filter *streamFilter, kept chan<- signal.RawSignal) error {
+	for _, ws := range sc.workspaces {
+		if ws.Name != "" {
+			slog.Info("scanning workspace", "name", ws.Name, "path", ws.Rel)
+		}
+		wsCfg, _, err := loadScanConfig(sc.cmd, ws.Path, sc.gitRoot, sc.policy)
+		if err != nil {
+			return err
+		}
+		if sc.sinceBase != "" {
+			files, err := sinceRefFiles(ctx, ws.Path, sc.sinceBase)
+			if err != nil {
+				return exitError(ExitTotalFailure, "stringer: cannot list files changed since %s (%v)", scanSinceRef, err)
+			}
+			if !applySinceRef(&wsCfg, files) {
+				slog.Info("no changed files to scan", "path", ws.Path)
+				continue
+			}
+		}
+		p, err := pipeline.New(wsCfg)
+		if err != nil {
+			available := collector.List()
+			sort.Strings(available)
+			return exitError(ExitInvalidArgs, "stringer: %v (available: %s)", err, strings.Join(available, ", "))
+		}
+
+		// Workspace stamping and filters run as signals arrive, so the
+		// pipeline's buffer is the only one between collector and output.
+		out := make(chan signal.RawSignal, pipeline.StreamBuffer)
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			for sig := range out {
+				one := []signal.RawSignal{sig}
+				stampWorkspace(ws, one)
+				if sig, ok := filter.keep(one[0]); ok {
+					select {
+					case kept <- sig:
+					case <-ctx.Done():
+					}
+				}
+			}
+		}()
+		wsResult, err := p.Stream(ctx, out)
+		close(out)
+		<-done
+
+		sc.result.Results = append(sc.result.Results, wsResult.Results...)
+		sc.result.Duration += wsResult.Duration
+		if err != nil {
+			return exitError(ExitTotalFailure, "stringer: scan failed (%v)", err)
+		}
+		if ctx.Err() != nil {
+			return nil
+		}
+	}
+
+	for _, cr := range sc.result.Results {
+		switch {
+		case cr.SkipReason != "":
+		case cr.Err != nil:
+			slog.Error("collector failed", "name", cr.Collector, "category", cr.Category(), "error", cr.Err, "duration", cr.Duration)
+		default:
+			slog.Info("collector complete", "name", cr.Collector, "signals", cr.Streamed, "duration", cr.Duration)
+		}
+	}
+	return nil
+}
+
+// streamFilter applies the scan's per-signal filters to a stream: scoring
+// profile, rules, beads-aware dedup, baseline suppressions, and the
+// confidence and kind filters. It counts what it keeps for the exit code.
+type streamFilter struct {
+	sc       *scanContext
+	existing *beads.Matcher
+	baseline map[string]baseline.Suppression
+	kinds    map[string]bool
+	dropped  map[string]int // by reason, for the summary log
+	written  int
+	open     int // written signals that are new work, not pre-closed
+	secrets  bool
+}
+
+// newStreamFilter loads the existing beads and baseline suppressions the
+// filter matches signals against.
+func (sc *scanContext) newStreamFilter() *streamFilter {
+	f := &streamFilter{sc: sc, dropped: make(map[string]int)}
+
+	if sc.fileCfg.BeadsAware == nil || *sc.fileCfg.BeadsAware {
+		existing, err := beads.LoadBeads(sc.absPath)
+		if err != nil {
+			slog.Warn("failed to load existing beads", "error", err)
+		}
+		for _, ws := range sc.workspaces {
+			if ws.Name == "" {
+				continue
+			}
+			wsBeads, err := beads.LoadBeads(ws.Path)
+			if err != nil {
+				slog.Warn("failed to load workspace beads", "workspace", ws.Name, "error", err)
+				continue
+			}
+			existing = append(existing, wsBeads...)
+		}
+		if len(existing) > 0 {
+			f.existing = beads.NewMatcher(existing)
+			if sc.scanCfg.OutputFormat == "beads" {
+				if conventions := beads.DetectConventions(existing); conventions != nil {
+					if bf, ok := sc.formatter().(*output.BeadsFormatter); ok {
+						bf.SetConventions(conventions)
+					}
+				}
+			}
+		}
+	}
+
+	if !scanNoBaseline {
+		blState, err := baseline.Load(sc.absPath)
+		blState = applyPolicySuppressions(sc.policy, blState)
+		if err != nil {
+			slog.Warn("failed to load baseline", "error", err)
+		} else {
+			f.baseline = baseline.Lookup(blState)
+		}
+	}
+
+	if scanKind != "" {
+		f.kinds = make(map[string]bool)
+		for _, k := range strings.Split(scanKind, ",") {
+			f.kinds[strings.TrimSpace(strings.ToLower(k))] = true
+		}
+	}
+	return f
+}
+
+// keep returns sig as it should be written, or false to drop it. It is
+// called from one goroutine at a time.
+func (f *streamFilter) keep(sig signal.RawSignal) (signal.RawSignal, bool) {
+	one := []signal.RawSignal{sig}
+	if len(f.sc.scoring.Weights) > 0 {
+		if one, _ = f.sc.scoring.Apply(one); len(one) == 0 {
+			f.dropped["scoring profile"]++
+			return sig, false
+		}
+	}
+	if len(f.sc.rules) > 0 {
+		if one, _ = f.sc.rules.Apply(one); len(one) == 0 {
+			f.dropped["rules"]++
+			return sig, false
+		}
+	}
+	sig = one[0]
+
+	if f.existing != nil {
+		if _, ok := f.existing.Match(sig); ok {
+			f.dropped["existing beads"]++
+			return sig, false
+		}
+	}
+	if sup, ok := f.baseline[output.SignalID(sig, "str-")]; ok && !baseline.IsExpired(sup) {
+		f.dropped["baseline"]++
+		return sig, false
+	}
+	if sig.Confidence < scanMinConfidence {
+		f.dropped["min confidence"]++
+		return sig, false
+	}
+	if f.kinds != nil && !f.kinds[sig.Kind] {
+		f.dropped["kind"]++
+		return sig, false
+	}
+
+	f.written++
+	if sig.ClosedAt.IsZero() {
+		f.open++
+	}
+	if sig.Kind == "committed-secret" {
+		f.secrets = true
+	}
+	return sig, true
+}
+
+// log reports how many signals were written and why the others were not.
+func (f *streamFilter) log() {
+	attrs := []any{"written", f.written}
+	reasons := make([]string, 0, len(f.dropped))
+	for reason := range f.dropped {
+		reasons = append(reasons, reason)
+	}
+	sort.Strings(reasons)
+	for _, reason := range reasons {
+		attrs = append(attrs, "dropped_"+strings.ReplaceAll(reason, " ", "_"), f.dropped[reason])
+	}
+	slog.Info("stream filters", attrs...)
+}
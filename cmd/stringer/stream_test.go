@@ -0,0 +1,121 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// beadIDs returns the sorted ids of the beads JSONL in out.
+func beadIDs(t *testing.T, out string) []string {
+	t.Helper()
+	var ids []string
+	for _, line := range strings.Split(strings.TrimSpace(out), "\n") {
+		if line == "" {
+			continue
+		}
+		var rec struct {
+			ID string `json:"id"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(line), &rec), line)
+		ids = append(ids, rec.ID)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+func TestScan_StreamMatchesBatchSignals(t *testing.T) {
+	dir := initTestRepo(t)
+
+	resetScanFlags()
+	cmd, stdout, _ := newTestCmd()
+	cmd.SetArgs([]string{"scan", dir, "-c", "todos", "--quiet"})
+	require.NoError(t, cmd.Execute())
+	want := beadIDs(t, stdout.String())
+	require.NotEmpty(t, want)
+
+	resetScanFlags()
+	cmd, stdout, _ = newTestCmd()
+	cmd.SetArgs([]string{"scan", dir, "-c", "todos", "--quiet", "--stream"})
+	require.NoError(t, cmd.Execute())
+	assert.Equal(t, want, beadIDs(t, stdout.String()))
+}
+
+func TestScan_StreamCSVFilters(t *testing.T) {
+	dir := initTestRepo(t)
+
+	resetScanFlags()
+	cmd, stdout, _ := newTestCmd()
+	cmd.SetArgs([]string{"scan", dir, "-c", "todos", "--quiet", "--stream", "-f", "csv", "--kind", "fixme"})
+	require.NoError(t, cmd.Execute())
+
+	records, err := csv.NewReader(stdout).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 2, "header and the one FIXME")
+	assert.Equal(t, "fixme", records[1][1])
+}
+
+func TestScan_StreamJSONMatchesBatch(t *testing.T) {
+	dir := initTestRepo(t)
+	writeTestFile(t, dir, "big.go", "package main\n"+strings.Repeat("// filler\n", 1600))
+
+	scanIDs := func(args ...string) ([]string, int) {
+		t.Helper()
+		resetScanFlags()
+		cmd, stdout, _ := newTestCmd()
+		cmd.SetArgs(append([]string{"scan", dir, "-c", "todos,patterns", "--quiet", "-f", "json"}, args...))
+		require.NoError(t, cmd.Execute())
+		var env struct {
+			Signals []struct {
+				ID string `json:"id"`
+			} `json:"signals"`
+			Metadata struct {
+				TotalCount int `json:"total_count"`
+			} `json:"metadata"`
+		}
+		require.NoError(t, json.Unmarshal(stdout.Bytes(), &env))
+		ids := make([]string, 0, len(env.Signals))
+		for _, s := range env.Signals {
+			ids = append(ids, s.ID)
+		}
+		sort.Strings(ids)
+		return ids, env.Metadata.TotalCount
+	}
+
+	want, _ := scanIDs()
+	require.NotEmpty(t, want)
+	got, total := scanIDs("--stream")
+	assert.Equal(t, want, got)
+	assert.Equal(t, len(got), total)
+}
+
+func TestScan_StreamRejects(t *testing.T) {
+	dir := initTestRepo(t)
+	tests := []struct {
+		name string
+		args []string
+		want string
+	}{
+		{"conflicting flag", []string{"--delta"}, "--delta cannot be combined with --stream"},
+		{"dry run", []string{"--dry-run"}, "--dry-run cannot be combined with --stream"},
+		{"format", []string{"-f", "markdown"}, "--stream cannot write markdown"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resetScanFlags()
+			cmd, _, _ := newTestCmd()
+			cmd.SetArgs(append([]string{"scan", dir, "-c", "todos", "--stream"}, tt.args...))
+			err := cmd.Execute()
+			requireExitCode(t, err, ExitInvalidArgs)
+			assert.Contains(t, err.Error(), tt.want)
+		})
+	}
+}
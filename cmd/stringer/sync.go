@@ -0,0 +1,178 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/davetashner/stringer/internal/beads"
+	"github.com/davetashner/stringer/internal/config"
+	"github.com/davetashner/stringer/internal/estimate"
+	"github.com/davetashner/stringer/internal/output"
+	"github.com/davetashner/stringer/internal/pipeline"
+	"github.com/davetashner/stringer/internal/reconcile"
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+// Sync command flags.
+var (
+	syncBeadsDB       string
+	syncCollectors    string
+	syncMinConfidence float64
+	syncDryRun        bool
+)
+
+// syncCmd reconciles a beads database with a fresh scan.
+var syncCmd = &cobra.Command{
+	Use:   "sync [path]",
+	Short: "Sync a Beads database with the current signals",
+	Long: `Scan the repository and bring a Beads database in line with the result:
+
+  - signals that match no bead, open or closed, are filed as new beads,
+  - open beads whose signal is no longer detected are closed, and
+  - open beads whose signal is still detected get its current priority
+    and description.
+
+Only beads stringer exported are closed or updated: those labeled
+stringer-generated or with a str- ID. A bead is only closed when the
+collector that found it ran without error, so syncing with fewer
+collectors leaves the other beads alone. Closed beads are never reopened
+or refiled, so won't-fix decisions stick.
+
+The database's issues.jsonl is rewritten in place; lines that did not
+change are kept byte for byte, and changed beads keep every field stringer
+does not manage. bd picks the changes up on its next import. Use --dry-run
+to list the changes without writing them.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runSync,
+}
+
+func init() {
+	syncCmd.Flags().StringVar(&syncBeadsDB, "beads-db", "", "beads database directory (default: <path>/.beads)")
+	syncCmd.Flags().StringVarP(&syncCollectors, "collectors", "c", "", "comma-separated list of collectors to run")
+	syncCmd.Flags().Float64Var(&syncMinConfidence, "min-confidence", 0, "do not file signals below this confidence threshold (0.0-1.0)")
+	syncCmd.Flags().BoolVar(&syncDryRun, "dry-run", false, "list the changes without writing them")
+
+	rootCmd.AddCommand(syncCmd)
+}
+
+// resetSyncFlags resets sync command flags for testing.
+func resetSyncFlags() {
+	syncBeadsDB = ""
+	syncCollectors = ""
+	syncMinConfidence = 0
+	syncDryRun = false
+
+	syncCmd.Flags().VisitAll(func(f *pflag.Flag) {
+		_ = f.Value.Set(f.DefValue)
+		f.Changed = false
+	})
+}
+
+func runSync(cmd *cobra.Command, args []string) error {
+	if syncMinConfidence < 0 || syncMinConfidence > 1.0 {
+		return exitError(ExitInvalidArgs,
+			"stringer: --min-confidence must be between 0.0 and 1.0 (got %.2f)", syncMinConfidence)
+	}
+
+	repoPath := "."
+	if len(args) > 0 {
+		repoPath = args[0]
+	}
+	absPath, gitRoot, err := resolveScanPath(repoPath)
+	if err != nil {
+		return err
+	}
+
+	// 1. Read the database first so a wrong --beads-db fails before the scan.
+	dbDir := syncBeadsDB
+	if dbDir == "" {
+		dbDir = filepath.Join(absPath, beads.BeadsDir)
+	}
+	if info, statErr := cmdFS.Stat(dbDir); statErr != nil || !info.IsDir() {
+		return exitError(ExitInvalidArgs, "stringer: no beads database at %s (run 'bd init' first)", dbDir)
+	}
+	issuesPath := filepath.Join(dbDir, beads.IssuesFile)
+	data, err := cmdFS.ReadFile(issuesPath)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return exitError(ExitInvalidArgs, "stringer: cannot read %s (%v)", issuesPath, err)
+	}
+	existing, err := beads.LoadFile(issuesPath)
+	if err != nil {
+		return exitError(ExitInvalidArgs, "stringer: cannot read beads (%v)", err)
+	}
+
+	// 2. Scan.
+	result, err := runConfiguredScan(cmd.Context(), gitRoot, signal.ScanConfig{RepoPath: absPath, Collectors: splitCollectors(syncCollectors)})
+	if err != nil {
+		return err
+	}
+	pipeline.BoostColocatedSignals(result.Signals)
+	estimate.Annotate(result.Signals, result.Signals, absPath)
+
+	ranOK := collectorsRanOK(result.Results)
+
+	// 3. Reconcile.
+	fileCfg, err := config.Load(absPath)
+	if err != nil {
+		return exitError(ExitInvalidArgs, "stringer: failed to load %s (%v)", config.FileName, err)
+	}
+	formatter := output.NewBeadsFormatter()
+	formatter.SetLabelMap(config.LabelMap(fileCfg))
+	formatter.SetConventions(beads.DetectConventions(existing))
+
+	synced, err := reconcile.SyncBeads(data, result.Signals, formatter, reconcile.SyncOptions{
+		MinConfidence: syncMinConfidence,
+		Collectors:    ranOK,
+		Now:           time.Now(),
+	})
+	if err != nil {
+		return exitError(ExitInvalidArgs, "stringer: cannot read %s (%v)", issuesPath, err)
+	}
+
+	// 4. Write.
+	w := cmd.OutOrStdout()
+	if syncDryRun || !quiet {
+		_ = reconcile.RenderSync(synced, w)
+	}
+	if !syncDryRun && synced.Changed() {
+		if err := cmdFS.WriteFile(issuesPath, synced.Bytes(), 0o644); err != nil { //nolint:gosec // issues.jsonl is committed and shared
+			return exitError(ExitTotalFailure, "stringer: cannot write %s (%v)", issuesPath, err)
+		}
+	}
+
+	if !quiet {
+		verb := "synced"
+		if syncDryRun {
+			verb = "would sync"
+		}
+		_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "stringer: %s %s: %d created, %d closed, %d updated\n",
+			verb, issuesPath, len(synced.Created), len(synced.Closed), len(synced.Updated))
+	}
+	return nil
+}
+
+// collectorsRanOK returns the collectors, and the sources derived from
+// them, that ran without error and were not skipped.
+func collectorsRanOK(results []signal.CollectorResult) map[string]bool {
+	ranOK := make(map[string]bool)
+	for _, cr := range results {
+		if cr.Err == nil && cr.SkipReason == "" {
+			ranOK[cr.Collector] = true
+		}
+	}
+	for derived, src := range derivedSources {
+		if ranOK[src] {
+			ranOK[derived] = true
+		}
+	}
+	return ranOK
+}
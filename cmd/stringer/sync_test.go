@@ -0,0 +1,76 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davetashner/stringer/internal/beads"
+)
+
+func TestSyncCmd(t *testing.T) {
+	root := initTestRepo(t)
+	writeTestFile(t, root, ".beads/issues.jsonl",
+		`{"id":"str-00000000","title":"TODO: Remove the legacy flag","status":"open","labels":["stringer-generated","todos"]}
+{"id":"app-1","title":"Write release notes","status":"open"}
+`)
+	issues := filepath.Join(root, ".beads", "issues.jsonl")
+
+	// A dry run lists the changes without writing them.
+	resetSyncFlags()
+	cmd, stdout, stderr := newTestCmd()
+	cmd.SetArgs([]string{"sync", root, "-c", "todos", "--dry-run"})
+	require.NoError(t, cmd.Execute())
+	assert.Contains(t, stdout.String(), "  create  ")
+	assert.Contains(t, stdout.String(), "  close   str-00000000  TODO: Remove the legacy flag\n")
+	assert.Contains(t, stderr.String(), "would sync")
+	before, err := os.ReadFile(issues)
+	require.NoError(t, err)
+	assert.NotContains(t, string(before), "closed")
+
+	resetSyncFlags()
+	cmd, _, stderr = newTestCmd()
+	cmd.SetArgs([]string{"sync", root, "-c", "todos"})
+	require.NoError(t, cmd.Execute())
+	assert.Regexp(t, `synced .*issues\.jsonl: \d+ created, 1 closed, 0 updated`, stderr.String())
+
+	synced, err := beads.LoadFile(issues)
+	require.NoError(t, err)
+	byID := make(map[string]beads.Bead)
+	for _, b := range synced {
+		byID[b.ID] = b
+	}
+	assert.Equal(t, "closed", byID["str-00000000"].Status)
+	assert.Equal(t, "open", byID["app-1"].Status)
+	assert.Greater(t, len(synced), 2)
+
+	// Nothing changed since: a second sync is a no-op.
+	resetSyncFlags()
+	cmd, _, stderr = newTestCmd()
+	cmd.SetArgs([]string{"sync", root, "-c", "todos", "--beads-db", filepath.Join(root, ".beads")})
+	require.NoError(t, cmd.Execute())
+	assert.Contains(t, stderr.String(), ": 0 created, 0 closed, 0 updated")
+}
+
+func TestSyncCmd_NoDatabase(t *testing.T) {
+	root := initTestRepo(t)
+	resetSyncFlags()
+	cmd, _, _ := newTestCmd()
+	cmd.SetArgs([]string{"sync", root, "-c", "todos"})
+	err := cmd.Execute()
+	requireExitCode(t, err, ExitInvalidArgs)
+	assert.Contains(t, err.Error(), "bd init")
+}
+
+func TestSyncCmd_InvalidMinConfidence(t *testing.T) {
+	resetSyncFlags()
+	cmd, _, _ := newTestCmd()
+	cmd.SetArgs([]string{"sync", t.TempDir(), "--min-confidence", "2"})
+	requireExitCode(t, cmd.Execute(), ExitInvalidArgs)
+}
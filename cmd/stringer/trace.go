@@ -0,0 +1,88 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/davetashner/stringer/internal/tracing"
+)
+
+// traceExportTimeout bounds sending the trace to an OTLP endpoint, so an
+// unreachable collector does not hold up the end of the scan.
+const traceExportTimeout = 10 * time.Second
+
+// traceGetenv reads the OTEL_* environment. Tests replace it.
+var traceGetenv = os.Getenv
+
+// startScanTrace starts tracing the scan when --trace names a file or the
+// OTEL_* environment names an OTLP endpoint, and attaches the tracer to
+// cmd's context so the pipeline, the collectors, and the forge clients
+// record spans. The returned function ends the scan span, records err on
+// it, restores cmd's context, and writes and sends the trace; it fails only
+// if the --trace file cannot be written. startScanTrace fails when the
+// environment asks for an OTLP protocol stringer cannot send.
+func startScanTrace(cmd *cobra.Command, absPath, traceFile string) (func(err error) error, error) {
+	cfg, err := tracing.ConfigFromEnv(traceGetenv)
+	if err != nil {
+		return nil, exitError(ExitInvalidArgs, "stringer: %v", err)
+	}
+	if cfg.Disabled {
+		cfg.Endpoint = ""
+	}
+	if traceFile == "" && cfg.Endpoint == "" {
+		return func(error) error { return nil }, nil
+	}
+
+	cfg.Resource["service.version"] = Version
+	tr := tracing.New(cfg.Service, cfg.Resource)
+	parent := cmd.Context()
+	ctx, span := tracing.Start(tracing.WithTracer(parent, tr), "stringer scan",
+		tracing.String("stringer.path", absPath))
+	cmd.SetContext(ctx)
+
+	return func(err error) error {
+		var ece *exitCodeError
+		switch {
+		case errors.As(err, &ece):
+			span.SetAttributes(tracing.Int("stringer.exit_code", ece.ExitCode()))
+			if ece.ExitCode() != ExitExpectation {
+				span.RecordError(err)
+			}
+		case err != nil:
+			span.RecordError(err)
+		}
+		span.End()
+		cmd.SetContext(parent)
+
+		if cfg.Endpoint != "" {
+			ctx, cancel := context.WithTimeout(context.Background(), traceExportTimeout)
+			defer cancel()
+			if err := tr.Export(ctx, &http.Client{}, cfg); err != nil {
+				slog.Warn("failed to export trace", "error", err)
+			} else {
+				slog.Info("trace exported", "endpoint", cfg.Endpoint, "spans", tr.Len())
+			}
+		}
+		if traceFile != "" {
+			var buf bytes.Buffer
+			if err := tr.WriteJSON(&buf); err != nil {
+				return exitError(ExitTotalFailure, "stringer: failed to render trace (%v)", err)
+			}
+			if err := cmdFS.WriteFile(traceFile, buf.Bytes(), 0o600); err != nil {
+				return exitError(ExitInvalidArgs, "stringer: cannot write trace file %q (%v)", traceFile, err)
+			}
+			slog.Info("trace written", "file", traceFile, "spans", tr.Len())
+		}
+		return nil
+	}, nil
+}
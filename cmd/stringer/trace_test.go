@@ -0,0 +1,154 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubTraceEnv replaces the OTEL_* environment for the test.
+func stubTraceEnv(t *testing.T, vars map[string]string) {
+	t.Helper()
+	orig := traceGetenv
+	traceGetenv = func(k string) string { return vars[k] }
+	t.Cleanup(func() { traceGetenv = orig })
+}
+
+// traceSpanNames returns the span names of an OTLP/JSON trace.
+func traceSpanNames(t *testing.T, data []byte) []string {
+	t.Helper()
+	var req struct {
+		ResourceSpans []struct {
+			ScopeSpans []struct {
+				Spans []struct {
+					Name string `json:"name"`
+				} `json:"spans"`
+			} `json:"scopeSpans"`
+		} `json:"resourceSpans"`
+	}
+	require.NoError(t, json.Unmarshal(data, &req))
+	var names []string
+	for _, rs := range req.ResourceSpans {
+		for _, ss := range rs.ScopeSpans {
+			for _, s := range ss.Spans {
+				names = append(names, s.Name)
+			}
+		}
+	}
+	return names
+}
+
+func TestScan_Trace(t *testing.T) {
+	stubTraceEnv(t, nil)
+	root := initTestRepo(t)
+	tracePath := filepath.Join(t.TempDir(), "trace.json")
+
+	resetScanFlags()
+	cmd, _, _ := newTestCmd()
+	cmd.SetArgs([]string{"scan", root, "-c", "todos", "-q", "--trace", tracePath})
+	require.NoError(t, cmd.Execute())
+
+	data, err := os.ReadFile(tracePath)
+	require.NoError(t, err)
+	names := traceSpanNames(t, data)
+	assert.Contains(t, names, "stringer scan")
+	assert.Contains(t, names, "collector todos")
+	assert.Contains(t, names, "walk")
+	assert.Contains(t, string(data), `"key":"service.name","value":{"stringValue":"stringer"}`)
+}
+
+func TestScan_TraceExportedToEndpoint(t *testing.T) {
+	var got []byte
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/traces", r.URL.Path)
+		assert.Equal(t, "secret", r.Header.Get("x-api-key"))
+		got, _ = io.ReadAll(r.Body)
+	}))
+	defer srv.Close()
+	stubTraceEnv(t, map[string]string{
+		"OTEL_EXPORTER_OTLP_ENDPOINT": srv.URL,
+		"OTEL_EXPORTER_OTLP_HEADERS":  "x-api-key=secret",
+		"OTEL_EXPORTER_OTLP_PROTOCOL": "http/json",
+		"OTEL_SERVICE_NAME":           "ci-scan",
+	})
+	root := initTestRepo(t)
+
+	resetScanFlags()
+	cmd, _, _ := newTestCmd()
+	cmd.SetArgs([]string{"scan", root, "-c", "todos", "-q"})
+	require.NoError(t, cmd.Execute())
+
+	assert.Contains(t, traceSpanNames(t, got), "collector todos")
+	assert.Contains(t, string(got), `"stringValue":"ci-scan"`)
+}
+
+func TestScan_TraceExportedAsProtobufByDefault(t *testing.T) {
+	var contentType string
+	var got []byte
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		contentType = r.Header.Get("Content-Type")
+		got, _ = io.ReadAll(r.Body)
+	}))
+	defer srv.Close()
+	stubTraceEnv(t, map[string]string{"OTEL_EXPORTER_OTLP_ENDPOINT": srv.URL})
+	root := initTestRepo(t)
+
+	resetScanFlags()
+	cmd, _, _ := newTestCmd()
+	cmd.SetArgs([]string{"scan", root, "-c", "todos", "-q"})
+	require.NoError(t, cmd.Execute())
+	assert.Equal(t, "application/x-protobuf", contentType)
+	assert.Contains(t, string(got), "collector todos")
+}
+
+func TestScan_TraceUnsupportedProtocol(t *testing.T) {
+	stubTraceEnv(t, map[string]string{
+		"OTEL_EXPORTER_OTLP_ENDPOINT": "http://localhost:4317",
+		"OTEL_EXPORTER_OTLP_PROTOCOL": "grpc",
+	})
+	root := initTestRepo(t)
+
+	resetScanFlags()
+	cmd, _, _ := newTestCmd()
+	cmd.SetArgs([]string{"scan", root, "-c", "todos", "-q"})
+	err := cmd.Execute()
+	requireExitCode(t, err, ExitInvalidArgs)
+	assert.Contains(t, err.Error(), `"grpc" is not supported`)
+}
+
+func TestScan_TraceDisabledByEnv(t *testing.T) {
+	called := false
+	srv := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) { called = true }))
+	defer srv.Close()
+	stubTraceEnv(t, map[string]string{
+		"OTEL_EXPORTER_OTLP_ENDPOINT": srv.URL,
+		"OTEL_SDK_DISABLED":           "true",
+	})
+	root := initTestRepo(t)
+
+	resetScanFlags()
+	cmd, _, _ := newTestCmd()
+	cmd.SetArgs([]string{"scan", root, "-c", "todos", "-q"})
+	require.NoError(t, cmd.Execute())
+	assert.False(t, called)
+}
+
+func TestScan_TraceUnwritable(t *testing.T) {
+	stubTraceEnv(t, nil)
+	root := initTestRepo(t)
+
+	resetScanFlags()
+	cmd, _, _ := newTestCmd()
+	cmd.SetArgs([]string{"scan", root, "-c", "todos", "-q", "--trace", filepath.Join(root, "missing", "trace.json")})
+	requireExitCode(t, cmd.Execute(), ExitInvalidArgs)
+}
@@ -5,17 +5,67 @@ package main
 
 import (
 	"fmt"
+	"net/http"
 
 	"github.com/spf13/cobra"
+
+	"github.com/davetashner/stringer/internal/versioncheck"
+)
+
+// versionCheck is the --check flag.
+var versionCheck bool
+
+// versionCheckURL and versionHTTPClient locate the release listing for
+// --check. A nil client uses the versioncheck default. Override in tests.
+var (
+	versionCheckURL   = versioncheck.ReleasesURL
+	versionHTTPClient *http.Client
 )
 
 // versionCmd prints the stringer version.
 var versionCmd = &cobra.Command{
 	Use:   "version",
 	Short: "Print version information",
-	Long:  "Print the version of the stringer binary.",
-	Args:  cobra.NoArgs,
-	Run: func(cmd *cobra.Command, _ []string) {
-		_, _ = fmt.Fprintf(cmd.OutOrStdout(), "stringer %s\n", Version)
-	},
+	Long: `Print the version of the stringer binary.
+
+With --check, also look up the latest release on GitHub, report whether this
+binary is out of date, and list configuration changes called out in the
+upgrade notes of the releases in between. Without --check the command never
+touches the network.`,
+	Args: cobra.NoArgs,
+	RunE: runVersion,
+}
+
+func init() {
+	versionCmd.Flags().BoolVar(&versionCheck, "check", false, "check GitHub for a newer release")
+}
+
+func runVersion(cmd *cobra.Command, _ []string) error {
+	w := cmd.OutOrStdout()
+	_, _ = fmt.Fprintf(w, "stringer %s\n", Version)
+	if !versionCheck {
+		return nil
+	}
+
+	res, err := versioncheck.Check(cmd.Context(), versionHTTPClient, versionCheckURL, Version)
+	if err != nil {
+		return exitError(ExitTotalFailure, "stringer: version check failed (%v)", err)
+	}
+
+	_, _ = fmt.Fprintf(w, "latest release: %s (%s)\n", res.Latest, res.URL)
+	switch {
+	case res.Outdated:
+		_, _ = fmt.Fprintf(w, "this binary is out of date; upgrade to %s\n", res.Latest)
+	case res.Development:
+		_, _ = fmt.Fprintln(w, "development build; cannot compare against releases")
+	default:
+		_, _ = fmt.Fprintln(w, "up to date")
+	}
+	if len(res.ConfigNotes) > 0 {
+		_, _ = fmt.Fprintln(w, "\nconfiguration changes since this version:")
+		for _, n := range res.ConfigNotes {
+			_, _ = fmt.Fprintf(w, "  %s: %s\n", n.Version, n.Note)
+		}
+	}
+	return nil
 }
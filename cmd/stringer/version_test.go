@@ -0,0 +1,97 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func resetVersionFlags() {
+	versionCheck = false
+	versionCmd.Flags().VisitAll(func(f *pflag.Flag) { f.Changed = false })
+}
+
+// withVersionServer points --check at a server answering with body and
+// stamps the binary as version.
+func withVersionServer(t *testing.T, version string, status int, body string) *int {
+	t.Helper()
+	hits := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		hits++
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(srv.Close)
+
+	origURL, origVersion := versionCheckURL, Version
+	versionCheckURL, Version = srv.URL, version
+	t.Cleanup(func() { versionCheckURL, Version = origURL, origVersion })
+	return &hits
+}
+
+const versionReleases = `[
+  {"tag_name": "v1.5.0", "html_url": "https://github.com/davetashner/stringer/releases/tag/v1.5.0",
+   "body": "## Upgrading\n\nRename ` + "`output.dir`" + ` to ` + "`output.path`" + ` in .stringer.yaml."},
+  {"tag_name": "v1.4.0", "body": "## Upgrading\n\nNo configuration changes required."}
+]`
+
+func TestVersionCmd_Offline(t *testing.T) {
+	resetVersionFlags()
+	hits := withVersionServer(t, "1.4.0", http.StatusOK, versionReleases)
+
+	cmd, stdout, _ := newTestCmd()
+	cmd.SetArgs([]string{"version"})
+	require.NoError(t, cmd.Execute())
+	assert.Equal(t, "stringer 1.4.0\n", stdout.String())
+	assert.Zero(t, *hits, "no network access without --check")
+}
+
+func TestVersionCmd_CheckOutdated(t *testing.T) {
+	resetVersionFlags()
+	withVersionServer(t, "1.4.0", http.StatusOK, versionReleases)
+
+	cmd, stdout, _ := newTestCmd()
+	cmd.SetArgs([]string{"version", "--check"})
+	require.NoError(t, cmd.Execute())
+	assert.Equal(t, "stringer 1.4.0\n"+
+		"latest release: v1.5.0 (https://github.com/davetashner/stringer/releases/tag/v1.5.0)\n"+
+		"this binary is out of date; upgrade to v1.5.0\n"+
+		"\nconfiguration changes since this version:\n"+
+		"  v1.5.0: Rename `output.dir` to `output.path` in .stringer.yaml.\n", stdout.String())
+}
+
+func TestVersionCmd_CheckCurrent(t *testing.T) {
+	resetVersionFlags()
+	withVersionServer(t, "v1.5.0", http.StatusOK, versionReleases)
+	cmd, stdout, _ := newTestCmd()
+	cmd.SetArgs([]string{"version", "--check"})
+	require.NoError(t, cmd.Execute())
+	assert.Contains(t, stdout.String(), "up to date\n")
+	assert.NotContains(t, stdout.String(), "configuration changes")
+
+	resetVersionFlags()
+	withVersionServer(t, "dev", http.StatusOK, versionReleases)
+	cmd, stdout, _ = newTestCmd()
+	cmd.SetArgs([]string{"version", "--check"})
+	require.NoError(t, cmd.Execute())
+	assert.Contains(t, stdout.String(), "development build")
+}
+
+func TestVersionCmd_CheckFails(t *testing.T) {
+	resetVersionFlags()
+	withVersionServer(t, "1.4.0", http.StatusServiceUnavailable, "")
+
+	cmd, stdout, _ := newTestCmd()
+	cmd.SetArgs([]string{"version", "--check"})
+	err := cmd.Execute()
+	requireExitCode(t, err, ExitTotalFailure)
+	assert.Contains(t, err.Error(), "version check failed")
+	assert.Equal(t, "stringer 1.4.0\n", stdout.String(), "the local version is still printed")
+}
@@ -0,0 +1,616 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/spf13/cobra"
+
+	"github.com/davetashner/stringer/internal/gitignore"
+	"github.com/davetashner/stringer/internal/output"
+	"github.com/davetashner/stringer/internal/pipeline"
+	"github.com/davetashner/stringer/internal/signal"
+	"github.com/davetashner/stringer/internal/state"
+	"github.com/davetashner/stringer/internal/statedir"
+)
+
+// Watch command flags.
+var (
+	watchCollectors  string
+	watchInterval    time.Duration
+	watchFormat      string
+	watchOutput      string
+	watchNoCache     bool
+	watchNoGitignore bool
+	watchPoll        bool
+)
+
+// watchSettle is how long the watcher waits after the last file system
+// event before re-scanning, so a burst of writes is scanned once.
+const watchSettle = 200 * time.Millisecond
+
+// Watch event names.
+const (
+	watchEventNew      = "new"
+	watchEventChanged  = "changed"
+	watchEventResolved = "resolved"
+)
+
+// watchCmd re-scans files as they change and streams signal events.
+var watchCmd = &cobra.Command{
+	Use:   "watch [path]",
+	Short: "Re-scan changed files continuously and stream signal events",
+	Long: `Watch a repository and re-scan files as they change.
+
+After an initial scan, which reports every signal as new, stringer waits
+for file system notifications and re-runs the collectors on the files that
+were written, created, or removed. Each difference is written as one line:
+
+  new       a signal appeared
+  changed   a signal moved to another line or file
+  resolved  a signal disappeared
+
+The jsonl format writes {"event": ..., "signal": ...} objects. The beads
+format writes Beads JSONL records: open records for new and changed
+signals, closed records for resolved ones and for the old location of a
+moved one.
+
+Notifications need one watch per directory. When they are unavailable,
+for example because the tree has more directories than the watch limit
+(fs.inotify.max_user_watches on Linux), or with --poll, stringer instead
+stats every file each --interval and re-scans those whose size or
+modification time changed. Polling costs a full tree walk per interval,
+so the default of 10s is kept long; lower it on small trees only.
+
+Only signals tied to a file are tracked after the initial scan, so the
+default collectors are todos and patterns. The .git and .stringer
+directories, paths git ignores (unless --no-gitignore), and the --output
+file are not watched. Stop with Ctrl-C.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runWatch,
+}
+
+func init() {
+	watchCmd.Flags().StringVarP(&watchCollectors, "collectors", "c", "todos,patterns", "comma-separated list of collectors to run")
+	watchCmd.Flags().DurationVar(&watchInterval, "interval", 10*time.Second, "how often to check for changed files when polling")
+	watchCmd.Flags().BoolVar(&watchPoll, "poll", false, "poll file stats instead of using file system notifications")
+	watchCmd.Flags().StringVarP(&watchFormat, "format", "f", "jsonl", "event format: jsonl or beads")
+	watchCmd.Flags().StringVarP(&watchOutput, "output", "o", "", "write events to this file instead of stdout")
+	watchCmd.Flags().BoolVar(&watchNoCache, "no-cache", false, "read every file instead of replaying the per-file scan cache")
+	watchCmd.Flags().BoolVar(&watchNoGitignore, "no-gitignore", false, "also scan files listed in .gitignore and .git/info/exclude")
+	rootCmd.AddCommand(watchCmd)
+}
+
+// watchEvent is one jsonl event line.
+type watchEvent struct {
+	Event    string             `json:"event"`
+	Signal   signal.RawSignal   `json:"signal"`
+	Previous *watchPrevLocation `json:"previous,omitempty"`
+}
+
+// watchPrevLocation is where a changed signal was before it moved.
+type watchPrevLocation struct {
+	FilePath string `json:"file_path"`
+	Line     int    `json:"line,omitempty"`
+}
+
+// fileStamp is what the watcher compares to decide a file changed.
+type fileStamp struct {
+	size    int64
+	modTime time.Time
+}
+
+func (s fileStamp) equal(o fileStamp) bool {
+	return s.size == o.size && s.modTime.Equal(o.modTime)
+}
+
+// watcher holds the signals seen so far, grouped by file.
+type watcher struct {
+	cmd     *cobra.Command
+	root    string
+	gitRoot string
+	skip    string             // absolute path of the output file, never watched
+	ignore  *gitignore.Matcher // nil with --no-gitignore
+	files   map[string]fileStamp
+	signals map[string][]signal.RawSignal
+	emit    func(event string, sig signal.RawSignal, prev *signal.RawSignal) error
+}
+
+func runWatch(cmd *cobra.Command, args []string) error {
+	repoPath := "."
+	if len(args) > 0 {
+		repoPath = args[0]
+	}
+	if watchFormat != "jsonl" && watchFormat != "beads" {
+		return exitError(ExitInvalidArgs, "stringer: unsupported watch format %q (use jsonl or beads)", watchFormat)
+	}
+	if watchInterval <= 0 {
+		return exitError(ExitInvalidArgs, "stringer: --interval must be positive")
+	}
+	absPath, gitRoot, err := resolveScanPath(repoPath)
+	if err != nil {
+		return err
+	}
+
+	var out io.Writer = cmd.OutOrStdout()
+	w := &watcher{cmd: cmd, root: absPath, gitRoot: gitRoot, signals: make(map[string][]signal.RawSignal)}
+	if watchOutput != "" {
+		f, err := cmdFS.Create(watchOutput)
+		if err != nil {
+			return exitError(ExitInvalidArgs, "stringer: cannot create output file (%v)", err)
+		}
+		defer f.Close() //nolint:errcheck // best-effort close on exit
+		out = f
+		if w.skip, err = filepath.Abs(watchOutput); err != nil {
+			return exitError(ExitInvalidArgs, "stringer: %v", err)
+		}
+	}
+	w.emit = newWatchEmitter(out, watchFormat)
+	if !watchNoGitignore {
+		w.ignore = gitignore.New(gitRoot)
+	}
+
+	if w.files, err = w.snapshot(); err != nil {
+		return exitError(ExitTotalFailure, "stringer: %v", err)
+	}
+	if err := w.initialScan(); err != nil {
+		return err
+	}
+
+	ctx := cmd.Context()
+	if !watchPoll {
+		fw, err := w.startNotify()
+		if err == nil {
+			defer fw.Close() //nolint:errcheck // best-effort close on exit
+			return w.notifyLoop(ctx, fw)
+		}
+		slog.Warn("file notifications unavailable, polling instead", "interval", watchInterval, "error", err)
+	}
+	return w.pollLoop(ctx)
+}
+
+// pollLoop re-scans the files whose stamp changed between two snapshots
+// taken --interval apart.
+func (w *watcher) pollLoop(ctx context.Context) error {
+	ticker := time.NewTicker(watchInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+		files, err := w.snapshot()
+		if err != nil {
+			slog.Warn("cannot list files, will retry", "error", err)
+			continue
+		}
+		changed := changedFiles(w.files, files)
+		w.files = files
+		if err := w.rescanChanged(ctx, changed); err != nil {
+			return err
+		}
+	}
+}
+
+// startNotify watches every directory of the tree that a snapshot would
+// visit. It fails when the platform has no notification support or the
+// watch limit (fs.inotify.max_user_watches on Linux) is too low.
+func (w *watcher) startNotify() (*fsnotify.Watcher, error) {
+	fw, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := w.addTree(fw, w.root); err != nil {
+		fw.Close() //nolint:errcheck,gosec // already failing
+		return nil, err
+	}
+	return fw, nil
+}
+
+// notifyLoop re-scans the files named by file system events. Events are
+// collected until none has arrived for watchSettle, so an editor's save or
+// a branch checkout triggers one re-scan rather than one per write.
+func (w *watcher) notifyLoop(ctx context.Context, fw *fsnotify.Watcher) error {
+	pending := make(map[string]bool)
+	overflow := false
+	var settle <-chan time.Time
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case ev, ok := <-fw.Events:
+			if !ok {
+				return nil
+			}
+			pending[ev.Name] = true
+			settle = time.After(watchSettle)
+		case err, ok := <-fw.Errors:
+			if !ok {
+				return nil
+			}
+			if !errors.Is(err, fsnotify.ErrEventOverflow) {
+				slog.Warn("file notification error", "error", err)
+				continue
+			}
+			// Events were dropped; compare a full snapshot instead.
+			overflow = true
+			settle = time.After(watchSettle)
+		case <-settle:
+			var changed []string
+			if overflow {
+				files, err := w.snapshot()
+				if err != nil {
+					slog.Warn("cannot list files, will retry", "error", err)
+					settle = time.After(watchInterval)
+					continue
+				}
+				changed = changedFiles(w.files, files)
+				w.files = files
+				// New directories may have appeared among the lost events.
+				if _, err := w.addTree(fw, w.root); err != nil {
+					slog.Warn("cannot watch new directories", "error", err)
+				}
+			} else {
+				changed = w.applyEvents(fw, pending)
+			}
+			pending = make(map[string]bool)
+			overflow = false
+			settle = nil
+			if err := w.rescanChanged(ctx, changed); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// applyEvents updates w.files for the paths named by events and returns
+// the files that were added, modified, or removed. A removed or renamed
+// directory removes every file below it; a new directory is watched and
+// its files are added.
+func (w *watcher) applyEvents(fw *fsnotify.Watcher, paths map[string]bool) []string {
+	seen := make(map[string]bool)
+	for path := range paths {
+		rel, err := filepath.Rel(w.root, path)
+		if err != nil || rel == "." || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) || path == w.skip {
+			continue
+		}
+		rel = filepath.ToSlash(rel)
+		info, err := os.Lstat(path)
+		switch {
+		case err != nil:
+			for tracked := range w.files {
+				if tracked == rel || strings.HasPrefix(tracked, rel+"/") {
+					delete(w.files, tracked)
+					seen[tracked] = true
+				}
+			}
+		case info.IsDir():
+			if w.skipDir(path, info.Name()) {
+				continue
+			}
+			added, err := w.addTree(fw, path)
+			if err != nil {
+				slog.Warn("cannot watch new directory", "path", rel, "error", err)
+			}
+			for _, f := range added {
+				seen[f] = true
+			}
+		case info.Mode().IsRegular():
+			if w.ignore.Ignored(path, false) {
+				continue
+			}
+			stamp := fileStamp{size: info.Size(), modTime: info.ModTime()}
+			if old, ok := w.files[rel]; !ok || !old.equal(stamp) {
+				w.files[rel] = stamp
+				seen[rel] = true
+			}
+		}
+	}
+	changed := make([]string, 0, len(seen))
+	for rel := range seen {
+		changed = append(changed, rel)
+	}
+	sort.Strings(changed)
+	return changed
+}
+
+// addTree watches dir and the directories below it, records the stamps of
+// their files, and returns the files that were new or had changed.
+func (w *watcher) addTree(fw *fsnotify.Watcher, dir string) ([]string, error) {
+	var changed []string
+	err := w.walk(dir, func(path string, d fs.DirEntry) error {
+		if d.IsDir() {
+			return fw.Add(path)
+		}
+		info, err := d.Info()
+		if err != nil {
+			return nil //nolint:nilerr // vanished between readdir and stat
+		}
+		rel, err := filepath.Rel(w.root, path)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+		stamp := fileStamp{size: info.Size(), modTime: info.ModTime()}
+		if old, ok := w.files[rel]; !ok || !old.equal(stamp) {
+			w.files[rel] = stamp
+			changed = append(changed, rel)
+		}
+		return nil
+	})
+	return changed, err
+}
+
+// rescanChanged re-scans changed, if any. Errors after cancellation are
+// dropped since the scan was interrupted by the user stopping the watch.
+func (w *watcher) rescanChanged(ctx context.Context, changed []string) error {
+	if len(changed) == 0 {
+		return nil
+	}
+	if err := w.rescan(changed); err != nil {
+		if ctx.Err() != nil {
+			return nil
+		}
+		return err
+	}
+	return nil
+}
+
+// initialScan scans the whole tree and reports every signal as new.
+func (w *watcher) initialScan() error {
+	result, err := w.scan(signal.Scope{})
+	if err != nil {
+		return err
+	}
+	for _, sig := range result {
+		if err := w.emit(watchEventNew, sig, nil); err != nil {
+			return exitError(ExitTotalFailure, "stringer: %v", err)
+		}
+		w.signals[sig.FilePath] = append(w.signals[sig.FilePath], sig)
+	}
+	return nil
+}
+
+// rescan re-runs the collectors on the changed files and reports how their
+// signals differ from the previous scan. Deleted files are not scanned;
+// their signals are resolved.
+func (w *watcher) rescan(changed []string) error {
+	var present []string
+	for _, rel := range changed {
+		if _, ok := w.files[rel]; ok {
+			present = append(present, rel)
+		}
+	}
+	var current []signal.RawSignal
+	if len(present) > 0 {
+		result, err := w.scan(signal.NewFileScope(present))
+		if err != nil {
+			return err
+		}
+		current = result
+	}
+
+	inScope := make(map[string]bool, len(changed))
+	var previous []signal.RawSignal
+	for _, rel := range changed {
+		inScope[rel] = true
+		previous = append(previous, w.signals[rel]...)
+		delete(w.signals, rel)
+	}
+	var kept []signal.RawSignal
+	for _, sig := range current {
+		// Collectors that ignore the scope report other files too.
+		if inScope[sig.FilePath] {
+			kept = append(kept, sig)
+			w.signals[sig.FilePath] = append(w.signals[sig.FilePath], sig)
+		}
+	}
+
+	for _, ev := range diffWatchSignals(previous, kept) {
+		if err := w.emit(ev.event, ev.signal, ev.previous); err != nil {
+			return exitError(ExitTotalFailure, "stringer: %v", err)
+		}
+	}
+	return nil
+}
+
+func (w *watcher) scan(scope signal.Scope) ([]signal.RawSignal, error) {
+	result, err := runConfiguredScan(w.cmd.Context(), w.gitRoot, signal.ScanConfig{
+		RepoPath:    w.root,
+		Collectors:  splitCollectors(watchCollectors),
+		Scope:       scope,
+		NoCache:     watchNoCache,
+		NoGitignore: watchNoGitignore,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.Signals, nil
+}
+
+// snapshot records the size and modification time of every file under the
+// watched root, keyed by slash-separated relative path.
+func (w *watcher) snapshot() (map[string]fileStamp, error) {
+	files := make(map[string]fileStamp)
+	err := w.walk(w.root, func(path string, d fs.DirEntry) error {
+		if d.IsDir() {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return nil //nolint:nilerr // vanished between readdir and stat
+		}
+		rel, err := filepath.Rel(w.root, path)
+		if err != nil {
+			return err
+		}
+		files[filepath.ToSlash(rel)] = fileStamp{size: info.Size(), modTime: info.ModTime()}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return files, nil
+}
+
+// walk calls fn for dir, every directory below it, and every regular file
+// the watcher tracks. It skips the .git and .stringer directories, paths
+// git ignores, and the output file.
+func (w *watcher) walk(dir string, fn func(path string, d fs.DirEntry) error) error {
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			// Files removed mid-walk are picked up as deleted next time.
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		if d.IsDir() {
+			if path != w.root && w.skipDir(path, d.Name()) {
+				return filepath.SkipDir
+			}
+			return fn(path, d)
+		}
+		if !d.Type().IsRegular() || path == w.skip || w.ignore.Ignored(path, false) {
+			return nil
+		}
+		return fn(path, d)
+	})
+	if err != nil {
+		return fmt.Errorf("walk %s: %w", dir, err)
+	}
+	return nil
+}
+
+// skipDir reports whether the directory at path is never watched.
+func (w *watcher) skipDir(path, name string) bool {
+	return name == ".git" || name == statedir.Name || w.ignore.Ignored(path, true)
+}
+
+// changedFiles returns the sorted paths that were added, modified, or
+// removed between two snapshots.
+func changedFiles(prev, cur map[string]fileStamp) []string {
+	var changed []string
+	for rel, stamp := range cur {
+		if old, ok := prev[rel]; !ok || !old.equal(stamp) {
+			changed = append(changed, rel)
+		}
+	}
+	for rel := range prev {
+		if _, ok := cur[rel]; !ok {
+			changed = append(changed, rel)
+		}
+	}
+	sort.Strings(changed)
+	return changed
+}
+
+// watchDiff is one event produced by diffWatchSignals.
+type watchDiff struct {
+	event    string
+	signal   signal.RawSignal
+	previous *signal.RawSignal
+}
+
+// diffWatchSignals compares the signals of the changed files before and
+// after a re-scan. It uses the same matching as `stringer scan --delta`:
+// a signal with the same title and kind at a new location has changed.
+func diffWatchSignals(previous, current []signal.RawSignal) []watchDiff {
+	prevByHash := indexByHash(previous)
+	curByHash := indexByHash(current)
+	diff := state.ComputeDiff(
+		&state.ScanState{SignalMetas: watchMetas(previous)},
+		&state.ScanState{SignalMetas: watchMetas(current)},
+	)
+
+	var events []watchDiff
+	for _, m := range diff.Added {
+		events = append(events, watchDiff{event: watchEventNew, signal: curByHash[m.Hash]})
+	}
+	for _, mv := range diff.Moved {
+		prev := prevByHash[mv.Previous.Hash]
+		events = append(events, watchDiff{event: watchEventChanged, signal: curByHash[mv.Current.Hash], previous: &prev})
+	}
+	for _, m := range diff.Removed {
+		events = append(events, watchDiff{event: watchEventResolved, signal: prevByHash[m.Hash]})
+	}
+	return events
+}
+
+func indexByHash(signals []signal.RawSignal) map[string]signal.RawSignal {
+	m := make(map[string]signal.RawSignal, len(signals))
+	for _, s := range signals {
+		m[pipeline.SignalHash(s)] = s
+	}
+	return m
+}
+
+func watchMetas(signals []signal.RawSignal) []state.SignalMeta {
+	metas := make([]state.SignalMeta, 0, len(signals))
+	for _, s := range signals {
+		metas = append(metas, state.SignalMeta{
+			Hash:     pipeline.SignalHash(s),
+			Source:   s.Source,
+			Kind:     s.Kind,
+			FilePath: s.FilePath,
+			Line:     s.Line,
+			Title:    s.Title,
+		})
+	}
+	return metas
+}
+
+// newWatchEmitter returns a function writing one event in format to out.
+func newWatchEmitter(out io.Writer, format string) func(string, signal.RawSignal, *signal.RawSignal) error {
+	if format == "beads" {
+		bf := output.NewBeadsFormatter()
+		return func(event string, sig signal.RawSignal, prev *signal.RawSignal) error {
+			var records []signal.RawSignal
+			switch event {
+			case watchEventResolved:
+				records = append(records, closedSignal(sig))
+			case watchEventChanged:
+				// Bead IDs include the location, so a move closes the old bead.
+				records = append(records, closedSignal(*prev), sig)
+			default:
+				records = append(records, sig)
+			}
+			return bf.Format(records, out)
+		}
+	}
+
+	enc := json.NewEncoder(out)
+	return func(event string, sig signal.RawSignal, prev *signal.RawSignal) error {
+		ev := watchEvent{Event: event, Signal: sig}
+		if prev != nil {
+			ev.Previous = &watchPrevLocation{FilePath: prev.FilePath, Line: prev.Line}
+		}
+		if err := enc.Encode(ev); err != nil {
+			return fmt.Errorf("write %s event: %w", event, err)
+		}
+		return nil
+	}
+}
+
+// closedSignal marks sig as resolved now so the beads formatter writes it
+// as a closed record.
+func closedSignal(sig signal.RawSignal) signal.RawSignal {
+	sig.Tags = append(append([]string(nil), sig.Tags...), "pre-closed")
+	sig.ClosedAt = time.Now()
+	return sig
+}
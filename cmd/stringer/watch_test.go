@@ -0,0 +1,267 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davetashner/stringer/internal/gitignore"
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+func resetWatchFlags() {
+	watchCmd.Flags().VisitAll(func(f *pflag.Flag) {
+		f.Changed = false
+		_ = f.Value.Set(f.DefValue)
+	})
+}
+
+// readWatchEvents parses the jsonl events written so far to path.
+func readWatchEvents(t *testing.T, path string) []watchEvent {
+	t.Helper()
+	f, err := os.Open(path) //nolint:gosec // test-controlled path
+	if err != nil {
+		return nil
+	}
+	defer f.Close() //nolint:errcheck // test cleanup
+
+	var events []watchEvent
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		var ev watchEvent
+		if json.Unmarshal(sc.Bytes(), &ev) == nil {
+			events = append(events, ev)
+		}
+	}
+	return events
+}
+
+func hasWatchEvent(events []watchEvent, event, title string) bool {
+	for _, ev := range events {
+		if ev.Event == event && strings.Contains(ev.Signal.Title, title) {
+			return true
+		}
+	}
+	return false
+}
+
+func TestWatchCmd_StreamsEvents(t *testing.T) {
+	testWatchStreamsEvents(t)
+}
+
+func TestWatchCmd_StreamsEventsPolling(t *testing.T) {
+	testWatchStreamsEvents(t, "--poll", "--interval", "20ms")
+}
+
+func testWatchStreamsEvents(t *testing.T, extraArgs ...string) {
+	dir := initTestRepo(t)
+	out := filepath.Join(t.TempDir(), "events.jsonl")
+
+	resetWatchFlags()
+	cmd, _, _ := newTestCmd()
+	cmd.SetArgs(append([]string{"watch", dir, "-c", "todos", "--no-cache", "-o", out}, extraArgs...))
+	ctx, cancel := context.WithCancel(context.Background())
+	// Cobra only hands ctx to a subcommand without one, so replace the
+	// context an earlier run left on watchCmd.
+	watchCmd.SetContext(ctx)
+	done := make(chan error, 1)
+	go func() { done <- cmd.ExecuteContext(ctx) }()
+	defer func() {
+		cancel()
+		select {
+		case err := <-done:
+			assert.NoError(t, err)
+		case <-time.After(10 * time.Second):
+			t.Error("watch did not stop after cancellation")
+		}
+		// ExecuteContext keeps ctx on the shared root command.
+		cmd.SetContext(context.Background())
+	}()
+
+	require.Eventually(t, func() bool {
+		return hasWatchEvent(readWatchEvents(t, out), watchEventNew, "Add proper CLI argument parsing")
+	}, 10*time.Second, 20*time.Millisecond, "initial scan reports existing signals as new")
+
+	writeTestFile(t, dir, "extra.go", "package main\n\n// TODO: Watch for this one\n")
+	require.Eventually(t, func() bool {
+		return hasWatchEvent(readWatchEvents(t, out), watchEventNew, "Watch for this one")
+	}, 10*time.Second, 20*time.Millisecond, "added TODO is reported as new")
+
+	writeTestFile(t, dir, "extra.go", "package main\n\n\n// TODO: Watch for this one\n")
+	require.Eventually(t, func() bool {
+		for _, ev := range readWatchEvents(t, out) {
+			if ev.Event == watchEventChanged && ev.Signal.Line == 4 {
+				return assert.Equal(t, &watchPrevLocation{FilePath: "extra.go", Line: 3}, ev.Previous)
+			}
+		}
+		return false
+	}, 10*time.Second, 20*time.Millisecond, "moved TODO is reported as changed")
+
+	require.NoError(t, os.Remove(filepath.Join(dir, "extra.go")))
+	require.Eventually(t, func() bool {
+		return hasWatchEvent(readWatchEvents(t, out), watchEventResolved, "Watch for this one")
+	}, 10*time.Second, 20*time.Millisecond, "TODO of a deleted file is reported as resolved")
+
+	writeTestFile(t, dir, "pkg/nested/deep.go", "package nested\n\n// TODO: Watch new directories\n")
+	require.Eventually(t, func() bool {
+		return hasWatchEvent(readWatchEvents(t, out), watchEventNew, "Watch new directories")
+	}, 10*time.Second, 20*time.Millisecond, "TODO in a new directory is reported as new")
+
+	require.NoError(t, os.RemoveAll(filepath.Join(dir, "pkg")))
+	require.Eventually(t, func() bool {
+		return hasWatchEvent(readWatchEvents(t, out), watchEventResolved, "Watch new directories")
+	}, 10*time.Second, 20*time.Millisecond, "TODO of a deleted directory is reported as resolved")
+}
+
+func TestWatchCmd_InvalidFormat(t *testing.T) {
+	resetWatchFlags()
+	cmd, _, _ := newTestCmd()
+	cmd.SetArgs([]string{"watch", ".", "--format", "sarif"})
+	err := cmd.Execute()
+	requireExitCode(t, err, ExitInvalidArgs)
+	assert.Contains(t, err.Error(), "unsupported watch format")
+}
+
+func TestWatchCmd_InvalidInterval(t *testing.T) {
+	resetWatchFlags()
+	cmd, _, _ := newTestCmd()
+	cmd.SetArgs([]string{"watch", ".", "--interval", "0s"})
+	err := cmd.Execute()
+	requireExitCode(t, err, ExitInvalidArgs)
+	assert.Contains(t, err.Error(), "--interval must be positive")
+}
+
+func TestWatcher_ApplyEvents(t *testing.T) {
+	dir := t.TempDir()
+	writeTestFile(t, dir, ".gitignore", "build/\n")
+	writeTestFile(t, dir, "kept.go", "package main\n")
+	writeTestFile(t, dir, "build/out.go", "package build\n")
+	writeTestFile(t, dir, "added/new.go", "package added\n")
+
+	fw, err := fsnotify.NewWatcher()
+	require.NoError(t, err)
+	defer fw.Close() //nolint:errcheck // test cleanup
+
+	w := &watcher{root: dir, ignore: gitignore.New(dir), files: map[string]fileStamp{
+		"kept.go":         {},
+		"gone/a.go":       {},
+		"gone/sub/b.go":   {},
+		"gone-sibling.go": {},
+	}}
+	changed := w.applyEvents(fw, map[string]bool{
+		filepath.Join(dir, "kept.go"):      true,
+		filepath.Join(dir, "gone"):         true,
+		filepath.Join(dir, "build"):        true,
+		filepath.Join(dir, "build/out.go"): true,
+		filepath.Join(dir, "added"):        true,
+	})
+
+	assert.Equal(t, []string{"added/new.go", "gone/a.go", "gone/sub/b.go", "kept.go"}, changed)
+	assert.Contains(t, w.files, "added/new.go")
+	assert.Contains(t, w.files, "gone-sibling.go")
+	assert.NotContains(t, w.files, "gone/a.go")
+	assert.NotContains(t, w.files, "build/out.go")
+	assert.Contains(t, fw.WatchList(), filepath.Join(dir, "added"))
+	assert.NotContains(t, fw.WatchList(), filepath.Join(dir, "build"))
+}
+
+func TestWatcher_SnapshotSkipsIgnored(t *testing.T) {
+	dir := t.TempDir()
+	writeTestFile(t, dir, ".gitignore", "build/\n*.log\n")
+	writeTestFile(t, dir, "main.go", "package main\n")
+	writeTestFile(t, dir, "debug.log", "x\n")
+	writeTestFile(t, dir, "build/out.go", "package build\n")
+	writeTestFile(t, dir, ".stringer/cache.json", "{}\n")
+
+	files, err := (&watcher{root: dir, ignore: gitignore.New(dir)}).snapshot()
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{".gitignore", "main.go"}, mapKeys(files))
+
+	files, err = (&watcher{root: dir}).snapshot()
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{".gitignore", "main.go", "debug.log", "build/out.go"}, mapKeys(files))
+}
+
+func mapKeys(m map[string]fileStamp) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+func TestChangedFiles(t *testing.T) {
+	t0 := time.Unix(1000, 0)
+	prev := map[string]fileStamp{
+		"same.go":    {size: 1, modTime: t0},
+		"resized.go": {size: 1, modTime: t0},
+		"touched.go": {size: 1, modTime: t0},
+		"gone.go":    {size: 1, modTime: t0},
+	}
+	cur := map[string]fileStamp{
+		"same.go":    {size: 1, modTime: t0},
+		"resized.go": {size: 2, modTime: t0},
+		"touched.go": {size: 1, modTime: t0.Add(time.Second)},
+		"added.go":   {size: 1, modTime: t0},
+	}
+	assert.Equal(t, []string{"added.go", "gone.go", "resized.go", "touched.go"}, changedFiles(prev, cur))
+}
+
+func TestDiffWatchSignals(t *testing.T) {
+	kept := signal.RawSignal{Source: "todos", Kind: "todo", FilePath: "a.go", Line: 1, Title: "kept"}
+	moved := signal.RawSignal{Source: "todos", Kind: "todo", FilePath: "a.go", Line: 2, Title: "moved"}
+	gone := signal.RawSignal{Source: "todos", Kind: "todo", FilePath: "a.go", Line: 3, Title: "gone"}
+	added := signal.RawSignal{Source: "todos", Kind: "todo", FilePath: "a.go", Line: 4, Title: "added"}
+	movedNow := moved
+	movedNow.Line = 5
+
+	events := diffWatchSignals(
+		[]signal.RawSignal{kept, moved, gone},
+		[]signal.RawSignal{kept, movedNow, added},
+	)
+	require.Len(t, events, 3)
+	assert.Equal(t, watchEventNew, events[0].event)
+	assert.Equal(t, added, events[0].signal)
+	assert.Equal(t, watchEventChanged, events[1].event)
+	assert.Equal(t, movedNow, events[1].signal)
+	assert.Equal(t, moved, *events[1].previous)
+	assert.Equal(t, watchEventResolved, events[2].event)
+	assert.Equal(t, gone, events[2].signal)
+}
+
+func TestWatchEmitter_Beads(t *testing.T) {
+	var buf strings.Builder
+	emit := newWatchEmitter(&buf, "beads")
+	prev := signal.RawSignal{Source: "todos", Kind: "todo", FilePath: "a.go", Line: 1, Title: "fix it"}
+	cur := prev
+	cur.Line = 2
+
+	require.NoError(t, emit(watchEventChanged, cur, &prev))
+	require.NoError(t, emit(watchEventResolved, cur, nil))
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 3)
+	var statuses []string
+	for _, line := range lines {
+		var rec struct {
+			Status string `json:"status"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(line), &rec))
+		statuses = append(statuses, rec.Status)
+	}
+	assert.Equal(t, []string{"closed", "open", "closed"}, statuses)
+	assert.Empty(t, prev.Tags, "closing a signal must not alias its tags")
+}
@@ -0,0 +1,203 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/davetashner/stringer/internal/output"
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+// splitIndexFile is the file --split-by-workspace writes next to the
+// per-workspace outputs, listing each one with its summary.
+const splitIndexFile = "index.json"
+
+// rootWorkspace names the output for signals outside any workspace, and for
+// the whole scan of a repository that is not a monorepo.
+const rootWorkspace = "_root"
+
+// formatExtensions maps stream formats to the file extension of their
+// per-workspace output.
+var formatExtensions = map[string]string{
+	"beads":         ".jsonl",
+	"json":          ".json",
+	"markdown":      ".md",
+	"html":          ".html",
+	"sarif":         ".sarif",
+	"tasks":         ".json",
+	"pr-comment":    ".md",
+	"github-issues": ".jsonl",
+	"csv":           ".csv",
+	"junit":         ".xml",
+	"rdjson":        ".json",
+}
+
+// workspaceOutput summarizes one file written by --split-by-workspace.
+type workspaceOutput struct {
+	Workspace  string         `json:"workspace"`
+	Path       string         `json:"path"`
+	File       string         `json:"file"`
+	Signals    int            `json:"signals"`
+	Kinds      map[string]int `json:"kinds,omitempty"`
+	Collectors map[string]int `json:"collectors,omitempty"`
+}
+
+// splitByWorkspace groups signals by workspace, in workspace order, with
+// file paths made relative to the workspace again. Every scanned workspace
+// gets a group, even an empty one; signals outside any workspace are
+// grouped under rootWorkspace.
+func splitByWorkspace(workspaces []workspaceEntry, signals []signal.RawSignal) ([]workspaceEntry, map[string][]signal.RawSignal) {
+	byName := make(map[string][]signal.RawSignal)
+	for _, sig := range signals {
+		name := sig.Workspace
+		if name == "" {
+			name = rootWorkspace
+		}
+		byName[name] = append(byName[name], sig)
+	}
+
+	var order []workspaceEntry
+	for _, ws := range workspaces {
+		if ws.Name == "" {
+			continue
+		}
+		order = append(order, ws)
+		byName[ws.Name] = unstampWorkspace(ws, byName[ws.Name])
+	}
+	if len(order) == 0 || len(byName[rootWorkspace]) > 0 {
+		order = append(order, workspaceEntry{Name: rootWorkspace, Rel: "."})
+	}
+	return order, byName
+}
+
+// unstampWorkspace returns copies of signals with FilePath relative to the
+// workspace rather than the monorepo root, undoing stampWorkspace.
+func unstampWorkspace(ws workspaceEntry, signals []signal.RawSignal) []signal.RawSignal {
+	out := make([]signal.RawSignal, len(signals))
+	copy(out, signals)
+	if ws.Rel == "." {
+		return out
+	}
+	for i := range out {
+		if out[i].FilePath == "" {
+			continue
+		}
+		rel, err := filepath.Rel(ws.Rel, out[i].FilePath)
+		if err == nil && !strings.HasPrefix(rel, "..") {
+			out[i].FilePath = rel
+		}
+	}
+	return out
+}
+
+// workspaceFileName turns a workspace name, which may contain slashes or an
+// npm scope, into a file name.
+func workspaceFileName(name string) string {
+	name = strings.TrimPrefix(name, "@")
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '-', r == '_', r == '.':
+			return r
+		default:
+			return '-'
+		}
+	}, name)
+}
+
+// writeWorkspaceOutputs writes one output per workspace into dir, plus an
+// index summarizing them. Directory formats get a subdirectory each.
+func writeWorkspaceOutputs(cmd *cobra.Command, workspaces []workspaceEntry, result *signal.ScanResult, scanCfg signal.ScanConfig, dir string) error {
+	formatter, _ := output.GetFormatter(scanCfg.OutputFormat) // already validated in loadScanConfig
+	if err := cmdFS.MkdirAll(dir, 0o750); err != nil {
+		return exitError(ExitInvalidArgs, "stringer: cannot create output directory %q (%v)", dir, err)
+	}
+
+	order, groups := splitByWorkspace(workspaces, result.Signals)
+	index := make([]workspaceOutput, 0, len(order))
+	// The index's name is taken, so a workspace called "index" does not
+	// overwrite it. Names compare case-insensitively, as on macOS.
+	used := map[string]int{strings.TrimSuffix(splitIndexFile, filepath.Ext(splitIndexFile)): 1}
+	for _, ws := range order {
+		sigs := groups[ws.Name]
+		file := workspaceFileName(ws.Name)
+		key := strings.ToLower(file)
+		if used[key]++; used[key] > 1 {
+			file = fmt.Sprintf("%s-%d", file, used[key])
+		}
+		entry := workspaceOutput{
+			Workspace:  ws.Name,
+			Path:       filepath.ToSlash(ws.Rel),
+			File:       file,
+			Signals:    len(sigs),
+			Kinds:      countBy(sigs, func(s signal.RawSignal) string { return s.Kind }),
+			Collectors: countBy(sigs, func(s signal.RawSignal) string { return s.Source }),
+		}
+
+		if df, ok := formatter.(output.DirectoryFormatter); ok {
+			if err := df.FormatDir(sigs, filepath.Join(dir, entry.File)); err != nil {
+				return exitError(ExitTotalFailure, "stringer: formatting failed for workspace %s (%v)", ws.Name, err)
+			}
+		} else {
+			entry.File += formatExtensions[scanCfg.OutputFormat]
+			var buf bytes.Buffer
+			if err := formatter.Format(sigs, &buf); err != nil {
+				return exitError(ExitTotalFailure, "stringer: formatting failed for workspace %s (%v)", ws.Name, err)
+			}
+			if err := cmdFS.WriteFile(filepath.Join(dir, entry.File), buf.Bytes(), 0o600); err != nil {
+				return exitError(ExitInvalidArgs, "stringer: cannot write output file %q (%v)", entry.File, err)
+			}
+		}
+		slog.Info("workspace output written", "workspace", ws.Name, "file", entry.File, "issues", entry.Signals)
+		index = append(index, entry)
+	}
+
+	data, err := json.MarshalIndent(struct {
+		Workspaces []workspaceOutput `json:"workspaces"`
+	}{index}, "", "  ")
+	if err != nil {
+		return exitError(ExitTotalFailure, "stringer: JSON marshal failed (%v)", err)
+	}
+	if err := cmdFS.WriteFile(filepath.Join(dir, splitIndexFile), append(data, '\n'), 0o600); err != nil {
+		return exitError(ExitInvalidArgs, "stringer: cannot write output file %q (%v)", splitIndexFile, err)
+	}
+	if !quiet {
+		_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "stringer: wrote %d workspace output(s) to %s\n", len(index), dir)
+	}
+	slog.Info("scan complete", "issues", len(result.Signals), "workspaces", len(index), "duration", result.Duration)
+	return nil
+}
+
+// scannedWorkspaces returns the workspaces the scan reached, leaving out
+// those --quick skipped at its deadline.
+func (sc *scanContext) scannedWorkspaces() []workspaceEntry {
+	var out []workspaceEntry
+	for _, ws := range sc.workspaces {
+		if !slices.Contains(sc.skippedWS, ws.Name) {
+			out = append(out, ws)
+		}
+	}
+	return out
+}
+
+// countBy counts signals by the key each maps to; it returns nil for no
+// signals so empty summaries are omitted.
+func countBy(signals []signal.RawSignal, key func(signal.RawSignal) string) map[string]int {
+	if len(signals) == 0 {
+		return nil
+	}
+	counts := make(map[string]int)
+	for _, s := range signals {
+		counts[key(s)]++
+	}
+	return counts
+}
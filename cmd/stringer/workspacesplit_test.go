@@ -0,0 +1,139 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+func TestSplitByWorkspace(t *testing.T) {
+	workspaces := []workspaceEntry{
+		{Name: "api", Rel: filepath.Join("services", "api")},
+		{Name: "web", Rel: "web"},
+		{Name: "docs", Rel: "docs"},
+	}
+	signals := []signal.RawSignal{
+		{Workspace: "api", FilePath: filepath.Join("services", "api", "main.go"), Title: "a"},
+		{Workspace: "web", FilePath: filepath.Join("web", "app.js"), Title: "w"},
+		{Workspace: "api", Title: "no file"},
+		{FilePath: "README.md", Title: "root"},
+	}
+
+	order, groups := splitByWorkspace(workspaces, signals)
+	require.Len(t, order, 4)
+	assert.Equal(t, []string{"api", "web", "docs", rootWorkspace},
+		[]string{order[0].Name, order[1].Name, order[2].Name, order[3].Name})
+
+	require.Len(t, groups["api"], 2)
+	assert.Equal(t, "main.go", groups["api"][0].FilePath)
+	assert.Empty(t, groups["api"][1].FilePath)
+	assert.Equal(t, "app.js", groups["web"][0].FilePath)
+	assert.Empty(t, groups["docs"], "a workspace without signals still gets a group")
+	assert.Equal(t, "README.md", groups[rootWorkspace][0].FilePath)
+	assert.Equal(t, filepath.Join("services", "api", "main.go"), signals[0].FilePath, "input signals are not modified")
+}
+
+func TestSplitByWorkspace_NotMonorepo(t *testing.T) {
+	order, groups := splitByWorkspace([]workspaceEntry{{Rel: "."}}, []signal.RawSignal{{FilePath: "main.go"}})
+	require.Len(t, order, 1)
+	assert.Equal(t, rootWorkspace, order[0].Name)
+	assert.Len(t, groups[rootWorkspace], 1)
+}
+
+func TestWorkspaceFileName(t *testing.T) {
+	assert.Equal(t, "svc-a", workspaceFileName("svc-a"))
+	assert.Equal(t, "acme-ui", workspaceFileName("@acme/ui"))
+	assert.Equal(t, "apps-web", workspaceFileName("apps/web"))
+	assert.Equal(t, "_root", workspaceFileName(rootWorkspace))
+}
+
+func TestRunScan_SplitByWorkspace(t *testing.T) {
+	dir := initTestRepo(t)
+	writeTestFile(t, dir, "go.work", "go 1.24\n\nuse (\n\t./svc-a\n\t./svc-b\n)\n")
+	writeTestFile(t, dir, "svc-a/go.mod", "module svc-a\n\ngo 1.24\n")
+	writeTestFile(t, dir, "svc-a/handler.go", "package a\n\n// TODO: Validate input\n")
+	writeTestFile(t, dir, "svc-b/go.mod", "module svc-b\n\ngo 1.24\n")
+	writeTestFile(t, dir, "svc-b/store.go", "package b\n\n// FIXME: Close the connection\n// TODO: Add retries\n")
+	out := filepath.Join(t.TempDir(), "out")
+
+	resetScanFlags()
+	cmd, stdout, stderr := newTestCmd()
+	cmd.SetArgs([]string{"scan", dir, "-c", "todos", "--no-cache", "-f", "json", "--split-by-workspace", "--output-dir", out})
+	require.NoError(t, cmd.Execute())
+	assert.Empty(t, stdout.String())
+	assert.Contains(t, stderr.String(), "stringer: wrote 2 workspace output(s)")
+
+	data, err := os.ReadFile(filepath.Join(out, "svc-b.json")) //nolint:gosec // test-controlled path
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"FilePath": "store.go"`, "paths are relative to the workspace")
+	assert.NotContains(t, string(data), "Validate input")
+
+	data, err = os.ReadFile(filepath.Join(out, splitIndexFile)) //nolint:gosec // test-controlled path
+	require.NoError(t, err)
+	var index struct {
+		Workspaces []workspaceOutput `json:"workspaces"`
+	}
+	require.NoError(t, json.Unmarshal(data, &index))
+	require.Len(t, index.Workspaces, 2)
+	assert.Equal(t, workspaceOutput{
+		Workspace: "svc-a", Path: "svc-a", File: "svc-a.json", Signals: 1,
+		Kinds: map[string]int{"todo": 1}, Collectors: map[string]int{"todos": 1},
+	}, index.Workspaces[0])
+	assert.Equal(t, map[string]int{"todo": 1, "fixme": 1}, index.Workspaces[1].Kinds)
+}
+
+func TestWriteWorkspaceOutputs_IndexNameReserved(t *testing.T) {
+	workspaces := []workspaceEntry{{Name: "index", Rel: "index"}, {Name: "Index", Rel: "Index"}}
+	result := &signal.ScanResult{Signals: []signal.RawSignal{
+		{Workspace: "index", Kind: "todo", Title: "TODO: in the index workspace", FilePath: filepath.Join("index", "a.go")},
+	}}
+	out := t.TempDir()
+
+	cmd, _, _ := newTestCmd()
+	require.NoError(t, writeWorkspaceOutputs(cmd, workspaces, result, signal.ScanConfig{OutputFormat: "json"}, out))
+
+	data, err := os.ReadFile(filepath.Join(out, splitIndexFile)) //nolint:gosec // test-controlled path
+	require.NoError(t, err)
+	var index struct {
+		Workspaces []workspaceOutput `json:"workspaces"`
+	}
+	require.NoError(t, json.Unmarshal(data, &index), "the index is not overwritten")
+	require.Len(t, index.Workspaces, 2)
+	assert.Equal(t, "index-2.json", index.Workspaces[0].File)
+	assert.Equal(t, "Index-3.json", index.Workspaces[1].File, "names differing only in case do not collide")
+
+	data, err = os.ReadFile(filepath.Join(out, "index-2.json")) //nolint:gosec // test-controlled path
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "in the index workspace")
+}
+
+func TestRunScan_SplitByWorkspaceInvalid(t *testing.T) {
+	tests := []struct {
+		args []string
+		want string
+	}{
+		{[]string{"--split-by-workspace"}, "requires --output-dir"},
+		{[]string{"--output-dir", "out"}, "requires --split-by-workspace"},
+		{[]string{"--split-by-workspace", "--output-dir", "out", "-o", "x.jsonl"}, "cannot be combined"},
+	}
+	for _, tt := range tests {
+		t.Run(strings.Join(tt.args, " "), func(t *testing.T) {
+			resetScanFlags()
+			cmd, _, _ := newTestCmd()
+			cmd.SetArgs(append([]string{"scan", fixtureDir(t)}, tt.args...))
+			err := cmd.Execute()
+			requireExitCode(t, err, ExitInvalidArgs)
+			assert.Contains(t, err.Error(), tt.want)
+		})
+	}
+}
@@ -0,0 +1,342 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package analysis
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"github.com/davetashner/stringer/internal/llm"
+	"github.com/davetashner/stringer/internal/redact"
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+// Defaults for EnrichConfig.
+const (
+	DefaultEnrichBatchSize          = 20
+	DefaultEnrichDuplicateThreshold = 0.8
+)
+
+// enrichPromptVersion is part of every cache key; bump it when the prompt
+// changes so cached enrichments are redone.
+const enrichPromptVersion = 1
+
+// maxEnrichedTitle caps the length of a rewritten title.
+const maxEnrichedTitle = 120
+
+// EnrichConfig controls LLM enrichment.
+type EnrichConfig struct {
+	// Model names the provider and model in cache keys, so switching
+	// models enriches signals again.
+	Model string
+
+	// BatchSize is the number of signals sent per request.
+	// Default: DefaultEnrichBatchSize.
+	BatchSize int
+
+	// DuplicateThreshold is the Jaccard similarity of two rewritten titles
+	// of the same kind at or above which the signals are merged.
+	// Default: DefaultEnrichDuplicateThreshold.
+	DuplicateThreshold float64
+
+	// Cache keeps enrichments between runs. Nil disables caching.
+	Cache *EnrichCache
+}
+
+// Enrichment is the LLM's rewrite of one signal.
+type Enrichment struct {
+	Title    string   `json:"title"`
+	Criteria []string `json:"acceptance_criteria,omitempty"`
+}
+
+// EnrichStats counts what EnrichSignals did.
+type EnrichStats struct {
+	Enriched int // signals with a rewritten title
+	Cached   int // of those, served from the cache
+	Merged   int // near-duplicates merged into another signal
+}
+
+// enrichResponseItem is one signal in the LLM's response.
+type enrichResponseItem struct {
+	ID       string   `json:"id"`
+	Title    string   `json:"title"`
+	Criteria []string `json:"acceptance_criteria"`
+}
+
+// enrichResponseWrapper is the expected JSON shape of the response.
+type enrichResponseWrapper struct {
+	Signals []enrichResponseItem `json:"signals"`
+}
+
+// EnrichSignals rewrites terse signal titles into actionable issue titles,
+// drafts acceptance criteria into descriptions, and then merges signals of
+// the same kind whose rewritten titles are near-duplicates. The collector's
+// title is kept in OriginalTitle, so signal IDs do not change.
+//
+// Text is redacted before it is sent and again when it comes back, and
+// committed-secret signals are never sent. Signals already enriched,
+// pre-closed signals, and epics are left alone. On an LLM error the
+// signals enriched so far are kept and the error is returned.
+func EnrichSignals(ctx context.Context, signals []signal.RawSignal, provider llm.Provider, cfg EnrichConfig) ([]signal.RawSignal, EnrichStats, error) {
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = DefaultEnrichBatchSize
+	}
+	if cfg.DuplicateThreshold <= 0 {
+		cfg.DuplicateThreshold = DefaultEnrichDuplicateThreshold
+	}
+
+	var stats EnrichStats
+	var pending []int
+	for i, sig := range signals {
+		if !enrichable(sig) {
+			continue
+		}
+		if e, ok := cfg.Cache.get(enrichKey(cfg.Model, sig)); ok {
+			applyEnrichment(&signals[i], e)
+			stats.Enriched++
+			stats.Cached++
+			continue
+		}
+		pending = append(pending, i)
+	}
+
+	var err error
+	for batch := range slices.Chunk(pending, cfg.BatchSize) {
+		var results map[int]Enrichment
+		if results, err = enrichBatch(ctx, signals, batch, provider); err != nil {
+			break
+		}
+		for _, i := range batch {
+			e, ok := results[i]
+			if !ok {
+				continue
+			}
+			cfg.Cache.put(enrichKey(cfg.Model, signals[i]), e)
+			applyEnrichment(&signals[i], e)
+			stats.Enriched++
+		}
+	}
+
+	signals, stats.Merged = mergeNearDuplicates(signals, cfg.DuplicateThreshold)
+	return signals, stats, err
+}
+
+// enrichable reports whether sig may be sent for enrichment.
+func enrichable(sig signal.RawSignal) bool {
+	return sig.OriginalTitle == "" && len(sig.Children) == 0 &&
+		sig.Kind != "committed-secret" && !slices.Contains(sig.Tags, "pre-closed")
+}
+
+// enrichKey identifies the enrichment of a signal's content by a model.
+func enrichKey(model string, sig signal.RawSignal) string {
+	sum := sha256.Sum256(fmt.Appendf(nil, "%d\x00%s\x00%s\x00%s\x00%s\x00%s",
+		enrichPromptVersion, model, sig.Source, sig.Kind, sig.Title, sig.Description))
+	return fmt.Sprintf("%x", sum[:12])
+}
+
+// enrichBatch asks the LLM to enrich the signals at indexes batch and
+// returns the usable answers by index.
+func enrichBatch(ctx context.Context, signals []signal.RawSignal, batch []int, provider llm.Provider) (map[int]Enrichment, error) {
+	resp, err := provider.Complete(ctx, llm.Request{
+		SystemPrompt: "You are a software engineering assistant that turns code findings into actionable issues. Always respond with valid JSON only.",
+		Prompt:       buildEnrichPrompt(signals, batch),
+		MaxTokens:    4096,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("LLM enrichment failed: %w", err)
+	}
+	items, err := parseEnrichResponse(resp.Content)
+	if err != nil {
+		slog.Warn("failed to parse enrichment response, keeping original titles", "error", err)
+		return nil, nil
+	}
+
+	results := make(map[int]Enrichment, len(items))
+	for _, item := range items {
+		var n int
+		if _, err := fmt.Sscanf(item.ID, "sig-%d", &n); err != nil || n < 0 || n >= len(batch) {
+			slog.Debug("ignoring unknown signal ID from enrichment response", "id", item.ID)
+			continue
+		}
+		title := strings.Join(strings.Fields(redact.String(item.Title)), " ")
+		if title == "" {
+			continue
+		}
+		if len(title) > maxEnrichedTitle {
+			title = strings.TrimSpace(title[:maxEnrichedTitle]) + "..."
+		}
+		e := Enrichment{Title: title}
+		for _, c := range item.Criteria {
+			if c = strings.TrimSpace(redact.String(c)); c != "" {
+				e.Criteria = append(e.Criteria, c)
+			}
+		}
+		results[batch[n]] = e
+	}
+	return results, nil
+}
+
+// buildEnrichPrompt constructs the prompt for the signals at indexes batch.
+// Authors are left out, and titles and descriptions are redacted.
+func buildEnrichPrompt(signals []signal.RawSignal, batch []int) string {
+	var b strings.Builder
+
+	b.WriteString("Rewrite each finding below from a code repository as an issue a developer can pick up.\n\n")
+	b.WriteString("SIGNALS:\n")
+	b.WriteString("--------\n")
+
+	for n, i := range batch {
+		sig := signals[i]
+		fmt.Fprintf(&b, "ID: sig-%d\n", n)
+		fmt.Fprintf(&b, "  Title: %s\n", redact.String(sig.Title))
+		fmt.Fprintf(&b, "  Kind: %s\n", sig.Kind)
+		if sig.FilePath != "" {
+			fmt.Fprintf(&b, "  Path: %s\n", sig.FilePath)
+		}
+		if sig.Description != "" {
+			desc := redact.String(sig.Description)
+			if len(desc) > 400 {
+				desc = desc[:400] + "..."
+			}
+			fmt.Fprintf(&b, "  Description: %s\n", desc)
+		}
+		b.WriteString("\n")
+	}
+
+	b.WriteString("--------\n\n")
+	b.WriteString("Respond with ONLY a JSON object in the following format (no markdown, no explanation):\n")
+	b.WriteString(`{"signals": [{"id": "sig-0", "title": "Retry GitHub API calls on rate limit errors", "acceptance_criteria": ["Requests that get a 429 are retried with backoff", "A test covers the retry"]}]}`)
+	b.WriteString("\n\n")
+	b.WriteString("Rules:\n")
+	b.WriteString("- Titles start with a verb, name the affected component, and stay under 80 characters\n")
+	b.WriteString("- Give 1-3 acceptance criteria, each one verifiable sentence\n")
+	b.WriteString("- Do not invent details the finding does not support\n")
+	b.WriteString("- Findings that describe the same work should get the same title\n")
+
+	return b.String()
+}
+
+// parseEnrichResponse parses the LLM's JSON response into enrichment items.
+func parseEnrichResponse(content string) ([]enrichResponseItem, error) {
+	content = strings.TrimSpace(content)
+
+	// Strip markdown code fences if present.
+	if strings.HasPrefix(content, "```") {
+		lines := strings.Split(content, "\n")
+		var jsonLines []string
+		inBlock := false
+		for _, line := range lines {
+			trimmed := strings.TrimSpace(line)
+			if strings.HasPrefix(trimmed, "```") {
+				inBlock = !inBlock
+				continue
+			}
+			if inBlock {
+				jsonLines = append(jsonLines, line)
+			}
+		}
+		content = strings.Join(jsonLines, "\n")
+	}
+
+	content = strings.TrimSpace(content)
+
+	var wrapper enrichResponseWrapper
+	if err := json.Unmarshal([]byte(content), &wrapper); err == nil && len(wrapper.Signals) > 0 {
+		return wrapper.Signals, nil
+	}
+
+	var items []enrichResponseItem
+	if err := json.Unmarshal([]byte(content), &items); err == nil && len(items) > 0 {
+		return items, nil
+	}
+
+	return nil, fmt.Errorf("failed to parse LLM response as enrichment JSON: %.200s", content)
+}
+
+// applyEnrichment rewrites sig's title and appends the acceptance criteria
+// to its description.
+func applyEnrichment(sig *signal.RawSignal, e Enrichment) {
+	sig.OriginalTitle = sig.Title
+	sig.Title = e.Title
+	if len(e.Criteria) == 0 {
+		return
+	}
+	var b strings.Builder
+	b.WriteString(sig.Description)
+	if b.Len() > 0 {
+		b.WriteString("\n\n")
+	}
+	b.WriteString("Acceptance criteria:")
+	for _, c := range e.Criteria {
+		fmt.Fprintf(&b, "\n- [ ] %s", c)
+	}
+	sig.Description = b.String()
+}
+
+// mergeNearDuplicates folds enriched signals into an earlier enriched
+// signal of the same workspace, collector, and kind whose title is at
+// least threshold alike. The kept signal takes the highest confidence and
+// lists the other locations. It returns the remaining signals and how many
+// were merged.
+func mergeNearDuplicates(signals []signal.RawSignal, threshold float64) ([]signal.RawSignal, int) {
+	type group struct{ workspace, source, kind string }
+	firsts := make(map[group][]int)
+	mergedInto := make(map[int]int)
+	for i, sig := range signals {
+		if sig.OriginalTitle == "" {
+			continue
+		}
+		g := group{sig.Workspace, sig.Source, sig.Kind}
+		dup := -1
+		for _, j := range firsts[g] {
+			if jaccardSimilarity(signals[j].Title, sig.Title) >= threshold {
+				dup = j
+				break
+			}
+		}
+		if dup < 0 {
+			firsts[g] = append(firsts[g], i)
+			continue
+		}
+		mergedInto[i] = dup
+	}
+	if len(mergedInto) == 0 {
+		return signals, 0
+	}
+
+	also := make(map[int][]string)
+	for i, j := range mergedInto {
+		signals[j].Confidence = max(signals[j].Confidence, signals[i].Confidence)
+		also[j] = append(also[j], location(signals[i]))
+	}
+	kept := make([]signal.RawSignal, 0, len(signals)-len(mergedInto))
+	for i, sig := range signals {
+		if _, ok := mergedInto[i]; ok {
+			continue
+		}
+		if locs := also[i]; len(locs) > 0 {
+			slices.Sort(locs)
+			sig.Description += "\n\nAlso at:\n- " + strings.Join(locs, "\n- ")
+		}
+		kept = append(kept, sig)
+	}
+	return kept, len(mergedInto)
+}
+
+// location renders where a signal was found as "path:line", or its
+// original title when it has no file.
+func location(sig signal.RawSignal) string {
+	if sig.FilePath == "" {
+		return sig.OriginalTitle
+	}
+	if sig.Line > 0 {
+		return fmt.Sprintf("%s:%d", sig.FilePath, sig.Line)
+	}
+	return sig.FilePath
+}
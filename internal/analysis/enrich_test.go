@@ -0,0 +1,152 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package analysis
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davetashner/stringer/internal/llm"
+	"github.com/davetashner/stringer/internal/redact"
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+func TestEnrichSignals_RewritesTitles(t *testing.T) {
+	signals := testSignals()
+	signals[0].Description = "Found in handler."
+	mock := llm.NewMockProvider(llm.MockResponse{Content: `{"signals": [
+		{"id": "sig-0", "title": "Return a typed error from the auth handler", "acceptance_criteria": ["Callers can tell auth errors apart", " "]},
+		{"id": "sig-2", "title": "Split the database connection layer"},
+		{"id": "sig-9", "title": "Unknown"}
+	]}`})
+
+	got, stats, err := EnrichSignals(context.Background(), signals, mock, EnrichConfig{})
+	require.NoError(t, err)
+	require.Len(t, got, 3)
+	assert.Equal(t, EnrichStats{Enriched: 2}, stats)
+
+	assert.Equal(t, "Return a typed error from the auth handler", got[0].Title)
+	assert.Equal(t, "fix auth error", got[0].OriginalTitle)
+	assert.Equal(t, "Found in handler.\n\nAcceptance criteria:\n- [ ] Callers can tell auth errors apart", got[0].Description)
+	assert.Equal(t, "fix login bug", got[1].Title, "signals the LLM skipped keep their title")
+	assert.Empty(t, got[1].OriginalTitle)
+	assert.Equal(t, "Split the database connection layer", got[2].Title)
+	assert.Empty(t, got[2].Description)
+}
+
+func TestEnrichSignals_SkipsSecretsAndEnriched(t *testing.T) {
+	signals := []signal.RawSignal{
+		{Source: "secrets", Kind: "committed-secret", Title: "AWS key in config.go"},
+		{Source: "todos", Kind: "todo", Title: "Add retries", OriginalTitle: "retry"},
+		{Source: "todos", Kind: "todo", Title: "done", Tags: []string{"pre-closed"}},
+	}
+	mock := llm.NewMockProvider()
+
+	got, stats, err := EnrichSignals(context.Background(), signals, mock, EnrichConfig{})
+	require.NoError(t, err)
+	assert.Equal(t, signals, got)
+	assert.Zero(t, stats.Enriched)
+	assert.Empty(t, mock.Calls(), "nothing is sent when nothing is enrichable")
+}
+
+func TestEnrichSignals_Redacts(t *testing.T) {
+	redact.ResetForTest()
+	t.Cleanup(redact.ResetForTest)
+	redact.Register("ghp_TESTSECRETVALUE1234567890")
+
+	signals := []signal.RawSignal{
+		{Source: "todos", Kind: "todo", Title: "rotate ghp_TESTSECRETVALUE1234567890", Author: "alice@example.com"},
+	}
+	mock := llm.NewMockProvider(llm.MockResponse{Content: `{"signals": [
+		{"id": "sig-0", "title": "Rotate ghp_TESTSECRETVALUE1234567890", "acceptance_criteria": ["ghp_TESTSECRETVALUE1234567890 is revoked"]}
+	]}`})
+
+	got, _, err := EnrichSignals(context.Background(), signals, mock, EnrichConfig{})
+	require.NoError(t, err)
+
+	prompt := mock.Calls()[0].Prompt
+	assert.NotContains(t, prompt, "ghp_TESTSECRETVALUE1234567890")
+	assert.NotContains(t, prompt, "alice")
+	assert.Equal(t, "Rotate [REDACTED]", got[0].Title)
+	assert.Contains(t, got[0].Description, "[REDACTED] is revoked")
+}
+
+func TestEnrichSignals_Cache(t *testing.T) {
+	root := t.TempDir()
+	response := llm.MockResponse{Content: `{"signals": [{"id": "sig-0", "title": "Add retries to the fetcher"}]}`}
+	sig := signal.RawSignal{Source: "todos", Kind: "todo", Title: "retry", FilePath: "fetch.go"}
+
+	cache := LoadEnrichCache(root)
+	_, stats, err := EnrichSignals(context.Background(), []signal.RawSignal{sig}, llm.NewMockProvider(response), EnrichConfig{Model: "m1", Cache: cache})
+	require.NoError(t, err)
+	assert.Equal(t, EnrichStats{Enriched: 1}, stats)
+	require.NoError(t, cache.Save())
+
+	mock := llm.NewMockProvider()
+	got, stats, err := EnrichSignals(context.Background(), []signal.RawSignal{sig}, mock, EnrichConfig{Model: "m1", Cache: LoadEnrichCache(root)})
+	require.NoError(t, err)
+	assert.Equal(t, EnrichStats{Enriched: 1, Cached: 1}, stats)
+	assert.Equal(t, "Add retries to the fetcher", got[0].Title)
+	assert.Empty(t, mock.Calls())
+
+	mock = llm.NewMockProvider(response)
+	_, stats, err = EnrichSignals(context.Background(), []signal.RawSignal{sig}, mock, EnrichConfig{Model: "m2", Cache: LoadEnrichCache(root)})
+	require.NoError(t, err)
+	assert.Zero(t, stats.Cached, "another model does not reuse the cache")
+	assert.Len(t, mock.Calls(), 1)
+}
+
+func TestEnrichSignals_MergesNearDuplicates(t *testing.T) {
+	signals := []signal.RawSignal{
+		{Source: "todos", Kind: "todo", Title: "retry", FilePath: "a.go", Line: 3, Confidence: 0.5},
+		{Source: "todos", Kind: "todo", Title: "add retry", FilePath: "b.go", Line: 9, Confidence: 0.7},
+		{Source: "todos", Kind: "fixme", Title: "retries", FilePath: "c.go", Line: 1, Confidence: 0.5},
+	}
+	mock := llm.NewMockProvider(llm.MockResponse{Content: `{"signals": [
+		{"id": "sig-0", "title": "Retry failed uploads with backoff"},
+		{"id": "sig-1", "title": "Retry failed uploads with backoff"},
+		{"id": "sig-2", "title": "Retry failed uploads with backoff"}
+	]}`})
+
+	got, stats, err := EnrichSignals(context.Background(), signals, mock, EnrichConfig{})
+	require.NoError(t, err)
+	assert.Equal(t, 1, stats.Merged)
+	require.Len(t, got, 2, "only signals of the same kind are merged")
+	assert.Equal(t, "a.go", got[0].FilePath)
+	assert.InDelta(t, 0.7, got[0].Confidence, 0.001)
+	assert.Contains(t, got[0].Description, "Also at:\n- b.go:9")
+	assert.Equal(t, "fixme", got[1].Kind)
+}
+
+func TestEnrichSignals_ErrorKeepsProgress(t *testing.T) {
+	signals := []signal.RawSignal{
+		{Source: "todos", Kind: "todo", Title: "one"},
+		{Source: "todos", Kind: "todo", Title: "two"},
+	}
+	mock := llm.NewMockProvider(
+		llm.MockResponse{Content: `{"signals": [{"id": "sig-0", "title": "Do the first thing"}]}`},
+		llm.MockResponse{Err: errors.New("rate limited")},
+	)
+
+	got, stats, err := EnrichSignals(context.Background(), signals, mock, EnrichConfig{BatchSize: 1})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "rate limited")
+	assert.Equal(t, 1, stats.Enriched)
+	assert.Equal(t, "Do the first thing", got[0].Title)
+	assert.Equal(t, "two", got[1].Title)
+}
+
+func TestEnrichSignals_UnparseableResponse(t *testing.T) {
+	signals := testSignals()
+	mock := llm.NewMockProvider(llm.MockResponse{Content: "Sure! Here are better titles."})
+
+	got, stats, err := EnrichSignals(context.Background(), signals, mock, EnrichConfig{})
+	require.NoError(t, err)
+	assert.Zero(t, stats.Enriched)
+	assert.Equal(t, testSignals(), got)
+}
@@ -0,0 +1,138 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package analysis
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"path/filepath"
+	"time"
+
+	"github.com/davetashner/stringer/internal/statedir"
+	"github.com/davetashner/stringer/internal/testable"
+)
+
+const (
+	// EnrichCacheFile holds LLM enrichments inside the .stringer directory.
+	EnrichCacheFile = "llm-cache.json"
+
+	// enrichCacheMaxAge is when an enrichment no scan has used is dropped,
+	// so signals fixed long ago do not pile up.
+	enrichCacheMaxAge = 90 * 24 * time.Hour
+
+	// enrichCacheVersion is bumped whenever the on-disk layout changes.
+	enrichCacheVersion = 1
+)
+
+// FS is the file system implementation used for the enrichment cache.
+// Override in tests with a testable.MockFileSystem.
+var FS testable.FileSystem = testable.DefaultFS
+
+// enrichCacheEntry is one cached enrichment.
+type enrichCacheEntry struct {
+	UsedAt time.Time `json:"used_at"`
+	Enrichment
+}
+
+// enrichCacheData is the on-disk layout, keyed by enrichKey.
+type enrichCacheData struct {
+	Version int                         `json:"version"`
+	Entries map[string]enrichCacheEntry `json:"entries"`
+}
+
+// EnrichCache keeps LLM enrichments in .stringer/llm-cache.json, keyed by
+// the model and the signal's content, so a signal is sent to the LLM once.
+// A nil *EnrichCache caches nothing.
+type EnrichCache struct {
+	root  string
+	now   func() time.Time
+	data  enrichCacheData
+	dirty bool
+}
+
+// enrichCachePath returns the enrichment cache file for the directory root.
+func enrichCachePath(root string) string {
+	return filepath.Join(root, statedir.Name, EnrichCacheFile)
+}
+
+// LoadEnrichCache opens the enrichment cache of root. A missing or
+// unreadable cache starts empty.
+func LoadEnrichCache(root string) *EnrichCache {
+	c := &EnrichCache{
+		root: root,
+		now:  time.Now,
+		data: enrichCacheData{Version: enrichCacheVersion, Entries: make(map[string]enrichCacheEntry)},
+	}
+	raw, err := FS.ReadFile(enrichCachePath(root))
+	if err != nil {
+		if !errors.Is(err, fs.ErrNotExist) {
+			slog.Warn("ignoring unreadable LLM cache", "error", err)
+		}
+		return c
+	}
+	var loaded enrichCacheData
+	if err := json.Unmarshal(raw, &loaded); err != nil {
+		slog.Warn("ignoring unreadable LLM cache", "error", err)
+		return c
+	}
+	if loaded.Version == enrichCacheVersion && loaded.Entries != nil {
+		c.data.Entries = loaded.Entries
+	}
+	return c
+}
+
+// get returns the cached enrichment for key and marks it used.
+func (c *EnrichCache) get(key string) (Enrichment, bool) {
+	if c == nil {
+		return Enrichment{}, false
+	}
+	e, ok := c.data.Entries[key]
+	if !ok {
+		return Enrichment{}, false
+	}
+	e.UsedAt = c.now().UTC()
+	c.data.Entries[key] = e
+	c.dirty = true
+	return e.Enrichment, true
+}
+
+// put caches an enrichment.
+func (c *EnrichCache) put(key string, e Enrichment) {
+	if c == nil {
+		return
+	}
+	c.data.Entries[key] = enrichCacheEntry{UsedAt: c.now().UTC(), Enrichment: e}
+	c.dirty = true
+}
+
+// Save writes the cache back when it changed, dropping enrichments unused
+// for longer than enrichCacheMaxAge.
+func (c *EnrichCache) Save() error {
+	if c == nil || !c.dirty {
+		return nil
+	}
+	for key, e := range c.data.Entries {
+		if c.now().Sub(e.UsedAt) > enrichCacheMaxAge {
+			delete(c.data.Entries, key)
+		}
+	}
+	dir := filepath.Join(c.root, statedir.Name)
+	if err := FS.MkdirAll(dir, 0o750); err != nil {
+		return fmt.Errorf("create state directory: %w", err)
+	}
+	statedir.EnsureIgnore(FS, c.root)
+
+	data, err := json.Marshal(c.data)
+	if err != nil {
+		return fmt.Errorf("encode LLM cache: %w", err)
+	}
+	if err := FS.WriteFile(enrichCachePath(c.root), data, 0o644); err != nil {
+		return fmt.Errorf("write LLM cache: %w", err)
+	}
+	c.dirty = false
+	return nil
+}
@@ -0,0 +1,509 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package analysis
+
+import (
+	"fmt"
+	"path"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/davetashner/stringer/internal/output"
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+// EpicKind is the signal kind of an umbrella signal grouping related
+// signals. The beads formatter emits it as an epic.
+const EpicKind = "epic"
+
+// Defaults for EpicConfig.
+const (
+	DefaultTodoEpicMinSize    = 3
+	DefaultModuleEpicMinSize  = 5
+	DefaultModuleEpicDepth    = 2
+	DefaultEpicKindSimilarity = 0.3
+)
+
+// EpicConfig controls epic grouping.
+type EpicConfig struct {
+	// TodoMinSize is the minimum number of TODOs grouped into an epic by
+	// theme or by module and author. Default: DefaultTodoEpicMinSize.
+	TodoMinSize int
+
+	// ModuleMinSize is the minimum number of signals of similar kinds in one
+	// module grouped into an epic. Default: DefaultModuleEpicMinSize.
+	ModuleMinSize int
+
+	// ModuleDepth is how many leading directories of a signal's path name
+	// its module, so "internal/collectors/todos.go" is in
+	// "internal/collectors" at depth 2. Default: DefaultModuleEpicDepth.
+	ModuleDepth int
+
+	// KindSimilarity is the Jaccard similarity of the words in two kinds
+	// from the same collector above which they are grouped together, so
+	// "stale-dependency" and "vulnerable-dependency" share an epic.
+	// Default: DefaultEpicKindSimilarity.
+	KindSimilarity float64
+
+	// IDPrefix is the bead ID prefix used for the children lists.
+	// Default: "str-".
+	IDPrefix string
+}
+
+// epicStopWords are tokens too generic to name a theme: comment markers and
+// the verbs most TODOs start with.
+var epicStopWords = map[string]bool{
+	"todo": true, "fixme": true, "hack": true, "xxx": true, "bug": true, "optimize": true, "note": true,
+	"add": true, "fix": true, "handle": true, "implement": true, "remove": true, "use": true,
+	"make": true, "support": true, "need": true, "needs": true, "should": true, "could": true,
+	"would": true, "maybe": true, "later": true, "here": true, "there": true, "when": true,
+	"not": true, "we": true, "if": true, "all": true, "more": true, "some": true, "get": true,
+	"set": true, "check": true, "move": true, "update": true, "instead": true, "also": true,
+}
+
+// todoEpicMember pairs a TODO signal with the theme tokens of its title.
+type todoEpicMember struct {
+	sig    signal.RawSignal
+	themes map[string]bool
+}
+
+// BuildEpics groups related signals into suggested epics and returns one
+// umbrella signal per group. The input signals are not modified; callers
+// append the epics to the signal list so the children still appear on
+// their own.
+//
+// TODOs are grouped first: by a shared theme, a word or adjacent word pair
+// in their titles, taking the most common themes first, and then the TODOs
+// without a theme by directory and author. The signals left over, TODOs
+// included, are then grouped by module and kind: signals from one collector
+// in the same module whose kinds' words are at least cfg.KindSimilarity
+// alike share an epic, so 14 missing-tests signals in internal/collectors
+// become one epic with 14 children. Signals without a file, pre-closed
+// signals, and signals already in an epic are left out of the second
+// stage. Groups smaller than the minimum sizes are dropped, and signals in
+// different workspaces are never grouped together.
+//
+// Epics have no file, since they span several; their modules are tagged
+// and listed in the description.
+func BuildEpics(signals []signal.RawSignal, cfg EpicConfig) []signal.RawSignal {
+	if cfg.TodoMinSize <= 0 {
+		cfg.TodoMinSize = DefaultTodoEpicMinSize
+	}
+	if cfg.ModuleMinSize <= 0 {
+		cfg.ModuleMinSize = DefaultModuleEpicMinSize
+	}
+	if cfg.ModuleDepth <= 0 {
+		cfg.ModuleDepth = DefaultModuleEpicDepth
+	}
+	if cfg.KindSimilarity <= 0 {
+		cfg.KindSimilarity = DefaultEpicKindSimilarity
+	}
+	if cfg.IDPrefix == "" {
+		cfg.IDPrefix = "str-"
+	}
+
+	epics := buildTodoEpics(signals, cfg)
+	return append(epics, buildModuleEpics(signals, epics, cfg)...)
+}
+
+// isEpic reports whether sig is an umbrella signal, built here or by LLM
+// clustering.
+func isEpic(sig signal.RawSignal) bool {
+	return sig.Kind == EpicKind || len(sig.Children) > 0
+}
+
+// buildTodoEpics groups the TODOs of each workspace.
+func buildTodoEpics(signals []signal.RawSignal, cfg EpicConfig) []signal.RawSignal {
+	byWorkspace := make(map[string][]todoEpicMember)
+	var workspaces []string
+	for _, sig := range signals {
+		if sig.Source != "todos" || isEpic(sig) || slices.Contains(sig.Tags, "pre-closed") {
+			continue
+		}
+		if _, ok := byWorkspace[sig.Workspace]; !ok {
+			workspaces = append(workspaces, sig.Workspace)
+		}
+		byWorkspace[sig.Workspace] = append(byWorkspace[sig.Workspace], todoEpicMember{
+			sig:    sig,
+			themes: epicThemes(sig.Title),
+		})
+	}
+	sort.Strings(workspaces)
+
+	var epics []signal.RawSignal
+	for _, ws := range workspaces {
+		epics = append(epics, clusterTodoEpics(byWorkspace[ws], cfg)...)
+	}
+	return epics
+}
+
+// clusterTodoEpics clusters the TODOs of one workspace.
+func clusterTodoEpics(members []todoEpicMember, cfg EpicConfig) []signal.RawSignal {
+	assigned := make([]bool, len(members))
+	var epics []signal.RawSignal
+
+	// Themes, most common first; a word pair beats a single word on ties.
+	freq := make(map[string]int)
+	for _, m := range members {
+		for t := range m.themes {
+			freq[t]++
+		}
+	}
+	themes := make([]string, 0, len(freq))
+	for t, n := range freq {
+		if n >= cfg.TodoMinSize {
+			themes = append(themes, t)
+		}
+	}
+	sort.Slice(themes, func(i, j int) bool {
+		a, b := themes[i], themes[j]
+		if freq[a] != freq[b] {
+			return freq[a] > freq[b]
+		}
+		if wa, wb := strings.Count(a, " "), strings.Count(b, " "); wa != wb {
+			return wa > wb
+		}
+		return a < b
+	})
+
+	for _, theme := range themes {
+		var idx []int
+		for i, m := range members {
+			if !assigned[i] && m.themes[theme] {
+				idx = append(idx, i)
+			}
+		}
+		if len(idx) < cfg.TodoMinSize {
+			continue
+		}
+		group := make([]signal.RawSignal, len(idx))
+		for k, i := range idx {
+			assigned[i] = true
+			group[k] = members[i].sig
+		}
+		epics = append(epics, newTodoEpic(
+			fmt.Sprintf("Epic: TODOs mentioning %q", theme),
+			fmt.Sprintf("%d TODO comments mention %q.", len(group), theme),
+			"theme:"+strings.ReplaceAll(theme, " ", "-"),
+			group, cfg.IDPrefix))
+	}
+
+	// Remaining TODOs: group by module and author.
+	type moduleAuthor struct{ module, author string }
+	groups := make(map[moduleAuthor][]signal.RawSignal)
+	var keys []moduleAuthor
+	for i, m := range members {
+		if assigned[i] || m.sig.Author == "" {
+			continue
+		}
+		k := moduleAuthor{path.Dir(m.sig.FilePath), m.sig.Author}
+		if _, ok := groups[k]; !ok {
+			keys = append(keys, k)
+		}
+		groups[k] = append(groups[k], m.sig)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].module != keys[j].module {
+			return keys[i].module < keys[j].module
+		}
+		return keys[i].author < keys[j].author
+	})
+	for _, k := range keys {
+		group := groups[k]
+		if len(group) < cfg.TodoMinSize {
+			continue
+		}
+		where := k.module
+		if where == "." {
+			where = "the repository root"
+		}
+		epics = append(epics, newTodoEpic(
+			fmt.Sprintf("Epic: TODOs by %s in %s", k.author, where),
+			fmt.Sprintf("%d TODO comments by %s in %s.", len(group), k.author, where),
+			"module:"+k.module,
+			group, cfg.IDPrefix))
+	}
+	return epics
+}
+
+// newTodoEpic builds the umbrella signal for a group of TODOs. The title must
+// not include the group size so the epic keeps its ID as TODOs come and go.
+func newTodoEpic(title, summary, tag string, group []signal.RawSignal, idPrefix string) signal.RawSignal {
+	sortByLocation(group)
+	epic := signal.RawSignal{
+		Source:    "todos",
+		Kind:      EpicKind,
+		Title:     title,
+		Tags:      []string{"epic", tag},
+		Workspace: group[0].Workspace,
+	}
+
+	prefix := epicIDPrefix(idPrefix, epic.Workspace)
+	modules := make(map[string]int)
+	authors := make(map[string]int)
+	var b strings.Builder
+	b.WriteString(summary)
+	b.WriteString("\n")
+	for _, sig := range group {
+		epic.Confidence = max(epic.Confidence, sig.Confidence)
+		if !sig.Timestamp.IsZero() && (epic.Timestamp.IsZero() || sig.Timestamp.Before(epic.Timestamp)) {
+			epic.Timestamp = sig.Timestamp
+		}
+		modules[path.Dir(sig.FilePath)]++
+		if sig.Author != "" {
+			authors[sig.Author]++
+		}
+		epic.Children = append(epic.Children, output.SignalID(sig, prefix))
+		fmt.Fprintf(&b, "\n- %s:%d %s", sig.FilePath, sig.Line, sig.Title)
+	}
+	if len(authors) == 1 {
+		for a := range authors {
+			epic.Author = a
+		}
+	}
+	fmt.Fprintf(&b, "\n\nModules: %s", formatCounts(modules))
+	if len(authors) > 0 {
+		fmt.Fprintf(&b, "\nAuthors: %s", formatCounts(authors))
+	}
+	epic.Description = b.String()
+	return epic
+}
+
+// epicThemes returns the theme tokens of a TODO title: its significant words
+// and adjacent pairs of them.
+func epicThemes(title string) map[string]bool {
+	var words []string
+	for _, w := range normalizeTitle(title) {
+		if len(w) < 3 || epicStopWords[w] || strings.Trim(w, "0123456789") == "" {
+			continue
+		}
+		words = append(words, w)
+	}
+	themes := make(map[string]bool, 2*len(words))
+	for i, w := range words {
+		themes[w] = true
+		if i > 0 && words[i-1] != w {
+			themes[words[i-1]+" "+w] = true
+		}
+	}
+	return themes
+}
+
+// moduleEpicKey identifies a module epic: signals of one kind family from
+// one collector in one module of one workspace.
+type moduleEpicKey struct {
+	workspace, source, family, module string
+}
+
+// buildModuleEpics groups the signals not in one of epics, or in an epic
+// already among signals, by module and kind family.
+func buildModuleEpics(signals, epics []signal.RawSignal, cfg EpicConfig) []signal.RawSignal {
+	grouped := make(map[string]bool)
+	for _, sig := range slices.Concat(signals, epics) {
+		for _, id := range sig.Children {
+			grouped[id] = true
+		}
+	}
+
+	var members []signal.RawSignal
+	kinds := make(map[string][]string) // source -> kinds
+	for _, sig := range signals {
+		if sig.FilePath == "" || isEpic(sig) || slices.Contains(sig.Tags, "pre-closed") ||
+			grouped[output.SignalID(sig, epicIDPrefix(cfg.IDPrefix, sig.Workspace))] {
+			continue
+		}
+		members = append(members, sig)
+		if !slices.Contains(kinds[sig.Source], sig.Kind) {
+			kinds[sig.Source] = append(kinds[sig.Source], sig.Kind)
+		}
+	}
+	families := make(map[string]map[string]string) // source -> kind -> family
+	for source, ks := range kinds {
+		families[source] = kindFamilies(ks, cfg.KindSimilarity)
+	}
+
+	groups := make(map[moduleEpicKey][]signal.RawSignal)
+	var keys []moduleEpicKey
+	for _, sig := range members {
+		k := moduleEpicKey{
+			workspace: sig.Workspace,
+			source:    sig.Source,
+			family:    families[sig.Source][sig.Kind],
+			module:    moduleOf(sig.FilePath, cfg.ModuleDepth),
+		}
+		if _, ok := groups[k]; !ok {
+			keys = append(keys, k)
+		}
+		groups[k] = append(groups[k], sig)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		a, b := keys[i], keys[j]
+		if a.workspace != b.workspace {
+			return a.workspace < b.workspace
+		}
+		if a.module != b.module {
+			return a.module < b.module
+		}
+		if a.source != b.source {
+			return a.source < b.source
+		}
+		return a.family < b.family
+	})
+
+	var out []signal.RawSignal
+	for _, k := range keys {
+		if group := groups[k]; len(group) >= cfg.ModuleMinSize {
+			out = append(out, newModuleEpic(k, group, cfg.IDPrefix))
+		}
+	}
+	return out
+}
+
+// kindFamilies names the family of each kind. Kinds whose words are at
+// least threshold alike, directly or through other kinds, share a family,
+// named by the words they all have or, failing that, by the kinds.
+func kindFamilies(kinds []string, threshold float64) map[string]string {
+	sort.Strings(kinds)
+	parent := make([]int, len(kinds))
+	for i := range parent {
+		parent[i] = i
+	}
+	var find func(int) int
+	find = func(i int) int {
+		if parent[i] != i {
+			parent[i] = find(parent[i])
+		}
+		return parent[i]
+	}
+	for i := range kinds {
+		for j := i + 1; j < len(kinds); j++ {
+			if jaccardSimilarity(kinds[i], kinds[j]) >= threshold {
+				parent[find(j)] = find(i)
+			}
+		}
+	}
+
+	byRoot := make(map[int][]string)
+	for i, k := range kinds {
+		byRoot[find(i)] = append(byRoot[find(i)], k)
+	}
+	names := make(map[string]string, len(kinds))
+	for _, family := range byRoot {
+		name := family[0]
+		if len(family) > 1 {
+			name = strings.Join(family, ", ")
+			if common := commonWords(family); len(common) > 0 {
+				name = strings.Join(common, "-")
+			}
+		}
+		for _, k := range family {
+			names[k] = name
+		}
+	}
+	return names
+}
+
+// commonWords returns the words of the first kind that every kind has, in
+// order.
+func commonWords(kinds []string) []string {
+	var common []string
+	for _, w := range strings.Split(kinds[0], "-") {
+		if slices.ContainsFunc(kinds[1:], func(k string) bool { return !slices.Contains(strings.Split(k, "-"), w) }) {
+			continue
+		}
+		common = append(common, w)
+	}
+	return common
+}
+
+// moduleOf returns the first depth directories of a file's path, or "."
+// for files at the root.
+func moduleOf(file string, depth int) string {
+	dir := path.Dir(file)
+	if dir == "." || dir == "/" {
+		return "."
+	}
+	parts := strings.Split(strings.TrimPrefix(dir, "/"), "/")
+	if len(parts) > depth {
+		parts = parts[:depth]
+	}
+	return strings.Join(parts, "/")
+}
+
+// epicIDPrefix returns the bead ID prefix for a signal in workspace.
+func epicIDPrefix(idPrefix, workspace string) string {
+	if workspace != "" {
+		return idPrefix + workspace + "-"
+	}
+	return idPrefix
+}
+
+// newModuleEpic builds the umbrella signal for a module epic. The title
+// must not include the group size so the epic keeps its ID as signals come
+// and go.
+func newModuleEpic(k moduleEpicKey, group []signal.RawSignal, idPrefix string) signal.RawSignal {
+	sortByLocation(group)
+	where := k.module
+	if where == "." {
+		where = "the repository root"
+	}
+	epic := signal.RawSignal{
+		Source:    k.source,
+		Kind:      EpicKind,
+		Title:     fmt.Sprintf("Epic: %s signals in %s", k.family, where),
+		Tags:      []string{"epic", "module:" + k.module},
+		Workspace: k.workspace,
+	}
+
+	prefix := epicIDPrefix(idPrefix, epic.Workspace)
+	kinds := make(map[string]int)
+	var b strings.Builder
+	fmt.Fprintf(&b, "%d %s signals from %s in %s.\n", len(group), k.family, k.source, where)
+	for _, sig := range group {
+		epic.Confidence = max(epic.Confidence, sig.Confidence)
+		if sig.Priority != nil && (epic.Priority == nil || *sig.Priority < *epic.Priority) {
+			p := *sig.Priority
+			epic.Priority = &p
+		}
+		if !sig.Timestamp.IsZero() && (epic.Timestamp.IsZero() || sig.Timestamp.Before(epic.Timestamp)) {
+			epic.Timestamp = sig.Timestamp
+		}
+		kinds[sig.Kind]++
+		epic.Children = append(epic.Children, output.SignalID(sig, prefix))
+		fmt.Fprintf(&b, "\n- %s:%d %s", sig.FilePath, sig.Line, sig.Title)
+	}
+	fmt.Fprintf(&b, "\n\nKinds: %s", formatCounts(kinds))
+	epic.Description = b.String()
+	return epic
+}
+
+// sortByLocation orders an epic's members by file and line.
+func sortByLocation(group []signal.RawSignal) {
+	sort.SliceStable(group, func(i, j int) bool {
+		if group[i].FilePath != group[j].FilePath {
+			return group[i].FilePath < group[j].FilePath
+		}
+		return group[i].Line < group[j].Line
+	})
+}
+
+// formatCounts renders counts as "a (3), b (1)", most frequent first.
+func formatCounts(counts map[string]int) string {
+	keys := make([]string, 0, len(counts))
+	for k := range counts {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if counts[keys[i]] != counts[keys[j]] {
+			return counts[keys[i]] > counts[keys[j]]
+		}
+		return keys[i] < keys[j]
+	})
+	parts := make([]string, len(keys))
+	for i, k := range keys {
+		parts[i] = fmt.Sprintf("%s (%d)", k, counts[k])
+	}
+	return strings.Join(parts, ", ")
+}
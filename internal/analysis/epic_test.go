@@ -0,0 +1,269 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package analysis
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davetashner/stringer/internal/output"
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+func todoSig(path string, line int, title, author string) signal.RawSignal {
+	return signal.RawSignal{
+		Source:     "todos",
+		Kind:       "todo",
+		FilePath:   path,
+		Line:       line,
+		Title:      title,
+		Author:     author,
+		Confidence: 0.5,
+		Timestamp:  time.Date(2026, 1, line, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestBuildEpics_TodoSharedTheme(t *testing.T) {
+	signals := []signal.RawSignal{
+		todoSig("internal/collectors/github.go", 3, "TODO: add retry logic for rate limits", "alice"),
+		todoSig("internal/collectors/vuln.go", 7, "TODO: retry logic on OSV timeouts", "bob"),
+		todoSig("internal/collectors/dephealth.go", 2, "FIXME: retry logic is missing here", "alice"),
+		todoSig("cmd/stringer/scan.go", 9, "TODO: document flags", "carol"),
+		{Source: "gitlog", Kind: "churn", FilePath: "internal/collectors/github.go", Title: "retry logic churn"},
+	}
+	signals[1].Confidence = 0.8
+
+	epics := BuildEpics(signals, EpicConfig{})
+	require.Len(t, epics, 1)
+	epic := epics[0]
+
+	assert.Equal(t, EpicKind, epic.Kind)
+	assert.Equal(t, "todos", epic.Source)
+	assert.Equal(t, `Epic: TODOs mentioning "retry logic"`, epic.Title)
+	assert.Empty(t, epic.FilePath, "an epic spans files")
+	assert.Equal(t, []string{"epic", "theme:retry-logic"}, epic.Tags)
+	assert.InDelta(t, 0.8, epic.Confidence, 0.001)
+	assert.Equal(t, signals[2].Timestamp, epic.Timestamp)
+	assert.Empty(t, epic.Author, "mixed authors leave the epic unattributed")
+
+	// Children are sorted by location and use bead IDs.
+	assert.Equal(t, []string{
+		output.SignalID(signals[2], "str-"),
+		output.SignalID(signals[0], "str-"),
+		output.SignalID(signals[1], "str-"),
+	}, epic.Children)
+	assert.Contains(t, epic.Description, "3 TODO comments mention \"retry logic\".")
+	assert.Contains(t, epic.Description, "- internal/collectors/vuln.go:7 TODO: retry logic on OSV timeouts")
+	assert.Contains(t, epic.Description, "Authors: alice (2), bob (1)")
+}
+
+func TestBuildEpics_TodoModuleAndAuthor(t *testing.T) {
+	signals := []signal.RawSignal{
+		todoSig("internal/api/a.go", 1, "TODO: validate input", "dave"),
+		todoSig("internal/api/b.go", 2, "TODO: paginate results", "dave"),
+		todoSig("internal/api/c.go", 3, "TODO: cache tokens", "dave"),
+		todoSig("internal/api/d.go", 4, "TODO: log requests", "erin"),
+	}
+
+	epics := BuildEpics(signals, EpicConfig{})
+	require.Len(t, epics, 1)
+	assert.Equal(t, "Epic: TODOs by dave in internal/api", epics[0].Title)
+	assert.Equal(t, "dave", epics[0].Author)
+	assert.Len(t, epics[0].Children, 3)
+	assert.Contains(t, epics[0].Tags, "module:internal/api")
+}
+
+func TestBuildEpics_TodoMinSize(t *testing.T) {
+	signals := []signal.RawSignal{
+		todoSig("a.go", 1, "TODO: retry logic", "alice"),
+		todoSig("b.go", 2, "TODO: retry logic again", "bob"),
+	}
+	assert.Empty(t, BuildEpics(signals, EpicConfig{}))
+
+	epics := BuildEpics(signals, EpicConfig{TodoMinSize: 2})
+	require.Len(t, epics, 1)
+	assert.Len(t, epics[0].Children, 2)
+}
+
+func TestBuildEpics_TodoGreedyAssignment(t *testing.T) {
+	// "cache" is the most common theme; the "timeout" TODOs left over form
+	// their own epic, and no TODO belongs to two epics.
+	signals := []signal.RawSignal{
+		todoSig("a.go", 1, "TODO: cache invalidation", "x"),
+		todoSig("a.go", 2, "TODO: cache warmup", "x"),
+		todoSig("a.go", 3, "TODO: cache timeout", "x"),
+		todoSig("a.go", 4, "TODO: cache size", "x"),
+		todoSig("b.go", 5, "TODO: timeout for dial", "y"),
+		todoSig("b.go", 6, "TODO: timeout on read", "y"),
+		todoSig("b.go", 7, "TODO: timeout on write", "y"),
+	}
+	epics := BuildEpics(signals, EpicConfig{})
+	require.Len(t, epics, 2)
+	assert.Equal(t, `Epic: TODOs mentioning "cache"`, epics[0].Title)
+	assert.Len(t, epics[0].Children, 4)
+	assert.Equal(t, `Epic: TODOs mentioning "timeout"`, epics[1].Title)
+	assert.Len(t, epics[1].Children, 3)
+}
+
+func TestBuildEpics_TodoWorkspaces(t *testing.T) {
+	var signals []signal.RawSignal
+	for i, ws := range []string{"api", "api", "web", "web"} {
+		s := todoSig("pkg/x.go", i+1, "TODO: retry logic", "")
+		s.Workspace = ws
+		signals = append(signals, s)
+	}
+	signals = append(signals, todoSig("pkg/y.go", 9, "TODO: retry logic", ""))
+
+	epics := BuildEpics(signals, EpicConfig{TodoMinSize: 2})
+	require.Len(t, epics, 2)
+	assert.Equal(t, "api", epics[0].Workspace)
+	assert.Equal(t, output.SignalID(signals[0], "str-api-"), epics[0].Children[0])
+	assert.Equal(t, "web", epics[1].Workspace)
+}
+
+func TestBuildEpics_TodoSkipsClosedAndEpics(t *testing.T) {
+	closed := todoSig("a.go", 1, "TODO: retry logic", "x")
+	closed.Tags = []string{"pre-closed"}
+	signals := []signal.RawSignal{
+		closed,
+		todoSig("a.go", 2, "TODO: retry logic", "x"),
+		todoSig("a.go", 3, "TODO: retry logic", "x"),
+		{Source: "todos", Kind: EpicKind, Title: "Epic: TODOs mentioning \"retry logic\""},
+	}
+	assert.Empty(t, BuildEpics(signals, EpicConfig{}))
+}
+
+func TestEpicThemes(t *testing.T) {
+	themes := epicThemes("TODO: add retry logic to the client (v2)")
+	assert.True(t, themes["retry"])
+	assert.True(t, themes["retry logic"])
+	assert.True(t, themes["logic client"])
+	assert.False(t, themes["todo"])
+	assert.False(t, themes["add"])
+	assert.False(t, themes["v2"], "short tokens are dropped")
+}
+
+func kindSig(source, kind, path string, confidence float64) signal.RawSignal {
+	return signal.RawSignal{
+		Source:     source,
+		Kind:       kind,
+		FilePath:   path,
+		Title:      kind + " in " + path,
+		Confidence: confidence,
+	}
+}
+
+func TestBuildEpics_Module(t *testing.T) {
+	var signals []signal.RawSignal
+	for i := range 14 {
+		signals = append(signals, kindSig("patterns", "missing-tests", fmt.Sprintf("internal/collectors/c%02d.go", i), 0.5))
+	}
+	signals = append(signals,
+		kindSig("patterns", "missing-tests", "cmd/stringer/main.go", 0.5),
+		kindSig("patterns", "large-file", "internal/collectors/c00.go", 0.5))
+	p := 2
+	signals[3].Priority = &p
+	signals[5].Confidence = 0.7
+
+	epics := BuildEpics(signals, EpicConfig{})
+	require.Len(t, epics, 1)
+	epic := epics[0]
+
+	assert.Equal(t, EpicKind, epic.Kind)
+	assert.Equal(t, "patterns", epic.Source)
+	assert.Equal(t, "Epic: missing-tests signals in internal/collectors", epic.Title)
+	assert.Empty(t, epic.FilePath, "an epic spans files")
+	assert.Equal(t, []string{"epic", "module:internal/collectors"}, epic.Tags)
+	assert.InDelta(t, 0.7, epic.Confidence, 0.001)
+	require.NotNil(t, epic.Priority)
+	assert.Equal(t, 2, *epic.Priority, "the epic takes its most urgent child's priority")
+	require.Len(t, epic.Children, 14)
+	assert.Equal(t, output.SignalID(signals[0], "str-"), epic.Children[0])
+	assert.Contains(t, epic.Description, "14 missing-tests signals from patterns in internal/collectors.")
+	assert.Contains(t, epic.Description, "Kinds: missing-tests (14)")
+}
+
+func TestBuildEpics_KindFamilies(t *testing.T) {
+	signals := []signal.RawSignal{
+		kindSig("dephealth", "stale-dependency", "go.mod", 0.5),
+		kindSig("dephealth", "vulnerable-dependency", "go.mod", 0.9),
+		kindSig("dephealth", "archived-dependency", "go.mod", 0.5),
+		kindSig("dephealth", "local-replace", "go.mod", 0.5),
+		kindSig("vuln", "vulnerable-dependency", "go.mod", 0.9),
+	}
+
+	epics := BuildEpics(signals, EpicConfig{ModuleMinSize: 3})
+	require.Len(t, epics, 1)
+	assert.Equal(t, "Epic: dependency signals in the repository root", epics[0].Title)
+	assert.Len(t, epics[0].Children, 3, "only kinds from the same collector that are alike")
+	assert.Contains(t, epics[0].Description,
+		"Kinds: archived-dependency (1), stale-dependency (1), vulnerable-dependency (1)")
+
+	epics = BuildEpics(signals, EpicConfig{ModuleMinSize: 3, KindSimilarity: 0.9})
+	assert.Empty(t, epics, "a stricter similarity keeps the kinds apart")
+}
+
+func TestBuildEpics_ModuleDepth(t *testing.T) {
+	signals := []signal.RawSignal{
+		kindSig("complexity", "complex-function", "internal/a/x.go", 0.5),
+		kindSig("complexity", "complex-function", "internal/b/y.go", 0.5),
+		kindSig("complexity", "complex-function", "internal/b/c/z.go", 0.5),
+	}
+
+	assert.Empty(t, BuildEpics(signals, EpicConfig{ModuleMinSize: 3}))
+
+	epics := BuildEpics(signals, EpicConfig{ModuleMinSize: 3, ModuleDepth: 1})
+	require.Len(t, epics, 1)
+	assert.Equal(t, "Epic: complex-function signals in internal", epics[0].Title)
+}
+
+func TestBuildEpics_SkipsGroupedAndClosed(t *testing.T) {
+	var signals []signal.RawSignal
+	for i, word := range []string{"alpha", "bravo", "charlie", "delta", "echo"} {
+		signals = append(signals, todoSig(fmt.Sprintf("pkg/f%d.go", i), i+1, "TODO: "+word, ""))
+	}
+	closed := kindSig("todos", "todo", "pkg/g.go", 0.5)
+	closed.Tags = []string{"pre-closed"}
+	signals = append(signals, closed, kindSig("github", "github-issue", "", 0.5))
+
+	epics := BuildEpics(signals, EpicConfig{})
+	require.Len(t, epics, 1)
+	assert.Equal(t, "Epic: todo signals in pkg", epics[0].Title)
+	assert.Len(t, epics[0].Children, 5)
+
+	signals = append(signals, epics...)
+	assert.Empty(t, BuildEpics(signals, EpicConfig{}), "signals already in an epic are not grouped again")
+}
+
+func TestBuildEpics_TodoEpicsBeforeModules(t *testing.T) {
+	var signals []signal.RawSignal
+	for i := range 5 {
+		signals = append(signals, todoSig(fmt.Sprintf("pkg/f%d.go", i), i+1, "TODO: retry logic", "alice"))
+	}
+
+	epics := BuildEpics(signals, EpicConfig{})
+	require.Len(t, epics, 1, "TODOs grouped by theme are not grouped by module too")
+	assert.Equal(t, `Epic: TODOs mentioning "retry logic"`, epics[0].Title)
+}
+
+func TestBuildEpics_Workspaces(t *testing.T) {
+	var signals []signal.RawSignal
+	for i := range 3 {
+		for _, ws := range []string{"api", "web"} {
+			sig := kindSig("deadcode", "unused-function", fmt.Sprintf("src/f%d.go", i), 0.5)
+			sig.Workspace = ws
+			signals = append(signals, sig)
+		}
+	}
+
+	epics := BuildEpics(signals, EpicConfig{ModuleMinSize: 3})
+	require.Len(t, epics, 2)
+	assert.Equal(t, "api", epics[0].Workspace)
+	assert.Equal(t, output.SignalID(signals[0], "str-api-"), epics[0].Children[0])
+	assert.Equal(t, "web", epics[1].Workspace)
+}
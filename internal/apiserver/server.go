@@ -0,0 +1,431 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+// Package apiserver implements the HTTP JSON API behind stringer serve. It
+// runs scans of one repository on demand and serves the latest result, so
+// internal tools can query signals without shelling out to the CLI.
+package apiserver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"mime"
+	"net"
+	"net/http"
+	"net/url"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/davetashner/stringer/internal/output"
+	"github.com/davetashner/stringer/internal/promexport"
+	"github.com/davetashner/stringer/internal/redact"
+	"github.com/davetashner/stringer/internal/report"
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+// ScanFunc scans the served repository with the given collectors, or the
+// configured ones when collectors is empty.
+type ScanFunc func(ctx context.Context, collectors []string) (*signal.ScanResult, error)
+
+// Options configures a Server.
+type Options struct {
+	// Repository is the absolute path of the served repository, reported
+	// in responses.
+	Repository string
+	// Dashboard serves the HTML dashboard of the latest scan at "/".
+	Dashboard bool
+	// Hosts are the host names, besides localhost and the loopback
+	// addresses, that requests may be addressed to.
+	Hosts []string
+}
+
+// Scan is one completed scan.
+type Scan struct {
+	ID        int
+	StartedAt time.Time
+	Duration  time.Duration
+	Result    *signal.ScanResult
+}
+
+// errScanRunning is returned when a scan is requested while one runs.
+var errScanRunning = errors.New("a scan is already running")
+
+// Server serves scan results over HTTP.
+type Server struct {
+	scan ScanFunc
+	opts Options
+
+	scanMu sync.Mutex // held while a scan runs
+
+	mu     sync.RWMutex
+	latest *Scan
+
+	reportMu sync.Mutex // report sections keep state between Analyze and Render
+}
+
+// New returns a Server that runs scans with scan.
+func New(scan ScanFunc, opts Options) *Server {
+	return &Server{scan: scan, opts: opts}
+}
+
+// Handler returns the HTTP handler for the API:
+//
+//	POST /scan     run a scan (?collectors=a,b) and return its summary
+//	GET  /scan     summary of the latest scan
+//	GET  /signals  signals of the latest scan (?kind=, collector=, path=, owner=, min_confidence=, limit=)
+//	GET  /metrics  report JSON of the latest scan (?sections=a,b)
+//	GET  /metrics/prometheus  signal counts and collector timings in Prometheus text format
+//	GET  /         HTML dashboard of the latest scan, with Options.Dashboard
+//
+// GET endpoints run a first scan when none has completed yet. Requests must
+// be addressed to a loopback host or one of Options.Hosts, and POST requests
+// must declare a JSON body, which a browser cannot send to another origin
+// without a preflight the server never approves. Together they keep web
+// pages, including DNS rebinding ones, from driving the API.
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /scan", s.handleRunScan)
+	mux.HandleFunc("GET /scan", s.handleScan)
+	mux.HandleFunc("GET /signals", s.handleSignals)
+	mux.HandleFunc("GET /metrics", s.handleMetrics)
+	mux.HandleFunc("GET /metrics/prometheus", s.handlePrometheus)
+	if s.opts.Dashboard {
+		mux.HandleFunc("GET /{$}", s.handleDashboard)
+	}
+	return s.guard(mux)
+}
+
+// guard rejects requests addressed to unknown hosts, from other origins, or
+// posted without a JSON content type.
+func (s *Server) guard(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !s.allowedHost(r.Host) {
+			writeError(w, http.StatusForbidden, fmt.Errorf("host %q is not allowed", r.Host))
+			return
+		}
+		if origin := r.Header.Get("Origin"); origin != "" {
+			u, err := url.Parse(origin)
+			if err != nil || !s.allowedHost(u.Host) {
+				writeError(w, http.StatusForbidden, fmt.Errorf("origin %q is not allowed", origin))
+				return
+			}
+		}
+		if r.Method == http.MethodPost {
+			if mt, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type")); mt != "application/json" {
+				writeError(w, http.StatusUnsupportedMediaType, errors.New("POST requests must set Content-Type: application/json"))
+				return
+			}
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// allowedHost reports whether hostport, a Host header or URL host, names
+// localhost, a loopback address, or one of Options.Hosts.
+func (s *Server) allowedHost(hostport string) bool {
+	host := hostport
+	if h, _, err := net.SplitHostPort(hostport); err == nil {
+		host = h
+	}
+	host = strings.ToLower(strings.Trim(host, "[]"))
+	if host == "localhost" {
+		return true
+	}
+	if ip := net.ParseIP(host); ip != nil && ip.IsLoopback() {
+		return true
+	}
+	return slices.ContainsFunc(s.opts.Hosts, func(h string) bool { return strings.EqualFold(h, host) })
+}
+
+// Latest returns the most recent completed scan, or nil.
+func (s *Server) Latest() *Scan {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.latest
+}
+
+// Run scans the repository and makes the result the latest scan. It fails
+// with errScanRunning instead of waiting when wait is false and another
+// scan is in progress.
+func (s *Server) Run(ctx context.Context, collectors []string, wait bool) (*Scan, error) {
+	if wait {
+		s.scanMu.Lock()
+	} else if !s.scanMu.TryLock() {
+		return nil, errScanRunning
+	}
+	defer s.scanMu.Unlock()
+	return s.runLocked(ctx, collectors)
+}
+
+// runLocked runs a scan; the caller holds scanMu.
+func (s *Server) runLocked(ctx context.Context, collectors []string) (*Scan, error) {
+	start := time.Now()
+	result, err := s.scan(ctx, collectors)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	id := 1
+	if s.latest != nil {
+		id = s.latest.ID + 1
+	}
+	s.latest = &Scan{ID: id, StartedAt: start, Duration: time.Since(start), Result: result}
+	slog.Info("scan complete", "id", id, "signals", len(result.Signals), "duration", s.latest.Duration)
+	return s.latest, nil
+}
+
+// current returns the latest scan, running one first if there is none.
+func (s *Server) current(ctx context.Context) (*Scan, error) {
+	if sc := s.Latest(); sc != nil {
+		return sc, nil
+	}
+	s.scanMu.Lock()
+	defer s.scanMu.Unlock()
+	if sc := s.Latest(); sc != nil {
+		return sc, nil // another request's scan finished while we waited
+	}
+	return s.runLocked(ctx, nil)
+}
+
+// scanSummary is the JSON body of /scan responses.
+type scanSummary struct {
+	ID         int                `json:"id"`
+	Repository string             `json:"repository"`
+	StartedAt  time.Time          `json:"started_at"`
+	Duration   string             `json:"duration"`
+	Signals    int                `json:"signals"`
+	Collectors []collectorSummary `json:"collectors"`
+}
+
+type collectorSummary struct {
+	Name    string `json:"name"`
+	Signals int    `json:"signals"`
+	Error   string `json:"error,omitempty"`
+	Skipped string `json:"skipped,omitempty"`
+}
+
+func (s *Server) summarize(sc *Scan) scanSummary {
+	out := scanSummary{
+		ID:         sc.ID,
+		Repository: s.opts.Repository,
+		StartedAt:  sc.StartedAt.UTC(),
+		Duration:   sc.Duration.Round(time.Millisecond).String(),
+		Signals:    len(sc.Result.Signals),
+		Collectors: []collectorSummary{},
+	}
+	for _, cr := range sc.Result.Results {
+		cs := collectorSummary{Name: cr.Collector, Signals: len(cr.Signals), Skipped: cr.SkipReason}
+		if cr.Err != nil {
+			cs.Error = redact.String(cr.Err.Error())
+		}
+		out.Collectors = append(out.Collectors, cs)
+	}
+	return out
+}
+
+func (s *Server) handleRunScan(w http.ResponseWriter, r *http.Request) {
+	var collectors []string
+	if v := r.URL.Query().Get("collectors"); v != "" {
+		collectors = splitList(v)
+	}
+	sc, err := s.Run(r.Context(), collectors, false)
+	if errors.Is(err, errScanRunning) {
+		writeError(w, http.StatusConflict, err)
+		return
+	}
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, s.summarize(sc))
+}
+
+func (s *Server) handleScan(w http.ResponseWriter, r *http.Request) {
+	sc, err := s.current(r.Context())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, s.summarize(sc))
+}
+
+// signalsResponse is the JSON body of /signals responses.
+type signalsResponse struct {
+	ScanID  int                 `json:"scan_id"`
+	Total   int                 `json:"total"`
+	Signals []output.JSONSignal `json:"signals"`
+}
+
+func (s *Server) handleSignals(w http.ResponseWriter, r *http.Request) {
+	f, err := parseSignalFilter(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	sc, err := s.current(r.Context())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	matched := []signal.RawSignal{}
+	for _, sig := range sc.Result.Signals {
+		if f.matches(sig) {
+			matched = append(matched, sig)
+		}
+	}
+	out := signalsResponse{ScanID: sc.ID, Signals: output.NewJSONSignals(matched)}
+	out.Total = len(out.Signals)
+	if f.limit > 0 && len(out.Signals) > f.limit {
+		out.Signals = out.Signals[:f.limit]
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+func (s *Server) handleMetrics(w http.ResponseWriter, r *http.Request) {
+	var sections []string
+	if v := r.URL.Query().Get("sections"); v != "" {
+		sections = splitList(v)
+	}
+	sc, err := s.current(r.Context())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	names := make([]string, 0, len(sc.Result.Results))
+	for _, cr := range sc.Result.Results {
+		names = append(names, cr.Collector)
+	}
+	sort.Strings(names)
+
+	var buf strings.Builder
+	s.reportMu.Lock()
+	err = report.RenderJSON(sc.Result, s.opts.Repository, names, sections, &buf)
+	s.reportMu.Unlock()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write([]byte(buf.String()))
+}
+
+func (s *Server) handlePrometheus(w http.ResponseWriter, r *http.Request) {
+	sc, err := s.current(r.Context())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	var buf strings.Builder
+	if err := promexport.Write(&buf, sc.Result); err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	w.Header().Set("Content-Type", promexport.ContentType)
+	_, _ = w.Write([]byte(buf.String()))
+}
+
+func (s *Server) handleDashboard(w http.ResponseWriter, r *http.Request) {
+	sc, err := s.current(r.Context())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	var buf strings.Builder
+	if err := output.NewHTMLFormatter().Format(sc.Result.Signals, &buf); err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	_, _ = w.Write([]byte(buf.String()))
+}
+
+// signalFilter holds the /signals query parameters.
+type signalFilter struct {
+	kinds         []string
+	collectors    []string
+	owners        []string
+	paths         signal.Scope
+	minConfidence float64
+	limit         int
+}
+
+func parseSignalFilter(r *http.Request) (signalFilter, error) {
+	q := r.URL.Query()
+	f := signalFilter{
+		kinds:      splitList(q.Get("kind")),
+		collectors: splitList(q.Get("collector")),
+		owners:     splitList(q.Get("owner")),
+		paths:      signal.NewScope(splitList(q.Get("path")), 0),
+	}
+	if v := q.Get("min_confidence"); v != "" {
+		c, err := strconv.ParseFloat(v, 64)
+		if err != nil || c < 0 || c > 1 {
+			return f, fmt.Errorf("min_confidence must be between 0.0 and 1.0, got %q", v)
+		}
+		f.minConfidence = c
+	}
+	if v := q.Get("limit"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 0 {
+			return f, fmt.Errorf("limit must be a non-negative integer, got %q", v)
+		}
+		f.limit = n
+	}
+	return f, nil
+}
+
+func (f signalFilter) matches(sig signal.RawSignal) bool {
+	if sig.Confidence < f.minConfidence {
+		return false
+	}
+	if len(f.kinds) > 0 && !slices.Contains(f.kinds, sig.Kind) {
+		return false
+	}
+	if len(f.collectors) > 0 && !slices.Contains(f.collectors, sig.Source) {
+		return false
+	}
+	if len(f.owners) > 0 && !slices.ContainsFunc(sig.Owners, func(o string) bool { return slices.Contains(f.owners, o) }) {
+		return false
+	}
+	if !f.paths.IsZero() && (sig.FilePath == "" || !f.paths.Contains(sig.FilePath)) {
+		return false
+	}
+	return true
+}
+
+// splitList splits a comma-separated query value, dropping empty entries.
+func splitList(v string) []string {
+	var out []string
+	for _, s := range strings.Split(v, ",") {
+		if s = strings.TrimSpace(s); s != "" {
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(v); err != nil {
+		slog.Warn("failed to write response", "error", err)
+	}
+}
+
+// writeError writes {"error": ...}, with secrets redacted from the message.
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, map[string]string{"error": redact.String(err.Error())})
+}
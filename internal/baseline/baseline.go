@@ -17,11 +17,12 @@ import (
 	"path/filepath"
 	"time"
 
+	"github.com/davetashner/stringer/internal/statedir"
 	"github.com/davetashner/stringer/internal/testable"
 )
 
 // baselineDir is the directory name within a repo where baseline state is stored.
-const baselineDir = ".stringer"
+const baselineDir = statedir.Name
 
 // baselineFile is the filename for baseline suppressions.
 const baselineFile = "baseline.json"
@@ -98,6 +99,7 @@ func Save(repoPath string, state *BaselineState) error {
 	if err := FS.MkdirAll(dir, 0o750); err != nil {
 		return fmt.Errorf("create baseline directory: %w", err)
 	}
+	statedir.EnsureIgnore(FS, repoPath)
 
 	data, err := json.MarshalIndent(state, "", "  ")
 	if err != nil {
@@ -4,8 +4,6 @@
 package beads
 
 import (
-	"crypto/sha256"
-	"fmt"
 	"strings"
 
 	"github.com/davetashner/stringer/internal/signal"
@@ -19,47 +17,67 @@ func FilterAgainstExisting(signals []signal.RawSignal, existing []Bead) []signal
 		return signals
 	}
 
-	// Build lookup sets for each tier.
-	idSet := make(map[string]bool, len(existing))
-	hashSet := make(map[string]bool, len(existing))
-	titleSet := make(map[string]bool, len(existing))
-
-	for _, b := range existing {
-		idSet[b.ID] = true
-
-		// Extract hash portion from str-XXXXXXXX IDs.
-		if strings.HasPrefix(b.ID, "str-") {
-			hashSet[strings.TrimPrefix(b.ID, "str-")] = true
-		}
-
-		titleSet[normalizeTitle(b.Title)] = true
-	}
-
+	m := NewMatcher(existing)
 	var filtered []signal.RawSignal
 	for _, s := range signals {
-		sigID := signalToBeadID(s)
-		sigHash := signalHash(s)
-		sigTitle := normalizeTitle(s.Title)
-
-		// Tier 1: ID match.
-		if idSet[sigID] {
+		if _, ok := m.Match(s); ok {
 			continue
 		}
+		filtered = append(filtered, s)
+	}
 
-		// Tier 2: Hash match (signal hash matches hash portion of any str-* bead).
-		if hashSet[sigHash] {
-			continue
+	return filtered
+}
+
+// Matcher finds the existing bead a signal was filed as.
+type Matcher struct {
+	byID    map[string]int
+	byHash  map[string]int
+	byTitle map[string]int
+}
+
+// NewMatcher indexes existing beads for Match. When several beads share an
+// ID, hash, or title, the first one wins.
+func NewMatcher(existing []Bead) *Matcher {
+	m := &Matcher{
+		byID:    make(map[string]int, len(existing)),
+		byHash:  make(map[string]int, len(existing)),
+		byTitle: make(map[string]int, len(existing)),
+	}
+	index := func(set map[string]int, key string, i int) {
+		if _, ok := set[key]; !ok {
+			set[key] = i
 		}
+	}
+	for i, b := range existing {
+		index(m.byID, b.ID, i)
 
-		// Tier 3: Normalized title match.
-		if titleSet[sigTitle] {
-			continue
+		// Extract hash portion from str-XXXXXXXX IDs.
+		if strings.HasPrefix(b.ID, "str-") {
+			index(m.byHash, strings.TrimPrefix(b.ID, "str-"), i)
 		}
 
-		filtered = append(filtered, s)
+		index(m.byTitle, signal.NormalizeTitle(b.Title), i)
 	}
+	return m
+}
 
-	return filtered
+// Match returns the index of the bead s matches, trying the ID, then the
+// hash, then the normalized title.
+func (m *Matcher) Match(s signal.RawSignal) (int, bool) {
+	// Tier 1: ID match.
+	if i, ok := m.byID[signalToBeadID(s)]; ok {
+		return i, true
+	}
+
+	// Tier 2: Hash match (signal hash matches hash portion of any str-* bead).
+	if i, ok := m.byHash[signalHash(s)]; ok {
+		return i, true
+	}
+
+	// Tier 3: Normalized title match.
+	i, ok := m.byTitle[signal.NormalizeTitle(s.Title)]
+	return i, ok
 }
 
 // signalToBeadID produces the same ID that BeadsFormatter.generateID() would.
@@ -68,28 +86,6 @@ func signalToBeadID(s signal.RawSignal) string {
 }
 
 // signalHash computes the hash portion of a signal's bead ID.
-// Must match the algorithm in output/beads.go generateID().
 func signalHash(s signal.RawSignal) string {
-	h := sha256.New()
-	_, _ = fmt.Fprintf(h, "%s\x00%s\x00%s\x00%d\x00%s", s.Source, s.Kind, s.FilePath, s.Line, s.Title)
-	sum := h.Sum(nil)
-	return fmt.Sprintf("%x", sum[:4])
-}
-
-// normalizeTitle normalizes a title for comparison:
-//   - lowercase
-//   - strip common prefixes (TODO:, FIXME:, HACK:, XXX:, BUG:, OPTIMIZE:)
-//   - trim whitespace
-func normalizeTitle(title string) string {
-	t := strings.ToLower(strings.TrimSpace(title))
-
-	prefixes := []string{"todo:", "fixme:", "hack:", "xxx:", "bug:", "optimize:"}
-	for _, prefix := range prefixes {
-		if strings.HasPrefix(t, prefix) {
-			t = strings.TrimSpace(strings.TrimPrefix(t, prefix))
-			break
-		}
-	}
-
-	return t
+	return signal.Hash(s)
 }
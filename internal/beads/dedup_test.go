@@ -111,30 +111,27 @@ func TestFilterAgainstExisting_MixedMatchAndNoMatch(t *testing.T) {
 	assert.Equal(t, "New task", result[0].Title)
 }
 
-func TestNormalizeTitle(t *testing.T) {
-	tests := []struct {
-		input string
-		want  string
-	}{
-		{"  Fix This  ", "fix this"},
-		{"TODO: add feature", "add feature"},
-		{"FIXME: broken test", "broken test"},
-		{"HACK: workaround", "workaround"},
-		{"XXX: danger zone", "danger zone"},
-		{"BUG: null pointer", "null pointer"},
-		{"OPTIMIZE: slow query", "slow query"},
-		{"todo: Add Feature", "add feature"},
-		{"Regular title", "regular title"},
-		{"", ""},
-		{"TODO:", ""},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.input, func(t *testing.T) {
-			got := normalizeTitle(tt.input)
-			assert.Equal(t, tt.want, got)
-		})
-	}
+func TestMatcher_Match(t *testing.T) {
+	byID := makeSignal("todos", "todo", "main.go", 10, "Fix this")
+	byTitle := makeSignal("todos", "todo", "api.go", 3, "TODO: Add rate limiting")
+
+	m := NewMatcher([]Bead{
+		{ID: "other-1", Title: "Fix this"},
+		{ID: signalToBeadID(byID), Title: "Renamed"},
+		{ID: "other-2", Title: "add rate limiting"},
+		{ID: "other-3", Title: "Add rate limiting"},
+	})
+
+	i, ok := m.Match(byID)
+	require.True(t, ok)
+	assert.Equal(t, 1, i, "ID match wins over title match")
+
+	i, ok = m.Match(byTitle)
+	require.True(t, ok)
+	assert.Equal(t, 2, i, "first bead with the title wins")
+
+	_, ok = m.Match(makeSignal("todos", "todo", "main.go", 11, "Unrelated"))
+	assert.False(t, ok)
 }
 
 func TestSignalToBeadID(t *testing.T) {
@@ -31,8 +31,12 @@ const IssuesFile = "issues.jsonl"
 // LoadBeads reads and parses the .beads/issues.jsonl file from a repo path.
 // Returns nil, nil if the file does not exist.
 func LoadBeads(repoPath string) ([]Bead, error) {
-	path := filepath.Join(repoPath, BeadsDir, IssuesFile)
+	return LoadFile(filepath.Join(repoPath, BeadsDir, IssuesFile))
+}
 
+// LoadFile reads and parses a beads issues.jsonl file at path.
+// Returns nil, nil if the file does not exist.
+func LoadFile(path string) ([]Bead, error) {
 	f, err := os.Open(path) //nolint:gosec // path constructed from validated repo path
 	if os.IsNotExist(err) {
 		return nil, nil
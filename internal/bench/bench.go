@@ -0,0 +1,398 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+// Package bench stores go test -bench results per commit and compares
+// them. `stringer bench record` saves a run under .stringer/bench/ keyed by
+// commit; `stringer bench compare` and the bench collector compare a run
+// with one recorded at a baseline commit to find benchmarks that got
+// slower or allocate more.
+//
+// Benchmark timings depend on the machine, so runs are only compared when
+// they were recorded on the same OS, architecture, and CPU. Results are
+// local to the checkout and listed in .stringer/.gitignore.
+package bench
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"math"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/davetashner/stringer/internal/gitcli"
+	"github.com/davetashner/stringer/internal/statedir"
+	"github.com/davetashner/stringer/internal/testable"
+)
+
+const (
+	// DirName is the directory, inside .stringer, holding one file per
+	// recorded commit.
+	DirName = "bench"
+
+	// formatVersion is bumped whenever the on-disk layout changes. Runs
+	// with a different version are ignored.
+	formatVersion = 1
+
+	// DefaultThreshold is the relative slowdown reported as a regression.
+	DefaultThreshold = 0.10
+
+	// maxBaseSearch caps the ancestors searched for a recorded baseline.
+	maxBaseSearch = 200
+)
+
+// Units compared between runs, in the order they are reported.
+const (
+	UnitTime   = "ns/op"
+	UnitBytes  = "B/op"
+	UnitAllocs = "allocs/op"
+)
+
+// FS is the file system implementation used by this package.
+// Override in tests with a testable.MockFileSystem.
+var FS testable.FileSystem = testable.DefaultFS
+
+// Run is the benchmark results recorded at one commit.
+type Run struct {
+	Version    int         `json:"version"`
+	Commit     string      `json:"commit"`
+	RecordedAt time.Time   `json:"recorded_at"`
+	GOOS       string      `json:"goos,omitempty"`
+	GOARCH     string      `json:"goarch,omitempty"`
+	CPU        string      `json:"cpu,omitempty"`
+	Benchmarks []Benchmark `json:"benchmarks"`
+}
+
+// Benchmark holds every sample of one benchmark, one per -count
+// iteration, for each unit reported.
+type Benchmark struct {
+	Package string               `json:"package"`
+	Name    string               `json:"name"` // without the -GOMAXPROCS suffix
+	Procs   int                  `json:"procs,omitempty"`
+	Samples map[string][]float64 `json:"samples"` // unit → values
+}
+
+// Median returns the median sample for unit, and false when there is none.
+func (b Benchmark) Median(unit string) (float64, bool) {
+	values := append([]float64(nil), b.Samples[unit]...)
+	if len(values) == 0 {
+		return 0, false
+	}
+	sort.Float64s(values)
+	mid := len(values) / 2
+	if len(values)%2 == 0 {
+		return (values[mid-1] + values[mid]) / 2, true
+	}
+	return values[mid], true
+}
+
+// key identifies a benchmark across runs.
+func (b Benchmark) key() string { return b.Package + "\x00" + b.Name }
+
+// Parse reads go test -bench output, as text or as go test -json events,
+// and returns a run without a commit. Samples of the same benchmark are
+// merged. The run records the OS, architecture, and CPU printed by go test,
+// defaulting to this machine's OS and architecture.
+func Parse(r io.Reader) (*Run, error) {
+	run := &Run{Version: formatVersion, GOOS: runtime.GOOS, GOARCH: runtime.GOARCH}
+	byKey := make(map[string]int)
+
+	var lines []benchLine
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 0, 64*1024), 1<<20)
+	jsonOutput := map[string]*strings.Builder{}
+	var jsonOrder []string
+	pkg := ""
+	for sc.Scan() {
+		line := sc.Text()
+		if strings.HasPrefix(line, "{") {
+			var ev struct {
+				Action  string
+				Package string
+				Output  string
+			}
+			if err := json.Unmarshal([]byte(line), &ev); err == nil {
+				if ev.Action == "output" {
+					b, ok := jsonOutput[ev.Package]
+					if !ok {
+						b = &strings.Builder{}
+						jsonOutput[ev.Package] = b
+						jsonOrder = append(jsonOrder, ev.Package)
+					}
+					b.WriteString(ev.Output)
+				}
+				continue
+			}
+		}
+		lines = append(lines, benchLine{pkg, line})
+		if p, ok := strings.CutPrefix(line, "pkg: "); ok {
+			pkg = strings.TrimSpace(p)
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return nil, fmt.Errorf("read benchmark output: %w", err)
+	}
+	// go test -json splits a result line across output events, so each
+	// package's output is reassembled before it is parsed.
+	for _, p := range jsonOrder {
+		for _, line := range strings.Split(jsonOutput[p].String(), "\n") {
+			lines = append(lines, benchLine{p, line})
+		}
+	}
+
+	for _, l := range lines {
+		switch {
+		case strings.HasPrefix(l.text, "goos: "):
+			run.GOOS = strings.TrimSpace(strings.TrimPrefix(l.text, "goos: "))
+		case strings.HasPrefix(l.text, "goarch: "):
+			run.GOARCH = strings.TrimSpace(strings.TrimPrefix(l.text, "goarch: "))
+		case strings.HasPrefix(l.text, "cpu: "):
+			run.CPU = strings.TrimSpace(strings.TrimPrefix(l.text, "cpu: "))
+		}
+		b, ok := parseResult(l.pkg, l.text)
+		if !ok {
+			continue
+		}
+		if i, seen := byKey[b.key()]; seen {
+			for unit, values := range b.Samples {
+				run.Benchmarks[i].Samples[unit] = append(run.Benchmarks[i].Samples[unit], values...)
+			}
+			continue
+		}
+		byKey[b.key()] = len(run.Benchmarks)
+		run.Benchmarks = append(run.Benchmarks, b)
+	}
+	return run, nil
+}
+
+// benchLine is a line of output and the package it belongs to.
+type benchLine struct {
+	pkg  string
+	text string
+}
+
+// parseResult parses a result line such as
+//
+//	BenchmarkParse/small-8   120000   9876 ns/op   512 B/op   7 allocs/op
+func parseResult(pkg, line string) (Benchmark, bool) {
+	fields := strings.Fields(line)
+	if len(fields) < 4 || !strings.HasPrefix(fields[0], "Benchmark") {
+		return Benchmark{}, false
+	}
+	if _, err := strconv.ParseInt(fields[1], 10, 64); err != nil {
+		return Benchmark{}, false
+	}
+	b := Benchmark{Package: pkg, Name: fields[0], Samples: make(map[string][]float64)}
+	if i := strings.LastIndexByte(b.Name, '-'); i > 0 {
+		if procs, err := strconv.Atoi(b.Name[i+1:]); err == nil {
+			b.Name, b.Procs = b.Name[:i], procs
+		}
+	}
+	for i := 2; i+1 < len(fields); i += 2 {
+		v, err := strconv.ParseFloat(fields[i], 64)
+		if err != nil {
+			return Benchmark{}, false
+		}
+		b.Samples[fields[i+1]] = append(b.Samples[fields[i+1]], v)
+	}
+	if len(b.Samples[UnitTime]) == 0 {
+		return Benchmark{}, false
+	}
+	return b, true
+}
+
+// Path returns the file holding the run recorded at commit.
+func Path(repoPath, commit string) string {
+	return filepath.Join(repoPath, statedir.Name, DirName, commit+".json")
+}
+
+// Load reads the run recorded at commit. Returns nil and no error when
+// there is none or it was written by an incompatible version.
+func Load(repoPath, commit string) (*Run, error) {
+	data, err := FS.ReadFile(Path(repoPath, commit))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("read benchmark results: %w", err)
+	}
+	var run Run
+	if err := json.Unmarshal(data, &run); err != nil {
+		return nil, fmt.Errorf("parse benchmark results for %s: %w", commit, err)
+	}
+	if run.Version != formatVersion {
+		return nil, nil
+	}
+	return &run, nil
+}
+
+// Save writes run under repoPath's .stringer/bench directory, replacing
+// any run recorded at the same commit.
+func Save(repoPath string, run *Run) error {
+	if run.Commit == "" {
+		return errors.New("benchmark run has no commit")
+	}
+	dir := filepath.Join(repoPath, statedir.Name, DirName)
+	if err := FS.MkdirAll(dir, 0o750); err != nil {
+		return fmt.Errorf("create benchmark directory: %w", err)
+	}
+	statedir.EnsureIgnore(FS, repoPath)
+
+	run.Version = formatVersion
+	data, err := json.MarshalIndent(run, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := FS.WriteFile(Path(repoPath, run.Commit), data, 0o644); err != nil { //nolint:gosec // benchmark results are not secret
+		return fmt.Errorf("write benchmark results: %w", err)
+	}
+	return nil
+}
+
+// ResolveCommit returns the full hash of the commit ref names.
+func ResolveCommit(ctx context.Context, gitRoot, ref string) (string, error) {
+	out, err := gitcli.Exec(ctx, gitRoot, "rev-parse", "--verify", "--quiet", ref+"^{commit}")
+	if err != nil {
+		return "", fmt.Errorf("cannot resolve %q to a commit", ref)
+	}
+	return strings.TrimSpace(out), nil
+}
+
+// NearestRecorded returns the closest first-parent ancestor of commit with
+// a recorded run, or "" when none of the last 200 has one.
+func NearestRecorded(ctx context.Context, gitRoot, commit string) (string, error) {
+	out, err := gitcli.Exec(ctx, gitRoot, "rev-list", "--first-parent", fmt.Sprintf("--max-count=%d", maxBaseSearch+1), commit)
+	if err != nil {
+		return "", fmt.Errorf("listing ancestors of %s: %w", commit, err)
+	}
+	for i, c := range strings.Fields(out) {
+		if i == 0 {
+			continue // commit itself
+		}
+		if _, err := FS.Stat(Path(gitRoot, c)); err == nil {
+			return c, nil
+		}
+	}
+	return "", nil
+}
+
+// Comparable returns an error when base and head were recorded on
+// different kinds of machines, whose timings say nothing about each other.
+func Comparable(base, head *Run) error {
+	if base.GOOS != head.GOOS || base.GOARCH != head.GOARCH || base.CPU != head.CPU {
+		return fmt.Errorf("runs were recorded on different machines (%s) and (%s)", base.machine(), head.machine())
+	}
+	return nil
+}
+
+func (r *Run) machine() string {
+	m := r.GOOS + "/" + r.GOARCH
+	if r.CPU != "" {
+		m += ", " + r.CPU
+	}
+	return m
+}
+
+// Change is the difference in one unit of one benchmark between runs.
+type Change struct {
+	Package string
+	Name    string
+	Unit    string
+	Base    float64 // median at the baseline
+	Head    float64 // median at head
+	Samples int     // samples at head
+}
+
+// Delta returns the relative change from Base to Head: 0.25 is 25% more.
+// Growth from zero is infinite.
+func (c Change) Delta() float64 {
+	if c.Base == 0 {
+		if c.Head == 0 {
+			return 0
+		}
+		return math.Inf(1)
+	}
+	return (c.Head - c.Base) / c.Base
+}
+
+// String describes the change, e.g. "1.20ms → 1.50ms (+25.0%)".
+func (c Change) String() string {
+	delta := "new"
+	if d := c.Delta(); !math.IsInf(d, 0) {
+		delta = fmt.Sprintf("%+.1f%%", d*100)
+	}
+	return fmt.Sprintf("%s → %s (%s)", FormatValue(c.Base, c.Unit), FormatValue(c.Head, c.Unit), delta)
+}
+
+// Compare returns the change in every unit of every benchmark present in
+// both runs, sorted by package, name, and unit.
+func Compare(base, head *Run) []Change {
+	baseByKey := make(map[string]Benchmark, len(base.Benchmarks))
+	for _, b := range base.Benchmarks {
+		baseByKey[b.key()] = b
+	}
+	var changes []Change
+	for _, h := range head.Benchmarks {
+		b, ok := baseByKey[h.key()]
+		if !ok {
+			continue
+		}
+		for _, unit := range []string{UnitTime, UnitBytes, UnitAllocs} {
+			bv, ok1 := b.Median(unit)
+			hv, ok2 := h.Median(unit)
+			if ok1 && ok2 {
+				changes = append(changes, Change{Package: h.Package, Name: h.Name, Unit: unit,
+					Base: bv, Head: hv, Samples: len(h.Samples[unit])})
+			}
+		}
+	}
+	sort.SliceStable(changes, func(i, j int) bool {
+		if changes[i].Package != changes[j].Package {
+			return changes[i].Package < changes[j].Package
+		}
+		return changes[i].Name < changes[j].Name
+	})
+	return changes
+}
+
+// Regressions returns the changes that grew by more than threshold.
+// Memory counts are exact, but timings are noisy, so a slowdown also needs
+// more than one sample at head to count.
+func Regressions(changes []Change, threshold float64) []Change {
+	var out []Change
+	for _, c := range changes {
+		if c.Delta() <= threshold {
+			continue
+		}
+		if c.Unit == UnitTime && c.Samples < 2 {
+			continue
+		}
+		out = append(out, c)
+	}
+	return out
+}
+
+// FormatValue prints a value in unit with a readable scale.
+func FormatValue(v float64, unit string) string {
+	switch unit {
+	case UnitTime:
+		return time.Duration(math.Round(v)).String()
+	case UnitBytes:
+		switch {
+		case v >= 1<<20:
+			return fmt.Sprintf("%.1fMiB", v/(1<<20))
+		case v >= 1<<10:
+			return fmt.Sprintf("%.1fKiB", v/(1<<10))
+		}
+		return fmt.Sprintf("%gB", v)
+	}
+	return fmt.Sprintf("%g %s", v, unit)
+}
@@ -0,0 +1,142 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package bench
+
+import (
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const textOutput = `goos: linux
+goarch: amd64
+pkg: example.com/api/parse
+cpu: AMD EPYC 7763 64-Core Processor
+BenchmarkParse/small-8   	  120000	      9876 ns/op	     512 B/op	       7 allocs/op
+BenchmarkParse/small-8   	  120000	     10124 ns/op	     512 B/op	       7 allocs/op
+BenchmarkParse/small-8   	  120000	      9950 ns/op	     512 B/op	       7 allocs/op
+BenchmarkThroughput-8    	    5000	    250000 ns/op	 400.00 MB/s
+PASS
+ok  	example.com/api/parse	4.210s
+pkg: example.com/api/store
+BenchmarkGet     	 1000000	      1042 ns/op
+BenchmarkBroken-8	--- FAIL: BenchmarkBroken
+ok  	example.com/api/store	1.102s
+`
+
+func TestParse_Text(t *testing.T) {
+	run, err := Parse(strings.NewReader(textOutput))
+	require.NoError(t, err)
+	assert.Equal(t, "linux", run.GOOS)
+	assert.Equal(t, "amd64", run.GOARCH)
+	assert.Equal(t, "AMD EPYC 7763 64-Core Processor", run.CPU)
+	require.Len(t, run.Benchmarks, 3)
+
+	parse := run.Benchmarks[0]
+	assert.Equal(t, "example.com/api/parse", parse.Package)
+	assert.Equal(t, "BenchmarkParse/small", parse.Name)
+	assert.Equal(t, 8, parse.Procs)
+	assert.Equal(t, []float64{9876, 10124, 9950}, parse.Samples[UnitTime])
+	median, ok := parse.Median(UnitTime)
+	assert.True(t, ok)
+	assert.InDelta(t, 9950, median, 0.001)
+
+	assert.Equal(t, []float64{400}, run.Benchmarks[1].Samples["MB/s"])
+
+	get := run.Benchmarks[2]
+	assert.Equal(t, "example.com/api/store", get.Package)
+	assert.Equal(t, "BenchmarkGet", get.Name)
+	assert.Zero(t, get.Procs)
+	_, ok = get.Median(UnitAllocs)
+	assert.False(t, ok, "run without -benchmem")
+}
+
+func TestParse_JSON(t *testing.T) {
+	// go test -json splits a result across output events.
+	input := `{"Action":"start","Package":"example.com/api/parse"}
+{"Action":"output","Package":"example.com/api/parse","Output":"cpu: Apple M2\n"}
+{"Action":"output","Package":"example.com/api/parse","Output":"BenchmarkParse-8   \t"}
+{"Action":"output","Package":"example.com/api/parse","Output":"  120000\t      9876 ns/op\t     512 B/op\n"}
+{"Action":"output","Package":"example.com/api/parse","Output":"BenchmarkParse-8   \t  120000\t      9000 ns/op\t     512 B/op\n"}
+{"Action":"pass","Package":"example.com/api/parse"}
+`
+	run, err := Parse(strings.NewReader(input))
+	require.NoError(t, err)
+	assert.Equal(t, "Apple M2", run.CPU)
+	require.Len(t, run.Benchmarks, 1)
+	assert.Equal(t, "example.com/api/parse", run.Benchmarks[0].Package)
+	assert.Equal(t, []float64{9876, 9000}, run.Benchmarks[0].Samples[UnitTime])
+	median, _ := run.Benchmarks[0].Median(UnitTime)
+	assert.InDelta(t, 9438, median, 0.001)
+}
+
+func TestCompareAndRegressions(t *testing.T) {
+	base := &Run{Benchmarks: []Benchmark{
+		{Package: "p", Name: "BenchmarkA", Samples: map[string][]float64{UnitTime: {100, 100, 100}, UnitAllocs: {0}}},
+		{Package: "p", Name: "BenchmarkB", Samples: map[string][]float64{UnitTime: {100, 100}, UnitBytes: {64}}},
+		{Package: "p", Name: "BenchmarkGone", Samples: map[string][]float64{UnitTime: {1}}},
+	}}
+	head := &Run{Benchmarks: []Benchmark{
+		{Package: "p", Name: "BenchmarkB", Samples: map[string][]float64{UnitTime: {150}, UnitBytes: {128}}},
+		{Package: "p", Name: "BenchmarkA", Samples: map[string][]float64{UnitTime: {104, 108, 130}, UnitAllocs: {2}}},
+		{Package: "p", Name: "BenchmarkNew", Samples: map[string][]float64{UnitTime: {1}}},
+	}}
+
+	changes := Compare(base, head)
+	require.Len(t, changes, 4)
+	assert.Equal(t, Change{Package: "p", Name: "BenchmarkA", Unit: UnitTime, Base: 100, Head: 108, Samples: 3}, changes[0])
+	assert.True(t, math.IsInf(changes[1].Delta(), 1), "allocations from zero")
+
+	regressions := Regressions(changes, 0.05)
+	var got []string
+	for _, r := range regressions {
+		got = append(got, r.Name+" "+r.Unit+": "+r.String())
+	}
+	assert.Equal(t, []string{
+		"BenchmarkA ns/op: 100ns → 108ns (+8.0%)",
+		"BenchmarkA allocs/op: 0 allocs/op → 2 allocs/op (new)",
+		"BenchmarkB B/op: 64B → 128B (+100.0%)",
+	}, got, "a single timing sample is too noisy to report")
+}
+
+func TestComparable(t *testing.T) {
+	a := &Run{GOOS: "linux", GOARCH: "amd64", CPU: "EPYC"}
+	assert.NoError(t, Comparable(a, &Run{GOOS: "linux", GOARCH: "amd64", CPU: "EPYC"}))
+	err := Comparable(a, &Run{GOOS: "darwin", GOARCH: "arm64", CPU: "Apple M2"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "(linux/amd64, EPYC) and (darwin/arm64, Apple M2)")
+}
+
+func TestSaveLoad(t *testing.T) {
+	dir := t.TempDir()
+	run, err := Parse(strings.NewReader(textOutput))
+	require.NoError(t, err)
+	run.Commit = "4f2a9c1e"
+
+	require.NoError(t, Save(dir, run))
+	got, err := Load(dir, "4f2a9c1e")
+	require.NoError(t, err)
+	assert.Equal(t, run.Benchmarks, got.Benchmarks)
+
+	ignore, err := os.ReadFile(filepath.Join(dir, ".stringer", ".gitignore")) //nolint:gosec // test path
+	require.NoError(t, err)
+	assert.Contains(t, string(ignore), "\nbench/\n")
+
+	missing, err := Load(dir, "0000000")
+	assert.NoError(t, err)
+	assert.Nil(t, missing)
+
+	assert.Error(t, Save(dir, &Run{}), "a run needs a commit")
+}
+
+func TestFormatValue(t *testing.T) {
+	assert.Equal(t, "1.5ms", FormatValue(1.5e6, UnitTime))
+	assert.Equal(t, "2.0KiB", FormatValue(2048, UnitBytes))
+	assert.Equal(t, "3 allocs/op", FormatValue(3, UnitAllocs))
+}
@@ -0,0 +1,489 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+// Package blameindex persists a precomputed line-ownership index of a
+// repository at a specific commit. On very large repositories git blame
+// dominates scan time; with an index built by `stringer index build`, the
+// todos and lotteryrisk collectors look attribution up instead of blaming.
+//
+// The index is keyed by commit. When HEAD moves, only the files changed
+// since the indexed commit are re-blamed. Files with uncommitted edits are
+// never served from the index so that callers fall back to live blame.
+package blameindex
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/davetashner/stringer/internal/gitcli"
+	"github.com/davetashner/stringer/internal/statedir"
+	"github.com/davetashner/stringer/internal/testable"
+)
+
+const (
+	// FileName is the index file name inside the .stringer directory.
+	FileName = "blame-index.json.gz"
+
+	// stateDir is the directory holding stringer state files.
+	stateDir = statedir.Name
+
+	// formatVersion is bumped whenever the on-disk layout changes. Indexes
+	// with a different version are ignored.
+	formatVersion = 2
+
+	// DefaultWorkers is the default number of concurrent git blame processes.
+	DefaultWorkers = 8
+
+	// blameTimeout bounds a single file blame during index builds. Builds are
+	// an explicit offline step, so this is more generous than
+	// gitcli.DefaultTimeout.
+	blameTimeout = 2 * time.Minute
+)
+
+// FS is the file system implementation used by this package.
+// Override in tests with a testable.MockFileSystem.
+var FS testable.FileSystem = testable.DefaultFS
+
+// Author identifies a line author as reported by git blame.
+type Author struct {
+	Name  string `json:"name"`
+	Email string `json:"email,omitempty"`
+}
+
+// Span is a run of consecutive lines attributed to the same author and time.
+type Span struct {
+	Author int   `json:"a"` // index into Index.Authors
+	Time   int64 `json:"t"` // author time, Unix seconds
+	Lines  int   `json:"n"`
+}
+
+// Index maps each tracked text file to run-length encoded blame spans.
+type Index struct {
+	Version int               `json:"version"`
+	Commit  string            `json:"commit"`
+	BuiltAt time.Time         `json:"built_at"`
+	Authors []Author          `json:"authors"`
+	Files   map[string][]Span `json:"files"`
+
+	authorIDs map[Author]int
+	dirty     map[string]bool
+}
+
+// Stats summarizes the result of a Build or Update.
+type Stats struct {
+	Files   int // files blamed in this run
+	Removed int // files dropped from the index
+	Full    bool
+}
+
+// Path returns the index file path for the repository rooted at gitRoot.
+func Path(gitRoot string) string {
+	return filepath.Join(gitRoot, stateDir, FileName)
+}
+
+// Load reads the index for gitRoot. Returns nil and no error when no index
+// exists or it was written by an incompatible version.
+func Load(gitRoot string) (*Index, error) {
+	data, err := FS.ReadFile(Path(gitRoot))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("read blame index: %w", err)
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("decompress blame index: %w", err)
+	}
+	defer zr.Close() //nolint:errcheck // read-only gzip reader
+
+	var idx Index
+	if err := json.NewDecoder(zr).Decode(&idx); err != nil {
+		return nil, fmt.Errorf("parse blame index: %w", err)
+	}
+	if idx.Version != formatVersion {
+		return nil, nil
+	}
+	idx.reindexAuthors()
+	return &idx, nil
+}
+
+// Save writes idx to gitRoot's .stringer directory.
+func Save(gitRoot string, idx *Index) error {
+	dir := filepath.Join(gitRoot, stateDir)
+	if err := FS.MkdirAll(dir, 0o750); err != nil {
+		return fmt.Errorf("create state directory: %w", err)
+	}
+	statedir.EnsureIgnore(FS, gitRoot)
+
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if err := json.NewEncoder(zw).Encode(idx); err != nil {
+		return fmt.Errorf("encode blame index: %w", err)
+	}
+	if err := zw.Close(); err != nil {
+		return fmt.Errorf("compress blame index: %w", err)
+	}
+	if err := FS.WriteFile(Path(gitRoot), buf.Bytes(), 0o644); err != nil {
+		return fmt.Errorf("write blame index: %w", err)
+	}
+	return nil
+}
+
+// Build blames every tracked text file at HEAD and returns a fresh index.
+func Build(ctx context.Context, gitRoot string, workers int, progress func(string)) (*Index, Stats, error) {
+	head, err := revParseHead(ctx, gitRoot)
+	if err != nil {
+		return nil, Stats{}, err
+	}
+	files, err := textFiles(ctx, gitRoot)
+	if err != nil {
+		return nil, Stats{}, err
+	}
+
+	idx := &Index{
+		Version:   formatVersion,
+		Commit:    head,
+		Files:     make(map[string][]Span, len(files)),
+		authorIDs: make(map[Author]int),
+	}
+	n, err := idx.blame(ctx, gitRoot, head, files, workers, progress)
+	if err != nil {
+		return nil, Stats{}, err
+	}
+	idx.BuiltAt = time.Now().UTC()
+	return idx, Stats{Files: n, Full: true}, nil
+}
+
+// Update brings idx forward to HEAD by re-blaming only the files changed
+// between idx.Commit and HEAD. It returns an error when the indexed commit
+// is no longer reachable (e.g. after a force push); callers should rebuild.
+func Update(ctx context.Context, gitRoot string, idx *Index, workers int, progress func(string)) (Stats, error) {
+	head, err := revParseHead(ctx, gitRoot)
+	if err != nil {
+		return Stats{}, err
+	}
+	if head == idx.Commit {
+		return Stats{}, nil
+	}
+
+	out, err := gitcli.Exec(ctx, gitRoot, "diff", "--name-only", "--no-renames", "-z", idx.Commit, head)
+	if err != nil {
+		return Stats{}, fmt.Errorf("diff against indexed commit %s: %w", shortSHA(idx.Commit), err)
+	}
+	changed := splitNUL(out)
+
+	text, err := textFiles(ctx, gitRoot)
+	if err != nil {
+		return Stats{}, err
+	}
+	isText := make(map[string]bool, len(text))
+	for _, f := range text {
+		isText[f] = true
+	}
+
+	var stats Stats
+	var reblame []string
+	for _, f := range changed {
+		if isText[f] {
+			reblame = append(reblame, f)
+			continue
+		}
+		if _, ok := idx.Files[f]; ok {
+			delete(idx.Files, f)
+			stats.Removed++
+		}
+	}
+
+	if idx.authorIDs == nil {
+		idx.reindexAuthors()
+	}
+	n, err := idx.blame(ctx, gitRoot, head, reblame, workers, progress)
+	if err != nil {
+		return Stats{}, err
+	}
+	stats.Files = n
+	idx.Commit = head
+	idx.BuiltAt = time.Now().UTC()
+	return stats, nil
+}
+
+// blame runs git blame at rev for each file and stores the resulting spans.
+// Files that fail to blame are dropped from the index.
+func (idx *Index) blame(ctx context.Context, gitRoot, rev string, files []string, workers int, progress func(string)) (int, error) {
+	if workers <= 0 {
+		workers = DefaultWorkers
+	}
+	var mu sync.Mutex
+	var done int
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(workers)
+	for _, f := range files {
+		g.Go(func() error {
+			blameCtx, cancel := context.WithTimeout(gctx, blameTimeout)
+			lines, err := gitcli.BlameFileAt(blameCtx, gitRoot, rev, f)
+			cancel()
+			if gctx.Err() != nil {
+				return gctx.Err()
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				slog.Debug("blameindex: skipping file", "file", f, "error", err)
+				delete(idx.Files, f)
+				return nil
+			}
+			idx.Files[f] = idx.encode(lines)
+			done++
+			if progress != nil && done%500 == 0 {
+				progress(fmt.Sprintf("index: blamed %d/%d files", done, len(files)))
+			}
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return 0, err
+	}
+	return done, nil
+}
+
+// encode run-length encodes blame lines. Callers must hold the build lock.
+func (idx *Index) encode(lines []gitcli.BlameLine) []Span {
+	var spans []Span
+	for _, bl := range lines {
+		a := Author{Name: bl.AuthorName, Email: bl.AuthorEmail}
+		id, ok := idx.authorIDs[a]
+		if !ok {
+			id = len(idx.Authors)
+			idx.Authors = append(idx.Authors, a)
+			idx.authorIDs[a] = id
+		}
+		ts := bl.AuthorTime.Unix()
+		if n := len(spans); n > 0 && spans[n-1].Author == id && spans[n-1].Time == ts {
+			spans[n-1].Lines++
+			continue
+		}
+		spans = append(spans, Span{Author: id, Time: ts, Lines: 1})
+	}
+	return spans
+}
+
+// reindexAuthors rebuilds the author lookup table after decoding.
+func (idx *Index) reindexAuthors() {
+	idx.authorIDs = make(map[Author]int, len(idx.Authors))
+	for i, a := range idx.Authors {
+		idx.authorIDs[a] = i
+	}
+}
+
+// File returns the blame for every line of relPath (slash-separated,
+// relative to the git root). The second result is false when the file is
+// not indexed or has uncommitted changes. A nil Index has no entries.
+func (idx *Index) File(relPath string) ([]gitcli.BlameLine, bool) {
+	spans, ok := idx.lookup(relPath)
+	if !ok {
+		return nil, false
+	}
+	var lines []gitcli.BlameLine
+	for _, s := range spans {
+		bl := idx.line(s)
+		for i := 0; i < s.Lines; i++ {
+			lines = append(lines, bl)
+		}
+	}
+	return lines, true
+}
+
+// Line returns the blame for a single 1-based line of relPath.
+func (idx *Index) Line(relPath string, line int) (gitcli.BlameLine, bool) {
+	spans, ok := idx.lookup(relPath)
+	if !ok || line <= 0 {
+		return gitcli.BlameLine{}, false
+	}
+	for _, s := range spans {
+		if line <= s.Lines {
+			return idx.line(s), true
+		}
+		line -= s.Lines
+	}
+	return gitcli.BlameLine{}, false
+}
+
+func (idx *Index) lookup(relPath string) ([]Span, bool) {
+	if idx == nil {
+		return nil, false
+	}
+	relPath = filepath.ToSlash(relPath)
+	if idx.dirty[relPath] {
+		return nil, false
+	}
+	spans, ok := idx.Files[relPath]
+	return spans, ok
+}
+
+func (idx *Index) line(s Span) gitcli.BlameLine {
+	var author Author
+	if s.Author >= 0 && s.Author < len(idx.Authors) {
+		author = idx.Authors[s.Author]
+	}
+	return gitcli.BlameLine{AuthorName: author.Name, AuthorEmail: author.Email, AuthorTime: time.Unix(s.Time, 0)}
+}
+
+// openCache holds indexes already brought up to date, keyed by git root and
+// HEAD, so that several collectors in one scan share a single load. Cached
+// indexes are never mutated; Open hands out views.
+var (
+	openMu    sync.Mutex
+	openCache = make(map[string]*Index)
+)
+
+// Open returns the index for gitRoot ready for lookups, or nil when no index
+// has been built. A stale index is updated incrementally to HEAD and saved.
+// Files with uncommitted changes are excluded from lookups. Errors are
+// logged and reported as a nil index so callers fall back to live blame.
+// The returned index is safe for concurrent lookups.
+func Open(ctx context.Context, gitRoot string) *Index {
+	if _, err := FS.Stat(Path(gitRoot)); err != nil {
+		return nil
+	}
+	head, err := revParseHead(ctx, gitRoot)
+	if err != nil {
+		slog.Debug("blameindex: cannot resolve HEAD", "error", err)
+		return nil
+	}
+
+	idx, err := openAt(ctx, gitRoot, head)
+	if idx == nil || err != nil {
+		return nil
+	}
+
+	dirty, err := gitcli.Exec(ctx, gitRoot, "diff", "--name-only", "--no-renames", "-z", "HEAD")
+	if err != nil {
+		slog.Warn("blameindex: cannot list uncommitted changes", "error", err)
+		return nil
+	}
+	view := *idx
+	view.dirty = make(map[string]bool)
+	for _, f := range splitNUL(dirty) {
+		view.dirty[f] = true
+	}
+	return &view
+}
+
+// openAt loads the index for gitRoot and updates it to head, consulting and
+// populating the cache.
+func openAt(ctx context.Context, gitRoot, head string) (*Index, error) {
+	openMu.Lock()
+	defer openMu.Unlock()
+
+	key := gitRoot + "@" + head
+	if idx, ok := openCache[key]; ok {
+		return idx, nil
+	}
+
+	idx, err := Load(gitRoot)
+	if err != nil {
+		slog.Warn("blameindex: ignoring unreadable index", "path", Path(gitRoot), "error", err)
+		return nil, err
+	}
+	if idx == nil {
+		return nil, nil
+	}
+
+	stats, err := Update(ctx, gitRoot, idx, DefaultWorkers, nil)
+	if err != nil {
+		slog.Warn("blameindex: index is stale and cannot be updated, run 'stringer index build --full'", "error", err)
+		return nil, err
+	}
+	if stats.Files > 0 || stats.Removed > 0 {
+		slog.Info("blameindex: updated index to HEAD", "files", stats.Files, "removed", stats.Removed)
+		if err := Save(gitRoot, idx); err != nil {
+			slog.Warn("blameindex: cannot save updated index", "error", err)
+		}
+	}
+
+	openCache[key] = idx
+	return idx, nil
+}
+
+// resetCache clears the Open cache. Only for use in tests.
+func resetCache() {
+	openMu.Lock()
+	defer openMu.Unlock()
+	openCache = make(map[string]*Index)
+}
+
+// revParseHead returns the full SHA of HEAD.
+func revParseHead(ctx context.Context, gitRoot string) (string, error) {
+	out, err := gitcli.Exec(ctx, gitRoot, "rev-parse", "HEAD")
+	if err != nil {
+		return "", fmt.Errorf("resolve HEAD: %w", err)
+	}
+	return strings.TrimSpace(out), nil
+}
+
+// textFiles lists tracked files that git considers text. `git ls-files --eol`
+// reports "i/-text" for binary blobs and "i/none" for empty ones.
+func textFiles(ctx context.Context, gitRoot string) ([]string, error) {
+	out, err := gitcli.Exec(ctx, gitRoot, "ls-files", "--eol", "-z")
+	if err != nil {
+		return nil, fmt.Errorf("list tracked files: %w", err)
+	}
+	var files []string
+	for _, entry := range splitNUL(out) {
+		meta, path, ok := strings.Cut(entry, "\t")
+		if !ok {
+			continue
+		}
+		if strings.HasPrefix(meta, "i/-text") || strings.HasPrefix(meta, "i/none") {
+			continue
+		}
+		files = append(files, path)
+	}
+	return files, nil
+}
+
+// splitNUL splits NUL-terminated git output, dropping empty entries.
+func splitNUL(out string) []string {
+	var parts []string
+	sc := bufio.NewScanner(strings.NewReader(out))
+	sc.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	sc.Split(func(data []byte, atEOF bool) (int, []byte, error) {
+		if i := bytes.IndexByte(data, 0); i >= 0 {
+			return i + 1, data[:i], nil
+		}
+		if atEOF && len(data) > 0 {
+			return len(data), data, nil
+		}
+		return 0, nil, nil
+	})
+	for sc.Scan() {
+		if s := sc.Text(); s != "" {
+			parts = append(parts, s)
+		}
+	}
+	return parts
+}
+
+// shortSHA abbreviates a commit SHA for messages.
+func shortSHA(sha string) string {
+	if len(sha) > 12 {
+		return sha[:12]
+	}
+	return sha
+}
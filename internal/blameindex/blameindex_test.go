@@ -0,0 +1,201 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package blameindex
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func runGit(t *testing.T, dir string, args ...string) {
+	t.Helper()
+	cmd := exec.Command("git", args...) //nolint:gosec // test helper
+	cmd.Dir = dir
+	out, err := cmd.CombinedOutput()
+	require.NoError(t, err, "git %v: %s", args, out)
+}
+
+func writeFile(t *testing.T, dir, rel, content string) {
+	t.Helper()
+	path := filepath.Join(dir, rel)
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o750))
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+}
+
+func commitAs(t *testing.T, dir, author, msg string) {
+	t.Helper()
+	runGit(t, dir, "add", "-A")
+	runGit(t, dir, "-c", "user.name="+author, "-c", "user.email="+author+"@example.com", "commit", "-q", "-m", msg)
+}
+
+func initRepo(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	runGit(t, dir, "init", "-q")
+	writeFile(t, dir, "a.go", "one\ntwo\nthree\n")
+	writeFile(t, dir, "pkg/b.go", "b1\n")
+	writeFile(t, dir, "empty.txt", "")
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "bin.dat"), []byte{0, 1, 2, 0}, 0o600))
+	commitAs(t, dir, "Alice", "initial")
+	writeFile(t, dir, "a.go", "one\ntwo\nthree\nfour\n")
+	commitAs(t, dir, "Bob", "append")
+	return dir
+}
+
+func TestBuild(t *testing.T) {
+	dir := initRepo(t)
+
+	idx, stats, err := Build(context.Background(), dir, 2, nil)
+	require.NoError(t, err)
+	assert.True(t, stats.Full)
+	assert.Equal(t, 2, stats.Files)
+	assert.Len(t, idx.Commit, 40)
+	assert.Contains(t, idx.Files, "a.go")
+	assert.Contains(t, idx.Files, "pkg/b.go")
+	assert.NotContains(t, idx.Files, "bin.dat", "binary files are not indexed")
+	assert.NotContains(t, idx.Files, "empty.txt", "empty files are not indexed")
+
+	assert.Len(t, idx.Files["a.go"], 2, "consecutive lines by one commit collapse into a span")
+
+	lines, ok := idx.File("a.go")
+	require.True(t, ok)
+	require.Len(t, lines, 4)
+	assert.Equal(t, "Alice", lines[0].AuthorName)
+	assert.Equal(t, "Bob", lines[3].AuthorName)
+	assert.False(t, lines[0].AuthorTime.IsZero())
+
+	bl, ok := idx.Line("a.go", 4)
+	require.True(t, ok)
+	assert.Equal(t, "Bob", bl.AuthorName)
+	_, ok = idx.Line("a.go", 5)
+	assert.False(t, ok)
+	_, ok = idx.Line("a.go", 0)
+	assert.False(t, ok)
+	_, ok = idx.Line("missing.go", 1)
+	assert.False(t, ok)
+}
+
+func TestSaveLoadRoundTrip(t *testing.T) {
+	dir := initRepo(t)
+	idx, _, err := Build(context.Background(), dir, 2, nil)
+	require.NoError(t, err)
+	require.NoError(t, Save(dir, idx))
+
+	loaded, err := Load(dir)
+	require.NoError(t, err)
+	require.NotNil(t, loaded)
+	assert.Equal(t, idx.Commit, loaded.Commit)
+	assert.Equal(t, idx.Files, loaded.Files)
+	bl, ok := loaded.Line("pkg/b.go", 1)
+	require.True(t, ok)
+	assert.Equal(t, "Alice", bl.AuthorName)
+}
+
+func TestLoad_Missing(t *testing.T) {
+	idx, err := Load(t.TempDir())
+	require.NoError(t, err)
+	assert.Nil(t, idx)
+}
+
+func TestLoad_Corrupt(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, ".stringer/"+FileName, "not gzip")
+	_, err := Load(dir)
+	assert.Error(t, err)
+}
+
+func TestLoad_OtherVersionIgnored(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, Save(dir, &Index{Version: formatVersion + 1}))
+	idx, err := Load(dir)
+	require.NoError(t, err)
+	assert.Nil(t, idx)
+}
+
+func TestUpdate_Incremental(t *testing.T) {
+	dir := initRepo(t)
+	idx, _, err := Build(context.Background(), dir, 2, nil)
+	require.NoError(t, err)
+	oldCommit := idx.Commit
+
+	writeFile(t, dir, "pkg/b.go", "b1\nb2\n")
+	writeFile(t, dir, "c.go", "c1\n")
+	require.NoError(t, os.Remove(filepath.Join(dir, "a.go")))
+	commitAs(t, dir, "Carol", "change")
+
+	stats, err := Update(context.Background(), dir, idx, 2, nil)
+	require.NoError(t, err)
+	assert.False(t, stats.Full)
+	assert.Equal(t, 2, stats.Files, "only changed text files are re-blamed")
+	assert.Equal(t, 1, stats.Removed)
+	assert.NotEqual(t, oldCommit, idx.Commit)
+	assert.NotContains(t, idx.Files, "a.go")
+
+	bl, ok := idx.Line("pkg/b.go", 2)
+	require.True(t, ok)
+	assert.Equal(t, "Carol", bl.AuthorName)
+	bl, ok = idx.Line("c.go", 1)
+	require.True(t, ok)
+	assert.Equal(t, "Carol", bl.AuthorName)
+
+	stats, err = Update(context.Background(), dir, idx, 2, nil)
+	require.NoError(t, err)
+	assert.Equal(t, Stats{}, stats, "no-op when already at HEAD")
+}
+
+func TestUpdate_UnreachableCommit(t *testing.T) {
+	dir := initRepo(t)
+	idx := &Index{Version: formatVersion, Commit: "0123456789abcdef0123456789abcdef01234567", Files: map[string][]Span{}}
+	_, err := Update(context.Background(), dir, idx, 2, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "diff against indexed commit")
+}
+
+func TestOpen(t *testing.T) {
+	t.Cleanup(resetCache)
+	dir := initRepo(t)
+	assert.Nil(t, Open(context.Background(), dir), "no index built yet")
+
+	idx, _, err := Build(context.Background(), dir, 2, nil)
+	require.NoError(t, err)
+	require.NoError(t, Save(dir, idx))
+
+	// Commit a change and leave another file dirty.
+	writeFile(t, dir, "pkg/b.go", "b1\nnew\n")
+	commitAs(t, dir, "Dave", "update b")
+	writeFile(t, dir, "a.go", "dirty\n")
+
+	opened := Open(context.Background(), dir)
+	require.NotNil(t, opened)
+
+	bl, ok := opened.Line("pkg/b.go", 2)
+	require.True(t, ok, "index is brought up to date with HEAD")
+	assert.Equal(t, "Dave", bl.AuthorName)
+
+	_, ok = opened.Line("a.go", 1)
+	assert.False(t, ok, "files with uncommitted changes are not served")
+
+	saved, err := Load(dir)
+	require.NoError(t, err)
+	assert.Equal(t, opened.Commit, saved.Commit, "updated index is persisted")
+}
+
+func TestNilIndex(t *testing.T) {
+	var idx *Index
+	_, ok := idx.Line("a.go", 1)
+	assert.False(t, ok)
+	_, ok = idx.File("a.go")
+	assert.False(t, ok)
+}
+
+func TestSplitNUL(t *testing.T) {
+	assert.Equal(t, []string{"a", "b c"}, splitNUL("a\x00b c\x00"))
+	assert.Empty(t, splitNUL(""))
+}
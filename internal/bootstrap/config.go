@@ -68,8 +68,9 @@ func collectorEnabled(data configTemplateData, name string) bool {
 }
 
 var templateFuncs = template.FuncMap{
-	"bool":    boolFn,
-	"enabled": collectorEnabled,
+	"bool":      boolFn,
+	"enabled":   collectorEnabled,
+	"schemaURL": func() string { return config.SchemaID },
 }
 
 // configTemplate generates a commented .stringer.yaml with sensible defaults.
@@ -77,6 +78,9 @@ var templateFuncs = template.FuncMap{
 // based on auto-detection or wizard selections.
 var configTemplate = template.Must(template.New("config").Funcs(templateFuncs).Parse(`# Stringer configuration — generated by 'stringer init'
 # See: stringer docs --help for full documentation
+# Check it with 'stringer config validate'; editors with YAML language support
+# pick up the schema below.
+# yaml-language-server: $schema={{ schemaURL }}
 
 # Output format: beads (default), json, markdown, tasks
 #   beads  — JSONL for 'bd import' (machine-readable issue tracking)
@@ -0,0 +1,248 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package bundle
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/davetashner/stringer/internal/redact"
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+// emailPattern matches email addresses in free text and config values.
+var emailPattern = regexp.MustCompile(`[A-Za-z0-9._%+-]+@[A-Za-z0-9.-]+\.[A-Za-z]{2,}`)
+
+// minAuthorReplace is the shortest author name replaced in free text;
+// shorter names would match inside unrelated words.
+const minAuthorReplace = 3
+
+// identityKeys are metrics fields that always hold a person's name, login,
+// or email address.
+var identityKeys = map[string]bool{
+	"Author": true, "Login": true, "Email": true, "Owner": true, "TopOwner": true,
+}
+
+// peopleKeys are metrics fields listing people; a Name inside them is a
+// person's name.
+var peopleKeys = map[string]bool{
+	"Authors": true, "Reviewers": true, "Owners": true, "Contributors": true,
+}
+
+// anonymizer replaces author names with stable labels ("author-1", ...)
+// in order of first appearance, so the same person has the same label in
+// every file of a bundle.
+type anonymizer struct {
+	labels map[string]string
+}
+
+func newAnonymizer() *anonymizer {
+	return &anonymizer{labels: make(map[string]string)}
+}
+
+// author returns the label for name.
+func (a *anonymizer) author(name string) string {
+	if name == "" {
+		return ""
+	}
+	if label, ok := a.labels[name]; ok {
+		return label
+	}
+	label := fmt.Sprintf("author-%d", len(a.labels)+1)
+	a.labels[name] = label
+	return label
+}
+
+// text scrubs free text: environment secrets, email addresses, and every
+// author name seen so far.
+func (a *anonymizer) text(s string) string {
+	s = emailPattern.ReplaceAllString(redact.String(s), "[EMAIL]")
+	names := make([]string, 0, len(a.labels))
+	for name := range a.labels {
+		if len(name) >= minAuthorReplace {
+			names = append(names, name)
+		}
+	}
+	// Longest first, so "Ann Lee" is replaced before "Ann".
+	sort.Slice(names, func(i, j int) bool {
+		if len(names[i]) != len(names[j]) {
+			return len(names[i]) > len(names[j])
+		}
+		return names[i] < names[j]
+	})
+	for _, name := range names {
+		s = strings.ReplaceAll(s, name, a.labels[name])
+	}
+	return s
+}
+
+// signal anonymizes s.
+func (a *anonymizer) signal(s signal.RawSignal) Signal {
+	out := Signal{
+		Source:      s.Source,
+		Kind:        s.Kind,
+		FilePath:    s.FilePath,
+		Line:        s.Line,
+		Confidence:  s.Confidence,
+		Tags:        s.Tags,
+		Priority:    s.Priority,
+		Author:      a.author(s.Author),
+		Workspace:   s.Workspace,
+		TitleLength: len(s.Title),
+	}
+	if !s.Timestamp.IsZero() {
+		out.Timestamp = s.Timestamp.UTC().Format(time.RFC3339)
+	}
+	if !s.ClosedAt.IsZero() {
+		out.ClosedAt = s.ClosedAt.UTC().Format(time.RFC3339)
+	}
+	return out
+}
+
+// collectorRun summarizes r with its error text and metrics scrubbed.
+func (a *anonymizer) collectorRun(r signal.CollectorResult) (CollectorRun, error) {
+	run := CollectorRun{
+		Name:        r.Collector,
+		Duration:    r.Duration.Round(time.Millisecond).String(),
+		Signals:     len(r.Signals),
+		SkipReason:  r.SkipReason,
+		RetryReason: a.text(r.RetryReason),
+	}
+	if r.Err != nil {
+		run.Error = a.text(r.Err.Error())
+	}
+	if r.Metrics == nil {
+		return run, nil
+	}
+	data, err := json.Marshal(r.Metrics)
+	if err != nil {
+		return run, fmt.Errorf("marshal %s metrics: %w", r.Collector, err)
+	}
+	var tree any
+	if err := json.Unmarshal(data, &tree); err != nil {
+		return run, fmt.Errorf("decode %s metrics: %w", r.Collector, err)
+	}
+	tree = a.metrics(tree, false)
+	if data, err = json.Marshal(tree); err != nil {
+		return run, fmt.Errorf("marshal %s metrics: %w", r.Collector, err)
+	}
+	run.Metrics = json.RawMessage(a.text(string(data)))
+	return run, nil
+}
+
+// metrics walks decoded metrics JSON and replaces people's names with
+// labels. inPeople is true inside a list of people, where Name is a
+// person's name.
+func (a *anonymizer) metrics(v any, inPeople bool) any {
+	switch v := v.(type) {
+	case map[string]any:
+		// Sorted keys, so labels are assigned in the same order every run.
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			child := v[k]
+			switch {
+			case identityKeys[k] || (inPeople && k == "Name"):
+				v[k] = a.identity(child)
+			case peopleKeys[k]:
+				if names, ok := child.([]any); ok && allStrings(names) {
+					v[k] = a.identity(child)
+				} else {
+					v[k] = a.metrics(child, true)
+				}
+			default:
+				v[k] = a.metrics(child, false)
+			}
+		}
+	case []any:
+		for i, child := range v {
+			v[i] = a.metrics(child, inPeople)
+		}
+	}
+	return v
+}
+
+// identity replaces a name or a list of names with labels.
+func (a *anonymizer) identity(v any) any {
+	switch v := v.(type) {
+	case string:
+		return a.author(v)
+	case []any:
+		for i, child := range v {
+			if s, ok := child.(string); ok {
+				v[i] = a.author(s)
+			}
+		}
+	}
+	return v
+}
+
+func allStrings(vs []any) bool {
+	for _, v := range vs {
+		if _, ok := v.(string); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// config scrubs a config file. Identity names and aliases are replaced
+// with labels and the rest is scrubbed as text. A file that does not
+// parse is scrubbed as text only, since the parse failure may be the bug.
+func (a *anonymizer) config(data []byte) []byte {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return []byte(a.text(string(data)))
+	}
+	a.configNode(&doc)
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(&doc); err != nil {
+		return []byte(a.text(string(data)))
+	}
+	_ = enc.Close()
+	return []byte(a.text(buf.String()))
+}
+
+func (a *anonymizer) configNode(n *yaml.Node) {
+	if n.Kind == yaml.MappingNode {
+		for i := 0; i+1 < len(n.Content); i += 2 {
+			if n.Content[i].Value == "identities" && n.Content[i+1].Kind == yaml.MappingNode {
+				a.identitiesNode(n.Content[i+1])
+				continue
+			}
+			a.configNode(n.Content[i+1])
+		}
+		return
+	}
+	for _, child := range n.Content {
+		a.configNode(child)
+	}
+}
+
+// identitiesNode labels each canonical name and its aliases. Aliases get
+// the canonical name's label so the mapping stays visible.
+func (a *anonymizer) identitiesNode(n *yaml.Node) {
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		key, aliases := n.Content[i], n.Content[i+1]
+		label := a.author(key.Value)
+		key.Value = label
+		key.HeadComment, key.LineComment = "", ""
+		for j, alias := range aliases.Content {
+			alias.Value = fmt.Sprintf("%s-alias-%d", label, j+1)
+			alias.LineComment = ""
+		}
+	}
+}
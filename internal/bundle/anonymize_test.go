@@ -0,0 +1,65 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package bundle
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAnonymizer_Author(t *testing.T) {
+	a := newAnonymizer()
+	assert.Equal(t, "author-1", a.author("Ann"))
+	assert.Equal(t, "author-2", a.author("Ann Lee"))
+	assert.Equal(t, "author-1", a.author("Ann"), "labels are stable")
+	assert.Empty(t, a.author(""))
+
+	assert.Equal(t, "author-2 and author-1 <[EMAIL]>", a.text("Ann Lee and Ann <ann@example.org>"), "longest names first")
+}
+
+func TestAnonymizer_TextSkipsShortNames(t *testing.T) {
+	a := newAnonymizer()
+	a.author("al")
+	assert.Equal(t, "balance", a.text("balance"))
+}
+
+func TestAnonymizer_Metrics(t *testing.T) {
+	a := newAnonymizer()
+	tree := map[string]any{
+		"TopOwner":  "Dana",
+		"Reviewers": []any{map[string]any{"Login": "dana-gh", "Reviews": 3.0}},
+		"Authors":   []any{"Dana", "Eve"},
+		"Files":     []any{map[string]any{"Name": "main.go"}},
+	}
+	got := a.metrics(tree, false)
+	assert.Equal(t, map[string]any{
+		"TopOwner":  "author-1",
+		"Reviewers": []any{map[string]any{"Login": "author-3", "Reviews": 3.0}},
+		"Authors":   []any{"author-1", "author-2"},
+		"Files":     []any{map[string]any{"Name": "main.go"}},
+	}, got)
+}
+
+func TestAnonymizer_Config(t *testing.T) {
+	a := newAnonymizer()
+	got := string(a.config([]byte(`identities:
+  Jane Doe: # moved teams
+    - jane@old.example.com
+    - jdoe
+labels:
+  - tags: [security]
+    labels: [security]
+`)))
+	assert.Contains(t, got, "author-1:")
+	assert.Contains(t, got, "author-1-alias-1")
+	assert.Contains(t, got, "author-1-alias-2")
+	assert.Contains(t, got, "labels: [security]")
+	assert.NotContains(t, got, "Jane")
+	assert.NotContains(t, got, "jdoe")
+	assert.NotContains(t, got, "moved teams")
+
+	// Unparseable config is kept as scrubbed text.
+	assert.Equal(t, "max_issues: [oops <[EMAIL]>\n", string(a.config([]byte("max_issues: [oops <x@y.io>\n"))))
+}
@@ -0,0 +1,247 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+// Package bundle packages the inputs and results of a scan into a zip
+// archive users can attach to stringer bug reports. Everything written is
+// redacted: secrets from the environment, email addresses, and author
+// names are replaced, and signal titles and descriptions, which quote
+// source code, are left out.
+package bundle
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"time"
+
+	"github.com/davetashner/stringer/internal/redact"
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+// Archive entries, in the order they are written.
+const (
+	ManifestFile     = "manifest.json"
+	RepoConfigFile   = "config/repo.yaml"
+	GlobalConfigFile = "config/global.yaml"
+	SettingsFile     = "settings.json"
+	CollectorsFile   = "collectors.json"
+	SignalsFile      = "signals.json"
+)
+
+// zipEpoch is the modification time given to every entry so the same
+// inputs always produce the same bytes.
+var zipEpoch = time.Date(1980, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// Environment describes the machine and tools that ran the scan.
+type Environment struct {
+	StringerVersion string `json:"stringer_version"`
+	GoVersion       string `json:"go_version"`
+	OS              string `json:"os"`
+	Arch            string `json:"arch"`
+	NumCPU          int    `json:"num_cpu"`
+	GitVersion      string `json:"git_version,omitempty"`
+}
+
+// Input is everything a bundle records.
+type Input struct {
+	Env         Environment
+	GeneratedAt time.Time
+
+	// RepoConfig and GlobalConfig are the raw config files; nil when absent.
+	RepoConfig   []byte
+	GlobalConfig []byte
+
+	// Settings is the effective scan configuration after merging config
+	// files and flags.
+	Settings signal.ScanConfig
+
+	Result *signal.ScanResult
+}
+
+// Manifest is the bundle's table of contents.
+type Manifest struct {
+	GeneratedAt string      `json:"generated_at"`
+	Environment Environment `json:"environment"`
+	Files       []string    `json:"files"`
+}
+
+// Settings is the JSON form of the effective scan configuration. Repo
+// paths are omitted; per-collector options list only non-default fields.
+type Settings struct {
+	Collectors      []string                  `json:"collectors,omitempty"`
+	OutputFormat    string                    `json:"output_format,omitempty"`
+	NoLLM           bool                      `json:"no_llm,omitempty"`
+	MaxIssues       int                       `json:"max_issues,omitempty"`
+	ExcludePatterns []string                  `json:"exclude_patterns,omitempty"`
+	Scope           signal.Scope              `json:"scope"`
+	Identities      int                       `json:"identities,omitempty"` // count only
+	CollectorOpts   map[string]map[string]any `json:"collector_opts,omitempty"`
+}
+
+// CollectorRun is one collector's outcome in the scan.
+type CollectorRun struct {
+	Name        string          `json:"name"`
+	Duration    string          `json:"duration"`
+	Signals     int             `json:"signals"`
+	Error       string          `json:"error,omitempty"`
+	SkipReason  string          `json:"skip_reason,omitempty"`
+	RetryReason string          `json:"retry_reason,omitempty"`
+	Metrics     json.RawMessage `json:"metrics,omitempty"`
+}
+
+// Signal is an anonymized signal: it keeps what drives collector behavior
+// and drops text that may quote the repository's source.
+type Signal struct {
+	Source      string   `json:"source"`
+	Kind        string   `json:"kind"`
+	FilePath    string   `json:"file_path,omitempty"`
+	Line        int      `json:"line,omitempty"`
+	Confidence  float64  `json:"confidence"`
+	Tags        []string `json:"tags,omitempty"`
+	Priority    *int     `json:"priority,omitempty"`
+	Author      string   `json:"author,omitempty"`
+	Timestamp   string   `json:"timestamp,omitempty"`
+	ClosedAt    string   `json:"closed_at,omitempty"`
+	Workspace   string   `json:"workspace,omitempty"`
+	TitleLength int      `json:"title_length"`
+}
+
+// Write writes the bundle for in to w as a zip archive.
+func Write(w io.Writer, in Input) error {
+	anon := newAnonymizer()
+	signals := make([]Signal, 0)
+	runs := make([]CollectorRun, 0)
+	if in.Result != nil {
+		for _, s := range in.Result.Signals {
+			signals = append(signals, anon.signal(s))
+		}
+		for _, r := range in.Result.Results {
+			run, err := anon.collectorRun(r)
+			if err != nil {
+				return err
+			}
+			runs = append(runs, run)
+		}
+	}
+
+	type entry struct {
+		name string
+		data []byte
+	}
+	var entries []entry
+	if in.RepoConfig != nil {
+		entries = append(entries, entry{RepoConfigFile, anon.config(in.RepoConfig)})
+	}
+	if in.GlobalConfig != nil {
+		entries = append(entries, entry{GlobalConfigFile, anon.config(in.GlobalConfig)})
+	}
+	for _, e := range []struct {
+		name string
+		v    any
+	}{
+		{SettingsFile, settingsFor(in.Settings)},
+		{CollectorsFile, runs},
+		{SignalsFile, signals},
+	} {
+		data, err := marshal(e.v)
+		if err != nil {
+			return fmt.Errorf("marshal %s: %w", e.name, err)
+		}
+		entries = append(entries, entry{e.name, data})
+	}
+
+	manifest := Manifest{
+		GeneratedAt: in.GeneratedAt.UTC().Format(time.RFC3339),
+		Environment: in.Env,
+		Files:       []string{ManifestFile},
+	}
+	for _, e := range entries {
+		manifest.Files = append(manifest.Files, e.name)
+	}
+	data, err := marshal(manifest)
+	if err != nil {
+		return fmt.Errorf("marshal %s: %w", ManifestFile, err)
+	}
+	entries = append([]entry{{ManifestFile, data}}, entries...)
+
+	zw := zip.NewWriter(w)
+	for _, e := range entries {
+		f, err := zw.CreateHeader(&zip.FileHeader{Name: e.name, Method: zip.Deflate, Modified: zipEpoch})
+		if err != nil {
+			return fmt.Errorf("add %s: %w", e.name, err)
+		}
+		if _, err := f.Write(e.data); err != nil {
+			return fmt.Errorf("write %s: %w", e.name, err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return fmt.Errorf("close bundle: %w", err)
+	}
+	return nil
+}
+
+// settingsFor converts the effective scan configuration to Settings.
+func settingsFor(cfg signal.ScanConfig) Settings {
+	s := Settings{
+		Collectors:      cfg.Collectors,
+		OutputFormat:    cfg.OutputFormat,
+		NoLLM:           cfg.NoLLM,
+		MaxIssues:       cfg.MaxIssues,
+		ExcludePatterns: cfg.ExcludePatterns,
+		Scope:           cfg.Scope,
+		Identities:      len(cfg.Identities),
+	}
+	for name, opts := range cfg.CollectorOpts {
+		fields := nonZeroFields(opts)
+		if len(fields) == 0 {
+			continue
+		}
+		if s.CollectorOpts == nil {
+			s.CollectorOpts = make(map[string]map[string]any)
+		}
+		s.CollectorOpts[name] = fields
+	}
+	return s
+}
+
+// nonZeroFields returns the set fields of a CollectorOpts by name. Funcs
+// and the machine-specific git root are skipped, identities are reduced to
+// a count, and durations are rendered as strings.
+func nonZeroFields(opts signal.CollectorOpts) map[string]any {
+	fields := make(map[string]any)
+	v := reflect.ValueOf(opts)
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := v.Field(i)
+		name := t.Field(i).Name
+		if f.Kind() == reflect.Func || name == "GitRoot" || f.IsZero() {
+			continue
+		}
+		switch val := f.Interface().(type) {
+		case time.Duration:
+			fields[name] = val.String()
+		case map[string]time.Duration:
+			budgets := make(map[string]string, len(val))
+			for k, d := range val {
+				budgets[k] = d.String()
+			}
+			fields[name] = budgets
+		case map[string][]string:
+			fields[name] = len(val)
+		default:
+			fields[name] = val
+		}
+	}
+	return fields
+}
+
+// marshal renders v as indented JSON with secrets redacted.
+func marshal(v any) ([]byte, error) {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(redact.String(string(data))), '\n'), nil
+}
@@ -0,0 +1,154 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package bundle
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davetashner/stringer/internal/redact"
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+type testMetrics struct {
+	Directories []struct {
+		Path    string
+		Authors []struct {
+			Name      string
+			Ownership float64
+		}
+	}
+}
+
+func testInput() Input {
+	var metrics testMetrics
+	_ = json.Unmarshal([]byte(`{"Directories":[{"Path":"internal","Authors":[{"Name":"Grace Hopper","Ownership":0.9}]}]}`), &metrics)
+	return Input{
+		Env:         Environment{StringerVersion: "1.2.3", GoVersion: "go1.25.0", OS: "linux", Arch: "amd64", NumCPU: 8, GitVersion: "2.45.0"},
+		GeneratedAt: time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC),
+		RepoConfig:  []byte("max_issues: 10\nidentities:\n  Ada Lovelace:\n    - ada@example.com\n"),
+		Settings: signal.ScanConfig{
+			RepoPath:   "/home/ada/src/app",
+			Collectors: []string{"todos"},
+			CollectorOpts: map[string]signal.CollectorOpts{
+				"todos":    {MinConfidence: 0.5, FileTimeout: 5 * time.Second, GitRoot: "/home/ada/src", ProgressFunc: func(string) {}},
+				"gitlog":   {},
+				"patterns": {Identities: map[string][]string{"Ada Lovelace": {"ada@example.com"}}},
+			},
+		},
+		Result: &signal.ScanResult{
+			Signals: []signal.RawSignal{{
+				Source: "todos", Kind: "todo", FilePath: "main.go", Line: 3,
+				Title: "TODO: call Ada Lovelace", Description: "secret source", Author: "Ada Lovelace",
+				Confidence: 0.8, Tags: []string{"todo"},
+			}},
+			Results: []signal.CollectorResult{
+				{Collector: "todos", Duration: 1500 * time.Microsecond, Signals: make([]signal.RawSignal, 1)},
+				{Collector: "lotteryrisk", Err: errors.New("blame failed for Ada Lovelace <ada@example.com>"), Metrics: metrics},
+			},
+		},
+	}
+}
+
+func readBundle(t *testing.T, data []byte) map[string][]byte {
+	t.Helper()
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+	files := make(map[string][]byte)
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		require.NoError(t, err)
+		body, err := io.ReadAll(rc)
+		require.NoError(t, err)
+		_ = rc.Close()
+		files[f.Name] = body
+	}
+	return files
+}
+
+func TestWrite(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, Write(&buf, testInput()))
+	files := readBundle(t, buf.Bytes())
+
+	var manifest Manifest
+	require.NoError(t, json.Unmarshal(files[ManifestFile], &manifest))
+	assert.Equal(t, "2026-03-01T12:00:00Z", manifest.GeneratedAt)
+	assert.Equal(t, "1.2.3", manifest.Environment.StringerVersion)
+	assert.Equal(t, []string{ManifestFile, RepoConfigFile, SettingsFile, CollectorsFile, SignalsFile}, manifest.Files)
+
+	var signals []Signal
+	require.NoError(t, json.Unmarshal(files[SignalsFile], &signals))
+	require.Len(t, signals, 1)
+	assert.Equal(t, "author-1", signals[0].Author)
+	assert.Equal(t, "main.go", signals[0].FilePath)
+	assert.Equal(t, len("TODO: call Ada Lovelace"), signals[0].TitleLength)
+
+	var runs []CollectorRun
+	require.NoError(t, json.Unmarshal(files[CollectorsFile], &runs))
+	require.Len(t, runs, 2)
+	assert.Equal(t, "2ms", runs[0].Duration)
+	assert.Equal(t, 1, runs[0].Signals)
+	assert.Equal(t, "blame failed for author-1 <[EMAIL]>", runs[1].Error)
+	assert.JSONEq(t, `{"Directories":[{"Path":"internal","Authors":[{"Name":"author-2","Ownership":0.9}]}]}`, string(runs[1].Metrics))
+
+	var settings Settings
+	require.NoError(t, json.Unmarshal(files[SettingsFile], &settings))
+	assert.Equal(t, map[string]map[string]any{
+		"todos":    {"MinConfidence": 0.5, "FileTimeout": "5s"},
+		"patterns": {"Identities": float64(1)},
+	}, settings.CollectorOpts)
+
+	assert.Contains(t, string(files[RepoConfigFile]), "max_issues: 10")
+	for name, body := range files {
+		assert.NotContains(t, string(body), "Ada Lovelace", name)
+		assert.NotContains(t, string(body), "ada@example.com", name)
+		assert.NotContains(t, string(body), "Grace Hopper", name)
+		assert.NotContains(t, string(body), "secret source", name)
+		assert.NotContains(t, string(body), "/home/ada", name)
+	}
+}
+
+func TestWrite_Reproducible(t *testing.T) {
+	var a, b bytes.Buffer
+	require.NoError(t, Write(&a, testInput()))
+	require.NoError(t, Write(&b, testInput()))
+	assert.Equal(t, a.Bytes(), b.Bytes())
+}
+
+func TestWrite_RedactsEnvironmentSecrets(t *testing.T) {
+	t.Setenv("GITHUB_TOKEN", "ghp_bundletoken123")
+	redact.ResetForTest()
+	t.Cleanup(redact.ResetForTest)
+
+	in := testInput()
+	in.Result.Results[0].Err = errors.New("401 for token ghp_bundletoken123")
+	in.GlobalConfig = []byte("# token: ghp_bundletoken123\noutput_format: json\n")
+
+	var buf bytes.Buffer
+	require.NoError(t, Write(&buf, in))
+	files := readBundle(t, buf.Bytes())
+	require.Contains(t, files, GlobalConfigFile)
+	for name, body := range files {
+		assert.NotContains(t, string(body), "ghp_bundletoken123", name)
+	}
+	assert.Contains(t, string(files[CollectorsFile]), "[REDACTED]")
+}
+
+func TestWrite_NoResult(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, Write(&buf, Input{}))
+	files := readBundle(t, buf.Bytes())
+	assert.Equal(t, "[]\n", string(files[SignalsFile]))
+	assert.Equal(t, "[]\n", string(files[CollectorsFile]))
+	assert.NotContains(t, files, RepoConfigFile)
+}
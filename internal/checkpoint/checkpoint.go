@@ -0,0 +1,349 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+// Package checkpoint records the progress of a scan so an interrupted scan
+// can be resumed with stringer scan --resume. When checkpointing is on
+// (scan --checkpoint or --resume), each collector's result is appended to
+// .stringer/checkpoint.jsonl as soon as it completes; a resumed scan reuses
+// those results and runs only the collectors that had not finished. Files
+// the todos and patterns collectors already read are replayed from the
+// scan cache, which is saved when a scan is interrupted.
+//
+// The file is JSON Lines: a header recording the HEAD commit and a
+// fingerprint of the scan's flags and configuration, then one line per
+// completed collector. Each line carries a SHA-256 checksum of its
+// contents. A checkpoint is only reused by the scan that wrote it, so one
+// that was edited or written for another commit or another command line is
+// discarded instead of mixing stale results into the scan; a last line cut
+// short by an interruption is dropped, and its collector runs again.
+// Secrets are redacted from signal text before it is written.
+package checkpoint
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/davetashner/stringer/internal/redact"
+	"github.com/davetashner/stringer/internal/signal"
+	"github.com/davetashner/stringer/internal/statedir"
+	"github.com/davetashner/stringer/internal/testable"
+)
+
+const (
+	// FileName is the checkpoint file name inside the .stringer directory.
+	FileName = "checkpoint.jsonl"
+
+	// formatVersion is bumped whenever the on-disk layout changes.
+	formatVersion = 2
+)
+
+// FS is the file system implementation used by this package.
+// Override in tests with a testable.MockFileSystem.
+var FS testable.FileSystem = testable.DefaultFS
+
+// openFile opens the checkpoint file for writing: truncated for a new
+// scan, or for appending to a resumed one.
+var openFile = func(name string, flag int) (*os.File, error) {
+	return os.OpenFile(name, flag|os.O_WRONLY|os.O_CREATE, 0o600) //nolint:gosec // path is under the scanned repo's .stringer directory
+}
+
+// Result is the stored result of one collector that completed.
+type Result struct {
+	Workspace   string             `json:"workspace,omitempty"` // relative path, "" outside monorepos
+	Collector   string             `json:"collector"`
+	Signals     []signal.RawSignal `json:"signals"`
+	Duration    time.Duration      `json:"duration"`
+	RetryReason string             `json:"retry_reason,omitempty"`
+	Warnings    []string           `json:"warnings,omitempty"`
+}
+
+// State is the progress of one scan.
+type State struct {
+	GitHead     string    `json:"git_head"`
+	Fingerprint string    `json:"fingerprint"`
+	StartedAt   time.Time `json:"started_at"`
+	Results     []Result  `json:"-"`
+}
+
+// header is the first line of the file.
+type header struct {
+	Version int `json:"version"`
+	State
+}
+
+// line is one line of the file. Checksum covers the exact bytes of Data:
+// the header on the first line and a Result on the others.
+type line struct {
+	Checksum string          `json:"checksum"`
+	Data     json.RawMessage `json:"data"`
+}
+
+// Path returns the checkpoint file path for the directory root.
+func Path(root string) string {
+	return filepath.Join(root, statedir.Name, FileName)
+}
+
+// Load reads the checkpoint for root. It returns nil, nil when there is
+// none, and an error when the file cannot be read or fails its integrity
+// check. A last line without its newline was cut short while being
+// written and is ignored.
+func Load(root string) (*State, error) {
+	raw, err := FS.ReadFile(Path(root))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("read checkpoint: %w", err)
+	}
+	if i := bytes.LastIndexByte(raw, '\n'); i < len(raw)-1 {
+		raw = raw[:i+1]
+	}
+	lines := bytes.Split(bytes.TrimSuffix(raw, []byte("\n")), []byte("\n"))
+	if len(lines[0]) == 0 {
+		return nil, nil
+	}
+
+	var h header
+	if err := decodeLine(lines[0], &h); err != nil {
+		return nil, err
+	}
+	if h.Version != formatVersion {
+		return nil, fmt.Errorf("checkpoint format version %d, want %d", h.Version, formatVersion)
+	}
+	s := h.State
+	for _, l := range lines[1:] {
+		var r Result
+		if err := decodeLine(l, &r); err != nil {
+			return nil, err
+		}
+		s.Results = append(s.Results, r)
+	}
+	return &s, nil
+}
+
+// decodeLine verifies one line's checksum and decodes its data into v.
+func decodeLine(raw []byte, v any) error {
+	var l line
+	if err := json.Unmarshal(raw, &l); err != nil {
+		return fmt.Errorf("parse checkpoint: %w", err)
+	}
+	if sum := checksum(l.Data); l.Checksum != sum {
+		return errors.New("checkpoint checksum mismatch: the file was modified")
+	}
+	if err := json.Unmarshal(l.Data, v); err != nil {
+		return fmt.Errorf("parse checkpoint: %w", err)
+	}
+	return nil
+}
+
+// Matches returns why s cannot be resumed by a scan of gitHead with the
+// given fingerprint, or nil if it can.
+func (s *State) Matches(gitHead, fingerprint string) error {
+	switch {
+	case s.GitHead != gitHead:
+		return fmt.Errorf("checkpoint was written at commit %.7s, HEAD is %.7s", s.GitHead, gitHead)
+	case s.Fingerprint != fingerprint:
+		return errors.New("checkpoint was written by a scan with other flags or configuration")
+	}
+	return nil
+}
+
+// Checkpoint accumulates the results of completed collectors and appends
+// each one to the checkpoint file. It is safe for concurrent use.
+type Checkpoint struct {
+	root string
+
+	mu      sync.Mutex
+	state   State
+	started bool // the file holds this scan's header
+}
+
+// New starts a checkpoint for a scan of root. With a resumed state, its
+// results are kept and reported by Completed, and new results are appended
+// to its file; otherwise the file is replaced when the first collector
+// completes.
+func New(root, gitHead, fingerprint string, resumed *State) *Checkpoint {
+	c := &Checkpoint{
+		root:  root,
+		state: State{GitHead: gitHead, Fingerprint: fingerprint, StartedAt: time.Now().UTC()},
+	}
+	if resumed != nil {
+		c.state.StartedAt = resumed.StartedAt
+		c.state.Results = append(c.state.Results, resumed.Results...)
+		c.started = true
+	}
+	return c
+}
+
+// Len returns how many collector results the checkpoint holds.
+func (c *Checkpoint) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.state.Results)
+}
+
+// Completed returns the stored result of collector in workspace.
+func (c *Checkpoint) Completed(workspace, collector string) (signal.CollectorResult, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, r := range c.state.Results {
+		if r.Workspace == workspace && r.Collector == collector {
+			return signal.CollectorResult{
+				Collector:   r.Collector,
+				Signals:     append([]signal.RawSignal(nil), r.Signals...),
+				Duration:    r.Duration,
+				RetryReason: r.RetryReason,
+				Warnings:    r.Warnings,
+				Resumed:     true,
+			}, true
+		}
+	}
+	return signal.CollectorResult{}, false
+}
+
+// Record stores the result of a collector that completed in workspace and
+// appends it to the checkpoint file. Failed and skipped collectors are not
+// recorded, so a resumed scan runs them again.
+func (c *Checkpoint) Record(workspace string, result signal.CollectorResult) error {
+	if result.Err != nil || result.SkipReason != "" {
+		return nil
+	}
+	r := Result{
+		Workspace:   workspace,
+		Collector:   result.Collector,
+		Signals:     redactSignals(result.Signals),
+		Duration:    result.Duration,
+		RetryReason: result.RetryReason,
+		Warnings:    redactAll(result.Warnings),
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.append(r); err != nil {
+		return err
+	}
+	c.state.Results = append(c.state.Results, r)
+	return nil
+}
+
+// Workspace returns the view of c for one workspace, "" outside monorepos,
+// that a scan pipeline reads and records results through.
+func (c *Checkpoint) Workspace(rel string) *WorkspaceCheckpoint {
+	return &WorkspaceCheckpoint{c: c, workspace: rel}
+}
+
+// WorkspaceCheckpoint is a Checkpoint restricted to one workspace. It
+// implements pipeline.Checkpointer.
+type WorkspaceCheckpoint struct {
+	c         *Checkpoint
+	workspace string
+}
+
+// Completed returns the stored result of collector.
+func (w *WorkspaceCheckpoint) Completed(collector string) (signal.CollectorResult, bool) {
+	return w.c.Completed(w.workspace, collector)
+}
+
+// Record stores the result of a collector that completed.
+func (w *WorkspaceCheckpoint) Record(result signal.CollectorResult) error {
+	return w.c.Record(w.workspace, result)
+}
+
+// append writes r to the checkpoint file, after the header when this is
+// the scan's first result. Callers hold c.mu.
+func (c *Checkpoint) append(r Result) error {
+	var data []byte
+	if !c.started {
+		h, err := encodeLine(header{Version: formatVersion, State: c.state})
+		if err != nil {
+			return err
+		}
+		data = h
+	}
+	l, err := encodeLine(r)
+	if err != nil {
+		return err
+	}
+	data = append(data, l...)
+
+	flag := os.O_APPEND
+	if !c.started {
+		flag = os.O_TRUNC
+		if err := FS.MkdirAll(filepath.Join(c.root, statedir.Name), 0o750); err != nil {
+			return fmt.Errorf("create state directory: %w", err)
+		}
+		statedir.EnsureIgnore(FS, c.root)
+	}
+	f, err := openFile(Path(c.root), flag)
+	if err != nil {
+		return fmt.Errorf("write checkpoint: %w", err)
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Close() //nolint:errcheck,gosec // the write error is the one reported
+		return fmt.Errorf("write checkpoint: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("write checkpoint: %w", err)
+	}
+	c.started = true
+	return nil
+}
+
+// encodeLine returns v as one checksummed line of the file.
+func encodeLine(v any) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("encode checkpoint: %w", err)
+	}
+	l, err := json.Marshal(line{Checksum: checksum(data), Data: data})
+	if err != nil {
+		return nil, fmt.Errorf("encode checkpoint: %w", err)
+	}
+	return append(l, '\n'), nil
+}
+
+// redactSignals returns a copy of signals with secret values replaced in
+// their free-text fields, as in the scan's own output.
+func redactSignals(signals []signal.RawSignal) []signal.RawSignal {
+	out := make([]signal.RawSignal, len(signals))
+	for i, s := range signals {
+		s.Title = redact.String(s.Title)
+		s.Description = redact.String(s.Description)
+		out[i] = s
+	}
+	return out
+}
+
+// redactAll returns a copy of ss with secret values replaced.
+func redactAll(ss []string) []string {
+	if ss == nil {
+		return nil
+	}
+	out := make([]string, len(ss))
+	for i, s := range ss {
+		out[i] = redact.String(s)
+	}
+	return out
+}
+
+// Remove deletes the checkpoint for root, if any.
+func Remove(root string) error {
+	if err := os.Remove(Path(root)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("remove checkpoint: %w", err)
+	}
+	return nil
+}
+
+// checksum returns the SHA-256 of data as "sha256:<hex>".
+func checksum(data []byte) string {
+	sum := sha256.Sum256(data)
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
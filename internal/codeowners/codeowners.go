@@ -0,0 +1,279 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+// Package codeowners reads GitHub and GitLab CODEOWNERS files and resolves
+// the owners of a path, so signals can be assigned to the people or teams
+// responsible for the code they point at.
+package codeowners
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+// Locations are the paths, relative to the repository root, where GitHub
+// and GitLab look for a CODEOWNERS file. The first one that exists is used.
+var Locations = []string{
+	".github/CODEOWNERS",
+	"CODEOWNERS",
+	"docs/CODEOWNERS",
+	".gitlab/CODEOWNERS",
+}
+
+// Rule assigns owners to the paths matching a pattern.
+type Rule struct {
+	Pattern string
+	Owners  []string
+	Line    int
+
+	re *regexp.Regexp
+}
+
+// section is a GitLab [Section] and the rules under it. Rules before the
+// first section header belong to an unnamed section.
+type section struct {
+	name     string
+	defaults []string
+	rules    []Rule
+}
+
+// File is a parsed CODEOWNERS file.
+type File struct {
+	sections []section
+}
+
+// Parse reads a CODEOWNERS file. Within each section, the last rule
+// matching a path decides its owners, as on GitHub, which has no sections;
+// GitLab combines the owners each section assigns.
+func Parse(r io.Reader) (*File, error) {
+	f := &File{sections: []section{{}}}
+	sc := bufio.NewScanner(r)
+	lineNum := 0
+	for sc.Scan() {
+		lineNum++
+		fields := splitFields(sc.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		if name, defaults, ok := parseSectionHeader(fields); ok {
+			f.sections = append(f.sections, section{name: name, defaults: defaults})
+			continue
+		}
+		re, err := compilePattern(fields[0])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", lineNum, err)
+		}
+		cur := &f.sections[len(f.sections)-1]
+		owners := fields[1:]
+		if len(owners) == 0 {
+			owners = cur.defaults
+		}
+		cur.rules = append(cur.rules, Rule{Pattern: fields[0], Owners: owners, Line: lineNum, re: re})
+	}
+	if err := sc.Err(); err != nil {
+		return nil, fmt.Errorf("read CODEOWNERS: %w", err)
+	}
+	return f, nil
+}
+
+// Load parses the CODEOWNERS file of the repository at root. It returns
+// nil and no error when the repository has none.
+func Load(root string) (*File, string, error) {
+	for _, loc := range Locations {
+		path := filepath.Join(root, filepath.FromSlash(loc))
+		fh, err := os.Open(path) //nolint:gosec // fixed name under the repository root
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, "", fmt.Errorf("open %s: %w", loc, err)
+		}
+		f, err := Parse(fh)
+		_ = fh.Close()
+		if err != nil {
+			return nil, "", fmt.Errorf("%s: %w", loc, err)
+		}
+		return f, loc, nil
+	}
+	return nil, "", nil
+}
+
+// Owners returns the owners of path, slash-separated and relative to the
+// repository root, in file order without duplicates. A path no rule
+// matches, or whose last matching rule lists no owners, has none.
+func (f *File) Owners(path string) []string {
+	path = strings.TrimPrefix(filepath.ToSlash(filepath.Clean(path)), "/")
+	var owners []string
+	seen := make(map[string]bool)
+	for _, s := range f.sections {
+		for i := len(s.rules) - 1; i >= 0; i-- {
+			if !s.rules[i].re.MatchString(path) {
+				continue
+			}
+			for _, o := range s.rules[i].Owners {
+				if !seen[o] {
+					seen[o] = true
+					owners = append(owners, o)
+				}
+			}
+			break
+		}
+	}
+	return owners
+}
+
+// Annotate sets Owners on the signals with a file path, from the
+// CODEOWNERS file of the repository at gitRoot. Signal paths are relative
+// to repoPath. It returns the number of signals given owners, and zero when
+// the repository has no CODEOWNERS file.
+func Annotate(_ context.Context, signals []signal.RawSignal, repoPath, gitRoot string) (int, error) {
+	f, _, err := Load(gitRoot)
+	if err != nil || f == nil {
+		return 0, err
+	}
+	prefix, err := filepath.Rel(gitRoot, repoPath)
+	if err != nil {
+		return 0, fmt.Errorf("relate scan path to git root: %w", err)
+	}
+	prefix = filepath.ToSlash(prefix)
+
+	n := 0
+	for i := range signals {
+		s := &signals[i]
+		if s.FilePath == "" {
+			continue
+		}
+		p := filepath.ToSlash(filepath.Clean(s.FilePath))
+		if prefix != "." {
+			p = prefix + "/" + p
+		}
+		if owners := f.Owners(p); len(owners) > 0 {
+			s.Owners = owners
+			n++
+		}
+	}
+	return n, nil
+}
+
+// splitFields splits a line into whitespace-separated fields, honoring
+// backslash escapes ("\ " and "\#") and dropping a trailing comment.
+func splitFields(line string) []string {
+	var fields []string
+	var cur strings.Builder
+	inField := false
+	for i := 0; i < len(line); i++ {
+		c := line[i]
+		switch {
+		case c == '\\' && i+1 < len(line):
+			i++
+			cur.WriteByte('\\')
+			cur.WriteByte(line[i])
+			inField = true
+		case c == '#' && !inField:
+			i = len(line)
+		case c == ' ' || c == '\t':
+			if inField {
+				fields = append(fields, cur.String())
+				cur.Reset()
+				inField = false
+			}
+		default:
+			cur.WriteByte(c)
+			inField = true
+		}
+	}
+	if inField {
+		fields = append(fields, cur.String())
+	}
+	return fields
+}
+
+// sectionHeader matches a GitLab section header: an optional "^", the name
+// in brackets, and an optional approval count in brackets.
+var sectionHeader = regexp.MustCompile(`^\^?\[([^\]]+)\](?:\[\d+\])?$`)
+
+// parseSectionHeader reports whether fields are a GitLab section header
+// and returns its name and default owners.
+func parseSectionHeader(fields []string) (string, []string, bool) {
+	m := sectionHeader.FindStringSubmatch(fields[0])
+	if m == nil {
+		return "", nil, false
+	}
+	return m[1], fields[1:], true
+}
+
+// compilePattern turns a CODEOWNERS pattern, which follows gitignore rules,
+// into a regular expression over slash-separated repository paths.
+// Patterns match the path itself and everything below it, except that a
+// final "*" segment matches only the entries directly inside a directory.
+func compilePattern(pattern string) (*regexp.Regexp, error) {
+	p := pattern
+	dirOnly := strings.HasSuffix(p, "/")
+	p = strings.TrimSuffix(p, "/")
+	anchored := strings.HasPrefix(p, "/") || strings.Contains(p, "/")
+	p = strings.TrimPrefix(p, "/")
+	if p == "" {
+		return nil, fmt.Errorf("invalid pattern %q", pattern)
+	}
+
+	var b strings.Builder
+	b.WriteString("^")
+	if !anchored {
+		b.WriteString("(?:.*/)?")
+	}
+	for i := 0; i < len(p); i++ {
+		c := p[i]
+		switch {
+		case c == '\\' && i+1 < len(p):
+			i++
+			b.WriteString(regexp.QuoteMeta(string(p[i])))
+		case strings.HasPrefix(p[i:], "**/"):
+			b.WriteString("(?:.*/)?")
+			i += 2
+		case strings.HasPrefix(p[i:], "**"):
+			b.WriteString(".*")
+			i++
+		case c == '*':
+			b.WriteString("[^/]*")
+		case c == '?':
+			b.WriteString("[^/]")
+		case c == '[':
+			end := strings.IndexByte(p[i+1:], ']')
+			if end < 0 {
+				return nil, fmt.Errorf("invalid pattern %q: unclosed [", pattern)
+			}
+			class := p[i+1 : i+1+end]
+			if strings.HasPrefix(class, "!") {
+				class = "^" + class[1:]
+			}
+			b.WriteString("[" + class + "]")
+			i += end + 1
+		default:
+			b.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	switch {
+	case dirOnly:
+		b.WriteString("/.*")
+	case strings.HasSuffix(p, "/*") || p == "*":
+		// "docs/*" covers the files in docs, not those in its subdirectories.
+	default:
+		b.WriteString("(?:/.*)?")
+	}
+	b.WriteString("$")
+	re, err := regexp.Compile(b.String())
+	if err != nil {
+		return nil, fmt.Errorf("invalid pattern %q: %w", pattern, err)
+	}
+	return re, nil
+}
@@ -0,0 +1,126 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package codeowners
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+const githubFile = `# Default owners
+*       @org/everyone
+
+*.js    @js-owner  # inline comment
+/build/logs/ @doctocat
+docs/*  docs@example.com
+apps/   @octocat
+/scripts/ @doctocat @octocat
+**/tmp @monalisa
+/apps/github
+My\ Docs/ @spaces
+\#notes @hash
+`
+
+func TestOwners_GitHub(t *testing.T) {
+	f, err := Parse(strings.NewReader(githubFile))
+	require.NoError(t, err)
+
+	tests := []struct {
+		path string
+		want []string
+	}{
+		{"README.md", []string{"@org/everyone"}},
+		{"web/app.js", []string{"@js-owner"}},
+		{"build/logs/out.txt", []string{"@doctocat"}},
+		{"build/logs/nested/out.txt", []string{"@doctocat"}},
+		{"docs/getting-started.md", []string{"docs@example.com"}},
+		{"docs/build-app/troubleshooting.md", []string{"@org/everyone"}},
+		{"apps/web/main.go", []string{"@octocat"}},
+		{"src/apps/main.go", []string{"@octocat"}},
+		{"scripts/run.sh", []string{"@doctocat", "@octocat"}},
+		{"deep/path/tmp/x.log", []string{"@monalisa"}},
+		{"apps/github/main.go", nil},
+		{"My Docs/a.md", []string{"@spaces"}},
+		{"#notes", []string{"@hash"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			assert.Equal(t, tt.want, f.Owners(tt.path))
+		})
+	}
+}
+
+func TestOwners_GitLabSections(t *testing.T) {
+	f, err := Parse(strings.NewReader(`
+* @admin
+
+[Backend] @backend-team
+internal/
+internal/legacy/ @old-guard
+
+^[Docs][2] @docs-team
+*.md
+internal/*.md @admin
+`))
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"@admin", "@backend-team"}, f.Owners("internal/a.go"))
+	assert.Equal(t, []string{"@admin", "@old-guard"}, f.Owners("internal/legacy/b.go"))
+	assert.Equal(t, []string{"@admin", "@backend-team"}, f.Owners("internal/README.md"), "owners are not repeated across sections")
+	assert.Equal(t, []string{"@admin", "@docs-team"}, f.Owners("README.md"))
+	assert.Equal(t, []string{"@admin"}, f.Owners("cmd/main.go"))
+}
+
+func TestParse_InvalidPattern(t *testing.T) {
+	_, err := Parse(strings.NewReader("ok @a\nsrc/[abc @b\n"))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "line 2")
+}
+
+func TestLoad(t *testing.T) {
+	dir := t.TempDir()
+	f, loc, err := Load(dir)
+	require.NoError(t, err)
+	assert.Nil(t, f, "no CODEOWNERS file")
+	assert.Empty(t, loc)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "CODEOWNERS"), []byte("* @root\n"), 0o600))
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, ".github"), 0o750))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".github", "CODEOWNERS"), []byte("* @github\n"), 0o600))
+
+	f, loc, err = Load(dir)
+	require.NoError(t, err)
+	assert.Equal(t, ".github/CODEOWNERS", loc, ".github takes precedence")
+	assert.Equal(t, []string{"@github"}, f.Owners("main.go"))
+}
+
+func TestAnnotate(t *testing.T) {
+	root := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(root, "CODEOWNERS"),
+		[]byte("* @everyone\n/services/api/ @api-team\n"), 0o600))
+
+	signals := []signal.RawSignal{
+		{Kind: "todo", FilePath: "handler.go"},
+		{Kind: "github-issue"},
+	}
+	n, err := Annotate(context.Background(), signals, filepath.Join(root, "services", "api"), root)
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	assert.Equal(t, []string{"@api-team"}, signals[0].Owners, "paths are resolved from the git root")
+	assert.Nil(t, signals[1].Owners)
+
+	signals = []signal.RawSignal{{Kind: "todo", FilePath: "main.go"}}
+	n, err = Annotate(context.Background(), signals, t.TempDir(), t.TempDir())
+	require.NoError(t, err)
+	assert.Zero(t, n)
+	assert.Nil(t, signals[0].Owners)
+}
@@ -30,6 +30,34 @@ type MetricsProvider interface {
 	Metrics() any
 }
 
+// CapabilityChecker is an optional interface that collectors can implement to
+// report missing prerequisites (no token, no git history, no network) before
+// the scan starts. The pipeline calls CheckCapabilities for every collector
+// first; a non-empty reason disables the collector for the scan and is
+// recorded in CollectorResult.SkipReason so empty output can be diagnosed.
+type CapabilityChecker interface {
+	CheckCapabilities(ctx context.Context, repoPath string, opts signal.CollectorOpts) (reason string)
+}
+
+// Streamer is an optional interface for collectors that can produce very
+// many signals. A streaming pipeline calls Stream instead of Collect, and
+// Stream passes each signal to emit as soon as it is found rather than
+// returning them all at the end. emit blocks while the consumer catches up
+// and returns an error once the scan is cancelled; Stream must then stop
+// and return that error.
+type Streamer interface {
+	Collector
+	Stream(ctx context.Context, repoPath string, opts signal.CollectorOpts, emit func(signal.RawSignal) error) error
+}
+
+// SecretFinder is an optional marker interface for collectors that register
+// the secrets they find with the redact package. A streaming pipeline runs
+// them before every other collector, so no signal is written before the
+// secrets it might quote are known.
+type SecretFinder interface {
+	FindsSecrets()
+}
+
 var (
 	mu       sync.RWMutex
 	registry = make(map[string]Collector)
@@ -72,6 +100,15 @@ func Get(name string) Collector {
 	return registry[name]
 }
 
+// Unregister removes the named collector from the registry, if present.
+// Collectors registered at runtime, such as plugins declared in config,
+// use it to drop registrations that no longer apply.
+func Unregister(name string) {
+	mu.Lock()
+	defer mu.Unlock()
+	delete(registry, name)
+}
+
 // List returns the names of all registered collectors in no particular order.
 func List() []string {
 	mu.RLock()
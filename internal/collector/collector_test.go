@@ -87,6 +87,20 @@ func TestTryRegister_Conflict(t *testing.T) {
 	}
 }
 
+func TestUnregister(t *testing.T) {
+	resetForTesting()
+	Register(&stubCollector{name: "plugin"})
+
+	Unregister("plugin")
+	if Get("plugin") != nil {
+		t.Fatal("Get returned a collector after Unregister")
+	}
+	Unregister("plugin") // absent names are a no-op
+	if err := TryRegister(&stubCollector{name: "plugin"}); err != nil {
+		t.Fatalf("TryRegister after Unregister returned %v", err)
+	}
+}
+
 func TestList(t *testing.T) {
 	resetForTesting()
 
@@ -0,0 +1,539 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package collectors
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"golang.org/x/mod/module"
+	"golang.org/x/mod/semver"
+
+	"github.com/davetashner/stringer/internal/collector"
+	"github.com/davetashner/stringer/internal/gitcli"
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+// maxListedAPIChanges caps the changes a breaking-change signal lists.
+const maxListedAPIChanges = 20
+
+func init() {
+	collector.Register(&APICompatCollector{})
+}
+
+// APICompatMetrics holds structured metrics from the API compatibility
+// check.
+type APICompatMetrics struct {
+	BaseTag          string
+	PackagesCompared int
+	Removed          int
+	Changed          int
+}
+
+// APICompatCollector compares the exported API of a Go module at HEAD with
+// its latest release tag, as apidiff does, and emits a breaking-change
+// signal for each package that removed or incompatibly changed exported
+// declarations. Only packages with Go files changed since the tag are
+// compared. Internal packages, commands, and nested modules are left out,
+// and a new major version at HEAD is expected to break.
+type APICompatCollector struct {
+	metrics *APICompatMetrics
+}
+
+// Name returns the collector name used for registration and filtering.
+func (c *APICompatCollector) Name() string { return "apicompat" }
+
+// CheckCapabilities reports a skip reason when there is no git history.
+func (c *APICompatCollector) CheckCapabilities(_ context.Context, repoPath string, opts signal.CollectorOpts) string {
+	gitRoot := repoPath
+	if opts.GitRoot != "" {
+		gitRoot = opts.GitRoot
+	}
+	return gitHistoryReason(nil, gitRoot)
+}
+
+// Collect finds the latest release tag of the module in repoPath and
+// compares the packages changed since.
+func (c *APICompatCollector) Collect(ctx context.Context, repoPath string, opts signal.CollectorOpts) ([]signal.RawSignal, error) {
+	metrics := &APICompatMetrics{}
+	c.metrics = metrics
+
+	modulePath := readGoModulePath(repoPath)
+	if modulePath == "" {
+		return nil, nil
+	}
+	gitRoot := repoPath
+	if opts.GitRoot != "" {
+		gitRoot = opts.GitRoot
+	}
+	prefix := ""
+	if rel, err := filepath.Rel(gitRoot, repoPath); err == nil && rel != "." {
+		prefix = filepath.ToSlash(rel)
+	}
+
+	tag, err := latestModuleTag(ctx, gitRoot, prefix, modulePath)
+	if err != nil || tag == "" {
+		return nil, err
+	}
+	metrics.BaseTag = tag
+	version := strings.TrimPrefix(tag, prefix+"/")
+
+	dirs, err := changedPackageDirs(ctx, gitRoot, prefix, tag)
+	if err != nil {
+		return nil, err
+	}
+
+	excludes := mergeExcludes(opts.ExcludePatterns)
+	var signals []signal.RawSignal
+	for _, dir := range dirs {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		rel := strings.TrimPrefix(strings.TrimPrefix(dir, prefix), "/")
+		if rel == "" {
+			rel = "."
+		}
+		if !opts.Scope.ContainsDir(rel) || shouldExclude(rel, excludes) {
+			continue
+		}
+		old, err := packageAPIAt(ctx, gitRoot, tag, dir)
+		if err != nil {
+			return nil, err
+		}
+		if old == nil {
+			continue // new package, or not one with an API
+		}
+		cur, err := packageAPIAt(ctx, gitRoot, "HEAD", dir)
+		if err != nil {
+			return nil, err
+		}
+		metrics.PackagesCompared++
+
+		importPath := modulePath
+		if rel != "." {
+			importPath += "/" + rel
+		}
+		changes := compareGoAPI(old, cur)
+		for _, ch := range changes {
+			if ch.removed {
+				metrics.Removed++
+			} else {
+				metrics.Changed++
+			}
+		}
+		if len(changes) == 0 {
+			continue
+		}
+		if sig := breakingChangeSignal(rel, importPath, version, cur == nil, changes); sig.Confidence >= opts.MinConfidence {
+			signals = append(signals, sig)
+		}
+	}
+	return signals, nil
+}
+
+// latestModuleTag returns the highest release tag of the module, or "" when
+// it has none. Tags of a module in a subdirectory carry its path as a
+// prefix, and only tags of the module's current major version count.
+func latestModuleTag(ctx context.Context, gitRoot, prefix, modulePath string) (string, error) {
+	out, err := gitcli.Exec(ctx, gitRoot, "tag", "--list")
+	if err != nil {
+		return "", fmt.Errorf("listing tags: %w", err)
+	}
+	_, pathMajor, _ := module.SplitPathVersion(modulePath)
+
+	best, bestVersion := "", ""
+	for _, name := range strings.Split(out, "\n") {
+		v := name
+		if prefix != "" {
+			var ok bool
+			if v, ok = strings.CutPrefix(name, prefix+"/"); !ok {
+				continue
+			}
+		}
+		if !semver.IsValid(v) || semver.Prerelease(v) != "" || semver.Build(v) != "" {
+			continue
+		}
+		if module.CheckPathMajor(v, pathMajor) != nil {
+			continue
+		}
+		if bestVersion == "" || semver.Compare(v, bestVersion) > 0 {
+			best, bestVersion = name, v
+		}
+	}
+	return best, nil
+}
+
+// changedPackageDirs returns the directories, relative to gitRoot, of the
+// public packages under prefix with Go files changed between tag and HEAD.
+func changedPackageDirs(ctx context.Context, gitRoot, prefix, tag string) ([]string, error) {
+	args := []string{"diff", "--name-only", "-z", tag, "HEAD"}
+	if prefix != "" {
+		args = append(args, "--", prefix)
+	}
+	out, err := gitcli.Exec(ctx, gitRoot, args...)
+	if err != nil {
+		return nil, fmt.Errorf("listing changes since %s: %w", tag, err)
+	}
+	seen := make(map[string]bool)
+	var dirs []string
+	for _, name := range strings.Split(out, "\x00") {
+		if path.Ext(name) != ".go" || strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+		dir := path.Dir(name)
+		if seen[dir] || !publicPackageDir(gitRoot, prefix, dir) {
+			continue
+		}
+		seen[dir] = true
+		dirs = append(dirs, dir)
+	}
+	sort.Strings(dirs)
+	return dirs, nil
+}
+
+// publicPackageDir reports whether dir holds a package other modules can
+// import: not internal, not test data or vendored code, and not in a
+// nested module.
+func publicPackageDir(gitRoot, prefix, dir string) bool {
+	rel := strings.TrimPrefix(strings.TrimPrefix(dir, prefix), "/")
+	if rel == "." || rel == "" {
+		return true
+	}
+	parts := strings.Split(rel, "/")
+	for i, part := range parts {
+		if part == "internal" || part == "testdata" || part == "vendor" ||
+			strings.HasPrefix(part, ".") || strings.HasPrefix(part, "_") {
+			return false
+		}
+		nested := path.Join(prefix, strings.Join(parts[:i+1], "/"), "go.mod")
+		if _, err := FS.Stat(filepath.Join(gitRoot, filepath.FromSlash(nested))); err == nil {
+			return false
+		}
+	}
+	return true
+}
+
+// apiDecl is one exported declaration.
+type apiDecl struct {
+	kind string // func, method, type, field, var, const, interface method
+	sig  string // type or signature, without parameter names
+}
+
+// goAPI is the exported API of a package by name: "F", "T", "T.M", "T.F".
+type goAPI map[string]apiDecl
+
+// packageAPIAt returns the exported API of the package in dir at rev, or
+// nil when there is no package there, it is a command, or it has no Go
+// files for the default build.
+func packageAPIAt(ctx context.Context, gitRoot, rev, dir string) (goAPI, error) {
+	out, err := gitcli.Exec(ctx, gitRoot, "ls-tree", "--name-only", "-z", rev, "--", dir+"/")
+	if err != nil {
+		return nil, fmt.Errorf("listing %s at %s: %w", dir, rev, err)
+	}
+	fset := token.NewFileSet()
+	var files []*ast.File
+	for _, name := range strings.Split(out, "\x00") {
+		base := path.Base(name)
+		if path.Ext(base) != ".go" || strings.HasSuffix(base, "_test.go") {
+			continue
+		}
+		src, err := gitcli.Exec(ctx, gitRoot, "show", rev+":"+name)
+		if err != nil || !goFileBuilds(base, []byte(src)) {
+			continue
+		}
+		f, err := parser.ParseFile(fset, name, src, parser.SkipObjectResolution)
+		if err != nil || f.Name.Name == "main" || f.Name.Name == "documentation" {
+			continue
+		}
+		files = append(files, f)
+	}
+	if len(files) == 0 {
+		return nil, nil
+	}
+	api := make(goAPI)
+	for _, f := range files {
+		addFileAPI(api, f)
+	}
+	return api, nil
+}
+
+// goFileBuilds reports whether a file is part of the default build for
+// this platform, by its name and build constraints.
+func goFileBuilds(name string, src []byte) bool {
+	ctxt := build.Default
+	ctxt.OpenFile = func(string) (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(src)), nil
+	}
+	ok, err := ctxt.MatchFile(".", name)
+	return err == nil && ok
+}
+
+// addFileAPI adds the exported declarations of f to api.
+func addFileAPI(api goAPI, f *ast.File) {
+	for _, decl := range f.Decls {
+		switch d := decl.(type) {
+		case *ast.FuncDecl:
+			if !d.Name.IsExported() {
+				continue
+			}
+			if d.Recv == nil {
+				api[d.Name.Name] = apiDecl{kind: "func", sig: funcSignature(d.Type)}
+				continue
+			}
+			recv, ptr := receiverType(d.Recv.List[0].Type)
+			if !ast.IsExported(recv) {
+				continue
+			}
+			prefix := "(" + recv + ") "
+			if ptr {
+				prefix = "(*" + recv + ") "
+			}
+			api[recv+"."+d.Name.Name] = apiDecl{kind: "method", sig: prefix + funcSignature(d.Type)}
+		case *ast.GenDecl:
+			for _, spec := range d.Specs {
+				switch s := spec.(type) {
+				case *ast.TypeSpec:
+					if s.Name.IsExported() {
+						addTypeAPI(api, s)
+					}
+				case *ast.ValueSpec:
+					sig := ""
+					if s.Type != nil {
+						sig = types.ExprString(s.Type)
+					}
+					for _, name := range s.Names {
+						if name.IsExported() {
+							api[name.Name] = apiDecl{kind: d.Tok.String(), sig: sig}
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+// addTypeAPI adds an exported type and its exported fields or interface
+// methods to api.
+func addTypeAPI(api goAPI, s *ast.TypeSpec) {
+	name := s.Name.Name
+	tparams := typeParams(s.TypeParams)
+	if s.Assign.IsValid() {
+		api[name] = apiDecl{kind: "type", sig: tparams + "= " + types.ExprString(s.Type)}
+		return
+	}
+	switch t := s.Type.(type) {
+	case *ast.StructType:
+		api[name] = apiDecl{kind: "type", sig: tparams + "struct"}
+		for _, field := range t.Fields.List {
+			sig := types.ExprString(field.Type)
+			if len(field.Names) == 0 {
+				embedded, _ := receiverType(field.Type)
+				if ast.IsExported(embedded) {
+					api[name+"."+embedded] = apiDecl{kind: "field", sig: sig}
+				}
+				continue
+			}
+			for _, n := range field.Names {
+				if n.IsExported() {
+					api[name+"."+n.Name] = apiDecl{kind: "field", sig: sig}
+				}
+			}
+		}
+	case *ast.InterfaceType:
+		api[name] = apiDecl{kind: "type", sig: tparams + "interface"}
+		for _, m := range t.Methods.List {
+			if len(m.Names) == 0 {
+				embedded := types.ExprString(m.Type)
+				api[name+"."+embedded] = apiDecl{kind: "interface method", sig: "embedded " + embedded}
+				continue
+			}
+			if ft, ok := m.Type.(*ast.FuncType); ok && m.Names[0].IsExported() {
+				api[name+"."+m.Names[0].Name] = apiDecl{kind: "interface method", sig: funcSignature(ft)}
+			}
+		}
+	default:
+		api[name] = apiDecl{kind: "type", sig: tparams + types.ExprString(s.Type)}
+	}
+}
+
+// receiverType returns the type name of a receiver or embedded field and
+// whether it is a pointer.
+func receiverType(expr ast.Expr) (string, bool) {
+	ptr := false
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr, ptr = star.X, true
+	}
+	switch t := expr.(type) {
+	case *ast.IndexExpr:
+		expr = t.X
+	case *ast.IndexListExpr:
+		expr = t.X
+	}
+	switch t := expr.(type) {
+	case *ast.Ident:
+		return t.Name, ptr
+	case *ast.SelectorExpr:
+		return t.Sel.Name, ptr
+	}
+	return "", ptr
+}
+
+// funcSignature prints a function type without parameter names, which
+// callers never depend on.
+func funcSignature(ft *ast.FuncType) string {
+	sig := typeParams(ft.TypeParams) + "func(" + fieldTypes(ft.Params) + ")"
+	if ft.Results == nil || len(ft.Results.List) == 0 {
+		return sig
+	}
+	results := fieldTypes(ft.Results)
+	if len(ft.Results.List) == 1 && len(ft.Results.List[0].Names) <= 1 {
+		return sig + " " + results
+	}
+	return sig + " (" + results + ")"
+}
+
+// typeParams prints type parameter constraints without their names.
+func typeParams(fl *ast.FieldList) string {
+	if fl == nil || len(fl.List) == 0 {
+		return ""
+	}
+	return "[" + fieldTypes(fl) + "]"
+}
+
+// fieldTypes prints the types of a field list, once per name.
+func fieldTypes(fl *ast.FieldList) string {
+	if fl == nil {
+		return ""
+	}
+	var parts []string
+	for _, f := range fl.List {
+		t := types.ExprString(f.Type)
+		for range max(len(f.Names), 1) {
+			parts = append(parts, t)
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// apiChange is one incompatible difference between two versions of a
+// package API.
+type apiChange struct {
+	name    string
+	removed bool
+	text    string
+}
+
+// compareGoAPI returns the incompatible changes from old to cur: exported
+// declarations removed or changed, and methods added to interfaces, which
+// breaks their implementations. A nil cur means the package was removed.
+// Members of a removed type are not listed separately, and moving a method
+// from a pointer to a value receiver is compatible.
+func compareGoAPI(old, cur goAPI) []apiChange {
+	if cur == nil {
+		return []apiChange{{name: ".", removed: true, text: "removed package"}}
+	}
+	var changes []apiChange
+	for name, o := range old {
+		parent, _, member := strings.Cut(name, ".")
+		n, ok := cur[name]
+		switch {
+		case !ok:
+			if member {
+				if _, parentKept := cur[parent]; !parentKept {
+					continue
+				}
+			}
+			changes = append(changes, apiChange{name: name, removed: true,
+				text: fmt.Sprintf("removed %s %s", o.kind, name)})
+		case o.kind != n.kind:
+			changes = append(changes, apiChange{name: name,
+				text: fmt.Sprintf("changed %s from %s to %s", name, o.kind, n.kind)})
+		case o.sig != n.sig && o.sig != "" && n.sig != "" && !pointerToValueReceiver(o, n):
+			changes = append(changes, apiChange{name: name,
+				text: fmt.Sprintf("changed %s %s: %s → %s", o.kind, name, o.sig, n.sig)})
+		}
+	}
+	for name, n := range cur {
+		parent, _, _ := strings.Cut(name, ".")
+		if _, existed := old[name]; existed || n.kind != "interface method" {
+			continue
+		}
+		if p, ok := old[parent]; ok && p.kind == "type" && strings.HasSuffix(p.sig, "interface") {
+			changes = append(changes, apiChange{name: name,
+				text: fmt.Sprintf("added method %s to interface %s", strings.TrimPrefix(name, parent+"."), parent)})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool { return changes[i].name < changes[j].name })
+	return changes
+}
+
+// pointerToValueReceiver reports whether a method only moved from a
+// pointer receiver to a value receiver, which keeps every method set.
+func pointerToValueReceiver(old, cur apiDecl) bool {
+	if old.kind != "method" || !strings.HasPrefix(old.sig, "(*") {
+		return false
+	}
+	return strings.Replace(old.sig, "(*", "(", 1) == cur.sig
+}
+
+// breakingChangeSignal reports the incompatible changes of one package.
+// Before v1 a module makes no compatibility promise, so the signal ranks
+// lower.
+func breakingChangeSignal(dir, importPath, version string, removed bool, changes []apiChange) signal.RawSignal {
+	confidence := 0.7
+	if semver.Major(version) == "v0" {
+		confidence = 0.4
+	}
+	title := fmt.Sprintf("Breaking API change in %s since %s: package removed", importPath, version)
+	lines := []string{fmt.Sprintf("Package %s, released in %s, no longer exists.", importPath, version)}
+	if !removed {
+		n := 0
+		for _, ch := range changes {
+			if ch.removed {
+				n++
+			}
+		}
+		title = fmt.Sprintf("Breaking API change in %s since %s: %d removed, %d changed", importPath, version, n, len(changes)-n)
+		lines = []string{fmt.Sprintf("Since %s, %s changed incompatibly:", version, importPath)}
+		for _, ch := range changes[:min(len(changes), maxListedAPIChanges)] {
+			lines = append(lines, "- "+ch.text)
+		}
+		if len(changes) > maxListedAPIChanges {
+			lines = append(lines, fmt.Sprintf("- and %d more", len(changes)-maxListedAPIChanges))
+		}
+	}
+	next := "a new major version"
+	if semver.Major(version) == "v0" {
+		next = "the next minor version"
+	}
+	lines = append(lines, fmt.Sprintf("Restore compatibility before the next release, or release it as %s.", next))
+	return signal.RawSignal{
+		Source:      "apicompat",
+		Kind:        "breaking-change",
+		FilePath:    dir,
+		Title:       title,
+		Description: strings.Join(lines, "\n"),
+		Confidence:  confidence,
+		Tags:        []string{"breaking-change", "api-compat"},
+	}
+}
+
+// Metrics returns structured metrics from the API compatibility check.
+func (c *APICompatCollector) Metrics() any { return c.metrics }
+
+// Compile-time interface checks.
+var _ collector.Collector = (*APICompatCollector)(nil)
+var _ collector.MetricsProvider = (*APICompatCollector)(nil)
+var _ collector.CapabilityChecker = (*APICompatCollector)(nil)
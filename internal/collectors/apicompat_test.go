@@ -0,0 +1,214 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package collectors
+
+import (
+	"context"
+	"go/parser"
+	"go/token"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+func TestAPICompatCollector_Name(t *testing.T) {
+	assert.Equal(t, "apicompat", (&APICompatCollector{}).Name())
+}
+
+const apicompatV1 = `package client
+
+// Client talks to the API.
+type Client struct {
+	BaseURL string
+	Timeout int
+	token   string
+}
+
+func New(baseURL string) *Client { return &Client{BaseURL: baseURL} }
+
+func (c *Client) Get(path string) ([]byte, error) { return nil, nil }
+
+func (c *Client) Close() {}
+
+func Retry(n int) {}
+
+type Doer interface {
+	Do(req string) error
+}
+
+const DefaultTimeout = 30
+
+func helper() {}
+`
+
+func collectAPICompat(t *testing.T, dir string) ([]signal.RawSignal, *APICompatMetrics) {
+	t.Helper()
+	c := &APICompatCollector{}
+	signals, err := c.Collect(context.Background(), dir, signal.CollectorOpts{})
+	require.NoError(t, err)
+	return signals, c.Metrics().(*APICompatMetrics)
+}
+
+func TestAPICompatCollector_BreakingChanges(t *testing.T) {
+	dir := initTestGitRepo(t, map[string]string{
+		"go.mod":                "module example.com/api\n\ngo 1.22\n",
+		"client/client.go":      apicompatV1,
+		"internal/x/x.go":       "package x\n\nfunc Old() {}\n",
+		"cmd/api/main.go":       "package main\n\nfunc Run() {}\n",
+		"stable/stable.go":      "package stable\n\nfunc Keep() {}\n",
+		"gone/gone.go":          "package gone\n\nfunc Bye() {}\n",
+		"client/client_test.go": "package client\n\nfunc TestHelper() {}\n",
+	})
+	runGit(t, dir, "tag", "v1.2.0")
+	runGit(t, dir, "tag", "v1.3.0-rc.1")
+
+	writeAndCommit(t, dir, map[string]string{
+		"client/client.go": `package client
+
+type Client struct {
+	BaseURL string
+	Timeout string
+}
+
+func New(url string) *Client { return &Client{BaseURL: url} }
+
+func (c *Client) Get(p string) ([]byte, error) { return nil, nil }
+
+func (c Client) Close() {}
+
+type Doer interface {
+	Do(req string) error
+	Name() string
+}
+
+const DefaultTimeout = 30
+
+func Extra() {}
+`,
+		"internal/x/x.go":       "package x\n",
+		"cmd/api/main.go":       "package main\n",
+		"stable/stable.go":      "package stable\n\n// Keep is documented now.\nfunc Keep() {}\n",
+		"client/client_test.go": "package client\n",
+	}, "Rework client")
+	runGit(t, dir, "rm", "-q", "gone/gone.go")
+	runGit(t, dir, "commit", "-q", "-m", "Remove gone")
+
+	signals, m := collectAPICompat(t, dir)
+	require.Len(t, signals, 2)
+
+	assert.Equal(t, "Breaking API change in example.com/api/client since v1.2.0: 1 removed, 2 changed", signals[0].Title)
+	assert.Equal(t, "client", signals[0].FilePath)
+	assert.Equal(t, "breaking-change", signals[0].Kind)
+	assert.Equal(t, "apicompat", signals[0].Source)
+	assert.InDelta(t, 0.7, signals[0].Confidence, 0.001)
+	assert.Equal(t, `Since v1.2.0, example.com/api/client changed incompatibly:
+- changed field Client.Timeout: int → string
+- added method Name to interface Doer
+- removed func Retry
+Restore compatibility before the next release, or release it as a new major version.`, signals[0].Description)
+
+	assert.Equal(t, "Breaking API change in example.com/api/gone since v1.2.0: package removed", signals[1].Title)
+	assert.Equal(t, "gone", signals[1].FilePath)
+
+	assert.Equal(t, &APICompatMetrics{BaseTag: "v1.2.0", PackagesCompared: 3, Removed: 2, Changed: 2}, m)
+}
+
+func TestAPICompatCollector_PointerToValueReceiver(t *testing.T) {
+	old := goAPI{"T": {kind: "type", sig: "struct"}, "T.M": {kind: "method", sig: "(*T) func()"}}
+	assert.Empty(t, compareGoAPI(old, goAPI{"T": {kind: "type", sig: "struct"}, "T.M": {kind: "method", sig: "(T) func()"}}))
+}
+
+func TestAPICompatCollector_SubdirectoryModule(t *testing.T) {
+	dir := initTestGitRepo(t, map[string]string{
+		"sdk/go.mod":    "module example.com/mono/sdk/v2\n\ngo 1.22\n",
+		"sdk/sdk.go":    "package sdk\n\nfunc Call(a, b int) {}\n",
+		"other/main.go": "package main\n",
+	})
+	runGit(t, dir, "tag", "sdk/v2.0.0")
+	runGit(t, dir, "tag", "sdk/v1.9.0")
+	runGit(t, dir, "tag", "v3.0.0")
+	writeAndCommit(t, dir, map[string]string{"sdk/sdk.go": "package sdk\n\nfunc Call(a int) {}\n"}, "Drop arg")
+
+	c := &APICompatCollector{}
+	signals, err := c.Collect(context.Background(), dir+"/sdk", signal.CollectorOpts{GitRoot: dir})
+	require.NoError(t, err)
+	require.Len(t, signals, 1)
+	assert.Equal(t, "Breaking API change in example.com/mono/sdk/v2 since v2.0.0: 0 removed, 1 changed", signals[0].Title)
+	assert.Equal(t, ".", signals[0].FilePath)
+	assert.Contains(t, signals[0].Description, "- changed func Call: func(int, int) → func(int)")
+}
+
+func TestAPICompatCollector_PreV1(t *testing.T) {
+	dir := initTestGitRepo(t, map[string]string{
+		"go.mod": "module example.com/lib\n\ngo 1.22\n",
+		"lib.go": "package lib\n\nvar Verbose bool\n",
+	})
+	runGit(t, dir, "tag", "v0.4.0")
+	writeAndCommit(t, dir, map[string]string{"lib.go": "package lib\n"}, "Drop Verbose")
+
+	signals, _ := collectAPICompat(t, dir)
+	require.Len(t, signals, 1)
+	assert.InDelta(t, 0.4, signals[0].Confidence, 0.001, "v0 makes no compatibility promise")
+	assert.Contains(t, signals[0].Description, "release it as the next minor version")
+}
+
+func TestAPICompatCollector_NoTagOrModule(t *testing.T) {
+	dir := initTestGitRepo(t, map[string]string{"go.mod": "module example.com/lib\n", "lib.go": "package lib\n"})
+	signals, m := collectAPICompat(t, dir)
+	assert.Empty(t, signals)
+	assert.Empty(t, m.BaseTag)
+
+	dir = initTestGitRepo(t, map[string]string{"lib.go": "package lib\n"})
+	runGit(t, dir, "tag", "v1.0.0")
+	signals, _ = collectAPICompat(t, dir)
+	assert.Empty(t, signals)
+}
+
+func TestAddFileAPI(t *testing.T) {
+	f, err := parser.ParseFile(token.NewFileSet(), "x.go", `package x
+
+type List[T any] struct {
+	Items []T
+	io.Reader
+}
+
+func Map[T, U any](in []T, fn func(T) U) []U { return nil }
+
+type ID = string
+
+type Mode int
+
+type Store interface {
+	Get(key string) (value []byte, ok bool)
+	fmt.Stringer
+}
+
+var ErrClosed, errHidden = errors.New("closed"), errors.New("x")
+var Limit int64 = 5
+
+func (l *List[T]) Len() int { return 0 }
+func (s store) Hidden() {}
+`, parser.SkipObjectResolution)
+	require.NoError(t, err)
+
+	api := make(goAPI)
+	addFileAPI(api, f)
+	assert.Equal(t, goAPI{
+		"List":               {kind: "type", sig: "[any]struct"},
+		"List.Items":         {kind: "field", sig: "[]T"},
+		"List.Reader":        {kind: "field", sig: "io.Reader"},
+		"Map":                {kind: "func", sig: "[any, any]func([]T, func(T) U) []U"},
+		"ID":                 {kind: "type", sig: "= string"},
+		"Mode":               {kind: "type", sig: "int"},
+		"Store":              {kind: "type", sig: "interface"},
+		"Store.Get":          {kind: "interface method", sig: "func(string) ([]byte, bool)"},
+		"Store.fmt.Stringer": {kind: "interface method", sig: "embedded fmt.Stringer"},
+		"ErrClosed":          {kind: "var", sig: ""},
+		"Limit":              {kind: "var", sig: "int64"},
+		"List.Len":           {kind: "method", sig: "(*List) func() int"},
+	}, api)
+}
@@ -161,7 +161,7 @@ func (c *APIDriftCollector) Collect(ctx context.Context, repoPath string, opts s
 
 	// Phase 2: Walk source files and extract code route registrations.
 	codeRoutes := make(map[string]string) // normalized route → source file
-	err := FS.WalkDir(repoPath, func(path string, d os.DirEntry, walkErr error) error {
+	err := walkTree(ctx, repoPath, func(path string, d os.DirEntry, walkErr error) error {
 		if walkErr != nil {
 			return nil
 		}
@@ -175,7 +175,7 @@ func (c *APIDriftCollector) Collect(ctx context.Context, repoPath string, opts s
 		}
 
 		if d.IsDir() {
-			if shouldExclude(relPath, excludes) {
+			if shouldExclude(relPath, excludes) || !opts.Scope.ContainsDir(relPath) {
 				return filepath.SkipDir
 			}
 			return nil
@@ -189,6 +189,10 @@ func (c *APIDriftCollector) Collect(ctx context.Context, repoPath string, opts s
 			return nil
 		}
 
+		if !opts.Scope.Contains(relPath) {
+			return nil
+		}
+
 		ext := strings.ToLower(filepath.Ext(relPath))
 
 		// Check for Next.js file-path routes (pages/api/ convention).
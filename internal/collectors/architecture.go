@@ -0,0 +1,310 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package collectors
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/davetashner/stringer/internal/collector"
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+func init() {
+	collector.Register(&ArchitectureCollector{})
+}
+
+// ArchitectureMetrics holds structured metrics from the architecture scan.
+type ArchitectureMetrics struct {
+	RulesFile        string // rules file checked, relative to the repo; empty when none exists
+	Layers           int
+	ForbiddenImports int
+	FilesScanned     int
+	Violations       int
+}
+
+// ArchitectureCollector checks Go and JS/TS imports against the layers and
+// forbidden imports declared in an architecture rules file, emitting an
+// architecture-violation signal at each offending import. It does nothing
+// when the repository has no rules file.
+type ArchitectureCollector struct {
+	metrics *ArchitectureMetrics
+}
+
+var _ collector.Collector = (*ArchitectureCollector)(nil)
+var _ collector.MetricsProvider = (*ArchitectureCollector)(nil)
+
+// Name returns the collector name used for registration and filtering.
+func (c *ArchitectureCollector) Name() string { return "architecture" }
+
+// Metrics returns the structured metrics from the last scan.
+func (c *ArchitectureCollector) Metrics() any { return c.metrics }
+
+// architectureExtensions are the source files whose imports are checked.
+var architectureExtensions = map[string]bool{
+	".go": true, ".js": true, ".jsx": true, ".ts": true, ".tsx": true, ".mjs": true, ".cjs": true,
+}
+
+// JS/TS import forms not covered by jsImportFrom and jsRequire: side-effect
+// imports, dynamic imports, and the closing line of a multi-line import.
+var (
+	jsImportBare    = regexp.MustCompile(`^\s*import\s+['"]([^'"]+)['"]`)
+	jsImportDynamic = regexp.MustCompile(`\bimport\s*\(\s*['"]([^'"]+)['"]\s*\)`)
+	jsImportClosing = regexp.MustCompile(`^\s*}\s*from\s+['"]([^'"]+)['"]`)
+)
+
+// importRef is one import statement in a source file.
+type importRef struct {
+	Spec string // import path or module specifier as written
+	Line int
+	Text string // the source line, trimmed
+}
+
+// Collect loads the architecture rules and checks every import in scope.
+func (c *ArchitectureCollector) Collect(ctx context.Context, repoPath string, opts signal.CollectorOpts) ([]signal.RawSignal, error) {
+	metrics := &ArchitectureMetrics{}
+	c.metrics = metrics
+
+	rulesFile := opts.ArchitectureRules
+	explicit := rulesFile != ""
+	if !explicit {
+		rulesFile = defaultArchitectureRules
+	}
+	rulesPath := rulesFile
+	if !filepath.IsAbs(rulesPath) {
+		rulesPath = filepath.Join(repoPath, rulesPath)
+	}
+	data, err := FS.ReadFile(rulesPath)
+	if err != nil {
+		if !explicit && errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("reading architecture rules: %w", err)
+	}
+	rules, err := parseArchitectureRules(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", rulesFile, err)
+	}
+	metrics.RulesFile = filepath.ToSlash(rulesFile)
+	metrics.Layers = len(rules.Layers)
+	metrics.ForbiddenImports = len(rules.Forbidden)
+
+	excludes := mergeExcludes(opts.ExcludePatterns)
+	goModulePath := readGoModulePath(repoPath)
+
+	var signals []signal.RawSignal
+	err = walkTree(ctx, repoPath, func(p string, d os.DirEntry, walkErr error) error {
+		if walkErr != nil {
+			return nil
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		relPath, relErr := filepath.Rel(repoPath, p)
+		if relErr != nil {
+			return nil
+		}
+
+		if d.IsDir() {
+			if shouldExclude(relPath, excludes) || !opts.Scope.ContainsDir(relPath) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if shouldExclude(relPath, excludes) || isSymlink(d) {
+			return nil
+		}
+		if len(opts.IncludePatterns) > 0 && !matchesAny(relPath, opts.IncludePatterns) {
+			return nil
+		}
+		if !opts.Scope.Contains(relPath) || !architectureExtensions[filepath.Ext(p)] {
+			return nil
+		}
+		if isGeneratedFile(p) {
+			return nil
+		}
+
+		src, readErr := FS.ReadFile(p)
+		if readErr != nil {
+			return nil
+		}
+		metrics.FilesScanned++
+
+		relPath = filepath.ToSlash(relPath)
+		var refs []importRef
+		if filepath.Ext(p) == ".go" {
+			refs = goImportRefs(p, src)
+		} else {
+			refs = jsImportRefs(src)
+		}
+		signals = append(signals, checkImports(rules, relPath, refs, goModulePath)...)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("walking repo: %w", err)
+	}
+	metrics.Violations = len(signals)
+
+	sort.SliceStable(signals, func(i, j int) bool {
+		if signals[i].FilePath != signals[j].FilePath {
+			return signals[i].FilePath < signals[j].FilePath
+		}
+		return signals[i].Line < signals[j].Line
+	})
+	return signals, nil
+}
+
+// checkImports returns a signal for each import in relPath that crosses a
+// disallowed layer boundary or matches a forbidden import.
+func checkImports(rules *architectureRules, relPath string, refs []importRef, goModulePath string) []signal.RawSignal {
+	layer := rules.layerFor(relPath)
+	var signals []signal.RawSignal
+	for _, ref := range refs {
+		if f := firstForbidding(rules, ref.Spec, layer); f != nil {
+			signals = append(signals, forbiddenImportSignal(relPath, ref, f, layer))
+			continue
+		}
+		if layer == nil {
+			continue
+		}
+		target, ok := resolveImportTarget(relPath, ref.Spec, goModulePath)
+		if !ok {
+			continue
+		}
+		if dep := rules.layerFor(target); !layer.allows(dep) {
+			signals = append(signals, layerViolationSignal(relPath, ref, layer, dep, target))
+		}
+	}
+	return signals
+}
+
+// firstForbidding returns the first forbidden-import rule matching spec
+// for a file in layer, or nil.
+func firstForbidding(rules *architectureRules, spec string, layer *architectureLayer) *forbiddenImport {
+	for i := range rules.Forbidden {
+		if rules.Forbidden[i].forbids(spec, layer) {
+			return &rules.Forbidden[i]
+		}
+	}
+	return nil
+}
+
+// resolveImportTarget maps an intra-project import to the repo-relative
+// path it refers to: a package directory for Go, a module path (without
+// extension) for relative JS/TS imports. External imports and JS/TS path
+// aliases are not resolved.
+func resolveImportTarget(relPath, spec, goModulePath string) (string, bool) {
+	if strings.HasSuffix(relPath, ".go") {
+		if goModulePath == "" {
+			return "", false
+		}
+		if spec == goModulePath {
+			return ".", true
+		}
+		if rest, ok := strings.CutPrefix(spec, goModulePath+"/"); ok {
+			return rest, true
+		}
+		return "", false
+	}
+	if !strings.HasPrefix(spec, ".") {
+		return "", false
+	}
+	target := path.Clean(path.Join(path.Dir(relPath), spec))
+	if target == ".." || strings.HasPrefix(target, "../") {
+		return "", false
+	}
+	if ext := path.Ext(target); architectureExtensions[ext] {
+		target = strings.TrimSuffix(target, ext)
+	}
+	return target, true
+}
+
+// goImportRefs returns the imports of a Go file. Files that do not parse
+// yield no imports.
+func goImportRefs(filename string, src []byte) []importRef {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, filename, src, parser.ImportsOnly)
+	if err != nil {
+		return nil
+	}
+	lines := strings.Split(string(src), "\n")
+	refs := make([]importRef, 0, len(file.Imports))
+	for _, imp := range file.Imports {
+		spec, unqErr := strconv.Unquote(imp.Path.Value)
+		if unqErr != nil {
+			continue
+		}
+		line := fset.Position(imp.Path.Pos()).Line
+		refs = append(refs, importRef{Spec: spec, Line: line, Text: strings.TrimSpace(lines[line-1])})
+	}
+	return refs
+}
+
+// jsImportRefs returns the import and require statements of a JS/TS file.
+func jsImportRefs(src []byte) []importRef {
+	var refs []importRef
+	for i, line := range strings.Split(string(src), "\n") {
+		for _, re := range []*regexp.Regexp{jsImportFrom, jsImportClosing, jsImportBare, jsRequire, jsImportDynamic} {
+			if m := re.FindStringSubmatch(line); m != nil {
+				refs = append(refs, importRef{Spec: m[1], Line: i + 1, Text: strings.TrimSpace(line)})
+				break
+			}
+		}
+	}
+	return refs
+}
+
+func layerViolationSignal(relPath string, ref importRef, layer, dep *architectureLayer, target string) signal.RawSignal {
+	allowed := "only itself"
+	if len(layer.MayImport) > 0 {
+		allowed = strings.Join(layer.MayImport, ", ")
+	}
+	return signal.RawSignal{
+		Source:   "architecture",
+		Kind:     "architecture-violation",
+		FilePath: relPath,
+		Line:     ref.Line,
+		Title:    fmt.Sprintf("Layer %s imports %s: %s", layer.Name, dep.Name, ref.Spec),
+		Description: fmt.Sprintf("%s (layer %s) imports %s (layer %s), but layer %s may import %s.\n\nOffending import (line %d): %s",
+			relPath, layer.Name, target, dep.Name, layer.Name, allowed, ref.Line, ref.Text),
+		Confidence: 0.9,
+		Tags:       []string{"architecture-violation", "layer:" + layer.Name},
+	}
+}
+
+func forbiddenImportSignal(relPath string, ref importRef, f *forbiddenImport, layer *architectureLayer) signal.RawSignal {
+	desc := fmt.Sprintf("%s imports %s, which the architecture rules forbid", relPath, ref.Spec)
+	if len(f.Layers) > 0 {
+		desc += " in layer " + layer.Name
+	}
+	if f.Reason != "" {
+		desc += ": " + f.Reason
+	}
+	tags := []string{"architecture-violation", "forbidden-import"}
+	if layer != nil {
+		tags = append(tags, "layer:"+layer.Name)
+	}
+	return signal.RawSignal{
+		Source:      "architecture",
+		Kind:        "architecture-violation",
+		FilePath:    relPath,
+		Line:        ref.Line,
+		Title:       "Forbidden import: " + ref.Spec,
+		Description: fmt.Sprintf("%s.\n\nOffending import (line %d): %s", desc, ref.Line, ref.Text),
+		Confidence:  0.9,
+		Tags:        tags,
+	}
+}
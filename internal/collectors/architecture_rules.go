@@ -0,0 +1,164 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package collectors
+
+import (
+	"bytes"
+	"fmt"
+	"path"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// defaultArchitectureRules is the rules file read when none is configured.
+const defaultArchitectureRules = ".stringer/architecture.yaml"
+
+// anyLayer in may_import allows a layer to import every other layer.
+const anyLayer = "*"
+
+// architectureRules is the parsed architecture rules file.
+//
+//	layers:
+//	  - name: cmd
+//	    paths: [cmd]
+//	    may_import: [app, domain]
+//	  - name: domain
+//	    paths: [internal/domain]
+//	forbidden:
+//	  - import: github.com/pkg/errors
+//	    reason: use fmt.Errorf with %w
+type architectureRules struct {
+	Layers    []architectureLayer `yaml:"layers"`
+	Forbidden []forbiddenImport   `yaml:"forbidden"`
+}
+
+// architectureLayer groups directories that share import permissions.
+type architectureLayer struct {
+	Name string `yaml:"name"`
+
+	// Paths are repo-relative directories. A file or package belongs to the
+	// layer whose path is the longest prefix of its own.
+	Paths []string `yaml:"paths"`
+
+	// MayImport names the other layers this layer may depend on. A layer
+	// may always import itself and code outside every layer.
+	MayImport []string `yaml:"may_import"`
+}
+
+// forbiddenImport bans an import path and everything beneath it.
+type forbiddenImport struct {
+	Import string `yaml:"import"`
+	Reason string `yaml:"reason"`
+
+	// Layers limits the ban to files in these layers. Empty bans the
+	// import everywhere.
+	Layers []string `yaml:"layers"`
+}
+
+// parseArchitectureRules decodes and validates a rules file.
+func parseArchitectureRules(data []byte) (*architectureRules, error) {
+	var rules architectureRules
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(&rules); err != nil {
+		return nil, fmt.Errorf("parse architecture rules: %w", err)
+	}
+
+	names := make(map[string]bool, len(rules.Layers))
+	for i := range rules.Layers {
+		l := &rules.Layers[i]
+		if l.Name == "" || l.Name == anyLayer {
+			return nil, fmt.Errorf("architecture rules: layer %d needs a name", i+1)
+		}
+		if names[l.Name] {
+			return nil, fmt.Errorf("architecture rules: duplicate layer %q", l.Name)
+		}
+		names[l.Name] = true
+		if len(l.Paths) == 0 {
+			return nil, fmt.Errorf("architecture rules: layer %q has no paths", l.Name)
+		}
+		for j, p := range l.Paths {
+			l.Paths[j] = cleanLayerPath(p)
+		}
+	}
+	for _, l := range rules.Layers {
+		for _, dep := range l.MayImport {
+			if dep != anyLayer && !names[dep] {
+				return nil, fmt.Errorf("architecture rules: layer %q may_import unknown layer %q", l.Name, dep)
+			}
+		}
+	}
+	for i, f := range rules.Forbidden {
+		if strings.TrimSpace(f.Import) == "" {
+			return nil, fmt.Errorf("architecture rules: forbidden entry %d needs an import", i+1)
+		}
+		for _, name := range f.Layers {
+			if !names[name] {
+				return nil, fmt.Errorf("architecture rules: forbidden import %q names unknown layer %q", f.Import, name)
+			}
+		}
+	}
+	return &rules, nil
+}
+
+// cleanLayerPath normalizes a layer path to a slash-separated directory
+// with no leading "./" or trailing slash; the repository root is ".".
+func cleanLayerPath(p string) string {
+	p = path.Clean(strings.ReplaceAll(strings.TrimSpace(p), "\\", "/"))
+	return strings.TrimPrefix(p, "/")
+}
+
+// layerFor returns the layer owning relPath, by longest matching path, or
+// nil when relPath is outside every layer.
+func (r *architectureRules) layerFor(relPath string) *architectureLayer {
+	var best *architectureLayer
+	bestLen := -1
+	for i := range r.Layers {
+		for _, p := range r.Layers[i].Paths {
+			if !underPath(relPath, p) || len(p) <= bestLen {
+				continue
+			}
+			best, bestLen = &r.Layers[i], len(p)
+		}
+	}
+	return best
+}
+
+// allows reports whether layer l may import layer dep.
+func (l *architectureLayer) allows(dep *architectureLayer) bool {
+	if dep == nil || dep.Name == l.Name {
+		return true
+	}
+	for _, name := range l.MayImport {
+		if name == anyLayer || name == dep.Name {
+			return true
+		}
+	}
+	return false
+}
+
+// forbids reports whether f bans importPath for a file in layer l.
+func (f *forbiddenImport) forbids(importPath string, l *architectureLayer) bool {
+	if importPath != f.Import && !strings.HasPrefix(importPath, strings.TrimSuffix(f.Import, "/")+"/") {
+		return false
+	}
+	if len(f.Layers) == 0 {
+		return true
+	}
+	if l == nil {
+		return false
+	}
+	for _, name := range f.Layers {
+		if name == l.Name {
+			return true
+		}
+	}
+	return false
+}
+
+// underPath reports whether relPath is dir or lies beneath it.
+func underPath(relPath, dir string) bool {
+	return dir == "." || relPath == dir || strings.HasPrefix(relPath, dir+"/")
+}
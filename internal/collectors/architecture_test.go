@@ -0,0 +1,225 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package collectors
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+const testArchitectureRules = `layers:
+  - name: cmd
+    paths: [cmd]
+    may_import: [app]
+  - name: app
+    paths: [internal/app]
+    may_import: [domain]
+  - name: domain
+    paths: [internal/domain]
+  - name: web
+    paths: [web/src]
+    may_import: [web-api]
+  - name: web-api
+    paths: [web/src/api]
+forbidden:
+  - import: github.com/pkg/errors
+    reason: use fmt.Errorf with %w
+  - import: lodash
+    layers: [web-api]
+`
+
+// initArchitectureRepo writes a Go module and a TS tree with layer and
+// forbidden-import violations in both languages.
+func initArchitectureRepo(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	writeTestFile(t, dir, "go.mod", "module example.com/app\n\ngo 1.22\n")
+	writeTestFile(t, dir, ".stringer/architecture.yaml", testArchitectureRules)
+	writeTestFile(t, dir, "cmd/main.go", `package main
+
+import (
+	"fmt"
+
+	"example.com/app/internal/app"
+	"example.com/app/internal/domain"
+)
+
+func main() { fmt.Println(app.Run(), domain.Name) }
+`)
+	writeTestFile(t, dir, "internal/app/app.go", `package app
+
+import "example.com/app/internal/domain"
+
+func Run() string { return domain.Name }
+`)
+	writeTestFile(t, dir, "internal/domain/domain.go", `package domain
+
+import (
+	"github.com/pkg/errors"
+
+	app "example.com/app/internal/app"
+)
+
+var Name = errors.New(app.Run()).Error()
+`)
+	writeTestFile(t, dir, "web/src/api/client.ts", `import {
+  get,
+  post,
+} from './http';
+import _ from 'lodash';
+import { render } from '../ui/render.tsx';
+`)
+	writeTestFile(t, dir, "web/src/ui/render.tsx", `import _ from 'lodash';
+import { get } from '../api/client';
+export function render() {}
+`)
+	return dir
+}
+
+func TestArchitectureCollector_Violations(t *testing.T) {
+	dir := initArchitectureRepo(t)
+	c := &ArchitectureCollector{}
+	signals, err := c.Collect(context.Background(), dir, signal.CollectorOpts{})
+	require.NoError(t, err)
+
+	type hit struct {
+		File  string
+		Line  int
+		Title string
+	}
+	var got []hit
+	for _, s := range signals {
+		assert.Equal(t, "architecture", s.Source)
+		assert.Equal(t, "architecture-violation", s.Kind)
+		assert.Contains(t, s.Tags, "architecture-violation")
+		got = append(got, hit{s.FilePath, s.Line, s.Title})
+	}
+	assert.Equal(t, []hit{
+		{"cmd/main.go", 7, "Layer cmd imports domain: example.com/app/internal/domain"},
+		{"internal/domain/domain.go", 4, "Forbidden import: github.com/pkg/errors"},
+		{"internal/domain/domain.go", 6, "Layer domain imports app: example.com/app/internal/app"},
+		{"web/src/api/client.ts", 5, "Forbidden import: lodash"},
+		{"web/src/api/client.ts", 6, "Layer web-api imports web: ../ui/render.tsx"},
+	}, got)
+
+	assert.Contains(t, signals[1].Description, "use fmt.Errorf with %w")
+	assert.Contains(t, signals[2].Description, `Offending import (line 6): app "example.com/app/internal/app"`)
+	assert.Contains(t, signals[2].Description, "layer domain may import only itself")
+	assert.Contains(t, signals[1].Tags, "forbidden-import")
+	assert.Contains(t, signals[3].Tags, "layer:web-api")
+
+	m, ok := c.Metrics().(*ArchitectureMetrics)
+	require.True(t, ok)
+	assert.Equal(t, ".stringer/architecture.yaml", m.RulesFile)
+	assert.Equal(t, 5, m.Layers)
+	assert.Equal(t, 2, m.ForbiddenImports)
+	assert.Equal(t, 5, m.FilesScanned)
+	assert.Equal(t, 5, m.Violations)
+}
+
+func TestArchitectureCollector_NoRulesFile(t *testing.T) {
+	dir := t.TempDir()
+	writeTestFile(t, dir, "main.go", "package main\n\nimport \"github.com/pkg/errors\"\n")
+
+	c := &ArchitectureCollector{}
+	signals, err := c.Collect(context.Background(), dir, signal.CollectorOpts{})
+	require.NoError(t, err)
+	assert.Empty(t, signals)
+	assert.Empty(t, c.Metrics().(*ArchitectureMetrics).RulesFile)
+
+	// A configured rules file must exist.
+	_, err = c.Collect(context.Background(), dir, signal.CollectorOpts{ArchitectureRules: "layers.yaml"})
+	assert.ErrorContains(t, err, "reading architecture rules")
+}
+
+func TestArchitectureCollector_ConfiguredRulesFile(t *testing.T) {
+	dir := t.TempDir()
+	writeTestFile(t, dir, "docs/layers.yaml", "forbidden:\n  - import: github.com/pkg/errors\n")
+	writeTestFile(t, dir, "main.go", "package main\n\nimport \"github.com/pkg/errors\"\n\nvar _ = errors.New\n")
+
+	c := &ArchitectureCollector{}
+	signals, err := c.Collect(context.Background(), dir, signal.CollectorOpts{ArchitectureRules: "docs/layers.yaml"})
+	require.NoError(t, err)
+	require.Len(t, signals, 1)
+	assert.Equal(t, "main.go", signals[0].FilePath)
+	assert.Equal(t, 3, signals[0].Line)
+	assert.Equal(t, []string{"architecture-violation", "forbidden-import"}, signals[0].Tags, "no layer tag outside layers")
+}
+
+func TestParseArchitectureRules_Errors(t *testing.T) {
+	tests := []struct {
+		name, yaml, want string
+	}{
+		{"unknown field", "layer: []\n", "field layer not found"},
+		{"unnamed layer", "layers:\n  - paths: [a]\n", "layer 1 needs a name"},
+		{"duplicate", "layers:\n  - {name: a, paths: [a]}\n  - {name: a, paths: [b]}\n", `duplicate layer "a"`},
+		{"no paths", "layers:\n  - name: a\n", `layer "a" has no paths`},
+		{"unknown dep", "layers:\n  - {name: a, paths: [a], may_import: [b]}\n", `may_import unknown layer "b"`},
+		{"empty import", "forbidden:\n  - reason: x\n", "forbidden entry 1 needs an import"},
+		{"unknown forbidden layer", "forbidden:\n  - {import: x, layers: [b]}\n", `names unknown layer "b"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseArchitectureRules([]byte(tt.yaml))
+			assert.ErrorContains(t, err, tt.want)
+		})
+	}
+}
+
+func TestArchitectureRules_LayerFor(t *testing.T) {
+	rules, err := parseArchitectureRules([]byte(testArchitectureRules + "  - import: x\n"))
+	require.NoError(t, err)
+
+	assert.Equal(t, "web-api", rules.layerFor("web/src/api/client.ts").Name, "longest path wins")
+	assert.Equal(t, "web", rules.layerFor("web/src/ui").Name)
+	assert.Equal(t, "app", rules.layerFor("internal/app").Name)
+	assert.Nil(t, rules.layerFor("internal/application/x.go"), "prefix matches whole segments")
+	assert.Nil(t, rules.layerFor("README.md"))
+}
+
+func TestResolveImportTarget(t *testing.T) {
+	tests := []struct {
+		file, spec, want string
+		ok               bool
+	}{
+		{"cmd/main.go", "example.com/app/internal/app", "internal/app", true},
+		{"cmd/main.go", "example.com/app", ".", true},
+		{"cmd/main.go", "example.com/application/x", "", false},
+		{"cmd/main.go", "fmt", "", false},
+		{"web/src/a/b.ts", "../c/d", "web/src/c/d", true},
+		{"web/src/a/b.ts", "./e.js", "web/src/a/e", true},
+		{"web/src/a/b.ts", "./user.service", "web/src/a/user.service", true},
+		{"web/src/a/b.ts", "react", "", false},
+		{"b.ts", "../outside", "", false},
+	}
+	for _, tt := range tests {
+		got, ok := resolveImportTarget(tt.file, tt.spec, "example.com/app")
+		assert.Equal(t, tt.ok, ok, "%s imports %s", tt.file, tt.spec)
+		assert.Equal(t, tt.want, got, "%s imports %s", tt.file, tt.spec)
+	}
+}
+
+func TestJSImportRefs(t *testing.T) {
+	refs := jsImportRefs([]byte(`import a from 'a';
+import 'side-effect';
+const b = require("b");
+const c = await import('./c');
+export { d } from './d';
+import {
+  e,
+} from "e";
+const notAnImport = "from 'x'";
+`))
+	var specs []string
+	for _, r := range refs {
+		specs = append(specs, r.Spec)
+	}
+	assert.Equal(t, []string{"a", "side-effect", "b", "./c", "./d", "e"}, specs)
+	assert.Equal(t, 8, refs[5].Line)
+}
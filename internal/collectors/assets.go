@@ -0,0 +1,394 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package collectors
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/davetashner/stringer/internal/collector"
+	"github.com/davetashner/stringer/internal/gitcli"
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+// lfsCandidateMinVersions is the number of committed versions of a binary
+// under the size threshold that makes it an LFS candidate: every version
+// stays in every clone.
+const lfsCandidateMinVersions = 3
+
+// generatedArtifactDirs are directories that hold build output, caches, or
+// installed dependencies and do not belong in version control.
+var generatedArtifactDirs = map[string]bool{
+	"node_modules":  true,
+	"__pycache__":   true,
+	".pytest_cache": true,
+	".mypy_cache":   true,
+	".next":         true,
+	".nuxt":         true,
+	".gradle":       true,
+	".tox":          true,
+	".venv":         true,
+	".terraform":    true,
+}
+
+// generatedArtifactExts are extensions of compiled objects and packaged
+// build output.
+var generatedArtifactExts = map[string]bool{
+	".o": true, ".obj": true, ".a": true, ".so": true, ".dylib": true,
+	".dll": true, ".exe": true, ".class": true, ".pyc": true, ".pyo": true,
+	".jar": true, ".war": true, ".ear": true, ".whl": true, ".egg": true,
+}
+
+func init() {
+	collector.Register(&AssetsCollector{})
+}
+
+// AssetsMetrics holds structured metrics from the asset scan.
+type AssetsMetrics struct {
+	TrackedFiles       int
+	TrackedBytes       int64
+	HistoryBlobs       int
+	HistoryBytes       int64
+	LFSCandidates      int
+	GeneratedArtifacts int
+	HistoryBloat       int
+}
+
+// AssetsCollector looks at what git stores rather than the working tree:
+// binaries that are rewritten often enough to belong in Git LFS, build
+// output checked into the tree, and large files that were deleted but still
+// weigh down every clone. Signals carry the object size and the path's
+// total size across history so teams can plan LFS migrations and history
+// rewrites. Large binaries at HEAD are left to githygiene.
+type AssetsCollector struct {
+	metrics *AssetsMetrics
+}
+
+// Name returns the collector name used for registration and filtering.
+func (c *AssetsCollector) Name() string { return "assets" }
+
+// CheckCapabilities reports a skip reason when there is no git history.
+func (c *AssetsCollector) CheckCapabilities(_ context.Context, repoPath string, opts signal.CollectorOpts) string {
+	gitRoot := repoPath
+	if opts.GitRoot != "" {
+		gitRoot = opts.GitRoot
+	}
+	return gitHistoryReason(nil, gitRoot)
+}
+
+// pathHistory is the size of one path across the walked history.
+type pathHistory struct {
+	versions int
+	bytes    int64
+}
+
+// trackedBlob is one file in the HEAD tree.
+type trackedBlob struct {
+	path string // relative to the scanned directory, slash-separated
+	size int64
+}
+
+// Collect lists the HEAD tree and the blobs reachable from any ref, then
+// emits repo-hygiene signals for LFS candidates, generated artifacts, and
+// deleted large files.
+func (c *AssetsCollector) Collect(ctx context.Context, repoPath string, opts signal.CollectorOpts) ([]signal.RawSignal, error) {
+	metrics := &AssetsMetrics{}
+	c.metrics = metrics
+
+	gitRoot := repoPath
+	if opts.GitRoot != "" {
+		gitRoot = opts.GitRoot
+	}
+	prefix := ""
+	if rel, err := filepath.Rel(gitRoot, repoPath); err == nil && rel != "." {
+		prefix = filepath.ToSlash(rel) + "/"
+	}
+	threshold := int64(opts.LargeBinaryThreshold)
+	if threshold == 0 {
+		threshold = defaultLargeBinaryThreshold
+	}
+
+	tree, err := headTree(ctx, gitRoot, prefix)
+	if err != nil {
+		return nil, err
+	}
+	history, err := blobHistory(ctx, gitRoot, prefix, opts.GitDepth, metrics)
+	if err != nil {
+		return nil, err
+	}
+
+	excludes := mergeExcludes(opts.ExcludePatterns)
+	wanted := func(rel string, excludes []string) bool {
+		if shouldExclude(rel, excludes) || !opts.Scope.Contains(rel) {
+			return false
+		}
+		return len(opts.IncludePatterns) == 0 || matchesAny(rel, opts.IncludePatterns)
+	}
+	lfsPatterns := parseLFSPatterns(gitRoot)
+
+	var signals []signal.RawSignal
+	emit := func(sig signal.RawSignal) bool {
+		if sig.Confidence < opts.MinConfidence {
+			return false
+		}
+		signals = append(signals, sig)
+		return true
+	}
+
+	artifactDirs := make(map[string]*pathHistory)
+	atHead := make(map[string]bool, len(tree))
+	for _, b := range tree {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		atHead[b.path] = true
+		metrics.TrackedFiles++
+		metrics.TrackedBytes += b.size
+
+		// Build output is flagged even under the default excludes, which
+		// skip node_modules and the like precisely because they are not
+		// source.
+		if dir := artifactDir(b.path); dir != "" {
+			if wanted(b.path, opts.ExcludePatterns) {
+				d := artifactDirs[dir]
+				if d == nil {
+					d = &pathHistory{}
+					artifactDirs[dir] = d
+				}
+				d.versions++
+				d.bytes += b.size
+			}
+			continue
+		}
+		if !wanted(b.path, excludes) {
+			continue
+		}
+		h := history[b.path]
+		if h.versions == 0 {
+			// The blob was first reached under another path.
+			h = pathHistory{versions: 1, bytes: b.size}
+		}
+		if generatedArtifactExts[strings.ToLower(path.Ext(b.path))] {
+			if emit(generatedArtifactSignal(b, h)) {
+				metrics.GeneratedArtifacts++
+			}
+			continue
+		}
+		if isLFSTracked(b.path, lfsPatterns) {
+			continue
+		}
+		// Binaries over the threshold are githygiene's large-binary signals.
+		if b.size < threshold && h.versions >= lfsCandidateMinVersions && h.bytes >= threshold {
+			if isBinaryBlob(filepath.Join(repoPath, filepath.FromSlash(b.path))) && emit(lfsCandidateSignal(b, h)) {
+				metrics.LFSCandidates++
+			}
+		}
+	}
+
+	for dir, d := range artifactDirs {
+		if emit(generatedDirSignal(dir, d.versions, d.bytes)) {
+			metrics.GeneratedArtifacts++
+		}
+	}
+
+	for p, h := range history {
+		if atHead[p] || h.bytes < threshold || !wanted(p, excludes) {
+			continue
+		}
+		if emit(historyBloatSignal(p, h)) {
+			metrics.HistoryBloat++
+		}
+	}
+
+	sort.Slice(signals, func(i, j int) bool {
+		return signals[i].FilePath < signals[j].FilePath
+	})
+	return signals, nil
+}
+
+// headTree lists the blobs of the HEAD tree under prefix with their sizes.
+// An empty repository has no HEAD and yields no blobs.
+func headTree(ctx context.Context, gitRoot, prefix string) ([]trackedBlob, error) {
+	if _, err := gitcli.Exec(ctx, gitRoot, "rev-parse", "--verify", "--quiet", "HEAD"); err != nil {
+		return nil, nil //nolint:nilerr // no commits yet
+	}
+	out, err := gitcli.Exec(ctx, gitRoot, "ls-tree", "-r", "-l", "-z", "--full-tree", "HEAD")
+	if err != nil {
+		return nil, fmt.Errorf("listing HEAD tree: %w", err)
+	}
+	var blobs []trackedBlob
+	for _, entry := range strings.Split(out, "\x00") {
+		// <mode> SP <type> SP <object> SP <size padded> TAB <path>
+		meta, name, ok := strings.Cut(entry, "\t")
+		if !ok || !strings.HasPrefix(name, prefix) {
+			continue
+		}
+		fields := strings.Fields(meta)
+		if len(fields) != 4 || fields[1] != "blob" {
+			continue // submodules and malformed entries
+		}
+		size, err := strconv.ParseInt(fields[3], 10, 64)
+		if err != nil {
+			continue
+		}
+		blobs = append(blobs, trackedBlob{path: strings.TrimPrefix(name, prefix), size: size})
+	}
+	return blobs, nil
+}
+
+// blobHistory returns, per path under prefix, the number of distinct blobs
+// reachable from any ref and their total size. depth limits the commits
+// walked when positive.
+func blobHistory(ctx context.Context, gitRoot, prefix string, depth int, metrics *AssetsMetrics) (map[string]pathHistory, error) {
+	history := make(map[string]pathHistory)
+	if _, err := gitcli.Exec(ctx, gitRoot, "rev-parse", "--verify", "--quiet", "HEAD"); err != nil {
+		return history, nil //nolint:nilerr // no commits yet
+	}
+
+	sizesOut, err := gitcli.Exec(ctx, gitRoot, "cat-file", "--batch-all-objects", "--batch-check=%(objectname) %(objecttype) %(objectsize)")
+	if err != nil {
+		return nil, fmt.Errorf("listing objects: %w", err)
+	}
+	sizes := make(map[string]int64)
+	for _, line := range strings.Split(sizesOut, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) != 3 || fields[1] != "blob" {
+			continue
+		}
+		if size, err := strconv.ParseInt(fields[2], 10, 64); err == nil {
+			sizes[fields[0]] = size
+		}
+	}
+
+	args := []string{"rev-list", "--objects", "--all"}
+	if depth > 0 {
+		args = append(args, fmt.Sprintf("--max-count=%d", depth))
+	}
+	objectsOut, err := gitcli.Exec(ctx, gitRoot, args...)
+	if err != nil {
+		return nil, fmt.Errorf("listing reachable objects: %w", err)
+	}
+	for _, line := range strings.Split(objectsOut, "\n") {
+		hash, name, ok := strings.Cut(line, " ")
+		if !ok || !strings.HasPrefix(name, prefix) {
+			continue
+		}
+		size, isBlob := sizes[hash]
+		if !isBlob {
+			continue // a tree
+		}
+		rel := strings.TrimPrefix(name, prefix)
+		h := history[rel]
+		h.versions++
+		h.bytes += size
+		history[rel] = h
+		metrics.HistoryBlobs++
+		metrics.HistoryBytes += size
+	}
+	return history, nil
+}
+
+// artifactDir returns the path of the first build-output directory that
+// contains p, or "".
+func artifactDir(p string) string {
+	parts := strings.Split(p, "/")
+	for i, part := range parts[:len(parts)-1] {
+		if generatedArtifactDirs[part] {
+			return strings.Join(parts[:i+1], "/")
+		}
+	}
+	return ""
+}
+
+// isBinaryBlob reports whether the working-tree copy of a tracked file is
+// binary. A file missing from the working tree, as in a sparse checkout, is
+// not judged.
+func isBinaryBlob(absPath string) bool {
+	if _, err := FS.Stat(absPath); err != nil {
+		return false
+	}
+	return isBinaryFile(absPath)
+}
+
+// historyNote describes a path's weight across history.
+func historyNote(h pathHistory) string {
+	if h.versions <= 1 {
+		return "It has one version in history."
+	}
+	return fmt.Sprintf("History holds %d versions totaling %s, all downloaded by every clone.", h.versions, humanSize(h.bytes))
+}
+
+// lfsCandidateSignal flags a binary under the size threshold that has been
+// committed so often its history outweighs the threshold.
+func lfsCandidateSignal(b trackedBlob, h pathHistory) signal.RawSignal {
+	return signal.RawSignal{
+		Source:   "assets",
+		Kind:     "repo-hygiene",
+		FilePath: b.path,
+		Title:    fmt.Sprintf("LFS candidate: %s (%d versions, %s in history)", b.path, h.versions, humanSize(h.bytes)),
+		Description: fmt.Sprintf("The object at HEAD is %s. %s Binaries don't diff or compress across versions; "+
+			"track it with Git LFS so clones fetch only the version they check out.", humanSize(b.size), historyNote(h)),
+		Confidence: 0.5,
+		Tags:       []string{"repo-hygiene", "lfs-candidate"},
+	}
+}
+
+// generatedArtifactSignal flags a compiled object or package checked in.
+func generatedArtifactSignal(b trackedBlob, h pathHistory) signal.RawSignal {
+	return signal.RawSignal{
+		Source:   "assets",
+		Kind:     "repo-hygiene",
+		FilePath: b.path,
+		Title:    fmt.Sprintf("Generated artifact committed: %s (%s)", b.path, humanSize(b.size)),
+		Description: fmt.Sprintf("%s is build output (%s at HEAD). %s Build it in CI or publish it as a release "+
+			"artifact, then remove it and add it to .gitignore.", b.path, humanSize(b.size), historyNote(h)),
+		Confidence: 0.6,
+		Tags:       []string{"repo-hygiene", "generated-artifact"},
+	}
+}
+
+// generatedDirSignal flags a build-output or dependency directory checked
+// in, as one signal for all its files.
+func generatedDirSignal(dir string, files int, bytes int64) signal.RawSignal {
+	return signal.RawSignal{
+		Source:   "assets",
+		Kind:     "repo-hygiene",
+		FilePath: dir,
+		Title:    fmt.Sprintf("Build output committed: %s/ (%d files, %s)", dir, files, humanSize(bytes)),
+		Description: fmt.Sprintf("%s/ holds generated files or installed dependencies: %d tracked files, %s at HEAD. "+
+			"Remove it with git rm -r --cached and add it to .gitignore.", dir, files, humanSize(bytes)),
+		Confidence: 0.7,
+		Tags:       []string{"repo-hygiene", "generated-artifact"},
+	}
+}
+
+// historyBloatSignal flags a path deleted from HEAD whose history is still
+// over the size threshold. Reclaiming the space needs a history rewrite,
+// so it ranks lowest.
+func historyBloatSignal(p string, h pathHistory) signal.RawSignal {
+	return signal.RawSignal{
+		Source:   "assets",
+		Kind:     "repo-hygiene",
+		FilePath: p,
+		Title:    fmt.Sprintf("Large file in history: %s (%s, deleted)", p, humanSize(h.bytes)),
+		Description: fmt.Sprintf("%s is no longer in the tree, but every clone still downloads it: %d version(s) totaling %s. "+
+			"Only a history rewrite (git filter-repo --path %q --invert-paths) reclaims the space.",
+			p, h.versions, humanSize(h.bytes), p),
+		Confidence: 0.4,
+		Tags:       []string{"repo-hygiene", "history-bloat"},
+	}
+}
+
+// Metrics returns structured metrics from the asset scan.
+func (c *AssetsCollector) Metrics() any { return c.metrics }
+
+// Compile-time interface checks.
+var _ collector.Collector = (*AssetsCollector)(nil)
+var _ collector.MetricsProvider = (*AssetsCollector)(nil)
+var _ collector.CapabilityChecker = (*AssetsCollector)(nil)
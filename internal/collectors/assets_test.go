@@ -0,0 +1,149 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package collectors
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+// binaryContent returns n bytes of binary data that differ by seed.
+func binaryContent(n int, seed byte) string {
+	return "\x00" + strings.Repeat(string([]byte{seed, 0x01}), n/2)[:n-1]
+}
+
+func writeAndCommit(t *testing.T, dir string, files map[string]string, msg string) {
+	t.Helper()
+	for relPath, content := range files {
+		absPath := filepath.Join(dir, relPath)
+		require.NoError(t, os.MkdirAll(filepath.Dir(absPath), 0o750))
+		require.NoError(t, os.WriteFile(absPath, []byte(content), 0o600))
+		runGit(t, dir, "add", relPath)
+	}
+	runGit(t, dir, "commit", "-m", msg)
+}
+
+func initAssetsRepo(t *testing.T) string {
+	t.Helper()
+	dir := initTestGitRepo(t, map[string]string{
+		"main.go":        "package main\n",
+		".gitattributes": "*.psd filter=lfs diff=lfs merge=lfs -text\n",
+	})
+	writeAndCommit(t, dir, map[string]string{
+		"assets/big.bin":                 binaryContent(2000, 'a'),
+		"assets/icon.png":                binaryContent(400, 'a'),
+		"assets/design.psd":              binaryContent(2000, 'd'),
+		"notes.txt":                      strings.Repeat("plain text\n", 200),
+		"lib/native.so":                  binaryContent(100, 's'),
+		"web/node_modules/left/index.js": "module.exports = 1;\n",
+		"web/node_modules/pad/index.js":  "module.exports = 2;\n",
+		"vendor/blob.bin":                binaryContent(2000, 'v'),
+		"old.iso":                        binaryContent(3000, 'i'),
+	}, "add assets")
+	writeAndCommit(t, dir, map[string]string{"assets/icon.png": binaryContent(400, 'b')}, "new icon")
+	writeAndCommit(t, dir, map[string]string{"assets/icon.png": binaryContent(400, 'c')}, "newer icon")
+	runGit(t, dir, "rm", "-q", "old.iso")
+	runGit(t, dir, "commit", "-m", "drop iso")
+	return dir
+}
+
+func TestAssetsCollector_Name(t *testing.T) {
+	assert.Equal(t, "assets", (&AssetsCollector{}).Name())
+}
+
+func TestAssetsCollector_Collect(t *testing.T) {
+	dir := initAssetsRepo(t)
+
+	c := &AssetsCollector{}
+	signals, err := c.Collect(context.Background(), dir, signal.CollectorOpts{LargeBinaryThreshold: 1000})
+	require.NoError(t, err)
+
+	titles := make([]string, 0, len(signals))
+	byPath := make(map[string]signal.RawSignal)
+	for _, s := range signals {
+		assert.Equal(t, "assets", s.Source)
+		assert.Equal(t, "repo-hygiene", s.Kind)
+		titles = append(titles, s.Title)
+		byPath[s.FilePath] = s
+	}
+	assert.Equal(t, []string{
+		"LFS candidate: assets/icon.png (3 versions, 1.2 KB in history)",
+		"Generated artifact committed: lib/native.so (100 B)",
+		"Large file in history: old.iso (3.0 KB, deleted)",
+		"Build output committed: web/node_modules/ (2 files, 40 B)",
+	}, titles, "LFS-tracked, text, and default-excluded files are skipped")
+	assert.NotContains(t, byPath, "assets/big.bin", "githygiene reports large binaries at HEAD")
+
+	assert.Contains(t, byPath["assets/icon.png"].Description, "History holds 3 versions totaling 1.2 KB")
+	assert.Equal(t, []string{"repo-hygiene", "history-bloat"}, byPath["old.iso"].Tags)
+	assert.Equal(t, []string{"repo-hygiene", "generated-artifact"}, byPath["web/node_modules"].Tags)
+
+	m, ok := c.Metrics().(*AssetsMetrics)
+	require.True(t, ok)
+	assert.Equal(t, 10, m.TrackedFiles)
+	assert.Equal(t, 1, m.LFSCandidates)
+	assert.Equal(t, 2, m.GeneratedArtifacts)
+	assert.Equal(t, 1, m.HistoryBloat)
+	assert.Greater(t, m.HistoryBytes, m.TrackedBytes)
+}
+
+func TestAssetsCollector_Filters(t *testing.T) {
+	dir := initAssetsRepo(t)
+
+	signals, err := (&AssetsCollector{}).Collect(context.Background(), dir, signal.CollectorOpts{
+		LargeBinaryThreshold: 1000,
+		ExcludePatterns:      []string{"web/**"},
+		MinConfidence:        0.6,
+	})
+	require.NoError(t, err)
+	var paths []string
+	for _, s := range signals {
+		paths = append(paths, s.FilePath)
+	}
+	assert.Equal(t, []string{"lib/native.so"}, paths)
+
+	signals, err = (&AssetsCollector{}).Collect(context.Background(), dir, signal.CollectorOpts{
+		LargeBinaryThreshold: 1000,
+		Scope:                signal.NewScope([]string{"assets"}, 0),
+	})
+	require.NoError(t, err)
+	require.Len(t, signals, 1)
+	assert.Equal(t, "assets/icon.png", signals[0].FilePath)
+}
+
+func TestAssetsCollector_Subdirectory(t *testing.T) {
+	dir := initAssetsRepo(t)
+
+	signals, err := (&AssetsCollector{}).Collect(context.Background(), filepath.Join(dir, "assets"), signal.CollectorOpts{
+		LargeBinaryThreshold: 1000,
+		GitRoot:              dir,
+	})
+	require.NoError(t, err)
+	require.Len(t, signals, 1)
+	assert.Equal(t, "icon.png", signals[0].FilePath, "paths are relative to the scanned directory")
+}
+
+func TestAssetsCollector_EmptyRepo(t *testing.T) {
+	dir := t.TempDir()
+	runGit(t, dir, "init")
+
+	signals, err := (&AssetsCollector{}).Collect(context.Background(), dir, signal.CollectorOpts{})
+	require.NoError(t, err)
+	assert.Empty(t, signals)
+}
+
+func TestArtifactDir(t *testing.T) {
+	assert.Equal(t, "node_modules", artifactDir("node_modules/a/b.js"))
+	assert.Equal(t, "src/__pycache__", artifactDir("src/__pycache__/m.cpython-312.pyc"))
+	assert.Empty(t, artifactDir("src/node_modules.go"))
+	assert.Empty(t, artifactDir("node_modules"))
+}
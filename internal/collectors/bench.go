@@ -0,0 +1,217 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package collectors
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/davetashner/stringer/internal/bench"
+	"github.com/davetashner/stringer/internal/collector"
+	"github.com/davetashner/stringer/internal/gitcli"
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+func init() {
+	collector.Register(&BenchCollector{})
+}
+
+// BenchMetrics holds structured metrics from the benchmark comparison.
+type BenchMetrics struct {
+	HeadCommit  string
+	BaseCommit  string
+	Benchmarks  int
+	Regressions int
+}
+
+// BenchCollector compares the benchmark results recorded at HEAD by
+// `stringer bench record` with those of a baseline commit, by default the
+// nearest first-parent ancestor with results, and emits a perf-regression
+// signal for each benchmark that got slower or allocates more by over the
+// threshold. It does nothing until results are recorded at HEAD.
+type BenchCollector struct {
+	metrics *BenchMetrics
+}
+
+// Name returns the collector name used for registration and filtering.
+func (c *BenchCollector) Name() string { return "bench" }
+
+// CheckCapabilities reports a skip reason when there is no git history.
+func (c *BenchCollector) CheckCapabilities(_ context.Context, repoPath string, opts signal.CollectorOpts) string {
+	gitRoot := repoPath
+	if opts.GitRoot != "" {
+		gitRoot = opts.GitRoot
+	}
+	return gitHistoryReason(nil, gitRoot)
+}
+
+// Collect compares HEAD's recorded results with the baseline's.
+func (c *BenchCollector) Collect(ctx context.Context, repoPath string, opts signal.CollectorOpts) ([]signal.RawSignal, error) {
+	metrics := &BenchMetrics{}
+	c.metrics = metrics
+
+	gitRoot := repoPath
+	if opts.GitRoot != "" {
+		gitRoot = opts.GitRoot
+	}
+	if gitcli.Available() != nil || !isGitRepo(gitRoot) {
+		return nil, nil
+	}
+	head, err := bench.ResolveCommit(ctx, gitRoot, "HEAD")
+	if err != nil {
+		return nil, nil // no commits yet
+	}
+	headRun, err := bench.Load(gitRoot, head)
+	if err != nil || headRun == nil {
+		return nil, err
+	}
+	metrics.HeadCommit = head
+
+	var base string
+	if opts.BenchBase != "" {
+		if base, err = bench.ResolveCommit(ctx, gitRoot, opts.BenchBase); err != nil {
+			return nil, fmt.Errorf("bench_base: %w", err)
+		}
+	} else if base, err = bench.NearestRecorded(ctx, gitRoot, head); err != nil {
+		return nil, err
+	}
+	if base == "" || base == head {
+		return nil, nil
+	}
+	baseRun, err := bench.Load(gitRoot, base)
+	if err != nil {
+		return nil, err
+	}
+	if baseRun == nil {
+		slog.WarnContext(ctx, "no benchmark results recorded at the baseline", "base", base)
+		return nil, nil
+	}
+	if err := bench.Comparable(baseRun, headRun); err != nil {
+		slog.WarnContext(ctx, "cannot compare benchmark results", "base", base, "head", head, "error", err)
+		return nil, nil
+	}
+	metrics.BaseCommit = base
+
+	threshold := opts.BenchThreshold
+	if threshold == 0 {
+		threshold = bench.DefaultThreshold
+	}
+	changes := bench.Compare(baseRun, headRun)
+	regressions := bench.Regressions(changes, threshold)
+
+	compared := make(map[string]bool)
+	for _, ch := range changes {
+		compared[ch.Package+" "+ch.Name] = true
+	}
+	metrics.Benchmarks = len(compared)
+
+	// One signal per benchmark, listing every unit that regressed.
+	var order []string
+	byBenchmark := make(map[string][]bench.Change)
+	for _, r := range regressions {
+		key := r.Package + " " + r.Name
+		if len(byBenchmark[key]) == 0 {
+			order = append(order, key)
+		}
+		byBenchmark[key] = append(byBenchmark[key], r)
+	}
+
+	modulePath := readGoModulePath(repoPath)
+	excludes := mergeExcludes(opts.ExcludePatterns)
+	var signals []signal.RawSignal
+	for _, key := range order {
+		regressed := byBenchmark[key]
+		relPath, line := locateBenchmark(repoPath, modulePath, regressed[0].Package, regressed[0].Name)
+		if relPath != "" && (shouldExclude(relPath, excludes) || !opts.Scope.Contains(relPath)) {
+			continue
+		}
+		metrics.Regressions++
+		sig := perfRegressionSignal(relPath, line, shortHash(base), regressed)
+		if sig.Confidence >= opts.MinConfidence {
+			signals = append(signals, sig)
+		}
+	}
+	return signals, nil
+}
+
+// locateBenchmark finds the file and line declaring a benchmark of
+// importPath, when the package belongs to the module in repoPath. It
+// falls back to the package directory, and to "" for packages elsewhere.
+func locateBenchmark(repoPath, modulePath, importPath, name string) (string, int) {
+	if modulePath == "" || (importPath != modulePath && !strings.HasPrefix(importPath, modulePath+"/")) {
+		return "", 0
+	}
+	dir := strings.TrimPrefix(strings.TrimPrefix(importPath, modulePath), "/")
+	if dir == "" {
+		dir = "."
+	}
+	fn, _, _ := strings.Cut(name, "/") // sub-benchmarks live in their parent
+
+	entries, err := os.ReadDir(filepath.Join(repoPath, filepath.FromSlash(dir)))
+	if err != nil {
+		return "", 0 // moved or removed since
+	}
+	for _, e := range entries {
+		if e.IsDir() || !strings.HasSuffix(e.Name(), "_test.go") {
+			continue
+		}
+		relPath := filepath.ToSlash(filepath.Join(dir, e.Name()))
+		data, err := FS.ReadFile(filepath.Join(repoPath, relPath))
+		if err != nil {
+			continue
+		}
+		for i, l := range strings.Split(string(data), "\n") {
+			if strings.HasPrefix(l, "func "+fn+"(") {
+				return relPath, i + 1
+			}
+		}
+	}
+	return dir, 0
+}
+
+// perfRegressionSignal reports the units of one benchmark that regressed
+// against the baseline. Regressions of 50% or more, and allocations where
+// there were none, rank higher.
+func perfRegressionSignal(relPath string, line int, base string, regressed []bench.Change) signal.RawSignal {
+	worst := regressed[0]
+	lines := make([]string, 0, len(regressed)+1)
+	for _, r := range regressed {
+		if r.Delta() > worst.Delta() {
+			worst = r
+		}
+		lines = append(lines, fmt.Sprintf("- %s: %s", r.Unit, r))
+	}
+	confidence := 0.5
+	if worst.Delta() >= 0.5 {
+		confidence = 0.7
+	}
+	growth := "new"
+	if d := worst.Delta(); !math.IsInf(d, 0) {
+		growth = fmt.Sprintf("%+.0f%%", d*100)
+	}
+	desc := fmt.Sprintf("%s in %s regressed against %s:\n%s", worst.Name, worst.Package, base, strings.Join(lines, "\n"))
+	return signal.RawSignal{
+		Source:      "bench",
+		Kind:        "perf-regression",
+		FilePath:    relPath,
+		Line:        line,
+		Title:       fmt.Sprintf("Benchmark regressed: %s (%s %s)", worst.Name, growth, worst.Unit),
+		Description: desc,
+		Confidence:  confidence,
+		Tags:        []string{"perf-regression", "benchmark"},
+	}
+}
+
+// Metrics returns structured metrics from the benchmark comparison.
+func (c *BenchCollector) Metrics() any { return c.metrics }
+
+// Compile-time interface checks.
+var _ collector.Collector = (*BenchCollector)(nil)
+var _ collector.MetricsProvider = (*BenchCollector)(nil)
+var _ collector.CapabilityChecker = (*BenchCollector)(nil)
@@ -0,0 +1,117 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package collectors
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davetashner/stringer/internal/bench"
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+func TestBenchCollector_Name(t *testing.T) {
+	assert.Equal(t, "bench", (&BenchCollector{}).Name())
+}
+
+// recordBench stores go test -bench output as the run for commit.
+func recordBench(t *testing.T, dir, commit, output string) {
+	t.Helper()
+	run, err := bench.Parse(strings.NewReader("goos: linux\ngoarch: amd64\ncpu: EPYC\npkg: example.com/svc/cache\n" + output))
+	require.NoError(t, err)
+	run.Commit = commit
+	require.NoError(t, bench.Save(dir, run))
+}
+
+func headCommit(t *testing.T, dir string) string {
+	t.Helper()
+	commit, err := bench.ResolveCommit(context.Background(), dir, "HEAD")
+	require.NoError(t, err)
+	return commit
+}
+
+func TestBenchCollector_Regression(t *testing.T) {
+	dir := initTestGitRepo(t, map[string]string{
+		"go.mod":              "module example.com/svc\n",
+		"cache/cache.go":      "package cache\n",
+		"cache/cache_test.go": "package cache\n\nimport \"testing\"\n\nfunc BenchmarkGet(b *testing.B) {\n\tb.Run(\"hit\", func(b *testing.B) {})\n}\n\nfunc BenchmarkPut(b *testing.B) {}\n",
+	})
+	base := headCommit(t, dir)
+	recordBench(t, dir, base, `BenchmarkGet/hit-8   1000   100 ns/op   0 B/op   0 allocs/op
+BenchmarkGet/hit-8   1000   102 ns/op   0 B/op   0 allocs/op
+BenchmarkPut-8       1000   500 ns/op  64 B/op   1 allocs/op
+BenchmarkPut-8       1000   510 ns/op  64 B/op   1 allocs/op
+`)
+	writeAndCommit(t, dir, map[string]string{"cache/cache.go": "package cache\n\n// v2\n"}, "Change cache")
+	writeAndCommit(t, dir, map[string]string{"README.md": "# svc\n"}, "Docs")
+	recordBench(t, dir, headCommit(t, dir), `BenchmarkGet/hit-8   1000   160 ns/op  16 B/op   1 allocs/op
+BenchmarkGet/hit-8   1000   170 ns/op  16 B/op   1 allocs/op
+BenchmarkPut-8       1000   505 ns/op  64 B/op   1 allocs/op
+BenchmarkPut-8       1000   520 ns/op  64 B/op   1 allocs/op
+`)
+
+	c := &BenchCollector{}
+	signals, err := c.Collect(context.Background(), dir, signal.CollectorOpts{})
+	require.NoError(t, err)
+	require.Len(t, signals, 1)
+
+	sig := signals[0]
+	assert.Equal(t, "bench", sig.Source)
+	assert.Equal(t, "perf-regression", sig.Kind)
+	assert.Equal(t, "Benchmark regressed: BenchmarkGet/hit (new B/op)", sig.Title)
+	assert.Equal(t, "cache/cache_test.go", sig.FilePath)
+	assert.Equal(t, 5, sig.Line)
+	assert.InDelta(t, 0.7, sig.Confidence, 0.001)
+	assert.Equal(t, "BenchmarkGet/hit in example.com/svc/cache regressed against "+shortHash(base)+":\n"+
+		"- ns/op: 101ns → 165ns (+63.4%)\n"+
+		"- B/op: 0B → 16B (new)\n"+
+		"- allocs/op: 0 allocs/op → 1 allocs/op (new)", sig.Description)
+
+	m := c.Metrics().(*BenchMetrics)
+	assert.Equal(t, base, m.BaseCommit)
+	assert.Equal(t, 2, m.Benchmarks)
+	assert.Equal(t, 1, m.Regressions)
+}
+
+func TestBenchCollector_ConfiguredBaseAndThreshold(t *testing.T) {
+	dir := initTestGitRepo(t, map[string]string{"go.mod": "module example.com/svc\n"})
+	runGit(t, dir, "tag", "v1.0.0")
+	recordBench(t, dir, headCommit(t, dir), "BenchmarkPut-8 1000 500 ns/op\nBenchmarkPut-8 1000 500 ns/op\n")
+	writeAndCommit(t, dir, map[string]string{"a.go": "package svc\n"}, "Change")
+	recordBench(t, dir, headCommit(t, dir), "BenchmarkPut-8 1000 600 ns/op\nBenchmarkPut-8 1000 600 ns/op\n")
+
+	collect := func(opts signal.CollectorOpts) []signal.RawSignal {
+		signals, err := (&BenchCollector{}).Collect(context.Background(), dir, opts)
+		require.NoError(t, err)
+		return signals
+	}
+	signals := collect(signal.CollectorOpts{BenchBase: "v1.0.0"})
+	require.Len(t, signals, 1)
+	assert.Equal(t, "Benchmark regressed: BenchmarkPut (+20% ns/op)", signals[0].Title)
+	assert.Empty(t, signals[0].FilePath, "the package directory does not exist")
+	assert.InDelta(t, 0.5, signals[0].Confidence, 0.001)
+
+	assert.Empty(t, collect(signal.CollectorOpts{BenchBase: "v1.0.0", BenchThreshold: 0.25}))
+
+	_, err := (&BenchCollector{}).Collect(context.Background(), dir, signal.CollectorOpts{BenchBase: "v9"})
+	assert.ErrorContains(t, err, "bench_base")
+}
+
+func TestBenchCollector_NothingRecorded(t *testing.T) {
+	dir := initTestGitRepo(t, map[string]string{"go.mod": "module example.com/svc\n"})
+	c := &BenchCollector{}
+	signals, err := c.Collect(context.Background(), dir, signal.CollectorOpts{})
+	require.NoError(t, err)
+	assert.Empty(t, signals)
+	assert.Empty(t, c.Metrics().(*BenchMetrics).HeadCommit)
+
+	recordBench(t, dir, headCommit(t, dir), "BenchmarkPut-8 1000 500 ns/op\n")
+	signals, err = c.Collect(context.Background(), dir, signal.CollectorOpts{})
+	require.NoError(t, err)
+	assert.Empty(t, signals, "no baseline")
+}
@@ -0,0 +1,64 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package collectors
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"time"
+
+	"github.com/davetashner/stringer/internal/testable"
+)
+
+// Skip reasons reported by collector capability checks. They are shared so
+// the scan summary reads the same way whichever collector is affected.
+const (
+	reasonNoToken      = "no token (set GITHUB_TOKEN)"
+	reasonNoGitHistory = "no git history"
+	reasonNoNetwork    = "no network"
+
+	reasonNoNetworkBuild = "no network (built with the nonetwork tag)"
+)
+
+// networkProbeTimeout bounds the DNS lookup used to detect network access.
+const networkProbeTimeout = 3 * time.Second
+
+// lookupHost resolves a host name for the network capability check. Tests
+// replace it to simulate an offline machine.
+var lookupHost = net.DefaultResolver.LookupHost
+
+// gitHistoryReason returns a skip reason when gitRoot is not a git
+// repository or has no commits yet, and "" otherwise.
+func gitHistoryReason(opener testable.GitOpener, gitRoot string) string {
+	if opener == nil {
+		opener = testable.DefaultGitOpener
+	}
+	repo, err := opener.PlainOpen(gitRoot)
+	if err != nil {
+		return reasonNoGitHistory
+	}
+	if _, err := repo.Head(); err != nil {
+		return reasonNoGitHistory + " (no commits)"
+	}
+	return ""
+}
+
+// networkReason returns a skip reason when the host of endpoint cannot be
+// resolved, and "" otherwise. A DNS lookup is enough to tell an offline or
+// air-gapped machine apart from one that can reach the service; transient
+// request failures are still handled by the collector itself.
+func networkReason(ctx context.Context, endpoint string) string {
+	u, err := url.Parse(endpoint)
+	if err != nil || u.Hostname() == "" {
+		return ""
+	}
+	ctx, cancel := context.WithTimeout(ctx, networkProbeTimeout)
+	defer cancel()
+	if _, err := lookupHost(ctx, u.Hostname()); err != nil {
+		return fmt.Sprintf("%s (cannot resolve %s)", reasonNoNetwork, u.Hostname())
+	}
+	return ""
+}
@@ -0,0 +1,63 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package collectors
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+// stubLookupHost replaces lookupHost for the duration of a test.
+func stubLookupHost(t *testing.T, err error) {
+	t.Helper()
+	orig := lookupHost
+	lookupHost = func(_ context.Context, _ string) ([]string, error) {
+		if err != nil {
+			return nil, err
+		}
+		return []string{"192.0.2.1"}, nil
+	}
+	t.Cleanup(func() { lookupHost = orig })
+}
+
+func TestGitHistoryReason(t *testing.T) {
+	assert.Equal(t, reasonNoGitHistory, gitHistoryReason(nil, t.TempDir()))
+
+	empty := t.TempDir()
+	runGit(t, empty, "init")
+	assert.Equal(t, "no git history (no commits)", gitHistoryReason(nil, empty))
+
+	repo := initTestGitRepo(t, map[string]string{"main.go": "package main\n"})
+	assert.Empty(t, gitHistoryReason(nil, repo))
+}
+
+func TestNetworkReason(t *testing.T) {
+	stubLookupHost(t, nil)
+	assert.Empty(t, networkReason(context.Background(), osvDefaultBaseURL))
+
+	stubLookupHost(t, errors.New("no such host"))
+	assert.Equal(t, "no network (cannot resolve api.osv.dev)", networkReason(context.Background(), osvDefaultBaseURL))
+	assert.Empty(t, networkReason(context.Background(), "not a url"), "unparseable endpoints are left to the collector")
+}
+
+func TestCollectorCapabilityChecks(t *testing.T) {
+	stubLookupHost(t, errors.New("no such host"))
+	noGit := t.TempDir()
+
+	assert.Equal(t, reasonNoGitHistory, (&GitlogCollector{}).CheckCapabilities(context.Background(), noGit, signal.CollectorOpts{}))
+	assert.Equal(t, reasonNoGitHistory, (&LotteryRiskCollector{}).CheckCapabilities(context.Background(), noGit, signal.CollectorOpts{}))
+
+	repo := initTestGitRepo(t, map[string]string{"main.go": "package main\n"})
+	assert.Empty(t, (&GitlogCollector{}).CheckCapabilities(context.Background(), noGit, signal.CollectorOpts{GitRoot: repo}),
+		"GitRoot takes precedence over the scan path")
+
+	assert.Contains(t, (&VulnCollector{}).CheckCapabilities(context.Background(), noGit, signal.CollectorOpts{}), reasonNoNetwork)
+	assert.Empty(t, (&VulnCollector{osv: &mockOSVClient{}}).CheckCapabilities(context.Background(), noGit, signal.CollectorOpts{}),
+		"an injected client bypasses the network probe")
+}
@@ -191,7 +191,7 @@ func (c *ComplexityCollector) Collect(ctx context.Context, repoPath string, opts
 	var allFunctions []FunctionComplexity
 	var fileCount int
 
-	err := FS.WalkDir(repoPath, func(path string, d os.DirEntry, walkErr error) error {
+	err := walkTree(ctx, repoPath, func(path string, d os.DirEntry, walkErr error) error {
 		if walkErr != nil {
 			return nil
 		}
@@ -205,7 +205,7 @@ func (c *ComplexityCollector) Collect(ctx context.Context, repoPath string, opts
 		}
 
 		if d.IsDir() {
-			if shouldExclude(relPath, excludes) {
+			if shouldExclude(relPath, excludes) || !opts.Scope.ContainsDir(relPath) {
 				return filepath.SkipDir
 			}
 			return nil
@@ -215,8 +215,9 @@ func (c *ComplexityCollector) Collect(ctx context.Context, repoPath string, opts
 			return nil
 		}
 
-		// Skip symlinks outside repo tree.
-		if d.Type()&os.ModeSymlink != 0 && isSymlinkOutsideRepo(path, repoPath) {
+		// Skip symlinks; targets inside the tree are visited at their
+		// canonical path.
+		if isSymlink(d) {
 			return nil
 		}
 
@@ -224,6 +225,10 @@ func (c *ComplexityCollector) Collect(ctx context.Context, repoPath string, opts
 			return nil
 		}
 
+		if !opts.Scope.Contains(relPath) {
+			return nil
+		}
+
 		ext := filepath.Ext(path)
 		spec := extToSpec[ext]
 		if spec == nil {
@@ -242,7 +247,7 @@ func (c *ComplexityCollector) Collect(ctx context.Context, repoPath string, opts
 		if ext == ".go" {
 			goFuncs, astErr := analyzeGoFile(path)
 			if astErr != nil {
-				slog.Warn("complexity: Go AST parse failed, skipping file", "path", relPath, "error", astErr)
+				slog.WarnContext(ctx, "complexity: Go AST parse failed, skipping file", "path", relPath, "error", astErr)
 				return nil
 			}
 			for _, gf := range goFuncs {
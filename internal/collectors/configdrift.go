@@ -121,7 +121,7 @@ func (c *ConfigDriftCollector) Collect(ctx context.Context, repoPath string, opt
 
 	// Phase 2: Walk source files and extract env var references.
 	codeVars := make(map[string]string) // var name → first file path
-	err := FS.WalkDir(repoPath, func(path string, d os.DirEntry, walkErr error) error {
+	err := walkTree(ctx, repoPath, func(path string, d os.DirEntry, walkErr error) error {
 		if walkErr != nil {
 			return nil
 		}
@@ -135,7 +135,7 @@ func (c *ConfigDriftCollector) Collect(ctx context.Context, repoPath string, opt
 		}
 
 		if d.IsDir() {
-			if shouldExclude(relPath, excludes) {
+			if shouldExclude(relPath, excludes) || !opts.Scope.ContainsDir(relPath) {
 				return filepath.SkipDir
 			}
 			return nil
@@ -149,6 +149,10 @@ func (c *ConfigDriftCollector) Collect(ctx context.Context, repoPath string, opt
 			return nil
 		}
 
+		if !opts.Scope.Contains(relPath) {
+			return nil
+		}
+
 		ext := strings.ToLower(filepath.Ext(relPath))
 		patterns, ok := envExtPatterns[ext]
 		if !ok {
@@ -321,7 +325,7 @@ func findDeadConfigKeys(ctx context.Context, repoPath string, templateKeys map[s
 	// Build per-key reference found map.
 	keyFound := make(map[string]bool, len(templateKeys))
 
-	_ = FS.WalkDir(repoPath, func(path string, d os.DirEntry, walkErr error) error { //nolint:errcheck // best-effort directory scan; empty result on failure is acceptable
+	_ = walkTree(ctx, repoPath, func(path string, d os.DirEntry, walkErr error) error { //nolint:errcheck // best-effort directory scan; empty result on failure is acceptable
 		if walkErr != nil {
 			return nil
 		}
@@ -335,7 +339,7 @@ func findDeadConfigKeys(ctx context.Context, repoPath string, templateKeys map[s
 		}
 
 		if d.IsDir() {
-			if shouldExclude(relPath, excludes) {
+			if shouldExclude(relPath, excludes) || !opts.Scope.ContainsDir(relPath) {
 				return filepath.SkipDir
 			}
 			return nil
@@ -349,6 +353,10 @@ func findDeadConfigKeys(ctx context.Context, repoPath string, templateKeys map[s
 			return nil
 		}
 
+		if !opts.Scope.Contains(relPath) {
+			return nil
+		}
+
 		// Skip env files themselves.
 		base := filepath.Base(relPath)
 		if strings.HasPrefix(base, ".env") {
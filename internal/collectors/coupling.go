@@ -76,7 +76,7 @@ func (c *CouplingCollector) Collect(ctx context.Context, repoPath string, opts s
 	// Read Go module path for intra-project import filtering.
 	goModulePath := readGoModulePath(repoPath)
 
-	err := FS.WalkDir(repoPath, func(path string, d os.DirEntry, walkErr error) error {
+	err := walkTree(ctx, repoPath, func(path string, d os.DirEntry, walkErr error) error {
 		if walkErr != nil {
 			return nil
 		}
@@ -90,7 +90,7 @@ func (c *CouplingCollector) Collect(ctx context.Context, repoPath string, opts s
 		}
 
 		if d.IsDir() {
-			if shouldExclude(relPath, excludes) {
+			if shouldExclude(relPath, excludes) || !opts.Scope.ContainsDir(relPath) {
 				return filepath.SkipDir
 			}
 			return nil
@@ -100,8 +100,9 @@ func (c *CouplingCollector) Collect(ctx context.Context, repoPath string, opts s
 			return nil
 		}
 
-		// Skip symlinks outside repo tree.
-		if d.Type()&os.ModeSymlink != 0 && isSymlinkOutsideRepo(path, repoPath) {
+		// Skip symlinks; targets inside the tree are visited at their
+		// canonical path.
+		if isSymlink(d) {
 			return nil
 		}
 
@@ -109,6 +110,10 @@ func (c *CouplingCollector) Collect(ctx context.Context, repoPath string, opts s
 			return nil
 		}
 
+		if !opts.Scope.Contains(relPath) {
+			return nil
+		}
+
 		ext := filepath.Ext(path)
 		if !sourceExtensions[ext] {
 			return nil
@@ -0,0 +1,202 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package collectors
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/davetashner/stringer/internal/collector"
+	"github.com/davetashner/stringer/internal/coverage"
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+// minCoverageLines is the fewest measured statements or lines a file needs
+// before its coverage is reported; a ratio over two or three lines says
+// little.
+const minCoverageLines = 5
+
+func init() {
+	collector.Register(&CoverageCollector{})
+}
+
+// CoverageMetrics holds structured metrics from the coverage ingestion.
+type CoverageMetrics struct {
+	ReportsParsed int
+	FilesMeasured int
+	FilesBelow    int
+	Threshold     float64
+
+	// Directories is the measured coverage of each directory's own files,
+	// sorted by path.
+	Directories []DirectoryCoverage
+}
+
+// DirectoryCoverage is the combined coverage of the measured files directly
+// in one directory.
+type DirectoryCoverage struct {
+	Path    string
+	Files   int
+	Covered int
+	Total   int
+	Ratio   float64
+}
+
+// CoverageCollector reads test coverage reports and flags files whose
+// measured coverage is under a threshold. Unlike the patterns collector's
+// low-test-ratio, which counts test files, this uses what the tests
+// actually executed. It runs only when coverage reports are configured.
+type CoverageCollector struct {
+	metrics *CoverageMetrics
+}
+
+// Name returns the collector name used for registration and filtering.
+func (c *CoverageCollector) Name() string { return "coverage" }
+
+// Collect loads the configured coverage reports, matches their files to the
+// repository, and emits a low-coverage signal for each file under the
+// threshold.
+func (c *CoverageCollector) Collect(ctx context.Context, repoPath string, opts signal.CollectorOpts) ([]signal.RawSignal, error) {
+	threshold := opts.CoverageThreshold
+	if threshold <= 0 {
+		threshold = coverage.LowCoverage
+	}
+	metrics := &CoverageMetrics{Threshold: threshold}
+	c.metrics = metrics
+	if len(opts.CoverageReports) == 0 {
+		return nil, nil
+	}
+
+	var reports []*coverage.Report
+	for _, p := range expandReports(ctx, "coverage", repoPath, opts.CoverageReports) {
+		r, err := coverage.Load(p)
+		if err != nil {
+			slog.WarnContext(ctx, "coverage: skipping unreadable report", "path", p, "error", err)
+			continue
+		}
+		reports = append(reports, r)
+	}
+	if len(reports) == 0 {
+		return nil, fmt.Errorf("no readable coverage reports matched %s", strings.Join(opts.CoverageReports, ", "))
+	}
+	metrics.ReportsParsed = len(reports)
+
+	files, err := coverageCandidates(ctx, repoPath, opts)
+	if err != nil {
+		return nil, err
+	}
+	resolved := coverage.Merge(reports...).Resolve(files)
+	if len(resolved) == 0 {
+		slog.WarnContext(ctx, "coverage: no file in the coverage reports matches this repository")
+		return nil, nil
+	}
+
+	paths := make([]string, 0, len(resolved))
+	for p := range resolved {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+
+	dirs := make(map[string]*DirectoryCoverage)
+	var signals []signal.RawSignal
+	for _, p := range paths {
+		f := resolved[p]
+		if f.Total < minCoverageLines {
+			continue
+		}
+		metrics.FilesMeasured++
+		dir := path.Dir(p)
+		d := dirs[dir]
+		if d == nil {
+			d = &DirectoryCoverage{Path: dir}
+			dirs[dir] = d
+		}
+		d.Files++
+		d.Covered += f.Covered
+		d.Total += f.Total
+
+		if f.Ratio() >= threshold {
+			continue
+		}
+		metrics.FilesBelow++
+		sig := lowCoverageSignal(p, f, threshold)
+		if sig.Confidence >= opts.MinConfidence {
+			signals = append(signals, sig)
+		}
+	}
+
+	for _, d := range dirs {
+		d.Ratio = coverage.File{Covered: d.Covered, Total: d.Total}.Ratio()
+		metrics.Directories = append(metrics.Directories, *d)
+	}
+	sort.Slice(metrics.Directories, func(i, j int) bool {
+		return metrics.Directories[i].Path < metrics.Directories[j].Path
+	})
+	return signals, nil
+}
+
+// coverageCandidates lists the repository's files, relative and
+// slash-separated, that coverage report paths may be matched against.
+func coverageCandidates(ctx context.Context, repoPath string, opts signal.CollectorOpts) ([]string, error) {
+	excludes := mergeExcludes(opts.ExcludePatterns)
+	var files []string
+	err := walkTree(ctx, repoPath, func(p string, d os.DirEntry, walkErr error) error {
+		if walkErr != nil {
+			return nil
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		relPath, relErr := filepath.Rel(repoPath, p)
+		if relErr != nil {
+			return nil
+		}
+		if d.IsDir() {
+			if shouldExclude(relPath, excludes) || !opts.Scope.ContainsDir(relPath) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if shouldExclude(relPath, excludes) || isSymlink(d) {
+			return nil
+		}
+		if len(opts.IncludePatterns) > 0 && !matchesAny(relPath, opts.IncludePatterns) {
+			return nil
+		}
+		files = append(files, filepath.ToSlash(relPath))
+		return nil
+	})
+	return files, err
+}
+
+// lowCoverageSignal builds the low-coverage signal for one file.
+func lowCoverageSignal(file string, f coverage.File, threshold float64) signal.RawSignal {
+	return signal.RawSignal{
+		Source:   "coverage",
+		Kind:     "low-coverage",
+		FilePath: file,
+		Title:    fmt.Sprintf("Low test coverage: %s (%.0f%% covered)", file, f.Ratio()*100),
+		Description: fmt.Sprintf("Tests cover %d of %d measured statements or lines in %s (%.1f%%), under the %.0f%% threshold. "+
+			"Untested code is where regressions go unnoticed; add tests for the uncovered paths before changing it.",
+			f.Covered, f.Total, file, f.Ratio()*100, threshold*100),
+		Confidence: lowCoverageConfidence(f.Ratio(), threshold),
+		Tags:       []string{"low-coverage"},
+	}
+}
+
+// lowCoverageConfidence scales from 0.4 just under the threshold to 0.8 for
+// a file no test touches.
+func lowCoverageConfidence(ratio, threshold float64) float64 {
+	return math.Round((0.4+0.4*(1-ratio/threshold))*100) / 100
+}
+
+// Metrics returns structured metrics from the coverage scan.
+func (c *CoverageCollector) Metrics() any { return c.metrics }
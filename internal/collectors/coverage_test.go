@@ -0,0 +1,126 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package collectors
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+// coverProfile covers internal/db/db.go 2 of 10 statements, internal/api/api.go
+// 9 of 10, cmd/main.go 0 of 6, and tiny.go 0 of 2.
+const coverProfile = `mode: set
+example.com/app/internal/db/db.go:10.2,12.3 2 1
+example.com/app/internal/db/db.go:14.2,20.3 8 0
+example.com/app/internal/api/api.go:5.1,8.2 9 1
+example.com/app/internal/api/api.go:9.1,9.9 1 0
+example.com/app/cmd/main.go:5.1,8.2 6 0
+example.com/app/tiny.go:1.1,2.2 2 0
+example.com/app/deleted.go:1.1,9.2 9 0
+`
+
+func writeCoverageRepo(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	for _, f := range []string{"internal/db/db.go", "internal/api/api.go", "cmd/main.go", "tiny.go"} {
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, filepath.Dir(f)), 0o750))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, f), []byte("package x\n"), 0o600))
+	}
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "coverage.out"), []byte(coverProfile), 0o600))
+	return dir
+}
+
+func TestCoverageCollector_Name(t *testing.T) {
+	assert.Equal(t, "coverage", (&CoverageCollector{}).Name())
+}
+
+func TestCoverageCollector_NotConfigured(t *testing.T) {
+	c := &CoverageCollector{}
+	signals, err := c.Collect(context.Background(), t.TempDir(), signal.CollectorOpts{})
+	require.NoError(t, err)
+	assert.Empty(t, signals)
+}
+
+func TestCoverageCollector_Collect(t *testing.T) {
+	dir := writeCoverageRepo(t)
+
+	c := &CoverageCollector{}
+	signals, err := c.Collect(context.Background(), dir, signal.CollectorOpts{CoverageReports: []string{"*.out"}})
+	require.NoError(t, err)
+
+	require.Len(t, signals, 2, "tiny files and files over the threshold are not flagged")
+	assert.Equal(t, "cmd/main.go", signals[0].FilePath)
+	assert.Equal(t, "Low test coverage: cmd/main.go (0% covered)", signals[0].Title)
+	assert.InDelta(t, 0.8, signals[0].Confidence, 1e-9)
+	assert.Equal(t, "internal/db/db.go", signals[1].FilePath)
+	assert.Equal(t, "low-coverage", signals[1].Kind)
+	assert.Equal(t, "coverage", signals[1].Source)
+	assert.InDelta(t, 0.64, signals[1].Confidence, 1e-9)
+	assert.Contains(t, signals[1].Description, "Tests cover 2 of 10 measured statements or lines in internal/db/db.go (20.0%), under the 50% threshold.")
+
+	m, ok := c.Metrics().(*CoverageMetrics)
+	require.True(t, ok)
+	assert.Equal(t, 1, m.ReportsParsed)
+	assert.Equal(t, 3, m.FilesMeasured)
+	assert.Equal(t, 2, m.FilesBelow)
+	assert.Equal(t, []DirectoryCoverage{
+		{Path: "cmd", Files: 1, Covered: 0, Total: 6, Ratio: 0},
+		{Path: "internal/api", Files: 1, Covered: 9, Total: 10, Ratio: 0.9},
+		{Path: "internal/db", Files: 1, Covered: 2, Total: 10, Ratio: 0.2},
+	}, m.Directories)
+}
+
+func TestCoverageCollector_ThresholdAndFilters(t *testing.T) {
+	dir := writeCoverageRepo(t)
+
+	signals, err := (&CoverageCollector{}).Collect(context.Background(), dir, signal.CollectorOpts{
+		CoverageReports:   []string{"coverage.out"},
+		CoverageThreshold: 0.95,
+		ExcludePatterns:   []string{"cmd/**"},
+	})
+	require.NoError(t, err)
+	require.Len(t, signals, 2)
+	assert.Equal(t, "internal/api/api.go", signals[0].FilePath)
+	assert.InDelta(t, 0.42, signals[0].Confidence, 1e-9)
+	assert.Equal(t, "internal/db/db.go", signals[1].FilePath)
+
+	signals, err = (&CoverageCollector{}).Collect(context.Background(), dir, signal.CollectorOpts{
+		CoverageReports: []string{"coverage.out"},
+		MinConfidence:   0.7,
+	})
+	require.NoError(t, err)
+	require.Len(t, signals, 1)
+	assert.Equal(t, "cmd/main.go", signals[0].FilePath)
+}
+
+func TestCoverageCollector_NoMatchingFiles(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "coverage.out"), []byte(coverProfile), 0o600))
+
+	signals, err := (&CoverageCollector{}).Collect(context.Background(), dir, signal.CollectorOpts{CoverageReports: []string{"coverage.out"}})
+	require.NoError(t, err)
+	assert.Empty(t, signals)
+}
+
+func TestCoverageCollector_NoReadableReports(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "coverage.out"), []byte("not coverage"), 0o600))
+
+	_, err := (&CoverageCollector{}).Collect(context.Background(), dir, signal.CollectorOpts{CoverageReports: []string{"coverage.out", "missing.xml"}})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no readable coverage reports matched coverage.out, missing.xml")
+}
+
+func TestLowCoverageConfidence(t *testing.T) {
+	assert.InDelta(t, 0.8, lowCoverageConfidence(0, 0.5), 1e-9)
+	assert.InDelta(t, 0.6, lowCoverageConfidence(0.25, 0.5), 1e-9)
+	assert.InDelta(t, 0.4, lowCoverageConfidence(0.5, 0.5), 1e-9)
+}
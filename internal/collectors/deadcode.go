@@ -40,12 +40,14 @@ type DeadCodeMetrics struct {
 	FilesAnalyzed      int
 	SymbolsFound       int
 	DeadSymbols        int
+	UnreachableBlocks  int
 	SkippedCapExceeded bool
 }
 
 // DeadCodeCollector detects unused functions and types using regex-based
 // symbol extraction and in-memory reference searching. Follows the
-// regex-over-AST philosophy from DR-013/DR-014.
+// regex-over-AST philosophy from DR-013/DR-014. Go files are also parsed
+// with go/parser to find unreachable statements, which no regex can see.
 type DeadCodeCollector struct {
 	metrics    *DeadCodeMetrics
 	regexCache map[string]*regexp.Regexp
@@ -171,9 +173,10 @@ func (c *DeadCodeCollector) Collect(ctx context.Context, repoPath string, opts s
 	// Pass 1: Walk files, extract symbols, cache content.
 	var symbols []symbolDef
 	var files []fileContents
+	var unreachable []signal.RawSignal
 	var fileCount int
 
-	err := FS.WalkDir(repoPath, func(path string, d os.DirEntry, walkErr error) error {
+	err := walkTree(ctx, repoPath, func(path string, d os.DirEntry, walkErr error) error {
 		if walkErr != nil {
 			return nil
 		}
@@ -187,7 +190,7 @@ func (c *DeadCodeCollector) Collect(ctx context.Context, repoPath string, opts s
 		}
 
 		if d.IsDir() {
-			if shouldExclude(relPath, excludes) {
+			if shouldExclude(relPath, excludes) || !opts.Scope.ContainsDir(relPath) {
 				return filepath.SkipDir
 			}
 			return nil
@@ -197,8 +200,9 @@ func (c *DeadCodeCollector) Collect(ctx context.Context, repoPath string, opts s
 			return nil
 		}
 
-		// Skip symlinks outside repo tree.
-		if d.Type()&os.ModeSymlink != 0 && isSymlinkOutsideRepo(path, repoPath) {
+		// Skip symlinks; targets inside the tree are visited at their
+		// canonical path.
+		if isSymlink(d) {
 			return nil
 		}
 
@@ -206,6 +210,10 @@ func (c *DeadCodeCollector) Collect(ctx context.Context, repoPath string, opts s
 			return nil
 		}
 
+		if !opts.Scope.Contains(relPath) {
+			return nil
+		}
+
 		ext := filepath.Ext(path)
 		// Must be a supported language (has either function or type patterns).
 		if extToSpec[ext] == nil && typePatterns[ext] == nil {
@@ -247,6 +255,10 @@ func (c *DeadCodeCollector) Collect(ctx context.Context, repoPath string, opts s
 		syms := extractSymbols(content, relPath, ext)
 		symbols = append(symbols, syms...)
 
+		if ext == ".go" {
+			unreachable = append(unreachable, unreachableSignals(relPath, content)...)
+		}
+
 		if opts.ProgressFunc != nil && fileCount%500 == 0 {
 			opts.ProgressFunc(fmt.Sprintf("deadcode: scanned %d files", fileCount))
 		}
@@ -308,10 +320,17 @@ func (c *DeadCodeCollector) Collect(ctx context.Context, repoPath string, opts s
 		deadCount++
 	}
 
+	for _, sig := range unreachable {
+		if sig.Confidence >= opts.MinConfidence {
+			signals = append(signals, sig)
+		}
+	}
+
 	c.metrics = &DeadCodeMetrics{
 		FilesAnalyzed:      fileCount,
 		SymbolsFound:       len(symbols),
 		DeadSymbols:        deadCount,
+		UnreachableBlocks:  len(unreachable),
 		SkippedCapExceeded: capExceeded,
 	}
 
@@ -0,0 +1,139 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package collectors
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+// unreachableConfidence is the confidence of unreachable-code signals. The
+// statements can never run, but removing them may be the wrong fix when the
+// terminator itself is the mistake.
+const unreachableConfidence = 0.7
+
+// unreachableGo is a run of Go statements that can never execute.
+type unreachableGo struct {
+	Func   string // enclosing function
+	Line   int    // first unreachable statement
+	Reason string // what makes it unreachable, e.g. "after return"
+}
+
+// findUnreachableGo parses Go source and reports statements that follow a
+// terminating statement in the same block (return, panic, break, continue,
+// goto) and branches guarded by a literal true or false. Source that does
+// not parse yields nothing.
+func findUnreachableGo(relPath, src string) []unreachableGo {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, relPath, src, parser.SkipObjectResolution)
+	if err != nil {
+		return nil
+	}
+
+	var found []unreachableGo
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Body == nil {
+			continue
+		}
+		name := fn.Name.Name
+		ast.Inspect(fn.Body, func(n ast.Node) bool {
+			switch n := n.(type) {
+			case *ast.FuncLit:
+				// Closures are inspected as part of the enclosing function.
+				return true
+			case *ast.BlockStmt:
+				found = appendAfterTerminator(found, fset, name, n.List)
+			case *ast.CaseClause:
+				found = appendAfterTerminator(found, fset, name, n.Body)
+			case *ast.CommClause:
+				found = appendAfterTerminator(found, fset, name, n.Body)
+			case *ast.IfStmt:
+				switch {
+				case isBoolLit(n.Cond, "false") && n.Init == nil && len(n.Body.List) > 0:
+					found = append(found, unreachableGo{name, fset.Position(n.Body.List[0].Pos()).Line, "in if false"})
+				case isBoolLit(n.Cond, "true") && n.Init == nil && n.Else != nil:
+					found = append(found, unreachableGo{name, fset.Position(n.Else.Pos()).Line, "in else of if true"})
+				}
+			case *ast.ForStmt:
+				if isBoolLit(n.Cond, "false") && len(n.Body.List) > 0 {
+					found = append(found, unreachableGo{name, fset.Position(n.Body.List[0].Pos()).Line, "in for false"})
+				}
+			}
+			return true
+		})
+	}
+	return found
+}
+
+// unreachableSignals returns an unreachable-code signal for each run of
+// unreachable statements in a Go file.
+func unreachableSignals(relPath, src string) []signal.RawSignal {
+	var signals []signal.RawSignal
+	for _, u := range findUnreachableGo(relPath, src) {
+		signals = append(signals, signal.RawSignal{
+			Source:     "deadcode",
+			Kind:       "unreachable-code",
+			FilePath:   relPath,
+			Line:       u.Line,
+			Title:      fmt.Sprintf("Unreachable code in %s %s", u.Func, u.Reason),
+			Confidence: unreachableConfidence,
+			Tags:       []string{"dead-code", "cleanup-candidate"},
+		})
+	}
+	return signals
+}
+
+// appendAfterTerminator records the statement after the first terminating
+// statement in list. A labeled statement can be reached by goto, so it ends
+// the unreachable run and nothing is reported.
+func appendAfterTerminator(found []unreachableGo, fset *token.FileSet, fn string, list []ast.Stmt) []unreachableGo {
+	for i, stmt := range list[:max(len(list)-1, 0)] {
+		reason := terminatorReason(stmt)
+		if reason == "" {
+			continue
+		}
+		next := list[i+1]
+		if _, labeled := next.(*ast.LabeledStmt); labeled {
+			return found
+		}
+		if _, empty := next.(*ast.EmptyStmt); empty {
+			return found
+		}
+		return append(found, unreachableGo{fn, fset.Position(next.Pos()).Line, "after " + reason})
+	}
+	return found
+}
+
+// terminatorReason names stmt if control never continues past it.
+func terminatorReason(stmt ast.Stmt) string {
+	switch s := stmt.(type) {
+	case *ast.ReturnStmt:
+		return "return"
+	case *ast.BranchStmt:
+		if s.Tok == token.FALLTHROUGH {
+			return ""
+		}
+		return s.Tok.String()
+	case *ast.ExprStmt:
+		call, ok := s.X.(*ast.CallExpr)
+		if !ok {
+			return ""
+		}
+		if id, ok := call.Fun.(*ast.Ident); ok && id.Name == "panic" {
+			return "panic"
+		}
+	}
+	return ""
+}
+
+// isBoolLit reports whether expr is the predeclared identifier name.
+func isBoolLit(expr ast.Expr, name string) bool {
+	id, ok := expr.(*ast.Ident)
+	return ok && id.Name == name
+}
@@ -0,0 +1,134 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package collectors
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+func TestFindUnreachableGo(t *testing.T) {
+	src := `package p
+
+func afterReturn() int {
+	return 1
+	println("gone")
+}
+
+func afterPanic() {
+	panic("no")
+	println("gone")
+}
+
+func inLoop(xs []int) {
+	for _, x := range xs {
+		continue
+		println(x)
+	}
+}
+
+func inSwitch(x int) {
+	switch x {
+	case 1:
+		return
+		println("gone")
+	case 2:
+		fallthrough
+	default:
+	}
+}
+
+func constant() {
+	if false {
+		println("never")
+	}
+	if true {
+		println("always")
+	} else {
+		println("never")
+	}
+	for false {
+		println("never")
+	}
+}
+
+func inClosure() func() {
+	return func() {
+		return
+		println("gone")
+	}
+}
+
+func reachable(x int) int {
+	if x > 0 {
+		return 1
+	}
+	goto end
+end:
+	return 0
+}
+`
+	got := findUnreachableGo("p.go", src)
+	assert.Equal(t, []unreachableGo{
+		{"afterReturn", 5, "after return"},
+		{"afterPanic", 10, "after panic"},
+		{"inLoop", 16, "after continue"},
+		{"inSwitch", 24, "after return"},
+		{"constant", 33, "in if false"},
+		{"constant", 37, "in else of if true"},
+		{"constant", 41, "in for false"},
+		{"inClosure", 48, "after return"},
+	}, got)
+}
+
+func TestFindUnreachableGo_ParseError(t *testing.T) {
+	assert.Nil(t, findUnreachableGo("bad.go", "package p\nfunc {"))
+}
+
+func TestDeadCode_UnreachableGoCode(t *testing.T) {
+	dir := t.TempDir()
+	goCode := `package main
+
+func main() {
+	run()
+}
+
+func run() {
+	return
+	println("never printed")
+}
+`
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "main.go"), []byte(goCode), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "main_test.go"), []byte("package main\n\nfunc helper() {\n\treturn\n\thelper()\n}\n"), 0o600))
+
+	c := &DeadCodeCollector{}
+	signals, err := c.Collect(context.Background(), dir, signal.CollectorOpts{})
+	require.NoError(t, err)
+
+	var unreachable []signal.RawSignal
+	for _, sig := range signals {
+		if sig.Kind == "unreachable-code" {
+			unreachable = append(unreachable, sig)
+		}
+	}
+	require.Len(t, unreachable, 1, "test files are not analyzed")
+	assert.Equal(t, "Unreachable code in run after return", unreachable[0].Title)
+	assert.Equal(t, "main.go", unreachable[0].FilePath)
+	assert.Equal(t, 9, unreachable[0].Line)
+	assert.Contains(t, unreachable[0].Tags, "dead-code")
+	assert.Equal(t, 1, c.Metrics().(*DeadCodeMetrics).UnreachableBlocks)
+
+	signals, err = c.Collect(context.Background(), dir, signal.CollectorOpts{MinConfidence: 0.8})
+	require.NoError(t, err)
+	for _, sig := range signals {
+		assert.NotEqual(t, "unreachable-code", sig.Kind, "below --min-confidence")
+	}
+}
@@ -11,8 +11,8 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
-	"github.com/google/go-github/v68/github"
 	"golang.org/x/mod/modfile"
 
 	"github.com/davetashner/stringer/internal/collector"
@@ -23,6 +23,10 @@ func init() {
 	collector.Register(&DepHealthCollector{})
 }
 
+// defaultStalenessThreshold is 2 years — repos with no push activity beyond
+// this are flagged as stale.
+const defaultStalenessThreshold = 2 * 365 * 24 * time.Hour
+
 // DepHealthMetrics holds structured dependency data parsed from manifests.
 type DepHealthMetrics struct {
 	ModulePath   string
@@ -34,6 +38,7 @@ type DepHealthMetrics struct {
 	Deprecated   []string
 	Stale        []string
 	Yanked       []string
+	VersionSkew  []string // titles of version-skew signals
 	Ecosystems   []string // ecosystems detected (e.g., "go", "npm", "cargo")
 }
 
@@ -44,6 +49,30 @@ type ModuleDep struct {
 	Indirect bool
 }
 
+// extractGitHubOwnerRepo extracts the GitHub owner and repo from a Go module
+// path. Returns ok=false for non-GitHub modules.
+// Examples:
+//
+//	"github.com/foo/bar"      → "foo", "bar", true
+//	"github.com/foo/bar/v2"   → "foo", "bar", true
+//	"github.com/foo/bar/pkg"  → "foo", "bar", true
+//	"golang.org/x/mod"        → "", "", false
+func extractGitHubOwnerRepo(modulePath string) (owner, repo string, ok bool) {
+	if !strings.HasPrefix(modulePath, "github.com/") {
+		return "", "", false
+	}
+	parts := strings.SplitN(modulePath, "/", 4) // ["github.com", owner, repo, ...]
+	if len(parts) < 3 {
+		return "", "", false
+	}
+	return parts[1], parts[2], true
+}
+
+// repoKey returns a dedup key for a GitHub repo.
+func repoKey(owner, repo string) string {
+	return owner + "/" + repo
+}
+
 // ModuleReplace represents a single replace directive.
 type ModuleReplace struct {
 	OldPath    string
@@ -64,7 +93,8 @@ type ModuleRetract struct {
 // Cargo.toml, pom.xml, *.csproj, requirements.txt, pyproject.toml,
 // composer.json, Package.swift, build.sbt, mix.exs) to extract dependency
 // information and emits signals for deprecated, yanked, archived, and stale
-// dependencies across multiple ecosystems.
+// dependencies across multiple ecosystems, and for dependencies declared at
+// different versions by the workspaces of a monorepo.
 type DepHealthCollector struct {
 	metrics         *DepHealthMetrics
 	ghAPI           dephealthGitHubAPI
@@ -81,6 +111,15 @@ type DepHealthCollector struct {
 // Name returns the collector name used for registration and filtering.
 func (c *DepHealthCollector) Name() string { return "dephealth" }
 
+// CheckCapabilities reports a skip reason in builds without network
+// support, where the registry and GitHub lookups cannot run.
+func (c *DepHealthCollector) CheckCapabilities(_ context.Context, _ string, _ signal.CollectorOpts) string {
+	if !networkCollectors {
+		return reasonNoNetworkBuild
+	}
+	return ""
+}
+
 // Collect parses dependency manifests in repoPath and returns signals for
 // actionable findings (local replaces, retracted versions, archived repos,
 // deprecated modules, yanked versions, stale dependencies) across Go, npm,
@@ -132,9 +171,16 @@ func (c *DepHealthCollector) Collect(ctx context.Context, repoPath string, opts
 	hexSignals := c.collectHexHealth(ctx, repoPath, metrics)
 	signals = append(signals, hexSignals...)
 
-	// If no ecosystems found at all, return nil.
-	if len(metrics.Ecosystems) == 0 {
-		slog.Info("no dependency manifests found, skipping dephealth collector")
+	// --- Version skew across monorepo workspaces ---
+	skewSignals := collectVersionSkew(ctx, repoPath, opts.GitRoot)
+	for _, s := range skewSignals {
+		metrics.VersionSkew = append(metrics.VersionSkew, s.Title)
+	}
+	signals = append(signals, skewSignals...)
+
+	// If no ecosystems or workspace manifests found at all, return nil.
+	if len(metrics.Ecosystems) == 0 && len(skewSignals) == 0 {
+		slog.InfoContext(ctx, "no dependency manifests found, skipping dephealth collector")
 		return nil, nil
 	}
 
@@ -250,10 +296,9 @@ func (c *DepHealthCollector) collectGoHealth(ctx context.Context, repoPath strin
 	if ghAPI == nil {
 		token := os.Getenv("GITHUB_TOKEN")
 		if token != "" {
-			client := github.NewClient(nil).WithAuthToken(token)
-			ghAPI = &realGitHubAPI{client: client}
+			ghAPI = newDepHealthGitHubAPI(ctx, token)
 		} else {
-			slog.Info("GITHUB_TOKEN not set, skipping dephealth GitHub checks")
+			slog.InfoContext(ctx, "GITHUB_TOKEN not set, skipping dephealth GitHub checks")
 		}
 	}
 	if ghAPI != nil {
@@ -262,7 +307,7 @@ func (c *DepHealthCollector) collectGoHealth(ctx context.Context, repoPath strin
 			if d, err := ParseDuration(opts.StalenessThreshold); err == nil {
 				threshold = d
 			} else {
-				slog.Warn("invalid staleness-threshold, using default", "value", opts.StalenessThreshold, "error", err)
+				slog.WarnContext(ctx, "invalid staleness-threshold, using default", "value", opts.StalenessThreshold, "error", err)
 			}
 		}
 		ghSignals := checkGitHubDeps(ctx, ghAPI, metrics.Dependencies, threshold)
@@ -296,14 +341,14 @@ func (c *DepHealthCollector) collectNpmHealth(ctx context.Context, repoPath stri
 	data, err := FS.ReadFile(filepath.Join(repoPath, "package.json"))
 	if err != nil {
 		if !errors.Is(err, os.ErrNotExist) {
-			slog.Warn("dephealth: reading package.json", "error", err)
+			slog.WarnContext(ctx, "dephealth: reading package.json", "error", err)
 		}
 		return nil
 	}
 
 	deps, err := parseNpmDeps(data)
 	if err != nil {
-		slog.Warn("dephealth: parsing package.json", "error", err)
+		slog.WarnContext(ctx, "dephealth: parsing package.json", "error", err)
 		return nil
 	}
 	if len(deps) == 0 {
@@ -329,14 +374,14 @@ func (c *DepHealthCollector) collectCargoHealth(ctx context.Context, repoPath st
 	data, err := FS.ReadFile(filepath.Join(repoPath, "Cargo.toml"))
 	if err != nil {
 		if !errors.Is(err, os.ErrNotExist) {
-			slog.Warn("dephealth: reading Cargo.toml", "error", err)
+			slog.WarnContext(ctx, "dephealth: reading Cargo.toml", "error", err)
 		}
 		return nil
 	}
 
 	deps, err := parseCargoDeps(data)
 	if err != nil {
-		slog.Warn("dephealth: parsing Cargo.toml", "error", err)
+		slog.WarnContext(ctx, "dephealth: parsing Cargo.toml", "error", err)
 		return nil
 	}
 	if len(deps) == 0 {
@@ -362,14 +407,14 @@ func (c *DepHealthCollector) collectMavenHealth(ctx context.Context, repoPath st
 	data, err := FS.ReadFile(filepath.Join(repoPath, "pom.xml"))
 	if err != nil {
 		if !errors.Is(err, os.ErrNotExist) {
-			slog.Warn("dephealth: reading pom.xml", "error", err)
+			slog.WarnContext(ctx, "dephealth: reading pom.xml", "error", err)
 		}
 		return nil
 	}
 
-	deps, err := parseMavenDeps(data)
+	deps, err := parseMavenDeps(ctx, data)
 	if err != nil {
-		slog.Warn("dephealth: parsing pom.xml", "error", err)
+		slog.WarnContext(ctx, "dephealth: parsing pom.xml", "error", err)
 		return nil
 	}
 	if len(deps) == 0 {
@@ -392,7 +437,7 @@ func (c *DepHealthCollector) collectMavenHealth(ctx context.Context, repoPath st
 
 // collectNuGetHealth parses .csproj files and checks NuGet for deprecated packages.
 func (c *DepHealthCollector) collectNuGetHealth(ctx context.Context, repoPath string, metrics *DepHealthMetrics) []signal.RawSignal {
-	filePath, deps := parseCsprojQueries(repoPath)
+	filePath, deps := parseCsprojQueries(ctx, repoPath)
 	if len(deps) == 0 {
 		return nil
 	}
@@ -413,7 +458,7 @@ func (c *DepHealthCollector) collectNuGetHealth(ctx context.Context, repoPath st
 
 // collectPyPIHealth parses Python manifests and checks PyPI for deprecated packages.
 func (c *DepHealthCollector) collectPyPIHealth(ctx context.Context, repoPath string, metrics *DepHealthMetrics) []signal.RawSignal {
-	filePath, deps := parsePythonQueries(repoPath)
+	filePath, deps := parsePythonQueries(ctx, repoPath)
 	if len(deps) == 0 {
 		return nil
 	}
@@ -437,14 +482,14 @@ func (c *DepHealthCollector) collectPackagistHealth(ctx context.Context, repoPat
 	data, err := FS.ReadFile(filepath.Join(repoPath, "composer.json"))
 	if err != nil {
 		if !errors.Is(err, os.ErrNotExist) {
-			slog.Warn("dephealth: reading composer.json", "error", err)
+			slog.WarnContext(ctx, "dephealth: reading composer.json", "error", err)
 		}
 		return nil
 	}
 
 	deps, err := parseComposerDeps(data)
 	if err != nil {
-		slog.Warn("dephealth: parsing composer.json", "error", err)
+		slog.WarnContext(ctx, "dephealth: parsing composer.json", "error", err)
 		return nil
 	}
 	if len(deps) == 0 {
@@ -470,7 +515,7 @@ func (c *DepHealthCollector) collectSwiftHealth(ctx context.Context, repoPath st
 	data, err := FS.ReadFile(filepath.Join(repoPath, "Package.swift"))
 	if err != nil {
 		if !errors.Is(err, os.ErrNotExist) {
-			slog.Warn("dephealth: reading Package.swift", "error", err)
+			slog.WarnContext(ctx, "dephealth: reading Package.swift", "error", err)
 		}
 		return nil
 	}
@@ -487,10 +532,9 @@ func (c *DepHealthCollector) collectSwiftHealth(ctx context.Context, repoPath st
 	if ghAPI == nil {
 		token := os.Getenv("GITHUB_TOKEN")
 		if token != "" {
-			ghClient := github.NewClient(nil).WithAuthToken(token)
-			ghAPI = &realGitHubAPI{client: ghClient}
+			ghAPI = newDepHealthGitHubAPI(ctx, token)
 		} else {
-			slog.Info("GITHUB_TOKEN not set, skipping Swift GitHub checks")
+			slog.InfoContext(ctx, "GITHUB_TOKEN not set, skipping Swift GitHub checks")
 			return nil
 		}
 	}
@@ -547,7 +591,7 @@ func (c *DepHealthCollector) collectSbtHealth(ctx context.Context, repoPath stri
 	data, err := FS.ReadFile(filepath.Join(repoPath, "build.sbt"))
 	if err != nil {
 		if !errors.Is(err, os.ErrNotExist) {
-			slog.Warn("dephealth: reading build.sbt", "error", err)
+			slog.WarnContext(ctx, "dephealth: reading build.sbt", "error", err)
 		}
 		return nil
 	}
@@ -577,7 +621,7 @@ func (c *DepHealthCollector) collectHexHealth(ctx context.Context, repoPath stri
 	data, err := FS.ReadFile(filepath.Join(repoPath, "mix.exs"))
 	if err != nil {
 		if !errors.Is(err, os.ErrNotExist) {
-			slog.Warn("dephealth: reading mix.exs", "error", err)
+			slog.WarnContext(ctx, "dephealth: reading mix.exs", "error", err)
 		}
 		return nil
 	}
@@ -612,3 +656,4 @@ func isLocalPath(p string) bool {
 // Compile-time interface checks.
 var _ collector.Collector = (*DepHealthCollector)(nil)
 var _ collector.MetricsProvider = (*DepHealthCollector)(nil)
+var _ collector.CapabilityChecker = (*DepHealthCollector)(nil)
@@ -94,14 +94,14 @@ func checkCratesDeps(ctx context.Context, client cratesRegistryClient, deps []Pa
 			break
 		}
 		if checked >= maxCratesChecks {
-			slog.Info("dephealth: reached crates.io check cap", "cap", maxCratesChecks)
+			slog.InfoContext(ctx, "dephealth: reached crates.io check cap", "cap", maxCratesChecks)
 			break
 		}
 		checked++
 
 		info, err := client.FetchCrate(ctx, dep.Name)
 		if err != nil {
-			slog.Debug("dephealth: crates.io lookup failed", "crate", dep.Name, "error", err)
+			slog.DebugContext(ctx, "dephealth: crates.io lookup failed", "crate", dep.Name, "error", err)
 			continue
 		}
 
@@ -92,14 +92,14 @@ func checkDeprecatedDeps(ctx context.Context, client moduleProxyClient, deps []M
 			break
 		}
 		if checked >= maxProxyChecks {
-			slog.Info("dephealth: reached module proxy check cap", "cap", maxProxyChecks)
+			slog.InfoContext(ctx, "dephealth: reached module proxy check cap", "cap", maxProxyChecks)
 			break
 		}
 		checked++
 
 		info, err := client.FetchLatest(ctx, dep.Path)
 		if err != nil {
-			slog.Debug("dephealth: proxy lookup failed", "module", dep.Path, "error", err)
+			slog.DebugContext(ctx, "dephealth: proxy lookup failed", "module", dep.Path, "error", err)
 			continue
 		}
 
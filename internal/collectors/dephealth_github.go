@@ -1,13 +1,15 @@
 // Copyright 2026 The Stringer Authors
 // SPDX-License-Identifier: MIT
 
+//go:build !nonetwork
+
 package collectors
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
-	"strings"
 	"time"
 
 	"github.com/google/go-github/v68/github"
@@ -18,38 +20,52 @@ import (
 // maxGitHubDepChecks caps the number of unique GitHub repos queried.
 const maxGitHubDepChecks = 50
 
-// defaultStalenessThreshold is 2 years — repos with no push activity beyond
-// this are flagged as stale.
-const defaultStalenessThreshold = 2 * 365 * 24 * time.Hour
-
 // dephealthGitHubAPI is a narrow interface for the GitHub API calls needed by
 // the dephealth collector. It is a subset of the full githubAPI interface.
 type dephealthGitHubAPI interface {
 	GetRepository(ctx context.Context, owner, repo string) (*github.Repository, *github.Response, error)
 }
 
-// extractGitHubOwnerRepo extracts the GitHub owner and repo from a Go module
-// path. Returns ok=false for non-GitHub modules.
-// Examples:
-//
-//	"github.com/foo/bar"      → "foo", "bar", true
-//	"github.com/foo/bar/v2"   → "foo", "bar", true
-//	"github.com/foo/bar/pkg"  → "foo", "bar", true
-//	"golang.org/x/mod"        → "", "", false
-func extractGitHubOwnerRepo(modulePath string) (owner, repo string, ok bool) {
-	if !strings.HasPrefix(modulePath, "github.com/") {
-		return "", "", false
-	}
-	parts := strings.SplitN(modulePath, "/", 4) // ["github.com", owner, repo, ...]
-	if len(parts) < 3 {
-		return "", "", false
-	}
-	return parts[1], parts[2], true
+// newDepHealthGitHubAPI returns a GitHub client authenticated with token
+// that draws on the scan's GitHub API budget.
+func newDepHealthGitHubAPI(ctx context.Context, token string) dephealthGitHubAPI {
+	return githubDataFrom(ctx).wrap(&realGitHubAPI{client: NewGitHubClient(token)})
 }
 
-// repoKey returns a dedup key for a GitHub repo.
-func repoKey(owner, repo string) string {
-	return owner + "/" + repo
+// archivedGitHubRepos returns the GitHub repositories, as owner/repo, of
+// the given dependencies that are archived. It queries each repository
+// once, up to maxGitHubDepChecks.
+func archivedGitHubRepos(ctx context.Context, api dephealthGitHubAPI, deps []ModuleDep) []string {
+	seen := make(map[string]bool)
+	var archived []string
+	for _, dep := range deps {
+		if ctx.Err() != nil {
+			break
+		}
+		owner, repo, ok := extractGitHubOwnerRepo(dep.Path)
+		if !ok || seen[repoKey(owner, repo)] {
+			continue
+		}
+		if len(seen) >= maxGitHubDepChecks {
+			slog.InfoContext(ctx, "reached GitHub API call cap", "cap", maxGitHubDepChecks)
+			break
+		}
+		seen[repoKey(owner, repo)] = true
+
+		ghRepo, _, err := api.GetRepository(ctx, owner, repo)
+		if errors.Is(err, ErrGitHubBudgetExhausted) {
+			slog.InfoContext(ctx, "GitHub API request budget used up, skipping the remaining GitHub dependency checks")
+			break
+		}
+		if err != nil {
+			slog.DebugContext(ctx, "failed to fetch GitHub repo", "owner", owner, "repo", repo, "error", err)
+			continue
+		}
+		if ghRepo.GetArchived() {
+			archived = append(archived, repoKey(owner, repo))
+		}
+	}
+	return archived
 }
 
 // checkGitHubDeps queries the GitHub API for each unique GitHub-hosted
@@ -74,14 +90,18 @@ func checkGitHubDeps(ctx context.Context, api dephealthGitHubAPI, deps []ModuleD
 		seen[key] = true
 
 		if checked >= maxGitHubDepChecks {
-			slog.Info("dephealth: reached GitHub API call cap", "cap", maxGitHubDepChecks)
+			slog.InfoContext(ctx, "dephealth: reached GitHub API call cap", "cap", maxGitHubDepChecks)
 			break
 		}
 		checked++
 
 		ghRepo, _, err := api.GetRepository(ctx, owner, repo)
+		if errors.Is(err, ErrGitHubBudgetExhausted) {
+			slog.InfoContext(ctx, "GitHub API request budget used up, skipping the remaining GitHub dependency checks")
+			break
+		}
 		if err != nil {
-			slog.Debug("dephealth: failed to fetch GitHub repo", "owner", owner, "repo", repo, "error", err)
+			slog.DebugContext(ctx, "dephealth: failed to fetch GitHub repo", "owner", owner, "repo", repo, "error", err)
 			continue
 		}
 
@@ -0,0 +1,356 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+//go:build !nonetwork
+
+package collectors
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v68/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+// mockDephealthGitHubAPI implements dephealthGitHubAPI for testing.
+type mockDephealthGitHubAPI struct {
+	repos map[string]*github.Repository
+	err   error
+}
+
+func (m *mockDephealthGitHubAPI) GetRepository(_ context.Context, owner, repo string) (*github.Repository, *github.Response, error) {
+	if m.err != nil {
+		return nil, nil, m.err
+	}
+	key := owner + "/" + repo
+	r, ok := m.repos[key]
+	if !ok {
+		return nil, nil, fmt.Errorf("repo %s not found", key)
+	}
+	return r, nil, nil
+}
+
+func TestExtractGitHubOwnerRepo(t *testing.T) {
+	tests := []struct {
+		name      string
+		path      string
+		wantOwner string
+		wantRepo  string
+		wantOK    bool
+	}{
+		{"standard", "github.com/foo/bar", "foo", "bar", true},
+		{"versioned", "github.com/foo/bar/v2", "foo", "bar", true},
+		{"subpackage", "github.com/foo/bar/pkg/sub", "foo", "bar", true},
+		{"non-github", "golang.org/x/mod", "", "", false},
+		{"too-short", "github.com/foo", "", "", false},
+		{"empty", "", "", "", false},
+		{"other-host", "gitlab.com/foo/bar", "", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			owner, repo, ok := extractGitHubOwnerRepo(tt.path)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.wantOwner, owner)
+			assert.Equal(t, tt.wantRepo, repo)
+		})
+	}
+}
+
+func TestCheckGitHubDeps_Archived(t *testing.T) {
+	api := &mockDephealthGitHubAPI{
+		repos: map[string]*github.Repository{
+			"foo/bar": {Archived: github.Ptr(true)},
+		},
+	}
+	deps := []ModuleDep{{Path: "github.com/foo/bar", Version: "v1.0.0"}}
+
+	signals := checkGitHubDeps(context.Background(), api, deps, defaultStalenessThreshold)
+	require.Len(t, signals, 1)
+	assert.Equal(t, "archived-dependency", signals[0].Kind)
+	assert.Equal(t, 0.9, signals[0].Confidence)
+	assert.Contains(t, signals[0].Title, "foo/bar")
+	assert.Contains(t, signals[0].Description, "archived")
+	assert.Contains(t, signals[0].Tags, "archived-dependency")
+}
+
+func TestCheckGitHubDeps_Stale(t *testing.T) {
+	staleTime := time.Now().Add(-3 * 365 * 24 * time.Hour) // 3 years ago
+	api := &mockDephealthGitHubAPI{
+		repos: map[string]*github.Repository{
+			"foo/bar": {
+				Archived: github.Ptr(false),
+				PushedAt: &github.Timestamp{Time: staleTime},
+			},
+		},
+	}
+	deps := []ModuleDep{{Path: "github.com/foo/bar", Version: "v1.0.0"}}
+
+	signals := checkGitHubDeps(context.Background(), api, deps, defaultStalenessThreshold)
+	require.Len(t, signals, 1)
+	assert.Equal(t, "stale-dependency", signals[0].Kind)
+	assert.Equal(t, 0.6, signals[0].Confidence)
+	assert.Contains(t, signals[0].Description, "not been pushed")
+}
+
+func TestCheckGitHubDeps_ArchivedNotDoubleStale(t *testing.T) {
+	staleTime := time.Now().Add(-3 * 365 * 24 * time.Hour)
+	api := &mockDephealthGitHubAPI{
+		repos: map[string]*github.Repository{
+			"foo/bar": {
+				Archived: github.Ptr(true),
+				PushedAt: &github.Timestamp{Time: staleTime},
+			},
+		},
+	}
+	deps := []ModuleDep{{Path: "github.com/foo/bar", Version: "v1.0.0"}}
+
+	signals := checkGitHubDeps(context.Background(), api, deps, defaultStalenessThreshold)
+	require.Len(t, signals, 1)
+	assert.Equal(t, "archived-dependency", signals[0].Kind, "should only emit archived, not stale")
+}
+
+func TestCheckGitHubDeps_Healthy(t *testing.T) {
+	recentTime := time.Now().Add(-30 * 24 * time.Hour) // 30 days ago
+	api := &mockDephealthGitHubAPI{
+		repos: map[string]*github.Repository{
+			"foo/bar": {
+				Archived: github.Ptr(false),
+				PushedAt: &github.Timestamp{Time: recentTime},
+			},
+		},
+	}
+	deps := []ModuleDep{{Path: "github.com/foo/bar", Version: "v1.0.0"}}
+
+	signals := checkGitHubDeps(context.Background(), api, deps, defaultStalenessThreshold)
+	assert.Empty(t, signals)
+}
+
+func TestCheckGitHubDeps_NonGitHub(t *testing.T) {
+	api := &mockDephealthGitHubAPI{repos: map[string]*github.Repository{}}
+	deps := []ModuleDep{
+		{Path: "golang.org/x/mod", Version: "v0.17.0"},
+		{Path: "gopkg.in/yaml.v3", Version: "v3.0.1"},
+	}
+
+	signals := checkGitHubDeps(context.Background(), api, deps, defaultStalenessThreshold)
+	assert.Empty(t, signals, "non-GitHub deps should be silently skipped")
+}
+
+func TestCheckGitHubDeps_Dedup(t *testing.T) {
+	recentTime := time.Now().Add(-30 * 24 * time.Hour)
+	callCount := 0
+	api := &countingGitHubAPI{
+		inner: &mockDephealthGitHubAPI{
+			repos: map[string]*github.Repository{
+				"foo/bar": {
+					Archived: github.Ptr(false),
+					PushedAt: &github.Timestamp{Time: recentTime},
+				},
+			},
+		},
+		count: &callCount,
+	}
+	deps := []ModuleDep{
+		{Path: "github.com/foo/bar", Version: "v1.0.0"},
+		{Path: "github.com/foo/bar/v2", Version: "v2.0.0"},
+		{Path: "github.com/foo/bar/pkg/sub", Version: "v1.1.0"},
+	}
+
+	signals := checkGitHubDeps(context.Background(), api, deps, defaultStalenessThreshold)
+	assert.Empty(t, signals) // healthy repo
+	assert.Equal(t, 1, callCount, "should only make one API call for foo/bar")
+}
+
+// countingGitHubAPI wraps a mock and counts API calls.
+type countingGitHubAPI struct {
+	inner dephealthGitHubAPI
+	count *int
+}
+
+func (c *countingGitHubAPI) GetRepository(ctx context.Context, owner, repo string) (*github.Repository, *github.Response, error) {
+	*c.count++
+	return c.inner.GetRepository(ctx, owner, repo)
+}
+
+func TestCheckGitHubDeps_APIError(t *testing.T) {
+	api := &mockDephealthGitHubAPI{
+		err: fmt.Errorf("rate limited"),
+	}
+	deps := []ModuleDep{{Path: "github.com/foo/bar", Version: "v1.0.0"}}
+
+	signals := checkGitHubDeps(context.Background(), api, deps, defaultStalenessThreshold)
+	assert.Empty(t, signals, "API errors should be silently skipped")
+}
+
+func TestDepHealthCollector_Integration(t *testing.T) {
+	t.Setenv("GITHUB_TOKEN", "fake-token")
+
+	dir := t.TempDir()
+	gomod := `module example.com/test
+
+go 1.22
+
+require (
+	github.com/archived/repo v1.0.0
+	github.com/stale/repo v1.0.0
+	github.com/deprecated/mod v1.0.0
+	github.com/healthy/repo v1.0.0
+	golang.org/x/text v0.14.0
+)
+
+replace github.com/local/thing => ../local
+`
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "go.mod"), []byte(gomod), 0o600))
+
+	staleTime := time.Now().Add(-3 * 365 * 24 * time.Hour)
+	recentTime := time.Now().Add(-30 * 24 * time.Hour)
+
+	ghAPI := &mockDephealthGitHubAPI{
+		repos: map[string]*github.Repository{
+			"archived/repo": {Archived: github.Ptr(true)},
+			"stale/repo": {
+				Archived: github.Ptr(false),
+				PushedAt: &github.Timestamp{Time: staleTime},
+			},
+			"deprecated/mod": {
+				Archived: github.Ptr(false),
+				PushedAt: &github.Timestamp{Time: recentTime},
+			},
+			"healthy/repo": {
+				Archived: github.Ptr(false),
+				PushedAt: &github.Timestamp{Time: recentTime},
+			},
+		},
+	}
+
+	proxy := &mockModuleProxyClient{
+		results: map[string]*moduleInfo{
+			"github.com/archived/repo":  {Version: "v1.0.0"},
+			"github.com/stale/repo":     {Version: "v1.0.0"},
+			"github.com/deprecated/mod": {Version: "v1.0.0", Deprecated: "use github.com/new/mod instead"},
+			"github.com/healthy/repo":   {Version: "v1.0.0"},
+			"golang.org/x/text":         {Version: "v0.14.0"},
+		},
+	}
+
+	c := &DepHealthCollector{ghAPI: ghAPI, proxyClient: proxy}
+	signals, err := c.Collect(context.Background(), dir, signal.CollectorOpts{})
+	require.NoError(t, err)
+
+	// Expect: archived(1) + stale(1) + deprecated(1) = 3 signals.
+	kinds := make(map[string]int)
+	for _, s := range signals {
+		kinds[s.Kind]++
+		assert.Equal(t, "dephealth", s.Source)
+		assert.Equal(t, "go.mod", s.FilePath)
+	}
+	assert.Equal(t, 1, kinds["archived-dependency"])
+	assert.Equal(t, 1, kinds["stale-dependency"])
+	assert.Equal(t, 1, kinds["deprecated-dependency"])
+
+	// Metrics should be populated.
+	metrics := c.Metrics().(*DepHealthMetrics)
+	assert.Len(t, metrics.Archived, 1)
+	assert.Len(t, metrics.Stale, 1)
+	assert.Len(t, metrics.Deprecated, 1)
+	assert.Len(t, metrics.Dependencies, 5)
+}
+
+func TestDepHealthCollector_StalenessThresholdOpt(t *testing.T) {
+	t.Setenv("GITHUB_TOKEN", "fake-token")
+
+	dir := t.TempDir()
+	gomod := `module example.com/test
+
+go 1.22
+
+require github.com/foo/bar v1.0.0
+`
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "go.mod"), []byte(gomod), 0o600))
+
+	// Repo was pushed 6 months ago — stale with 3m threshold, not stale with default 2y.
+	sixMonthsAgo := time.Now().Add(-6 * 30 * 24 * time.Hour)
+	ghAPI := &mockDephealthGitHubAPI{
+		repos: map[string]*github.Repository{
+			"foo/bar": {
+				Archived: github.Ptr(false),
+				PushedAt: &github.Timestamp{Time: sixMonthsAgo},
+			},
+		},
+	}
+
+	// With default threshold (2y), should not be stale.
+	c := &DepHealthCollector{ghAPI: ghAPI, proxyClient: &noopProxyClient{}}
+	signals, err := c.Collect(context.Background(), dir, signal.CollectorOpts{})
+	require.NoError(t, err)
+	assert.Empty(t, signals)
+
+	// With 3-month threshold, should be stale.
+	c2 := &DepHealthCollector{ghAPI: ghAPI, proxyClient: &noopProxyClient{}}
+	signals2, err := c2.Collect(context.Background(), dir, signal.CollectorOpts{
+		StalenessThreshold: "3m",
+	})
+	require.NoError(t, err)
+	require.Len(t, signals2, 1)
+	assert.Equal(t, "stale-dependency", signals2[0].Kind)
+}
+
+func TestArchivedGitHubRepos(t *testing.T) {
+	api := &mockDephealthGitHubAPI{
+		repos: map[string]*github.Repository{
+			"foo/old":  {Archived: github.Ptr(true)},
+			"foo/live": {Archived: github.Ptr(false)},
+		},
+	}
+	deps := []ModuleDep{
+		{Path: "github.com/foo/old"},
+		{Path: "github.com/foo/old/v2"},
+		{Path: "github.com/foo/live"},
+		{Path: "github.com/foo/missing"},
+		{Path: "golang.org/x/mod"},
+	}
+	assert.Equal(t, []string{"foo/old"}, archivedGitHubRepos(context.Background(), api, deps))
+}
+
+func TestDeprecationCollector_ArchivedImports(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "go.mod"), []byte("module example.com/app\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "main.go"), []byte(`package main
+
+import (
+	"github.com/foo/old/pkg"
+	"github.com/foo/live"
+)
+
+func main() {
+	pkg.Run()
+	live.Run()
+}
+`), 0o600))
+
+	c := &DeprecationCollector{ghAPI: &mockDephealthGitHubAPI{
+		repos: map[string]*github.Repository{
+			"foo/old":  {Archived: github.Ptr(true)},
+			"foo/live": {Archived: github.Ptr(false)},
+		},
+	}}
+	signals, err := c.Collect(context.Background(), dir, signal.CollectorOpts{})
+	require.NoError(t, err)
+	require.Len(t, signals, 1)
+	assert.Equal(t, "Import of archived repository: github.com/foo/old/pkg", signals[0].Title)
+	assert.Equal(t, 4, signals[0].Line)
+	assert.Equal(t, []string{"deprecated-usage", "archived-repo"}, signals[0].Tags)
+
+	m, ok := c.Metrics().(*DeprecationMetrics)
+	require.True(t, ok)
+	assert.Equal(t, []string{"foo/old"}, m.ArchivedRepos)
+}
@@ -95,14 +95,14 @@ func checkHexDeps(ctx context.Context, client hexRegistryClient, deps []PackageQ
 			break
 		}
 		if checked >= maxHexChecks {
-			slog.Info("dephealth: reached hex.pm check cap", "cap", maxHexChecks)
+			slog.InfoContext(ctx, "dephealth: reached hex.pm check cap", "cap", maxHexChecks)
 			break
 		}
 		checked++
 
 		info, err := client.FetchPackage(ctx, dep.Name)
 		if err != nil {
-			slog.Debug("dephealth: hex.pm lookup failed", "package", dep.Name, "error", err)
+			slog.DebugContext(ctx, "dephealth: hex.pm lookup failed", "package", dep.Name, "error", err)
 			continue
 		}
 
@@ -98,7 +98,7 @@ func checkMavenDeps(ctx context.Context, client mavenRegistryClient, deps []Pack
 			break
 		}
 		if checked >= maxMavenChecks {
-			slog.Info("dephealth: reached Maven Central check cap", "cap", maxMavenChecks)
+			slog.InfoContext(ctx, "dephealth: reached Maven Central check cap", "cap", maxMavenChecks)
 			break
 		}
 		checked++
@@ -112,7 +112,7 @@ func checkMavenDeps(ctx context.Context, client mavenRegistryClient, deps []Pack
 
 		info, err := client.FetchArtifact(ctx, groupID, artifactID)
 		if err != nil {
-			slog.Debug("dephealth: maven lookup failed", "artifact", dep.Name, "error", err)
+			slog.DebugContext(ctx, "dephealth: maven lookup failed", "artifact", dep.Name, "error", err)
 			continue
 		}
 
@@ -85,14 +85,14 @@ func checkNpmDeps(ctx context.Context, client npmRegistryClient, deps []PackageQ
 			break
 		}
 		if checked >= maxNpmChecks {
-			slog.Info("dephealth: reached npm registry check cap", "cap", maxNpmChecks)
+			slog.InfoContext(ctx, "dephealth: reached npm registry check cap", "cap", maxNpmChecks)
 			break
 		}
 		checked++
 
 		info, err := client.FetchPackage(ctx, dep.Name)
 		if err != nil {
-			slog.Debug("dephealth: npm lookup failed", "package", dep.Name, "error", err)
+			slog.DebugContext(ctx, "dephealth: npm lookup failed", "package", dep.Name, "error", err)
 			continue
 		}
 
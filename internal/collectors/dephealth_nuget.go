@@ -108,14 +108,14 @@ func checkNuGetDeps(ctx context.Context, client nugetRegistryClient, deps []Pack
 			break
 		}
 		if checked >= maxNuGetChecks {
-			slog.Info("dephealth: reached NuGet check cap", "cap", maxNuGetChecks)
+			slog.InfoContext(ctx, "dephealth: reached NuGet check cap", "cap", maxNuGetChecks)
 			break
 		}
 		checked++
 
 		info, err := client.FetchRegistration(ctx, dep.Name)
 		if err != nil {
-			slog.Debug("dephealth: nuget lookup failed", "package", dep.Name, "error", err)
+			slog.DebugContext(ctx, "dephealth: nuget lookup failed", "package", dep.Name, "error", err)
 			continue
 		}
 
@@ -88,14 +88,14 @@ func checkPackagistDeps(ctx context.Context, client packagistRegistryClient, dep
 			break
 		}
 		if checked >= maxPackagistChecks {
-			slog.Info("dephealth: reached packagist check cap", "cap", maxPackagistChecks)
+			slog.InfoContext(ctx, "dephealth: reached packagist check cap", "cap", maxPackagistChecks)
 			break
 		}
 		checked++
 
 		info, err := client.FetchPackage(ctx, dep.Name)
 		if err != nil {
-			slog.Debug("dephealth: packagist lookup failed", "package", dep.Name, "error", err)
+			slog.DebugContext(ctx, "dephealth: packagist lookup failed", "package", dep.Name, "error", err)
 			continue
 		}
 
@@ -88,14 +88,14 @@ func checkPyPIDeps(ctx context.Context, client pypiRegistryClient, deps []Packag
 			break
 		}
 		if checked >= maxPyPIChecks {
-			slog.Info("dephealth: reached PyPI check cap", "cap", maxPyPIChecks)
+			slog.InfoContext(ctx, "dephealth: reached PyPI check cap", "cap", maxPyPIChecks)
 			break
 		}
 		checked++
 
 		info, err := client.FetchPackage(ctx, dep.Name)
 		if err != nil {
-			slog.Debug("dephealth: pypi lookup failed", "package", dep.Name, "error", err)
+			slog.DebugContext(ctx, "dephealth: pypi lookup failed", "package", dep.Name, "error", err)
 			continue
 		}
 
@@ -0,0 +1,219 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package collectors
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"golang.org/x/mod/modfile"
+
+	"github.com/davetashner/stringer/internal/signal"
+	"github.com/davetashner/stringer/internal/workspace"
+)
+
+// skewDecl is one dependency declaration in a workspace manifest.
+type skewDecl struct {
+	Ecosystem string // "go" or "npm"
+	Name      string
+	Version   string
+	Manifest  string // slash-separated, relative to the monorepo root
+}
+
+// collectVersionSkew compares the direct dependencies declared in the
+// go.mod and package.json of every workspace in the monorepo containing
+// repoPath, and emits a version-skew signal for each manifest that declares
+// a shared dependency at a version another manifest disagrees with. When
+// repoPath is the monorepo root every manifest is reported; when it is a
+// single workspace, only that workspace's manifests are.
+func collectVersionSkew(ctx context.Context, repoPath, gitRoot string) []signal.RawSignal {
+	root, layout, self := findMonorepo(ctx, repoPath, gitRoot)
+	if layout == nil {
+		return nil
+	}
+
+	dirs := []string{"."}
+	for _, ws := range layout.Workspaces {
+		if rel := filepath.ToSlash(filepath.Clean(ws.Rel)); rel != "." {
+			dirs = append(dirs, rel)
+		}
+	}
+
+	var decls []skewDecl
+	local := make(map[string]bool) // module and package names published by the monorepo
+	for _, dir := range dirs {
+		decls = append(decls, readSkewDecls(ctx, root, dir, local)...)
+	}
+
+	groups := make(map[string][]skewDecl)
+	for _, d := range decls {
+		if local[d.Ecosystem+"\x00"+d.Name] {
+			continue // sibling workspaces are linked, not versioned
+		}
+		key := d.Ecosystem + "\x00" + d.Name
+		groups[key] = append(groups[key], d)
+	}
+
+	var signals []signal.RawSignal
+	for _, group := range groups {
+		versions := make(map[string]bool)
+		for _, d := range group {
+			versions[d.Version] = true
+		}
+		if len(versions) < 2 {
+			continue
+		}
+		sort.Slice(group, func(i, j int) bool { return group[i].Manifest < group[j].Manifest })
+		for _, d := range group {
+			if self != "." && path.Dir(d.Manifest) != self {
+				continue
+			}
+			rel, err := filepath.Rel(repoPath, filepath.Join(root, filepath.FromSlash(d.Manifest)))
+			if err != nil {
+				continue
+			}
+			signals = append(signals, versionSkewSignal(d, group, rel))
+		}
+	}
+	sort.Slice(signals, func(i, j int) bool {
+		if signals[i].FilePath != signals[j].FilePath {
+			return signals[i].FilePath < signals[j].FilePath
+		}
+		return signals[i].Title < signals[j].Title
+	})
+	return signals
+}
+
+// versionSkewSignal reports d, whose version disagrees with others in group.
+func versionSkewSignal(d skewDecl, group []skewDecl, filePath string) signal.RawSignal {
+	var others []string
+	seen := map[string]bool{d.Version: true}
+	var lines []string
+	for _, g := range group {
+		lines = append(lines, fmt.Sprintf("  - %s: %s", g.Manifest, g.Version))
+		if !seen[g.Version] {
+			seen[g.Version] = true
+			others = append(others, g.Version)
+		}
+	}
+	sort.Strings(others)
+	return signal.RawSignal{
+		Source:   "dephealth",
+		Kind:     "version-skew",
+		FilePath: filePath,
+		Title: fmt.Sprintf("Version skew: %s %s in %s (elsewhere %s)",
+			d.Name, d.Version, d.Manifest, strings.Join(others, ", ")),
+		Description: fmt.Sprintf("%s is declared at %d versions across %d workspace manifests:\n%s\n"+
+			"Workspaces that build and test against different versions of a shared dependency drift apart; align them on one version.",
+			d.Name, len(seen), len(group), strings.Join(lines, "\n")),
+		Confidence: 0.5,
+		Tags:       []string{"version-skew", "dephealth", d.Ecosystem},
+	}
+}
+
+// findMonorepo returns the root and layout of the monorepo containing
+// repoPath, looking at repoPath and its parents up to gitRoot, and the
+// slash-separated workspace directory of repoPath ("." for the root). The
+// layout is nil when repoPath is neither a monorepo root nor one of its
+// workspaces.
+func findMonorepo(ctx context.Context, repoPath, gitRoot string) (string, *workspace.Layout, string) {
+	if resolved, err := filepath.EvalSymlinks(repoPath); err == nil {
+		repoPath = resolved
+	}
+	if gitRoot == "" {
+		gitRoot = repoPath
+	} else if resolved, err := filepath.EvalSymlinks(gitRoot); err == nil {
+		gitRoot = resolved
+	}
+
+	for dir := repoPath; ; {
+		layout, err := workspace.Detect(dir)
+		if err != nil {
+			slog.WarnContext(ctx, "dephealth: workspace detection failed", "path", dir, "error", err)
+		}
+		if layout != nil {
+			if dir == repoPath {
+				return layout.Root, layout, "."
+			}
+			for _, ws := range layout.Workspaces {
+				if filepath.Clean(ws.Path) == repoPath {
+					return layout.Root, layout, filepath.ToSlash(filepath.Clean(ws.Rel))
+				}
+			}
+			return "", nil, ""
+		}
+		parent := filepath.Dir(dir)
+		if dir == gitRoot || parent == dir || !strings.HasPrefix(dir, gitRoot) {
+			return "", nil, ""
+		}
+		dir = parent
+	}
+}
+
+// readSkewDecls returns the direct dependencies declared in dir's go.mod
+// and package.json, and records the module and package names they publish
+// in local.
+func readSkewDecls(ctx context.Context, root, dir string, local map[string]bool) []skewDecl {
+	var decls []skewDecl
+
+	goMod := path.Join(dir, "go.mod")
+	if data, err := readSkewManifest(root, goMod); data != nil {
+		if f, parseErr := modfile.Parse(goMod, data, nil); parseErr != nil {
+			slog.WarnContext(ctx, "dephealth: parsing go.mod for version skew", "path", goMod, "error", parseErr)
+		} else {
+			if f.Module != nil {
+				local["go\x00"+f.Module.Mod.Path] = true
+			}
+			for _, req := range f.Require {
+				if !req.Indirect {
+					decls = append(decls, skewDecl{Ecosystem: "go", Name: req.Mod.Path, Version: req.Mod.Version, Manifest: goMod})
+				}
+			}
+		}
+	} else if err != nil {
+		slog.WarnContext(ctx, "dephealth: reading go.mod for version skew", "path", goMod, "error", err)
+	}
+
+	pkgJSON := path.Join(dir, "package.json")
+	if data, err := readSkewManifest(root, pkgJSON); data != nil {
+		var pkg struct {
+			Name string `json:"name"`
+		}
+		deps, parseErr := parseNpmDeps(data)
+		if parseErr == nil {
+			parseErr = json.Unmarshal(data, &pkg)
+		}
+		if parseErr != nil {
+			slog.WarnContext(ctx, "dephealth: parsing package.json for version skew", "path", pkgJSON, "error", parseErr)
+		} else {
+			if pkg.Name != "" {
+				local["npm\x00"+pkg.Name] = true
+			}
+			for _, dep := range deps {
+				decls = append(decls, skewDecl{Ecosystem: "npm", Name: dep.Name, Version: dep.Version, Manifest: pkgJSON})
+			}
+		}
+	} else if err != nil {
+		slog.WarnContext(ctx, "dephealth: reading package.json for version skew", "path", pkgJSON, "error", err)
+	}
+	return decls
+}
+
+// readSkewManifest reads a root-relative manifest, returning nil, nil when
+// it does not exist.
+func readSkewManifest(root, rel string) ([]byte, error) {
+	data, err := FS.ReadFile(filepath.Join(root, filepath.FromSlash(rel)))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	return data, err
+}
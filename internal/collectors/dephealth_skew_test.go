@@ -0,0 +1,102 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package collectors
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// initSkewMonorepo writes an npm workspace where react is declared at two
+// versions and lodash at one.
+func initSkewMonorepo(t *testing.T) string {
+	t.Helper()
+	dir, err := filepath.EvalSymlinks(t.TempDir())
+	require.NoError(t, err)
+	writeTestFile(t, dir, "package.json", `{"name": "mono", "workspaces": ["packages/*"], "devDependencies": {"typescript": "^5.4.0"}}`)
+	writeTestFile(t, dir, "packages/web/package.json", `{"name": "@mono/web", "dependencies": {"react": "^18.2.0", "lodash": "4.17.21", "@mono/ui": "1.0.0"}}`)
+	writeTestFile(t, dir, "packages/ui/package.json", `{"name": "@mono/ui", "dependencies": {"react": "^17.0.2", "lodash": "~4.17.21"}, "devDependencies": {"typescript": "5.3.3"}}`)
+	writeTestFile(t, dir, "packages/docs/package.json", `{"name": "@mono/docs", "dependencies": {"react": "18.2.0", "@mono/ui": "0.9.0"}}`)
+	return dir
+}
+
+func TestCollectVersionSkew_Root(t *testing.T) {
+	dir := initSkewMonorepo(t)
+
+	signals := collectVersionSkew(context.Background(), dir, dir)
+	var titles []string
+	for _, s := range signals {
+		assert.Equal(t, "dephealth", s.Source)
+		assert.Equal(t, "version-skew", s.Kind)
+		assert.Equal(t, []string{"version-skew", "dephealth", "npm"}, s.Tags)
+		titles = append(titles, s.FilePath+" | "+s.Title)
+	}
+	assert.Equal(t, []string{
+		"package.json | Version skew: typescript 5.4.0 in package.json (elsewhere 5.3.3)",
+		"packages/docs/package.json | Version skew: react 18.2.0 in packages/docs/package.json (elsewhere 17.0.2)",
+		"packages/ui/package.json | Version skew: react 17.0.2 in packages/ui/package.json (elsewhere 18.2.0)",
+		"packages/ui/package.json | Version skew: typescript 5.3.3 in packages/ui/package.json (elsewhere 5.4.0)",
+		"packages/web/package.json | Version skew: react 18.2.0 in packages/web/package.json (elsewhere 17.0.2)",
+	}, titles, "sibling packages and matching versions are not skew")
+
+	assert.Contains(t, signals[1].Description, "react is declared at 2 versions across 3 workspace manifests:\n"+
+		"  - packages/docs/package.json: 18.2.0\n  - packages/ui/package.json: 17.0.2\n  - packages/web/package.json: 18.2.0\n")
+}
+
+func TestCollectVersionSkew_Workspace(t *testing.T) {
+	dir := initSkewMonorepo(t)
+
+	signals := collectVersionSkew(context.Background(), filepath.Join(dir, "packages", "ui"), dir)
+	require.Len(t, signals, 2)
+	for _, s := range signals {
+		assert.Equal(t, "package.json", s.FilePath, "paths are relative to the scanned workspace")
+	}
+	assert.Equal(t, "Version skew: react 17.0.2 in packages/ui/package.json (elsewhere 18.2.0)", signals[0].Title)
+}
+
+func TestCollectVersionSkew_GoWork(t *testing.T) {
+	dir, err := filepath.EvalSymlinks(t.TempDir())
+	require.NoError(t, err)
+	writeTestFile(t, dir, "go.work", "go 1.22\n\nuse (\n\t./api\n\t./worker\n)\n")
+	writeTestFile(t, dir, "api/go.mod", `module example.com/api
+
+go 1.22
+
+require (
+	example.com/worker v0.1.0
+	github.com/spf13/cobra v1.8.0
+	golang.org/x/sys v0.20.0 // indirect
+)
+`)
+	writeTestFile(t, dir, "worker/go.mod", `module example.com/worker
+
+go 1.22
+
+require (
+	github.com/spf13/cobra v1.7.0
+	golang.org/x/sys v0.18.0 // indirect
+)
+`)
+
+	signals := collectVersionSkew(context.Background(), dir, dir)
+	require.Len(t, signals, 2, "indirect requires and workspace modules are ignored")
+	assert.Equal(t, filepath.Join("api", "go.mod"), signals[0].FilePath)
+	assert.Equal(t, "Version skew: github.com/spf13/cobra v1.8.0 in api/go.mod (elsewhere v1.7.0)", signals[0].Title)
+	assert.Contains(t, signals[1].Tags, "go")
+}
+
+func TestCollectVersionSkew_NotMonorepo(t *testing.T) {
+	dir := t.TempDir()
+	writeTestFile(t, dir, "package.json", `{"dependencies": {"react": "18.2.0"}}`)
+	assert.Empty(t, collectVersionSkew(context.Background(), dir, dir))
+
+	// A directory inside a monorepo that is not one of its workspaces.
+	mono := initSkewMonorepo(t)
+	writeTestFile(t, mono, "tools/package.json", `{"dependencies": {"react": "16.0.0"}}`)
+	assert.Empty(t, collectVersionSkew(context.Background(), filepath.Join(mono, "tools"), mono))
+}
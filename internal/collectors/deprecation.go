@@ -0,0 +1,699 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package collectors
+
+import (
+	"context"
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/parser"
+	"go/token"
+	"log/slog"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/mod/modfile"
+	"golang.org/x/mod/module"
+
+	"github.com/davetashner/stringer/internal/collector"
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+// Confidence of deprecated-usage signals by where the deprecation comes
+// from. A deprecation the repo declares for itself is often a migration
+// still in progress, so its uses rank a little lower.
+const (
+	deprecatedExternalConfidence = 0.7
+	deprecatedInternalConfidence = 0.6
+	deprecatedRuleConfidence     = 0.7
+	archivedImportConfidence     = 0.6
+)
+
+func init() {
+	collector.Register(&DeprecationCollector{})
+}
+
+// DeprecationMetrics holds structured metrics from the deprecation scan.
+type DeprecationMetrics struct {
+	// DeprecatedSymbols counts the deprecated functions, types, constants,
+	// and variables found in the repo and the packages it imports.
+	DeprecatedSymbols int
+	PackagesLoaded    int
+	Usages            int
+	ArchivedRepos     []string
+}
+
+// DeprecationCollector finds uses of deprecated APIs: Go identifiers whose
+// doc comment has a "Deprecated:" paragraph, whether declared in the repo,
+// the standard library, or a dependency in the module cache; symbols and
+// patterns listed in deprecated_apis; and, when GITHUB_TOKEN is set, Go
+// imports of archived GitHub repositories.
+//
+// Go usages are found syntactically: a qualified reference through an
+// import, or an unqualified one to a package-level declaration in the same
+// package. Deprecated methods and struct fields need type information and
+// are not tracked. Test files are skipped, since tests of a deprecated API
+// are expected to call it.
+type DeprecationCollector struct {
+	metrics *DeprecationMetrics
+	ghAPI   dephealthGitHubAPI // nil uses GITHUB_TOKEN when set
+}
+
+// Name returns the collector name used for registration and filtering.
+func (c *DeprecationCollector) Name() string { return "deprecation" }
+
+// goPackageDeprecations is the deprecation data of one Go package.
+type goPackageDeprecations struct {
+	name    string            // package name, for unaliased imports
+	symbols map[string]string // identifier → "Deprecated:" paragraph
+}
+
+// deprecationRule is a compiled deprecated_apis entry.
+type deprecationRule struct {
+	signal.DeprecatedAPIConfig
+	importPath, name string
+	re               *regexp.Regexp
+}
+
+// goSourceFile is one parsed Go file of the repo.
+type goSourceFile struct {
+	rel  string // slash-separated, relative to the repo
+	file *ast.File
+}
+
+// deprecationScan holds the state of one Collect call.
+type deprecationScan struct {
+	repoPath   string
+	modulePath string
+	modFile    *modfile.File
+	fset       *token.FileSet
+	local      map[string][]goSourceFile // import path → non-test files
+	packages   map[string]*goPackageDeprecations
+	metrics    *DeprecationMetrics
+}
+
+// Collect parses the repo's Go files, loads the deprecations of every
+// package they import, and reports each use, plus each line matching a
+// deprecated_apis pattern.
+func (c *DeprecationCollector) Collect(ctx context.Context, repoPath string, opts signal.CollectorOpts) ([]signal.RawSignal, error) {
+	metrics := &DeprecationMetrics{}
+	c.metrics = metrics
+
+	symbolRules := make(map[string]map[string]deprecationRule)
+	var patternRules []deprecationRule
+	for _, r := range opts.DeprecatedAPIs {
+		rule, err := compileDeprecationRule(r)
+		if err != nil {
+			return nil, err
+		}
+		if rule.re != nil {
+			patternRules = append(patternRules, rule)
+			continue
+		}
+		if symbolRules[rule.importPath] == nil {
+			symbolRules[rule.importPath] = make(map[string]deprecationRule)
+		}
+		symbolRules[rule.importPath][rule.name] = rule
+	}
+
+	s := &deprecationScan{
+		repoPath:   repoPath,
+		modulePath: readGoModulePath(repoPath),
+		fset:       token.NewFileSet(),
+		local:      make(map[string][]goSourceFile),
+		packages:   make(map[string]*goPackageDeprecations),
+		metrics:    metrics,
+	}
+	if data, err := FS.ReadFile(filepath.Join(repoPath, "go.mod")); err == nil {
+		s.modFile, _ = modfile.Parse("go.mod", data, nil)
+	}
+
+	limits := newScanLimits(opts)
+	excludes := mergeExcludes(opts.ExcludePatterns)
+	var goFiles []goSourceFile
+	var signals []signal.RawSignal
+	err := walkTree(ctx, repoPath, func(p string, d os.DirEntry, walkErr error) error {
+		if walkErr != nil {
+			return nil
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		relPath, relErr := filepath.Rel(repoPath, p)
+		if relErr != nil {
+			return nil
+		}
+		if d.IsDir() {
+			if shouldExclude(relPath, excludes) || !opts.Scope.ContainsDir(relPath) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if shouldExclude(relPath, excludes) || isSymlink(d) {
+			return nil
+		}
+		if len(opts.IncludePatterns) > 0 && !matchesAny(relPath, opts.IncludePatterns) {
+			return nil
+		}
+		rel := filepath.ToSlash(relPath)
+
+		if len(patternRules) > 0 && rel != ".stringer.yaml" && !isBinaryFile(p) {
+			sigs, err := scanDeprecationPatterns(ctx, p, rel, patternRules, limits)
+			if err != nil {
+				return err
+			}
+			signals = append(signals, sigs...)
+		}
+
+		if filepath.Ext(p) != ".go" || strings.HasSuffix(p, "_test.go") || isGeneratedFile(p) {
+			return nil
+		}
+		src, err := FS.ReadFile(p)
+		if err != nil {
+			return nil
+		}
+		f, err := parser.ParseFile(s.fset, p, src, parser.ParseComments)
+		if err != nil {
+			slog.DebugContext(ctx, "deprecation: skipping unparsable file", "path", rel, "error", err)
+			return nil
+		}
+		gf := goSourceFile{rel: rel, file: f}
+		goFiles = append(goFiles, gf)
+		s.local[s.importPath(path.Dir(rel))] = append(s.local[s.importPath(path.Dir(rel))], gf)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	archived := c.archivedImports(ctx, goFiles)
+	for _, gf := range goFiles {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		signals = append(signals, s.fileUsages(gf, symbolRules, archived)...)
+	}
+
+	var kept []signal.RawSignal
+	seen := make(map[string]bool)
+	for _, sig := range signals {
+		key := fmt.Sprintf("%s\x00%d\x00%s", sig.FilePath, sig.Line, sig.Title)
+		if seen[key] || sig.Confidence < opts.MinConfidence {
+			continue
+		}
+		seen[key] = true
+		kept = append(kept, sig)
+	}
+	sort.SliceStable(kept, func(i, j int) bool {
+		if kept[i].FilePath != kept[j].FilePath {
+			return kept[i].FilePath < kept[j].FilePath
+		}
+		return kept[i].Line < kept[j].Line
+	})
+	metrics.Usages = len(kept)
+	return kept, nil
+}
+
+// Metrics returns structured metrics from the deprecation scan.
+func (c *DeprecationCollector) Metrics() any { return c.metrics }
+
+// compileDeprecationRule validates a deprecated_apis entry: a Go symbol
+// written "import/path.Name", or a regular expression matched per line.
+func compileDeprecationRule(r signal.DeprecatedAPIConfig) (deprecationRule, error) {
+	rule := deprecationRule{DeprecatedAPIConfig: r}
+	switch {
+	case r.Pattern != "" && r.Symbol == "":
+		re, err := regexp.Compile(r.Pattern)
+		if err != nil {
+			return rule, fmt.Errorf("deprecated_apis: invalid pattern %q: %w", r.Pattern, err)
+		}
+		rule.re = re
+	case r.Symbol != "" && r.Pattern == "":
+		importPath, name, ok := splitGoSymbol(r.Symbol)
+		if !ok {
+			return rule, fmt.Errorf("deprecated_apis: invalid symbol %q (want import/path.Name)", r.Symbol)
+		}
+		rule.importPath, rule.name = importPath, name
+	default:
+		return rule, fmt.Errorf("deprecated_apis: each entry needs exactly one of symbol or pattern")
+	}
+	return rule, nil
+}
+
+// splitGoSymbol splits "io/ioutil.ReadAll" into its import path and name.
+func splitGoSymbol(symbol string) (string, string, bool) {
+	slash := strings.LastIndexByte(symbol, '/')
+	dot := strings.LastIndexByte(symbol, '.')
+	if dot <= slash+1 || dot == len(symbol)-1 || !token.IsIdentifier(symbol[dot+1:]) {
+		return "", "", false
+	}
+	return symbol[:dot], symbol[dot+1:], true
+}
+
+// scanDeprecationPatterns reports each line of the file at p that matches a
+// pattern rule.
+func scanDeprecationPatterns(ctx context.Context, p, rel string, rules []deprecationRule, limits scanLimits) ([]signal.RawSignal, error) {
+	f, err := FS.Open(p)
+	if err != nil {
+		return nil, nil
+	}
+	defer f.Close() //nolint:errcheck // read-only file, close error is inconsequential
+
+	var signals []signal.RawSignal
+	lineNo := 0
+	_, err = limits.scanLines(ctx, f, func(line string) {
+		lineNo++
+		for _, r := range rules {
+			if m := r.re.FindString(line); m != "" {
+				signals = append(signals, deprecatedUsageSignal(rel, lineNo, m, r.Replacement, "rule", deprecatedRuleConfidence))
+			}
+		}
+	})
+	return signals, err
+}
+
+// importPath returns the import path of the repo package in dir.
+func (s *deprecationScan) importPath(dir string) string {
+	if dir == "." {
+		return s.modulePath
+	}
+	if s.modulePath == "" {
+		return dir
+	}
+	return s.modulePath + "/" + dir
+}
+
+// fileUsages reports the deprecated symbols and archived repositories the
+// file refers to.
+func (s *deprecationScan) fileUsages(gf goSourceFile, rules map[string]map[string]deprecationRule, archived map[string]bool) []signal.RawSignal {
+	var signals []signal.RawSignal
+	report := func(pos token.Pos, display, importPath, name string) {
+		line := s.fset.Position(pos).Line
+		if rule, ok := rules[importPath][name]; ok {
+			signals = append(signals, deprecatedUsageSignal(gf.rel, line, display, rule.Replacement, "rule", deprecatedRuleConfidence))
+			return
+		}
+		pkg := s.pkg(importPath)
+		if pkg == nil {
+			return
+		}
+		if note, ok := pkg.symbols[name]; ok {
+			confidence := deprecatedExternalConfidence
+			if s.isLocal(importPath) {
+				confidence = deprecatedInternalConfidence
+			}
+			signals = append(signals, deprecatedUsageSignal(gf.rel, line, display, note, "go-doc", confidence))
+		}
+	}
+
+	imports := make(map[string]string) // local name → import path
+	for _, spec := range gf.file.Imports {
+		importPath, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
+			continue
+		}
+		if owner, repo, ok := extractGitHubOwnerRepo(importPath); ok && archived[repoKey(owner, repo)] {
+			signals = append(signals, archivedImportSignal(gf.rel, s.fset.Position(spec.Pos()).Line, importPath, repoKey(owner, repo)))
+		}
+		name := ""
+		switch {
+		case spec.Name != nil:
+			name = spec.Name.Name
+		case s.pkg(importPath) != nil:
+			name = s.pkg(importPath).name
+		default:
+			name = path.Base(importPath)
+		}
+		if name != "_" && name != "." {
+			imports[name] = importPath
+		}
+	}
+
+	own := s.importPath(path.Dir(gf.rel))
+	ownPkg := s.pkg(own)
+	topLevel := make(map[any]bool)
+	for _, decl := range gf.file.Decls {
+		switch d := decl.(type) {
+		case *ast.FuncDecl:
+			topLevel[d] = true
+		case *ast.GenDecl:
+			for _, spec := range d.Specs {
+				topLevel[spec] = true
+			}
+		}
+	}
+
+	var visit func(n ast.Node) bool
+	visit = func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.SelectorExpr:
+			if x, ok := n.X.(*ast.Ident); ok && x.Obj == nil {
+				if importPath, ok := imports[x.Name]; ok {
+					report(n.Sel.Pos(), x.Name+"."+n.Sel.Name, importPath, n.Sel.Name)
+					return false
+				}
+			}
+			ast.Inspect(n.X, visit)
+			return false
+		case *ast.KeyValueExpr:
+			// Composite literal keys are usually field names.
+			if _, ok := n.Key.(*ast.Ident); ok {
+				ast.Inspect(n.Value, visit)
+				return false
+			}
+		case *ast.Ident:
+			if n.Obj != nil && (n.Obj.Pos() == n.Pos() || !topLevel[n.Obj.Decl]) {
+				return false // a declaration, or a local shadowing the name
+			}
+			_, isRule := rules[own][n.Name]
+			_, isDeprecated := ownPkg.lookup(n.Name)
+			if isRule || isDeprecated {
+				report(n.Pos(), n.Name, own, n.Name)
+			}
+		}
+		return true
+	}
+	for _, decl := range gf.file.Decls {
+		switch d := decl.(type) {
+		case *ast.FuncDecl:
+			if deprecationNote(d.Doc) != "" {
+				continue
+			}
+			if d.Recv != nil {
+				ast.Inspect(d.Recv, visit)
+			}
+			ast.Inspect(d.Type, visit)
+			if d.Body != nil {
+				ast.Inspect(d.Body, visit)
+			}
+		case *ast.GenDecl:
+			if d.Tok == token.IMPORT {
+				continue
+			}
+			for _, spec := range d.Specs {
+				if specDeprecation(d, spec) == "" {
+					ast.Inspect(spec, visit)
+				}
+			}
+		}
+	}
+	return signals
+}
+
+// lookup returns the deprecation note for name; p may be nil.
+func (p *goPackageDeprecations) lookup(name string) (string, bool) {
+	if p == nil {
+		return "", false
+	}
+	note, ok := p.symbols[name]
+	return note, ok
+}
+
+// isLocal reports whether importPath is a package of the scanned repo.
+func (s *deprecationScan) isLocal(importPath string) bool {
+	_, ok := s.local[importPath]
+	return ok
+}
+
+// pkg returns the deprecations of the package at importPath, loading it
+// from the repo, its vendor directory, GOROOT, or the module cache. It
+// returns nil for a package whose source is not available.
+func (s *deprecationScan) pkg(importPath string) *goPackageDeprecations {
+	if p, ok := s.packages[importPath]; ok {
+		return p
+	}
+	var files []*ast.File
+	if local, ok := s.local[importPath]; ok {
+		for _, gf := range local {
+			files = append(files, gf.file)
+		}
+	} else if dir := s.packageDir(importPath); dir != "" {
+		files = parsePackageDir(dir)
+	}
+	var p *goPackageDeprecations
+	if len(files) > 0 {
+		p = &goPackageDeprecations{name: files[0].Name.Name, symbols: make(map[string]string)}
+		for _, f := range files {
+			for name, note := range fileDeprecations(f) {
+				p.symbols[name] = note
+			}
+		}
+		s.metrics.PackagesLoaded++
+		s.metrics.DeprecatedSymbols += len(p.symbols)
+	}
+	s.packages[importPath] = p
+	return p
+}
+
+// packageDir finds the source directory of a package outside the repo:
+// vendor/ first, then GOROOT for the standard library, then a local
+// replace directive or the module cache for a go.mod requirement.
+func (s *deprecationScan) packageDir(importPath string) string {
+	if dir := filepath.Join(s.repoPath, "vendor", filepath.FromSlash(importPath)); isDir(dir) {
+		return dir
+	}
+	first, _, _ := strings.Cut(importPath, "/")
+	if !strings.Contains(first, ".") {
+		if dir := filepath.Join(build.Default.GOROOT, "src", filepath.FromSlash(importPath)); isDir(dir) {
+			return dir
+		}
+		return ""
+	}
+	if s.modFile == nil {
+		return ""
+	}
+
+	var modPath, version string
+	for _, req := range s.modFile.Require {
+		p := req.Mod.Path
+		if (importPath == p || strings.HasPrefix(importPath, p+"/")) && len(p) > len(modPath) {
+			modPath, version = p, req.Mod.Version
+		}
+	}
+	if modPath == "" {
+		return ""
+	}
+	sub := filepath.FromSlash(strings.TrimPrefix(importPath[len(modPath):], "/"))
+	for _, rep := range s.modFile.Replace {
+		if rep.Old.Path != modPath {
+			continue
+		}
+		if rep.New.Version == "" { // a local directory
+			dir := rep.New.Path
+			if !filepath.IsAbs(dir) {
+				dir = filepath.Join(s.repoPath, dir)
+			}
+			return filepath.Join(dir, sub)
+		}
+		modPath, version = rep.New.Path, rep.New.Version
+	}
+
+	escPath, err1 := module.EscapePath(modPath)
+	escVersion, err2 := module.EscapeVersion(version)
+	if err1 != nil || err2 != nil {
+		return ""
+	}
+	dir := filepath.Join(goModCache(), escPath+"@"+escVersion, sub)
+	if !isDir(dir) {
+		return ""
+	}
+	return dir
+}
+
+// goModCache returns the module cache directory, as go env GOMODCACHE
+// would.
+func goModCache() string {
+	if dir := os.Getenv("GOMODCACHE"); dir != "" {
+		return dir
+	}
+	gopath := build.Default.GOPATH
+	if list := filepath.SplitList(os.Getenv("GOPATH")); len(list) > 0 && list[0] != "" {
+		gopath = list[0]
+	}
+	return filepath.Join(gopath, "pkg", "mod")
+}
+
+// isDir reports whether path is an existing directory.
+func isDir(path string) bool {
+	info, err := FS.Stat(path)
+	return err == nil && info.IsDir()
+}
+
+// parsePackageDir parses the non-test Go files of one package directory.
+// Only declarations and their doc comments are needed, so files that fail
+// to parse are skipped rather than failing the package.
+func parsePackageDir(dir string) []*ast.File {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil
+	}
+	fset := token.NewFileSet()
+	var files []*ast.File
+	for _, e := range entries {
+		name := e.Name()
+		if e.IsDir() || filepath.Ext(name) != ".go" || strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+		src, err := FS.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			continue
+		}
+		f, err := parser.ParseFile(fset, name, src, parser.ParseComments|parser.SkipObjectResolution)
+		if err != nil || f.Name.Name == "documentation" {
+			continue
+		}
+		files = append(files, f)
+	}
+	return files
+}
+
+// fileDeprecations returns the package-level functions, types, constants,
+// and variables of f whose doc comment has a "Deprecated:" paragraph.
+func fileDeprecations(f *ast.File) map[string]string {
+	symbols := make(map[string]string)
+	for _, decl := range f.Decls {
+		switch d := decl.(type) {
+		case *ast.FuncDecl:
+			if d.Recv == nil {
+				if note := deprecationNote(d.Doc); note != "" {
+					symbols[d.Name.Name] = note
+				}
+			}
+		case *ast.GenDecl:
+			for _, spec := range d.Specs {
+				note := specDeprecation(d, spec)
+				if note == "" {
+					continue
+				}
+				switch sp := spec.(type) {
+				case *ast.TypeSpec:
+					symbols[sp.Name.Name] = note
+				case *ast.ValueSpec:
+					for _, n := range sp.Names {
+						symbols[n.Name] = note
+					}
+				}
+			}
+		}
+	}
+	return symbols
+}
+
+// specDeprecation returns the deprecation note of one spec: its own doc
+// comment's, or else that of its declaration group.
+func specDeprecation(d *ast.GenDecl, spec ast.Spec) string {
+	var doc *ast.CommentGroup
+	switch sp := spec.(type) {
+	case *ast.TypeSpec:
+		doc = sp.Doc
+	case *ast.ValueSpec:
+		doc = sp.Doc
+	default:
+		return ""
+	}
+	if note := deprecationNote(doc); note != "" {
+		return note
+	}
+	return deprecationNote(d.Doc)
+}
+
+// deprecationNote returns the "Deprecated:" paragraph of a doc comment,
+// with its whitespace collapsed, or "" if there is none.
+func deprecationNote(doc *ast.CommentGroup) string {
+	if doc == nil {
+		return ""
+	}
+	for _, para := range strings.Split(doc.Text(), "\n\n") {
+		para = strings.TrimSpace(para)
+		if strings.HasPrefix(para, "Deprecated:") {
+			return strings.Join(strings.Fields(para), " ")
+		}
+	}
+	return ""
+}
+
+// deprecatedUsageSignal builds the signal for one use of a deprecated API.
+// hint is the deprecation note or configured replacement, if any.
+func deprecatedUsageSignal(file string, line int, api, hint, source string, confidence float64) signal.RawSignal {
+	desc := fmt.Sprintf("%s is deprecated.", api)
+	switch {
+	case strings.HasPrefix(hint, "Deprecated:"):
+		desc += " " + hint
+	case hint != "":
+		desc += " Replacement: " + hint
+	default:
+		desc += " No replacement is documented."
+	}
+	return signal.RawSignal{
+		Source:      "deprecation",
+		Kind:        "deprecated-usage",
+		FilePath:    file,
+		Line:        line,
+		Title:       fmt.Sprintf("Deprecated API: %s", api),
+		Description: desc,
+		Confidence:  confidence,
+		Tags:        []string{"deprecated-usage", source},
+	}
+}
+
+// archivedImportSignal builds the signal for an import of a package from an
+// archived GitHub repository.
+func archivedImportSignal(file string, line int, importPath, repo string) signal.RawSignal {
+	return signal.RawSignal{
+		Source:   "deprecation",
+		Kind:     "deprecated-usage",
+		FilePath: file,
+		Line:     line,
+		Title:    fmt.Sprintf("Import of archived repository: %s", importPath),
+		Description: fmt.Sprintf("GitHub repository %s is archived and receives no further fixes. "+
+			"Replace %s with a maintained alternative.", repo, importPath),
+		Confidence: archivedImportConfidence,
+		Tags:       []string{"deprecated-usage", "archived-repo"},
+	}
+}
+
+// archivedImports returns the GitHub repositories, as owner/repo, that the
+// given files import from and that are archived. It needs GITHUB_TOKEN (or
+// an injected client) and returns nil without one.
+func (c *DeprecationCollector) archivedImports(ctx context.Context, files []goSourceFile) map[string]bool {
+	var deps []ModuleDep
+	seen := make(map[string]bool)
+	for _, gf := range files {
+		for _, spec := range gf.file.Imports {
+			importPath, err := strconv.Unquote(spec.Path.Value)
+			if err != nil {
+				continue
+			}
+			if owner, repo, ok := extractGitHubOwnerRepo(importPath); ok && !seen[repoKey(owner, repo)] {
+				seen[repoKey(owner, repo)] = true
+				deps = append(deps, ModuleDep{Path: "github.com/" + repoKey(owner, repo)})
+			}
+		}
+	}
+	if len(deps) == 0 {
+		return nil
+	}
+
+	api := c.ghAPI
+	if api == nil {
+		token := os.Getenv("GITHUB_TOKEN")
+		if token == "" {
+			slog.InfoContext(ctx, "GITHUB_TOKEN not set, skipping archived import checks")
+			return nil
+		}
+		api = newDepHealthGitHubAPI(ctx, token)
+	}
+	archived := make(map[string]bool)
+	for _, repo := range archivedGitHubRepos(ctx, api, deps) {
+		archived[repo] = true
+		c.metrics.ArchivedRepos = append(c.metrics.ArchivedRepos, repo)
+	}
+	return archived
+}
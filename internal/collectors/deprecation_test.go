@@ -0,0 +1,266 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package collectors
+
+import (
+	"context"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/mod/modfile"
+
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+// writeDeprecationRepo writes a small Go module that declares and uses
+// deprecated APIs.
+func writeDeprecationRepo(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	files := map[string]string{
+		"go.mod": "module example.com/app\n\ngo 1.22\n",
+		"old/old.go": `package old
+
+// Old does the old thing.
+//
+// Deprecated: Use New instead.
+func Old() int { return helper() }
+
+// New does the new thing.
+func New() int { return helper() }
+
+// Legacy is the old option set.
+//
+// Deprecated: Options replaced it.
+type Legacy struct{ Flag bool }
+
+// Deprecated: the v1 limits no longer apply.
+const (
+	MaxV1 = 10
+	MinV1 = 1
+)
+
+// Keep is listed in deprecated_apis.
+func Keep() {}
+
+// Wrapper stays on the deprecated path on purpose.
+//
+// Deprecated: Use New.
+func Wrapper() int { return Old() }
+`,
+		"old/helper.go": `package old
+
+// helper is only for this package.
+//
+// Deprecated: inline it.
+func helper() int { return 1 }
+
+func uses() int {
+	helper := func() int { return 2 } // shadows the package-level helper
+	return helper() + MaxV1
+}
+`,
+		"cmd/app/main.go": `package main
+
+import (
+	"io/ioutil"
+	"os"
+
+	legacy "example.com/app/old"
+)
+
+func main() {
+	_ = legacy.Old()
+	_ = legacy.New()
+	_ = legacy.Legacy{Flag: true}
+	legacy.Keep()
+	_, _ = ioutil.ReadAll(os.Stdin)
+}
+`,
+		"cmd/app/main_test.go": `package main
+
+import (
+	"testing"
+
+	"example.com/app/old"
+)
+
+func TestOld(t *testing.T) { _ = old.Old() }
+`,
+		"web/app.js": "$.ajax({url: '/api'});\nfetch('/api');\n",
+	}
+	for name, content := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o750))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	}
+	return dir
+}
+
+func TestDeprecationCollector_Name(t *testing.T) {
+	assert.Equal(t, "deprecation", (&DeprecationCollector{}).Name())
+}
+
+func TestDeprecationCollector_Collect(t *testing.T) {
+	t.Setenv("GITHUB_TOKEN", "")
+	dir := writeDeprecationRepo(t)
+
+	c := &DeprecationCollector{}
+	signals, err := c.Collect(context.Background(), dir, signal.CollectorOpts{
+		DeprecatedAPIs: []signal.DeprecatedAPIConfig{
+			{Symbol: "example.com/app/old.Keep", Replacement: "call New directly"},
+			{Pattern: `\$\.ajax\(`, Replacement: "fetch"},
+		},
+	})
+	require.NoError(t, err)
+
+	type got struct {
+		File  string
+		Line  int
+		Title string
+	}
+	var all []got
+	for _, s := range signals {
+		assert.Equal(t, "deprecation", s.Source)
+		assert.Equal(t, "deprecated-usage", s.Kind)
+		all = append(all, got{s.FilePath, s.Line, s.Title})
+	}
+	assert.Equal(t, []got{
+		{"cmd/app/main.go", 11, "Deprecated API: legacy.Old"},
+		{"cmd/app/main.go", 13, "Deprecated API: legacy.Legacy"},
+		{"cmd/app/main.go", 14, "Deprecated API: legacy.Keep"},
+		{"cmd/app/main.go", 15, "Deprecated API: ioutil.ReadAll"},
+		{"old/helper.go", 10, "Deprecated API: MaxV1"},
+		{"old/old.go", 9, "Deprecated API: helper"},
+		{"web/app.js", 1, "Deprecated API: $.ajax("},
+	}, all, "test files, deprecated declarations, and shadowed names are skipped")
+
+	byTitle := make(map[string]signal.RawSignal)
+	for _, s := range signals {
+		byTitle[s.Title] = s
+	}
+	assert.Equal(t, "legacy.Old is deprecated. Deprecated: Use New instead.", byTitle["Deprecated API: legacy.Old"].Description)
+	assert.Equal(t, []string{"deprecated-usage", "go-doc"}, byTitle["Deprecated API: legacy.Old"].Tags)
+	assert.InDelta(t, 0.6, byTitle["Deprecated API: legacy.Old"].Confidence, 1e-9, "repo-declared deprecations rank lower")
+	assert.InDelta(t, 0.7, byTitle["Deprecated API: ioutil.ReadAll"].Confidence, 1e-9)
+	assert.Contains(t, byTitle["Deprecated API: ioutil.ReadAll"].Description, "Deprecated: As of Go 1.16")
+	assert.Equal(t, "legacy.Keep is deprecated. Replacement: call New directly", byTitle["Deprecated API: legacy.Keep"].Description)
+	assert.Equal(t, []string{"deprecated-usage", "rule"}, byTitle["Deprecated API: $.ajax("].Tags)
+
+	m, ok := c.Metrics().(*DeprecationMetrics)
+	require.True(t, ok)
+	assert.Equal(t, 7, m.Usages)
+	assert.GreaterOrEqual(t, m.DeprecatedSymbols, 8)
+	assert.Empty(t, m.ArchivedRepos)
+}
+
+func TestDeprecationCollector_Filters(t *testing.T) {
+	t.Setenv("GITHUB_TOKEN", "")
+	dir := writeDeprecationRepo(t)
+
+	signals, err := (&DeprecationCollector{}).Collect(context.Background(), dir, signal.CollectorOpts{
+		ExcludePatterns: []string{"old/**"},
+		MinConfidence:   0.65,
+	})
+	require.NoError(t, err)
+	require.Len(t, signals, 1, "old/ is excluded, so only the standard library deprecation remains")
+	assert.Equal(t, "Deprecated API: ioutil.ReadAll", signals[0].Title)
+}
+
+func TestDeprecationCollector_InvalidRule(t *testing.T) {
+	for _, r := range []signal.DeprecatedAPIConfig{
+		{Pattern: "("},
+		{Symbol: "noDot"},
+		{Symbol: "a/b.C", Pattern: "x"},
+		{},
+	} {
+		_, err := (&DeprecationCollector{}).Collect(context.Background(), t.TempDir(), signal.CollectorOpts{DeprecatedAPIs: []signal.DeprecatedAPIConfig{r}})
+		assert.Error(t, err, "%+v", r)
+	}
+}
+
+func TestSplitGoSymbol(t *testing.T) {
+	for _, tc := range []struct {
+		symbol, path, name string
+		ok                 bool
+	}{
+		{"io/ioutil.ReadAll", "io/ioutil", "ReadAll", true},
+		{"github.com/foo/bar.Baz", "github.com/foo/bar", "Baz", true},
+		{"strings.Title", "strings", "Title", true},
+		{"github.com/foo/bar", "", "", false},
+		{"pkg.", "", "", false},
+		{"pkg.1x", "", "", false},
+	} {
+		path, name, ok := splitGoSymbol(tc.symbol)
+		assert.Equal(t, tc.ok, ok, tc.symbol)
+		assert.Equal(t, tc.path, path, tc.symbol)
+		assert.Equal(t, tc.name, name, tc.symbol)
+	}
+}
+
+func TestDeprecationNote(t *testing.T) {
+	src := `package p
+
+// A is fine.
+func A() {}
+
+// B is old.
+//
+// Deprecated: Use A,
+// which is faster.
+func B() {}
+
+// Deprecated in name only.
+func C() {}
+`
+	f, err := parser.ParseFile(token.NewFileSet(), "p.go", src, parser.ParseComments)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"B": "Deprecated: Use A, which is faster."}, fileDeprecations(f))
+}
+
+func TestGoModCache(t *testing.T) {
+	t.Setenv("GOMODCACHE", "/cache/mod")
+	assert.Equal(t, "/cache/mod", goModCache())
+
+	t.Setenv("GOMODCACHE", "")
+	t.Setenv("GOPATH", "/home/me/go")
+	assert.Equal(t, filepath.Join("/home/me/go", "pkg", "mod"), goModCache())
+}
+
+func TestDeprecationScan_PackageDir(t *testing.T) {
+	dir := writeDeprecationRepo(t)
+	cache := t.TempDir()
+	t.Setenv("GOMODCACHE", cache)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "go.mod"), []byte(`module example.com/app
+
+go 1.22
+
+require (
+	github.com/Foo/bar v1.2.0
+	example.com/local v0.0.0
+)
+
+replace example.com/local => ./third_party/local
+`), 0o600))
+	modDir := filepath.Join(cache, "github.com", "!foo", "bar@v1.2.0", "sub")
+	require.NoError(t, os.MkdirAll(modDir, 0o750))
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "third_party", "local"), 0o750))
+
+	data, err := os.ReadFile(filepath.Join(dir, "go.mod")) //nolint:gosec // test file
+	require.NoError(t, err)
+	mf, err := modfile.Parse("go.mod", data, nil)
+	require.NoError(t, err)
+
+	s := &deprecationScan{repoPath: dir, modFile: mf}
+	assert.Equal(t, modDir, s.packageDir("github.com/Foo/bar/sub"))
+	assert.Equal(t, filepath.Join(dir, "third_party", "local"), s.packageDir("example.com/local"))
+	assert.Empty(t, s.packageDir("github.com/other/repo"))
+	assert.NotEmpty(t, s.packageDir("io/ioutil"))
+	assert.Empty(t, s.packageDir("no/such/stdpkg"))
+}
@@ -94,7 +94,7 @@ func (c *DocStaleCollector) Collect(ctx context.Context, repoPath string, opts s
 	var docFiles []string
 	var signals []signal.RawSignal
 
-	err := FS.WalkDir(repoPath, func(path string, d os.DirEntry, walkErr error) error {
+	err := walkTree(ctx, repoPath, func(path string, d os.DirEntry, walkErr error) error {
 		if walkErr != nil {
 			return nil
 		}
@@ -108,7 +108,7 @@ func (c *DocStaleCollector) Collect(ctx context.Context, repoPath string, opts s
 		}
 
 		if d.IsDir() {
-			if shouldExclude(relPath, excludes) {
+			if shouldExclude(relPath, excludes) || !opts.Scope.ContainsDir(relPath) {
 				return filepath.SkipDir
 			}
 			return nil
@@ -122,6 +122,10 @@ func (c *DocStaleCollector) Collect(ctx context.Context, repoPath string, opts s
 			return nil
 		}
 
+		if !opts.Scope.Contains(relPath) {
+			return nil
+		}
+
 		if !isDocFile(relPath) {
 			return nil
 		}
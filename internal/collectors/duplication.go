@@ -72,7 +72,7 @@ func (c *DuplicationCollector) Collect(ctx context.Context, repoPath string, opt
 	var files []fileData
 	var fileCount int
 
-	err := FS.WalkDir(repoPath, func(path string, d os.DirEntry, walkErr error) error {
+	err := walkTree(ctx, repoPath, func(path string, d os.DirEntry, walkErr error) error {
 		if walkErr != nil {
 			return nil
 		}
@@ -86,7 +86,7 @@ func (c *DuplicationCollector) Collect(ctx context.Context, repoPath string, opt
 		}
 
 		if d.IsDir() {
-			if shouldExclude(relPath, excludes) {
+			if shouldExclude(relPath, excludes) || !opts.Scope.ContainsDir(relPath) {
 				return filepath.SkipDir
 			}
 			return nil
@@ -96,8 +96,9 @@ func (c *DuplicationCollector) Collect(ctx context.Context, repoPath string, opt
 			return nil
 		}
 
-		// Skip symlinks outside repo tree.
-		if d.Type()&os.ModeSymlink != 0 && isSymlinkOutsideRepo(path, repoPath) {
+		// Skip symlinks; targets inside the tree are visited at their
+		// canonical path.
+		if isSymlink(d) {
 			return nil
 		}
 
@@ -105,6 +106,10 @@ func (c *DuplicationCollector) Collect(ctx context.Context, repoPath string, opt
 			return nil
 		}
 
+		if !opts.Scope.Contains(relPath) {
+			return nil
+		}
+
 		ext := filepath.Ext(path)
 		if !sourceExtensions[ext] {
 			return nil
@@ -0,0 +1,177 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package collectors
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/charmap"
+	"golang.org/x/text/encoding/japanese"
+	"golang.org/x/text/encoding/unicode"
+	"golang.org/x/text/transform"
+)
+
+// encodingSniffLen is the number of leading bytes inspected to detect a
+// file's text encoding.
+const encodingSniffLen = 1024
+
+// textEncoding describes the detected encoding of a file.
+type textEncoding struct {
+	// name is the label recorded in verbose output, e.g. "utf-16le".
+	name string
+
+	// enc transcodes the file to UTF-8. It is nil for UTF-8 files, which
+	// are read as-is, and for binary content.
+	enc encoding.Encoding
+
+	// binary is true when the content is not recognizable text in any
+	// supported encoding.
+	binary bool
+}
+
+var (
+	encodingUTF8    = textEncoding{name: "utf-8"}
+	encodingUTF8BOM = textEncoding{name: "utf-8-bom", enc: unicode.UTF8BOM}
+	encodingUTF16LE = textEncoding{name: "utf-16le", enc: unicode.UTF16(unicode.LittleEndian, unicode.UseBOM)}
+	encodingUTF16BE = textEncoding{name: "utf-16be", enc: unicode.UTF16(unicode.BigEndian, unicode.UseBOM)}
+	encodingSJIS    = textEncoding{name: "shift_jis", enc: japanese.ShiftJIS}
+	encodingLatin1  = textEncoding{name: "windows-1252", enc: charmap.Windows1252}
+	encodingBinary  = textEncoding{name: "binary", binary: true}
+)
+
+// detectEncoding guesses the encoding of content from its leading bytes.
+// atEOF reports whether head holds the whole file; when false, a multi-byte
+// sequence cut off at the end of head is not held against an encoding.
+//
+// A byte order mark is trusted first. Without one, NUL bytes in an
+// alternating pattern indicate UTF-16 and any other NUL means binary.
+// NUL-free content is UTF-8 if valid. Otherwise content with many control
+// characters is binary, and the rest is Shift-JIS if it decodes cleanly,
+// else Windows-1252, which accepts any byte sequence.
+func detectEncoding(head []byte, atEOF bool) textEncoding {
+	switch {
+	case bytes.HasPrefix(head, []byte{0xEF, 0xBB, 0xBF}):
+		return encodingUTF8BOM
+	case bytes.HasPrefix(head, []byte{0xFF, 0xFE}):
+		return encodingUTF16LE
+	case bytes.HasPrefix(head, []byte{0xFE, 0xFF}):
+		return encodingUTF16BE
+	}
+
+	if bytes.IndexByte(head, 0) >= 0 {
+		return detectUTF16(head)
+	}
+
+	if validUTF8Prefix(head, atEOF) {
+		return encodingUTF8
+	}
+	if hasBinaryControls(head) {
+		return encodingBinary
+	}
+	if decodesCleanly(japanese.ShiftJIS, head, atEOF) {
+		return encodingSJIS
+	}
+	return encodingLatin1
+}
+
+// detectUTF16 recognizes BOM-less UTF-16 from the position of its NUL
+// bytes. Mostly-ASCII UTF-16 text has a NUL in every other byte: the odd
+// positions for little-endian, the even positions for big-endian.
+func detectUTF16(head []byte) textEncoding {
+	var even, odd int
+	for i, b := range head {
+		if b != 0 {
+			continue
+		}
+		if i%2 == 0 {
+			even++
+		} else {
+			odd++
+		}
+	}
+	units := len(head) / 2
+	switch {
+	case even == 0 && odd*2 >= units:
+		return encodingUTF16LE
+	case odd == 0 && even*2 >= units:
+		return encodingUTF16BE
+	}
+	return encodingBinary
+}
+
+// hasBinaryControls reports whether more than one byte in 32 of head is a
+// C0 control character that text does not use. Only the whitespace
+// controls and ESC, for terminal colors, appear in text files in any
+// encoding; binary formats without NUL bytes are full of the others.
+func hasBinaryControls(head []byte) bool {
+	var n int
+	for _, b := range head {
+		switch {
+		case b == '\t', b == '\n', b == '\v', b == '\f', b == '\r', b == 0x1B:
+		case b < 0x20, b == 0x7F:
+			n++
+		}
+	}
+	return n*32 > len(head)
+}
+
+// validUTF8Prefix reports whether head is valid UTF-8, allowing a rune
+// truncated at the end when more content follows.
+func validUTF8Prefix(head []byte, atEOF bool) bool {
+	if utf8.Valid(head) {
+		return true
+	}
+	if atEOF {
+		return false
+	}
+	for cut := 1; cut < utf8.UTFMax && cut <= len(head); cut++ {
+		if utf8.Valid(head[:len(head)-cut]) {
+			return true
+		}
+	}
+	return false
+}
+
+// decodesCleanly reports whether head decodes with enc without producing
+// replacement characters.
+func decodesCleanly(enc encoding.Encoding, head []byte, atEOF bool) bool {
+	dst := make([]byte, 3*len(head)+utf8.UTFMax)
+	n, _, err := enc.NewDecoder().Transform(dst, head, atEOF)
+	if err != nil && err != transform.ErrShortSrc {
+		return false
+	}
+	return !bytes.ContainsRune(dst[:n], utf8.RuneError)
+}
+
+// sniffEncoding reads up to encodingSniffLen bytes from r to detect its
+// encoding.
+func sniffEncoding(r io.Reader) (textEncoding, error) {
+	head := make([]byte, encodingSniffLen)
+	n, err := io.ReadFull(r, head)
+	switch err {
+	case nil:
+		return detectEncoding(head, false), nil
+	case io.EOF, io.ErrUnexpectedEOF:
+		return detectEncoding(head[:n], true), nil
+	default:
+		return textEncoding{}, err
+	}
+}
+
+// isUndecodableFile returns true if the file is neither UTF-8 nor text in
+// another supported encoding. Unlike isBinaryFile, it accepts UTF-16.
+func isUndecodableFile(ctx context.Context, path string) bool {
+	f, err := openSource(ctx, path)
+	if err != nil {
+		return true // treat unreadable as binary to skip
+	}
+	defer f.Close() //nolint:errcheck // read-only file, close error is inconsequential
+
+	enc, err := sniffEncoding(f)
+	return err != nil || enc.binary
+}
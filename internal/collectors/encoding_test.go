@@ -0,0 +1,123 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package collectors
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/charmap"
+	"golang.org/x/text/encoding/japanese"
+	"golang.org/x/text/encoding/unicode"
+
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+func encode(t *testing.T, enc encoding.Encoding, s string) []byte {
+	t.Helper()
+	b, err := enc.NewEncoder().Bytes([]byte(s))
+	require.NoError(t, err)
+	return b
+}
+
+func TestDetectEncoding(t *testing.T) {
+	const src = "package main\n// TODO: 設定を読み込む\n"
+	utf16le := unicode.UTF16(unicode.LittleEndian, unicode.IgnoreBOM)
+	utf16be := unicode.UTF16(unicode.BigEndian, unicode.IgnoreBOM)
+
+	tests := []struct {
+		name    string
+		content []byte
+		want    string
+	}{
+		{"utf-8", []byte(src), "utf-8"},
+		{"empty", nil, "utf-8"},
+		{"utf-8 bom", append([]byte{0xEF, 0xBB, 0xBF}, src...), "utf-8-bom"},
+		{"utf-16le bom", append([]byte{0xFF, 0xFE}, encode(t, utf16le, src)...), "utf-16le"},
+		{"utf-16be bom", append([]byte{0xFE, 0xFF}, encode(t, utf16be, src)...), "utf-16be"},
+		{"utf-16le no bom", encode(t, utf16le, src), "utf-16le"},
+		{"utf-16be no bom", encode(t, utf16be, src), "utf-16be"},
+		{"shift_jis", encode(t, japanese.ShiftJIS, src), "shift_jis"},
+		{"windows-1252", encode(t, charmap.Windows1252, "// TODO: café au lait\n"), "windows-1252"},
+		{"binary", []byte{0x89, 'P', 'N', 'G', 0x00, 0x00, 0x00, 0x0D, 0x00, 0x01}, "binary"},
+		{"binary without NUL", []byte{0x1F, 0x8B, 0x08, 0x08, 0xE5, 0x9C, 0x03, 0x02, 0xFF, 0xA1, 0x17, 0xC3, 0x81, 0x05}, "binary"},
+		{"windows-1252 with escapes", encode(t, charmap.Windows1252, "\x1b[1m// TODO: café\x1b[0m\r\n"), "windows-1252"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, detectEncoding(tt.content, true).name)
+		})
+	}
+}
+
+func TestDetectEncoding_TruncatedRune(t *testing.T) {
+	// A multi-byte rune cut off at the sniff boundary is still UTF-8.
+	head := []byte("// TODO: 設定")
+	head = head[:len(head)-1]
+	assert.Equal(t, "utf-8", detectEncoding(head, false).name)
+	assert.NotEqual(t, "utf-8", detectEncoding(head, true).name)
+}
+
+func TestSniffEncoding_LongInput(t *testing.T) {
+	enc, err := sniffEncoding(strings.NewReader(strings.Repeat("x", 4*encodingSniffLen)))
+	require.NoError(t, err)
+	assert.Equal(t, "utf-8", enc.name)
+}
+
+func TestIsUndecodableFile(t *testing.T) {
+	dir := t.TempDir()
+	utf16Path := filepath.Join(dir, "utf16.go")
+	require.NoError(t, os.WriteFile(utf16Path,
+		append([]byte{0xFF, 0xFE}, encode(t, unicode.UTF16(unicode.LittleEndian, unicode.IgnoreBOM), "// TODO: x\n")...), 0o600))
+	binPath := filepath.Join(dir, "binary.dat")
+	require.NoError(t, os.WriteFile(binPath, make([]byte, 64), 0o600))
+
+	assert.False(t, isUndecodableFile(context.Background(), utf16Path))
+	assert.True(t, isBinaryFile(utf16Path), "isBinaryFile keeps rejecting UTF-16 for other collectors")
+	assert.True(t, isUndecodableFile(context.Background(), binPath))
+	assert.True(t, isUndecodableFile(context.Background(), filepath.Join(dir, "missing.go")))
+}
+
+func TestScanFile_TranscodesEncodings(t *testing.T) {
+	const src = "package main\n\n// TODO: 設定を読み込む\nfunc main() {}\n"
+	tests := []struct {
+		name    string
+		content []byte
+	}{
+		{"utf-16le bom", append([]byte{0xFF, 0xFE}, encode(t, unicode.UTF16(unicode.LittleEndian, unicode.IgnoreBOM), src)...)},
+		{"utf-16be bom", append([]byte{0xFE, 0xFF}, encode(t, unicode.UTF16(unicode.BigEndian, unicode.IgnoreBOM), src)...)},
+		{"shift_jis", encode(t, japanese.ShiftJIS, src)},
+		{"utf-8 bom", append([]byte{0xEF, 0xBB, 0xBF}, src...)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "main.go")
+			require.NoError(t, os.WriteFile(path, tt.content, 0o600))
+
+			signals, err := scanFile(context.Background(), path, "main.go", newScanLimits(signal.CollectorOpts{}))
+			require.NoError(t, err)
+			require.Len(t, signals, 1)
+			assert.Equal(t, "TODO: 設定を読み込む", signals[0].Title)
+			assert.Equal(t, 3, signals[0].Line)
+		})
+	}
+}
+
+func TestCountLines_UTF16(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "big.go")
+	content := append([]byte{0xFF, 0xFE},
+		encode(t, unicode.UTF16(unicode.LittleEndian, unicode.IgnoreBOM), strings.Repeat("line\n", 10))...)
+	require.NoError(t, os.WriteFile(path, content, 0o600))
+
+	count, truncated, err := countLines(context.Background(), path, newScanLimits(signal.CollectorOpts{}))
+	require.NoError(t, err)
+	assert.False(t, truncated)
+	assert.Equal(t, 10, count)
+}
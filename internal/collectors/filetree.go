@@ -0,0 +1,278 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package collectors
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+const (
+	// sharedFileMax is the largest file whose contents FileTrees keeps;
+	// larger files are read from disk by each collector.
+	sharedFileMax = 1 << 20
+
+	// sharedBytesMax caps the file contents FileTrees holds at once. The
+	// collectors sharing them walk in the same order at about the same
+	// pace, so the oldest are dropped first.
+	sharedBytesMax = 64 << 20
+)
+
+// FileTrees is what the collectors walking source files (todos, patterns,
+// and lotteryrisk) share during a scan: the entries of each scanned
+// directory, enumerated once, and the recently read contents of its files,
+// so a file read by one collector is not read from disk again by the next.
+// Directories excluded by default and paths git ignores are left out of the
+// enumeration. It is safe for concurrent use.
+type FileTrees struct {
+	mu    sync.Mutex
+	trees map[treeKey]*fileTree
+
+	files    map[string]sharedContent
+	queue    []string // paths in files, oldest first
+	bytes    int
+	maxBytes int
+}
+
+// treeKey identifies one enumeration: the walked root and the matcher of
+// the paths left out of it.
+type treeKey struct {
+	root   string
+	ignore signal.IgnoreMatcher
+}
+
+// NewFileTrees returns an empty FileTrees.
+func NewFileTrees() *FileTrees {
+	return &FileTrees{
+		trees:    make(map[treeKey]*fileTree),
+		files:    make(map[string]sharedContent),
+		maxBytes: sharedBytesMax,
+	}
+}
+
+type fileTreesKey struct{}
+
+// WithFileTrees returns a context whose collectors share t. Without it,
+// each collector walks and reads the tree on its own.
+func WithFileTrees(ctx context.Context, t *FileTrees) context.Context {
+	return context.WithValue(ctx, fileTreesKey{}, t)
+}
+
+// fileTreesFrom returns the FileTrees attached to ctx, or nil.
+func fileTreesFrom(ctx context.Context) *FileTrees {
+	t, _ := ctx.Value(fileTreesKey{}).(*FileTrees)
+	return t
+}
+
+// walkSource walks root like walkTree, leaving out the directories every
+// source-walking collector excludes by default and the paths
+// opts.Ignore reports. When ctx carries a FileTrees, the entries come from
+// its enumeration of root instead of the disk.
+func walkSource(ctx context.Context, root string, opts signal.CollectorOpts, fn fs.WalkDirFunc) error {
+	t := fileTreesFrom(ctx)
+	if t == nil {
+		return walkPruned(ctx, root, opts.Ignore, fn)
+	}
+	entries, err := t.tree(root, opts.Ignore).load(ctx)
+	if err != nil {
+		return err
+	}
+	return replay(entries, fn)
+}
+
+// walkPruned walks root with walkTree, skipping default-excluded
+// directories and files and the paths ignore reports.
+func walkPruned(ctx context.Context, root string, ignore signal.IgnoreMatcher, fn fs.WalkDirFunc) error {
+	return walkTree(ctx, root, func(path string, d fs.DirEntry, walkErr error) error {
+		if walkErr == nil && path != root && pruned(root, path, d, ignore) {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		return fn(path, d, walkErr)
+	})
+}
+
+// pruned reports whether the entry at path below root is excluded by
+// default or ignored by git.
+func pruned(root, path string, d fs.DirEntry, ignore signal.IgnoreMatcher) bool {
+	if rel, err := filepath.Rel(root, path); err == nil && shouldExclude(rel, defaultExcludePatterns) {
+		return true
+	}
+	return ignore != nil && ignore.Ignored(path, d.IsDir())
+}
+
+// tree returns the enumeration of root without the paths ignore reports.
+func (t *FileTrees) tree(root string, ignore signal.IgnoreMatcher) *fileTree {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	key := treeKey{root: root, ignore: ignore}
+	ft, ok := t.trees[key]
+	if !ok {
+		ft = &fileTree{root: root, ignore: ignore, sem: make(chan struct{}, 1)}
+		t.trees[key] = ft
+	}
+	return ft
+}
+
+// fileTree is one enumeration of a directory.
+type fileTree struct {
+	root   string
+	ignore signal.IgnoreMatcher
+
+	sem     chan struct{} // held while enumerating
+	loaded  bool
+	entries []walkEntry
+}
+
+// walkEntry is one call a walk made to its WalkDirFunc.
+type walkEntry struct {
+	path string
+	d    fs.DirEntry
+	err  error
+}
+
+// load enumerates the tree on first use. Callers arriving meanwhile wait
+// for it. An enumeration cut short by a cancelled context is not kept, so
+// the next caller starts over.
+func (ft *fileTree) load(ctx context.Context) ([]walkEntry, error) {
+	select {
+	case ft.sem <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	defer func() { <-ft.sem }()
+	if ft.loaded {
+		return ft.entries, nil
+	}
+
+	var entries []walkEntry
+	err := walkPruned(ctx, ft.root, ft.ignore, func(path string, d fs.DirEntry, walkErr error) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		entries = append(entries, walkEntry{path: path, d: d, err: walkErr})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	ft.entries, ft.loaded = entries, true
+	return entries, nil
+}
+
+// replay calls fn for entries as WalkDir would have: fs.SkipDir skips the
+// rest of a directory, or of a file's parent directory, and fs.SkipAll
+// ends the walk.
+func replay(entries []walkEntry, fn fs.WalkDirFunc) error {
+	skip := ""
+	for _, e := range entries {
+		if skip != "" && (skip == "." || strings.HasPrefix(e.path, skip+string(filepath.Separator))) {
+			continue
+		}
+		skip = ""
+
+		err := fn(e.path, e.d, e.err)
+		switch {
+		case err == nil:
+		case errors.Is(err, fs.SkipDir):
+			if e.d != nil && e.d.IsDir() {
+				skip = e.path
+			} else {
+				skip = filepath.Dir(e.path)
+			}
+		case errors.Is(err, fs.SkipAll):
+			return nil
+		default:
+			return err
+		}
+	}
+	return nil
+}
+
+// sourceFile is a file opened to be read line by line: on disk, or from
+// the contents a FileTrees shares.
+type sourceFile interface {
+	io.ReadCloser
+	Name() string
+	Stat() (fs.FileInfo, error)
+}
+
+// sharedContent is a file's contents as first read.
+type sharedContent struct {
+	data []byte
+	info fs.FileInfo
+}
+
+// memFile reads shared contents.
+type memFile struct {
+	*bytes.Reader
+	name string
+	info fs.FileInfo
+}
+
+func (f *memFile) Name() string               { return f.name }
+func (f *memFile) Stat() (fs.FileInfo, error) { return f.info, nil }
+func (f *memFile) Close() error               { return nil }
+
+// openSource opens the file at path for reading. When ctx carries a
+// FileTrees, the contents another collector read are reused, and those of
+// small files read now are kept for the next.
+func openSource(ctx context.Context, path string) (sourceFile, error) {
+	t := fileTreesFrom(ctx)
+	if t == nil {
+		return FS.Open(path)
+	}
+
+	t.mu.Lock()
+	c, ok := t.files[path]
+	t.mu.Unlock()
+	if ok {
+		return &memFile{Reader: bytes.NewReader(c.data), name: path, info: c.info}, nil
+	}
+
+	f, err := FS.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	info, err := f.Stat()
+	if err != nil || !info.Mode().IsRegular() || info.Size() > sharedFileMax {
+		return f, nil
+	}
+	data, err := io.ReadAll(f)
+	f.Close() //nolint:errcheck,gosec // read-only file, close error is inconsequential
+	if err != nil {
+		return nil, err
+	}
+	t.keep(path, sharedContent{data: data, info: info})
+	return &memFile{Reader: bytes.NewReader(data), name: path, info: info}, nil
+}
+
+// keep stores the contents of path, dropping the oldest kept contents
+// beyond t.maxBytes.
+func (t *FileTrees) keep(path string, c sharedContent) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if _, ok := t.files[path]; ok {
+		return
+	}
+	t.files[path] = c
+	t.queue = append(t.queue, path)
+	t.bytes += len(c.data)
+	for t.bytes > t.maxBytes && len(t.queue) > 0 {
+		oldest := t.queue[0]
+		t.queue = t.queue[1:]
+		t.bytes -= len(t.files[oldest].data)
+		delete(t.files, oldest)
+	}
+}
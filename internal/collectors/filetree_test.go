@@ -0,0 +1,212 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package collectors
+
+import (
+	"context"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davetashner/stringer/internal/gitignore"
+	"github.com/davetashner/stringer/internal/signal"
+	"github.com/davetashner/stringer/internal/testable"
+)
+
+// writeTree creates each file under dir with the given content.
+func writeTree(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+	for name, content := range files {
+		p := filepath.Join(dir, filepath.FromSlash(name))
+		require.NoError(t, os.MkdirAll(filepath.Dir(p), 0o750))
+		require.NoError(t, os.WriteFile(p, []byte(content), 0o600))
+	}
+}
+
+// visited returns the paths, relative to root, a walk passes to fn.
+func visited(t *testing.T, walk func(fs.WalkDirFunc) error, root string, fn fs.WalkDirFunc) []string {
+	t.Helper()
+	var paths []string
+	require.NoError(t, walk(func(path string, d fs.DirEntry, err error) error {
+		rel, _ := filepath.Rel(root, path)
+		paths = append(paths, filepath.ToSlash(rel))
+		return fn(path, d, err)
+	}))
+	return paths
+}
+
+func TestWalkSource_ReplayMatchesWalk(t *testing.T) {
+	dir := t.TempDir()
+	writeTree(t, dir, map[string]string{
+		"a.go":              "",
+		"b/c.go":            "",
+		"b/d/e.go":          "",
+		"b/z.go":            "",
+		"f/g.go":            "",
+		"f/h.go":            "",
+		"vendor/v.go":       "",
+		"node_modules/n.js": "",
+		".gitignore":        "dist/\n",
+		"dist/out.js":       "",
+	})
+	opts := signal.CollectorOpts{Ignore: gitignore.New(dir)}
+	shared := WithFileTrees(context.Background(), NewFileTrees())
+
+	callbacks := map[string]fs.WalkDirFunc{
+		"all": func(string, fs.DirEntry, error) error { return nil },
+		"skip dir": func(path string, d fs.DirEntry, _ error) error {
+			if d.IsDir() && filepath.Base(path) == "d" {
+				return fs.SkipDir
+			}
+			return nil
+		},
+		"skip from file": func(path string, _ fs.DirEntry, _ error) error {
+			if filepath.Base(path) == "c.go" {
+				return fs.SkipDir
+			}
+			return nil
+		},
+		"skip all": func(path string, _ fs.DirEntry, _ error) error {
+			if filepath.Base(path) == "f" {
+				return fs.SkipAll
+			}
+			return nil
+		},
+	}
+	for name, fn := range callbacks {
+		t.Run(name, func(t *testing.T) {
+			direct := visited(t, func(fn fs.WalkDirFunc) error {
+				return walkSource(context.Background(), dir, opts, fn)
+			}, dir, fn)
+			replayed := visited(t, func(fn fs.WalkDirFunc) error {
+				return walkSource(shared, dir, opts, fn)
+			}, dir, fn)
+			assert.Equal(t, direct, replayed)
+			for _, p := range direct {
+				assert.NotContains(t, []string{"vendor", "node_modules", "dist"}, strings.Split(p, "/")[0])
+			}
+		})
+	}
+}
+
+func TestWalkSource_EnumeratesOnce(t *testing.T) {
+	oldFS := FS
+	defer func() { FS = oldFS }()
+	walks := 0
+	FS = &testable.MockFileSystem{WalkDirFn: func(root string, fn fs.WalkDirFunc) error {
+		walks++
+		return filepath.WalkDir(root, fn)
+	}}
+
+	dir := t.TempDir()
+	writeTree(t, dir, map[string]string{"a.go": "", "b/c.go": ""})
+	ctx := WithFileTrees(context.Background(), NewFileTrees())
+	for range 3 {
+		require.NoError(t, walkSource(ctx, dir, signal.CollectorOpts{}, func(string, fs.DirEntry, error) error { return nil }))
+	}
+	assert.Equal(t, 1, walks)
+
+	// A different ignore matcher is a different enumeration.
+	require.NoError(t, walkSource(ctx, dir, signal.CollectorOpts{Ignore: gitignore.New(dir)}, func(string, fs.DirEntry, error) error { return nil }))
+	assert.Equal(t, 2, walks)
+}
+
+func TestWalkSource_CancelledEnumerationNotKept(t *testing.T) {
+	dir := t.TempDir()
+	writeTree(t, dir, map[string]string{"a.go": ""})
+	trees := NewFileTrees()
+
+	cancelled, cancel := context.WithCancel(WithFileTrees(context.Background(), trees))
+	cancel()
+	err := walkSource(cancelled, dir, signal.CollectorOpts{}, func(string, fs.DirEntry, error) error { return nil })
+	require.ErrorIs(t, err, context.Canceled)
+
+	var files int
+	err = walkSource(WithFileTrees(context.Background(), trees), dir, signal.CollectorOpts{}, func(_ string, d fs.DirEntry, _ error) error {
+		if !d.IsDir() {
+			files++
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1, files)
+}
+
+func TestOpenSource_SharesContents(t *testing.T) {
+	dir := t.TempDir()
+	small := filepath.Join(dir, "small.go")
+	large := filepath.Join(dir, "large.go")
+	writeTree(t, dir, map[string]string{"small.go": "v1", "large.go": strings.Repeat("x", sharedFileMax+1)})
+	ctx := WithFileTrees(context.Background(), NewFileTrees())
+
+	read := func(path string) string {
+		f, err := openSource(ctx, path)
+		require.NoError(t, err)
+		defer f.Close() //nolint:errcheck // test
+		data, err := io.ReadAll(f)
+		require.NoError(t, err)
+		return string(data)
+	}
+
+	assert.Equal(t, "v1", read(small))
+	assert.Len(t, read(large), sharedFileMax+1)
+	writeTree(t, dir, map[string]string{"small.go": "v2", "large.go": "y"})
+	assert.Equal(t, "v1", read(small), "the contents first read are shared")
+	assert.Equal(t, "y", read(large), "large files are read from disk each time")
+
+	f, err := openSource(ctx, small)
+	require.NoError(t, err)
+	info, err := f.Stat()
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), info.Size())
+	assert.Equal(t, small, f.Name())
+
+	_, err = openSource(ctx, filepath.Join(dir, "missing.go"))
+	assert.ErrorIs(t, err, fs.ErrNotExist)
+}
+
+func TestFileTrees_KeepDropsOldest(t *testing.T) {
+	trees := NewFileTrees()
+	trees.maxBytes = 10
+	trees.keep("a", sharedContent{data: []byte("aaaa")})
+	trees.keep("b", sharedContent{data: []byte("bbbb")})
+	trees.keep("b", sharedContent{data: []byte("bbbb")})
+	trees.keep("c", sharedContent{data: []byte("cccc")})
+
+	assert.NotContains(t, trees.files, "a")
+	assert.Contains(t, trees.files, "b")
+	assert.Contains(t, trees.files, "c")
+	assert.Equal(t, 8, trees.bytes)
+}
+
+func TestFileTrees_CollectorsAgree(t *testing.T) {
+	dir := t.TempDir()
+	writeTree(t, dir, map[string]string{
+		"main.go":      "package main\n// TODO: wire flags\n" + strings.Repeat("var _ = 1\n", 1100),
+		"util/util.go": "package util\n// FIXME: handle nil\n",
+		".gitignore":   "build/\n",
+		"build/gen.go": "// TODO: ignored\n",
+	})
+	opts := signal.CollectorOpts{Ignore: gitignore.New(dir)}
+	shared := WithFileTrees(context.Background(), NewFileTrees())
+
+	for _, c := range []interface {
+		Collect(context.Context, string, signal.CollectorOpts) ([]signal.RawSignal, error)
+	}{&TodoCollector{}, &PatternsCollector{}} {
+		want, err := c.Collect(context.Background(), dir, opts)
+		require.NoError(t, err)
+		got, err := c.Collect(shared, dir, opts)
+		require.NoError(t, err)
+		assert.Equal(t, want, got)
+		for _, sig := range got {
+			assert.NotContains(t, sig.FilePath, "build")
+		}
+	}
+}
@@ -1,15 +1,16 @@
 // Copyright 2026 The Stringer Authors
 // SPDX-License-Identifier: MIT
 
-// Package collectors provides signal extraction modules for stringer.
+//go:build !nonetwork
+
 package collectors
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"math"
-	"net/url"
 	"os"
 	"regexp"
 	"sort"
@@ -19,7 +20,6 @@ import (
 	"github.com/google/go-github/v68/github"
 
 	"github.com/davetashner/stringer/internal/collector"
-	"github.com/davetashner/stringer/internal/gitcli"
 	"github.com/davetashner/stringer/internal/signal"
 	"github.com/davetashner/stringer/internal/testable"
 )
@@ -35,9 +35,6 @@ const (
 // actionablePattern matches comment text containing actionable language.
 var actionablePattern = regexp.MustCompile(`(?i)\b(TODO|FIXME|should|needs|must)\b`)
 
-// sshRemotePattern matches git@github.com:owner/repo.git SSH URLs.
-var sshRemotePattern = regexp.MustCompile(`^git@github\.com:([^/]+)/([^/]+?)(?:\.git)?$`)
-
 func init() {
 	collector.Register(&GitHubCollector{})
 }
@@ -96,17 +93,38 @@ type GitHubCollector struct {
 // Name returns the collector name used for registration and filtering.
 func (c *GitHubCollector) Name() string { return "github" }
 
+// CheckCapabilities reports a skip reason when GITHUB_TOKEN is unset or the
+// repository has no GitHub remote.
+func (c *GitHubCollector) CheckCapabilities(_ context.Context, repoPath string, opts signal.CollectorOpts) string {
+	if os.Getenv("GITHUB_TOKEN") == "" {
+		return reasonNoToken
+	}
+	opener := c.GitOpener
+	if opener == nil {
+		opener = testable.DefaultGitOpener
+	}
+	gitPath := repoPath
+	if opts.GitRoot != "" {
+		gitPath = opts.GitRoot
+	}
+	if _, _, err := githubRepoFor(opener, gitPath, opts); err != nil {
+		return "no GitHub remote"
+	}
+	return ""
+}
+
 // Collect fetches open issues, PRs, and review comments from GitHub and
-// returns them as raw signals.
+// returns them as raw signals, along with large-batch-pattern signals for
+// modules whose merged PRs are typically oversized.
 func (c *GitHubCollector) Collect(ctx context.Context, repoPath string, opts signal.CollectorOpts) ([]signal.RawSignal, error) {
 	// Check for GITHUB_TOKEN.
 	token := os.Getenv("GITHUB_TOKEN")
 	if token == "" {
-		slog.Info("GITHUB_TOKEN not set, skipping GitHub collector (set via: export GITHUB_TOKEN=$(gh auth token))")
+		slog.InfoContext(ctx, "GITHUB_TOKEN not set, skipping GitHub collector (set via: export GITHUB_TOKEN=$(gh auth token))")
 		return nil, nil
 	}
 
-	// Parse owner/repo from git remote.
+	// Parse owner/repo from git remote, unless scan --remote named it.
 	// Use GitRoot when set so that subdirectory scans (e.g. individual Cargo
 	// workspace members) resolve the remote from the repo root rather than the
 	// crate directory, which has no .git of its own.
@@ -118,18 +136,18 @@ func (c *GitHubCollector) Collect(ctx context.Context, repoPath string, opts sig
 	if opts.GitRoot != "" {
 		gitPath = opts.GitRoot
 	}
-	owner, repo, err := parseGitHubRemoteWith(opener, gitPath)
+	owner, repo, err := githubRepoFor(opener, gitPath, opts)
 	if err != nil {
-		slog.Info("cannot determine GitHub remote, skipping GitHub collector", "error", err)
+		slog.InfoContext(ctx, "cannot determine GitHub remote, skipping GitHub collector", "error", err)
 		return nil, nil
 	}
 
 	// Create API client.
 	api := c.api
 	if api == nil {
-		client := github.NewClient(nil).WithAuthToken(token)
-		api = &realGitHubAPI{client: client}
+		api = &realGitHubAPI{client: NewGitHubClient(token)}
 	}
+	api = githubDataFrom(ctx).wrap(api)
 
 	// Read config values with defaults.
 	maxIssues := defaultMaxIssuesPerCollector
@@ -146,28 +164,43 @@ func (c *GitHubCollector) Collect(ctx context.Context, repoPath string, opts sig
 	if includeClosed && opts.HistoryDepth != "" {
 		if d, parseErr := ParseDuration(opts.HistoryDepth); parseErr == nil {
 			historyCutoff = time.Now().Add(-d)
-			slog.Info("history depth filter", "cutoff", historyCutoff.Format(time.RFC3339))
+			slog.InfoContext(ctx, "history depth filter", "cutoff", historyCutoff.Format(time.RFC3339))
 		} else {
-			slog.Warn("invalid history-depth, ignoring", "value", opts.HistoryDepth, "error", parseErr)
+			slog.WarnContext(ctx, "invalid history-depth, ignoring", "value", opts.HistoryDepth, "error", parseErr)
 		}
 	}
 
 	var signals []signal.RawSignal
 
-	// Fetch issues.
+	// Fetch issues, then PRs. When the scan's GitHub API budget runs out,
+	// keep what was fetched rather than failing the collector.
 	issueSigs, err := fetchIssues(ctx, api, owner, repo, maxIssues, includeClosed, historyCutoff)
+	signals = append(signals, issueSigs...)
 	if err != nil {
+		if errors.Is(err, ErrGitHubBudgetExhausted) {
+			return budgetStop(ctx, signals), nil
+		}
 		return nil, fmt.Errorf("fetching issues: %w", err)
 	}
-	signals = append(signals, issueSigs...)
 
-	// Fetch PRs.
 	if includePRs {
 		prSigs, prErr := fetchPullRequests(ctx, api, owner, repo, maxIssues, commentDepth, includeClosed, historyCutoff)
+		signals = append(signals, prSigs...)
 		if prErr != nil {
+			if errors.Is(prErr, ErrGitHubBudgetExhausted) {
+				return budgetStop(ctx, signals), nil
+			}
 			return nil, fmt.Errorf("fetching pull requests: %w", prErr)
 		}
-		signals = append(signals, prSigs...)
+
+		batchSigs, batchErr := analyzeBatchSizes(ctx, api, owner, repo, opts.LargeBatchThreshold, mergeExcludes(opts.ExcludePatterns), time.Now())
+		if batchErr != nil {
+			if errors.Is(batchErr, ErrGitHubBudgetExhausted) {
+				return budgetStop(ctx, signals), nil
+			}
+			return nil, fmt.Errorf("analyzing pull request sizes: %w", batchErr)
+		}
+		signals = append(signals, batchSigs...)
 	}
 
 	// Sort by FilePath for deterministic output.
@@ -178,82 +211,21 @@ func (c *GitHubCollector) Collect(ctx context.Context, repoPath string, opts sig
 	return signals, nil
 }
 
-// parseGitHubRemote extracts the owner and repo name from the git remote
-// origin URL. Supports both HTTPS and SSH formats.
-// Uses the default git opener.
-func parseGitHubRemote(repoPath string) (owner, repo string, err error) {
-	return parseGitHubRemoteWith(testable.DefaultGitOpener, repoPath)
-}
-
-// parseGitHubRemoteWith extracts the owner and repo name using the provided
-// GitOpener. This allows tests to inject a mock opener.
-// When go-git cannot open the repository (e.g. because the repo has
-// extensions.worktreeConfig=true which go-git does not yet support), it falls
-// back to shelling out to the system git binary via gitcli.
-func parseGitHubRemoteWith(opener testable.GitOpener, repoPath string) (owner, repo string, err error) {
-	gitRepo, err := opener.PlainOpen(repoPath)
-	if err != nil {
-		// Fall back to the system git CLI when go-git cannot open the repo.
-		// This handles repos with unsupported extensions (e.g. worktreeConfig).
-		rawURL, cliErr := gitcli.Exec(context.Background(), repoPath, "remote", "get-url", "origin")
-		if cliErr != nil {
-			return "", "", fmt.Errorf("opening repo: %w", err)
-		}
-		return parseGitHubURL(strings.TrimSpace(rawURL))
-	}
-
-	remotes, err := gitRepo.Remotes()
-	if err != nil {
-		return "", "", fmt.Errorf("listing remotes: %w", err)
-	}
-
-	// Find origin remote.
-	var originURLs []string
-	for _, r := range remotes {
-		if r.Config().Name == "origin" {
-			originURLs = r.Config().URLs
-			break
-		}
-	}
-	if len(originURLs) == 0 {
-		return "", "", fmt.Errorf("no origin remote found")
-	}
-
-	rawURL := originURLs[0]
-	return parseGitHubURL(rawURL)
-}
-
-// parseGitHubURL parses a GitHub URL (HTTPS or SSH) into owner and repo.
-func parseGitHubURL(rawURL string) (owner, repo string, err error) {
-	// Try SSH format: git@github.com:owner/repo.git
-	if m := sshRemotePattern.FindStringSubmatch(rawURL); m != nil {
-		return m[1], m[2], nil
-	}
-
-	// Try HTTPS format: https://github.com/owner/repo.git
-	parsed, err := url.Parse(rawURL)
-	if err != nil {
-		return "", "", fmt.Errorf("parsing URL %q: %w", rawURL, err)
-	}
-
-	if parsed.Host != "github.com" {
-		return "", "", fmt.Errorf("remote %q is not a GitHub URL", rawURL)
-	}
-
-	parts := strings.Split(strings.Trim(parsed.Path, "/"), "/")
-	if len(parts) < 2 {
-		return "", "", fmt.Errorf("cannot parse owner/repo from %q", rawURL)
-	}
-
-	owner = parts[0]
-	repo = strings.TrimSuffix(parts[1], ".git")
-	return owner, repo, nil
+// budgetStop logs that the GitHub API budget ran out and returns signals
+// sorted as Collect returns them. The scan warns about the budget once.
+func budgetStop(ctx context.Context, signals []signal.RawSignal) []signal.RawSignal {
+	slog.InfoContext(ctx, "GitHub API request budget used up, keeping the GitHub signals fetched so far", "signals", len(signals))
+	sort.Slice(signals, func(i, j int) bool {
+		return signals[i].FilePath < signals[j].FilePath
+	})
+	return signals
 }
 
 // fetchIssues fetches issues (excluding PRs) from GitHub. When includeClosed
 // is true, it fetches all issues (open and closed) and classifies closed ones
 // with dedicated kinds and lower confidence. If historyCutoff is non-zero,
-// closed items with ClosedAt before the cutoff are skipped.
+// closed items with ClosedAt before the cutoff are skipped. When a request
+// fails, the signals of the pages before it are returned with the error.
 func fetchIssues(ctx context.Context, api githubAPI, owner, repo string, maxIssues int, includeClosed bool, historyCutoff time.Time) ([]signal.RawSignal, error) {
 	var signals []signal.RawSignal
 	state := "open"
@@ -276,7 +248,7 @@ func fetchIssues(ctx context.Context, api githubAPI, owner, repo string, maxIssu
 
 		issues, resp, err := api.ListIssues(ctx, owner, repo, opts)
 		if err != nil {
-			return nil, fmt.Errorf("listing issues: %w", err)
+			return signals, fmt.Errorf("listing issues: %w", err)
 		}
 
 		for _, issue := range issues {
@@ -330,6 +302,7 @@ func fetchIssues(ctx context.Context, api githubAPI, owner, repo string, maxIssu
 				Timestamp:   issue.GetCreatedAt().Time,
 				Confidence:  confidence,
 				Tags:        tags,
+				URL:         issue.GetHTMLURL(),
 			}
 			if issue.GetState() == "closed" && issue.ClosedAt != nil {
 				sig.ClosedAt = issue.ClosedAt.Time
@@ -354,6 +327,8 @@ func fetchIssues(ctx context.Context, api githubAPI, owner, repo string, maxIssu
 // comments. When includeClosed is true, it also fetches merged and
 // closed-not-merged PRs with dedicated kinds and lower confidence.
 // If historyCutoff is non-zero, closed PRs before the cutoff are skipped.
+// When a request fails, the signals of the PRs before it are returned
+// with the error.
 func fetchPullRequests(ctx context.Context, api githubAPI, owner, repo string, maxIssues, commentDepth int, includeClosed bool, historyCutoff time.Time) ([]signal.RawSignal, error) {
 	var signals []signal.RawSignal
 	state := "open"
@@ -376,7 +351,7 @@ func fetchPullRequests(ctx context.Context, api githubAPI, owner, repo string, m
 
 		prs, resp, err := api.ListPullRequests(ctx, owner, repo, opts)
 		if err != nil {
-			return nil, fmt.Errorf("listing pull requests: %w", err)
+			return signals, fmt.Errorf("listing pull requests: %w", err)
 		}
 
 		for _, pr := range prs {
@@ -431,7 +406,7 @@ func fetchPullRequests(ctx context.Context, api githubAPI, owner, repo string, m
 				// Open PR: fetch reviews and classify.
 				reviews, reviewErr := fetchAllReviews(ctx, api, owner, repo, pr.GetNumber())
 				if reviewErr != nil {
-					return nil, fmt.Errorf("listing reviews for PR #%d: %w", pr.GetNumber(), reviewErr)
+					return signals, fmt.Errorf("listing reviews for PR #%d: %w", pr.GetNumber(), reviewErr)
 				}
 				kind, confidence = classifyPR(pr, reviews)
 				tags = []string{kind}
@@ -439,7 +414,7 @@ func fetchPullRequests(ctx context.Context, api githubAPI, owner, repo string, m
 				// Fetch actionable review comments for open PRs only.
 				commentSigs, commentErr := fetchActionableComments(ctx, api, owner, repo, pr.GetNumber(), commentDepth)
 				if commentErr != nil {
-					return nil, fmt.Errorf("listing review comments for PR #%d: %w", pr.GetNumber(), commentErr)
+					return signals, fmt.Errorf("listing review comments for PR #%d: %w", pr.GetNumber(), commentErr)
 				}
 				signals = append(signals, commentSigs...)
 			}
@@ -455,6 +430,7 @@ func fetchPullRequests(ctx context.Context, api githubAPI, owner, repo string, m
 				Timestamp:   pr.GetCreatedAt().Time,
 				Confidence:  confidence,
 				Tags:        tags,
+				URL:         pr.GetHTMLURL(),
 			}
 			if pr.GetState() == "closed" {
 				if pr.GetMerged() && pr.MergedAt != nil {
@@ -506,6 +482,34 @@ func fetchAllReviews(ctx context.Context, api githubAPI, owner, repo string, prN
 	return allReviews, nil
 }
 
+// fetchAllPullRequestFiles fetches all changed files of a PR with
+// pagination. GitHub lists at most 3000 files per PR.
+func fetchAllPullRequestFiles(ctx context.Context, api githubAPI, owner, repo string, prNumber int) ([]*github.CommitFile, error) {
+	var allFiles []*github.CommitFile
+	opts := &github.ListOptions{
+		PerPage: 100,
+	}
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		files, resp, err := api.ListPullRequestFiles(ctx, owner, repo, prNumber, opts)
+		if err != nil {
+			return nil, err
+		}
+		allFiles = append(allFiles, files...)
+
+		if resp == nil || resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	return allFiles, nil
+}
+
 // fetchActionableComments fetches review comments that contain actionable
 // language (TODO, FIXME, should, needs, must).
 func fetchActionableComments(ctx context.Context, api githubAPI, owner, repo string, prNumber, commentDepth int) ([]signal.RawSignal, error) {
@@ -558,6 +562,7 @@ func fetchActionableComments(ctx context.Context, api githubAPI, owner, repo str
 				Timestamp:   comment.GetCreatedAt().Time,
 				Confidence:  confidence,
 				Tags:        []string{"github-review-todo"},
+				URL:         comment.GetHTMLURL(),
 			})
 		}
 
@@ -656,15 +661,23 @@ func truncateBody(body string, maxLen int) string {
 // extractModuleContext groups changed files by top-level directory and returns
 // a summary string like "Modules affected: internal/collectors (3 files), cmd (1 file)".
 func extractModuleContext(files []*github.CommitFile) string {
-	if len(files) == 0 {
+	paths := make([]string, len(files))
+	for i, f := range files {
+		paths[i] = f.GetFilename()
+	}
+	return moduleContext(paths)
+}
+
+// moduleContext summarizes changed file paths by module, as for
+// extractModuleContext.
+func moduleContext(paths []string) string {
+	if len(paths) == 0 {
 		return ""
 	}
 
 	counts := make(map[string]int)
-	for _, f := range files {
-		path := f.GetFilename()
-		module := moduleFromPath(path)
-		counts[module]++
+	for _, path := range paths {
+		counts[moduleFromPath(path)]++
 	}
 
 	// Sort for deterministic output.
@@ -686,24 +699,6 @@ func extractModuleContext(files []*github.CommitFile) string {
 	return "Modules affected: " + strings.Join(parts, ", ")
 }
 
-// ModuleFromPath derives a module name from a file path by taking the
-// first two path segments (e.g., "internal/collectors") or the directory
-// name if only one level deep. Root-level files return ".".
-func ModuleFromPath(path string) string {
-	return moduleFromPath(path)
-}
-
-func moduleFromPath(path string) string {
-	parts := strings.Split(path, "/")
-	switch {
-	case len(parts) >= 3:
-		return parts[0] + "/" + parts[1]
-	case len(parts) == 2:
-		return parts[0]
-	default:
-		return "."
-	}
-}
-
-// Compile-time interface check.
+// Compile-time interface checks.
 var _ collector.Collector = (*GitHubCollector)(nil)
+var _ collector.CapabilityChecker = (*GitHubCollector)(nil)
@@ -0,0 +1,235 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+//go:build !nonetwork
+
+package collectors
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/google/go-github/v68/github"
+
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+// Batch-size analysis defaults for the GitHub collector.
+const (
+	// defaultLargeBatchThreshold is the median merged-PR size, in changed
+	// lines, above which a module is reported as a large-batch-pattern.
+	defaultLargeBatchThreshold = 400
+
+	// batchSizeMaxPRs caps how many merged PRs are sampled. Each one costs
+	// an extra API call per 100 changed files to list its files.
+	batchSizeMaxPRs = 100
+
+	// batchSizeWindow limits sampling to recently merged PRs.
+	batchSizeWindow = 180 * 24 * time.Hour
+
+	// batchSizeMinPRs is the minimum number of merged PRs touching a module
+	// before its median is considered representative.
+	batchSizeMinPRs = 5
+
+	// Later-half medians outside these ratios of the earlier-half median
+	// are reported as a growing or shrinking trend.
+	batchTrendGrowing   = 1.2
+	batchTrendShrinking = 0.8
+)
+
+// mergedPRSize records the size of one merged PR.
+type mergedPRSize struct {
+	mergedAt time.Time
+	lines    int
+}
+
+// analyzeBatchSizes samples recently merged PRs, computes the median PR size
+// per module, and emits a large-batch-pattern signal for each module whose
+// median exceeds threshold. A PR counts toward every module it touches, with
+// its full size, since batch size is a property of the PR rather than of the
+// individual files. Files matching excludes do not count toward the size.
+func analyzeBatchSizes(ctx context.Context, api githubAPI, owner, repo string, threshold int, excludes []string, now time.Time) ([]signal.RawSignal, error) {
+	if threshold <= 0 {
+		threshold = defaultLargeBatchThreshold
+	}
+	cutoff := now.Add(-batchSizeWindow)
+
+	prs, err := fetchRecentlyMergedPRs(ctx, api, owner, repo, cutoff)
+	if err != nil {
+		return nil, err
+	}
+
+	byModule := make(map[string][]mergedPRSize)
+	for _, pr := range prs {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		files, filesErr := fetchAllPullRequestFiles(ctx, api, owner, repo, pr.GetNumber())
+		if filesErr != nil {
+			slog.DebugContext(ctx, "skipping PR in batch-size analysis", "pr", pr.GetNumber(), "error", filesErr)
+			continue
+		}
+
+		lines := 0
+		modules := make(map[string]bool)
+		for _, f := range files {
+			path := f.GetFilename()
+			if shouldExclude(path, excludes) {
+				continue
+			}
+			lines += f.GetAdditions() + f.GetDeletions()
+			if module := moduleFromPath(path); module != "." {
+				modules[module] = true
+			}
+		}
+		for module := range modules {
+			byModule[module] = append(byModule[module], mergedPRSize{
+				mergedAt: pr.GetMergedAt().Time,
+				lines:    lines,
+			})
+		}
+	}
+
+	var signals []signal.RawSignal
+	for module, sizes := range byModule {
+		if len(sizes) < batchSizeMinPRs {
+			continue
+		}
+		sort.Slice(sizes, func(i, j int) bool {
+			return sizes[i].mergedAt.Before(sizes[j].mergedAt)
+		})
+		median := medianPRSize(sizes)
+		if median <= threshold {
+			continue
+		}
+		signals = append(signals, buildBatchSizeSignal(module, sizes, median, threshold))
+	}
+
+	sort.Slice(signals, func(i, j int) bool {
+		return signals[i].FilePath < signals[j].FilePath
+	})
+	return signals, nil
+}
+
+// fetchRecentlyMergedPRs lists closed PRs, most recently updated first, and
+// returns up to batchSizeMaxPRs of them merged after cutoff.
+func fetchRecentlyMergedPRs(ctx context.Context, api githubAPI, owner, repo string, cutoff time.Time) ([]*github.PullRequest, error) {
+	opts := &github.PullRequestListOptions{
+		State:     "closed",
+		Sort:      "updated",
+		Direction: "desc",
+		ListOptions: github.ListOptions{
+			PerPage: 100,
+		},
+	}
+
+	var merged []*github.PullRequest
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		prs, resp, err := api.ListPullRequests(ctx, owner, repo, opts)
+		if err != nil {
+			return nil, fmt.Errorf("listing merged pull requests: %w", err)
+		}
+
+		for _, pr := range prs {
+			// Results are ordered by update time, so nothing further down
+			// can have been merged inside the window.
+			if pr.UpdatedAt != nil && pr.UpdatedAt.Before(cutoff) {
+				return merged, nil
+			}
+			// The list endpoint omits the merged flag; merged_at is set
+			// only for merged PRs.
+			if pr.MergedAt == nil || pr.MergedAt.Before(cutoff) {
+				continue
+			}
+			merged = append(merged, pr)
+			if len(merged) >= batchSizeMaxPRs {
+				return merged, nil
+			}
+		}
+
+		if resp == nil || resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+	return merged, nil
+}
+
+// medianPRSize returns the median line count of sizes.
+func medianPRSize(sizes []mergedPRSize) int {
+	lines := make([]int, len(sizes))
+	for i, s := range sizes {
+		lines[i] = s.lines
+	}
+	sort.Ints(lines)
+	mid := len(lines) / 2
+	if len(lines)%2 == 0 {
+		return (lines[mid-1] + lines[mid]) / 2
+	}
+	return lines[mid]
+}
+
+// batchSizeTrend compares the median of the earlier half of sizes (ordered by
+// merge time) against the later half and returns "growing", "shrinking", or
+// "stable" along with both medians.
+func batchSizeTrend(sizes []mergedPRSize) (trend string, earlier, later int) {
+	half := len(sizes) / 2
+	earlier = medianPRSize(sizes[:half])
+	later = medianPRSize(sizes[len(sizes)-half:])
+
+	switch {
+	case earlier == 0 && later > 0:
+		return "growing", earlier, later
+	case earlier > 0 && float64(later) > float64(earlier)*batchTrendGrowing:
+		return "growing", earlier, later
+	case earlier > 0 && float64(later) < float64(earlier)*batchTrendShrinking:
+		return "shrinking", earlier, later
+	default:
+		return "stable", earlier, later
+	}
+}
+
+// batchSizeConfidence starts at 0.4 for a median at the threshold and rises
+// 0.1 per additional multiple of the threshold, capped at 0.7. A growing
+// trend adds 0.1.
+func batchSizeConfidence(median, threshold int, trend string) float64 {
+	conf := 0.4 + 0.1*(float64(median)/float64(threshold)-1)
+	conf = math.Min(conf, 0.7)
+	if trend == "growing" {
+		conf += 0.1
+	}
+	return math.Round(conf*100) / 100
+}
+
+// buildBatchSizeSignal creates the large-batch-pattern signal for a module.
+// sizes must be sorted by merge time.
+func buildBatchSizeSignal(module string, sizes []mergedPRSize, median, threshold int) signal.RawSignal {
+	trend, earlier, later := batchSizeTrend(sizes)
+	windowDays := int(batchSizeWindow.Hours() / 24)
+
+	desc := fmt.Sprintf("%d merged PRs touching %s in the last %d days had a median size of %d changed lines (threshold %d).\n",
+		len(sizes), module, windowDays, median, threshold)
+	desc += fmt.Sprintf("Trend: %s (median %d lines in the earlier half, %d in the later half).\n", trend, earlier, later)
+	desc += "Large PRs are slower to review and riskier to merge; consider splitting work in this module into smaller changes."
+
+	return signal.RawSignal{
+		Source:      "github",
+		Kind:        "large-batch-pattern",
+		FilePath:    module,
+		Line:        0,
+		Title:       fmt.Sprintf("Large PR batches in %s: median %d lines per merged PR (%s)", module, median, trend),
+		Description: desc,
+		Timestamp:   sizes[len(sizes)-1].mergedAt,
+		Confidence:  batchSizeConfidence(median, threshold, trend),
+		Tags:        []string{"large-batch-pattern", "trend-" + trend},
+	}
+}
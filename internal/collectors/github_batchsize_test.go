@@ -0,0 +1,220 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+//go:build !nonetwork
+
+package collectors
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v68/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mergedPRAt creates a merged PR as returned by the list endpoint, which
+// sets merged_at but not the merged flag.
+func mergedPRAt(number int, mergedAt time.Time) *github.PullRequest {
+	pr := makePR(number, fmt.Sprintf("PR %d", number), mergedAt.Add(-24*time.Hour))
+	pr.State = github.Ptr("closed")
+	pr.MergedAt = &github.Timestamp{Time: mergedAt}
+	pr.UpdatedAt = &github.Timestamp{Time: mergedAt}
+	return pr
+}
+
+// prFile creates a changed file with the given line counts.
+func prFile(name string, additions, deletions int) *github.CommitFile {
+	return &github.CommitFile{
+		Filename:  github.Ptr(name),
+		Additions: github.Ptr(additions),
+		Deletions: github.Ptr(deletions),
+	}
+}
+
+// batchSizeMock builds a mock with one merged PR per size, merged a day
+// apart and listed newest first, each touching internal/api.
+func batchSizeMock(now time.Time, sizes []int) *closedPRWithFilesMock {
+	mock := &closedPRWithFilesMock{files: map[int][]*github.CommitFile{}}
+	for i, size := range sizes {
+		number := i + 1
+		mergedAt := now.Add(-time.Duration(len(sizes)-i) * 24 * time.Hour)
+		mock.prs = append([]*github.PullRequest{mergedPRAt(number, mergedAt)}, mock.prs...)
+		mock.files[number] = []*github.CommitFile{prFile("internal/api/handler.go", size, 0)}
+	}
+	return mock
+}
+
+func TestAnalyzeBatchSizes_GrowingTrend(t *testing.T) {
+	now := time.Now()
+	mock := batchSizeMock(now, []int{300, 350, 500, 900, 1000, 1200})
+
+	signals, err := analyzeBatchSizes(context.Background(), mock, "owner", "repo", 0, nil, now)
+	require.NoError(t, err)
+	require.Len(t, signals, 1)
+
+	sig := signals[0]
+	assert.Equal(t, "github", sig.Source)
+	assert.Equal(t, "large-batch-pattern", sig.Kind)
+	assert.Equal(t, "internal/api", sig.FilePath)
+	assert.Equal(t, "Large PR batches in internal/api: median 700 lines per merged PR (growing)", sig.Title)
+	assert.Contains(t, sig.Description, "6 merged PRs touching internal/api")
+	assert.Contains(t, sig.Description, "(threshold 400)")
+	assert.Contains(t, sig.Description, "Trend: growing (median 350 lines in the earlier half, 1000 in the later half)")
+	assert.Equal(t, []string{"large-batch-pattern", "trend-growing"}, sig.Tags)
+	assert.InDelta(t, 0.58, sig.Confidence, 0.001)
+	assert.WithinDuration(t, now.Add(-24*time.Hour), sig.Timestamp, time.Second)
+}
+
+func TestAnalyzeBatchSizes_BelowThreshold(t *testing.T) {
+	now := time.Now()
+	mock := batchSizeMock(now, []int{100, 200, 300, 800, 900, 50})
+
+	signals, err := analyzeBatchSizes(context.Background(), mock, "owner", "repo", 0, nil, now)
+	require.NoError(t, err)
+	assert.Empty(t, signals)
+
+	signals, err = analyzeBatchSizes(context.Background(), mock, "owner", "repo", 200, nil, now)
+	require.NoError(t, err)
+	require.Len(t, signals, 1, "a lower configured threshold flags the module")
+	assert.Contains(t, signals[0].Title, "median 250 lines")
+}
+
+func TestAnalyzeBatchSizes_TooFewPRs(t *testing.T) {
+	now := time.Now()
+	mock := batchSizeMock(now, []int{5000, 5000, 5000, 5000})
+
+	signals, err := analyzeBatchSizes(context.Background(), mock, "owner", "repo", 0, nil, now)
+	require.NoError(t, err)
+	assert.Empty(t, signals)
+}
+
+func TestAnalyzeBatchSizes_ModulesAndExcludes(t *testing.T) {
+	now := time.Now()
+	mock := batchSizeMock(now, []int{10, 10, 10, 10, 10})
+	for number := range mock.files {
+		mock.files[number] = append(mock.files[number],
+			prFile("cmd/tool/main.go", 20, 20),
+			prFile("go.sum", 1000, 0),
+			prFile("vendor/lib/lib.go", 5000, 0),
+		)
+	}
+
+	signals, err := analyzeBatchSizes(context.Background(), mock, "owner", "repo", 0, []string{"vendor/**"}, now)
+	require.NoError(t, err)
+	require.Len(t, signals, 2, "root files count toward size but are not a module")
+	assert.Equal(t, "cmd/tool", signals[0].FilePath)
+	assert.Equal(t, "internal/api", signals[1].FilePath)
+	assert.Contains(t, signals[0].Title, "median 1050 lines", "excluded files do not count toward size")
+	assert.Contains(t, signals[0].Title, "(stable)")
+
+	signals, err = analyzeBatchSizes(context.Background(), mock, "owner", "repo", 0, []string{"vendor/**", "go.sum"}, now)
+	require.NoError(t, err)
+	assert.Empty(t, signals)
+}
+
+// pagedFilesMock lists each PR's changed files one per page.
+type pagedFilesMock struct {
+	*closedPRWithFilesMock
+}
+
+func (m pagedFilesMock) ListPullRequestFiles(_ context.Context, _, _ string, number int, opts *github.ListOptions) ([]*github.CommitFile, *github.Response, error) {
+	files := m.files[number]
+	page := max(opts.Page, 1)
+	resp := emptyResponse()
+	if page > len(files) {
+		return nil, resp, nil
+	}
+	if page < len(files) {
+		resp.NextPage = page + 1
+	}
+	return files[page-1 : page], resp, nil
+}
+
+func TestAnalyzeBatchSizes_AllFilePages(t *testing.T) {
+	now := time.Now()
+	mock := batchSizeMock(now, []int{300, 300, 300, 300, 300})
+	for number := range mock.files {
+		mock.files[number] = append(mock.files[number], prFile("internal/api/routes.go", 300, 0))
+	}
+
+	signals, err := analyzeBatchSizes(context.Background(), pagedFilesMock{mock}, "owner", "repo", 0, nil, now)
+	require.NoError(t, err)
+	require.Len(t, signals, 1)
+	assert.Contains(t, signals[0].Title, "median 600 lines", "files on later pages count toward size")
+}
+
+func TestAnalyzeBatchSizes_SkipsUnmergedAndOldPRs(t *testing.T) {
+	now := time.Now()
+	mock := batchSizeMock(now, []int{1000, 1000, 1000, 1000, 1000})
+
+	closed := makeClosedPR(90, "Abandoned", now.Add(-48*time.Hour))
+	mock.prs = append([]*github.PullRequest{closed}, mock.prs...)
+	mock.files[90] = []*github.CommitFile{prFile("internal/api/handler.go", 10, 0)}
+
+	old := mergedPRAt(91, now.Add(-batchSizeWindow-time.Hour))
+	mock.prs = append(mock.prs, old)
+	mock.files[91] = []*github.CommitFile{prFile("internal/api/handler.go", 10, 0)}
+
+	signals, err := analyzeBatchSizes(context.Background(), mock, "owner", "repo", 0, nil, now)
+	require.NoError(t, err)
+	require.Len(t, signals, 1)
+	assert.Contains(t, signals[0].Description, "5 merged PRs")
+	assert.Contains(t, signals[0].Title, "median 1000 lines")
+}
+
+func TestAnalyzeBatchSizes_ListError(t *testing.T) {
+	mock := &mockGitHubAPI{prErr: errors.New("rate limited")}
+
+	_, err := analyzeBatchSizes(context.Background(), mock, "owner", "repo", 0, nil, time.Now())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "listing merged pull requests")
+}
+
+func TestAnalyzeBatchSizes_ContextCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := analyzeBatchSizes(ctx, batchSizeMock(time.Now(), []int{1000}), "owner", "repo", 0, nil, time.Now())
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestBatchSizeTrend(t *testing.T) {
+	sizes := func(lines ...int) []mergedPRSize {
+		out := make([]mergedPRSize, len(lines))
+		for i, l := range lines {
+			out[i] = mergedPRSize{lines: l}
+		}
+		return out
+	}
+
+	trend, earlier, later := batchSizeTrend(sizes(100, 100, 999, 300, 300))
+	assert.Equal(t, "growing", trend)
+	assert.Equal(t, 100, earlier)
+	assert.Equal(t, 300, later, "the middle PR of an odd sample is left out")
+
+	trend, _, _ = batchSizeTrend(sizes(800, 800, 500, 500))
+	assert.Equal(t, "shrinking", trend)
+
+	trend, _, _ = batchSizeTrend(sizes(500, 500, 550, 550))
+	assert.Equal(t, "stable", trend)
+
+	trend, _, _ = batchSizeTrend(sizes(0, 0, 10, 10))
+	assert.Equal(t, "growing", trend)
+}
+
+func TestBatchSizeConfidence(t *testing.T) {
+	assert.InDelta(t, 0.4, batchSizeConfidence(400, 400, "stable"), 0.001)
+	assert.InDelta(t, 0.5, batchSizeConfidence(800, 400, "shrinking"), 0.001)
+	assert.InDelta(t, 0.7, batchSizeConfidence(4000, 400, "stable"), 0.001)
+	assert.InDelta(t, 0.8, batchSizeConfidence(4000, 400, "growing"), 0.001)
+}
+
+func TestMedianPRSize(t *testing.T) {
+	assert.Equal(t, 200, medianPRSize([]mergedPRSize{{lines: 300}, {lines: 100}, {lines: 200}}))
+	assert.Equal(t, 250, medianPRSize([]mergedPRSize{{lines: 400}, {lines: 100}, {lines: 200}, {lines: 300}}))
+}
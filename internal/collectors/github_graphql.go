@@ -0,0 +1,365 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+//go:build !nonetwork
+
+package collectors
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v68/github"
+)
+
+// pullRequestPageQuery lists one page of pull requests, most recently
+// updated first, with the reviews, review comments, and changed files the
+// collectors read. Over REST these take three more requests per pull
+// request. The nested page sizes keep the query well under GitHub's node
+// limit at 100 pull requests per page; a pull request with more than fits
+// has that list fetched over REST.
+const pullRequestPageQuery = `query($owner: String!, $name: String!, $states: [PullRequestState!], $first: Int!, $after: String) {
+  repository(owner: $owner, name: $name) {
+    pullRequests(states: $states, first: $first, after: $after, orderBy: {field: UPDATED_AT, direction: DESC}) {
+      pageInfo { hasNextPage endCursor }
+      nodes {
+        number title body state url isDraft merged createdAt updatedAt closedAt mergedAt
+        author { login }
+        labels(first: 20) { nodes { name } }
+        reviews(first: 50) {
+          pageInfo { hasNextPage }
+          nodes { state submittedAt author { login } }
+        }
+        reviewThreads(first: 50) {
+          pageInfo { hasNextPage }
+          nodes {
+            comments(first: 10) {
+              pageInfo { hasNextPage }
+              nodes { body path line createdAt url author { login } }
+            }
+          }
+        }
+        files(first: 100) {
+          pageInfo { hasNextPage }
+          nodes { path additions deletions changeType }
+        }
+      }
+    }
+  }
+}`
+
+// The options the collectors list the first page of a pull request's
+// reviews, review comments, and files with. Batched lists are seeded under
+// the cache keys of these requests.
+var (
+	batchedReviewOpts  = &github.ListOptions{PerPage: 100}
+	batchedCommentOpts = &github.PullRequestListCommentsOptions{Sort: "created", Direction: "desc", ListOptions: github.ListOptions{PerPage: 100}}
+	batchedFileOpts    = &github.ListOptions{PerPage: 100}
+)
+
+// pullRequestBatcher is implemented by a githubAPI that can list a page of
+// pull requests together with their reviews, review comments, and files.
+type pullRequestBatcher interface {
+	listPullRequestPage(ctx context.Context, owner, repo, state string, first int, after string) (*pullRequestPage, error)
+}
+
+// pullRequestPage is one page of pull requests listed through GraphQL.
+type pullRequestPage struct {
+	pulls       []*github.PullRequest
+	details     map[int]*pullRequestDetails
+	hasNextPage bool
+	endCursor   string
+}
+
+// pullRequestDetails holds the lists fetched with a pull request. A list
+// is only used when complete.
+type pullRequestDetails struct {
+	reviews          []*github.PullRequestReview
+	comments         []*github.PullRequestComment
+	files            []*github.CommitFile
+	reviewsComplete  bool
+	commentsComplete bool
+	filesComplete    bool
+}
+
+// batchPullRequests lists a page of pull requests through GraphQL when the
+// underlying API supports it, seeding the cache with the reviews, review
+// comments, and files of each one. It reports false when the page is to be
+// listed over REST: the API cannot batch, the listing is not one the query
+// reproduces, or GraphQL failed, which switches the rest of the scan to
+// REST.
+func (s *sharedGitHubAPI) batchPullRequests(ctx context.Context, owner, repo string, opts *github.PullRequestListOptions) ([]*github.PullRequest, *github.Response, bool) {
+	b, ok := s.api.(pullRequestBatcher)
+	if !ok || opts.Sort != "updated" || opts.Direction != "desc" || graphQLPRStates(opts.State) == nil {
+		return nil, nil, false
+	}
+	after, ok := s.data.graphQLCursor(pullsKey(owner, repo, opts), opts.Page)
+	if !ok {
+		return nil, nil, false
+	}
+
+	page, err := b.listPullRequestPage(ctx, owner, repo, opts.State, opts.PerPage, after)
+	if err != nil {
+		if ctx.Err() == nil && s.data.disableGraphQL() {
+			slog.InfoContext(ctx, "GitHub GraphQL API unavailable, listing pull requests over REST", "error", err)
+		}
+		return nil, nil, false
+	}
+
+	resp := &github.Response{}
+	if page.hasNextPage {
+		next := *opts
+		next.Page = max(opts.Page, 1) + 1
+		s.data.setGraphQLCursor(pullsKey(owner, repo, &next), page.endCursor)
+		resp.NextPage = next.Page
+	}
+	for number, d := range page.details {
+		if d.reviewsComplete {
+			s.data.seed(reviewsKey(owner, repo, number, batchedReviewOpts), d.reviews)
+		}
+		if d.commentsComplete {
+			s.data.seed(reviewCommentsKey(owner, repo, number, batchedCommentOpts), d.comments)
+		}
+		if d.filesComplete {
+			s.data.seed(filesKey(owner, repo, number, batchedFileOpts), d.files)
+		}
+	}
+	return page.pulls, resp, true
+}
+
+// graphQLPRStates returns the GraphQL pull request states matching a REST
+// state filter, or nil for a filter the query cannot reproduce.
+func graphQLPRStates(state string) []string {
+	switch state {
+	case "", "open":
+		return []string{"OPEN"}
+	case "closed":
+		return []string{"CLOSED", "MERGED"}
+	case "all":
+		return []string{"OPEN", "CLOSED", "MERGED"}
+	}
+	return nil
+}
+
+func (r *realGitHubAPI) listPullRequestPage(ctx context.Context, owner, repo, state string, first int, after string) (*pullRequestPage, error) {
+	if first <= 0 {
+		first = 30 // the REST default
+	}
+	vars := map[string]any{"owner": owner, "name": repo, "states": graphQLPRStates(state), "first": min(first, 100)}
+	if after != "" {
+		vars["after"] = after
+	}
+	req, err := r.client.NewRequest(http.MethodPost, "graphql", map[string]any{"query": pullRequestPageQuery, "variables": vars})
+	if err != nil {
+		return nil, err
+	}
+
+	var out graphQLPullRequestsResponse
+	if _, err := r.client.Do(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	if len(out.Errors) > 0 {
+		return nil, fmt.Errorf("GraphQL: %s", out.Errors[0].Message)
+	}
+	if out.Data.Repository == nil {
+		return nil, errors.New("GraphQL: no repository in response")
+	}
+	return out.Data.Repository.PullRequests.page(), nil
+}
+
+// graphQLPullRequestsResponse is the response to pullRequestPageQuery.
+type graphQLPullRequestsResponse struct {
+	Data struct {
+		Repository *struct {
+			PullRequests graphQLPullRequests `json:"pullRequests"`
+		} `json:"repository"`
+	} `json:"data"`
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+type graphQLPageInfo struct {
+	HasNextPage bool   `json:"hasNextPage"`
+	EndCursor   string `json:"endCursor"`
+}
+
+type graphQLActor struct {
+	Login string `json:"login"`
+}
+
+type graphQLPullRequests struct {
+	PageInfo graphQLPageInfo      `json:"pageInfo"`
+	Nodes    []graphQLPullRequest `json:"nodes"`
+}
+
+type graphQLPullRequest struct {
+	Number    int           `json:"number"`
+	Title     string        `json:"title"`
+	Body      string        `json:"body"`
+	State     string        `json:"state"`
+	URL       string        `json:"url"`
+	IsDraft   bool          `json:"isDraft"`
+	Merged    bool          `json:"merged"`
+	CreatedAt time.Time     `json:"createdAt"`
+	UpdatedAt time.Time     `json:"updatedAt"`
+	ClosedAt  *time.Time    `json:"closedAt"`
+	MergedAt  *time.Time    `json:"mergedAt"`
+	Author    *graphQLActor `json:"author"`
+	Labels    struct {
+		Nodes []struct {
+			Name string `json:"name"`
+		} `json:"nodes"`
+	} `json:"labels"`
+	Reviews struct {
+		PageInfo graphQLPageInfo `json:"pageInfo"`
+		Nodes    []struct {
+			State       string        `json:"state"`
+			SubmittedAt *time.Time    `json:"submittedAt"`
+			Author      *graphQLActor `json:"author"`
+		} `json:"nodes"`
+	} `json:"reviews"`
+	ReviewThreads struct {
+		PageInfo graphQLPageInfo `json:"pageInfo"`
+		Nodes    []struct {
+			Comments struct {
+				PageInfo graphQLPageInfo `json:"pageInfo"`
+				Nodes    []struct {
+					Body      string        `json:"body"`
+					Path      string        `json:"path"`
+					Line      *int          `json:"line"`
+					CreatedAt time.Time     `json:"createdAt"`
+					URL       string        `json:"url"`
+					Author    *graphQLActor `json:"author"`
+				} `json:"nodes"`
+			} `json:"comments"`
+		} `json:"nodes"`
+	} `json:"reviewThreads"`
+	Files struct {
+		PageInfo graphQLPageInfo `json:"pageInfo"`
+		Nodes    []struct {
+			Path       string `json:"path"`
+			Additions  int    `json:"additions"`
+			Deletions  int    `json:"deletions"`
+			ChangeType string `json:"changeType"`
+		} `json:"nodes"`
+	} `json:"files"`
+}
+
+// page converts the GraphQL response into the shapes the REST API returns.
+func (p *graphQLPullRequests) page() *pullRequestPage {
+	page := &pullRequestPage{
+		details:     make(map[int]*pullRequestDetails, len(p.Nodes)),
+		hasNextPage: p.PageInfo.HasNextPage,
+		endCursor:   p.PageInfo.EndCursor,
+	}
+	for i := range p.Nodes {
+		n := &p.Nodes[i]
+		page.pulls = append(page.pulls, n.pullRequest())
+		page.details[n.Number] = n.details()
+	}
+	return page
+}
+
+func (n *graphQLPullRequest) pullRequest() *github.PullRequest {
+	state := "closed"
+	if n.State == "OPEN" {
+		state = "open"
+	}
+	var labels []*github.Label
+	for _, l := range n.Labels.Nodes {
+		labels = append(labels, &github.Label{Name: github.Ptr(l.Name)})
+	}
+	return &github.PullRequest{
+		Number:    github.Ptr(n.Number),
+		Title:     github.Ptr(n.Title),
+		Body:      github.Ptr(n.Body),
+		State:     github.Ptr(state),
+		HTMLURL:   github.Ptr(n.URL),
+		Draft:     github.Ptr(n.IsDraft),
+		Merged:    github.Ptr(n.Merged),
+		CreatedAt: &github.Timestamp{Time: n.CreatedAt},
+		UpdatedAt: &github.Timestamp{Time: n.UpdatedAt},
+		ClosedAt:  timestamp(n.ClosedAt),
+		MergedAt:  timestamp(n.MergedAt),
+		User:      n.Author.user(),
+		Labels:    labels,
+	}
+}
+
+func (n *graphQLPullRequest) details() *pullRequestDetails {
+	d := &pullRequestDetails{
+		reviews:          []*github.PullRequestReview{},
+		comments:         []*github.PullRequestComment{},
+		files:            []*github.CommitFile{},
+		reviewsComplete:  !n.Reviews.PageInfo.HasNextPage,
+		commentsComplete: !n.ReviewThreads.PageInfo.HasNextPage,
+		filesComplete:    !n.Files.PageInfo.HasNextPage,
+	}
+	for _, r := range n.Reviews.Nodes {
+		d.reviews = append(d.reviews, &github.PullRequestReview{
+			State:       github.Ptr(r.State),
+			SubmittedAt: timestamp(r.SubmittedAt),
+			User:        r.Author.user(),
+		})
+	}
+	for _, t := range n.ReviewThreads.Nodes {
+		if t.Comments.PageInfo.HasNextPage {
+			d.commentsComplete = false
+		}
+		for _, c := range t.Comments.Nodes {
+			d.comments = append(d.comments, &github.PullRequestComment{
+				Body:      github.Ptr(c.Body),
+				Path:      github.Ptr(c.Path),
+				Line:      c.Line,
+				CreatedAt: &github.Timestamp{Time: c.CreatedAt},
+				HTMLURL:   github.Ptr(c.URL),
+				User:      c.Author.user(),
+			})
+		}
+	}
+	// REST lists review comments newest first; threads group them instead.
+	sort.SliceStable(d.comments, func(i, j int) bool {
+		return d.comments[i].GetCreatedAt().After(d.comments[j].GetCreatedAt().Time)
+	})
+	for _, f := range n.Files.Nodes {
+		d.files = append(d.files, &github.CommitFile{
+			Filename:  github.Ptr(f.Path),
+			Additions: github.Ptr(f.Additions),
+			Deletions: github.Ptr(f.Deletions),
+			Changes:   github.Ptr(f.Additions + f.Deletions),
+			Status:    github.Ptr(fileStatus(f.ChangeType)),
+		})
+	}
+	return d
+}
+
+// user returns the REST user for a GraphQL author, which is null for
+// deleted accounts.
+func (a *graphQLActor) user() *github.User {
+	if a == nil {
+		return nil
+	}
+	return &github.User{Login: github.Ptr(a.Login)}
+}
+
+func timestamp(t *time.Time) *github.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return &github.Timestamp{Time: *t}
+}
+
+// fileStatus maps a GraphQL PatchStatus to the REST file status.
+func fileStatus(changeType string) string {
+	if changeType == "DELETED" {
+		return "removed"
+	}
+	return strings.ToLower(changeType)
+}
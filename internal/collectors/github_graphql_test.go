@@ -0,0 +1,166 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+//go:build !nonetwork
+
+package collectors
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v68/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// graphQLServer serves GraphQL pages keyed by the after cursor, and counts
+// every other request by path.
+type graphQLServer struct {
+	mu      sync.Mutex
+	pages   map[string]string // response body by after cursor
+	rest    map[string]string // response body by REST path
+	queries int
+	calls   map[string]int
+}
+
+func (g *graphQLServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if r.URL.Path == "/graphql" {
+		g.queries++
+		var req struct {
+			Variables map[string]any `json:"variables"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		after, _ := req.Variables["after"].(string)
+		fmt.Fprint(w, g.pages[after])
+		return
+	}
+	g.calls[r.URL.Path]++
+	if body, ok := g.rest[r.URL.Path]; ok {
+		fmt.Fprint(w, body)
+		return
+	}
+	http.NotFound(w, r)
+}
+
+func newGraphQLTestAPI(t *testing.T, g *graphQLServer) githubAPI {
+	t.Helper()
+	g.calls = make(map[string]int)
+	srv := httptest.NewServer(g)
+	t.Cleanup(srv.Close)
+	client := github.NewClient(nil)
+	client.BaseURL, _ = url.Parse(srv.URL + "/")
+	return &realGitHubAPI{client: client}
+}
+
+const graphQLPage1 = `{"data": {"repository": {"pullRequests": {
+  "pageInfo": {"hasNextPage": true, "endCursor": "c1"},
+  "nodes": [
+    {"number": 1, "title": "Add cache", "state": "OPEN", "url": "https://github.com/acme/api/pull/1",
+     "createdAt": "2026-01-02T00:00:00Z", "updatedAt": "2026-01-05T00:00:00Z", "author": {"login": "alice"},
+     "reviews": {"pageInfo": {"hasNextPage": false}, "nodes": [{"state": "CHANGES_REQUESTED", "author": {"login": "bob"}}]},
+     "reviewThreads": {"pageInfo": {"hasNextPage": false}, "nodes": [
+       {"comments": {"pageInfo": {"hasNextPage": false}, "nodes": [
+         {"body": "Looks fine", "path": "cache.go", "line": 3, "createdAt": "2026-01-03T00:00:00Z", "author": {"login": "bob"}},
+         {"body": "TODO: handle a nil entry", "path": "cache.go", "line": 9, "createdAt": "2026-01-04T00:00:00Z", "author": {"login": "bob"}}
+       ]}}
+     ]},
+     "files": {"pageInfo": {"hasNextPage": false}, "nodes": [{"path": "cache.go", "additions": 10, "deletions": 2, "changeType": "ADDED"}]}},
+    {"number": 2, "title": "Drop v1", "state": "MERGED", "merged": true, "url": "https://github.com/acme/api/pull/2",
+     "createdAt": "2026-01-01T00:00:00Z", "updatedAt": "2026-01-04T00:00:00Z",
+     "closedAt": "2026-01-04T00:00:00Z", "mergedAt": "2026-01-04T00:00:00Z", "author": null,
+     "reviews": {"pageInfo": {"hasNextPage": false}, "nodes": []},
+     "reviewThreads": {"pageInfo": {"hasNextPage": false}, "nodes": []},
+     "files": {"pageInfo": {"hasNextPage": false}, "nodes": [{"path": "v1/api.go", "additions": 0, "deletions": 40, "changeType": "DELETED"}]}}
+  ]}}}}`
+
+const graphQLPage2 = `{"data": {"repository": {"pullRequests": {
+  "pageInfo": {"hasNextPage": false, "endCursor": "c2"},
+  "nodes": [
+    {"number": 3, "title": "Busy review", "state": "OPEN", "url": "https://github.com/acme/api/pull/3",
+     "createdAt": "2026-01-01T00:00:00Z", "updatedAt": "2026-01-03T00:00:00Z", "author": {"login": "carol"},
+     "reviews": {"pageInfo": {"hasNextPage": true}, "nodes": [{"state": "COMMENTED", "author": {"login": "bob"}}]},
+     "reviewThreads": {"pageInfo": {"hasNextPage": false}, "nodes": []},
+     "files": {"pageInfo": {"hasNextPage": false}, "nodes": []}}
+  ]}}}}`
+
+func TestFetchPullRequests_GraphQL(t *testing.T) {
+	g := &graphQLServer{
+		pages: map[string]string{"": graphQLPage1, "c1": graphQLPage2},
+		rest:  map[string]string{"/repos/acme/api/pulls/3/reviews": `[{"state": "APPROVED"}]`},
+	}
+	d := NewGitHubData(0)
+	api := d.wrap(newGraphQLTestAPI(t, g))
+
+	signals, err := fetchPullRequests(context.Background(), api, "acme", "api", 100, 10, true, time.Time{})
+	require.NoError(t, err)
+
+	kinds := make(map[string]string)
+	for _, s := range signals {
+		kinds[s.Title] = s.Kind
+	}
+	assert.Equal(t, map[string]string{
+		"Add cache": "github-pr-changes",
+		"Review comment on PR #1: TODO: handle a nil entry": "github-review-todo",
+		"Drop v1":     "github-merged-pr",
+		"Busy review": "github-pr-approved",
+	}, kinds)
+	for _, s := range signals {
+		if s.Kind == "github-merged-pr" {
+			assert.Contains(t, s.Description, "v1 (1 file)", "files come with the page")
+		}
+	}
+
+	assert.Equal(t, 2, g.queries, "one query per page")
+	assert.Equal(t, map[string]int{"/repos/acme/api/pulls/3/reviews": 1}, g.calls,
+		"only the list GraphQL did not return in full is fetched over REST")
+	assert.Equal(t, 3, d.Requests())
+}
+
+func TestFetchPullRequests_GraphQLFallsBackToREST(t *testing.T) {
+	g := &graphQLServer{
+		pages: map[string]string{"": `{"errors": [{"message": "Resource not accessible by integration"}]}`},
+		rest: map[string]string{
+			"/repos/acme/api/pulls":            `[{"number": 1, "title": "Add cache", "state": "open"}]`,
+			"/repos/acme/api/pulls/1/reviews":  `[{"state": "APPROVED"}]`,
+			"/repos/acme/api/pulls/1/comments": `[]`,
+		},
+	}
+	d := NewGitHubData(0)
+	api := d.wrap(newGraphQLTestAPI(t, g))
+
+	signals, err := fetchPullRequests(context.Background(), api, "acme", "api", 100, 10, false, time.Time{})
+	require.NoError(t, err)
+	require.Len(t, signals, 1)
+	assert.Equal(t, "github-pr-approved", signals[0].Kind)
+
+	_, _, err = api.ListPullRequests(context.Background(), "acme", "api", closedPROpts(1))
+	require.NoError(t, err)
+	assert.Equal(t, 1, g.queries, "GraphQL is not tried again after it fails")
+	assert.Equal(t, 2, g.calls["/repos/acme/api/pulls"])
+}
+
+func TestBatchPullRequests_Unbatchable(t *testing.T) {
+	g := &graphQLServer{rest: map[string]string{"/repos/acme/api/pulls": `[]`}}
+	api := NewGitHubData(0).wrap(newGraphQLTestAPI(t, g))
+
+	opts := &github.PullRequestListOptions{State: "open", Sort: "created", Direction: "desc"}
+	_, _, err := api.ListPullRequests(context.Background(), "acme", "api", opts)
+	require.NoError(t, err)
+
+	opts = &github.PullRequestListOptions{State: "open", Sort: "updated", Direction: "desc", ListOptions: github.ListOptions{Page: 3}}
+	_, _, err = api.ListPullRequests(context.Background(), "acme", "api", opts)
+	require.NoError(t, err)
+
+	assert.Zero(t, g.queries, "other orders and pages without a cursor are listed over REST")
+	assert.Equal(t, 2, g.calls["/repos/acme/api/pulls"])
+}
@@ -1,6 +1,8 @@
 // Copyright 2026 The Stringer Authors
 // SPDX-License-Identifier: MIT
 
+//go:build !nonetwork
+
 package collectors
 
 import (
@@ -14,8 +16,6 @@ import (
 	"testing"
 	"time"
 
-	gogit "github.com/go-git/go-git/v5"
-	gogitconfig "github.com/go-git/go-git/v5/config"
 	"github.com/google/go-github/v68/github"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -165,6 +165,46 @@ func TestGitHubCollector_SubdirectoryScan(t *testing.T) {
 	assert.Equal(t, "github-bug", signals[0].Kind)
 }
 
+func TestGitHubCollector_BudgetKeepsPartialResults(t *testing.T) {
+	t.Setenv("GITHUB_TOKEN", "test-token")
+	repoPath := initGitHubTestRepo(t, "https://github.com/testowner/testrepo.git")
+
+	now := time.Now()
+	mock := &mockGitHubAPI{
+		issues: []*github.Issue{
+			makeIssue(1, "Bug report", now, []string{"bug"}),
+			makeIssue(2, "Feature request", now, []string{"enhancement"}),
+		},
+		issueResp: emptyResponse(),
+		prResp:    emptyResponse(),
+	}
+
+	c := &GitHubCollector{api: mock}
+	data := NewGitHubData(1)
+	signals, err := c.Collect(WithGitHubData(context.Background(), data), repoPath, signal.CollectorOpts{})
+	require.NoError(t, err, "running out of budget does not fail the collector")
+	assert.Len(t, signals, 2, "issues fetched before the budget ran out are kept")
+	assert.Equal(t, 0, mock.prCallCount)
+	assert.True(t, data.Exhausted())
+}
+
+func TestGitHubCollector_GitHubRepoOverride(t *testing.T) {
+	t.Setenv("GITHUB_TOKEN", "test-token")
+
+	mock := &mockGitHubAPI{
+		issues:    []*github.Issue{makeIssue(1, "Bug report", time.Now(), []string{"bug"})},
+		issueResp: emptyResponse(),
+		prResp:    emptyResponse(),
+	}
+	c := &GitHubCollector{api: mock}
+	opts := signal.CollectorOpts{GitHubRepo: "acme/api"}
+	assert.Empty(t, c.CheckCapabilities(context.Background(), t.TempDir(), opts), "no origin remote is needed")
+
+	signals, err := c.Collect(context.Background(), t.TempDir(), opts)
+	require.NoError(t, err)
+	assert.Len(t, signals, 1)
+}
+
 func TestGitHubCollector_IssuesWithLabels(t *testing.T) {
 	t.Setenv("GITHUB_TOKEN", "test-token")
 
@@ -206,6 +246,7 @@ func TestGitHubCollector_IssuesWithLabels(t *testing.T) {
 	issueSig := sigMap["github/issues/3"]
 	assert.Equal(t, "github-issue", issueSig.Kind)
 	assert.InDelta(t, 0.4, issueSig.Confidence, 0.01)
+	assert.Equal(t, "https://github.com/testowner/testrepo/issues/3", issueSig.URL)
 }
 
 func TestGitHubCollector_IssueAgeBoost(t *testing.T) {
@@ -442,37 +483,6 @@ func TestGitHubCollector_ContextCancellation(t *testing.T) {
 	assert.Error(t, err)
 }
 
-func TestParseGitHubRemote_HTTPS(t *testing.T) {
-	repoPath := initGitHubTestRepo(t, "https://github.com/myowner/myrepo.git")
-	owner, repo, err := parseGitHubRemote(repoPath)
-	require.NoError(t, err)
-	assert.Equal(t, "myowner", owner)
-	assert.Equal(t, "myrepo", repo)
-}
-
-func TestParseGitHubRemote_SSH(t *testing.T) {
-	repoPath := initGitHubTestRepo(t, "git@github.com:sshowner/sshrepo.git")
-	owner, repo, err := parseGitHubRemote(repoPath)
-	require.NoError(t, err)
-	assert.Equal(t, "sshowner", owner)
-	assert.Equal(t, "sshrepo", repo)
-}
-
-func TestParseGitHubRemote_HTTPSNoGit(t *testing.T) {
-	repoPath := initGitHubTestRepo(t, "https://github.com/noext/norepo")
-	owner, repo, err := parseGitHubRemote(repoPath)
-	require.NoError(t, err)
-	assert.Equal(t, "noext", owner)
-	assert.Equal(t, "norepo", repo)
-}
-
-func TestParseGitHubRemote_NonGitHub(t *testing.T) {
-	repoPath := initGitHubTestRepo(t, "https://gitlab.com/other/repo.git")
-	_, _, err := parseGitHubRemote(repoPath)
-	require.Error(t, err)
-	assert.Contains(t, err.Error(), "not a GitHub URL")
-}
-
 func TestIsActionableComment(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -735,25 +745,6 @@ func (m *paginatingMockAPI) GetRepository(_ context.Context, _, _ string) (*gith
 
 // --- Helper functions ---
 
-// initGitHubTestRepo creates a temporary git repository with the given remote URL.
-func initGitHubTestRepo(t *testing.T, remoteURL string) string {
-	t.Helper()
-	dir := t.TempDir()
-
-	// Initialize a git repo using go-git.
-	repo, err := gogit.PlainInit(dir, false)
-	require.NoError(t, err)
-
-	// Add origin remote.
-	_, err = repo.CreateRemote(&gogitconfig.RemoteConfig{
-		Name: "origin",
-		URLs: []string{remoteURL},
-	})
-	require.NoError(t, err)
-
-	return dir
-}
-
 // makeIssue creates a test GitHub issue.
 func makeIssue(number int, title string, created time.Time, labelNames []string) *github.Issue {
 	var labels []*github.Label
@@ -767,6 +758,7 @@ func makeIssue(number int, title string, created time.Time, labelNames []string)
 		Number:    &number,
 		Title:     &title,
 		Body:      github.Ptr("Issue body"),
+		HTMLURL:   github.Ptr(fmt.Sprintf("https://github.com/testowner/testrepo/issues/%d", number)),
 		Labels:    labels,
 		CreatedAt: &ts,
 		User:      user,
@@ -912,23 +904,6 @@ func TestExtractModuleContext_SingleModule(t *testing.T) {
 	assert.Equal(t, "Modules affected: internal/state (2 files)", result)
 }
 
-func TestModuleFromPath(t *testing.T) {
-	tests := []struct {
-		path     string
-		expected string
-	}{
-		{"internal/collectors/todos.go", "internal/collectors"},
-		{"cmd/stringer/main.go", "cmd/stringer"},
-		{"cmd/main.go", "cmd"},
-		{"README.md", "."},
-	}
-	for _, tt := range tests {
-		t.Run(tt.path, func(t *testing.T) {
-			assert.Equal(t, tt.expected, moduleFromPath(tt.path))
-		})
-	}
-}
-
 // makeComment creates a test PR review comment.
 func makeComment(body, path string, line int, created time.Time) *github.PullRequestComment {
 	ts := github.Timestamp{Time: created}
@@ -973,37 +948,6 @@ func TestGitHubCollector_RemotesError(t *testing.T) {
 	assert.Empty(t, signals)
 }
 
-func TestParseGitHubURL_SSHFormat(t *testing.T) {
-	owner, repo, err := parseGitHubURL("git@github.com:myowner/myrepo.git")
-	require.NoError(t, err)
-	assert.Equal(t, "myowner", owner)
-	assert.Equal(t, "myrepo", repo)
-}
-
-func TestParseGitHubURL_SSHWithoutGit(t *testing.T) {
-	owner, repo, err := parseGitHubURL("git@github.com:myowner/myrepo")
-	require.NoError(t, err)
-	assert.Equal(t, "myowner", owner)
-	assert.Equal(t, "myrepo", repo)
-}
-
-func TestParseGitHubURL_InvalidURL(t *testing.T) {
-	_, _, err := parseGitHubURL("not-a-url")
-	require.Error(t, err)
-}
-
-func TestParseGitHubURL_NonGitHubHost(t *testing.T) {
-	_, _, err := parseGitHubURL("https://gitlab.com/owner/repo.git")
-	require.Error(t, err)
-	assert.Contains(t, err.Error(), "not a GitHub URL")
-}
-
-func TestParseGitHubURL_TooFewPathParts(t *testing.T) {
-	_, _, err := parseGitHubURL("https://github.com/onlyowner")
-	require.Error(t, err)
-	assert.Contains(t, err.Error(), "cannot parse owner/repo")
-}
-
 func TestTruncateBody(t *testing.T) {
 	// Short body: not truncated.
 	assert.Equal(t, "hello", truncateBody("hello", 100))
@@ -1251,16 +1195,6 @@ func TestFetchPullRequests_MaxIssuesLimit(t *testing.T) {
 	assert.Len(t, signals, 2)
 }
 
-func TestParseGitHubRemoteWith_NoOrigin(t *testing.T) {
-	mockRepo := &testable.MockGitRepository{
-		RemotesList: nil, // No remotes.
-	}
-	opener := &testable.MockGitOpener{Repo: mockRepo}
-	_, _, err := parseGitHubRemoteWith(opener, "/tmp/fake")
-	require.Error(t, err)
-	assert.Contains(t, err.Error(), "no origin remote found")
-}
-
 func TestClassifyPR_CommentOnlyReviews(t *testing.T) {
 	now := time.Now()
 	pr := makePR(1, "Test", now)
@@ -1273,17 +1207,11 @@ func TestClassifyPR_CommentOnlyReviews(t *testing.T) {
 	assert.InDelta(t, 0.5, conf, 0.11)
 }
 
-func TestModuleFromPath_Public(t *testing.T) {
-	assert.Equal(t, "internal/collectors", ModuleFromPath("internal/collectors/todos.go"))
-	assert.Equal(t, ".", ModuleFromPath("README.md"))
-	assert.Equal(t, "cmd", ModuleFromPath("cmd/main.go"))
-}
-
 func TestNewGitHubContext_WithToken(t *testing.T) {
 	t.Setenv("GITHUB_TOKEN", "test-token")
 
 	repoPath := initGitHubTestRepo(t, "https://github.com/testowner/testrepo.git")
-	ctx := newGitHubContext(repoPath)
+	ctx := newGitHubContext(context.Background(), repoPath)
 	require.NotNil(t, ctx)
 	assert.Equal(t, "testowner", ctx.Owner)
 	assert.Equal(t, "testrepo", ctx.Repo)
@@ -1292,14 +1220,14 @@ func TestNewGitHubContext_WithToken(t *testing.T) {
 
 func TestNewGitHubContext_NoToken(t *testing.T) {
 	t.Setenv("GITHUB_TOKEN", "")
-	ctx := newGitHubContext("/tmp/fake")
+	ctx := newGitHubContext(context.Background(), "/tmp/fake")
 	assert.Nil(t, ctx)
 }
 
 func TestNewGitHubContext_NotGitHub(t *testing.T) {
 	t.Setenv("GITHUB_TOKEN", "test-token")
 	repoPath := initGitHubTestRepo(t, "https://gitlab.com/owner/repo.git")
-	ctx := newGitHubContext(repoPath)
+	ctx := newGitHubContext(context.Background(), repoPath)
 	assert.Nil(t, ctx)
 }
 
@@ -1450,13 +1378,6 @@ func TestGitHubCollector_FetchPRError(t *testing.T) {
 	assert.Contains(t, err.Error(), "fetching pull requests")
 }
 
-func TestParseGitHubURL_SSHMalformed(t *testing.T) {
-	// SSH URL with only owner (no repo).
-	_, _, err := parseGitHubURL("git@github.com:onlyowner")
-	// This should fail because SSH pattern requires owner/repo.
-	require.Error(t, err)
-}
-
 func TestFetchIssues_SkipsPullRequests(t *testing.T) {
 	now := time.Now()
 	prLink := &github.PullRequestLinks{URL: github.Ptr("https://api.github.com/repos/o/r/pulls/1")}
@@ -1748,3 +1669,17 @@ func TestGitHubCollector_MaxIssuesFromConfig(t *testing.T) {
 	require.NoError(t, err)
 	assert.Len(t, signals, 5)
 }
+
+func TestGitHubCollector_CheckCapabilities(t *testing.T) {
+	c := &GitHubCollector{}
+
+	t.Setenv("GITHUB_TOKEN", "")
+	assert.Equal(t, reasonNoToken, c.CheckCapabilities(context.Background(), t.TempDir(), signal.CollectorOpts{}))
+
+	t.Setenv("GITHUB_TOKEN", "test-token")
+	nonGitHub := initGitHubTestRepo(t, "https://gitlab.com/owner/repo.git")
+	assert.Equal(t, "no GitHub remote", c.CheckCapabilities(context.Background(), nonGitHub, signal.CollectorOpts{}))
+
+	repo := initGitHubTestRepo(t, "https://github.com/owner/repo.git")
+	assert.Empty(t, c.CheckCapabilities(context.Background(), repo, signal.CollectorOpts{}))
+}
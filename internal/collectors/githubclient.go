@@ -1,13 +1,19 @@
 // Copyright 2026 The Stringer Authors
 // SPDX-License-Identifier: MIT
 
+//go:build !nonetwork
+
 package collectors
 
 import (
+	"context"
 	"log/slog"
+	"net/http"
 	"os"
 
 	"github.com/google/go-github/v68/github"
+
+	"github.com/davetashner/stringer/internal/tracing"
 )
 
 // githubContext holds a GitHub API client and the parsed owner/repo.
@@ -20,7 +26,7 @@ type githubContext struct {
 
 // newGitHubContext creates a githubContext for the given repo path.
 // Returns nil if GITHUB_TOKEN is not set or the remote is not a GitHub URL.
-func newGitHubContext(repoPath string) *githubContext {
+func newGitHubContext(ctx context.Context, repoPath string) *githubContext {
 	token := os.Getenv("GITHUB_TOKEN")
 	if token == "" {
 		return nil
@@ -28,14 +34,37 @@ func newGitHubContext(repoPath string) *githubContext {
 
 	owner, repo, err := parseGitHubRemote(repoPath)
 	if err != nil {
-		slog.Debug("cannot determine GitHub remote for lottery risk review analysis", "error", err)
+		slog.DebugContext(ctx, "cannot determine GitHub remote for lottery risk review analysis", "error", err)
 		return nil
 	}
 
-	client := github.NewClient(nil).WithAuthToken(token)
 	return &githubContext{
 		Owner: owner,
 		Repo:  repo,
-		API:   &realGitHubAPI{client: client},
+		API:   &realGitHubAPI{client: NewGitHubClient(token)},
+	}
+}
+
+// NewGitHubClient returns a GitHub API client authenticated with token.
+// Its requests are recorded as spans when the scan is traced, wait out
+// rate limits that lift soon, and revalidate cached GET responses by ETag.
+// Commands that write to GitHub use it too.
+func NewGitHubClient(token string) *github.Client {
+	return github.NewClient(&http.Client{Transport: newGitHubTransport(tracing.Transport(nil))}).WithAuthToken(token)
+}
+
+// shared returns a copy of g whose API shares fetched responses and the
+// request budget with the other collectors in the scan.
+func (g *githubContext) shared(ctx context.Context) *githubContext {
+	return &githubContext{Owner: g.Owner, Repo: g.Repo, API: githubDataFrom(ctx).wrap(g.API)}
+}
+
+// isPublic reports whether the repository is public. It returns false when
+// the visibility cannot be determined.
+func (g *githubContext) isPublic(ctx context.Context) bool {
+	repo, _, err := g.API.GetRepository(ctx, g.Owner, g.Repo)
+	if err != nil || repo == nil {
+		return false
 	}
+	return !repo.GetPrivate()
 }
@@ -0,0 +1,277 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+//go:build !nonetwork
+
+package collectors
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/google/go-github/v68/github"
+)
+
+// DefaultGitHubAPIBudget is the number of GitHub API requests a scan may
+// make when no budget is given. It leaves headroom under the 5,000
+// requests per hour GitHub grants an authenticated token.
+const DefaultGitHubAPIBudget = 2000
+
+// ErrGitHubBudgetExhausted is returned for GitHub API requests made after
+// the scan's request budget is spent.
+var ErrGitHubBudgetExhausted = errors.New("GitHub API request budget exhausted")
+
+// GitHubData is a per-scan cache of GitHub API responses shared by every
+// collector that talks to GitHub. The github and lotteryrisk collectors
+// both page through closed pull requests and their changed files; with a
+// shared GitHubData each page is fetched once, concurrent requests for the
+// same page wait for a single fetch, and all requests draw on one budget.
+//
+// Pages of pull requests are listed through GraphQL where the API allows,
+// and the reviews, review comments, and files that come with each pull
+// request are kept as if fetched, so they cost no further requests.
+type GitHubData struct {
+	mu        sync.Mutex
+	calls     map[string]*githubCall
+	budget    int
+	requests  int
+	exhausted bool
+	cursors   map[string]string // GraphQL cursor by key of the page it starts
+	noGraphQL bool              // GraphQL failed; list pull requests over REST
+}
+
+// githubCall is one API request, in flight or finished.
+type githubCall struct {
+	done  chan struct{}
+	value any
+	resp  *github.Response
+	err   error
+}
+
+// NewGitHubData returns an empty cache allowing budget API requests.
+// A budget of zero or less uses DefaultGitHubAPIBudget.
+func NewGitHubData(budget int) *GitHubData {
+	if budget <= 0 {
+		budget = DefaultGitHubAPIBudget
+	}
+	return &GitHubData{calls: make(map[string]*githubCall), budget: budget, cursors: make(map[string]string)}
+}
+
+// Requests returns the number of API requests made so far.
+func (d *GitHubData) Requests() int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.requests
+}
+
+// Exhausted reports whether a request was refused because the budget was
+// spent, so some GitHub results are missing.
+func (d *GitHubData) Exhausted() bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.exhausted
+}
+
+type githubDataKey struct{}
+
+// WithGitHubData returns a context whose GitHub collectors share d. Scans
+// attach one GitHubData to the context they run every workspace under.
+func WithGitHubData(ctx context.Context, d *GitHubData) context.Context {
+	return context.WithValue(ctx, githubDataKey{}, d)
+}
+
+// githubDataFrom returns the GitHubData attached to ctx, or a fresh one
+// private to the caller when the scan did not attach one.
+func githubDataFrom(ctx context.Context) *GitHubData {
+	if d, ok := ctx.Value(githubDataKey{}).(*GitHubData); ok && d != nil {
+		return d
+	}
+	return NewGitHubData(0)
+}
+
+// wrap returns a githubAPI that answers from d and falls back to api.
+func (d *GitHubData) wrap(api githubAPI) *sharedGitHubAPI {
+	return &sharedGitHubAPI{data: d, api: api}
+}
+
+// do returns the cached result for key, calling fetch when there is none.
+// A caller arriving while another fetches the same key waits for it. Errors
+// are not cached, and a waiter whose leader was cancelled fetches again
+// under its own context.
+func (d *GitHubData) do(ctx context.Context, key string, fetch func() (any, *github.Response, error)) (any, *github.Response, error) {
+	for {
+		d.mu.Lock()
+		if c, ok := d.calls[key]; ok {
+			d.mu.Unlock()
+			select {
+			case <-c.done:
+			case <-ctx.Done():
+				return nil, nil, ctx.Err()
+			}
+			if c.err != nil && (errors.Is(c.err, context.Canceled) || errors.Is(c.err, context.DeadlineExceeded)) && ctx.Err() == nil {
+				continue
+			}
+			return c.value, c.resp, c.err
+		}
+		if d.requests >= d.budget {
+			d.exhausted = true
+			d.mu.Unlock()
+			return nil, nil, ErrGitHubBudgetExhausted
+		}
+		d.requests++
+		c := &githubCall{done: make(chan struct{})}
+		d.calls[key] = c
+		d.mu.Unlock()
+
+		c.value, c.resp, c.err = fetch()
+		if c.err != nil {
+			d.mu.Lock()
+			delete(d.calls, key)
+			d.mu.Unlock()
+		}
+		close(c.done)
+		return c.value, c.resp, c.err
+	}
+}
+
+// seed stores value as the fetched response for key without drawing on the
+// budget. A key already fetched or in flight keeps its response.
+func (d *GitHubData) seed(key string, value any) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if _, ok := d.calls[key]; ok {
+		return
+	}
+	c := &githubCall{done: make(chan struct{}), value: value, resp: &github.Response{}}
+	close(c.done)
+	d.calls[key] = c
+}
+
+// graphQLCursor returns the GraphQL cursor starting the given page of pull
+// requests, listed under key, and false when the page is to be listed over
+// REST instead.
+func (d *GitHubData) graphQLCursor(key string, page int) (string, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.noGraphQL {
+		return "", false
+	}
+	if page <= 1 {
+		return "", true
+	}
+	cursor, ok := d.cursors[key]
+	return cursor, ok
+}
+
+// setGraphQLCursor records the cursor starting the page listed under key.
+func (d *GitHubData) setGraphQLCursor(key, cursor string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.cursors[key] = cursor
+}
+
+// disableGraphQL makes the rest of the scan list pull requests over REST.
+// It reports whether GraphQL was still enabled.
+func (d *GitHubData) disableGraphQL() bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	enabled := !d.noGraphQL
+	d.noGraphQL = true
+	return enabled
+}
+
+// Cache keys of the requests for one page of each list. The GraphQL path
+// seeds entries under the same keys.
+
+func pullsKey(owner, repo string, opts *github.PullRequestListOptions) string {
+	return fmt.Sprintf("pulls %s/%s %+v", owner, repo, opts)
+}
+
+func reviewsKey(owner, repo string, number int, opts *github.ListOptions) string {
+	return fmt.Sprintf("reviews %s/%s#%d %+v", owner, repo, number, opts)
+}
+
+func reviewCommentsKey(owner, repo string, number int, opts *github.PullRequestListCommentsOptions) string {
+	return fmt.Sprintf("review-comments %s/%s#%d %+v", owner, repo, number, opts)
+}
+
+func filesKey(owner, repo string, number int, opts *github.ListOptions) string {
+	return fmt.Sprintf("files %s/%s#%d %+v", owner, repo, number, opts)
+}
+
+// cachedCall runs one typed request through d.do.
+func cachedCall[T any](ctx context.Context, d *GitHubData, key string, fetch func() (T, *github.Response, error)) (T, *github.Response, error) {
+	v, resp, err := d.do(ctx, key, func() (any, *github.Response, error) {
+		return fetch()
+	})
+	t, _ := v.(T)
+	return t, resp, err
+}
+
+// sharedGitHubAPI is a githubAPI answering from a GitHubData. Responses are
+// shared between collectors and must not be modified.
+type sharedGitHubAPI struct {
+	data *GitHubData
+	api  githubAPI
+}
+
+func (s *sharedGitHubAPI) ListIssues(ctx context.Context, owner, repo string, opts *github.IssueListByRepoOptions) ([]*github.Issue, *github.Response, error) {
+	key := fmt.Sprintf("issues %s/%s %+v", owner, repo, opts)
+	return cachedCall(ctx, s.data, key, func() ([]*github.Issue, *github.Response, error) {
+		return s.api.ListIssues(ctx, owner, repo, opts)
+	})
+}
+
+func (s *sharedGitHubAPI) ListPullRequests(ctx context.Context, owner, repo string, opts *github.PullRequestListOptions) ([]*github.PullRequest, *github.Response, error) {
+	key := pullsKey(owner, repo, opts)
+	return cachedCall(ctx, s.data, key, func() ([]*github.PullRequest, *github.Response, error) {
+		if prs, resp, ok := s.batchPullRequests(ctx, owner, repo, opts); ok {
+			return prs, resp, nil
+		}
+		return s.api.ListPullRequests(ctx, owner, repo, opts)
+	})
+}
+
+func (s *sharedGitHubAPI) ListReviews(ctx context.Context, owner, repo string, number int, opts *github.ListOptions) ([]*github.PullRequestReview, *github.Response, error) {
+	key := reviewsKey(owner, repo, number, opts)
+	return cachedCall(ctx, s.data, key, func() ([]*github.PullRequestReview, *github.Response, error) {
+		return s.api.ListReviews(ctx, owner, repo, number, opts)
+	})
+}
+
+func (s *sharedGitHubAPI) ListReviewComments(ctx context.Context, owner, repo string, number int, opts *github.PullRequestListCommentsOptions) ([]*github.PullRequestComment, *github.Response, error) {
+	key := reviewCommentsKey(owner, repo, number, opts)
+	return cachedCall(ctx, s.data, key, func() ([]*github.PullRequestComment, *github.Response, error) {
+		return s.api.ListReviewComments(ctx, owner, repo, number, opts)
+	})
+}
+
+func (s *sharedGitHubAPI) ListPullRequestFiles(ctx context.Context, owner, repo string, number int, opts *github.ListOptions) ([]*github.CommitFile, *github.Response, error) {
+	key := filesKey(owner, repo, number, opts)
+	return cachedCall(ctx, s.data, key, func() ([]*github.CommitFile, *github.Response, error) {
+		return s.api.ListPullRequestFiles(ctx, owner, repo, number, opts)
+	})
+}
+
+// ListReleases lists releases when the underlying API can.
+func (s *sharedGitHubAPI) ListReleases(ctx context.Context, owner, repo string, opts *github.ListOptions) ([]*github.RepositoryRelease, *github.Response, error) {
+	lister, ok := s.api.(interface {
+		ListReleases(ctx context.Context, owner, repo string, opts *github.ListOptions) ([]*github.RepositoryRelease, *github.Response, error)
+	})
+	if !ok {
+		return nil, nil, errors.New("listing releases is not supported")
+	}
+	key := fmt.Sprintf("releases %s/%s %+v", owner, repo, opts)
+	return cachedCall(ctx, s.data, key, func() ([]*github.RepositoryRelease, *github.Response, error) {
+		return lister.ListReleases(ctx, owner, repo, opts)
+	})
+}
+
+func (s *sharedGitHubAPI) GetRepository(ctx context.Context, owner, repo string) (*github.Repository, *github.Response, error) {
+	key := fmt.Sprintf("repo %s/%s", owner, repo)
+	return cachedCall(ctx, s.data, key, func() (*github.Repository, *github.Response, error) {
+		return s.api.GetRepository(ctx, owner, repo)
+	})
+}
@@ -0,0 +1,174 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+//go:build !nonetwork
+
+package collectors
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/google/go-github/v68/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// countingPRAPI counts ListPullRequests and ListPullRequestFiles calls.
+// When release is set, ListPullRequests blocks until it is closed.
+type countingPRAPI struct {
+	mockGitHubAPI
+	prCalls   atomic.Int32
+	fileCalls atomic.Int32
+	started   chan struct{}
+	release   chan struct{}
+	err       error
+}
+
+func (c *countingPRAPI) ListPullRequests(ctx context.Context, _, _ string, _ *github.PullRequestListOptions) ([]*github.PullRequest, *github.Response, error) {
+	c.prCalls.Add(1)
+	if c.release != nil {
+		c.started <- struct{}{}
+		select {
+		case <-c.release:
+		case <-ctx.Done():
+			return nil, nil, ctx.Err()
+		}
+	}
+	if c.err != nil {
+		return nil, nil, c.err
+	}
+	return []*github.PullRequest{{Number: github.Ptr(1)}}, &github.Response{}, nil
+}
+
+func (c *countingPRAPI) ListPullRequestFiles(_ context.Context, _, _ string, _ int, _ *github.ListOptions) ([]*github.CommitFile, *github.Response, error) {
+	c.fileCalls.Add(1)
+	return []*github.CommitFile{{Filename: github.Ptr("main.go")}}, &github.Response{}, nil
+}
+
+func closedPROpts(page int) *github.PullRequestListOptions {
+	return &github.PullRequestListOptions{State: "closed", Sort: "updated", Direction: "desc", ListOptions: github.ListOptions{Page: page, PerPage: 100}}
+}
+
+func TestGitHubData_SharesResponses(t *testing.T) {
+	ctx := context.Background()
+	inner := &countingPRAPI{}
+	d := NewGitHubData(0)
+	ghAPI, lottery := d.wrap(inner), d.wrap(inner)
+
+	prs, _, err := ghAPI.ListPullRequests(ctx, "o", "r", closedPROpts(0))
+	require.NoError(t, err)
+	again, _, err := lottery.ListPullRequests(ctx, "o", "r", closedPROpts(0))
+	require.NoError(t, err)
+	assert.Equal(t, prs, again)
+	assert.Equal(t, int32(1), inner.prCalls.Load(), "the same page is fetched once")
+
+	_, _, err = lottery.ListPullRequests(ctx, "o", "r", closedPROpts(2))
+	require.NoError(t, err)
+	_, _, err = lottery.ListPullRequests(ctx, "o", "other", closedPROpts(0))
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), inner.prCalls.Load(), "other pages and repos are fetched")
+
+	for range 2 {
+		_, _, err = ghAPI.ListPullRequestFiles(ctx, "o", "r", 1, &github.ListOptions{PerPage: 100})
+		require.NoError(t, err)
+	}
+	assert.Equal(t, int32(1), inner.fileCalls.Load())
+	assert.Equal(t, 4, d.Requests())
+}
+
+func TestGitHubData_Coalesces(t *testing.T) {
+	inner := &countingPRAPI{started: make(chan struct{}, 1), release: make(chan struct{})}
+	api := NewGitHubData(0).wrap(inner)
+
+	var wg sync.WaitGroup
+	results := make([][]*github.PullRequest, 5)
+	for i := range results {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			prs, _, err := api.ListPullRequests(context.Background(), "o", "r", closedPROpts(0))
+			assert.NoError(t, err)
+			results[i] = prs
+		}()
+	}
+	<-inner.started
+	close(inner.release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), inner.prCalls.Load(), "concurrent requests wait for one fetch")
+	for _, prs := range results {
+		assert.Len(t, prs, 1)
+	}
+}
+
+func TestGitHubData_Budget(t *testing.T) {
+	ctx := context.Background()
+	inner := &countingPRAPI{}
+	data := NewGitHubData(1)
+	api := data.wrap(inner)
+
+	_, _, err := api.ListPullRequests(ctx, "o", "r", closedPROpts(0))
+	require.NoError(t, err)
+	_, _, err = api.ListPullRequests(ctx, "o", "r", closedPROpts(0))
+	require.NoError(t, err, "cached responses cost nothing")
+	assert.False(t, data.Exhausted())
+	_, _, err = api.ListPullRequests(ctx, "o", "r", closedPROpts(2))
+	assert.ErrorIs(t, err, ErrGitHubBudgetExhausted)
+	assert.True(t, data.Exhausted())
+	assert.Equal(t, int32(1), inner.prCalls.Load())
+}
+
+func TestGitHubData_ErrorsNotCached(t *testing.T) {
+	ctx := context.Background()
+	inner := &countingPRAPI{err: errors.New("502 bad gateway")}
+	api := NewGitHubData(0).wrap(inner)
+
+	_, _, err := api.ListPullRequests(ctx, "o", "r", closedPROpts(0))
+	require.Error(t, err)
+	inner.err = nil
+	prs, _, err := api.ListPullRequests(ctx, "o", "r", closedPROpts(0))
+	require.NoError(t, err)
+	assert.Len(t, prs, 1)
+	assert.Equal(t, int32(2), inner.prCalls.Load())
+}
+
+func TestGitHubData_CancelledLeader(t *testing.T) {
+	inner := &countingPRAPI{started: make(chan struct{}, 2), release: make(chan struct{})}
+	api := NewGitHubData(0).wrap(inner)
+
+	leaderCtx, cancel := context.WithCancel(context.Background())
+	leaderErr := make(chan error, 1)
+	go func() {
+		_, _, err := api.ListPullRequests(leaderCtx, "o", "r", closedPROpts(0))
+		leaderErr <- err
+	}()
+	<-inner.started
+
+	waiter := make(chan []*github.PullRequest, 1)
+	go func() {
+		prs, _, err := api.ListPullRequests(context.Background(), "o", "r", closedPROpts(0))
+		assert.NoError(t, err)
+		waiter <- prs
+	}()
+
+	cancel()
+	assert.ErrorIs(t, <-leaderErr, context.Canceled)
+	<-inner.started
+	close(inner.release)
+	assert.Len(t, <-waiter, 1, "a waiter refetches under its own context")
+	assert.Equal(t, int32(2), inner.prCalls.Load())
+}
+
+func TestGitHubDataFrom(t *testing.T) {
+	d := NewGitHubData(5)
+	assert.Same(t, d, githubDataFrom(WithGitHubData(context.Background(), d)))
+
+	fresh := githubDataFrom(context.Background())
+	require.NotNil(t, fresh)
+	assert.NotSame(t, d, fresh)
+	assert.Equal(t, DefaultGitHubAPIBudget, fresh.budget)
+}
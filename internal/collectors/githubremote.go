@@ -0,0 +1,136 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+// Package collectors provides signal extraction modules for stringer.
+//
+// The github, gitlab, dephealth, and vuln collectors call out to network
+// services. Builds with the nonetwork tag skip them and do not link
+// go-github.
+package collectors
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/davetashner/stringer/internal/gitcli"
+	"github.com/davetashner/stringer/internal/signal"
+	"github.com/davetashner/stringer/internal/testable"
+)
+
+// sshRemotePattern matches git@github.com:owner/repo.git SSH URLs.
+var sshRemotePattern = regexp.MustCompile(`^git@github\.com:([^/]+)/([^/]+?)(?:\.git)?$`)
+
+// ParseGitHubRemote extracts the owner and repo name from the origin remote
+// of the repository at repoPath. Supports both HTTPS and SSH formats.
+func ParseGitHubRemote(repoPath string) (owner, repo string, err error) {
+	return parseGitHubRemote(repoPath)
+}
+
+// parseGitHubRemote extracts the owner and repo name from the git remote
+// origin URL. Supports both HTTPS and SSH formats.
+// Uses the default git opener.
+func parseGitHubRemote(repoPath string) (owner, repo string, err error) {
+	return parseGitHubRemoteWith(testable.DefaultGitOpener, repoPath)
+}
+
+// parseGitHubRemoteWith extracts the owner and repo name using the provided
+// GitOpener. This allows tests to inject a mock opener.
+func parseGitHubRemoteWith(opener testable.GitOpener, repoPath string) (owner, repo string, err error) {
+	rawURL, err := originURL(opener, repoPath)
+	if err != nil {
+		return "", "", err
+	}
+	return parseGitHubURL(rawURL)
+}
+
+// originURL returns the URL of the origin remote of the repository at
+// repoPath. When go-git cannot open the repository (e.g. because the repo
+// has extensions.worktreeConfig=true which go-git does not yet support), it
+// falls back to shelling out to the system git binary via gitcli.
+func originURL(opener testable.GitOpener, repoPath string) (string, error) {
+	gitRepo, err := opener.PlainOpen(repoPath)
+	if err != nil {
+		// Fall back to the system git CLI when go-git cannot open the repo.
+		// This handles repos with unsupported extensions (e.g. worktreeConfig).
+		rawURL, cliErr := gitcli.Exec(context.Background(), repoPath, "remote", "get-url", "origin")
+		if cliErr != nil {
+			return "", fmt.Errorf("opening repo: %w", err)
+		}
+		return strings.TrimSpace(rawURL), nil
+	}
+
+	remotes, err := gitRepo.Remotes()
+	if err != nil {
+		return "", fmt.Errorf("listing remotes: %w", err)
+	}
+
+	// Find origin remote.
+	for _, r := range remotes {
+		if r.Config().Name == "origin" && len(r.Config().URLs) > 0 {
+			return r.Config().URLs[0], nil
+		}
+	}
+	return "", fmt.Errorf("no origin remote found")
+}
+
+// githubRepoFor returns the GitHub owner and repo a collector reads:
+// opts.GitHubRepo when set, and otherwise the origin remote of gitPath.
+func githubRepoFor(opener testable.GitOpener, gitPath string, opts signal.CollectorOpts) (owner, repo string, err error) {
+	if opts.GitHubRepo != "" {
+		owner, repo, ok := strings.Cut(opts.GitHubRepo, "/")
+		if !ok || owner == "" || repo == "" || strings.Contains(repo, "/") {
+			return "", "", fmt.Errorf("GitHub repository %q is not owner/name", opts.GitHubRepo)
+		}
+		return owner, repo, nil
+	}
+	return parseGitHubRemoteWith(opener, gitPath)
+}
+
+// parseGitHubURL parses a GitHub URL (HTTPS or SSH) into owner and repo.
+func parseGitHubURL(rawURL string) (owner, repo string, err error) {
+	// Try SSH format: git@github.com:owner/repo.git
+	if m := sshRemotePattern.FindStringSubmatch(rawURL); m != nil {
+		return m[1], m[2], nil
+	}
+
+	// Try HTTPS format: https://github.com/owner/repo.git
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return "", "", fmt.Errorf("parsing URL %q: %w", rawURL, err)
+	}
+
+	if parsed.Host != "github.com" {
+		return "", "", fmt.Errorf("remote %q is not a GitHub URL", rawURL)
+	}
+
+	parts := strings.Split(strings.Trim(parsed.Path, "/"), "/")
+	if len(parts) < 2 {
+		return "", "", fmt.Errorf("cannot parse owner/repo from %q", rawURL)
+	}
+
+	owner = parts[0]
+	repo = strings.TrimSuffix(parts[1], ".git")
+	return owner, repo, nil
+}
+
+// ModuleFromPath derives a module name from a file path by taking the
+// first two path segments (e.g., "internal/collectors") or the directory
+// name if only one level deep. Root-level files return ".".
+func ModuleFromPath(path string) string {
+	return moduleFromPath(path)
+}
+
+func moduleFromPath(path string) string {
+	parts := strings.Split(path, "/")
+	switch {
+	case len(parts) >= 3:
+		return parts[0] + "/" + parts[1]
+	case len(parts) == 2:
+		return parts[0]
+	default:
+		return "."
+	}
+}
@@ -0,0 +1,152 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package collectors
+
+import (
+	"testing"
+
+	gogit "github.com/go-git/go-git/v5"
+	gogitconfig "github.com/go-git/go-git/v5/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davetashner/stringer/internal/signal"
+	"github.com/davetashner/stringer/internal/testable"
+)
+
+func TestParseGitHubRemote_HTTPS(t *testing.T) {
+	repoPath := initGitHubTestRepo(t, "https://github.com/myowner/myrepo.git")
+	owner, repo, err := parseGitHubRemote(repoPath)
+	require.NoError(t, err)
+	assert.Equal(t, "myowner", owner)
+	assert.Equal(t, "myrepo", repo)
+}
+
+func TestParseGitHubRemote_SSH(t *testing.T) {
+	repoPath := initGitHubTestRepo(t, "git@github.com:sshowner/sshrepo.git")
+	owner, repo, err := parseGitHubRemote(repoPath)
+	require.NoError(t, err)
+	assert.Equal(t, "sshowner", owner)
+	assert.Equal(t, "sshrepo", repo)
+}
+
+func TestParseGitHubRemote_HTTPSNoGit(t *testing.T) {
+	repoPath := initGitHubTestRepo(t, "https://github.com/noext/norepo")
+	owner, repo, err := parseGitHubRemote(repoPath)
+	require.NoError(t, err)
+	assert.Equal(t, "noext", owner)
+	assert.Equal(t, "norepo", repo)
+}
+
+func TestParseGitHubRemote_NonGitHub(t *testing.T) {
+	repoPath := initGitHubTestRepo(t, "https://gitlab.com/other/repo.git")
+	_, _, err := parseGitHubRemote(repoPath)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not a GitHub URL")
+}
+
+func TestGitHubRepoFor_Override(t *testing.T) {
+	repoPath := initGitHubTestRepo(t, "https://github.com/myowner/myrepo.git")
+	owner, repo, err := githubRepoFor(testable.DefaultGitOpener, repoPath, signal.CollectorOpts{GitHubRepo: "acme/api"})
+	require.NoError(t, err)
+	assert.Equal(t, "acme", owner)
+	assert.Equal(t, "api", repo)
+
+	owner, _, err = githubRepoFor(testable.DefaultGitOpener, repoPath, signal.CollectorOpts{})
+	require.NoError(t, err)
+	assert.Equal(t, "myowner", owner, "the origin remote is used without an override")
+
+	_, _, err = githubRepoFor(testable.DefaultGitOpener, t.TempDir(), signal.CollectorOpts{GitHubRepo: "acme"})
+	assert.ErrorContains(t, err, "not owner/name")
+}
+
+// initGitHubTestRepo creates a temporary git repository with the given remote URL.
+func initGitHubTestRepo(t *testing.T, remoteURL string) string {
+	t.Helper()
+	dir := t.TempDir()
+
+	// Initialize a git repo using go-git.
+	repo, err := gogit.PlainInit(dir, false)
+	require.NoError(t, err)
+
+	// Add origin remote.
+	_, err = repo.CreateRemote(&gogitconfig.RemoteConfig{
+		Name: "origin",
+		URLs: []string{remoteURL},
+	})
+	require.NoError(t, err)
+
+	return dir
+}
+
+func TestModuleFromPath(t *testing.T) {
+	tests := []struct {
+		path     string
+		expected string
+	}{
+		{"internal/collectors/todos.go", "internal/collectors"},
+		{"cmd/stringer/main.go", "cmd/stringer"},
+		{"cmd/main.go", "cmd"},
+		{"README.md", "."},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			assert.Equal(t, tt.expected, moduleFromPath(tt.path))
+		})
+	}
+}
+
+func TestParseGitHubURL_SSHFormat(t *testing.T) {
+	owner, repo, err := parseGitHubURL("git@github.com:myowner/myrepo.git")
+	require.NoError(t, err)
+	assert.Equal(t, "myowner", owner)
+	assert.Equal(t, "myrepo", repo)
+}
+
+func TestParseGitHubURL_SSHWithoutGit(t *testing.T) {
+	owner, repo, err := parseGitHubURL("git@github.com:myowner/myrepo")
+	require.NoError(t, err)
+	assert.Equal(t, "myowner", owner)
+	assert.Equal(t, "myrepo", repo)
+}
+
+func TestParseGitHubURL_InvalidURL(t *testing.T) {
+	_, _, err := parseGitHubURL("not-a-url")
+	require.Error(t, err)
+}
+
+func TestParseGitHubURL_NonGitHubHost(t *testing.T) {
+	_, _, err := parseGitHubURL("https://gitlab.com/owner/repo.git")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not a GitHub URL")
+}
+
+func TestParseGitHubURL_TooFewPathParts(t *testing.T) {
+	_, _, err := parseGitHubURL("https://github.com/onlyowner")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "cannot parse owner/repo")
+}
+
+func TestParseGitHubRemoteWith_NoOrigin(t *testing.T) {
+	mockRepo := &testable.MockGitRepository{
+		RemotesList: nil, // No remotes.
+	}
+	opener := &testable.MockGitOpener{Repo: mockRepo}
+	_, _, err := parseGitHubRemoteWith(opener, "/tmp/fake")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no origin remote found")
+}
+
+func TestModuleFromPath_Public(t *testing.T) {
+	assert.Equal(t, "internal/collectors", ModuleFromPath("internal/collectors/todos.go"))
+	assert.Equal(t, ".", ModuleFromPath("README.md"))
+	assert.Equal(t, "cmd", ModuleFromPath("cmd/main.go"))
+}
+
+func TestParseGitHubURL_SSHMalformed(t *testing.T) {
+	// SSH URL with only owner (no repo).
+	_, _, err := parseGitHubURL("git@github.com:onlyowner")
+	// This should fail because SSH pattern requires owner/repo.
+	require.Error(t, err)
+}
@@ -0,0 +1,266 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+//go:build !nonetwork
+
+package collectors
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// githubRetries is how many times a rate-limited request is retried.
+	githubRetries = 3
+
+	// maxGitHubRetryWait is the longest a request waits for a rate limit
+	// to lift. Longer waits fail the request, as GitHub answered it.
+	maxGitHubRetryWait = 2 * time.Minute
+
+	// defaultSecondaryRetryAfter is waited when a secondary rate limit
+	// response does not say how long to wait.
+	defaultSecondaryRetryAfter = time.Minute
+
+	// maxETagCacheBody caps the size of a response kept in the ETag cache.
+	maxETagCacheBody = 8 << 20
+)
+
+// githubTransport is the http.RoundTripper of the GitHub clients stringer
+// creates. It retries requests GitHub turned away with a secondary rate
+// limit, or with a primary limit that lifts soon, after the wait GitHub
+// asks for. GET responses with an ETag are kept in an on-disk cache and
+// revalidated with If-None-Match, so pages that have not changed since the
+// last scan cost no rate limit.
+type githubTransport struct {
+	base  http.RoundTripper
+	cache *etagCache // nil disables conditional requests
+	now   func() time.Time
+	sleep func(ctx context.Context, d time.Duration) error
+}
+
+// newGitHubTransport wraps base with rate-limit retries and the ETag cache
+// in the user cache directory.
+func newGitHubTransport(base http.RoundTripper) *githubTransport {
+	t := &githubTransport{base: base, now: time.Now, sleep: sleepContext}
+	if dir, err := os.UserCacheDir(); err == nil {
+		t.cache = &etagCache{dir: filepath.Join(dir, "stringer", "github")}
+	}
+	return t
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *githubTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var key string
+	var cached *etagEntry
+	if t.cache != nil && req.Method == http.MethodGet && req.Header.Get("If-None-Match") == "" {
+		key = etagKey(req)
+		if cached = t.cache.load(key); cached != nil {
+			req = req.Clone(req.Context())
+			req.Header.Set("If-None-Match", cached.ETag)
+		}
+	}
+
+	resp, err := t.roundTripWithRetry(req)
+	if err != nil || key == "" {
+		return resp, err
+	}
+	switch {
+	case resp.StatusCode == http.StatusNotModified && cached != nil:
+		return cached.response(req, resp), nil
+	case resp.StatusCode == http.StatusOK && resp.Header.Get("ETag") != "":
+		return t.cache.store(req.Context(), key, resp), nil
+	}
+	return resp, nil
+}
+
+// roundTripWithRetry sends req, sending it again while GitHub answers with
+// a rate limit that lifts within maxGitHubRetryWait.
+func (t *githubTransport) roundTripWithRetry(req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		resp, err := t.base.RoundTrip(req)
+		if err != nil || attempt >= githubRetries {
+			return resp, err
+		}
+		wait, limited := t.rateLimitWait(resp)
+		if !limited || wait > maxGitHubRetryWait {
+			return resp, nil
+		}
+		if req.Body != nil && req.GetBody == nil {
+			return resp, nil
+		}
+		next := req.Clone(req.Context())
+		if req.GetBody != nil {
+			if next.Body, err = req.GetBody(); err != nil {
+				return resp, nil
+			}
+		}
+
+		_ = resp.Body.Close()
+		slog.WarnContext(req.Context(), "GitHub rate limit reached, waiting", "wait", wait.Round(time.Second), "attempt", attempt+1)
+		if err := t.sleep(req.Context(), wait); err != nil {
+			return nil, err
+		}
+		req = next
+	}
+}
+
+// rateLimitWait reports whether resp is a rate limit response and, if so,
+// how long to wait before trying again. The body of resp is left readable.
+func (t *githubTransport) rateLimitWait(resp *http.Response) (time.Duration, bool) {
+	if resp.StatusCode != http.StatusForbidden && resp.StatusCode != http.StatusTooManyRequests {
+		return 0, false
+	}
+	if s := resp.Header.Get("Retry-After"); s != "" {
+		if secs, err := strconv.Atoi(s); err == nil && secs >= 0 {
+			return time.Duration(secs) * time.Second, true
+		}
+	}
+	if resp.Header.Get("X-RateLimit-Remaining") == "0" {
+		if reset, err := strconv.ParseInt(resp.Header.Get("X-RateLimit-Reset"), 10, 64); err == nil {
+			// A second of slack covers clock skew with GitHub.
+			return max(time.Unix(reset, 0).Sub(t.now()), 0) + time.Second, true
+		}
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	if err == nil && strings.Contains(strings.ToLower(string(body)), "secondary rate limit") {
+		return defaultSecondaryRetryAfter, true
+	}
+	return 0, false
+}
+
+// sleepContext waits for d or until ctx is done.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// etagCache keeps GitHub GET responses on disk, one file per request.
+type etagCache struct {
+	dir string
+}
+
+// etagEntry is a cached response.
+type etagEntry struct {
+	ETag   string      `json:"etag"`
+	Header http.Header `json:"header"`
+	Body   []byte      `json:"body"`
+}
+
+// etagKey identifies a request by URL, media type, and credentials, so
+// tokens with different access never share an entry.
+func etagKey(req *http.Request) string {
+	h := sha256.New()
+	for _, s := range []string{req.URL.String(), req.Header.Get("Accept"), req.Header.Get("Authorization")} {
+		h.Write([]byte(s))
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func (c *etagCache) path(key string) string {
+	return filepath.Join(c.dir, key[:2], key+".json")
+}
+
+// load returns the entry for key, or nil when there is none or it cannot
+// be read.
+func (c *etagCache) load(key string) *etagEntry {
+	data, err := os.ReadFile(c.path(key))
+	if err != nil {
+		return nil
+	}
+	var e etagEntry
+	if err := json.Unmarshal(data, &e); err != nil || e.ETag == "" {
+		return nil
+	}
+	return &e
+}
+
+// store saves resp under key and returns a response with the same body.
+// Failing to save only costs the next scan a full request.
+func (c *etagCache) store(ctx context.Context, key string, resp *http.Response) *http.Response {
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxETagCacheBody+1))
+	if err != nil || len(body) > maxETagCacheBody {
+		resp.Body = struct {
+			io.Reader
+			io.Closer
+		}{io.MultiReader(bytes.NewReader(body), resp.Body), resp.Body}
+		return resp
+	}
+	_ = resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	data, err := json.Marshal(etagEntry{ETag: resp.Header.Get("ETag"), Header: resp.Header, Body: body})
+	if err == nil {
+		err = writeFileAtomic(c.path(key), data)
+	}
+	if err != nil {
+		slog.DebugContext(ctx, "cannot cache GitHub response", "error", err)
+	}
+	return resp
+}
+
+// response rebuilds the cached response for req from a 304 answer, taking
+// the current rate limit headers from it.
+func (e *etagEntry) response(req *http.Request, notModified *http.Response) *http.Response {
+	_ = notModified.Body.Close()
+	header := e.Header.Clone()
+	for k, v := range notModified.Header {
+		if strings.HasPrefix(k, "X-Ratelimit-") {
+			header[k] = v
+		}
+	}
+	return &http.Response{
+		Status:        "200 OK",
+		StatusCode:    http.StatusOK,
+		Proto:         notModified.Proto,
+		ProtoMajor:    notModified.ProtoMajor,
+		ProtoMinor:    notModified.ProtoMinor,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(e.Body)),
+		ContentLength: int64(len(e.Body)),
+		Request:       req,
+	}
+}
+
+// writeFileAtomic writes data to path through a temporary file, so a
+// concurrent reader never sees a partial entry.
+func writeFileAtomic(path string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".tmp-*")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
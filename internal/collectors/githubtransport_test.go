@@ -0,0 +1,196 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+//go:build !nonetwork
+
+package collectors
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testGitHubTransport returns a githubTransport that records its waits
+// instead of sleeping, with an ETag cache in a temporary directory.
+func testGitHubTransport(t *testing.T, now time.Time) (*githubTransport, *[]time.Duration) {
+	t.Helper()
+	var waits []time.Duration
+	return &githubTransport{
+		base:  http.DefaultTransport,
+		cache: &etagCache{dir: t.TempDir()},
+		now:   func() time.Time { return now },
+		sleep: func(_ context.Context, d time.Duration) error {
+			waits = append(waits, d)
+			return nil
+		},
+	}, &waits
+}
+
+func transportGet(t *testing.T, tr http.RoundTripper, url, token string) (*http.Response, string) {
+	t.Helper()
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	require.NoError(t, err)
+	req.Header.Set("Authorization", "Bearer "+token)
+	resp, err := tr.RoundTrip(req)
+	require.NoError(t, err)
+	defer resp.Body.Close() //nolint:errcheck // test
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return resp, string(body)
+}
+
+func TestGitHubTransport_SecondaryRateLimit(t *testing.T) {
+	tests := []struct {
+		name   string
+		limit  func(w http.ResponseWriter)
+		wantIn time.Duration
+	}{
+		{"retry-after", func(w http.ResponseWriter) {
+			w.Header().Set("Retry-After", "5")
+			w.WriteHeader(http.StatusForbidden)
+		}, 5 * time.Second},
+		{"message only", func(w http.ResponseWriter) {
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, `{"message": "You have exceeded a secondary rate limit. Please wait a few minutes before you try again."}`)
+		}, defaultSecondaryRetryAfter},
+		{"too many requests", func(w http.ResponseWriter) {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+		}, time.Second},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls atomic.Int32
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				if calls.Add(1) == 1 {
+					tt.limit(w)
+					return
+				}
+				fmt.Fprint(w, "[]")
+			}))
+			defer srv.Close()
+
+			tr, waits := testGitHubTransport(t, time.Now())
+			resp, body := transportGet(t, tr, srv.URL, "t")
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+			assert.Equal(t, "[]", body)
+			assert.Equal(t, []time.Duration{tt.wantIn}, *waits)
+		})
+	}
+}
+
+func TestGitHubTransport_PrimaryRateLimit(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	resetIn := func(d time.Duration) http.HandlerFunc {
+		var calls atomic.Int32
+		return func(w http.ResponseWriter, _ *http.Request) {
+			if calls.Add(1) == 1 {
+				w.Header().Set("X-RateLimit-Remaining", "0")
+				w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(now.Add(d).Unix(), 10))
+				w.WriteHeader(http.StatusForbidden)
+				fmt.Fprint(w, `{"message": "API rate limit exceeded"}`)
+				return
+			}
+			fmt.Fprint(w, "[]")
+		}
+	}
+
+	srv := httptest.NewServer(resetIn(30 * time.Second))
+	defer srv.Close()
+	tr, waits := testGitHubTransport(t, now)
+	resp, _ := transportGet(t, tr, srv.URL, "t")
+	assert.Equal(t, http.StatusOK, resp.StatusCode, "a limit that resets soon is waited out")
+	assert.Equal(t, []time.Duration{31 * time.Second}, *waits)
+
+	far := httptest.NewServer(resetIn(time.Hour))
+	defer far.Close()
+	tr, waits = testGitHubTransport(t, now)
+	resp, body := transportGet(t, tr, far.URL, "t")
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode, "a limit that resets later fails the request")
+	assert.Contains(t, body, "API rate limit exceeded", "the body is left for the client to read")
+	assert.Empty(t, *waits)
+}
+
+func TestGitHubTransport_GivesUp(t *testing.T) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls.Add(1)
+		w.Header().Set("Retry-After", "1")
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer srv.Close()
+
+	tr, waits := testGitHubTransport(t, time.Now())
+	resp, _ := transportGet(t, tr, srv.URL, "t")
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	assert.Equal(t, int32(githubRetries+1), calls.Load())
+	assert.Len(t, *waits, githubRetries)
+}
+
+func TestGitHubTransport_RetriesResendBody(t *testing.T) {
+	var calls atomic.Int32
+	var bodies []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(b))
+		if calls.Add(1) == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer srv.Close()
+
+	tr, _ := testGitHubTransport(t, time.Now())
+	req, err := http.NewRequest(http.MethodPost, srv.URL, strings.NewReader(`{"title":"x"}`))
+	require.NoError(t, err)
+	resp, err := tr.RoundTrip(req)
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+	assert.Equal(t, []string{`{"title":"x"}`, `{"title":"x"}`}, bodies)
+}
+
+func TestGitHubTransport_ETagCache(t *testing.T) {
+	var full, notModified atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Remaining", strconv.Itoa(4000-int(full.Load())))
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			notModified.Add(1)
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		full.Add(1)
+		w.Header().Set("ETag", `"v1"`)
+		w.Header().Set("Link", `<https://api.github.com/x?page=2>; rel="next"`)
+		fmt.Fprint(w, `[{"number": 1}]`)
+	}))
+	defer srv.Close()
+
+	tr, _ := testGitHubTransport(t, time.Now())
+	_, body := transportGet(t, tr, srv.URL+"/repos/o/r/issues", "alice")
+	assert.Equal(t, `[{"number": 1}]`, body)
+
+	resp, body := transportGet(t, tr, srv.URL+"/repos/o/r/issues", "alice")
+	assert.Equal(t, http.StatusOK, resp.StatusCode, "a 304 is answered from the cache")
+	assert.Equal(t, `[{"number": 1}]`, body)
+	assert.Equal(t, `<https://api.github.com/x?page=2>; rel="next"`, resp.Header.Get("Link"))
+	assert.Equal(t, "3999", resp.Header.Get("X-RateLimit-Remaining"), "rate limit headers come from the 304")
+	assert.Equal(t, int32(1), full.Load())
+	assert.Equal(t, int32(1), notModified.Load())
+
+	_, _ = transportGet(t, tr, srv.URL+"/repos/o/r/issues", "bob")
+	assert.Equal(t, int32(2), full.Load(), "another token does not use the cached entry")
+}
@@ -13,7 +13,10 @@ import (
 	"strings"
 
 	"github.com/davetashner/stringer/internal/collector"
+	"github.com/davetashner/stringer/internal/gitcli"
+	"github.com/davetashner/stringer/internal/redact"
 	"github.com/davetashner/stringer/internal/signal"
+	"github.com/davetashner/stringer/internal/statedir"
 )
 
 // defaultLargeBinaryThreshold is the minimum file size in bytes to flag a
@@ -31,11 +34,12 @@ type GitHygieneMetrics struct {
 	MergeConflictMarkers int
 	CommittedSecrets     int
 	MixedLineEndings     int
+	TrackedStateFiles    int
 }
 
 // GitHygieneCollector detects repository-level hygiene problems:
 // large committed binaries, merge conflict markers, committed secrets,
-// and mixed line endings.
+// mixed line endings, and stringer state files tracked in git.
 type GitHygieneCollector struct {
 	metrics *GitHygieneMetrics
 }
@@ -87,7 +91,7 @@ func (c *GitHygieneCollector) Collect(ctx context.Context, repoPath string, opts
 	var signals []signal.RawSignal
 	metrics := &GitHygieneMetrics{}
 
-	err := FS.WalkDir(repoPath, func(path string, d os.DirEntry, walkErr error) error {
+	err := walkTree(ctx, repoPath, func(path string, d os.DirEntry, walkErr error) error {
 		if walkErr != nil {
 			return nil
 		}
@@ -101,7 +105,7 @@ func (c *GitHygieneCollector) Collect(ctx context.Context, repoPath string, opts
 		}
 
 		if d.IsDir() {
-			if shouldExclude(relPath, excludes) {
+			if shouldExclude(relPath, excludes) || !opts.Scope.ContainsDir(relPath) {
 				return filepath.SkipDir
 			}
 			return nil
@@ -111,8 +115,9 @@ func (c *GitHygieneCollector) Collect(ctx context.Context, repoPath string, opts
 			return nil
 		}
 
-		// Skip symlinks outside repo tree.
-		if d.Type()&os.ModeSymlink != 0 && isSymlinkOutsideRepo(path, repoPath) {
+		// Skip symlinks; targets inside the tree are visited at their
+		// canonical path.
+		if isSymlink(d) {
 			return nil
 		}
 
@@ -120,6 +125,10 @@ func (c *GitHygieneCollector) Collect(ctx context.Context, repoPath string, opts
 			return nil
 		}
 
+		if !opts.Scope.Contains(relPath) {
+			return nil
+		}
+
 		metrics.FilesScanned++
 
 		binary := isBinaryFile(path)
@@ -176,6 +185,10 @@ func (c *GitHygieneCollector) Collect(ctx context.Context, repoPath string, opts
 		return nil, fmt.Errorf("walking repo: %w", err)
 	}
 
+	stateSignals := trackedStateSignals(ctx, repoPath, opts)
+	metrics.TrackedStateFiles = len(stateSignals)
+	signals = append(signals, stateSignals...)
+
 	c.metrics = metrics
 
 	// Enrich signals with timestamps from git log.
@@ -188,6 +201,38 @@ func (c *GitHygieneCollector) Collect(ctx context.Context, repoPath string, opts
 	return signals, nil
 }
 
+// trackedStateSignals reports stringer's local state files (scan state,
+// history, blame index) that are tracked in git. They hold author identities
+// and what was found when, and belong in .stringer/.gitignore.
+func trackedStateSignals(ctx context.Context, repoPath string, opts signal.CollectorOpts) []signal.RawSignal {
+	const conf = 0.8
+	if conf < opts.MinConfidence {
+		return nil
+	}
+	out, err := gitcli.Exec(ctx, repoPath, "ls-files", "-z", "--", statedir.Name, "*/"+statedir.Name+"/*")
+	if err != nil {
+		return nil // not a git repository
+	}
+	var signals []signal.RawSignal
+	for _, relPath := range strings.Split(out, "\x00") {
+		if relPath == "" || !statedir.IsLocal(relPath) || !opts.Scope.Contains(relPath) {
+			continue
+		}
+		signals = append(signals, signal.RawSignal{
+			Source:   "githygiene",
+			Kind:     "tooling-hygiene",
+			FilePath: relPath,
+			Title:    fmt.Sprintf("Stringer state file tracked in git: %s", relPath),
+			Description: fmt.Sprintf("%s is local scan state and should not be committed: it records what was found when, "+
+				"and the blame index carries author names and emails. Run `git rm --cached %s`; "+
+				"stringer keeps these files listed in %s/.gitignore.", relPath, relPath, statedir.Name),
+			Confidence: conf,
+			Tags:       []string{"git-hygiene", "tooling-hygiene"},
+		})
+	}
+	return signals
+}
+
 // scanTextFileHygiene reads a text file and checks for merge conflict
 // markers, committed secrets, and mixed line endings in a single pass.
 func scanTextFileHygiene(path, relPath string, minConfidence float64, registry *secretRegistry, entropyEnabled bool) []signal.RawSignal {
@@ -252,6 +297,7 @@ func scanTextFileHygiene(path, relPath string, minConfidence float64, registry *
 		if matches := registry.Match(line); len(matches) > 0 {
 			// Use the first match (one secret signal per line).
 			m := matches[0]
+			redact.Register(m.Value)
 			if m.Confidence >= minConfidence {
 				signals = append(signals, signal.RawSignal{
 					Source:     "githygiene",
@@ -275,6 +321,7 @@ func scanTextFileHygiene(path, relPath string, minConfidence float64, registry *
 					}
 					val := lit[1]
 					if len(val) >= 16 && shannonEntropy(val) >= 4.0 {
+						redact.Register(val)
 						conf := 0.4
 						if conf >= minConfidence {
 							signals = append(signals, signal.RawSignal{
@@ -375,6 +422,11 @@ func humanSize(bytes int64) string {
 // Metrics returns structured metrics from the git hygiene scan.
 func (c *GitHygieneCollector) Metrics() any { return c.metrics }
 
+// FindsSecrets marks the collector as registering committed secrets with
+// the redact package.
+func (c *GitHygieneCollector) FindsSecrets() {}
+
 // Compile-time interface checks.
 var _ collector.Collector = (*GitHygieneCollector)(nil)
 var _ collector.MetricsProvider = (*GitHygieneCollector)(nil)
+var _ collector.SecretFinder = (*GitHygieneCollector)(nil)
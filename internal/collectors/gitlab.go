@@ -0,0 +1,425 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+//go:build !nonetwork
+
+package collectors
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/davetashner/stringer/internal/collector"
+	"github.com/davetashner/stringer/internal/signal"
+	"github.com/davetashner/stringer/internal/testable"
+)
+
+// reasonNoGitLabToken is the skip reason when GITLAB_TOKEN is unset.
+const reasonNoGitLabToken = "no token (set GITLAB_TOKEN)"
+
+func init() {
+	collector.Register(&GitLabCollector{})
+}
+
+// GitLabCollector imports open issues, merge requests, and actionable review
+// discussions from GitLab, on gitlab.com or a self-hosted instance. Its
+// signals mirror the github collector's, with gitlab- kinds.
+type GitLabCollector struct {
+	// api is the GitLab API client (nil means use real client).
+	api gitlabAPI
+
+	// GitOpener is the opener used to access the git repository.
+	// If nil, testable.DefaultGitOpener is used.
+	GitOpener testable.GitOpener
+}
+
+// Name returns the collector name used for registration and filtering.
+func (c *GitLabCollector) Name() string { return "gitlab" }
+
+// CheckCapabilities reports a skip reason when GITLAB_TOKEN is unset or the
+// repository has no GitLab remote.
+func (c *GitLabCollector) CheckCapabilities(_ context.Context, repoPath string, opts signal.CollectorOpts) string {
+	if os.Getenv("GITLAB_TOKEN") == "" {
+		return reasonNoGitLabToken
+	}
+	if _, err := c.remote(repoPath, opts); err != nil {
+		return "no GitLab remote"
+	}
+	return ""
+}
+
+// Collect fetches issues, merge requests, and review discussions from GitLab
+// and returns them as raw signals.
+func (c *GitLabCollector) Collect(ctx context.Context, repoPath string, opts signal.CollectorOpts) ([]signal.RawSignal, error) {
+	token := os.Getenv("GITLAB_TOKEN")
+	if token == "" {
+		slog.InfoContext(ctx, "GITLAB_TOKEN not set, skipping GitLab collector (set via: export GITLAB_TOKEN=<personal access token with read_api>)")
+		return nil, nil
+	}
+
+	remote, err := c.remote(repoPath, opts)
+	if err != nil {
+		slog.InfoContext(ctx, "cannot determine GitLab remote, skipping GitLab collector", "error", err)
+		return nil, nil
+	}
+
+	api := c.api
+	if api == nil {
+		api = newGitLabAPI(remote.apiURL(), token)
+	}
+
+	maxIssues := defaultMaxIssuesPerCollector
+	if opts.MaxIssues > 0 {
+		maxIssues = opts.MaxIssues
+	}
+	includeClosed := opts.IncludeClosed
+
+	var historyCutoff time.Time
+	if includeClosed && opts.HistoryDepth != "" {
+		if d, parseErr := ParseDuration(opts.HistoryDepth); parseErr == nil {
+			historyCutoff = time.Now().Add(-d)
+		} else {
+			slog.WarnContext(ctx, "invalid history-depth, ignoring", "value", opts.HistoryDepth, "error", parseErr)
+		}
+	}
+
+	var signals []signal.RawSignal
+
+	issueSigs, err := fetchGitLabIssues(ctx, api, remote.Project, maxIssues, includeClosed, historyCutoff)
+	if err != nil {
+		return nil, fmt.Errorf("fetching issues: %w", err)
+	}
+	signals = append(signals, issueSigs...)
+
+	mrSigs, err := fetchGitLabMergeRequests(ctx, api, remote.Project, maxIssues, defaultCommentDepth, includeClosed, historyCutoff)
+	if err != nil {
+		return nil, fmt.Errorf("fetching merge requests: %w", err)
+	}
+	signals = append(signals, mrSigs...)
+
+	// Sort by FilePath for deterministic output.
+	sort.Slice(signals, func(i, j int) bool {
+		return signals[i].FilePath < signals[j].FilePath
+	})
+
+	return signals, nil
+}
+
+// remote resolves the GitLab project from the origin remote, using GitRoot
+// when set so subdirectory scans find the repository's remote.
+func (c *GitLabCollector) remote(repoPath string, opts signal.CollectorOpts) (*gitlabRemote, error) {
+	opener := c.GitOpener
+	if opener == nil {
+		opener = testable.DefaultGitOpener
+	}
+	gitPath := repoPath
+	if opts.GitRoot != "" {
+		gitPath = opts.GitRoot
+	}
+	return parseGitLabRemoteWith(opener, gitPath)
+}
+
+// fetchGitLabIssues fetches issues, classifying them like fetchIssues does
+// for GitHub: by label while open, as gitlab-closed-issue once closed.
+func fetchGitLabIssues(ctx context.Context, api gitlabAPI, project string, maxIssues int, includeClosed bool, historyCutoff time.Time) ([]signal.RawSignal, error) {
+	var signals []signal.RawSignal
+	state := "opened"
+	if includeClosed {
+		state = "all"
+	}
+
+	for page := 1; page > 0; {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		issues, next, err := api.ListIssues(ctx, project, state, page)
+		if err != nil {
+			return nil, fmt.Errorf("listing issues: %w", err)
+		}
+
+		for _, issue := range issues {
+			closed := issue.State == "closed"
+			if closed && !historyCutoff.IsZero() && issue.ClosedAt != nil && issue.ClosedAt.Before(historyCutoff) {
+				continue
+			}
+
+			var kind string
+			var confidence float64
+			var tags []string
+			desc := truncateBody(issue.Description, 500)
+
+			if closed {
+				kind = "gitlab-closed-issue"
+				confidence = 0.3
+				tags = []string{kind, "pre-closed"}
+				closedAt := ""
+				if issue.ClosedAt != nil {
+					closedAt = issue.ClosedAt.Format(time.RFC3339)
+				}
+				desc = fmt.Sprintf("Closed at: %s\n%s", closedAt, desc)
+			} else {
+				kind, confidence = classifyGitLabIssue(issue)
+				tags = []string{kind}
+				if !issue.UpdatedAt.IsZero() && time.Since(issue.UpdatedAt) > defaultStaleThreshold {
+					kind = "gitlab-stale-issue"
+					confidence = 0.2
+					tags = []string{kind}
+				}
+			}
+
+			sig := signal.RawSignal{
+				Source:      "gitlab",
+				Kind:        kind,
+				FilePath:    fmt.Sprintf("gitlab/issues/%d", issue.IID),
+				Title:       issue.Title,
+				Description: desc,
+				Author:      issue.Author.Username,
+				Timestamp:   issue.CreatedAt,
+				Confidence:  confidence,
+				Tags:        tags,
+				URL:         issue.WebURL,
+			}
+			if closed && issue.ClosedAt != nil {
+				sig.ClosedAt = *issue.ClosedAt
+			}
+			signals = append(signals, sig)
+
+			if len(signals) >= maxIssues {
+				return signals, nil
+			}
+		}
+		page = next
+	}
+
+	return signals, nil
+}
+
+// fetchGitLabMergeRequests fetches merge requests with their approval state
+// and actionable review discussions. Merged and closed merge requests are
+// included when includeClosed is set, as pre-closed signals.
+func fetchGitLabMergeRequests(ctx context.Context, api gitlabAPI, project string, maxIssues, commentDepth int, includeClosed bool, historyCutoff time.Time) ([]signal.RawSignal, error) {
+	var signals []signal.RawSignal
+	state := "opened"
+	if includeClosed {
+		state = "all"
+	}
+
+	for page := 1; page > 0; {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		mrs, next, err := api.ListMergeRequests(ctx, project, state, page)
+		if err != nil {
+			return nil, fmt.Errorf("listing merge requests: %w", err)
+		}
+
+		for _, mr := range mrs {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+			if mr.State == "locked" {
+				continue
+			}
+
+			closedAt := mr.ClosedAt
+			if mr.State == "merged" {
+				closedAt = mr.MergedAt
+			}
+			if mr.State != "opened" && !historyCutoff.IsZero() && closedAt != nil && closedAt.Before(historyCutoff) {
+				continue
+			}
+
+			var kind string
+			var confidence float64
+			var tags []string
+			desc := truncateBody(mr.Description, 500)
+
+			if mr.State == "opened" {
+				discussions, discErr := fetchAllDiscussions(ctx, api, project, mr.IID)
+				if discErr != nil {
+					return nil, fmt.Errorf("listing discussions for MR !%d: %w", mr.IID, discErr)
+				}
+				approvals, apprErr := api.GetApprovals(ctx, project, mr.IID)
+				if apprErr != nil {
+					return nil, fmt.Errorf("getting approvals for MR !%d: %w", mr.IID, apprErr)
+				}
+				kind, confidence = classifyMergeRequest(mr, approvals, discussions)
+				tags = []string{kind}
+				signals = append(signals, actionableNotes(mr, discussions, commentDepth)...)
+			} else {
+				if mr.State == "merged" {
+					kind = "gitlab-merged-mr"
+					confidence = 0.3
+				} else {
+					kind = "gitlab-closed-mr"
+					confidence = 0.2
+				}
+				tags = []string{kind, "pre-closed"}
+				desc = fmt.Sprintf("Merged at: %s, Closed at: %s\n%s", formatOptionalTime(mr.MergedAt), formatOptionalTime(mr.ClosedAt), desc)
+
+				// Enrich with module context from changed files.
+				if diffs, diffErr := api.ListMergeRequestDiffs(ctx, project, mr.IID); diffErr == nil {
+					paths := make([]string, len(diffs))
+					for i, d := range diffs {
+						paths[i] = d.NewPath
+					}
+					if moduleCtx := moduleContext(paths); moduleCtx != "" {
+						desc = moduleCtx + "\n" + desc
+					}
+				}
+			}
+
+			sig := signal.RawSignal{
+				Source:      "gitlab",
+				Kind:        kind,
+				FilePath:    fmt.Sprintf("gitlab/mrs/%d", mr.IID),
+				Title:       mr.Title,
+				Description: desc,
+				Author:      mr.Author.Username,
+				Timestamp:   mr.CreatedAt,
+				Confidence:  confidence,
+				Tags:        tags,
+				URL:         mr.WebURL,
+			}
+			if mr.State != "opened" && closedAt != nil {
+				sig.ClosedAt = *closedAt
+			}
+			signals = append(signals, sig)
+
+			if len(signals) >= maxIssues {
+				return signals, nil
+			}
+		}
+		page = next
+	}
+
+	return signals, nil
+}
+
+// fetchAllDiscussions fetches every discussion on a merge request.
+func fetchAllDiscussions(ctx context.Context, api gitlabAPI, project string, iid int) ([]gitlabDiscussion, error) {
+	var all []gitlabDiscussion
+	for page := 1; page > 0; {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		discussions, next, err := api.ListDiscussions(ctx, project, iid, page)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, discussions...)
+		page = next
+	}
+	return all, nil
+}
+
+// actionableNotes returns a gitlab-review-todo signal for each of the first
+// commentDepth review notes that uses actionable language. System notes
+// ("added 1 commit") and resolved threads are skipped.
+func actionableNotes(mr gitlabMergeRequest, discussions []gitlabDiscussion, commentDepth int) []signal.RawSignal {
+	var signals []signal.RawSignal
+	seen := 0
+	for _, d := range discussions {
+		for _, note := range d.Notes {
+			if note.System {
+				continue
+			}
+			if seen >= commentDepth {
+				return signals
+			}
+			seen++
+			if note.Resolved || !isActionableComment(note.Body) {
+				continue
+			}
+
+			filePath, line := fmt.Sprintf("gitlab/mrs/%d", mr.IID), 0
+			if note.Position != nil && note.Position.NewPath != "" {
+				filePath, line = note.Position.NewPath, note.Position.NewLine
+			}
+			confidence := math.Min(0.6+ageBoost(note.CreatedAt, 30, 0.1), 1.0)
+			url := ""
+			if mr.WebURL != "" {
+				url = fmt.Sprintf("%s#note_%d", mr.WebURL, note.ID)
+			}
+
+			signals = append(signals, signal.RawSignal{
+				Source:      "gitlab",
+				Kind:        "gitlab-review-todo",
+				FilePath:    filePath,
+				Line:        line,
+				Title:       fmt.Sprintf("Review comment on MR !%d: %s", mr.IID, truncateBody(note.Body, 100)),
+				Description: note.Body,
+				Author:      note.Author.Username,
+				Timestamp:   note.CreatedAt,
+				Confidence:  confidence,
+				Tags:        []string{"gitlab-review-todo"},
+				URL:         url,
+			})
+		}
+	}
+	return signals
+}
+
+// classifyGitLabIssue determines the signal kind and confidence for an open
+// issue from its labels, as classifyIssue does for GitHub. GitLab scoped
+// labels such as "type::bug" count by their value.
+func classifyGitLabIssue(issue gitlabIssue) (kind string, confidence float64) {
+	has := func(names ...string) bool {
+		for _, label := range issue.Labels {
+			name := strings.ToLower(label)
+			if i := strings.LastIndex(name, "::"); i >= 0 {
+				name = name[i+2:]
+			}
+			for _, n := range names {
+				if name == n {
+					return true
+				}
+			}
+		}
+		return false
+	}
+	switch {
+	case has("bug"):
+		return "gitlab-bug", math.Min(0.7+ageBoost(issue.CreatedAt, 90, 0.1), 1.0)
+	case has("enhancement", "feature"):
+		return "gitlab-feature", math.Min(0.5+ageBoost(issue.CreatedAt, 90, 0.1), 1.0)
+	}
+	return "gitlab-issue", math.Min(0.4+ageBoost(issue.CreatedAt, 90, 0.1), 1.0)
+}
+
+// classifyMergeRequest determines the signal kind and confidence for an open
+// merge request. GitLab has no "changes requested" review state on every
+// tier, so an unresolved review thread stands in for it.
+func classifyMergeRequest(mr gitlabMergeRequest, approvals *gitlabApprovals, discussions []gitlabDiscussion) (kind string, confidence float64) {
+	for _, d := range discussions {
+		for _, note := range d.Notes {
+			if note.Resolvable && !note.Resolved {
+				return "gitlab-mr-changes", math.Min(0.7+ageBoost(mr.CreatedAt, 30, 0.1), 1.0)
+			}
+		}
+	}
+	if approvals != nil && len(approvals.ApprovedBy) > 0 {
+		return "gitlab-mr-approved", math.Min(0.6+ageBoost(mr.CreatedAt, 14, 0.1), 1.0)
+	}
+	return "gitlab-mr-pending", math.Min(0.5+ageBoost(mr.CreatedAt, 14, 0.05), 1.0)
+}
+
+// formatOptionalTime formats t as RFC 3339, or "" when it is nil.
+func formatOptionalTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
+// Compile-time interface checks.
+var _ collector.Collector = (*GitLabCollector)(nil)
+var _ collector.CapabilityChecker = (*GitLabCollector)(nil)
@@ -0,0 +1,309 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+//go:build !nonetwork
+
+package collectors
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+// mockGitLabAPI serves canned issues and merge requests, one page each
+// unless pages are given.
+type mockGitLabAPI struct {
+	issues      [][]gitlabIssue
+	mrs         []gitlabMergeRequest
+	approvals   map[int]*gitlabApprovals
+	discussions map[int][]gitlabDiscussion
+	diffs       map[int][]gitlabDiff
+
+	issueErr       error
+	states         []string
+	issueCalls     int
+	discussions404 bool
+}
+
+func (m *mockGitLabAPI) ListIssues(_ context.Context, _, state string, page int) ([]gitlabIssue, int, error) {
+	m.states = append(m.states, state)
+	m.issueCalls++
+	if m.issueErr != nil {
+		return nil, 0, m.issueErr
+	}
+	if page > len(m.issues) {
+		return nil, 0, nil
+	}
+	next := page + 1
+	if next > len(m.issues) {
+		next = 0
+	}
+	return m.issues[page-1], next, nil
+}
+
+func (m *mockGitLabAPI) ListMergeRequests(_ context.Context, _, state string, _ int) ([]gitlabMergeRequest, int, error) {
+	m.states = append(m.states, state)
+	return m.mrs, 0, nil
+}
+
+func (m *mockGitLabAPI) GetApprovals(_ context.Context, _ string, iid int) (*gitlabApprovals, error) {
+	if a, ok := m.approvals[iid]; ok {
+		return a, nil
+	}
+	return &gitlabApprovals{}, nil
+}
+
+func (m *mockGitLabAPI) ListDiscussions(_ context.Context, _ string, iid, _ int) ([]gitlabDiscussion, int, error) {
+	if m.discussions404 {
+		return nil, 0, fmt.Errorf("gitlab api returned 404")
+	}
+	return m.discussions[iid], 0, nil
+}
+
+func (m *mockGitLabAPI) ListMergeRequestDiffs(_ context.Context, _ string, iid int) ([]gitlabDiff, error) {
+	return m.diffs[iid], nil
+}
+
+func approvedBy(users ...string) *gitlabApprovals {
+	a := &gitlabApprovals{}
+	for _, u := range users {
+		a.ApprovedBy = append(a.ApprovedBy, struct {
+			User gitlabUser `json:"user"`
+		}{User: gitlabUser{Username: u}})
+	}
+	return a
+}
+
+func findKind(signals []signal.RawSignal, path string) string {
+	for _, s := range signals {
+		if s.FilePath == path {
+			return s.Kind
+		}
+	}
+	return ""
+}
+
+func TestGitLabCollector_Name(t *testing.T) {
+	assert.Equal(t, "gitlab", (&GitLabCollector{}).Name())
+}
+
+func TestGitLabCollector_CheckCapabilities(t *testing.T) {
+	t.Setenv("GITLAB_HOST", "")
+	c := &GitLabCollector{}
+
+	t.Setenv("GITLAB_TOKEN", "")
+	assert.Equal(t, reasonNoGitLabToken, c.CheckCapabilities(context.Background(), t.TempDir(), signal.CollectorOpts{}))
+
+	t.Setenv("GITLAB_TOKEN", "test-token")
+	github := initGitHubTestRepo(t, "https://github.com/owner/repo.git")
+	assert.Equal(t, "no GitLab remote", c.CheckCapabilities(context.Background(), github, signal.CollectorOpts{}))
+
+	gitlab := initGitHubTestRepo(t, "git@gitlab.com:group/repo.git")
+	assert.Empty(t, c.CheckCapabilities(context.Background(), t.TempDir(), signal.CollectorOpts{GitRoot: gitlab}))
+}
+
+func TestGitLabCollector_SkipsWithoutTokenOrRemote(t *testing.T) {
+	t.Setenv("GITLAB_HOST", "")
+	mock := &mockGitLabAPI{}
+	c := &GitLabCollector{api: mock}
+
+	t.Setenv("GITLAB_TOKEN", "")
+	signals, err := c.Collect(context.Background(), initGitHubTestRepo(t, "git@gitlab.com:group/repo.git"), signal.CollectorOpts{})
+	require.NoError(t, err)
+	assert.Empty(t, signals)
+
+	t.Setenv("GITLAB_TOKEN", "test-token")
+	signals, err = c.Collect(context.Background(), initGitHubTestRepo(t, "https://github.com/owner/repo.git"), signal.CollectorOpts{})
+	require.NoError(t, err)
+	assert.Empty(t, signals)
+	assert.Zero(t, mock.issueCalls, "no API calls without a GitLab remote")
+}
+
+func TestGitLabCollector_Collect(t *testing.T) {
+	t.Setenv("GITLAB_TOKEN", "test-token")
+	t.Setenv("GITLAB_HOST", "")
+	now := time.Now()
+	old := now.Add(-120 * 24 * time.Hour)
+
+	mock := &mockGitLabAPI{
+		issues: [][]gitlabIssue{
+			{
+				{IID: 1, Title: "Crash on start", State: "opened", Labels: []string{"type::bug"}, CreatedAt: old, UpdatedAt: now, WebURL: "https://gitlab.com/g/r/-/issues/1"},
+				{IID: 2, Title: "Dark mode", State: "opened", Labels: []string{"Feature"}, CreatedAt: now, UpdatedAt: now},
+			},
+			{
+				{IID: 3, Title: "Question", State: "opened", CreatedAt: now, UpdatedAt: now},
+				{IID: 4, Title: "Forgotten", State: "opened", CreatedAt: old, UpdatedAt: now.Add(-200 * 24 * time.Hour)},
+			},
+		},
+		mrs: []gitlabMergeRequest{
+			{IID: 10, Title: "Needs work", State: "opened", CreatedAt: now, WebURL: "https://gitlab.com/g/r/-/merge_requests/10"},
+			{IID: 11, Title: "Ready", State: "opened", CreatedAt: now},
+			{IID: 12, Title: "Waiting", State: "opened", CreatedAt: now},
+		},
+		approvals: map[int]*gitlabApprovals{10: approvedBy("alice"), 11: approvedBy("bob")},
+		discussions: map[int][]gitlabDiscussion{
+			10: {
+				{Notes: []gitlabNote{{ID: 501, Body: "This should handle nil", Resolvable: true, CreatedAt: now, Author: gitlabUser{Username: "carol"},
+					Position: &gitlabPosition{NewPath: "internal/db/db.go", NewLine: 42}}}},
+				{Notes: []gitlabNote{{ID: 502, Body: "added 1 commit", System: true}}},
+				{Notes: []gitlabNote{{ID: 503, Body: "Looks good", CreatedAt: now}}},
+			},
+			11: {
+				{Notes: []gitlabNote{{ID: 601, Body: "must fix the typo", Resolvable: true, Resolved: true, CreatedAt: now}}},
+			},
+		},
+	}
+	c := &GitLabCollector{api: mock}
+	dir := initGitHubTestRepo(t, "https://gitlab.com/g/r.git")
+	signals, err := c.Collect(context.Background(), dir, signal.CollectorOpts{})
+	require.NoError(t, err)
+
+	assert.Equal(t, "gitlab-bug", findKind(signals, "gitlab/issues/1"))
+	assert.Equal(t, "gitlab-feature", findKind(signals, "gitlab/issues/2"))
+	assert.Equal(t, "gitlab-issue", findKind(signals, "gitlab/issues/3"))
+	assert.Equal(t, "gitlab-stale-issue", findKind(signals, "gitlab/issues/4"))
+	assert.Equal(t, "gitlab-mr-changes", findKind(signals, "gitlab/mrs/10"), "an unresolved thread outweighs approval")
+	assert.Equal(t, "gitlab-mr-approved", findKind(signals, "gitlab/mrs/11"), "resolved threads do not block")
+	assert.Equal(t, "gitlab-mr-pending", findKind(signals, "gitlab/mrs/12"))
+	assert.Equal(t, []string{"opened", "opened", "opened"}, mock.states)
+
+	var todo *signal.RawSignal
+	for i := range signals {
+		if signals[i].Kind == "gitlab-review-todo" {
+			require.Nil(t, todo, "only the unresolved actionable note is a review todo")
+			todo = &signals[i]
+		}
+	}
+	require.NotNil(t, todo)
+	assert.Equal(t, "internal/db/db.go", todo.FilePath)
+	assert.Equal(t, 42, todo.Line)
+	assert.Equal(t, "Review comment on MR !10: This should handle nil", todo.Title)
+	assert.Equal(t, "carol", todo.Author)
+	assert.Equal(t, "https://gitlab.com/g/r/-/merge_requests/10#note_501", todo.URL)
+
+	for _, s := range signals {
+		assert.Equal(t, "gitlab", s.Source)
+	}
+	assert.IsNonDecreasing(t, []string{signals[0].FilePath, signals[1].FilePath, signals[len(signals)-1].FilePath})
+}
+
+func TestGitLabCollector_IncludeClosed(t *testing.T) {
+	t.Setenv("GITLAB_TOKEN", "test-token")
+	t.Setenv("GITLAB_HOST", "")
+	now := time.Now()
+	longAgo := now.Add(-400 * 24 * time.Hour)
+	recent := now.Add(-2 * 24 * time.Hour)
+
+	mock := &mockGitLabAPI{
+		issues: [][]gitlabIssue{{
+			{IID: 1, Title: "Fixed", State: "closed", CreatedAt: longAgo, ClosedAt: &recent},
+			{IID: 2, Title: "Ancient", State: "closed", CreatedAt: longAgo, ClosedAt: &longAgo},
+		}},
+		mrs: []gitlabMergeRequest{
+			{IID: 20, Title: "Landed", State: "merged", CreatedAt: longAgo, MergedAt: &recent, ClosedAt: nil},
+			{IID: 21, Title: "Abandoned", State: "closed", CreatedAt: longAgo, ClosedAt: &recent},
+			{IID: 22, Title: "Locked", State: "locked", CreatedAt: longAgo},
+		},
+		diffs: map[int][]gitlabDiff{20: {{NewPath: "internal/db/db.go"}, {NewPath: "internal/db/pool.go"}, {NewPath: "README.md"}}},
+	}
+	c := &GitLabCollector{api: mock}
+	dir := initGitHubTestRepo(t, "https://gitlab.com/g/r.git")
+	signals, err := c.Collect(context.Background(), dir, signal.CollectorOpts{IncludeClosed: true, HistoryDepth: "90d"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"all", "all"}, mock.states)
+	require.Len(t, signals, 3, "closed before the history depth and locked MRs are skipped")
+
+	byPath := make(map[string]signal.RawSignal)
+	for _, s := range signals {
+		byPath[s.FilePath] = s
+		assert.Contains(t, s.Tags, "pre-closed")
+		assert.False(t, s.ClosedAt.IsZero())
+	}
+	assert.Equal(t, "gitlab-closed-issue", byPath["gitlab/issues/1"].Kind)
+	assert.Equal(t, "gitlab-merged-mr", byPath["gitlab/mrs/20"].Kind)
+	assert.Contains(t, byPath["gitlab/mrs/20"].Description, "Modules affected: . (1 file), internal/db (2 files)")
+	assert.Equal(t, "gitlab-closed-mr", byPath["gitlab/mrs/21"].Kind)
+}
+
+func TestGitLabCollector_MaxIssues(t *testing.T) {
+	var issues []gitlabIssue
+	for i := range 10 {
+		issues = append(issues, gitlabIssue{IID: i + 1, State: "opened", CreatedAt: time.Now(), UpdatedAt: time.Now()})
+	}
+	mock := &mockGitLabAPI{issues: [][]gitlabIssue{issues, issues}}
+	signals, err := fetchGitLabIssues(context.Background(), mock, "g/r", 3, false, time.Time{})
+	require.NoError(t, err)
+	assert.Len(t, signals, 3)
+	assert.Equal(t, 1, mock.issueCalls)
+}
+
+func TestGitLabCollector_Errors(t *testing.T) {
+	t.Setenv("GITLAB_TOKEN", "test-token")
+	t.Setenv("GITLAB_HOST", "")
+	dir := initGitHubTestRepo(t, "https://gitlab.com/g/r.git")
+
+	c := &GitLabCollector{api: &mockGitLabAPI{issueErr: fmt.Errorf("401 Unauthorized")}}
+	_, err := c.Collect(context.Background(), dir, signal.CollectorOpts{})
+	assert.ErrorContains(t, err, "fetching issues: listing issues: 401 Unauthorized")
+
+	c = &GitLabCollector{api: &mockGitLabAPI{
+		mrs:            []gitlabMergeRequest{{IID: 7, State: "opened"}},
+		discussions404: true,
+	}}
+	_, err = c.Collect(context.Background(), dir, signal.CollectorOpts{})
+	assert.ErrorContains(t, err, "listing discussions for MR !7")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = fetchGitLabIssues(ctx, &mockGitLabAPI{}, "g/r", 10, false, time.Time{})
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestRealGitLabAPI(t *testing.T) {
+	var gotPaths, gotTokens []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPaths = append(gotPaths, r.URL.EscapedPath()+"?"+r.URL.RawQuery)
+		gotTokens = append(gotTokens, r.Header.Get("PRIVATE-TOKEN"))
+		switch r.URL.EscapedPath() {
+		case "/api/v4/projects/group%2Fsub%2Frepo/issues":
+			w.Header().Set("X-Next-Page", "2")
+			_, _ = w.Write([]byte(`[{"iid":5,"title":"Bug","state":"opened","labels":["bug"],"author":{"username":"dana"},"created_at":"2026-01-02T03:04:05Z","updated_at":"2026-01-03T00:00:00Z","web_url":"https://x/5"}]`))
+		case "/api/v4/projects/group%2Fsub%2Frepo/merge_requests/9/approvals":
+			_, _ = w.Write([]byte(`{"approved_by":[{"user":{"username":"erin"}}]}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	api := newGitLabAPI(srv.URL+"/api/v4", "secret")
+	issues, next, err := api.ListIssues(context.Background(), "group/sub/repo", "opened", 1)
+	require.NoError(t, err)
+	assert.Equal(t, 2, next)
+	require.Len(t, issues, 1)
+	assert.Equal(t, 5, issues[0].IID)
+	assert.Equal(t, "dana", issues[0].Author.Username)
+	assert.Equal(t, time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC), issues[0].CreatedAt.UTC())
+	assert.Contains(t, gotPaths[0], "state=opened")
+	assert.Contains(t, gotPaths[0], "order_by=updated_at")
+
+	approvals, err := api.GetApprovals(context.Background(), "group/sub/repo", 9)
+	require.NoError(t, err)
+	require.Len(t, approvals.ApprovedBy, 1)
+	assert.Equal(t, "erin", approvals.ApprovedBy[0].User.Username)
+
+	_, _, err = api.ListMergeRequests(context.Background(), "group/sub/repo", "opened", 1)
+	assert.ErrorContains(t, err, "returned 404")
+
+	assert.Equal(t, []string{"secret", "secret", "secret"}, gotTokens)
+}
@@ -0,0 +1,207 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+//go:build !nonetwork
+
+package collectors
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/davetashner/stringer/internal/tracing"
+)
+
+const (
+	gitlabRequestTimeout   = 30 * time.Second
+	gitlabMaxResponseBytes = 10 * 1024 * 1024 // 10 MiB
+	gitlabPerPage          = 100
+)
+
+// gitlabAPI abstracts the GitLab REST API (v4) for testing. List methods
+// take a 1-based page and return the next page, or 0 after the last one.
+type gitlabAPI interface {
+	ListIssues(ctx context.Context, project, state string, page int) ([]gitlabIssue, int, error)
+	ListMergeRequests(ctx context.Context, project, state string, page int) ([]gitlabMergeRequest, int, error)
+	GetApprovals(ctx context.Context, project string, iid int) (*gitlabApprovals, error)
+	ListDiscussions(ctx context.Context, project string, iid, page int) ([]gitlabDiscussion, int, error)
+	ListMergeRequestDiffs(ctx context.Context, project string, iid int) ([]gitlabDiff, error)
+}
+
+// gitlabUser is the author of an issue, merge request, or note.
+type gitlabUser struct {
+	Username string `json:"username"`
+}
+
+// gitlabIssue is an issue as returned by GET /projects/:id/issues.
+type gitlabIssue struct {
+	IID         int        `json:"iid"`
+	Title       string     `json:"title"`
+	Description string     `json:"description"`
+	State       string     `json:"state"` // "opened" or "closed"
+	Labels      []string   `json:"labels"`
+	Author      gitlabUser `json:"author"`
+	WebURL      string     `json:"web_url"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+	ClosedAt    *time.Time `json:"closed_at"`
+}
+
+// gitlabMergeRequest is a merge request as returned by GET
+// /projects/:id/merge_requests.
+type gitlabMergeRequest struct {
+	IID         int        `json:"iid"`
+	Title       string     `json:"title"`
+	Description string     `json:"description"`
+	State       string     `json:"state"` // "opened", "closed", "merged", or "locked"
+	Author      gitlabUser `json:"author"`
+	WebURL      string     `json:"web_url"`
+	CreatedAt   time.Time  `json:"created_at"`
+	MergedAt    *time.Time `json:"merged_at"`
+	ClosedAt    *time.Time `json:"closed_at"`
+}
+
+// gitlabApprovals is the approval state of a merge request.
+type gitlabApprovals struct {
+	ApprovedBy []struct {
+		User gitlabUser `json:"user"`
+	} `json:"approved_by"`
+}
+
+// gitlabDiscussion is a review thread on a merge request.
+type gitlabDiscussion struct {
+	ID    string       `json:"id"`
+	Notes []gitlabNote `json:"notes"`
+}
+
+// gitlabNote is one comment in a discussion. Position is set for comments
+// on the diff.
+type gitlabNote struct {
+	ID         int             `json:"id"`
+	Body       string          `json:"body"`
+	Author     gitlabUser      `json:"author"`
+	CreatedAt  time.Time       `json:"created_at"`
+	System     bool            `json:"system"`
+	Resolvable bool            `json:"resolvable"`
+	Resolved   bool            `json:"resolved"`
+	Position   *gitlabPosition `json:"position"`
+}
+
+// gitlabPosition locates a diff comment in the new version of a file.
+type gitlabPosition struct {
+	NewPath string `json:"new_path"`
+	NewLine int    `json:"new_line"`
+}
+
+// gitlabDiff is one changed file of a merge request.
+type gitlabDiff struct {
+	NewPath string `json:"new_path"`
+}
+
+// realGitLabAPI implements gitlabAPI over HTTP with a personal, group, or
+// project access token.
+type realGitLabAPI struct {
+	httpClient *http.Client
+	baseURL    string // API root, e.g. https://gitlab.com/api/v4
+	token      string
+}
+
+// Compile-time check that realGitLabAPI implements gitlabAPI.
+var _ gitlabAPI = (*realGitLabAPI)(nil)
+
+func newGitLabAPI(baseURL, token string) *realGitLabAPI {
+	return &realGitLabAPI{
+		httpClient: &http.Client{Timeout: gitlabRequestTimeout, Transport: tracing.Transport(nil)},
+		baseURL:    baseURL,
+		token:      token,
+	}
+}
+
+func (r *realGitLabAPI) ListIssues(ctx context.Context, project, state string, page int) ([]gitlabIssue, int, error) {
+	var issues []gitlabIssue
+	next, err := r.get(ctx, r.projectPath(project, "issues"), listQuery(state, page), &issues)
+	return issues, next, err
+}
+
+func (r *realGitLabAPI) ListMergeRequests(ctx context.Context, project, state string, page int) ([]gitlabMergeRequest, int, error) {
+	var mrs []gitlabMergeRequest
+	next, err := r.get(ctx, r.projectPath(project, "merge_requests"), listQuery(state, page), &mrs)
+	return mrs, next, err
+}
+
+func (r *realGitLabAPI) GetApprovals(ctx context.Context, project string, iid int) (*gitlabApprovals, error) {
+	var approvals gitlabApprovals
+	if _, err := r.get(ctx, r.projectPath(project, fmt.Sprintf("merge_requests/%d/approvals", iid)), nil, &approvals); err != nil {
+		return nil, err
+	}
+	return &approvals, nil
+}
+
+func (r *realGitLabAPI) ListDiscussions(ctx context.Context, project string, iid, page int) ([]gitlabDiscussion, int, error) {
+	var discussions []gitlabDiscussion
+	q := url.Values{"per_page": {strconv.Itoa(gitlabPerPage)}, "page": {strconv.Itoa(page)}}
+	next, err := r.get(ctx, r.projectPath(project, fmt.Sprintf("merge_requests/%d/discussions", iid)), q, &discussions)
+	return discussions, next, err
+}
+
+func (r *realGitLabAPI) ListMergeRequestDiffs(ctx context.Context, project string, iid int) ([]gitlabDiff, error) {
+	var diffs []gitlabDiff
+	q := url.Values{"per_page": {strconv.Itoa(gitlabPerPage)}}
+	_, err := r.get(ctx, r.projectPath(project, fmt.Sprintf("merge_requests/%d/diffs", iid)), q, &diffs)
+	return diffs, err
+}
+
+// projectPath returns the API path of a project resource. The project's
+// full path is its ID once URL-encoded, slashes included.
+func (r *realGitLabAPI) projectPath(project, resource string) string {
+	return "/projects/" + url.PathEscape(project) + "/" + resource
+}
+
+// listQuery returns the query for a page of issues or merge requests,
+// most recently updated first.
+func listQuery(state string, page int) url.Values {
+	return url.Values{
+		"state":    {state},
+		"order_by": {"updated_at"},
+		"sort":     {"desc"},
+		"per_page": {strconv.Itoa(gitlabPerPage)},
+		"page":     {strconv.Itoa(page)},
+	}
+}
+
+// get fetches path with query, decodes the JSON response into out, and
+// returns the next page from the X-Next-Page header.
+func (r *realGitLabAPI) get(ctx context.Context, path string, query url.Values, out any) (int, error) {
+	u := r.baseURL + path
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return 0, fmt.Errorf("creating request: %w", err)
+	}
+	req.Header.Set("PRIVATE-TOKEN", r.token)
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := r.httpClient.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("request to %s: %w", path, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return 0, fmt.Errorf("gitlab api %s returned %d", path, resp.StatusCode)
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, gitlabMaxResponseBytes)).Decode(out); err != nil {
+		return 0, fmt.Errorf("decoding %s: %w", path, err)
+	}
+	next, _ := strconv.Atoi(resp.Header.Get("X-Next-Page"))
+	return next, nil
+}
@@ -0,0 +1,112 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+//go:build !nonetwork
+
+package collectors
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/davetashner/stringer/internal/testable"
+)
+
+// gitlabSCPPattern matches scp-style SSH remotes: git@host:group/repo.git.
+var gitlabSCPPattern = regexp.MustCompile(`^(?:[^@/]+@)?([^:/]+):(.+)$`)
+
+// gitlabRemote identifies a project on a GitLab instance.
+type gitlabRemote struct {
+	// Base is the instance's web URL, e.g. https://gitlab.com or
+	// https://git.example.com/gitlab for an instance under a path.
+	Base string
+
+	// Project is the project's full path, including any subgroups.
+	Project string
+}
+
+// apiURL returns the REST API root of the instance.
+func (r *gitlabRemote) apiURL() string {
+	return r.Base + "/api/v4"
+}
+
+// parseGitLabRemoteWith returns the GitLab project the origin remote of the
+// repository at repoPath points at.
+func parseGitLabRemoteWith(opener testable.GitOpener, repoPath string) (*gitlabRemote, error) {
+	rawURL, err := originURL(opener, repoPath)
+	if err != nil {
+		return nil, err
+	}
+	return parseGitLabURL(rawURL, os.Getenv("GITLAB_HOST"))
+}
+
+// parseGitLabURL parses a GitLab remote URL (HTTPS or SSH) into the
+// instance and project path. Since the API is called with GITLAB_TOKEN,
+// only gitlab.com and customHost are recognized, and always over HTTPS:
+// the instance URL comes from them, never from the remote. customHost is a
+// host name or, for an instance served under a path, an https URL such as
+// https://git.example.com/gitlab.
+func parseGitLabURL(rawURL, customHost string) (*gitlabRemote, error) {
+	rawURL = strings.TrimSpace(rawURL)
+	var host, path string
+	if u, err := url.Parse(rawURL); err == nil && u.Scheme != "" && u.Host != "" {
+		switch u.Scheme {
+		case "https", "http", "ssh", "git":
+		default:
+			return nil, fmt.Errorf("remote %q is not a GitLab URL", rawURL)
+		}
+		host, path = u.Hostname(), u.Path
+	} else if m := gitlabSCPPattern.FindStringSubmatch(rawURL); m != nil {
+		host, path = m[1], m[2]
+	} else {
+		return nil, fmt.Errorf("parsing URL %q: unrecognized remote", rawURL)
+	}
+	host = strings.ToLower(host)
+
+	var base string
+	switch custom := parseCustomGitLabHost(customHost); {
+	case custom != nil && strings.EqualFold(custom.Hostname(), host):
+		// The configured instance decides any port and path prefix; SSH
+		// remotes do not carry either.
+		base = "https://" + custom.Host + custom.Path
+		path = strings.TrimPrefix(strings.TrimPrefix(path, "/"), strings.TrimPrefix(custom.Path, "/"))
+	case host == "gitlab.com":
+		base = "https://gitlab.com"
+	default:
+		return nil, fmt.Errorf("remote %q is not a GitLab URL (set GITLAB_HOST for a self-hosted instance)", rawURL)
+	}
+
+	path = strings.TrimSuffix(strings.Trim(path, "/"), ".git")
+	parts := strings.Split(path, "/")
+	if len(parts) < 2 {
+		return nil, fmt.Errorf("cannot parse project path from %q", rawURL)
+	}
+	for _, p := range parts {
+		if p == "" {
+			return nil, fmt.Errorf("cannot parse project path from %q", rawURL)
+		}
+	}
+	return &gitlabRemote{Base: base, Project: path}, nil
+}
+
+// parseCustomGitLabHost parses the GITLAB_HOST value, or returns nil when it
+// is empty or invalid. A bare host name means HTTPS at the root; any other
+// scheme than https is invalid, so the token is never sent in the clear.
+func parseCustomGitLabHost(value string) *url.URL {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil
+	}
+	if !strings.Contains(value, "://") {
+		value = "https://" + value
+	}
+	u, err := url.Parse(value)
+	if err != nil || u.Host == "" || u.Scheme != "https" {
+		return nil
+	}
+	u.Path = strings.TrimSuffix(u.Path, "/")
+	return u
+}
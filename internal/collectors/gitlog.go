@@ -7,6 +7,7 @@ package collectors
 import (
 	"context"
 	"fmt"
+	"log/slog"
 	"math"
 	"regexp"
 	"sort"
@@ -20,6 +21,7 @@ import (
 	"github.com/go-git/go-git/v5/plumbing/object"
 
 	"github.com/davetashner/stringer/internal/collector"
+	"github.com/davetashner/stringer/internal/identity"
 	"github.com/davetashner/stringer/internal/signal"
 	"github.com/davetashner/stringer/internal/testable"
 )
@@ -60,9 +62,10 @@ func init() {
 
 // GitlogMetrics holds structured metrics from the git log analysis.
 type GitlogMetrics struct {
-	FileChurns       []FileChurn
-	RevertCount      int
-	StaleBranchCount int
+	FileChurns        []FileChurn
+	RevertCount       int
+	StaleBranchCount  int
+	ChurnQualityCount int
 }
 
 // FileChurn describes change frequency for a single file.
@@ -72,8 +75,8 @@ type FileChurn struct {
 	AuthorCount int
 }
 
-// GitlogCollector examines git history for reverts, high-churn files, and
-// stale branches.
+// GitlogCollector examines git history for reverts, high-churn files,
+// files with chaotic history, and stale branches.
 type GitlogCollector struct {
 	metrics *GitlogMetrics
 
@@ -85,6 +88,16 @@ type GitlogCollector struct {
 // Name returns the collector name used for registration and filtering.
 func (c *GitlogCollector) Name() string { return "gitlog" }
 
+// CheckCapabilities reports a skip reason when there is no git history to
+// mine.
+func (c *GitlogCollector) CheckCapabilities(_ context.Context, repoPath string, opts signal.CollectorOpts) string {
+	gitRoot := repoPath
+	if opts.GitRoot != "" {
+		gitRoot = opts.GitRoot
+	}
+	return gitHistoryReason(c.GitOpener, gitRoot)
+}
+
 // Collect scans the repository at repoPath for git-level signals.
 func (c *GitlogCollector) Collect(ctx context.Context, repoPath string, opts signal.CollectorOpts) ([]signal.RawSignal, error) {
 	// Use GitRoot if set (subdirectory scans), otherwise fall back to repoPath.
@@ -103,22 +116,44 @@ func (c *GitlogCollector) Collect(ctx context.Context, repoPath string, opts sig
 
 	var signals []signal.RawSignal
 
+	// Consolidate author identities via .mailmap and configured identities.
+	ids, idErr := identity.Load(gitRoot, opts.Identities)
+	if idErr != nil {
+		slog.WarnContext(ctx, "gitlog: failed to load author identities", "error", idErr)
+	}
+
+	// History paths are relative to the git root, scope paths to repoPath.
+	root := signal.ScanRoot(gitRoot, repoPath)
+	inScope := func(name string) bool { return opts.Scope.ContainsFrom(root, name) }
+
 	// Collect reverts and build churn data in a single commit walk.
-	reverts, churnSignals, fileChanges, fileAuthors, err := c.walkCommits(ctx, repo, opts)
+	quality := make(churnQuality)
+	reverts, churnSignals, fileChanges, fileAuthors, err := c.walkCommits(ctx, repo, ids, opts, inScope, quality)
 	if err != nil {
 		return nil, fmt.Errorf("walking commits: %w", err)
 	}
 	signals = append(signals, reverts...)
 	signals = append(signals, churnSignals...)
 
+	churnWindow := time.Now().AddDate(0, 0, -churnWindowDays)
+	if err := quality.recordForcePushes(ctx, repo, gitRoot, churnWindow, inScope); err != nil {
+		return nil, fmt.Errorf("reading reflogs: %w", err)
+	}
+	qualitySignals := quality.signals()
+	signals = append(signals, qualitySignals...)
+
 	// Check context before stale-branch scan.
 	if err := ctx.Err(); err != nil {
 		return nil, err
 	}
 
-	staleBranches, err := c.detectStaleBranches(ctx, repo)
-	if err != nil {
-		return nil, fmt.Errorf("detecting stale branches: %w", err)
+	// Branches are not tied to a path, so a scoped scan leaves them out.
+	var staleBranches []signal.RawSignal
+	if opts.Scope.IsZero() {
+		staleBranches, err = c.detectStaleBranches(ctx, repo)
+		if err != nil {
+			return nil, fmt.Errorf("detecting stale branches: %w", err)
+		}
 	}
 	signals = append(signals, staleBranches...)
 
@@ -137,17 +172,21 @@ func (c *GitlogCollector) Collect(ctx context.Context, repoPath string, opts sig
 	})
 
 	c.metrics = &GitlogMetrics{
-		FileChurns:       churns,
-		RevertCount:      len(reverts),
-		StaleBranchCount: len(staleBranches),
+		FileChurns:        churns,
+		RevertCount:       len(reverts),
+		StaleBranchCount:  len(staleBranches),
+		ChurnQualityCount: len(qualitySignals),
 	}
 
 	return signals, nil
 }
 
 // walkCommits iterates over the most recent commits and returns revert signals,
-// churn signals, and the raw file-change/author maps for metrics.
-func (c *GitlogCollector) walkCommits(ctx context.Context, repo testable.GitRepository, opts signal.CollectorOpts) ([]signal.RawSignal, []signal.RawSignal, map[string]int, map[string]map[string]bool, error) {
+// churn signals, and the raw file-change/author maps for metrics. Authors are
+// resolved through ids so one person under several identities counts once.
+// Commits in the churn window are also recorded in quality. Only files for
+// which inScope is true are counted, and only reverts whose FilePath is.
+func (c *GitlogCollector) walkCommits(ctx context.Context, repo testable.GitRepository, ids *identity.Map, opts signal.CollectorOpts, inScope func(string) bool, quality churnQuality) ([]signal.RawSignal, []signal.RawSignal, map[string]int, map[string]map[string]bool, error) {
 	head, err := repo.Head()
 	if err != nil {
 		// Empty repo or detached HEAD with no commits.
@@ -195,7 +234,8 @@ func (c *GitlogCollector) walkCommits(ctx context.Context, repo testable.GitRepo
 		}
 
 		// --- Revert detection ---
-		if sig, ok := detectRevert(commit); ok {
+		sig, isRevert := detectRevert(commit)
+		if isRevert && (sig.FilePath == "" || inScope(sig.FilePath)) {
 			reverts = append(reverts, sig)
 		}
 
@@ -203,14 +243,18 @@ func (c *GitlogCollector) walkCommits(ctx context.Context, repo testable.GitRepo
 		if commit.Committer.When.After(churnWindow) {
 			files, filesErr := changedFiles(commit)
 			if filesErr == nil {
-				author := commit.Author.Name
+				author := ids.Resolve(commit.Author.Name, commit.Author.Email)
 				for _, name := range files {
+					if !inScope(name) {
+						continue
+					}
 					fileChanges[name]++
 					if fileAuthors[name] == nil {
 						fileAuthors[name] = make(map[string]bool)
 					}
 					fileAuthors[name][author] = true
 				}
+				quality.record(commit, files, isRevert, inScope)
 			}
 		}
 
@@ -304,7 +348,7 @@ func buildChurnSignals(fileChanges map[string]int, fileAuthors map[string]map[st
 		authors := sortedKeys(fileAuthors[filePath])
 		confidence := churnConfidence(count)
 
-		signals = append(signals, signal.RawSignal{
+		sig := signal.RawSignal{
 			Source:   "gitlog",
 			Kind:     "churn",
 			FilePath: filePath,
@@ -314,7 +358,10 @@ func buildChurnSignals(fileChanges map[string]int, fileAuthors map[string]map[st
 				count, churnWindowDays, strings.Join(authors, ", ")),
 			Confidence: confidence,
 			Tags:       []string{"churn"},
-		})
+		}
+		sig.AddFactor("base", confidence, fmt.Sprintf("%d changes in %d days (0.40 at %d, up to 0.80 at 30)",
+			count, churnWindowDays, churnThreshold))
+		signals = append(signals, sig)
 	}
 
 	// Sort by file path for deterministic output.
@@ -376,7 +423,7 @@ func (c *GitlogCollector) detectStaleBranches(ctx context.Context, repo testable
 		daysSinceActivity := int(math.Round(now.Sub(lastActivity).Hours() / 24))
 		confidence := staleBranchConfidence(daysSinceActivity)
 
-		signals = append(signals, signal.RawSignal{
+		sig := signal.RawSignal{
 			Source:   "gitlog",
 			Kind:     "stale-branch",
 			FilePath: branchName,
@@ -388,7 +435,10 @@ func (c *GitlogCollector) detectStaleBranches(ctx context.Context, repo testable
 			Timestamp:  lastActivity,
 			Confidence: confidence,
 			Tags:       []string{"stale-branch"},
-		})
+		}
+		sig.AddFactor("base", confidence, fmt.Sprintf("no activity for %d days (0.30 at %d, up to 0.60 at 90)",
+			daysSinceActivity, staleBranchDays))
+		signals = append(signals, sig)
 
 		return nil
 	})
@@ -495,3 +545,4 @@ func (c *GitlogCollector) Metrics() any { return c.metrics }
 // Compile-time interface checks.
 var _ collector.Collector = (*GitlogCollector)(nil)
 var _ collector.MetricsProvider = (*GitlogCollector)(nil)
+var _ collector.CapabilityChecker = (*GitlogCollector)(nil)
@@ -0,0 +1,275 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package collectors
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io/fs"
+	"math"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+
+	"github.com/davetashner/stringer/internal/signal"
+	"github.com/davetashner/stringer/internal/testable"
+)
+
+// fixStreakThreshold is the number of consecutive fix-up commits to one file
+// that counts as a streak.
+const fixStreakThreshold = 3
+
+// revertChainThreshold is the number of reverts touching one file in the
+// churn window that counts as a revert chain.
+const revertChainThreshold = 2
+
+// fixupSubjectPattern matches subjects of commits that patch up an earlier
+// one: autosquash markers, and "fix", "wip", "typo", "oops", and "try again"
+// messages.
+var fixupSubjectPattern = regexp.MustCompile(`(?i)^\s*(fixup!|squash!|amend!)|^\s*(fix(e[sd])?|hotfix|try again|again)\b|\b(wip|typo|oops)\b`)
+
+// reapplySubjectPattern matches a revert of a revert, which git titles
+// "Reapply" or nests as "Revert "Revert ..."".
+var reapplySubjectPattern = regexp.MustCompile(`(?i)^(Reapply\s+"|Revert\s+"Revert\s)`)
+
+// fileHistoryQuality accumulates the history patterns of one file within
+// the churn window, newest commit first.
+type fileHistoryQuality struct {
+	streak        []string // subjects of the current fix-up streak
+	longestStreak []string // subjects of the longest fix-up streak
+	reverts       []string // short hashes of reverts touching the file
+	reapplied     bool     // a revert of a revert touched the file
+	forcePushes   int      // forced updates that dropped commits touching the file
+}
+
+// churnQuality tracks chaotic-history patterns per file during the commit
+// walk.
+type churnQuality map[string]*fileHistoryQuality
+
+// file returns the tracker for name, creating it on first use.
+func (q churnQuality) file(name string) *fileHistoryQuality {
+	h := q[name]
+	if h == nil {
+		h = &fileHistoryQuality{}
+		q[name] = h
+	}
+	return h
+}
+
+// record notes one commit touching files. isRevert is whether the commit is
+// a revert, as detectRevert decides. Files for which inScope is false are
+// skipped.
+func (q churnQuality) record(commit *object.Commit, files []string, isRevert bool, inScope func(string) bool) {
+	subject := strings.TrimSpace(firstLine(commit.Message))
+	fixup := !isRevert && fixupSubjectPattern.MatchString(subject)
+	reapply := reapplySubjectPattern.MatchString(subject)
+	for _, name := range files {
+		if !inScope(name) {
+			continue
+		}
+		h := q.file(name)
+		if fixup {
+			h.streak = append(h.streak, subject)
+			if len(h.streak) > len(h.longestStreak) {
+				h.longestStreak = append([]string(nil), h.streak...)
+			}
+		} else {
+			h.streak = nil
+		}
+		if isRevert || reapply {
+			h.reverts = append(h.reverts, shortHash(commit.Hash.String()))
+		}
+		if reapply {
+			h.reapplied = true
+		}
+	}
+}
+
+// recordForcePushes reads the reflogs under the repository's .git/logs
+// directory for forced updates since the given time and counts, per file,
+// the updates that dropped commits touching it. Forced updates are what git
+// logs when a fetch or pull finds that someone force-pushed over work it had
+// already seen. Repositories without reflogs, such as fresh CI clones, have
+// nothing to report.
+func (q churnQuality) recordForcePushes(ctx context.Context, repo testable.GitRepository, gitRoot string, since time.Time, inScope func(string) bool) error {
+	logsDir := filepath.Join(gitRoot, ".git", "logs", "refs")
+	if info, err := FS.Stat(logsDir); err != nil || !info.IsDir() {
+		return nil
+	}
+	seen := make(map[string]bool)
+	return FS.WalkDir(logsDir, func(path string, d fs.DirEntry, walkErr error) error {
+		if walkErr != nil || d.IsDir() {
+			return nil
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		data, err := FS.ReadFile(path)
+		if err != nil {
+			return nil
+		}
+		for _, u := range forcedUpdates(data, since) {
+			key := u[0] + ".." + u[1]
+			if seen[key] {
+				continue // the same update logged for several refs
+			}
+			seen[key] = true
+			for _, name := range droppedFiles(repo, u[0], u[1]) {
+				if inScope(name) {
+					q.file(name).forcePushes++
+				}
+			}
+		}
+		return nil
+	})
+}
+
+// forcedUpdates returns the old and new hashes of each "forced-update"
+// entry in a reflog that is newer than since.
+func forcedUpdates(reflog []byte, since time.Time) [][2]string {
+	var updates [][2]string
+	sc := bufio.NewScanner(bytes.NewReader(reflog))
+	for sc.Scan() {
+		entry, msg, ok := strings.Cut(sc.Text(), "\t")
+		if !ok || !strings.Contains(msg, "forced-update") {
+			continue
+		}
+		fields := strings.Fields(entry)
+		if len(fields) < 4 {
+			continue
+		}
+		// <old> <new> <name> <email> <unix time> <tz>
+		ts, err := strconv.ParseInt(fields[len(fields)-2], 10, 64)
+		if err != nil || time.Unix(ts, 0).Before(since) {
+			continue
+		}
+		updates = append(updates, [2]string{fields[0], fields[1]})
+	}
+	return updates
+}
+
+// droppedFiles returns the files changed by the commits a forced update
+// from oldHash to newHash left behind: the diff from their merge base to
+// the old tip. It returns nil when either commit is gone, as it is once git
+// has pruned the dropped work.
+func droppedFiles(repo testable.GitRepository, oldHash, newHash string) []string {
+	oldCommit, err := repo.CommitObject(plumbing.NewHash(oldHash))
+	if err != nil {
+		return nil
+	}
+	newCommit, err := repo.CommitObject(plumbing.NewHash(newHash))
+	if err != nil {
+		return nil
+	}
+	bases, err := oldCommit.MergeBase(newCommit)
+	if err != nil || len(bases) == 0 || bases[0].Hash == oldCommit.Hash {
+		return nil
+	}
+	baseTree, err := bases[0].Tree()
+	if err != nil {
+		return nil
+	}
+	oldTree, err := oldCommit.Tree()
+	if err != nil {
+		return nil
+	}
+	changes, err := baseTree.Diff(oldTree)
+	if err != nil {
+		return nil
+	}
+	var names []string
+	for _, ch := range changes {
+		name := ch.To.Name
+		if name == "" {
+			name = ch.From.Name
+		}
+		names = append(names, name)
+	}
+	return names
+}
+
+// signals returns a churn-quality signal for each file whose history shows
+// a fix-up streak, a revert chain, or work lost to a force push, sorted by
+// file path.
+func (q churnQuality) signals() []signal.RawSignal {
+	var signals []signal.RawSignal
+	for name, h := range q {
+		var patterns, evidence, others []string
+		var strongest string
+		confidence := 0.0
+		add := func(pattern, detail string, c float64) {
+			patterns = append(patterns, pattern)
+			evidence = append(evidence, detail)
+			if c > confidence {
+				if strongest != "" {
+					others = append(others, strongest)
+				}
+				strongest, confidence = pattern, c
+			} else {
+				others = append(others, pattern)
+			}
+		}
+
+		if n := len(h.longestStreak); n >= fixStreakThreshold {
+			// Newest first in the walk; list them oldest first.
+			subjects := make([]string, n)
+			for i, s := range h.longestStreak {
+				subjects[n-1-i] = fmt.Sprintf("%q", s)
+			}
+			add(fmt.Sprintf("%d-commit fix streak", n),
+				fmt.Sprintf("%d fix-up commits in a row: %s", n, strings.Join(subjects, ", ")),
+				math.Min(0.4+0.05*float64(n-fixStreakThreshold), 0.6))
+		}
+		if n := len(h.reverts); n >= revertChainThreshold || h.reapplied {
+			pattern, c := fmt.Sprintf("%d reverts", n), 0.5
+			detail := fmt.Sprintf("%s: %s", pattern, strings.Join(h.reverts, ", "))
+			if h.reapplied {
+				c = 0.6
+				detail += " (including a revert of a revert)"
+				if n < revertChainThreshold {
+					pattern = "revert of a revert"
+				}
+			}
+			add(pattern, detail, c)
+		}
+		if h.forcePushes > 0 {
+			add("force-pushed over",
+				fmt.Sprintf("%d forced update(s) dropped commits touching this file", h.forcePushes),
+				0.4)
+		}
+		if len(patterns) == 0 {
+			continue
+		}
+
+		base := confidence
+		confidence = math.Min(base+0.1*float64(len(patterns)-1), 0.8)
+		sig := signal.RawSignal{
+			Source:   "gitlog",
+			Kind:     "churn-quality",
+			FilePath: name,
+			Title:    fmt.Sprintf("Chaotic history: %s (%s)", name, strings.Join(patterns, ", ")),
+			Description: fmt.Sprintf("In the last %d days:\n- %s\nRepeated fix-ups, reverts, and force pushes mark code that is hard to change safely; it needs stabilization work such as tests or a redesign.",
+				churnWindowDays, strings.Join(evidence, "\n- ")),
+			Confidence: confidence,
+			Tags:       []string{"churn-quality"},
+		}
+		sig.AddFactor("base", base, strongest+" (fix streak 0.40 at 3 commits up to 0.60, revert chain 0.50 or 0.60 with a reapply, force push 0.40)")
+		if len(others) > 0 {
+			sig.AddFactor("corroboration", confidence-base, "+0.10 each for "+strings.Join(others, ", "))
+		}
+		signals = append(signals, sig)
+	}
+	sort.Slice(signals, func(i, j int) bool {
+		return signals[i].FilePath < signals[j].FilePath
+	})
+	return signals
+}
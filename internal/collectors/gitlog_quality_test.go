@@ -0,0 +1,149 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package collectors
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	gogit "github.com/go-git/go-git/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+func TestGitlogCollector_FixStreak(t *testing.T) {
+	repo, dir := initGoGitRepo(t, map[string]string{
+		"flaky.go":  "package main\n",
+		"steady.go": "package main\n",
+	})
+
+	start := time.Now().Add(-48 * time.Hour)
+	subjects := []string{"feat: add parser", "fix parser", "wip", "typo", "oops, again", "feat: add lexer"}
+	for i, msg := range subjects {
+		addCommit(t, repo, dir, "flaky.go", fmt.Sprintf("package main\n// %d\n", i), msg, start.Add(time.Duration(i)*time.Hour))
+	}
+	for i, msg := range []string{"fix a", "feat: b", "fix c", "docs: d"} {
+		addCommit(t, repo, dir, "steady.go", fmt.Sprintf("package main\n// %d\n", i), msg, start.Add(time.Duration(10+i)*time.Hour))
+	}
+
+	c := &GitlogCollector{}
+	signals, err := c.Collect(context.Background(), dir, signal.CollectorOpts{})
+	require.NoError(t, err)
+
+	quality := filterByKind(signals, "churn-quality")
+	require.Len(t, quality, 1, "interrupted fix-ups on steady.go are not a streak")
+	sig := quality[0]
+	assert.Equal(t, "gitlog", sig.Source)
+	assert.Equal(t, "flaky.go", sig.FilePath)
+	assert.Equal(t, "Chaotic history: flaky.go (4-commit fix streak)", sig.Title)
+	assert.Contains(t, sig.Description, `4 fix-up commits in a row: "fix parser", "wip", "typo", "oops, again"`)
+	assert.InDelta(t, 0.45, sig.Confidence, 1e-9)
+	assert.Equal(t, []string{"churn-quality"}, sig.Tags)
+
+	metrics, ok := c.Metrics().(*GitlogMetrics)
+	require.True(t, ok)
+	assert.Equal(t, 1, metrics.ChurnQualityCount)
+}
+
+func TestGitlogCollector_RevertChain(t *testing.T) {
+	repo, dir := initGoGitRepo(t, map[string]string{"cache.go": "package main\n"})
+
+	start := time.Now().Add(-48 * time.Hour)
+	steps := []string{
+		"feat: add cache",
+		`Revert "feat: add cache"`,
+		`Reapply "feat: add cache"` + "\n\nThis reverts commit 0123456789abcdef0123456789abcdef01234567.",
+		"fix cache eviction",
+		"fix cache eviction again",
+		"fix: cache keys",
+	}
+	for i, msg := range steps {
+		addCommit(t, repo, dir, "cache.go", fmt.Sprintf("package main\n// %d\n", i), msg, start.Add(time.Duration(i)*time.Hour))
+	}
+
+	signals, err := (&GitlogCollector{}).Collect(context.Background(), dir, signal.CollectorOpts{})
+	require.NoError(t, err)
+
+	quality := filterByKind(signals, "churn-quality")
+	require.Len(t, quality, 1)
+	sig := quality[0]
+	assert.Equal(t, "Chaotic history: cache.go (3-commit fix streak, 2 reverts)", sig.Title)
+	assert.Contains(t, sig.Description, "(including a revert of a revert)")
+	assert.InDelta(t, 0.7, sig.Confidence, 1e-9, "0.6 for the reapplied revert chain, +0.1 for the streak")
+	require.Len(t, sig.Factors, 2)
+	assert.Equal(t, "corroboration", sig.Factors[1].Name)
+	assert.Contains(t, sig.Factors[1].Detail, "3-commit fix streak")
+}
+
+func TestGitlogCollector_ForcePushedOver(t *testing.T) {
+	repo, dir := initGoGitRepo(t, map[string]string{"base.go": "package main\n"})
+	head, err := repo.Head()
+	require.NoError(t, err)
+	base := head.Hash()
+
+	now := time.Now()
+	lost := addCommit(t, repo, dir, "lost.go", "package main\n// work\n", "feat: work in progress", now.Add(-2*time.Hour))
+
+	wt, err := repo.Worktree()
+	require.NoError(t, err)
+	require.NoError(t, wt.Reset(&gogit.ResetOptions{Commit: base, Mode: gogit.HardReset}))
+	rewritten := addCommit(t, repo, dir, "other.go", "package main\n", "feat: something else", now.Add(-time.Hour))
+
+	logDir := filepath.Join(dir, ".git", "logs", "refs", "remotes", "origin")
+	require.NoError(t, os.MkdirAll(logDir, 0o750))
+	reflog := fmt.Sprintf("%s %s Test Author <test@example.com> %d +0000\tfetch: forced-update\n"+
+		"%s %s Test Author <test@example.com> %d +0000\tfetch: forced-update\n",
+		lost, rewritten, now.Unix(),
+		base, lost, now.AddDate(0, 0, -200).Unix())
+	require.NoError(t, os.WriteFile(filepath.Join(logDir, "main"), []byte(reflog), 0o600))
+
+	signals, err := (&GitlogCollector{}).Collect(context.Background(), dir, signal.CollectorOpts{})
+	require.NoError(t, err)
+
+	quality := filterByKind(signals, "churn-quality")
+	require.Len(t, quality, 1, "old forced updates are outside the churn window")
+	assert.Equal(t, "lost.go", quality[0].FilePath)
+	assert.Equal(t, "Chaotic history: lost.go (force-pushed over)", quality[0].Title)
+	assert.InDelta(t, 0.4, quality[0].Confidence, 1e-9)
+
+	signals, err = (&GitlogCollector{}).Collect(context.Background(), dir, signal.CollectorOpts{
+		Scope: signal.NewScope([]string{"cmd"}, 0),
+	})
+	require.NoError(t, err)
+	assert.Empty(t, filterByKind(signals, "churn-quality"))
+}
+
+func TestForcedUpdates(t *testing.T) {
+	since := time.Unix(1000, 0)
+	reflog := []byte("aaa bbb A <a@x> 2000 +0000\tfetch: forced-update\n" +
+		"bbb ccc A <a@x> 2000 +0000\tfetch: fast-forward\n" +
+		"ccc ddd A <a@x> 500 +0000\tpull: forced-update\n" +
+		"malformed line\n")
+	assert.Equal(t, [][2]string{{"aaa", "bbb"}}, forcedUpdates(reflog, since))
+}
+
+func TestFixupSubjectPattern(t *testing.T) {
+	for subject, want := range map[string]bool{
+		"fix typo in README":          true,
+		"Fix: nil pointer":            true,
+		"fixes #12":                   true,
+		"fixup! feat: add parser":     true,
+		"WIP parser":                  true,
+		"parser wip":                  true,
+		"oops":                        true,
+		"try again":                   true,
+		"feat: add fixture loader":    false,
+		"refactor: prefix handling":   false,
+		"docs: explain the wiping":    false,
+		"Revert \"feat: add parser\"": false,
+	} {
+		assert.Equal(t, want, fixupSubjectPattern.MatchString(subject), subject)
+	}
+}
@@ -147,6 +147,64 @@ func TestGitlogCollector_ChurnDetected(t *testing.T) {
 	assert.Contains(t, sig.Description, "Test Author")
 }
 
+func TestGitlogCollector_Scope(t *testing.T) {
+	repo, dir := initGoGitRepo(t, map[string]string{
+		"internal/api/hot.go": "package api\n",
+		"cmd/hot.go":          "package main\n",
+	})
+
+	now := time.Now()
+	for i := 0; i < 12; i++ {
+		for _, path := range []string{"internal/api/hot.go", "cmd/hot.go"} {
+			addCommit(t, repo, dir, path, fmt.Sprintf("package x\n// change %d\n", i),
+				fmt.Sprintf("chore: tweak %s (%d)", path, i), now.Add(-time.Duration(i)*24*time.Hour))
+		}
+	}
+	addCommit(t, repo, dir, "cmd/hot.go", "package main\n", `Revert "chore: tweak cmd/hot.go (0)"`, now)
+
+	c := &GitlogCollector{}
+	signals, err := c.Collect(context.Background(), dir, signal.CollectorOpts{
+		Scope: signal.NewScope([]string{"internal"}, 0),
+	})
+	require.NoError(t, err)
+
+	churn := filterByKind(signals, "churn")
+	require.Len(t, churn, 1)
+	assert.Equal(t, "internal/api/hot.go", churn[0].FilePath)
+	assert.Empty(t, filterByKind(signals, "revert"), "the revert only touched files outside the scope")
+
+	metrics, ok := c.Metrics().(*GitlogMetrics)
+	require.True(t, ok)
+	require.Len(t, metrics.FileChurns, 1)
+	assert.Equal(t, "internal/api/hot.go", metrics.FileChurns[0].Path)
+}
+
+func TestGitlogCollector_ScopeInSubdirectory(t *testing.T) {
+	repo, dir := initGoGitRepo(t, map[string]string{
+		"svc/internal/hot.go": "package internal\n",
+		"svc/cmd/hot.go":      "package main\n",
+	})
+
+	now := time.Now()
+	for i := 0; i < 12; i++ {
+		addCommit(t, repo, dir, "svc/internal/hot.go", fmt.Sprintf("package internal\n// change %d\n", i),
+			fmt.Sprintf("chore: tweak (%d)", i), now.Add(-time.Duration(i)*24*time.Hour))
+	}
+	addCommit(t, repo, dir, "svc/cmd/hot.go", "package main\n// revert\n", `Revert "chore: tweak cmd"`, now)
+
+	c := &GitlogCollector{}
+	signals, err := c.Collect(context.Background(), filepath.Join(dir, "svc"), signal.CollectorOpts{
+		GitRoot: dir,
+		Scope:   signal.NewScope([]string{"internal"}, 0),
+	})
+	require.NoError(t, err)
+
+	churn := filterByKind(signals, "churn")
+	require.Len(t, churn, 1, "history paths are matched against the scope from the scan root")
+	assert.Equal(t, "svc/internal/hot.go", churn[0].FilePath)
+	assert.Empty(t, filterByKind(signals, "revert"))
+}
+
 func TestGitlogCollector_ChurnNotDetected_FewChanges(t *testing.T) {
 	repo, dir := initGoGitRepo(t, map[string]string{
 		"stable.go": "package main\n",
@@ -258,6 +316,13 @@ func TestGitlogCollector_StaleBranchDetected(t *testing.T) {
 	assert.LessOrEqual(t, sig.Confidence, 0.6)
 	assert.Contains(t, sig.Tags, "stale-branch")
 	assert.NotEmpty(t, sig.Description)
+
+	// Branches are not tied to a path, so a scoped scan leaves them out.
+	signals, err = c.Collect(context.Background(), dir, signal.CollectorOpts{
+		Scope: signal.NewScope([]string{"internal"}, 0),
+	})
+	require.NoError(t, err)
+	assert.Empty(t, filterByKind(signals, "stale-branch"))
 }
 
 func TestGitlogCollector_ProtectedBranchesExcluded(t *testing.T) {
@@ -1029,3 +1094,38 @@ func TestGitlogCollector_MetricsAfterCollect(t *testing.T) {
 		assert.LessOrEqual(t, metrics.FileChurns[i-1].Path, metrics.FileChurns[i].Path)
 	}
 }
+
+func TestGitlogCollector_MailmapConsolidatesAuthors(t *testing.T) {
+	repo, dir := initGoGitRepo(t, map[string]string{
+		"main.go": "package main\n",
+	})
+
+	now := time.Now()
+	addCommitAs(t, repo, dir, "main.go", "package main\n// a\n", "chore: a", now, "Jane Doe", "jane@example.com")
+	addCommitAs(t, repo, dir, "main.go", "package main\n// b\n", "chore: b", now, "jdoe", "jane@old-job.com")
+	addCommitAs(t, repo, dir, "main.go", "package main\n// c\n", "chore: c", now, "Jane", "jane@laptop.local")
+
+	authorCount := func(t *testing.T, opts signal.CollectorOpts) int {
+		t.Helper()
+		c := &GitlogCollector{}
+		_, err := c.Collect(context.Background(), dir, opts)
+		require.NoError(t, err)
+		for _, fc := range c.metrics.FileChurns {
+			if fc.Path == "main.go" {
+				return fc.AuthorCount
+			}
+		}
+		t.Fatal("main.go missing from churn metrics")
+		return 0
+	}
+
+	// Without identity data, each Jane counts separately.
+	assert.Equal(t, 3, authorCount(t, signal.CollectorOpts{}))
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".mailmap"),
+		[]byte("Jane Doe <jane@example.com> <jane@old-job.com>\n"), 0o600))
+	assert.Equal(t, 2, authorCount(t, signal.CollectorOpts{}))
+
+	opts := signal.CollectorOpts{Identities: map[string][]string{"Jane Doe": {"jane@laptop.local"}}}
+	assert.Equal(t, 1, authorCount(t, opts))
+}
@@ -0,0 +1,363 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package collectors
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/davetashner/stringer/internal/collector"
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+// LintTools are the linters the lint collector can run itself.
+var LintTools = []string{"vet", "staticcheck"}
+
+func init() {
+	collector.Register(&LintCollector{})
+}
+
+// LintMetrics holds structured metrics from the lint ingestion.
+type LintMetrics struct {
+	ReportsParsed int
+	ToolsRun      int
+	Findings      int
+}
+
+// LintCollector turns go vet and staticcheck diagnostics into signals, so
+// teams that already run them triage one backlog instead of two. It reads
+// their JSON output from configured reports, runs the configured tools, or
+// both; it does nothing unless one is configured.
+type LintCollector struct {
+	metrics *LintMetrics
+}
+
+// Name returns the collector name used for registration and filtering.
+func (c *LintCollector) Name() string { return "lint" }
+
+// lintFinding is one diagnostic from go vet or staticcheck.
+type lintFinding struct {
+	Tool     string // "go vet" or "staticcheck"
+	Check    string // vet analyzer name or staticcheck check code
+	File     string
+	Line     int
+	Column   int
+	Message  string
+	Severity string // staticcheck only: "error", "warning", or "ignored"
+}
+
+// Collect parses each configured lint report and runs each configured tool,
+// emitting one signal per distinct diagnostic.
+func (c *LintCollector) Collect(ctx context.Context, repoPath string, opts signal.CollectorOpts) ([]signal.RawSignal, error) {
+	metrics := &LintMetrics{}
+	c.metrics = metrics
+	if len(opts.LintReports) == 0 && len(opts.LintTools) == 0 {
+		return nil, nil
+	}
+
+	var findings []lintFinding
+	for _, path := range expandReports(ctx, "lint", repoPath, opts.LintReports) {
+		data, err := FS.ReadFile(path)
+		if err != nil {
+			slog.WarnContext(ctx, "lint: skipping unreadable report", "path", path, "error", err)
+			continue
+		}
+		parsed, err := parseLintOutput(data)
+		if err != nil {
+			slog.WarnContext(ctx, "lint: skipping malformed report", "path", path, "error", err)
+			continue
+		}
+		metrics.ReportsParsed++
+		findings = append(findings, parsed...)
+	}
+
+	var toolErrs []error
+	for _, tool := range opts.LintTools {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		parsed, err := runLintTool(ctx, repoPath, tool)
+		if err != nil {
+			slog.WarnContext(ctx, "lint: tool failed", "tool", tool, "error", err)
+			toolErrs = append(toolErrs, err)
+			continue
+		}
+		metrics.ToolsRun++
+		findings = append(findings, parsed...)
+	}
+	if metrics.ReportsParsed == 0 && metrics.ToolsRun == 0 {
+		if len(toolErrs) > 0 {
+			return nil, errors.Join(toolErrs...)
+		}
+		return nil, fmt.Errorf("no readable lint reports matched %s", strings.Join(opts.LintReports, ", "))
+	}
+
+	signals := lintSignals(repoPath, findings, opts, lintSignal)
+	metrics.Findings = len(signals)
+	return signals, nil
+}
+
+// Metrics returns structured metrics from the last Collect call.
+func (c *LintCollector) Metrics() any { return c.metrics }
+
+// lintSignals turns findings into sorted signals built by build, dropping
+// duplicates, findings outside the repo or the include/exclude patterns,
+// and signals under MinConfidence. Findings with severity "ignored" are
+// dropped too.
+func lintSignals(repoPath string, findings []lintFinding, opts signal.CollectorOpts, build func(lintFinding, string) signal.RawSignal) []signal.RawSignal {
+	excludes := mergeExcludes(opts.ExcludePatterns)
+	seen := make(map[string]bool)
+	var signals []signal.RawSignal
+	for _, f := range findings {
+		file, ok := lintRelPath(repoPath, f.File)
+		if !ok || shouldExclude(file, excludes) {
+			continue
+		}
+		if len(opts.IncludePatterns) > 0 && !matchesAny(file, opts.IncludePatterns) {
+			continue
+		}
+		key := fmt.Sprintf("%s\x00%s\x00%s\x00%d\x00%s", f.Tool, f.Check, file, f.Line, f.Message)
+		if seen[key] || f.Severity == "ignored" {
+			continue
+		}
+		seen[key] = true
+		sig := build(f, filepath.ToSlash(file))
+		if sig.Confidence < opts.MinConfidence {
+			continue
+		}
+		signals = append(signals, sig)
+	}
+
+	sort.Slice(signals, func(i, j int) bool {
+		if signals[i].FilePath != signals[j].FilePath {
+			return signals[i].FilePath < signals[j].FilePath
+		}
+		if signals[i].Line != signals[j].Line {
+			return signals[i].Line < signals[j].Line
+		}
+		return signals[i].Title < signals[j].Title
+	})
+	return signals
+}
+
+// runLintTool runs go vet or staticcheck over every package in repoPath
+// and parses its JSON output. Both exit non-zero when they report
+// diagnostics, so a failure only counts when nothing could be parsed.
+func runLintTool(ctx context.Context, repoPath, tool string) ([]lintFinding, error) {
+	var name string
+	var args []string
+	switch tool {
+	case "vet":
+		name, args = "go", []string{"vet", "-json", "./..."}
+	case "staticcheck":
+		name, args = "staticcheck", []string{"-f", "json", "./..."}
+	default:
+		return nil, fmt.Errorf("unknown lint tool %q (must be %s)", tool, strings.Join(LintTools, " or "))
+	}
+	if _, err := exec.LookPath(name); err != nil {
+		return nil, fmt.Errorf("%s: %s not found in PATH", tool, name)
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, name, args...) //nolint:gosec // fixed tool and arguments
+	cmd.Dir = repoPath
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	runErr := cmd.Run()
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+
+	// staticcheck writes diagnostics to stdout. go vet -json does too in
+	// recent Go releases; older ones wrote them to stderr.
+	out := stdout.Bytes()
+	if tool == "vet" && len(bytes.TrimSpace(out)) == 0 {
+		out = stderr.Bytes()
+	}
+	findings, parseErr := parseLintOutput(out)
+	if runErr != nil && (parseErr != nil || len(findings) == 0) {
+		msg := strings.TrimSpace(stderr.String())
+		if len(msg) > maxPluginStderr {
+			msg = msg[len(msg)-maxPluginStderr:]
+		}
+		return nil, fmt.Errorf("%s: %w: %s", tool, runErr, msg)
+	}
+	if parseErr != nil {
+		return nil, fmt.Errorf("%s: %w", tool, parseErr)
+	}
+	return findings, nil
+}
+
+// staticcheckDiagnostic is one line of staticcheck -f json output.
+type staticcheckDiagnostic struct {
+	Code     string `json:"code"`
+	Severity string `json:"severity"`
+	Location *struct {
+		File   string `json:"file"`
+		Line   int    `json:"line"`
+		Column int    `json:"column"`
+	} `json:"location"`
+	Message string `json:"message"`
+}
+
+// vetDiagnostic is one diagnostic in go vet -json output.
+type vetDiagnostic struct {
+	Posn    string `json:"posn"`
+	Message string `json:"message"`
+}
+
+// parseLintOutput reads staticcheck -f json lines, go vet -json output, or
+// a mix of both. go vet prints "# package" header lines between its JSON
+// objects; they are skipped.
+func parseLintOutput(data []byte) ([]lintFinding, error) {
+	var body bytes.Buffer
+	for _, line := range bytes.Split(data, []byte("\n")) {
+		if !bytes.HasPrefix(bytes.TrimSpace(line), []byte("#")) {
+			body.Write(line)
+			body.WriteByte('\n')
+		}
+	}
+
+	var findings []lintFinding
+	dec := json.NewDecoder(&body)
+	for {
+		var raw json.RawMessage
+		if err := dec.Decode(&raw); err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+
+		var sc staticcheckDiagnostic
+		if err := json.Unmarshal(raw, &sc); err == nil && sc.Code != "" && sc.Location != nil {
+			if sc.Code == "compile" {
+				continue // a build error, not a finding
+			}
+			findings = append(findings, lintFinding{
+				Tool: "staticcheck", Check: sc.Code, Severity: sc.Severity, Message: sc.Message,
+				File: sc.Location.File, Line: sc.Location.Line, Column: sc.Location.Column,
+			})
+			continue
+		}
+
+		// go vet -json: {"pkg": {"analyzer": [diagnostics] or {"error": ...}}}
+		var tree map[string]map[string]json.RawMessage
+		if err := json.Unmarshal(raw, &tree); err != nil {
+			return nil, fmt.Errorf("unrecognized lint output: %w", err)
+		}
+		for _, analyzers := range tree {
+			for analyzer, v := range analyzers {
+				var diags []vetDiagnostic
+				if json.Unmarshal(v, &diags) != nil {
+					continue // a package or analyzer error
+				}
+				for _, d := range diags {
+					file, line, col := splitPosn(d.Posn)
+					findings = append(findings, lintFinding{
+						Tool: "go vet", Check: analyzer, Message: d.Message,
+						File: file, Line: line, Column: col,
+					})
+				}
+			}
+		}
+	}
+	return findings, nil
+}
+
+// splitPosn splits a go vet position, "file.go:12:3", into its parts.
+func splitPosn(posn string) (string, int, int) {
+	file, line, col := posn, 0, 0
+	if i := strings.LastIndexByte(file, ':'); i > 0 {
+		if n, err := strconv.Atoi(file[i+1:]); err == nil {
+			file, col = file[:i], n
+		}
+	}
+	if i := strings.LastIndexByte(file, ':'); i > 0 {
+		if n, err := strconv.Atoi(file[i+1:]); err == nil {
+			file, line = file[:i], n
+		}
+	}
+	if line == 0 { // only one number: it was the line
+		line, col = col, 0
+	}
+	return file, line, col
+}
+
+// lintRelPath makes a reported file path relative to the repo. Paths
+// outside the repo, such as the module cache, are rejected.
+func lintRelPath(repoPath, file string) (string, bool) {
+	if file == "" {
+		return "", false
+	}
+	if filepath.IsAbs(file) {
+		rel, err := filepath.Rel(repoPath, file)
+		if err != nil || strings.HasPrefix(rel, "..") {
+			return "", false
+		}
+		return rel, true
+	}
+	file = filepath.Clean(file)
+	return file, !strings.HasPrefix(file, "..")
+}
+
+// vetBugAnalyzers are go vet analyzers whose findings are almost always
+// real bugs rather than suspicious style.
+var vetBugAnalyzers = map[string]bool{
+	"atomic": true, "copylocks": true, "lostcancel": true, "loopclosure": true,
+	"nilfunc": true, "printf": true, "unmarshal": true, "unsafeptr": true,
+	"httpresponse": true, "stdmethods": true, "errorsas": true,
+}
+
+// lintKind maps a finding to its signal kind and base confidence.
+// staticcheck's check-code prefix says what kind of problem it is:
+// SA correctness, U unused code, S and QF simplifications, ST style.
+func lintKind(f lintFinding) (string, float64, string) {
+	if f.Tool == "go vet" {
+		if vetBugAnalyzers[f.Check] {
+			return "vet-finding", 0.8, "go vet analyzer " + f.Check + " rarely reports false positives"
+		}
+		return "vet-finding", 0.7, "go vet analyzer " + f.Check
+	}
+	switch {
+	case strings.HasPrefix(f.Check, "SA"):
+		return "staticcheck-bug", 0.75, "staticcheck " + f.Check + " is a correctness check"
+	case strings.HasPrefix(f.Check, "U"):
+		return "staticcheck-unused", 0.5, "staticcheck " + f.Check + " reports unused code"
+	case strings.HasPrefix(f.Check, "ST"):
+		return "staticcheck-style", 0.3, "staticcheck " + f.Check + " is a style check"
+	default:
+		return "staticcheck-simplify", 0.4, "staticcheck " + f.Check + " suggests a simplification"
+	}
+}
+
+// lintSignal builds the signal for one finding.
+func lintSignal(f lintFinding, file string) signal.RawSignal {
+	kind, confidence, basis := lintKind(f)
+	sig := signal.RawSignal{
+		Source:     "lint",
+		Kind:       kind,
+		FilePath:   file,
+		Line:       f.Line,
+		Title:      fmt.Sprintf("%s: %s", f.Check, f.Message),
+		Confidence: confidence,
+		Tags:       []string{"lint", strings.ReplaceAll(f.Tool, " ", "-"), f.Check},
+	}
+	sig.Description = fmt.Sprintf("Reported by %s (%s) at %s:%d", f.Tool, f.Check, file, f.Line)
+	if f.Column > 0 {
+		sig.Description += fmt.Sprintf(":%d", f.Column)
+	}
+	sig.Description += "."
+	sig.AddFactor("base", confidence, basis)
+	return sig
+}
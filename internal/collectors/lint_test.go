@@ -0,0 +1,179 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package collectors
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+const staticcheckReport = `{"code":"SA4006","severity":"error","location":{"file":"%[1]s/main.go","line":10,"column":2},"end":{"file":"%[1]s/main.go","line":10,"column":5},"message":"this value of err is never used"}
+{"code":"U1000","severity":"error","location":{"file":"%[1]s/util.go","line":3,"column":6},"message":"func helper is unused"}
+{"code":"ST1003","severity":"ignored","location":{"file":"%[1]s/util.go","line":1,"column":1},"message":"should not use underscores"}
+{"code":"S1002","severity":"error","location":{"file":"%[1]s/vendor/x/x.go","line":1,"column":1},"message":"should omit comparison to bool constant"}
+{"code":"compile","severity":"error","location":{"file":"%[1]s/broken.go","line":1,"column":1},"message":"expected declaration"}
+`
+
+const vetReport = `# example.com/m
+{
+	"example.com/m": {
+		"printf": [
+			{"posn": "%[1]s/main.go:6:14", "end": "%[1]s/main.go:6:16", "message": "fmt.Printf format %%d has arg \"x\" of wrong type string"}
+		],
+		"shadow": {"error": "analyzer failed"}
+	}
+}
+# example.com/m/sub
+{
+	"example.com/m/sub": {
+		"structtag": [
+			{"posn": "sub/sub.go:4:2", "message": "struct field tag not compatible with reflect.StructTag.Get"}
+		]
+	}
+}
+`
+
+func writeLintReport(t *testing.T, dir, name, format string) {
+	t.Helper()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(fmt.Sprintf(format, dir)), 0o600))
+}
+
+func TestLintCollector_Name(t *testing.T) {
+	assert.Equal(t, "lint", (&LintCollector{}).Name())
+}
+
+func TestLintCollector_NotConfigured(t *testing.T) {
+	c := &LintCollector{}
+	signals, err := c.Collect(context.Background(), t.TempDir(), signal.CollectorOpts{})
+	require.NoError(t, err)
+	assert.Empty(t, signals)
+}
+
+func TestLintCollector_Reports(t *testing.T) {
+	dir := t.TempDir()
+	writeLintReport(t, dir, "staticcheck.json", staticcheckReport)
+	writeLintReport(t, dir, "vet.json", vetReport)
+	writeLintReport(t, dir, "vet-again.json", vetReport)
+
+	c := &LintCollector{}
+	signals, err := c.Collect(context.Background(), dir, signal.CollectorOpts{LintReports: []string{"*.json"}})
+	require.NoError(t, err)
+
+	type got struct {
+		Kind, File, Title string
+		Line              int
+		Confidence        float64
+	}
+	var all []got
+	for _, s := range signals {
+		assert.Equal(t, "lint", s.Source)
+		all = append(all, got{s.Kind, s.FilePath, s.Title, s.Line, s.Confidence})
+	}
+	assert.Equal(t, []got{
+		{"vet-finding", "main.go", `printf: fmt.Printf format %d has arg "x" of wrong type string`, 6, 0.8},
+		{"staticcheck-bug", "main.go", "SA4006: this value of err is never used", 10, 0.75},
+		{"vet-finding", "sub/sub.go", "structtag: struct field tag not compatible with reflect.StructTag.Get", 4, 0.7},
+		{"staticcheck-unused", "util.go", "U1000: func helper is unused", 3, 0.5},
+	}, all, "duplicates, ignored checks, compile errors, and vendored files are dropped")
+
+	assert.Equal(t, []string{"lint", "staticcheck", "SA4006"}, signals[1].Tags)
+	assert.Equal(t, "Reported by staticcheck (SA4006) at main.go:10:2.", signals[1].Description)
+	assert.Equal(t, []signal.ConfidenceFactor{{Name: "base", Delta: 0.75, Detail: "staticcheck SA4006 is a correctness check"}}, signals[1].Factors)
+	assert.Equal(t, &LintMetrics{ReportsParsed: 3, Findings: 4}, c.Metrics())
+}
+
+func TestLintCollector_Filters(t *testing.T) {
+	dir := t.TempDir()
+	writeLintReport(t, dir, "staticcheck.json", staticcheckReport)
+
+	signals, err := (&LintCollector{}).Collect(context.Background(), dir, signal.CollectorOpts{
+		LintReports:     []string{"staticcheck.json"},
+		MinConfidence:   0.6,
+		IncludePatterns: []string{"*.go"},
+	})
+	require.NoError(t, err)
+	require.Len(t, signals, 1)
+	assert.Equal(t, "staticcheck-bug", signals[0].Kind)
+
+	signals, err = (&LintCollector{}).Collect(context.Background(), dir, signal.CollectorOpts{
+		LintReports:     []string{"staticcheck.json"},
+		ExcludePatterns: []string{"util.go"},
+	})
+	require.NoError(t, err)
+	require.Len(t, signals, 1)
+	assert.Equal(t, "main.go", signals[0].FilePath)
+}
+
+func TestLintCollector_NoReadableReports(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "bad.json"), []byte("not json"), 0o600))
+	_, err := (&LintCollector{}).Collect(context.Background(), dir, signal.CollectorOpts{LintReports: []string{"bad.json", "missing.json"}})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no readable lint reports matched bad.json, missing.json")
+}
+
+func TestLintCollector_RunsVet(t *testing.T) {
+	if _, err := exec.LookPath("go"); err != nil {
+		t.Skip("go not in PATH")
+	}
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "go.mod"), []byte("module example.com/m\n\ngo 1.22\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "main.go"),
+		[]byte("package main\n\nimport \"fmt\"\n\nfunc main() {\n\tfmt.Printf(\"%d\\n\", \"x\")\n}\n"), 0o600))
+
+	c := &LintCollector{}
+	signals, err := c.Collect(context.Background(), dir, signal.CollectorOpts{LintTools: []string{"vet"}})
+	require.NoError(t, err)
+	require.Len(t, signals, 1)
+	assert.Equal(t, "vet-finding", signals[0].Kind)
+	assert.Equal(t, "main.go", signals[0].FilePath)
+	assert.Equal(t, 6, signals[0].Line)
+	assert.Equal(t, 1, c.metrics.ToolsRun)
+}
+
+func TestLintCollector_ToolErrors(t *testing.T) {
+	t.Setenv("PATH", t.TempDir())
+	_, err := (&LintCollector{}).Collect(context.Background(), t.TempDir(), signal.CollectorOpts{LintTools: []string{"staticcheck"}})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "staticcheck: staticcheck not found in PATH")
+}
+
+func TestSplitPosn(t *testing.T) {
+	for _, tc := range []struct {
+		posn      string
+		file      string
+		line, col int
+	}{
+		{"/a/b.go:12:3", "/a/b.go", 12, 3},
+		{"b.go:7", "b.go", 7, 0},
+		{"C:/a/b.go:1:2", "C:/a/b.go", 1, 2},
+		{"b.go", "b.go", 0, 0},
+	} {
+		file, line, col := splitPosn(tc.posn)
+		assert.Equal(t, tc.file, file, tc.posn)
+		assert.Equal(t, tc.line, line, tc.posn)
+		assert.Equal(t, tc.col, col, tc.posn)
+	}
+}
+
+func TestLintRelPath(t *testing.T) {
+	repo := filepath.FromSlash("/src/app")
+	rel, ok := lintRelPath(repo, filepath.FromSlash("/src/app/pkg/a.go"))
+	assert.True(t, ok)
+	assert.Equal(t, filepath.FromSlash("pkg/a.go"), rel)
+
+	_, ok = lintRelPath(repo, filepath.FromSlash("/go/pkg/mod/x.go"))
+	assert.False(t, ok, "files outside the repo are dropped")
+	_, ok = lintRelPath(repo, "")
+	assert.False(t, ok)
+}
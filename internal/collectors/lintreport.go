@@ -0,0 +1,398 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package collectors
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log/slog"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/davetashner/stringer/internal/collector"
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+// defaultLintReportDir is the report directory read when none is configured.
+const defaultLintReportDir = ".stringer/lint-reports"
+
+// lintReportExts are the file extensions read from the report directory.
+var lintReportExts = map[string]bool{".json": true, ".jsonl": true, ".sarif": true}
+
+func init() {
+	collector.Register(&LintReportCollector{})
+}
+
+// LintReportMetrics holds structured metrics from the lint report ingestion.
+type LintReportMetrics struct {
+	ReportDir     string
+	ReportsParsed int
+	Findings      int
+}
+
+// LintReportCollector ingests reports from linters stringer does not run
+// itself — ESLint, Ruff, Clippy, or anything that writes SARIF — so a
+// polyglot repo gets one backlog. It reads every .json, .jsonl, and .sarif
+// file in the report directory (.stringer/lint-reports unless configured)
+// and does nothing when the directory is absent.
+type LintReportCollector struct {
+	metrics *LintReportMetrics
+}
+
+// Name returns the collector name used for registration and filtering.
+func (c *LintReportCollector) Name() string { return "lint-report" }
+
+// Collect parses every report in the report directory and emits one signal
+// per distinct finding.
+func (c *LintReportCollector) Collect(ctx context.Context, repoPath string, opts signal.CollectorOpts) ([]signal.RawSignal, error) {
+	metrics := &LintReportMetrics{}
+	c.metrics = metrics
+
+	dir := opts.LintReportDir
+	explicit := dir != ""
+	if !explicit {
+		dir = defaultLintReportDir
+	}
+	metrics.ReportDir = filepath.ToSlash(dir)
+	root := dir
+	if !filepath.IsAbs(root) {
+		root = filepath.Join(repoPath, root)
+	}
+	if _, err := FS.Stat(root); err != nil {
+		if !explicit && errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("reading lint report directory: %w", err)
+	}
+
+	var reports int
+	var findings []lintFinding
+	err := walkTree(ctx, root, func(path string, d os.DirEntry, walkErr error) error {
+		if walkErr != nil {
+			return nil
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if d.IsDir() || !lintReportExts[strings.ToLower(filepath.Ext(path))] {
+			return nil
+		}
+		reports++
+		data, err := FS.ReadFile(path)
+		if err != nil {
+			slog.WarnContext(ctx, "lint-report: skipping unreadable report", "path", path, "error", err)
+			return nil
+		}
+		parsed, err := parseLintReport(data)
+		if err != nil {
+			slog.WarnContext(ctx, "lint-report: skipping malformed report", "path", path, "error", err)
+			return nil
+		}
+		metrics.ReportsParsed++
+		for _, f := range parsed {
+			rel, ok := reportRelPath(repoPath, f.File)
+			if !ok {
+				continue
+			}
+			f.File = rel
+			findings = append(findings, f)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if reports > 0 && metrics.ReportsParsed == 0 {
+		return nil, fmt.Errorf("no readable lint reports in %s", dir)
+	}
+
+	signals := lintSignals(repoPath, findings, opts, lintReportSignal)
+	metrics.Findings = len(signals)
+	return signals, nil
+}
+
+// Metrics returns structured metrics from the last Collect call.
+func (c *LintReportCollector) Metrics() any { return c.metrics }
+
+// eslintOrRuffResult is one element of an ESLint -f json or Ruff
+// --output-format json report. ESLint fills FilePath and Messages; Ruff
+// reports one finding per element.
+type eslintOrRuffResult struct {
+	FilePath string `json:"filePath"`
+	Messages []struct {
+		RuleID   string `json:"ruleId"`
+		Severity int    `json:"severity"` // 1 warning, 2 error
+		Message  string `json:"message"`
+		Line     int    `json:"line"`
+		Column   int    `json:"column"`
+		Fatal    bool   `json:"fatal"`
+	} `json:"messages"`
+
+	Filename string `json:"filename"`
+	Code     string `json:"code"`
+	Message  string `json:"message"`
+	Location *struct {
+		Row    int `json:"row"`
+		Column int `json:"column"`
+	} `json:"location"`
+}
+
+// reportObject is a top-level JSON object in a report: a SARIF log or one
+// line of cargo clippy --message-format=json output.
+type reportObject struct {
+	Runs []struct {
+		Tool struct {
+			Driver struct {
+				Name string `json:"name"`
+			} `json:"driver"`
+		} `json:"tool"`
+		Results []struct {
+			RuleID string `json:"ruleId"`
+			Rule   *struct {
+				ID string `json:"id"`
+			} `json:"rule"`
+			Level   string `json:"level"`
+			Message struct {
+				Text string `json:"text"`
+			} `json:"message"`
+			Locations []struct {
+				PhysicalLocation struct {
+					ArtifactLocation struct {
+						URI string `json:"uri"`
+					} `json:"artifactLocation"`
+					Region struct {
+						StartLine   int `json:"startLine"`
+						StartColumn int `json:"startColumn"`
+					} `json:"region"`
+				} `json:"physicalLocation"`
+			} `json:"locations"`
+		} `json:"results"`
+	} `json:"runs"`
+
+	Reason  string `json:"reason"`
+	Message *struct {
+		Code *struct {
+			Code string `json:"code"`
+		} `json:"code"`
+		Level   string `json:"level"`
+		Message string `json:"message"`
+		Spans   []struct {
+			FileName    string `json:"file_name"`
+			LineStart   int    `json:"line_start"`
+			ColumnStart int    `json:"column_start"`
+			IsPrimary   bool   `json:"is_primary"`
+		} `json:"spans"`
+	} `json:"message"`
+}
+
+// parseLintReport reads a SARIF log, an ESLint or Ruff JSON report, or
+// cargo clippy JSON lines, detected from the shape of each top-level value.
+// Findings keep the file path as reported; severities are normalized to
+// "error", "warning", or "note".
+func parseLintReport(data []byte) ([]lintFinding, error) {
+	var findings []lintFinding
+	dec := json.NewDecoder(bytes.NewReader(data))
+	for {
+		var raw json.RawMessage
+		if err := dec.Decode(&raw); err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+
+		switch raw[0] {
+		case '[':
+			var results []eslintOrRuffResult
+			if err := json.Unmarshal(raw, &results); err != nil {
+				return nil, fmt.Errorf("unrecognized lint report: %w", err)
+			}
+			findings = append(findings, eslintOrRuffFindings(results)...)
+		case '{':
+			var obj reportObject
+			if err := json.Unmarshal(raw, &obj); err != nil {
+				return nil, fmt.Errorf("unrecognized lint report: %w", err)
+			}
+			switch {
+			case obj.Runs != nil:
+				findings = append(findings, sarifFindings(obj)...)
+			case obj.Reason != "":
+				if f, ok := clippyFinding(obj); ok {
+					findings = append(findings, f)
+				}
+			default:
+				return nil, errors.New("unrecognized lint report: not SARIF or cargo JSON")
+			}
+		default:
+			return nil, errors.New("unrecognized lint report: expected a JSON array or object")
+		}
+	}
+	return findings, nil
+}
+
+// eslintOrRuffFindings converts the elements of an ESLint or Ruff report.
+// ESLint parse errors (fatal messages) and disabled rules are skipped; Ruff
+// has no severities, so its findings are warnings.
+func eslintOrRuffFindings(results []eslintOrRuffResult) []lintFinding {
+	var findings []lintFinding
+	for _, r := range results {
+		if r.Filename != "" && r.Code != "" {
+			f := lintFinding{Tool: "ruff", Check: r.Code, File: r.Filename, Message: r.Message, Severity: "warning"}
+			if r.Location != nil {
+				f.Line, f.Column = r.Location.Row, r.Location.Column
+			}
+			findings = append(findings, f)
+			continue
+		}
+		for _, m := range r.Messages {
+			if m.Fatal || m.RuleID == "" || m.Severity == 0 {
+				continue
+			}
+			severity := "warning"
+			if m.Severity >= 2 {
+				severity = "error"
+			}
+			findings = append(findings, lintFinding{
+				Tool: "eslint", Check: m.RuleID, File: r.FilePath,
+				Line: m.Line, Column: m.Column, Message: m.Message, Severity: severity,
+			})
+		}
+	}
+	return findings
+}
+
+// sarifFindings converts the results of a SARIF log. Logs written by
+// stringer itself are skipped so a scan never re-ingests its own output.
+func sarifFindings(obj reportObject) []lintFinding {
+	var findings []lintFinding
+	for _, run := range obj.Runs {
+		tool := strings.ToLower(strings.Join(strings.Fields(run.Tool.Driver.Name), "-"))
+		switch tool {
+		case "stringer":
+			continue
+		case "":
+			tool = "sarif"
+		}
+		for _, r := range run.Results {
+			check := r.RuleID
+			if check == "" && r.Rule != nil {
+				check = r.Rule.ID
+			}
+			if check == "" || len(r.Locations) == 0 {
+				continue
+			}
+			// SARIF's default level is warning; "none" is informational.
+			severity := r.Level
+			switch severity {
+			case "":
+				severity = "warning"
+			case "none":
+				severity = "note"
+			}
+			loc := r.Locations[0].PhysicalLocation
+			findings = append(findings, lintFinding{
+				Tool: tool, Check: check, File: sarifURIPath(loc.ArtifactLocation.URI),
+				Line: loc.Region.StartLine, Column: loc.Region.StartColumn,
+				Message: r.Message.Text, Severity: severity,
+			})
+		}
+	}
+	return findings
+}
+
+// clippyFinding converts one cargo compiler message. Build progress lines,
+// messages without a lint code (such as "aborting due to previous error"),
+// and messages with no primary span are skipped.
+func clippyFinding(obj reportObject) (lintFinding, bool) {
+	m := obj.Message
+	if obj.Reason != "compiler-message" || m == nil || m.Code == nil || m.Code.Code == "" {
+		return lintFinding{}, false
+	}
+	severity := m.Level
+	switch severity {
+	case "error", "warning":
+	case "note", "help":
+		severity = "note"
+	default:
+		return lintFinding{}, false
+	}
+	for _, span := range m.Spans {
+		if span.IsPrimary {
+			return lintFinding{
+				Tool: "clippy", Check: m.Code.Code, File: span.FileName,
+				Line: span.LineStart, Column: span.ColumnStart,
+				Message: m.Message, Severity: severity,
+			}, true
+		}
+	}
+	return lintFinding{}, false
+}
+
+// sarifURIPath turns a SARIF artifact URI, relative or file://, into a
+// file path.
+func sarifURIPath(uri string) string {
+	if strings.HasPrefix(uri, "file://") {
+		if u, err := url.Parse(uri); err == nil {
+			return u.Path
+		}
+	}
+	if path, err := url.PathUnescape(uri); err == nil {
+		return path
+	}
+	return uri
+}
+
+// reportRelPath makes a reported file path relative to the repo. Reports
+// are often produced on a CI runner with a different checkout path, so an
+// absolute path outside the repo is matched by its longest suffix that
+// exists in the repo.
+func reportRelPath(repoPath, file string) (string, bool) {
+	if rel, ok := lintRelPath(repoPath, filepath.FromSlash(file)); ok {
+		return rel, true
+	}
+	if !filepath.IsAbs(file) {
+		return "", false
+	}
+	parts := strings.Split(strings.Trim(filepath.ToSlash(file), "/"), "/")
+	for i := 1; i < len(parts); i++ {
+		candidate := filepath.Join(parts[i:]...)
+		if _, err := FS.Stat(filepath.Join(repoPath, candidate)); err == nil {
+			return candidate, true
+		}
+	}
+	return "", false
+}
+
+// lintReportConfidence maps a normalized severity to base confidence.
+var lintReportConfidence = map[string]float64{"error": 0.7, "warning": 0.5, "note": 0.3}
+
+// lintReportSignal builds the signal for one ingested finding.
+func lintReportSignal(f lintFinding, file string) signal.RawSignal {
+	confidence, ok := lintReportConfidence[f.Severity]
+	if !ok {
+		confidence = lintReportConfidence["warning"]
+	}
+	sig := signal.RawSignal{
+		Source:     "lint-report",
+		Kind:       "lint-finding",
+		FilePath:   file,
+		Line:       f.Line,
+		Title:      fmt.Sprintf("%s: %s", f.Check, f.Message),
+		Confidence: confidence,
+		Tags:       []string{"lint", f.Tool, f.Check},
+	}
+	sig.Description = fmt.Sprintf("Reported by %s (%s, %s) at %s:%d", f.Tool, f.Check, f.Severity, file, f.Line)
+	if f.Column > 0 {
+		sig.Description += fmt.Sprintf(":%d", f.Column)
+	}
+	sig.Description += "."
+	sig.AddFactor("base", confidence, fmt.Sprintf("%s severity %s", f.Tool, f.Severity))
+	return sig
+}
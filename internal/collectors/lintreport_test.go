@@ -0,0 +1,205 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package collectors
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+const eslintReport = `[
+  {"filePath": "/home/runner/work/app/app/web/src/app.js", "messages": [
+    {"ruleId": "no-unused-vars", "severity": 2, "message": "'x' is assigned a value but never used.", "line": 3, "column": 7},
+    {"ruleId": "eqeqeq", "severity": 1, "message": "Expected '===' and instead saw '=='.", "line": 9, "column": 11},
+    {"ruleId": null, "severity": 2, "fatal": true, "message": "Parsing error: Unexpected token", "line": 20, "column": 1}
+  ]},
+  {"filePath": "/home/runner/work/app/app/web/src/clean.js", "messages": []}
+]`
+
+const ruffReport = `[
+  {"code": "F401", "message": "` + "`os`" + ` imported but unused", "filename": "tools/gen.py", "location": {"row": 1, "column": 8}},
+  {"code": "E501", "message": "Line too long (120 > 88)", "filename": "/elsewhere/site-packages/x.py", "location": {"row": 4, "column": 89}}
+]`
+
+const clippyReport = `{"reason":"compiler-artifact","target":{"name":"app"}}
+{"reason":"compiler-message","message":{"code":{"code":"clippy::needless_return"},"level":"warning","message":"unneeded ` + "`return`" + ` statement","spans":[{"file_name":"src/main.rs","line_start":5,"column_start":5,"is_primary":true}]}}
+{"reason":"compiler-message","message":{"code":null,"level":"error","message":"aborting due to 1 previous error","spans":[]}}
+{"reason":"build-finished","success":false}
+`
+
+const sarifReport = `{
+  "version": "2.1.0",
+  "runs": [
+    {"tool": {"driver": {"name": "Semgrep OSS"}}, "results": [
+      {"ruleId": "python.lang.security.eval", "level": "error", "message": {"text": "Detected eval"},
+       "locations": [{"physicalLocation": {"artifactLocation": {"uri": "tools/gen%20old.py"}, "region": {"startLine": 12, "startColumn": 3}}}]},
+      {"ruleId": "python.style.todo", "level": "none", "message": {"text": "Informational"},
+       "locations": [{"physicalLocation": {"artifactLocation": {"uri": "tools/gen.py"}, "region": {"startLine": 2}}}]}
+    ]},
+    {"tool": {"driver": {"name": "stringer"}}, "results": [
+      {"ruleId": "todo", "level": "note", "message": {"text": "TODO"},
+       "locations": [{"physicalLocation": {"artifactLocation": {"uri": "tools/gen.py"}, "region": {"startLine": 1}}}]}
+    ]}
+  ]
+}`
+
+func writeLintReportRepo(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	for _, f := range []string{"web/src/app.js", "web/src/clean.js", "tools/gen.py", "tools/gen old.py", "src/main.rs"} {
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, filepath.Dir(f)), 0o750))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, f), []byte("x\n"), 0o600))
+	}
+	reports := filepath.Join(dir, defaultLintReportDir)
+	require.NoError(t, os.MkdirAll(filepath.Join(reports, "rust"), 0o750))
+	for name, data := range map[string]string{
+		"eslint.json":        eslintReport,
+		"ruff.json":          ruffReport,
+		"rust/clippy.jsonl":  clippyReport,
+		"semgrep.sarif":      sarifReport,
+		"README.md":          "not a report",
+		"eslint-again.json":  eslintReport,
+		"coverage/lcov.info": "",
+	} {
+		path := filepath.Join(reports, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o750))
+		require.NoError(t, os.WriteFile(path, []byte(data), 0o600))
+	}
+	return dir
+}
+
+func TestLintReportCollector_Name(t *testing.T) {
+	assert.Equal(t, "lint-report", (&LintReportCollector{}).Name())
+}
+
+func TestLintReportCollector_NoReportDir(t *testing.T) {
+	c := &LintReportCollector{}
+	signals, err := c.Collect(context.Background(), t.TempDir(), signal.CollectorOpts{})
+	require.NoError(t, err)
+	assert.Empty(t, signals)
+
+	_, err = c.Collect(context.Background(), t.TempDir(), signal.CollectorOpts{LintReportDir: "ci/reports"})
+	require.Error(t, err, "a configured directory must exist")
+	assert.Contains(t, err.Error(), "reading lint report directory")
+}
+
+func TestLintReportCollector_Collect(t *testing.T) {
+	dir := writeLintReportRepo(t)
+
+	c := &LintReportCollector{}
+	signals, err := c.Collect(context.Background(), dir, signal.CollectorOpts{})
+	require.NoError(t, err)
+
+	type got struct {
+		File       string
+		Line       int
+		Title      string
+		Confidence float64
+		Tags       []string
+	}
+	var all []got
+	for _, s := range signals {
+		assert.Equal(t, "lint-report", s.Source)
+		assert.Equal(t, "lint-finding", s.Kind)
+		all = append(all, got{s.FilePath, s.Line, s.Title, s.Confidence, s.Tags})
+	}
+	assert.Equal(t, []got{
+		{"src/main.rs", 5, "clippy::needless_return: unneeded `return` statement", 0.5, []string{"lint", "clippy", "clippy::needless_return"}},
+		{"tools/gen old.py", 12, "python.lang.security.eval: Detected eval", 0.7, []string{"lint", "semgrep-oss", "python.lang.security.eval"}},
+		{"tools/gen.py", 1, "F401: `os` imported but unused", 0.5, []string{"lint", "ruff", "F401"}},
+		{"tools/gen.py", 2, "python.style.todo: Informational", 0.3, []string{"lint", "semgrep-oss", "python.style.todo"}},
+		{"web/src/app.js", 3, "no-unused-vars: 'x' is assigned a value but never used.", 0.7, []string{"lint", "eslint", "no-unused-vars"}},
+		{"web/src/app.js", 9, "eqeqeq: Expected '===' and instead saw '=='.", 0.5, []string{"lint", "eslint", "eqeqeq"}},
+	}, all)
+
+	assert.Equal(t, "Reported by eslint (no-unused-vars, error) at web/src/app.js:3:7.", signals[4].Description)
+	assert.Equal(t, []signal.ConfidenceFactor{{Name: "base", Delta: 0.7, Detail: "eslint severity error"}}, signals[4].Factors)
+	assert.Equal(t, &LintReportMetrics{ReportDir: defaultLintReportDir, ReportsParsed: 5, Findings: 6}, c.Metrics())
+}
+
+func TestLintReportCollector_Filters(t *testing.T) {
+	dir := writeLintReportRepo(t)
+
+	signals, err := (&LintReportCollector{}).Collect(context.Background(), dir, signal.CollectorOpts{
+		MinConfidence:   0.6,
+		ExcludePatterns: []string{"tools/**"},
+	})
+	require.NoError(t, err)
+	require.Len(t, signals, 1)
+	assert.Equal(t, "web/src/app.js", signals[0].FilePath)
+
+	signals, err = (&LintReportCollector{}).Collect(context.Background(), dir, signal.CollectorOpts{IncludePatterns: []string{"**/*.rs"}})
+	require.NoError(t, err)
+	require.Len(t, signals, 1)
+	assert.Equal(t, "src/main.rs", signals[0].FilePath)
+}
+
+func TestLintReportCollector_ConfiguredDir(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "ci"), 0o750))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "ci", "ruff.json"), []byte(ruffReport), 0o600))
+
+	c := &LintReportCollector{}
+	signals, err := c.Collect(context.Background(), dir, signal.CollectorOpts{LintReportDir: "ci"})
+	require.NoError(t, err)
+	require.Len(t, signals, 1, "relative paths are kept; absolute paths with no match in the repo are dropped")
+	assert.Equal(t, "tools/gen.py", signals[0].FilePath)
+	assert.Equal(t, "ci", c.metrics.ReportDir)
+}
+
+func TestLintReportCollector_NoReadableReports(t *testing.T) {
+	dir := t.TempDir()
+	reports := filepath.Join(dir, defaultLintReportDir)
+	require.NoError(t, os.MkdirAll(reports, 0o750))
+	require.NoError(t, os.WriteFile(filepath.Join(reports, "bad.json"), []byte(`{"unknown": true}`), 0o600))
+
+	_, err := (&LintReportCollector{}).Collect(context.Background(), dir, signal.CollectorOpts{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no readable lint reports in .stringer/lint-reports")
+}
+
+func TestParseLintReport_Errors(t *testing.T) {
+	for _, data := range []string{`"text"`, `{"unknown": 1}`, `[1, 2]`, `{"runs": [`} {
+		_, err := parseLintReport([]byte(data))
+		assert.Error(t, err, data)
+	}
+	findings, err := parseLintReport([]byte("[]"))
+	require.NoError(t, err)
+	assert.Empty(t, findings)
+}
+
+func TestReportRelPath(t *testing.T) {
+	repo := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(repo, "pkg"), 0o750))
+	require.NoError(t, os.WriteFile(filepath.Join(repo, "pkg", "a.go"), []byte("x"), 0o600))
+
+	for _, tc := range []struct {
+		file string
+		want string
+		ok   bool
+	}{
+		{"pkg/a.go", "pkg/a.go", true},
+		{filepath.Join(repo, "pkg", "a.go"), "pkg/a.go", true},
+		{"/ci/work/checkout/pkg/a.go", "pkg/a.go", true},
+		{"/ci/work/checkout/pkg/b.go", "", false},
+		{"../outside.go", "", false},
+	} {
+		got, ok := reportRelPath(repo, tc.file)
+		assert.Equal(t, tc.ok, ok, tc.file)
+		assert.Equal(t, filepath.FromSlash(tc.want), got, tc.file)
+	}
+}
+
+func TestSARIFURIPath(t *testing.T) {
+	assert.Equal(t, "/src/app/a b.go", sarifURIPath("file:///src/app/a%20b.go"))
+	assert.Equal(t, "pkg/a b.go", sarifURIPath("pkg/a%20b.go"))
+	assert.Equal(t, "pkg/100%.go", sarifURIPath("pkg/100%.go"))
+}
@@ -17,8 +17,11 @@ import (
 
 	"golang.org/x/sync/errgroup"
 
+	"github.com/davetashner/stringer/internal/blameindex"
 	"github.com/davetashner/stringer/internal/collector"
 	"github.com/davetashner/stringer/internal/gitcli"
+	"github.com/davetashner/stringer/internal/gradle"
+	"github.com/davetashner/stringer/internal/identity"
 	"github.com/davetashner/stringer/internal/signal"
 )
 
@@ -70,9 +73,15 @@ type LotteryRiskMetrics struct {
 // DirectoryOwnership describes ownership distribution for a single directory.
 type DirectoryOwnership struct {
 	Path        string
+	Module      string // Gradle project path when Path is a Gradle module
 	LotteryRisk int
 	Authors     []AuthorShare
 	TotalLines  int
+
+	// Reviewers lists review participation from recently merged PRs that
+	// touched the directory, most active first. Empty when GitHub review
+	// data is unavailable.
+	Reviewers []ReviewerShare
 }
 
 // AuthorShare describes a single author's ownership share of a directory.
@@ -81,6 +90,12 @@ type AuthorShare struct {
 	Ownership float64
 }
 
+// ReviewerShare describes a single reviewer's participation in a directory.
+type ReviewerShare struct {
+	Login   string
+	Reviews int // approving or change-requesting reviews
+}
+
 // LotteryRiskCollector analyzes git blame and commit history to identify
 // directories with low lottery risk (single-author ownership risk).
 type LotteryRiskCollector struct {
@@ -94,20 +109,33 @@ type LotteryRiskCollector struct {
 // authorStats tracks per-author contribution metrics within a directory.
 type authorStats struct {
 	BlameLines   int
+	TestLines    int // subset of BlameLines that fall in test files
 	CommitWeight float64
 }
 
 // dirOwnership holds aggregated ownership data for a single directory.
 type dirOwnership struct {
 	Path        string
+	Module      string // Gradle project path, e.g. ":core:data"
 	Authors     map[string]*authorStats
 	TotalLines  int
+	TestLines   int // subset of TotalLines that fall in test files
 	LotteryRisk int
 }
 
 // Name returns the collector name used for registration and filtering.
 func (c *LotteryRiskCollector) Name() string { return "lotteryrisk" }
 
+// CheckCapabilities reports a skip reason when there is no git history to
+// blame.
+func (c *LotteryRiskCollector) CheckCapabilities(_ context.Context, repoPath string, opts signal.CollectorOpts) string {
+	gitRoot := repoPath
+	if opts.GitRoot != "" {
+		gitRoot = opts.GitRoot
+	}
+	return gitHistoryReason(nil, gitRoot)
+}
+
 // Collect scans the repository at repoPath for directories with low bus
 // factor and returns them as raw signals.
 func (c *LotteryRiskCollector) Collect(ctx context.Context, repoPath string, opts signal.CollectorOpts) ([]signal.RawSignal, error) {
@@ -125,11 +153,18 @@ func (c *LotteryRiskCollector) Collect(ctx context.Context, repoPath string, opt
 	excludes := mergeExcludes(opts.ExcludePatterns)
 
 	// Discover directories up to the configured depth.
-	dirs, err := discoverDirectories(ctx, repoPath, defaultDirectoryDepth, excludes, opts.IncludeDemoPaths)
+	dirs, err := discoverDirectories(ctx, repoPath, defaultDirectoryDepth, excludes, opts)
 	if err != nil {
 		return nil, fmt.Errorf("discovering directories: %w", err)
 	}
 
+	// Gradle modules are the ownership units of a multi-project build.
+	modules, modErr := gradle.Modules(repoPath)
+	if modErr != nil {
+		slog.WarnContext(ctx, "lotteryrisk: failed to read Gradle settings", "error", modErr)
+	}
+	dirs, moduleNames := applyGradleModules(dirs, modules, excludes, opts.IncludeDemoPaths, opts.Scope)
+
 	// Build per-directory ownership from blame.
 	ownership := make(map[string]*dirOwnership)
 	for _, dir := range dirs {
@@ -138,24 +173,36 @@ func (c *LotteryRiskCollector) Collect(ctx context.Context, repoPath string, opt
 		}
 		ownership[dir] = &dirOwnership{
 			Path:    dir,
+			Module:  moduleNames[dir],
 			Authors: make(map[string]*authorStats),
 		}
 	}
 
+	// Consolidate author identities via .mailmap and configured identities so
+	// one person with several emails isn't counted as several owners.
+	ids, idErr := identity.Load(gitRoot, opts.Identities)
+	if idErr != nil {
+		slog.WarnContext(ctx, "lotteryrisk: failed to load author identities", "error", idErr)
+	}
+
 	// Blame source files and attribute lines to directories.
-	if err := blameDirectories(ctx, gitRoot, repoPath, ownership, defaultMaxBlameFiles, excludes, opts); err != nil {
+	if err := blameDirectories(ctx, gitRoot, repoPath, ownership, defaultMaxBlameFiles, excludes, ids, opts); err != nil {
 		return nil, fmt.Errorf("blaming files: %w", err)
 	}
 
 	// Walk commits and attribute weighted commit activity to directories.
-	if err := walkCommitsForOwnership(ctx, gitRoot, ownership, opts); err != nil {
+	if err := walkCommitsForOwnership(ctx, gitRoot, ownership, ids, opts); err != nil {
 		return nil, fmt.Errorf("walking commits for ownership: %w", err)
 	}
 
 	// Resolve anonymization mode.
 	ghCtx := c.ghCtx
 	if ghCtx == nil {
-		ghCtx = newGitHubContext(repoPath)
+		ghCtx = newGitHubContext(ctx, repoPath)
+	}
+	if ghCtx != nil {
+		// Share fetched PRs and files with the github collector.
+		ghCtx = ghCtx.shared(ctx)
 	}
 	var anon *nameAnonymizer
 	if resolveAnonymize(ctx, ghCtx, opts.Anonymize) {
@@ -185,6 +232,10 @@ func (c *LotteryRiskCollector) Collect(ctx context.Context, repoPath string, opt
 			sig := buildLotteryRiskSignal(own, anon)
 			signals = append(signals, sig)
 		}
+
+		if sig, ok := buildKnowledgeSplitSignal(own, anon); ok {
+			signals = append(signals, sig)
+		}
 	}
 
 	c.metrics = &LotteryRiskMetrics{Directories: metricsDirectories}
@@ -193,8 +244,9 @@ func (c *LotteryRiskCollector) Collect(ctx context.Context, repoPath string, opt
 	if ghCtx != nil {
 		reviewData, reviewErr := fetchReviewParticipation(ctx, ghCtx, ownership, maxReviewPRs)
 		if reviewErr != nil {
-			slog.Warn("review participation analysis failed, continuing without it", "error", reviewErr)
+			slog.WarnContext(ctx, "review participation analysis failed, continuing without it", "error", reviewErr)
 		} else {
+			attachReviewers(c.metrics, reviewData)
 			reviewSignals := buildReviewConcentrationSignals(reviewData, anon)
 			signals = append(signals, reviewSignals...)
 		}
@@ -213,12 +265,16 @@ func (c *LotteryRiskCollector) Collect(ctx context.Context, repoPath string, opt
 
 // discoverDirectories walks the repo and returns unique directory paths
 // up to the given depth (relative to repoPath). The root directory "." is
-// included. Directories matching excludes or demo patterns are skipped.
-func discoverDirectories(ctx context.Context, repoPath string, maxDepth int, excludes []string, includeDemoPaths bool) ([]string, error) {
+// included when in scope. Directories matching excludes or demo patterns,
+// ignored by git, or outside scope, are skipped.
+func discoverDirectories(ctx context.Context, repoPath string, maxDepth int, excludes []string, opts signal.CollectorOpts) ([]string, error) {
+	includeDemoPaths, scope := opts.IncludeDemoPaths, opts.Scope
 	dirSet := make(map[string]bool)
-	dirSet["."] = true
+	if scope.Contains(".") {
+		dirSet["."] = true
+	}
 
-	err := filepath.WalkDir(repoPath, func(path string, d os.DirEntry, walkErr error) error {
+	err := walkSource(ctx, repoPath, opts, func(path string, d os.DirEntry, walkErr error) error {
 		if walkErr != nil {
 			return nil // skip unreadable entries
 		}
@@ -256,11 +312,14 @@ func discoverDirectories(ctx context.Context, repoPath string, maxDepth int, exc
 			depth++ // "internal" is depth 1, "internal/collectors" is depth 2
 		}
 
-		if depth > maxDepth {
+		if depth > maxDepth || !scope.ContainsDir(relPath) {
 			return filepath.SkipDir
 		}
 
-		dirSet[relPath] = true
+		// Ancestors of the scope are walked through but not reported.
+		if scope.Contains(relPath) {
+			dirSet[relPath] = true
+		}
 		return nil
 	})
 	if err != nil {
@@ -275,6 +334,47 @@ func discoverDirectories(ctx context.Context, repoPath string, maxDepth int, exc
 	return dirs, nil
 }
 
+// applyGradleModules replaces the directories inside Gradle modules with
+// the module directories, so ownership is reported per module. Directories
+// outside every module are kept. It returns the directories and the
+// module name of each module directory.
+func applyGradleModules(dirs []string, modules []gradle.Module, excludes []string, includeDemoPaths bool, scope signal.Scope) ([]string, map[string]string) {
+	names := make(map[string]string)
+	var kept []gradle.Module
+	for _, m := range modules {
+		dir := filepath.FromSlash(m.Dir)
+		if shouldExclude(dir, excludes) || (!includeDemoPaths && isDemoPath(dir)) || !scope.Contains(dir) {
+			continue
+		}
+		kept = append(kept, m)
+		names[dir] = m.Name
+	}
+	if len(kept) == 0 {
+		return dirs, names
+	}
+
+	out := make([]string, 0, len(dirs)+len(kept))
+	for _, d := range dirs {
+		if _, inModule := gradle.Containing(kept, d); !inModule {
+			out = append(out, d)
+		}
+	}
+	for dir := range names {
+		out = append(out, dir)
+	}
+	sort.Strings(out)
+	return out, names
+}
+
+// label names the directory in signal titles: its Gradle module if it is
+// one, otherwise its path.
+func (o *dirOwnership) label() string {
+	if o.Module != "" {
+		return "module " + o.Module
+	}
+	return o.Path
+}
+
 // blameFile holds a file to be blamed and its owning directory.
 type blameFile struct {
 	relPath   string
@@ -284,12 +384,12 @@ type blameFile struct {
 // blameDirectories blames source files and attributes line counts to their
 // containing directories. It caps blame at maxFiles per directory.
 // Uses native git CLI for blame (DR-011) with parallel workers for performance.
-func blameDirectories(ctx context.Context, gitDir string, repoPath string, ownership map[string]*dirOwnership, maxFiles int, excludes []string, opts signal.CollectorOpts) error {
+func blameDirectories(ctx context.Context, gitDir string, repoPath string, ownership map[string]*dirOwnership, maxFiles int, excludes []string, ids *identity.Map, opts signal.CollectorOpts) error {
 	// Phase 1: Walk the filesystem to collect files to blame.
 	dirFileCount := make(map[string]int)
 	var files []blameFile
 
-	err := filepath.WalkDir(repoPath, func(path string, d os.DirEntry, walkErr error) error {
+	err := walkSource(ctx, repoPath, opts, func(path string, d os.DirEntry, walkErr error) error {
 		if walkErr != nil {
 			return nil
 		}
@@ -312,6 +412,9 @@ func blameDirectories(ctx context.Context, gitDir string, repoPath string, owner
 			if !opts.IncludeDemoPaths && isDemoPath(relPath) {
 				return filepath.SkipDir
 			}
+			if !opts.Scope.ContainsDir(relPath) {
+				return filepath.SkipDir
+			}
 			return nil
 		}
 
@@ -320,7 +423,7 @@ func blameDirectories(ctx context.Context, gitDir string, repoPath string, owner
 			return nil
 		}
 
-		if isBinaryFile(path) {
+		if !opts.Scope.Contains(relPath) {
 			return nil
 		}
 
@@ -329,6 +432,10 @@ func blameDirectories(ctx context.Context, gitDir string, repoPath string, owner
 			return nil
 		}
 
+		if isBinaryFile(path) {
+			return nil
+		}
+
 		dir := findOwningDir(relPath, ownership)
 		if dir == "" {
 			return nil
@@ -346,7 +453,9 @@ func blameDirectories(ctx context.Context, gitDir string, repoPath string, owner
 		return err
 	}
 
-	// Phase 2: Blame files in parallel.
+	// Phase 2: Blame files in parallel, serving from the precomputed blame
+	// index where possible.
+	idx := blameindex.Open(ctx, gitDir)
 	var mu sync.Mutex
 	var blamed int64
 
@@ -356,26 +465,39 @@ func blameDirectories(ctx context.Context, gitDir string, repoPath string, owner
 	for _, f := range files {
 		f := f // capture
 		g.Go(func() error {
-			blameCtx, cancel := context.WithTimeout(gctx, gitcli.DefaultTimeout)
-			blameResult, blameErr := gitcli.BlameFile(blameCtx, gitDir, filepath.ToSlash(f.relPath))
-			cancel()
-			if blameErr != nil {
-				return nil // skip files that can't be blamed
+			var blameResult []gitcli.BlameLine
+			if gitRel, relErr := filepath.Rel(gitDir, filepath.Join(repoPath, f.relPath)); relErr == nil {
+				blameResult, _ = idx.File(gitRel)
+			}
+			if blameResult == nil {
+				blameCtx, cancel := context.WithTimeout(gctx, gitcli.DefaultTimeout)
+				var blameErr error
+				blameResult, blameErr = gitcli.BlameFile(blameCtx, gitDir, filepath.ToSlash(f.relPath))
+				cancel()
+				if blameErr != nil {
+					return nil // skip files that can't be blamed
+				}
 			}
 
+			isTest := isTestFile(f.relPath)
+
 			mu.Lock()
 			own := ownership[f.owningDir]
 			for _, bl := range blameResult {
-				author := bl.AuthorName
-				if author == "" {
+				if bl.AuthorName == "" {
 					continue
 				}
+				author := ids.Resolve(bl.AuthorName, bl.AuthorEmail)
 
 				if own.Authors[author] == nil {
 					own.Authors[author] = &authorStats{}
 				}
 				own.Authors[author].BlameLines++
 				own.TotalLines++
+				if isTest {
+					own.Authors[author].TestLines++
+					own.TestLines++
+				}
 			}
 			blamed++
 			if opts.ProgressFunc != nil && blamed%50 == 0 {
@@ -393,7 +515,7 @@ func blameDirectories(ctx context.Context, gitDir string, repoPath string, owner
 // walkCommitsForOwnership runs `git log --numstat` and applies recency-weighted
 // attribution to directories based on changed files. This replaced the earlier
 // go-git tree-diff approach for performance (DR-011).
-func walkCommitsForOwnership(ctx context.Context, gitDir string, ownership map[string]*dirOwnership, opts signal.CollectorOpts) error {
+func walkCommitsForOwnership(ctx context.Context, gitDir string, ownership map[string]*dirOwnership, ids *identity.Map, opts signal.CollectorOpts) error {
 	maxWalk := maxCommitWalk
 	if opts.GitDepth > 0 {
 		maxWalk = opts.GitDepth
@@ -416,7 +538,7 @@ func walkCommitsForOwnership(ctx context.Context, gitDir string, ownership map[s
 			strings.Contains(errMsg, "bad default revision") ||
 			strings.Contains(errMsg, "object not found") ||
 			strings.Contains(errMsg, "exit status 128") {
-			slog.Warn("lottery risk: limited git history detected, ownership data may be incomplete (shallow clone?)", "error", err)
+			slog.WarnContext(ctx, "lottery risk: limited git history detected, ownership data may be incomplete (shallow clone?)", "error", err)
 			return nil
 		}
 		return fmt.Errorf("git log --numstat: %w", err)
@@ -433,10 +555,10 @@ func walkCommitsForOwnership(ctx context.Context, gitDir string, ownership map[s
 			opts.ProgressFunc(fmt.Sprintf("lotteryrisk: examined %d commits", i+1))
 		}
 
-		author := c.Author
-		if author == "" {
+		if c.Author == "" {
 			continue
 		}
+		author := ids.Resolve(c.Author, c.AuthorEmail)
 
 		daysOld := now.Sub(c.AuthorTime).Hours() / 24
 		weight := recencyDecay(daysOld)
@@ -503,3 +625,4 @@ func (c *LotteryRiskCollector) Metrics() any { return c.metrics }
 // Compile-time interface checks.
 var _ collector.Collector = (*LotteryRiskCollector)(nil)
 var _ collector.MetricsProvider = (*LotteryRiskCollector)(nil)
+var _ collector.CapabilityChecker = (*LotteryRiskCollector)(nil)
@@ -0,0 +1,111 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package collectors
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+// knowledgeSplitMinLines is the minimum number of blamed lines required on
+// both the production and the test side of a directory before a knowledge
+// split is considered. Small directories produce noisy ratios.
+const knowledgeSplitMinLines = 50
+
+// knowledgeSplitDominance is the share of one side (tests or production
+// code) a single author must hold to be considered its de facto owner.
+const knowledgeSplitDominance = 0.8
+
+// knowledgeSplitOverlap is the maximum share of the other side the dominant
+// author may hold for the split to be flagged.
+const knowledgeSplitOverlap = 0.1
+
+// buildKnowledgeSplitSignal detects directories where tests and production
+// code are owned by different people: one author wrote nearly all of the
+// tests but almost none of the code under test, or vice versa. Such splits
+// mean the person who understands the behavior and the person who encodes
+// its expectations never overlap. If anon is non-nil, author names are
+// anonymized.
+func buildKnowledgeSplitSignal(own *dirOwnership, anon *nameAnonymizer) (signal.RawSignal, bool) {
+	testTotal := own.TestLines
+	prodTotal := own.TotalLines - own.TestLines
+	if testTotal < knowledgeSplitMinLines || prodTotal < knowledgeSplitMinLines {
+		return signal.RawSignal{}, false
+	}
+
+	testLines := func(s *authorStats) int { return s.TestLines }
+	prodLines := func(s *authorStats) int { return s.BlameLines - s.TestLines }
+
+	// Tests written almost exclusively by someone who doesn't author the code.
+	if author, share := dominantAuthor(own, testLines, testTotal); share >= knowledgeSplitDominance {
+		other := float64(prodLines(own.Authors[author])) / float64(prodTotal)
+		if other <= knowledgeSplitOverlap {
+			return knowledgeSplitSignal(own, displayAuthor(author, anon), "tests", share, "production code", other), true
+		}
+	}
+
+	// Code written almost exclusively by someone who doesn't write its tests.
+	if author, share := dominantAuthor(own, prodLines, prodTotal); share >= knowledgeSplitDominance {
+		other := float64(testLines(own.Authors[author])) / float64(testTotal)
+		if other <= knowledgeSplitOverlap {
+			return knowledgeSplitSignal(own, displayAuthor(author, anon), "production code", share, "tests", other), true
+		}
+	}
+
+	return signal.RawSignal{}, false
+}
+
+// dominantAuthor returns the author with the most lines according to lines,
+// and their share of total. Ties are broken by name for determinism.
+func dominantAuthor(own *dirOwnership, lines func(*authorStats) int, total int) (string, float64) {
+	names := make([]string, 0, len(own.Authors))
+	for name := range own.Authors {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var best string
+	bestLines := 0
+	for _, name := range names {
+		if n := lines(own.Authors[name]); n > bestLines {
+			best, bestLines = name, n
+		}
+	}
+	if best == "" || total == 0 {
+		return "", 0
+	}
+	return best, float64(bestLines) / float64(total)
+}
+
+// displayAuthor returns name, anonymized if anon is non-nil.
+func displayAuthor(name string, anon *nameAnonymizer) string {
+	if anon != nil {
+		return anon.anonymize(name)
+	}
+	return name
+}
+
+// knowledgeSplitSignal constructs the knowledge-split RawSignal for a
+// directory where author owns ownedShare of the owned side but only
+// otherShare of the other side.
+func knowledgeSplitSignal(own *dirOwnership, author, owned string, ownedShare float64, other string, otherShare float64) signal.RawSignal {
+	return signal.RawSignal{
+		Source:   "lotteryrisk",
+		Kind:     "knowledge-split",
+		FilePath: own.Path,
+		Line:     0,
+		Title: fmt.Sprintf("Knowledge split: %s wrote %.0f%% of %s in %s but only %.0f%% of %s",
+			author, ownedShare*100, owned, own.label(), otherShare*100, other),
+		Description: fmt.Sprintf("%s authored %.0f%% of the %s lines in %s but only %.0f%% of the %s. "+
+			"The people who understand the implementation and the people who encode its expected behavior do not overlap, "+
+			"so changes on one side are easily missed on the other. Consider pairing on tests or rotating ownership.\n"+
+			"Production lines: %d\nTest lines: %d",
+			author, ownedShare*100, owned, own.label(), otherShare*100, other,
+			own.TotalLines-own.TestLines, own.TestLines),
+		Confidence: 0.5,
+		Tags:       []string{"knowledge-split"},
+	}
+}
@@ -98,6 +98,7 @@ func buildDirectoryOwnership(own *dirOwnership) DirectoryOwnership {
 
 	return DirectoryOwnership{
 		Path:        own.Path,
+		Module:      own.Module,
 		LotteryRisk: own.LotteryRisk,
 		Authors:     authors,
 		TotalLines:  own.TotalLines,
@@ -154,7 +155,7 @@ func buildLotteryRiskSignal(own *dirOwnership, anon *nameAnonymizer) signal.RawS
 		Kind:        "low-lottery-risk",
 		FilePath:    own.Path,
 		Line:        0,
-		Title:       fmt.Sprintf("%s: %s (lottery risk %d, primary: %s %.0f%%)", lotteryRiskLabel(own.LotteryRisk), own.Path, own.LotteryRisk, primary.Name, primary.Pct),
+		Title:       fmt.Sprintf("%s: %s (lottery risk %d, primary: %s %.0f%%)", lotteryRiskLabel(own.LotteryRisk), own.label(), own.LotteryRisk, primary.Name, primary.Pct),
 		Description: strings.Join(descParts, "\n"),
 		Confidence:  confidence,
 		Tags:        []string{"low-lottery-risk"},
@@ -233,12 +234,9 @@ func resolveAnonymize(ctx context.Context, ghCtx *githubContext, mode string) bo
 		if ghCtx == nil {
 			return false // no API available, default to not anonymizing
 		}
-		repo, _, err := ghCtx.API.GetRepository(ctx, ghCtx.Owner, ghCtx.Repo)
-		if err != nil || repo == nil {
-			return false // can't determine visibility, default to not anonymizing
-		}
-		// Public repos -> anonymize; private repos -> don't.
-		return !repo.GetPrivate()
+		// Public repos -> anonymize; private repos -> don't. If visibility
+		// can't be determined, default to not anonymizing.
+		return ghCtx.isPublic(ctx)
 	default:
 		return false
 	}
@@ -4,11 +4,8 @@
 package collectors
 
 import (
-	"context"
 	"fmt"
-	"strings"
-
-	"github.com/google/go-github/v68/github"
+	"sort"
 
 	"github.com/davetashner/stringer/internal/signal"
 )
@@ -19,95 +16,6 @@ type reviewParticipation struct {
 	Authors   map[string]int // PR author login -> PR count
 }
 
-// fetchReviewParticipation fetches merged PRs and their reviews from GitHub,
-// then maps review activity to directories based on changed files.
-func fetchReviewParticipation(ctx context.Context, ghCtx *githubContext, ownership map[string]*dirOwnership, maxPRs int) (map[string]*reviewParticipation, error) {
-	result := make(map[string]*reviewParticipation)
-
-	// Fetch recently merged PRs.
-	opts := &github.PullRequestListOptions{
-		State:     "closed",
-		Sort:      "updated",
-		Direction: "desc",
-		ListOptions: github.ListOptions{
-			PerPage: 100,
-		},
-	}
-
-	fetched := 0
-	for {
-		if err := ctx.Err(); err != nil {
-			return nil, err
-		}
-
-		prs, resp, err := ghCtx.API.ListPullRequests(ctx, ghCtx.Owner, ghCtx.Repo, opts)
-		if err != nil {
-			return nil, fmt.Errorf("listing merged PRs for review analysis: %w", err)
-		}
-
-		for _, pr := range prs {
-			if !pr.GetMerged() {
-				continue
-			}
-			if fetched >= maxPRs {
-				return result, nil
-			}
-			fetched++
-
-			if err := ctx.Err(); err != nil {
-				return nil, err
-			}
-
-			// Fetch reviews for this PR.
-			reviews, reviewErr := fetchAllReviews(ctx, ghCtx.API, ghCtx.Owner, ghCtx.Repo, pr.GetNumber())
-			if reviewErr != nil {
-				continue // skip PRs with review fetch errors
-			}
-
-			// Fetch files changed in this PR.
-			files, _, filesErr := ghCtx.API.ListPullRequestFiles(ctx, ghCtx.Owner, ghCtx.Repo, pr.GetNumber(), &github.ListOptions{PerPage: 100})
-			if filesErr != nil {
-				continue // skip PRs with file fetch errors
-			}
-
-			// Determine which directories this PR touches.
-			touchedDirs := make(map[string]bool)
-			for _, f := range files {
-				dir := findOwningDir(f.GetFilename(), ownership)
-				if dir != "" {
-					touchedDirs[dir] = true
-				}
-			}
-
-			// Attribute reviews and authorship to directories.
-			for dir := range touchedDirs {
-				if result[dir] == nil {
-					result[dir] = &reviewParticipation{
-						Reviewers: make(map[string]int),
-						Authors:   make(map[string]int),
-					}
-				}
-
-				result[dir].Authors[pr.GetUser().GetLogin()]++
-
-				for _, review := range reviews {
-					state := strings.ToUpper(review.GetState())
-					if state == "APPROVED" || state == "CHANGES_REQUESTED" {
-						result[dir].Reviewers[review.GetUser().GetLogin()]++
-					}
-				}
-			}
-		}
-
-		if resp.NextPage == 0 {
-			break
-		}
-		opts.Page = resp.NextPage
-	}
-
-	return result, nil
-}
-
 // buildReviewConcentrationSignals produces signals for directories where a
 // single reviewer handles more than 70% of all reviews.
 // If anon is non-nil, reviewer names are anonymized.
@@ -147,3 +55,31 @@ func buildReviewConcentrationSignals(reviewData map[string]*reviewParticipation,
 
 	return signals
 }
+
+// attachReviewers records review participation on the matching directory
+// metrics so consumers such as review routing can rank reviewers.
+func attachReviewers(metrics *LotteryRiskMetrics, reviewData map[string]*reviewParticipation) {
+	if metrics == nil {
+		return
+	}
+	for i := range metrics.Directories {
+		rp := reviewData[metrics.Directories[i].Path]
+		if rp == nil {
+			continue
+		}
+		var reviewers []ReviewerShare
+		for login, count := range rp.Reviewers {
+			if login == "" {
+				continue
+			}
+			reviewers = append(reviewers, ReviewerShare{Login: login, Reviews: count})
+		}
+		sort.Slice(reviewers, func(a, b int) bool {
+			if reviewers[a].Reviews != reviewers[b].Reviews {
+				return reviewers[a].Reviews > reviewers[b].Reviews
+			}
+			return reviewers[a].Login < reviewers[b].Login
+		})
+		metrics.Directories[i].Reviewers = reviewers
+	}
+}
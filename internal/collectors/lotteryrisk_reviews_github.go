@@ -0,0 +1,103 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+//go:build !nonetwork
+
+package collectors
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/go-github/v68/github"
+)
+
+// fetchReviewParticipation fetches merged PRs and their reviews from GitHub,
+// then maps review activity to directories based on changed files.
+func fetchReviewParticipation(ctx context.Context, ghCtx *githubContext, ownership map[string]*dirOwnership, maxPRs int) (map[string]*reviewParticipation, error) {
+	result := make(map[string]*reviewParticipation)
+
+	// Fetch recently merged PRs.
+	opts := &github.PullRequestListOptions{
+		State:     "closed",
+		Sort:      "updated",
+		Direction: "desc",
+		ListOptions: github.ListOptions{
+			PerPage: 100,
+		},
+	}
+
+	fetched := 0
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		prs, resp, err := ghCtx.API.ListPullRequests(ctx, ghCtx.Owner, ghCtx.Repo, opts)
+		if err != nil {
+			return nil, fmt.Errorf("listing merged PRs for review analysis: %w", err)
+		}
+
+		for _, pr := range prs {
+			if !pr.GetMerged() {
+				continue
+			}
+			if fetched >= maxPRs {
+				return result, nil
+			}
+			fetched++
+
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+
+			// Fetch reviews for this PR.
+			reviews, reviewErr := fetchAllReviews(ctx, ghCtx.API, ghCtx.Owner, ghCtx.Repo, pr.GetNumber())
+			if reviewErr != nil {
+				continue // skip PRs with review fetch errors
+			}
+
+			// Fetch files changed in this PR.
+			files, _, filesErr := ghCtx.API.ListPullRequestFiles(ctx, ghCtx.Owner, ghCtx.Repo, pr.GetNumber(), &github.ListOptions{PerPage: 100})
+			if filesErr != nil {
+				continue // skip PRs with file fetch errors
+			}
+
+			// Determine which directories this PR touches.
+			touchedDirs := make(map[string]bool)
+			for _, f := range files {
+				dir := findOwningDir(f.GetFilename(), ownership)
+				if dir != "" {
+					touchedDirs[dir] = true
+				}
+			}
+
+			// Attribute reviews and authorship to directories.
+			for dir := range touchedDirs {
+				if result[dir] == nil {
+					result[dir] = &reviewParticipation{
+						Reviewers: make(map[string]int),
+						Authors:   make(map[string]int),
+					}
+				}
+
+				result[dir].Authors[pr.GetUser().GetLogin()]++
+
+				for _, review := range reviews {
+					state := strings.ToUpper(review.GetState())
+					if state == "APPROVED" || state == "CHANGES_REQUESTED" {
+						result[dir].Reviewers[review.GetUser().GetLogin()]++
+					}
+				}
+			}
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	return result, nil
+}
@@ -0,0 +1,433 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+//go:build !nonetwork
+
+package collectors
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v68/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+// reviewMockAPI implements githubAPI for lottery risk review tests.
+type reviewMockAPI struct {
+	prs     []*github.PullRequest
+	reviews map[int][]*github.PullRequestReview
+	files   map[int][]*github.CommitFile
+	repo    *github.Repository
+}
+
+func (m *reviewMockAPI) ListIssues(_ context.Context, _, _ string, _ *github.IssueListByRepoOptions) ([]*github.Issue, *github.Response, error) {
+	return nil, lrEmptyResponse(), nil
+}
+
+func (m *reviewMockAPI) ListPullRequests(_ context.Context, _, _ string, _ *github.PullRequestListOptions) ([]*github.PullRequest, *github.Response, error) {
+	return m.prs, lrEmptyResponse(), nil
+}
+
+func (m *reviewMockAPI) ListReviews(_ context.Context, _, _ string, number int, _ *github.ListOptions) ([]*github.PullRequestReview, *github.Response, error) {
+	return m.reviews[number], lrEmptyResponse(), nil
+}
+
+func (m *reviewMockAPI) ListReviewComments(_ context.Context, _, _ string, _ int, _ *github.PullRequestListCommentsOptions) ([]*github.PullRequestComment, *github.Response, error) {
+	return nil, lrEmptyResponse(), nil
+}
+
+func (m *reviewMockAPI) ListPullRequestFiles(_ context.Context, _, _ string, number int, _ *github.ListOptions) ([]*github.CommitFile, *github.Response, error) {
+	return m.files[number], lrEmptyResponse(), nil
+}
+
+func (m *reviewMockAPI) GetRepository(_ context.Context, _, _ string) (*github.Repository, *github.Response, error) {
+	if m.repo != nil {
+		return m.repo, lrEmptyResponse(), nil
+	}
+	return nil, lrEmptyResponse(), nil
+}
+
+func lrEmptyResponse() *github.Response {
+	return &github.Response{
+		Response: &http.Response{StatusCode: http.StatusOK},
+	}
+}
+
+func TestLotteryRiskCollector_ReviewConcentration(t *testing.T) {
+	t.Setenv("GITHUB_TOKEN", "test-token")
+
+	_, dir := initGoGitRepo(t, map[string]string{
+		"main.go":     "package main\n\nfunc main() {}\n",
+		"lib/util.go": "package lib\n\nfunc Util() {}\n",
+	})
+
+	now := time.Now()
+	mock := &reviewMockAPI{
+		prs: []*github.PullRequest{
+			makeMergedPR(1, "PR 1", now),
+			makeMergedPR(2, "PR 2", now),
+			makeMergedPR(3, "PR 3", now),
+			makeMergedPR(4, "PR 4", now),
+		},
+		reviews: map[int][]*github.PullRequestReview{
+			1: {makeReview("APPROVED")},
+			2: {makeReview("APPROVED")},
+			3: {makeReview("APPROVED")},
+			4: {{State: github.Ptr("APPROVED"), User: &github.User{Login: github.Ptr("other")}}},
+		},
+		files: map[int][]*github.CommitFile{
+			1: {{Filename: github.Ptr("main.go")}},
+			2: {{Filename: github.Ptr("main.go")}},
+			3: {{Filename: github.Ptr("main.go")}},
+			4: {{Filename: github.Ptr("main.go")}},
+		},
+	}
+
+	// Default review mock user is nil, so reviews from makeReview have nil user.
+	// Let's fix: makeReview doesn't set User, so GetUser().GetLogin() returns "".
+	// We need reviews with actual users. Override the reviews.
+	reviewer1 := &github.User{Login: github.Ptr("alice")}
+	reviewer2 := &github.User{Login: github.Ptr("bob")}
+	mock.reviews = map[int][]*github.PullRequestReview{
+		1: {{State: github.Ptr("APPROVED"), User: reviewer1}},
+		2: {{State: github.Ptr("APPROVED"), User: reviewer1}},
+		3: {{State: github.Ptr("APPROVED"), User: reviewer1}},
+		4: {{State: github.Ptr("APPROVED"), User: reviewer2}},
+	}
+
+	ghCtx := &githubContext{Owner: "testowner", Repo: "testrepo", API: mock}
+	c := &LotteryRiskCollector{ghCtx: ghCtx}
+	signals, err := c.Collect(context.Background(), dir, signal.CollectorOpts{})
+	require.NoError(t, err)
+
+	reviewSigs := filterByKind(signals, "review-concentration")
+	require.NotEmpty(t, reviewSigs, "should produce review-concentration signals when one reviewer dominates")
+
+	for _, sig := range reviewSigs {
+		assert.Equal(t, "lotteryrisk", sig.Source)
+		assert.Contains(t, sig.Title, "alice")
+		assert.InDelta(t, 0.6, sig.Confidence, 0.001)
+		assert.Contains(t, sig.Tags, "review-concentration")
+	}
+
+	// Review participation is also exposed in metrics.
+	metrics, ok := c.Metrics().(*LotteryRiskMetrics)
+	require.True(t, ok)
+	var root *DirectoryOwnership
+	for i := range metrics.Directories {
+		if metrics.Directories[i].Path == "." {
+			root = &metrics.Directories[i]
+		}
+	}
+	require.NotNil(t, root)
+	assert.Equal(t, []ReviewerShare{{Login: "alice", Reviews: 3}, {Login: "bob", Reviews: 1}}, root.Reviewers)
+}
+
+func TestLotteryRiskCollector_ReviewDiversity(t *testing.T) {
+	t.Setenv("GITHUB_TOKEN", "test-token")
+
+	_, dir := initGoGitRepo(t, map[string]string{
+		"main.go": "package main\n\nfunc main() {}\n",
+	})
+
+	now := time.Now()
+	mock := &reviewMockAPI{
+		prs: []*github.PullRequest{
+			makeMergedPR(1, "PR 1", now),
+			makeMergedPR(2, "PR 2", now),
+			makeMergedPR(3, "PR 3", now),
+			makeMergedPR(4, "PR 4", now),
+		},
+		reviews: map[int][]*github.PullRequestReview{
+			1: {{State: github.Ptr("APPROVED"), User: &github.User{Login: github.Ptr("alice")}}},
+			2: {{State: github.Ptr("APPROVED"), User: &github.User{Login: github.Ptr("bob")}}},
+			3: {{State: github.Ptr("APPROVED"), User: &github.User{Login: github.Ptr("charlie")}}},
+			4: {{State: github.Ptr("APPROVED"), User: &github.User{Login: github.Ptr("dave")}}},
+		},
+		files: map[int][]*github.CommitFile{
+			1: {{Filename: github.Ptr("main.go")}},
+			2: {{Filename: github.Ptr("main.go")}},
+			3: {{Filename: github.Ptr("main.go")}},
+			4: {{Filename: github.Ptr("main.go")}},
+		},
+	}
+
+	ghCtx := &githubContext{Owner: "testowner", Repo: "testrepo", API: mock}
+	c := &LotteryRiskCollector{ghCtx: ghCtx}
+	signals, err := c.Collect(context.Background(), dir, signal.CollectorOpts{})
+	require.NoError(t, err)
+
+	reviewSigs := filterByKind(signals, "review-concentration")
+	assert.Empty(t, reviewSigs, "diverse reviewers should not produce review-concentration signals")
+}
+
+func TestResolveAnonymize_AutoPublic(t *testing.T) {
+	mock := &reviewMockAPI{
+		repo: &github.Repository{Private: github.Ptr(false)},
+	}
+	ghCtx := &githubContext{Owner: "o", Repo: "r", API: mock}
+	assert.True(t, resolveAnonymize(context.Background(), ghCtx, "auto"))
+}
+
+func TestResolveAnonymize_AutoPrivate(t *testing.T) {
+	mock := &reviewMockAPI{
+		repo: &github.Repository{Private: github.Ptr(true)},
+	}
+	ghCtx := &githubContext{Owner: "o", Repo: "r", API: mock}
+	assert.False(t, resolveAnonymize(context.Background(), ghCtx, "auto"))
+}
+
+func TestResolveAnonymize_AutoAPIError(t *testing.T) {
+	mock := &reviewMockAPI{}
+	// GetRepository returns nil, nil — which means err is nil but repo is nil.
+	ghCtx := &githubContext{Owner: "o", Repo: "r", API: mock}
+	assert.False(t, resolveAnonymize(context.Background(), ghCtx, "auto"))
+}
+
+func TestFetchReviewParticipation_ContextCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	mock := &reviewMockAPI{}
+	ghCtx := &githubContext{Owner: "o", Repo: "r", API: mock}
+	ownership := map[string]*dirOwnership{
+		".": {Path: ".", Authors: make(map[string]*authorStats)},
+	}
+
+	_, err := fetchReviewParticipation(ctx, ghCtx, ownership, 10)
+	require.Error(t, err)
+}
+
+func TestFetchReviewParticipation_PRError(t *testing.T) {
+	mock := &reviewMockAPI{
+		prs: nil,
+	}
+	// Override ListPullRequests to return error.
+	errorMock := &reviewErrorMock{inner: mock, prErr: fmt.Errorf("PR list error")}
+	ghCtx := &githubContext{Owner: "o", Repo: "r", API: errorMock}
+	ownership := map[string]*dirOwnership{
+		".": {Path: ".", Authors: make(map[string]*authorStats)},
+	}
+	_, err := fetchReviewParticipation(context.Background(), ghCtx, ownership, 10)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "listing merged PRs")
+}
+
+// reviewErrorMock wraps a reviewMockAPI and overrides ListPullRequests to return an error.
+type reviewErrorMock struct {
+	inner *reviewMockAPI
+	prErr error
+}
+
+func (m *reviewErrorMock) ListIssues(ctx context.Context, owner, repo string, opts *github.IssueListByRepoOptions) ([]*github.Issue, *github.Response, error) {
+	return m.inner.ListIssues(ctx, owner, repo, opts)
+}
+
+func (m *reviewErrorMock) ListPullRequests(_ context.Context, _, _ string, _ *github.PullRequestListOptions) ([]*github.PullRequest, *github.Response, error) {
+	return nil, lrEmptyResponse(), m.prErr
+}
+
+func (m *reviewErrorMock) ListReviews(ctx context.Context, owner, repo string, number int, opts *github.ListOptions) ([]*github.PullRequestReview, *github.Response, error) {
+	return m.inner.ListReviews(ctx, owner, repo, number, opts)
+}
+
+func (m *reviewErrorMock) ListReviewComments(ctx context.Context, owner, repo string, number int, opts *github.PullRequestListCommentsOptions) ([]*github.PullRequestComment, *github.Response, error) {
+	return m.inner.ListReviewComments(ctx, owner, repo, number, opts)
+}
+
+func (m *reviewErrorMock) ListPullRequestFiles(ctx context.Context, owner, repo string, number int, opts *github.ListOptions) ([]*github.CommitFile, *github.Response, error) {
+	return m.inner.ListPullRequestFiles(ctx, owner, repo, number, opts)
+}
+
+func (m *reviewErrorMock) GetRepository(ctx context.Context, owner, repo string) (*github.Repository, *github.Response, error) {
+	return m.inner.GetRepository(ctx, owner, repo)
+}
+
+func TestFetchReviewParticipation_SkipNonMerged(t *testing.T) {
+	now := time.Now()
+	mock := &reviewMockAPI{
+		prs: []*github.PullRequest{
+			makeClosedPR(1, "Closed not merged", now), // not merged, should be skipped
+			makeMergedPR(2, "Merged PR", now),
+		},
+		reviews: map[int][]*github.PullRequestReview{
+			2: {{State: github.Ptr("APPROVED"), User: &github.User{Login: github.Ptr("alice")}}},
+		},
+		files: map[int][]*github.CommitFile{
+			2: {{Filename: github.Ptr("main.go")}},
+		},
+	}
+
+	ghCtx := &githubContext{Owner: "o", Repo: "r", API: mock}
+	ownership := map[string]*dirOwnership{
+		".": {Path: ".", Authors: make(map[string]*authorStats)},
+	}
+	result, err := fetchReviewParticipation(context.Background(), ghCtx, ownership, 10)
+	require.NoError(t, err)
+	// Should have data from the merged PR only.
+	assert.NotEmpty(t, result)
+}
+
+func TestFetchReviewParticipation_ReviewError(t *testing.T) {
+	now := time.Now()
+	mock := &reviewMockAPI{
+		prs: []*github.PullRequest{
+			makeMergedPR(1, "Merged PR", now),
+		},
+	}
+	// Override to return review error.
+	errMock := &reviewErrOnReviews{inner: mock, reviewErr: fmt.Errorf("review fetch failed")}
+	ghCtx := &githubContext{Owner: "o", Repo: "r", API: errMock}
+	ownership := map[string]*dirOwnership{
+		".": {Path: ".", Authors: make(map[string]*authorStats)},
+	}
+	result, err := fetchReviewParticipation(context.Background(), ghCtx, ownership, 10)
+	require.NoError(t, err)
+	// Review error is skipped, result should be empty.
+	assert.Empty(t, result)
+}
+
+type reviewErrOnReviews struct {
+	inner     *reviewMockAPI
+	reviewErr error
+}
+
+func (m *reviewErrOnReviews) ListIssues(ctx context.Context, o, r string, opts *github.IssueListByRepoOptions) ([]*github.Issue, *github.Response, error) {
+	return m.inner.ListIssues(ctx, o, r, opts)
+}
+
+func (m *reviewErrOnReviews) ListPullRequests(ctx context.Context, o, r string, opts *github.PullRequestListOptions) ([]*github.PullRequest, *github.Response, error) {
+	return m.inner.ListPullRequests(ctx, o, r, opts)
+}
+
+func (m *reviewErrOnReviews) ListReviews(_ context.Context, _, _ string, _ int, _ *github.ListOptions) ([]*github.PullRequestReview, *github.Response, error) {
+	return nil, lrEmptyResponse(), m.reviewErr
+}
+
+func (m *reviewErrOnReviews) ListReviewComments(ctx context.Context, o, r string, n int, opts *github.PullRequestListCommentsOptions) ([]*github.PullRequestComment, *github.Response, error) {
+	return m.inner.ListReviewComments(ctx, o, r, n, opts)
+}
+
+func (m *reviewErrOnReviews) ListPullRequestFiles(ctx context.Context, o, r string, n int, opts *github.ListOptions) ([]*github.CommitFile, *github.Response, error) {
+	return m.inner.ListPullRequestFiles(ctx, o, r, n, opts)
+}
+
+func (m *reviewErrOnReviews) GetRepository(ctx context.Context, o, r string) (*github.Repository, *github.Response, error) {
+	return m.inner.GetRepository(ctx, o, r)
+}
+
+func TestFetchReviewParticipation_FileError(t *testing.T) {
+	now := time.Now()
+	mock := &reviewMockAPI{
+		prs: []*github.PullRequest{
+			makeMergedPR(1, "Merged PR", now),
+		},
+		reviews: map[int][]*github.PullRequestReview{
+			1: {{State: github.Ptr("APPROVED"), User: &github.User{Login: github.Ptr("alice")}}},
+		},
+	}
+	// Override to return file error.
+	errMock := &reviewErrOnFiles{inner: mock, fileErr: fmt.Errorf("file fetch failed")}
+	ghCtx := &githubContext{Owner: "o", Repo: "r", API: errMock}
+	ownership := map[string]*dirOwnership{
+		".": {Path: ".", Authors: make(map[string]*authorStats)},
+	}
+	result, err := fetchReviewParticipation(context.Background(), ghCtx, ownership, 10)
+	require.NoError(t, err)
+	// File error is skipped, result should be empty.
+	assert.Empty(t, result)
+}
+
+type reviewErrOnFiles struct {
+	inner   *reviewMockAPI
+	fileErr error
+}
+
+func (m *reviewErrOnFiles) ListIssues(ctx context.Context, o, r string, opts *github.IssueListByRepoOptions) ([]*github.Issue, *github.Response, error) {
+	return m.inner.ListIssues(ctx, o, r, opts)
+}
+
+func (m *reviewErrOnFiles) ListPullRequests(ctx context.Context, o, r string, opts *github.PullRequestListOptions) ([]*github.PullRequest, *github.Response, error) {
+	return m.inner.ListPullRequests(ctx, o, r, opts)
+}
+
+func (m *reviewErrOnFiles) ListReviews(ctx context.Context, o, r string, n int, opts *github.ListOptions) ([]*github.PullRequestReview, *github.Response, error) {
+	return m.inner.ListReviews(ctx, o, r, n, opts)
+}
+
+func (m *reviewErrOnFiles) ListReviewComments(ctx context.Context, o, r string, n int, opts *github.PullRequestListCommentsOptions) ([]*github.PullRequestComment, *github.Response, error) {
+	return m.inner.ListReviewComments(ctx, o, r, n, opts)
+}
+
+func (m *reviewErrOnFiles) ListPullRequestFiles(_ context.Context, _, _ string, _ int, _ *github.ListOptions) ([]*github.CommitFile, *github.Response, error) {
+	return nil, lrEmptyResponse(), m.fileErr
+}
+
+func (m *reviewErrOnFiles) GetRepository(ctx context.Context, o, r string) (*github.Repository, *github.Response, error) {
+	return m.inner.GetRepository(ctx, o, r)
+}
+
+func TestFetchReviewParticipation_MaxPRsLimit(t *testing.T) {
+	now := time.Now()
+	mock := &reviewMockAPI{
+		prs: []*github.PullRequest{
+			makeMergedPR(1, "PR 1", now),
+			makeMergedPR(2, "PR 2", now),
+			makeMergedPR(3, "PR 3", now),
+		},
+		reviews: map[int][]*github.PullRequestReview{
+			1: {{State: github.Ptr("APPROVED"), User: &github.User{Login: github.Ptr("alice")}}},
+			2: {{State: github.Ptr("APPROVED"), User: &github.User{Login: github.Ptr("bob")}}},
+			3: {{State: github.Ptr("APPROVED"), User: &github.User{Login: github.Ptr("charlie")}}},
+		},
+		files: map[int][]*github.CommitFile{
+			1: {{Filename: github.Ptr("main.go")}},
+			2: {{Filename: github.Ptr("main.go")}},
+			3: {{Filename: github.Ptr("main.go")}},
+		},
+	}
+
+	ghCtx := &githubContext{Owner: "o", Repo: "r", API: mock}
+	ownership := map[string]*dirOwnership{
+		".": {Path: ".", Authors: make(map[string]*authorStats)},
+	}
+	// Limit to 2 PRs.
+	result, err := fetchReviewParticipation(context.Background(), ghCtx, ownership, 2)
+	require.NoError(t, err)
+	assert.NotEmpty(t, result)
+	// Only 2 PRs should be processed, not 3.
+	if part, ok := result["."]; ok {
+		total := 0
+		for _, count := range part.Reviewers {
+			total += count
+		}
+		assert.LessOrEqual(t, total, 2, "should only process 2 PRs max")
+	}
+}
+
+func TestFetchReviewParticipation_ContextDuringIteration(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	now := time.Now()
+	mock := &reviewMockAPI{
+		prs: []*github.PullRequest{
+			makeMergedPR(1, "PR 1", now),
+		},
+		reviews: map[int][]*github.PullRequestReview{},
+		files:   map[int][]*github.CommitFile{},
+	}
+
+	cancel()
+	ghCtx := &githubContext{Owner: "o", Repo: "r", API: mock}
+	ownership := map[string]*dirOwnership{
+		".": {Path: ".", Authors: make(map[string]*authorStats)},
+	}
+	_, err := fetchReviewParticipation(ctx, ghCtx, ownership, 10)
+	require.Error(t, err)
+}
@@ -6,17 +6,16 @@ package collectors
 import (
 	"context"
 	"fmt"
-	"net/http"
 	"strings"
 	"testing"
 	"time"
 
 	gogit "github.com/go-git/go-git/v5"
-	"github.com/google/go-github/v68/github"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/davetashner/stringer/internal/gitcli"
+	"github.com/davetashner/stringer/internal/gradle"
 	"github.com/davetashner/stringer/internal/signal"
 	"github.com/davetashner/stringer/internal/testable"
 )
@@ -50,6 +49,24 @@ func TestLotteryRiskCollector_SingleAuthor(t *testing.T) {
 	}
 }
 
+func TestLotteryRiskCollector_Scope(t *testing.T) {
+	_, dir := initGoGitRepo(t, map[string]string{
+		"main.go":     "package main\n\nfunc main() {}\n",
+		"lib/util.go": "package lib\n\nfunc Util() {}\n",
+		"cmd/tool.go": "package cmd\n\nfunc Tool() {}\n",
+	})
+
+	c := &LotteryRiskCollector{}
+	signals, err := c.Collect(context.Background(), dir, signal.CollectorOpts{
+		Scope: signal.NewScope([]string{"lib"}, 0),
+	})
+	require.NoError(t, err)
+
+	lotteryrisk := filterByKind(signals, "low-lottery-risk")
+	require.Len(t, lotteryrisk, 1, "only the scoped directory is reported, not the root or siblings")
+	assert.Equal(t, "lib", lotteryrisk[0].FilePath)
+}
+
 func TestLotteryRiskCollector_TwoAuthorsEqual(t *testing.T) {
 	// Two authors with equal contributions should give lottery risk 2.
 	repo, dir := initGoGitRepo(t, map[string]string{
@@ -378,143 +395,6 @@ func TestLotteryRiskCollector_ProgressCallback(t *testing.T) {
 
 // --- Review participation tests ---
 
-// reviewMockAPI implements githubAPI for lottery risk review tests.
-type reviewMockAPI struct {
-	prs     []*github.PullRequest
-	reviews map[int][]*github.PullRequestReview
-	files   map[int][]*github.CommitFile
-	repo    *github.Repository
-}
-
-func (m *reviewMockAPI) ListIssues(_ context.Context, _, _ string, _ *github.IssueListByRepoOptions) ([]*github.Issue, *github.Response, error) {
-	return nil, lrEmptyResponse(), nil
-}
-
-func (m *reviewMockAPI) ListPullRequests(_ context.Context, _, _ string, _ *github.PullRequestListOptions) ([]*github.PullRequest, *github.Response, error) {
-	return m.prs, lrEmptyResponse(), nil
-}
-
-func (m *reviewMockAPI) ListReviews(_ context.Context, _, _ string, number int, _ *github.ListOptions) ([]*github.PullRequestReview, *github.Response, error) {
-	return m.reviews[number], lrEmptyResponse(), nil
-}
-
-func (m *reviewMockAPI) ListReviewComments(_ context.Context, _, _ string, _ int, _ *github.PullRequestListCommentsOptions) ([]*github.PullRequestComment, *github.Response, error) {
-	return nil, lrEmptyResponse(), nil
-}
-
-func (m *reviewMockAPI) ListPullRequestFiles(_ context.Context, _, _ string, number int, _ *github.ListOptions) ([]*github.CommitFile, *github.Response, error) {
-	return m.files[number], lrEmptyResponse(), nil
-}
-
-func (m *reviewMockAPI) GetRepository(_ context.Context, _, _ string) (*github.Repository, *github.Response, error) {
-	if m.repo != nil {
-		return m.repo, lrEmptyResponse(), nil
-	}
-	return nil, lrEmptyResponse(), nil
-}
-
-func lrEmptyResponse() *github.Response {
-	return &github.Response{
-		Response: &http.Response{StatusCode: http.StatusOK},
-	}
-}
-
-func TestLotteryRiskCollector_ReviewConcentration(t *testing.T) {
-	t.Setenv("GITHUB_TOKEN", "test-token")
-
-	_, dir := initGoGitRepo(t, map[string]string{
-		"main.go":     "package main\n\nfunc main() {}\n",
-		"lib/util.go": "package lib\n\nfunc Util() {}\n",
-	})
-
-	now := time.Now()
-	mock := &reviewMockAPI{
-		prs: []*github.PullRequest{
-			makeMergedPR(1, "PR 1", now),
-			makeMergedPR(2, "PR 2", now),
-			makeMergedPR(3, "PR 3", now),
-			makeMergedPR(4, "PR 4", now),
-		},
-		reviews: map[int][]*github.PullRequestReview{
-			1: {makeReview("APPROVED")},
-			2: {makeReview("APPROVED")},
-			3: {makeReview("APPROVED")},
-			4: {{State: github.Ptr("APPROVED"), User: &github.User{Login: github.Ptr("other")}}},
-		},
-		files: map[int][]*github.CommitFile{
-			1: {{Filename: github.Ptr("main.go")}},
-			2: {{Filename: github.Ptr("main.go")}},
-			3: {{Filename: github.Ptr("main.go")}},
-			4: {{Filename: github.Ptr("main.go")}},
-		},
-	}
-
-	// Default review mock user is nil, so reviews from makeReview have nil user.
-	// Let's fix: makeReview doesn't set User, so GetUser().GetLogin() returns "".
-	// We need reviews with actual users. Override the reviews.
-	reviewer1 := &github.User{Login: github.Ptr("alice")}
-	reviewer2 := &github.User{Login: github.Ptr("bob")}
-	mock.reviews = map[int][]*github.PullRequestReview{
-		1: {{State: github.Ptr("APPROVED"), User: reviewer1}},
-		2: {{State: github.Ptr("APPROVED"), User: reviewer1}},
-		3: {{State: github.Ptr("APPROVED"), User: reviewer1}},
-		4: {{State: github.Ptr("APPROVED"), User: reviewer2}},
-	}
-
-	ghCtx := &githubContext{Owner: "testowner", Repo: "testrepo", API: mock}
-	c := &LotteryRiskCollector{ghCtx: ghCtx}
-	signals, err := c.Collect(context.Background(), dir, signal.CollectorOpts{})
-	require.NoError(t, err)
-
-	reviewSigs := filterByKind(signals, "review-concentration")
-	require.NotEmpty(t, reviewSigs, "should produce review-concentration signals when one reviewer dominates")
-
-	for _, sig := range reviewSigs {
-		assert.Equal(t, "lotteryrisk", sig.Source)
-		assert.Contains(t, sig.Title, "alice")
-		assert.InDelta(t, 0.6, sig.Confidence, 0.001)
-		assert.Contains(t, sig.Tags, "review-concentration")
-	}
-}
-
-func TestLotteryRiskCollector_ReviewDiversity(t *testing.T) {
-	t.Setenv("GITHUB_TOKEN", "test-token")
-
-	_, dir := initGoGitRepo(t, map[string]string{
-		"main.go": "package main\n\nfunc main() {}\n",
-	})
-
-	now := time.Now()
-	mock := &reviewMockAPI{
-		prs: []*github.PullRequest{
-			makeMergedPR(1, "PR 1", now),
-			makeMergedPR(2, "PR 2", now),
-			makeMergedPR(3, "PR 3", now),
-			makeMergedPR(4, "PR 4", now),
-		},
-		reviews: map[int][]*github.PullRequestReview{
-			1: {{State: github.Ptr("APPROVED"), User: &github.User{Login: github.Ptr("alice")}}},
-			2: {{State: github.Ptr("APPROVED"), User: &github.User{Login: github.Ptr("bob")}}},
-			3: {{State: github.Ptr("APPROVED"), User: &github.User{Login: github.Ptr("charlie")}}},
-			4: {{State: github.Ptr("APPROVED"), User: &github.User{Login: github.Ptr("dave")}}},
-		},
-		files: map[int][]*github.CommitFile{
-			1: {{Filename: github.Ptr("main.go")}},
-			2: {{Filename: github.Ptr("main.go")}},
-			3: {{Filename: github.Ptr("main.go")}},
-			4: {{Filename: github.Ptr("main.go")}},
-		},
-	}
-
-	ghCtx := &githubContext{Owner: "testowner", Repo: "testrepo", API: mock}
-	c := &LotteryRiskCollector{ghCtx: ghCtx}
-	signals, err := c.Collect(context.Background(), dir, signal.CollectorOpts{})
-	require.NoError(t, err)
-
-	reviewSigs := filterByKind(signals, "review-concentration")
-	assert.Empty(t, reviewSigs, "diverse reviewers should not produce review-concentration signals")
-}
-
 func TestLotteryRiskCollector_ReviewParticipation_NoToken(t *testing.T) {
 	t.Setenv("GITHUB_TOKEN", "")
 
@@ -561,6 +441,22 @@ func TestBuildReviewConcentrationSignals_TooFewReviews(t *testing.T) {
 	assert.Empty(t, signals, "fewer than 3 reviews should not produce signals")
 }
 
+func TestAttachReviewers(t *testing.T) {
+	metrics := &LotteryRiskMetrics{Directories: []DirectoryOwnership{{Path: "."}, {Path: "lib"}}}
+	attachReviewers(metrics, map[string]*reviewParticipation{
+		"lib": {Reviewers: map[string]int{"bob": 2, "alice": 2, "carol": 5, "": 1}},
+	})
+
+	assert.Empty(t, metrics.Directories[0].Reviewers)
+	assert.Equal(t, []ReviewerShare{
+		{Login: "carol", Reviews: 5},
+		{Login: "alice", Reviews: 2},
+		{Login: "bob", Reviews: 2},
+	}, metrics.Directories[1].Reviewers, "sorted by reviews then login; anonymous reviews dropped")
+
+	attachReviewers(nil, nil) // no metrics is a no-op
+}
+
 // --- Anonymization tests ---
 
 func TestNameAnonymizer_Stable(t *testing.T) {
@@ -605,22 +501,6 @@ func TestResolveAnonymize_Never(t *testing.T) {
 	assert.False(t, resolveAnonymize(context.Background(), nil, "never"))
 }
 
-func TestResolveAnonymize_AutoPublic(t *testing.T) {
-	mock := &reviewMockAPI{
-		repo: &github.Repository{Private: github.Ptr(false)},
-	}
-	ghCtx := &githubContext{Owner: "o", Repo: "r", API: mock}
-	assert.True(t, resolveAnonymize(context.Background(), ghCtx, "auto"))
-}
-
-func TestResolveAnonymize_AutoPrivate(t *testing.T) {
-	mock := &reviewMockAPI{
-		repo: &github.Repository{Private: github.Ptr(true)},
-	}
-	ghCtx := &githubContext{Owner: "o", Repo: "r", API: mock}
-	assert.False(t, resolveAnonymize(context.Background(), ghCtx, "auto"))
-}
-
 func TestResolveAnonymize_AutoNoToken(t *testing.T) {
 	assert.False(t, resolveAnonymize(context.Background(), nil, "auto"))
 }
@@ -800,6 +680,39 @@ func TestFindOwningDir_NoMatch(t *testing.T) {
 	assert.Equal(t, "", dir)
 }
 
+func TestApplyGradleModules(t *testing.T) {
+	dirs := []string{".", "buildSrc", "core", "core/data", "core/data/src", "legacy", "legacy/src"}
+	modules := []gradle.Module{
+		{Name: ":core:data", Dir: "core/data"},
+		{Name: ":legacy", Dir: "legacy"},
+		{Name: ":samples", Dir: "examples/samples"},
+		{Name: ":deep", Dir: "core/data/src/main/deep"},
+	}
+
+	got, names := applyGradleModules(dirs, modules, []string{"legacy/**"}, false, signal.Scope{})
+	assert.Equal(t, []string{".", "buildSrc", "core", "core/data", "core/data/src/main/deep", "legacy", "legacy/src"}, got,
+		"directories inside kept modules are replaced; excluded and demo modules leave directories as they were")
+	assert.Equal(t, map[string]string{"core/data": ":core:data", "core/data/src/main/deep": ":deep"}, names)
+
+	got, names = applyGradleModules(dirs, nil, nil, false, signal.Scope{})
+	assert.Equal(t, dirs, got)
+	assert.Empty(t, names)
+}
+
+func TestBuildLotteryRiskSignal_GradleModule(t *testing.T) {
+	own := &dirOwnership{
+		Path:        "core/data",
+		Module:      ":core:data",
+		TotalLines:  100,
+		LotteryRisk: 1,
+		Authors:     map[string]*authorStats{"Alice": {BlameLines: 100, CommitWeight: 1}},
+	}
+	sig := buildLotteryRiskSignal(own, nil)
+	assert.Equal(t, "core/data", sig.FilePath)
+	assert.Equal(t, "Critical lottery risk: module :core:data (lottery risk 1, primary: Alice 100%)", sig.Title)
+	assert.Equal(t, ":core:data", buildDirectoryOwnership(own).Module)
+}
+
 func TestIsSourceExtension(t *testing.T) {
 	assert.True(t, isSourceExtension(".go"))
 	assert.True(t, isSourceExtension(".py"))
@@ -856,13 +769,6 @@ func TestResolveAnonymize_UnknownMode(t *testing.T) {
 	assert.False(t, resolveAnonymize(context.Background(), nil, "unknown"))
 }
 
-func TestResolveAnonymize_AutoAPIError(t *testing.T) {
-	mock := &reviewMockAPI{}
-	// GetRepository returns nil, nil — which means err is nil but repo is nil.
-	ghCtx := &githubContext{Owner: "o", Repo: "r", API: mock}
-	assert.False(t, resolveAnonymize(context.Background(), ghCtx, "auto"))
-}
-
 func TestBuildLotteryRiskSignal_WithAnonymizer(t *testing.T) {
 	own := &dirOwnership{
 		Path: ".",
@@ -929,20 +835,6 @@ func TestBuildReviewConcentrationSignals_WithAnonymizer(t *testing.T) {
 	assert.NotContains(t, signals[0].Title, "alice")
 }
 
-func TestFetchReviewParticipation_ContextCancelled(t *testing.T) {
-	ctx, cancel := context.WithCancel(context.Background())
-	cancel()
-
-	mock := &reviewMockAPI{}
-	ghCtx := &githubContext{Owner: "o", Repo: "r", API: mock}
-	ownership := map[string]*dirOwnership{
-		".": {Path: ".", Authors: make(map[string]*authorStats)},
-	}
-
-	_, err := fetchReviewParticipation(ctx, ghCtx, ownership, 10)
-	require.Error(t, err)
-}
-
 func TestContributorLabel_Range(t *testing.T) {
 	// Test a range beyond Z.
 	assert.Equal(t, "Contributor A", contributorLabel(0))
@@ -1082,219 +974,156 @@ func TestBuildLotteryRiskSignal_NegligibleContributor(t *testing.T) {
 	// Charlie may or may not appear (< 1% threshold), depends on combined weight.
 }
 
-func TestFetchReviewParticipation_PRError(t *testing.T) {
-	mock := &reviewMockAPI{
-		prs: nil,
-	}
-	// Override ListPullRequests to return error.
-	errorMock := &reviewErrorMock{inner: mock, prErr: fmt.Errorf("PR list error")}
-	ghCtx := &githubContext{Owner: "o", Repo: "r", API: errorMock}
-	ownership := map[string]*dirOwnership{
-		".": {Path: ".", Authors: make(map[string]*authorStats)},
-	}
-	_, err := fetchReviewParticipation(context.Background(), ghCtx, ownership, 10)
-	require.Error(t, err)
-	assert.Contains(t, err.Error(), "listing merged PRs")
-}
-
-// reviewErrorMock wraps a reviewMockAPI and overrides ListPullRequests to return an error.
-type reviewErrorMock struct {
-	inner *reviewMockAPI
-	prErr error
-}
-
-func (m *reviewErrorMock) ListIssues(ctx context.Context, owner, repo string, opts *github.IssueListByRepoOptions) ([]*github.Issue, *github.Response, error) {
-	return m.inner.ListIssues(ctx, owner, repo, opts)
-}
-
-func (m *reviewErrorMock) ListPullRequests(_ context.Context, _, _ string, _ *github.PullRequestListOptions) ([]*github.PullRequest, *github.Response, error) {
-	return nil, lrEmptyResponse(), m.prErr
-}
-
-func (m *reviewErrorMock) ListReviews(ctx context.Context, owner, repo string, number int, opts *github.ListOptions) ([]*github.PullRequestReview, *github.Response, error) {
-	return m.inner.ListReviews(ctx, owner, repo, number, opts)
-}
+func TestLotteryRiskCollector_KnowledgeSplit(t *testing.T) {
+	// Alice writes the code, Bob writes all the tests.
+	repo, dir := initGoGitRepo(t, map[string]string{
+		"README.md": "# repo\n",
+	})
+	now := time.Now()
 
-func (m *reviewErrorMock) ListReviewComments(ctx context.Context, owner, repo string, number int, opts *github.PullRequestListCommentsOptions) ([]*github.PullRequestComment, *github.Response, error) {
-	return m.inner.ListReviewComments(ctx, owner, repo, number, opts)
-}
+	prod := "package main\n\n" + strings.Repeat("func F() {}\n", 60)
+	tests := "package main\n\n" + strings.Repeat("func TestF() {}\n", 60)
+	addCommitAs(t, repo, dir, "svc/svc.go", prod, "feat: add svc", now, "Alice", "alice@example.com")
+	addCommitAs(t, repo, dir, "svc/svc_test.go", tests, "test: add svc tests", now, "Bob", "bob@example.com")
 
-func (m *reviewErrorMock) ListPullRequestFiles(ctx context.Context, owner, repo string, number int, opts *github.ListOptions) ([]*github.CommitFile, *github.Response, error) {
-	return m.inner.ListPullRequestFiles(ctx, owner, repo, number, opts)
-}
+	c := &LotteryRiskCollector{}
+	signals, err := c.Collect(context.Background(), dir, signal.CollectorOpts{Anonymize: "never"})
+	require.NoError(t, err)
 
-func (m *reviewErrorMock) GetRepository(ctx context.Context, owner, repo string) (*github.Repository, *github.Response, error) {
-	return m.inner.GetRepository(ctx, owner, repo)
+	splits := filterByKind(signals, "knowledge-split")
+	require.NotEmpty(t, splits)
+	var svc *signal.RawSignal
+	for i := range splits {
+		if splits[i].FilePath == "svc" {
+			require.Nil(t, svc, "svc is reported once")
+			svc = &splits[i]
+		}
+	}
+	require.NotNil(t, svc, "svc should be flagged as a knowledge split")
+	assert.Equal(t, "lotteryrisk", svc.Source)
+	assert.Contains(t, svc.Title, "Bob wrote 100% of tests in svc but only 0% of production code")
+	assert.Contains(t, svc.Tags, "knowledge-split")
 }
 
-func TestFetchReviewParticipation_SkipNonMerged(t *testing.T) {
-	now := time.Now()
-	mock := &reviewMockAPI{
-		prs: []*github.PullRequest{
-			makeClosedPR(1, "Closed not merged", now), // not merged, should be skipped
-			makeMergedPR(2, "Merged PR", now),
+func TestBuildKnowledgeSplitSignal(t *testing.T) {
+	tests := []struct {
+		name      string
+		authors   map[string]*authorStats
+		wantOK    bool
+		wantTitle string
+	}{
+		{
+			name: "tests by non-author",
+			authors: map[string]*authorStats{
+				"alice": {BlameLines: 100},
+				"bob":   {BlameLines: 90, TestLines: 90},
+				"carol": {BlameLines: 10, TestLines: 10},
+			},
+			wantOK:    true,
+			wantTitle: "Knowledge split: bob wrote 90% of tests in pkg but only 0% of production code",
 		},
-		reviews: map[int][]*github.PullRequestReview{
-			2: {{State: github.Ptr("APPROVED"), User: &github.User{Login: github.Ptr("alice")}}},
+		{
+			name: "code by author who never tests",
+			authors: map[string]*authorStats{
+				"alice": {BlameLines: 100},
+				"bob":   {BlameLines: 30, TestLines: 30},
+				"carol": {BlameLines: 30, TestLines: 30},
+			},
+			wantOK:    true,
+			wantTitle: "Knowledge split: alice wrote 100% of production code in pkg but only 0% of tests",
 		},
-		files: map[int][]*github.CommitFile{
-			2: {{Filename: github.Ptr("main.go")}},
+		{
+			name: "same author owns both",
+			authors: map[string]*authorStats{
+				"alice": {BlameLines: 200, TestLines: 100},
+			},
+			wantOK: false,
 		},
-	}
-
-	ghCtx := &githubContext{Owner: "o", Repo: "r", API: mock}
-	ownership := map[string]*dirOwnership{
-		".": {Path: ".", Authors: make(map[string]*authorStats)},
-	}
-	result, err := fetchReviewParticipation(context.Background(), ghCtx, ownership, 10)
-	require.NoError(t, err)
-	// Should have data from the merged PR only.
-	assert.NotEmpty(t, result)
-}
-
-func TestFetchReviewParticipation_ReviewError(t *testing.T) {
-	now := time.Now()
-	mock := &reviewMockAPI{
-		prs: []*github.PullRequest{
-			makeMergedPR(1, "Merged PR", now),
+		{
+			name: "dominant test author also writes code",
+			authors: map[string]*authorStats{
+				"alice": {BlameLines: 60},
+				"bob":   {BlameLines: 140, TestLines: 100},
+			},
+			wantOK: false,
+		},
+		{
+			name: "too few test lines",
+			authors: map[string]*authorStats{
+				"alice": {BlameLines: 100},
+				"bob":   {BlameLines: 10, TestLines: 10},
+			},
+			wantOK: false,
 		},
 	}
-	// Override to return review error.
-	errMock := &reviewErrOnReviews{inner: mock, reviewErr: fmt.Errorf("review fetch failed")}
-	ghCtx := &githubContext{Owner: "o", Repo: "r", API: errMock}
-	ownership := map[string]*dirOwnership{
-		".": {Path: ".", Authors: make(map[string]*authorStats)},
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			own := &dirOwnership{Path: "pkg", Authors: tt.authors}
+			for _, s := range tt.authors {
+				own.TotalLines += s.BlameLines
+				own.TestLines += s.TestLines
+			}
+			sig, ok := buildKnowledgeSplitSignal(own, nil)
+			assert.Equal(t, tt.wantOK, ok)
+			if tt.wantOK {
+				assert.Equal(t, "knowledge-split", sig.Kind)
+				assert.Equal(t, "pkg", sig.FilePath)
+				assert.Equal(t, tt.wantTitle, sig.Title)
+			}
+		})
 	}
-	result, err := fetchReviewParticipation(context.Background(), ghCtx, ownership, 10)
-	require.NoError(t, err)
-	// Review error is skipped, result should be empty.
-	assert.Empty(t, result)
-}
-
-type reviewErrOnReviews struct {
-	inner     *reviewMockAPI
-	reviewErr error
-}
-
-func (m *reviewErrOnReviews) ListIssues(ctx context.Context, o, r string, opts *github.IssueListByRepoOptions) ([]*github.Issue, *github.Response, error) {
-	return m.inner.ListIssues(ctx, o, r, opts)
-}
-func (m *reviewErrOnReviews) ListPullRequests(ctx context.Context, o, r string, opts *github.PullRequestListOptions) ([]*github.PullRequest, *github.Response, error) {
-	return m.inner.ListPullRequests(ctx, o, r, opts)
-}
-func (m *reviewErrOnReviews) ListReviews(_ context.Context, _, _ string, _ int, _ *github.ListOptions) ([]*github.PullRequestReview, *github.Response, error) {
-	return nil, lrEmptyResponse(), m.reviewErr
-}
-func (m *reviewErrOnReviews) ListReviewComments(ctx context.Context, o, r string, n int, opts *github.PullRequestListCommentsOptions) ([]*github.PullRequestComment, *github.Response, error) {
-	return m.inner.ListReviewComments(ctx, o, r, n, opts)
-}
-func (m *reviewErrOnReviews) ListPullRequestFiles(ctx context.Context, o, r string, n int, opts *github.ListOptions) ([]*github.CommitFile, *github.Response, error) {
-	return m.inner.ListPullRequestFiles(ctx, o, r, n, opts)
-}
-func (m *reviewErrOnReviews) GetRepository(ctx context.Context, o, r string) (*github.Repository, *github.Response, error) {
-	return m.inner.GetRepository(ctx, o, r)
 }
 
-func TestFetchReviewParticipation_FileError(t *testing.T) {
-	now := time.Now()
-	mock := &reviewMockAPI{
-		prs: []*github.PullRequest{
-			makeMergedPR(1, "Merged PR", now),
-		},
-		reviews: map[int][]*github.PullRequestReview{
-			1: {{State: github.Ptr("APPROVED"), User: &github.User{Login: github.Ptr("alice")}}},
+func TestBuildKnowledgeSplitSignal_WithAnonymizer(t *testing.T) {
+	own := &dirOwnership{
+		Path:       "pkg",
+		TotalLines: 200,
+		TestLines:  100,
+		Authors: map[string]*authorStats{
+			"alice": {BlameLines: 100},
+			"bob":   {BlameLines: 100, TestLines: 100},
 		},
 	}
-	// Override to return file error.
-	errMock := &reviewErrOnFiles{inner: mock, fileErr: fmt.Errorf("file fetch failed")}
-	ghCtx := &githubContext{Owner: "o", Repo: "r", API: errMock}
-	ownership := map[string]*dirOwnership{
-		".": {Path: ".", Authors: make(map[string]*authorStats)},
-	}
-	result, err := fetchReviewParticipation(context.Background(), ghCtx, ownership, 10)
-	require.NoError(t, err)
-	// File error is skipped, result should be empty.
-	assert.Empty(t, result)
-}
-
-type reviewErrOnFiles struct {
-	inner   *reviewMockAPI
-	fileErr error
-}
-
-func (m *reviewErrOnFiles) ListIssues(ctx context.Context, o, r string, opts *github.IssueListByRepoOptions) ([]*github.Issue, *github.Response, error) {
-	return m.inner.ListIssues(ctx, o, r, opts)
-}
-func (m *reviewErrOnFiles) ListPullRequests(ctx context.Context, o, r string, opts *github.PullRequestListOptions) ([]*github.PullRequest, *github.Response, error) {
-	return m.inner.ListPullRequests(ctx, o, r, opts)
-}
-func (m *reviewErrOnFiles) ListReviews(ctx context.Context, o, r string, n int, opts *github.ListOptions) ([]*github.PullRequestReview, *github.Response, error) {
-	return m.inner.ListReviews(ctx, o, r, n, opts)
-}
-func (m *reviewErrOnFiles) ListReviewComments(ctx context.Context, o, r string, n int, opts *github.PullRequestListCommentsOptions) ([]*github.PullRequestComment, *github.Response, error) {
-	return m.inner.ListReviewComments(ctx, o, r, n, opts)
-}
-func (m *reviewErrOnFiles) ListPullRequestFiles(_ context.Context, _, _ string, _ int, _ *github.ListOptions) ([]*github.CommitFile, *github.Response, error) {
-	return nil, lrEmptyResponse(), m.fileErr
-}
-func (m *reviewErrOnFiles) GetRepository(ctx context.Context, o, r string) (*github.Repository, *github.Response, error) {
-	return m.inner.GetRepository(ctx, o, r)
+	sig, ok := buildKnowledgeSplitSignal(own, newNameAnonymizer())
+	require.True(t, ok)
+	assert.Contains(t, sig.Title, "Contributor A")
+	assert.NotContains(t, sig.Title, "bob")
 }
 
-func TestFetchReviewParticipation_MaxPRsLimit(t *testing.T) {
+func TestLotteryRiskCollector_IdentitiesConsolidateAuthors(t *testing.T) {
+	repo, dir := initGoGitRepo(t, map[string]string{
+		"README.md": "# repo\n",
+	})
 	now := time.Now()
-	mock := &reviewMockAPI{
-		prs: []*github.PullRequest{
-			makeMergedPR(1, "PR 1", now),
-			makeMergedPR(2, "PR 2", now),
-			makeMergedPR(3, "PR 3", now),
-		},
-		reviews: map[int][]*github.PullRequestReview{
-			1: {{State: github.Ptr("APPROVED"), User: &github.User{Login: github.Ptr("alice")}}},
-			2: {{State: github.Ptr("APPROVED"), User: &github.User{Login: github.Ptr("bob")}}},
-			3: {{State: github.Ptr("APPROVED"), User: &github.User{Login: github.Ptr("charlie")}}},
-		},
-		files: map[int][]*github.CommitFile{
-			1: {{Filename: github.Ptr("main.go")}},
-			2: {{Filename: github.Ptr("main.go")}},
-			3: {{Filename: github.Ptr("main.go")}},
-		},
-	}
-
-	ghCtx := &githubContext{Owner: "o", Repo: "r", API: mock}
-	ownership := map[string]*dirOwnership{
-		".": {Path: ".", Authors: make(map[string]*authorStats)},
-	}
-	// Limit to 2 PRs.
-	result, err := fetchReviewParticipation(context.Background(), ghCtx, ownership, 2)
-	require.NoError(t, err)
-	assert.NotEmpty(t, result)
-	// Only 2 PRs should be processed, not 3.
-	if part, ok := result["."]; ok {
-		total := 0
-		for _, count := range part.Reviewers {
-			total += count
+	body := "package main\n\nfunc F() {}\nfunc G() {}\nfunc H() {}\n"
+	addCommitAs(t, repo, dir, "a.go", body, "feat: a", now, "Alice", "alice@example.com")
+	addCommitAs(t, repo, dir, "b.go", body, "feat: b", now, "asmith", "alice@old.example.com")
+	addCommitAs(t, repo, dir, "c.go", body, "feat: c", now, "Bob", "bob@example.com")
+
+	rootAuthors := func(t *testing.T, opts signal.CollectorOpts) []string {
+		t.Helper()
+		c := &LotteryRiskCollector{}
+		_, err := c.Collect(context.Background(), dir, opts)
+		require.NoError(t, err)
+		for _, d := range c.metrics.Directories {
+			if d.Path == "." {
+				var names []string
+				for _, a := range d.Authors {
+					names = append(names, a.Name)
+				}
+				return names
+			}
 		}
-		assert.LessOrEqual(t, total, 2, "should only process 2 PRs max")
+		t.Fatal("root directory missing from metrics")
+		return nil
 	}
-}
 
-func TestFetchReviewParticipation_ContextDuringIteration(t *testing.T) {
-	ctx, cancel := context.WithCancel(context.Background())
-	now := time.Now()
-	mock := &reviewMockAPI{
-		prs: []*github.PullRequest{
-			makeMergedPR(1, "PR 1", now),
-		},
-		reviews: map[int][]*github.PullRequestReview{},
-		files:   map[int][]*github.CommitFile{},
-	}
+	assert.ElementsMatch(t, []string{"Alice", "asmith", "Bob", "Test Author"},
+		rootAuthors(t, signal.CollectorOpts{Anonymize: "never"}))
 
-	cancel()
-	ghCtx := &githubContext{Owner: "o", Repo: "r", API: mock}
-	ownership := map[string]*dirOwnership{
-		".": {Path: ".", Authors: make(map[string]*authorStats)},
+	opts := signal.CollectorOpts{
+		Anonymize:  "never",
+		Identities: map[string][]string{"Alice": {"alice@old.example.com"}},
 	}
-	_, err := fetchReviewParticipation(ctx, ghCtx, ownership, 10)
-	require.Error(t, err)
+	assert.ElementsMatch(t, []string{"Alice", "Bob", "Test Author"}, rootAuthors(t, opts))
 }
@@ -0,0 +1,11 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+//go:build !nonetwork
+
+package collectors
+
+// networkCollectors reports whether collectors that call out to package
+// registries, vulnerability databases, and GitHub can run. It is false in
+// builds with the nonetwork tag.
+const networkCollectors = true
@@ -0,0 +1,116 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+//go:build nonetwork
+
+package collectors
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/davetashner/stringer/internal/collector"
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+// networkCollectors is false in builds with the nonetwork tag. The github,
+// gitlab, dephealth, and vuln collectors stay registered, so configs naming
+// them still validate, but their capability checks skip them, and the
+// go-github client is not linked. The declarations below stand in for the GitHub
+// code the other collectors and commands refer to.
+const networkCollectors = false
+
+func init() {
+	collector.Register(&GitHubCollector{})
+	collector.Register(&GitLabCollector{})
+}
+
+// GitHubCollector stands in for the github collector.
+type GitHubCollector struct{}
+
+// Name returns the collector name used for registration and filtering.
+func (c *GitHubCollector) Name() string { return "github" }
+
+// CheckCapabilities always skips the collector.
+func (c *GitHubCollector) CheckCapabilities(context.Context, string, signal.CollectorOpts) string {
+	return reasonNoNetworkBuild
+}
+
+// Collect fails; the pipeline never calls it after CheckCapabilities.
+func (c *GitHubCollector) Collect(context.Context, string, signal.CollectorOpts) ([]signal.RawSignal, error) {
+	return nil, errors.New(reasonNoNetworkBuild)
+}
+
+// GitLabCollector stands in for the gitlab collector.
+type GitLabCollector struct{}
+
+// Name returns the collector name used for registration and filtering.
+func (c *GitLabCollector) Name() string { return "gitlab" }
+
+// CheckCapabilities always skips the collector.
+func (c *GitLabCollector) CheckCapabilities(context.Context, string, signal.CollectorOpts) string {
+	return reasonNoNetworkBuild
+}
+
+// Collect fails; the pipeline never calls it after CheckCapabilities.
+func (c *GitLabCollector) Collect(context.Context, string, signal.CollectorOpts) ([]signal.RawSignal, error) {
+	return nil, errors.New(reasonNoNetworkBuild)
+}
+
+// DefaultGitHubAPIBudget matches the network build so flag defaults agree.
+const DefaultGitHubAPIBudget = 2000
+
+// ErrGitHubBudgetExhausted is never returned without network support.
+var ErrGitHubBudgetExhausted = errors.New("GitHub API request budget exhausted")
+
+// GitHubData is an empty placeholder; no GitHub requests are made.
+type GitHubData struct{}
+
+// NewGitHubData returns an empty GitHubData.
+func NewGitHubData(int) *GitHubData { return &GitHubData{} }
+
+// Requests always returns zero.
+func (d *GitHubData) Requests() int { return 0 }
+
+// Exhausted always returns false.
+func (d *GitHubData) Exhausted() bool { return false }
+
+// WithGitHubData returns ctx unchanged.
+func WithGitHubData(ctx context.Context, _ *GitHubData) context.Context { return ctx }
+
+// githubContext is never created without network support.
+type githubContext struct {
+	Owner string
+	Repo  string
+}
+
+func newGitHubContext(context.Context, string) *githubContext { return nil }
+
+func (g *githubContext) shared(context.Context) *githubContext { return g }
+
+func (g *githubContext) isPublic(context.Context) bool { return false }
+
+func fetchReviewParticipation(context.Context, *githubContext, map[string]*dirOwnership, int) (map[string]*reviewParticipation, error) {
+	return nil, nil
+}
+
+// dephealthGitHubAPI is never implemented without network support.
+type dephealthGitHubAPI interface{}
+
+func newDepHealthGitHubAPI(context.Context, string) dephealthGitHubAPI { return nil }
+
+func checkGitHubDeps(context.Context, dephealthGitHubAPI, []ModuleDep, time.Duration) []signal.RawSignal {
+	return nil
+}
+
+func archivedGitHubRepos(context.Context, dephealthGitHubAPI, []ModuleDep) []string { return nil }
+
+// releasesGitHubAPI is never implemented without network support.
+type releasesGitHubAPI interface{}
+
+func newReleasesGitHubAPI(context.Context, string) releasesGitHubAPI { return nil }
+
+func fetchGitHubReleaseFacts(context.Context, releasesGitHubAPI, string, string, time.Time) githubReleaseFacts {
+	return githubReleaseFacts{}
+}
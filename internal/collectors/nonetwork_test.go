@@ -0,0 +1,32 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+//go:build nonetwork
+
+package collectors
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/davetashner/stringer/internal/collector"
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+func TestNoNetworkBuild_SkipsNetworkCollectors(t *testing.T) {
+	t.Setenv("GITHUB_TOKEN", "test-token")
+	t.Setenv("GITLAB_TOKEN", "test-token")
+	for _, name := range []string{"github", "gitlab", "dephealth", "vuln"} {
+		c := collector.Get(name)
+		if !assert.NotNil(t, c, "%s stays registered so configs naming it validate", name) {
+			continue
+		}
+		checker, ok := c.(collector.CapabilityChecker)
+		if assert.True(t, ok, name) {
+			assert.Equal(t, reasonNoNetworkBuild, checker.CheckCapabilities(context.Background(), t.TempDir(), signal.CollectorOpts{}), name)
+		}
+	}
+	assert.Nil(t, newGitHubContext(context.Background(), t.TempDir()), "lotteryrisk review analysis is off")
+}
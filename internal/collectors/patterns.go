@@ -4,7 +4,6 @@
 package collectors
 
 import (
-	"bufio"
 	"context"
 	"fmt"
 	"log/slog"
@@ -15,6 +14,7 @@ import (
 
 	"github.com/davetashner/stringer/internal/collector"
 	"github.com/davetashner/stringer/internal/gitcli"
+	"github.com/davetashner/stringer/internal/gradle"
 	"github.com/davetashner/stringer/internal/signal"
 )
 
@@ -74,11 +74,17 @@ func init() {
 type PatternsMetrics struct {
 	LargeFiles          int
 	DirectoryTestRatios []DirectoryTestRatio
+
+	// GradleModules lists the projects of a Gradle multi-project build,
+	// which replace directories as test-ratio units. Empty otherwise.
+	GradleModules []gradle.Module
 }
 
-// DirectoryTestRatio describes the test coverage ratio for a directory.
+// DirectoryTestRatio describes the test coverage ratio for a directory, or
+// for a whole Gradle module when Module is set.
 type DirectoryTestRatio struct {
 	Path        string
+	Module      string // Gradle project path, e.g. ":core:data"
 	SourceFiles int
 	TestFiles   int
 	Ratio       float64
@@ -109,6 +115,21 @@ func detectTestRoots(repoPath string) []string {
 // Collect walks source files in repoPath, detects pattern-based signals, and
 // returns them as raw signals.
 func (c *PatternsCollector) Collect(ctx context.Context, repoPath string, opts signal.CollectorOpts) ([]signal.RawSignal, error) {
+	var signals []signal.RawSignal
+	err := c.Stream(ctx, repoPath, opts, func(sig signal.RawSignal) error {
+		signals = append(signals, sig)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return signals, nil
+}
+
+// Stream is Collect for streaming pipelines: large-file and missing-test
+// signals are passed to emit as each file is inspected. Low-test-ratio
+// signals need the whole walk and are emitted at the end.
+func (c *PatternsCollector) Stream(ctx context.Context, repoPath string, opts signal.CollectorOpts, emit func(signal.RawSignal) error) error {
 	excludes := mergeExcludes(opts.ExcludePatterns)
 
 	// Detect parallel test directories before the walk.
@@ -130,8 +151,15 @@ func (c *PatternsCollector) Collect(ctx context.Context, repoPath string, opts s
 		testRatioMinFiles = minSourceFilesForRatio
 	}
 
-	var signals []signal.RawSignal
-	var fileCount int
+	limits := newScanLimits(opts)
+
+	// Timestamps come from the git log of the signal's path.
+	gitRoot := opts.GitRoot
+	if gitRoot == "" {
+		gitRoot = repoPath
+	}
+
+	var fileCount, largeFileCount int
 
 	// Track per-directory file counts for test-ratio analysis.
 	type dirStats struct {
@@ -140,7 +168,15 @@ func (c *PatternsCollector) Collect(ctx context.Context, repoPath string, opts s
 	}
 	dirMap := make(map[string]*dirStats)
 
-	err := FS.WalkDir(repoPath, func(path string, d os.DirEntry, walkErr error) error {
+	// In a Gradle multi-project build, each module is one test-ratio unit:
+	// its tests live in src/test, apart from the sources they cover.
+	modules, modErr := gradle.Modules(repoPath)
+	if modErr != nil {
+		slog.WarnContext(ctx, "patterns: failed to read Gradle settings", "error", modErr)
+	}
+	moduleNames := make(map[string]string)
+
+	err := walkSource(ctx, repoPath, opts, func(path string, d os.DirEntry, walkErr error) error {
 		if walkErr != nil {
 			return nil // skip unreadable entries
 		}
@@ -155,7 +191,7 @@ func (c *PatternsCollector) Collect(ctx context.Context, repoPath string, opts s
 
 		// Skip directories that match exclude patterns early.
 		if d.IsDir() {
-			if shouldExclude(relPath, excludes) {
+			if shouldExclude(relPath, excludes) || !opts.Scope.ContainsDir(relPath) {
 				return filepath.SkipDir
 			}
 			return nil
@@ -166,8 +202,9 @@ func (c *PatternsCollector) Collect(ctx context.Context, repoPath string, opts s
 			return nil
 		}
 
-		// Skip symlinks that resolve outside the repo tree.
-		if d.Type()&os.ModeSymlink != 0 && isSymlinkOutsideRepo(path, repoPath) {
+		// Skip symlinks; targets inside the tree are visited at their
+		// canonical path.
+		if isSymlink(d) {
 			return nil
 		}
 
@@ -176,8 +213,7 @@ func (c *PatternsCollector) Collect(ctx context.Context, repoPath string, opts s
 			return nil
 		}
 
-		// Skip binary files.
-		if isBinaryFile(path) {
+		if !opts.Scope.Contains(relPath) {
 			return nil
 		}
 
@@ -186,16 +222,17 @@ func (c *PatternsCollector) Collect(ctx context.Context, repoPath string, opts s
 			return nil
 		}
 
-		// Count lines.
-		lineCount, countErr := countLines(path)
-		if countErr != nil {
-			return nil // skip files we can't read
+		facts, ok := c.inspectCached(ctx, path, relPath, d, limits, opts.Cache)
+		if !ok {
+			return nil
 		}
+		lineCount, truncated := facts.Lines, facts.Truncated
+		var found []signal.RawSignal
 
 		// C3.1: Large file detection.
-		if lineCount > threshold && !isGeneratedFile(path) {
+		if lineCount > threshold && !facts.Generated {
 			confidence := largeFileConfidence(lineCount, threshold)
-			signals = append(signals, signal.RawSignal{
+			sig := signal.RawSignal{
 				Source:      "patterns",
 				Kind:        "large-file",
 				FilePath:    relPath,
@@ -204,11 +241,21 @@ func (c *PatternsCollector) Collect(ctx context.Context, repoPath string, opts s
 				Description: fmt.Sprintf("File exceeds %d-line threshold. Consider breaking it into smaller, focused modules.", threshold),
 				Confidence:  confidence,
 				Tags:        []string{"large-file"},
-			})
+			}
+			if truncated {
+				sig.Title = fmt.Sprintf("Large file: %s (at least %d lines)", relPath, lineCount)
+				sig.Tags = append(sig.Tags, truncatedScanTag)
+			}
+			found = append(found, sig)
+			largeFileCount++
 		}
 
 		// Track directory stats for test-ratio and missing-test analysis.
 		dir := filepath.Dir(relPath)
+		if m, ok := gradle.Containing(modules, relPath); ok {
+			dir = filepath.FromSlash(m.Dir)
+			moduleNames[dir] = m.Name
+		}
 		if dirMap[dir] == nil {
 			dirMap[dir] = &dirStats{}
 		}
@@ -224,10 +271,10 @@ func (c *PatternsCollector) Collect(ctx context.Context, repoPath string, opts s
 			if lineCount >= minSourceLinesForTestCheck &&
 				!isUnderTestRoot(relPath, testRoots) &&
 				!isUnderMavenTestRoot(relPath) &&
-				!isGeneratedFile(path) {
+				!facts.Generated {
 				if !hasTestCounterpart(path, relPath, repoPath, testRoots) {
 					if opts.IncludeDemoPaths || !isDemoPath(relPath) {
-						signals = append(signals, signal.RawSignal{
+						found = append(found, signal.RawSignal{
 							Source:      "patterns",
 							Kind:        "missing-tests",
 							FilePath:    relPath,
@@ -242,6 +289,13 @@ func (c *PatternsCollector) Collect(ctx context.Context, repoPath string, opts s
 			}
 		}
 
+		enrichTimestamps(ctx, gitRoot, found)
+		for _, sig := range found {
+			if err := emit(sig); err != nil {
+				return err
+			}
+		}
+
 		fileCount++
 		if opts.ProgressFunc != nil && fileCount%500 == 0 {
 			opts.ProgressFunc(fmt.Sprintf("patterns: scanned %d files", fileCount))
@@ -251,22 +305,16 @@ func (c *PatternsCollector) Collect(ctx context.Context, repoPath string, opts s
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("walking repo: %w", err)
+		return fmt.Errorf("walking repo: %w", err)
 	}
 
 	// C3.3: Test-to-source ratio per directory.
 	// Also build metrics from ALL directories (not just below-threshold).
-	largeFileCount := 0
-	for _, sig := range signals {
-		if sig.Kind == "large-file" {
-			largeFileCount++
-		}
-	}
-
+	var ratioSignals []signal.RawSignal
 	var dirRatios []DirectoryTestRatio
 	for dir, stats := range dirMap {
 		if err := ctx.Err(); err != nil {
-			return nil, err
+			return err
 		}
 
 		// Build metrics for every directory with source files,
@@ -275,6 +323,7 @@ func (c *PatternsCollector) Collect(ctx context.Context, repoPath string, opts s
 			ratio := float64(stats.testFiles) / float64(stats.sourceFiles)
 			dirRatios = append(dirRatios, DirectoryTestRatio{
 				Path:        dir,
+				Module:      moduleNames[dir],
 				SourceFiles: stats.sourceFiles,
 				TestFiles:   stats.testFiles,
 				Ratio:       ratio,
@@ -292,12 +341,16 @@ func (c *PatternsCollector) Collect(ctx context.Context, repoPath string, opts s
 
 		ratio := float64(stats.testFiles) / float64(stats.sourceFiles)
 		if ratio < testRatioThreshold {
-			signals = append(signals, signal.RawSignal{
+			unit := dir
+			if name := moduleNames[dir]; name != "" {
+				unit = "module " + name
+			}
+			ratioSignals = append(ratioSignals, signal.RawSignal{
 				Source:      "patterns",
 				Kind:        "low-test-ratio",
 				FilePath:    dir,
 				Line:        0,
-				Title:       fmt.Sprintf("Low test ratio in %s: %d test files / %d source files", dir, stats.testFiles, stats.sourceFiles),
+				Title:       fmt.Sprintf("Low test ratio in %s: %d test files / %d source files", unit, stats.testFiles, stats.sourceFiles),
 				Description: fmt.Sprintf("Test-to-source ratio is %.1f%%, below the %.0f%% threshold. Consider adding more tests.", ratio*100, testRatioThreshold*100),
 				Confidence:  lowTestRatioConfidence,
 				Tags:        []string{"low-test-ratio"},
@@ -312,35 +365,67 @@ func (c *PatternsCollector) Collect(ctx context.Context, repoPath string, opts s
 	c.metrics = &PatternsMetrics{
 		LargeFiles:          largeFileCount,
 		DirectoryTestRatios: dirRatios,
+		GradleModules:       modules,
 	}
 
-	// Enrich signals with timestamps from git log.
-	gitRoot := opts.GitRoot
-	if gitRoot == "" {
-		gitRoot = repoPath
+	enrichTimestamps(ctx, gitRoot, ratioSignals)
+	for _, sig := range ratioSignals {
+		if err := emit(sig); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// fileFacts is what the patterns collector learns from reading a file.
+type fileFacts struct {
+	Lines     int  `json:"lines"`
+	Truncated bool `json:"truncated,omitempty"`
+	Generated bool `json:"generated,omitempty"`
+}
+
+// inspectCached counts a file's lines and checks whether it is generated,
+// replaying both from cache when the file is unchanged since an earlier
+// scan. ok is false for binary and unreadable files. Truncated counts are
+// not stored, since the per-file timeout may cut the next scan elsewhere.
+func (c *PatternsCollector) inspectCached(ctx context.Context, path, relPath string, d os.DirEntry, limits scanLimits, cache signal.FileCache) (facts fileFacts, ok bool) {
+	info, infoErr := d.Info()
+	if infoErr != nil || info.Size() > limits.maxBytes {
+		cache = nil
+	}
+	if cache != nil && cache.Get(c.Name(), relPath, info, &facts) {
+		return facts, true
 	}
-	enrichTimestamps(ctx, gitRoot, signals)
 
-	return signals, nil
+	// Skip binary files; text in other supported encodings is kept.
+	if isUndecodableFile(ctx, path) {
+		return facts, false
+	}
+	lines, truncated, err := countLines(ctx, path, limits)
+	if err != nil {
+		return facts, false // skip files we can't read
+	}
+	if truncated {
+		slog.WarnContext(ctx, "patterns: file scan truncated", "path", relPath, "lines_scanned", lines)
+	}
+	facts = fileFacts{Lines: lines, Truncated: truncated, Generated: isGeneratedFile(path)}
+	if cache != nil && !truncated {
+		cache.Put(c.Name(), relPath, info, facts)
+	}
+	return facts, true
 }
 
-// countLines counts the number of lines in a file using bufio.Scanner.
-func countLines(path string) (int, error) {
-	f, err := FS.Open(path)
+// countLines counts the number of lines in a file, stopping at the per-file
+// size cap or timeout. When truncated is true, count is a lower bound.
+func countLines(ctx context.Context, path string, limits scanLimits) (count int, truncated bool, err error) {
+	f, err := openSource(ctx, path)
 	if err != nil {
-		return 0, err
+		return 0, false, err
 	}
 	defer f.Close() //nolint:errcheck // read-only file
 
-	count := 0
-	scanner := bufio.NewScanner(f)
-	for scanner.Scan() {
-		count++
-	}
-	if err := scanner.Err(); err != nil {
-		return count, err
-	}
-	return count, nil
+	truncated, err = limits.scanLines(ctx, f, func(string) { count++ })
+	return count, truncated, err
 }
 
 // largeFileConfidence scales confidence from 0.4 (just over threshold) to 0.8
@@ -367,7 +452,7 @@ func enrichTimestamps(ctx context.Context, gitRoot string, signals []signal.RawS
 		}
 		t, err := gitcli.LastCommitTime(ctx, gitRoot, signals[i].FilePath)
 		if err != nil {
-			slog.Debug("enrichTimestamps: git log failed", "path", signals[i].FilePath, "error", err)
+			slog.DebugContext(ctx, "enrichTimestamps: git log failed", "path", signals[i].FilePath, "error", err)
 			continue
 		}
 		signals[i].Timestamp = t
@@ -377,3 +462,4 @@ func enrichTimestamps(ctx context.Context, gitRoot string, signals []signal.RawS
 // Compile-time interface checks.
 var _ collector.Collector = (*PatternsCollector)(nil)
 var _ collector.MetricsProvider = (*PatternsCollector)(nil)
+var _ collector.Streamer = (*PatternsCollector)(nil)
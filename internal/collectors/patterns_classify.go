@@ -9,6 +9,12 @@ import (
 	"strings"
 )
 
+// IsTestFile reports whether relPath follows a common test-file naming
+// convention. See isTestFile.
+func IsTestFile(relPath string) bool {
+	return isTestFile(relPath)
+}
+
 // isTestFile returns true if the filename matches common test-file naming
 // conventions across languages.
 func isTestFile(relPath string) bool {
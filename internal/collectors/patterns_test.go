@@ -16,6 +16,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/davetashner/stringer/internal/gitignore"
 	"github.com/davetashner/stringer/internal/signal"
 	"github.com/davetashner/stringer/internal/testable"
 )
@@ -299,6 +300,39 @@ func TestLowTestRatioDetected(t *testing.T) {
 	assert.Contains(t, ratioSignals[0].Tags, "low-test-ratio")
 }
 
+func TestLowTestRatio_GradleModules(t *testing.T) {
+	dir := t.TempDir()
+	writeTestFile(t, dir, "settings.gradle.kts", "include(\":app\", \":core:data\")\n")
+	// :app keeps its tests apart from its sources, as Gradle does; per
+	// directory, src/main would always look untested.
+	for i := 0; i < 3; i++ {
+		writeTestFile(t, dir, fmt.Sprintf("app/src/main/kotlin/App%d.kt", i), "class App\n")
+	}
+	writeTestFile(t, dir, "app/src/test/kotlin/App0Test.kt", "class AppTest\n")
+	for i := 0; i < 4; i++ {
+		writeTestFile(t, dir, fmt.Sprintf("core/data/src/main/kotlin/Repo%d.kt", i), "class Repo\n")
+	}
+
+	c := &PatternsCollector{}
+	signals, err := c.Collect(context.Background(), dir, signal.CollectorOpts{})
+	require.NoError(t, err)
+
+	var ratioSignals []signal.RawSignal
+	for _, s := range signals {
+		if s.Kind == "low-test-ratio" {
+			ratioSignals = append(ratioSignals, s)
+		}
+	}
+	require.Len(t, ratioSignals, 1)
+	assert.Equal(t, filepath.Join("core", "data"), ratioSignals[0].FilePath)
+	assert.Equal(t, "Low test ratio in module :core:data: 0 test files / 4 source files", ratioSignals[0].Title)
+
+	m := c.Metrics().(*PatternsMetrics)
+	assert.Len(t, m.GradleModules, 2)
+	require.Len(t, m.DirectoryTestRatios, 2)
+	assert.Equal(t, DirectoryTestRatio{Path: "app", Module: ":app", SourceFiles: 3, TestFiles: 1, Ratio: 1.0 / 3}, m.DirectoryTestRatios[0])
+}
+
 func TestLowTestRatioNotDetectedWhenEnoughTests(t *testing.T) {
 	dir := t.TempDir()
 	subdir := filepath.Join(dir, "pkg")
@@ -503,7 +537,7 @@ func TestCountLines(t *testing.T) {
 	path := filepath.Join(dir, "lines.txt")
 	require.NoError(t, os.WriteFile(path, []byte("a\nb\nc\n"), 0o600))
 
-	count, err := countLines(path)
+	count, _, err := countLines(context.Background(), path, newScanLimits(signal.CollectorOpts{}))
 	require.NoError(t, err)
 	assert.Equal(t, 3, count)
 }
@@ -513,7 +547,7 @@ func TestCountLinesEmpty(t *testing.T) {
 	path := filepath.Join(dir, "empty.txt")
 	require.NoError(t, os.WriteFile(path, []byte{}, 0o600))
 
-	count, err := countLines(path)
+	count, _, err := countLines(context.Background(), path, newScanLimits(signal.CollectorOpts{}))
 	require.NoError(t, err)
 	assert.Equal(t, 0, count)
 }
@@ -578,7 +612,7 @@ func TestNonSourceExtensionsSkipped(t *testing.T) {
 // --- countLines edge case tests ---
 
 func TestCountLines_NonexistentFile(t *testing.T) {
-	_, err := countLines("/nonexistent/path/to/file.go")
+	_, _, err := countLines(context.Background(), "/nonexistent/path/to/file.go", newScanLimits(signal.CollectorOpts{}))
 	assert.Error(t, err, "nonexistent file should return error")
 }
 
@@ -587,7 +621,7 @@ func TestCountLines_SingleLineNoNewline(t *testing.T) {
 	path := filepath.Join(dir, "one.txt")
 	require.NoError(t, os.WriteFile(path, []byte("no newline"), 0o600))
 
-	count, err := countLines(path)
+	count, _, err := countLines(context.Background(), path, newScanLimits(signal.CollectorOpts{}))
 	require.NoError(t, err)
 	assert.Equal(t, 1, count)
 }
@@ -919,6 +953,34 @@ func TestPatterns_ExcludedFilePattern(t *testing.T) {
 	assert.True(t, found, "non-excluded large file should be detected")
 }
 
+func TestPatterns_ScanCache(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "small.go", "package main\n")
+	writeFile(t, dir, "other.go", "package main\n")
+
+	// A cached line count is trusted over the file on disk.
+	cache := newMemCache()
+	cache.Put("patterns", "small.go", nil, fileFacts{Lines: 2000})
+	cache.puts = nil
+
+	c := &PatternsCollector{}
+	signals, err := c.Collect(context.Background(), dir, signal.CollectorOpts{Cache: cache})
+	require.NoError(t, err)
+
+	var large []string
+	for _, s := range signals {
+		if s.Kind == "large-file" {
+			large = append(large, s.FilePath)
+		}
+	}
+	assert.Equal(t, []string{"small.go"}, large)
+	assert.Equal(t, []string{"patterns:other.go"}, cache.puts)
+
+	var facts fileFacts
+	require.True(t, cache.Get("patterns", "other.go", nil, &facts))
+	assert.Equal(t, fileFacts{Lines: 1}, facts)
+}
+
 // --- Patterns Collect: broken symlink ---
 
 func TestPatterns_BrokenSymlinkSkipped(t *testing.T) {
@@ -1711,3 +1773,49 @@ func TestPatterns_ConfigurableTestRatioMinFiles(t *testing.T) {
 	lowRatio2 := filterByKind(sigs2, "low-test-ratio")
 	assert.NotEmpty(t, lowRatio2, "2 files should trigger with min-files=1")
 }
+
+func TestPatternsCollector_TruncatedLargeFile(t *testing.T) {
+	dir := t.TempDir()
+	content := strings.Repeat("package main\n", 2000)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "huge.go"), []byte(content), 0o600))
+
+	c := &PatternsCollector{}
+	signals, err := c.Collect(context.Background(), dir, signal.CollectorOpts{
+		LargeFileThreshold: 100,
+		MaxFileSize:        13 * 500, // 500 lines
+	})
+	require.NoError(t, err)
+
+	var large *signal.RawSignal
+	for i := range signals {
+		if signals[i].Kind == "large-file" && signals[i].FilePath == "huge.go" {
+			large = &signals[i]
+		}
+	}
+	require.NotNil(t, large)
+	assert.Equal(t, "Large file: huge.go (at least 500 lines)", large.Title)
+	assert.Contains(t, large.Tags, "truncated-scan")
+}
+
+func TestPatterns_SkipsGitignored(t *testing.T) {
+	dir := t.TempDir()
+	big := strings.Repeat("package main\n", 1600)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".gitignore"), []byte("build/\n"), 0o600))
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "build"), 0o750))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "build", "big.go"), []byte(big), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "main.go"), []byte(big), 0o600))
+
+	c := &PatternsCollector{}
+	signals, err := c.Collect(context.Background(), dir, signal.CollectorOpts{Ignore: gitignore.New(dir)})
+	require.NoError(t, err)
+	for _, s := range signals {
+		assert.NotContains(t, s.FilePath, "build/", "signal from gitignored directory")
+	}
+	var found bool
+	for _, s := range signals {
+		if s.Kind == "large-file" && s.FilePath == "main.go" {
+			found = true
+		}
+	}
+	assert.True(t, found, "expected large-file signal for main.go")
+}
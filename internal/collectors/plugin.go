@@ -0,0 +1,302 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package collectors
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/davetashner/stringer/internal/collector"
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+// PluginProtocol is the version of the request plugins receive on stdin.
+const PluginProtocol = 1
+
+// DefaultPluginTimeout bounds a plugin run when its config sets no timeout.
+const DefaultPluginTimeout = 60 * time.Second
+
+// maxPluginStderr caps how much of a plugin's stderr is kept for errors.
+const maxPluginStderr = 4096
+
+// maxPluginLine caps the length of one JSONL line from a plugin.
+const maxPluginLine = 1 << 20
+
+// maxPluginOutput caps a plugin's stdout. A plugin that writes more is
+// stopped and fails. A variable so tests can lower it.
+var maxPluginOutput = 64 << 20
+
+// errPluginOutputFull is returned to a plugin writing past maxPluginOutput.
+var errPluginOutputFull = errors.New("plugin output limit reached")
+
+// PluginSpec describes an external executable or WASM module run as a
+// collector. Exactly one of Command and WASM is set.
+type PluginSpec struct {
+	Name    string
+	Command string // resolved against Dir when it contains a path separator
+	Args    []string
+	Timeout time.Duration // 0 uses DefaultPluginTimeout
+	Dir     string        // directory of the config that declared the plugin
+
+	// WASM is the path of a WASI module, resolved against Dir when
+	// relative. It runs sandboxed; see runWASM.
+	WASM string
+
+	// ReadRepo mounts the scanned directory read-only at /repo in a WASM
+	// module. Without it the module sees no files.
+	ReadRepo bool
+
+	// MemoryMB caps a WASM module's memory; 0 uses DefaultPluginMemoryMB.
+	MemoryMB int
+}
+
+// PluginRequest is the JSON a plugin reads from stdin.
+type PluginRequest struct {
+	Protocol        int      `json:"protocol"`
+	Collector       string   `json:"collector"`
+	RepoPath        string   `json:"repo_path"`
+	GitRoot         string   `json:"git_root,omitempty"`
+	MinConfidence   float64  `json:"min_confidence,omitempty"`
+	IncludePatterns []string `json:"include_patterns,omitempty"`
+	ExcludePatterns []string `json:"exclude_patterns,omitempty"`
+	GitDepth        int      `json:"git_depth,omitempty"`
+	GitSince        string   `json:"git_since,omitempty"`
+	MaxIssues       int      `json:"max_issues,omitempty"`
+}
+
+// PluginCollector runs an external executable or WASM module that writes
+// signals as JSON lines, one RawSignal per line, in the same shape as
+// --format json. Its signals go through the same filters as built-in
+// collectors.
+type PluginCollector struct {
+	spec PluginSpec
+}
+
+// NewPluginCollector returns a collector for spec.
+func NewPluginCollector(spec PluginSpec) *PluginCollector {
+	return &PluginCollector{spec: spec}
+}
+
+// Name returns the plugin name used for registration and filtering.
+func (c *PluginCollector) Name() string { return c.spec.Name }
+
+// Collect runs the plugin in repoPath with the scan options on stdin and
+// parses the signals it writes to stdout. A non-zero exit, a timeout, or a
+// malformed line fails the collector; stderr is included in the error.
+func (c *PluginCollector) Collect(ctx context.Context, repoPath string, opts signal.CollectorOpts) ([]signal.RawSignal, error) {
+	timeout := c.spec.Timeout
+	if timeout <= 0 {
+		timeout = DefaultPluginTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	runCtx, stop := context.WithCancel(ctx)
+	defer stop()
+
+	req := PluginRequest{
+		Protocol:        PluginProtocol,
+		Collector:       c.spec.Name,
+		RepoPath:        repoPath,
+		GitRoot:         opts.GitRoot,
+		MinConfidence:   opts.MinConfidence,
+		IncludePatterns: opts.IncludePatterns,
+		ExcludePatterns: opts.ExcludePatterns,
+		GitDepth:        opts.GitDepth,
+		GitSince:        opts.GitSince,
+		MaxIssues:       opts.MaxIssues,
+	}
+	run := c.runExec
+	if c.spec.WASM != "" {
+		// The module sees the repository, if at all, at its mount point.
+		req.RepoPath, req.GitRoot = wasmRepoMount, ""
+		run = func(ctx context.Context, repoPath string, req []byte, stdout, stderr io.Writer) error {
+			return c.runWASM(ctx, repoPath, req, stdout, stderr, opts.Cache != nil)
+		}
+	}
+	data, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("plugin %s: encode request: %w", c.spec.Name, err)
+	}
+
+	stdout := &capBuffer{max: maxPluginOutput, onFull: stop}
+	stderr := &tailBuffer{max: maxPluginStderr}
+	if err := run(runCtx, repoPath, data, stdout, stderr); err != nil || stdout.full {
+		if stdout.full {
+			return nil, fmt.Errorf("plugin %s: output exceeds %d bytes", c.spec.Name, maxPluginOutput)
+		}
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return nil, fmt.Errorf("plugin %s timed out after %s: %w", c.spec.Name, timeout, ctx.Err())
+		}
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return nil, fmt.Errorf("plugin %s: %w: %s", c.spec.Name, err, msg)
+		}
+		return nil, fmt.Errorf("plugin %s: %w", c.spec.Name, err)
+	}
+	if msg := strings.TrimSpace(stderr.String()); msg != "" {
+		slog.DebugContext(ctx, "plugin stderr", "plugin", c.spec.Name, "output", msg)
+	}
+
+	signals, err := parsePluginSignals(c.spec.Name, &stdout.buf)
+	if err != nil {
+		return nil, err
+	}
+	return filterPluginSignals(signals, opts), nil
+}
+
+// runExec runs the plugin command in repoPath.
+func (c *PluginCollector) runExec(ctx context.Context, repoPath string, req []byte, stdout, stderr io.Writer) error {
+	cmd := exec.CommandContext(ctx, c.command(), c.spec.Args...) //nolint:gosec // command comes from the user's config
+	cmd.Dir = repoPath
+	cmd.Stdin = bytes.NewReader(req)
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+	cmd.WaitDelay = time.Second
+	return cmd.Run()
+}
+
+// command resolves the plugin command: paths relative to the declaring
+// config's directory, bare names through PATH.
+func (c *PluginCollector) command() string {
+	cmd := c.spec.Command
+	if c.spec.Dir != "" && !filepath.IsAbs(cmd) && strings.ContainsRune(cmd, filepath.Separator) {
+		return filepath.Join(c.spec.Dir, cmd)
+	}
+	return cmd
+}
+
+// parsePluginSignals reads one RawSignal per non-blank line. Every signal
+// is attributed to the plugin, and one without recorded factors gets its
+// confidence as the base factor.
+func parsePluginSignals(name string, r *bytes.Buffer) ([]signal.RawSignal, error) {
+	var signals []signal.RawSignal
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 0, 64*1024), maxPluginLine)
+	for n := 1; sc.Scan(); n++ {
+		line := bytes.TrimSpace(sc.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		var sig signal.RawSignal
+		if err := json.Unmarshal(line, &sig); err != nil {
+			return nil, fmt.Errorf("plugin %s: line %d: %w", name, n, err)
+		}
+		if sig.Kind == "" || sig.Title == "" {
+			return nil, fmt.Errorf("plugin %s: line %d: signal needs a Kind and a Title", name, n)
+		}
+		if sig.Confidence < 0 || sig.Confidence > 1 {
+			return nil, fmt.Errorf("plugin %s: line %d: Confidence must be between 0.0 and 1.0, got %g", name, n, sig.Confidence)
+		}
+		sig.Source = name
+		sig.FilePath = filepath.ToSlash(sig.FilePath)
+		if len(sig.Factors) == 0 {
+			sig.AddFactor("base", sig.Confidence, "reported by plugin "+name)
+		}
+		signals = append(signals, sig)
+	}
+	if err := sc.Err(); err != nil {
+		return nil, fmt.Errorf("plugin %s: read output: %w", name, err)
+	}
+	return signals, nil
+}
+
+// filterPluginSignals applies the collector options plugins may ignore:
+// min_confidence, include_patterns, and exclude_patterns (on top of the
+// default excludes).
+func filterPluginSignals(signals []signal.RawSignal, opts signal.CollectorOpts) []signal.RawSignal {
+	excludes := mergeExcludes(opts.ExcludePatterns)
+	kept := signals[:0]
+	for _, sig := range signals {
+		if sig.Confidence < opts.MinConfidence {
+			continue
+		}
+		if sig.FilePath != "" {
+			relPath := filepath.FromSlash(sig.FilePath)
+			if shouldExclude(relPath, excludes) {
+				continue
+			}
+			if len(opts.IncludePatterns) > 0 && !matchesAny(relPath, opts.IncludePatterns) {
+				continue
+			}
+		}
+		kept = append(kept, sig)
+	}
+	return kept
+}
+
+// capBuffer keeps up to max bytes written to it. The write that would go
+// past max fails, and onFull is called once to stop the writer. The buffer
+// is a field, not embedded, so io.Copy cannot bypass Write via ReadFrom.
+type capBuffer struct {
+	buf    bytes.Buffer
+	max    int
+	full   bool
+	onFull func()
+}
+
+func (b *capBuffer) Write(p []byte) (int, error) {
+	if !b.full && b.buf.Len()+len(p) > b.max {
+		b.full = true
+		b.onFull()
+	}
+	if b.full {
+		return 0, errPluginOutputFull
+	}
+	return b.buf.Write(p)
+}
+
+// tailBuffer keeps the last max bytes written to it.
+type tailBuffer struct {
+	max int
+	buf []byte
+}
+
+func (b *tailBuffer) Write(p []byte) (int, error) {
+	b.buf = append(b.buf, p...)
+	if len(b.buf) > b.max {
+		b.buf = b.buf[len(b.buf)-b.max:]
+	}
+	return len(p), nil
+}
+
+func (b *tailBuffer) String() string { return string(b.buf) }
+
+var (
+	pluginMu sync.Mutex
+	plugins  []string // names registered by the last RegisterPlugins call
+)
+
+// RegisterPlugins registers a collector for each spec, replacing the
+// plugins an earlier call registered. A spec that shares a name with a
+// built-in collector is an error, and nothing from this call is registered.
+func RegisterPlugins(specs []PluginSpec) error {
+	pluginMu.Lock()
+	defer pluginMu.Unlock()
+
+	for _, name := range plugins {
+		collector.Unregister(name)
+	}
+	plugins = nil
+	for _, spec := range specs {
+		if err := collector.TryRegister(NewPluginCollector(spec)); err != nil {
+			for _, name := range plugins {
+				collector.Unregister(name)
+			}
+			plugins = nil
+			return fmt.Errorf("plugin %s: name is taken by a built-in collector", spec.Name)
+		}
+		plugins = append(plugins, spec.Name)
+	}
+	return nil
+}
@@ -0,0 +1,136 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package collectors
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davetashner/stringer/internal/collector"
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+// writePlugin writes an executable shell script to dir/name.
+func writePlugin(t *testing.T, dir, name, script string) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	require.NoError(t, os.WriteFile(path, []byte("#!/bin/sh\n"+script), 0o755)) //nolint:gosec // test plugin must be executable
+	return path
+}
+
+func TestPluginCollector_Collect(t *testing.T) {
+	dir := t.TempDir()
+	writePlugin(t, dir, "license-check", `cat > request.json
+cat <<'EOF'
+{"Kind": "missing-license", "FilePath": "main.go", "Line": 1, "Title": "No license header", "Confidence": 0.7, "Tags": ["license"]}
+
+{"Source": "spoofed", "Kind": "missing-license", "FilePath": "vendor/x.go", "Title": "Vendored", "Confidence": 0.7}
+{"Kind": "missing-license", "FilePath": "doc.go", "Title": "Low", "Confidence": 0.2}
+EOF
+`)
+
+	c := NewPluginCollector(PluginSpec{Name: "license", Command: "./license-check", Dir: dir})
+	assert.Equal(t, "license", c.Name())
+	signals, err := c.Collect(context.Background(), dir, signal.CollectorOpts{MinConfidence: 0.5, GitDepth: 10})
+	require.NoError(t, err)
+
+	require.Len(t, signals, 1, "default excludes and min_confidence apply")
+	assert.Equal(t, "license", signals[0].Source)
+	assert.Equal(t, "No license header", signals[0].Title)
+	assert.Equal(t, []string{"license"}, signals[0].Tags)
+	assert.Equal(t, []signal.ConfidenceFactor{{Name: "base", Delta: 0.7, Detail: "reported by plugin license"}}, signals[0].Factors)
+
+	data, err := os.ReadFile(filepath.Join(dir, "request.json")) //nolint:gosec // test file
+	require.NoError(t, err)
+	var req PluginRequest
+	require.NoError(t, json.Unmarshal(data, &req))
+	assert.Equal(t, PluginRequest{Protocol: 1, Collector: "license", RepoPath: dir, MinConfidence: 0.5, GitDepth: 10}, req)
+}
+
+func TestPluginCollector_IncludePatterns(t *testing.T) {
+	dir := t.TempDir()
+	writePlugin(t, dir, "p", `echo '{"Kind": "k", "FilePath": "a.go", "Title": "a"}'
+echo '{"Kind": "k", "FilePath": "a.py", "Title": "b"}'
+echo '{"Kind": "k", "Title": "repo-wide"}'
+`)
+	c := NewPluginCollector(PluginSpec{Name: "p", Command: filepath.Join(dir, "p")})
+	signals, err := c.Collect(context.Background(), dir, signal.CollectorOpts{IncludePatterns: []string{"*.go"}})
+	require.NoError(t, err)
+	require.Len(t, signals, 2)
+	assert.Equal(t, "a", signals[0].Title)
+	assert.Equal(t, "repo-wide", signals[1].Title, "signals without a file are kept")
+}
+
+func TestPluginCollector_Errors(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		script string
+		want   string
+	}{
+		{"exit status", "echo 'token expired' >&2\nexit 3\n", "plugin p: exit status 3: token expired"},
+		{"bad json", "echo '{\"Kind\": \"k\", \"Title\": \"a\"}'\necho 'not json'\n", "plugin p: line 2:"},
+		{"missing title", `echo '{"Kind": "k"}'` + "\n", "plugin p: line 1: signal needs a Kind and a Title"},
+		{"confidence range", `echo '{"Kind": "k", "Title": "a", "Confidence": 1.5}'` + "\n", "Confidence must be between 0.0 and 1.0, got 1.5"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			dir := t.TempDir()
+			writePlugin(t, dir, "p", tc.script)
+			_, err := NewPluginCollector(PluginSpec{Name: "p", Command: "./p", Dir: dir}).Collect(context.Background(), dir, signal.CollectorOpts{})
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.want)
+		})
+	}
+}
+
+func TestPluginCollector_Timeout(t *testing.T) {
+	dir := t.TempDir()
+	writePlugin(t, dir, "slow", "exec sleep 5\n")
+	c := NewPluginCollector(PluginSpec{Name: "slow", Command: "./slow", Dir: dir, Timeout: 100 * time.Millisecond})
+
+	start := time.Now()
+	_, err := c.Collect(context.Background(), dir, signal.CollectorOpts{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "plugin slow timed out after 100ms")
+	assert.Less(t, time.Since(start), 3*time.Second)
+}
+
+func TestPluginCollector_OutputLimit(t *testing.T) {
+	orig := maxPluginOutput
+	maxPluginOutput = 4096
+	t.Cleanup(func() { maxPluginOutput = orig })
+	dir := t.TempDir()
+	writePlugin(t, dir, "flood", `while :; do echo '{"Kind": "k", "Title": "t"}'; done
+`)
+
+	c := NewPluginCollector(PluginSpec{Name: "flood", Command: "./flood", Dir: dir, Timeout: time.Minute})
+	start := time.Now()
+	_, err := c.Collect(context.Background(), dir, signal.CollectorOpts{})
+	require.Error(t, err)
+	assert.Equal(t, "plugin flood: output exceeds 4096 bytes", err.Error())
+	assert.Less(t, time.Since(start), 30*time.Second, "the plugin is stopped, not left to time out")
+}
+
+func TestRegisterPlugins(t *testing.T) {
+	t.Cleanup(func() { _ = RegisterPlugins(nil) })
+
+	require.NoError(t, RegisterPlugins([]PluginSpec{{Name: "plugin-a", Command: "a"}, {Name: "plugin-b", Command: "b"}}))
+	assert.IsType(t, &PluginCollector{}, collector.Get("plugin-a"))
+
+	require.NoError(t, RegisterPlugins([]PluginSpec{{Name: "plugin-b", Command: "b2"}}))
+	assert.Nil(t, collector.Get("plugin-a"), "plugins from an earlier call are replaced")
+	assert.Equal(t, "b2", collector.Get("plugin-b").(*PluginCollector).spec.Command)
+
+	err := RegisterPlugins([]PluginSpec{{Name: "plugin-c", Command: "c"}, {Name: "todos", Command: "t"}})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "plugin todos: name is taken by a built-in collector")
+	assert.Nil(t, collector.Get("plugin-c"), "a failed call registers nothing")
+	assert.IsType(t, &TodoCollector{}, collector.Get("todos"))
+}
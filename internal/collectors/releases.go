@@ -0,0 +1,512 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package collectors
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/davetashner/stringer/internal/collector"
+	"github.com/davetashner/stringer/internal/gitcli"
+	"github.com/davetashner/stringer/internal/signal"
+	"github.com/davetashner/stringer/internal/testable"
+)
+
+const (
+	// unreleasedCommitThreshold is the number of commits since the latest
+	// version tag that makes unreleased work worth a release.
+	unreleasedCommitThreshold = 20
+
+	// unreleasedAgeThreshold is how long the oldest unreleased commit may
+	// wait before it is worth a release, however few commits there are.
+	unreleasedAgeThreshold = 90 * 24 * time.Hour
+
+	// maxReleaseNoteTags is the number of most recent version tags checked
+	// for release notes. Older releases rarely get notes after the fact.
+	maxReleaseNoteTags = 10
+
+	// maxListedChangelogPRs caps the pull requests a missing-changelog
+	// signal lists.
+	maxListedChangelogPRs = 10
+)
+
+// changelogNames are the files read as the changelog, in order of
+// preference.
+var changelogNames = []string{"CHANGELOG.md", "CHANGELOG", "CHANGES.md", "HISTORY.md", "RELEASES.md", "changelog.md"}
+
+// skipChangelogLabels are pull request labels that mark a change as not
+// needing a changelog entry.
+var skipChangelogLabels = map[string]bool{
+	"skip-changelog": true, "no-changelog": true, "skip changelog": true,
+	"no changelog": true, "changelog: skip": true, "dependencies": true, "chore": true,
+}
+
+var (
+	// versionTagPattern matches release tags such as v1.2, 1.2.3, and
+	// v2.0.0-rc.1.
+	versionTagPattern = regexp.MustCompile(`^v?(\d+\.\d+(?:\.\d+)?(?:[-+][0-9A-Za-z.-]+)?)$`)
+
+	// changelogVersionPattern finds versions in changelog headings.
+	changelogVersionPattern = regexp.MustCompile(`\bv?(\d+\.\d+(?:\.\d+)?(?:-[0-9A-Za-z.]+)?)`)
+
+	// changelogPRPattern finds pull request references in a changelog.
+	changelogPRPattern = regexp.MustCompile(`(?:#|/pull/)(\d+)\b`)
+
+	// squashSubjectPattern and mergeSubjectPattern find the pull request
+	// of a squash-merge or merge commit subject.
+	squashSubjectPattern = regexp.MustCompile(`\(#(\d+)\)\s*$`)
+	mergeSubjectPattern  = regexp.MustCompile(`^Merge pull request #(\d+)`)
+)
+
+func init() {
+	collector.Register(&ReleasesCollector{})
+}
+
+// ReleasesMetrics holds structured metrics from the release hygiene scan.
+type ReleasesMetrics struct {
+	VersionTags             int
+	LatestTag               string
+	UnreleasedCommits       int
+	UnreleasedPRs           int
+	Changelog               string
+	TagsWithoutNotes        int
+	MissingChangelogEntries int
+}
+
+// ReleasesCollector compares version tags with the changelog and the pull
+// requests merged since the last release. It flags work that has waited
+// too long for a release, merged pull requests the changelog does not
+// mention, and recent tags that have neither a changelog section nor a
+// GitHub release with notes. Repositories without version tags are not
+// checked.
+type ReleasesCollector struct {
+	metrics *ReleasesMetrics
+}
+
+// Name returns the collector name used for registration and filtering.
+func (c *ReleasesCollector) Name() string { return "releases" }
+
+// CheckCapabilities reports a skip reason when there is no git history.
+func (c *ReleasesCollector) CheckCapabilities(_ context.Context, repoPath string, opts signal.CollectorOpts) string {
+	gitRoot := repoPath
+	if opts.GitRoot != "" {
+		gitRoot = opts.GitRoot
+	}
+	return gitHistoryReason(nil, gitRoot)
+}
+
+// versionTag is a tag naming a release.
+type versionTag struct {
+	name    string
+	version string // without the v prefix
+	date    time.Time
+}
+
+// mergedWork is a pull request merged since the latest version tag.
+type mergedWork struct {
+	number int
+	title  string
+	labels []string
+}
+
+// githubReleaseFacts is what GitHub adds to the checks.
+type githubReleaseFacts struct {
+	notes  map[string]bool // tag name → its release has notes; nil when unknown
+	merged []mergedWork    // pull requests merged since the latest tag
+}
+
+// changelog is what the checks read from the changelog file.
+type changelog struct {
+	path              string // relative to the scanned directory
+	versions          map[string]bool
+	prs               map[int]bool
+	unreleasedEntries int
+}
+
+// Collect reads the version tags, the commits since the latest one, and
+// the changelog, then emits release-hygiene signals.
+func (c *ReleasesCollector) Collect(ctx context.Context, repoPath string, opts signal.CollectorOpts) ([]signal.RawSignal, error) {
+	metrics := &ReleasesMetrics{}
+	c.metrics = metrics
+
+	gitRoot := repoPath
+	if opts.GitRoot != "" {
+		gitRoot = opts.GitRoot
+	}
+
+	tags, err := versionTags(ctx, gitRoot)
+	if err != nil {
+		return nil, err
+	}
+	metrics.VersionTags = len(tags)
+	if len(tags) == 0 {
+		return nil, nil
+	}
+	latest := tags[0]
+	metrics.LatestTag = latest.name
+
+	commits, err := unreleasedCommits(ctx, gitRoot, repoPath, latest.name)
+	if err != nil {
+		return nil, err
+	}
+	cl := readChangelog(repoPath)
+	if cl != nil {
+		metrics.Changelog = cl.path
+	}
+
+	var gh githubReleaseFacts
+	if token := os.Getenv("GITHUB_TOKEN"); token != "" {
+		if owner, repo, err := githubRepoFor(testable.DefaultGitOpener, gitRoot, opts); err == nil {
+			gh = fetchGitHubReleaseFacts(ctx, newReleasesGitHubAPI(ctx, token), owner, repo, latest.date)
+		}
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	prs := mergedSince(commits, gh.merged)
+	commits = withoutMerges(commits)
+	metrics.UnreleasedCommits = len(commits)
+	metrics.UnreleasedPRs = len(prs)
+
+	var signals []signal.RawSignal
+	emit := func(sig signal.RawSignal) bool {
+		if sig.Confidence < opts.MinConfidence {
+			return false
+		}
+		signals = append(signals, sig)
+		return true
+	}
+
+	if sig, ok := unreleasedWorkSignal(latest, commits, len(prs), time.Now()); ok {
+		emit(sig)
+	}
+	if cl != nil {
+		if sig, missing := missingChangelogSignal(cl, latest, commits, prs); missing > 0 && emit(sig) {
+			metrics.MissingChangelogEntries = missing
+		}
+	}
+	if cl != nil || gh.notes != nil {
+		for i, tag := range tags[:min(len(tags), maxReleaseNoteTags)] {
+			if (cl != nil && cl.versions[tag.version]) || gh.notes[tag.name] {
+				continue
+			}
+			if emit(missingNotesSignal(tag, i == 0, cl)) {
+				metrics.TagsWithoutNotes++
+			}
+		}
+	}
+	return signals, nil
+}
+
+// versionTags returns the repository's version tags, newest first.
+func versionTags(ctx context.Context, gitRoot string) ([]versionTag, error) {
+	// The last sort key is the primary one; tags of the same second are
+	// ordered by version.
+	out, err := gitcli.Exec(ctx, gitRoot, "for-each-ref", "--sort=-v:refname", "--sort=-creatordate",
+		"--format=%(refname:short)%09%(creatordate:unix)", "refs/tags")
+	if err != nil {
+		return nil, fmt.Errorf("listing tags: %w", err)
+	}
+	var tags []versionTag
+	for _, line := range strings.Split(out, "\n") {
+		name, unix, ok := strings.Cut(line, "\t")
+		m := versionTagPattern.FindStringSubmatch(name)
+		if !ok || m == nil {
+			continue
+		}
+		secs, err := strconv.ParseInt(unix, 10, 64)
+		if err != nil {
+			continue
+		}
+		tags = append(tags, versionTag{name: name, version: m[1], date: time.Unix(secs, 0)})
+	}
+	return tags, nil
+}
+
+// unreleasedCommit is a commit made since the latest version tag.
+type unreleasedCommit struct {
+	date    time.Time
+	subject string
+	merge   bool
+	pr      int // 0 when the subject names no pull request
+}
+
+// unreleasedCommits returns the commits after tag that touch the scanned
+// directory, newest first.
+func unreleasedCommits(ctx context.Context, gitRoot, repoPath, tag string) ([]unreleasedCommit, error) {
+	args := []string{"log", "--format=%ct%x09%P%x09%s", tag + "..HEAD"}
+	if rel, err := filepath.Rel(gitRoot, repoPath); err == nil && rel != "." {
+		args = append(args, "--", filepath.ToSlash(rel))
+	}
+	out, err := gitcli.Exec(ctx, gitRoot, args...)
+	if err != nil {
+		return nil, fmt.Errorf("listing commits since %s: %w", tag, err)
+	}
+
+	var commits []unreleasedCommit
+	for _, line := range strings.Split(out, "\n") {
+		fields := strings.SplitN(line, "\t", 3)
+		if len(fields) != 3 {
+			continue
+		}
+		secs, err := strconv.ParseInt(fields[0], 10, 64)
+		if err != nil {
+			continue
+		}
+		c := unreleasedCommit{
+			date:    time.Unix(secs, 0),
+			subject: fields[2],
+			merge:   len(strings.Fields(fields[1])) > 1,
+		}
+		for _, p := range []*regexp.Regexp{squashSubjectPattern, mergeSubjectPattern} {
+			if m := p.FindStringSubmatch(c.subject); m != nil {
+				c.pr, _ = strconv.Atoi(m[1])
+			}
+		}
+		commits = append(commits, c)
+	}
+	return commits, nil
+}
+
+// withoutMerges returns the commits that are not merge commits. Merges are
+// not work of their own, but their subjects name pull requests.
+func withoutMerges(commits []unreleasedCommit) []unreleasedCommit {
+	var work []unreleasedCommit
+	for _, c := range commits {
+		if !c.merge {
+			work = append(work, c)
+		}
+	}
+	return work
+}
+
+// mergedSince returns the pull requests merged since the latest tag, from
+// commit subjects and, when known, from GitHub, which adds their labels.
+// Pull requests labeled as needing no changelog entry are dropped.
+func mergedSince(commits []unreleasedCommit, fromGitHub []mergedWork) []mergedWork {
+	byNumber := make(map[int]mergedWork)
+	for _, c := range commits {
+		if c.pr != 0 {
+			title := strings.TrimSpace(squashSubjectPattern.ReplaceAllString(c.subject, ""))
+			byNumber[c.pr] = mergedWork{number: c.pr, title: title}
+		}
+	}
+	for _, w := range fromGitHub {
+		byNumber[w.number] = w
+	}
+
+	var prs []mergedWork
+	for _, w := range byNumber {
+		skip := false
+		for _, l := range w.labels {
+			skip = skip || skipChangelogLabels[strings.ToLower(l)]
+		}
+		if !skip {
+			prs = append(prs, w)
+		}
+	}
+	sort.Slice(prs, func(i, j int) bool { return prs[i].number < prs[j].number })
+	return prs
+}
+
+// readChangelog reads the first changelog file found in dir, or returns
+// nil when there is none.
+func readChangelog(dir string) *changelog {
+	for _, name := range changelogNames {
+		data, err := FS.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			continue
+		}
+		cl := parseChangelog(string(data))
+		cl.path = name
+		return cl
+	}
+	return nil
+}
+
+// parseChangelog collects the versions named in headings, the pull
+// requests referenced anywhere, and the number of entries under an
+// "Unreleased" heading.
+func parseChangelog(content string) *changelog {
+	cl := &changelog{versions: make(map[string]bool), prs: make(map[int]bool)}
+	unreleasedLevel := 0
+	sc := bufio.NewScanner(strings.NewReader(content))
+	for sc.Scan() {
+		line := strings.TrimSpace(sc.Text())
+		for _, m := range changelogPRPattern.FindAllStringSubmatch(line, -1) {
+			if n, err := strconv.Atoi(m[1]); err == nil {
+				cl.prs[n] = true
+			}
+		}
+
+		level := len(line) - len(strings.TrimLeft(line, "#"))
+		if level == 0 {
+			if unreleasedLevel > 0 && line != "" {
+				cl.unreleasedEntries++
+			}
+			continue
+		}
+		if unreleasedLevel > 0 && level <= unreleasedLevel {
+			unreleasedLevel = 0
+		}
+		if strings.Contains(strings.ToLower(line), "unreleased") {
+			unreleasedLevel = level
+			continue
+		}
+		for _, m := range changelogVersionPattern.FindAllStringSubmatch(line, -1) {
+			cl.versions[m[1]] = true
+		}
+	}
+	return cl
+}
+
+// tagPath is the file path of signals about a tag.
+func tagPath(tag string) string {
+	return "tags/" + tag
+}
+
+// unreleasedWorkSignal flags commits that have waited long enough, or
+// piled up enough, to be worth a release.
+func unreleasedWorkSignal(latest versionTag, commits []unreleasedCommit, prs int, now time.Time) (signal.RawSignal, bool) {
+	if len(commits) == 0 {
+		return signal.RawSignal{}, false
+	}
+	oldest := commits[len(commits)-1].date
+	waiting := now.Sub(oldest)
+	if len(commits) < unreleasedCommitThreshold && waiting < unreleasedAgeThreshold {
+		return signal.RawSignal{}, false
+	}
+
+	confidence := 0.4
+	if len(commits) >= 50 {
+		confidence += 0.1
+	}
+	if waiting >= 2*unreleasedAgeThreshold {
+		confidence += 0.1
+	}
+	work := countOf(len(commits), "commit")
+	if prs > 0 {
+		work += ", " + countOf(prs, "merged PR")
+	}
+	return signal.RawSignal{
+		Source:   "releases",
+		Kind:     "release-hygiene",
+		FilePath: tagPath(latest.name),
+		Title:    fmt.Sprintf("Unreleased work since %s: %s", latest.name, work),
+		Description: fmt.Sprintf("%s was tagged %d days ago, and the oldest commit since is %d days old. "+
+			"Users of the released version do not have these changes; cut a release or note why it is held back.",
+			latest.name, int(now.Sub(latest.date).Hours()/24), int(waiting.Hours()/24)),
+		Timestamp:  oldest,
+		Confidence: confidence,
+		Tags:       []string{"release-hygiene", "unreleased-work"},
+	}, true
+}
+
+// missingChangelogSignal flags merged work the changelog does not record
+// and returns how many pull requests (or, when no pull requests are known,
+// commits) are missing. A changelog that references pull requests is
+// checked for each one; any other changelog only needs entries under its
+// Unreleased heading.
+func missingChangelogSignal(cl *changelog, latest versionTag, commits []unreleasedCommit, prs []mergedWork) (signal.RawSignal, int) {
+	sig := signal.RawSignal{
+		Source:     "releases",
+		Kind:       "release-hygiene",
+		FilePath:   cl.path,
+		Confidence: 0.5,
+		Tags:       []string{"release-hygiene", "missing-changelog"},
+	}
+	if len(commits) > 0 {
+		sig.Timestamp = commits[len(commits)-1].date
+	}
+
+	if len(cl.prs) > 0 {
+		var missing []mergedWork
+		for _, pr := range prs {
+			if !cl.prs[pr.number] {
+				missing = append(missing, pr)
+			}
+		}
+		if len(missing) == 0 {
+			return sig, 0
+		}
+		lines := make([]string, 0, maxListedChangelogPRs)
+		for _, pr := range missing[:min(len(missing), maxListedChangelogPRs)] {
+			lines = append(lines, fmt.Sprintf("- #%d %s", pr.number, pr.title))
+		}
+		if len(missing) > maxListedChangelogPRs {
+			lines = append(lines, fmt.Sprintf("- and %d more", len(missing)-maxListedChangelogPRs))
+		}
+		if len(missing) >= 10 {
+			sig.Confidence = 0.6
+		}
+		sig.Title = fmt.Sprintf("%s since %s missing from %s", countOf(len(missing), "merged PR"), latest.name, cl.path)
+		sig.Description = fmt.Sprintf("%s references pull requests, but not these, merged since %s:\n%s",
+			cl.path, latest.name, strings.Join(lines, "\n"))
+		return sig, len(missing)
+	}
+
+	if cl.unreleasedEntries > 0 {
+		return sig, 0
+	}
+	work, n := countOf(len(prs), "merged PR"), len(prs)
+	if n == 0 {
+		work, n = countOf(len(commits), "commit"), len(commits)
+	}
+	if n == 0 {
+		return sig, 0
+	}
+	sig.Title = fmt.Sprintf("%s has no entries for %s since %s", cl.path, work, latest.name)
+	sig.Description = fmt.Sprintf("%s has no entries under an Unreleased heading, but %s landed since %s. "+
+		"Record them now, while the authors remember what changed.", cl.path, work, latest.name)
+	return sig, n
+}
+
+// missingNotesSignal flags a version tag with no changelog section and no
+// GitHub release notes. Only the latest release ranks high; notes for
+// older ones are rarely read.
+func missingNotesSignal(tag versionTag, latest bool, cl *changelog) signal.RawSignal {
+	confidence := 0.3
+	if latest {
+		confidence = 0.5
+	}
+	where := "a GitHub release with notes"
+	if cl != nil {
+		where = fmt.Sprintf("a section in %s or %s", cl.path, where)
+	}
+	return signal.RawSignal{
+		Source:      "releases",
+		Kind:        "release-hygiene",
+		FilePath:    tagPath(tag.name),
+		Title:       fmt.Sprintf("Tag without release notes: %s", tag.name),
+		Description: fmt.Sprintf("%s, tagged %s, has no %s. Users upgrading cannot tell what changed.", tag.name, tag.date.Format("2006-01-02"), where),
+		Timestamp:   tag.date,
+		Confidence:  confidence,
+		Tags:        []string{"release-hygiene", "missing-release-notes"},
+	}
+}
+
+// countOf returns "1 commit" or "n commits".
+func countOf(n int, noun string) string {
+	if n == 1 {
+		return "1 " + noun
+	}
+	return fmt.Sprintf("%d %ss", n, noun)
+}
+
+// Metrics returns structured metrics from the release hygiene scan.
+func (c *ReleasesCollector) Metrics() any { return c.metrics }
+
+// Compile-time interface checks.
+var _ collector.Collector = (*ReleasesCollector)(nil)
+var _ collector.MetricsProvider = (*ReleasesCollector)(nil)
+var _ collector.CapabilityChecker = (*ReleasesCollector)(nil)
@@ -0,0 +1,95 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+//go:build !nonetwork
+
+package collectors
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v68/github"
+)
+
+// maxReleasePRs caps the merged pull requests read for the release
+// hygiene checks.
+const maxReleasePRs = 200
+
+// releasesGitHubAPI is the narrow interface for the GitHub API calls
+// needed by the releases collector.
+type releasesGitHubAPI interface {
+	ListPullRequests(ctx context.Context, owner, repo string, opts *github.PullRequestListOptions) ([]*github.PullRequest, *github.Response, error)
+	ListReleases(ctx context.Context, owner, repo string, opts *github.ListOptions) ([]*github.RepositoryRelease, *github.Response, error)
+}
+
+// newReleasesGitHubAPI returns a GitHub client authenticated with token
+// that shares responses and the request budget with the rest of the scan.
+func newReleasesGitHubAPI(ctx context.Context, token string) releasesGitHubAPI {
+	return githubDataFrom(ctx).wrap(&realGitHubAPI{client: NewGitHubClient(token)})
+}
+
+func (r *realGitHubAPI) ListReleases(ctx context.Context, owner, repo string, opts *github.ListOptions) ([]*github.RepositoryRelease, *github.Response, error) {
+	return r.client.Repositories.ListReleases(ctx, owner, repo, opts)
+}
+
+// fetchGitHubReleaseFacts reads which recent releases have notes and the
+// pull requests merged after since, with their labels. What cannot be
+// fetched is left out: a failed release listing leaves notes nil, and a
+// failed pull request listing keeps the pages read before it.
+func fetchGitHubReleaseFacts(ctx context.Context, api releasesGitHubAPI, owner, repo string, since time.Time) githubReleaseFacts {
+	var facts githubReleaseFacts
+
+	releases, _, err := api.ListReleases(ctx, owner, repo, &github.ListOptions{PerPage: 100})
+	if err != nil {
+		slog.DebugContext(ctx, "cannot list GitHub releases", "owner", owner, "repo", repo, "error", err)
+	} else {
+		facts.notes = make(map[string]bool, len(releases))
+		for _, r := range releases {
+			if !r.GetDraft() {
+				facts.notes[r.GetTagName()] = strings.TrimSpace(r.GetBody()) != ""
+			}
+		}
+	}
+
+	opts := &github.PullRequestListOptions{
+		State:     "closed",
+		Sort:      "updated",
+		Direction: "desc",
+		ListOptions: github.ListOptions{
+			PerPage: 100,
+		},
+	}
+	for {
+		prs, resp, err := api.ListPullRequests(ctx, owner, repo, opts)
+		if err != nil {
+			slog.DebugContext(ctx, "cannot list merged pull requests", "owner", owner, "repo", repo, "error", err)
+			return facts
+		}
+		for _, pr := range prs {
+			// Ordered by update time: nothing further down was merged
+			// after since.
+			if pr.UpdatedAt != nil && pr.UpdatedAt.Before(since) {
+				return facts
+			}
+			if pr.MergedAt == nil || !pr.MergedAt.After(since) {
+				continue
+			}
+			w := mergedWork{number: pr.GetNumber(), title: pr.GetTitle()}
+			for _, l := range pr.Labels {
+				w.labels = append(w.labels, l.GetName())
+			}
+			facts.merged = append(facts.merged, w)
+			if len(facts.merged) >= maxReleasePRs {
+				return facts
+			}
+		}
+		if resp == nil || resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+	return facts
+}
@@ -0,0 +1,58 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+//go:build !nonetwork
+
+package collectors
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v68/github"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockReleasesAPI struct {
+	releases   []*github.RepositoryRelease
+	releaseErr error
+	prs        []*github.PullRequest
+}
+
+func (m *mockReleasesAPI) ListPullRequests(_ context.Context, _, _ string, _ *github.PullRequestListOptions) ([]*github.PullRequest, *github.Response, error) {
+	return m.prs, &github.Response{}, nil
+}
+
+func (m *mockReleasesAPI) ListReleases(_ context.Context, _, _ string, _ *github.ListOptions) ([]*github.RepositoryRelease, *github.Response, error) {
+	return m.releases, &github.Response{}, m.releaseErr
+}
+
+func TestFetchGitHubReleaseFacts(t *testing.T) {
+	since := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	at := func(days int) *github.Timestamp { return &github.Timestamp{Time: since.AddDate(0, 0, days)} }
+	api := &mockReleasesAPI{
+		releases: []*github.RepositoryRelease{
+			{TagName: github.Ptr("v1.2.0"), Body: github.Ptr("## What's changed\n- Cache")},
+			{TagName: github.Ptr("v1.1.0"), Body: github.Ptr("  \n")},
+			{TagName: github.Ptr("v1.3.0"), Body: github.Ptr("Draft notes"), Draft: github.Ptr(true)},
+		},
+		prs: []*github.PullRequest{
+			{Number: github.Ptr(21), Title: github.Ptr("Add retries"), UpdatedAt: at(5), MergedAt: at(4),
+				Labels: []*github.Label{{Name: github.Ptr("enhancement")}}},
+			{Number: github.Ptr(20), Title: github.Ptr("Closed unmerged"), UpdatedAt: at(3)},
+			{Number: github.Ptr(19), Title: github.Ptr("Merged before the tag, updated after"), UpdatedAt: at(2), MergedAt: at(-3)},
+			{Number: github.Ptr(18), Title: github.Ptr("Old"), UpdatedAt: at(-1), MergedAt: at(-1)},
+		},
+	}
+
+	facts := fetchGitHubReleaseFacts(context.Background(), api, "acme", "api", since)
+	assert.Equal(t, map[string]bool{"v1.2.0": true, "v1.1.0": false}, facts.notes)
+	assert.Equal(t, []mergedWork{{number: 21, title: "Add retries", labels: []string{"enhancement"}}}, facts.merged)
+
+	api.releaseErr = errors.New("403 Forbidden")
+	facts = fetchGitHubReleaseFacts(context.Background(), api, "acme", "api", since)
+	assert.Nil(t, facts.notes, "release notes are unknown when releases cannot be listed")
+	assert.Len(t, facts.merged, 1)
+}
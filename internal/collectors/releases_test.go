@@ -0,0 +1,155 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package collectors
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+func TestReleasesCollector_Name(t *testing.T) {
+	assert.Equal(t, "releases", (&ReleasesCollector{}).Name())
+}
+
+// initReleasesRepo returns a repository tagged v1.0.0 and v1.1.0, with
+// squash-merged pull requests #12 and #13 and a direct commit since.
+func initReleasesRepo(t *testing.T, changelog string) string {
+	t.Helper()
+	dir := initTestGitRepo(t, map[string]string{"main.go": "package main\n"})
+	runGit(t, dir, "tag", "v1.0.0")
+	writeAndCommit(t, dir, map[string]string{"api.go": "package main\n"}, "Add API (#10)")
+	runGit(t, dir, "tag", "-a", "v1.1.0", "-m", "v1.1.0")
+	runGit(t, dir, "tag", "nightly")
+	writeAndCommit(t, dir, map[string]string{"cache.go": "package main\n"}, "Add cache (#12)")
+	writeAndCommit(t, dir, map[string]string{"retry.go": "package main\n"}, "Retry requests (#13)")
+	writeAndCommit(t, dir, map[string]string{"README.md": "# api\n"}, "Fix typo")
+	if changelog != "" {
+		writeAndCommit(t, dir, map[string]string{"CHANGELOG.md": changelog}, "Update changelog")
+	}
+	return dir
+}
+
+func collectReleases(t *testing.T, dir string) ([]signal.RawSignal, *ReleasesMetrics) {
+	t.Helper()
+	t.Setenv("GITHUB_TOKEN", "")
+	c := &ReleasesCollector{}
+	signals, err := c.Collect(context.Background(), dir, signal.CollectorOpts{})
+	require.NoError(t, err)
+	for _, s := range signals {
+		assert.Equal(t, "releases", s.Source)
+		assert.Equal(t, "release-hygiene", s.Kind)
+	}
+	return signals, c.Metrics().(*ReleasesMetrics)
+}
+
+func TestReleasesCollector_ChangelogReferencingPRs(t *testing.T) {
+	dir := initReleasesRepo(t, "# Changelog\n\n## Unreleased\n\n- Add cache (#12)\n\n## [1.1.0] - 2026-01-10\n\n- Add API (#10)\n")
+
+	signals, m := collectReleases(t, dir)
+	titles := make([]string, 0, len(signals))
+	for _, s := range signals {
+		titles = append(titles, s.Title)
+	}
+	assert.Equal(t, []string{
+		"1 merged PR since v1.1.0 missing from CHANGELOG.md",
+		"Tag without release notes: v1.0.0",
+	}, titles)
+	assert.Contains(t, signals[0].Description, "- #13 Retry requests")
+	assert.Equal(t, "CHANGELOG.md", signals[0].FilePath)
+	assert.Equal(t, "tags/v1.0.0", signals[1].FilePath)
+	assert.InDelta(t, 0.3, signals[1].Confidence, 0.001, "only the latest tag ranks high")
+
+	assert.Equal(t, &ReleasesMetrics{
+		VersionTags:             2,
+		LatestTag:               "v1.1.0",
+		UnreleasedCommits:       4,
+		UnreleasedPRs:           2,
+		Changelog:               "CHANGELOG.md",
+		TagsWithoutNotes:        1,
+		MissingChangelogEntries: 1,
+	}, m)
+}
+
+func TestReleasesCollector_EmptyUnreleasedSection(t *testing.T) {
+	dir := initReleasesRepo(t, "# Changelog\n\n## Unreleased\n\n### Added\n\n## v1.1.0\n\n- Add an API.\n\n## v1.0.0\n\n- First release.\n")
+
+	signals, _ := collectReleases(t, dir)
+	require.Len(t, signals, 1)
+	assert.Equal(t, "CHANGELOG.md has no entries for 2 merged PRs since v1.1.0", signals[0].Title)
+}
+
+func TestReleasesCollector_NoChangelog(t *testing.T) {
+	dir := initReleasesRepo(t, "")
+	signals, m := collectReleases(t, dir)
+	assert.Empty(t, signals, "without a changelog or GitHub releases, notes cannot be checked")
+	assert.Equal(t, 3, m.UnreleasedCommits)
+}
+
+func TestReleasesCollector_NoVersionTags(t *testing.T) {
+	dir := initTestGitRepo(t, map[string]string{"main.go": "package main\n", "CHANGELOG.md": "# Changelog\n"})
+	runGit(t, dir, "tag", "nightly")
+	signals, m := collectReleases(t, dir)
+	assert.Empty(t, signals)
+	assert.Zero(t, m.VersionTags)
+}
+
+func TestReleasesCollector_UnreleasedWork(t *testing.T) {
+	dir := initReleasesRepo(t, "")
+	for i := range unreleasedCommitThreshold {
+		writeAndCommit(t, dir, map[string]string{fmt.Sprintf("f%d.go", i): "package main\n"}, fmt.Sprintf("Change %d", i))
+	}
+
+	signals, _ := collectReleases(t, dir)
+	require.Len(t, signals, 1)
+	assert.Equal(t, "Unreleased work since v1.1.0: 23 commits, 2 merged PRs", signals[0].Title)
+	assert.Equal(t, "tags/v1.1.0", signals[0].FilePath)
+}
+
+func TestUnreleasedWorkSignal_Age(t *testing.T) {
+	now := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	tag := versionTag{name: "v2.0.0", date: now.AddDate(0, -8, 0)}
+	commits := []unreleasedCommit{{date: now.AddDate(0, 0, -10)}, {date: now.AddDate(0, -7, 0)}}
+
+	sig, ok := unreleasedWorkSignal(tag, commits, 0, now)
+	require.True(t, ok, "a few commits waiting long enough are worth a release")
+	assert.Equal(t, "Unreleased work since v2.0.0: 2 commits", sig.Title)
+	assert.InDelta(t, 0.5, sig.Confidence, 0.001)
+
+	_, ok = unreleasedWorkSignal(tag, commits[:1], 0, now)
+	assert.False(t, ok)
+}
+
+func TestParseChangelog(t *testing.T) {
+	cl := parseChangelog(`# Changelog
+
+## [Unreleased]
+### Fixed
+- Retry on 502 ([#41](https://github.com/acme/api/pull/41))
+
+## [2.0.0-rc.1] - 2026-02-01
+- See https://github.com/acme/api/pull/40
+
+# v1.4 (2025-12-01)
+`)
+	assert.Equal(t, map[string]bool{"2.0.0-rc.1": true, "1.4": true}, cl.versions)
+	assert.Equal(t, map[int]bool{40: true, 41: true}, cl.prs)
+	assert.Equal(t, 1, cl.unreleasedEntries)
+}
+
+func TestMergedSince_SkipLabels(t *testing.T) {
+	commits := []unreleasedCommit{
+		{subject: "Merge pull request #7 from acme/deps", pr: 7, merge: true},
+		{subject: "Add cache (#8)", pr: 8},
+		{subject: "Fix typo"},
+	}
+	prs := mergedSince(commits, []mergedWork{{number: 7, title: "Bump yaml", labels: []string{"Dependencies"}}})
+	assert.Equal(t, []mergedWork{{number: 8, title: "Add cache"}}, prs)
+}
@@ -0,0 +1,99 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package collectors
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"log/slog"
+	"time"
+
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+// defaultMaxFileSize is the number of bytes of a single file that line
+// scanners read before giving up on the rest. It keeps one pathological
+// multi-gigabyte text file from stalling a scan.
+const defaultMaxFileSize = 10 << 20 // 10 MiB
+
+// defaultFileTimeout bounds the time spent scanning a single file.
+const defaultFileTimeout = 10 * time.Second
+
+// scanDeadlineCheckInterval is how many lines are scanned between deadline
+// and cancellation checks.
+const scanDeadlineCheckInterval = 1024
+
+// truncatedScanTag marks signals from a file whose scan stopped before the
+// end of the file, so results from it may be incomplete.
+const truncatedScanTag = "truncated-scan"
+
+// scanLimits caps the work a line scanner does on any one file.
+type scanLimits struct {
+	maxBytes int64
+	timeout  time.Duration
+}
+
+// newScanLimits resolves per-file scan limits from collector options,
+// falling back to the defaults for zero values.
+func newScanLimits(opts signal.CollectorOpts) scanLimits {
+	l := scanLimits{maxBytes: defaultMaxFileSize, timeout: defaultFileTimeout}
+	if opts.MaxFileSize > 0 {
+		l.maxBytes = opts.MaxFileSize
+	}
+	if opts.FileTimeout > 0 {
+		l.timeout = opts.FileTimeout
+	}
+	return l
+}
+
+// scanLines calls fn with each line of f until the end of the file, the
+// size cap, or the per-file timeout, whichever comes first. It reports
+// whether the scan stopped early. Context cancellation is returned as an
+// error. Files in a supported non-UTF-8 encoding are transcoded so fn
+// always receives UTF-8; the size cap applies to the raw bytes.
+func (l scanLimits) scanLines(ctx context.Context, f sourceFile, fn func(line string)) (truncated bool, err error) {
+	var r io.Reader = f
+	if info, statErr := f.Stat(); statErr == nil && info.Size() > l.maxBytes {
+		r = io.LimitReader(f, l.maxBytes)
+		truncated = true
+	}
+
+	br := bufio.NewReaderSize(r, encodingSniffLen)
+	head, peekErr := br.Peek(encodingSniffLen)
+	if peekErr != nil && peekErr != io.EOF {
+		return false, peekErr
+	}
+	r = br
+	if enc := detectEncoding(head, peekErr == io.EOF); enc.enc != nil {
+		slog.DebugContext(ctx, "transcoding file", "path", f.Name(), "encoding", enc.name)
+		r = enc.enc.NewDecoder().Reader(br)
+	}
+
+	deadline := time.Now().Add(l.timeout)
+	scanner := bufio.NewScanner(r)
+	lines := 0
+	for scanner.Scan() {
+		// The line is already read, so it is scanned even if the check
+		// below stops here.
+		fn(scanner.Text())
+		lines++
+		if lines%scanDeadlineCheckInterval == 0 {
+			if err := ctx.Err(); err != nil {
+				return true, err
+			}
+			if time.Now().After(deadline) {
+				return true, nil
+			}
+		}
+	}
+	return truncated, scanner.Err()
+}
+
+// tagTruncated appends the truncated-scan tag to every signal.
+func tagTruncated(signals []signal.RawSignal) {
+	for i := range signals {
+		signals[i].Tags = append(signals[i].Tags, truncatedScanTag)
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package collectors
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+func TestNewScanLimits_Defaults(t *testing.T) {
+	l := newScanLimits(signal.CollectorOpts{})
+	assert.Equal(t, int64(defaultMaxFileSize), l.maxBytes)
+	assert.Equal(t, defaultFileTimeout, l.timeout)
+}
+
+func TestNewScanLimits_Overrides(t *testing.T) {
+	l := newScanLimits(signal.CollectorOpts{MaxFileSize: 1024, FileTimeout: time.Second})
+	assert.Equal(t, int64(1024), l.maxBytes)
+	assert.Equal(t, time.Second, l.timeout)
+}
+
+func openTestFile(t *testing.T, content string) *os.File {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "f.txt")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	f, err := os.Open(path) //nolint:gosec // test path
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = f.Close() })
+	return f
+}
+
+func TestScanLines_Complete(t *testing.T) {
+	f := openTestFile(t, "a\nb\nc\n")
+	var lines []string
+	truncated, err := newScanLimits(signal.CollectorOpts{}).scanLines(context.Background(), f, func(line string) {
+		lines = append(lines, line)
+	})
+	require.NoError(t, err)
+	assert.False(t, truncated)
+	assert.Equal(t, []string{"a", "b", "c"}, lines)
+}
+
+func TestScanLines_SizeCap(t *testing.T) {
+	f := openTestFile(t, "aaaa\nbbbb\ncccc\n")
+	var lines []string
+	l := scanLimits{maxBytes: 10, timeout: time.Minute}
+	truncated, err := l.scanLines(context.Background(), f, func(line string) {
+		lines = append(lines, line)
+	})
+	require.NoError(t, err)
+	assert.True(t, truncated)
+	assert.Equal(t, []string{"aaaa", "bbbb"}, lines)
+}
+
+func TestScanLines_Timeout(t *testing.T) {
+	f := openTestFile(t, strings.Repeat("x\n", 5*scanDeadlineCheckInterval))
+	count := 0
+	l := scanLimits{maxBytes: defaultMaxFileSize, timeout: time.Nanosecond}
+	truncated, err := l.scanLines(context.Background(), f, func(string) { count++ })
+	require.NoError(t, err)
+	assert.True(t, truncated)
+	assert.Equal(t, scanDeadlineCheckInterval, count, "the line read when the deadline is noticed is still scanned")
+}
+
+func TestScanLines_ContextCancelled(t *testing.T) {
+	f := openTestFile(t, strings.Repeat("x\n", 2*scanDeadlineCheckInterval))
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	truncated, err := newScanLimits(signal.CollectorOpts{}).scanLines(ctx, f, func(string) {})
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.True(t, truncated)
+}
+
+func TestTagTruncated(t *testing.T) {
+	signals := []signal.RawSignal{{Tags: []string{"todo"}}, {}}
+	tagTruncated(signals)
+	assert.Equal(t, []string{"todo", truncatedScanTag}, signals[0].Tags)
+	assert.Equal(t, []string{truncatedScanTag}, signals[1].Tags)
+}
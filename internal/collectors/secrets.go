@@ -27,6 +27,7 @@ type SecretMatch struct {
 	Name       string
 	Confidence float64
 	Line       int
+	Value      string // the secret itself, for redaction only; never emitted
 }
 
 // secretRegistry holds registered patterns and provides matching.
@@ -126,7 +127,7 @@ func (r *secretRegistry) Match(line string) []SecretMatch {
 				continue
 			}
 		}
-		if p.Pattern.MatchString(line) {
+		if loc := p.Pattern.FindStringIndex(line); loc != nil {
 			// Check allowlist before adding.
 			if allowed, _ := r.isAllowlisted(line); allowed {
 				continue
@@ -135,6 +136,7 @@ func (r *secretRegistry) Match(line string) []SecretMatch {
 				PatternID:  p.ID,
 				Name:       p.Name,
 				Confidence: p.Confidence,
+				Value:      secretValue(line[loc[0]:loc[1]]),
 			})
 		}
 	}
@@ -337,6 +339,26 @@ var secretAssignmentPattern = regexp.MustCompile(
 // stringLiteralPattern extracts quoted string literals from a line.
 var stringLiteralPattern = regexp.MustCompile(`["']([^"']{16,})["']`)
 
+// secretValueChars are the characters a credential is made of.
+var secretValueChars = regexp.MustCompile(`[A-Za-z0-9/+=_.~-]{8,}`)
+
+// secretValue extracts the credential from a pattern match, which may also
+// cover the key name and quotes around it: the longest run of credential
+// characters. Matches without such a run, like a private key header, have
+// no value.
+func secretValue(match string) string {
+	if strings.HasPrefix(match, "-----BEGIN ") {
+		return ""
+	}
+	best := ""
+	for _, run := range secretValueChars.FindAllString(match, -1) {
+		if len(run) > len(best) {
+			best = run
+		}
+	}
+	return best
+}
+
 // shannonEntropy computes the Shannon entropy of a string in bits per character.
 func shannonEntropy(s string) float64 {
 	if len(s) == 0 {
@@ -4,17 +4,15 @@
 package collectors
 
 import (
-	"path/filepath"
-	"strings"
+	"io/fs"
 )
 
-// isSymlinkOutsideRepo returns true if path resolves to a location outside repoRoot.
-// It also returns true when the symlink cannot be resolved, treating unresolvable
-// symlinks as outside the repo for safety.
-func isSymlinkOutsideRepo(path, repoRoot string) bool {
-	resolved, err := FS.EvalSymlinks(path)
-	if err != nil {
-		return true
-	}
-	return !strings.HasPrefix(resolved, repoRoot+string(filepath.Separator)) && resolved != repoRoot
+// isSymlink reports whether a walk entry is a symbolic link. Walks skip every
+// symlink rather than following it: links that resolve outside the repo tree
+// could pull in files from elsewhere, and links that resolve inside it point
+// at a file the walk already visits under its canonical path. The latter is
+// common in pnpm and yarn workspaces, where internal packages are linked into
+// one another and following the link would count each file twice.
+func isSymlink(d fs.DirEntry) bool {
+	return d.Type()&fs.ModeSymlink != 0
 }
@@ -0,0 +1,72 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+// Command wasmplugin is the WASI module the WASM plugin tests run. Built
+// with GOOS=wasip1 GOARCH=wasm, it reads the plugin request from stdin and
+// reports what it can see as signals. Its first argument picks a behavior.
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"time"
+)
+
+type request struct {
+	Collector string `json:"collector"`
+	RepoPath  string `json:"repo_path"`
+}
+
+type rawSignal struct {
+	Kind       string
+	FilePath   string `json:",omitempty"`
+	Title      string
+	Confidence float64
+}
+
+func main() {
+	var req request
+	if err := json.NewDecoder(os.Stdin).Decode(&req); err != nil {
+		fmt.Fprintln(os.Stderr, "bad request:", err)
+		os.Exit(2)
+	}
+	mode := ""
+	if len(os.Args) > 1 {
+		mode = os.Args[1]
+	}
+
+	out := json.NewEncoder(os.Stdout)
+	switch mode {
+	case "fail":
+		fmt.Fprintln(os.Stderr, "license server unreachable")
+		os.Exit(3)
+	case "spin":
+		for {
+			time.Sleep(time.Millisecond)
+		}
+	case "alloc":
+		big := make([]byte, 256<<20)
+		big[len(big)-1] = 1
+		fmt.Println(len(big))
+	case "write":
+		err := os.WriteFile(req.RepoPath+"/planted.txt", []byte("x"), 0o600)
+		_ = out.Encode(rawSignal{Kind: "probe", Title: fmt.Sprintf("write error: %v", err != nil), Confidence: 0.5})
+	default:
+		_ = out.Encode(rawSignal{
+			Kind:       "probe",
+			Title:      fmt.Sprintf("collector %s at %s with %d env", req.Collector, req.RepoPath, len(os.Environ())),
+			Confidence: 0.5,
+		})
+		err := fs.WalkDir(os.DirFS(req.RepoPath), ".", func(path string, d fs.DirEntry, err error) error {
+			if err != nil || d.IsDir() {
+				return err
+			}
+			return out.Encode(rawSignal{Kind: "missing-license", FilePath: path, Title: "No license header in " + path, Confidence: 0.7})
+		})
+		if err != nil {
+			_ = out.Encode(rawSignal{Kind: "probe", Title: "repo not readable", Confidence: 0.5})
+		}
+	}
+}
@@ -0,0 +1,550 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package collectors
+
+import (
+	"context"
+	"fmt"
+	"go/ast"
+	"go/build/constraint"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/davetashner/stringer/internal/blameindex"
+	"github.com/davetashner/stringer/internal/collector"
+	"github.com/davetashner/stringer/internal/gitcli"
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+const (
+	// longSkipAge is how long a test must have been skipped unconditionally
+	// before it is reported.
+	longSkipAge = 90 * 24 * time.Hour
+
+	// skipDensityMinTests and skipDensityRatio set when a package has
+	// enough tests that can skip to report it: at least this many, making
+	// up at least this fraction of its tests.
+	skipDensityMinTests = 3
+	skipDensityRatio    = 0.25
+
+	// maxListedSkips caps the tests a skip-density signal lists.
+	maxListedSkips = 10
+)
+
+// nonAssertingTestMethods are the *testing.T methods that cannot fail a
+// test.
+var nonAssertingTestMethods = map[string]bool{
+	"Log": true, "Logf": true, "Helper": true, "Parallel": true,
+	"Cleanup": true, "TempDir": true, "Setenv": true, "Chdir": true,
+	"Name": true, "Context": true, "Deadline": true, "Output": true,
+	"Attr": true, "ArtifactDir": true,
+	"Skip": true, "Skipf": true, "SkipNow": true, "Skipped": true,
+}
+
+// assertionPrefixes mark calls that assert without taking the *testing.T,
+// such as gomega's Expect or a must helper that panics.
+var assertionPrefixes = []string{"assert", "expect", "require", "check", "verify", "must", "fatal", "panic"}
+
+func init() {
+	collector.Register(&TestQualityCollector{})
+}
+
+// TestQualityMetrics holds structured metrics from the test quality scan.
+type TestQualityMetrics struct {
+	TestFiles     int
+	Tests         int
+	SkippingTests int
+	LongSkipped   int
+	AssertFree    int
+	IgnoredFiles  int
+}
+
+// TestQualityCollector looks for Go tests that no longer protect anything:
+// tests skipped unconditionally for a long time, by the blame date of the
+// t.Skip call; packages where many tests can skip; tests that never assert;
+// and test files excluded from every build by a //go:build ignore line.
+// Skips guarded by testing.Short() are expected and not counted.
+type TestQualityCollector struct {
+	metrics *TestQualityMetrics
+}
+
+// Name returns the collector name used for registration and filtering.
+func (c *TestQualityCollector) Name() string { return "testquality" }
+
+// goTest is what the collector learns about one Go test function.
+type goTest struct {
+	name       string
+	line       int
+	skipLine   int    // first unconditional t.Skip, or 0
+	skipReason string // its message, when a string literal
+	canSkip    bool   // has a skip not guarded by testing.Short()
+	asserts    bool
+	empty      bool
+}
+
+// Collect parses the Go test files in repoPath and reports degraded tests.
+func (c *TestQualityCollector) Collect(ctx context.Context, repoPath string, opts signal.CollectorOpts) ([]signal.RawSignal, error) {
+	metrics := &TestQualityMetrics{}
+	c.metrics = metrics
+	excludes := mergeExcludes(opts.ExcludePatterns)
+
+	gitRoot := repoPath
+	if opts.GitRoot != "" {
+		gitRoot = opts.GitRoot
+	}
+	gitDir := ""
+	var idx *blameindex.Index
+	if gitcli.Available() == nil && isGitRepo(gitRoot) {
+		gitDir = gitRoot
+		idx = blameindex.Open(ctx, gitRoot)
+	}
+	now := time.Now()
+
+	var signals []signal.RawSignal
+	emit := func(sig signal.RawSignal) {
+		if sig.Confidence >= opts.MinConfidence {
+			signals = append(signals, sig)
+		}
+	}
+	testsByDir := make(map[string][]goTestAt)
+
+	err := walkTree(ctx, repoPath, func(path string, d os.DirEntry, walkErr error) error {
+		if walkErr != nil {
+			return nil
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		relPath, relErr := filepath.Rel(repoPath, path)
+		if relErr != nil {
+			return nil
+		}
+		if d.IsDir() {
+			if shouldExclude(relPath, excludes) || !opts.Scope.ContainsDir(relPath) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !strings.HasSuffix(relPath, "_test.go") || shouldExclude(relPath, excludes) ||
+			isSymlink(d) || !opts.Scope.Contains(relPath) || isGeneratedFile(path) {
+			return nil
+		}
+		src, err := FS.ReadFile(path)
+		if err != nil {
+			return nil
+		}
+		relPath = filepath.ToSlash(relPath)
+		fset := token.NewFileSet()
+		file, err := parser.ParseFile(fset, relPath, src, parser.ParseComments|parser.SkipObjectResolution)
+		if err != nil {
+			return nil
+		}
+		metrics.TestFiles++
+
+		blamePath := relPath
+		if gitRoot != repoPath {
+			if rel, err := filepath.Rel(gitRoot, path); err == nil {
+				blamePath = filepath.ToSlash(rel)
+			}
+		}
+
+		if line, ok := ignoredByBuildConstraint(fset, file); ok {
+			metrics.IgnoredFiles++
+			var changed time.Time
+			if gitDir != "" {
+				changed, _ = gitcli.LastCommitTime(ctx, gitDir, blamePath) //nolint:errcheck // the date is optional context
+			}
+			emit(ignoredTestFileSignal(relPath, line, len(goTests(fset, file)), changed))
+			return nil
+		}
+
+		dir := filepath.ToSlash(filepath.Dir(relPath))
+		for _, test := range goTests(fset, file) {
+			metrics.Tests++
+			testsByDir[dir] = append(testsByDir[dir], goTestAt{test, relPath})
+			if test.canSkip {
+				metrics.SkippingTests++
+			}
+			if test.skipLine > 0 {
+				since, ok := lineBlameTime(ctx, idx, gitDir, blamePath, test.skipLine)
+				if ok && now.Sub(since) >= longSkipAge {
+					metrics.LongSkipped++
+					emit(longSkipSignal(relPath, test, since, now))
+				}
+				continue // a skipped test is not expected to assert
+			}
+			if !test.asserts {
+				metrics.AssertFree++
+				emit(assertFreeSignal(relPath, test))
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("walking repo: %w", err)
+	}
+
+	dirs := make([]string, 0, len(testsByDir))
+	for dir := range testsByDir {
+		dirs = append(dirs, dir)
+	}
+	sort.Strings(dirs)
+	for _, dir := range dirs {
+		if sig, ok := skipDensitySignal(dir, testsByDir[dir]); ok {
+			emit(sig)
+		}
+	}
+	return signals, nil
+}
+
+// goTestAt is a test and the file declaring it.
+type goTestAt struct {
+	goTest
+	file string
+}
+
+// goTests returns the Test functions declared in file.
+func goTests(fset *token.FileSet, file *ast.File) []goTest {
+	var tests []goTest
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Recv != nil || fn.Body == nil || !isTestFuncName(fn.Name.Name) {
+			continue
+		}
+		params := fn.Type.Params.List
+		if len(params) != 1 || len(params[0].Names) > 1 || types.ExprString(params[0].Type) != "*testing.T" {
+			continue
+		}
+		tName := "_"
+		if len(params[0].Names) == 1 {
+			tName = params[0].Names[0].Name
+		}
+		test := goTest{
+			name:  fn.Name.Name,
+			line:  fset.Position(fn.Pos()).Line,
+			empty: len(fn.Body.List) == 0,
+		}
+		test.skipLine, test.skipReason = unconditionalSkip(fset, fn.Body, tName)
+		test.canSkip = test.skipLine > 0 || hasUnguardedSkip(fn.Body, tName)
+		test.asserts = testAsserts(fn.Body, tName)
+		tests = append(tests, test)
+	}
+	return tests
+}
+
+// isTestFuncName reports whether name is a test name go test runs: Test,
+// followed by nothing or by a character that is not a lowercase letter.
+func isTestFuncName(name string) bool {
+	rest, ok := strings.CutPrefix(name, "Test")
+	return ok && (rest == "" || rest[0] < 'a' || rest[0] > 'z')
+}
+
+// isSkipCall reports whether n calls one of tName's Skip methods.
+func isSkipCall(n ast.Node, tName string) (*ast.CallExpr, bool) {
+	call, ok := n.(*ast.CallExpr)
+	if !ok {
+		return nil, false
+	}
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return nil, false
+	}
+	x, ok := sel.X.(*ast.Ident)
+	if !ok || x.Name != tName {
+		return nil, false
+	}
+	switch sel.Sel.Name {
+	case "Skip", "Skipf", "SkipNow":
+		return call, true
+	}
+	return nil, false
+}
+
+// unconditionalSkip returns the line of a t.Skip call made directly in the
+// test body, and its message when it is a string literal.
+func unconditionalSkip(fset *token.FileSet, body *ast.BlockStmt, tName string) (int, string) {
+	for _, stmt := range body.List {
+		expr, ok := stmt.(*ast.ExprStmt)
+		if !ok {
+			continue
+		}
+		call, ok := isSkipCall(expr.X, tName)
+		if !ok {
+			continue
+		}
+		reason := ""
+		if len(call.Args) > 0 {
+			if lit, ok := call.Args[0].(*ast.BasicLit); ok && lit.Kind == token.STRING {
+				reason, _ = strconv.Unquote(lit.Value) //nolint:errcheck // parsed literals unquote
+			}
+		}
+		return fset.Position(call.Pos()).Line, reason
+	}
+	return 0, ""
+}
+
+// hasUnguardedSkip reports whether the test can skip other than in short
+// mode: whether it calls t.Skip outside an if testing.Short() block.
+func hasUnguardedSkip(body *ast.BlockStmt, tName string) bool {
+	found := false
+	var visit func(n ast.Node) bool
+	visit = func(n ast.Node) bool {
+		if found {
+			return false
+		}
+		if ifStmt, ok := n.(*ast.IfStmt); ok && strings.Contains(types.ExprString(ifStmt.Cond), "testing.Short()") {
+			if ifStmt.Else != nil {
+				ast.Inspect(ifStmt.Else, visit)
+			}
+			return false
+		}
+		if _, ok := isSkipCall(n, tName); ok {
+			found = true
+		}
+		return true
+	}
+	ast.Inspect(body, visit)
+	return found
+}
+
+// testAsserts reports whether a test body can fail. It fails through t
+// itself (Error, Fatal, Fail), by passing t to a helper or an assertion
+// library, through a subtest that can fail, by panicking, or through a call
+// whose name marks it as an assertion (Expect, mustParse, log.Fatal).
+func testAsserts(body *ast.BlockStmt, tName string) bool {
+	found := false
+	var visit func(n ast.Node) bool
+	visit = func(n ast.Node) bool {
+		if found || n == nil {
+			return false
+		}
+		switch n := n.(type) {
+		case *ast.SelectorExpr:
+			x, ok := n.X.(*ast.Ident)
+			if !ok || x.Name != tName {
+				ast.Inspect(n.X, visit)
+				return false // a field or method named like t is not t
+			}
+			if !nonAssertingTestMethods[n.Sel.Name] && n.Sel.Name != "Run" {
+				found = true
+			}
+			return false
+		case *ast.Ident:
+			if n.Name == tName && tName != "_" {
+				found = true // t escapes: stored, or passed to a helper
+			}
+		case *ast.CallExpr:
+			if isSubtestCall(n, tName) {
+				lit, ok := n.Args[1].(*ast.FuncLit)
+				if !ok || len(lit.Type.Params.List) != 1 || len(lit.Type.Params.List[0].Names) != 1 {
+					found = true // a named test function is assumed to assert
+					return false
+				}
+				found = testAsserts(lit.Body, lit.Type.Params.List[0].Names[0].Name)
+				return false
+			}
+			if isAssertionName(calledName(n.Fun)) {
+				found = true
+			}
+		}
+		return true
+	}
+	ast.Inspect(body, visit)
+	return found
+}
+
+// isSubtestCall reports whether call is t.Run(name, fn).
+func isSubtestCall(call *ast.CallExpr, tName string) bool {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != "Run" || len(call.Args) != 2 {
+		return false
+	}
+	x, ok := sel.X.(*ast.Ident)
+	return ok && x.Name == tName
+}
+
+// calledName returns the name of a called function or method.
+func calledName(fun ast.Expr) string {
+	switch f := fun.(type) {
+	case *ast.Ident:
+		return f.Name
+	case *ast.SelectorExpr:
+		return f.Sel.Name
+	case *ast.IndexExpr:
+		return calledName(f.X)
+	case *ast.IndexListExpr:
+		return calledName(f.X)
+	}
+	return ""
+}
+
+// isAssertionName reports whether a function name marks an assertion.
+func isAssertionName(name string) bool {
+	lower := strings.ToLower(name)
+	for _, prefix := range assertionPrefixes {
+		if strings.HasPrefix(lower, prefix) {
+			return true
+		}
+	}
+	return name == "Exit" // os.Exit
+}
+
+// ignoredByBuildConstraint returns the line of a build constraint that
+// only the ignore tag keeps from being satisfied. Constraints on other
+// tags, such as integration or a platform, are left alone.
+func ignoredByBuildConstraint(fset *token.FileSet, file *ast.File) (int, bool) {
+	for _, group := range file.Comments {
+		if group.Pos() >= file.Package {
+			break
+		}
+		for _, comment := range group.List {
+			if !constraint.IsGoBuild(comment.Text) && !constraint.IsPlusBuild(comment.Text) {
+				continue
+			}
+			expr, err := constraint.Parse(comment.Text)
+			if err != nil {
+				continue
+			}
+			withIgnore := expr.Eval(func(string) bool { return true })
+			withoutIgnore := expr.Eval(func(tag string) bool { return tag != "ignore" })
+			if withIgnore && !withoutIgnore {
+				return fset.Position(comment.Pos()).Line, true
+			}
+		}
+	}
+	return 0, false
+}
+
+// lineBlameTime returns when a line was last changed, from the blame index
+// or a live blame. ok is false when the line has no history.
+func lineBlameTime(ctx context.Context, idx *blameindex.Index, gitDir, relPath string, line int) (time.Time, bool) {
+	if bl, ok := idx.Line(relPath, line); ok {
+		return bl.AuthorTime, true
+	}
+	if gitDir == "" {
+		return time.Time{}, false
+	}
+	blameCtx, cancel := context.WithTimeout(ctx, gitcli.DefaultTimeout)
+	defer cancel()
+	bl, err := gitcli.BlameSingleLine(blameCtx, gitDir, relPath, line)
+	if err != nil || bl == nil || bl.AuthorTime.IsZero() {
+		return time.Time{}, false
+	}
+	return bl.AuthorTime, true
+}
+
+// longSkipSignal reports a test skipped unconditionally since since. A
+// skip over a year old is more likely forgotten.
+func longSkipSignal(relPath string, test goTest, since, now time.Time) signal.RawSignal {
+	confidence := 0.5
+	if now.Sub(since) >= 365*24*time.Hour {
+		confidence = 0.7
+	}
+	desc := fmt.Sprintf("%s has been skipped unconditionally since %s (%d days), so it no longer runs.",
+		test.name, since.Format("2006-01-02"), int(now.Sub(since).Hours()/24))
+	if test.skipReason != "" {
+		desc += fmt.Sprintf(" Reason given: %q.", test.skipReason)
+	}
+	desc += " Fix and re-enable it, or delete it."
+	return signal.RawSignal{
+		Source:      "testquality",
+		Kind:        "test-debt",
+		FilePath:    relPath,
+		Line:        test.skipLine,
+		Title:       fmt.Sprintf("Test skipped since %s: %s", since.Format("2006-01-02"), test.name),
+		Description: desc,
+		Timestamp:   since,
+		Confidence:  confidence,
+		Tags:        []string{"test-debt", "skipped-test"},
+	}
+}
+
+// assertFreeSignal reports a test that cannot fail.
+func assertFreeSignal(relPath string, test goTest) signal.RawSignal {
+	title := "Test without assertions: " + test.name
+	desc := fmt.Sprintf("%s never fails: it reports no errors through t, passes t to no helper, and does not panic. "+
+		"It only checks that the code under test does not crash.", test.name)
+	confidence := 0.5
+	if test.empty {
+		title = "Empty test: " + test.name
+		desc = fmt.Sprintf("%s has an empty body and always passes.", test.name)
+		confidence = 0.6
+	}
+	return signal.RawSignal{
+		Source:      "testquality",
+		Kind:        "test-debt",
+		FilePath:    relPath,
+		Line:        test.line,
+		Title:       title,
+		Description: desc,
+		Confidence:  confidence,
+		Tags:        []string{"test-debt", "assert-free-test"},
+	}
+}
+
+// ignoredTestFileSignal reports a test file that no build includes.
+func ignoredTestFileSignal(relPath string, line, tests int, changed time.Time) signal.RawSignal {
+	desc := fmt.Sprintf("%s is excluded from every build by its ignore build constraint, so its %s never run.",
+		relPath, countOf(tests, "test"))
+	if !changed.IsZero() {
+		desc += fmt.Sprintf(" It was last changed on %s.", changed.Format("2006-01-02"))
+	}
+	desc += " Restore it to the build or delete it."
+	return signal.RawSignal{
+		Source:      "testquality",
+		Kind:        "test-debt",
+		FilePath:    relPath,
+		Line:        line,
+		Title:       "Test file excluded by //go:build ignore: " + filepath.Base(relPath),
+		Description: desc,
+		Timestamp:   changed,
+		Confidence:  0.6,
+		Tags:        []string{"test-debt", "ignored-test-file"},
+	}
+}
+
+// skipDensitySignal reports a package where a large share of the tests can
+// skip outside short mode, which usually means they do not run in CI.
+func skipDensitySignal(dir string, tests []goTestAt) (signal.RawSignal, bool) {
+	var skipping []goTestAt
+	for _, test := range tests {
+		if test.canSkip {
+			skipping = append(skipping, test)
+		}
+	}
+	if len(skipping) < skipDensityMinTests || float64(len(skipping)) < skipDensityRatio*float64(len(tests)) {
+		return signal.RawSignal{}, false
+	}
+	lines := []string{fmt.Sprintf("%d of %d tests in %s call t.Skip outside a testing.Short() check. "+
+		"Check that the environment they need exists where the tests run:", len(skipping), len(tests), dir)}
+	for _, test := range skipping[:min(len(skipping), maxListedSkips)] {
+		lines = append(lines, fmt.Sprintf("- %s (%s:%d)", test.name, test.file, test.line))
+	}
+	if len(skipping) > maxListedSkips {
+		lines = append(lines, fmt.Sprintf("- and %d more", len(skipping)-maxListedSkips))
+	}
+	return signal.RawSignal{
+		Source:      "testquality",
+		Kind:        "test-debt",
+		FilePath:    dir,
+		Title:       fmt.Sprintf("%d of %d tests in %s can skip", len(skipping), len(tests), dir),
+		Description: strings.Join(lines, "\n"),
+		Confidence:  0.4,
+		Tags:        []string{"test-debt", "skip-density"},
+	}, true
+}
+
+// Metrics returns structured metrics from the test quality scan.
+func (c *TestQualityCollector) Metrics() any { return c.metrics }
+
+// Compile-time interface checks.
+var _ collector.Collector = (*TestQualityCollector)(nil)
+var _ collector.MetricsProvider = (*TestQualityCollector)(nil)
@@ -0,0 +1,192 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package collectors
+
+import (
+	"context"
+	"go/parser"
+	"go/token"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+func TestTestQualityCollector_Name(t *testing.T) {
+	assert.Equal(t, "testquality", (&TestQualityCollector{}).Name())
+}
+
+const testQualityFixture = `package store
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlaky(t *testing.T) {
+	t.Skip("flaky on CI, see #12")
+	if Get() != 1 {
+		t.Fatal("wrong")
+	}
+}
+
+func TestSmoke(t *testing.T) {
+	_ = Get()
+}
+
+func TestEmpty(t *testing.T) {}
+
+func TestAssertLib(t *testing.T) {
+	assert.Equal(t, 1, Get())
+}
+
+func TestIntegration(t *testing.T) {
+	if os.Getenv("DB_URL") == "" {
+		t.Skip("needs DB_URL")
+	}
+	if Get() != 1 {
+		t.Error("wrong")
+	}
+}
+
+func TestSlow(t *testing.T) {
+	if testing.Short() {
+		t.Skip("slow")
+	}
+	mustGet()
+}
+
+func TestSubtests(t *testing.T) {
+	t.Run("quiet", func(t *testing.T) {
+		t.Log(Get())
+	})
+}
+
+func helperTest(t *testing.T) {}
+`
+
+func TestTestQualityCollector_Collect(t *testing.T) {
+	dir := initTestGitRepo(t, map[string]string{
+		"go.mod":                "module example.com/store\n",
+		"store/store_test.go":   testQualityFixture,
+		"store/legacy_test.go":  "//go:build ignore\n\npackage store\n\nimport \"testing\"\n\nfunc TestOld(t *testing.T) { t.Fatal(1) }\n",
+		"store/linux_test.go":   "//go:build linux && integration\n\npackage store\n\nimport \"testing\"\n\nfunc TestLinux(t *testing.T) { t.Fatal(1) }\n",
+		"store/store.go":        "package store\n\nfunc TestNotATest() {}\n",
+		"gen/zz_gen_test.go":    "// Code generated by gen. DO NOT EDIT.\n\npackage gen\n\nimport \"testing\"\n\nfunc TestGen(t *testing.T) {}\n",
+		"other/other_test.go":   "package other\n\nimport \"testing\"\n\nfunc Testify(t *testing.T) {}\n",
+		"store/skipped_test.go": "package store\n\nimport \"testing\"\n\nfunc TestA(t *testing.T) { t.SkipNow() }\n\nfunc TestB(t *testing.T) { t.Skipf(\"todo\") }\n",
+	})
+	backdateLastCommit(t, dir, time.Now().AddDate(-2, 0, 0))
+
+	c := &TestQualityCollector{}
+	signals, err := c.Collect(context.Background(), dir, signal.CollectorOpts{})
+	require.NoError(t, err)
+
+	byTitle := make(map[string]signal.RawSignal)
+	for _, s := range signals {
+		assert.Equal(t, "testquality", s.Source)
+		assert.Equal(t, "test-debt", s.Kind)
+		byTitle[s.Title] = s
+	}
+	skippedOn := time.Now().AddDate(-2, 0, 0).Format("2006-01-02")
+	assert.ElementsMatch(t, []string{
+		"Test skipped since " + skippedOn + ": TestFlaky",
+		"Test skipped since " + skippedOn + ": TestA",
+		"Test skipped since " + skippedOn + ": TestB",
+		"Test without assertions: TestSmoke",
+		"Empty test: TestEmpty",
+		"Test without assertions: TestSubtests",
+		"Test file excluded by //go:build ignore: legacy_test.go",
+		"4 of 10 tests in store can skip",
+	}, keysOf(byTitle))
+
+	flaky := byTitle["Test skipped since "+skippedOn+": TestFlaky"]
+	assert.Equal(t, "store/store_test.go", flaky.FilePath)
+	assert.Equal(t, 11, flaky.Line)
+	assert.InDelta(t, 0.7, flaky.Confidence, 0.001)
+	assert.Contains(t, flaky.Description, `Reason given: "flaky on CI, see #12".`)
+
+	ignored := byTitle["Test file excluded by //go:build ignore: legacy_test.go"]
+	assert.Equal(t, 1, ignored.Line)
+	assert.Contains(t, ignored.Description, "its 1 test never run")
+
+	density := byTitle["4 of 10 tests in store can skip"]
+	assert.Equal(t, "store", density.FilePath)
+	assert.Contains(t, density.Description, "- TestIntegration (store/store_test.go:27)")
+
+	assert.Equal(t, &TestQualityMetrics{
+		TestFiles:     5,
+		Tests:         10,
+		SkippingTests: 4,
+		LongSkipped:   3,
+		AssertFree:    3,
+		IgnoredFiles:  1,
+	}, c.Metrics())
+}
+
+func TestTestQualityCollector_RecentSkip(t *testing.T) {
+	dir := initTestGitRepo(t, map[string]string{
+		"a_test.go": "package a\n\nimport \"testing\"\n\nfunc TestA(t *testing.T) {\n\tt.Skip()\n}\n",
+	})
+	c := &TestQualityCollector{}
+	signals, err := c.Collect(context.Background(), dir, signal.CollectorOpts{})
+	require.NoError(t, err)
+	assert.Empty(t, signals, "a recent skip may be work in progress")
+	assert.Zero(t, c.metrics.LongSkipped)
+}
+
+func TestTestAsserts(t *testing.T) {
+	tests := []struct {
+		body    string
+		asserts bool
+	}{
+		{"t.Errorf(\"x\")", true},
+		{"check(t, 1)", true},
+		{"h := newHarness(t); h.Run()", true},
+		{"g := NewWithT(t); g.Expect(1).To(Equal(1))", true},
+		{"if err != nil { panic(err) }", true},
+		{"t.Run(\"a\", testA)", true},
+		{"t.Run(\"a\", func(t *testing.T) { require.NoError(t, f()) })", true},
+		{"t.Run(\"a\", func(t *testing.T) { t.Log(f()) })", false},
+		{"t.Parallel(); t.Logf(\"%v\", f()); tc.t = 1", false},
+		{"defer t.Cleanup(func() {}); _ = f()", false},
+	}
+	for _, tt := range tests {
+		file, err := parser.ParseFile(token.NewFileSet(), "x_test.go", "package x\n\nfunc TestX(t *testing.T) {\n"+tt.body+"\n}\n", 0)
+		require.NoError(t, err)
+		found := goTests(token.NewFileSet(), file)
+		require.Len(t, found, 1)
+		assert.Equal(t, tt.asserts, found[0].asserts, tt.body)
+	}
+}
+
+func TestIgnoredByBuildConstraint(t *testing.T) {
+	for src, want := range map[string]bool{
+		"//go:build ignore\n\npackage x\n":                true,
+		"// +build ignore\n\npackage x\n":                 true,
+		"//go:build integration && ignore\n\npackage x\n": true,
+		"//go:build !linux\n\npackage x\n":                false,
+		"//go:build integration\n\npackage x\n":           false,
+		"package x\n\n//go:build ignore\n":                false,
+	} {
+		fset := token.NewFileSet()
+		file, err := parser.ParseFile(fset, "x_test.go", src, parser.ParseComments)
+		require.NoError(t, err)
+		_, got := ignoredByBuildConstraint(fset, file)
+		assert.Equal(t, want, got, src)
+	}
+}
+
+func keysOf(m map[string]signal.RawSignal) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	return keys
+}
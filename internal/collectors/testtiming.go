@@ -0,0 +1,402 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package collectors
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"log/slog"
+	"math"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/davetashner/stringer/internal/collector"
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+// defaultSlowTestThreshold is the latency budget for a single test when no
+// per-package budget applies.
+const defaultSlowTestThreshold = 2 * time.Second
+
+// maxTestReportLine bounds a single line of go test -json output.
+const maxTestReportLine = 1 << 20 // 1 MiB
+
+func init() {
+	collector.Register(&TestTimingCollector{})
+}
+
+// TestTimingMetrics holds structured metrics from the test timing scan.
+type TestTimingMetrics struct {
+	ReportsParsed int
+	TestsSeen     int
+	SlowTests     int
+}
+
+// TestTimingCollector ingests test execution reports produced by CI (go test
+// -json output or JUnit XML) and flags tests that exceed their package's
+// latency budget. It does nothing unless reports are configured.
+type TestTimingCollector struct {
+	metrics *TestTimingMetrics
+}
+
+// Name returns the collector name used for registration and filtering.
+func (c *TestTimingCollector) Name() string { return "testtiming" }
+
+// testTiming is the recorded duration of one test.
+type testTiming struct {
+	Package string
+	Name    string
+	Elapsed time.Duration
+	File    string // source file reported by the test runner, if any
+	Line    int
+	Report  string // report the timing came from, relative to the repo
+}
+
+// Collect parses each configured test report and emits a slow-test signal
+// for every test whose slowest recorded run exceeds its latency budget.
+func (c *TestTimingCollector) Collect(ctx context.Context, repoPath string, opts signal.CollectorOpts) ([]signal.RawSignal, error) {
+	metrics := &TestTimingMetrics{}
+	c.metrics = metrics
+	if len(opts.TestReports) == 0 {
+		return nil, nil
+	}
+
+	reports := expandReports(ctx, "testtiming", repoPath, opts.TestReports)
+	timings := make(map[string]testTiming)
+	for _, path := range reports {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		parsed, err := parseTestReport(path)
+		if err != nil {
+			slog.WarnContext(ctx, "testtiming: skipping unreadable test report", "path", path, "error", err)
+			continue
+		}
+		metrics.ReportsParsed++
+
+		report := path
+		if rel, relErr := filepath.Rel(repoPath, path); relErr == nil && !strings.HasPrefix(rel, "..") {
+			report = filepath.ToSlash(rel)
+		}
+		for _, t := range parsed {
+			t.Report = report
+			key := t.Package + "\x00" + t.Name
+			if prev, ok := timings[key]; !ok || t.Elapsed > prev.Elapsed {
+				timings[key] = t
+			}
+		}
+	}
+	if metrics.ReportsParsed == 0 {
+		return nil, fmt.Errorf("no readable test reports matched %s", strings.Join(opts.TestReports, ", "))
+	}
+	metrics.TestsSeen = len(timings)
+
+	threshold := opts.SlowTestThreshold
+	if threshold <= 0 {
+		threshold = defaultSlowTestThreshold
+	}
+	modulePath := readGoModulePath(repoPath)
+	excludes := mergeExcludes(opts.ExcludePatterns)
+
+	var signals []signal.RawSignal
+	for _, t := range timings {
+		budget := slowTestBudget(t.Package, opts.SlowTestBudgets, threshold)
+		if t.Elapsed <= budget {
+			continue
+		}
+		file, line := locateTest(repoPath, modulePath, t)
+		if file != t.Report && shouldExclude(file, excludes) {
+			continue
+		}
+		signals = append(signals, slowTestSignal(t, file, line, budget))
+	}
+	metrics.SlowTests = len(signals)
+
+	sort.Slice(signals, func(i, j int) bool {
+		if signals[i].FilePath != signals[j].FilePath {
+			return signals[i].FilePath < signals[j].FilePath
+		}
+		return signals[i].Title < signals[j].Title
+	})
+	return signals, nil
+}
+
+// expandReports resolves report paths and globs relative to repoPath,
+// warning on behalf of the named collector about patterns that match nothing.
+func expandReports(ctx context.Context, name, repoPath string, patterns []string) []string {
+	var paths []string
+	seen := make(map[string]bool)
+	for _, pattern := range patterns {
+		if !filepath.IsAbs(pattern) {
+			pattern = filepath.Join(repoPath, pattern)
+		}
+		matches, err := filepath.Glob(pattern)
+		if err != nil || len(matches) == 0 {
+			slog.WarnContext(ctx, name+": no reports match", "pattern", pattern)
+			continue
+		}
+		for _, m := range matches {
+			if !seen[m] {
+				seen[m] = true
+				paths = append(paths, m)
+			}
+		}
+	}
+	return paths
+}
+
+// parseTestReport reads a JUnit XML report or go test -json output,
+// detected from the first non-space byte.
+func parseTestReport(path string) ([]testTiming, error) {
+	data, err := FS.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) > 0 && trimmed[0] == '<' {
+		return parseJUnitReport(trimmed)
+	}
+	return parseGoTestJSON(data)
+}
+
+// goTestEvent is one line of go test -json output.
+type goTestEvent struct {
+	Action  string
+	Package string
+	Test    string
+	Elapsed float64
+}
+
+// parseGoTestJSON extracts the duration of each top-level test from go test
+// -json output. Subtests are skipped since their time is included in the
+// parent's. Lines that are not JSON, such as build output, are ignored.
+func parseGoTestJSON(data []byte) ([]testTiming, error) {
+	var timings []testTiming
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 0, 64*1024), maxTestReportLine)
+	for scanner.Scan() {
+		var ev goTestEvent
+		if err := json.Unmarshal(scanner.Bytes(), &ev); err != nil {
+			continue
+		}
+		if ev.Test == "" || strings.Contains(ev.Test, "/") {
+			continue
+		}
+		if ev.Action != "pass" && ev.Action != "fail" {
+			continue
+		}
+		timings = append(timings, testTiming{
+			Package: ev.Package,
+			Name:    ev.Test,
+			Elapsed: secondsToDuration(ev.Elapsed),
+		})
+	}
+	return timings, scanner.Err()
+}
+
+// junitSuite is a <testsuite> or <testsuites> element. Suites may nest.
+type junitSuite struct {
+	XMLName xml.Name
+	Name    string          `xml:"name,attr"`
+	Suites  []junitSuite    `xml:"testsuite"`
+	Cases   []junitTestCase `xml:"testcase"`
+}
+
+// junitTestCase is a <testcase> element.
+type junitTestCase struct {
+	Name      string `xml:"name,attr"`
+	Classname string `xml:"classname,attr"`
+	Time      string `xml:"time,attr"`
+	File      string `xml:"file,attr"`
+	Line      int    `xml:"line,attr"`
+}
+
+// parseJUnitReport extracts test case durations from a JUnit XML report.
+// The package of a case is its classname, falling back to the suite name.
+func parseJUnitReport(data []byte) ([]testTiming, error) {
+	var root junitSuite
+	if err := xml.Unmarshal(data, &root); err != nil {
+		return nil, fmt.Errorf("parse JUnit XML: %w", err)
+	}
+
+	var timings []testTiming
+	var walk func(s junitSuite)
+	walk = func(s junitSuite) {
+		for _, tc := range s.Cases {
+			secs, err := strconv.ParseFloat(strings.ReplaceAll(tc.Time, ",", ""), 64)
+			if err != nil {
+				continue
+			}
+			pkg := tc.Classname
+			if pkg == "" {
+				pkg = s.Name
+			}
+			timings = append(timings, testTiming{
+				Package: pkg,
+				Name:    tc.Name,
+				Elapsed: secondsToDuration(secs),
+				File:    tc.File,
+				Line:    tc.Line,
+			})
+		}
+		for _, child := range s.Suites {
+			walk(child)
+		}
+	}
+	walk(root)
+	return timings, nil
+}
+
+// secondsToDuration converts fractional seconds to a Duration.
+func secondsToDuration(secs float64) time.Duration {
+	return time.Duration(secs * float64(time.Second))
+}
+
+// slowTestBudget returns the latency budget for tests in pkg. A budget key
+// applies to the package it names and every package beneath it ("/" or "."
+// separated); the most specific key wins.
+func slowTestBudget(pkg string, budgets map[string]time.Duration, fallback time.Duration) time.Duration {
+	best, bestLen := fallback, -1
+	for key, budget := range budgets {
+		if key == pkg || strings.HasPrefix(pkg, key+"/") || strings.HasPrefix(pkg, key+".") {
+			if len(key) > bestLen {
+				best, bestLen = budget, len(key)
+			}
+		}
+	}
+	return best
+}
+
+// locateTest returns the repo-relative file and line that define a test.
+// It uses the file reported by the runner when present, maps Go import
+// paths under the repo's module to their directory, and maps dotted class
+// names (Python, Java, Kotlin) to source files. Tests that cannot be
+// located are attributed to the report they came from.
+func locateTest(repoPath, modulePath string, t testTiming) (string, int) {
+	if t.File != "" {
+		file := t.File
+		if filepath.IsAbs(file) {
+			if rel, err := filepath.Rel(repoPath, file); err == nil {
+				file = rel
+			}
+		}
+		file = filepath.ToSlash(file)
+		line := t.Line
+		if line == 0 {
+			line = findTestLine(filepath.Join(repoPath, file), t.Name)
+		}
+		return file, line
+	}
+
+	if modulePath != "" && (t.Package == modulePath || strings.HasPrefix(t.Package, modulePath+"/")) {
+		dir := strings.TrimPrefix(strings.TrimPrefix(t.Package, modulePath), "/")
+		matches, _ := filepath.Glob(filepath.Join(repoPath, filepath.FromSlash(dir), "*_test.go"))
+		sort.Strings(matches)
+		for _, m := range matches {
+			if line := findTestLine(m, t.Name); line > 0 {
+				return relSlash(repoPath, m), line
+			}
+		}
+		if dir == "" {
+			dir = "."
+		}
+		return dir, 0
+	}
+
+	for _, candidate := range classFileCandidates(t.Package) {
+		abs := filepath.Join(repoPath, filepath.FromSlash(candidate))
+		if info, err := FS.Stat(abs); err == nil && !info.IsDir() {
+			return candidate, findTestLine(abs, t.Name)
+		}
+	}
+	return t.Report, 0
+}
+
+// classTestRoots are directories that conventionally hold JVM test sources.
+var classTestRoots = []string{"src/test/java/", "src/test/kotlin/", ""}
+
+// classFileCandidates lists source files a dotted class name may live in,
+// most specific first. "tests.test_api.TestUsers" yields
+// tests/test_api/TestUsers.py, src/test/java/tests/test_api/TestUsers.java,
+// ..., tests/test_api.py, and so on.
+func classFileCandidates(classname string) []string {
+	if classname == "" || strings.ContainsAny(classname, "/\\") {
+		return nil
+	}
+	parts := strings.Split(classname, ".")
+	var candidates []string
+	for n := len(parts); n > 0; n-- {
+		path := strings.Join(parts[:n], "/")
+		candidates = append(candidates, path+".py")
+		for _, root := range classTestRoots {
+			candidates = append(candidates, root+path+".java", root+path+".kt")
+		}
+	}
+	return candidates
+}
+
+// findTestLine returns the first line of path that looks like the
+// definition of test name, or 0 if none does.
+func findTestLine(path, name string) int {
+	lines, err := readFileLines(path)
+	if err != nil {
+		return 0
+	}
+	def := regexp.MustCompile(`\b` + regexp.QuoteMeta(name) + `\s*\(`)
+	for i, line := range lines {
+		if def.MatchString(line) {
+			return i + 1
+		}
+	}
+	return 0
+}
+
+// relSlash returns path relative to base with forward slashes.
+func relSlash(base, path string) string {
+	rel, err := filepath.Rel(base, path)
+	if err != nil {
+		return filepath.ToSlash(path)
+	}
+	return filepath.ToSlash(rel)
+}
+
+// slowTestSignal builds the slow-test signal for t.
+func slowTestSignal(t testTiming, file string, line int, budget time.Duration) signal.RawSignal {
+	elapsed := t.Elapsed.Round(10 * time.Millisecond)
+	return signal.RawSignal{
+		Source:   "testtiming",
+		Kind:     "slow-test",
+		FilePath: file,
+		Line:     line,
+		Title:    fmt.Sprintf("Slow test: %s took %s (budget %s)", t.Name, elapsed, budget),
+		Description: fmt.Sprintf("Test %s in %s took %s, over its %s latency budget (from %s). "+
+			"Slow tests lengthen CI feedback loops. Consider splitting the test, faking slow dependencies, "+
+			"or moving it behind an integration-test build tag.",
+			t.Name, t.Package, elapsed, budget, t.Report),
+		Confidence: slowTestConfidence(t.Elapsed, budget),
+		Tags:       []string{"slow-test"},
+	}
+}
+
+// slowTestConfidence scales from 0.4 just over budget to 0.8 at four times
+// the budget or more.
+func slowTestConfidence(elapsed, budget time.Duration) float64 {
+	ratio := float64(elapsed) / float64(budget)
+	return math.Min(0.4+0.4*(ratio-1)/3, 0.8)
+}
+
+// Metrics returns structured metrics from the test timing scan.
+func (c *TestTimingCollector) Metrics() any { return c.metrics }
+
+// Compile-time interface checks.
+var _ collector.Collector = (*TestTimingCollector)(nil)
+var _ collector.MetricsProvider = (*TestTimingCollector)(nil)
@@ -0,0 +1,211 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package collectors
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+const goTestJSONReport = `{"Action":"start","Package":"example.com/m/pkg/foo"}
+{"Action":"run","Package":"example.com/m/pkg/foo","Test":"TestSlow"}
+{"Action":"output","Package":"example.com/m/pkg/foo","Test":"TestSlow","Output":"=== RUN   TestSlow\n"}
+{"Action":"pass","Package":"example.com/m/pkg/foo","Test":"TestSlow/sub","Elapsed":4.9}
+{"Action":"pass","Package":"example.com/m/pkg/foo","Test":"TestSlow","Elapsed":5.02}
+{"Action":"pass","Package":"example.com/m/pkg/foo","Test":"TestFast","Elapsed":0.1}
+# example.com/m/pkg/bar [build failed]
+{"Action":"fail","Package":"example.com/m/pkg/foo","Test":"TestFlaky","Elapsed":3}
+{"Action":"pass","Package":"example.com/m/pkg/foo","Elapsed":8.2}
+`
+
+const junitReport = `<?xml version="1.0" encoding="UTF-8"?>
+<testsuites>
+  <testsuite name="tests.test_api" tests="2">
+    <testcase classname="tests.test_api" name="test_upload" time="12.5"/>
+    <testcase classname="tests.test_api" name="test_ping" time="0.01"/>
+    <testsuite name="nested">
+      <testcase name="test_nested" time="1,234.5"/>
+    </testsuite>
+  </testsuite>
+  <testsuite name="app">
+    <testcase classname="app.Checkout" name="test_total" time="9" file="app/checkout_test.py" line="42"/>
+    <testcase classname="app.Checkout" name="test_bad_time" time="n/a"/>
+  </testsuite>
+</testsuites>
+`
+
+func TestParseGoTestJSON(t *testing.T) {
+	timings, err := parseGoTestJSON([]byte(goTestJSONReport))
+	require.NoError(t, err)
+	require.Len(t, timings, 3)
+	assert.Equal(t, testTiming{Package: "example.com/m/pkg/foo", Name: "TestSlow", Elapsed: 5020 * time.Millisecond}, timings[0])
+	assert.Equal(t, "TestFast", timings[1].Name)
+	assert.Equal(t, "TestFlaky", timings[2].Name, "failed tests are timed too")
+}
+
+func TestParseJUnitReport(t *testing.T) {
+	timings, err := parseJUnitReport([]byte(junitReport))
+	require.NoError(t, err)
+	require.Len(t, timings, 4)
+
+	byName := make(map[string]testTiming)
+	for _, tt := range timings {
+		byName[tt.Name] = tt
+	}
+	assert.Equal(t, 12500*time.Millisecond, byName["test_upload"].Elapsed)
+	assert.Equal(t, "tests.test_api", byName["test_upload"].Package)
+	assert.Equal(t, "nested", byName["test_nested"].Package, "suite name is used without a classname")
+	assert.Equal(t, 1234500*time.Millisecond, byName["test_nested"].Elapsed)
+	assert.Equal(t, "app/checkout_test.py", byName["test_total"].File)
+	assert.Equal(t, 42, byName["test_total"].Line)
+}
+
+func TestParseJUnitReport_SingleSuiteRoot(t *testing.T) {
+	timings, err := parseJUnitReport([]byte(`<testsuite name="s"><testcase classname="c" name="t" time="3"/></testsuite>`))
+	require.NoError(t, err)
+	require.Len(t, timings, 1)
+	assert.Equal(t, 3*time.Second, timings[0].Elapsed)
+}
+
+func TestParseJUnitReport_Malformed(t *testing.T) {
+	_, err := parseJUnitReport([]byte(`<testsuite><testcase`))
+	assert.Error(t, err)
+}
+
+func TestSlowTestBudget(t *testing.T) {
+	budgets := map[string]time.Duration{
+		"example.com/m":             10 * time.Second,
+		"example.com/m/pkg/foo":     30 * time.Second,
+		"com.example":               5 * time.Second,
+		"example.com/m/pkg/foobar2": time.Minute,
+	}
+	fallback := 2 * time.Second
+
+	assert.Equal(t, 30*time.Second, slowTestBudget("example.com/m/pkg/foo", budgets, fallback))
+	assert.Equal(t, 30*time.Second, slowTestBudget("example.com/m/pkg/foo/sub", budgets, fallback))
+	assert.Equal(t, 10*time.Second, slowTestBudget("example.com/m/pkg/foobar", budgets, fallback))
+	assert.Equal(t, 5*time.Second, slowTestBudget("com.example.CheckoutTest", budgets, fallback))
+	assert.Equal(t, fallback, slowTestBudget("org.other", budgets, fallback))
+}
+
+func TestSlowTestConfidence(t *testing.T) {
+	assert.InDelta(t, 0.4, slowTestConfidence(2*time.Second, 2*time.Second), 0.001)
+	assert.InDelta(t, 0.6, slowTestConfidence(5*time.Second, 2*time.Second), 0.001)
+	assert.InDelta(t, 0.8, slowTestConfidence(time.Minute, 2*time.Second), 0.001)
+}
+
+func TestTestTimingCollector_GoReport(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "go.mod", "module example.com/m\n\ngo 1.25\n")
+	writeFile(t, dir, "pkg/foo/foo_test.go", "package foo\n\nimport \"testing\"\n\nfunc TestFast(t *testing.T) {}\n\nfunc TestSlow(t *testing.T) {}\n")
+	writeFile(t, dir, "reports/go-test.json", goTestJSONReport)
+
+	c := &TestTimingCollector{}
+	signals, err := c.Collect(context.Background(), dir, signal.CollectorOpts{
+		TestReports: []string{"reports/*.json"},
+	})
+	require.NoError(t, err)
+	require.Len(t, signals, 2)
+
+	// TestFlaky has no definition in the package, so it is attributed to the directory.
+	assert.Equal(t, "pkg/foo", signals[0].FilePath)
+	assert.Equal(t, 0, signals[0].Line)
+	assert.Contains(t, signals[0].Title, "TestFlaky took 3s (budget 2s)")
+
+	sig := signals[1]
+	assert.Equal(t, "testtiming", sig.Source)
+	assert.Equal(t, "slow-test", sig.Kind)
+	assert.Equal(t, "pkg/foo/foo_test.go", sig.FilePath)
+	assert.Equal(t, 7, sig.Line)
+	assert.Equal(t, "Slow test: TestSlow took 5.02s (budget 2s)", sig.Title)
+	assert.Contains(t, sig.Description, "reports/go-test.json")
+	assert.Equal(t, []string{"slow-test"}, sig.Tags)
+
+	metrics, ok := c.Metrics().(*TestTimingMetrics)
+	require.True(t, ok)
+	assert.Equal(t, 1, metrics.ReportsParsed)
+	assert.Equal(t, 3, metrics.TestsSeen)
+	assert.Equal(t, 2, metrics.SlowTests)
+}
+
+func TestTestTimingCollector_PackageBudget(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "report.json", goTestJSONReport)
+
+	c := &TestTimingCollector{}
+	signals, err := c.Collect(context.Background(), dir, signal.CollectorOpts{
+		TestReports:       []string{"report.json"},
+		SlowTestThreshold: time.Second,
+		SlowTestBudgets:   map[string]time.Duration{"example.com/m/pkg": 10 * time.Second},
+	})
+	require.NoError(t, err)
+	assert.Empty(t, signals)
+}
+
+func TestTestTimingCollector_JUnitReport(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "tests/test_api.py", "import pytest\n\n\ndef test_ping():\n    pass\n\n\ndef test_upload():\n    pass\n")
+	writeFile(t, dir, "junit.xml", junitReport)
+
+	c := &TestTimingCollector{}
+	signals, err := c.Collect(context.Background(), dir, signal.CollectorOpts{
+		TestReports: []string{filepath.Join(dir, "junit.xml")},
+	})
+	require.NoError(t, err)
+	require.Len(t, signals, 3)
+
+	files := map[string]int{}
+	for _, s := range signals {
+		files[s.FilePath] = s.Line
+	}
+	assert.Equal(t, map[string]int{
+		"app/checkout_test.py": 42, // from the file and line attributes
+		"tests/test_api.py":    8,  // classname mapped to a Python module
+		"junit.xml":            0,  // no source found, attributed to the report
+	}, files)
+}
+
+func TestTestTimingCollector_KeepsSlowestRun(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "run1.json", `{"Action":"pass","Package":"p","Test":"TestX","Elapsed":1}`+"\n")
+	writeFile(t, dir, "run2.json", `{"Action":"pass","Package":"p","Test":"TestX","Elapsed":6}`+"\n")
+
+	signals, err := (&TestTimingCollector{}).Collect(context.Background(), dir, signal.CollectorOpts{
+		TestReports: []string{"run1.json", "run2.json", "run*.json"},
+	})
+	require.NoError(t, err)
+	require.Len(t, signals, 1)
+	assert.Contains(t, signals[0].Title, "took 6s")
+	assert.Equal(t, "run2.json", signals[0].FilePath)
+}
+
+func TestTestTimingCollector_NoReportsConfigured(t *testing.T) {
+	signals, err := (&TestTimingCollector{}).Collect(context.Background(), t.TempDir(), signal.CollectorOpts{})
+	require.NoError(t, err)
+	assert.Empty(t, signals)
+}
+
+func TestTestTimingCollector_MissingReport(t *testing.T) {
+	_, err := (&TestTimingCollector{}).Collect(context.Background(), t.TempDir(), signal.CollectorOpts{
+		TestReports: []string{"missing.xml"},
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no readable test reports")
+}
+
+func TestClassFileCandidates(t *testing.T) {
+	candidates := classFileCandidates("com.example.CartTest")
+	assert.Equal(t, "com/example/CartTest.py", candidates[0])
+	assert.Contains(t, candidates, "src/test/java/com/example/CartTest.java")
+	assert.Contains(t, candidates, "src/test/kotlin/com/example/CartTest.kt")
+	assert.Nil(t, classFileCandidates("example.com/m/pkg"))
+	assert.Nil(t, classFileCandidates(""))
+}
@@ -5,9 +5,9 @@
 package collectors
 
 import (
-	"bufio"
 	"context"
 	"fmt"
+	"log/slog"
 	"math"
 	"os"
 	"path/filepath"
@@ -15,6 +15,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/davetashner/stringer/internal/blameindex"
 	"github.com/davetashner/stringer/internal/collector"
 	"github.com/davetashner/stringer/internal/gitcli"
 	"github.com/davetashner/stringer/internal/signal"
@@ -55,6 +56,36 @@ var todoPattern = regexp.MustCompile(
 		`(.*)`, // message (captured)
 )
 
+// ParseTODO extracts the upper-cased keyword and message from a line
+// containing a TODO-style comment, using the same rules as the todos
+// collector. ok is false when the line has none.
+func ParseTODO(line string) (keyword, message string, ok bool) {
+	return parseTodoLine(line)
+}
+
+func parseTodoLine(line string) (keyword, message string, ok bool) {
+	loc := todoPattern.FindStringSubmatchIndex(line)
+	if loc == nil {
+		return "", "", false
+	}
+
+	// Skip matches that fall inside string literals (e.g. '.get("//todo@txt")').
+	if isInsideStringLiteral(line, loc[0]) {
+		return "", "", false
+	}
+
+	keyword = strings.ToUpper(line[loc[2]:loc[3]])
+	message = strings.TrimSpace(line[loc[4]:loc[5]])
+	// Strip trailing block-comment close if present.
+	message = strings.TrimSuffix(message, "*/")
+	message = strings.TrimSpace(message)
+
+	if message == "" {
+		message = keyword + " comment (no description)"
+	}
+	return keyword, message, true
+}
+
 // defaultExcludePatterns are directory/file globs skipped unless overridden.
 var defaultExcludePatterns = []string{
 	"vendor/**",
@@ -133,6 +164,20 @@ func (c *TodoCollector) Name() string { return "todos" }
 // Collect walks source files in repoPath, extracts TODO-style comments, and
 // returns them as raw signals with confidence scores and blame attribution.
 func (c *TodoCollector) Collect(ctx context.Context, repoPath string, opts signal.CollectorOpts) ([]signal.RawSignal, error) {
+	var signals []signal.RawSignal
+	err := c.Stream(ctx, repoPath, opts, func(sig signal.RawSignal) error {
+		signals = append(signals, sig)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return signals, nil
+}
+
+// Stream is Collect for streaming pipelines: each file's TODOs are passed
+// to emit once they are scored, so they are not held until the walk ends.
+func (c *TodoCollector) Stream(ctx context.Context, repoPath string, opts signal.CollectorOpts, emit func(signal.RawSignal) error) error {
 	excludes := mergeExcludes(opts.ExcludePatterns)
 
 	// Determine git root for blame lookups.
@@ -142,14 +187,18 @@ func (c *TodoCollector) Collect(ctx context.Context, repoPath string, opts signa
 		gitRoot = opts.GitRoot
 	}
 	gitDir := ""
+	var idx *blameindex.Index
 	if gitcli.Available() == nil && isGitRepo(gitRoot) {
 		gitDir = gitRoot
+		idx = blameindex.Open(ctx, gitRoot)
 	}
 
-	var signals []signal.RawSignal
+	limits := newScanLimits(opts)
+
+	metrics := &TodoMetrics{ByKind: make(map[string]int)}
 	var fileCount int
 
-	err := FS.WalkDir(repoPath, func(path string, d os.DirEntry, walkErr error) error {
+	err := walkSource(ctx, repoPath, opts, func(path string, d os.DirEntry, walkErr error) error {
 		if walkErr != nil {
 			return nil // skip unreadable entries
 		}
@@ -164,7 +213,7 @@ func (c *TodoCollector) Collect(ctx context.Context, repoPath string, opts signa
 
 		// Skip directories that match exclude patterns early.
 		if d.IsDir() {
-			if shouldExclude(relPath, excludes) {
+			if shouldExclude(relPath, excludes) || !opts.Scope.ContainsDir(relPath) {
 				return filepath.SkipDir
 			}
 			return nil
@@ -175,8 +224,9 @@ func (c *TodoCollector) Collect(ctx context.Context, repoPath string, opts signa
 			return nil
 		}
 
-		// Skip symlinks that resolve outside the repo tree to prevent traversal.
-		if d.Type()&os.ModeSymlink != 0 && isSymlinkOutsideRepo(path, repoPath) {
+		// Skip symlinks; targets inside the tree are visited at their
+		// canonical path.
+		if isSymlink(d) {
 			return nil
 		}
 
@@ -185,14 +235,13 @@ func (c *TodoCollector) Collect(ctx context.Context, repoPath string, opts signa
 			return nil
 		}
 
-		// Skip binary files.
-		if isBinaryFile(path) {
+		if !opts.Scope.Contains(relPath) {
 			return nil
 		}
 
-		found, scanErr := scanFile(path, relPath)
-		if scanErr != nil {
-			return nil // skip files we can't read
+		found, ok := c.scanCached(ctx, path, relPath, d, limits, opts.Cache)
+		if !ok {
+			return nil
 		}
 
 		// For blame, we need the path relative to gitRoot (not repoPath).
@@ -202,11 +251,29 @@ func (c *TodoCollector) Collect(ctx context.Context, repoPath string, opts signa
 		}
 
 		for i := range found {
-			enrichWithBlame(ctx, gitDir, blameRelPath, &found[i], path)
+			if len(found[i].References) > 0 {
+				resolveFileRefs(repoPath, &found[i])
+			}
+			if !enrichFromIndex(idx, blameRelPath, &found[i]) {
+				enrichWithBlame(ctx, gitDir, blameRelPath, &found[i], path)
+			}
 			found[i].Confidence = computeConfidence(found[i])
+			found[i].Factors = todoConfidenceFactors(found[i])
+		}
+		if opts.FunctionBlame {
+			blameFunctions(ctx, idx, gitDir, blameRelPath, path, found)
 		}
 
-		signals = append(signals, found...)
+		for _, sig := range found {
+			metrics.Total++
+			metrics.ByKind[sig.Kind]++
+			if !sig.Timestamp.IsZero() {
+				metrics.WithTimestamp++
+			}
+			if err := emit(sig); err != nil {
+				return err
+			}
+		}
 
 		fileCount++
 		if opts.ProgressFunc != nil && fileCount%500 == 0 {
@@ -217,25 +284,39 @@ func (c *TodoCollector) Collect(ctx context.Context, repoPath string, opts signa
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("walking repo: %w", err)
+		return fmt.Errorf("walking repo: %w", err)
 	}
+	c.metrics = metrics
+	return nil
+}
 
-	// Build metrics from collected signals.
-	byKind := make(map[string]int)
-	withTimestamp := 0
-	for _, sig := range signals {
-		byKind[sig.Kind]++
-		if !sig.Timestamp.IsZero() {
-			withTimestamp++
-		}
+// scanCached returns the TODOs in a file, replaying them from cache when
+// the file is unchanged since an earlier scan. ok is false for binary and
+// unreadable files. Results are stored before blame enrichment, which
+// depends on git history rather than the file alone, and only for files
+// scanned in full.
+func (c *TodoCollector) scanCached(ctx context.Context, path, relPath string, d os.DirEntry, limits scanLimits, cache signal.FileCache) (found []signal.RawSignal, ok bool) {
+	info, infoErr := d.Info()
+	if infoErr != nil || info.Size() > limits.maxBytes {
+		cache = nil
 	}
-	c.metrics = &TodoMetrics{
-		Total:         len(signals),
-		ByKind:        byKind,
-		WithTimestamp: withTimestamp,
+	if cache != nil && cache.Get(c.Name(), relPath, info, &found) {
+		return found, true
 	}
 
-	return signals, nil
+	// Skip binary files. UTF-16 and other supported encodings are
+	// transcoded by scanTodos rather than skipped.
+	if isUndecodableFile(ctx, path) {
+		return nil, false
+	}
+	found, truncated, err := scanTodos(ctx, path, relPath, limits)
+	if err != nil {
+		return nil, false // skip files we can't read
+	}
+	if cache != nil && !truncated {
+		cache.Put(c.Name(), relPath, info, found)
+	}
+	return found, true
 }
 
 // isInsideStringLiteral walks line up to matchStart, tracking whether we are
@@ -266,59 +347,53 @@ func isInsideStringLiteral(line string, matchStart int) bool {
 	return inSingle || inDouble || inBacktick
 }
 
-// scanFile reads a file line by line and extracts TODO-style signals.
-func scanFile(absPath, relPath string) ([]signal.RawSignal, error) {
-	f, err := FS.Open(absPath)
+// scanFile reads a file line by line and extracts TODO-style signals. Files
+// that exceed the size cap or per-file timeout are scanned only partially,
+// and their signals are tagged truncated-scan.
+func scanFile(ctx context.Context, absPath, relPath string, limits scanLimits) ([]signal.RawSignal, error) {
+	signals, _, err := scanTodos(ctx, absPath, relPath, limits)
+	return signals, err
+}
+
+// scanTodos is scanFile that also reports whether the scan stopped early.
+func scanTodos(ctx context.Context, absPath, relPath string, limits scanLimits) (signals []signal.RawSignal, truncated bool, err error) {
+	f, err := openSource(ctx, absPath)
 	if err != nil {
-		return nil, err
+		return nil, false, err
 	}
 	defer f.Close() //nolint:errcheck // read-only file, close error is inconsequential
 
-	var signals []signal.RawSignal
-	scanner := bufio.NewScanner(f)
 	lineNo := 0
 
-	for scanner.Scan() {
+	truncated, err = limits.scanLines(ctx, f, func(line string) {
 		lineNo++
-		line := scanner.Text()
-
-		loc := todoPattern.FindStringSubmatchIndex(line)
-		if loc == nil {
-			continue
-		}
-
-		// Skip matches that fall inside string literals (e.g. '.get("//todo@txt")').
-		if isInsideStringLiteral(line, loc[0]) {
-			continue
-		}
-
-		keyword := strings.ToUpper(line[loc[2]:loc[3]])
-		message := strings.TrimSpace(line[loc[4]:loc[5]])
-		// Strip trailing block-comment close if present.
-		message = strings.TrimSuffix(message, "*/")
-		message = strings.TrimSpace(message)
 
-		if message == "" {
-			message = keyword + " comment (no description)"
+		keyword, message, ok := parseTodoLine(line)
+		if !ok {
+			return
 		}
 
 		kind := strings.ToLower(keyword)
 
 		signals = append(signals, signal.RawSignal{
-			Source:   "todos",
-			Kind:     kind,
-			FilePath: relPath,
-			Line:     lineNo,
-			Title:    fmt.Sprintf("%s: %s", keyword, message),
-			Tags:     []string{kind},
+			Source:     "todos",
+			Kind:       kind,
+			FilePath:   relPath,
+			Line:       lineNo,
+			Title:      fmt.Sprintf("%s: %s", keyword, message),
+			Tags:       []string{kind},
+			References: todoRefs(line),
 		})
+	})
+	if err != nil {
+		return signals, truncated, err
 	}
-
-	if err := scanner.Err(); err != nil {
-		return signals, err
+	if truncated {
+		slog.WarnContext(ctx, "todos: file scan truncated", "path", relPath, "lines_scanned", lineNo)
+		tagTruncated(signals)
 	}
 
-	return signals, nil
+	return signals, truncated, nil
 }
 
 // enrichWithBlame populates Author and Timestamp from git blame if available.
@@ -353,6 +428,22 @@ func enrichWithBlame(ctx context.Context, gitDir string, relPath string, sig *si
 	sig.Timestamp = bl.AuthorTime
 }
 
+// enrichFromIndex populates Author and Timestamp from the precomputed blame
+// index built by `stringer index build`. Returns false when the line is not
+// indexed (no index, uncommitted changes), in which case the caller falls
+// back to live blame.
+func enrichFromIndex(idx *blameindex.Index, relPath string, sig *signal.RawSignal) bool {
+	bl, ok := idx.Line(relPath, sig.Line)
+	if !ok {
+		return false
+	}
+	if bl.AuthorName != "" {
+		sig.Author = bl.AuthorName
+	}
+	sig.Timestamp = bl.AuthorTime
+	return true
+}
+
 // isGitRepo returns true if dir contains a .git directory or file.
 func isGitRepo(dir string) bool {
 	_, err := FS.Stat(filepath.Join(dir, ".git"))
@@ -364,24 +455,39 @@ func isGitRepo(dir string) bool {
 //   - Recency boost: +0.1 if < 30 days old
 //   - Capped at 1.0
 func computeConfidence(sig signal.RawSignal) float64 {
+	score := 0.0
+	for _, f := range todoConfidenceFactors(sig) {
+		score += f.Delta
+	}
+	return math.Min(score, 1.0)
+}
+
+// todoConfidenceFactors returns the steps computeConfidence sums: the
+// keyword's base score and, for comments under 30 days old, the recency
+// boost.
+func todoConfidenceFactors(sig signal.RawSignal) []signal.ConfidenceFactor {
 	keyword := strings.ToUpper(sig.Kind)
 	base, ok := todoKeyword[keyword]
+	detail := keyword + " keyword"
 	if !ok {
-		base = 0.5
+		base, detail = 0.5, "unrecognized keyword "+keyword
 	}
-
-	score := base
+	factors := []signal.ConfidenceFactor{{Name: "base", Delta: base, Detail: detail}}
 
 	if !sig.Timestamp.IsZero() {
 		age := time.Since(sig.Timestamp)
 		thirtyDays := 30 * 24 * time.Hour
 
 		if age < thirtyDays {
-			score += 0.1
+			factors = append(factors, signal.ConfidenceFactor{
+				Name:   "recency",
+				Delta:  0.1,
+				Detail: fmt.Sprintf("added %d days ago (under 30)", int(age.Hours()/24)),
+			})
 		}
 	}
 
-	return math.Min(score, 1.0)
+	return factors
 }
 
 // shouldExclude returns true if relPath matches any of the exclude patterns.
@@ -489,3 +595,4 @@ func (c *TodoCollector) Metrics() any { return c.metrics }
 // Compile-time interface checks.
 var _ collector.Collector = (*TodoCollector)(nil)
 var _ collector.MetricsProvider = (*TodoCollector)(nil)
+var _ collector.Streamer = (*TodoCollector)(nil)
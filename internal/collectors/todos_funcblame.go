@@ -0,0 +1,183 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package collectors
+
+import (
+	"context"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"strings"
+
+	"github.com/davetashner/stringer/internal/blameindex"
+	"github.com/davetashner/stringer/internal/gitcli"
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+// funcSpan is the line range of a function, 1-based and inclusive.
+type funcSpan struct {
+	name       string
+	start, end int
+}
+
+// blameFunctions sets the FunctionOwner of each TODO in sigs, all found in
+// the file at absPath, to the author of most lines of the innermost
+// function enclosing it. When that is not the TODO's author, the
+// description says so, so the TODO can go to someone still familiar with
+// the code. The file is blamed at most once, from the blame index when it
+// has the file and with git blame otherwise.
+func blameFunctions(ctx context.Context, idx *blameindex.Index, gitDir, relPath, absPath string, sigs []signal.RawSignal) {
+	if gitDir == "" || len(sigs) == 0 {
+		return
+	}
+	src, err := FS.ReadFile(absPath)
+	if err != nil {
+		return
+	}
+	spans := funcSpans(absPath, src)
+	if len(spans) == 0 {
+		return
+	}
+
+	var blame []gitcli.BlameLine
+	blamed := false
+	for i := range sigs {
+		fn, ok := enclosingFunc(spans, sigs[i].Line)
+		if !ok {
+			continue
+		}
+		if !blamed {
+			blamed = true
+			var indexed bool
+			if blame, indexed = idx.File(filepath.ToSlash(relPath)); !indexed {
+				blameCtx, cancel := context.WithTimeout(ctx, gitcli.DefaultTimeout)
+				blame, _ = gitcli.BlameFile(blameCtx, gitDir, filepath.ToSlash(relPath)) //nolint:errcheck // untracked files have no owner
+				cancel()
+			}
+		}
+		owner, share := functionOwner(blame, fn.start, fn.end)
+		if owner == "" {
+			continue
+		}
+		sigs[i].FunctionOwner = owner
+		if owner == sigs[i].Author {
+			continue
+		}
+		if sigs[i].Description != "" {
+			sigs[i].Description += "\n"
+		}
+		sigs[i].Description += fmt.Sprintf("In %s (lines %d-%d), where %s wrote %.0f%% of the lines.", fn.name, fn.start, fn.end, owner, share*100)
+	}
+}
+
+// functionOwner returns the author of most of the lines start to end of a
+// file's blame and their share of the range. Ties go to the name that
+// sorts first.
+func functionOwner(blame []gitcli.BlameLine, start, end int) (string, float64) {
+	end = min(end, len(blame))
+	if start < 1 || start > end {
+		return "", 0
+	}
+	counts := make(map[string]int)
+	for _, bl := range blame[start-1 : end] {
+		if bl.AuthorName != "" {
+			counts[bl.AuthorName]++
+		}
+	}
+	owner, most := "", 0
+	for name, n := range counts {
+		if n > most || (n == most && name < owner) {
+			owner, most = name, n
+		}
+	}
+	return owner, float64(most) / float64(end-start+1)
+}
+
+// enclosingFunc returns the shortest span containing line, which for
+// nested functions is the innermost one.
+func enclosingFunc(spans []funcSpan, line int) (funcSpan, bool) {
+	var best funcSpan
+	found := false
+	for _, s := range spans {
+		if line < s.start || line > s.end {
+			continue
+		}
+		if !found || s.end-s.start < best.end-best.start {
+			best, found = s, true
+		}
+	}
+	return best, found
+}
+
+// funcSpans returns the functions of a source file. Go files are parsed,
+// closures included; other languages use the complexity collector's
+// function patterns, which also find nested functions. A Go file that does
+// not parse falls back to the patterns.
+func funcSpans(path string, src []byte) []funcSpan {
+	ext := filepath.Ext(path)
+	if ext == ".go" {
+		if spans, err := goFuncSpans(src); err == nil {
+			return spans
+		}
+	}
+	spec := extToSpec[ext]
+	if spec == nil {
+		return nil
+	}
+	lines := strings.Split(string(src), "\n")
+	var spans []funcSpan
+	for i, line := range lines {
+		name, _ := matchFuncStart(line, spec, i+1)
+		if name == "" {
+			continue
+		}
+		var end int
+		switch spec.endMode {
+		case endDedent:
+			_, end = extractDedentBody(lines, i)
+		case endKeyword:
+			_, end = extractKeywordBody(lines, i)
+		default:
+			_, end = extractBraceBody(lines, i)
+		}
+		spans = append(spans, funcSpan{name: name, start: i + 1, end: end + 1})
+	}
+	return spans
+}
+
+// goFuncSpans returns the functions and function literals of Go source.
+// Literals are named after the function declaring them the way the Go
+// runtime names closures: Serve.func1, Serve.func2, and so on.
+func goFuncSpans(src []byte) ([]funcSpan, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "", src, parser.SkipObjectResolution)
+	if err != nil {
+		return nil, err
+	}
+	var spans []funcSpan
+	span := func(name string, n ast.Node) funcSpan {
+		return funcSpan{name: name, start: fset.Position(n.Pos()).Line, end: fset.Position(n.End()).Line}
+	}
+	for _, decl := range file.Decls {
+		outer := "glob"
+		if fn, ok := decl.(*ast.FuncDecl); ok {
+			outer = fn.Name.Name
+			if fn.Recv != nil && len(fn.Recv.List) > 0 {
+				outer = receiverString(fn.Recv.List[0].Type) + "." + outer
+			}
+			spans = append(spans, span(outer, fn))
+		}
+		n := 0
+		ast.Inspect(decl, func(node ast.Node) bool {
+			if lit, ok := node.(*ast.FuncLit); ok {
+				n++
+				spans = append(spans, span(fmt.Sprintf("%s.func%d", outer, n), lit))
+			}
+			return true
+		})
+	}
+	return spans, nil
+}
@@ -0,0 +1,105 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package collectors
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davetashner/stringer/internal/gitcli"
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+func TestFuncSpans_Go(t *testing.T) {
+	src := `package a
+
+func (s *Server) Serve() {
+	go func() {
+		// TODO: drain
+	}()
+}
+
+var handler = func() {}
+`
+	spans := funcSpans("a.go", []byte(src))
+	assert.Equal(t, []funcSpan{
+		{name: "(*Server).Serve", start: 3, end: 7},
+		{name: "(*Server).Serve.func1", start: 4, end: 6},
+		{name: "glob.func1", start: 9, end: 9},
+	}, spans)
+
+	fn, ok := enclosingFunc(spans, 5)
+	require.True(t, ok)
+	assert.Equal(t, "(*Server).Serve.func1", fn.name, "the innermost function wins")
+	_, ok = enclosingFunc(spans, 1)
+	assert.False(t, ok)
+}
+
+func TestFuncSpans_Heuristic(t *testing.T) {
+	src := `def outer():
+    x = 1
+
+    def inner():
+        # TODO: cache
+        return x
+    return inner
+
+def other():
+    pass
+`
+	spans := funcSpans("a.py", []byte(src))
+	fn, ok := enclosingFunc(spans, 5)
+	require.True(t, ok)
+	assert.Equal(t, funcSpan{name: "inner", start: 4, end: 6}, fn)
+	fn, ok = enclosingFunc(spans, 2)
+	require.True(t, ok)
+	assert.Equal(t, "outer", fn.name)
+
+	assert.Nil(t, funcSpans("notes.txt", []byte("TODO: nothing")))
+}
+
+func TestFunctionOwner(t *testing.T) {
+	blame := []gitcli.BlameLine{{AuthorName: "bob"}, {AuthorName: "alice"}, {AuthorName: "bob"}, {AuthorName: "alice"}, {AuthorName: "carol"}}
+
+	owner, share := functionOwner(blame, 1, 3)
+	assert.Equal(t, "bob", owner)
+	assert.InDelta(t, 0.667, share, 0.001)
+
+	owner, _ = functionOwner(blame, 1, 4)
+	assert.Equal(t, "alice", owner, "ties go to the name that sorts first")
+
+	owner, _ = functionOwner(blame, 5, 99)
+	assert.Equal(t, "carol", owner)
+	owner, _ = functionOwner(nil, 1, 3)
+	assert.Empty(t, owner)
+}
+
+func TestTodoCollector_FunctionBlame(t *testing.T) {
+	if gitcli.Available() != nil {
+		t.Skip("git not available")
+	}
+	dir := initTestGitRepo(t, map[string]string{
+		"a.go": "package a\n\nfunc Sync() {\n\tstep1()\n\tstep2()\n\tstep3()\n}\n",
+	})
+	runGit(t, dir, "config", "user.name", "Drive By")
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a.go"),
+		[]byte("package a\n\nfunc Sync() {\n\tstep1()\n\t// TODO: retry step2\n\tstep2()\n\tstep3()\n}\n"), 0o600))
+	runGit(t, dir, "commit", "-am", "add todo")
+
+	signals, err := (&TodoCollector{}).Collect(context.Background(), dir, signal.CollectorOpts{FunctionBlame: true})
+	require.NoError(t, err)
+	require.Len(t, signals, 1)
+	assert.Equal(t, "Drive By", signals[0].Author)
+	assert.Equal(t, "Test Author", signals[0].FunctionOwner)
+	assert.Equal(t, "In Sync (lines 3-8), where Test Author wrote 83% of the lines.", signals[0].Description)
+
+	signals, err = (&TodoCollector{}).Collect(context.Background(), dir, signal.CollectorOpts{})
+	require.NoError(t, err)
+	assert.Empty(t, signals[0].FunctionOwner, "function blame is opt-in")
+}
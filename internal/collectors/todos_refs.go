@@ -0,0 +1,123 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package collectors
+
+import (
+	"fmt"
+	"path"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+// todoRefTagPattern matches the tag right after a TODO keyword, in
+// parentheses or brackets: TODO(#123), TODO[PROJ-456], TODO(alice, #12).
+var todoRefTagPattern = regexp.MustCompile(`^\s*[(\[]([^)\]]*)[)\]]`)
+
+// issueRefPattern matches an issue number reference such as "#123" that
+// starts a word.
+var issueRefPattern = regexp.MustCompile(`(?:^|[\s(\[,])#(\d+)\b`)
+
+// trackerKeyPattern matches an issue tracker key such as "PROJ-456".
+var trackerKeyPattern = regexp.MustCompile(`^[A-Z][A-Z0-9_]+-\d+$`)
+
+// seeRefPattern matches "see X" in a TODO message, where X is a tracker
+// key or a file with an optional line: "see PROJ-456", "see foo.go:42".
+var seeRefPattern = regexp.MustCompile(`(?i)\bsee(?:\s+also)?\s+([\w./-]+(?::\d+)?)`)
+
+// fileRefPattern matches a file reference with an extension and an
+// optional line number.
+var fileRefPattern = regexp.MustCompile(`^([\w./-]*\w\.\w+)(?::(\d+))?$`)
+
+// todoRefs returns what the TODO comment on line refers to.
+func todoRefs(line string) []string {
+	loc := todoPattern.FindStringSubmatchIndex(line)
+	if loc == nil {
+		return nil
+	}
+	return parseTodoRefs(line[loc[3]:])
+}
+
+// parseTodoRefs returns the issues, tracker keys, and files a TODO comment
+// refers to, in order and without repeats. rest is the comment after the
+// keyword, tag included. File references are returned as written.
+func parseTodoRefs(rest string) []string {
+	var refs []string
+	add := func(ref string) {
+		if !slices.Contains(refs, ref) {
+			refs = append(refs, ref)
+		}
+	}
+
+	if m := todoRefTagPattern.FindStringSubmatch(rest); m != nil {
+		for _, tok := range strings.FieldsFunc(m[1], func(r rune) bool { return r == ',' || r == ' ' }) {
+			if trackerKeyPattern.MatchString(tok) {
+				add(tok)
+			}
+		}
+	}
+	for _, m := range issueRefPattern.FindAllStringSubmatch(rest, -1) {
+		add("#" + m[1])
+	}
+	for _, m := range seeRefPattern.FindAllStringSubmatch(rest, -1) {
+		ref := strings.TrimRight(m[1], ".")
+		switch {
+		case trackerKeyPattern.MatchString(ref):
+			add(ref)
+		case fileRefPattern.MatchString(ref) && !strings.Contains(ref, "://"):
+			add(ref)
+		}
+	}
+	return refs
+}
+
+// resolveFileRefs rewrites the file references of sig relative to the
+// repository root at repoPath, trying the TODO's own directory first, and
+// notes them in the description. A reference with a line number to a file
+// that does not exist is kept as written and noted as missing; one without
+// a line number is dropped, since "see example.com" names no file. It runs
+// after the scan cache, as a referenced file can go away while the TODO's
+// file stays the same.
+func resolveFileRefs(repoPath string, sig *signal.RawSignal) {
+	var refs, notes []string
+	for _, ref := range sig.References {
+		m := fileRefPattern.FindStringSubmatch(ref)
+		if m == nil || strings.HasPrefix(ref, "#") {
+			refs = append(refs, ref)
+			continue
+		}
+		resolved := ""
+		for _, candidate := range []string{path.Join(path.Dir(filepath.ToSlash(sig.FilePath)), m[1]), path.Clean(m[1])} {
+			if strings.HasPrefix(candidate, "../") {
+				continue
+			}
+			if _, err := FS.Stat(filepath.Join(repoPath, filepath.FromSlash(candidate))); err == nil {
+				resolved = candidate
+				break
+			}
+		}
+		switch {
+		case resolved != "":
+			if m[2] != "" {
+				resolved += ":" + m[2]
+			}
+			refs = append(refs, resolved)
+			notes = append(notes, "See "+resolved+".")
+		case m[2] != "":
+			refs = append(refs, ref)
+			notes = append(notes, fmt.Sprintf("Refers to %s, which does not exist.", ref))
+		}
+	}
+	sig.References = refs
+	if len(notes) == 0 {
+		return
+	}
+	if sig.Description != "" {
+		sig.Description += "\n"
+	}
+	sig.Description += strings.Join(notes, "\n")
+}
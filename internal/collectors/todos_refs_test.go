@@ -0,0 +1,67 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package collectors
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+func TestTodoRefs(t *testing.T) {
+	tests := []struct {
+		line string
+		want []string
+	}{
+		{"// TODO(#123): retry uploads", []string{"#123"}},
+		{"// TODO[JIRA-456] retry uploads", []string{"JIRA-456"}},
+		{"// TODO(alice, PROJ-7, #12): retry", []string{"PROJ-7", "#12"}},
+		{"# FIXME: fails on Windows, see #88 and #88", []string{"#88"}},
+		{"// TODO: see foo.go:42.", []string{"foo.go:42"}},
+		{"// TODO: see also internal/auth/token.go and see OPS-3", []string{"internal/auth/token.go", "OPS-3"}},
+		{"// TODO: handle UTF-8 input, see e.g. the spec", []string{"e.g"}},
+		{"// TODO(alice): retry uploads", nil},
+		{"// TODO: use issue#5 style", nil},
+		{"x := 1", nil},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, todoRefs(tt.line), tt.line)
+	}
+}
+
+func TestResolveFileRefs(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "pkg", "auth"), 0o750))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "pkg", "auth", "token.go"), []byte("package auth\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "main.go"), []byte("package main\n"), 0o600))
+
+	sig := signal.RawSignal{
+		FilePath:   "pkg/auth/login.go",
+		References: []string{"#9", "token.go:42", "main.go", "gone.go:3", "e.g", "OPS-3"},
+	}
+	resolveFileRefs(dir, &sig)
+
+	assert.Equal(t, []string{"#9", "pkg/auth/token.go:42", "main.go", "gone.go:3", "OPS-3"}, sig.References)
+	assert.Equal(t, "See pkg/auth/token.go:42.\nSee main.go.\nRefers to gone.go:3, which does not exist.", sig.Description)
+}
+
+func TestTodoCollector_References(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a.go"),
+		[]byte("package a\n// TODO(#12): retry, see b.go:2\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "b.go"), []byte("package a\n\n"), 0o600))
+
+	signals, err := (&TodoCollector{}).Collect(context.Background(), dir, signal.CollectorOpts{})
+	require.NoError(t, err)
+	require.Len(t, signals, 1)
+	assert.Equal(t, "TODO: retry, see b.go:2", signals[0].Title)
+	assert.Equal(t, []string{"#12", "b.go:2"}, signals[0].References)
+	assert.Equal(t, "See b.go:2.", signals[0].Description)
+}
@@ -5,18 +5,22 @@ package collectors
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"io/fs"
 	"math"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/davetashner/stringer/internal/blameindex"
+	"github.com/davetashner/stringer/internal/gitignore"
 	"github.com/davetashner/stringer/internal/signal"
 	"github.com/davetashner/stringer/internal/testable"
 )
@@ -152,6 +156,19 @@ func TestComputeConfidenceCap(t *testing.T) {
 	}
 }
 
+func TestTodoConfidenceFactors(t *testing.T) {
+	got := todoConfidenceFactors(signal.RawSignal{Kind: "fixme", Timestamp: time.Now().Add(-10 * 24 * time.Hour)})
+	want := []signal.ConfidenceFactor{
+		{Name: "base", Delta: 0.65, Detail: "FIXME keyword"},
+		{Name: "recency", Delta: 0.1, Detail: "added 10 days ago (under 30)"},
+	}
+	assert.Equal(t, want, got)
+
+	got = todoConfidenceFactors(signal.RawSignal{Kind: "note"})
+	want = []signal.ConfidenceFactor{{Name: "base", Delta: 0.5, Detail: "unrecognized keyword NOTE"}}
+	assert.Equal(t, want, got)
+}
+
 func TestShouldExclude(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -271,7 +288,7 @@ func Bar() error { return nil }
 		t.Fatal(err)
 	}
 
-	signals, err := scanFile(path, "example.go")
+	signals, err := scanFile(context.Background(), path, "example.go", newScanLimits(signal.CollectorOpts{}))
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -304,7 +321,7 @@ func TestScanFileEmptyMessage(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	signals, err := scanFile(path, "empty.go")
+	signals, err := scanFile(context.Background(), path, "empty.go", newScanLimits(signal.CollectorOpts{}))
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -367,7 +384,7 @@ func bugTracker() {}
 		t.Fatal(err)
 	}
 
-	signals, err := scanFile(path, "nofp.go")
+	signals, err := scanFile(context.Background(), path, "nofp.go", newScanLimits(signal.CollectorOpts{}))
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -385,7 +402,7 @@ func TestScanFile_TodoWithAuthorAfterWordBoundary(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	signals, err := scanFile(path, "author.go")
+	signals, err := scanFile(context.Background(), path, "author.go", newScanLimits(signal.CollectorOpts{}))
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -429,6 +446,62 @@ func TestCollect_BasicSignals(t *testing.T) {
 	}
 }
 
+// memCache is an in-memory signal.FileCache. Entries never go stale.
+type memCache struct {
+	entries map[string][]byte
+	puts    []string
+}
+
+func newMemCache() *memCache { return &memCache{entries: make(map[string][]byte)} }
+
+func (m *memCache) Get(collector, relPath string, _ fs.FileInfo, v any) bool {
+	data, ok := m.entries[collector+":"+relPath]
+	return ok && json.Unmarshal(data, v) == nil
+}
+
+func (m *memCache) Put(collector, relPath string, _ fs.FileInfo, v any) {
+	data, _ := json.Marshal(v)
+	m.entries[collector+":"+relPath] = data
+	m.puts = append(m.puts, collector+":"+relPath)
+}
+
+func TestCollect_ScanCache(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "a.go", "// TODO: from disk\n")
+	writeFile(t, dir, "b.go", "// FIXME: also from disk\n")
+
+	cache := newMemCache()
+	cache.Put("todos", "a.go", nil, []signal.RawSignal{
+		{Source: "todos", Kind: "todo", FilePath: "a.go", Line: 1, Title: "TODO: from cache", Tags: []string{"todo"}},
+	})
+	cache.puts = nil
+
+	c := &TodoCollector{}
+	signals, err := c.Collect(context.Background(), dir, signal.CollectorOpts{Cache: cache})
+	require.NoError(t, err)
+
+	titles := make([]string, len(signals))
+	for i, s := range signals {
+		titles[i] = s.Title
+	}
+	assert.ElementsMatch(t, []string{"TODO: from cache", "FIXME: also from disk"}, titles)
+	assert.Equal(t, []string{"todos:b.go"}, cache.puts, "only the uncached file is read and stored")
+	for _, s := range signals {
+		assert.Positive(t, s.Confidence, "replayed signals are scored like fresh ones")
+	}
+}
+
+func TestCollect_ScanCacheSkipsOversizedFiles(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "big.go", strings.Repeat("// TODO: line\n", 100))
+
+	cache := newMemCache()
+	c := &TodoCollector{}
+	_, err := c.Collect(context.Background(), dir, signal.CollectorOpts{Cache: cache, MaxFileSize: 64})
+	require.NoError(t, err)
+	assert.Empty(t, cache.puts, "truncated scans are not cached")
+}
+
 func TestCollect_ExcludePatterns(t *testing.T) {
 	repoPath := initTestGitRepo(t, map[string]string{
 		"main.go":             "// TODO: keep this\n",
@@ -491,6 +564,23 @@ func TestCollect_IncludePatterns(t *testing.T) {
 	}
 }
 
+func TestCollect_Scope(t *testing.T) {
+	repoPath := initTestGitRepo(t, map[string]string{
+		"main.go":                    "// TODO: root\n",
+		"internal/api/handler.go":    "// TODO: in scope\n",
+		"internal/api/v2/handler.go": "// TODO: too deep\n",
+		"internal/apix/other.go":     "// TODO: shared prefix\n",
+	})
+
+	c := &TodoCollector{}
+	signals, err := c.Collect(context.Background(), repoPath, signal.CollectorOpts{
+		Scope: signal.NewScope([]string{"internal/api/"}, 1),
+	})
+	require.NoError(t, err)
+	require.Len(t, signals, 1)
+	assert.Equal(t, "internal/api/handler.go", signals[0].FilePath)
+}
+
 func TestCollect_SkipsBinaryFiles(t *testing.T) {
 	repoPath := initTestGitRepo(t, map[string]string{
 		"main.go": "// TODO: text file\n",
@@ -841,7 +931,7 @@ func TestIsBinaryFile_EmptyFile(t *testing.T) {
 // --- scanFile edge case tests ---
 
 func TestScanFile_NonexistentFile(t *testing.T) {
-	_, err := scanFile("/nonexistent/path.go", "path.go")
+	_, err := scanFile(context.Background(), "/nonexistent/path.go", "path.go", newScanLimits(signal.CollectorOpts{}))
 	if err == nil {
 		t.Error("expected error for nonexistent file")
 	}
@@ -854,7 +944,7 @@ func TestScanFile_EmptyFile(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	signals, err := scanFile(path, "empty.go")
+	signals, err := scanFile(context.Background(), path, "empty.go", newScanLimits(signal.CollectorOpts{}))
 	if err != nil {
 		t.Fatalf("scanFile() error: %v", err)
 	}
@@ -871,7 +961,7 @@ func TestScanFile_BlockCommentStripping(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	signals, err := scanFile(path, "block.go")
+	signals, err := scanFile(context.Background(), path, "block.go", newScanLimits(signal.CollectorOpts{}))
 	if err != nil {
 		t.Fatalf("scanFile() error: %v", err)
 	}
@@ -927,6 +1017,33 @@ func TestCollect_SymlinkOutsideRepoSkipped(t *testing.T) {
 	}
 }
 
+func TestCollect_WorkspaceSymlinkCountedOnce(t *testing.T) {
+	// pnpm and yarn link internal packages into one another. A file reached
+	// both directly and through such a link is reported once, at its
+	// canonical path.
+	repoPath := initTestGitRepo(t, map[string]string{
+		"packages/ui/button.ts": "// TODO: shared component\n",
+		"packages/app/index.ts": "export {}\n",
+	})
+	linkPath := filepath.Join(repoPath, "packages", "app", "button.ts")
+	if err := os.Symlink(filepath.Join("..", "ui", "button.ts"), linkPath); err != nil {
+		t.Skip("symlinks not supported on this OS")
+	}
+
+	c := &TodoCollector{}
+	signals, err := c.Collect(context.Background(), repoPath, signal.CollectorOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(signals) != 1 {
+		t.Fatalf("expected 1 signal, got %d", len(signals))
+	}
+	if signals[0].FilePath != filepath.Join("packages", "ui", "button.ts") {
+		t.Errorf("expected canonical path, got %q", signals[0].FilePath)
+	}
+}
+
 // --- Collect edge case: unreadable directory entry ---
 
 func TestCollect_WalkDirErrorContinues(t *testing.T) {
@@ -1368,7 +1485,7 @@ var url = "http://todo@example.com/path";
 `
 	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
 
-	signals, err := scanFile(path, "express.js")
+	signals, err := scanFile(context.Background(), path, "express.js", newScanLimits(signal.CollectorOpts{}))
 	require.NoError(t, err)
 
 	// Only the real TODO comment on the last line should match.
@@ -1396,7 +1513,7 @@ func TestScanFile_RealCommentsStillMatch(t *testing.T) {
 `
 	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
 
-	signals, err := scanFile(path, "real.go")
+	signals, err := scanFile(context.Background(), path, "real.go", newScanLimits(signal.CollectorOpts{}))
 	require.NoError(t, err)
 
 	assert.Len(t, signals, 6, "all real comment patterns should still match")
@@ -1432,3 +1549,85 @@ func TestIsBinaryFile_MockOpenError(t *testing.T) {
 	// Should treat as binary (unreadable).
 	assert.True(t, isBinaryFile("/any/path"))
 }
+
+// --- blame index tests ---
+
+func TestEnrichFromIndex_NilIndex(t *testing.T) {
+	sig := signal.RawSignal{Line: 1}
+	assert.False(t, enrichFromIndex(nil, "a.go", &sig))
+	assert.Empty(t, sig.Author)
+}
+
+func TestTodoCollector_UsesBlameIndex(t *testing.T) {
+	repoPath := initTestGitRepo(t, map[string]string{
+		"main.go": "package main\n\n// TODO: use the index\n",
+	})
+
+	idx, _, err := blameindex.Build(context.Background(), repoPath, 1, nil)
+	require.NoError(t, err)
+	// Rename the only author so we can tell index hits from live blame.
+	require.Len(t, idx.Authors, 1)
+	idx.Authors[0].Name = "Indexed Author"
+	require.NoError(t, blameindex.Save(repoPath, idx))
+
+	c := &TodoCollector{}
+	signals, err := c.Collect(context.Background(), repoPath, signal.CollectorOpts{})
+	require.NoError(t, err)
+	require.Len(t, signals, 1)
+	assert.Equal(t, "Indexed Author", signals[0].Author)
+	assert.False(t, signals[0].Timestamp.IsZero())
+}
+
+func TestTodoCollector_TruncatesOversizedFile(t *testing.T) {
+	dir := t.TempDir()
+	content := "// TODO: early\n" + strings.Repeat("x := 1\n", 100) + "// TODO: beyond the cap\n"
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "big.go"), []byte(content), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "small.go"), []byte("// TODO: small\n"), 0o600))
+
+	c := &TodoCollector{}
+	signals, err := c.Collect(context.Background(), dir, signal.CollectorOpts{MaxFileSize: 64})
+	require.NoError(t, err)
+
+	byFile := make(map[string][]signal.RawSignal)
+	for _, sig := range signals {
+		byFile[sig.FilePath] = append(byFile[sig.FilePath], sig)
+	}
+	require.Len(t, byFile["big.go"], 1, "TODO past the size cap should not be found")
+	assert.Equal(t, "TODO: early", byFile["big.go"][0].Title)
+	assert.Contains(t, byFile["big.go"][0].Tags, "truncated-scan")
+
+	require.Len(t, byFile["small.go"], 1)
+	assert.NotContains(t, byFile["small.go"][0].Tags, "truncated-scan")
+}
+
+func TestCollect_SkipsGitignored(t *testing.T) {
+	repoPath := t.TempDir()
+	for name, content := range map[string]string{
+		".gitignore":     "dist/\n*.gen.go\n",
+		"main.go":        "// TODO: keep this\n",
+		"dist/bundle.js": "// TODO: built output\n",
+		"types.gen.go":   "// TODO: generated\n",
+		"src/.gitignore": "out/\n",
+		"src/out/tmp.go": "// TODO: nested ignore\n",
+		"src/lib.go":     "// TODO: keep this too\n",
+		"other/dist.go":  "// TODO: not a directory\n",
+	} {
+		p := filepath.Join(repoPath, filepath.FromSlash(name))
+		require.NoError(t, os.MkdirAll(filepath.Dir(p), 0o750))
+		require.NoError(t, os.WriteFile(p, []byte(content), 0o600))
+	}
+
+	c := &TodoCollector{}
+	signals, err := c.Collect(context.Background(), repoPath, signal.CollectorOpts{Ignore: gitignore.New(repoPath)})
+	require.NoError(t, err)
+	var paths []string
+	for _, s := range signals {
+		paths = append(paths, s.FilePath)
+	}
+	assert.ElementsMatch(t, []string{"main.go", "src/lib.go", "other/dist.go"}, paths)
+
+	// Without a matcher, as with --no-gitignore, ignored files are scanned.
+	signals, err = c.Collect(context.Background(), repoPath, signal.CollectorOpts{})
+	require.NoError(t, err)
+	assert.Len(t, signals, 6)
+}
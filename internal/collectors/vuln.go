@@ -53,50 +53,217 @@ type VulnCollector struct {
 // Name returns the collector name used for registration and filtering.
 func (c *VulnCollector) Name() string { return "vuln" }
 
+// CheckCapabilities reports a skip reason when OSV.dev cannot be reached
+// and there are no cached answers to fall back on. The check is bypassed
+// when a client has been injected.
+func (c *VulnCollector) CheckCapabilities(ctx context.Context, repoPath string, _ signal.CollectorOpts) string {
+	if c.osv != nil {
+		return ""
+	}
+	if !networkCollectors {
+		return reasonNoNetworkBuild
+	}
+	reason := networkReason(ctx, osvDefaultBaseURL)
+	if reason != "" && hasOSVCache(repoPath) {
+		slog.InfoContext(ctx, "vuln: OSV.dev unreachable, using cached results", "reason", reason)
+		return ""
+	}
+	return reason
+}
+
 // Collect parses dependency manifests (go.mod, pom.xml, build.gradle/kts, Cargo.toml, *.csproj,
 // requirements.txt, pyproject.toml, package.json) in repoPath, queries OSV.dev for known
-// vulnerabilities, and returns signals with severity-based confidence scoring.
+// vulnerabilities, and returns signals with severity-based confidence scoring. Answers are
+// cached in .stringer/osv-cache.json for a day and reused past that when OSV.dev is down.
 func (c *VulnCollector) Collect(ctx context.Context, repoPath string, _ signal.CollectorOpts) ([]signal.RawSignal, error) {
+	queries, fileMap, err := gatherManifestQueries(ctx, repoPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(queries) == 0 {
+		return nil, nil
+	}
+
+	client := c.osv
+	var cache *cachedOSVClient
+	if client == nil {
+		cache = newCachedOSVClient(ctx, newOSVClient(30*time.Second), repoPath)
+		client = cache
+	}
+
+	results, err := client.QueryBatch(ctx, queries)
+	if err != nil {
+		slog.InfoContext(ctx, "vuln scan unavailable, skipping", "error", err)
+		return nil, nil // graceful degradation
+	}
+	if cache != nil {
+		if err := cache.save(); err != nil {
+			slog.WarnContext(ctx, "vuln: cannot save OSV cache", "error", err)
+		}
+	}
+	lines := newManifestLines(repoPath)
+
+	var signals []signal.RawSignal
+	metrics := &VulnMetrics{}
+
+	for _, r := range results {
+		cve := extractCVE(r.Aliases)
+		titleID := cve
+		if titleID == "" {
+			titleID = r.ID
+		}
+
+		title := fmt.Sprintf("Vulnerable dependency: %s [%s]", r.PackageName, titleID)
+
+		var desc string
+		if r.FixedVersion != "" {
+			desc = fmt.Sprintf("%s\n\nUpgrade %s from %s to %s.", r.Summary, r.PackageName, r.Version, r.FixedVersion)
+		} else {
+			desc = fmt.Sprintf("%s\n\nNo fix available for %s %s.", r.Summary, r.PackageName, r.Version)
+		}
+
+		severity := severityFromCVSS(r.Severity)
+		confidence := confidenceForSeverity(severity)
+
+		// Look up the manifest file for this result.
+		meta := fileMap[r.Ecosystem+"|"+r.PackageName+"|"+r.Version]
+
+		tags := []string{"security", "vulnerable-dependency"}
+		if meta.ecosystem == "Maven" {
+			tags = append(tags, "java")
+		}
+		if meta.ecosystem == "crates.io" {
+			tags = append(tags, "rust")
+		}
+		if meta.ecosystem == "NuGet" {
+			tags = append(tags, "csharp")
+		}
+		if meta.ecosystem == "PyPI" {
+			tags = append(tags, "python")
+		}
+		if meta.ecosystem == "npm" {
+			tags = append(tags, "nodejs")
+		}
+		if meta.ecosystem == "Packagist" {
+			tags = append(tags, "php")
+		}
+		if meta.ecosystem == "SwiftURL" {
+			tags = append(tags, "swift")
+		}
+		if meta.ecosystem == "Hex" {
+			tags = append(tags, "elixir")
+		}
+		if cve != "" {
+			tags = append(tags, cve)
+		}
+		tags = append(tags, r.ID)
+
+		signals = append(signals, signal.RawSignal{
+			Source:      "vuln",
+			Kind:        "vulnerable-dependency",
+			FilePath:    meta.filePath,
+			Line:        lines.find(meta.filePath, r.PackageName, r.Version),
+			Title:       title,
+			Description: desc,
+			Confidence:  confidence,
+			Tags:        tags,
+		})
+
+		metrics.Vulns = append(metrics.Vulns, VulnEntry{
+			OSVID:        r.ID,
+			CVE:          cve,
+			Module:       r.PackageName,
+			Version:      r.Version,
+			FixedVersion: r.FixedVersion,
+			Summary:      r.Summary,
+			Severity:     severity,
+			Ecosystem:    meta.ecosystem,
+			FilePath:     meta.filePath,
+		})
+	}
+
+	metrics.TotalVulns = len(signals)
+	c.metrics = metrics
+	return signals, nil
+}
+
+// queryMeta records which manifest a query came from.
+type queryMeta struct {
+	filePath  string
+	ecosystem string
+}
+
+// Dependency is a package version pinned in one of the repository's
+// dependency manifests.
+type Dependency struct {
+	Ecosystem string // OSV ecosystem: "Go", "npm", "crates.io", "Maven", ...
+	Name      string
+	Version   string
+	FilePath  string // Manifest the dependency was declared in.
+}
+
+// ListDependencies returns the dependencies declared in repoPath's manifests,
+// the same inventory the vuln collector checks against OSV.dev. Only a
+// malformed go.mod is an error; other manifests that fail to parse are
+// skipped.
+func ListDependencies(repoPath string) ([]Dependency, error) {
+	queries, fileMap, err := gatherManifestQueries(context.Background(), repoPath)
+	if err != nil {
+		return nil, err
+	}
+	deps := make([]Dependency, len(queries))
+	for i, q := range queries {
+		deps[i] = Dependency{
+			Ecosystem: q.Ecosystem,
+			Name:      q.Name,
+			Version:   q.Version,
+			FilePath:  fileMap[q.Ecosystem+"|"+q.Name+"|"+q.Version].filePath,
+		}
+	}
+	return deps, nil
+}
+
+// gatherManifestQueries parses every supported manifest in repoPath and
+// returns the deduplicated OSV queries along with the manifest each came
+// from, keyed by "ecosystem|name|version".
+func gatherManifestQueries(ctx context.Context, repoPath string) ([]PackageQuery, map[string]queryMeta, error) {
 	// Gather queries from Go manifest (fatal on parse error).
 	goQueries, err := parseGoModQueries(repoPath)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	// Gather queries from Java manifests (non-fatal on parse error).
-	pomQueries := parsePomQueries(repoPath)
-	gradleFile, gradleQueries := parseGradleQueries(repoPath)
+	pomQueries := parsePomQueries(ctx, repoPath)
+	gradleFile, gradleQueries := parseGradleQueries(ctx, repoPath)
 
 	// Gather queries from Rust manifest (non-fatal on parse error).
-	cargoQueries := parseCargoQueries(repoPath)
+	cargoQueries := parseCargoQueries(ctx, repoPath)
 
 	// Gather queries from .NET manifests (non-fatal on parse error).
-	csprojFile, csprojQueries := parseCsprojQueries(repoPath)
+	csprojFile, csprojQueries := parseCsprojQueries(ctx, repoPath)
 
 	// Gather queries from Python manifests (non-fatal on parse error).
-	pythonFile, pythonQueries := parsePythonQueries(repoPath)
+	pythonFile, pythonQueries := parsePythonQueries(ctx, repoPath)
 
 	// Gather queries from Node.js manifest (non-fatal on parse error).
-	npmFile, npmQueries := parseNpmQueries(repoPath)
+	npmFile, npmQueries := parseNpmQueries(ctx, repoPath)
 
 	// Gather queries from PHP manifest (non-fatal on parse error).
-	composerFile, composerQueries := parseComposerQueries(repoPath)
+	composerFile, composerQueries := parseComposerQueries(ctx, repoPath)
 
 	// Gather queries from Swift manifest (non-fatal on parse error).
-	swiftFile, swiftQueries := parseSwiftQueries(repoPath)
+	swiftFile, swiftQueries := parseSwiftQueries(ctx, repoPath)
 
 	// Gather queries from Scala manifest (non-fatal on parse error).
-	sbtFile, sbtQueries := parseSbtQueries(repoPath)
+	sbtFile, sbtQueries := parseSbtQueries(ctx, repoPath)
 
 	// Gather queries from Elixir manifest (non-fatal on parse error).
-	mixFile, mixQueries := parseMixQueries(repoPath)
+	mixFile, mixQueries := parseMixQueries(ctx, repoPath)
 
 	// Build combined query list with file/ecosystem tracking.
 	// fileMap tracks which manifest a query came from; used for dedup and signal emission.
-	type queryMeta struct {
-		filePath  string
-		ecosystem string
-	}
 	fileMap := make(map[string]queryMeta) // key: "ecosystem|name|version"
 	var queries []PackageQuery
 
@@ -178,102 +345,7 @@ func (c *VulnCollector) Collect(ctx context.Context, repoPath string, _ signal.C
 		}
 	}
 
-	if len(queries) == 0 {
-		return nil, nil
-	}
-
-	client := c.osv
-	if client == nil {
-		client = newOSVClient(30 * time.Second)
-	}
-
-	results, err := client.QueryBatch(ctx, queries)
-	if err != nil {
-		slog.Info("vuln scan unavailable, skipping", "error", err)
-		return nil, nil // graceful degradation
-	}
-
-	var signals []signal.RawSignal
-	metrics := &VulnMetrics{}
-
-	for _, r := range results {
-		cve := extractCVE(r.Aliases)
-		titleID := cve
-		if titleID == "" {
-			titleID = r.ID
-		}
-
-		title := fmt.Sprintf("Vulnerable dependency: %s [%s]", r.PackageName, titleID)
-
-		var desc string
-		if r.FixedVersion != "" {
-			desc = fmt.Sprintf("%s\n\nUpgrade %s from %s to %s.", r.Summary, r.PackageName, r.Version, r.FixedVersion)
-		} else {
-			desc = fmt.Sprintf("%s\n\nNo fix available for %s %s.", r.Summary, r.PackageName, r.Version)
-		}
-
-		severity := severityFromCVSS(r.Severity)
-		confidence := confidenceForSeverity(severity)
-
-		// Look up the manifest file for this result.
-		meta := fileMap[r.Ecosystem+"|"+r.PackageName+"|"+r.Version]
-
-		tags := []string{"security", "vulnerable-dependency"}
-		if meta.ecosystem == "Maven" {
-			tags = append(tags, "java")
-		}
-		if meta.ecosystem == "crates.io" {
-			tags = append(tags, "rust")
-		}
-		if meta.ecosystem == "NuGet" {
-			tags = append(tags, "csharp")
-		}
-		if meta.ecosystem == "PyPI" {
-			tags = append(tags, "python")
-		}
-		if meta.ecosystem == "npm" {
-			tags = append(tags, "nodejs")
-		}
-		if meta.ecosystem == "Packagist" {
-			tags = append(tags, "php")
-		}
-		if meta.ecosystem == "SwiftURL" {
-			tags = append(tags, "swift")
-		}
-		if meta.ecosystem == "Hex" {
-			tags = append(tags, "elixir")
-		}
-		if cve != "" {
-			tags = append(tags, cve)
-		}
-		tags = append(tags, r.ID)
-
-		signals = append(signals, signal.RawSignal{
-			Source:      "vuln",
-			Kind:        "vulnerable-dependency",
-			FilePath:    meta.filePath,
-			Title:       title,
-			Description: desc,
-			Confidence:  confidence,
-			Tags:        tags,
-		})
-
-		metrics.Vulns = append(metrics.Vulns, VulnEntry{
-			OSVID:        r.ID,
-			CVE:          cve,
-			Module:       r.PackageName,
-			Version:      r.Version,
-			FixedVersion: r.FixedVersion,
-			Summary:      r.Summary,
-			Severity:     severity,
-			Ecosystem:    meta.ecosystem,
-			FilePath:     meta.filePath,
-		})
-	}
-
-	metrics.TotalVulns = len(signals)
-	c.metrics = metrics
-	return signals, nil
+	return queries, fileMap, nil
 }
 
 // parseGoModQueries reads go.mod and returns PackageQuery entries for OSV lookup.
@@ -309,18 +381,18 @@ func parseGoModQueries(repoPath string) ([]PackageQuery, error) {
 
 // parsePomQueries reads pom.xml and returns PackageQuery entries for OSV lookup.
 // Returns nil if no pom.xml exists or on parse error (non-fatal, logged as warning).
-func parsePomQueries(repoPath string) []PackageQuery {
+func parsePomQueries(ctx context.Context, repoPath string) []PackageQuery {
 	data, err := FS.ReadFile(filepath.Join(repoPath, "pom.xml"))
 	if err != nil {
 		if !errors.Is(err, os.ErrNotExist) {
-			slog.Warn("vuln: reading pom.xml", "error", err)
+			slog.WarnContext(ctx, "vuln: reading pom.xml", "error", err)
 		}
 		return nil
 	}
 
-	queries, err := parseMavenDeps(data)
+	queries, err := parseMavenDeps(ctx, data)
 	if err != nil {
-		slog.Warn("vuln: parsing pom.xml", "error", err)
+		slog.WarnContext(ctx, "vuln: parsing pom.xml", "error", err)
 		return nil
 	}
 	return queries
@@ -329,20 +401,20 @@ func parsePomQueries(repoPath string) []PackageQuery {
 // parseGradleQueries reads build.gradle or build.gradle.kts and returns the
 // chosen filename and PackageQuery entries for OSV lookup.
 // Returns "", nil if no Gradle build file exists or on parse error (non-fatal).
-func parseGradleQueries(repoPath string) (string, []PackageQuery) {
+func parseGradleQueries(ctx context.Context, repoPath string) (string, []PackageQuery) {
 	for _, name := range []string{"build.gradle", "build.gradle.kts"} {
 		data, err := FS.ReadFile(filepath.Join(repoPath, name))
 		if err != nil {
 			if errors.Is(err, os.ErrNotExist) {
 				continue
 			}
-			slog.Warn("vuln: reading manifest", "file", name, "error", err)
+			slog.WarnContext(ctx, "vuln: reading manifest", "file", name, "error", err)
 			return "", nil
 		}
 
 		queries, err := parseGradleDeps(data)
 		if err != nil {
-			slog.Warn("vuln: parsing manifest", "file", name, "error", err)
+			slog.WarnContext(ctx, "vuln: parsing manifest", "file", name, "error", err)
 			return "", nil
 		}
 		return name, queries
@@ -352,18 +424,18 @@ func parseGradleQueries(repoPath string) (string, []PackageQuery) {
 
 // parseCargoQueries reads Cargo.toml and returns PackageQuery entries for OSV lookup.
 // Returns nil if no Cargo.toml exists or on parse error (non-fatal, logged as warning).
-func parseCargoQueries(repoPath string) []PackageQuery {
+func parseCargoQueries(ctx context.Context, repoPath string) []PackageQuery {
 	data, err := FS.ReadFile(filepath.Join(repoPath, "Cargo.toml"))
 	if err != nil {
 		if !errors.Is(err, os.ErrNotExist) {
-			slog.Warn("vuln: reading Cargo.toml", "error", err)
+			slog.WarnContext(ctx, "vuln: reading Cargo.toml", "error", err)
 		}
 		return nil
 	}
 
 	queries, err := parseCargoDeps(data)
 	if err != nil {
-		slog.Warn("vuln: parsing Cargo.toml", "error", err)
+		slog.WarnContext(ctx, "vuln: parsing Cargo.toml", "error", err)
 		return nil
 	}
 	return queries
@@ -401,7 +473,7 @@ func findCsprojFiles(repoPath string) []string {
 // parseCsprojQueries discovers .csproj files in repoPath, parses each for
 // NuGet package references, and returns an aggregate display filename and
 // combined queries (deduplicated by package name).
-func parseCsprojQueries(repoPath string) (string, []PackageQuery) {
+func parseCsprojQueries(ctx context.Context, repoPath string) (string, []PackageQuery) {
 	csprojFiles := findCsprojFiles(repoPath)
 	if len(csprojFiles) == 0 {
 		return "", nil
@@ -413,13 +485,13 @@ func parseCsprojQueries(repoPath string) (string, []PackageQuery) {
 	for _, f := range csprojFiles {
 		data, err := FS.ReadFile(filepath.Join(repoPath, f))
 		if err != nil {
-			slog.Warn("vuln: reading csproj", "file", f, "error", err)
+			slog.WarnContext(ctx, "vuln: reading csproj", "file", f, "error", err)
 			continue
 		}
 
 		parsed, err := parseCsprojDeps(data)
 		if err != nil {
-			slog.Warn("vuln: parsing csproj", "file", f, "error", err)
+			slog.WarnContext(ctx, "vuln: parsing csproj", "file", f, "error", err)
 			continue
 		}
 
@@ -447,20 +519,20 @@ func parseCsprojQueries(repoPath string) (string, []PackageQuery) {
 // chosen filename and PackageQuery entries for OSV lookup.
 // Returns "", nil if no Python manifest exists or on parse error (non-fatal).
 // If both files exist, requirements.txt takes precedence (it's the lockfile equivalent).
-func parsePythonQueries(repoPath string) (string, []PackageQuery) {
+func parsePythonQueries(ctx context.Context, repoPath string) (string, []PackageQuery) {
 	// Try requirements.txt first (more common, often pinned).
 	data, err := FS.ReadFile(filepath.Join(repoPath, "requirements.txt"))
 	if err == nil {
 		queries, parseErr := parsePythonRequirements(data)
 		if parseErr != nil {
-			slog.Warn("vuln: parsing requirements.txt", "error", parseErr)
+			slog.WarnContext(ctx, "vuln: parsing requirements.txt", "error", parseErr)
 			return "", nil
 		}
 		if len(queries) > 0 {
 			return "requirements.txt", queries
 		}
 	} else if !errors.Is(err, os.ErrNotExist) {
-		slog.Warn("vuln: reading requirements.txt", "error", err)
+		slog.WarnContext(ctx, "vuln: reading requirements.txt", "error", err)
 	}
 
 	// Fall back to pyproject.toml.
@@ -468,14 +540,14 @@ func parsePythonQueries(repoPath string) (string, []PackageQuery) {
 	if err == nil {
 		queries, parseErr := parsePyprojectDeps(data)
 		if parseErr != nil {
-			slog.Warn("vuln: parsing pyproject.toml", "error", parseErr)
+			slog.WarnContext(ctx, "vuln: parsing pyproject.toml", "error", parseErr)
 			return "", nil
 		}
 		if len(queries) > 0 {
 			return "pyproject.toml", queries
 		}
 	} else if !errors.Is(err, os.ErrNotExist) {
-		slog.Warn("vuln: reading pyproject.toml", "error", err)
+		slog.WarnContext(ctx, "vuln: reading pyproject.toml", "error", err)
 	}
 
 	return "", nil
@@ -485,20 +557,20 @@ func parsePythonQueries(repoPath string) (string, []PackageQuery) {
 // chosen filename and PackageQuery entries for OSV lookup.
 // Returns "", nil if no npm manifest exists or on parse error (non-fatal).
 // If package-lock.json exists, its resolved versions are used instead of semver ranges.
-func parseNpmQueries(repoPath string) (string, []PackageQuery) {
+func parseNpmQueries(ctx context.Context, repoPath string) (string, []PackageQuery) {
 	// Try package-lock.json first (resolved versions, no false positives from ranges).
 	data, err := FS.ReadFile(filepath.Join(repoPath, "package-lock.json"))
 	if err == nil {
 		queries, parseErr := parseNpmLockDeps(data)
 		if parseErr != nil {
-			slog.Warn("vuln: parsing package-lock.json", "error", parseErr)
+			slog.WarnContext(ctx, "vuln: parsing package-lock.json", "error", parseErr)
 			return "", nil
 		}
 		if len(queries) > 0 {
 			return "package-lock.json", queries
 		}
 	} else if !errors.Is(err, os.ErrNotExist) {
-		slog.Warn("vuln: reading package-lock.json", "error", err)
+		slog.WarnContext(ctx, "vuln: reading package-lock.json", "error", err)
 	}
 
 	// Fall back to package.json (semver ranges, stripped to base version).
@@ -506,14 +578,14 @@ func parseNpmQueries(repoPath string) (string, []PackageQuery) {
 	if err == nil {
 		queries, parseErr := parseNpmDeps(data)
 		if parseErr != nil {
-			slog.Warn("vuln: parsing package.json", "error", parseErr)
+			slog.WarnContext(ctx, "vuln: parsing package.json", "error", parseErr)
 			return "", nil
 		}
 		if len(queries) > 0 {
 			return "package.json", queries
 		}
 	} else if !errors.Is(err, os.ErrNotExist) {
-		slog.Warn("vuln: reading package.json", "error", err)
+		slog.WarnContext(ctx, "vuln: reading package.json", "error", err)
 	}
 
 	return "", nil
@@ -602,18 +674,18 @@ func confidenceForSeverity(severity string) float64 {
 
 // parseComposerQueries reads composer.json and returns the filename and PackageQuery
 // entries for OSV lookup. Returns "", nil if no composer.json exists or on parse error.
-func parseComposerQueries(repoPath string) (string, []PackageQuery) {
+func parseComposerQueries(ctx context.Context, repoPath string) (string, []PackageQuery) {
 	data, err := FS.ReadFile(filepath.Join(repoPath, "composer.json"))
 	if err != nil {
 		if !errors.Is(err, os.ErrNotExist) {
-			slog.Warn("vuln: reading composer.json", "error", err)
+			slog.WarnContext(ctx, "vuln: reading composer.json", "error", err)
 		}
 		return "", nil
 	}
 
 	queries, err := parseComposerDeps(data)
 	if err != nil {
-		slog.Warn("vuln: parsing composer.json", "error", err)
+		slog.WarnContext(ctx, "vuln: parsing composer.json", "error", err)
 		return "", nil
 	}
 	if len(queries) > 0 {
@@ -624,11 +696,11 @@ func parseComposerQueries(repoPath string) (string, []PackageQuery) {
 
 // parseSwiftQueries reads Package.swift and returns the filename and PackageQuery
 // entries for OSV lookup. Returns "", nil if no Package.swift exists.
-func parseSwiftQueries(repoPath string) (string, []PackageQuery) {
+func parseSwiftQueries(ctx context.Context, repoPath string) (string, []PackageQuery) {
 	data, err := FS.ReadFile(filepath.Join(repoPath, "Package.swift"))
 	if err != nil {
 		if !errors.Is(err, os.ErrNotExist) {
-			slog.Warn("vuln: reading Package.swift", "error", err)
+			slog.WarnContext(ctx, "vuln: reading Package.swift", "error", err)
 		}
 		return "", nil
 	}
@@ -642,11 +714,11 @@ func parseSwiftQueries(repoPath string) (string, []PackageQuery) {
 
 // parseSbtQueries reads build.sbt and returns the filename and PackageQuery
 // entries for OSV lookup. Returns "", nil if no build.sbt exists.
-func parseSbtQueries(repoPath string) (string, []PackageQuery) {
+func parseSbtQueries(ctx context.Context, repoPath string) (string, []PackageQuery) {
 	data, err := FS.ReadFile(filepath.Join(repoPath, "build.sbt"))
 	if err != nil {
 		if !errors.Is(err, os.ErrNotExist) {
-			slog.Warn("vuln: reading build.sbt", "error", err)
+			slog.WarnContext(ctx, "vuln: reading build.sbt", "error", err)
 		}
 		return "", nil
 	}
@@ -660,11 +732,11 @@ func parseSbtQueries(repoPath string) (string, []PackageQuery) {
 
 // parseMixQueries reads mix.exs and returns the filename and PackageQuery
 // entries for OSV lookup. Returns "", nil if no mix.exs exists.
-func parseMixQueries(repoPath string) (string, []PackageQuery) {
+func parseMixQueries(ctx context.Context, repoPath string) (string, []PackageQuery) {
 	data, err := FS.ReadFile(filepath.Join(repoPath, "mix.exs"))
 	if err != nil {
 		if !errors.Is(err, os.ErrNotExist) {
-			slog.Warn("vuln: reading mix.exs", "error", err)
+			slog.WarnContext(ctx, "vuln: reading mix.exs", "error", err)
 		}
 		return "", nil
 	}
@@ -679,3 +751,88 @@ func parseMixQueries(repoPath string) (string, []PackageQuery) {
 // Compile-time interface checks.
 var _ collector.Collector = (*VulnCollector)(nil)
 var _ collector.MetricsProvider = (*VulnCollector)(nil)
+var _ collector.CapabilityChecker = (*VulnCollector)(nil)
+
+// manifestLines finds where a dependency is declared, reading each
+// manifest once.
+type manifestLines struct {
+	repoPath string
+	files    map[string][]string
+}
+
+func newManifestLines(repoPath string) *manifestLines {
+	return &manifestLines{repoPath: repoPath, files: make(map[string][]string)}
+}
+
+// find returns the 1-based line of manifest declaring name, preferring a
+// line that also carries version, or 0 when it cannot be found. Maven
+// coordinates ("group:artifact") fall back to the artifact ID, which pom.xml
+// declares on its own line.
+func (m *manifestLines) find(manifest, name, version string) int {
+	if manifest == "" || strings.Contains(manifest, "*") {
+		return 0
+	}
+	lines, ok := m.files[manifest]
+	if !ok {
+		data, err := FS.ReadFile(filepath.Join(m.repoPath, manifest))
+		if err == nil {
+			lines = strings.Split(string(data), "\n")
+		}
+		m.files[manifest] = lines
+	}
+
+	names := []string{name}
+	if i := strings.LastIndex(name, ":"); i >= 0 {
+		names = append(names, name[i+1:])
+	}
+	for _, n := range names {
+		first := 0
+		for i, line := range lines {
+			if !containsDepName(line, n) {
+				continue
+			}
+			if version != "" && strings.Contains(line, version) {
+				return i + 1
+			}
+			if first == 0 {
+				first = i + 1
+			}
+		}
+		if first > 0 {
+			return first
+		}
+	}
+	return 0
+}
+
+// containsDepName reports whether line mentions name as a whole package
+// name, so "react" does not match "react-dom" and "example.com/mod" does not
+// match "example.com/mod/v2".
+func containsDepName(line, name string) bool {
+	for start := 0; ; {
+		i := strings.Index(line[start:], name)
+		if i < 0 {
+			return false
+		}
+		i += start
+		end := i + len(name)
+		if (i == 0 || !isDepNameByte(line[i-1], false)) && (end == len(line) || !isDepNameByte(line[end], true)) {
+			return true
+		}
+		start = i + 1
+	}
+}
+
+// isDepNameByte reports whether b can continue a package name. A slash
+// may precede a name (node_modules/lodash) but not follow one.
+func isDepNameByte(b byte, after bool) bool {
+	switch {
+	case b >= 'a' && b <= 'z', b >= 'A' && b <= 'Z', b >= '0' && b <= '9':
+		return true
+	case b == '-', b == '_', b == '.':
+		return true
+	case b == '/':
+		return after
+	}
+	return false
+}
@@ -0,0 +1,177 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package collectors
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"path/filepath"
+	"time"
+
+	"github.com/davetashner/stringer/internal/statedir"
+)
+
+const (
+	// osvCacheFile holds OSV.dev answers inside the .stringer directory.
+	osvCacheFile = "osv-cache.json"
+
+	// osvCacheTTL is how long an answer is reused before OSV.dev is asked
+	// again. Older answers are still used when OSV.dev cannot be reached.
+	osvCacheTTL = 24 * time.Hour
+
+	// osvCacheMaxAge is when an answer is dropped from the cache, so
+	// versions a repository no longer pins do not pile up.
+	osvCacheMaxAge = 30 * 24 * time.Hour
+
+	// osvCacheVersion is bumped whenever the on-disk layout changes.
+	osvCacheVersion = 1
+)
+
+// osvCacheEntry is the answer OSV.dev gave for one package version. An
+// entry without vulns records that the version had none.
+type osvCacheEntry struct {
+	CheckedAt time.Time    `json:"checked_at"`
+	Vulns     []VulnDetail `json:"vulns,omitempty"`
+}
+
+// osvCacheData is the on-disk layout, keyed by "ecosystem|name|version".
+type osvCacheData struct {
+	Version int                      `json:"version"`
+	Entries map[string]osvCacheEntry `json:"entries"`
+}
+
+// cachedOSVClient answers queries from .stringer/osv-cache.json when it
+// can and asks the wrapped client for the rest. When the wrapped client
+// fails, cached answers of any age are used instead.
+type cachedOSVClient struct {
+	inner osvClient
+	root  string
+	now   func() time.Time
+	data  osvCacheData
+	dirty bool
+}
+
+// Compile-time check that cachedOSVClient implements osvClient.
+var _ osvClient = (*cachedOSVClient)(nil)
+
+// osvCachePath returns the OSV cache file for the directory root.
+func osvCachePath(root string) string {
+	return filepath.Join(root, statedir.Name, osvCacheFile)
+}
+
+// hasOSVCache reports whether root has cached OSV answers to fall back on.
+func hasOSVCache(root string) bool {
+	_, err := FS.Stat(osvCachePath(root))
+	return err == nil
+}
+
+// newCachedOSVClient wraps inner with the cache of root. A missing or
+// unreadable cache starts empty.
+func newCachedOSVClient(ctx context.Context, inner osvClient, root string) *cachedOSVClient {
+	c := &cachedOSVClient{
+		inner: inner,
+		root:  root,
+		now:   time.Now,
+		data:  osvCacheData{Version: osvCacheVersion, Entries: make(map[string]osvCacheEntry)},
+	}
+	raw, err := FS.ReadFile(osvCachePath(root))
+	if err != nil {
+		if !errors.Is(err, fs.ErrNotExist) {
+			slog.WarnContext(ctx, "vuln: ignoring unreadable OSV cache", "error", err)
+		}
+		return c
+	}
+	var loaded osvCacheData
+	if err := json.Unmarshal(raw, &loaded); err != nil {
+		slog.WarnContext(ctx, "vuln: ignoring unreadable OSV cache", "error", err)
+		return c
+	}
+	if loaded.Version == osvCacheVersion && loaded.Entries != nil {
+		c.data.Entries = loaded.Entries
+	}
+	return c
+}
+
+// QueryBatch returns cached answers younger than osvCacheTTL and queries
+// the wrapped client for the other packages.
+func (c *cachedOSVClient) QueryBatch(ctx context.Context, queries []PackageQuery) ([]VulnDetail, error) {
+	var details []VulnDetail
+	var stale []PackageQuery
+	for _, q := range queries {
+		e, ok := c.data.Entries[osvQueryKey(q)]
+		if ok && c.now().Sub(e.CheckedAt) < osvCacheTTL {
+			details = append(details, e.Vulns...)
+			continue
+		}
+		stale = append(stale, q)
+	}
+	if len(stale) == 0 {
+		return details, nil
+	}
+
+	fresh, err := c.inner.QueryBatch(ctx, stale)
+	if err != nil {
+		used := 0
+		for _, q := range stale {
+			if e, ok := c.data.Entries[osvQueryKey(q)]; ok {
+				details = append(details, e.Vulns...)
+				used++
+			}
+		}
+		if used == 0 && len(stale) == len(queries) {
+			return nil, err
+		}
+		slog.InfoContext(ctx, "vuln: OSV.dev unavailable, using cached results", "cached", used, "unchecked", len(stale)-used, "error", err)
+		return details, nil
+	}
+
+	byKey := make(map[string][]VulnDetail, len(fresh))
+	for _, v := range fresh {
+		key := osvQueryKey(PackageQuery{Ecosystem: v.Ecosystem, Name: v.PackageName, Version: v.Version})
+		byKey[key] = append(byKey[key], v)
+	}
+	checked := c.now().UTC()
+	for _, q := range stale {
+		key := osvQueryKey(q)
+		c.data.Entries[key] = osvCacheEntry{CheckedAt: checked, Vulns: byKey[key]}
+	}
+	c.dirty = true
+	return append(details, fresh...), nil
+}
+
+// save writes the cache back when it changed, dropping answers older
+// than osvCacheMaxAge.
+func (c *cachedOSVClient) save() error {
+	if !c.dirty {
+		return nil
+	}
+	for key, e := range c.data.Entries {
+		if c.now().Sub(e.CheckedAt) > osvCacheMaxAge {
+			delete(c.data.Entries, key)
+		}
+	}
+	dir := filepath.Join(c.root, statedir.Name)
+	if err := FS.MkdirAll(dir, 0o750); err != nil {
+		return fmt.Errorf("create state directory: %w", err)
+	}
+	statedir.EnsureIgnore(FS, c.root)
+
+	data, err := json.Marshal(c.data)
+	if err != nil {
+		return fmt.Errorf("encode OSV cache: %w", err)
+	}
+	if err := FS.WriteFile(osvCachePath(c.root), data, 0o644); err != nil {
+		return fmt.Errorf("write OSV cache: %w", err)
+	}
+	c.dirty = false
+	return nil
+}
+
+func osvQueryKey(q PackageQuery) string {
+	return q.Ecosystem + "|" + q.Name + "|" + q.Version
+}
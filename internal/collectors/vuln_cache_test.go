@@ -0,0 +1,120 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package collectors
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+// countingOSVClient records the queries it was asked.
+type countingOSVClient struct {
+	results []VulnDetail
+	err     error
+	asked   [][]PackageQuery
+}
+
+func (m *countingOSVClient) QueryBatch(_ context.Context, queries []PackageQuery) ([]VulnDetail, error) {
+	m.asked = append(m.asked, queries)
+	if m.err != nil {
+		return nil, m.err
+	}
+	return m.results, nil
+}
+
+var (
+	cachedBar = PackageQuery{Ecosystem: "Go", Name: "github.com/foo/bar", Version: "v1.0.0"}
+	cachedQux = PackageQuery{Ecosystem: "Go", Name: "github.com/baz/qux", Version: "v0.2.0"}
+	barVuln   = VulnDetail{ID: "GO-2024-0001", Ecosystem: "Go", PackageName: "github.com/foo/bar", Version: "v1.0.0"}
+)
+
+func TestCachedOSVClient_ReusesFreshAnswers(t *testing.T) {
+	dir := t.TempDir()
+	inner := &countingOSVClient{results: []VulnDetail{barVuln}}
+	c := newCachedOSVClient(context.Background(), inner, dir)
+	got, err := c.QueryBatch(context.Background(), []PackageQuery{cachedBar, cachedQux})
+	require.NoError(t, err)
+	assert.Equal(t, []VulnDetail{barVuln}, got)
+	require.NoError(t, c.save())
+	assert.FileExists(t, osvCachePath(dir))
+
+	// Both answers, including "no vulns" for qux, come from the cache.
+	c = newCachedOSVClient(context.Background(), inner, dir)
+	got, err = c.QueryBatch(context.Background(), []PackageQuery{cachedBar, cachedQux})
+	require.NoError(t, err)
+	assert.Equal(t, []VulnDetail{barVuln}, got)
+	assert.Len(t, inner.asked, 1)
+}
+
+func TestCachedOSVClient_RequeriesExpiredAnswers(t *testing.T) {
+	dir := t.TempDir()
+	inner := &countingOSVClient{}
+	c := newCachedOSVClient(context.Background(), inner, dir)
+	_, err := c.QueryBatch(context.Background(), []PackageQuery{cachedBar})
+	require.NoError(t, err)
+
+	c.now = func() time.Time { return time.Now().Add(osvCacheTTL + time.Minute) }
+	_, err = c.QueryBatch(context.Background(), []PackageQuery{cachedBar, cachedQux})
+	require.NoError(t, err)
+	require.Len(t, inner.asked, 2)
+	assert.Equal(t, []PackageQuery{cachedBar, cachedQux}, inner.asked[1])
+}
+
+func TestCachedOSVClient_FallsBackWhenUnavailable(t *testing.T) {
+	dir := t.TempDir()
+	c := newCachedOSVClient(context.Background(), &countingOSVClient{results: []VulnDetail{barVuln}}, dir)
+	_, err := c.QueryBatch(context.Background(), []PackageQuery{cachedBar})
+	require.NoError(t, err)
+	require.NoError(t, c.save())
+
+	offline := &countingOSVClient{err: errors.New("no such host")}
+	c = newCachedOSVClient(context.Background(), offline, dir)
+	c.now = func() time.Time { return time.Now().Add(2 * osvCacheTTL) }
+	got, err := c.QueryBatch(context.Background(), []PackageQuery{cachedBar, cachedQux})
+	require.NoError(t, err, "stale answers are used when OSV.dev is down")
+	assert.Equal(t, []VulnDetail{barVuln}, got)
+
+	// Nothing cached: the error is reported.
+	c = newCachedOSVClient(context.Background(), offline, t.TempDir())
+	_, err = c.QueryBatch(context.Background(), []PackageQuery{cachedQux})
+	assert.Error(t, err)
+}
+
+func TestCachedOSVClient_SavePrunesOldAnswers(t *testing.T) {
+	dir := t.TempDir()
+	c := newCachedOSVClient(context.Background(), &countingOSVClient{}, dir)
+	_, err := c.QueryBatch(context.Background(), []PackageQuery{cachedBar})
+	require.NoError(t, err)
+	c.now = func() time.Time { return time.Now().Add(osvCacheMaxAge + time.Hour) }
+	_, err = c.QueryBatch(context.Background(), []PackageQuery{cachedQux})
+	require.NoError(t, err)
+	require.NoError(t, c.save())
+
+	c = newCachedOSVClient(context.Background(), &countingOSVClient{}, dir)
+	assert.NotContains(t, c.data.Entries, osvQueryKey(cachedBar))
+	assert.Contains(t, c.data.Entries, osvQueryKey(cachedQux))
+}
+
+func TestVulnCollector_CheckCapabilities_OfflineWithCache(t *testing.T) {
+	if !networkCollectors {
+		t.Skip("network collectors are off in this build")
+	}
+	stubLookupHost(t, errors.New("no such host"))
+	dir := t.TempDir()
+	c := &VulnCollector{}
+	assert.Contains(t, c.CheckCapabilities(context.Background(), dir, signal.CollectorOpts{}), reasonNoNetwork)
+
+	cache := newCachedOSVClient(context.Background(), &countingOSVClient{}, dir)
+	_, err := cache.QueryBatch(context.Background(), []PackageQuery{cachedBar})
+	require.NoError(t, err)
+	require.NoError(t, cache.save())
+	assert.Empty(t, c.CheckCapabilities(context.Background(), dir, signal.CollectorOpts{}))
+}
@@ -4,6 +4,7 @@
 package collectors
 
 import (
+	"context"
 	"encoding/xml"
 	"log/slog"
 	"strings"
@@ -45,7 +46,7 @@ type pomDependency struct {
 // It extracts dependencies from both <dependencies> and <dependencyManagement> sections,
 // performs property interpolation for ${...} references, and skips test-scoped
 // dependencies and those with unresolvable versions.
-func parseMavenDeps(data []byte) ([]PackageQuery, error) {
+func parseMavenDeps(ctx context.Context, data []byte) ([]PackageQuery, error) {
 	var project pomProject
 	if err := xml.Unmarshal(data, &project); err != nil {
 		return nil, err
@@ -83,7 +84,7 @@ func parseMavenDeps(data []byte) ([]PackageQuery, error) {
 		// Skip if version is empty or still contains unresolved property references.
 		if version == "" || strings.Contains(version, "${") {
 			if dep.Version != "" && strings.Contains(dep.Version, "${") {
-				slog.Warn("maven: skipping dependency with unresolvable version property",
+				slog.WarnContext(ctx, "maven: skipping dependency with unresolvable version property",
 					"groupId", groupID,
 					"artifactId", artifactID,
 					"version", dep.Version,
@@ -4,6 +4,7 @@
 package collectors
 
 import (
+	"context"
 	"encoding/xml"
 	"testing"
 
@@ -26,7 +27,7 @@ func TestParseMavenDeps_SingleDependency(t *testing.T) {
   </dependencies>
 </project>`)
 
-	queries, err := parseMavenDeps(pom)
+	queries, err := parseMavenDeps(context.Background(), pom)
 	require.NoError(t, err)
 	require.Len(t, queries, 1)
 
@@ -60,7 +61,7 @@ func TestParseMavenDeps_MultipleDependencies(t *testing.T) {
   </dependencies>
 </project>`)
 
-	queries, err := parseMavenDeps(pom)
+	queries, err := parseMavenDeps(context.Background(), pom)
 	require.NoError(t, err)
 	require.Len(t, queries, 3)
 
@@ -90,7 +91,7 @@ func TestParseMavenDeps_PropertyInterpolation_ProjectVersion(t *testing.T) {
   </dependencies>
 </project>`)
 
-	queries, err := parseMavenDeps(pom)
+	queries, err := parseMavenDeps(context.Background(), pom)
 	require.NoError(t, err)
 	require.Len(t, queries, 1)
 
@@ -122,7 +123,7 @@ func TestParseMavenDeps_PropertyInterpolation_CustomProperties(t *testing.T) {
   </dependencies>
 </project>`)
 
-	queries, err := parseMavenDeps(pom)
+	queries, err := parseMavenDeps(context.Background(), pom)
 	require.NoError(t, err)
 	require.Len(t, queries, 2)
 
@@ -152,7 +153,7 @@ func TestParseMavenDeps_DependencyManagement(t *testing.T) {
   </dependencyManagement>
 </project>`)
 
-	queries, err := parseMavenDeps(pom)
+	queries, err := parseMavenDeps(context.Background(), pom)
 	require.NoError(t, err)
 	require.Len(t, queries, 1)
 
@@ -185,7 +186,7 @@ func TestParseMavenDeps_BothSections(t *testing.T) {
   </dependencies>
 </project>`)
 
-	queries, err := parseMavenDeps(pom)
+	queries, err := parseMavenDeps(context.Background(), pom)
 	require.NoError(t, err)
 	require.Len(t, queries, 2)
 
@@ -225,7 +226,7 @@ func TestParseMavenDeps_TestScopeSkipped(t *testing.T) {
   </dependencies>
 </project>`)
 
-	queries, err := parseMavenDeps(pom)
+	queries, err := parseMavenDeps(context.Background(), pom)
 	require.NoError(t, err)
 	require.Len(t, queries, 1)
 
@@ -251,7 +252,7 @@ func TestParseMavenDeps_NoVersion_Skipped(t *testing.T) {
   </dependencies>
 </project>`)
 
-	queries, err := parseMavenDeps(pom)
+	queries, err := parseMavenDeps(context.Background(), pom)
 	require.NoError(t, err)
 	require.Len(t, queries, 1)
 
@@ -281,7 +282,7 @@ func TestParseMavenDeps_UnresolvableProperty_Skipped(t *testing.T) {
   </dependencies>
 </project>`)
 
-	queries, err := parseMavenDeps(pom)
+	queries, err := parseMavenDeps(context.Background(), pom)
 	require.NoError(t, err)
 	require.Len(t, queries, 1)
 
@@ -294,7 +295,7 @@ func TestParseMavenDeps_EmptyPom(t *testing.T) {
 <project>
 </project>`)
 
-	queries, err := parseMavenDeps(pom)
+	queries, err := parseMavenDeps(context.Background(), pom)
 	require.NoError(t, err)
 	assert.Empty(t, queries)
 }
@@ -302,12 +303,12 @@ func TestParseMavenDeps_EmptyPom(t *testing.T) {
 func TestParseMavenDeps_MalformedXML(t *testing.T) {
 	pom := []byte(`this is not valid XML at all <<<<`)
 
-	_, err := parseMavenDeps(pom)
+	_, err := parseMavenDeps(context.Background(), pom)
 	assert.Error(t, err)
 }
 
 func TestParseMavenDeps_EmptyInput(t *testing.T) {
-	_, err := parseMavenDeps([]byte{})
+	_, err := parseMavenDeps(context.Background(), []byte{})
 	assert.Error(t, err)
 }
 
@@ -319,7 +320,7 @@ func TestParseMavenDeps_MinimalProject_NoDeps(t *testing.T) {
   <version>1.0.0</version>
 </project>`)
 
-	queries, err := parseMavenDeps(pom)
+	queries, err := parseMavenDeps(context.Background(), pom)
 	require.NoError(t, err)
 	assert.Empty(t, queries)
 }
@@ -348,7 +349,7 @@ func TestParseMavenDeps_DuplicateDependency_Deduped(t *testing.T) {
   </dependencies>
 </project>`)
 
-	queries, err := parseMavenDeps(pom)
+	queries, err := parseMavenDeps(context.Background(), pom)
 	require.NoError(t, err)
 	// The <dependencies> entry appears first in allDeps, so it wins.
 	require.Len(t, queries, 1)
@@ -395,7 +396,7 @@ func TestParseMavenDeps_NonProductionScopes_Kept(t *testing.T) {
   </dependencies>
 </project>`)
 
-	queries, err := parseMavenDeps(pom)
+	queries, err := parseMavenDeps(context.Background(), pom)
 	require.NoError(t, err)
 	assert.Len(t, queries, 5)
 }
@@ -418,7 +419,7 @@ func TestParseMavenDeps_ProjectGroupIdProperty(t *testing.T) {
   </dependencies>
 </project>`)
 
-	queries, err := parseMavenDeps(pom)
+	queries, err := parseMavenDeps(context.Background(), pom)
 	require.NoError(t, err)
 	require.Len(t, queries, 1)
 
@@ -504,7 +505,7 @@ func TestParseMavenDeps_RealWorldPom(t *testing.T) {
   </dependencies>
 </project>`)
 
-	queries, err := parseMavenDeps(pom)
+	queries, err := parseMavenDeps(context.Background(), pom)
 	require.NoError(t, err)
 
 	byName := make(map[string]string)
@@ -553,7 +554,7 @@ func TestParseMavenDeps_WhitespaceInElements(t *testing.T) {
   </dependencies>
 </project>`)
 
-	queries, err := parseMavenDeps(pom)
+	queries, err := parseMavenDeps(context.Background(), pom)
 	require.NoError(t, err)
 	require.Len(t, queries, 1)
 
@@ -580,7 +581,7 @@ func TestParseMavenDeps_MissingGroupId_Skipped(t *testing.T) {
   </dependencies>
 </project>`)
 
-	queries, err := parseMavenDeps(pom)
+	queries, err := parseMavenDeps(context.Background(), pom)
 	require.NoError(t, err)
 	require.Len(t, queries, 1)
 
@@ -601,7 +602,7 @@ func TestParseMavenDeps_MissingArtifactId_Skipped(t *testing.T) {
   </dependencies>
 </project>`)
 
-	queries, err := parseMavenDeps(pom)
+	queries, err := parseMavenDeps(context.Background(), pom)
 	require.NoError(t, err)
 	assert.Empty(t, queries)
 }
@@ -689,7 +690,7 @@ func TestParseMavenDeps_TestScopeInDependencyManagement(t *testing.T) {
   </dependencyManagement>
 </project>`)
 
-	queries, err := parseMavenDeps(pom)
+	queries, err := parseMavenDeps(context.Background(), pom)
 	require.NoError(t, err)
 	require.Len(t, queries, 1)
 
@@ -712,7 +713,7 @@ func TestParseMavenDeps_NamespacePrefix(t *testing.T) {
   </dependencies>
 </project>`)
 
-	queries, err := parseMavenDeps(pom)
+	queries, err := parseMavenDeps(context.Background(), pom)
 	require.NoError(t, err)
 	require.Len(t, queries, 1)
 	assert.Equal(t, "org.apache.commons:commons-lang3", queries[0].Name)
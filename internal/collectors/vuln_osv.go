@@ -125,7 +125,7 @@ func (c *realOSVClient) QueryBatch(ctx context.Context, queries []PackageQuery)
 	for _, id := range uniqueIDs {
 		vuln, err := c.fetchVuln(ctx, id)
 		if err != nil {
-			slog.Warn("osv: failed to fetch vuln details, skipping", "id", id, "error", err)
+			slog.WarnContext(ctx, "osv: failed to fetch vuln details, skipping", "id", id, "error", err)
 			continue
 		}
 		vulnCache[id] = vuln
@@ -244,7 +244,7 @@ func (c *realOSVClient) doWithRetry(ctx context.Context, method, url string, bod
 
 		if resp.StatusCode == 429 || resp.StatusCode >= 500 {
 			lastErr = fmt.Errorf("osv api %s returned %d", url, resp.StatusCode)
-			slog.Debug("osv: retryable error", "url", url, "status", resp.StatusCode, "attempt", attempt+1)
+			slog.DebugContext(ctx, "osv: retryable error", "url", url, "status", resp.StatusCode, "attempt", attempt+1)
 			continue
 		}
 
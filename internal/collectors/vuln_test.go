@@ -81,6 +81,7 @@ func TestVulnCollector_SingleVuln(t *testing.T) {
 	assert.Equal(t, "vuln", sig.Source)
 	assert.Equal(t, "vulnerable-dependency", sig.Kind)
 	assert.Equal(t, "go.mod", sig.FilePath)
+	assert.Equal(t, 6, sig.Line)
 	assert.Contains(t, sig.Title, "CVE-2024-24790")
 	assert.Contains(t, sig.Title, "github.com/foo/bar")
 	assert.Contains(t, sig.Description, "Upgrade github.com/foo/bar from v1.0.0 to v1.0.1")
@@ -91,6 +92,44 @@ func TestVulnCollector_SingleVuln(t *testing.T) {
 	assert.Contains(t, sig.Tags, "GO-2024-2687")
 }
 
+func TestManifestLines_Find(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "package.json"), []byte(`{
+  "dependencies": {
+    "react-dom": "18.2.0",
+    "react": "18.2.0",
+    "@babel/core": "7.0.0"
+  }
+}
+`), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "pom.xml"), []byte(`<project>
+  <dependencies>
+    <dependency>
+      <groupId>org.apache.logging.log4j</groupId>
+      <artifactId>log4j-core</artifactId>
+      <version>2.14.1</version>
+    </dependency>
+  </dependencies>
+</project>
+`), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "go.mod"), []byte(`module example.com/test
+
+require (
+	example.com/mod/v2 v2.0.0
+	example.com/mod v1.0.0
+)
+`), 0o600))
+
+	lines := newManifestLines(dir)
+	assert.Equal(t, 4, lines.find("package.json", "react", "18.2.0"), "react-dom is a different package")
+	assert.Equal(t, 5, lines.find("package.json", "@babel/core", "7.0.0"))
+	assert.Equal(t, 5, lines.find("pom.xml", "org.apache.logging.log4j:log4j-core", "2.14.1"), "falls back to the artifact ID")
+	assert.Equal(t, 5, lines.find("go.mod", "example.com/mod", "v1.0.0"))
+	assert.Equal(t, 0, lines.find("go.mod", "example.com/missing", "v1.0.0"))
+	assert.Equal(t, 0, lines.find("*.csproj", "Newtonsoft.Json", "12.0.1"))
+	assert.Equal(t, 0, lines.find("absent.txt", "react", "18.2.0"))
+}
+
 func TestVulnCollector_NoFixAvailable(t *testing.T) {
 	dir := t.TempDir()
 	require.NoError(t, os.WriteFile(filepath.Join(dir, "go.mod"), validGoMod(), 0o600))
@@ -1371,3 +1410,26 @@ func TestVulnCollector_NpmParseError(t *testing.T) {
 	require.Len(t, signals, 1)
 	assert.Equal(t, "go.mod", signals[0].FilePath)
 }
+
+func TestListDependencies(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "go.mod", string(validGoMod()))
+	writeFile(t, dir, "package.json", `{"dependencies": {"lodash": "4.17.20"}}`)
+
+	deps, err := ListDependencies(dir)
+	require.NoError(t, err)
+	assert.Equal(t, []Dependency{
+		{Ecosystem: "Go", Name: "github.com/foo/bar", Version: "v1.0.0", FilePath: "go.mod"},
+		{Ecosystem: "Go", Name: "github.com/baz/qux", Version: "v0.2.0", FilePath: "go.mod"},
+		{Ecosystem: "npm", Name: "lodash", Version: "4.17.20", FilePath: "package.json"},
+	}, deps)
+
+	empty, err := ListDependencies(t.TempDir())
+	require.NoError(t, err)
+	assert.Empty(t, empty)
+
+	bad := t.TempDir()
+	writeFile(t, bad, "go.mod", "not a go.mod {{{")
+	_, err = ListDependencies(bad)
+	assert.Error(t, err)
+}
@@ -0,0 +1,30 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package collectors
+
+import (
+	"context"
+	"io/fs"
+
+	"github.com/davetashner/stringer/internal/tracing"
+)
+
+// walkTree walks root with FS.WalkDir, recording the walk and the number
+// of entries it visited as a "walk" span when the scan is traced.
+func walkTree(ctx context.Context, root string, fn fs.WalkDirFunc) error {
+	_, span := tracing.Start(ctx, "walk", tracing.String("stringer.walk.root", root))
+	if span == nil {
+		return FS.WalkDir(root, fn)
+	}
+	defer span.End()
+
+	entries := 0
+	err := FS.WalkDir(root, func(path string, d fs.DirEntry, walkErr error) error {
+		entries++
+		return fn(path, d, walkErr)
+	})
+	span.SetAttributes(tracing.Int("stringer.walk.entries", entries))
+	span.RecordError(err)
+	return err
+}
@@ -0,0 +1,42 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package collectors
+
+import (
+	"bytes"
+	"context"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davetashner/stringer/internal/tracing"
+)
+
+func TestWalkTree(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a.go"), []byte("package a\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "b.go"), []byte("package b\n"), 0o600))
+
+	count := func(ctx context.Context) int {
+		n := 0
+		require.NoError(t, walkTree(ctx, dir, func(string, fs.DirEntry, error) error {
+			n++
+			return nil
+		}))
+		return n
+	}
+	assert.Equal(t, 3, count(context.Background()))
+
+	tr := tracing.New("stringer", nil)
+	assert.Equal(t, 3, count(tracing.WithTracer(context.Background(), tr)))
+	require.Equal(t, 1, tr.Len())
+	var buf bytes.Buffer
+	require.NoError(t, tr.WriteJSON(&buf))
+	assert.Contains(t, buf.String(), `"name":"walk"`)
+	assert.Contains(t, buf.String(), `{"key":"stringer.walk.entries","value":{"intValue":"3"}}`)
+}
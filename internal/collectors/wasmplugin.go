@@ -0,0 +1,138 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package collectors
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+
+	"github.com/tetratelabs/wazero"
+	"github.com/tetratelabs/wazero/imports/wasi_snapshot_preview1"
+	"github.com/tetratelabs/wazero/sys"
+)
+
+// DefaultPluginMemoryMB caps a WASM plugin's memory when its config sets
+// no memory_mb.
+const DefaultPluginMemoryMB = 128
+
+// MaxPluginMemoryMB is the largest memory_mb a WASM plugin may ask for.
+const MaxPluginMemoryMB = 1024
+
+// wasmRepoMount is where a WASM plugin with read access sees the scanned
+// directory.
+const wasmRepoMount = "/repo"
+
+// wasmCacheDir holds compiled WASM plugins under stringer's directory in
+// the user cache directory, so warm scans skip compilation. It is outside
+// the scanned repository, which must not be able to plant compiled code.
+const wasmCacheDir = "wasm"
+
+// wasmPageSize is the size of a WebAssembly memory page.
+const wasmPageSize = 64 << 10
+
+// wasmMemCache keeps compiled modules for the life of the process when the
+// file-backed cache is not used, so a module runs compiled once per
+// workspace, watch re-scan, or test.
+var wasmMemCache = wazero.NewCompilationCache()
+
+// runWASM runs the plugin's WASI module with req on stdin. The module gets
+// the configured args, no environment, the real clock, and crypto/rand as
+// its random source. It sees no files unless ReadRepo mounts repoPath
+// read-only at /repo, and it cannot open sockets. ctx's deadline stops the
+// module mid-run. With cache set, compiled code is kept in the user cache
+// directory, keyed by the module's hash, otherwise in memory.
+func (c *PluginCollector) runWASM(ctx context.Context, repoPath string, req []byte, stdout, stderr io.Writer, cache bool) error {
+	path := c.spec.WASM
+	if c.spec.Dir != "" && !filepath.IsAbs(path) {
+		path = filepath.Join(c.spec.Dir, path)
+	}
+	code, err := os.ReadFile(path) //nolint:gosec // module path comes from the user's config
+	if err != nil {
+		return fmt.Errorf("read module: %w", err)
+	}
+
+	memoryMB := c.spec.MemoryMB
+	if memoryMB <= 0 {
+		memoryMB = DefaultPluginMemoryMB
+	}
+	cfg := wazero.NewRuntimeConfig().
+		WithCloseOnContextDone(true).
+		WithMemoryLimitPages(uint32(min(memoryMB, MaxPluginMemoryMB) << 20 / wasmPageSize)) //nolint:gosec // bounded by MaxPluginMemoryMB
+	cc := wasmMemCache
+	if cache {
+		if fc := openWASMCache(code); fc != nil {
+			defer fc.Close(ctx) //nolint:errcheck // nothing to do on failure
+			cc = fc
+		}
+	}
+	cfg = cfg.WithCompilationCache(cc)
+	r := wazero.NewRuntimeWithConfig(ctx, cfg)
+	defer r.Close(ctx) //nolint:errcheck // nothing to do on failure
+
+	if _, err := wasi_snapshot_preview1.Instantiate(ctx, r); err != nil {
+		return fmt.Errorf("instantiate WASI: %w", err)
+	}
+	mod, err := r.CompileModule(ctx, code)
+	if err != nil {
+		return fmt.Errorf("compile module: %w", err)
+	}
+
+	fsCfg := wazero.NewFSConfig()
+	if c.spec.ReadRepo {
+		fsCfg = fsCfg.WithReadOnlyDirMount(repoPath, wasmRepoMount)
+	}
+	modCfg := wazero.NewModuleConfig().
+		WithName(c.spec.Name).
+		WithArgs(append([]string{c.spec.Name}, c.spec.Args...)...).
+		WithStdin(bytes.NewReader(req)).
+		WithStdout(stdout).
+		WithStderr(stderr).
+		WithFSConfig(fsCfg).
+		WithSysWalltime().
+		WithSysNanotime().
+		WithSysNanosleep().
+		WithRandSource(rand.Reader)
+
+	// A zero exit is reported as success.
+	if _, err := r.InstantiateModule(ctx, mod, modCfg); err != nil {
+		var exitErr *sys.ExitError
+		if errors.As(err, &exitErr) && ctx.Err() == nil {
+			return fmt.Errorf("exit status %d", exitErr.ExitCode())
+		}
+		return err
+	}
+	return nil
+}
+
+// openWASMCache opens the compilation cache for the module code, in a
+// directory of the user cache directory named by the code's hash, or
+// returns nil if it cannot be created.
+func openWASMCache(code []byte) wazero.CompilationCache {
+	base, err := os.UserCacheDir()
+	if err != nil {
+		slog.Debug("no user cache directory for the WASM compilation cache", "error", err)
+		return nil
+	}
+	sum := sha256.Sum256(code)
+	dir := filepath.Join(base, "stringer", wasmCacheDir, hex.EncodeToString(sum[:]))
+	if err := FS.MkdirAll(dir, 0o700); err != nil {
+		slog.Debug("cannot create WASM compilation cache", "path", dir, "error", err)
+		return nil
+	}
+	cc, err := wazero.NewCompilationCacheWithDir(dir)
+	if err != nil {
+		slog.Debug("cannot open WASM compilation cache", "path", dir, "error", err)
+		return nil
+	}
+	return cc
+}
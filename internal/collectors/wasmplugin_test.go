@@ -0,0 +1,154 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package collectors
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+var (
+	wasmFixtureOnce sync.Once
+	wasmFixtureDir  string
+	wasmFixture     string
+	wasmFixtureErr  error
+)
+
+func TestMain(m *testing.M) {
+	code := m.Run()
+	if wasmFixtureDir != "" {
+		_ = os.RemoveAll(wasmFixtureDir)
+	}
+	os.Exit(code)
+}
+
+// buildWASMFixture compiles testdata/wasmplugin for WASI once per test run
+// and returns the module's path.
+func buildWASMFixture(t *testing.T) string {
+	t.Helper()
+	if testing.Short() {
+		t.Skip("builds a WASI module")
+	}
+	wasmFixtureOnce.Do(func() {
+		wasmFixtureDir, wasmFixtureErr = os.MkdirTemp("", "stringer-wasm-")
+		if wasmFixtureErr != nil {
+			return
+		}
+		wasmFixture = filepath.Join(wasmFixtureDir, "plugin.wasm")
+		build := exec.Command("go", "build", "-o", wasmFixture, "./testdata/wasmplugin") //nolint:gosec // test helper with fixed args
+		build.Env = append(os.Environ(), "GOOS=wasip1", "GOARCH=wasm")
+		if out, err := build.CombinedOutput(); err != nil {
+			wasmFixtureErr = err
+			wasmFixture = string(out)
+		}
+	})
+	if wasmFixtureErr != nil {
+		t.Fatalf("build WASM fixture: %v\n%s", wasmFixtureErr, wasmFixture)
+	}
+	return wasmFixture
+}
+
+func TestWASMPlugin_Collect(t *testing.T) {
+	module := buildWASMFixture(t)
+	dir := t.TempDir()
+	writeFile(t, dir, "main.go", "package main\n")
+	writeFile(t, dir, "vendor/x.go", "package x\n")
+
+	c := NewPluginCollector(PluginSpec{Name: "license", WASM: module, ReadRepo: true})
+	signals, err := c.Collect(context.Background(), dir, signal.CollectorOpts{})
+	require.NoError(t, err)
+
+	require.Len(t, signals, 2, "default excludes apply")
+	assert.Equal(t, "collector license at /repo with 0 env", signals[0].Title, "the module sees the mount point and no environment")
+	assert.Equal(t, "license", signals[1].Source)
+	assert.Equal(t, "main.go", signals[1].FilePath)
+	assert.NoDirExists(t, filepath.Join(dir, ".stringer"), "no compilation cache without the scan cache")
+}
+
+func TestWASMPlugin_RelativeToConfig(t *testing.T) {
+	module := buildWASMFixture(t)
+	cfgDir := t.TempDir()
+	data, err := os.ReadFile(module) //nolint:gosec // test fixture
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(cfgDir, "license.wasm"), data, 0o600))
+
+	c := NewPluginCollector(PluginSpec{Name: "license", WASM: "license.wasm", Dir: cfgDir})
+	signals, err := c.Collect(context.Background(), t.TempDir(), signal.CollectorOpts{})
+	require.NoError(t, err)
+	require.Len(t, signals, 2)
+	assert.Equal(t, "repo not readable", signals[1].Title, "no files are mounted without read access")
+}
+
+func TestWASMPlugin_ReadOnlyMount(t *testing.T) {
+	module := buildWASMFixture(t)
+	dir := t.TempDir()
+
+	c := NewPluginCollector(PluginSpec{Name: "p", WASM: module, Args: []string{"write"}, ReadRepo: true})
+	signals, err := c.Collect(context.Background(), dir, signal.CollectorOpts{})
+	require.NoError(t, err)
+	require.Len(t, signals, 1)
+	assert.Equal(t, "write error: true", signals[0].Title)
+	assert.NoFileExists(t, filepath.Join(dir, "planted.txt"))
+}
+
+func TestWASMPlugin_Errors(t *testing.T) {
+	module := buildWASMFixture(t)
+	for _, tc := range []struct {
+		name string
+		spec PluginSpec
+		want string
+	}{
+		{"exit status", PluginSpec{Name: "p", WASM: module, Args: []string{"fail"}}, "plugin p: exit status 3: license server unreachable"},
+		{"memory limit", PluginSpec{Name: "p", WASM: module, Args: []string{"alloc"}, MemoryMB: 32}, "plugin p: exit status 2"},
+		{"missing module", PluginSpec{Name: "p", WASM: filepath.Join(t.TempDir(), "none.wasm")}, "plugin p: read module:"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := NewPluginCollector(tc.spec).Collect(context.Background(), t.TempDir(), signal.CollectorOpts{})
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.want)
+		})
+	}
+}
+
+func TestWASMPlugin_Timeout(t *testing.T) {
+	module := buildWASMFixture(t)
+	c := NewPluginCollector(PluginSpec{Name: "slow", WASM: module, Args: []string{"spin"}, Timeout: 500 * time.Millisecond})
+
+	start := time.Now()
+	_, err := c.Collect(context.Background(), t.TempDir(), signal.CollectorOpts{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "plugin slow timed out after 500ms")
+	assert.Less(t, time.Since(start), 30*time.Second)
+}
+
+func TestWASMPlugin_CompilationCache(t *testing.T) {
+	module := buildWASMFixture(t)
+	dir := t.TempDir()
+	cacheHome := t.TempDir()
+	t.Setenv("XDG_CACHE_HOME", cacheHome)
+
+	c := NewPluginCollector(PluginSpec{Name: "p", WASM: module})
+	_, err := c.Collect(context.Background(), dir, signal.CollectorOpts{Cache: newMemCache()})
+	require.NoError(t, err)
+
+	code, err := os.ReadFile(module) //nolint:gosec // test fixture
+	require.NoError(t, err)
+	sum := sha256.Sum256(code)
+	entries, err := os.ReadDir(filepath.Join(cacheHome, "stringer", wasmCacheDir, hex.EncodeToString(sum[:])))
+	require.NoError(t, err)
+	assert.NotEmpty(t, entries, "compiled code is cached under the module's hash")
+	assert.NoDirExists(t, filepath.Join(dir, ".stringer"), "nothing is cached in the scanned repository")
+}
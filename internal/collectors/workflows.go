@@ -0,0 +1,424 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package collectors
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/davetashner/stringer/internal/collector"
+	"github.com/davetashner/stringer/internal/gitcli"
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+func init() {
+	collector.Register(&WorkflowsCollector{})
+}
+
+// workflowsDir is where GitHub Actions looks for workflow files.
+const workflowsDir = ".github/workflows"
+
+// workflowDisabledDays is how long a workflow must have been disabled,
+// judged by the last commit to its file, before it is reported.
+const workflowDisabledDays = 90
+
+// firstPartyActionOwners are the GitHub-maintained action owners that are
+// not flagged when pinned to a tag.
+var firstPartyActionOwners = map[string]bool{"actions": true, "github": true}
+
+var (
+	// fullSHARef matches a ref pinned to a full commit SHA.
+	fullSHARef = regexp.MustCompile(`^[0-9a-f]{40}$`)
+
+	// untrustedCheckoutRef matches checkout refs that resolve to the pull
+	// request's head, which pull_request_target runs with write access.
+	untrustedCheckoutRef = regexp.MustCompile(`github\.event\.pull_request\.head\.|github\.head_ref|refs/pull/`)
+
+	// falseCondition matches a job condition that can never hold.
+	falseCondition = regexp.MustCompile(`^\s*(\$\{\{\s*)?false(\s*\}\})?\s*$`)
+)
+
+// WorkflowsMetrics holds structured metrics from the workflows scan.
+type WorkflowsMetrics struct {
+	WorkflowsScanned           int
+	UnpinnedActions            int
+	PullRequestTargetCheckouts int
+	MissingPermissions         int
+	DisabledWorkflows          int
+}
+
+// WorkflowsCollector lints GitHub Actions workflows for risky patterns:
+// third-party actions not pinned to a commit SHA, pull_request_target
+// workflows that check out code, workflows without a permissions block, and
+// workflows that have been disabled for a long time. Every finding is a
+// ci-risk signal at the offending line.
+type WorkflowsCollector struct {
+	metrics *WorkflowsMetrics
+}
+
+var _ collector.Collector = (*WorkflowsCollector)(nil)
+var _ collector.MetricsProvider = (*WorkflowsCollector)(nil)
+
+// Name returns the collector name used for registration and filtering.
+func (c *WorkflowsCollector) Name() string { return "workflows" }
+
+// Metrics returns the structured metrics from the last scan.
+func (c *WorkflowsCollector) Metrics() any { return c.metrics }
+
+// Collect parses each file in .github/workflows and reports its risks.
+func (c *WorkflowsCollector) Collect(ctx context.Context, repoPath string, opts signal.CollectorOpts) ([]signal.RawSignal, error) {
+	metrics := &WorkflowsMetrics{}
+	c.metrics = metrics
+
+	dir := filepath.Join(repoPath, filepath.FromSlash(workflowsDir))
+	if _, err := FS.Stat(dir); err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("reading %s: %w", workflowsDir, err)
+	}
+	var names []string
+	err := walkTree(ctx, dir, func(p string, d fs.DirEntry, walkErr error) error {
+		if walkErr != nil {
+			return walkErr
+		}
+		if d.IsDir() {
+			if p != dir {
+				return filepath.SkipDir // GitHub only loads the top level
+			}
+			return nil
+		}
+		names = append(names, d.Name())
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", workflowsDir, err)
+	}
+
+	var signals []signal.RawSignal
+	for _, name := range names {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		disabledFile, ok := workflowFileKind(name)
+		if !ok {
+			continue
+		}
+		relPath := workflowsDir + "/" + name
+		if shouldExclude(relPath, opts.ExcludePatterns) || !opts.Scope.Contains(relPath) {
+			continue
+		}
+		if len(opts.IncludePatterns) > 0 && !matchesAny(relPath, opts.IncludePatterns) {
+			continue
+		}
+
+		data, err := FS.ReadFile(filepath.Join(repoPath, filepath.FromSlash(relPath)))
+		if err != nil {
+			continue
+		}
+		wf, err := parseWorkflow(data)
+		if err != nil {
+			continue // not valid YAML; GitHub reports it on the workflow run
+		}
+		metrics.WorkflowsScanned++
+
+		// A disabled workflow never runs, so only its age is of interest.
+		var found []signal.RawSignal
+		if disabledFile || wf.allJobsDisabled() {
+			if sig, ok := disabledWorkflowSignal(ctx, repoPath, relPath, disabledFile); ok && sig.Confidence >= opts.MinConfidence {
+				signals = append(signals, sig)
+				metrics.DisabledWorkflows++
+			}
+			continue
+		}
+		for _, u := range wf.unpinnedActions() {
+			found = append(found, ciRiskSignal(relPath, u.line, 0.7,
+				fmt.Sprintf("Unpinned third-party action %s in %s", u.uses, relPath),
+				fmt.Sprintf("%s is referenced by a tag or branch, which its owner can move to different code. Pin it to a full commit SHA (keep the tag in a comment) so upstream changes are reviewed before they run with this workflow's secrets.", u.uses),
+				"supply-chain"))
+			metrics.UnpinnedActions++
+		}
+		if wf.triggers["pull_request_target"] {
+			for _, co := range wf.checkouts() {
+				if untrustedCheckoutRef.MatchString(co.ref) {
+					found = append(found, ciRiskSignal(relPath, co.line, 0.9,
+						fmt.Sprintf("pull_request_target workflow checks out pull request code in %s", relPath),
+						fmt.Sprintf("The job checks out %s in a pull_request_target workflow, which runs with a write token and secrets. Code from a fork can then run with those privileges; use the pull_request trigger, or never build or run the checked-out code.", co.ref),
+						"pull-request-target"))
+				} else {
+					found = append(found, ciRiskSignal(relPath, co.line, 0.5,
+						fmt.Sprintf("pull_request_target workflow runs actions/checkout in %s", relPath),
+						"pull_request_target runs with a write token and secrets. The default checkout is the base branch, which is safe, but any step that fetches or runs pull request code inherits those privileges; review the job or use the pull_request trigger.",
+						"pull-request-target"))
+				}
+				metrics.PullRequestTargetCheckouts++
+			}
+		}
+		if line, ok := wf.missingPermissions(); ok {
+			found = append(found, ciRiskSignal(relPath, line, 0.6,
+				fmt.Sprintf("Workflow %s has no permissions block", relPath),
+				"Without a top-level or per-job permissions block, GITHUB_TOKEN gets the repository's default scopes, which may include write access to contents, packages, and pull requests. Declare the least permissions each job needs (permissions: {} grants none).",
+				"permissions"))
+			metrics.MissingPermissions++
+		}
+
+		for _, sig := range found {
+			if sig.Confidence >= opts.MinConfidence {
+				signals = append(signals, sig)
+			}
+		}
+	}
+	return signals, nil
+}
+
+// workflowFileKind reports whether name is a workflow file, and whether it
+// is one disabled by renaming (ci.yml.disabled, ci.yaml.off), which
+// GitHub does not run.
+func workflowFileKind(name string) (disabled, ok bool) {
+	lower := strings.ToLower(name)
+	for _, ext := range []string{".yml", ".yaml"} {
+		if strings.HasSuffix(lower, ext) {
+			return false, true
+		}
+		for _, suffix := range []string{".disabled", ".off", ".bak"} {
+			if strings.HasSuffix(lower, ext+suffix) {
+				return true, true
+			}
+		}
+	}
+	return false, false
+}
+
+// disabledWorkflowSignal reports a workflow disabled for at least
+// workflowDisabledDays, dated by the last commit to its file. Files
+// without history are not reported, since their age is unknown.
+func disabledWorkflowSignal(ctx context.Context, repoPath, relPath string, renamed bool) (signal.RawSignal, bool) {
+	last, err := gitcli.LastCommitTime(ctx, repoPath, relPath)
+	if err != nil || last.IsZero() {
+		return signal.RawSignal{}, false
+	}
+	days := int(time.Since(last).Hours() / 24)
+	if days < workflowDisabledDays {
+		return signal.RawSignal{}, false
+	}
+	how := "every job has if: false"
+	if renamed {
+		how = "its file is renamed so GitHub does not load it"
+	}
+	sig := ciRiskSignal(relPath, 1, 0.4+0.3*min(float64(days)/365, 1),
+		fmt.Sprintf("Workflow %s disabled for %d days", relPath, days),
+		fmt.Sprintf("The workflow has been disabled for %d days (%s). Either delete it or fix and re-enable it; a long-disabled workflow rots and hides the checks it used to run.", days, how),
+		"disabled-workflow")
+	sig.Timestamp = last
+	return sig, true
+}
+
+// ciRiskSignal builds a ci-risk signal for a workflow finding.
+func ciRiskSignal(relPath string, line int, confidence float64, title, desc, tag string) signal.RawSignal {
+	return signal.RawSignal{
+		Source:      "workflows",
+		Kind:        "ci-risk",
+		FilePath:    relPath,
+		Line:        line,
+		Title:       title,
+		Description: desc,
+		Confidence:  confidence,
+		Tags:        []string{"ci-risk", "github-actions", tag},
+	}
+}
+
+// workflow is the parts of a parsed workflow file the checks read.
+type workflow struct {
+	root     *yaml.Node
+	triggers map[string]bool
+}
+
+// workflowUses is a uses: reference and its line.
+type workflowUses struct {
+	uses string
+	line int
+}
+
+// workflowCheckout is an actions/checkout step and the ref it checks out.
+type workflowCheckout struct {
+	ref  string
+	line int
+}
+
+// parseWorkflow decodes a workflow file, keeping node positions.
+func parseWorkflow(data []byte) (*workflow, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+	if doc.Kind != yaml.DocumentNode || len(doc.Content) == 0 || doc.Content[0].Kind != yaml.MappingNode {
+		return nil, errors.New("workflow is not a mapping")
+	}
+	wf := &workflow{root: doc.Content[0], triggers: make(map[string]bool)}
+
+	// on: push | [push, pull_request] | {push: {...}}
+	_, on := yamlLookup(wf.root, "on")
+	if on == nil {
+		_, on = yamlLookup(wf.root, "true") // YAML 1.1 reads a bare on as true
+	}
+	if on != nil {
+		switch on.Kind {
+		case yaml.ScalarNode:
+			wf.triggers[on.Value] = true
+		case yaml.SequenceNode:
+			for _, n := range on.Content {
+				wf.triggers[n.Value] = true
+			}
+		case yaml.MappingNode:
+			for i := 0; i+1 < len(on.Content); i += 2 {
+				wf.triggers[on.Content[i].Value] = true
+			}
+		}
+	}
+	return wf, nil
+}
+
+// jobs returns each job's key and value nodes, in file order.
+func (wf *workflow) jobs() [][2]*yaml.Node {
+	_, jobs := yamlLookup(wf.root, "jobs")
+	if jobs == nil || jobs.Kind != yaml.MappingNode {
+		return nil
+	}
+	var out [][2]*yaml.Node
+	for i := 0; i+1 < len(jobs.Content); i += 2 {
+		out = append(out, [2]*yaml.Node{jobs.Content[i], jobs.Content[i+1]})
+	}
+	return out
+}
+
+// steps returns every step mapping across all jobs.
+func (wf *workflow) steps() []*yaml.Node {
+	var out []*yaml.Node
+	for _, job := range wf.jobs() {
+		_, steps := yamlLookup(job[1], "steps")
+		if steps == nil || steps.Kind != yaml.SequenceNode {
+			continue
+		}
+		for _, step := range steps.Content {
+			if step.Kind == yaml.MappingNode {
+				out = append(out, step)
+			}
+		}
+	}
+	return out
+}
+
+// unpinnedActions returns third-party actions and reusable workflows not
+// pinned to a full commit SHA, and Docker actions not pinned to a digest.
+// Local actions (./path) are part of the repository and are skipped.
+func (wf *workflow) unpinnedActions() []workflowUses {
+	var refs []*yaml.Node
+	for _, job := range wf.jobs() {
+		if _, uses := yamlLookup(job[1], "uses"); uses != nil {
+			refs = append(refs, uses) // reusable workflow call
+		}
+	}
+	for _, step := range wf.steps() {
+		if _, uses := yamlLookup(step, "uses"); uses != nil {
+			refs = append(refs, uses)
+		}
+	}
+
+	var out []workflowUses
+	for _, n := range refs {
+		uses := strings.TrimSpace(n.Value)
+		if uses == "" || strings.HasPrefix(uses, "./") {
+			continue
+		}
+		if image, ok := strings.CutPrefix(uses, "docker://"); ok {
+			if !strings.Contains(image, "@sha256:") {
+				out = append(out, workflowUses{uses, n.Line})
+			}
+			continue
+		}
+		action, ref, _ := strings.Cut(uses, "@")
+		owner, _, _ := strings.Cut(action, "/")
+		if firstPartyActionOwners[strings.ToLower(owner)] || fullSHARef.MatchString(ref) {
+			continue
+		}
+		out = append(out, workflowUses{uses, n.Line})
+	}
+	sort.SliceStable(out, func(i, j int) bool { return out[i].line < out[j].line })
+	return out
+}
+
+// checkouts returns the actions/checkout steps and the ref each checks out.
+func (wf *workflow) checkouts() []workflowCheckout {
+	var out []workflowCheckout
+	for _, step := range wf.steps() {
+		_, uses := yamlLookup(step, "uses")
+		if uses == nil || !strings.HasPrefix(strings.ToLower(uses.Value), "actions/checkout@") {
+			continue
+		}
+		co := workflowCheckout{line: uses.Line}
+		if _, with := yamlLookup(step, "with"); with != nil {
+			if _, ref := yamlLookup(with, "ref"); ref != nil {
+				co.ref = ref.Value
+			}
+		}
+		out = append(out, co)
+	}
+	return out
+}
+
+// missingPermissions reports whether the workflow leaves GITHUB_TOKEN at
+// the default scopes: no top-level permissions and at least one job
+// without its own. The line is the first such job's.
+func (wf *workflow) missingPermissions() (int, bool) {
+	if k, _ := yamlLookup(wf.root, "permissions"); k != nil {
+		return 0, false
+	}
+	for _, job := range wf.jobs() {
+		if _, uses := yamlLookup(job[1], "uses"); uses != nil {
+			continue // reusable workflow calls inherit the caller's permissions
+		}
+		if k, _ := yamlLookup(job[1], "permissions"); k == nil {
+			return job[0].Line, true
+		}
+	}
+	return 0, false
+}
+
+// allJobsDisabled reports whether every job is guarded by if: false.
+func (wf *workflow) allJobsDisabled() bool {
+	jobs := wf.jobs()
+	if len(jobs) == 0 {
+		return false
+	}
+	for _, job := range jobs {
+		_, cond := yamlLookup(job[1], "if")
+		if cond == nil || !falseCondition.MatchString(cond.Value) {
+			return false
+		}
+	}
+	return true
+}
+
+// yamlLookup returns the key and value nodes for key in a mapping node, or
+// nils if n is not a mapping or has no such key.
+func yamlLookup(n *yaml.Node, key string) (*yaml.Node, *yaml.Node) {
+	if n == nil || n.Kind != yaml.MappingNode {
+		return nil, nil
+	}
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		if n.Content[i].Value == key {
+			return n.Content[i], n.Content[i+1]
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,187 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package collectors
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+const testRiskyWorkflow = `name: CI
+on:
+  pull_request_target:
+    types: [opened]
+jobs:
+  build:
+    runs-on: ubuntu-latest
+    steps:
+      - uses: actions/checkout@v4
+        with:
+          ref: ${{ github.event.pull_request.head.sha }}
+      - uses: ./.github/actions/setup
+      - uses: some-org/deploy-action@v2
+      - uses: some-org/pinned-action@0123456789abcdef0123456789abcdef01234567
+      - uses: docker://alpine:3.20
+      - run: make test
+  call:
+    uses: other-org/workflows/.github/workflows/release.yml@main
+`
+
+const testSafeWorkflow = `name: Lint
+on: [push, pull_request]
+permissions:
+  contents: read
+jobs:
+  lint:
+    runs-on: ubuntu-latest
+    steps:
+      - uses: actions/checkout@v4
+      - uses: github/codeql-action/init@v3
+`
+
+func workflowSignalsByTag(signals []signal.RawSignal) map[string][]signal.RawSignal {
+	byTag := make(map[string][]signal.RawSignal)
+	for _, s := range signals {
+		byTag[s.Tags[2]] = append(byTag[s.Tags[2]], s)
+	}
+	return byTag
+}
+
+func TestWorkflows_RiskyPatterns(t *testing.T) {
+	dir := t.TempDir()
+	writeTestFile(t, dir, ".github/workflows/ci.yml", testRiskyWorkflow)
+	writeTestFile(t, dir, ".github/workflows/lint.yaml", testSafeWorkflow)
+	writeTestFile(t, dir, ".github/workflows/README.md", "not a workflow")
+	writeTestFile(t, dir, ".github/workflows/broken.yml", "jobs: [unterminated")
+
+	c := &WorkflowsCollector{}
+	signals, err := c.Collect(context.Background(), dir, signal.CollectorOpts{})
+	require.NoError(t, err)
+	for _, s := range signals {
+		assert.Equal(t, "workflows", s.Source)
+		assert.Equal(t, "ci-risk", s.Kind)
+		assert.Equal(t, ".github/workflows/ci.yml", s.FilePath, "lint.yaml is safe")
+	}
+	byTag := workflowSignalsByTag(signals)
+
+	unpinned := byTag["supply-chain"]
+	require.Len(t, unpinned, 3)
+	assert.Equal(t, "Unpinned third-party action some-org/deploy-action@v2 in .github/workflows/ci.yml", unpinned[0].Title)
+	assert.Equal(t, 13, unpinned[0].Line)
+	assert.Equal(t, 15, unpinned[1].Line, "docker image without a digest")
+	assert.Equal(t, 18, unpinned[2].Line, "reusable workflow on a branch")
+
+	prt := byTag["pull-request-target"]
+	require.Len(t, prt, 1)
+	assert.Equal(t, "pull_request_target workflow checks out pull request code in .github/workflows/ci.yml", prt[0].Title)
+	assert.Equal(t, 9, prt[0].Line)
+	assert.Equal(t, 0.9, prt[0].Confidence)
+
+	perms := byTag["permissions"]
+	require.Len(t, perms, 1)
+	assert.Equal(t, 6, perms[0].Line, "the first job without permissions")
+
+	m := c.Metrics().(*WorkflowsMetrics)
+	assert.Equal(t, 2, m.WorkflowsScanned)
+	assert.Equal(t, 3, m.UnpinnedActions)
+	assert.Equal(t, 1, m.PullRequestTargetCheckouts)
+	assert.Equal(t, 1, m.MissingPermissions)
+}
+
+func TestWorkflows_NoWorkflowsDir(t *testing.T) {
+	c := &WorkflowsCollector{}
+	signals, err := c.Collect(context.Background(), t.TempDir(), signal.CollectorOpts{})
+	require.NoError(t, err)
+	assert.Empty(t, signals)
+	assert.Equal(t, 0, c.Metrics().(*WorkflowsMetrics).WorkflowsScanned)
+}
+
+func TestWorkflows_MinConfidenceAndScope(t *testing.T) {
+	dir := t.TempDir()
+	writeTestFile(t, dir, ".github/workflows/ci.yml", testRiskyWorkflow)
+
+	c := &WorkflowsCollector{}
+	signals, err := c.Collect(context.Background(), dir, signal.CollectorOpts{MinConfidence: 0.8})
+	require.NoError(t, err)
+	require.Len(t, signals, 1)
+	assert.Equal(t, 0.9, signals[0].Confidence)
+
+	signals, err = c.Collect(context.Background(), dir, signal.CollectorOpts{ExcludePatterns: []string{".github/**"}})
+	require.NoError(t, err)
+	assert.Empty(t, signals)
+}
+
+func TestWorkflows_BaseCheckoutIsLowConfidence(t *testing.T) {
+	dir := t.TempDir()
+	writeTestFile(t, dir, ".github/workflows/label.yml", `on: pull_request_target
+permissions:
+  pull-requests: write
+jobs:
+  label:
+    runs-on: ubuntu-latest
+    steps:
+      - uses: actions/checkout@v4
+`)
+	signals, err := (&WorkflowsCollector{}).Collect(context.Background(), dir, signal.CollectorOpts{})
+	require.NoError(t, err)
+	require.Len(t, signals, 1)
+	assert.Equal(t, "pull_request_target workflow runs actions/checkout in .github/workflows/label.yml", signals[0].Title)
+	assert.Equal(t, 0.5, signals[0].Confidence)
+}
+
+func TestWorkflows_DisabledWorkflows(t *testing.T) {
+	dir := initDocTestRepo(t)
+	writeTestFile(t, dir, ".github/workflows/old.yml.disabled", testRiskyWorkflow)
+	writeTestFile(t, dir, ".github/workflows/off.yml", `on: push
+jobs:
+  a:
+    if: false
+    runs-on: ubuntu-latest
+    steps: [{run: "true"}]
+  b:
+    if: ${{ false }}
+    runs-on: ubuntu-latest
+    steps: [{run: "true"}]
+`)
+	gitCommit(t, dir, "disable workflows")
+	backdateLastCommit(t, dir, time.Now().AddDate(0, 0, -200))
+	writeTestFile(t, dir, ".github/workflows/recent.yml.off", testSafeWorkflow)
+	gitCommit(t, dir, "disable recent")
+
+	c := &WorkflowsCollector{}
+	signals, err := c.Collect(context.Background(), dir, signal.CollectorOpts{})
+	require.NoError(t, err)
+	require.Len(t, signals, 2, "a disabled workflow is not linted, and recent.yml.off was disabled lately")
+
+	byPath := make(map[string]signal.RawSignal)
+	for _, s := range signals {
+		byPath[s.FilePath] = s
+		assert.Contains(t, s.Tags, "disabled-workflow")
+		assert.InDelta(t, 0.56, s.Confidence, 0.01)
+	}
+	assert.Contains(t, byPath[".github/workflows/off.yml"].Title, "disabled for 200 days")
+	assert.Contains(t, byPath[".github/workflows/off.yml"].Description, "every job has if: false")
+	assert.Contains(t, byPath[".github/workflows/old.yml.disabled"].Description, "renamed")
+	assert.Equal(t, 2, c.Metrics().(*WorkflowsMetrics).DisabledWorkflows)
+}
+
+func TestWorkflowFileKind(t *testing.T) {
+	for name, want := range map[string][2]bool{
+		"ci.yml":          {false, true},
+		"CI.YAML":         {false, true},
+		"ci.yml.disabled": {true, true},
+		"ci.yaml.bak":     {true, true},
+		"README.md":       {false, false},
+		"ci.yml.orig":     {false, false},
+	} {
+		disabled, ok := workflowFileKind(name)
+		assert.Equal(t, want, [2]bool{disabled, ok}, name)
+	}
+}
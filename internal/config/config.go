@@ -7,11 +7,188 @@ package config
 // Config represents the contents of a .stringer.yaml file.
 type Config struct {
 	OutputFormat      string                     `yaml:"output_format,omitempty"`
+	Lang              string                     `yaml:"lang,omitempty"`
 	MaxIssues         int                        `yaml:"max_issues,omitempty"`
 	NoLLM             bool                       `yaml:"no_llm,omitempty"`
 	BeadsAware        *bool                      `yaml:"beads_aware,omitempty"`
 	Collectors        map[string]CollectorConfig `yaml:"collectors,omitempty"`
 	PriorityOverrides []PriorityOverrideConfig   `yaml:"priority_overrides,omitempty"`
+
+	// Identities maps a canonical author name to the other names and email
+	// addresses the same person has committed under. Combined with .mailmap
+	// when aggregating authors.
+	Identities map[string][]string `yaml:"identities,omitempty"`
+
+	// Labels maps signal kinds, tags, and collectors to the labels each
+	// exporter attaches.
+	Labels []LabelRuleConfig `yaml:"labels,omitempty"`
+
+	// ExitCodes remaps the exit code scan returns for each condition.
+	ExitCodes *ExitCodesConfig `yaml:"exit_codes,omitempty"`
+
+	// Hotspots tunes the churn × complexity hotspot signals.
+	Hotspots *HotspotsConfig `yaml:"hotspots,omitempty"`
+
+	// Priority tunes how scan derives each signal's priority from its
+	// confidence, age, and file churn.
+	Priority *PriorityConfig `yaml:"priority,omitempty"`
+
+	// Epics turns on the epics scan --epics suggests and tunes how signals
+	// of similar kinds in one module are grouped.
+	Epics *EpicsConfig `yaml:"epics,omitempty"`
+
+	// LLM selects the provider LLM features use and turns on enrichment.
+	LLM *LLMConfig `yaml:"llm,omitempty"`
+
+	// CSVColumns selects the columns of the csv output format, in order.
+	CSVColumns []string `yaml:"csv_columns,omitempty"`
+
+	// ScoringProfile selects the profile that re-weights signal confidence:
+	// a built-in (security-first, refactor-focus, docs-heavy) or one defined
+	// under ScoringProfiles.
+	ScoringProfile string `yaml:"scoring_profile,omitempty"`
+
+	// ScoringProfiles defines custom scoring profiles by name.
+	ScoringProfiles map[string]ScoringProfileConfig `yaml:"scoring_profiles,omitempty"`
+
+	// Plugins declares external executables that run as collectors.
+	Plugins []PluginConfig `yaml:"plugins,omitempty"`
+
+	// Rules post-process signals after collection: tag, prioritize,
+	// route to another output file, or drop.
+	Rules []RuleConfig `yaml:"rules,omitempty"`
+}
+
+// RuleConfig applies its actions to the signals Match selects. Every
+// matching rule applies, in order.
+type RuleConfig struct {
+	Match       RuleMatchConfig `yaml:"match,omitempty"`
+	AddTags     []string        `yaml:"add_tags,omitempty"`
+	SetPriority int             `yaml:"set_priority,omitempty"` // 1-4
+	MinPriority int             `yaml:"min_priority,omitempty"` // raise to at least this urgency, 1-4
+	Output      string          `yaml:"output,omitempty"`       // route matches to this file
+	Drop        bool            `yaml:"drop,omitempty"`
+}
+
+// RuleMatchConfig selects signals for a rule. Every non-empty field must
+// match; within a list, any entry may.
+type RuleMatchConfig struct {
+	Kinds          []string `yaml:"kinds,omitempty"`           // globs allowed, e.g. "*-dependency"
+	Paths          []string `yaml:"paths,omitempty"`           // e.g. "internal/auth/**"
+	Authors        []string `yaml:"authors,omitempty"`         // globs allowed, case-insensitive
+	FunctionOwners []string `yaml:"function_owners,omitempty"` // like authors; needs function_blame
+	Tags           []string `yaml:"tags,omitempty"`
+	Collectors     []string `yaml:"collectors,omitempty"`
+	MinConfidence  float64  `yaml:"min_confidence,omitempty"`
+	MaxConfidence  float64  `yaml:"max_confidence,omitempty"`
+}
+
+// PluginConfig declares an external collector, either a command or a WASI
+// module run sandboxed. It reads the scan options as JSON on stdin and
+// writes one signal per line to stdout. A command with a path separator,
+// and a relative module path, are relative to the config file's directory.
+type PluginConfig struct {
+	Name    string   `yaml:"name"`
+	Command string   `yaml:"command,omitempty"`
+	WASM    string   `yaml:"wasm,omitempty"`
+	Args    []string `yaml:"args,omitempty"`
+	Timeout string   `yaml:"timeout,omitempty"` // default 60s
+
+	// FS grants a WASM module file access: "read" mounts the scanned
+	// directory read-only at /repo. Unset, the module sees no files.
+	FS string `yaml:"fs,omitempty"`
+
+	// MemoryMB caps a WASM module's memory. Default 128, at most 1024.
+	MemoryMB int `yaml:"memory_mb,omitempty"`
+}
+
+// ScoringProfileConfig is a custom scoring profile. A signal takes the
+// factor of the first weight it matches.
+type ScoringProfileConfig struct {
+	Description string                `yaml:"description,omitempty"`
+	Weights     []ScoringWeightConfig `yaml:"weights"`
+}
+
+// ScoringWeightConfig scales the confidence of signals whose kind or one of
+// whose tags is listed. A factor of 0 drops them.
+type ScoringWeightConfig struct {
+	Kinds  []string `yaml:"kinds,omitempty"` // globs allowed, e.g. "*-dependency"
+	Tags   []string `yaml:"tags,omitempty"`
+	Factor float64  `yaml:"factor"`
+}
+
+// HotspotsConfig tunes which files scan reports as hotspots: files changed
+// often that are also large or complex. Zero fields keep the defaults.
+type HotspotsConfig struct {
+	Enabled       *bool   `yaml:"enabled,omitempty"`        // default true
+	MinChanges    int     `yaml:"min_changes,omitempty"`    // default 5
+	MinLines      int     `yaml:"min_lines,omitempty"`      // default 200
+	MinComplexity float64 `yaml:"min_complexity,omitempty"` // default 10
+	MinScore      float64 `yaml:"min_score,omitempty"`      // default 0
+	MaxSignals    int     `yaml:"max_signals,omitempty"`    // default 10
+}
+
+// PriorityConfig tunes the priority (P1 most urgent to P4) scan assigns
+// each signal. The score is the signal's confidence plus an age boost and a
+// boost for files with churn or hotspot signals; P1, P2, and P3 are the
+// minimum scores for each priority. Zero fields keep the defaults.
+type PriorityConfig struct {
+	Enabled       *bool    `yaml:"enabled,omitempty"`        // default true
+	P1            float64  `yaml:"p1,omitempty"`             // default 0.8
+	P2            float64  `yaml:"p2,omitempty"`             // default 0.6
+	P3            float64  `yaml:"p3,omitempty"`             // default 0.4
+	AgeWeight     *float64 `yaml:"age_weight,omitempty"`     // default 0.1; 0 ignores age
+	AgeDays       int      `yaml:"age_days,omitempty"`       // default 365
+	HotspotWeight *float64 `yaml:"hotspot_weight,omitempty"` // default 0.1; 0 ignores churn
+}
+
+// EpicsConfig turns on epic suggestions for every scan, as --epics does,
+// and tunes the epics grouping signals of similar kinds in the same module.
+// Zero fields keep the defaults.
+type EpicsConfig struct {
+	Enabled        bool    `yaml:"enabled,omitempty"`         // default false
+	ModuleMinSize  int     `yaml:"module_min_size,omitempty"` // default 5
+	ModuleDepth    int     `yaml:"module_depth,omitempty"`    // default 2
+	KindSimilarity float64 `yaml:"kind_similarity,omitempty"` // default 0.3
+}
+
+// LLMConfig selects the provider for LLM features (--infer-priority,
+// --infer-deps, --enrich) and tunes enrichment. --no-llm turns them all off.
+type LLMConfig struct {
+	Provider string `yaml:"provider,omitempty"` // anthropic (default), openai, or ollama
+	Model    string `yaml:"model,omitempty"`    // provider default when empty
+	BaseURL  string `yaml:"base_url,omitempty"` // API root, e.g. a remote ollama server; global config only
+
+	// Enrich rewrites signal titles, drafts acceptance criteria, and merges
+	// near-duplicates on every scan, as --enrich does. Global config only.
+	Enrich             bool    `yaml:"enrich,omitempty"`
+	DuplicateThreshold float64 `yaml:"duplicate_threshold,omitempty"` // default 0.8
+}
+
+// ExitCodesConfig overrides the scan exit code for each condition. A nil
+// field keeps the default; zero makes the condition succeed.
+type ExitCodesConfig struct {
+	PartialFailure *int `yaml:"partial_failure,omitempty"` // some collectors failed
+	TotalFailure   *int `yaml:"total_failure,omitempty"`   // every collector failed
+	Expectation    *int `yaml:"expectation,omitempty"`     // an --expect-zero or --fail-on condition matched
+	BudgetExceeded *int `yaml:"budget_exceeded,omitempty"` // more new signals than --budget
+	Secrets        *int `yaml:"secrets,omitempty"`         // a committed secret was found
+}
+
+// LabelRuleConfig maps matching signals to labels. A signal matches when
+// its kind, one of its tags, or its collector is listed. Labels applies to
+// every exporter; a non-empty exporter-specific list replaces it for that
+// exporter.
+type LabelRuleConfig struct {
+	Kinds      []string `yaml:"kinds,omitempty"` // globs allowed, e.g. "*-dependency"
+	Tags       []string `yaml:"tags,omitempty"`
+	Collectors []string `yaml:"collectors,omitempty"`
+
+	Labels []string `yaml:"labels,omitempty"`
+	Beads  []string `yaml:"beads,omitempty"`
+	GitHub []string `yaml:"github,omitempty"`
+	SARIF  []string `yaml:"sarif,omitempty"`
+	Tasks  []string `yaml:"tasks,omitempty"`
 }
 
 // PriorityOverrideConfig maps a file-path glob pattern to a fixed priority.
@@ -46,6 +223,7 @@ type CollectorConfig struct {
 	MaxIssuesPerCollector int    `yaml:"max_issues_per_collector,omitempty"`
 	IncludeClosed         *bool  `yaml:"include_closed,omitempty"`
 	HistoryDepth          string `yaml:"history_depth,omitempty"`
+	LargeBatchThreshold   int    `yaml:"large_batch_threshold,omitempty"`
 
 	// Anonymization settings.
 	Anonymize string `yaml:"anonymize,omitempty"`
@@ -82,9 +260,55 @@ type CollectorConfig struct {
 	SecretAllowlist      []string              `yaml:"secret_allowlist,omitempty"`
 	EntropyDetection     *bool                 `yaml:"entropy_detection,omitempty"`
 
+	// TODO collector settings: where tracker keys such as PROJ-456 in
+	// TODO(PROJ-456) link to, e.g. https://acme.atlassian.net/browse, and
+	// whether to blame the function enclosing each TODO as well as its line.
+	TrackerURL    string `yaml:"tracker_url,omitempty"`
+	FunctionBlame *bool  `yaml:"function_blame,omitempty"`
+
+	// Per-file scan guards for line scanners (todos, patterns).
+	MaxFileSize int64  `yaml:"max_file_size,omitempty"`
+	FileTimeout string `yaml:"file_timeout,omitempty"`
+
 	// Patterns collector test-ratio settings.
 	TestRatioThreshold float64 `yaml:"test_ratio_threshold,omitempty"`
 	TestRatioMinFiles  int     `yaml:"test_ratio_min_files,omitempty"`
+
+	// Test timing collector settings. Durations are strings (e.g. "2s").
+	TestReports       []string          `yaml:"test_reports,omitempty"`
+	SlowTestThreshold string            `yaml:"slow_test_threshold,omitempty"`
+	SlowTestBudgets   map[string]string `yaml:"slow_test_budgets,omitempty"`
+
+	// Architecture collector settings.
+	ArchitectureRules string `yaml:"architecture_rules,omitempty"`
+
+	// Lint collector settings.
+	LintReports []string `yaml:"lint_reports,omitempty"`
+	LintTools   []string `yaml:"lint_tools,omitempty"` // vet, staticcheck
+
+	// Lint report collector settings.
+	LintReportDir string `yaml:"lint_report_dir,omitempty"`
+
+	// Coverage collector settings.
+	CoverageReports   []string `yaml:"coverage_reports,omitempty"`
+	CoverageThreshold float64  `yaml:"coverage_threshold,omitempty"`
+
+	// Deprecation collector settings.
+	DeprecatedAPIs []DeprecatedAPIConfig `yaml:"deprecated_apis,omitempty"`
+
+	// Bench collector settings: the baseline commit or ref, and the relative
+	// growth reported as a regression.
+	BenchBase      string  `yaml:"bench_base,omitempty"`
+	BenchThreshold float64 `yaml:"bench_threshold,omitempty"`
+}
+
+// DeprecatedAPIConfig lists one deprecated API for the deprecation
+// collector: a Go symbol ("import/path.Name") or a line regex, with an
+// optional replacement hint.
+type DeprecatedAPIConfig struct {
+	Symbol      string `yaml:"symbol,omitempty"`
+	Pattern     string `yaml:"pattern,omitempty"`
+	Replacement string `yaml:"replacement,omitempty"`
 }
 
 // SecretPatternConfig holds a user-defined secret pattern from .stringer.yaml.
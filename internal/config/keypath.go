@@ -82,14 +82,54 @@ func ValidateKeyPath(keyPath string) error {
 	topKeys := yamlKeys(reflect.TypeOf(Config{}))
 	first := parts[0]
 
-	if first == "priority_overrides" {
-		return fmt.Errorf("priority_overrides cannot be set via config set; edit %s directly", FileName)
+	if first == "priority_overrides" || first == "identities" || first == "scoring_profiles" || first == "plugins" || first == "rules" {
+		return fmt.Errorf("%s cannot be set via config set; edit %s directly", first, FileName)
 	}
 
 	if _, ok := topKeys[first]; !ok {
 		return fmt.Errorf("unknown key %q; valid top-level keys: %s", first, sortedKeys(topKeys))
 	}
 
+	if first == "exit_codes" && len(parts) == 2 {
+		ecKeys := yamlKeys(reflect.TypeOf(ExitCodesConfig{}))
+		if _, ok := ecKeys[parts[1]]; !ok {
+			return fmt.Errorf("unknown exit code condition %q; valid conditions: %s", parts[1], sortedKeys(ecKeys))
+		}
+		return nil
+	}
+
+	if first == "hotspots" && len(parts) == 2 {
+		hsKeys := yamlKeys(reflect.TypeOf(HotspotsConfig{}))
+		if _, ok := hsKeys[parts[1]]; !ok {
+			return fmt.Errorf("unknown hotspots field %q; valid fields: %s", parts[1], sortedKeys(hsKeys))
+		}
+		return nil
+	}
+
+	if first == "llm" && len(parts) == 2 {
+		lKeys := yamlKeys(reflect.TypeOf(LLMConfig{}))
+		if _, ok := lKeys[parts[1]]; !ok {
+			return fmt.Errorf("unknown llm field %q; valid fields: %s", parts[1], sortedKeys(lKeys))
+		}
+		return nil
+	}
+
+	if first == "epics" && len(parts) == 2 {
+		eKeys := yamlKeys(reflect.TypeOf(EpicsConfig{}))
+		if _, ok := eKeys[parts[1]]; !ok {
+			return fmt.Errorf("unknown epics field %q; valid fields: %s", parts[1], sortedKeys(eKeys))
+		}
+		return nil
+	}
+
+	if first == "priority" && len(parts) == 2 {
+		pKeys := yamlKeys(reflect.TypeOf(PriorityConfig{}))
+		if _, ok := pKeys[parts[1]]; !ok {
+			return fmt.Errorf("unknown priority field %q; valid fields: %s", parts[1], sortedKeys(pKeys))
+		}
+		return nil
+	}
+
 	if first != "collectors" {
 		if len(parts) > 1 {
 			return fmt.Errorf("key %q is a scalar; cannot use sub-keys", first)
@@ -198,6 +198,54 @@ func TestValidateKeyPath_PriorityOverrides(t *testing.T) {
 	assert.Contains(t, err.Error(), "edit")
 }
 
+func TestValidateKeyPath_Identities(t *testing.T) {
+	err := ValidateKeyPath("identities")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "identities cannot be set")
+}
+
+func TestValidateKeyPath_ExitCodes(t *testing.T) {
+	assert.NoError(t, ValidateKeyPath("exit_codes.secrets"))
+	err := ValidateKeyPath("exit_codes.warnings")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "unknown exit code condition")
+}
+
+func TestValidateKeyPath_Hotspots(t *testing.T) {
+	assert.NoError(t, ValidateKeyPath("hotspots.min_changes"))
+	err := ValidateKeyPath("hotspots.threshold")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "unknown hotspots field")
+}
+
+func TestValidateKeyPath_Epics(t *testing.T) {
+	assert.NoError(t, ValidateKeyPath("epics.module_min_size"))
+	err := ValidateKeyPath("epics.max_size")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "unknown epics field")
+}
+
+func TestValidateKeyPath_LLM(t *testing.T) {
+	assert.NoError(t, ValidateKeyPath("llm.provider"))
+	err := ValidateKeyPath("llm.api_key")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "unknown llm field")
+}
+
+func TestValidateKeyPath_Priority(t *testing.T) {
+	assert.NoError(t, ValidateKeyPath("priority.age_days"))
+	err := ValidateKeyPath("priority.p0")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "unknown priority field")
+}
+
+func TestValidateKeyPath_ScoringProfiles(t *testing.T) {
+	assert.NoError(t, ValidateKeyPath("scoring_profile"))
+	err := ValidateKeyPath("scoring_profiles.ours")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "cannot be set via config set")
+}
+
 func TestValidateKeyPath_CollectorsNoName(t *testing.T) {
 	err := ValidateKeyPath("collectors")
 	assert.Error(t, err)
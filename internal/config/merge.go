@@ -6,6 +6,11 @@ package config
 import (
 	"time"
 
+	"github.com/davetashner/stringer/internal/analysis"
+	"github.com/davetashner/stringer/internal/labels"
+	"github.com/davetashner/stringer/internal/priority"
+	"github.com/davetashner/stringer/internal/rules"
+	"github.com/davetashner/stringer/internal/scoring"
 	"github.com/davetashner/stringer/internal/signal"
 )
 
@@ -29,6 +34,11 @@ func Merge(fileCfg *Config, cliCfg signal.ScanConfig) signal.ScanConfig {
 		result.NoLLM = true
 	}
 
+	// Identities: CLI wins if set.
+	if result.Identities == nil && len(fileCfg.Identities) > 0 {
+		result.Identities = fileCfg.Identities
+	}
+
 	// Per-collector opts: merge file config into CLI config.
 	if len(fileCfg.Collectors) > 0 {
 		if result.CollectorOpts == nil {
@@ -72,6 +82,9 @@ func Merge(fileCfg *Config, cliCfg signal.ScanConfig) signal.ScanConfig {
 			if co.MaxIssues == 0 && fc.MaxIssuesPerCollector > 0 {
 				co.MaxIssues = fc.MaxIssuesPerCollector
 			}
+			if co.LargeBatchThreshold == 0 && fc.LargeBatchThreshold > 0 {
+				co.LargeBatchThreshold = fc.LargeBatchThreshold
+			}
 			if co.Timeout == 0 && fc.Timeout != "" {
 				if d, err := time.ParseDuration(fc.Timeout); err == nil {
 					co.Timeout = d
@@ -133,9 +146,181 @@ func Merge(fileCfg *Config, cliCfg signal.ScanConfig) signal.ScanConfig {
 			if co.TestRatioMinFiles == 0 && fc.TestRatioMinFiles > 0 {
 				co.TestRatioMinFiles = fc.TestRatioMinFiles
 			}
+			if co.MaxFileSize == 0 && fc.MaxFileSize > 0 {
+				co.MaxFileSize = fc.MaxFileSize
+			}
+			if co.FileTimeout == 0 && fc.FileTimeout != "" {
+				if d, err := time.ParseDuration(fc.FileTimeout); err == nil {
+					co.FileTimeout = d
+				}
+			}
+			if len(co.TestReports) == 0 && len(fc.TestReports) > 0 {
+				co.TestReports = fc.TestReports
+			}
+			if co.SlowTestThreshold == 0 && fc.SlowTestThreshold != "" {
+				if d, err := time.ParseDuration(fc.SlowTestThreshold); err == nil {
+					co.SlowTestThreshold = d
+				}
+			}
+			if co.SlowTestBudgets == nil && len(fc.SlowTestBudgets) > 0 {
+				co.SlowTestBudgets = make(map[string]time.Duration, len(fc.SlowTestBudgets))
+				for pkg, budget := range fc.SlowTestBudgets {
+					if d, err := time.ParseDuration(budget); err == nil {
+						co.SlowTestBudgets[pkg] = d
+					}
+				}
+			}
+			if co.ArchitectureRules == "" && fc.ArchitectureRules != "" {
+				co.ArchitectureRules = fc.ArchitectureRules
+			}
+			if len(co.LintReports) == 0 && len(fc.LintReports) > 0 {
+				co.LintReports = fc.LintReports
+			}
+			if len(co.LintTools) == 0 && len(fc.LintTools) > 0 {
+				co.LintTools = fc.LintTools
+			}
+			if co.LintReportDir == "" && fc.LintReportDir != "" {
+				co.LintReportDir = fc.LintReportDir
+			}
+			if len(co.CoverageReports) == 0 && len(fc.CoverageReports) > 0 {
+				co.CoverageReports = fc.CoverageReports
+			}
+			if co.CoverageThreshold == 0 && fc.CoverageThreshold > 0 {
+				co.CoverageThreshold = fc.CoverageThreshold
+			}
+			if !co.FunctionBlame && fc.FunctionBlame != nil && *fc.FunctionBlame {
+				co.FunctionBlame = true
+			}
+			if len(co.DeprecatedAPIs) == 0 && len(fc.DeprecatedAPIs) > 0 {
+				for _, api := range fc.DeprecatedAPIs {
+					co.DeprecatedAPIs = append(co.DeprecatedAPIs, signal.DeprecatedAPIConfig{
+						Symbol:      api.Symbol,
+						Pattern:     api.Pattern,
+						Replacement: api.Replacement,
+					})
+				}
+			}
+			if co.BenchBase == "" && fc.BenchBase != "" {
+				co.BenchBase = fc.BenchBase
+			}
+			if co.BenchThreshold == 0 && fc.BenchThreshold > 0 {
+				co.BenchThreshold = fc.BenchThreshold
+			}
 			result.CollectorOpts[name] = co
 		}
 	}
 
 	return result
 }
+
+// LabelMap converts the configured label rules into a labels.Map. It
+// returns nil when no rules are configured.
+func LabelMap(cfg *Config) labels.Map {
+	if cfg == nil || len(cfg.Labels) == 0 {
+		return nil
+	}
+	m := make(labels.Map, 0, len(cfg.Labels))
+	for _, r := range cfg.Labels {
+		m = append(m, labels.Rule{
+			Kinds:      r.Kinds,
+			Tags:       r.Tags,
+			Collectors: r.Collectors,
+			Labels:     r.Labels,
+			Exporters: map[string][]string{
+				labels.Beads:  r.Beads,
+				labels.GitHub: r.GitHub,
+				labels.SARIF:  r.SARIF,
+				labels.Tasks:  r.Tasks,
+			},
+		})
+	}
+	return m
+}
+
+// Priority converts the priority settings into a priority.Config, filling
+// in the defaults for unset fields. It reports false when priorities are
+// disabled.
+func Priority(cfg *Config) (priority.Config, bool) {
+	pc := priority.DefaultConfig()
+	if cfg == nil || cfg.Priority == nil {
+		return pc, true
+	}
+	p := cfg.Priority
+	if p.P1 != 0 {
+		pc.P1 = p.P1
+	}
+	if p.P2 != 0 {
+		pc.P2 = p.P2
+	}
+	if p.P3 != 0 {
+		pc.P3 = p.P3
+	}
+	if p.AgeWeight != nil {
+		pc.AgeWeight = *p.AgeWeight
+	}
+	if p.AgeDays != 0 {
+		pc.AgeDays = p.AgeDays
+	}
+	if p.HotspotWeight != nil {
+		pc.HotspotWeight = *p.HotspotWeight
+	}
+	return pc, p.Enabled == nil || *p.Enabled
+}
+
+// Epics converts the epic settings into an analysis.EpicConfig. It reports
+// whether the config turns epics on for every scan.
+func Epics(cfg *Config) (analysis.EpicConfig, bool) {
+	if cfg == nil || cfg.Epics == nil {
+		return analysis.EpicConfig{}, false
+	}
+	e := cfg.Epics
+	return analysis.EpicConfig{
+		ModuleMinSize:  e.ModuleMinSize,
+		ModuleDepth:    e.ModuleDepth,
+		KindSimilarity: e.KindSimilarity,
+	}, e.Enabled
+}
+
+// Rules converts the configured rules into a rules.Set. It returns nil when
+// no rules are configured.
+func Rules(cfg *Config) (rules.Set, error) {
+	if cfg == nil || len(cfg.Rules) == 0 {
+		return nil, nil
+	}
+	rs := make([]rules.Rule, 0, len(cfg.Rules))
+	for _, r := range cfg.Rules {
+		rs = append(rs, rules.Rule{
+			Match: rules.Match{
+				Kinds:          r.Match.Kinds,
+				Paths:          r.Match.Paths,
+				Authors:        r.Match.Authors,
+				FunctionOwners: r.Match.FunctionOwners,
+				Tags:           r.Match.Tags,
+				Collectors:     r.Match.Collectors,
+				MinConfidence:  r.Match.MinConfidence,
+				MaxConfidence:  r.Match.MaxConfidence,
+			},
+			AddTags:     r.AddTags,
+			SetPriority: r.SetPriority,
+			MinPriority: r.MinPriority,
+			Output:      r.Output,
+			Drop:        r.Drop,
+		})
+	}
+	return rules.Compile(rs)
+}
+
+// ScoringProfile resolves name against the built-in profiles and the custom
+// ones configured in profiles. An empty name selects the default profile,
+// which leaves confidence unchanged.
+func ScoringProfile(name string, profiles map[string]ScoringProfileConfig) (scoring.Profile, error) {
+	custom := make(map[string]scoring.Profile, len(profiles))
+	for n, pc := range profiles {
+		p := scoring.Profile{Name: n, Description: pc.Description}
+		for _, w := range pc.Weights {
+			p.Weights = append(p.Weights, scoring.Weight{Kinds: w.Kinds, Tags: w.Tags, Factor: w.Factor})
+		}
+		custom[n] = p
+	}
+	return scoring.Resolve(name, custom)
+}
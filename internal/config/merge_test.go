@@ -8,7 +8,12 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
+	"github.com/davetashner/stringer/internal/analysis"
+	"github.com/davetashner/stringer/internal/labels"
+	"github.com/davetashner/stringer/internal/priority"
+	"github.com/davetashner/stringer/internal/scoring"
 	"github.com/davetashner/stringer/internal/signal"
 )
 
@@ -54,6 +59,117 @@ func TestMerge_EmptyFileConfig(t *testing.T) {
 	assert.Equal(t, 5, result.MaxIssues)
 }
 
+func TestMerge_Identities(t *testing.T) {
+	fileCfg := &Config{
+		Identities: map[string][]string{"Alice": {"alice@old.example.com"}},
+	}
+
+	result := Merge(fileCfg, signal.ScanConfig{})
+	assert.Equal(t, fileCfg.Identities, result.Identities)
+
+	cli := map[string][]string{"Bob": {"bob@old.example.com"}}
+	result = Merge(fileCfg, signal.ScanConfig{Identities: cli})
+	assert.Equal(t, cli, result.Identities)
+}
+
+func TestMerge_FileScanGuards(t *testing.T) {
+	fileCfg := &Config{
+		Collectors: map[string]CollectorConfig{
+			"todos": {MaxFileSize: 1 << 20, FileTimeout: "5s"},
+		},
+	}
+
+	result := Merge(fileCfg, signal.ScanConfig{})
+	assert.Equal(t, int64(1<<20), result.CollectorOpts["todos"].MaxFileSize)
+	assert.Equal(t, 5*time.Second, result.CollectorOpts["todos"].FileTimeout)
+}
+
+func TestMerge_TestTiming(t *testing.T) {
+	fileCfg := &Config{
+		Collectors: map[string]CollectorConfig{
+			"testtiming": {
+				TestReports:       []string{"reports/*.xml"},
+				SlowTestThreshold: "1500ms",
+				SlowTestBudgets:   map[string]string{"example.com/m/slow": "30s"},
+			},
+		},
+	}
+
+	result := Merge(fileCfg, signal.ScanConfig{})
+	co := result.CollectorOpts["testtiming"]
+	assert.Equal(t, []string{"reports/*.xml"}, co.TestReports)
+	assert.Equal(t, 1500*time.Millisecond, co.SlowTestThreshold)
+	assert.Equal(t, map[string]time.Duration{"example.com/m/slow": 30 * time.Second}, co.SlowTestBudgets)
+}
+
+func TestMerge_Lint(t *testing.T) {
+	fileCfg := &Config{
+		Collectors: map[string]CollectorConfig{
+			"lint":        {LintReports: []string{"lint/*.json"}, LintTools: []string{"vet"}},
+			"lint-report": {LintReportDir: "ci/reports"},
+		},
+	}
+
+	result := Merge(fileCfg, signal.ScanConfig{})
+	co := result.CollectorOpts["lint"]
+	assert.Equal(t, []string{"lint/*.json"}, co.LintReports)
+	assert.Equal(t, []string{"vet"}, co.LintTools)
+	assert.Equal(t, "ci/reports", result.CollectorOpts["lint-report"].LintReportDir)
+}
+
+func TestMerge_Coverage(t *testing.T) {
+	fileCfg := &Config{
+		Collectors: map[string]CollectorConfig{
+			"coverage": {CoverageReports: []string{"coverage.out", "web/lcov.info"}, CoverageThreshold: 0.7},
+		},
+	}
+
+	result := Merge(fileCfg, signal.ScanConfig{})
+	co := result.CollectorOpts["coverage"]
+	assert.Equal(t, []string{"coverage.out", "web/lcov.info"}, co.CoverageReports)
+	assert.InDelta(t, 0.7, co.CoverageThreshold, 1e-9)
+}
+
+func TestMerge_Bench(t *testing.T) {
+	fileCfg := &Config{
+		Collectors: map[string]CollectorConfig{
+			"bench": {BenchBase: "origin/main", BenchThreshold: 0.2},
+		},
+	}
+
+	result := Merge(fileCfg, signal.ScanConfig{})
+	co := result.CollectorOpts["bench"]
+	assert.Equal(t, "origin/main", co.BenchBase)
+	assert.InDelta(t, 0.2, co.BenchThreshold, 1e-9)
+}
+
+func TestMerge_DeprecatedAPIs(t *testing.T) {
+	fileCfg := &Config{
+		Collectors: map[string]CollectorConfig{
+			"deprecation": {DeprecatedAPIs: []DeprecatedAPIConfig{
+				{Symbol: "github.com/pkg/errors.Wrap", Replacement: "fmt.Errorf with %w"},
+				{Pattern: `\$\.ajax\(`},
+			}},
+		},
+	}
+	result := Merge(fileCfg, signal.ScanConfig{})
+	assert.Equal(t, []signal.DeprecatedAPIConfig{
+		{Symbol: "github.com/pkg/errors.Wrap", Replacement: "fmt.Errorf with %w"},
+		{Pattern: `\$\.ajax\(`},
+	}, result.CollectorOpts["deprecation"].DeprecatedAPIs)
+}
+
+func TestMerge_ArchitectureRules(t *testing.T) {
+	fileCfg := &Config{
+		Collectors: map[string]CollectorConfig{
+			"architecture": {ArchitectureRules: "docs/layers.yaml"},
+		},
+	}
+
+	result := Merge(fileCfg, signal.ScanConfig{})
+	assert.Equal(t, "docs/layers.yaml", result.CollectorOpts["architecture"].ArchitectureRules)
+}
+
 func TestMerge_PerCollectorOpts(t *testing.T) {
 	fileCfg := &Config{
 		Collectors: map[string]CollectorConfig{
@@ -481,6 +597,9 @@ func TestMerge_ConfigurableThresholdsFromFile(t *testing.T) {
 			"githygiene": {
 				LargeBinaryThreshold: 500000,
 			},
+			"github": {
+				LargeBatchThreshold: 800,
+			},
 			"patterns": {
 				TestRatioThreshold: 0.25,
 				TestRatioMinFiles:  5,
@@ -500,6 +619,7 @@ func TestMerge_ConfigurableThresholdsFromFile(t *testing.T) {
 	assert.Equal(t, 365, result.CollectorOpts["docstale"].DocStaleDays)
 	assert.Equal(t, 20, result.CollectorOpts["docstale"].DocDriftMinCommits)
 	assert.Equal(t, 500000, result.CollectorOpts["githygiene"].LargeBinaryThreshold)
+	assert.Equal(t, 800, result.CollectorOpts["github"].LargeBatchThreshold)
 	assert.InDelta(t, 0.25, result.CollectorOpts["patterns"].TestRatioThreshold, 0.001)
 	assert.Equal(t, 5, result.CollectorOpts["patterns"].TestRatioMinFiles)
 }
@@ -519,3 +639,77 @@ func TestMerge_ConfigurableThresholdsCLIOverride(t *testing.T) {
 	result := Merge(fileCfg, cliCfg)
 	assert.Equal(t, 8, result.CollectorOpts["duplication"].DuplicationWindowSize)
 }
+
+func TestLabelMap(t *testing.T) {
+	assert.Nil(t, LabelMap(nil))
+	assert.Nil(t, LabelMap(&Config{}))
+
+	m := LabelMap(&Config{Labels: []LabelRuleConfig{
+		{Tags: []string{"security"}, Labels: []string{"security"}, GitHub: []string{"sec", "triage"}},
+	}})
+	sig := signal.RawSignal{Tags: []string{"security"}}
+	assert.Equal(t, []string{"security"}, m.For(labels.Beads, sig))
+	assert.Equal(t, []string{"sec", "triage"}, m.For(labels.GitHub, sig))
+}
+
+func TestRules(t *testing.T) {
+	set, err := Rules(nil)
+	require.NoError(t, err)
+	assert.Nil(t, set)
+
+	set, err = Rules(&Config{Rules: []RuleConfig{
+		{Match: RuleMatchConfig{Paths: []string{"internal/auth/**"}, MinConfidence: 0.5}, AddTags: []string{"security"}, Output: "sec.jsonl"},
+	}})
+	require.NoError(t, err)
+	require.Len(t, set, 1)
+	assert.Equal(t, []string{"internal/auth/**"}, set[0].Match.Paths)
+	assert.Equal(t, 0.5, set[0].Match.MinConfidence)
+	assert.Equal(t, "sec.jsonl", set[0].Output)
+
+	kept, _ := set.Apply([]signal.RawSignal{{FilePath: "internal/auth/a.go", Confidence: 0.6}})
+	assert.Equal(t, []string{"security"}, kept[0].Tags)
+}
+
+func TestEpics(t *testing.T) {
+	ec, ok := Epics(nil)
+	assert.False(t, ok, "epics are opt-in")
+	assert.Equal(t, analysis.EpicConfig{}, ec)
+
+	ec, ok = Epics(&Config{Epics: &EpicsConfig{Enabled: true, ModuleMinSize: 8, ModuleDepth: 3, KindSimilarity: 0.5}})
+	assert.True(t, ok)
+	assert.Equal(t, analysis.EpicConfig{ModuleMinSize: 8, ModuleDepth: 3, KindSimilarity: 0.5}, ec)
+}
+
+func TestPriority(t *testing.T) {
+	pc, ok := Priority(nil)
+	assert.True(t, ok)
+	assert.Equal(t, priority.DefaultConfig(), pc)
+
+	off, zero := false, 0.0
+	pc, ok = Priority(&Config{Priority: &PriorityConfig{Enabled: &off, P1: 0.9, AgeWeight: &zero, AgeDays: 30}})
+	assert.False(t, ok)
+	assert.Equal(t, 0.9, pc.P1)
+	assert.Equal(t, 0.6, pc.P2, "unset thresholds keep the defaults")
+	assert.Zero(t, pc.AgeWeight, "an explicit zero weight turns the boost off")
+	assert.Equal(t, 30, pc.AgeDays)
+	assert.Equal(t, 0.1, pc.HotspotWeight)
+}
+
+func TestScoringProfile(t *testing.T) {
+	p, err := ScoringProfile("", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "default", p.Name)
+
+	p, err = ScoringProfile("ours", map[string]ScoringProfileConfig{
+		"ours": {Description: "our focus", Weights: []ScoringWeightConfig{{Kinds: []string{"todo"}, Tags: []string{"api"}, Factor: 0.5}}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, scoring.Profile{
+		Name:        "ours",
+		Description: "our focus",
+		Weights:     []scoring.Weight{{Kinds: []string{"todo"}, Tags: []string{"api"}, Factor: 0.5}},
+	}, p)
+
+	_, err = ScoringProfile("theirs", nil)
+	assert.ErrorContains(t, err, `unknown scoring profile "theirs"`)
+}
@@ -0,0 +1,397 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// SchemaID identifies the published JSON Schema for .stringer.yaml.
+const SchemaID = "https://raw.githubusercontent.com/davetashner/stringer/main/docs/stringer.schema.json"
+
+// schemaEnums lists the allowed values of string fields, keyed by yaml name.
+// Values checked against registries (output_format, collector names) are
+// left to Validate so the schema does not drift from the code.
+var schemaEnums = map[string][]string{
+	"error_mode": {"warn", "skip", "fail"},
+	"anonymize":  {"auto", "always", "never"},
+	"lint_tools": {"vet", "staticcheck"},
+	"provider":   {"anthropic", "openai", "ollama"},
+	"fs":         {"read"},
+}
+
+// Schema returns the JSON Schema (draft 2020-12) for .stringer.yaml,
+// derived from the Config struct so it cannot fall behind the fields the
+// loader accepts.
+func Schema() ([]byte, error) {
+	s := typeSchema(reflect.TypeOf(Config{}), "")
+	s["$schema"] = "https://json-schema.org/draft/2020-12/schema"
+	s["$id"] = SchemaID
+	s["title"] = "Stringer configuration (" + FileName + ")"
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(data, '\n'), nil
+}
+
+// typeSchema returns the schema for values of type t. name is the yaml key
+// the value sits under, used to attach enums.
+func typeSchema(t reflect.Type, name string) map[string]any {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.Struct:
+		props := make(map[string]any)
+		for i := range t.NumField() {
+			key := yamlName(t.Field(i))
+			if key == "" {
+				continue
+			}
+			props[key] = typeSchema(t.Field(i).Type, key)
+		}
+		return map[string]any{"type": "object", "properties": props, "additionalProperties": false}
+	case reflect.Map:
+		return map[string]any{"type": "object", "additionalProperties": typeSchema(t.Elem(), "")}
+	case reflect.Slice:
+		return map[string]any{"type": "array", "items": typeSchema(t.Elem(), name)}
+	case reflect.Bool:
+		return map[string]any{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return map[string]any{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return map[string]any{"type": "number"}
+	default:
+		s := map[string]any{"type": "string"}
+		if enum, ok := schemaEnums[name]; ok {
+			s["enum"] = enum
+		}
+		return s
+	}
+}
+
+// yamlName returns the yaml key of a struct field, or "" if it has none.
+func yamlName(f reflect.StructField) string {
+	tag := f.Tag.Get("yaml")
+	if tag == "" || tag == "-" {
+		return ""
+	}
+	return strings.Split(tag, ",")[0]
+}
+
+// FieldError is a config problem tied to a position in the file.
+type FieldError struct {
+	Line    int    // 1-based; 0 when the position is unknown
+	Column  int    // 1-based; 0 when the position is unknown
+	Path    string // dot-notation key path, e.g. collectors.todos.min_confidence
+	Message string
+}
+
+// Error formats the problem as line:column: path: message.
+func (e FieldError) Error() string {
+	var b strings.Builder
+	if e.Line > 0 {
+		fmt.Fprintf(&b, "%d:%d: ", e.Line, e.Column)
+	}
+	if e.Path != "" {
+		b.WriteString(e.Path + ": ")
+	}
+	b.WriteString(e.Message)
+	return b.String()
+}
+
+// ValidateFile checks the config file at path against the schema and the
+// semantic rules of Validate, returning every problem with the line and
+// column it was found at. A missing file is an error; an empty file is
+// valid.
+func ValidateFile(path string) ([]FieldError, error) {
+	data, err := os.ReadFile(path) //nolint:gosec // user-provided path
+	if err != nil {
+		return nil, err
+	}
+	return ValidateBytes(data), nil
+}
+
+// yamlErrLine extracts the line from a yaml.v3 error message.
+var yamlErrLine = regexp.MustCompile(`^(?:yaml: )?line (\d+): (.*)$`)
+
+// ValidateBytes is ValidateFile for config content already in memory.
+func ValidateBytes(data []byte) []FieldError {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return []FieldError{yamlError(err)}
+	}
+	if len(doc.Content) == 0 {
+		return nil
+	}
+	root := doc.Content[0]
+
+	var errs []FieldError
+	checkNode(root, reflect.TypeOf(Config{}), "", "", &errs)
+	if len(errs) > 0 {
+		// Semantic checks on a partly decoded file would repeat the
+		// structural problems in vaguer terms.
+		return errs
+	}
+
+	var cfg Config
+	if err := root.Decode(&cfg); err != nil {
+		return []FieldError{yamlError(err)}
+	}
+	for _, msg := range validationErrors(&cfg) {
+		keyPath, detail, _ := strings.Cut(msg, ": ")
+		line, col := locate(root, keyPath)
+		errs = append(errs, FieldError{Line: line, Column: col, Path: keyPath, Message: detail})
+	}
+	sort.SliceStable(errs, func(i, j int) bool {
+		if errs[i].Line != errs[j].Line {
+			return errs[i].Line < errs[j].Line
+		}
+		return errs[i].Column < errs[j].Column
+	})
+	return errs
+}
+
+// yamlError converts a yaml.v3 parse or decode error into a FieldError.
+func yamlError(err error) FieldError {
+	msg := err.Error()
+	var te *yaml.TypeError
+	if errors.As(err, &te) && len(te.Errors) > 0 {
+		msg = te.Errors[0]
+	}
+	if m := yamlErrLine.FindStringSubmatch(msg); m != nil {
+		line, _ := strconv.Atoi(m[1])
+		return FieldError{Line: line, Column: 1, Message: m[2]}
+	}
+	return FieldError{Message: strings.TrimPrefix(msg, "yaml: ")}
+}
+
+// checkNode walks n against type t, recording unknown keys, duplicate keys,
+// and values of the wrong type. name is the yaml key n sits under.
+func checkNode(n *yaml.Node, t reflect.Type, keyPath, name string, errs *[]FieldError) {
+	if n.Kind == yaml.AliasNode {
+		n = n.Alias
+	}
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if n.Kind == yaml.ScalarNode && n.Tag == "!!null" {
+		return
+	}
+	fail := func(format string, args ...any) {
+		*errs = append(*errs, FieldError{Line: n.Line, Column: n.Column, Path: keyPath, Message: fmt.Sprintf(format, args...)})
+	}
+
+	switch t.Kind() {
+	case reflect.Struct, reflect.Map:
+		if n.Kind != yaml.MappingNode {
+			fail("expected a mapping, got %s", describeNode(n))
+			return
+		}
+		var fields map[string]reflect.StructField
+		if t.Kind() == reflect.Struct {
+			fields = make(map[string]reflect.StructField, t.NumField())
+			for i := range t.NumField() {
+				if key := yamlName(t.Field(i)); key != "" {
+					fields[key] = t.Field(i)
+				}
+			}
+		}
+		seen := make(map[string]int)
+		for i := 0; i+1 < len(n.Content); i += 2 {
+			k, v := n.Content[i], n.Content[i+1]
+			childPath := joinKeyPath(keyPath, k.Value)
+			if first, dup := seen[k.Value]; dup {
+				*errs = append(*errs, FieldError{Line: k.Line, Column: k.Column, Path: childPath,
+					Message: fmt.Sprintf("duplicate key (first defined on line %d)", first)})
+				continue
+			}
+			seen[k.Value] = k.Line
+			if fields == nil {
+				checkNode(v, t.Elem(), childPath, "", errs)
+				continue
+			}
+			f, ok := fields[k.Value]
+			if !ok {
+				msg := "unknown key"
+				if hint := closestKey(k.Value, fields); hint != "" {
+					msg += fmt.Sprintf("; did you mean %q?", hint)
+				}
+				*errs = append(*errs, FieldError{Line: k.Line, Column: k.Column, Path: childPath, Message: msg})
+				continue
+			}
+			checkNode(v, f.Type, childPath, k.Value, errs)
+		}
+	case reflect.Slice:
+		if n.Kind != yaml.SequenceNode {
+			fail("expected a list, got %s", describeNode(n))
+			return
+		}
+		for i, item := range n.Content {
+			checkNode(item, t.Elem(), fmt.Sprintf("%s[%d]", keyPath, i), name, errs)
+		}
+	default:
+		if n.Kind != yaml.ScalarNode {
+			fail("expected %s, got %s", scalarKind(t), describeNode(n))
+			return
+		}
+		switch t.Kind() {
+		case reflect.Bool:
+			if n.Tag != "!!bool" {
+				fail("expected true or false, got %q", n.Value)
+			}
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			if n.Tag != "!!int" {
+				fail("expected an integer, got %q", n.Value)
+			}
+		case reflect.Float32, reflect.Float64:
+			if n.Tag != "!!int" && n.Tag != "!!float" {
+				fail("expected a number, got %q", n.Value)
+			}
+		default:
+			if enum, ok := schemaEnums[name]; ok && !contains(enum, n.Value) {
+				fail("invalid value %q (must be %s)", n.Value, strings.Join(enum, ", "))
+			}
+		}
+	}
+}
+
+// locate returns the position of the deepest node along keyPath, so a
+// problem with a value points at its key. Keys may themselves contain dots
+// (Go package paths under slow_test_budgets), so the longest matching key
+// wins at each level.
+func locate(n *yaml.Node, keyPath string) (line, col int) {
+	line, col = n.Line, n.Column
+	rest := keyPath
+	for rest != "" {
+		if n.Kind == yaml.AliasNode {
+			n = n.Alias
+		}
+		switch n.Kind {
+		case yaml.MappingNode:
+			var key, val *yaml.Node
+			for i := 0; i+1 < len(n.Content); i += 2 {
+				k := n.Content[i].Value
+				if (rest == k || strings.HasPrefix(rest, k+".") || strings.HasPrefix(rest, k+"[")) &&
+					(key == nil || len(k) > len(key.Value)) {
+					key, val = n.Content[i], n.Content[i+1]
+				}
+			}
+			if key == nil {
+				return line, col
+			}
+			line, col = key.Line, key.Column
+			rest = strings.TrimPrefix(strings.TrimPrefix(rest, key.Value), ".")
+			n = val
+		case yaml.SequenceNode:
+			end := strings.Index(rest, "]")
+			if !strings.HasPrefix(rest, "[") || end < 0 {
+				return line, col
+			}
+			i, err := strconv.Atoi(rest[1:end])
+			if err != nil || i < 0 || i >= len(n.Content) {
+				return line, col
+			}
+			n = n.Content[i]
+			line, col = n.Line, n.Column
+			rest = strings.TrimPrefix(rest[end+1:], ".")
+		default:
+			return line, col
+		}
+	}
+	return line, col
+}
+
+// closestKey suggests the known key nearest to an unknown one, if any is
+// within a third of its length in edits.
+func closestKey(key string, fields map[string]reflect.StructField) string {
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	best, bestDist := "", max(len(key)/3, 1)+1
+	for _, name := range names {
+		if d := editDistance(key, name); d < bestDist {
+			best, bestDist = name, d
+		}
+	}
+	return best
+}
+
+// editDistance returns the Levenshtein distance between a and b.
+func editDistance(a, b string) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
+
+// describeNode names the kind of a YAML node for error messages.
+func describeNode(n *yaml.Node) string {
+	switch n.Kind {
+	case yaml.MappingNode:
+		return "a mapping"
+	case yaml.SequenceNode:
+		return "a list"
+	default:
+		return fmt.Sprintf("%q", n.Value)
+	}
+}
+
+// scalarKind names the scalar type t expects.
+func scalarKind(t reflect.Type) string {
+	switch t.Kind() {
+	case reflect.Bool:
+		return "true or false"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return "an integer"
+	case reflect.Float32, reflect.Float64:
+		return "a number"
+	default:
+		return "a string"
+	}
+}
+
+// joinKeyPath appends key to a dot-notation path.
+func joinKeyPath(prefix, key string) string {
+	if prefix == "" {
+		return key
+	}
+	return prefix + "." + key
+}
+
+// contains reports whether list holds s.
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,139 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package config
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchema_MatchesPublishedFile(t *testing.T) {
+	got, err := Schema()
+	require.NoError(t, err)
+	want, err := os.ReadFile("../../docs/stringer.schema.json")
+	require.NoError(t, err)
+	assert.Equal(t, string(want), string(got), "regenerate with: stringer config schema > docs/stringer.schema.json")
+}
+
+func TestSchema_Shape(t *testing.T) {
+	data, err := Schema()
+	require.NoError(t, err)
+	var s map[string]any
+	require.NoError(t, json.Unmarshal(data, &s))
+	prop := func(m any, keys ...string) any {
+		for _, k := range keys {
+			m = m.(map[string]any)[k]
+		}
+		return m
+	}
+
+	assert.Equal(t, "integer", prop(s, "properties", "max_issues", "type"))
+	assert.Equal(t, "array", prop(s, "properties", "plugins", "type"))
+	assert.Equal(t, "object", prop(s, "properties", "plugins", "items", "type"))
+	assert.Equal(t, false, prop(s, "properties", "plugins", "items", "additionalProperties"))
+	cc := prop(s, "properties", "collectors", "additionalProperties", "properties")
+	assert.Equal(t, "number", prop(cc, "min_confidence", "type"))
+	assert.Equal(t, "boolean", prop(cc, "enabled", "type"))
+	assert.Equal(t, []any{"warn", "skip", "fail"}, prop(cc, "error_mode", "enum"))
+}
+
+func TestValidateBytes_Valid(t *testing.T) {
+	data := []byte(`output_format: json
+collectors:
+  todos:
+    enabled: true
+    min_confidence: 0.5
+  gitlog:
+    git_depth: 100
+  testtiming:
+    slow_test_budgets:
+      github.com/acme/api: 2s
+`)
+	assert.Empty(t, ValidateBytes(data))
+	assert.Empty(t, ValidateBytes(nil), "an empty file is valid")
+}
+
+func TestValidateBytes_Structural(t *testing.T) {
+	data := []byte(`max_isues: 3
+collectors:
+  todos:
+    enabled: yes please
+    error_mode: loud
+  gitlog:
+    git_depth: many
+    git_depth: 5
+labels: nope
+`)
+	errs := ValidateBytes(data)
+	require.Len(t, errs, 6)
+
+	assert.Equal(t, FieldError{Line: 1, Column: 1, Path: "max_isues", Message: `unknown key; did you mean "max_issues"?`}, errs[0])
+	assert.Equal(t, 4, errs[1].Line)
+	assert.Equal(t, 14, errs[1].Column)
+	assert.Equal(t, "collectors.todos.enabled", errs[1].Path)
+	assert.Contains(t, errs[2].Message, `invalid value "loud"`)
+	assert.Equal(t, "collectors.gitlog.git_depth", errs[3].Path)
+	assert.Contains(t, errs[3].Message, "expected an integer")
+	assert.Equal(t, "duplicate key (first defined on line 7)", errs[4].Message)
+	assert.Equal(t, 8, errs[4].Line)
+	assert.Equal(t, FieldError{Line: 9, Column: 9, Path: "labels", Message: `expected a list, got "nope"`}, errs[5])
+}
+
+func TestValidateBytes_NoSuggestionForDistantKeys(t *testing.T) {
+	errs := ValidateBytes([]byte("frobnicate: true\n"))
+	require.Len(t, errs, 1)
+	assert.Equal(t, "unknown key", errs[0].Message)
+}
+
+func TestValidateBytes_WrongContainer(t *testing.T) {
+	errs := ValidateBytes([]byte("collectors:\n  todos: [a, b]\n"))
+	require.Len(t, errs, 1)
+	assert.Equal(t, "collectors.todos", errs[0].Path)
+	assert.Equal(t, "expected a mapping, got a list", errs[0].Message)
+	assert.Equal(t, 2, errs[0].Line)
+}
+
+func TestValidateBytes_SemanticLocated(t *testing.T) {
+	data := []byte(`collectors:
+  nosuch:
+    enabled: true
+  todos:
+    min_confidence: 1.5
+  testtiming:
+    slow_test_budgets:
+      github.com/acme/api: soon
+labels:
+  - kinds: [todo]
+`)
+	errs := ValidateBytes(data)
+	require.Len(t, errs, 4)
+
+	assert.Equal(t, FieldError{Line: 2, Column: 3, Path: "collectors.nosuch", Message: "unknown collector"}, errs[0])
+	assert.Equal(t, 5, errs[1].Line)
+	assert.Equal(t, "collectors.todos.min_confidence", errs[1].Path)
+	assert.Equal(t, 8, errs[2].Line, "keys containing dots are located")
+	assert.Equal(t, 10, errs[3].Line)
+	assert.Equal(t, "labels[0]", errs[3].Path)
+}
+
+func TestValidateBytes_SyntaxError(t *testing.T) {
+	errs := ValidateBytes([]byte("collectors:\n  todos:\n    enabled: true\n   bad: [\n"))
+	require.Len(t, errs, 1)
+	assert.Positive(t, errs[0].Line)
+	assert.Empty(t, errs[0].Path)
+}
+
+func TestValidateFile_Missing(t *testing.T) {
+	_, err := ValidateFile(t.TempDir() + "/" + FileName)
+	assert.Error(t, err)
+}
+
+func TestFieldError_Error(t *testing.T) {
+	assert.Equal(t, "3:5: collectors.todos: unknown key", FieldError{Line: 3, Column: 5, Path: "collectors.todos", Message: "unknown key"}.Error())
+	assert.Equal(t, "did not find expected key", FieldError{Message: "did not find expected key"}.Error())
+}
@@ -5,15 +5,34 @@ package config
 
 import (
 	"fmt"
+	"net/url"
+	"path"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/davetashner/stringer/internal/collector"
+	"github.com/davetashner/stringer/internal/i18n"
+	"github.com/davetashner/stringer/internal/llm"
 	"github.com/davetashner/stringer/internal/output"
+	"github.com/davetashner/stringer/internal/scoring"
 	"github.com/davetashner/stringer/internal/signal"
 )
 
 // Validate checks all fields in the config and returns all errors at once.
 func Validate(cfg *Config) error {
+	if errs := validationErrors(cfg); len(errs) > 0 {
+		return fmt.Errorf("config validation failed:\n  %s", strings.Join(errs, "\n  "))
+	}
+	return nil
+}
+
+// validationErrors returns one "key.path: problem" message per invalid
+// field.
+func validationErrors(cfg *Config) []string {
 	var errs []string
 
 	if cfg.OutputFormat != "" {
@@ -22,12 +41,24 @@ func Validate(cfg *Config) error {
 		}
 	}
 
+	if cfg.Lang != "" && !i18n.IsCatalogPath(cfg.Lang) {
+		if _, err := i18n.Load(cfg.Lang); err != nil {
+			errs = append(errs, fmt.Sprintf("lang: %v", err))
+		}
+	}
+
+	if cfg.CSVColumns != nil {
+		if _, err := output.ValidateCSVColumns(cfg.CSVColumns); err != nil {
+			errs = append(errs, fmt.Sprintf("csv_columns: %v", err))
+		}
+	}
+
 	if cfg.MaxIssues < 0 {
 		errs = append(errs, fmt.Sprintf("max_issues: must be non-negative, got %d", cfg.MaxIssues))
 	}
 
 	for name, cc := range cfg.Collectors {
-		if collector.Get(name) == nil {
+		if collector.Get(name) == nil && !declaresPlugin(cfg, name) {
 			errs = append(errs, fmt.Sprintf("collectors.%s: unknown collector", name))
 		}
 
@@ -64,6 +95,72 @@ func Validate(cfg *Config) error {
 			errs = append(errs, fmt.Sprintf("collectors.%s.max_issues_per_collector: must be non-negative, got %d", name, cc.MaxIssuesPerCollector))
 		}
 
+		if cc.LargeBatchThreshold < 0 {
+			errs = append(errs, fmt.Sprintf("collectors.%s.large_batch_threshold: must be non-negative, got %d", name, cc.LargeBatchThreshold))
+		}
+
+		if cc.TrackerURL != "" {
+			if u, err := url.Parse(cc.TrackerURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+				errs = append(errs, fmt.Sprintf("collectors.%s.tracker_url: must be an http or https URL, got %q", name, cc.TrackerURL))
+			}
+		}
+
+		if cc.MaxFileSize < 0 {
+			errs = append(errs, fmt.Sprintf("collectors.%s.max_file_size: must be non-negative, got %d", name, cc.MaxFileSize))
+		}
+
+		if cc.FileTimeout != "" {
+			if d, err := time.ParseDuration(cc.FileTimeout); err != nil || d < 0 {
+				errs = append(errs, fmt.Sprintf("collectors.%s.file_timeout: invalid duration %q (e.g. 10s, 1m)", name, cc.FileTimeout))
+			}
+		}
+
+		if cc.SlowTestThreshold != "" {
+			if d, err := time.ParseDuration(cc.SlowTestThreshold); err != nil || d <= 0 {
+				errs = append(errs, fmt.Sprintf("collectors.%s.slow_test_threshold: invalid duration %q (e.g. 2s, 500ms)", name, cc.SlowTestThreshold))
+			}
+		}
+
+		pkgs := make([]string, 0, len(cc.SlowTestBudgets))
+		for pkg := range cc.SlowTestBudgets {
+			pkgs = append(pkgs, pkg)
+		}
+		sort.Strings(pkgs)
+		for _, pkg := range pkgs {
+			budget := cc.SlowTestBudgets[pkg]
+			if d, err := time.ParseDuration(budget); err != nil || d <= 0 {
+				errs = append(errs, fmt.Sprintf("collectors.%s.slow_test_budgets.%s: invalid duration %q (e.g. 2s, 500ms)", name, pkg, budget))
+			}
+		}
+
+		if cc.CoverageThreshold < 0 || cc.CoverageThreshold > 1 {
+			errs = append(errs, fmt.Sprintf("collectors.%s.coverage_threshold: must be between 0.0 and 1.0, got %g", name, cc.CoverageThreshold))
+		}
+
+		if cc.BenchThreshold < 0 {
+			errs = append(errs, fmt.Sprintf("collectors.%s.bench_threshold: must be non-negative, got %g", name, cc.BenchThreshold))
+		}
+
+		for i, api := range cc.DeprecatedAPIs {
+			field := fmt.Sprintf("collectors.%s.deprecated_apis[%d]", name, i)
+			switch {
+			case (api.Symbol == "") == (api.Pattern == ""):
+				errs = append(errs, field+": needs exactly one of symbol or pattern")
+			case api.Pattern != "":
+				if _, err := regexp.Compile(api.Pattern); err != nil {
+					errs = append(errs, fmt.Sprintf("%s.pattern: invalid regex %q", field, api.Pattern))
+				}
+			case !goSymbolPattern.MatchString(api.Symbol):
+				errs = append(errs, fmt.Sprintf("%s.symbol: want import/path.Name, got %q", field, api.Symbol))
+			}
+		}
+
+		for _, tool := range cc.LintTools {
+			if tool != "vet" && tool != "staticcheck" {
+				errs = append(errs, fmt.Sprintf("collectors.%s.lint_tools: invalid tool %q (must be vet or staticcheck)", name, tool))
+			}
+		}
+
 		if cc.Anonymize != "" {
 			switch cc.Anonymize {
 			case "auto", "always", "never":
@@ -74,8 +171,346 @@ func Validate(cfg *Config) error {
 		}
 	}
 
-	if len(errs) > 0 {
-		return fmt.Errorf("config validation failed:\n  %s", strings.Join(errs, "\n  "))
+	errs = append(errs, validateIdentities(cfg.Identities)...)
+	errs = append(errs, validateLabels(cfg.Labels)...)
+	errs = append(errs, validateExitCodes(cfg.ExitCodes)...)
+	errs = append(errs, validateHotspots(cfg.Hotspots)...)
+	errs = append(errs, validatePriority(cfg.Priority)...)
+	errs = append(errs, validateEpics(cfg.Epics)...)
+	errs = append(errs, validateLLM(cfg.LLM)...)
+	errs = append(errs, validateScoringProfiles(cfg.ScoringProfile, cfg.ScoringProfiles)...)
+	errs = append(errs, validatePlugins(cfg.Plugins)...)
+	errs = append(errs, validateRules(cfg.Rules)...)
+	return errs
+}
+
+// validateRules checks that each rule has an action, that priorities are
+// in range, that drop stands alone, and that its patterns are valid.
+func validateRules(rs []RuleConfig) []string {
+	var errs []string
+	for i, r := range rs {
+		field := fmt.Sprintf("rules[%d]", i)
+		switch {
+		case r.Drop && (len(r.AddTags) > 0 || r.SetPriority != 0 || r.MinPriority != 0 || r.Output != ""):
+			errs = append(errs, field+": drop cannot be combined with other actions")
+		case !r.Drop && len(r.AddTags) == 0 && r.SetPriority == 0 && r.MinPriority == 0 && r.Output == "":
+			errs = append(errs, field+": needs an action (add_tags, set_priority, min_priority, output, or drop)")
+		}
+		if r.SetPriority != 0 && r.MinPriority != 0 {
+			errs = append(errs, field+": set_priority and min_priority cannot be combined")
+		}
+		if r.Output != "" && (!filepath.IsLocal(filepath.FromSlash(r.Output)) || filepath.Clean(r.Output) == ".") {
+			errs = append(errs, fmt.Sprintf("%s.output: must be a relative path inside the scanned directory, got %q", field, r.Output))
+		}
+		for _, f := range []struct {
+			key   string
+			value int
+		}{{"set_priority", r.SetPriority}, {"min_priority", r.MinPriority}} {
+			if f.value < 0 || f.value > 4 {
+				errs = append(errs, fmt.Sprintf("%s.%s: must be between 1 and 4, got %d", field, f.key, f.value))
+			}
+		}
+		for _, t := range r.AddTags {
+			if strings.TrimSpace(t) == "" {
+				errs = append(errs, field+".add_tags: tag must not be empty")
+			}
+		}
+		m := r.Match
+		if m.MinConfidence < 0 || m.MinConfidence > 1 {
+			errs = append(errs, fmt.Sprintf("%s.match.min_confidence: must be between 0.0 and 1.0, got %g", field, m.MinConfidence))
+		}
+		if m.MaxConfidence < 0 || m.MaxConfidence > 1 {
+			errs = append(errs, fmt.Sprintf("%s.match.max_confidence: must be between 0.0 and 1.0, got %g", field, m.MaxConfidence))
+		} else if m.MaxConfidence != 0 && m.MaxConfidence < m.MinConfidence {
+			errs = append(errs, fmt.Sprintf("%s.match.max_confidence: must not be below min_confidence (%g)", field, m.MinConfidence))
+		}
+		for _, list := range []struct {
+			key      string
+			patterns []string
+		}{{"kinds", m.Kinds}, {"authors", m.Authors}, {"function_owners", m.FunctionOwners}} {
+			for _, p := range list.patterns {
+				if _, err := path.Match(p, ""); err != nil {
+					errs = append(errs, fmt.Sprintf("%s.match.%s: invalid pattern %q", field, list.key, p))
+				}
+			}
+		}
+		for _, p := range m.Paths {
+			if strings.Trim(p, "/") == "" {
+				errs = append(errs, fmt.Sprintf("%s.match.paths: invalid pattern %q", field, p))
+			}
+		}
 	}
-	return nil
+	return errs
+}
+
+// validateIdentities checks that no alias is claimed by two canonical names.
+func validateIdentities(identities map[string][]string) []string {
+	names := make([]string, 0, len(identities))
+	for name := range identities {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var errs []string
+	owner := make(map[string]string)
+	for _, name := range names {
+		if strings.TrimSpace(name) == "" {
+			errs = append(errs, "identities: canonical name must not be empty")
+			continue
+		}
+		for _, alias := range identities[name] {
+			key := strings.ToLower(strings.TrimSpace(alias))
+			if key == "" {
+				errs = append(errs, fmt.Sprintf("identities.%s: alias must not be empty", name))
+				continue
+			}
+			if prev, ok := owner[key]; ok && prev != name {
+				errs = append(errs, fmt.Sprintf("identities.%s: alias %q is already mapped to %q", name, alias, prev))
+				continue
+			}
+			owner[key] = name
+		}
+	}
+	return errs
+}
+
+// validateExitCodes checks that remapped exit codes fit in the range a
+// shell reports without confusing them with signals (0-125).
+func validateExitCodes(ec *ExitCodesConfig) []string {
+	if ec == nil {
+		return nil
+	}
+	var errs []string
+	for _, f := range []struct {
+		key  string
+		code *int
+	}{
+		{"partial_failure", ec.PartialFailure},
+		{"total_failure", ec.TotalFailure},
+		{"expectation", ec.Expectation},
+		{"budget_exceeded", ec.BudgetExceeded},
+		{"secrets", ec.Secrets},
+	} {
+		if f.code != nil && (*f.code < 0 || *f.code > 125) {
+			errs = append(errs, fmt.Sprintf("exit_codes.%s: must be between 0 and 125, got %d", f.key, *f.code))
+		}
+	}
+	return errs
+}
+
+// validatePriority checks that the thresholds and weights are between 0
+// and 1 and that the thresholds, defaults included, decrease from P1 to P3.
+func validatePriority(p *PriorityConfig) []string {
+	if p == nil {
+		return nil
+	}
+	var errs []string
+	for _, f := range []struct {
+		key   string
+		value *float64
+	}{
+		{"p1", &p.P1},
+		{"p2", &p.P2},
+		{"p3", &p.P3},
+		{"age_weight", p.AgeWeight},
+		{"hotspot_weight", p.HotspotWeight},
+	} {
+		if f.value != nil && (*f.value < 0 || *f.value > 1) {
+			errs = append(errs, fmt.Sprintf("priority.%s: must be between 0 and 1, got %g", f.key, *f.value))
+		}
+	}
+	if p.AgeDays < 0 {
+		errs = append(errs, fmt.Sprintf("priority.age_days: must be non-negative, got %d", p.AgeDays))
+	}
+	pc, _ := Priority(&Config{Priority: p})
+	if pc.P1 <= pc.P2 || pc.P2 <= pc.P3 {
+		errs = append(errs, fmt.Sprintf("priority: thresholds must decrease from p1 to p3, got p1=%g p2=%g p3=%g", pc.P1, pc.P2, pc.P3))
+	}
+	return errs
+}
+
+// validateEpics checks that epic sizes are not negative and the kind
+// similarity is between 0 and 1.
+func validateEpics(c *EpicsConfig) []string {
+	if c == nil {
+		return nil
+	}
+	var errs []string
+	if c.ModuleMinSize < 0 {
+		errs = append(errs, fmt.Sprintf("epics.module_min_size: must be non-negative, got %d", c.ModuleMinSize))
+	}
+	if c.ModuleDepth < 0 {
+		errs = append(errs, fmt.Sprintf("epics.module_depth: must be non-negative, got %d", c.ModuleDepth))
+	}
+	if c.KindSimilarity < 0 || c.KindSimilarity > 1 {
+		errs = append(errs, fmt.Sprintf("epics.kind_similarity: must be between 0 and 1, got %g", c.KindSimilarity))
+	}
+	return errs
+}
+
+// validateLLM checks the provider name, the API root, and the duplicate
+// threshold.
+func validateLLM(l *LLMConfig) []string {
+	if l == nil {
+		return nil
+	}
+	var errs []string
+	if l.Provider != "" && !slices.Contains(llm.Providers, l.Provider) {
+		errs = append(errs, fmt.Sprintf("llm.provider: unknown provider %q (valid: %s)", l.Provider, strings.Join(llm.Providers, ", ")))
+	}
+	if l.BaseURL != "" {
+		if u, err := url.Parse(l.BaseURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			errs = append(errs, fmt.Sprintf("llm.base_url: must be an http or https URL, got %q", l.BaseURL))
+		}
+	}
+	if l.DuplicateThreshold < 0 || l.DuplicateThreshold > 1 {
+		errs = append(errs, fmt.Sprintf("llm.duplicate_threshold: must be between 0 and 1, got %g", l.DuplicateThreshold))
+	}
+	return errs
+}
+
+// validateHotspots checks that hotspot thresholds are not negative.
+func validateHotspots(h *HotspotsConfig) []string {
+	if h == nil {
+		return nil
+	}
+	var errs []string
+	for _, f := range []struct {
+		key   string
+		value float64
+	}{
+		{"min_changes", float64(h.MinChanges)},
+		{"min_lines", float64(h.MinLines)},
+		{"min_complexity", h.MinComplexity},
+		{"min_score", h.MinScore},
+		{"max_signals", float64(h.MaxSignals)},
+	} {
+		if f.value < 0 {
+			errs = append(errs, fmt.Sprintf("hotspots.%s: must be non-negative, got %g", f.key, f.value))
+		}
+	}
+	return errs
+}
+
+// pluginNamePattern is the form plugin names take, like built-in collector
+// names.
+var pluginNamePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9-]*$`)
+
+// maxPluginMemoryMB is the largest memory_mb a WASM plugin may ask for;
+// the collectors package clamps to the same value.
+const maxPluginMemoryMB = 1024
+
+// goSymbolPattern matches a qualified Go symbol such as io/ioutil.ReadAll.
+var goSymbolPattern = regexp.MustCompile(`^[^\s]+\.[\pL_][\pL\pN_]*$`)
+
+// validatePlugins checks that each plugin has a unique name, either a
+// command or a WASM module, and valid limits. fs and memory_mb only apply
+// to WASM modules.
+func validatePlugins(plugins []PluginConfig) []string {
+	var errs []string
+	seen := make(map[string]bool, len(plugins))
+	for i, p := range plugins {
+		switch {
+		case !pluginNamePattern.MatchString(p.Name):
+			errs = append(errs, fmt.Sprintf("plugins[%d].name: must be lowercase letters, digits, and dashes, got %q", i, p.Name))
+		case seen[p.Name]:
+			errs = append(errs, fmt.Sprintf("plugins[%d].name: duplicate plugin %q", i, p.Name))
+		}
+		seen[p.Name] = true
+		command, wasm := strings.TrimSpace(p.Command) != "", strings.TrimSpace(p.WASM) != ""
+		switch {
+		case command && wasm:
+			errs = append(errs, fmt.Sprintf("plugins[%d]: set command or wasm, not both", i))
+		case !command && !wasm:
+			errs = append(errs, fmt.Sprintf("plugins[%d].command: must not be empty (or set wasm)", i))
+		}
+		if p.FS != "" && p.FS != "read" {
+			errs = append(errs, fmt.Sprintf("plugins[%d].fs: must be \"read\", got %q", i, p.FS))
+		}
+		if p.MemoryMB < 0 || p.MemoryMB > maxPluginMemoryMB {
+			errs = append(errs, fmt.Sprintf("plugins[%d].memory_mb: must be between 0 and %d, got %d", i, maxPluginMemoryMB, p.MemoryMB))
+		}
+		if !wasm && (p.FS != "" || p.MemoryMB != 0) {
+			errs = append(errs, fmt.Sprintf("plugins[%d]: fs and memory_mb only apply to wasm plugins", i))
+		}
+		if p.Timeout != "" {
+			if d, err := time.ParseDuration(p.Timeout); err != nil || d <= 0 {
+				errs = append(errs, fmt.Sprintf("plugins[%d].timeout: invalid duration %q (e.g. 30s, 2m)", i, p.Timeout))
+			}
+		}
+	}
+	return errs
+}
+
+// declaresPlugin reports whether cfg declares a plugin named name.
+func declaresPlugin(cfg *Config, name string) bool {
+	for _, p := range cfg.Plugins {
+		if p.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// validateScoringProfiles checks that custom profiles don't shadow the
+// built-ins, that each weight matches something with a valid kind glob and
+// a non-negative factor, and that the selected profile exists.
+func validateScoringProfiles(selected string, profiles map[string]ScoringProfileConfig) []string {
+	var errs []string
+	names := make([]string, 0, len(profiles))
+	for name := range profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if _, ok := scoring.Builtin(name); ok {
+			errs = append(errs, fmt.Sprintf("scoring_profiles.%s: shadows the built-in profile", name))
+		}
+		for i, w := range profiles[name].Weights {
+			if len(w.Kinds)+len(w.Tags) == 0 {
+				errs = append(errs, fmt.Sprintf("scoring_profiles.%s.weights[%d]: needs kinds or tags to match", name, i))
+			}
+			for _, k := range w.Kinds {
+				if _, err := path.Match(k, ""); err != nil {
+					errs = append(errs, fmt.Sprintf("scoring_profiles.%s.weights[%d].kinds: invalid pattern %q", name, i, k))
+				}
+			}
+			if w.Factor < 0 {
+				errs = append(errs, fmt.Sprintf("scoring_profiles.%s.weights[%d].factor: must be non-negative, got %g", name, i, w.Factor))
+			}
+		}
+	}
+	if selected != "" {
+		if _, err := ScoringProfile(selected, profiles); err != nil {
+			errs = append(errs, fmt.Sprintf("scoring_profile: %v", err))
+		}
+	}
+	return errs
+}
+
+// validateLabels checks that each label rule matches something, maps to at
+// least one label, and uses valid kind globs.
+func validateLabels(rules []LabelRuleConfig) []string {
+	var errs []string
+	for i, r := range rules {
+		if len(r.Kinds)+len(r.Tags)+len(r.Collectors) == 0 {
+			errs = append(errs, fmt.Sprintf("labels[%d]: needs kinds, tags, or collectors to match", i))
+		}
+		if len(r.Labels)+len(r.Beads)+len(r.GitHub)+len(r.SARIF)+len(r.Tasks) == 0 {
+			errs = append(errs, fmt.Sprintf("labels[%d]: needs labels or exporter-specific labels (beads, github, sarif, tasks)", i))
+		}
+		for _, k := range r.Kinds {
+			if _, err := path.Match(k, ""); err != nil {
+				errs = append(errs, fmt.Sprintf("labels[%d].kinds: invalid pattern %q", i, k))
+			}
+		}
+		for _, list := range [][]string{r.Labels, r.Beads, r.GitHub, r.SARIF, r.Tasks} {
+			for _, l := range list {
+				if strings.TrimSpace(l) == "" {
+					errs = append(errs, fmt.Sprintf("labels[%d]: label must not be empty", i))
+				}
+			}
+		}
+	}
+	return errs
 }
@@ -43,6 +43,192 @@ func TestValidate_UnknownFormat(t *testing.T) {
 	assert.Contains(t, err.Error(), "xml")
 }
 
+func TestValidate_Lang(t *testing.T) {
+	for _, lang := range []string{"de", "ja_JP.UTF-8", "team/fr.yaml"} {
+		require.NoError(t, Validate(&Config{Lang: lang}), lang)
+	}
+	err := Validate(&Config{Lang: "xx"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `lang: unknown language "xx"`)
+}
+
+func TestValidate_CSVColumns(t *testing.T) {
+	require.NoError(t, Validate(&Config{CSVColumns: []string{"id", "Title", "age"}}))
+
+	err := Validate(&Config{CSVColumns: []string{"id", "severity"}})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `csv_columns: unknown column "severity"`)
+
+	err = Validate(&Config{CSVColumns: []string{}})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "csv_columns: no columns given")
+}
+
+func TestValidate_Identities(t *testing.T) {
+	cfg := &Config{Identities: map[string][]string{
+		"Alice": {"alice@example.com", "ali"},
+		"Bob":   {"bob@example.com"},
+	}}
+	require.NoError(t, Validate(cfg))
+}
+
+func TestValidate_IdentitiesDuplicateAlias(t *testing.T) {
+	cfg := &Config{Identities: map[string][]string{
+		"Alice": {"shared@example.com"},
+		"Bob":   {"SHARED@example.com", ""},
+	}}
+	err := Validate(cfg)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `identities.Bob: alias "SHARED@example.com" is already mapped to "Alice"`)
+	assert.Contains(t, err.Error(), "identities.Bob: alias must not be empty")
+}
+
+func TestValidate_Labels(t *testing.T) {
+	require.NoError(t, Validate(&Config{Labels: []LabelRuleConfig{
+		{Kinds: []string{"*-dependency"}, GitHub: []string{"dependencies"}},
+	}}))
+
+	err := Validate(&Config{Labels: []LabelRuleConfig{
+		{Labels: []string{"x"}},
+		{Tags: []string{"security"}},
+		{Kinds: []string{"[bad"}, Beads: []string{"ok", " "}},
+	}})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "labels[0]: needs kinds, tags, or collectors to match")
+	assert.Contains(t, err.Error(), "labels[1]: needs labels or exporter-specific labels")
+	assert.Contains(t, err.Error(), `labels[2].kinds: invalid pattern "[bad"`)
+	assert.Contains(t, err.Error(), "labels[2]: label must not be empty")
+}
+
+func TestValidate_ScoringProfiles(t *testing.T) {
+	require.NoError(t, Validate(&Config{ScoringProfile: "security-first"}))
+	require.NoError(t, Validate(&Config{
+		ScoringProfile: "ours",
+		ScoringProfiles: map[string]ScoringProfileConfig{
+			"ours": {Weights: []ScoringWeightConfig{{Kinds: []string{"*-dependency"}, Factor: 0}}},
+		},
+	}))
+
+	err := Validate(&Config{
+		ScoringProfile: "missing",
+		ScoringProfiles: map[string]ScoringProfileConfig{
+			"docs-heavy": {Weights: []ScoringWeightConfig{{Tags: []string{"api"}, Factor: 2}}},
+			"ours": {Weights: []ScoringWeightConfig{
+				{Factor: 1.5},
+				{Kinds: []string{"[bad"}, Factor: -1},
+			}},
+		},
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "scoring_profiles.docs-heavy: shadows the built-in profile")
+	assert.Contains(t, err.Error(), "scoring_profiles.ours.weights[0]: needs kinds or tags to match")
+	assert.Contains(t, err.Error(), `scoring_profiles.ours.weights[1].kinds: invalid pattern "[bad"`)
+	assert.Contains(t, err.Error(), "scoring_profiles.ours.weights[1].factor: must be non-negative, got -1")
+	assert.Contains(t, err.Error(), `scoring_profile: unknown scoring profile "missing"`)
+}
+
+func TestValidate_Rules(t *testing.T) {
+	require.NoError(t, Validate(&Config{Rules: []RuleConfig{
+		{Match: RuleMatchConfig{Paths: []string{"internal/auth/**"}}, AddTags: []string{"security"}, MinPriority: 1},
+		{Match: RuleMatchConfig{Kinds: []string{"stale-branch"}}, Drop: true},
+		{Output: "all.jsonl"},
+		{Output: "reports/auth.json"},
+	}}))
+
+	err := Validate(&Config{Rules: []RuleConfig{
+		{Match: RuleMatchConfig{Kinds: []string{"todo"}}},
+		{Drop: true, AddTags: []string{"x"}},
+		{SetPriority: 5, MinPriority: 1},
+		{AddTags: []string{" "}, Match: RuleMatchConfig{MinConfidence: 0.8, MaxConfidence: 0.5, Authors: []string{"[bad"}, FunctionOwners: []string{"[x"}, Paths: []string{"/"}}},
+		{Output: "/etc/cron.d/stringer"},
+		{Output: "../outside.json"},
+		{Output: "."},
+	}})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "rules[0]: needs an action")
+	assert.Contains(t, err.Error(), "rules[1]: drop cannot be combined with other actions")
+	assert.Contains(t, err.Error(), "rules[2]: set_priority and min_priority cannot be combined")
+	assert.Contains(t, err.Error(), "rules[2].set_priority: must be between 1 and 4, got 5")
+	assert.Contains(t, err.Error(), "rules[3].add_tags: tag must not be empty")
+	assert.Contains(t, err.Error(), "rules[3].match.max_confidence: must not be below min_confidence (0.8)")
+	assert.Contains(t, err.Error(), `rules[3].match.authors: invalid pattern "[bad"`)
+	assert.Contains(t, err.Error(), `rules[3].match.function_owners: invalid pattern "[x"`)
+	assert.Contains(t, err.Error(), `rules[3].match.paths: invalid pattern "/"`)
+	assert.Contains(t, err.Error(), `rules[4].output: must be a relative path inside the scanned directory, got "/etc/cron.d/stringer"`)
+	assert.Contains(t, err.Error(), `rules[5].output: must be a relative path inside the scanned directory, got "../outside.json"`)
+	assert.Contains(t, err.Error(), `rules[6].output: must be a relative path`)
+}
+
+func TestValidate_Plugins(t *testing.T) {
+	require.NoError(t, Validate(&Config{
+		Plugins:    []PluginConfig{{Name: "license-check", Command: "./tools/license-check", Timeout: "30s"}},
+		Collectors: map[string]CollectorConfig{"license-check": {MinConfidence: 0.5}},
+	}), "collector settings may name a declared plugin")
+
+	err := Validate(&Config{Plugins: []PluginConfig{
+		{Name: "Bad Name", Command: "x"},
+		{Name: "dup", Command: "x"},
+		{Name: "dup", Command: " ", Timeout: "soon"},
+	}})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `plugins[0].name: must be lowercase letters, digits, and dashes, got "Bad Name"`)
+	assert.Contains(t, err.Error(), `plugins[2].name: duplicate plugin "dup"`)
+	assert.Contains(t, err.Error(), "plugins[2].command: must not be empty")
+	assert.Contains(t, err.Error(), `plugins[2].timeout: invalid duration "soon"`)
+}
+
+func TestValidate_WASMPlugins(t *testing.T) {
+	require.NoError(t, Validate(&Config{Plugins: []PluginConfig{
+		{Name: "license-check", WASM: "./tools/license-check.wasm", FS: "read", MemoryMB: 64},
+	}}))
+
+	err := Validate(&Config{Plugins: []PluginConfig{
+		{Name: "both", Command: "x", WASM: "x.wasm"},
+		{Name: "neither"},
+		{Name: "grants", WASM: "x.wasm", FS: "write", MemoryMB: 2048},
+		{Name: "exec", Command: "x", FS: "read"},
+	}})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "plugins[0]: set command or wasm, not both")
+	assert.Contains(t, err.Error(), "plugins[1].command: must not be empty (or set wasm)")
+	assert.Contains(t, err.Error(), `plugins[2].fs: must be "read", got "write"`)
+	assert.Contains(t, err.Error(), "plugins[2].memory_mb: must be between 0 and 1024, got 2048")
+	assert.Contains(t, err.Error(), "plugins[3]: fs and memory_mb only apply to wasm plugins")
+}
+
+func TestValidate_FileScanGuards(t *testing.T) {
+	cfg := &Config{Collectors: map[string]CollectorConfig{
+		"todos":    {MaxFileSize: 1 << 20, FileTimeout: "5s"},
+		"patterns": {MaxFileSize: -1, FileTimeout: "soon"},
+	}}
+	err := Validate(cfg)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "collectors.patterns.max_file_size: must be non-negative")
+	assert.Contains(t, err.Error(), `collectors.patterns.file_timeout: invalid duration "soon"`)
+	assert.NotContains(t, err.Error(), "collectors.todos")
+}
+
+func TestValidate_NegativeLargeBatchThreshold(t *testing.T) {
+	cfg := &Config{Collectors: map[string]CollectorConfig{
+		"github": {LargeBatchThreshold: -10},
+	}}
+	err := Validate(cfg)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "collectors.github.large_batch_threshold: must be non-negative, got -10")
+}
+
+func TestValidate_TrackerURL(t *testing.T) {
+	require.NoError(t, Validate(&Config{Collectors: map[string]CollectorConfig{
+		"todos": {TrackerURL: "https://acme.atlassian.net/browse"},
+	}}))
+
+	err := Validate(&Config{Collectors: map[string]CollectorConfig{
+		"todos": {TrackerURL: "acme.atlassian.net/browse"},
+	}})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `collectors.todos.tracker_url: must be an http or https URL, got "acme.atlassian.net/browse"`)
+}
+
 func TestValidate_NegativeMaxIssues(t *testing.T) {
 	cfg := &Config{MaxIssues: -1}
 	err := Validate(cfg)
@@ -305,3 +491,115 @@ func TestValidate_ValidAnonymizeValues(t *testing.T) {
 		assert.NoError(t, Validate(cfg), "anonymize=%q should be valid", val)
 	}
 }
+
+func TestValidate_TestTiming(t *testing.T) {
+	cfg := &Config{Collectors: map[string]CollectorConfig{
+		"testtiming": {
+			SlowTestThreshold: "0s",
+			SlowTestBudgets:   map[string]string{"ok": "5s", "pkg/a": "fast"},
+		},
+	}}
+	err := Validate(cfg)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `collectors.testtiming.slow_test_threshold: invalid duration "0s"`)
+	assert.Contains(t, err.Error(), `collectors.testtiming.slow_test_budgets.pkg/a: invalid duration "fast"`)
+	assert.NotContains(t, err.Error(), "slow_test_budgets.ok")
+}
+
+func TestValidate_LintTools(t *testing.T) {
+	require.NoError(t, Validate(&Config{Collectors: map[string]CollectorConfig{
+		"lint": {LintTools: []string{"vet", "staticcheck"}},
+	}}))
+
+	err := Validate(&Config{Collectors: map[string]CollectorConfig{
+		"lint": {LintTools: []string{"vet", "golangci-lint"}},
+	}})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `collectors.lint.lint_tools: invalid tool "golangci-lint" (must be vet or staticcheck)`)
+}
+
+func TestValidate_CoverageThreshold(t *testing.T) {
+	require.NoError(t, Validate(&Config{Collectors: map[string]CollectorConfig{"coverage": {CoverageThreshold: 0.8}}}))
+
+	err := Validate(&Config{Collectors: map[string]CollectorConfig{"coverage": {CoverageThreshold: 80}}})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "collectors.coverage.coverage_threshold: must be between 0.0 and 1.0, got 80")
+}
+
+func TestValidate_BenchThreshold(t *testing.T) {
+	require.NoError(t, Validate(&Config{Collectors: map[string]CollectorConfig{"bench": {BenchThreshold: 0.25}}}))
+
+	err := Validate(&Config{Collectors: map[string]CollectorConfig{"bench": {BenchThreshold: -0.1}}})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "collectors.bench.bench_threshold: must be non-negative, got -0.1")
+}
+
+func TestValidate_DeprecatedAPIs(t *testing.T) {
+	require.NoError(t, Validate(&Config{Collectors: map[string]CollectorConfig{"deprecation": {DeprecatedAPIs: []DeprecatedAPIConfig{
+		{Symbol: "io/ioutil.ReadAll", Replacement: "io.ReadAll"},
+		{Pattern: `moment\(`},
+	}}}}))
+
+	err := Validate(&Config{Collectors: map[string]CollectorConfig{"deprecation": {DeprecatedAPIs: []DeprecatedAPIConfig{
+		{Symbol: "ioutil"},
+		{Pattern: "("},
+		{Symbol: "io.Copy", Pattern: "x"},
+		{Replacement: "nothing"},
+	}}}})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `collectors.deprecation.deprecated_apis[0].symbol: want import/path.Name, got "ioutil"`)
+	assert.Contains(t, err.Error(), `collectors.deprecation.deprecated_apis[1].pattern: invalid regex "("`)
+	assert.Contains(t, err.Error(), "collectors.deprecation.deprecated_apis[2]: needs exactly one of symbol or pattern")
+	assert.Contains(t, err.Error(), "collectors.deprecation.deprecated_apis[3]: needs exactly one of symbol or pattern")
+}
+
+func TestValidate_ExitCodes(t *testing.T) {
+	code := func(v int) *int { return &v }
+	require.NoError(t, Validate(&Config{ExitCodes: &ExitCodesConfig{PartialFailure: code(0), Secrets: code(125)}}))
+
+	err := Validate(&Config{ExitCodes: &ExitCodesConfig{BudgetExceeded: code(-1), TotalFailure: code(256)}})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "exit_codes.budget_exceeded: must be between 0 and 125, got -1")
+	assert.Contains(t, err.Error(), "exit_codes.total_failure: must be between 0 and 125, got 256")
+}
+
+func TestValidate_Priority(t *testing.T) {
+	weight := func(v float64) *float64 { return &v }
+	require.NoError(t, Validate(&Config{Priority: &PriorityConfig{P1: 0.9, AgeWeight: weight(0), AgeDays: 90}}))
+
+	err := Validate(&Config{Priority: &PriorityConfig{P2: 1.5, HotspotWeight: weight(-0.1), AgeDays: -1}})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "priority.p2: must be between 0 and 1, got 1.5")
+	assert.Contains(t, err.Error(), "priority.hotspot_weight: must be between 0 and 1, got -0.1")
+	assert.Contains(t, err.Error(), "priority.age_days: must be non-negative, got -1")
+	assert.Contains(t, err.Error(), "priority: thresholds must decrease from p1 to p3, got p1=0.8 p2=1.5 p3=0.4")
+}
+
+func TestValidate_Epics(t *testing.T) {
+	require.NoError(t, Validate(&Config{Epics: &EpicsConfig{ModuleMinSize: 10, KindSimilarity: 0.5}}))
+
+	err := Validate(&Config{Epics: &EpicsConfig{ModuleMinSize: -1, ModuleDepth: -2, KindSimilarity: 1.5}})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "epics.module_min_size: must be non-negative, got -1")
+	assert.Contains(t, err.Error(), "epics.module_depth: must be non-negative, got -2")
+	assert.Contains(t, err.Error(), "epics.kind_similarity: must be between 0 and 1, got 1.5")
+}
+
+func TestValidate_LLM(t *testing.T) {
+	require.NoError(t, Validate(&Config{LLM: &LLMConfig{Provider: "ollama", BaseURL: "http://gpu-box:11434/v1", DuplicateThreshold: 0.9}}))
+
+	err := Validate(&Config{LLM: &LLMConfig{Provider: "gemini", BaseURL: "gpu-box:11434", DuplicateThreshold: 2}})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `llm.provider: unknown provider "gemini" (valid: anthropic, openai, ollama)`)
+	assert.Contains(t, err.Error(), `llm.base_url: must be an http or https URL, got "gpu-box:11434"`)
+	assert.Contains(t, err.Error(), "llm.duplicate_threshold: must be between 0 and 1, got 2")
+}
+
+func TestValidate_Hotspots(t *testing.T) {
+	require.NoError(t, Validate(&Config{Hotspots: &HotspotsConfig{MinChanges: 3, MinScore: 12.5}}))
+
+	err := Validate(&Config{Hotspots: &HotspotsConfig{MinLines: -1, MinComplexity: -0.5}})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "hotspots.min_lines: must be non-negative, got -1")
+	assert.Contains(t, err.Error(), "hotspots.min_complexity: must be non-negative, got -0.5")
+}
@@ -0,0 +1,292 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+// Package coverage reads test coverage reports and combines them with file
+// churn to sort files into risk quadrants: a file that changes often and
+// is poorly tested is where regressions are most likely to slip through.
+package coverage
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// File is the line or statement coverage of one source file.
+type File struct {
+	Covered int
+	Total   int
+}
+
+// Ratio returns the covered fraction, or 0 for a file with nothing to cover.
+func (f File) Ratio() float64 {
+	if f.Total <= 0 {
+		return 0
+	}
+	return float64(f.Covered) / float64(f.Total)
+}
+
+// Report maps the source paths recorded in a coverage report to their
+// coverage.
+type Report struct {
+	Files map[string]File
+}
+
+// Load reads a coverage report file. Go cover profiles (go test
+// -coverprofile, any mode), LCOV tracefiles, and Cobertura XML reports are
+// recognized by content.
+func Load(path string) (*Report, error) {
+	data, err := os.ReadFile(path) //nolint:gosec // user-supplied report path
+	if err != nil {
+		return nil, err
+	}
+	r, err := Parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return r, nil
+}
+
+// Parse decodes coverage report data, detecting the format from its
+// content.
+func Parse(data []byte) (*Report, error) {
+	trimmed := bytes.TrimSpace(data)
+	switch {
+	case bytes.HasPrefix(trimmed, []byte("mode:")):
+		return parseGo(data)
+	case bytes.HasPrefix(trimmed, []byte("TN:")) || bytes.HasPrefix(trimmed, []byte("SF:")):
+		return parseLCOV(data)
+	case bytes.HasPrefix(trimmed, []byte("<")):
+		return parseCobertura(data)
+	}
+	return nil, fmt.Errorf("unrecognized coverage report (want a Go cover profile, LCOV tracefile, or Cobertura XML)")
+}
+
+// Merge combines reports. A file present in several keeps the entry with
+// the highest coverage, since each report usually comes from a different
+// test suite over the same code.
+func Merge(reports ...*Report) *Report {
+	merged := &Report{Files: make(map[string]File)}
+	for _, r := range reports {
+		if r == nil {
+			continue
+		}
+		for path, f := range r.Files {
+			if cur, ok := merged.Files[path]; !ok || f.Ratio() > cur.Ratio() {
+				merged.Files[path] = f
+			}
+		}
+	}
+	return merged
+}
+
+// Resolve maps repository-relative paths to their coverage. Report paths
+// carry a tool-specific prefix (an import path for Go, often an absolute
+// checkout path for LCOV); the prefix is inferred as the one under which
+// the most given paths, weighted by depth, appear in the report. Paths
+// absent from the report are omitted.
+func (r *Report) Resolve(paths []string) map[string]File {
+	resolved := make(map[string]File)
+	votes := make(map[string]int)
+	for f := range r.Files {
+		for _, rel := range paths {
+			if f == rel || strings.HasSuffix(f, "/"+rel) {
+				votes[f[:len(f)-len(rel)]] += strings.Count(rel, "/") + 1
+			}
+		}
+	}
+	prefix, best := "", 0
+	for pre, n := range votes {
+		if n > best || (n == best && len(pre) > len(prefix)) {
+			prefix, best = pre, n
+		}
+	}
+	if best == 0 {
+		return resolved
+	}
+	for _, rel := range paths {
+		if f, ok := r.Files[prefix+rel]; ok {
+			resolved[rel] = f
+		}
+	}
+	return resolved
+}
+
+// parseGo decodes a Go cover profile. A file's coverage is its covered
+// statements over its total statements; blocks listed more than once, as
+// in profiles merged from several packages' runs, count once.
+func parseGo(data []byte) (*Report, error) {
+	type block struct {
+		stmts int
+		hit   bool
+	}
+	blocks := make(map[string]map[string]block)
+	sc := bufio.NewScanner(bytes.NewReader(data))
+	lineNo := 0
+	for sc.Scan() {
+		lineNo++
+		line := strings.TrimSpace(sc.Text())
+		if line == "" || strings.HasPrefix(line, "mode:") {
+			continue
+		}
+
+		// file.go:12.34,15.2 3 42
+		colon := strings.LastIndex(line, ":")
+		fields := strings.Fields(line[colon+1:])
+		if colon <= 0 || len(fields) != 3 {
+			return nil, fmt.Errorf("cover profile line %d: malformed block %q", lineNo, line)
+		}
+		stmts, err1 := strconv.Atoi(fields[1])
+		count, err2 := strconv.ParseInt(fields[2], 10, 64)
+		if err1 != nil || err2 != nil {
+			return nil, fmt.Errorf("cover profile line %d: malformed block %q", lineNo, line)
+		}
+		file := strings.TrimPrefix(line[:colon], "./")
+		if blocks[file] == nil {
+			blocks[file] = make(map[string]block)
+		}
+		b := blocks[file][fields[0]]
+		b.stmts = stmts
+		b.hit = b.hit || count > 0
+		blocks[file][fields[0]] = b
+	}
+	if err := sc.Err(); err != nil {
+		return nil, fmt.Errorf("read cover profile: %w", err)
+	}
+
+	r := &Report{Files: make(map[string]File, len(blocks))}
+	for file, bs := range blocks {
+		var f File
+		for _, b := range bs {
+			f.Total += b.stmts
+			if b.hit {
+				f.Covered += b.stmts
+			}
+		}
+		r.Files[file] = f
+	}
+	return r, nil
+}
+
+// parseLCOV decodes an LCOV tracefile. Line hits (DA records) are used when
+// present; otherwise the LF/LH summary counts.
+func parseLCOV(data []byte) (*Report, error) {
+	r := &Report{Files: make(map[string]File)}
+	var (
+		file    string
+		hits    map[int]bool
+		summary File
+	)
+	flush := func() {
+		if file == "" {
+			return
+		}
+		f := summary
+		if len(hits) > 0 {
+			f = File{Total: len(hits)}
+			for _, hit := range hits {
+				if hit {
+					f.Covered++
+				}
+			}
+		}
+		if cur, ok := r.Files[file]; !ok || f.Ratio() > cur.Ratio() {
+			r.Files[file] = f
+		}
+		file, hits, summary = "", nil, File{}
+	}
+
+	sc := bufio.NewScanner(bytes.NewReader(data))
+	sc.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	lineNo := 0
+	for sc.Scan() {
+		lineNo++
+		line := strings.TrimSpace(sc.Text())
+		key, value, _ := strings.Cut(line, ":")
+		switch key {
+		case "SF":
+			flush()
+			file = strings.TrimPrefix(value, "./")
+			hits = make(map[int]bool)
+		case "DA":
+			parts := strings.Split(value, ",")
+			if len(parts) < 2 {
+				return nil, fmt.Errorf("lcov line %d: malformed DA record %q", lineNo, line)
+			}
+			n, err1 := strconv.Atoi(parts[0])
+			count, err2 := strconv.ParseFloat(parts[1], 64)
+			if err1 != nil || err2 != nil {
+				return nil, fmt.Errorf("lcov line %d: malformed DA record %q", lineNo, line)
+			}
+			hits[n] = hits[n] || count > 0
+		case "LF":
+			summary.Total, _ = strconv.Atoi(value)
+		case "LH":
+			summary.Covered, _ = strconv.Atoi(value)
+		case "end_of_record":
+			flush()
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return nil, fmt.Errorf("read lcov: %w", err)
+	}
+	flush()
+	return r, nil
+}
+
+// coberturaReport is the part of a Cobertura XML report that records line
+// hits.
+type coberturaReport struct {
+	XMLName  xml.Name `xml:"coverage"`
+	Packages []struct {
+		Classes []struct {
+			Filename string `xml:"filename,attr"`
+			Lines    []struct {
+				Number int     `xml:"number,attr"`
+				Hits   float64 `xml:"hits,attr"`
+			} `xml:"lines>line"`
+		} `xml:"classes>class"`
+	} `xml:"packages>package"`
+}
+
+// parseCobertura decodes a Cobertura XML report, as written by coverage.py,
+// JaCoCo converters, and most .NET and JavaScript tools. A file's coverage
+// is its hit lines over its measured lines; a file split over several
+// classes, as with Java inner classes, counts each line once.
+func parseCobertura(data []byte) (*Report, error) {
+	var doc coberturaReport
+	if err := xml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("cobertura: %w", err)
+	}
+	hits := make(map[string]map[int]bool)
+	for _, pkg := range doc.Packages {
+		for _, class := range pkg.Classes {
+			file := strings.TrimPrefix(class.Filename, "./")
+			if file == "" {
+				continue
+			}
+			if hits[file] == nil {
+				hits[file] = make(map[int]bool)
+			}
+			for _, line := range class.Lines {
+				hits[file][line.Number] = hits[file][line.Number] || line.Hits > 0
+			}
+		}
+	}
+
+	r := &Report{Files: make(map[string]File, len(hits))}
+	for file, lines := range hits {
+		f := File{Total: len(lines)}
+		for _, hit := range lines {
+			if hit {
+				f.Covered++
+			}
+		}
+		r.Files[file] = f
+	}
+	return r, nil
+}
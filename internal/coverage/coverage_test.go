@@ -0,0 +1,141 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package coverage
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const goProfile = `mode: set
+example.com/app/internal/db/db.go:10.2,12.3 4 1
+example.com/app/internal/db/db.go:14.2,20.3 6 0
+example.com/app/internal/db/db.go:10.2,12.3 4 0
+example.com/app/cmd/main.go:5.1,8.2 2 1
+`
+
+const lcovReport = `TN:
+SF:/home/ci/app/src/server.js
+DA:1,3
+DA:2,0
+DA:3,1
+DA:4,0
+end_of_record
+SF:/home/ci/app/src/util.js
+LF:10
+LH:9
+end_of_record
+`
+
+const coberturaXML = `<?xml version="1.0" ?>
+<coverage version="7.4" line-rate="0.4">
+  <sources><source>/home/ci/app</source></sources>
+  <packages>
+    <package name="app">
+      <classes>
+        <class name="models.py" filename="app/models.py">
+          <lines><line number="1" hits="1"/><line number="2" hits="0"/><line number="3" hits="4"/></lines>
+        </class>
+        <class name="models.py$Inner" filename="app/models.py">
+          <lines><line number="2" hits="0"/></lines>
+        </class>
+        <class name="views.py" filename="./app/views.py">
+          <lines><line number="1" hits="0"/><line number="2" hits="0"/></lines>
+        </class>
+        <class name="empty" filename=""/>
+      </classes>
+    </package>
+  </packages>
+</coverage>
+`
+
+func TestParse_GoProfile(t *testing.T) {
+	r, err := Parse([]byte(goProfile))
+	require.NoError(t, err)
+	assert.Equal(t, File{Covered: 4, Total: 10}, r.Files["example.com/app/internal/db/db.go"], "repeated blocks count once")
+	assert.Equal(t, File{Covered: 2, Total: 2}, r.Files["example.com/app/cmd/main.go"])
+}
+
+func TestParse_LCOV(t *testing.T) {
+	r, err := Parse([]byte(lcovReport))
+	require.NoError(t, err)
+	assert.Equal(t, File{Covered: 2, Total: 4}, r.Files["/home/ci/app/src/server.js"])
+	assert.Equal(t, File{Covered: 9, Total: 10}, r.Files["/home/ci/app/src/util.js"], "LF/LH when there are no DA records")
+}
+
+func TestParse_Cobertura(t *testing.T) {
+	r, err := Parse([]byte(coberturaXML))
+	require.NoError(t, err)
+	assert.Equal(t, File{Covered: 2, Total: 3}, r.Files["app/models.py"], "lines shared by classes count once")
+	assert.Equal(t, File{Covered: 0, Total: 2}, r.Files["app/views.py"])
+	assert.Len(t, r.Files, 2, "classes without a filename are skipped")
+}
+
+func TestParse_Errors(t *testing.T) {
+	_, err := Parse([]byte(`{"coverage": 1}`))
+	assert.ErrorContains(t, err, "unrecognized coverage report")
+
+	_, err = Parse([]byte("<report><package/></report>"))
+	assert.ErrorContains(t, err, "cobertura:")
+
+	_, err = Parse([]byte("mode: count\nmain.go:1.1,2.2 x 1\n"))
+	assert.ErrorContains(t, err, "cover profile line 2: malformed block")
+
+	_, err = Parse([]byte("SF:a.js\nDA:1\n"))
+	assert.ErrorContains(t, err, "lcov line 2: malformed DA record")
+}
+
+func TestFile_Ratio(t *testing.T) {
+	assert.InDelta(t, 0.25, File{Covered: 1, Total: 4}.Ratio(), 1e-9)
+	assert.Zero(t, File{}.Ratio())
+}
+
+func TestLoad(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "lcov.info")
+	require.NoError(t, os.WriteFile(path, []byte(lcovReport), 0o600))
+	r, err := Load(path)
+	require.NoError(t, err)
+	assert.Len(t, r.Files, 2)
+
+	_, err = Load(filepath.Join(dir, "missing.out"))
+	assert.Error(t, err)
+
+	bad := filepath.Join(dir, "bad.out")
+	require.NoError(t, os.WriteFile(bad, []byte("nope"), 0o600))
+	_, err = Load(bad)
+	assert.ErrorContains(t, err, bad)
+}
+
+func TestMerge(t *testing.T) {
+	a := &Report{Files: map[string]File{"a.go": {Covered: 1, Total: 4}, "b.go": {Covered: 3, Total: 4}}}
+	b := &Report{Files: map[string]File{"a.go": {Covered: 3, Total: 4}, "c.go": {Covered: 0, Total: 2}}}
+	m := Merge(a, nil, b)
+	assert.Equal(t, map[string]File{
+		"a.go": {Covered: 3, Total: 4},
+		"b.go": {Covered: 3, Total: 4},
+		"c.go": {Covered: 0, Total: 2},
+	}, m.Files)
+}
+
+func TestResolve(t *testing.T) {
+	r, err := Parse([]byte(goProfile))
+	require.NoError(t, err)
+	got := r.Resolve([]string{"internal/db/db.go", "cmd/main.go", "README.md"})
+	assert.Equal(t, map[string]File{
+		"internal/db/db.go": {Covered: 4, Total: 10},
+		"cmd/main.go":       {Covered: 2, Total: 2},
+	}, got)
+
+	r, err = Parse([]byte(lcovReport))
+	require.NoError(t, err)
+	got = r.Resolve([]string{"src/server.js", "src/util.js"})
+	assert.Len(t, got, 2, "absolute LCOV paths resolve under the checkout prefix")
+
+	assert.Empty(t, r.Resolve([]string{"other.go"}))
+}
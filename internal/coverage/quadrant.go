@@ -0,0 +1,157 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package coverage
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+// Quadrant is a file's place on the churn × coverage grid.
+type Quadrant string
+
+// Quadrants, from most to least risky.
+const (
+	Danger   Quadrant = "danger"   // high churn, low coverage
+	Untested Quadrant = "untested" // low churn, low coverage
+	Guarded  Quadrant = "guarded"  // high churn, high coverage
+	Stable   Quadrant = "stable"   // low churn, high coverage
+)
+
+// Order lists the quadrants from most to least risky.
+var Order = []Quadrant{Danger, Untested, Guarded, Stable}
+
+// LowCoverage is the coverage ratio below which a file counts as poorly
+// tested.
+const LowCoverage = 0.5
+
+// minHighChurn is the fewest changes that count as high churn, so a quiet
+// repository where every file changed once has no danger quadrant.
+const minHighChurn = 3
+
+// Kind is the signal kind emitted for files in the danger quadrant.
+const Kind = "risk-quadrant"
+
+// Churn is the change count of one file over the gitlog churn window.
+type Churn struct {
+	Path    string
+	Changes int
+}
+
+// FileQuadrant places one file on the grid.
+type FileQuadrant struct {
+	Path     string
+	Changes  int
+	Coverage float64
+	Quadrant Quadrant
+}
+
+// Quadrants is the classification of every file that has both churn and
+// coverage data.
+type Quadrants struct {
+	Files []FileQuadrant
+
+	// ChurnCutoff is the change count at or above which a file counts as
+	// high churn: the 75th percentile of the classified files, and at
+	// least minHighChurn.
+	ChurnCutoff int
+}
+
+// Count returns the number of files in q.
+func (qs *Quadrants) Count(q Quadrant) int {
+	n := 0
+	for _, f := range qs.Files {
+		if f.Quadrant == q {
+			n++
+		}
+	}
+	return n
+}
+
+// Classify places each file with churn on the grid, using the coverage the
+// report records for it. Files without coverage data are left out, as are
+// churned files the report does not mention (usually deleted or generated
+// ones). Files are ordered by quadrant, then by changes descending.
+func Classify(churn []Churn, report *Report) *Quadrants {
+	paths := make([]string, 0, len(churn))
+	for _, c := range churn {
+		paths = append(paths, c.Path)
+	}
+	cov := report.Resolve(paths)
+
+	qs := &Quadrants{}
+	var counts []int
+	for _, c := range churn {
+		f, ok := cov[c.Path]
+		if !ok || f.Total == 0 {
+			continue
+		}
+		qs.Files = append(qs.Files, FileQuadrant{Path: c.Path, Changes: c.Changes, Coverage: f.Ratio()})
+		counts = append(counts, c.Changes)
+	}
+	qs.ChurnCutoff = minHighChurn
+	if len(counts) > 0 {
+		sort.Ints(counts)
+		qs.ChurnCutoff = max(counts[(len(counts)-1)*3/4], minHighChurn)
+	}
+
+	rank := make(map[Quadrant]int, len(Order))
+	for i, q := range Order {
+		rank[q] = i
+	}
+	for i := range qs.Files {
+		f := &qs.Files[i]
+		high, low := f.Changes >= qs.ChurnCutoff, f.Coverage < LowCoverage
+		switch {
+		case high && low:
+			f.Quadrant = Danger
+		case low:
+			f.Quadrant = Untested
+		case high:
+			f.Quadrant = Guarded
+		default:
+			f.Quadrant = Stable
+		}
+	}
+	sort.SliceStable(qs.Files, func(i, j int) bool {
+		a, b := qs.Files[i], qs.Files[j]
+		if a.Quadrant != b.Quadrant {
+			return rank[a.Quadrant] < rank[b.Quadrant]
+		}
+		if a.Changes != b.Changes {
+			return a.Changes > b.Changes
+		}
+		return a.Path < b.Path
+	})
+	return qs
+}
+
+// Signals returns a risk-quadrant signal for each file in the danger
+// quadrant. Confidence grows with churn above the cutoff and with the
+// coverage gap.
+func (qs *Quadrants) Signals() []signal.RawSignal {
+	var signals []signal.RawSignal
+	for _, f := range qs.Files {
+		if f.Quadrant != Danger {
+			continue
+		}
+		churnFactor := min(float64(f.Changes-qs.ChurnCutoff)/float64(qs.ChurnCutoff), 1)
+		gapFactor := 1 - f.Coverage/LowCoverage
+		signals = append(signals, signal.RawSignal{
+			Source:   "coverage",
+			Kind:     Kind,
+			FilePath: f.Path,
+			Title: fmt.Sprintf("High churn, low coverage: %s (%d changes, %.0f%% covered)",
+				f.Path, f.Changes, f.Coverage*100),
+			Description: fmt.Sprintf("Changed %d times in the churn window (high churn is %d or more) with %.1f%% test coverage (low is under %.0f%%).\n"+
+				"Frequent changes to poorly tested code are where regressions slip through; add tests before the next change.",
+				f.Changes, qs.ChurnCutoff, f.Coverage*100, LowCoverage*100),
+			Confidence: 0.5 + 0.2*churnFactor + 0.2*gapFactor,
+			Tags:       []string{Kind, "churn", "low-coverage"},
+		})
+	}
+	return signals
+}
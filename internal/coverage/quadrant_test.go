@@ -0,0 +1,71 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package coverage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testReport() *Report {
+	return &Report{Files: map[string]File{
+		"mod/hot_untested.go":  {Covered: 1, Total: 10},
+		"mod/hot_tested.go":    {Covered: 9, Total: 10},
+		"mod/cold_untested.go": {Covered: 2, Total: 10},
+		"mod/cold_tested.go":   {Covered: 8, Total: 10},
+		"mod/empty.go":         {},
+	}}
+}
+
+func testChurn() []Churn {
+	return []Churn{
+		{Path: "cold_tested.go", Changes: 1},
+		{Path: "hot_tested.go", Changes: 12},
+		{Path: "cold_untested.go", Changes: 2},
+		{Path: "hot_untested.go", Changes: 20},
+		{Path: "empty.go", Changes: 30},
+		{Path: "deleted.go", Changes: 40},
+	}
+}
+
+func TestClassify(t *testing.T) {
+	qs := Classify(testChurn(), testReport())
+	assert.Equal(t, 12, qs.ChurnCutoff)
+	assert.Equal(t, []FileQuadrant{
+		{Path: "hot_untested.go", Changes: 20, Coverage: 0.1, Quadrant: Danger},
+		{Path: "cold_untested.go", Changes: 2, Coverage: 0.2, Quadrant: Untested},
+		{Path: "hot_tested.go", Changes: 12, Coverage: 0.9, Quadrant: Guarded},
+		{Path: "cold_tested.go", Changes: 1, Coverage: 0.8, Quadrant: Stable},
+	}, qs.Files, "files without coverage data are left out")
+	for _, q := range Order {
+		assert.Equal(t, 1, qs.Count(q), q)
+	}
+}
+
+func TestClassify_MinimumChurn(t *testing.T) {
+	churn := []Churn{{Path: "hot_untested.go", Changes: 2}, {Path: "cold_untested.go", Changes: 1}}
+	qs := Classify(churn, testReport())
+	assert.Equal(t, minHighChurn, qs.ChurnCutoff)
+	assert.Zero(t, qs.Count(Danger), "a quiet repository has no danger quadrant")
+
+	qs = Classify(nil, testReport())
+	assert.Empty(t, qs.Files)
+	assert.Empty(t, qs.Signals())
+}
+
+func TestSignals(t *testing.T) {
+	qs := Classify(testChurn(), testReport())
+	sigs := qs.Signals()
+	require.Len(t, sigs, 1)
+	sig := sigs[0]
+	assert.Equal(t, Kind, sig.Kind)
+	assert.Equal(t, "coverage", sig.Source)
+	assert.Equal(t, "hot_untested.go", sig.FilePath)
+	assert.Equal(t, "High churn, low coverage: hot_untested.go (20 changes, 10% covered)", sig.Title)
+	assert.Contains(t, sig.Description, "high churn is 12 or more")
+	assert.InDelta(t, 0.5+0.2*(8.0/12)+0.2*0.8, sig.Confidence, 1e-9)
+	assert.Equal(t, []string{"risk-quadrant", "churn", "low-coverage"}, sig.Tags)
+}
@@ -0,0 +1,291 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+// Package estimate assigns each signal a rough effort bucket — S, M, or L —
+// from features stringer can measure: the kind of work, the lines the
+// signal involves, the size of the file it is in, how often that file
+// changes, and, for dead code, how many references removal has to touch.
+// The buckets are a planning aid, not a promise; they exist so exported
+// issues arrive with an estimate instead of none.
+package estimate
+
+import (
+	"bufio"
+	"bytes"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+// Effort buckets.
+const (
+	Small  = "S"
+	Medium = "M"
+	Large  = "L"
+)
+
+// Minutes returns a nominal duration for an effort bucket, for trackers
+// whose estimate field is a time: an hour, half a day, or two days. It
+// returns 0 for an empty or unknown bucket.
+func Minutes(effort string) int {
+	switch effort {
+	case Small:
+		return 60
+	case Medium:
+		return 240
+	case Large:
+		return 960
+	}
+	return 0
+}
+
+// kindBase is the starting score for each kind: 1 for a local edit, 2 for a
+// change that needs some design or verification, 3 for a restructuring.
+// Kinds not listed start at 2.
+var kindBase = map[string]int{
+	"todo": 1, "fixme": 1, "hack": 1, "xxx": 1, "bug": 1, "optimize": 1,
+	"unused-function": 1, "unused-type": 1, "unreachable-code": 1, "dead-config-key": 1,
+	"mixed-line-endings": 1, "merge-conflict-marker": 1, "broken-doc-link": 1,
+	"stale-branch": 1, "local-replace": 1, "retracted-version": 1, "ci-risk": 1,
+	"vulnerable-dependency": 1, "stale-dependency": 1, "yanked-dependency": 1,
+
+	"large-file": 3, "circular-dependency": 3, "high-coupling": 3,
+	"archived-dependency": 3, "low-lottery-risk": 3, "knowledge-split": 3,
+	"large-batch-pattern": 3, "hotspot": 3,
+}
+
+// deadCodeKinds are the kinds whose removal cost grows with the references
+// left to the symbol.
+var deadCodeKinds = map[string]bool{
+	"unused-function": true,
+	"unused-type":     true,
+}
+
+var (
+	// linesPattern matches the line counts collectors put in titles:
+	// "(42 lines", "score 9.1, 42 lines,".
+	linesPattern = regexp.MustCompile(`\b(\d+) lines\b`)
+
+	// locationsPattern matches the clone count in duplication titles.
+	locationsPattern = regexp.MustCompile(`\b(\d+) locations\b`)
+
+	// rangePattern matches the "Lines 10-42" span in complexity descriptions.
+	rangePattern = regexp.MustCompile(`\bLines (\d+)-(\d+)\b`)
+
+	// churnPattern matches the modification count in churn titles.
+	churnPattern = regexp.MustCompile(`modified (\d+) times`)
+
+	// unusedPattern extracts the symbol name from dead code titles.
+	unusedPattern = regexp.MustCompile(`^Unused \w+: (\S+)`)
+)
+
+// Annotate sets Effort on each signal that has none and returns how many it
+// estimated. File paths are relative to root. all is the scan before
+// filtering; its churn signals tell which files change often even when
+// --kind or --min-confidence dropped them from signals.
+func Annotate(signals, all []signal.RawSignal, root string) int {
+	e := &estimator{
+		root:   root,
+		churn:  churnCounts(all),
+		sizes:  make(map[string]int),
+		source: make(map[string][]byte),
+	}
+	n := 0
+	for i := range signals {
+		if signals[i].Effort != "" {
+			continue
+		}
+		signals[i].Effort = e.estimate(signals[i])
+		n++
+	}
+	return n
+}
+
+// estimator caches what it reads from the repository across signals.
+type estimator struct {
+	root   string
+	churn  map[string]int
+	sizes  map[string]int    // file path → line count, -1 if unreadable
+	source map[string][]byte // file path → contents, for dead code references
+}
+
+// estimate scores a signal and maps the score to a bucket.
+func (e *estimator) estimate(sig signal.RawSignal) string {
+	score, ok := kindBase[sig.Kind]
+	if !ok {
+		score = 2
+	}
+	score += linesScore(involvedLines(sig))
+	if sig.Kind != "large-file" {
+		score += sizeScore(e.fileLines(sig.FilePath))
+	}
+	score += churnScore(e.churn[sig.FilePath])
+	if deadCodeKinds[sig.Kind] {
+		score += referenceScore(e.references(sig))
+	}
+
+	switch {
+	case score <= 2:
+		return Small
+	case score <= 4:
+		return Medium
+	default:
+		return Large
+	}
+}
+
+// involvedLines returns the lines of code the signal covers, as reported in
+// its title or description, or 0 when the collector does not say. Clones
+// count every copy.
+func involvedLines(sig signal.RawSignal) int {
+	if m := rangePattern.FindStringSubmatch(sig.Description); m != nil {
+		start, _ := strconv.Atoi(m[1])
+		end, _ := strconv.Atoi(m[2])
+		if end >= start {
+			return end - start + 1
+		}
+	}
+	m := linesPattern.FindStringSubmatch(sig.Title)
+	if m == nil {
+		return 0
+	}
+	lines, _ := strconv.Atoi(m[1])
+	if loc := locationsPattern.FindStringSubmatch(sig.Title); loc != nil {
+		copies, _ := strconv.Atoi(loc[1])
+		lines *= max(copies, 1)
+	}
+	return lines
+}
+
+func linesScore(lines int) int {
+	switch {
+	case lines >= 300:
+		return 2
+	case lines >= 60:
+		return 1
+	}
+	return 0
+}
+
+func sizeScore(lines int) int {
+	switch {
+	case lines >= 1500:
+		return 2
+	case lines >= 500:
+		return 1
+	}
+	return 0
+}
+
+func churnScore(changes int) int {
+	switch {
+	case changes >= 20:
+		return 2
+	case changes > 0:
+		return 1
+	}
+	return 0
+}
+
+func referenceScore(refs int) int {
+	switch {
+	case refs > 3:
+		return 2
+	case refs > 0:
+		return 1
+	}
+	return 0
+}
+
+// churnCounts maps each file with a churn signal to its modification count.
+func churnCounts(signals []signal.RawSignal) map[string]int {
+	counts := make(map[string]int)
+	for _, sig := range signals {
+		if sig.Kind != "churn" || sig.FilePath == "" {
+			continue
+		}
+		n := 1
+		if m := churnPattern.FindStringSubmatch(sig.Title); m != nil {
+			n, _ = strconv.Atoi(m[1])
+		}
+		counts[sig.FilePath] = max(counts[sig.FilePath], n)
+	}
+	return counts
+}
+
+// fileLines returns the line count of path, or 0 if it cannot be read.
+func (e *estimator) fileLines(path string) int {
+	if path == "" {
+		return 0
+	}
+	if n, ok := e.sizes[path]; ok {
+		return max(n, 0)
+	}
+	n := -1
+	if data, err := os.ReadFile(filepath.Join(e.root, path)); err == nil { //nolint:gosec // path from a scan signal under root
+		n = bytes.Count(data, []byte("\n"))
+	}
+	e.sizes[path] = n
+	return max(n, 0)
+}
+
+// references counts the lines in the dead symbol's package directory that
+// mention it, other than its declaration. Unused code can still be named
+// by tests, docs, or reflection, and each mention has to go with it.
+func (e *estimator) references(sig signal.RawSignal) int {
+	m := unusedPattern.FindStringSubmatch(sig.Title)
+	if m == nil || sig.FilePath == "" {
+		return 0
+	}
+	name := m[1]
+	if i := strings.LastIndexByte(name, '.'); i >= 0 {
+		name = name[i+1:]
+	}
+	word := regexp.MustCompile(`\b` + regexp.QuoteMeta(name) + `\b`)
+
+	dir := filepath.Dir(sig.FilePath)
+	entries, err := os.ReadDir(filepath.Join(e.root, dir))
+	if err != nil {
+		return 0
+	}
+	refs := 0
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		path := filepath.Join(dir, entry.Name())
+		data := e.read(path)
+		if data == nil {
+			continue
+		}
+		sc := bufio.NewScanner(bytes.NewReader(data))
+		sc.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+		for line := 1; sc.Scan(); line++ {
+			if path == filepath.Clean(sig.FilePath) && line == sig.Line {
+				continue
+			}
+			if word.Match(sc.Bytes()) {
+				refs++
+			}
+		}
+	}
+	return refs
+}
+
+// read returns the contents of path, or nil if it cannot be read.
+func (e *estimator) read(path string) []byte {
+	path = filepath.Clean(path)
+	if data, ok := e.source[path]; ok {
+		return data
+	}
+	data, err := os.ReadFile(filepath.Join(e.root, path)) //nolint:gosec // path from a scan signal under root
+	if err != nil {
+		data = nil
+	}
+	e.source[path] = data
+	return data
+}
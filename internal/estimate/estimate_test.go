@@ -0,0 +1,97 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package estimate
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+func writeFile(t *testing.T, root, rel, content string) {
+	t.Helper()
+	path := filepath.Join(root, rel)
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o750))
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+}
+
+func lines(n int) string {
+	return strings.Repeat("x := 1\n", n)
+}
+
+func TestAnnotate(t *testing.T) {
+	root := t.TempDir()
+	writeFile(t, root, "small.go", lines(40))
+	writeFile(t, root, "big.go", lines(1600))
+	writeFile(t, root, "busy.go", lines(600))
+
+	signals := []signal.RawSignal{
+		{Kind: "todo", FilePath: "small.go", Line: 3, Title: "TODO: rename"},
+		{Kind: "todo", FilePath: "big.go", Line: 3, Title: "TODO: split"},
+		{Kind: "todo", FilePath: "busy.go", Line: 3, Title: "TODO: cache"},
+		{Kind: "complex-function", FilePath: "small.go", Title: "Complex function: parse (score 9.0, 120 lines, 14 branches)"},
+		{Kind: "code-clone", FilePath: "small.go", Title: "Duplicated block (40 lines, 4 locations)"},
+		{Kind: "circular-dependency", FilePath: "busy.go", Title: "Import cycle"},
+		{Kind: "large-file", FilePath: "big.go", Title: "Large file: big.go (1600 lines)"},
+		{Kind: "todo", FilePath: "gone.go", Title: "TODO: file deleted since scan"},
+		{Kind: "vulnerable-dependency", Title: "CVE in dep", Effort: Large},
+	}
+	all := append([]signal.RawSignal{
+		{Kind: "churn", FilePath: "busy.go", Title: "High churn: busy.go (modified 25 times in 90 days)"},
+	}, signals...)
+
+	n := Annotate(signals, all, root)
+	assert.Equal(t, 8, n, "signals with an effort are kept")
+
+	got := make([]string, len(signals))
+	for i, s := range signals {
+		got[i] = s.Effort
+	}
+	assert.Equal(t, []string{
+		Small,  // local edit in a small file
+		Medium, // local edit in a 1600-line file
+		Medium, // 600-line file that changed 25 times
+		Medium, // 120-line function
+		Medium, // 160 cloned lines across four copies
+		Large,  // restructuring in a busy file
+		Large,  // splitting a large file
+		Small,  // unreadable file adds nothing
+		Large,
+	}, got)
+}
+
+func TestAnnotate_DeadCodeReferences(t *testing.T) {
+	root := t.TempDir()
+	writeFile(t, root, "pkg/a.go", "package pkg\n\nfunc helper() {}\n")
+	writeFile(t, root, "pkg/a_test.go", "package pkg\n\nfunc TestHelper(t *testing.T) {\n\thelper()\n\thelper()\n\thelper()\n\thelper()\n}\n")
+	writeFile(t, root, "other/b.go", "package other\n\nfunc unusedType() {}\n")
+
+	signals := []signal.RawSignal{
+		{Kind: "unused-function", FilePath: "pkg/a.go", Line: 3, Title: "Unused function: helper"},
+		{Kind: "unused-function", FilePath: "other/b.go", Line: 3, Title: "Unused function: unusedType"},
+	}
+	Annotate(signals, nil, root)
+	assert.Equal(t, Medium, signals[0].Effort, "four test references have to be removed too")
+	assert.Equal(t, Small, signals[1].Effort, "the declaration is not a reference")
+}
+
+func TestInvolvedLines(t *testing.T) {
+	assert.Equal(t, 33, involvedLines(signal.RawSignal{Description: "Lines 10-42 in main.go"}))
+	assert.Equal(t, 120, involvedLines(signal.RawSignal{Title: "Complex function: f (score 7.0, 120 lines, 3 branches)"}))
+	assert.Equal(t, 90, involvedLines(signal.RawSignal{Title: "Near-duplicate block (30 lines, 3 locations, renamed identifiers)"}))
+	assert.Zero(t, involvedLines(signal.RawSignal{Title: "TODO: fix"}))
+}
+
+func TestMinutes(t *testing.T) {
+	assert.Equal(t, 60, Minutes(Small))
+	assert.Equal(t, 240, Minutes(Medium))
+	assert.Equal(t, 960, Minutes(Large))
+	assert.Zero(t, Minutes(""))
+}
@@ -0,0 +1,228 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+// Package feedback records accept/reject decisions on emitted signals and
+// turns them into per-kind confidence calibration.
+//
+// Decisions are appended to .stringer/feedback.jsonl, one JSON object per
+// line, and are intended to be version-controlled so a team's judgement
+// accumulates. When a kind has enough decisions, scans raise or lower the
+// confidence of its signals by an amount that follows its acceptance rate.
+package feedback
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"math"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/davetashner/stringer/internal/signal"
+	"github.com/davetashner/stringer/internal/statedir"
+	"github.com/davetashner/stringer/internal/testable"
+)
+
+// feedbackFile is the filename for recorded decisions under .stringer/.
+const feedbackFile = "feedback.jsonl"
+
+// FS is the file system implementation used by this package.
+// Override in tests with a testable.MockFileSystem.
+var FS testable.FileSystem = testable.DefaultFS
+
+// Decision is a reviewer's verdict on a signal.
+type Decision string
+
+const (
+	// Accept marks a signal as real work worth tracking.
+	Accept Decision = "accept"
+
+	// Reject marks a signal as noise.
+	Reject Decision = "reject"
+)
+
+// Entry is one recorded decision.
+type Entry struct {
+	SignalID  string    `json:"signal_id"`
+	Decision  Decision  `json:"decision"`
+	Kind      string    `json:"kind"`
+	Collector string    `json:"collector,omitempty"`
+	Comment   string    `json:"comment,omitempty"`
+	By        string    `json:"by,omitempty"`
+	At        time.Time `json:"at"`
+}
+
+// Load reads <repoPath>/.stringer/feedback.jsonl. If the file does not
+// exist, it returns (nil, nil). Blank lines are skipped.
+func Load(repoPath string) ([]Entry, error) {
+	data, err := FS.ReadFile(filepath.Join(repoPath, statedir.Name, feedbackFile))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var entries []Entry
+	sc := bufio.NewScanner(bytes.NewReader(data))
+	sc.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for n := 1; sc.Scan(); n++ {
+		line := bytes.TrimSpace(sc.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		var e Entry
+		if err := json.Unmarshal(line, &e); err != nil {
+			return nil, fmt.Errorf("%s line %d: %w", feedbackFile, n, err)
+		}
+		entries = append(entries, e)
+	}
+	return entries, sc.Err()
+}
+
+// Append adds entries to <repoPath>/.stringer/feedback.jsonl, creating the
+// file and the .stringer directory if needed. Earlier lines are kept, so
+// the file is a history of decisions; the latest one per signal counts.
+func Append(repoPath string, entries ...Entry) error {
+	dir := filepath.Join(repoPath, statedir.Name)
+	if err := FS.MkdirAll(dir, 0o750); err != nil {
+		return fmt.Errorf("create feedback directory: %w", err)
+	}
+	statedir.EnsureIgnore(FS, repoPath)
+
+	path := filepath.Join(dir, feedbackFile)
+	data, err := FS.ReadFile(path)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	if len(data) > 0 && data[len(data)-1] != '\n' {
+		data = append(data, '\n')
+	}
+	for _, e := range entries {
+		line, err := json.Marshal(e)
+		if err != nil {
+			return err
+		}
+		data = append(append(data, line...), '\n')
+	}
+	if err := FS.WriteFile(path, data, 0o644); err != nil { //nolint:gosec // feedback is meant to be committed
+		return fmt.Errorf("write feedback file: %w", err)
+	}
+	return nil
+}
+
+// Latest returns the most recent entry per signal ID, in file order of
+// those entries. A signal accepted and later rejected counts as rejected.
+func Latest(entries []Entry) []Entry {
+	last := make(map[string]int, len(entries))
+	for i, e := range entries {
+		last[e.SignalID] = i
+	}
+	out := make([]Entry, 0, len(last))
+	for i, e := range entries {
+		if last[e.SignalID] == i {
+			out = append(out, e)
+		}
+	}
+	return out
+}
+
+// MinDecisions is how many decisions a kind needs before its confidence
+// is adjusted. Fewer than that is too little evidence to act on.
+const MinDecisions = 3
+
+// MaxAdjustment bounds the confidence change for a kind. It is approached
+// only as a kind's decisions become many and unanimous.
+const MaxAdjustment = 0.2
+
+// priorWeight is how many neutral (half accepted) decisions are blended
+// into each kind's acceptance rate, so a handful of decisions moves
+// confidence less than a long record does.
+const priorWeight = 4
+
+// KindStats summarizes the decisions on one signal kind.
+type KindStats struct {
+	Kind       string  `json:"kind"`
+	Accepted   int     `json:"accepted"`
+	Rejected   int     `json:"rejected"`
+	Rate       float64 `json:"acceptance_rate"`
+	Adjustment float64 `json:"adjustment"`
+}
+
+// Calibration maps a signal kind to its confidence adjustment.
+type Calibration map[string]float64
+
+// Stats computes per-kind acceptance from the latest decision on each
+// signal, sorted by kind. Kinds with fewer than MinDecisions decisions get
+// no adjustment.
+func Stats(entries []Entry) []KindStats {
+	byKind := make(map[string]*KindStats)
+	for _, e := range Latest(entries) {
+		if e.Kind == "" {
+			continue
+		}
+		ks, ok := byKind[e.Kind]
+		if !ok {
+			ks = &KindStats{Kind: e.Kind}
+			byKind[e.Kind] = ks
+		}
+		switch e.Decision {
+		case Accept:
+			ks.Accepted++
+		case Reject:
+			ks.Rejected++
+		}
+	}
+
+	stats := make([]KindStats, 0, len(byKind))
+	for _, ks := range byKind {
+		n := ks.Accepted + ks.Rejected
+		if n == 0 {
+			continue
+		}
+		ks.Rate = float64(ks.Accepted) / float64(n)
+		if n >= MinDecisions {
+			smoothed := (float64(ks.Accepted) + priorWeight*0.5) / float64(n+priorWeight)
+			ks.Adjustment = math.Round((smoothed-0.5)*2*MaxAdjustment*100) / 100
+		}
+		stats = append(stats, *ks)
+	}
+	sort.Slice(stats, func(i, j int) bool { return stats[i].Kind < stats[j].Kind })
+	return stats
+}
+
+// Calibrate returns the confidence adjustments the entries support. Kinds
+// without an adjustment are left out.
+func Calibrate(entries []Entry) Calibration {
+	cal := make(Calibration)
+	for _, ks := range Stats(entries) {
+		if ks.Adjustment != 0 {
+			cal[ks.Kind] = ks.Adjustment
+		}
+	}
+	return cal
+}
+
+// Apply adjusts the confidence of signals whose kind has a calibration,
+// clamped to [0, 1]. It returns how many signals changed.
+func (c Calibration) Apply(signals []signal.RawSignal) int {
+	n := 0
+	for i := range signals {
+		adj, ok := c[signals[i].Kind]
+		if !ok {
+			continue
+		}
+		conf := math.Round(math.Min(1, math.Max(0, signals[i].Confidence+adj))*100) / 100
+		if conf != signals[i].Confidence {
+			signals[i].AddFactor("calibration", conf-signals[i].Confidence,
+				fmt.Sprintf("recorded feedback on %s signals adjusts them by %+.2f", signals[i].Kind, adj))
+			signals[i].Confidence = conf
+			n++
+		}
+	}
+	return n
+}
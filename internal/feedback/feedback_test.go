@@ -0,0 +1,115 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package feedback
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+func entry(id string, d Decision, kind string) Entry {
+	return Entry{SignalID: id, Decision: d, Kind: kind, At: time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)}
+}
+
+func TestLoad_Missing(t *testing.T) {
+	entries, err := Load(t.TempDir())
+	require.NoError(t, err)
+	assert.Nil(t, entries)
+}
+
+func TestAppendAndLoad(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, Append(dir, entry("str-00000001", Accept, "todo")))
+	require.NoError(t, Append(dir, entry("str-00000002", Reject, "churn"), entry("str-00000001", Reject, "todo")))
+
+	entries, err := Load(dir)
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+	assert.Equal(t, entry("str-00000002", Reject, "churn"), entries[1])
+
+	data, err := os.ReadFile(filepath.Join(dir, ".stringer", "feedback.jsonl"))
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `{"signal_id":"str-00000001","decision":"accept","kind":"todo","at":"2026-01-02T03:04:05Z"}`+"\n")
+	assert.FileExists(t, filepath.Join(dir, ".stringer", ".gitignore"))
+}
+
+func TestLoad_InvalidLine(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, ".stringer"), 0o750))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".stringer", "feedback.jsonl"),
+		[]byte(`{"signal_id":"str-00000001","decision":"accept","kind":"todo"}`+"\n\nnot json\n"), 0o600))
+
+	_, err := Load(dir)
+	assert.ErrorContains(t, err, "feedback.jsonl line 3")
+}
+
+func TestLatest(t *testing.T) {
+	got := Latest([]Entry{
+		entry("a", Accept, "todo"),
+		entry("b", Accept, "todo"),
+		entry("a", Reject, "todo"),
+	})
+	require.Len(t, got, 2)
+	assert.Equal(t, "b", got[0].SignalID)
+	assert.Equal(t, Reject, got[1].Decision, "the later decision wins")
+}
+
+func TestStats(t *testing.T) {
+	var entries []Entry
+	for i, d := range []Decision{Reject, Reject, Reject, Reject, Reject, Reject, Accept, Reject} {
+		entries = append(entries, entry(string(rune('a'+i)), d, "churn"))
+	}
+	entries = append(entries,
+		entry("t1", Accept, "todo"), entry("t2", Accept, "todo"), entry("t3", Accept, "todo"),
+		entry("f1", Reject, "fixme"), entry("f2", Reject, "fixme"),
+		entry("x", Accept, ""),
+	)
+
+	stats := Stats(entries)
+	require.Len(t, stats, 3)
+
+	assert.Equal(t, KindStats{Kind: "churn", Accepted: 1, Rejected: 7, Rate: 0.125, Adjustment: -0.1}, stats[0])
+	assert.Equal(t, "fixme", stats[1].Kind)
+	assert.Zero(t, stats[1].Adjustment, "two decisions are too few")
+	assert.Equal(t, KindStats{Kind: "todo", Accepted: 3, Rate: 1, Adjustment: 0.09}, stats[2])
+
+	assert.Equal(t, Calibration{"churn": -0.1, "todo": 0.09}, Calibrate(entries))
+}
+
+func TestStats_AdjustmentIsBounded(t *testing.T) {
+	var entries []Entry
+	for i := 0; i < 1000; i++ {
+		entries = append(entries, entry(string(rune(i)), Accept, "todo"))
+	}
+	adj := Stats(entries)[0].Adjustment
+	assert.Greater(t, adj, 0.19)
+	assert.LessOrEqual(t, adj, MaxAdjustment)
+}
+
+func TestCalibrationApply(t *testing.T) {
+	signals := []signal.RawSignal{
+		{Kind: "todo", Confidence: 0.5},
+		{Kind: "todo", Confidence: 0.95},
+		{Kind: "churn", Confidence: 0.05},
+		{Kind: "revert", Confidence: 0.7},
+	}
+	n := Calibration{"todo": 0.09, "churn": -0.1}.Apply(signals)
+	assert.Equal(t, 3, n)
+	assert.Equal(t, 0.59, signals[0].Confidence)
+	assert.Equal(t, 1.0, signals[1].Confidence, "clamped to 1")
+	assert.Equal(t, 0.0, signals[2].Confidence, "clamped to 0")
+	assert.Equal(t, 0.7, signals[3].Confidence)
+
+	assert.Equal(t, []signal.ConfidenceFactor{{Name: "calibration", Delta: 0.09,
+		Detail: "recorded feedback on todo signals adjusts them by +0.09"}}, signals[0].Factors)
+	assert.Equal(t, -0.05, signals[2].Factors[0].Delta, "the recorded change stops at 0")
+	assert.Empty(t, signals[3].Factors)
+}
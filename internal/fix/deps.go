@@ -0,0 +1,273 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+// Package fix turns detected signals into executed changes. Unlike the rest
+// of stringer, which is strictly read-only, everything in this package
+// modifies the target repository and is only reachable through the explicit
+// `stringer fix` subcommands.
+package fix
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/davetashner/stringer/internal/testable"
+)
+
+// Supported dependency ecosystems.
+const (
+	EcosystemGo  = "go"
+	EcosystemNpm = "npm"
+)
+
+// executor is the package-level CommandExecutor used to run ecosystem
+// tooling (go, npm) and git. It defaults to the real os/exec implementation.
+var executor testable.CommandExecutor = testable.DefaultExecutor()
+
+// SetExecutor replaces the package-level CommandExecutor. Pass nil to restore
+// the default production executor. This is intended for testing.
+func SetExecutor(e testable.CommandExecutor) {
+	if e == nil {
+		executor = testable.DefaultExecutor()
+		return
+	}
+	executor = e
+}
+
+// FS is the file system implementation used by this package.
+// Override in tests with a testable.MockFileSystem.
+var FS testable.FileSystem = testable.DefaultFS
+
+// DepUpdate describes a single direct dependency that has a newer release.
+type DepUpdate struct {
+	Ecosystem string `json:"ecosystem"`
+	Name      string `json:"name"`
+	Current   string `json:"current"`
+	Latest    string `json:"latest"`
+}
+
+// goListModule is the subset of `go list -m -u -json` output we need.
+type goListModule struct {
+	Path     string
+	Version  string
+	Main     bool
+	Indirect bool
+	Update   *struct {
+		Version string
+	}
+}
+
+// npmOutdatedEntry is the subset of `npm outdated --json` output we need.
+type npmOutdatedEntry struct {
+	Current string `json:"current"`
+	Latest  string `json:"latest"`
+}
+
+// Ecosystems returns the supported ecosystems detected at repoPath, based on
+// the presence of their root manifest files.
+func Ecosystems(repoPath string) []string {
+	var found []string
+	if _, err := FS.Stat(filepath.Join(repoPath, "go.mod")); err == nil {
+		found = append(found, EcosystemGo)
+	}
+	if _, err := FS.Stat(filepath.Join(repoPath, "package.json")); err == nil {
+		found = append(found, EcosystemNpm)
+	}
+	return found
+}
+
+// FindOutdatedDeps asks each detected ecosystem's own tooling which direct
+// dependencies have a newer release available. Results are sorted by
+// ecosystem, then name.
+func FindOutdatedDeps(ctx context.Context, repoPath string) ([]DepUpdate, error) {
+	var updates []DepUpdate
+	for _, eco := range Ecosystems(repoPath) {
+		var (
+			found []DepUpdate
+			err   error
+		)
+		switch eco {
+		case EcosystemGo:
+			found, err = outdatedGo(ctx, repoPath)
+		case EcosystemNpm:
+			found, err = outdatedNpm(ctx, repoPath)
+		}
+		if err != nil {
+			return nil, err
+		}
+		updates = append(updates, found...)
+	}
+	sort.Slice(updates, func(i, j int) bool {
+		if updates[i].Ecosystem != updates[j].Ecosystem {
+			return updates[i].Ecosystem < updates[j].Ecosystem
+		}
+		return updates[i].Name < updates[j].Name
+	})
+	return updates, nil
+}
+
+// outdatedGo lists direct Go module requirements with available updates.
+func outdatedGo(ctx context.Context, repoPath string) ([]DepUpdate, error) {
+	out, err := run(ctx, repoPath, "go", "list", "-m", "-u", "-json", "all")
+	if err != nil {
+		return nil, err
+	}
+
+	var updates []DepUpdate
+	dec := json.NewDecoder(strings.NewReader(out))
+	for {
+		var m goListModule
+		if err := dec.Decode(&m); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, fmt.Errorf("parsing go list output: %w", err)
+		}
+		if m.Main || m.Indirect || m.Update == nil {
+			continue
+		}
+		updates = append(updates, DepUpdate{
+			Ecosystem: EcosystemGo,
+			Name:      m.Path,
+			Current:   m.Version,
+			Latest:    m.Update.Version,
+		})
+	}
+	return updates, nil
+}
+
+// outdatedNpm lists direct npm dependencies with newer releases. npm exits
+// non-zero whenever anything is outdated, so stdout is parsed regardless of
+// the exit status.
+func outdatedNpm(ctx context.Context, repoPath string) ([]DepUpdate, error) {
+	out, err := run(ctx, repoPath, "npm", "outdated", "--json")
+	if err != nil && strings.TrimSpace(out) == "" {
+		return nil, err
+	}
+	if strings.TrimSpace(out) == "" {
+		return nil, nil
+	}
+
+	var entries map[string]npmOutdatedEntry
+	if err := json.Unmarshal([]byte(out), &entries); err != nil {
+		return nil, fmt.Errorf("parsing npm outdated output: %w", err)
+	}
+
+	updates := make([]DepUpdate, 0, len(entries))
+	for name, e := range entries {
+		if e.Latest == "" || e.Latest == e.Current {
+			continue
+		}
+		updates = append(updates, DepUpdate{
+			Ecosystem: EcosystemNpm,
+			Name:      name,
+			Current:   e.Current,
+			Latest:    e.Latest,
+		})
+	}
+	return updates, nil
+}
+
+// SelectUpdates filters updates to those whose Name appears in names. An
+// empty names list selects everything. Unknown names are returned so the
+// caller can report them.
+func SelectUpdates(updates []DepUpdate, names []string) (selected []DepUpdate, unknown []string) {
+	if len(names) == 0 {
+		return updates, nil
+	}
+	want := make(map[string]bool, len(names))
+	for _, n := range names {
+		want[n] = true
+	}
+	matched := make(map[string]bool, len(names))
+	for _, u := range updates {
+		if want[u.Name] {
+			selected = append(selected, u)
+			matched[u.Name] = true
+		}
+	}
+	for _, n := range names {
+		if !matched[n] {
+			unknown = append(unknown, n)
+		}
+	}
+	return selected, unknown
+}
+
+// ApplyUpdates bumps each dependency to its latest release using the
+// ecosystem's package manager. Go modules are tidied once afterwards.
+func ApplyUpdates(ctx context.Context, repoPath string, updates []DepUpdate) error {
+	touchedGo := false
+	for _, u := range updates {
+		switch u.Ecosystem {
+		case EcosystemGo:
+			if _, err := run(ctx, repoPath, "go", "get", u.Name+"@"+u.Latest); err != nil {
+				return fmt.Errorf("updating %s: %w", u.Name, err)
+			}
+			touchedGo = true
+		case EcosystemNpm:
+			if _, err := run(ctx, repoPath, "npm", "install", u.Name+"@"+u.Latest); err != nil {
+				return fmt.Errorf("updating %s: %w", u.Name, err)
+			}
+		default:
+			return fmt.Errorf("updating %s: unsupported ecosystem %q", u.Name, u.Ecosystem)
+		}
+	}
+	if touchedGo {
+		if _, err := run(ctx, repoPath, "go", "mod", "tidy"); err != nil {
+			return fmt.Errorf("tidying go.mod: %w", err)
+		}
+	}
+	return nil
+}
+
+// RunChecks builds and tests the ecosystems touched by updates so a broken
+// bump is caught before it is proposed.
+func RunChecks(ctx context.Context, repoPath string, updates []DepUpdate) error {
+	ecosystems := make(map[string]bool)
+	for _, u := range updates {
+		ecosystems[u.Ecosystem] = true
+	}
+	if ecosystems[EcosystemGo] {
+		if _, err := run(ctx, repoPath, "go", "build", "./..."); err != nil {
+			return fmt.Errorf("go build: %w", err)
+		}
+		if _, err := run(ctx, repoPath, "go", "test", "./..."); err != nil {
+			return fmt.Errorf("go test: %w", err)
+		}
+	}
+	if ecosystems[EcosystemNpm] {
+		if _, err := run(ctx, repoPath, "npm", "test"); err != nil {
+			return fmt.Errorf("npm test: %w", err)
+		}
+	}
+	return nil
+}
+
+// run executes name with args in dir and returns stdout. On failure the
+// returned error carries the trimmed stderr, and stdout is still returned so
+// callers can inspect tools that signal results through the exit status.
+func run(ctx context.Context, dir, name string, args ...string) (string, error) {
+	cmd := executor.CommandContext(ctx, name, args...)
+	cmd.Dir = dir
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		msg := strings.TrimSpace(stderr.String())
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) && msg != "" {
+			return string(out), fmt.Errorf("%s %s: %s", name, strings.Join(args, " "), msg)
+		}
+		return string(out), fmt.Errorf("%s %s: %w", name, strings.Join(args, " "), err)
+	}
+	return string(out), nil
+}
@@ -0,0 +1,166 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package fix
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davetashner/stringer/internal/testable"
+)
+
+func withMockExecutor(t *testing.T, m *testable.MockCommandExecutor) {
+	t.Helper()
+	SetExecutor(m)
+	t.Cleanup(func() { SetExecutor(nil) })
+}
+
+// go list emits a stream of JSON objects; the mock executor cannot reproduce
+// newlines, so they are space separated here.
+const goListOutput = `{"Path":"example.com/app","Main":true} ` +
+	`{"Path":"github.com/a/direct","Version":"v1.0.0","Update":{"Path":"github.com/a/direct","Version":"v1.2.0"}} ` +
+	`{"Path":"github.com/b/indirect","Version":"v0.1.0","Indirect":true,"Update":{"Path":"github.com/b/indirect","Version":"v0.2.0"}} ` +
+	`{"Path":"github.com/c/current","Version":"v2.0.0"}`
+
+func TestEcosystems(t *testing.T) {
+	dir := t.TempDir()
+	assert.Empty(t, Ecosystems(dir))
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "go.mod"), []byte("module x\n"), 0o600))
+	assert.Equal(t, []string{EcosystemGo}, Ecosystems(dir))
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "package.json"), []byte("{}"), 0o600))
+	assert.Equal(t, []string{EcosystemGo, EcosystemNpm}, Ecosystems(dir))
+}
+
+func TestFindOutdatedDeps_Go(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "go.mod"), []byte("module x\n"), 0o600))
+	withMockExecutor(t, &testable.MockCommandExecutor{
+		CommandOutputs: map[string]string{"go list -m -u -json all": goListOutput},
+	})
+
+	updates, err := FindOutdatedDeps(context.Background(), dir)
+	require.NoError(t, err)
+	require.Len(t, updates, 1)
+	assert.Equal(t, DepUpdate{
+		Ecosystem: EcosystemGo,
+		Name:      "github.com/a/direct",
+		Current:   "v1.0.0",
+		Latest:    "v1.2.0",
+	}, updates[0])
+}
+
+func TestFindOutdatedDeps_GoListFails(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "go.mod"), []byte("module x\n"), 0o600))
+	withMockExecutor(t, &testable.MockCommandExecutor{
+		CommandErrors: map[string]string{"go list -m -u -json all": "network unreachable"},
+	})
+
+	_, err := FindOutdatedDeps(context.Background(), dir)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "network unreachable")
+}
+
+func TestFindOutdatedDeps_Npm(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "package.json"), []byte("{}"), 0o600))
+	withMockExecutor(t, &testable.MockCommandExecutor{
+		CommandOutputs: map[string]string{
+			"npm outdated --json": `{"lodash":{"current":"4.17.0","wanted":"4.17.21","latest":"4.17.21"},"react":{"current":"18.0.0","wanted":"18.0.0","latest":"18.0.0"}}`,
+		},
+	})
+
+	updates, err := FindOutdatedDeps(context.Background(), dir)
+	require.NoError(t, err)
+	require.Len(t, updates, 1)
+	assert.Equal(t, "lodash", updates[0].Name)
+	assert.Equal(t, "4.17.21", updates[0].Latest)
+}
+
+func TestFindOutdatedDeps_NpmNothingOutdated(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "package.json"), []byte("{}"), 0o600))
+	withMockExecutor(t, &testable.MockCommandExecutor{})
+
+	updates, err := FindOutdatedDeps(context.Background(), dir)
+	require.NoError(t, err)
+	assert.Empty(t, updates)
+}
+
+func TestSelectUpdates(t *testing.T) {
+	all := []DepUpdate{{Name: "a"}, {Name: "b"}, {Name: "c"}}
+
+	sel, unknown := SelectUpdates(all, nil)
+	assert.Equal(t, all, sel)
+	assert.Empty(t, unknown)
+
+	sel, unknown = SelectUpdates(all, []string{"b", "zzz"})
+	assert.Equal(t, []DepUpdate{{Name: "b"}}, sel)
+	assert.Equal(t, []string{"zzz"}, unknown)
+}
+
+func TestApplyUpdates(t *testing.T) {
+	m := &testable.MockCommandExecutor{}
+	withMockExecutor(t, m)
+
+	err := ApplyUpdates(context.Background(), t.TempDir(), []DepUpdate{
+		{Ecosystem: EcosystemGo, Name: "github.com/a/direct", Latest: "v1.2.0"},
+		{Ecosystem: EcosystemNpm, Name: "lodash", Latest: "4.17.21"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"go get github.com/a/direct@v1.2.0",
+		"npm install lodash@4.17.21",
+		"go mod tidy",
+	}, m.Calls)
+}
+
+func TestApplyUpdates_Failure(t *testing.T) {
+	withMockExecutor(t, &testable.MockCommandExecutor{
+		CommandErrors: map[string]string{"go get github.com/a/direct@v1.2.0": "no matching versions"},
+	})
+
+	err := ApplyUpdates(context.Background(), t.TempDir(), []DepUpdate{
+		{Ecosystem: EcosystemGo, Name: "github.com/a/direct", Latest: "v1.2.0"},
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "updating github.com/a/direct")
+	assert.Contains(t, err.Error(), "no matching versions")
+}
+
+func TestApplyUpdates_UnsupportedEcosystem(t *testing.T) {
+	withMockExecutor(t, &testable.MockCommandExecutor{})
+	err := ApplyUpdates(context.Background(), t.TempDir(), []DepUpdate{{Ecosystem: "cargo", Name: "serde"}})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unsupported ecosystem")
+}
+
+func TestRunChecks(t *testing.T) {
+	m := &testable.MockCommandExecutor{}
+	withMockExecutor(t, m)
+
+	err := RunChecks(context.Background(), t.TempDir(), []DepUpdate{
+		{Ecosystem: EcosystemGo}, {Ecosystem: EcosystemNpm},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"go build ./...", "go test ./...", "npm test"}, m.Calls)
+}
+
+func TestRunChecks_TestFailure(t *testing.T) {
+	withMockExecutor(t, &testable.MockCommandExecutor{
+		CommandErrors: map[string]string{"go test ./...": "FAIL example.com/app"},
+	})
+
+	err := RunChecks(context.Background(), t.TempDir(), []DepUpdate{{Ecosystem: EcosystemGo}})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "go test")
+	assert.Contains(t, err.Error(), "FAIL example.com/app")
+}
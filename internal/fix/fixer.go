@@ -0,0 +1,268 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package fix
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/pmezard/go-difflib/difflib"
+
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+// Fixer produces machine-applicable changes for signals it understands.
+// Fixers never touch disk directly; they read and write through a Workspace
+// so that several fixers can compose on the same file and the combined result
+// can be previewed before it is applied.
+type Fixer interface {
+	// Name returns the unique name of this fixer (e.g., "resolved-todo").
+	Name() string
+
+	// Description returns a one-line summary shown in `stringer fix --list`.
+	Description() string
+
+	// Collectors returns the collectors whose signals this fixer consumes.
+	// An empty result means the fixer does not need scan signals.
+	Collectors() []string
+
+	// Fix applies the fixer's edits for signals to ws.
+	Fix(ctx context.Context, ws *Workspace, signals []signal.RawSignal) error
+}
+
+var (
+	mu       sync.RWMutex
+	registry = make(map[string]Fixer)
+)
+
+// ErrAlreadyRegistered is returned by TryRegister when a fixer with the same
+// Name() is already in the registry. Wrapped with the offending name.
+var ErrAlreadyRegistered = errors.New("fixer already registered")
+
+// TryRegister adds a fixer to the global registry and returns an error if a
+// fixer with the same Name() is already registered.
+func TryRegister(f Fixer) error {
+	mu.Lock()
+	defer mu.Unlock()
+	name := f.Name()
+	if _, exists := registry[name]; exists {
+		return fmt.Errorf("%w: %s", ErrAlreadyRegistered, name)
+	}
+	registry[name] = f
+	return nil
+}
+
+// Register adds a fixer to the global registry. It panics if a fixer with the
+// same Name() is already registered. Intended for use from package init().
+func Register(f Fixer) {
+	if err := TryRegister(f); err != nil {
+		panic(err.Error())
+	}
+}
+
+// Get returns the fixer with the given name, or nil if not found.
+func Get(name string) Fixer {
+	mu.RLock()
+	defer mu.RUnlock()
+	return registry[name]
+}
+
+// List returns the names of all registered fixers, sorted.
+func List() []string {
+	mu.RLock()
+	defer mu.RUnlock()
+	names := make([]string, 0, len(registry))
+	for name := range registry {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Change is the net edit a fix run makes to a single file.
+type Change struct {
+	FilePath string   // relative to the repository root
+	Before   []byte   // original content
+	After    []byte   // content with all fixes applied
+	Fixers   []string // names of the fixers that touched the file, in order
+}
+
+// Workspace is an in-memory overlay of the repository. Reads fall through to
+// disk until a file is written; writes are only persisted by Apply.
+type Workspace struct {
+	root     string
+	original map[string][]byte
+	current  map[string][]byte
+	touched  map[string][]string
+	fixer    string
+}
+
+// NewWorkspace returns an empty overlay rooted at repoPath.
+func NewWorkspace(repoPath string) *Workspace {
+	return &Workspace{
+		root:     repoPath,
+		original: make(map[string][]byte),
+		current:  make(map[string][]byte),
+		touched:  make(map[string][]string),
+	}
+}
+
+// Root returns the repository root the workspace is layered over.
+func (w *Workspace) Root() string { return w.root }
+
+// ReadFile returns the current content of relPath, including edits made by
+// earlier fixers in the same run.
+func (w *Workspace) ReadFile(relPath string) ([]byte, error) {
+	relPath = filepath.ToSlash(relPath)
+	if data, ok := w.current[relPath]; ok {
+		return data, nil
+	}
+	data, err := FS.ReadFile(filepath.Join(w.root, filepath.FromSlash(relPath)))
+	if err != nil {
+		return nil, err
+	}
+	w.original[relPath] = data
+	w.current[relPath] = data
+	return data, nil
+}
+
+// WriteFile records new content for relPath. The file must already exist;
+// fixers edit files, they do not create them.
+func (w *Workspace) WriteFile(relPath string, data []byte) error {
+	relPath = filepath.ToSlash(relPath)
+	if _, ok := w.current[relPath]; !ok {
+		if _, err := w.ReadFile(relPath); err != nil {
+			return err
+		}
+	}
+	if bytes.Equal(w.current[relPath], data) {
+		return nil
+	}
+	w.current[relPath] = data
+	names := w.touched[relPath]
+	if w.fixer != "" && (len(names) == 0 || names[len(names)-1] != w.fixer) {
+		w.touched[relPath] = append(names, w.fixer)
+	}
+	return nil
+}
+
+// Changes returns the files whose content differs from disk, sorted by path.
+func (w *Workspace) Changes() []Change {
+	var changes []Change
+	for path, after := range w.current {
+		before := w.original[path]
+		if bytes.Equal(before, after) {
+			continue
+		}
+		changes = append(changes, Change{
+			FilePath: path,
+			Before:   before,
+			After:    after,
+			Fixers:   w.touched[path],
+		})
+	}
+	sort.Slice(changes, func(i, j int) bool { return changes[i].FilePath < changes[j].FilePath })
+	return changes
+}
+
+// Run executes fixers in order against ws. Each fixer receives only the
+// signals produced by the collectors it declares.
+func Run(ctx context.Context, ws *Workspace, fixers []Fixer, signals []signal.RawSignal) error {
+	for _, f := range fixers {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		ws.fixer = f.Name()
+		err := f.Fix(ctx, ws, signalsFor(f, signals))
+		ws.fixer = ""
+		if err != nil {
+			return fmt.Errorf("fixer %s: %w", f.Name(), err)
+		}
+	}
+	return nil
+}
+
+// signalsFor filters signals to those produced by the fixer's collectors.
+func signalsFor(f Fixer, signals []signal.RawSignal) []signal.RawSignal {
+	sources := f.Collectors()
+	if len(sources) == 0 {
+		return nil
+	}
+	want := make(map[string]bool, len(sources))
+	for _, s := range sources {
+		want[s] = true
+	}
+	var out []signal.RawSignal
+	for _, sig := range signals {
+		if want[sig.Source] {
+			out = append(out, sig)
+		}
+	}
+	return out
+}
+
+// Apply writes every change to disk, preserving each file's permissions.
+func Apply(repoPath string, changes []Change) error {
+	for _, c := range changes {
+		path := filepath.Join(repoPath, filepath.FromSlash(c.FilePath))
+		mode := os.FileMode(0o644)
+		if info, err := FS.Stat(path); err == nil {
+			mode = info.Mode().Perm()
+		}
+		if err := FS.WriteFile(path, c.After, mode); err != nil {
+			return fmt.Errorf("writing %s: %w", c.FilePath, err)
+		}
+	}
+	return nil
+}
+
+// UnifiedDiff renders a change as a unified diff with three lines of context.
+func UnifiedDiff(c Change) string {
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(string(c.Before)),
+		B:        difflib.SplitLines(string(c.After)),
+		FromFile: "a/" + c.FilePath,
+		ToFile:   "b/" + c.FilePath,
+		Context:  3,
+	})
+	if err != nil {
+		// difflib only fails on writer errors, which a strings.Builder never returns.
+		return ""
+	}
+	return diff
+}
+
+// Summary renders a changed-files summary, one line per file.
+func Summary(changes []Change) string {
+	var b strings.Builder
+	for _, c := range changes {
+		added, removed := lineDelta(c)
+		fmt.Fprintf(&b, "  %s (+%d -%d) [%s]\n", c.FilePath, added, removed, strings.Join(c.Fixers, ", "))
+	}
+	return b.String()
+}
+
+// lineDelta counts added and removed lines between Before and After.
+func lineDelta(c Change) (added, removed int) {
+	m := difflib.NewMatcher(difflib.SplitLines(string(c.Before)), difflib.SplitLines(string(c.After)))
+	for _, op := range m.GetOpCodes() {
+		switch op.Tag {
+		case 'd':
+			removed += op.I2 - op.I1
+		case 'i':
+			added += op.J2 - op.J1
+		case 'r':
+			removed += op.I2 - op.I1
+			added += op.J2 - op.J1
+		}
+	}
+	return added, removed
+}
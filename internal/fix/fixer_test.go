@@ -0,0 +1,169 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package fix
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+// stubFixer appends a suffix to every file named by its signals.
+type stubFixer struct {
+	name    string
+	sources []string
+	suffix  string
+	err     error
+	got     []signal.RawSignal
+}
+
+func (s *stubFixer) Name() string         { return s.name }
+func (s *stubFixer) Description() string  { return "stub" }
+func (s *stubFixer) Collectors() []string { return s.sources }
+
+func (s *stubFixer) Fix(_ context.Context, ws *Workspace, signals []signal.RawSignal) error {
+	s.got = signals
+	if s.err != nil {
+		return s.err
+	}
+	for _, sig := range signals {
+		data, err := ws.ReadFile(sig.FilePath)
+		if err != nil {
+			return err
+		}
+		if err := ws.WriteFile(sig.FilePath, append(append([]byte{}, data...), s.suffix...)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeFile(t *testing.T, dir, rel, content string) {
+	t.Helper()
+	path := filepath.Join(dir, filepath.FromSlash(rel))
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o750))
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+}
+
+func TestRegistry_BuiltinsRegistered(t *testing.T) {
+	names := List()
+	assert.Contains(t, names, "resolved-todo")
+	assert.Contains(t, names, "license-header")
+	assert.Contains(t, names, "line-endings")
+	assert.IsIncreasing(t, names)
+	assert.NotNil(t, Get("line-endings"))
+	assert.Nil(t, Get("nope"))
+}
+
+func TestTryRegister_Duplicate(t *testing.T) {
+	err := TryRegister(&LineEndingsFixer{})
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, ErrAlreadyRegistered))
+	assert.Panics(t, func() { Register(&LineEndingsFixer{}) })
+}
+
+func TestRun_ComposesFixersOnSameFile(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "a.txt", "base\n")
+	sigs := []signal.RawSignal{
+		{Source: "one", FilePath: "a.txt"},
+		{Source: "two", FilePath: "a.txt"},
+		{Source: "other", FilePath: "a.txt"},
+	}
+	first := &stubFixer{name: "first", sources: []string{"one"}, suffix: "1\n"}
+	second := &stubFixer{name: "second", sources: []string{"two"}, suffix: "2\n"}
+
+	ws := NewWorkspace(dir)
+	require.NoError(t, Run(context.Background(), ws, []Fixer{first, second}, sigs))
+
+	assert.Len(t, first.got, 1, "fixer only receives signals from its collectors")
+	changes := ws.Changes()
+	require.Len(t, changes, 1)
+	assert.Equal(t, "a.txt", changes[0].FilePath)
+	assert.Equal(t, "base\n", string(changes[0].Before))
+	assert.Equal(t, "base\n1\n2\n", string(changes[0].After))
+	assert.Equal(t, []string{"first", "second"}, changes[0].Fixers)
+
+	// Nothing is written until Apply.
+	data, err := os.ReadFile(filepath.Join(dir, "a.txt"))
+	require.NoError(t, err)
+	assert.Equal(t, "base\n", string(data))
+}
+
+func TestRun_NoSourcesGetsNoSignals(t *testing.T) {
+	f := &stubFixer{name: "none"}
+	require.NoError(t, Run(context.Background(), NewWorkspace(t.TempDir()), []Fixer{f},
+		[]signal.RawSignal{{Source: "todos"}}))
+	assert.Nil(t, f.got)
+}
+
+func TestRun_Error(t *testing.T) {
+	f := &stubFixer{name: "broken", err: errors.New("boom")}
+	err := Run(context.Background(), NewWorkspace(t.TempDir()), []Fixer{f}, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "fixer broken: boom")
+}
+
+func TestRun_Canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err := Run(ctx, NewWorkspace(t.TempDir()), []Fixer{&stubFixer{name: "x"}}, nil)
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestWorkspace_UnchangedWriteIsNotAChange(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "a.txt", "same\n")
+	ws := NewWorkspace(dir)
+	require.NoError(t, ws.WriteFile("a.txt", []byte("same\n")))
+	assert.Empty(t, ws.Changes())
+}
+
+func TestWorkspace_WriteMissingFile(t *testing.T) {
+	ws := NewWorkspace(t.TempDir())
+	assert.Error(t, ws.WriteFile("missing.txt", []byte("x")))
+}
+
+func TestApply(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "sub/a.txt", "old\n")
+	require.NoError(t, os.Chmod(filepath.Join(dir, "sub/a.txt"), 0o640))
+
+	err := Apply(dir, []Change{{FilePath: "sub/a.txt", Before: []byte("old\n"), After: []byte("new\n")}})
+	require.NoError(t, err)
+
+	path := filepath.Join(dir, "sub", "a.txt")
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "new\n", string(data))
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o640), info.Mode().Perm())
+}
+
+func TestUnifiedDiff(t *testing.T) {
+	diff := UnifiedDiff(Change{
+		FilePath: "a.go",
+		Before:   []byte("one\ntwo\nthree\n"),
+		After:    []byte("one\nTWO\nthree\n"),
+	})
+	assert.Contains(t, diff, "--- a/a.go\n+++ b/a.go\n")
+	assert.Contains(t, diff, "-two\n+TWO\n")
+}
+
+func TestSummary(t *testing.T) {
+	out := Summary([]Change{
+		{FilePath: "a.go", Before: []byte("x\n"), After: []byte("h\n\nx\n"), Fixers: []string{"license-header"}},
+		{FilePath: "b.go", Before: []byte("x\ny\n"), After: []byte("x\n"), Fixers: []string{"resolved-todo", "license-header"}},
+	})
+	assert.Contains(t, out, "a.go (+2 -0) [license-header]")
+	assert.Contains(t, out, "b.go (+0 -1) [resolved-todo, license-header]")
+}
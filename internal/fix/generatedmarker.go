@@ -0,0 +1,100 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package fix
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"path"
+	"regexp"
+
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+func init() {
+	Register(&GeneratedMarkerFixer{})
+}
+
+// goGeneratedMarker matches the comment gofmt, go vet, and linters use to
+// recognize generated Go files (see "go help generate").
+var goGeneratedMarker = regexp.MustCompile(`(?m)^// Code generated .* DO NOT EDIT\.\r?$`)
+
+// looseGeneratedMarker matches header comments that say a file is generated
+// without using the standard wording, such as "// Auto-generated by protoc".
+var looseGeneratedMarker = regexp.MustCompile(`(?i)^//.*\b(?:auto-?generated|generated by|do not edit)\b`)
+
+// generatorName extracts the tool named in a loose marker.
+var generatorName = regexp.MustCompile(`(?i)\bgenerated by ([\w./-]*\w)`)
+
+// GeneratedMarkerFixer rewrites non-standard "generated" header comments in
+// Go files flagged as large into the standard "Code generated ... DO NOT
+// EDIT." marker, so gofmt, linters, and stringer itself skip them.
+type GeneratedMarkerFixer struct{}
+
+// Name returns the fixer name.
+func (f *GeneratedMarkerFixer) Name() string { return "generated-marker" }
+
+// Description returns a one-line summary of the fixer.
+func (f *GeneratedMarkerFixer) Description() string {
+	return "Rewrite non-standard generated-file comments in large Go files to the gofmt marker"
+}
+
+// Collectors returns the collectors this fixer consumes.
+func (f *GeneratedMarkerFixer) Collectors() []string { return []string{"patterns"} }
+
+// Fix rewrites the marker of each flagged generated Go file.
+func (f *GeneratedMarkerFixer) Fix(_ context.Context, ws *Workspace, signals []signal.RawSignal) error {
+	for _, sig := range signals {
+		if sig.Kind != "large-file" || path.Ext(sig.FilePath) != ".go" {
+			continue
+		}
+		data, err := ws.ReadFile(sig.FilePath)
+		if err != nil {
+			slog.Warn("generated-marker: cannot read file", "file", sig.FilePath, "error", err)
+			continue
+		}
+		if fixed, ok := standardizeGeneratedMarker(data); ok {
+			if err := ws.WriteFile(sig.FilePath, fixed); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// standardizeGeneratedMarker replaces the first loose generated-file comment
+// in the header of a Go file (the comments before the package clause) with
+// the standard marker on the first line. It reports false when the file
+// already has the standard marker or its header does not say it is
+// generated.
+func standardizeGeneratedMarker(data []byte) ([]byte, bool) {
+	if goGeneratedMarker.Match(data) {
+		return nil, false
+	}
+	lines := bytes.SplitAfter(data, []byte("\n"))
+	for i, line := range lines {
+		text := bytes.TrimSpace(line)
+		if len(text) > 0 && !bytes.HasPrefix(text, []byte("//")) {
+			break // end of the header
+		}
+		if !looseGeneratedMarker.Match(text) {
+			continue
+		}
+		marker := "// Code generated - DO NOT EDIT."
+		if m := generatorName.FindSubmatch(text); m != nil {
+			marker = "// Code generated by " + string(m[1]) + ". DO NOT EDIT."
+		}
+		eol := []byte("\n")
+		if bytes.HasSuffix(line, []byte("\r\n")) {
+			eol = []byte("\r\n")
+		}
+		rest := append(lines[:i:i], lines[i+1:]...)
+		out := append([]byte(marker), eol...)
+		return append(out, bytes.Join(rest, nil)...), true
+	}
+	return nil, false
+}
+
+var _ Fixer = (*GeneratedMarkerFixer)(nil)
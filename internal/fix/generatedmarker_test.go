@@ -0,0 +1,70 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package fix
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+func TestStandardizeGeneratedMarker(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+		ok   bool
+	}{
+		{
+			name: "named tool",
+			in:   "// Copyright 2026 Example\n\n// Auto-generated by protoc-gen-go, do not edit.\npackage pb\n",
+			want: "// Code generated by protoc-gen-go. DO NOT EDIT.\n// Copyright 2026 Example\n\npackage pb\n",
+			ok:   true,
+		},
+		{
+			name: "no tool",
+			in:   "// THIS FILE IS AUTOGENERATED\r\npackage a\r\n",
+			want: "// Code generated - DO NOT EDIT.\r\npackage a\r\n",
+			ok:   true,
+		},
+		{name: "already standard", in: "// Code generated by stringer; DO NOT EDIT.\npackage a\n"},
+		{name: "not generated", in: "// Package a does things.\npackage a\n"},
+		{name: "below package clause", in: "package a\n\n// generated by hand\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := standardizeGeneratedMarker([]byte(tt.in))
+			assert.Equal(t, tt.ok, ok)
+			if tt.ok {
+				assert.Equal(t, tt.want, string(got))
+				assert.True(t, goGeneratedMarker.Match(got))
+			}
+		})
+	}
+}
+
+func TestGeneratedMarkerFixer(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "gen.go", "// generated by mockgen\npackage a\n")
+	writeFile(t, dir, "big.go", "// Package a is large.\npackage a\n")
+	writeFile(t, dir, "gen.ts", "// generated by tsc\nexport {}\n")
+
+	ws := NewWorkspace(dir)
+	err := (&GeneratedMarkerFixer{}).Fix(context.Background(), ws, []signal.RawSignal{
+		{Source: "patterns", Kind: "large-file", FilePath: "gen.go"},
+		{Source: "patterns", Kind: "large-file", FilePath: "big.go"},
+		{Source: "patterns", Kind: "large-file", FilePath: "gen.ts"},
+		{Source: "patterns", Kind: "missing-tests", FilePath: "gen.go"},
+	})
+	require.NoError(t, err)
+
+	changes := ws.Changes()
+	require.Len(t, changes, 1)
+	assert.Equal(t, "gen.go", changes[0].FilePath)
+	assert.Equal(t, "// Code generated by mockgen. DO NOT EDIT.\npackage a\n", string(changes[0].After))
+}
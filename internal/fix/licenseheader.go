@@ -0,0 +1,194 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package fix
+
+import (
+	"context"
+	"io/fs"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+func init() {
+	Register(&LicenseHeaderFixer{})
+}
+
+// lineCommentPrefix maps source extensions to their line comment prefix.
+var lineCommentPrefix = map[string]string{
+	".go": "//", ".js": "//", ".jsx": "//", ".ts": "//", ".tsx": "//",
+	".java": "//", ".kt": "//", ".scala": "//", ".swift": "//", ".rs": "//",
+	".c": "//", ".h": "//", ".cc": "//", ".cpp": "//", ".hpp": "//", ".cs": "//",
+	".py": "#", ".rb": "#", ".sh": "#",
+}
+
+// licenseSkipDirs are directories never modified by the license fixer.
+var licenseSkipDirs = map[string]bool{
+	"vendor": true, "node_modules": true, "testdata": true,
+	"third_party": true, "3rdparty": true, "external": true, "extern": true,
+}
+
+// LicenseHeaderFixer adds the repository's license header to source files
+// that lack one. The header is not configured: it is inferred from the
+// files that already carry one, and only applied when a strict majority of
+// files sharing a comment style use the same header.
+type LicenseHeaderFixer struct{}
+
+// Name returns the fixer name.
+func (f *LicenseHeaderFixer) Name() string { return "license-header" }
+
+// Description returns a one-line summary of the fixer.
+func (f *LicenseHeaderFixer) Description() string {
+	return "Add the repository's prevailing license header to source files missing it"
+}
+
+// Collectors returns nil; the fixer inspects source files directly.
+func (f *LicenseHeaderFixer) Collectors() []string { return nil }
+
+// headerFile is a candidate source file and its detected license header.
+type headerFile struct {
+	path   string
+	header string // empty when the file has no license header
+}
+
+// Fix inserts the inferred header into files that are missing it.
+func (f *LicenseHeaderFixer) Fix(ctx context.Context, ws *Workspace, _ []signal.RawSignal) error {
+	groups := make(map[string][]headerFile)
+	err := FS.WalkDir(ws.Root(), func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return nil
+		}
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
+		name := d.Name()
+		if d.IsDir() {
+			if path != ws.Root() && (strings.HasPrefix(name, ".") || licenseSkipDirs[name]) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		prefix, ok := lineCommentPrefix[filepath.Ext(name)]
+		if !ok || !d.Type().IsRegular() {
+			return nil
+		}
+		rel, relErr := filepath.Rel(ws.Root(), path)
+		if relErr != nil {
+			return nil
+		}
+		data, readErr := ws.ReadFile(rel)
+		if readErr != nil {
+			return nil
+		}
+		if isGenerated(data) {
+			return nil
+		}
+		groups[prefix] = append(groups[prefix], headerFile{
+			path:   filepath.ToSlash(rel),
+			header: licenseHeader(string(data), prefix),
+		})
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	prefixes := make([]string, 0, len(groups))
+	for p := range groups {
+		prefixes = append(prefixes, p)
+	}
+	sort.Strings(prefixes)
+
+	for _, prefix := range prefixes {
+		files := groups[prefix]
+		header := prevailingHeader(files)
+		if header == "" {
+			continue
+		}
+		for _, hf := range files {
+			if hf.header != "" {
+				continue
+			}
+			data, err := ws.ReadFile(hf.path)
+			if err != nil {
+				continue
+			}
+			if err := ws.WriteFile(hf.path, []byte(insertHeader(string(data), header))); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// licenseHeader returns the leading comment block of content when it looks
+// like a license header (mentions a copyright or SPDX identifier).
+func licenseHeader(content, prefix string) string {
+	lines := strings.SplitAfter(content, "\n")
+	if len(lines) > 0 && strings.HasPrefix(lines[0], "#!") {
+		lines = lines[1:]
+	}
+	var block []string
+	for _, line := range lines {
+		if !strings.HasPrefix(line, prefix) {
+			break
+		}
+		block = append(block, line)
+	}
+	header := strings.Join(block, "")
+	lower := strings.ToLower(header)
+	if strings.Contains(lower, "copyright") || strings.Contains(lower, "spdx-license-identifier") {
+		return header
+	}
+	return ""
+}
+
+// prevailingHeader returns the header used by a strict majority of files,
+// or "" when there is no such header or fewer than two files share it.
+func prevailingHeader(files []headerFile) string {
+	counts := make(map[string]int)
+	for _, hf := range files {
+		if hf.header != "" {
+			counts[hf.header]++
+		}
+	}
+	best, bestCount := "", 0
+	for h, n := range counts {
+		if n > bestCount || (n == bestCount && h < best) {
+			best, bestCount = h, n
+		}
+	}
+	if bestCount < 2 || bestCount*2 <= len(files) {
+		return ""
+	}
+	return best
+}
+
+// insertHeader places header at the top of content, after any shebang line,
+// followed by a blank line.
+func insertHeader(content, header string) string {
+	if strings.HasPrefix(content, "#!") {
+		nl := strings.IndexByte(content, '\n')
+		if nl < 0 {
+			return content + "\n" + header
+		}
+		return content[:nl+1] + header + "\n" + content[nl+1:]
+	}
+	return header + "\n" + content
+}
+
+// isGenerated reports whether data carries a generated-code marker in its
+// first few lines. Generated files are owned by their generator.
+func isGenerated(data []byte) bool {
+	head := data
+	if len(head) > 1024 {
+		head = head[:1024]
+	}
+	s := string(head)
+	return strings.Contains(s, "Code generated") || strings.Contains(s, "DO NOT EDIT")
+}
+
+var _ Fixer = (*LicenseHeaderFixer)(nil)
@@ -0,0 +1,65 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package fix
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testHeader = "// Copyright 2026 Example Inc.\n// SPDX-License-Identifier: MIT\n"
+
+func TestLicenseHeaderFixer_AddsPrevailingHeader(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "a.go", testHeader+"\npackage a\n")
+	writeFile(t, dir, "b.go", testHeader+"\npackage a\n")
+	writeFile(t, dir, "c.go", "// Package c does things.\npackage c\n")
+	writeFile(t, dir, "gen.go", "// Code generated by stringer. DO NOT EDIT.\n\npackage a\n")
+	writeFile(t, dir, "vendor/x/x.go", "package x\n")
+	writeFile(t, dir, "notes.txt", "no comments here\n")
+
+	ws := NewWorkspace(dir)
+	require.NoError(t, (&LicenseHeaderFixer{}).Fix(context.Background(), ws, nil))
+
+	changes := ws.Changes()
+	require.Len(t, changes, 1)
+	assert.Equal(t, "c.go", changes[0].FilePath)
+	assert.Equal(t, testHeader+"\n// Package c does things.\npackage c\n", string(changes[0].After))
+}
+
+func TestLicenseHeaderFixer_NoMajority(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "a.go", testHeader+"\npackage a\n")
+	writeFile(t, dir, "b.go", testHeader+"\npackage a\n")
+	writeFile(t, dir, "c.go", "package a\n")
+	writeFile(t, dir, "d.go", "package a\n")
+
+	ws := NewWorkspace(dir)
+	require.NoError(t, (&LicenseHeaderFixer{}).Fix(context.Background(), ws, nil))
+	assert.Empty(t, ws.Changes(), "half the files is not a strict majority")
+}
+
+func TestLicenseHeaderFixer_HashCommentsAndShebang(t *testing.T) {
+	dir := t.TempDir()
+	hdr := "# Copyright 2026 Example Inc.\n"
+	writeFile(t, dir, "a.py", hdr+"\nimport os\n")
+	writeFile(t, dir, "b.py", hdr+"\nimport sys\n")
+	writeFile(t, dir, "run.sh", "#!/bin/sh\necho hi\n")
+
+	ws := NewWorkspace(dir)
+	require.NoError(t, (&LicenseHeaderFixer{}).Fix(context.Background(), ws, nil))
+
+	changes := ws.Changes()
+	require.Len(t, changes, 1)
+	assert.Equal(t, "#!/bin/sh\n"+hdr+"\necho hi\n", string(changes[0].After))
+}
+
+func TestLicenseHeader(t *testing.T) {
+	assert.Equal(t, testHeader, licenseHeader(testHeader+"\npackage a\n", "//"))
+	assert.Equal(t, "", licenseHeader("// Package a.\npackage a\n", "//"))
+	assert.Equal(t, "# copyright me\n", licenseHeader("#!/usr/bin/env python\n# copyright me\nimport x\n", "#"))
+}
@@ -0,0 +1,63 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package fix
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+func init() {
+	Register(&LineEndingsFixer{})
+}
+
+// LineEndingsFixer normalizes files flagged with mixed line endings to
+// whichever ending the file uses most.
+type LineEndingsFixer struct{}
+
+// Name returns the fixer name.
+func (f *LineEndingsFixer) Name() string { return "line-endings" }
+
+// Description returns a one-line summary of the fixer.
+func (f *LineEndingsFixer) Description() string {
+	return "Normalize files with mixed CRLF/LF line endings to the majority style"
+}
+
+// Collectors returns the collectors this fixer consumes.
+func (f *LineEndingsFixer) Collectors() []string { return []string{"githygiene"} }
+
+// Fix rewrites each flagged file with consistent line endings.
+func (f *LineEndingsFixer) Fix(_ context.Context, ws *Workspace, signals []signal.RawSignal) error {
+	for _, sig := range signals {
+		if sig.Kind != "mixed-line-endings" {
+			continue
+		}
+		data, err := ws.ReadFile(sig.FilePath)
+		if err != nil {
+			slog.Warn("line-endings: cannot read file", "file", sig.FilePath, "error", err)
+			continue
+		}
+		if err := ws.WriteFile(sig.FilePath, normalizeLineEndings(data)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// normalizeLineEndings converts every line ending in data to the majority
+// style. Ties resolve to LF.
+func normalizeLineEndings(data []byte) []byte {
+	crlf := bytes.Count(data, []byte("\r\n"))
+	lf := bytes.Count(data, []byte("\n")) - crlf
+	unix := bytes.ReplaceAll(data, []byte("\r\n"), []byte("\n"))
+	if crlf > lf {
+		return bytes.ReplaceAll(unix, []byte("\n"), []byte("\r\n"))
+	}
+	return unix
+}
+
+var _ Fixer = (*LineEndingsFixer)(nil)
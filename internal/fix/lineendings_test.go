@@ -0,0 +1,39 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package fix
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+func TestNormalizeLineEndings(t *testing.T) {
+	assert.Equal(t, "a\nb\nc\n", string(normalizeLineEndings([]byte("a\r\nb\nc\n"))))
+	assert.Equal(t, "a\r\nb\r\nc\r\n", string(normalizeLineEndings([]byte("a\r\nb\r\nc\n"))))
+	assert.Equal(t, "a\nb\n", string(normalizeLineEndings([]byte("a\r\nb\n"))), "ties resolve to LF")
+}
+
+func TestLineEndingsFixer(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "mixed.txt", "a\r\nb\nc\nd\n")
+	writeFile(t, dir, "other.txt", "a\r\nb\n")
+
+	ws := NewWorkspace(dir)
+	err := (&LineEndingsFixer{}).Fix(context.Background(), ws, []signal.RawSignal{
+		{Source: "githygiene", Kind: "mixed-line-endings", FilePath: "mixed.txt"},
+		{Source: "githygiene", Kind: "large-binary", FilePath: "other.txt"},
+		{Source: "githygiene", Kind: "mixed-line-endings", FilePath: "missing.txt"},
+	})
+	require.NoError(t, err)
+
+	changes := ws.Changes()
+	require.Len(t, changes, 1)
+	assert.Equal(t, "mixed.txt", changes[0].FilePath)
+	assert.Equal(t, "a\nb\nc\nd\n", string(changes[0].After))
+}
@@ -0,0 +1,43 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+//go:build nonetwork
+
+package fix
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+)
+
+// errNoNetwork is returned by GitHub calls in builds with the nonetwork tag.
+var errNoNetwork = errors.New("stringer was built without network support (nonetwork tag)")
+
+// NewPullRequestAPI returns a PullRequestAPI whose calls fail with
+// errNoNetwork.
+func NewPullRequestAPI(string) PullRequestAPI { return noNetworkPullRequestAPI{} }
+
+type noNetworkPullRequestAPI struct{}
+
+func (noNetworkPullRequestAPI) DefaultBranch(context.Context, string, string) (string, error) {
+	return "", errNoNetwork
+}
+
+func (noNetworkPullRequestAPI) CreatePullRequest(context.Context, string, string, NewPullRequest) (*PullRequest, error) {
+	return nil, errNoNetwork
+}
+
+func (noNetworkPullRequestAPI) EnsureLabel(context.Context, string, string, string) error {
+	return errNoNetwork
+}
+
+func (noNetworkPullRequestAPI) AddLabels(context.Context, string, string, int, []string) error {
+	return errNoNetwork
+}
+
+// newIssueChecker never finds a checker, so resolved-todo is skipped.
+var newIssueChecker = func(string) IssueChecker {
+	slog.Debug("resolved-todo: " + errNoNetwork.Error())
+	return nil
+}
@@ -0,0 +1,195 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package fix
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+// PullRequestAPI abstracts the GitHub calls needed to open and label a pull
+// request, enabling test mocking.
+type PullRequestAPI interface {
+	DefaultBranch(ctx context.Context, owner, repo string) (string, error)
+	CreatePullRequest(ctx context.Context, owner, repo string, pr NewPullRequest) (*PullRequest, error)
+	// EnsureLabel creates the named label when the repository lacks it.
+	EnsureLabel(ctx context.Context, owner, repo, name string) error
+	AddLabels(ctx context.Context, owner, repo string, number int, labels []string) error
+}
+
+// NewPullRequest describes a pull request to open.
+type NewPullRequest struct {
+	Title string
+	Head  string
+	Base  string
+	Body  string
+}
+
+// PullRequest is an opened pull request.
+type PullRequest struct {
+	Number int
+	URL    string
+}
+
+// PROptions configures OpenPullRequest.
+type PROptions struct {
+	Owner  string
+	Repo   string
+	Branch string // head branch to create and push
+	Base   string // target branch; empty means the repository default
+	Title  string
+	Body   string
+	Labels []string // created in the repository when missing
+}
+
+// UpdateSignal describes a dependency update as a stale-dependency signal so
+// configured label rules can choose its pull request labels.
+func UpdateSignal(u DepUpdate) signal.RawSignal {
+	return signal.RawSignal{
+		Source:   "dephealth",
+		Kind:     "stale-dependency",
+		FilePath: u.Name,
+		Title:    fmt.Sprintf("Bump %s from %s to %s", u.Name, u.Current, u.Latest),
+		Tags:     []string{"dependencies", u.Ecosystem},
+	}
+}
+
+// BranchName returns a deterministic branch name for a set of updates so
+// re-running the command for the same bump reuses the same branch.
+func BranchName(updates []DepUpdate) string {
+	if len(updates) == 1 {
+		name := strings.NewReplacer("/", "-", "@", "").Replace(updates[0].Name)
+		return "stringer/deps/" + name + "-" + strings.TrimPrefix(updates[0].Latest, "v")
+	}
+	return fmt.Sprintf("stringer/deps/update-%d-deps", len(updates))
+}
+
+// PRTitle returns the pull request title for a set of updates.
+func PRTitle(updates []DepUpdate) string {
+	if len(updates) == 1 {
+		u := updates[0]
+		return fmt.Sprintf("Bump %s from %s to %s", u.Name, u.Current, u.Latest)
+	}
+	return fmt.Sprintf("Bump %d dependencies", len(updates))
+}
+
+// PRBody renders a markdown pull request body listing each bump.
+func PRBody(updates []DepUpdate, checked bool) string {
+	var b strings.Builder
+	b.WriteString("Updates the following direct dependencies:\n\n")
+	b.WriteString("| Ecosystem | Package | From | To |\n")
+	b.WriteString("|-----------|---------|------|----|\n")
+	for _, u := range updates {
+		fmt.Fprintf(&b, "| %s | `%s` | %s | %s |\n", u.Ecosystem, u.Name, u.Current, u.Latest)
+	}
+	b.WriteString("\n")
+	if checked {
+		b.WriteString("Build and tests passed locally after the update.\n")
+	} else {
+		b.WriteString("Build and tests were not run; CI should verify this change.\n")
+	}
+	b.WriteString("\nGenerated by `stringer fix deps`.\n")
+	return b.String()
+}
+
+// CheckClean fails when tracked files in repoPath have uncommitted changes,
+// so a pull request carries only the changes stringer makes.
+func CheckClean(ctx context.Context, repoPath string) error {
+	out, err := run(ctx, repoPath, "git", "status", "--porcelain", "--untracked-files=no")
+	if err != nil {
+		return err
+	}
+	if strings.TrimSpace(out) != "" {
+		return errors.New("the working tree has uncommitted changes; commit or stash them first")
+	}
+	return nil
+}
+
+// manifestFiles are the files each ecosystem's package manager rewrites
+// when ApplyUpdates bumps a dependency.
+var manifestFiles = map[string][]string{
+	EcosystemGo:  {"go.mod", "go.sum"},
+	EcosystemNpm: {"package.json", "package-lock.json", "npm-shrinkwrap.json"},
+}
+
+// UpdatedFiles returns the manifest and lock files in repoPath that
+// ApplyUpdates may have changed for updates.
+func UpdatedFiles(repoPath string, updates []DepUpdate) []string {
+	var files []string
+	for _, eco := range []string{EcosystemGo, EcosystemNpm} {
+		if !slices.ContainsFunc(updates, func(u DepUpdate) bool { return u.Ecosystem == eco }) {
+			continue
+		}
+		for _, name := range manifestFiles[eco] {
+			if _, err := FS.Stat(filepath.Join(repoPath, name)); err == nil {
+				files = append(files, name)
+			}
+		}
+	}
+	return files
+}
+
+// CommitAndPush points branch at the current commit, creating it or
+// resetting an earlier run's, commits files with message, and pushes the
+// branch to origin, replacing the earlier push unless someone else has
+// pushed to it since. Other changes in the working tree are left alone.
+func CommitAndPush(ctx context.Context, repoPath, branch, message string, files []string) error {
+	if len(files) == 0 {
+		return errors.New("no changed files to commit")
+	}
+	steps := [][]string{
+		{"checkout", "-B", branch},
+		append([]string{"add", "--"}, files...),
+		{"commit", "-m", message},
+		{"push", "--force-with-lease", "-u", "origin", branch},
+	}
+	for _, args := range steps {
+		if _, err := run(ctx, repoPath, "git", args...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// OpenPullRequest opens a pull request for an already-pushed branch, applies
+// opts.Labels, and returns its URL. When labeling fails the pull request
+// stays open, so the URL is returned along with the error.
+func OpenPullRequest(ctx context.Context, api PullRequestAPI, opts PROptions) (string, error) {
+	base := opts.Base
+	if base == "" {
+		var err error
+		base, err = api.DefaultBranch(ctx, opts.Owner, opts.Repo)
+		if err != nil {
+			return "", fmt.Errorf("resolving default branch: %w", err)
+		}
+	}
+	pr, err := api.CreatePullRequest(ctx, opts.Owner, opts.Repo, NewPullRequest{
+		Title: opts.Title,
+		Head:  opts.Branch,
+		Base:  base,
+		Body:  opts.Body,
+	})
+	if err != nil {
+		return "", fmt.Errorf("creating pull request: %w", err)
+	}
+	url := pr.URL
+	if len(opts.Labels) == 0 {
+		return url, nil
+	}
+	for _, name := range opts.Labels {
+		if err := api.EnsureLabel(ctx, opts.Owner, opts.Repo, name); err != nil {
+			return url, fmt.Errorf("creating label %q: %w", name, err)
+		}
+	}
+	if err := api.AddLabels(ctx, opts.Owner, opts.Repo, pr.Number, opts.Labels); err != nil {
+		return url, fmt.Errorf("labeling pull request: %w", err)
+	}
+	return url, nil
+}
@@ -0,0 +1,69 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+//go:build !nonetwork
+
+package fix
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/google/go-github/v68/github"
+)
+
+// defaultLabelColor is the color given to labels created by EnsureLabel.
+const defaultLabelColor = "ededed"
+
+// realPullRequestAPI wraps a *github.Client to implement PullRequestAPI.
+type realPullRequestAPI struct {
+	client *github.Client
+}
+
+// NewPullRequestAPI returns a PullRequestAPI authenticated with token.
+func NewPullRequestAPI(token string) PullRequestAPI {
+	return &realPullRequestAPI{client: github.NewClient(nil).WithAuthToken(token)}
+}
+
+func (r *realPullRequestAPI) DefaultBranch(ctx context.Context, owner, repo string) (string, error) {
+	ghRepo, _, err := r.client.Repositories.Get(ctx, owner, repo)
+	if err != nil {
+		return "", err
+	}
+	return ghRepo.GetDefaultBranch(), nil
+}
+
+func (r *realPullRequestAPI) CreatePullRequest(ctx context.Context, owner, repo string, pr NewPullRequest) (*PullRequest, error) {
+	created, _, err := r.client.PullRequests.Create(ctx, owner, repo, &github.NewPullRequest{
+		Title: github.Ptr(pr.Title),
+		Head:  github.Ptr(pr.Head),
+		Base:  github.Ptr(pr.Base),
+		Body:  github.Ptr(pr.Body),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &PullRequest{Number: created.GetNumber(), URL: created.GetHTMLURL()}, nil
+}
+
+func (r *realPullRequestAPI) EnsureLabel(ctx context.Context, owner, repo, name string) error {
+	_, resp, err := r.client.Issues.GetLabel(ctx, owner, repo, name)
+	if err == nil {
+		return nil
+	}
+	var ghErr *github.ErrorResponse
+	if resp == nil || resp.StatusCode != http.StatusNotFound || !errors.As(err, &ghErr) {
+		return err
+	}
+	_, _, err = r.client.Issues.CreateLabel(ctx, owner, repo, &github.Label{
+		Name:  github.Ptr(name),
+		Color: github.Ptr(defaultLabelColor),
+	})
+	return err
+}
+
+func (r *realPullRequestAPI) AddLabels(ctx context.Context, owner, repo string, number int, labels []string) error {
+	_, _, err := r.client.Issues.AddLabelsToIssue(ctx, owner, repo, number, labels)
+	return err
+}
@@ -0,0 +1,186 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package fix
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davetashner/stringer/internal/testable"
+)
+
+type mockPullRequestAPI struct {
+	defaultBranch string
+	branchErr     error
+	createErr     error
+	created       *NewPullRequest
+	labelErr      error
+	ensured       []string
+	added         []string
+	addedTo       int
+}
+
+func (m *mockPullRequestAPI) DefaultBranch(_ context.Context, _, _ string) (string, error) {
+	return m.defaultBranch, m.branchErr
+}
+
+func (m *mockPullRequestAPI) CreatePullRequest(_ context.Context, owner, repo string, pr NewPullRequest) (*PullRequest, error) {
+	if m.createErr != nil {
+		return nil, m.createErr
+	}
+	m.created = &pr
+	return &PullRequest{Number: 1, URL: "https://github.com/" + owner + "/" + repo + "/pull/1"}, nil
+}
+
+func (m *mockPullRequestAPI) EnsureLabel(_ context.Context, _, _, name string) error {
+	m.ensured = append(m.ensured, name)
+	return m.labelErr
+}
+
+func (m *mockPullRequestAPI) AddLabels(_ context.Context, _, _ string, number int, labels []string) error {
+	m.addedTo, m.added = number, labels
+	return nil
+}
+
+func TestBranchName(t *testing.T) {
+	assert.Equal(t, "stringer/deps/github.com-a-direct-1.2.0",
+		BranchName([]DepUpdate{{Name: "github.com/a/direct", Latest: "v1.2.0"}}))
+	assert.Equal(t, "stringer/deps/types-node-20.1.0",
+		BranchName([]DepUpdate{{Name: "@types/node", Latest: "20.1.0"}}))
+	assert.Equal(t, "stringer/deps/update-2-deps",
+		BranchName([]DepUpdate{{Name: "a"}, {Name: "b"}}))
+}
+
+func TestPRTitle(t *testing.T) {
+	assert.Equal(t, "Bump lodash from 4.17.0 to 4.17.21",
+		PRTitle([]DepUpdate{{Name: "lodash", Current: "4.17.0", Latest: "4.17.21"}}))
+	assert.Equal(t, "Bump 3 dependencies", PRTitle(make([]DepUpdate, 3)))
+}
+
+func TestPRBody(t *testing.T) {
+	updates := []DepUpdate{{Ecosystem: EcosystemGo, Name: "github.com/a/direct", Current: "v1.0.0", Latest: "v1.2.0"}}
+
+	body := PRBody(updates, true)
+	assert.Contains(t, body, "| go | `github.com/a/direct` | v1.0.0 | v1.2.0 |")
+	assert.Contains(t, body, "tests passed")
+
+	body = PRBody(updates, false)
+	assert.Contains(t, body, "were not run")
+}
+
+func TestCommitAndPush(t *testing.T) {
+	m := &testable.MockCommandExecutor{}
+	withMockExecutor(t, m)
+
+	require.NoError(t, CommitAndPush(context.Background(), t.TempDir(), "stringer/deps/x", "Bump x", []string{"go.mod", "go.sum"}))
+	assert.Equal(t, []string{
+		"git checkout -B stringer/deps/x",
+		"git add -- go.mod go.sum",
+		"git commit -m Bump x",
+		"git push --force-with-lease -u origin stringer/deps/x",
+	}, m.Calls)
+}
+
+func TestCommitAndPush_PushFails(t *testing.T) {
+	withMockExecutor(t, &testable.MockCommandExecutor{
+		CommandErrors: map[string]string{"git push --force-with-lease -u origin b": "permission denied"},
+	})
+
+	err := CommitAndPush(context.Background(), t.TempDir(), "b", "msg", []string{"go.mod"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "permission denied")
+}
+
+func TestCommitAndPush_NoFiles(t *testing.T) {
+	m := &testable.MockCommandExecutor{}
+	withMockExecutor(t, m)
+
+	require.Error(t, CommitAndPush(context.Background(), t.TempDir(), "b", "msg", nil))
+	assert.Empty(t, m.Calls)
+}
+
+func TestCheckClean(t *testing.T) {
+	withMockExecutor(t, &testable.MockCommandExecutor{})
+	require.NoError(t, CheckClean(context.Background(), t.TempDir()))
+
+	withMockExecutor(t, &testable.MockCommandExecutor{
+		CommandOutputs: map[string]string{"git status --porcelain --untracked-files=no": " M main.go"},
+	})
+	err := CheckClean(context.Background(), t.TempDir())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "uncommitted changes")
+}
+
+func TestUpdatedFiles(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"go.mod", "go.sum", "package.json", "package-lock.json"} {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), nil, 0o600))
+	}
+	assert.Equal(t, []string{"go.mod", "go.sum"}, UpdatedFiles(dir, []DepUpdate{{Ecosystem: EcosystemGo}}))
+	assert.Equal(t, []string{"go.mod", "go.sum", "package.json", "package-lock.json"},
+		UpdatedFiles(dir, []DepUpdate{{Ecosystem: EcosystemNpm}, {Ecosystem: EcosystemGo}}))
+}
+
+func TestOpenPullRequest_DefaultBase(t *testing.T) {
+	api := &mockPullRequestAPI{defaultBranch: "main"}
+	url, err := OpenPullRequest(context.Background(), api, PROptions{
+		Owner: "o", Repo: "r", Branch: "stringer/deps/x", Title: "Bump x", Body: "body",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "https://github.com/o/r/pull/1", url)
+	require.NotNil(t, api.created)
+	assert.Equal(t, "main", api.created.Base)
+	assert.Equal(t, "stringer/deps/x", api.created.Head)
+	assert.Equal(t, "Bump x", api.created.Title)
+}
+
+func TestOpenPullRequest_ExplicitBase(t *testing.T) {
+	api := &mockPullRequestAPI{branchErr: errors.New("should not be called")}
+	_, err := OpenPullRequest(context.Background(), api, PROptions{Owner: "o", Repo: "r", Base: "develop"})
+	require.NoError(t, err)
+	assert.Equal(t, "develop", api.created.Base)
+}
+
+func TestOpenPullRequest_Errors(t *testing.T) {
+	_, err := OpenPullRequest(context.Background(), &mockPullRequestAPI{branchErr: errors.New("404")}, PROptions{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "resolving default branch")
+
+	_, err = OpenPullRequest(context.Background(), &mockPullRequestAPI{createErr: errors.New("422")}, PROptions{Base: "main"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "creating pull request")
+}
+
+func TestOpenPullRequest_Labels(t *testing.T) {
+	api := &mockPullRequestAPI{}
+	url, err := OpenPullRequest(context.Background(), api, PROptions{
+		Owner: "o", Repo: "r", Base: "main", Labels: []string{"dependencies", "go"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "https://github.com/o/r/pull/1", url)
+	assert.Equal(t, []string{"dependencies", "go"}, api.ensured)
+	assert.Equal(t, []string{"dependencies", "go"}, api.added)
+	assert.Equal(t, 1, api.addedTo)
+
+	// A labeling failure still reports the opened pull request.
+	api = &mockPullRequestAPI{labelErr: errors.New("403")}
+	url, err = OpenPullRequest(context.Background(), api, PROptions{Base: "main", Labels: []string{"deps"}})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `creating label "deps"`)
+	assert.NotEmpty(t, url)
+	assert.Empty(t, api.added)
+}
+
+func TestUpdateSignal(t *testing.T) {
+	sig := UpdateSignal(DepUpdate{Ecosystem: EcosystemGo, Name: "github.com/a/b", Current: "v1.0.0", Latest: "v1.1.0"})
+	assert.Equal(t, "dephealth", sig.Source)
+	assert.Equal(t, "stale-dependency", sig.Kind)
+	assert.Equal(t, []string{"dependencies", EcosystemGo}, sig.Tags)
+}
@@ -0,0 +1,140 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package fix
+
+import (
+	"context"
+	"log/slog"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+func init() {
+	Register(&ResolvedTodoFixer{})
+}
+
+// issueRefPattern matches issue references in TODO text: "#123" or a
+// "github.com/owner/repo/issues/123" URL.
+var issueRefPattern = regexp.MustCompile(`(?:^|[\s(])#(\d+)\b|\bgithub\.com/([\w.-]+/[\w.-]+)/issues/(\d+)\b`)
+
+// commentOnlyPattern matches lines that consist solely of a comment.
+var commentOnlyPattern = regexp.MustCompile(`^\s*(?://|#|--|/\*|\*)`)
+
+// IssueChecker reports whether an issue in the current repository is closed.
+type IssueChecker interface {
+	// Repository returns the checked repository as "owner/repo". Issue URLs
+	// that name another repository are ignored.
+	Repository() string
+	IssueClosed(ctx context.Context, number int) (bool, error)
+}
+
+// ResolvedTodoFixer deletes TODO-style comments whose referenced issue has
+// been closed. Only comment-only lines are removed; a TODO trailing a line of
+// code is left for a human.
+type ResolvedTodoFixer struct {
+	// Issues resolves issue state. When nil, a GitHub checker is built from
+	// GITHUB_TOKEN and the origin remote; without either the fixer is a no-op.
+	Issues IssueChecker
+}
+
+// Name returns the fixer name.
+func (f *ResolvedTodoFixer) Name() string { return "resolved-todo" }
+
+// Description returns a one-line summary of the fixer.
+func (f *ResolvedTodoFixer) Description() string {
+	return "Delete TODO comments whose referenced GitHub issue is closed"
+}
+
+// Collectors returns the collectors this fixer consumes.
+func (f *ResolvedTodoFixer) Collectors() []string { return []string{"todos"} }
+
+// Fix removes resolved TODO lines from the workspace.
+func (f *ResolvedTodoFixer) Fix(ctx context.Context, ws *Workspace, signals []signal.RawSignal) error {
+	checker := f.Issues
+	if checker == nil {
+		checker = newIssueChecker(ws.Root())
+	}
+	if checker == nil {
+		slog.Info("resolved-todo: skipped, GITHUB_TOKEN and a GitHub origin remote are required")
+		return nil
+	}
+
+	closed := make(map[int]bool)
+	byFile := make(map[string][]int)
+	for _, sig := range signals {
+		num := issueRef(sig.Title, checker.Repository())
+		if num == 0 || sig.Line <= 0 {
+			continue
+		}
+		isClosed, seen := closed[num]
+		if !seen {
+			var err error
+			isClosed, err = checker.IssueClosed(ctx, num)
+			if err != nil {
+				slog.Warn("resolved-todo: cannot fetch issue state", "issue", num, "error", err)
+			}
+			closed[num] = isClosed
+		}
+		if isClosed {
+			byFile[sig.FilePath] = append(byFile[sig.FilePath], sig.Line)
+		}
+	}
+
+	for path, lines := range byFile {
+		data, err := ws.ReadFile(path)
+		if err != nil {
+			slog.Warn("resolved-todo: cannot read file", "file", path, "error", err)
+			continue
+		}
+		if err := ws.WriteFile(path, deleteCommentLines(data, lines)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// issueRef returns the first issue number text references in repo
+// ("owner/repo"), or 0. A bare "#123" refers to repo; URLs must name it.
+func issueRef(text, repo string) int {
+	for _, m := range issueRefPattern.FindAllStringSubmatch(text, -1) {
+		raw := m[1]
+		if raw == "" {
+			if !strings.EqualFold(m[2], repo) {
+				continue
+			}
+			raw = m[3]
+		}
+		if n, err := strconv.Atoi(raw); err == nil {
+			return n
+		}
+	}
+	return 0
+}
+
+// deleteCommentLines removes the given 1-based line numbers from data when
+// they are comment-only lines. Lines are removed bottom-up so earlier
+// numbers stay valid.
+func deleteCommentLines(data []byte, lines []int) []byte {
+	text := string(data)
+	all := strings.SplitAfter(text, "\n")
+	sort.Sort(sort.Reverse(sort.IntSlice(lines)))
+	prev := 0
+	for _, ln := range lines {
+		if ln == prev || ln > len(all) {
+			continue
+		}
+		prev = ln
+		if !commentOnlyPattern.MatchString(all[ln-1]) {
+			continue
+		}
+		all = append(all[:ln-1], all[ln:]...)
+	}
+	return []byte(strings.Join(all, ""))
+}
+
+var _ Fixer = (*ResolvedTodoFixer)(nil)
@@ -0,0 +1,52 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+//go:build !nonetwork
+
+package fix
+
+import (
+	"context"
+	"log/slog"
+	"os"
+
+	"github.com/google/go-github/v68/github"
+
+	"github.com/davetashner/stringer/internal/collectors"
+)
+
+// githubIssueChecker implements IssueChecker against the GitHub API.
+type githubIssueChecker struct {
+	client *github.Client
+	owner  string
+	repo   string
+}
+
+func (g *githubIssueChecker) Repository() string { return g.owner + "/" + g.repo }
+
+func (g *githubIssueChecker) IssueClosed(ctx context.Context, number int) (bool, error) {
+	issue, _, err := g.client.Issues.Get(ctx, g.owner, g.repo, number)
+	if err != nil {
+		return false, err
+	}
+	return issue.GetState() == "closed", nil
+}
+
+// newIssueChecker returns a GitHub-backed IssueChecker for repoPath, or nil
+// when GITHUB_TOKEN is unset or the origin remote is not on GitHub.
+var newIssueChecker = func(repoPath string) IssueChecker {
+	token := os.Getenv("GITHUB_TOKEN")
+	if token == "" {
+		return nil
+	}
+	owner, repo, err := collectors.ParseGitHubRemote(repoPath)
+	if err != nil {
+		slog.Debug("resolved-todo: cannot determine GitHub remote", "error", err)
+		return nil
+	}
+	return &githubIssueChecker{
+		client: github.NewClient(nil).WithAuthToken(token),
+		owner:  owner,
+		repo:   repo,
+	}
+}
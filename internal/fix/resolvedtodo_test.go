@@ -0,0 +1,108 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package fix
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+type mockIssueChecker struct {
+	closed map[int]bool
+	err    error
+	calls  int
+}
+
+func (m *mockIssueChecker) Repository() string { return "o/r" }
+
+func (m *mockIssueChecker) IssueClosed(_ context.Context, number int) (bool, error) {
+	m.calls++
+	return m.closed[number], m.err
+}
+
+func TestIssueRef(t *testing.T) {
+	tests := []struct {
+		text string
+		want int
+	}{
+		{"TODO: remove after #12 lands", 12},
+		{"TODO(#7): cleanup", 7},
+		{"FIXME: see https://github.com/o/r/issues/345", 345},
+		{"FIXME: see https://github.com/O/R/issues/345", 345},
+		{"FIXME: upstream https://github.com/other/lib/issues/9", 0},
+		{"FIXME: upstream https://github.com/other/lib/issues/9, then #4", 4},
+		{"FIXME: see https://gitlab.com/group/o/r/issues/5", 0},
+		{"TODO: handle the C# case", 0},
+		{"TODO: refactor", 0},
+		{"TODO: abc#12", 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			assert.Equal(t, tt.want, issueRef(tt.text, "o/r"))
+		})
+	}
+}
+
+func TestResolvedTodoFixer_DeletesClosedRefs(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "main.go", "package main\n\n// TODO: drop shim once #1 ships\nfunc a() {}\n\n// TODO: revisit #2\nfunc b() {}\n\nfunc c() {} // TODO: inline #1\n")
+
+	checker := &mockIssueChecker{closed: map[int]bool{1: true}}
+	f := &ResolvedTodoFixer{Issues: checker}
+	ws := NewWorkspace(dir)
+	err := f.Fix(context.Background(), ws, []signal.RawSignal{
+		{Source: "todos", FilePath: "main.go", Line: 3, Title: "TODO: drop shim once #1 ships"},
+		{Source: "todos", FilePath: "main.go", Line: 6, Title: "TODO: revisit #2"},
+		{Source: "todos", FilePath: "main.go", Line: 9, Title: "TODO: inline #1"},
+		{Source: "todos", FilePath: "main.go", Line: 4, Title: "TODO: no reference"},
+	})
+	require.NoError(t, err)
+
+	changes := ws.Changes()
+	require.Len(t, changes, 1)
+	assert.Equal(t, "package main\n\nfunc a() {}\n\n// TODO: revisit #2\nfunc b() {}\n\nfunc c() {} // TODO: inline #1\n",
+		string(changes[0].After), "only the comment-only line for a closed issue is removed")
+	assert.Equal(t, 2, checker.calls, "issue state is cached per number")
+}
+
+func TestResolvedTodoFixer_CheckerErrorKeepsTodo(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "a.go", "// TODO: #3\npackage a\n")
+	f := &ResolvedTodoFixer{Issues: &mockIssueChecker{err: errors.New("rate limited")}}
+	ws := NewWorkspace(dir)
+	require.NoError(t, f.Fix(context.Background(), ws, []signal.RawSignal{
+		{Source: "todos", FilePath: "a.go", Line: 1, Title: "TODO: #3"},
+	}))
+	assert.Empty(t, ws.Changes())
+}
+
+func TestResolvedTodoFixer_NoCheckerIsNoop(t *testing.T) {
+	orig := newIssueChecker
+	newIssueChecker = func(string) IssueChecker { return nil }
+	t.Cleanup(func() { newIssueChecker = orig })
+
+	dir := t.TempDir()
+	writeFile(t, dir, "a.go", "// TODO: #3\npackage a\n")
+	ws := NewWorkspace(dir)
+	require.NoError(t, (&ResolvedTodoFixer{}).Fix(context.Background(), ws, []signal.RawSignal{
+		{Source: "todos", FilePath: "a.go", Line: 1, Title: "TODO: #3"},
+	}))
+	assert.Empty(t, ws.Changes())
+}
+
+func TestNewIssueChecker_NoToken(t *testing.T) {
+	t.Setenv("GITHUB_TOKEN", "")
+	assert.Nil(t, newIssueChecker(t.TempDir()))
+}
+
+func TestDeleteCommentLines(t *testing.T) {
+	in := []byte("a\n# TODO x\nb\n  // TODO y\n")
+	assert.Equal(t, "a\nb\n", string(deleteCommentLines(in, []int{2, 4, 4, 99})))
+}
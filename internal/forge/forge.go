@@ -0,0 +1,203 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+// Package forge builds web links into the code forge (GitHub, GitLab,
+// Bitbucket) hosting a repository, so signals can point at the exact file
+// and line they were found at.
+package forge
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/davetashner/stringer/internal/gitcli"
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+// Kind identifies a forge.
+type Kind string
+
+// Recognized forges.
+const (
+	GitHub    Kind = "github"
+	GitLab    Kind = "gitlab"
+	Bitbucket Kind = "bitbucket"
+)
+
+// Repo is a repository hosted on a recognized forge.
+type Repo struct {
+	Kind Kind
+
+	// Base is the repository's web URL, e.g. https://github.com/owner/repo.
+	Base string
+}
+
+// scpRemotePattern matches scp-style SSH remotes: git@host:owner/repo.git.
+var scpRemotePattern = regexp.MustCompile(`^(?:[^@/]+@)?([^:/]+):(.+)$`)
+
+// ParseRemote returns the forge repository a git remote URL points at.
+// github.com, gitlab.com, and bitbucket.org are recognized, as are
+// self-hosted instances whose host name starts with "github." or "gitlab.".
+func ParseRemote(rawURL string) (*Repo, bool) {
+	rawURL = strings.TrimSpace(rawURL)
+	var host, path string
+	if u, err := url.Parse(rawURL); err == nil && u.Scheme != "" && u.Host != "" {
+		if u.Scheme != "https" && u.Scheme != "http" && u.Scheme != "ssh" && u.Scheme != "git" {
+			return nil, false
+		}
+		host, path = u.Hostname(), u.Path
+	} else if m := scpRemotePattern.FindStringSubmatch(rawURL); m != nil {
+		host, path = m[1], m[2]
+	} else {
+		return nil, false
+	}
+
+	host = strings.ToLower(host)
+	var kind Kind
+	switch {
+	case host == "github.com" || strings.HasPrefix(host, "github."):
+		kind = GitHub
+	case host == "gitlab.com" || strings.HasPrefix(host, "gitlab."):
+		kind = GitLab
+	case host == "bitbucket.org":
+		kind = Bitbucket
+	default:
+		return nil, false
+	}
+
+	path = strings.TrimSuffix(strings.Trim(path, "/"), ".git")
+	parts := strings.Split(path, "/")
+	// GitLab nests projects in groups; the others are always owner/repo.
+	if len(parts) < 2 || (kind != GitLab && len(parts) != 2) {
+		return nil, false
+	}
+	for _, p := range parts {
+		if p == "" {
+			return nil, false
+		}
+	}
+	return &Repo{Kind: kind, Base: "https://" + host + "/" + path}, true
+}
+
+// FileURL links to path (slash-separated, relative to the repository root)
+// at commit, anchored at line when it is positive. Directories get a tree
+// URL where the forge distinguishes them.
+func (r *Repo) FileURL(commit, path string, line int, dir bool) string {
+	segs := strings.Split(path, "/")
+	for i, s := range segs {
+		segs[i] = url.PathEscape(s)
+	}
+	escaped := strings.Join(segs, "/")
+
+	var u string
+	switch r.Kind {
+	case GitLab:
+		view := "blob"
+		if dir {
+			view = "tree"
+		}
+		u = fmt.Sprintf("%s/-/%s/%s/%s", r.Base, view, commit, escaped)
+	case Bitbucket:
+		u = fmt.Sprintf("%s/src/%s/%s", r.Base, commit, escaped)
+	default:
+		view := "blob"
+		if dir {
+			view = "tree"
+		}
+		u = fmt.Sprintf("%s/%s/%s/%s", r.Base, view, commit, escaped)
+	}
+	if line > 0 && !dir {
+		if r.Kind == Bitbucket {
+			return fmt.Sprintf("%s#lines-%d", u, line)
+		}
+		return fmt.Sprintf("%s#L%d", u, line)
+	}
+	return u
+}
+
+// IssueURL links to issue number n. On GitHub the link also reaches a
+// pull request of that number.
+func (r *Repo) IssueURL(n int) string {
+	if r.Kind == GitLab {
+		return fmt.Sprintf("%s/-/issues/%d", r.Base, n)
+	}
+	return fmt.Sprintf("%s/issues/%d", r.Base, n)
+}
+
+// Origin returns the forge repository the origin remote of the repository
+// at gitRoot points at. ok is false when there is no origin or it is not on
+// a recognized forge.
+func Origin(ctx context.Context, gitRoot string) (repo *Repo, ok bool) {
+	remote, err := gitcli.Exec(ctx, gitRoot, "remote", "get-url", "origin")
+	if err != nil {
+		return nil, false
+	}
+	return ParseRemote(remote)
+}
+
+// Annotate sets URL on signals that have none and whose path exists in the
+// HEAD commit of the repository at gitRoot, linking to that commit. Signal
+// paths are relative to repoPath. Paths not in HEAD, such as files deleted
+// since a commit gitlog reports on or files not committed yet, stay
+// unlinked. It returns the number of signals linked, and zero when origin
+// is not on a recognized forge.
+func Annotate(ctx context.Context, signals []signal.RawSignal, repoPath, gitRoot string) (int, error) {
+	repo, ok := Origin(ctx, gitRoot)
+	if !ok {
+		return 0, nil
+	}
+	head, err := gitcli.Exec(ctx, gitRoot, "rev-parse", "HEAD")
+	if err != nil {
+		return 0, fmt.Errorf("resolve HEAD: %w", err)
+	}
+	commit := strings.TrimSpace(head)
+	tree, err := gitcli.Exec(ctx, gitRoot, "ls-tree", "-r", "-z", "--name-only", "HEAD")
+	if err != nil {
+		return 0, fmt.Errorf("list HEAD tree: %w", err)
+	}
+
+	files := make(map[string]bool)
+	dirs := make(map[string]bool)
+	for _, f := range strings.Split(tree, "\x00") {
+		if f == "" {
+			continue
+		}
+		files[f] = true
+		for d := f; strings.Contains(d, "/"); {
+			d = d[:strings.LastIndex(d, "/")]
+			dirs[d] = true
+		}
+	}
+
+	prefix, err := filepath.Rel(gitRoot, repoPath)
+	if err != nil {
+		return 0, fmt.Errorf("relate scan path to git root: %w", err)
+	}
+	prefix = filepath.ToSlash(prefix)
+
+	n := 0
+	for i := range signals {
+		s := &signals[i]
+		if s.URL != "" || s.FilePath == "" {
+			continue
+		}
+		p := filepath.ToSlash(filepath.Clean(s.FilePath))
+		if prefix != "." {
+			p = prefix + "/" + p
+		}
+		switch {
+		case files[p]:
+			s.URL = repo.FileURL(commit, p, s.Line, false)
+		case dirs[p]:
+			s.URL = repo.FileURL(commit, p, 0, true)
+		default:
+			continue
+		}
+		n++
+	}
+	return n, nil
+}
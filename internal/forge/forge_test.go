@@ -0,0 +1,133 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package forge
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+func TestParseRemote(t *testing.T) {
+	tests := []struct {
+		remote string
+		kind   Kind
+		base   string
+	}{
+		{"https://github.com/owner/repo.git", GitHub, "https://github.com/owner/repo"},
+		{"git@github.com:owner/repo.git", GitHub, "https://github.com/owner/repo"},
+		{"ssh://git@github.com:22/owner/repo", GitHub, "https://github.com/owner/repo"},
+		{"https://token@github.example.com/team/app.git\n", GitHub, "https://github.example.com/team/app"},
+		{"git@gitlab.com:group/sub/project.git", GitLab, "https://gitlab.com/group/sub/project"},
+		{"https://gitlab.corp.io/group/project", GitLab, "https://gitlab.corp.io/group/project"},
+		{"https://user@bitbucket.org/team/repo.git", Bitbucket, "https://bitbucket.org/team/repo"},
+	}
+	for _, tt := range tests {
+		repo, ok := ParseRemote(tt.remote)
+		require.True(t, ok, tt.remote)
+		assert.Equal(t, tt.kind, repo.Kind, tt.remote)
+		assert.Equal(t, tt.base, repo.Base, tt.remote)
+	}
+
+	for _, remote := range []string{
+		"https://example.com/owner/repo.git",
+		"/srv/git/repo.git",
+		"file:///srv/git/repo.git",
+		"https://github.com/owner",
+		"https://github.com/owner/repo/extra",
+	} {
+		_, ok := ParseRemote(remote)
+		assert.False(t, ok, remote)
+	}
+}
+
+func TestRepo_FileURL(t *testing.T) {
+	gh := &Repo{Kind: GitHub, Base: "https://github.com/o/r"}
+	assert.Equal(t, "https://github.com/o/r/blob/abc/cmd/main.go#L12", gh.FileURL("abc", "cmd/main.go", 12, false))
+	assert.Equal(t, "https://github.com/o/r/blob/abc/docs/my%20notes.md", gh.FileURL("abc", "docs/my notes.md", 0, false))
+	assert.Equal(t, "https://github.com/o/r/tree/abc/internal", gh.FileURL("abc", "internal", 0, true))
+
+	gl := &Repo{Kind: GitLab, Base: "https://gitlab.com/g/r"}
+	assert.Equal(t, "https://gitlab.com/g/r/-/blob/abc/main.go#L3", gl.FileURL("abc", "main.go", 3, false))
+	assert.Equal(t, "https://gitlab.com/g/r/-/tree/abc/pkg", gl.FileURL("abc", "pkg", 0, true))
+
+	bb := &Repo{Kind: Bitbucket, Base: "https://bitbucket.org/o/r"}
+	assert.Equal(t, "https://bitbucket.org/o/r/src/abc/main.go#lines-3", bb.FileURL("abc", "main.go", 3, false))
+	assert.Equal(t, "https://bitbucket.org/o/r/src/abc/pkg", bb.FileURL("abc", "pkg", 0, true))
+}
+
+func TestRepo_IssueURL(t *testing.T) {
+	assert.Equal(t, "https://github.com/o/r/issues/12", (&Repo{Kind: GitHub, Base: "https://github.com/o/r"}).IssueURL(12))
+	assert.Equal(t, "https://gitlab.com/g/r/-/issues/12", (&Repo{Kind: GitLab, Base: "https://gitlab.com/g/r"}).IssueURL(12))
+	assert.Equal(t, "https://bitbucket.org/o/r/issues/12", (&Repo{Kind: Bitbucket, Base: "https://bitbucket.org/o/r"}).IssueURL(12))
+}
+
+// initRepo creates a git repository with one commit and the given origin.
+func initRepo(t *testing.T, origin string) (string, string) {
+	t.Helper()
+	dir, err := filepath.EvalSymlinks(t.TempDir())
+	require.NoError(t, err)
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "svc", "api"), 0o750))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "svc", "api", "handler.go"), []byte("package api\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "README.md"), []byte("# repo\n"), 0o600))
+
+	git := func(args ...string) string {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		cmd.Env = append(os.Environ(), "GIT_AUTHOR_NAME=t", "GIT_AUTHOR_EMAIL=t@example.com",
+			"GIT_COMMITTER_NAME=t", "GIT_COMMITTER_EMAIL=t@example.com")
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, string(out))
+		return strings.TrimSpace(string(out))
+	}
+	git("init", "-q")
+	git("add", ".")
+	git("commit", "-q", "-m", "init")
+	if origin != "" {
+		git("remote", "add", "origin", origin)
+	}
+	return dir, git("rev-parse", "HEAD")
+}
+
+func TestAnnotate(t *testing.T) {
+	dir, head := initRepo(t, "git@github.com:acme/shop.git")
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "svc", "new.go"), []byte("package svc\n"), 0o600))
+
+	signals := []signal.RawSignal{
+		{FilePath: "api/handler.go", Line: 7},
+		{FilePath: "api"},
+		{FilePath: "new.go", Line: 1},
+		{FilePath: "github/issues/4", URL: "https://github.com/acme/shop/issues/4"},
+		{Title: "stale branch"},
+	}
+	n, err := Annotate(context.Background(), signals, filepath.Join(dir, "svc"), dir)
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+
+	base := "https://github.com/acme/shop/"
+	assert.Equal(t, base+"blob/"+head+"/svc/api/handler.go#L7", signals[0].URL, "paths are rooted at the git root")
+	assert.Equal(t, base+"tree/"+head+"/svc/api", signals[1].URL)
+	assert.Empty(t, signals[2].URL, "files not in HEAD are not linked")
+	assert.Equal(t, "https://github.com/acme/shop/issues/4", signals[3].URL, "existing URLs are kept")
+	assert.Empty(t, signals[4].URL)
+}
+
+func TestAnnotate_NoForge(t *testing.T) {
+	for _, origin := range []string{"", "https://git.example.com/acme/shop.git"} {
+		dir, _ := initRepo(t, origin)
+		signals := []signal.RawSignal{{FilePath: "README.md", Line: 1}}
+		n, err := Annotate(context.Background(), signals, dir, dir)
+		require.NoError(t, err)
+		assert.Zero(t, n)
+		assert.Empty(t, signals[0].URL)
+	}
+}
@@ -0,0 +1,133 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+// Package ghaction reads the GitHub Actions runner environment and writes
+// step outputs and job summaries, so stringer can run as an Actions step
+// without shell glue.
+package ghaction
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+)
+
+// Env is the subset of the GitHub Actions runner environment stringer uses.
+// See https://docs.github.com/actions/learn-github-actions/variables.
+type Env struct {
+	EventName   string // GITHUB_EVENT_NAME, e.g. "pull_request" or "push"
+	EventPath   string // GITHUB_EVENT_PATH, the webhook payload JSON
+	Repository  string // GITHUB_REPOSITORY, "owner/repo"
+	SHA         string // GITHUB_SHA
+	BaseRef     string // GITHUB_BASE_REF, set for pull request events
+	StepSummary string // GITHUB_STEP_SUMMARY, Markdown appended to the job summary
+	Output      string // GITHUB_OUTPUT, step outputs file
+	RunnerTemp  string // RUNNER_TEMP, scratch directory cleaned after the job
+}
+
+// LoadEnv reads the runner environment through getenv. It returns false
+// when not running inside GitHub Actions.
+func LoadEnv(getenv func(string) string) (*Env, bool) {
+	if getenv("GITHUB_ACTIONS") != "true" {
+		return nil, false
+	}
+	return &Env{
+		EventName:   getenv("GITHUB_EVENT_NAME"),
+		EventPath:   getenv("GITHUB_EVENT_PATH"),
+		Repository:  getenv("GITHUB_REPOSITORY"),
+		SHA:         getenv("GITHUB_SHA"),
+		BaseRef:     getenv("GITHUB_BASE_REF"),
+		StepSummary: getenv("GITHUB_STEP_SUMMARY"),
+		Output:      getenv("GITHUB_OUTPUT"),
+		RunnerTemp:  getenv("RUNNER_TEMP"),
+	}, true
+}
+
+// IsPullRequest reports whether the workflow was triggered by a pull request.
+func (e *Env) IsPullRequest() bool {
+	return e.EventName == "pull_request" || e.EventName == "pull_request_target"
+}
+
+// Event holds the pull request fields of the webhook payload. All fields are
+// zero for events without a pull request.
+type Event struct {
+	PRNumber int
+	BaseSHA  string
+	HeadSHA  string
+}
+
+// ReadEvent parses the webhook payload at path.
+func ReadEvent(path string) (*Event, error) {
+	data, err := os.ReadFile(path) //nolint:gosec // path comes from the runner
+	if err != nil {
+		return nil, fmt.Errorf("read event payload: %w", err)
+	}
+	var payload struct {
+		PullRequest *struct {
+			Number int `json:"number"`
+			Base   struct {
+				SHA string `json:"sha"`
+			} `json:"base"`
+			Head struct {
+				SHA string `json:"sha"`
+			} `json:"head"`
+		} `json:"pull_request"`
+	}
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return nil, fmt.Errorf("parse event payload: %w", err)
+	}
+	ev := &Event{}
+	if pr := payload.PullRequest; pr != nil {
+		ev.PRNumber = pr.Number
+		ev.BaseSHA = pr.Base.SHA
+		ev.HeadSHA = pr.Head.SHA
+	}
+	return ev, nil
+}
+
+// SetOutputs appends step outputs to the GITHUB_OUTPUT file at path, in key
+// order. Multi-line values use the heredoc form.
+func SetOutputs(path string, outputs map[string]string) error {
+	keys := make([]string, 0, len(outputs))
+	for k := range outputs {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var b strings.Builder
+	for _, k := range keys {
+		v := outputs[k]
+		if strings.Contains(v, "\n") {
+			delim := "STRINGER_EOF"
+			for strings.Contains(v, delim) {
+				delim += "_"
+			}
+			fmt.Fprintf(&b, "%s<<%s\n%s\n%s\n", k, delim, v, delim)
+			continue
+		}
+		fmt.Fprintf(&b, "%s=%s\n", k, v)
+	}
+	return appendFile(path, b.String())
+}
+
+// AppendSummary appends Markdown to the job summary file at path.
+func AppendSummary(path, markdown string) error {
+	if !strings.HasSuffix(markdown, "\n") {
+		markdown += "\n"
+	}
+	return appendFile(path, markdown)
+}
+
+func appendFile(path, content string) error {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600) //nolint:gosec // path comes from the runner
+	if err != nil {
+		return err
+	}
+	if _, err := f.WriteString(content); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
@@ -0,0 +1,97 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package ghaction
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mapEnv(m map[string]string) func(string) string {
+	return func(k string) string { return m[k] }
+}
+
+func TestLoadEnv(t *testing.T) {
+	_, ok := LoadEnv(mapEnv(nil))
+	assert.False(t, ok)
+
+	env, ok := LoadEnv(mapEnv(map[string]string{
+		"GITHUB_ACTIONS":      "true",
+		"GITHUB_EVENT_NAME":   "pull_request",
+		"GITHUB_EVENT_PATH":   "/tmp/event.json",
+		"GITHUB_REPOSITORY":   "owner/repo",
+		"GITHUB_SHA":          "abc",
+		"GITHUB_BASE_REF":     "main",
+		"GITHUB_STEP_SUMMARY": "/tmp/summary.md",
+		"GITHUB_OUTPUT":       "/tmp/output",
+		"RUNNER_TEMP":         "/tmp/runner",
+	}))
+	require.True(t, ok)
+	assert.Equal(t, &Env{
+		EventName: "pull_request", EventPath: "/tmp/event.json", Repository: "owner/repo",
+		SHA: "abc", BaseRef: "main", StepSummary: "/tmp/summary.md", Output: "/tmp/output",
+		RunnerTemp: "/tmp/runner",
+	}, env)
+	assert.True(t, env.IsPullRequest())
+	assert.True(t, (&Env{EventName: "pull_request_target"}).IsPullRequest())
+	assert.False(t, (&Env{EventName: "push"}).IsPullRequest())
+}
+
+func TestReadEvent(t *testing.T) {
+	dir := t.TempDir()
+	pr := filepath.Join(dir, "pr.json")
+	require.NoError(t, os.WriteFile(pr, []byte(`{"action":"opened","number":42,
+		"pull_request":{"number":42,"base":{"sha":"base123"},"head":{"sha":"head456"}}}`), 0o600))
+	ev, err := ReadEvent(pr)
+	require.NoError(t, err)
+	assert.Equal(t, &Event{PRNumber: 42, BaseSHA: "base123", HeadSHA: "head456"}, ev)
+
+	push := filepath.Join(dir, "push.json")
+	require.NoError(t, os.WriteFile(push, []byte(`{"ref":"refs/heads/main"}`), 0o600))
+	ev, err = ReadEvent(push)
+	require.NoError(t, err)
+	assert.Equal(t, &Event{}, ev)
+
+	bad := filepath.Join(dir, "bad.json")
+	require.NoError(t, os.WriteFile(bad, []byte(`{`), 0o600))
+	_, err = ReadEvent(bad)
+	assert.ErrorContains(t, err, "parse event payload")
+
+	_, err = ReadEvent(filepath.Join(dir, "missing.json"))
+	assert.ErrorContains(t, err, "read event payload")
+}
+
+func TestSetOutputs(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "output")
+	require.NoError(t, os.WriteFile(path, []byte("existing=1\n"), 0o600))
+
+	require.NoError(t, SetOutputs(path, map[string]string{
+		"signals": "3",
+		"mode":    "diff",
+		"notes":   "line one\nSTRINGER_EOF\nline three",
+	}))
+
+	data, err := os.ReadFile(path) //nolint:gosec // test-controlled path
+	require.NoError(t, err)
+	assert.Equal(t, "existing=1\n"+
+		"mode=diff\n"+
+		"notes<<STRINGER_EOF_\nline one\nSTRINGER_EOF\nline three\nSTRINGER_EOF_\n"+
+		"signals=3\n", string(data))
+}
+
+func TestAppendSummary(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "summary.md")
+	require.NoError(t, AppendSummary(path, "### One"))
+	require.NoError(t, AppendSummary(path, "### Two\n"))
+
+	data, err := os.ReadFile(path) //nolint:gosec // test-controlled path
+	require.NoError(t, err)
+	assert.Equal(t, "### One\n### Two\n", string(data))
+
+	assert.Error(t, AppendSummary(filepath.Join(t.TempDir(), "missing", "summary.md"), "x"))
+}
@@ -0,0 +1,149 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package ghaction
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Scan modes reported in Result.Mode.
+const (
+	ModeDiff = "diff" // only files changed by the pull request were scanned
+	ModeFull = "full" // the whole tree was scanned
+)
+
+// Result summarizes one `stringer action` run. Matrix jobs each write a
+// result file; `stringer action merge` combines them into one summary and
+// one set of outputs.
+type Result struct {
+	Label        string         `json:"label,omitempty"`
+	Mode         string         `json:"mode"`
+	ChangedFiles int            `json:"changed_files"`
+	Signals      int            `json:"signals"`
+	ByKind       map[string]int `json:"by_kind"`
+	Budget       int            `json:"budget,omitempty"`
+	OverBudget   bool           `json:"over_budget"`
+}
+
+// NewResult builds a result, deriving the per-kind counts and budget status
+// from the signal kinds found.
+func NewResult(label, mode string, changedFiles int, kinds []string, budget int) *Result {
+	r := &Result{
+		Label:        label,
+		Mode:         mode,
+		ChangedFiles: changedFiles,
+		Signals:      len(kinds),
+		ByKind:       make(map[string]int),
+		Budget:       budget,
+		OverBudget:   budget > 0 && len(kinds) > budget,
+	}
+	for _, k := range kinds {
+		r.ByKind[k]++
+	}
+	return r
+}
+
+// Outputs returns the step outputs for the result.
+func (r *Result) Outputs() map[string]string {
+	return map[string]string{
+		"signals":       strconv.Itoa(r.Signals),
+		"mode":          r.Mode,
+		"changed-files": strconv.Itoa(r.ChangedFiles),
+		"over-budget":   strconv.FormatBool(r.OverBudget),
+	}
+}
+
+// WriteResult writes r as JSON to path.
+func WriteResult(path string, r *Result) error {
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0o600)
+}
+
+// ReadResult reads a result file written by WriteResult.
+func ReadResult(path string) (*Result, error) {
+	data, err := os.ReadFile(path) //nolint:gosec // user-supplied result path
+	if err != nil {
+		return nil, err
+	}
+	var r Result
+	if err := json.Unmarshal(data, &r); err != nil {
+		return nil, fmt.Errorf("parse %s: %w", path, err)
+	}
+	return &r, nil
+}
+
+// Merge combines matrix results. Signal and changed-file counts are summed;
+// the merged mode is "diff" only when every leg ran in diff mode. The merged
+// result is over budget when any leg is.
+func Merge(results []*Result) *Result {
+	m := &Result{Mode: ModeDiff, ByKind: make(map[string]int)}
+	if len(results) == 0 {
+		m.Mode = ModeFull
+	}
+	for _, r := range results {
+		m.Signals += r.Signals
+		m.ChangedFiles += r.ChangedFiles
+		if r.Mode != ModeDiff {
+			m.Mode = ModeFull
+		}
+		m.OverBudget = m.OverBudget || r.OverBudget
+		for k, n := range r.ByKind {
+			m.ByKind[k] += n
+		}
+	}
+	return m
+}
+
+// RenderMergedSummary renders a Markdown job summary with one row per leg
+// and the combined signal counts by kind.
+func RenderMergedSummary(results []*Result) string {
+	merged := Merge(results)
+
+	var b strings.Builder
+	b.WriteString("### Stringer\n\n")
+	fmt.Fprintf(&b, "**%d signals** across %d matrix jobs\n\n", merged.Signals, len(results))
+
+	b.WriteString("| Job | Mode | Changed files | Signals | Budget |\n")
+	b.WriteString("|-----|------|---------------|---------|--------|\n")
+	for i, r := range results {
+		label := r.Label
+		if label == "" {
+			label = fmt.Sprintf("job %d", i+1)
+		}
+		budget := "—"
+		switch {
+		case r.OverBudget:
+			budget = fmt.Sprintf(":x: over (%d)", r.Budget)
+		case r.Budget > 0:
+			budget = fmt.Sprintf(":white_check_mark: %d", r.Budget)
+		}
+		fmt.Fprintf(&b, "| %s | %s | %d | %d | %s |\n", label, r.Mode, r.ChangedFiles, r.Signals, budget)
+	}
+
+	if len(merged.ByKind) > 0 {
+		kinds := make([]string, 0, len(merged.ByKind))
+		for k := range merged.ByKind {
+			kinds = append(kinds, k)
+		}
+		sort.Slice(kinds, func(i, j int) bool {
+			if merged.ByKind[kinds[i]] != merged.ByKind[kinds[j]] {
+				return merged.ByKind[kinds[i]] > merged.ByKind[kinds[j]]
+			}
+			return kinds[i] < kinds[j]
+		})
+		b.WriteString("\n| Kind | Signals |\n|------|---------|\n")
+		for _, k := range kinds {
+			fmt.Fprintf(&b, "| %s | %d |\n", k, merged.ByKind[k])
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,72 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package ghaction
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewResult(t *testing.T) {
+	r := NewResult("linux", ModeDiff, 4, []string{"todo", "todo", "fixme"}, 2)
+	assert.Equal(t, 3, r.Signals)
+	assert.Equal(t, map[string]int{"todo": 2, "fixme": 1}, r.ByKind)
+	assert.True(t, r.OverBudget)
+	assert.Equal(t, map[string]string{
+		"signals":       "3",
+		"mode":          "diff",
+		"changed-files": "4",
+		"over-budget":   "true",
+	}, r.Outputs())
+
+	assert.False(t, NewResult("", ModeFull, 0, []string{"todo"}, 0).OverBudget, "no budget is never over")
+	assert.False(t, NewResult("", ModeFull, 0, []string{"todo"}, 1).OverBudget)
+}
+
+func TestWriteReadResult(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "result.json")
+	want := NewResult("macos", ModeFull, 0, []string{"churn"}, 0)
+	require.NoError(t, WriteResult(path, want))
+
+	got, err := ReadResult(path)
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+
+	require.NoError(t, os.WriteFile(path, []byte("{"), 0o600))
+	_, err = ReadResult(path)
+	assert.ErrorContains(t, err, "parse")
+}
+
+func TestMerge(t *testing.T) {
+	a := NewResult("a", ModeDiff, 2, []string{"todo"}, 0)
+	b := NewResult("b", ModeDiff, 3, []string{"todo", "fixme"}, 1)
+
+	m := Merge([]*Result{a, b})
+	assert.Equal(t, ModeDiff, m.Mode)
+	assert.Equal(t, 3, m.Signals)
+	assert.Equal(t, 5, m.ChangedFiles)
+	assert.Equal(t, map[string]int{"todo": 2, "fixme": 1}, m.ByKind)
+	assert.True(t, m.OverBudget, "over budget when any job is")
+
+	c := NewResult("c", ModeFull, 0, nil, 0)
+	assert.Equal(t, ModeFull, Merge([]*Result{a, c}).Mode, "full when any job scanned the whole tree")
+	assert.Equal(t, ModeFull, Merge(nil).Mode)
+}
+
+func TestRenderMergedSummary(t *testing.T) {
+	out := RenderMergedSummary([]*Result{
+		NewResult("linux", ModeDiff, 2, []string{"todo", "fixme"}, 0),
+		NewResult("", ModeDiff, 1, []string{"todo", "todo"}, 1),
+		NewResult("windows", ModeDiff, 1, nil, 5),
+	})
+	assert.Contains(t, out, "**4 signals** across 3 matrix jobs")
+	assert.Contains(t, out, "| linux | diff | 2 | 2 | — |")
+	assert.Contains(t, out, "| job 2 | diff | 1 | 2 | :x: over (1) |")
+	assert.Contains(t, out, "| windows | diff | 1 | 0 | :white_check_mark: 5 |")
+	assert.Contains(t, out, "| todo | 3 |\n| fixme | 1 |")
+}
@@ -37,14 +37,16 @@ func SetExecutor(e testable.CommandExecutor) {
 
 // BlameLine holds attribution data for a single source line.
 type BlameLine struct {
-	AuthorName string
-	AuthorTime time.Time
+	AuthorName  string
+	AuthorEmail string
+	AuthorTime  time.Time
 }
 
 // commitInfo caches author metadata for a single commit SHA.
 type commitInfo struct {
-	authorName string
-	authorTime time.Time
+	authorName  string
+	authorEmail string
+	authorTime  time.Time
 }
 
 // Available returns nil if git is on PATH, or an error otherwise.
@@ -96,6 +98,16 @@ func BlameFile(ctx context.Context, repoDir, relPath string) ([]BlameLine, error
 	return parsePorcelainBlame(out)
 }
 
+// BlameFileAt runs `git blame --porcelain <rev> -- <relPath>` and returns one
+// BlameLine per source line of relPath as of rev, ignoring working tree edits.
+func BlameFileAt(ctx context.Context, repoDir, rev, relPath string) ([]BlameLine, error) {
+	out, err := Exec(ctx, repoDir, "blame", "--porcelain", rev, "--", relPath)
+	if err != nil {
+		return nil, err
+	}
+	return parsePorcelainBlame(out)
+}
+
 // parsePorcelainBlame parses the output of `git blame --porcelain`.
 //
 // Porcelain format consists of blocks, one per source line:
@@ -141,6 +153,8 @@ func parsePorcelainBlame(output string) ([]BlameLine, error) {
 
 			if strings.HasPrefix(mline, "author ") {
 				info.authorName = strings.TrimPrefix(mline, "author ")
+			} else if strings.HasPrefix(mline, "author-mail ") {
+				info.authorEmail = strings.Trim(strings.TrimPrefix(mline, "author-mail "), "<>")
 			} else if strings.HasPrefix(mline, "author-time ") {
 				ts, err := strconv.ParseInt(strings.TrimPrefix(mline, "author-time "), 10, 64)
 				if err == nil {
@@ -150,8 +164,9 @@ func parsePorcelainBlame(output string) ([]BlameLine, error) {
 		}
 
 		result = append(result, BlameLine{
-			AuthorName: info.authorName,
-			AuthorTime: info.authorTime,
+			AuthorName:  info.authorName,
+			AuthorEmail: info.authorEmail,
+			AuthorTime:  info.authorTime,
 		})
 	}
 
@@ -182,10 +197,11 @@ func LastCommitTime(ctx context.Context, repoDir, path string) (time.Time, error
 
 // NumstatCommit holds parsed data from a single commit in git log --numstat output.
 type NumstatCommit struct {
-	SHA        string
-	Author     string
-	AuthorTime time.Time
-	Files      []string
+	SHA         string
+	Author      string
+	AuthorEmail string
+	AuthorTime  time.Time
+	Files       []string
 }
 
 // LogNumstat runs `git log --numstat --format=...` and returns structured
@@ -195,7 +211,7 @@ func LogNumstat(ctx context.Context, repoDir string, maxCount int, since string)
 	args := []string{
 		"log",
 		"--numstat",
-		"--format=format:%H|%aN|%aI",
+		"--format=format:%H|%aN|%aE|%aI",
 		fmt.Sprintf("--max-count=%d", maxCount),
 	}
 	if since != "" {
@@ -209,11 +225,11 @@ func LogNumstat(ctx context.Context, repoDir string, maxCount int, since string)
 	return parseNumstatLog(out)
 }
 
-// parseNumstatLog parses the output of `git log --numstat --format='format:%H|%aN|%aI'`.
+// parseNumstatLog parses the output of `git log --numstat --format='format:%H|%aN|%aE|%aI'`.
 //
 // Format:
 //
-//	<sha>|<author>|<iso-date>
+//	<sha>|<author>|<email>|<iso-date>
 //	<added>\t<removed>\t<filepath>
 //	                                    ← blank line separates commits
 func parseNumstatLog(output string) ([]NumstatCommit, error) {
@@ -226,14 +242,15 @@ func parseNumstatLog(output string) ([]NumstatCommit, error) {
 			continue
 		}
 
-		// Try to parse as a header line: SHA|Author|Date
-		parts := strings.SplitN(line, "|", 3)
-		if len(parts) == 3 && isHexSHA(parts[0]) {
-			t, _ := time.Parse(time.RFC3339, strings.TrimSpace(parts[2]))
+		// Try to parse as a header line: SHA|Author|Email|Date
+		parts := strings.SplitN(line, "|", 4)
+		if len(parts) == 4 && isHexSHA(parts[0]) {
+			t, _ := time.Parse(time.RFC3339, strings.TrimSpace(parts[3]))
 			commit := NumstatCommit{
-				SHA:        parts[0],
-				Author:     parts[1],
-				AuthorTime: t,
+				SHA:         parts[0],
+				Author:      parts[1],
+				AuthorEmail: parts[2],
+				AuthorTime:  t,
 			}
 
 			// Read numstat lines until blank line or next header.
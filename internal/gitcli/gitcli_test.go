@@ -156,6 +156,26 @@ func TestBlameFile_MultipleAuthors(t *testing.T) {
 	}
 }
 
+func TestBlameFileAt_IgnoresWorkingTree(t *testing.T) {
+	dir := initTestRepo(t, map[string]string{
+		"a.go": "one\ntwo\n",
+	})
+	if err := os.WriteFile(filepath.Join(dir, "a.go"), []byte("one\ntwo\nthree\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	lines, err := BlameFileAt(context.Background(), dir, "HEAD", "a.go")
+	if err != nil {
+		t.Fatalf("BlameFileAt error: %v", err)
+	}
+	if len(lines) != 2 {
+		t.Fatalf("got %d lines, want 2 (committed content only)", len(lines))
+	}
+	if lines[0].AuthorName != "Test Author" {
+		t.Errorf("AuthorName = %q, want %q", lines[0].AuthorName, "Test Author")
+	}
+}
+
 func TestParsePorcelainBlame(t *testing.T) {
 	// Synthetic porcelain output with full and abbreviated blocks.
 	porcelain := `abc123def456abc123def456abc123def456abcd 1 1 2
@@ -198,6 +218,9 @@ filename hello.go
 	if lines[0].AuthorName != "Alice" {
 		t.Errorf("line 1 author = %q, want %q", lines[0].AuthorName, "Alice")
 	}
+	if lines[0].AuthorEmail != "alice@example.com" {
+		t.Errorf("line 1 author-mail = %q, want %q", lines[0].AuthorEmail, "alice@example.com")
+	}
 	if lines[0].AuthorTime.Unix() != 1700000000 {
 		t.Errorf("line 1 author-time = %d, want %d", lines[0].AuthorTime.Unix(), 1700000000)
 	}
@@ -386,11 +409,11 @@ func TestLogNumstat_EmptyRepo(t *testing.T) {
 }
 
 func TestParseNumstatLog(t *testing.T) {
-	output := "abc123def456abc123def456abc123def456abcd|Alice|2025-01-15T10:00:00+00:00\n" +
+	output := "abc123def456abc123def456abc123def456abcd|Alice|alice@example.com|2025-01-15T10:00:00+00:00\n" +
 		"3\t0\tmain.go\n" +
 		"5\t2\tlib/util.go\n" +
 		"\n" +
-		"def789012345def789012345def789012345def0|Bob|2025-01-14T09:00:00+00:00\n" +
+		"def789012345def789012345def789012345def0|Bob|bob@example.com|2025-01-14T09:00:00+00:00\n" +
 		"1\t1\tREADME.md\n" +
 		"\n"
 
@@ -405,6 +428,9 @@ func TestParseNumstatLog(t *testing.T) {
 	if commits[0].Author != "Alice" {
 		t.Errorf("commit 0 author = %q, want %q", commits[0].Author, "Alice")
 	}
+	if commits[0].AuthorEmail != "alice@example.com" {
+		t.Errorf("commit 0 email = %q, want %q", commits[0].AuthorEmail, "alice@example.com")
+	}
 	if len(commits[0].Files) != 2 {
 		t.Errorf("commit 0 files = %d, want 2", len(commits[0].Files))
 	}
@@ -421,7 +447,7 @@ func TestParseNumstatLog(t *testing.T) {
 }
 
 func TestParseNumstatLog_Rename(t *testing.T) {
-	output := "abc123def456abc123def456abc123def456abcd|Alice|2025-01-15T10:00:00+00:00\n" +
+	output := "abc123def456abc123def456abc123def456abcd|Alice|alice@example.com|2025-01-15T10:00:00+00:00\n" +
 		"0\t0\told.go => new.go\n" +
 		"\n"
 
@@ -438,7 +464,7 @@ func TestParseNumstatLog_Rename(t *testing.T) {
 }
 
 func TestParseNumstatLog_BraceRename(t *testing.T) {
-	output := "abc123def456abc123def456abc123def456abcd|Alice|2025-01-15T10:00:00+00:00\n" +
+	output := "abc123def456abc123def456abc123def456abcd|Alice|alice@example.com|2025-01-15T10:00:00+00:00\n" +
 		"0\t0\tsrc/{old.go => new.go}\n" +
 		"\n"
 
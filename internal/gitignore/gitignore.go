@@ -0,0 +1,267 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+// Package gitignore decides which paths of a repository git ignores, so
+// collectors walking the tree skip build output and other untracked
+// artifacts. It reads .git/info/exclude and the .gitignore file of every
+// directory, following the rules of gitignore(5): later patterns override
+// earlier ones, a .gitignore overrides those of its parent directories, "!"
+// re-includes, and nothing inside an ignored directory can be re-included.
+// The user's global core.excludesFile is not read, so scans of the same
+// checkout agree across machines.
+package gitignore
+
+import (
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/davetashner/stringer/internal/testable"
+)
+
+// FileName is the name of per-directory ignore files.
+const FileName = ".gitignore"
+
+// FS is the file system implementation used by this package.
+// Override in tests with a testable.MockFileSystem.
+var FS testable.FileSystem = testable.DefaultFS
+
+// pattern is one line of an ignore file.
+type pattern struct {
+	re      *regexp.Regexp // matches a path relative to the file's directory
+	negate  bool
+	dirOnly bool
+}
+
+// Matcher answers whether paths under a repository root are ignored. It
+// reads each directory's .gitignore the first time a path below it is
+// asked about. It is safe for concurrent use.
+type Matcher struct {
+	root string
+
+	mu      sync.Mutex
+	levels  map[string][]pattern // directory (slash-separated, "" for root) -> patterns
+	ignored map[string]bool      // directory -> result, for the parent walk
+}
+
+// New returns a Matcher for the repository at root, which should be the
+// git root so the .gitignore files above a scanned subdirectory apply.
+func New(root string) *Matcher {
+	if abs, err := filepath.Abs(root); err == nil {
+		root = abs
+	}
+	return &Matcher{
+		root:    root,
+		levels:  make(map[string][]pattern),
+		ignored: make(map[string]bool),
+	}
+}
+
+// Ignored reports whether the file or directory at p, absolute or relative
+// to the working directory, is ignored. Paths outside the root, and the
+// root itself, are never ignored. A nil Matcher ignores nothing.
+func (m *Matcher) Ignored(p string, isDir bool) bool {
+	if m == nil {
+		return false
+	}
+	if !filepath.IsAbs(p) {
+		abs, err := filepath.Abs(p)
+		if err != nil {
+			return false
+		}
+		p = abs
+	}
+	rel, err := filepath.Rel(m.root, p)
+	if err != nil || rel == "." || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return false
+	}
+	rel = filepath.ToSlash(rel)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if dir := path.Dir(rel); dir != "." && m.dirIgnored(dir) {
+		return true
+	}
+	return m.match(rel, isDir)
+}
+
+// dirIgnored reports whether dir or one of its parents is ignored.
+// Callers hold m.mu.
+func (m *Matcher) dirIgnored(dir string) bool {
+	if v, ok := m.ignored[dir]; ok {
+		return v
+	}
+	v := false
+	if parent := path.Dir(dir); parent != "." && m.dirIgnored(parent) {
+		v = true
+	} else {
+		v = m.match(dir, true)
+	}
+	m.ignored[dir] = v
+	return v
+}
+
+// match applies the patterns of every level from the root down to rel's
+// directory; the last pattern matching rel decides. Callers hold m.mu.
+func (m *Matcher) match(rel string, isDir bool) bool {
+	ignored := false
+	for _, dir := range levels(rel) {
+		sub := rel
+		if dir != "" {
+			sub = strings.TrimPrefix(rel, dir+"/")
+		}
+		for _, p := range m.load(dir) {
+			if p.dirOnly && !isDir {
+				continue
+			}
+			if p.re.MatchString(sub) {
+				ignored = !p.negate
+			}
+		}
+	}
+	return ignored
+}
+
+// levels returns the directories whose ignore files apply to rel, from
+// the root ("") down to rel's parent.
+func levels(rel string) []string {
+	dirs := []string{""}
+	for i := range len(rel) {
+		if rel[i] == '/' {
+			dirs = append(dirs, rel[:i])
+		}
+	}
+	return dirs
+}
+
+// load returns the patterns of dir's .gitignore, reading it on first use.
+// The root level also holds .git/info/exclude, which .gitignore overrides.
+// Callers hold m.mu.
+func (m *Matcher) load(dir string) []pattern {
+	if ps, ok := m.levels[dir]; ok {
+		return ps
+	}
+	var ps []pattern
+	if dir == "" {
+		ps = append(ps, readFile(filepath.Join(m.root, ".git", "info", "exclude"))...)
+	}
+	ps = append(ps, readFile(filepath.Join(m.root, filepath.FromSlash(dir), FileName))...)
+	m.levels[dir] = ps
+	return ps
+}
+
+// readFile parses the ignore file at name. A missing or unreadable file
+// has no patterns.
+func readFile(name string) []pattern {
+	data, err := FS.ReadFile(name)
+	if err != nil {
+		return nil
+	}
+	return parse(string(data))
+}
+
+// parse returns the patterns of an ignore file's contents. Lines that are
+// blank, comments, or invalid are skipped.
+func parse(content string) []pattern {
+	var ps []pattern
+	for _, line := range strings.Split(content, "\n") {
+		if p, ok := parseLine(strings.TrimSuffix(line, "\r")); ok {
+			ps = append(ps, p)
+		}
+	}
+	return ps
+}
+
+// parseLine compiles one ignore-file line.
+func parseLine(line string) (pattern, bool) {
+	line = trimTrailingSpace(line)
+	if line == "" || strings.HasPrefix(line, "#") {
+		return pattern{}, false
+	}
+	var p pattern
+	if strings.HasPrefix(line, "!") {
+		p.negate = true
+		line = line[1:]
+	}
+	if strings.HasSuffix(line, "/") {
+		p.dirOnly = true
+		line = strings.TrimRight(line, "/")
+	}
+	if line == "" {
+		return pattern{}, false
+	}
+
+	// A slash anywhere but the end anchors the pattern to the directory of
+	// the ignore file; otherwise it matches a name at any depth.
+	anchored := strings.Contains(line, "/")
+	line = strings.TrimPrefix(line, "/")
+
+	var b strings.Builder
+	b.WriteString("^")
+	if !anchored {
+		b.WriteString("(?:.*/)?")
+	}
+	if !translate(&b, line) {
+		return pattern{}, false
+	}
+	b.WriteString("$")
+	re, err := regexp.Compile(b.String())
+	if err != nil {
+		return pattern{}, false
+	}
+	p.re = re
+	return p, true
+}
+
+// translate writes the regular expression for the glob g to b. It
+// reports false for a malformed glob.
+func translate(b *strings.Builder, g string) bool {
+	for i := 0; i < len(g); i++ {
+		c := g[i]
+		switch {
+		case c == '*' && strings.HasPrefix(g[i:], "**") && (i == 0 || g[i-1] == '/') && (i+2 == len(g) || g[i+2] == '/'):
+			if i+2 == len(g) {
+				b.WriteString(".*") // "a/**": everything inside a
+				i++
+				continue
+			}
+			b.WriteString("(?:.*/)?") // "**/": zero or more directories
+			i += 2
+		case c == '*':
+			b.WriteString("[^/]*")
+		case c == '?':
+			b.WriteString("[^/]")
+		case c == '[':
+			end := strings.IndexByte(g[i+1:], ']')
+			if end < 0 {
+				return false
+			}
+			class := g[i+1 : i+1+end]
+			if strings.HasPrefix(class, "!") {
+				class = "^" + class[1:]
+			}
+			b.WriteString("[" + strings.ReplaceAll(class, `\`, `\\`) + "]")
+			i += end + 1
+		case c == '\\' && i+1 < len(g):
+			i++
+			b.WriteString(regexp.QuoteMeta(string(g[i])))
+		default:
+			b.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	return true
+}
+
+// trimTrailingSpace removes trailing spaces unless escaped with a
+// backslash.
+func trimTrailingSpace(s string) string {
+	for strings.HasSuffix(s, " ") && !strings.HasSuffix(s, `\ `) {
+		s = s[:len(s)-1]
+	}
+	if strings.HasSuffix(s, `\ `) {
+		s = s[:len(s)-2] + " "
+	}
+	return s
+}
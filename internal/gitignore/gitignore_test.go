@@ -0,0 +1,136 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package gitignore
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeFiles creates each file under dir with the given content.
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+	for name, content := range files {
+		p := filepath.Join(dir, filepath.FromSlash(name))
+		require.NoError(t, os.MkdirAll(filepath.Dir(p), 0o750))
+		require.NoError(t, os.WriteFile(p, []byte(content), 0o600))
+	}
+}
+
+func TestParse_Patterns(t *testing.T) {
+	tests := []struct {
+		pattern string
+		path    string
+		isDir   bool
+		want    bool
+	}{
+		{"*.log", "debug.log", false, true},
+		{"*.log", "logs/debug.log", false, true},
+		{"*.log", "debug.log.txt", false, false},
+		{"build/", "build", true, true},
+		{"build/", "build", false, false},
+		{"build/", "src/build", true, true},
+		{"/dist", "dist", true, true},
+		{"/dist", "src/dist", true, false},
+		{"doc/*.txt", "doc/notes.txt", false, true},
+		{"doc/*.txt", "doc/api/notes.txt", false, false},
+		{"**/gen", "gen", true, true},
+		{"**/gen", "a/b/gen", true, true},
+		{"out/**", "out/a/b.go", false, true},
+		{"out/**", "out", true, false},
+		{"a/**/z", "a/z", false, true},
+		{"a/**/z", "a/b/c/z", false, true},
+		{"file?.go", "file1.go", false, true},
+		{"file?.go", "file10.go", false, false},
+		{"[ab].go", "a.go", false, true},
+		{"[!ab].go", "a.go", false, false},
+		{"[!ab].go", "c.go", false, true},
+		{`\#notes`, "#notes", false, true},
+		{`\!keep`, "!keep", false, true},
+		{`trail\ `, "trail ", false, true},
+		{"trail   ", "trail", false, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.pattern+" "+tt.path, func(t *testing.T) {
+			ps := parse(tt.pattern)
+			require.Len(t, ps, 1)
+			p := ps[0]
+			got := p.re.MatchString(tt.path) && (!p.dirOnly || tt.isDir)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParse_SkipsCommentsAndBlanks(t *testing.T) {
+	ps := parse("# comment\n\n   \r\n!\n/\n[unclosed\n*.o\r\n")
+	require.Len(t, ps, 1)
+	assert.True(t, ps[0].re.MatchString("main.o"))
+}
+
+func TestMatcher_Ignored(t *testing.T) {
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{
+		".gitignore":         "*.log\nbuild/\n/dist\n!keep.log\n",
+		".git/info/exclude":  "scratch/\nlocal.txt\n",
+		"src/.gitignore":     "gen/\n!debug.log\n",
+		"src/gen/.gitignore": "!*\n",
+		"local.txt":          "",
+	})
+	m := New(dir)
+
+	tests := []struct {
+		path  string
+		isDir bool
+		want  bool
+	}{
+		{"main.go", false, false},
+		{"debug.log", false, true},
+		{"keep.log", false, false},
+		{"build", true, true},
+		{"build/out.go", false, true},
+		{"pkg/build/deep/out.go", false, true},
+		{"dist", true, true},
+		{"dist/app.js", false, true},
+		{"src/dist/app.js", false, false},
+		{"scratch/notes.md", false, true},
+		{"local.txt", false, true},
+		{"src/gen/types.go", false, true}, // cannot re-include inside an ignored directory
+		{"src/debug.log", false, false},   // the nested file overrides its parent
+		{"src/trace.log", false, true},
+		{"lib/gen/types.go", false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			assert.Equal(t, tt.want, m.Ignored(filepath.Join(dir, filepath.FromSlash(tt.path)), tt.isDir))
+		})
+	}
+}
+
+func TestMatcher_RootAndOutside(t *testing.T) {
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{".gitignore": "*\n"})
+	m := New(dir)
+
+	assert.False(t, m.Ignored(dir, true), "the root is never ignored")
+	assert.False(t, m.Ignored(filepath.Join(filepath.Dir(dir), "other.go"), false))
+	assert.True(t, m.Ignored(filepath.Join(dir, "a.go"), false))
+
+	var none *Matcher
+	assert.False(t, none.Ignored(filepath.Join(dir, "a.go"), false))
+}
+
+func TestMatcher_RelativePaths(t *testing.T) {
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{".gitignore": "vendor/\n"})
+	t.Chdir(dir)
+
+	m := New(".")
+	assert.True(t, m.Ignored(filepath.Join("vendor", "x.go"), false))
+	assert.True(t, m.Ignored(filepath.Join(dir, "vendor", "x.go"), false))
+	assert.False(t, m.Ignored("x.go", false))
+}
@@ -0,0 +1,123 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+// Package gradle reads the project list of a Gradle multi-project build
+// (settings.gradle or settings.gradle.kts) so collectors and reports can
+// attribute files to Gradle modules instead of raw directories.
+package gradle
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// SettingsFiles are the settings file names Gradle looks for, in order.
+var SettingsFiles = []string{"settings.gradle.kts", "settings.gradle"}
+
+// Module is one included Gradle project.
+type Module struct {
+	Name string `json:"name"` // project path, e.g. ":core:data"
+	Dir  string `json:"dir"`  // slash-separated directory relative to the root
+}
+
+var (
+	// blockComment and lineComment match Groovy/Kotlin comments. The line
+	// form requires a preceding space or line start so "https://" survives.
+	blockComment = regexp.MustCompile(`(?s)/\*.*?\*/`)
+	lineComment  = regexp.MustCompile(`(?m)(^|\s)//.*$`)
+
+	// includeStmt matches include ':a', ':b' and include(":a", ":b"),
+	// including argument lists that continue over several lines.
+	includeStmt = regexp.MustCompile(`\binclude\s*\(?((?:\s*['"][^'"\n]+['"]\s*,?)+)`)
+	quoted      = regexp.MustCompile(`['"]([^'"\n]+)['"]`)
+
+	// projectDir matches project(':a').projectDir = file('modules/a').
+	projectDir = regexp.MustCompile(`project\s*\(\s*['"]([^'"\n]+)['"]\s*\)\s*\.projectDir\s*=\s*(?:new\s+)?file\s*\(\s*['"]([^'"\n]+)['"]\s*\)`)
+)
+
+// Modules returns the modules of the Gradle build rooted at repoPath whose
+// directories exist, sorted by directory. It returns nil when there is no
+// settings file or it includes no projects.
+func Modules(repoPath string) ([]Module, error) {
+	for _, name := range SettingsFiles {
+		data, err := os.ReadFile(filepath.Join(repoPath, name)) //nolint:gosec // repo-relative settings file
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		var mods []Module
+		for _, m := range ParseSettings(data) {
+			if info, err := os.Stat(filepath.Join(repoPath, filepath.FromSlash(m.Dir))); err == nil && info.IsDir() {
+				mods = append(mods, m)
+			}
+		}
+		return mods, nil
+	}
+	return nil, nil
+}
+
+// ParseSettings returns the projects included by a settings file, sorted
+// by directory. A project's directory is its path with ":" replaced by
+// "/" unless the file assigns projectDir. Projects outside the root, such
+// as includeFlat siblings, are left out.
+func ParseSettings(data []byte) []Module {
+	src := lineComment.ReplaceAllString(blockComment.ReplaceAllString(string(data), ""), "$1")
+
+	dirs := make(map[string]string)
+	var names []string
+	for _, stmt := range includeStmt.FindAllStringSubmatch(src, -1) {
+		for _, q := range quoted.FindAllStringSubmatch(stmt[1], -1) {
+			name := normalizeName(q[1])
+			if name == ":" {
+				continue
+			}
+			if _, ok := dirs[name]; !ok {
+				names = append(names, name)
+			}
+			dirs[name] = strings.ReplaceAll(strings.TrimPrefix(name, ":"), ":", "/")
+		}
+	}
+	for _, m := range projectDir.FindAllStringSubmatch(src, -1) {
+		if name := normalizeName(m[1]); dirs[name] != "" {
+			dirs[name] = m[2]
+		}
+	}
+
+	var mods []Module
+	for _, name := range names {
+		dir := path.Clean(strings.TrimPrefix(filepath.ToSlash(dirs[name]), "./"))
+		if dir == "." || dir == ".." || strings.HasPrefix(dir, "../") || path.IsAbs(dir) {
+			continue
+		}
+		mods = append(mods, Module{Name: name, Dir: dir})
+	}
+	sort.Slice(mods, func(i, j int) bool { return mods[i].Dir < mods[j].Dir })
+	return mods
+}
+
+// normalizeName returns a project path with its leading colon.
+func normalizeName(name string) string {
+	return ":" + strings.TrimPrefix(strings.TrimSpace(name), ":")
+}
+
+// Containing returns the module with the deepest directory that contains
+// relPath, a file or directory relative to the build root.
+func Containing(mods []Module, relPath string) (Module, bool) {
+	p := filepath.ToSlash(relPath)
+	var best Module
+	found := false
+	for _, m := range mods {
+		if (p == m.Dir || strings.HasPrefix(p, m.Dir+"/")) && len(m.Dir) > len(best.Dir) {
+			best, found = m, true
+		}
+	}
+	return best, found
+}
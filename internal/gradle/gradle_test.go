@@ -0,0 +1,77 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package gradle
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSettings_Groovy(t *testing.T) {
+	mods := ParseSettings([]byte(`rootProject.name = 'shop' // see https://docs.gradle.org
+include ':app', ':core:data',
+        'feature-cart'
+// include ':old'
+/* include ':legacy' */
+include ':payments'
+project(':payments').projectDir = file('modules/payments')
+includeFlat 'sibling'
+`))
+	assert.Equal(t, []Module{
+		{Name: ":app", Dir: "app"},
+		{Name: ":core:data", Dir: "core/data"},
+		{Name: ":feature-cart", Dir: "feature-cart"},
+		{Name: ":payments", Dir: "modules/payments"},
+	}, mods)
+}
+
+func TestParseSettings_Kotlin(t *testing.T) {
+	mods := ParseSettings([]byte(`rootProject.name = "shop"
+include(
+    ":app",
+    ":core:domain",
+)
+include(":tools")
+project(":tools").projectDir = file("../tools")
+`))
+	assert.Equal(t, []Module{
+		{Name: ":app", Dir: "app"},
+		{Name: ":core:domain", Dir: "core/domain"},
+	}, mods, "projects outside the root are dropped")
+}
+
+func TestModules(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "app", "src"), 0o750))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "settings.gradle.kts"), []byte(`include(":app", ":missing")`), 0o600))
+
+	mods, err := Modules(dir)
+	require.NoError(t, err)
+	assert.Equal(t, []Module{{Name: ":app", Dir: "app"}}, mods, "modules without a directory are dropped")
+
+	mods, err = Modules(t.TempDir())
+	require.NoError(t, err)
+	assert.Nil(t, mods)
+}
+
+func TestContaining(t *testing.T) {
+	mods := []Module{{Name: ":core", Dir: "core"}, {Name: ":core:data", Dir: "core/data"}}
+
+	m, ok := Containing(mods, "core/data/src/main/kotlin/Repo.kt")
+	require.True(t, ok)
+	assert.Equal(t, ":core:data", m.Name, "deepest module wins")
+
+	m, ok = Containing(mods, "core")
+	require.True(t, ok)
+	assert.Equal(t, ":core", m.Name)
+
+	_, ok = Containing(mods, "core-utils/Main.kt")
+	assert.False(t, ok, "prefix matches whole segments")
+	_, ok = Containing(mods, "build.gradle.kts")
+	assert.False(t, ok)
+}
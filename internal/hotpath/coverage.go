@@ -0,0 +1,56 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package hotpath
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// parseCoverage decodes a Go coverage profile, as written by go test
+// -coverprofile or by GOCOVERDIR-instrumented binaries via go tool covdata
+// textfmt. Each block contributes its statement count times its execution
+// count, so it needs "count" or "atomic" mode; "set" mode only records
+// whether a block ran.
+func parseCoverage(data []byte) (*Profile, error) {
+	p := &Profile{Files: make(map[string]int64)}
+	sc := bufio.NewScanner(bytes.NewReader(data))
+	lineNo := 0
+	for sc.Scan() {
+		lineNo++
+		line := strings.TrimSpace(sc.Text())
+		if line == "" {
+			continue
+		}
+		if mode, ok := strings.CutPrefix(line, "mode:"); ok {
+			if strings.TrimSpace(mode) == "set" {
+				return nil, fmt.Errorf("coverage profile uses mode: set, which has no execution counts (use -covermode=count)")
+			}
+			continue
+		}
+
+		// file.go:12.34,15.2 3 42
+		colon := strings.LastIndex(line, ":")
+		fields := strings.Fields(line[colon+1:])
+		if colon <= 0 || len(fields) != 3 {
+			return nil, fmt.Errorf("coverage profile line %d: malformed block %q", lineNo, line)
+		}
+		stmts, err1 := strconv.ParseInt(fields[1], 10, 64)
+		count, err2 := strconv.ParseInt(fields[2], 10, 64)
+		if err1 != nil || err2 != nil {
+			return nil, fmt.Errorf("coverage profile line %d: malformed block %q", lineNo, line)
+		}
+		if w := stmts * count; w > 0 {
+			p.Files[line[:colon]] += w
+			p.Total += w
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return nil, fmt.Errorf("read coverage profile: %w", err)
+	}
+	return p, nil
+}
@@ -0,0 +1,178 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+// Package hotpath reads execution profiles (pprof CPU or memory profiles and
+// Go coverage profiles taken from production) and marks signals in
+// frequently executed files, so debt on hot paths is prioritized by real
+// usage.
+package hotpath
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+// Tag is added to signals in hot files.
+const Tag = "hot-path"
+
+// DefaultMinShare is the default share of a profile's total weight a file
+// must account for to be hot.
+const DefaultMinShare = 0.05
+
+// Confidence boosts applied to signals in hot files.
+const (
+	// hotBoost applies to every signal in a hot file.
+	hotBoost = 0.10
+
+	// optimizationBoost applies instead of hotBoost to kinds whose fix
+	// directly speeds up the hot path.
+	optimizationBoost = 0.20
+)
+
+// optimizationKinds are the signal kinds that describe performance-relevant
+// debt.
+var optimizationKinds = map[string]bool{
+	"optimize":         true,
+	"complex-function": true,
+	"large-file":       true,
+}
+
+// Profile is the execution weight of each source file in a profile. Paths
+// are as recorded by the profiler: usually absolute build paths for pprof
+// and import paths for coverage profiles.
+type Profile struct {
+	Files map[string]int64
+	Total int64
+}
+
+// Load reads a profile file. Gzip-compressed or raw pprof protobuf and Go
+// coverage profiles ("mode: ..." text) are recognized by content.
+func Load(path string) (*Profile, error) {
+	data, err := os.ReadFile(path) //nolint:gosec // user-supplied profile path
+	if err != nil {
+		return nil, err
+	}
+	p, err := Parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return p, nil
+}
+
+// Parse decodes profile data, detecting the format from its content.
+func Parse(data []byte) (*Profile, error) {
+	if bytes.HasPrefix(data, []byte{0x1f, 0x8b}) {
+		zr, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, fmt.Errorf("decompress profile: %w", err)
+		}
+		if data, err = io.ReadAll(zr); err != nil {
+			return nil, fmt.Errorf("decompress profile: %w", err)
+		}
+	}
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("mode:")) {
+		return parseCoverage(data)
+	}
+	return parsePprof(data)
+}
+
+// Shares maps repository-relative paths to the share of the profile's total
+// weight spent in them. Profile paths carry a build-specific prefix
+// ("/home/ci/src/repo/" or "github.com/org/repo/"); the prefix is inferred
+// as the one under which the most given paths, weighted by depth, appear
+// in the profile. Paths absent from the profile are omitted.
+func (p *Profile) Shares(paths []string) map[string]float64 {
+	shares := make(map[string]float64)
+	if p.Total <= 0 {
+		return shares
+	}
+
+	votes := make(map[string]int)
+	for f := range p.Files {
+		for _, rel := range paths {
+			if f == rel || strings.HasSuffix(f, "/"+rel) {
+				votes[f[:len(f)-len(rel)]] += strings.Count(rel, "/") + 1
+			}
+		}
+	}
+	prefix, best := "", 0
+	for pre, n := range votes {
+		if n > best || (n == best && len(pre) > len(prefix)) {
+			prefix, best = pre, n
+		}
+	}
+	if best == 0 {
+		return shares
+	}
+
+	for _, rel := range paths {
+		if w, ok := p.Files[prefix+rel]; ok {
+			shares[rel] = float64(w) / float64(p.Total)
+		}
+	}
+	return shares
+}
+
+// Annotate tags signals in files that account for at least minShare of any
+// profile's weight and boosts their confidence, more for optimization-related
+// kinds. It returns the number of signals annotated.
+func Annotate(signals []signal.RawSignal, profiles []*Profile, minShare float64) int {
+	if minShare <= 0 {
+		minShare = DefaultMinShare
+	}
+
+	seen := make(map[string]bool)
+	var paths []string
+	for _, s := range signals {
+		if s.FilePath != "" && !seen[s.FilePath] {
+			seen[s.FilePath] = true
+			paths = append(paths, s.FilePath)
+		}
+	}
+
+	hot := make(map[string]bool)
+	for _, p := range profiles {
+		for path, share := range p.Shares(paths) {
+			if share >= minShare {
+				hot[path] = true
+			}
+		}
+	}
+
+	annotated := 0
+	for i := range signals {
+		s := &signals[i]
+		if !hot[s.FilePath] {
+			continue
+		}
+		boost, detail := hotBoost, "file is hot in a production profile"
+		if optimizationKinds[s.Kind] {
+			boost, detail = optimizationBoost, "file is hot in a production profile, where "+s.Kind+" fixes pay off most"
+		}
+		boosted := min(s.Confidence+boost, 1.0)
+		if boosted > s.Confidence {
+			s.AddFactor("hot-path", boosted-s.Confidence, detail)
+		}
+		s.Confidence = boosted
+		if !hasTag(s.Tags, Tag) {
+			s.Tags = append(s.Tags, Tag)
+		}
+		annotated++
+	}
+	return annotated
+}
+
+func hasTag(tags []string, tag string) bool {
+	for _, t := range tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,204 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package hotpath
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"runtime/pprof"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+// pb is a minimal protobuf encoder for building test profiles.
+type pb struct{ buf []byte }
+
+func (p *pb) varint(num int, v uint64) *pb {
+	p.buf = binary.AppendUvarint(p.buf, uint64(num)<<3|wireVarint)
+	p.buf = binary.AppendUvarint(p.buf, v)
+	return p
+}
+
+func (p *pb) bytes(num int, b []byte) *pb {
+	p.buf = binary.AppendUvarint(p.buf, uint64(num)<<3|wireBytes)
+	p.buf = binary.AppendUvarint(p.buf, uint64(len(b)))
+	p.buf = append(p.buf, b...)
+	return p
+}
+
+func (p *pb) packed(num int, vs ...uint64) *pb {
+	var b []byte
+	for _, v := range vs {
+		b = binary.AppendUvarint(b, v)
+	}
+	return p.bytes(num, b)
+}
+
+// testPprof builds a CPU-style profile with sample types samples/count and
+// cpu/nanoseconds. Each file gets one function and location; weights are
+// the cpu values of one sample per file.
+func testPprof(files []string, cpu []uint64) []byte {
+	strs := []string{"", "samples", "count", "cpu", "nanoseconds"}
+	prof := &pb{}
+	prof.bytes(profileSampleType, (&pb{}).varint(valueTypeType, 1).varint(2, 2).buf)
+	prof.bytes(profileSampleType, (&pb{}).varint(valueTypeType, 3).varint(2, 4).buf)
+	for i, f := range files {
+		id := uint64(i + 1)
+		strs = append(strs, f)
+		line := (&pb{}).varint(lineFunctionID, id).varint(2, 10).buf
+		prof.bytes(profileLocation, (&pb{}).varint(locationID, id).bytes(locationLine, line).buf)
+		prof.bytes(profileFunction, (&pb{}).varint(functionID, id).varint(functionFilename, uint64(len(strs)-1)).buf)
+		// The innermost location comes first; a caller frame follows.
+		prof.bytes(profileSample, (&pb{}).packed(sampleLocationID, id, 1).packed(sampleValue, 1, cpu[i]).buf)
+	}
+	for _, s := range strs {
+		prof.bytes(profileStringTable, []byte(s))
+	}
+	return prof.buf
+}
+
+func TestParse_Pprof(t *testing.T) {
+	data := testPprof(
+		[]string{"/build/src/repo/internal/hot.go", "/build/src/repo/cmd/main.go"},
+		[]uint64{900, 100})
+
+	p, err := Parse(data)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1000), p.Total, "uses the last sample type (cpu)")
+	assert.Equal(t, int64(900), p.Files["/build/src/repo/internal/hot.go"])
+	assert.Equal(t, int64(100), p.Files["/build/src/repo/cmd/main.go"])
+}
+
+func TestParse_PprofDefaultSampleType(t *testing.T) {
+	data := testPprof([]string{"a.go"}, []uint64{900})
+	data = (&pb{buf: data}).varint(profileDefaultSampleType, 1).buf // "samples"
+
+	p, err := Parse(data)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), p.Total)
+}
+
+func TestParse_Gzip(t *testing.T) {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	_, err := zw.Write(testPprof([]string{"a.go"}, []uint64{5}))
+	require.NoError(t, err)
+	require.NoError(t, zw.Close())
+
+	p, err := Parse(buf.Bytes())
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), p.Files["a.go"])
+}
+
+func TestParse_RuntimeProfile(t *testing.T) {
+	// A real profile written by the Go runtime decodes and attributes
+	// samples to source files.
+	var buf bytes.Buffer
+	require.NoError(t, pprof.Lookup("goroutine").WriteTo(&buf, 0))
+
+	p, err := Parse(buf.Bytes())
+	require.NoError(t, err)
+	assert.Positive(t, p.Total)
+	require.NotEmpty(t, p.Files)
+	for f := range p.Files {
+		assert.True(t, strings.HasSuffix(f, ".go") || strings.HasSuffix(f, ".s"), "file %q", f)
+	}
+}
+
+func TestParse_Coverage(t *testing.T) {
+	data := []byte(`mode: count
+github.com/org/repo/internal/hot.go:10.2,12.3 3 100
+github.com/org/repo/internal/hot.go:14.2,15.3 1 50
+github.com/org/repo/cmd/main.go:5.2,6.3 2 1
+github.com/org/repo/cmd/main.go:8.2,9.3 4 0
+`)
+	p, err := Parse(data)
+	require.NoError(t, err)
+	assert.Equal(t, int64(352), p.Total)
+	assert.Equal(t, int64(350), p.Files["github.com/org/repo/internal/hot.go"])
+	assert.Equal(t, int64(2), p.Files["github.com/org/repo/cmd/main.go"])
+}
+
+func TestParse_Errors(t *testing.T) {
+	_, err := Parse([]byte("mode: set\na.go:1.1,2.2 1 1\n"))
+	assert.ErrorContains(t, err, "-covermode=count")
+
+	_, err = Parse([]byte("mode: count\nnot a block\n"))
+	assert.ErrorContains(t, err, "line 2")
+
+	_, err = Parse([]byte{0x0a, 0x05, 0x01})
+	assert.ErrorContains(t, err, "truncated")
+
+	_, err = Parse([]byte("plain text"))
+	assert.Error(t, err)
+}
+
+func TestLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cover.out")
+	require.NoError(t, os.WriteFile(path, []byte("mode: atomic\nx/a.go:1.1,2.2 1 7\n"), 0o600))
+	p, err := Load(path)
+	require.NoError(t, err)
+	assert.Equal(t, int64(7), p.Total)
+
+	_, err = Load(filepath.Join(t.TempDir(), "missing"))
+	assert.Error(t, err)
+}
+
+func TestShares_InfersPrefix(t *testing.T) {
+	p := &Profile{
+		Files: map[string]int64{
+			"/build/src/repo/internal/hot.go": 600,
+			"/build/src/repo/main.go":         300,
+			"/build/src/repo/cmd/x/main.go":   100,
+		},
+		Total: 1000,
+	}
+	// "main.go" alone would also match cmd/x/main.go; the deeper
+	// internal/hot.go match settles the prefix.
+	shares := p.Shares([]string{"internal/hot.go", "main.go", "other.go"})
+	assert.InDelta(t, 0.6, shares["internal/hot.go"], 0.001)
+	assert.InDelta(t, 0.3, shares["main.go"], 0.001)
+	assert.NotContains(t, shares, "other.go")
+
+	assert.Empty(t, p.Shares([]string{"unrelated.go"}))
+	assert.Empty(t, (&Profile{}).Shares([]string{"a.go"}))
+}
+
+func TestAnnotate(t *testing.T) {
+	p := &Profile{
+		Files: map[string]int64{"github.com/org/repo/hot.go": 90, "github.com/org/repo/cold.go": 2},
+		Total: 100,
+	}
+	signals := []signal.RawSignal{
+		{Kind: "todo", FilePath: "hot.go", Confidence: 0.5},
+		{Kind: "optimize", FilePath: "hot.go", Confidence: 0.5},
+		{Kind: "complex-function", FilePath: "hot.go", Confidence: 0.95, Tags: []string{Tag}},
+		{Kind: "optimize", FilePath: "cold.go", Confidence: 0.5},
+		{Kind: "github-issue", Confidence: 0.5},
+	}
+
+	n := Annotate(signals, []*Profile{p}, 0)
+	assert.Equal(t, 3, n)
+	assert.InDelta(t, 0.6, signals[0].Confidence, 0.001)
+	assert.Equal(t, []string{Tag}, signals[0].Tags)
+	assert.InDelta(t, 0.7, signals[1].Confidence, 0.001)
+	assert.InDelta(t, 1.0, signals[2].Confidence, 0.001, "capped at 1.0")
+	assert.Equal(t, []string{Tag}, signals[2].Tags, "tag is not duplicated")
+	assert.InDelta(t, 0.5, signals[3].Confidence, 0.001, "cold file is below the share")
+	assert.Empty(t, signals[3].Tags)
+	assert.Equal(t, []signal.ConfidenceFactor{{Name: "hot-path", Delta: 0.2,
+		Detail: "file is hot in a production profile, where optimize fixes pay off most"}}, signals[1].Factors)
+	assert.Equal(t, 0.05, signals[2].Factors[0].Delta, "the recorded boost stops at the cap")
+
+	// A lower share threshold makes cold.go hot too.
+	assert.Equal(t, 4, Annotate(signals, []*Profile{p}, 0.01))
+}
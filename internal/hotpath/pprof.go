@@ -0,0 +1,235 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package hotpath
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// Field numbers from github.com/google/pprof/proto/profile.proto. Only the
+// fields needed to attribute sample values to source files are decoded.
+const (
+	profileSampleType        = 1
+	profileSample            = 2
+	profileLocation          = 4
+	profileFunction          = 5
+	profileStringTable       = 6
+	profileDefaultSampleType = 14
+
+	valueTypeType = 1
+
+	sampleLocationID = 1
+	sampleValue      = 2
+
+	locationID   = 1
+	locationLine = 4
+
+	lineFunctionID = 1
+
+	functionID       = 1
+	functionFilename = 4
+)
+
+// Protobuf wire types.
+const (
+	wireVarint  = 0
+	wireFixed64 = 1
+	wireBytes   = 2
+	wireFixed32 = 5
+)
+
+var errTruncated = errors.New("truncated protobuf")
+
+type pprofSample struct {
+	locations []uint64
+	values    []int64
+}
+
+// parsePprof decodes an uncompressed pprof protobuf. Each sample's value is
+// attributed to the file of its innermost frame (flat weight), using the
+// default sample type, or the last one when no default is set.
+func parsePprof(data []byte) (*Profile, error) {
+	var (
+		sampleTypes  []int64 // string table index of each sample type
+		defaultType  int64
+		samples      []pprofSample
+		locFunction  = make(map[uint64]uint64) // location ID -> innermost function ID
+		funcFilename = make(map[uint64]int64)  // function ID -> string table index
+		stringTable  []string
+	)
+
+	err := walkFields(data, func(num int, wire int, v uint64, b []byte) error {
+		switch num {
+		case profileSampleType:
+			var typ int64
+			if err := walkFields(b, func(n, _ int, v uint64, _ []byte) error {
+				if n == valueTypeType {
+					typ = int64(v)
+				}
+				return nil
+			}); err != nil {
+				return err
+			}
+			sampleTypes = append(sampleTypes, typ)
+		case profileSample:
+			var s pprofSample
+			if err := walkFields(b, func(n, w int, v uint64, b []byte) error {
+				switch n {
+				case sampleLocationID:
+					return appendUints(&s.locations, w, v, b)
+				case sampleValue:
+					var u []uint64
+					if err := appendUints(&u, w, v, b); err != nil {
+						return err
+					}
+					for _, x := range u {
+						s.values = append(s.values, int64(x))
+					}
+				}
+				return nil
+			}); err != nil {
+				return err
+			}
+			samples = append(samples, s)
+		case profileLocation:
+			var id, fn uint64
+			if err := walkFields(b, func(n, _ int, v uint64, b []byte) error {
+				switch n {
+				case locationID:
+					id = v
+				case locationLine:
+					if fn != 0 {
+						return nil // the first line is the innermost inlined frame
+					}
+					return walkFields(b, func(n, _ int, v uint64, _ []byte) error {
+						if n == lineFunctionID {
+							fn = v
+						}
+						return nil
+					})
+				}
+				return nil
+			}); err != nil {
+				return err
+			}
+			locFunction[id] = fn
+		case profileFunction:
+			var id uint64
+			var file int64
+			if err := walkFields(b, func(n, _ int, v uint64, _ []byte) error {
+				switch n {
+				case functionID:
+					id = v
+				case functionFilename:
+					file = int64(v)
+				}
+				return nil
+			}); err != nil {
+				return err
+			}
+			funcFilename[id] = file
+		case profileStringTable:
+			stringTable = append(stringTable, string(b))
+		case profileDefaultSampleType:
+			defaultType = int64(v)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("parse pprof profile: %w", err)
+	}
+	if len(sampleTypes) == 0 {
+		return nil, fmt.Errorf("parse pprof profile: no sample types (not a pprof or coverage profile?)")
+	}
+
+	valueIdx := len(sampleTypes) - 1
+	if defaultType != 0 {
+		for i, t := range sampleTypes {
+			if t == defaultType {
+				valueIdx = i
+			}
+		}
+	}
+
+	p := &Profile{Files: make(map[string]int64)}
+	for _, s := range samples {
+		if valueIdx >= len(s.values) || len(s.locations) == 0 {
+			continue
+		}
+		v := s.values[valueIdx]
+		if v <= 0 {
+			continue
+		}
+		p.Total += v
+		idx := funcFilename[locFunction[s.locations[0]]]
+		if idx > 0 && idx < int64(len(stringTable)) {
+			p.Files[stringTable[idx]] += v
+		}
+	}
+	return p, nil
+}
+
+// appendUints appends a repeated integer field, packed or not.
+func appendUints(dst *[]uint64, wire int, v uint64, b []byte) error {
+	if wire != wireBytes {
+		*dst = append(*dst, v)
+		return nil
+	}
+	for len(b) > 0 {
+		x, n := binary.Uvarint(b)
+		if n <= 0 {
+			return errTruncated
+		}
+		*dst = append(*dst, x)
+		b = b[n:]
+	}
+	return nil
+}
+
+// walkFields calls fn for each field of a protobuf message. Varint and
+// fixed-width values are passed in v; length-delimited values in b.
+func walkFields(data []byte, fn func(num, wire int, v uint64, b []byte) error) error {
+	for len(data) > 0 {
+		key, n := binary.Uvarint(data)
+		if n <= 0 {
+			return errTruncated
+		}
+		data = data[n:]
+		num, wire := int(key>>3), int(key&7)
+
+		var v uint64
+		var b []byte
+		switch wire {
+		case wireVarint:
+			if v, n = binary.Uvarint(data); n <= 0 {
+				return errTruncated
+			}
+			data = data[n:]
+		case wireFixed64:
+			if len(data) < 8 {
+				return errTruncated
+			}
+			v, data = binary.LittleEndian.Uint64(data), data[8:]
+		case wireFixed32:
+			if len(data) < 4 {
+				return errTruncated
+			}
+			v, data = uint64(binary.LittleEndian.Uint32(data)), data[4:]
+		case wireBytes:
+			l, n := binary.Uvarint(data)
+			if n <= 0 || l > uint64(len(data)-n) {
+				return errTruncated
+			}
+			b, data = data[n:n+int(l)], data[n+int(l):]
+		default:
+			return fmt.Errorf("unsupported wire type %d", wire)
+		}
+		if err := fn(num, wire, v, b); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,149 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+// Package hotspot finds files that are both changed often and large or
+// complex. Following the hotspot method, code that is hard to work with
+// matters most where it is worked on most, so these files are the best
+// refactoring targets.
+package hotspot
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+// Kind is the signal kind emitted for hotspot files.
+const Kind = "hotspot"
+
+// Defaults for the thresholds in Config.
+const (
+	DefaultMinChanges    = 5
+	DefaultMinLines      = 200
+	DefaultMinComplexity = 10.0
+	DefaultMaxSignals    = 10
+)
+
+// Config tunes which files count as hotspots. Zero fields take the
+// defaults.
+type Config struct {
+	// MinChanges is the fewest changes in the churn window a file needs.
+	MinChanges int
+
+	// MinLines and MinComplexity are alternative size bars: a file must
+	// have at least MinLines lines or a total function complexity of at
+	// least MinComplexity.
+	MinLines      int
+	MinComplexity float64
+
+	// MinScore drops hotspots whose composite score is below it.
+	MinScore float64
+
+	// MaxSignals caps how many hotspots are reported, highest score first.
+	MaxSignals int
+}
+
+func (c Config) withDefaults() Config {
+	if c.MinChanges <= 0 {
+		c.MinChanges = DefaultMinChanges
+	}
+	if c.MinLines <= 0 {
+		c.MinLines = DefaultMinLines
+	}
+	if c.MinComplexity <= 0 {
+		c.MinComplexity = DefaultMinComplexity
+	}
+	if c.MaxSignals <= 0 {
+		c.MaxSignals = DefaultMaxSignals
+	}
+	return c
+}
+
+// File is the churn and size of one file.
+type File struct {
+	Path       string
+	Changes    int     // commits touching the file in the churn window
+	Lines      int     // current line count
+	Complexity float64 // sum of the file's function complexity scores; 0 if not analyzed
+}
+
+// Hotspot is a file that passed the thresholds, with its composite score.
+type Hotspot struct {
+	File
+	Score float64
+}
+
+// Score is the composite hotspot score of f: log2(changes + 1) ×
+// (lines/100 + complexity). Churn is damped by the logarithm so a file
+// changed in every commit does not drown out size.
+func Score(f File) float64 {
+	return math.Round(math.Log2(float64(f.Changes+1))*(float64(f.Lines)/100+f.Complexity)*10) / 10
+}
+
+// Find returns the files that pass cfg's thresholds, highest score first,
+// at most cfg.MaxSignals of them.
+func Find(files []File, cfg Config) []Hotspot {
+	cfg = cfg.withDefaults()
+	var spots []Hotspot
+	for _, f := range files {
+		if f.Changes < cfg.MinChanges {
+			continue
+		}
+		if f.Lines < cfg.MinLines && f.Complexity < cfg.MinComplexity {
+			continue
+		}
+		h := Hotspot{File: f, Score: Score(f)}
+		if h.Score < cfg.MinScore {
+			continue
+		}
+		spots = append(spots, h)
+	}
+	sort.Slice(spots, func(i, j int) bool {
+		if spots[i].Score != spots[j].Score {
+			return spots[i].Score > spots[j].Score
+		}
+		return spots[i].Path < spots[j].Path
+	})
+	if len(spots) > cfg.MaxSignals {
+		spots = spots[:cfg.MaxSignals]
+	}
+	return spots
+}
+
+// Signals returns a hotspot signal per hotspot. Confidence runs from 0.5
+// to 0.9 with the score relative to the top hotspot.
+func Signals(spots []Hotspot) []signal.RawSignal {
+	if len(spots) == 0 {
+		return nil
+	}
+	top := spots[0].Score
+	for _, h := range spots {
+		top = math.Max(top, h.Score)
+	}
+
+	signals := make([]signal.RawSignal, 0, len(spots))
+	for _, h := range spots {
+		conf := 0.9
+		if top > 0 {
+			conf = math.Round((0.5+0.4*h.Score/top)*100) / 100
+		}
+		size := fmt.Sprintf("%d lines", h.Lines)
+		if h.Complexity > 0 {
+			size += fmt.Sprintf(", complexity %.1f", h.Complexity)
+		}
+		signals = append(signals, signal.RawSignal{
+			Source:   "hotspot",
+			Kind:     Kind,
+			FilePath: h.Path,
+			Title:    fmt.Sprintf("Hotspot: %s (%d changes, %s)", h.Path, h.Changes, size),
+			Description: fmt.Sprintf("Hotspot score %.1f = log2(%d changes + 1) × (%d lines / 100 + complexity %.1f).\n"+
+				"The file is both changed often and large or complex, so its cost is paid on every change; it is a prime refactoring target.",
+				h.Score, h.Changes, h.Lines, h.Complexity),
+			Confidence: conf,
+			Tags:       []string{Kind, "churn", "complexity"},
+		})
+	}
+	return signals
+}
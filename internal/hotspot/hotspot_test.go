@@ -0,0 +1,61 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package hotspot
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScore(t *testing.T) {
+	assert.Equal(t, 15.0, Score(File{Changes: 7, Lines: 500}), "log2(8) × 5")
+	assert.Equal(t, 30.0, Score(File{Changes: 7, Lines: 500, Complexity: 5}))
+	assert.Zero(t, Score(File{Changes: 0, Lines: 500}))
+}
+
+func TestFind(t *testing.T) {
+	files := []File{
+		{Path: "big.go", Changes: 7, Lines: 500},
+		{Path: "complex.go", Changes: 15, Lines: 120, Complexity: 12},
+		{Path: "quiet.go", Changes: 2, Lines: 5000},
+		{Path: "small.go", Changes: 40, Lines: 60, Complexity: 2},
+	}
+
+	spots := Find(files, Config{})
+	require.Len(t, spots, 2)
+	assert.Equal(t, "complex.go", spots[0].Path)
+	assert.Equal(t, 52.8, spots[0].Score)
+	assert.Equal(t, "big.go", spots[1].Path)
+
+	assert.Len(t, Find(files, Config{MinChanges: 1}), 3, "quiet.go passes a lower churn bar")
+	assert.Len(t, Find(files, Config{MinLines: 1000, MinComplexity: 100}), 0)
+	assert.Len(t, Find(files, Config{MinScore: 20}), 1)
+
+	capped := Find(files, Config{MaxSignals: 1})
+	require.Len(t, capped, 1)
+	assert.Equal(t, "complex.go", capped[0].Path, "the highest scores are kept")
+}
+
+func TestSignals(t *testing.T) {
+	assert.Nil(t, Signals(nil))
+
+	sigs := Signals([]Hotspot{
+		{File: File{Path: "a.go", Changes: 15, Lines: 120, Complexity: 12}, Score: 52.8},
+		{File: File{Path: "b.go", Changes: 7, Lines: 500}, Score: 15},
+	})
+	require.Len(t, sigs, 2)
+
+	assert.Equal(t, "hotspot", sigs[0].Source)
+	assert.Equal(t, Kind, sigs[0].Kind)
+	assert.Equal(t, "a.go", sigs[0].FilePath)
+	assert.Equal(t, "Hotspot: a.go (15 changes, 120 lines, complexity 12.0)", sigs[0].Title)
+	assert.Contains(t, sigs[0].Description, "Hotspot score 52.8 = log2(15 changes + 1) × (120 lines / 100 + complexity 12.0)")
+	assert.Equal(t, 0.9, sigs[0].Confidence)
+	assert.Equal(t, []string{"hotspot", "churn", "complexity"}, sigs[0].Tags)
+
+	assert.Equal(t, "Hotspot: b.go (7 changes, 500 lines)", sigs[1].Title)
+	assert.Equal(t, 0.61, sigs[1].Confidence, "confidence scales with the top score")
+}
@@ -0,0 +1,194 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+// Package i18n translates the human-facing text stringer generates around
+// signals: headings, table headers, and summary lines in the Markdown,
+// HTML, and pr-comment formats. Messages live in YAML catalogs, one per
+// language, embedded from catalogs/ or loaded from a file so teams can add
+// a language without rebuilding stringer.
+//
+// Signal titles and descriptions are not translated; they come from the
+// scanned code and the collectors.
+package i18n
+
+import (
+	"embed"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultLang is the language used when none is selected. Every key has an
+// English message, so other catalogs fall back to it.
+const DefaultLang = "en"
+
+//go:embed catalogs/*.yaml
+var catalogFS embed.FS
+
+// Catalog holds the messages for one language.
+type Catalog struct {
+	lang     string
+	messages map[string]string
+	fallback *Catalog
+}
+
+var (
+	englishOnce sync.Once
+	english     *Catalog
+)
+
+// English returns the built-in English catalog.
+func English() *Catalog {
+	englishOnce.Do(func() {
+		c, err := builtin(DefaultLang)
+		if err != nil {
+			panic(fmt.Sprintf("i18n: loading English catalog: %v", err))
+		}
+		english = c
+	})
+	return english
+}
+
+// Languages returns the codes of the built-in catalogs, sorted.
+func Languages() []string {
+	entries, _ := catalogFS.ReadDir("catalogs")
+	langs := make([]string, 0, len(entries))
+	for _, e := range entries {
+		langs = append(langs, strings.TrimSuffix(e.Name(), ".yaml"))
+	}
+	sort.Strings(langs)
+	return langs
+}
+
+// IsCatalogPath reports whether lang names a catalog file rather than a
+// language code.
+func IsCatalogPath(lang string) bool {
+	ext := strings.ToLower(filepath.Ext(lang))
+	return ext == ".yaml" || ext == ".yml"
+}
+
+// Load returns the catalog for lang. A language code such as "de" or
+// "de_DE.UTF-8" selects a built-in catalog, trying the region-specific
+// catalog before the base language; a path ending in .yaml or .yml loads a
+// catalog file. The empty string selects English.
+func Load(lang string) (*Catalog, error) {
+	if IsCatalogPath(lang) {
+		return LoadFile(lang)
+	}
+	for _, code := range candidates(lang) {
+		if code == DefaultLang {
+			return English(), nil
+		}
+		if c, err := builtin(code); err == nil {
+			return c, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown language %q (available: %s)", lang, strings.Join(Languages(), ", "))
+}
+
+// LoadFile reads a catalog from a YAML file mapping message keys to
+// messages. The file name without its extension is the language code.
+// Keys the file leaves out fall back to the built-in catalog for that
+// language, if there is one, and then to English.
+func LoadFile(path string) (*Catalog, error) {
+	data, err := os.ReadFile(path) //nolint:gosec // user-specified catalog path
+	if err != nil {
+		return nil, fmt.Errorf("reading catalog: %w", err)
+	}
+	lang := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	c, err := parse(lang, data)
+	if err != nil {
+		return nil, fmt.Errorf("parsing catalog %s: %w", path, err)
+	}
+	if base, loadErr := Load(lang); loadErr == nil && base != English() {
+		c.fallback = base
+	}
+	return c, nil
+}
+
+// Lang returns the catalog's language code.
+func (c *Catalog) Lang() string {
+	if c == nil {
+		return DefaultLang
+	}
+	return c.lang
+}
+
+// T returns the message for key formatted with args, as by fmt.Sprintf. A
+// key missing from the catalog falls back to English; a key missing from
+// English is returned as is, so a gap shows up in the output rather than
+// as a blank. A nil catalog is English.
+func (c *Catalog) T(key string, args ...any) string {
+	if c == nil {
+		c = English()
+	}
+	msg, ok := c.lookup(key)
+	if !ok {
+		return key
+	}
+	if len(args) == 0 {
+		return msg
+	}
+	return fmt.Sprintf(msg, args...)
+}
+
+// lookup finds key in c or the catalogs it falls back to.
+func (c *Catalog) lookup(key string) (string, bool) {
+	for cur := c; cur != nil; cur = cur.next() {
+		if msg, ok := cur.messages[key]; ok {
+			return msg, true
+		}
+	}
+	return "", false
+}
+
+// next returns the catalog consulted after c.
+func (c *Catalog) next() *Catalog {
+	if c.fallback != nil {
+		return c.fallback
+	}
+	if c == English() {
+		return nil
+	}
+	return English()
+}
+
+// builtin loads the embedded catalog for code.
+func builtin(code string) (*Catalog, error) {
+	data, err := catalogFS.ReadFile("catalogs/" + code + ".yaml")
+	if err != nil {
+		return nil, err
+	}
+	return parse(code, data)
+}
+
+// parse decodes a catalog file's flat key → message map.
+func parse(lang string, data []byte) (*Catalog, error) {
+	messages := make(map[string]string)
+	if err := yaml.Unmarshal(data, &messages); err != nil {
+		return nil, err
+	}
+	return &Catalog{lang: lang, messages: messages}, nil
+}
+
+// candidates lists the catalog codes to try for lang, most specific first:
+// "de_DE.UTF-8" → "de-de", "de".
+func candidates(lang string) []string {
+	code := strings.ToLower(strings.TrimSpace(lang))
+	if i := strings.IndexAny(code, ".@"); i >= 0 {
+		code = code[:i]
+	}
+	code = strings.ReplaceAll(code, "_", "-")
+	if code == "" || code == "c" || code == "posix" {
+		return []string{DefaultLang}
+	}
+	if i := strings.IndexByte(code, '-'); i > 0 {
+		return []string{code, code[:i]}
+	}
+	return []string{code}
+}
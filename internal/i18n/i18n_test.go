@@ -0,0 +1,102 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package i18n
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// verbPattern matches fmt verbs, skipping escaped percent signs.
+var verbPattern = regexp.MustCompile(`%[-+# 0]*[0-9.]*[a-zA-Z%]`)
+
+func verbs(msg string) []string {
+	var out []string
+	for _, v := range verbPattern.FindAllString(msg, -1) {
+		if v != "%%" {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+func TestLanguages(t *testing.T) {
+	assert.Equal(t, []string{"de", "en", "ja"}, Languages())
+}
+
+func TestCatalogs_MatchEnglish(t *testing.T) {
+	en := English()
+	for _, lang := range Languages() {
+		c, err := Load(lang)
+		require.NoError(t, err, lang)
+		for key, msg := range c.messages {
+			ref, ok := en.messages[key]
+			if !assert.True(t, ok, "%s: key %q is not in en.yaml", lang, key) {
+				continue
+			}
+			assert.Equal(t, verbs(ref), verbs(msg), "%s: %q must use the same verbs as English", lang, key)
+		}
+	}
+}
+
+func TestLoad(t *testing.T) {
+	for _, lang := range []string{"de", "DE", "de_DE.UTF-8", "de-AT", "de_CH@euro"} {
+		c, err := Load(lang)
+		require.NoError(t, err, lang)
+		assert.Equal(t, "de", c.Lang(), lang)
+	}
+	for _, lang := range []string{"", "en", "en_US.UTF-8", "C", "POSIX"} {
+		c, err := Load(lang)
+		require.NoError(t, err, lang)
+		assert.Same(t, English(), c, lang)
+	}
+
+	_, err := Load("xx")
+	assert.EqualError(t, err, `unknown language "xx" (available: de, en, ja)`)
+}
+
+func TestT(t *testing.T) {
+	de, err := Load("de")
+	require.NoError(t, err)
+	assert.Equal(t, "Neue Signale (3)", de.T("prcomment.new_signals", 3))
+	assert.Equal(t, "Stringer Scan Results", English().T("markdown.title"))
+
+	var nilCatalog *Catalog
+	assert.Equal(t, "Stringer Dashboard", nilCatalog.T("html.title"), "nil catalog is English")
+	assert.Equal(t, "en", nilCatalog.Lang())
+	assert.Equal(t, "no.such.key", de.T("no.such.key"), "unknown keys are returned as is")
+}
+
+func TestLoadFile(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "fr.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("markdown.title: \"Résultats de Stringer\"\n"), 0o600))
+
+	c, err := Load(path)
+	require.NoError(t, err)
+	assert.Equal(t, "fr", c.Lang())
+	assert.Equal(t, "Résultats de Stringer", c.T("markdown.title"))
+	assert.Equal(t, "Count", c.T("markdown.count"), "missing keys fall back to English")
+
+	// A file for a built-in language overrides some keys and keeps the rest.
+	path = filepath.Join(dir, "de.yml")
+	require.NoError(t, os.WriteFile(path, []byte("markdown.count: \"Menge\"\n"), 0o600))
+	c, err = LoadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "Menge", c.T("markdown.count"))
+	assert.Equal(t, "Priorität", c.T("markdown.priority"))
+
+	_, err = Load(filepath.Join(dir, "missing.yaml"))
+	assert.ErrorContains(t, err, "reading catalog")
+
+	bad := filepath.Join(dir, "bad.yaml")
+	require.NoError(t, os.WriteFile(bad, []byte("- not\n- a map\n"), 0o600))
+	_, err = LoadFile(bad)
+	assert.ErrorContains(t, err, "parsing catalog")
+}
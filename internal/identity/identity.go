@@ -0,0 +1,173 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+// Package identity consolidates author identities so that one person who
+// committed under several names or email addresses is counted once. It
+// honors the repository's .mailmap (see gitmailmap(5)) and the identities
+// map in .stringer.yaml.
+package identity
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"path/filepath"
+	"strings"
+
+	"github.com/davetashner/stringer/internal/testable"
+)
+
+// MailmapFile is the mailmap file name in a repository root.
+const MailmapFile = ".mailmap"
+
+// FS is the file system implementation used by this package.
+// Override in tests with a testable.MockFileSystem.
+var FS testable.FileSystem = testable.DefaultFS
+
+// mailmapEntry is the replacement for one commit identity.
+type mailmapEntry struct {
+	name  string // proper name, empty to keep the commit name
+	email string // proper email, empty to keep the commit email
+}
+
+// Map resolves commit author identities to canonical names. A nil Map
+// returns names unchanged.
+type Map struct {
+	// mailmap is keyed by lowercased "email\x00name"; name is empty for
+	// entries that match on email alone.
+	mailmap map[string]mailmapEntry
+
+	// aliases maps a lowercased name or email to its canonical name.
+	aliases map[string]string
+}
+
+// Load reads gitRoot/.mailmap, if present, and combines it with identities,
+// which maps a canonical name to the other names and email addresses the
+// same person has committed under.
+func Load(gitRoot string, identities map[string][]string) (*Map, error) {
+	m := New(identities)
+	data, err := FS.ReadFile(filepath.Join(gitRoot, MailmapFile))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return m, nil
+		}
+		return m, fmt.Errorf("read %s: %w", MailmapFile, err)
+	}
+	if err := m.parseMailmap(bytes.NewReader(data)); err != nil {
+		return m, fmt.Errorf("parse %s: %w", MailmapFile, err)
+	}
+	return m, nil
+}
+
+// New returns a Map built from identities alone.
+func New(identities map[string][]string) *Map {
+	m := &Map{
+		mailmap: make(map[string]mailmapEntry),
+		aliases: make(map[string]string),
+	}
+	for canonical, aliases := range identities {
+		m.aliases[strings.ToLower(canonical)] = canonical
+		for _, a := range aliases {
+			m.aliases[strings.ToLower(strings.TrimSpace(a))] = canonical
+		}
+	}
+	return m
+}
+
+// Resolve returns the canonical name for a commit author. The .mailmap is
+// applied first, then the identities map is consulted by email and by
+// name. email may be empty when only the name is known.
+func (m *Map) Resolve(name, email string) string {
+	if m == nil {
+		return name
+	}
+
+	if e, ok := m.lookupMailmap(name, email); ok {
+		if e.name != "" {
+			name = e.name
+		}
+		if e.email != "" {
+			email = e.email
+		}
+	}
+
+	if email != "" {
+		if canonical, ok := m.aliases[strings.ToLower(email)]; ok {
+			return canonical
+		}
+	}
+	if canonical, ok := m.aliases[strings.ToLower(name)]; ok {
+		return canonical
+	}
+	return name
+}
+
+// lookupMailmap finds the most specific mailmap entry for an identity:
+// one matching both name and email, else one matching email alone.
+func (m *Map) lookupMailmap(name, email string) (mailmapEntry, bool) {
+	if email == "" {
+		return mailmapEntry{}, false
+	}
+	email = strings.ToLower(email)
+	if e, ok := m.mailmap[email+"\x00"+strings.ToLower(name)]; ok {
+		return e, true
+	}
+	e, ok := m.mailmap[email+"\x00"]
+	return e, ok
+}
+
+// parseMailmap reads mailmap lines of the forms:
+//
+//	Proper Name <commit@email>
+//	<proper@email> <commit@email>
+//	Proper Name <proper@email> <commit@email>
+//	Proper Name <proper@email> Commit Name <commit@email>
+//
+// Blank lines and # comments are ignored, as are malformed lines.
+func (m *Map) parseMailmap(r io.Reader) error {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if i := strings.Index(line, "#"); i >= 0 {
+			line = line[:i]
+		}
+
+		properName, properEmail, rest, ok := parseNameEmail(line)
+		if !ok {
+			continue
+		}
+		commitName, commitEmail, _, ok := parseNameEmail(rest)
+		if !ok {
+			// Single identity: "Proper Name <commit@email>".
+			if properName != "" {
+				m.mailmap[strings.ToLower(properEmail)+"\x00"] = mailmapEntry{name: properName}
+			}
+			continue
+		}
+		key := strings.ToLower(commitEmail) + "\x00" + strings.ToLower(commitName)
+		m.mailmap[key] = mailmapEntry{name: properName, email: properEmail}
+	}
+	return scanner.Err()
+}
+
+// parseNameEmail parses a leading "Name <email>" (name optional) from s and
+// returns the remainder.
+func parseNameEmail(s string) (name, email, rest string, ok bool) {
+	open := strings.Index(s, "<")
+	if open < 0 {
+		return "", "", "", false
+	}
+	closeIdx := strings.Index(s[open:], ">")
+	if closeIdx < 0 {
+		return "", "", "", false
+	}
+	closeIdx += open
+	email = strings.TrimSpace(s[open+1 : closeIdx])
+	if email == "" {
+		return "", "", "", false
+	}
+	return strings.TrimSpace(s[:open]), email, s[closeIdx+1:], true
+}
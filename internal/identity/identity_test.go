@@ -0,0 +1,108 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package identity
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testMailmap = `# Team mailmap
+Jane Doe <jane@example.com>
+<jane@example.com> <jane@old-job.com>
+Joe Smith <joe@example.com> <joe.smith@users.noreply.github.com>
+Joe Smith <joe@example.com> jsmith <build@ci.example.com>
+   # indented comment
+this line is malformed
+Nobody <>
+`
+
+func parseTest(t *testing.T, mailmap string, identities map[string][]string) *Map {
+	t.Helper()
+	m := New(identities)
+	require.NoError(t, m.parseMailmap(strings.NewReader(mailmap)))
+	return m
+}
+
+func TestResolve_Mailmap(t *testing.T) {
+	m := parseTest(t, testMailmap, nil)
+
+	tests := []struct {
+		name, email, want string
+	}{
+		{"jane", "jane@example.com", "Jane Doe"},
+		{"J. Doe", "JANE@EXAMPLE.COM", "Jane Doe"},
+		{"Joe", "joe.smith@users.noreply.github.com", "Joe Smith"},
+		{"jsmith", "build@ci.example.com", "Joe Smith"},
+		// Name+email entries only match that exact commit name.
+		{"release-bot", "build@ci.example.com", "release-bot"},
+		// Email-only replacement keeps the commit name.
+		{"Jane D", "jane@old-job.com", "Jane D"},
+		{"Stranger", "stranger@example.com", "Stranger"},
+		{"No Email", "", "No Email"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name+" "+tt.email, func(t *testing.T) {
+			assert.Equal(t, tt.want, m.Resolve(tt.name, tt.email))
+		})
+	}
+}
+
+func TestResolve_Identities(t *testing.T) {
+	m := New(map[string][]string{
+		"Dave Tashner": {"dave@old.example.com", "dtashner", " Dave T "},
+	})
+
+	assert.Equal(t, "Dave Tashner", m.Resolve("anything", "DAVE@old.example.com"))
+	assert.Equal(t, "Dave Tashner", m.Resolve("dtashner", "new@example.com"))
+	assert.Equal(t, "Dave Tashner", m.Resolve("dave t", ""))
+	assert.Equal(t, "Dave Tashner", m.Resolve("dave tashner", ""))
+	assert.Equal(t, "Someone Else", m.Resolve("Someone Else", "else@example.com"))
+}
+
+func TestResolve_MailmapThenIdentities(t *testing.T) {
+	// .mailmap rewrites the email; the identities map then matches it.
+	m := parseTest(t, "<canonical@example.com> <alias@example.com>\n", map[string][]string{
+		"Canonical Person": {"canonical@example.com"},
+	})
+	assert.Equal(t, "Canonical Person", m.Resolve("alias", "alias@example.com"))
+}
+
+func TestResolve_NilMap(t *testing.T) {
+	var m *Map
+	assert.Equal(t, "Alice", m.Resolve("Alice", "alice@example.com"))
+}
+
+func TestLoad_ReadsMailmap(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, MailmapFile), []byte(testMailmap), 0o600))
+
+	m, err := Load(dir, map[string][]string{"Ops": {"ops@example.com"}})
+	require.NoError(t, err)
+	assert.Equal(t, "Jane Doe", m.Resolve("jane", "jane@example.com"))
+	assert.Equal(t, "Ops", m.Resolve("ops-bot", "ops@example.com"))
+}
+
+func TestLoad_MissingMailmap(t *testing.T) {
+	m, err := Load(t.TempDir(), nil)
+	require.NoError(t, err)
+	require.NotNil(t, m)
+	assert.Equal(t, "Alice", m.Resolve("Alice", "alice@example.com"))
+}
+
+func TestLoad_UnreadableMailmap(t *testing.T) {
+	dir := t.TempDir()
+	// A directory in place of the file cannot be read.
+	require.NoError(t, os.Mkdir(filepath.Join(dir, MailmapFile), 0o750))
+
+	m, err := Load(dir, map[string][]string{"Ops": {"ops-bot"}})
+	require.Error(t, err)
+	require.NotNil(t, m, "identities still apply when .mailmap is unreadable")
+	assert.Equal(t, "Ops", m.Resolve("ops-bot", ""))
+}
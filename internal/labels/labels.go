@@ -0,0 +1,86 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+// Package labels maps signals to exporter-specific labels. Rules are defined
+// once in .stringer.yaml and honored by every exporter: beads labels, SARIF
+// result tags, tasks metadata, and GitHub pull request labels.
+package labels
+
+import (
+	"path"
+	"slices"
+
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+// Exporters that honor label rules.
+const (
+	Beads  = "beads"
+	GitHub = "github"
+	SARIF  = "sarif"
+	Tasks  = "tasks"
+)
+
+// Rule maps matching signals to labels. A signal matches when its kind
+// matches one of Kinds (path.Match globs), one of its tags is in Tags, or
+// its collector is in Collectors.
+type Rule struct {
+	Kinds      []string
+	Tags       []string
+	Collectors []string
+
+	// Labels apply to every exporter without its own entry in Exporters.
+	Labels []string
+
+	// Exporters replaces Labels for the named exporter.
+	Exporters map[string][]string
+}
+
+// Map is an ordered set of label rules. The zero Map adds no labels.
+type Map []Rule
+
+// For returns the labels exporter should attach to sig: the union of every
+// matching rule's labels, in rule order, without duplicates.
+func (m Map) For(exporter string, sig signal.RawSignal) []string {
+	var out []string
+	for _, r := range m {
+		if !r.matches(sig) {
+			continue
+		}
+		list := r.Labels
+		if specific, ok := r.Exporters[exporter]; ok && len(specific) > 0 {
+			list = specific
+		}
+		for _, l := range list {
+			if !slices.Contains(out, l) {
+				out = append(out, l)
+			}
+		}
+	}
+	return out
+}
+
+// Append adds the labels exporter should attach to sig to existing,
+// skipping any already present.
+func (m Map) Append(exporter string, sig signal.RawSignal, existing []string) []string {
+	for _, l := range m.For(exporter, sig) {
+		if !slices.Contains(existing, l) {
+			existing = append(existing, l)
+		}
+	}
+	return existing
+}
+
+func (r Rule) matches(sig signal.RawSignal) bool {
+	for _, k := range r.Kinds {
+		if ok, _ := path.Match(k, sig.Kind); ok {
+			return true
+		}
+	}
+	for _, t := range sig.Tags {
+		if slices.Contains(r.Tags, t) {
+			return true
+		}
+	}
+	return sig.Source != "" && slices.Contains(r.Collectors, sig.Source)
+}
@@ -0,0 +1,36 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package labels
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+var testMap = Map{
+	{Kinds: []string{"*-dependency"}, Labels: []string{"dependencies"}, Exporters: map[string][]string{GitHub: {"deps"}}},
+	{Tags: []string{"security"}, Labels: []string{"security", "dependencies"}},
+	{Collectors: []string{"todos"}, Labels: []string{"todo"}, Exporters: map[string][]string{Beads: nil}},
+}
+
+func TestMap_For(t *testing.T) {
+	vuln := signal.RawSignal{Source: "vuln", Kind: "vulnerable-dependency", Tags: []string{"security"}}
+	assert.Equal(t, []string{"dependencies", "security"}, testMap.For(Beads, vuln), "union in rule order without duplicates")
+	assert.Equal(t, []string{"deps", "security", "dependencies"}, testMap.For(GitHub, vuln), "exporter list replaces labels")
+
+	todo := signal.RawSignal{Source: "todos", Kind: "todo"}
+	assert.Equal(t, []string{"todo"}, testMap.For(Beads, todo), "empty exporter list falls back to labels")
+
+	assert.Empty(t, testMap.For(SARIF, signal.RawSignal{Source: "gitlog", Kind: "revert"}))
+	assert.Empty(t, Map(nil).For(Beads, vuln))
+}
+
+func TestMap_Append(t *testing.T) {
+	sig := signal.RawSignal{Source: "todos", Kind: "fixme", Tags: []string{"security"}}
+	got := testMap.Append(Tasks, sig, []string{"security", "stringer-generated"})
+	assert.Equal(t, []string{"security", "stringer-generated", "dependencies", "todo"}, got)
+}
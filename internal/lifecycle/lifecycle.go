@@ -0,0 +1,280 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+// Package lifecycle records when each signal first appeared and when it
+// went away, so resolution rates and time-to-resolution can be measured
+// across scans.
+//
+// Events are appended to .stringer/history.jsonl, one JSON object per
+// line. Every scan compares its signals with the ones the file says are
+// open: a signal not open before gets an "opened" event, and an open signal
+// the scan no longer finds gets a "resolved" event. A signal that comes
+// back after being resolved is opened again, starting a new lifecycle.
+package lifecycle
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"math"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/davetashner/stringer/internal/output"
+	"github.com/davetashner/stringer/internal/signal"
+	"github.com/davetashner/stringer/internal/statedir"
+	"github.com/davetashner/stringer/internal/testable"
+)
+
+// historyFile is the filename for lifecycle events under .stringer/.
+const historyFile = "history.jsonl"
+
+// FS is the file system implementation used by this package.
+// Override in tests with a testable.MockFileSystem.
+var FS testable.FileSystem = testable.DefaultFS
+
+// EventType says what happened to a signal.
+type EventType string
+
+const (
+	// Opened marks the first scan that found a signal, or the first scan
+	// that found it again after it was resolved.
+	Opened EventType = "opened"
+
+	// Resolved marks the first scan that no longer found an open signal.
+	Resolved EventType = "resolved"
+)
+
+// Event is one recorded change in a signal's state.
+type Event struct {
+	SignalID  string    `json:"signal_id"`
+	Event     EventType `json:"event"`
+	Kind      string    `json:"kind"`
+	Collector string    `json:"collector,omitempty"`
+	FilePath  string    `json:"file_path,omitempty"`
+	Workspace string    `json:"workspace,omitempty"`
+	Title     string    `json:"title,omitempty"`
+	At        time.Time `json:"at"`
+}
+
+// Load reads <repoPath>/.stringer/history.jsonl. If the file does not
+// exist, it returns (nil, nil). Blank lines are skipped.
+func Load(repoPath string) ([]Event, error) {
+	data, err := FS.ReadFile(filepath.Join(repoPath, statedir.Name, historyFile))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var events []Event
+	sc := bufio.NewScanner(bytes.NewReader(data))
+	sc.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for n := 1; sc.Scan(); n++ {
+		line := bytes.TrimSpace(sc.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		var e Event
+		if err := json.Unmarshal(line, &e); err != nil {
+			return nil, fmt.Errorf("%s line %d: %w", historyFile, n, err)
+		}
+		events = append(events, e)
+	}
+	return events, sc.Err()
+}
+
+// Append adds events to <repoPath>/.stringer/history.jsonl, creating the
+// file and the .stringer directory if needed.
+func Append(repoPath string, events ...Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+	dir := filepath.Join(repoPath, statedir.Name)
+	if err := FS.MkdirAll(dir, 0o750); err != nil {
+		return fmt.Errorf("create state directory: %w", err)
+	}
+	statedir.EnsureIgnore(FS, repoPath)
+
+	path := filepath.Join(dir, historyFile)
+	data, err := FS.ReadFile(path)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	if len(data) > 0 && data[len(data)-1] != '\n' {
+		data = append(data, '\n')
+	}
+	for _, e := range events {
+		line, err := json.Marshal(e)
+		if err != nil {
+			return err
+		}
+		data = append(append(data, line...), '\n')
+	}
+	if err := FS.WriteFile(path, data, 0o644); err != nil { //nolint:gosec // same mode as the other state files
+		return fmt.Errorf("write history file: %w", err)
+	}
+	return nil
+}
+
+// OpenSignals returns the "opened" event of every signal whose latest
+// event is an opening, keyed by signal ID.
+func OpenSignals(events []Event) map[string]Event {
+	open := make(map[string]Event)
+	for _, e := range events {
+		switch e.Event {
+		case Opened:
+			if _, ok := open[e.SignalID]; !ok {
+				open[e.SignalID] = e
+			}
+		case Resolved:
+			delete(open, e.SignalID)
+		}
+	}
+	return open
+}
+
+// Changes returns the events a scan that found signals adds to history:
+// an opening for each signal not already open, then a resolution for each
+// open signal the scan did not find. inScope reports whether the scan
+// could have found an open signal — its collector ran and its workspace
+// was scanned — so a partial scan does not resolve what it never looked
+// for; nil means every signal is in scope. Events are ordered by signal ID
+// within each group.
+func Changes(events []Event, signals []signal.RawSignal, inScope func(Event) bool, at time.Time) []Event {
+	open := OpenSignals(events)
+	found := make(map[string]bool, len(signals))
+
+	var opened []Event
+	for _, sig := range signals {
+		id := output.SignalID(sig, "str-")
+		if found[id] {
+			continue
+		}
+		found[id] = true
+		if _, ok := open[id]; ok {
+			continue
+		}
+		opened = append(opened, Event{
+			SignalID:  id,
+			Event:     Opened,
+			Kind:      sig.Kind,
+			Collector: sig.Source,
+			FilePath:  sig.FilePath,
+			Workspace: sig.Workspace,
+			Title:     sig.Title,
+			At:        at,
+		})
+	}
+
+	var resolved []Event
+	for id, e := range open {
+		if found[id] || (inScope != nil && !inScope(e)) {
+			continue
+		}
+		e.Event = Resolved
+		e.At = at
+		resolved = append(resolved, e)
+	}
+
+	sort.Slice(opened, func(i, j int) bool { return opened[i].SignalID < opened[j].SignalID })
+	sort.Slice(resolved, func(i, j int) bool { return resolved[i].SignalID < resolved[j].SignalID })
+	return append(opened, resolved...)
+}
+
+// Lifecycle is one stretch of time a signal was open.
+type Lifecycle struct {
+	// Event is the opening event.
+	Event
+
+	// ResolvedAt is when the signal was resolved, or zero while it is open.
+	ResolvedAt time.Time
+}
+
+// Lifecycles replays events into lifecycles, in the order they opened.
+// Resolutions without a matching opening are ignored.
+func Lifecycles(events []Event) []Lifecycle {
+	var out []Lifecycle
+	open := make(map[string]int)
+	for _, e := range events {
+		switch e.Event {
+		case Opened:
+			if _, ok := open[e.SignalID]; ok {
+				continue
+			}
+			open[e.SignalID] = len(out)
+			out = append(out, Lifecycle{Event: e})
+		case Resolved:
+			if i, ok := open[e.SignalID]; ok {
+				out[i].ResolvedAt = e.At
+				delete(open, e.SignalID)
+			}
+		}
+	}
+	return out
+}
+
+// GroupStats summarizes the lifecycles of one kind, module, or other group.
+type GroupStats struct {
+	Key      string `json:"key"`
+	Opened   int    `json:"opened"`
+	Resolved int    `json:"resolved"`
+	Open     int    `json:"open"`
+
+	// ResolutionRate is Resolved / Opened.
+	ResolutionRate float64 `json:"resolution_rate"`
+
+	// MeanTimeToResolve is the mean time from opening to resolution over
+	// the resolved lifecycles, or zero when none were resolved.
+	MeanTimeToResolve time.Duration `json:"-"`
+
+	// MTTRHours is MeanTimeToResolve in hours, for JSON output.
+	MTTRHours float64 `json:"mttr_hours"`
+}
+
+// Stats groups lifecycles by key and summarizes each group, sorted by key.
+// Lifecycles whose key is empty are left out.
+func Stats(lifecycles []Lifecycle, key func(Lifecycle) string) []GroupStats {
+	byKey := make(map[string]*GroupStats)
+	total := make(map[string]time.Duration)
+	for _, lc := range lifecycles {
+		k := key(lc)
+		if k == "" {
+			continue
+		}
+		gs, ok := byKey[k]
+		if !ok {
+			gs = &GroupStats{Key: k}
+			byKey[k] = gs
+		}
+		gs.Opened++
+		if lc.ResolvedAt.IsZero() {
+			gs.Open++
+			continue
+		}
+		gs.Resolved++
+		total[k] += lc.ResolvedAt.Sub(lc.At)
+	}
+
+	stats := make([]GroupStats, 0, len(byKey))
+	for k, gs := range byKey {
+		gs.ResolutionRate = float64(gs.Resolved) / float64(gs.Opened)
+		if gs.Resolved > 0 {
+			gs.MeanTimeToResolve = total[k] / time.Duration(gs.Resolved)
+			gs.MTTRHours = roundTenth(gs.MeanTimeToResolve.Hours())
+		}
+		stats = append(stats, *gs)
+	}
+	sort.Slice(stats, func(i, j int) bool { return stats[i].Key < stats[j].Key })
+	return stats
+}
+
+// roundTenth rounds to one decimal place.
+func roundTenth(v float64) float64 {
+	return math.Round(v*10) / 10
+}
@@ -0,0 +1,147 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package lifecycle
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davetashner/stringer/internal/output"
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+var day0 = time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+func sig(source, kind, path, title string) signal.RawSignal {
+	return signal.RawSignal{Source: source, Kind: kind, FilePath: path, Line: 1, Title: title}
+}
+
+func TestLoad_Missing(t *testing.T) {
+	events, err := Load(t.TempDir())
+	require.NoError(t, err)
+	assert.Nil(t, events)
+}
+
+func TestAppendAndLoad(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, Append(dir))
+	assert.NoFileExists(t, filepath.Join(dir, ".stringer", "history.jsonl"), "nothing to record")
+
+	e := Event{SignalID: "str-00000001", Event: Opened, Kind: "todo", At: day0}
+	require.NoError(t, Append(dir, e))
+	require.NoError(t, Append(dir, Event{SignalID: "str-00000001", Event: Resolved, Kind: "todo", At: day0.Add(time.Hour)}))
+
+	events, err := Load(dir)
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	assert.Equal(t, e, events[0])
+
+	data, err := os.ReadFile(filepath.Join(dir, ".stringer", "history.jsonl"))
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `{"signal_id":"str-00000001","event":"opened","kind":"todo","at":"2026-03-01T00:00:00Z"}`+"\n")
+	assert.FileExists(t, filepath.Join(dir, ".stringer", ".gitignore"))
+}
+
+func TestLoad_InvalidLine(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, ".stringer"), 0o750))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".stringer", "history.jsonl"), []byte("\n{}\nnot json\n"), 0o600))
+
+	_, err := Load(dir)
+	assert.ErrorContains(t, err, "history.jsonl line 3")
+}
+
+func TestChanges(t *testing.T) {
+	todo := sig("todos", "todo", "a.go", "TODO: a")
+	churn := sig("gitlog", "churn", "b.go", "High churn")
+	fixme := sig("todos", "fixme", "c.go", "FIXME: c")
+
+	// The first scan opens everything it finds, once.
+	first := Changes(nil, []signal.RawSignal{todo, churn, todo}, nil, day0)
+	require.Len(t, first, 2)
+	for _, e := range first {
+		assert.Equal(t, Opened, e.Event)
+		assert.Equal(t, day0, e.At)
+	}
+	history := first
+
+	// The second scan finds fixme but no longer todo or churn. Only todos
+	// ran, so churn stays open.
+	day1 := day0.AddDate(0, 0, 1)
+	onlyTodos := func(e Event) bool { return e.Collector == "todos" }
+	second := Changes(history, []signal.RawSignal{fixme}, onlyTodos, day1)
+	require.Len(t, second, 2)
+	assert.Equal(t, Opened, second[0].Event)
+	assert.Equal(t, output.SignalID(fixme, "str-"), second[0].SignalID)
+	assert.Equal(t, "fixme", second[0].Kind)
+	assert.Equal(t, "c.go", second[0].FilePath)
+	assert.Equal(t, Resolved, second[1].Event)
+	assert.Equal(t, output.SignalID(todo, "str-"), second[1].SignalID)
+	assert.Equal(t, "TODO: a", second[1].Title, "resolutions carry the signal's details")
+	assert.Equal(t, day1, second[1].At)
+	history = append(history, second...)
+
+	open := OpenSignals(history)
+	assert.Len(t, open, 2)
+	assert.Contains(t, open, output.SignalID(churn, "str-"))
+
+	// todo comes back: a new lifecycle opens.
+	third := Changes(history, []signal.RawSignal{todo, churn, fixme}, nil, day1.AddDate(0, 0, 1))
+	require.Len(t, third, 1)
+	assert.Equal(t, Opened, third[0].Event)
+	assert.Equal(t, output.SignalID(todo, "str-"), third[0].SignalID)
+
+	assert.Empty(t, Changes(append(history, third...), []signal.RawSignal{todo, churn, fixme}, nil, day0), "nothing changed")
+}
+
+func TestLifecycles(t *testing.T) {
+	events := []Event{
+		{SignalID: "a", Event: Opened, Kind: "todo", At: day0},
+		{SignalID: "b", Event: Opened, Kind: "churn", At: day0},
+		{SignalID: "a", Event: Resolved, At: day0.AddDate(0, 0, 2)},
+		{SignalID: "c", Event: Resolved, At: day0},
+		{SignalID: "a", Event: Opened, Kind: "todo", At: day0.AddDate(0, 0, 5)},
+		{SignalID: "b", Event: Opened, Kind: "churn", At: day0.AddDate(0, 0, 5)},
+	}
+
+	lcs := Lifecycles(events)
+	require.Len(t, lcs, 3, "the stray resolution and the repeated opening are ignored")
+	assert.Equal(t, "a", lcs[0].SignalID)
+	assert.Equal(t, day0.AddDate(0, 0, 2), lcs[0].ResolvedAt)
+	assert.Equal(t, "b", lcs[1].SignalID)
+	assert.True(t, lcs[1].ResolvedAt.IsZero())
+	assert.Equal(t, day0.AddDate(0, 0, 5), lcs[2].At, "a reopened")
+}
+
+func TestStats(t *testing.T) {
+	lc := func(kind string, openDays, resolvedDays int) Lifecycle {
+		l := Lifecycle{Event: Event{Kind: kind, At: day0.AddDate(0, 0, openDays)}}
+		if resolvedDays >= 0 {
+			l.ResolvedAt = day0.AddDate(0, 0, resolvedDays)
+		}
+		return l
+	}
+	stats := Stats([]Lifecycle{
+		lc("todo", 0, 2),
+		lc("todo", 1, 5),
+		lc("todo", 3, -1),
+		lc("churn", 0, -1),
+		lc("", 0, 1),
+	}, func(l Lifecycle) string { return l.Kind })
+
+	require.Len(t, stats, 2)
+	assert.Equal(t, GroupStats{Key: "churn", Opened: 1, Open: 1}, stats[0])
+	assert.Equal(t, "todo", stats[1].Key)
+	assert.Equal(t, 3, stats[1].Opened)
+	assert.Equal(t, 2, stats[1].Resolved)
+	assert.Equal(t, 1, stats[1].Open)
+	assert.InDelta(t, 0.667, stats[1].ResolutionRate, 0.001)
+	assert.Equal(t, 3*24*time.Hour, stats[1].MeanTimeToResolve, "mean of 2 and 4 days")
+	assert.Equal(t, 72.0, stats[1].MTTRHours)
+}
@@ -5,7 +5,43 @@
 // implementations for use by stringer's analysis features.
 package llm
 
-import "context"
+import (
+	"context"
+	"fmt"
+)
+
+// Providers lists the provider names New accepts.
+var Providers = []string{"anthropic", "openai", "ollama"}
+
+// New creates the named provider, "anthropic" when name is empty. A
+// non-empty model or baseURL overrides the provider's default.
+func New(name, model, baseURL string) (Provider, error) {
+	switch name {
+	case "", "anthropic":
+		var opts []AnthropicOption
+		if model != "" {
+			opts = append(opts, WithModel(model))
+		}
+		if baseURL != "" {
+			opts = append(opts, WithBaseURL(baseURL))
+		}
+		return NewAnthropicProvider(opts...)
+	case "openai", "ollama":
+		var opts []OpenAIOption
+		if model != "" {
+			opts = append(opts, WithOpenAIModel(model))
+		}
+		if baseURL != "" {
+			opts = append(opts, WithOpenAIBaseURL(baseURL))
+		}
+		if name == "ollama" {
+			return NewOllamaProvider(opts...)
+		}
+		return NewOpenAIProvider(opts...)
+	default:
+		return nil, fmt.Errorf("llm: unknown provider %q", name)
+	}
+}
 
 // Provider abstracts an LLM API behind a single synchronous completion method.
 type Provider interface {
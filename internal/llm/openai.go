@@ -0,0 +1,227 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package llm
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+const (
+	// defaultOpenAIModel is the OpenAI model used when no override is provided.
+	defaultOpenAIModel = "gpt-4o-mini"
+
+	// defaultOpenAIBaseURL is the OpenAI API root.
+	defaultOpenAIBaseURL = "https://api.openai.com/v1"
+
+	// defaultOllamaModel is the ollama model used when no override is provided.
+	defaultOllamaModel = "llama3.1"
+
+	// defaultOllamaBaseURL is the OpenAI-compatible API of a local ollama.
+	defaultOllamaBaseURL = "http://localhost:11434/v1"
+
+	// openAITimeout bounds a single completion request. Local models can be
+	// slow on large prompts.
+	openAITimeout = 5 * time.Minute
+)
+
+// OpenAIProvider implements Provider with the OpenAI chat completions API.
+// Any server speaking that API works, including ollama.
+type OpenAIProvider struct {
+	client  *http.Client
+	baseURL string
+	apiKey  string
+	model   string
+}
+
+// Compile-time check that OpenAIProvider satisfies the Provider interface.
+var _ Provider = (*OpenAIProvider)(nil)
+
+// OpenAIOption configures an OpenAIProvider.
+type OpenAIOption func(*openAIConfig)
+
+type openAIConfig struct {
+	apiKey  string
+	model   string
+	baseURL string
+	keyless bool
+	client  *http.Client
+}
+
+// WithOpenAIAPIKey sets the API key. If not provided, the provider reads
+// OPENAI_API_KEY from the environment.
+func WithOpenAIAPIKey(key string) OpenAIOption {
+	return func(c *openAIConfig) {
+		c.apiKey = key
+	}
+}
+
+// WithOpenAIModel overrides the default model for all requests.
+func WithOpenAIModel(model string) OpenAIOption {
+	return func(c *openAIConfig) {
+		c.model = model
+	}
+}
+
+// WithOpenAIBaseURL overrides the API root, e.g. for a proxy or a
+// self-hosted server.
+func WithOpenAIBaseURL(baseURL string) OpenAIOption {
+	return func(c *openAIConfig) {
+		c.baseURL = baseURL
+	}
+}
+
+// WithOpenAIHTTPClient overrides the HTTP client.
+func WithOpenAIHTTPClient(client *http.Client) OpenAIOption {
+	return func(c *openAIConfig) {
+		c.client = client
+	}
+}
+
+// NewOpenAIProvider creates a provider for the OpenAI API. It returns an
+// error if no API key is available (neither via option nor env).
+func NewOpenAIProvider(opts ...OpenAIOption) (*OpenAIProvider, error) {
+	return newOpenAIProvider(openAIConfig{
+		model:   defaultOpenAIModel,
+		baseURL: defaultOpenAIBaseURL,
+	}, opts)
+}
+
+// NewOllamaProvider creates a provider for a local ollama server through
+// its OpenAI-compatible API. No API key is needed.
+func NewOllamaProvider(opts ...OpenAIOption) (*OpenAIProvider, error) {
+	return newOpenAIProvider(openAIConfig{
+		model:   defaultOllamaModel,
+		baseURL: defaultOllamaBaseURL,
+		keyless: true,
+	}, opts)
+}
+
+func newOpenAIProvider(cfg openAIConfig, opts []OpenAIOption) (*OpenAIProvider, error) {
+	for _, o := range opts {
+		o(&cfg)
+	}
+	apiKey := cfg.apiKey
+	if apiKey == "" && !cfg.keyless {
+		apiKey = os.Getenv("OPENAI_API_KEY")
+		if apiKey == "" {
+			return nil, errors.New("llm: OPENAI_API_KEY not set and no API key provided")
+		}
+	}
+	client := cfg.client
+	if client == nil {
+		client = &http.Client{Timeout: openAITimeout}
+	}
+	return &OpenAIProvider{
+		client:  client,
+		baseURL: strings.TrimRight(cfg.baseURL, "/"),
+		apiKey:  apiKey,
+		model:   cfg.model,
+	}, nil
+}
+
+type openAIMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+type openAIRequest struct {
+	Model       string          `json:"model"`
+	Messages    []openAIMessage `json:"messages"`
+	MaxTokens   int             `json:"max_tokens,omitempty"`
+	Temperature *float64        `json:"temperature,omitempty"`
+}
+
+type openAIResponse struct {
+	Model   string `json:"model"`
+	Choices []struct {
+		Message openAIMessage `json:"message"`
+	} `json:"choices"`
+	Usage struct {
+		PromptTokens     int `json:"prompt_tokens"`
+		CompletionTokens int `json:"completion_tokens"`
+	} `json:"usage"`
+	Error *struct {
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+// Complete sends a chat completion request.
+func (p *OpenAIProvider) Complete(ctx context.Context, req Request) (*Response, error) {
+	model := p.model
+	if req.Model != "" {
+		model = req.Model
+	}
+	maxTokens := req.MaxTokens
+	if maxTokens == 0 {
+		maxTokens = defaultMaxTokens
+	}
+
+	body := openAIRequest{Model: model, MaxTokens: maxTokens, Temperature: req.Temperature}
+	if req.SystemPrompt != "" {
+		body.Messages = append(body.Messages, openAIMessage{Role: "system", Content: req.SystemPrompt})
+	}
+	body.Messages = append(body.Messages, openAIMessage{Role: "user", Content: req.Prompt})
+	data, err := json.Marshal(body)
+	if err != nil {
+		return nil, fmt.Errorf("llm: encode request: %w", err)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, p.baseURL+"/chat/completions", bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("llm: build request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	if p.apiKey != "" {
+		httpReq.Header.Set("Authorization", "Bearer "+p.apiKey)
+	}
+
+	resp, err := p.client.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("llm: completion request failed: %w", err)
+	}
+	defer resp.Body.Close() //nolint:errcheck // read-only body
+	raw, err := io.ReadAll(io.LimitReader(resp.Body, 16<<20))
+	if err != nil {
+		return nil, fmt.Errorf("llm: read response: %w", err)
+	}
+
+	var out openAIResponse
+	decodeErr := json.Unmarshal(raw, &out)
+	if resp.StatusCode != http.StatusOK {
+		msg := http.StatusText(resp.StatusCode)
+		if decodeErr == nil && out.Error != nil && out.Error.Message != "" {
+			msg = out.Error.Message
+		}
+		return nil, fmt.Errorf("llm: completion request failed: %d %s", resp.StatusCode, msg)
+	}
+	if decodeErr != nil {
+		return nil, fmt.Errorf("llm: decode response: %w", decodeErr)
+	}
+	if len(out.Choices) == 0 {
+		return nil, errors.New("llm: response has no choices")
+	}
+
+	return &Response{
+		Content: out.Choices[0].Message.Content,
+		Model:   out.Model,
+		Usage: Usage{
+			InputTokens:  out.Usage.PromptTokens,
+			OutputTokens: out.Usage.CompletionTokens,
+		},
+	}, nil
+}
+
+// Model returns the configured default model name.
+func (p *OpenAIProvider) Model() string {
+	return p.model
+}
@@ -0,0 +1,91 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package llm_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/davetashner/stringer/internal/llm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewOpenAIProvider_NoKeyError(t *testing.T) {
+	t.Setenv("OPENAI_API_KEY", "")
+
+	p, err := llm.NewOpenAIProvider()
+	assert.Nil(t, p)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "OPENAI_API_KEY")
+}
+
+func TestNewOllamaProvider_NoKeyNeeded(t *testing.T) {
+	t.Setenv("OPENAI_API_KEY", "")
+
+	p, err := llm.NewOllamaProvider()
+	require.NoError(t, err)
+	assert.Equal(t, "llama3.1", p.Model())
+}
+
+func TestOpenAIProvider_Complete(t *testing.T) {
+	var got map[string]any
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/chat/completions", r.URL.Path)
+		assert.Equal(t, "Bearer test-key", r.Header.Get("Authorization"))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+		_, _ = w.Write([]byte(`{"model": "gpt-4o-mini-2024", "choices": [{"message": {"role": "assistant", "content": "hello"}}],
+			"usage": {"prompt_tokens": 12, "completion_tokens": 3}}`))
+	}))
+	defer srv.Close()
+
+	p, err := llm.NewOpenAIProvider(llm.WithOpenAIAPIKey("test-key"), llm.WithOpenAIBaseURL(srv.URL+"/v1/"))
+	require.NoError(t, err)
+	resp, err := p.Complete(context.Background(), llm.Request{SystemPrompt: "be brief", Prompt: "hi", MaxTokens: 50})
+	require.NoError(t, err)
+
+	assert.Equal(t, "hello", resp.Content)
+	assert.Equal(t, "gpt-4o-mini-2024", resp.Model)
+	assert.Equal(t, llm.Usage{InputTokens: 12, OutputTokens: 3}, resp.Usage)
+	assert.Equal(t, "gpt-4o-mini", got["model"])
+	assert.EqualValues(t, 50, got["max_tokens"])
+	assert.Equal(t, []any{
+		map[string]any{"role": "system", "content": "be brief"},
+		map[string]any{"role": "user", "content": "hi"},
+	}, got["messages"])
+}
+
+func TestOpenAIProvider_ErrorStatus(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"error": {"message": "invalid api key"}}`))
+	}))
+	defer srv.Close()
+
+	p, err := llm.NewOllamaProvider(llm.WithOpenAIBaseURL(srv.URL))
+	require.NoError(t, err)
+	_, err = p.Complete(context.Background(), llm.Request{Prompt: "hi"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "401 invalid api key")
+}
+
+func TestNew(t *testing.T) {
+	t.Setenv("ANTHROPIC_API_KEY", "a-key")
+	t.Setenv("OPENAI_API_KEY", "o-key")
+
+	for _, name := range llm.Providers {
+		p, err := llm.New(name, "some-model", "")
+		require.NoError(t, err, name)
+		assert.NotNil(t, p)
+	}
+	p, err := llm.New("", "", "")
+	require.NoError(t, err)
+	assert.IsType(t, &llm.AnthropicProvider{}, p)
+
+	_, err = llm.New("gemini", "", "")
+	assert.ErrorContains(t, err, `unknown provider "gemini"`)
+}
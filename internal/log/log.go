@@ -5,10 +5,31 @@
 package log
 
 import (
+	"fmt"
+	"io"
 	"log/slog"
 	"os"
 )
 
+// Format is the encoding of log records.
+type Format string
+
+// Log formats accepted by --log-format.
+const (
+	FormatText Format = "text" // logfmt-style key=value lines
+	FormatJSON Format = "json" // one JSON object per record, for CI log processors
+)
+
+// ParseFormat validates a --log-format value.
+func ParseFormat(s string) (Format, error) {
+	switch f := Format(s); f {
+	case FormatText, FormatJSON:
+		return f, nil
+	default:
+		return "", fmt.Errorf("unknown log format %q (want text or json)", s)
+	}
+}
+
 // Setup configures the default slog logger based on verbosity flags.
 //
 //   - quiet mode:   only WARN and ERROR messages
@@ -17,6 +38,18 @@ import (
 //
 // Output is written to stderr using slog.TextHandler.
 func Setup(verbose, quiet bool) {
+	SetupFormat(verbose, quiet, FormatText)
+}
+
+// SetupFormat is Setup with the given output format. Records logged with a
+// context from WithCollector are tagged with the collector's name, and its
+// warnings are captured for the scan summary.
+func SetupFormat(verbose, quiet bool, format Format) {
+	slog.SetDefault(slog.New(NewHandler(os.Stderr, verbose, quiet, format)))
+}
+
+// NewHandler returns the handler SetupFormat installs, writing to w.
+func NewHandler(w io.Writer, verbose, quiet bool, format Format) slog.Handler {
 	var level slog.Level
 	switch {
 	case quiet:
@@ -27,8 +60,12 @@ func Setup(verbose, quiet bool) {
 		level = slog.LevelInfo
 	}
 
-	handler := slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{
-		Level: level,
-	})
-	slog.SetDefault(slog.New(handler))
+	opts := &slog.HandlerOptions{Level: level}
+	var handler slog.Handler
+	if format == FormatJSON {
+		handler = slog.NewJSONHandler(w, opts)
+	} else {
+		handler = slog.NewTextHandler(w, opts)
+	}
+	return &scopedHandler{inner: handler}
 }
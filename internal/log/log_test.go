@@ -4,7 +4,9 @@
 package log
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"log/slog"
 	"testing"
 
@@ -69,3 +71,34 @@ func TestSetup_CalledMultipleTimes(t *testing.T) {
 	assert.False(t, handler2.Enabled(ctx, slog.LevelDebug))
 	assert.True(t, handler2.Enabled(ctx, slog.LevelWarn))
 }
+
+func TestParseFormat(t *testing.T) {
+	f, err := ParseFormat("json")
+	assert.NoError(t, err)
+	assert.Equal(t, FormatJSON, f)
+
+	f, err = ParseFormat("text")
+	assert.NoError(t, err)
+	assert.Equal(t, FormatText, f)
+
+	_, err = ParseFormat("xml")
+	assert.EqualError(t, err, `unknown log format "xml" (want text or json)`)
+}
+
+func TestNewHandler_JSON(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(NewHandler(&buf, false, false, FormatJSON))
+	logger.Info("scan complete", "signals", 3)
+
+	var record map[string]any
+	assert.NoError(t, json.Unmarshal(buf.Bytes(), &record))
+	assert.Equal(t, "INFO", record["level"])
+	assert.Equal(t, "scan complete", record["msg"])
+	assert.InDelta(t, 3, record["signals"], 0)
+}
+
+func TestNewHandler_Text(t *testing.T) {
+	var buf bytes.Buffer
+	slog.New(NewHandler(&buf, false, false, FormatText)).Info("scan complete", "signals", 3)
+	assert.Contains(t, buf.String(), `level=INFO msg="scan complete" signals=3`)
+}
@@ -0,0 +1,122 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package log
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"sync"
+)
+
+// CollectorKey is the attribute naming the collector a record came from.
+const CollectorKey = "collector"
+
+type scopeKey struct{}
+
+// scope is the collector a context belongs to.
+type scope struct {
+	name     string
+	warnings *Warnings
+}
+
+// Warnings collects the warnings one collector logged. It is safe for
+// concurrent use by the collector's goroutines.
+type Warnings struct {
+	mu   sync.Mutex
+	list []string
+}
+
+// List returns the warnings in the order they were logged.
+func (w *Warnings) List() []string {
+	if w == nil {
+		return nil
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return append([]string(nil), w.list...)
+}
+
+func (w *Warnings) add(msg string) {
+	w.mu.Lock()
+	w.list = append(w.list, msg)
+	w.mu.Unlock()
+}
+
+// WithCollector returns a context whose records, logged through the
+// default logger with slog's *Context functions, carry a collector=name
+// attribute and whose warnings and errors are collected in the returned
+// Warnings. Collectors log with the context Collect receives so their
+// warnings can be reported in the scan summary instead of only scrolling
+// past on stderr.
+func WithCollector(ctx context.Context, name string) (context.Context, *Warnings) {
+	w := &Warnings{}
+	return context.WithValue(ctx, scopeKey{}, scope{name: name, warnings: w}), w
+}
+
+// Collector returns the name of the collector ctx belongs to, or "".
+func Collector(ctx context.Context) string {
+	s, _ := ctx.Value(scopeKey{}).(scope)
+	return s.name
+}
+
+// scopedHandler adds the collector attribute to records logged in a
+// collector's context and captures its warnings.
+type scopedHandler struct {
+	inner slog.Handler
+	attrs []slog.Attr // added by WithAttrs, rendered in captured warnings
+}
+
+func (h *scopedHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	if level >= slog.LevelWarn && Collector(ctx) != "" {
+		return true // captured even when not printed
+	}
+	return h.inner.Enabled(ctx, level)
+}
+
+func (h *scopedHandler) Handle(ctx context.Context, r slog.Record) error {
+	s, ok := ctx.Value(scopeKey{}).(scope)
+	if !ok {
+		return h.inner.Handle(ctx, r)
+	}
+	if r.Level >= slog.LevelWarn {
+		s.warnings.add(h.summarize(r))
+	}
+	if !h.inner.Enabled(ctx, r.Level) {
+		return nil
+	}
+	r = r.Clone()
+	r.AddAttrs(slog.String(CollectorKey, s.name))
+	return h.inner.Handle(ctx, r)
+}
+
+func (h *scopedHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &scopedHandler{
+		inner: h.inner.WithAttrs(attrs),
+		attrs: append(h.attrs[:len(h.attrs):len(h.attrs)], attrs...),
+	}
+}
+
+func (h *scopedHandler) WithGroup(name string) slog.Handler {
+	return &scopedHandler{inner: h.inner.WithGroup(name), attrs: h.attrs}
+}
+
+// summarize renders a record as "message (key=value, ...)".
+func (h *scopedHandler) summarize(r slog.Record) string {
+	var attrs []string
+	add := func(a slog.Attr) bool {
+		if !a.Equal(slog.Attr{}) {
+			attrs = append(attrs, a.Key+"="+a.Value.Resolve().String())
+		}
+		return true
+	}
+	for _, a := range h.attrs {
+		add(a)
+	}
+	r.Attrs(add)
+	if len(attrs) == 0 {
+		return r.Message
+	}
+	return r.Message + " (" + strings.Join(attrs, ", ") + ")"
+}
@@ -0,0 +1,54 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package log
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithCollector_TagsAndCaptures(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(NewHandler(&buf, false, false, FormatText))
+
+	ctx, warnings := WithCollector(context.Background(), "github")
+	assert.Equal(t, "github", Collector(ctx))
+
+	logger.InfoContext(ctx, "fetching issues", "page", 2)
+	logger.With("owner", "acme").WarnContext(ctx, "rate limited", "retry_after", "30s")
+	logger.ErrorContext(ctx, "request failed")
+	logger.Warn("unscoped")
+
+	assert.Equal(t, []string{
+		"rate limited (owner=acme, retry_after=30s)",
+		"request failed",
+	}, warnings.List())
+
+	out := buf.String()
+	assert.Contains(t, out, `msg="fetching issues" page=2 collector=github`)
+	assert.Contains(t, out, `msg="rate limited" owner=acme retry_after=30s collector=github`)
+	assert.Contains(t, out, `msg=unscoped`)
+	assert.NotContains(t, out, `msg=unscoped collector`)
+}
+
+func TestWithCollector_CapturesBelowLevel(t *testing.T) {
+	// A handler at ERROR level still captures a collector's warnings.
+	var buf bytes.Buffer
+	h := &scopedHandler{inner: slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelError})}
+	ctx, warnings := WithCollector(context.Background(), "vuln")
+
+	slog.New(h).WarnContext(ctx, "OSV unreachable")
+	assert.Equal(t, []string{"OSV unreachable"}, warnings.List())
+	assert.Empty(t, buf.String())
+}
+
+func TestWarnings_NilList(t *testing.T) {
+	var w *Warnings
+	assert.Nil(t, w.List())
+	assert.Empty(t, Collector(context.Background()))
+}
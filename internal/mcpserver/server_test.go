@@ -38,7 +38,7 @@ func TestRun_WithInMemoryTransport(t *testing.T) {
 
 	result, err := session.ListTools(ctx, nil)
 	require.NoError(t, err)
-	assert.Len(t, result.Tools, 4)
+	assert.Len(t, result.Tools, 6)
 
 	cancel()
 }
@@ -71,8 +71,8 @@ func TestServer_ListsTools(t *testing.T) {
 	result, err := session.ListTools(ctx, nil)
 	require.NoError(t, err)
 
-	// Should have 4 tools.
-	assert.Len(t, result.Tools, 4)
+	// Should have 6 tools.
+	assert.Len(t, result.Tools, 6)
 
 	// Verify tool names.
 	names := make(map[string]bool)
@@ -83,6 +83,8 @@ func TestServer_ListsTools(t *testing.T) {
 	assert.True(t, names["report"], "should have report tool")
 	assert.True(t, names["context"], "should have context tool")
 	assert.True(t, names["docs"], "should have docs tool")
+	assert.True(t, names["list_signals"], "should have list_signals tool")
+	assert.True(t, names["explain_signal"], "should have explain_signal tool")
 
 	cancel()
 }
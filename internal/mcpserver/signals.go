@@ -0,0 +1,365 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package mcpserver
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/davetashner/stringer/internal/output"
+	"github.com/davetashner/stringer/internal/redact"
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+// idPrefix is the prefix of the signal IDs the tools report, matching the
+// bead IDs of stringer scan output.
+const idPrefix = "str-"
+
+// defaultListLimit caps list_signals output unless the caller asks for more.
+const defaultListLimit = 50
+
+// contextLines is how many source lines explain_signal shows on each side
+// of a signal's line.
+const contextLines = 5
+
+// ListSignalsInput is the input schema for the list_signals MCP tool.
+type ListSignalsInput struct {
+	Path          string  `json:"path" jsonschema:"Repository path to scan (defaults to current directory)"`
+	Collectors    string  `json:"collectors,omitempty" jsonschema:"Comma-separated list of collectors to run (default: all)"`
+	Kind          string  `json:"kind,omitempty" jsonschema:"Filter signals by kind (comma-separated)"`
+	File          string  `json:"file,omitempty" jsonschema:"Only signals in this file or directory (relative to path; globs allowed)"`
+	MinConfidence float64 `json:"min_confidence,omitempty" jsonschema:"Filter signals below this confidence threshold (0.0-1.0)"`
+	Limit         int     `json:"limit,omitempty" jsonschema:"Maximum signals to list, highest confidence first (default 50)"`
+	Refresh       bool    `json:"refresh,omitempty" jsonschema:"Scan again instead of reusing the previous scan of this path"`
+}
+
+// ExplainSignalInput is the input schema for the explain_signal MCP tool.
+type ExplainSignalInput struct {
+	Path       string `json:"path" jsonschema:"Repository path the signal came from (defaults to current directory)"`
+	ID         string `json:"id" jsonschema:"Signal ID from scan or list_signals output (e.g. str-0e4098f9)"`
+	Collectors string `json:"collectors,omitempty" jsonschema:"Collectors to run if the signal is not in a previous scan (default: all)"`
+}
+
+// signalSummary is one list_signals entry.
+type signalSummary struct {
+	ID         string   `json:"id"`
+	Kind       string   `json:"kind"`
+	Collector  string   `json:"collector"`
+	Title      string   `json:"title"`
+	Location   string   `json:"location,omitempty"`
+	Confidence float64  `json:"confidence"`
+	Priority   string   `json:"priority"`
+	Effort     string   `json:"effort,omitempty"`
+	Owners     []string `json:"owners,omitempty"`
+}
+
+// signalList is the list_signals result.
+type signalList struct {
+	Total   int             `json:"total"`
+	Shown   int             `json:"shown"`
+	Signals []signalSummary `json:"signals"`
+}
+
+// signalExplanation is the explain_signal result.
+type signalExplanation struct {
+	signalSummary
+	Description string                    `json:"description,omitempty"`
+	Why         string                    `json:"why"`
+	Factors     []signal.ConfidenceFactor `json:"confidence_factors,omitempty"`
+	Tags        []string                  `json:"tags,omitempty"`
+	Author      string                    `json:"author,omitempty"`
+	Timestamp   string                    `json:"timestamp,omitempty"`
+	URL         string                    `json:"url,omitempty"`
+	Code        string                    `json:"code,omitempty"`
+	Related     []signalSummary           `json:"related,omitempty"`
+}
+
+// scanCache remembers the latest unfiltered signals per repository and
+// collector list, so list_signals and explain_signal can answer follow-up
+// questions without scanning again.
+type scanCache struct {
+	mu      sync.Mutex
+	entries map[string]cachedScan
+}
+
+type cachedScan struct {
+	path    string
+	signals []signal.RawSignal
+	at      time.Time
+}
+
+// scans is the cache shared by the tool handlers of this process.
+var scans = &scanCache{entries: make(map[string]cachedScan)}
+
+func cacheKey(path, collectors string) string {
+	return path + "\x00" + strings.Join(splitAndTrim(collectors), ",")
+}
+
+func (c *scanCache) store(path, collectors string, signals []signal.RawSignal) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[cacheKey(path, collectors)] = cachedScan{path: path, signals: signals, at: time.Now()}
+}
+
+func (c *scanCache) get(path, collectors string) ([]signal.RawSignal, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.entries[cacheKey(path, collectors)]
+	return e.signals, ok
+}
+
+// find returns the signal with the given ID from any cached scan of path,
+// newest scan first.
+func (c *scanCache) find(path, id string) (signal.RawSignal, []signal.RawSignal, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var best cachedScan
+	var found signal.RawSignal
+	ok := false
+	for _, e := range c.entries {
+		if e.path != path || (ok && !e.at.After(best.at)) {
+			continue
+		}
+		if sig, hit := findSignal(e.signals, id); hit {
+			best, found, ok = e, sig, true
+		}
+	}
+	return found, best.signals, ok
+}
+
+// findSignal looks up a signal by ID, with or without the str- prefix.
+func findSignal(signals []signal.RawSignal, id string) (signal.RawSignal, bool) {
+	want := strings.ToLower(strings.TrimPrefix(strings.TrimSpace(id), idPrefix))
+	for _, sig := range signals {
+		if output.SignalID(sig, "") == want {
+			return sig, true
+		}
+	}
+	return signal.RawSignal{}, false
+}
+
+// registerSignalTools adds list_signals and explain_signal to the server.
+func registerSignalTools(server *mcp.Server) {
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "list_signals",
+		Description: "List backlog candidates as compact entries (id, kind, title, location, confidence, priority, owners), highest confidence first. Reuses the previous scan of the path unless refresh is set.",
+		Annotations: &mcp.ToolAnnotations{
+			ReadOnlyHint:    true,
+			DestructiveHint: boolPtr(false),
+			OpenWorldHint:   boolPtr(false),
+		},
+	}, handleListSignals)
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "explain_signal",
+		Description: "Explain one signal by ID: why it was reported, its confidence and priority, the factors behind the confidence, the surrounding source code, and other signals in the same file.",
+		Annotations: &mcp.ToolAnnotations{
+			ReadOnlyHint:    true,
+			DestructiveHint: boolPtr(false),
+			OpenWorldHint:   boolPtr(false),
+		},
+	}, handleExplainSignal)
+}
+
+func handleListSignals(ctx context.Context, _ *mcp.CallToolRequest, input ListSignalsInput) (*mcp.CallToolResult, any, error) {
+	pathInfo, err := ResolvePath(input.Path)
+	if err != nil {
+		return nil, nil, redactErr(err)
+	}
+	if input.MinConfidence < 0 || input.MinConfidence > 1 {
+		return nil, nil, fmt.Errorf("min_confidence must be between 0.0 and 1.0, got %g", input.MinConfidence)
+	}
+	if input.Limit < 0 {
+		return nil, nil, fmt.Errorf("limit must not be negative, got %d", input.Limit)
+	}
+
+	signals, cached := scans.get(pathInfo.AbsPath, input.Collectors)
+	if !cached || input.Refresh {
+		if _, err := scanSignals(ctx, pathInfo, ScanInput{Path: input.Path, Collectors: input.Collectors}); err != nil {
+			return nil, nil, err
+		}
+		signals, _ = scans.get(pathInfo.AbsPath, input.Collectors)
+	}
+
+	kinds := make(map[string]bool)
+	for _, k := range splitAndTrim(input.Kind) {
+		kinds[strings.ToLower(k)] = true
+	}
+	scope := signal.NewScope(splitAndTrim(input.File), 0)
+
+	var matched []signal.RawSignal
+	for _, sig := range signals {
+		if sig.Confidence < input.MinConfidence || (len(kinds) > 0 && !kinds[sig.Kind]) {
+			continue
+		}
+		if !scope.IsZero() && (sig.FilePath == "" || !scope.Contains(sig.FilePath)) {
+			continue
+		}
+		matched = append(matched, sig)
+	}
+	sortByConfidence(matched)
+
+	limit := input.Limit
+	if limit == 0 {
+		limit = defaultListLimit
+	}
+	out := signalList{Total: len(matched), Signals: []signalSummary{}}
+	for i, sig := range matched {
+		if i == limit {
+			break
+		}
+		out.Signals = append(out.Signals, summarizeSignal(sig))
+	}
+	out.Shown = len(out.Signals)
+	return jsonResult(out)
+}
+
+func handleExplainSignal(ctx context.Context, _ *mcp.CallToolRequest, input ExplainSignalInput) (*mcp.CallToolResult, any, error) {
+	if strings.TrimSpace(input.ID) == "" {
+		return nil, nil, fmt.Errorf("id is required")
+	}
+	pathInfo, err := ResolvePath(input.Path)
+	if err != nil {
+		return nil, nil, redactErr(err)
+	}
+
+	sig, all, ok := scans.find(pathInfo.AbsPath, input.ID)
+	if !ok {
+		if _, err := scanSignals(ctx, pathInfo, ScanInput{Path: input.Path, Collectors: input.Collectors}); err != nil {
+			return nil, nil, err
+		}
+		if sig, all, ok = scans.find(pathInfo.AbsPath, input.ID); !ok {
+			return nil, nil, fmt.Errorf("signal %q not found in a scan of %s (IDs change when a signal's file, line, or title changes)", input.ID, input.Path)
+		}
+	}
+
+	out := signalExplanation{
+		signalSummary: summarizeSignal(sig),
+		Description:   sig.Description,
+		Why:           explainWhy(sig),
+		Factors:       sig.Factors,
+		Tags:          sig.Tags,
+		Author:        sig.Author,
+		URL:           sig.URL,
+	}
+	if sig.Kind != "committed-secret" {
+		out.Code = sourceContext(pathInfo.AbsPath, sig.FilePath, sig.Line)
+	}
+	if !sig.Timestamp.IsZero() {
+		out.Timestamp = sig.Timestamp.UTC().Format(time.RFC3339)
+	}
+	id := output.SignalID(sig, idPrefix)
+	for _, other := range all {
+		if other.FilePath == "" || other.FilePath != sig.FilePath || output.SignalID(other, idPrefix) == id {
+			continue
+		}
+		out.Related = append(out.Related, summarizeSignal(other))
+	}
+	return jsonResult(out)
+}
+
+func summarizeSignal(sig signal.RawSignal) signalSummary {
+	s := signalSummary{
+		ID:         output.SignalID(sig, idPrefix),
+		Kind:       sig.Kind,
+		Collector:  sig.Source,
+		Title:      sig.Title,
+		Confidence: sig.Confidence,
+		Priority:   fmt.Sprintf("P%d", signal.EffectivePriority(sig)),
+		Effort:     sig.Effort,
+		Owners:     sig.Owners,
+	}
+	if sig.FilePath != "" {
+		s.Location = sig.FilePath
+		if sig.Line > 0 {
+			s.Location = fmt.Sprintf("%s:%d", sig.FilePath, sig.Line)
+		}
+	}
+	return s
+}
+
+// explainWhy says in a sentence or two where a signal came from and how
+// its priority was set.
+func explainWhy(sig signal.RawSignal) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "The %s collector reported a %s signal", sig.Source, sig.Kind)
+	if sig.FilePath != "" {
+		fmt.Fprintf(&b, " in %s", sig.FilePath)
+	}
+	fmt.Fprintf(&b, " with confidence %.2f.", sig.Confidence)
+	if sig.Priority != nil {
+		fmt.Fprintf(&b, " Its priority P%d was assigned explicitly rather than from confidence.", *sig.Priority)
+	} else {
+		fmt.Fprintf(&b, " That confidence puts it at P%d (>=0.80 is P1, >=0.60 P2, >=0.40 P3).", signal.EffectivePriority(sig))
+	}
+	if len(sig.Tags) > 0 {
+		fmt.Fprintf(&b, " Tags: %s.", strings.Join(sig.Tags, ", "))
+	}
+	return b.String()
+}
+
+// sourceContext returns the lines around line in the repository file rel,
+// each prefixed with its number and the signal's line marked with ">", with
+// known secrets redacted. It returns "" for signals without a line and for
+// paths outside root. Callers leave out the context of committed-secret
+// signals, whose line is the secret.
+func sourceContext(root, rel string, line int) string {
+	if rel == "" || line <= 0 || filepath.IsAbs(rel) {
+		return ""
+	}
+	path := filepath.Join(root, rel)
+	if r, err := filepath.Rel(root, path); err != nil || strings.HasPrefix(r, "..") {
+		return ""
+	}
+	f, err := os.Open(path) //nolint:gosec // confined to the repository root above
+	if err != nil {
+		return ""
+	}
+	defer f.Close() //nolint:errcheck // read-only
+
+	var b strings.Builder
+	sc := bufio.NewScanner(f)
+	for n := 1; sc.Scan(); n++ {
+		if n < line-contextLines {
+			continue
+		}
+		if n > line+contextLines {
+			break
+		}
+		marker := " "
+		if n == line {
+			marker = ">"
+		}
+		fmt.Fprintf(&b, "%s%5d  %s\n", marker, n, redact.String(sc.Text()))
+	}
+	return b.String()
+}
+
+// sortByConfidence orders signals by confidence, highest first, keeping
+// scan order among equals.
+func sortByConfidence(signals []signal.RawSignal) {
+	sort.SliceStable(signals, func(i, j int) bool { return signals[i].Confidence > signals[j].Confidence })
+}
+
+func jsonResult(v any) (*mcp.CallToolResult, any, error) {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return nil, nil, fmt.Errorf("encoding result: %w", err)
+	}
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(data)},
+		},
+	}, nil, nil
+}
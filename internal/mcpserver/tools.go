@@ -114,6 +114,8 @@ func registerTools(server *mcp.Server) {
 			OpenWorldHint:   boolPtr(false),
 		},
 	}, handleDocs)
+
+	registerSignalTools(server)
 }
 
 func handleScan(ctx context.Context, _ *mcp.CallToolRequest, input ScanInput) (*mcp.CallToolResult, any, error) {
@@ -122,12 +124,6 @@ func handleScan(ctx context.Context, _ *mcp.CallToolRequest, input ScanInput) (*
 		return nil, nil, redactErr(err)
 	}
 
-	// Parse collectors.
-	var collectors []string
-	if input.Collectors != "" {
-		collectors = splitAndTrim(input.Collectors)
-	}
-
 	// Determine format (default to json for MCP consumers).
 	format := "json"
 	if input.Format != "" {
@@ -144,16 +140,45 @@ func handleScan(ctx context.Context, _ *mcp.CallToolRequest, input ScanInput) (*
 		return nil, nil, fmt.Errorf("min_confidence must be between 0.0 and 1.0, got %g", input.MinConfidence)
 	}
 
+	signals, err := scanSignals(ctx, pathInfo, input)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Format output.
+	formatter, _ := output.GetFormatter(format)
+	var buf bytes.Buffer
+	if err := formatter.Format(signals, &buf); err != nil {
+		return nil, nil, redactErr(fmt.Errorf("formatting failed: %w", err))
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: buf.String()},
+		},
+	}, nil, nil
+}
+
+// scanSignals runs the collectors on the repository and applies the
+// confidence, kind, and beads filters of input. The unfiltered signals are
+// cached for list_signals and explain_signal.
+func scanSignals(ctx context.Context, pathInfo *PathInfo, input ScanInput) ([]signal.RawSignal, error) {
+	// Parse collectors.
+	var collectors []string
+	if input.Collectors != "" {
+		collectors = splitAndTrim(input.Collectors)
+	}
+
 	// Load and merge config.
 	fileCfg, err := config.Load(pathInfo.AbsPath)
 	if err != nil {
-		return nil, nil, redactErr(fmt.Errorf("failed to load config: %w", err))
+		return nil, redactErr(fmt.Errorf("failed to load config: %w", err))
 	}
 
 	scanCfg := signal.ScanConfig{
 		RepoPath:     pathInfo.AbsPath,
 		Collectors:   collectors,
-		OutputFormat: format,
+		OutputFormat: input.Format,
 		MaxIssues:    input.MaxIssues,
 	}
 	scanCfg = config.Merge(fileCfg, scanCfg)
@@ -192,23 +217,25 @@ func handleScan(ctx context.Context, _ *mcp.CallToolRequest, input ScanInput) (*
 	if err != nil {
 		available := collector.List()
 		sort.Strings(available)
-		return nil, nil, redactErr(fmt.Errorf("%v (available: %s)", err, strings.Join(available, ", ")))
+		return nil, redactErr(fmt.Errorf("%v (available: %s)", err, strings.Join(available, ", ")))
 	}
 
 	result, err := p.Run(ctx)
 	if err != nil {
-		return nil, nil, redactErr(fmt.Errorf("scan failed: %w", err))
+		return nil, redactErr(fmt.Errorf("scan failed: %w", err))
 	}
+	signals := result.Signals
+	scans.store(pathInfo.AbsPath, input.Collectors, signals)
 
 	// Apply confidence filter.
 	if input.MinConfidence > 0 {
 		var filtered []signal.RawSignal
-		for _, sig := range result.Signals {
+		for _, sig := range signals {
 			if sig.Confidence >= input.MinConfidence {
 				filtered = append(filtered, sig)
 			}
 		}
-		result.Signals = filtered
+		signals = filtered
 	}
 
 	// Apply kind filter.
@@ -218,12 +245,12 @@ func handleScan(ctx context.Context, _ *mcp.CallToolRequest, input ScanInput) (*
 			kinds[strings.ToLower(k)] = true
 		}
 		var filtered []signal.RawSignal
-		for _, sig := range result.Signals {
+		for _, sig := range signals {
 			if kinds[sig.Kind] {
 				filtered = append(filtered, sig)
 			}
 		}
-		result.Signals = filtered
+		signals = filtered
 	}
 
 	// Beads-aware dedup (read-only).
@@ -233,22 +260,10 @@ func handleScan(ctx context.Context, _ *mcp.CallToolRequest, input ScanInput) (*
 		if beadsErr != nil {
 			slog.Warn("failed to load existing beads", "error", beadsErr)
 		} else if existingBeads != nil {
-			result.Signals = beads.FilterAgainstExisting(result.Signals, existingBeads)
+			signals = beads.FilterAgainstExisting(signals, existingBeads)
 		}
 	}
-
-	// Format output.
-	formatter, _ := output.GetFormatter(format)
-	var buf bytes.Buffer
-	if err := formatter.Format(result.Signals, &buf); err != nil {
-		return nil, nil, redactErr(fmt.Errorf("formatting failed: %w", err))
-	}
-
-	return &mcp.CallToolResult{
-		Content: []mcp.Content{
-			&mcp.TextContent{Text: buf.String()},
-		},
-	}, nil, nil
+	return signals, nil
 }
 
 func handleReport(ctx context.Context, _ *mcp.CallToolRequest, input ReportInput) (*mcp.CallToolResult, any, error) {
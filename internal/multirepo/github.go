@@ -0,0 +1,57 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+//go:build !nonetwork
+
+package multirepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/google/go-github/v68/github"
+
+	"github.com/davetashner/stringer/internal/collectors"
+)
+
+// DiscoverGitHubOrg lists the repositories of a GitHub organization,
+// authenticated with GITHUB_TOKEN. Archived repositories and forks are
+// left out.
+func DiscoverGitHubOrg(ctx context.Context, org string) ([]Repo, error) {
+	token := os.Getenv("GITHUB_TOKEN")
+	if token == "" {
+		return nil, errors.New("GITHUB_TOKEN is not set")
+	}
+	return discoverOrg(ctx, collectors.NewGitHubClient(token), org)
+}
+
+// discoverOrg lists org's active repositories, sorted by name.
+func discoverOrg(ctx context.Context, client *github.Client, org string) ([]Repo, error) {
+	opts := &github.RepositoryListByOrgOptions{ListOptions: github.ListOptions{PerPage: 100}}
+	var repos []Repo
+	for {
+		page, resp, err := client.Repositories.ListByOrg(ctx, org, opts)
+		if err != nil {
+			return nil, fmt.Errorf("listing repositories of %s: %w", org, err)
+		}
+		for _, r := range page {
+			if r.GetArchived() || r.GetFork() {
+				continue
+			}
+			repo, err := Parse(r.GetCloneURL())
+			if err != nil {
+				return nil, err
+			}
+			repos = append(repos, repo)
+		}
+		if resp == nil || resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+	sort.Slice(repos, func(i, j int) bool { return repos[i].Name < repos[j].Name })
+	return repos, nil
+}
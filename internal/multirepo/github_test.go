@@ -0,0 +1,51 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+//go:build !nonetwork
+
+package multirepo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-github/v68/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiscoverOrg(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/orgs/acme/repos", r.URL.Path)
+		if r.URL.Query().Get("page") == "2" {
+			fmt.Fprint(w, `[{"clone_url": "https://github.com/acme/api.git"}]`)
+			return
+		}
+		w.Header().Set("Link", `<`+"http://"+r.Host+`/orgs/acme/repos?page=2>; rel="next"`)
+		fmt.Fprint(w, `[
+			{"clone_url": "https://github.com/acme/web.git"},
+			{"clone_url": "https://github.com/acme/old.git", "archived": true},
+			{"clone_url": "https://github.com/acme/upstream.git", "fork": true}
+		]`)
+	}))
+	defer srv.Close()
+	client := github.NewClient(nil)
+	client.BaseURL, _ = url.Parse(srv.URL + "/")
+
+	repos, err := discoverOrg(context.Background(), client, "acme")
+	require.NoError(t, err)
+	require.Len(t, repos, 2)
+	assert.Equal(t, "acme/api", repos[0].Name)
+	assert.Equal(t, "https://github.com/acme/api.git", repos[0].URL)
+	assert.Equal(t, "acme/web", repos[1].Name)
+}
+
+func TestDiscoverGitHubOrg_NoToken(t *testing.T) {
+	t.Setenv("GITHUB_TOKEN", "")
+	_, err := DiscoverGitHubOrg(context.Background(), "acme")
+	assert.ErrorContains(t, err, "GITHUB_TOKEN")
+}
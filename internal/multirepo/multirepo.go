@@ -0,0 +1,208 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+// Package multirepo finds and checks out the repositories of a multi-repo
+// scan. It reads repository lists, discovers the repositories of a GitHub
+// organization, and keeps shallow clones of them in a cache directory so
+// nightly scans only fetch what changed.
+package multirepo
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/davetashner/stringer/internal/gitcli"
+)
+
+// Repo is a repository to scan.
+type Repo struct {
+	// Name is "owner/name", e.g. "acme/api". It keys the repository's
+	// signals in the combined output and its clone in the cache.
+	Name string
+
+	// URL is what git clones the repository from.
+	URL string
+
+	// host is the forge or "local", separating clones of same-named
+	// repositories on different hosts.
+	host string
+}
+
+// namePart matches one segment of a repository name.
+var namePart = regexp.MustCompile(`^[A-Za-z0-9_][A-Za-z0-9._-]*$`)
+
+// scpLike matches the scp-like syntax git accepts for SSH remotes:
+// git@github.com:acme/api.git.
+var scpLike = regexp.MustCompile(`^[\w.-]+@([\w.-]+):(.+)$`)
+
+// Parse reads a repository from a list entry:
+//
+//   - "acme/api", a GitHub repository;
+//   - a URL git can clone, such as https://gitlab.com/acme/api.git or
+//     git@github.com:acme/api.git;
+//   - a local path starting with "/", "./", or "../".
+//
+// The name is the last two segments of the URL or path.
+func Parse(s string) (Repo, error) {
+	var host, p, cloneURL string
+	switch {
+	case strings.HasPrefix(s, "/"), strings.HasPrefix(s, "./"), strings.HasPrefix(s, "../"):
+		abs, err := filepath.Abs(s)
+		if err != nil {
+			return Repo{}, fmt.Errorf("repository %q: %w", s, err)
+		}
+		// git only clones local repositories shallowly from file:// URLs.
+		host, p, cloneURL = "local", filepath.ToSlash(abs), (&url.URL{Scheme: "file", Path: filepath.ToSlash(abs)}).String()
+	case strings.Contains(s, "://"):
+		u, err := url.Parse(s)
+		if err != nil {
+			return Repo{}, fmt.Errorf("repository %q: %w", s, err)
+		}
+		host, p, cloneURL = u.Hostname(), u.Path, s
+		if host == "" {
+			host = "local"
+		}
+	case scpLike.MatchString(s):
+		m := scpLike.FindStringSubmatch(s)
+		host, p, cloneURL = m[1], m[2], s
+	case strings.Count(s, "/") == 1:
+		host, p, cloneURL = "github.com", s, "https://github.com/"+strings.TrimSuffix(s, ".git")+".git"
+	default:
+		return Repo{}, fmt.Errorf("repository %q: want owner/name, a clone URL, or a local path", s)
+	}
+
+	segments := strings.Split(strings.Trim(strings.TrimSuffix(path.Clean(p), ".git"), "/"), "/")
+	if len(segments) < 2 {
+		return Repo{}, fmt.Errorf("repository %q: no owner/name in the path", s)
+	}
+	owner, name := segments[len(segments)-2], segments[len(segments)-1]
+	if !namePart.MatchString(owner) || !namePart.MatchString(name) || !namePart.MatchString(host) {
+		return Repo{}, fmt.Errorf("repository %q: invalid owner/name %s/%s", s, owner, name)
+	}
+	return Repo{Name: owner + "/" + name, URL: cloneURL, host: host}, nil
+}
+
+// ParseList reads a repository list: one entry per line in a form Parse
+// accepts. Blank lines and lines starting with "#" are skipped, as is a
+// repeated repository.
+func ParseList(r io.Reader) ([]Repo, error) {
+	var repos []Repo
+	sc := bufio.NewScanner(r)
+	for n := 1; sc.Scan(); n++ {
+		line := strings.TrimSpace(sc.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		repo, err := Parse(line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", n, err)
+		}
+		repos = Merge(repos, []Repo{repo})
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	return repos, nil
+}
+
+// Merge appends the repositories of more that repos lacks. Repositories are
+// the same when they have the same host and name, so acme/api on GitHub and
+// on GitLab are both kept.
+func Merge(repos, more []Repo) []Repo {
+	seen := make(map[string]bool, len(repos))
+	for _, r := range repos {
+		seen[r.key()] = true
+	}
+	for _, r := range more {
+		if !seen[r.key()] {
+			seen[r.key()] = true
+			repos = append(repos, r)
+		}
+	}
+	return repos
+}
+
+// key returns "host/owner/name", which identifies the repository.
+func (r Repo) key() string {
+	host := r.host
+	if host == "" {
+		host = "github.com"
+	}
+	return host + "/" + r.Name
+}
+
+// Dir returns where the repository is cloned under cacheDir.
+func (r Repo) Dir(cacheDir string) string {
+	return filepath.Join(cacheDir, filepath.FromSlash(r.key()))
+}
+
+// DefaultCacheDir returns the default clone cache: stringer/repos in the
+// user's cache directory.
+func DefaultCacheDir() (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "stringer", "repos"), nil
+}
+
+// cloneMarker is the file, inside .git, that marks a clone as made by
+// Sync. Sync only updates or replaces directories that have it.
+const cloneMarker = "stringer-clone"
+
+// Sync clones the repository into its directory under cacheDir, keeping
+// depth commits of the default branch, or brings an earlier clone up to
+// date with the remote. It returns the clone's directory. Local changes in
+// a clone are discarded. Sync refuses to touch a directory it did not
+// clone, such as a working copy that happens to sit in the cache.
+func Sync(ctx context.Context, r Repo, cacheDir string, depth int) (string, error) {
+	dir := r.Dir(cacheDir)
+	depthArg := "--depth=" + strconv.Itoa(max(depth, 1))
+
+	if _, err := os.Lstat(dir); err == nil {
+		if _, err := os.Stat(filepath.Join(dir, ".git", cloneMarker)); err != nil {
+			return "", fmt.Errorf("updating %s: %s exists and was not cloned by stringer; move it or use another --repos-cache", r.Name, dir)
+		}
+		for _, args := range [][]string{
+			{"remote", "set-url", "origin", r.URL},
+			{"fetch", "--quiet", "--no-tags", depthArg, "origin", "HEAD"},
+			{"reset", "--quiet", "--hard", "FETCH_HEAD"},
+		} {
+			if _, err := gitcli.Exec(ctx, dir, args...); err != nil {
+				return "", fmt.Errorf("updating %s: %w", r.Name, err)
+			}
+		}
+		return dir, nil
+	}
+
+	// Clone next to dir and move the clone into place once it is complete,
+	// so an interrupted clone never leaves a directory behind at dir.
+	if err := os.MkdirAll(filepath.Dir(dir), 0o750); err != nil {
+		return "", fmt.Errorf("cloning %s: %w", r.Name, err)
+	}
+	tmp, err := os.MkdirTemp(filepath.Dir(dir), ".clone-*")
+	if err != nil {
+		return "", fmt.Errorf("cloning %s: %w", r.Name, err)
+	}
+	defer os.RemoveAll(tmp) //nolint:errcheck // best-effort cleanup; empty after the rename
+	clone := filepath.Join(tmp, "repo")
+	if _, err := gitcli.Exec(ctx, tmp, "clone", "--quiet", "--no-tags", "--single-branch", depthArg, "--", r.URL, clone); err != nil {
+		return "", fmt.Errorf("cloning %s: %w", r.Name, err)
+	}
+	if err := os.WriteFile(filepath.Join(clone, ".git", cloneMarker), []byte(r.URL+"\n"), 0o600); err != nil {
+		return "", fmt.Errorf("cloning %s: %w", r.Name, err)
+	}
+	if err := os.Rename(clone, dir); err != nil {
+		return "", fmt.Errorf("cloning %s: %w", r.Name, err)
+	}
+	return dir, nil
+}
@@ -0,0 +1,137 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package multirepo
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		in       string
+		name     string
+		url      string
+		cacheDir string
+	}{
+		{"acme/api", "acme/api", "https://github.com/acme/api.git", "github.com/acme/api"},
+		{"https://gitlab.com/acme/platform/web.git", "platform/web", "https://gitlab.com/acme/platform/web.git", "gitlab.com/platform/web"},
+		{"git@github.com:acme/api.git", "acme/api", "git@github.com:acme/api.git", "github.com/acme/api"},
+		{"/srv/git/acme/tools", "acme/tools", "file:///srv/git/acme/tools", "local/acme/tools"},
+	}
+	for _, tt := range tests {
+		r, err := Parse(tt.in)
+		require.NoError(t, err, tt.in)
+		assert.Equal(t, tt.name, r.Name, tt.in)
+		assert.Equal(t, tt.url, r.URL, tt.in)
+		assert.Equal(t, filepath.Join("cache", filepath.FromSlash(tt.cacheDir)), r.Dir("cache"), tt.in)
+	}
+
+	for _, bad := range []string{"api", "https://github.com/api", "acme/..", "-x/y", "a/b/c"} {
+		_, err := Parse(bad)
+		assert.Error(t, err, bad)
+	}
+}
+
+func TestParseList(t *testing.T) {
+	repos, err := ParseList(strings.NewReader("# nightly feed\nacme/api\n\n  acme/web  \nhttps://github.com/acme/api\n"))
+	require.NoError(t, err)
+	require.Len(t, repos, 2)
+	assert.Equal(t, "acme/api", repos[0].Name)
+	assert.Equal(t, "acme/web", repos[1].Name)
+
+	repos, err = ParseList(strings.NewReader("acme/api\nhttps://gitlab.com/acme/api.git\n"))
+	require.NoError(t, err)
+	assert.Len(t, repos, 2, "the same name on another host is another repository")
+
+	_, err = ParseList(strings.NewReader("acme/api\nnot a repo\n"))
+	assert.ErrorContains(t, err, "line 2")
+}
+
+func TestSync(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+	origin := filepath.Join(t.TempDir(), "acme", "api")
+	git(t, "", "init", "--quiet", origin)
+	commitFile(t, origin, "a.go", "package a\n")
+	commitFile(t, origin, "b.go", "package a\n")
+
+	repo, err := Parse(origin)
+	require.NoError(t, err)
+	cache := t.TempDir()
+
+	dir, err := Sync(context.Background(), repo, cache, 1)
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(cache, "local", "acme", "api"), dir)
+	assert.FileExists(t, filepath.Join(dir, "b.go"))
+	assert.Equal(t, "1", strings.TrimSpace(git(t, dir, "rev-list", "--count", "HEAD")), "the clone is shallow")
+
+	commitFile(t, origin, "c.go", "package a\n")
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a.go"), []byte("edited"), 0o600))
+	_, err = Sync(context.Background(), repo, cache, 1)
+	require.NoError(t, err)
+	assert.FileExists(t, filepath.Join(dir, "c.go"), "a second sync fetches new commits")
+	data, err := os.ReadFile(filepath.Join(dir, "a.go"))
+	require.NoError(t, err)
+	assert.Equal(t, "package a\n", string(data), "local changes are discarded")
+
+	gone := Repo{Name: "acme/gone", URL: "file://" + filepath.ToSlash(filepath.Join(t.TempDir(), "gone"))}
+	_, err = Sync(context.Background(), gone, cache, 1)
+	assert.ErrorContains(t, err, "cloning acme/gone")
+	assert.NoDirExists(t, gone.Dir(cache), "a failed clone leaves nothing behind")
+}
+
+func TestSync_RefusesForeignDirectory(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+	origin := filepath.Join(t.TempDir(), "acme", "api")
+	git(t, "", "init", "--quiet", origin)
+	commitFile(t, origin, "a.go", "package a\n")
+	repo, err := Parse(origin)
+	require.NoError(t, err)
+
+	cache := t.TempDir()
+	foreign := repo.Dir(cache)
+	git(t, "", "init", "--quiet", foreign)
+	commitFile(t, foreign, "mine.go", "package mine\n")
+	require.NoError(t, os.WriteFile(filepath.Join(foreign, "mine.go"), []byte("work in progress"), 0o600))
+
+	_, err = Sync(context.Background(), repo, cache, 1)
+	assert.ErrorContains(t, err, "was not cloned by stringer")
+	data, err := os.ReadFile(filepath.Join(foreign, "mine.go"))
+	require.NoError(t, err)
+	assert.Equal(t, "work in progress", string(data), "the working copy is untouched")
+	assert.Empty(t, strings.TrimSpace(git(t, foreign, "remote")), "no remote is added")
+
+	require.NoError(t, os.RemoveAll(foreign))
+	require.NoError(t, os.MkdirAll(foreign, 0o750))
+	_, err = Sync(context.Background(), repo, cache, 1)
+	assert.ErrorContains(t, err, "was not cloned by stringer", "a directory without a clone is not removed")
+	assert.DirExists(t, foreign)
+}
+
+func commitFile(t *testing.T, dir, name, content string) {
+	t.Helper()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600))
+	git(t, dir, "add", name)
+	git(t, dir, "-c", "user.name=Test", "-c", "user.email=test@example.com", "commit", "--quiet", "-m", "add "+name)
+}
+
+func git(t *testing.T, dir string, args ...string) string {
+	t.Helper()
+	cmd := exec.Command("git", args...) //nolint:gosec // test helper with controlled args
+	cmd.Dir = dir
+	out, err := cmd.CombinedOutput()
+	require.NoError(t, err, "git %v: %s", args, out)
+	return string(out)
+}
@@ -0,0 +1,16 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+//go:build nonetwork
+
+package multirepo
+
+import (
+	"context"
+	"errors"
+)
+
+// DiscoverGitHubOrg fails in builds with the nonetwork tag.
+func DiscoverGitHubOrg(context.Context, string) ([]Repo, error) {
+	return nil, errors.New("stringer was built without network support (nonetwork tag)")
+}
@@ -12,6 +12,8 @@ import (
 	"time"
 
 	"github.com/davetashner/stringer/internal/beads"
+	"github.com/davetashner/stringer/internal/estimate"
+	"github.com/davetashner/stringer/internal/labels"
 	"github.com/davetashner/stringer/internal/signal"
 )
 
@@ -31,6 +33,15 @@ type beadRecord struct {
 	CloseReason string   `json:"close_reason,omitempty"`
 	Blocks      []string `json:"blocks,omitempty"`
 	DependsOn   []string `json:"depends_on,omitempty"`
+	Children    []string `json:"children,omitempty"`
+
+	// EstimatedMinutes is the nominal duration of the signal's effort
+	// bucket, for bd's estimate field.
+	EstimatedMinutes int `json:"estimated_minutes,omitempty"`
+
+	// Owners are the CODEOWNERS owners of the signal's file, so the issue
+	// can be assigned downstream.
+	Owners []string `json:"owners,omitempty"`
 }
 
 func init() {
@@ -40,10 +51,12 @@ func init() {
 // BeadsFormatter writes signals as Beads-compatible JSONL.
 type BeadsFormatter struct {
 	conventions *beads.Conventions
+	labelMap    labels.Map
 }
 
-// Compile-time interface check.
+// Compile-time interface checks.
 var _ Formatter = (*BeadsFormatter)(nil)
+var _ StreamFormatter = (*BeadsFormatter)(nil)
 
 // NewBeadsFormatter returns a new BeadsFormatter.
 func NewBeadsFormatter() *BeadsFormatter {
@@ -56,6 +69,12 @@ func (b *BeadsFormatter) SetConventions(c *beads.Conventions) {
 	b.conventions = c
 }
 
+// SetLabelMap configures the label rules applied to each bead. Passing nil
+// removes them.
+func (b *BeadsFormatter) SetLabelMap(m labels.Map) {
+	b.labelMap = m
+}
+
 // Name returns the format name.
 func (b *BeadsFormatter) Name() string {
 	return "beads"
@@ -65,27 +84,49 @@ func (b *BeadsFormatter) Name() string {
 // Each line is valid JSON parseable by `bd import`.
 func (b *BeadsFormatter) Format(signals []signal.RawSignal, w io.Writer) error {
 	for i, sig := range signals {
-		rec := b.signalToBead(sig)
-		data, err := json.Marshal(rec)
-		if err != nil {
-			return fmt.Errorf("marshal signal %d: %w", i, err)
+		if err := b.writeBead(w, i, sig); err != nil {
+			return err
 		}
-		if _, err := w.Write(data); err != nil {
-			return fmt.Errorf("write signal %d: %w", i, err)
-		}
-		if _, err := w.Write([]byte("\n")); err != nil {
-			return fmt.Errorf("write newline %d: %w", i, err)
+	}
+	return nil
+}
+
+// FormatStream writes each signal received from signals as a line of JSON,
+// as Format does.
+func (b *BeadsFormatter) FormatStream(signals <-chan signal.RawSignal, w io.Writer) error {
+	i := 0
+	for sig := range signals {
+		if err := b.writeBead(w, i, sig); err != nil {
+			return err
 		}
+		i++
+	}
+	return nil
+}
+
+// writeBead writes signal i as one JSONL line.
+func (b *BeadsFormatter) writeBead(w io.Writer, i int, sig signal.RawSignal) error {
+	data, err := b.Bead(sig)
+	if err != nil {
+		return fmt.Errorf("marshal signal %d: %w", i, err)
+	}
+	if _, err := w.Write(data); err != nil {
+		return fmt.Errorf("write signal %d: %w", i, err)
+	}
+	if _, err := w.Write([]byte("\n")); err != nil {
+		return fmt.Errorf("write newline %d: %w", i, err)
 	}
 	return nil
 }
 
+// Bead returns the JSON object Format writes for sig, without the newline.
+func (b *BeadsFormatter) Bead(sig signal.RawSignal) ([]byte, error) {
+	return json.Marshal(b.signalToBead(sig))
+}
+
 // signalToBead converts a RawSignal into a beadRecord.
 func (b *BeadsFormatter) signalToBead(sig signal.RawSignal) beadRecord {
-	priority := mapConfidenceToPriority(sig.Confidence)
-	if sig.Priority != nil {
-		priority = *sig.Priority
-	}
+	priority := signal.EffectivePriority(sig)
 
 	rec := beadRecord{
 		ID:          b.generateID(sig),
@@ -99,6 +140,10 @@ func (b *BeadsFormatter) signalToBead(sig signal.RawSignal) beadRecord {
 		Labels:      b.buildLabels(sig),
 		Blocks:      sig.Blocks,
 		DependsOn:   sig.DependsOn,
+		Children:    sig.Children,
+
+		EstimatedMinutes: estimate.Minutes(sig.Effort),
+		Owners:           sig.Owners,
 	}
 
 	if hasTag(sig.Tags, "pre-closed") {
@@ -123,11 +168,11 @@ func hasTag(tags []string, tag string) bool {
 // deriveCloseReason maps a signal Kind to a human-readable close reason.
 func deriveCloseReason(kind string) string {
 	switch kind {
-	case "github-merged-pr":
+	case "github-merged-pr", "gitlab-merged-mr":
 		return "merged"
-	case "github-closed-pr":
+	case "github-closed-pr", "gitlab-closed-mr":
 		return "closed"
-	case "github-closed-issue":
+	case "github-closed-issue", "gitlab-closed-issue":
 		return "completed"
 	default:
 		return "resolved"
@@ -154,35 +199,24 @@ func mapKindToType(kind string) string {
 	switch strings.ToLower(kind) {
 	case "bug", "fixme":
 		return "bug"
-	case "github-bug":
+	case "github-bug", "gitlab-bug":
 		return "bug"
 	case "todo":
 		return "task"
+	case "epic":
+		return "epic"
 	case "hack", "xxx", "optimize", "low-lottery-risk":
 		return "chore"
 	case "github-feature", "github-issue", "github-pr-changes", "github-pr-approved", "github-pr-pending", "github-review-todo",
-		"github-closed-issue", "github-merged-pr", "github-closed-pr":
+		"github-closed-issue", "github-merged-pr", "github-closed-pr",
+		"gitlab-feature", "gitlab-issue", "gitlab-mr-changes", "gitlab-mr-approved", "gitlab-mr-pending", "gitlab-review-todo",
+		"gitlab-closed-issue", "gitlab-merged-mr", "gitlab-closed-mr":
 		return "task"
 	default:
 		return "task"
 	}
 }
 
-// mapConfidenceToPriority derives bead priority from signal confidence.
-// >=0.8 -> P1, >=0.6 -> P2, >=0.4 -> P3, else P4.
-func mapConfidenceToPriority(confidence float64) int {
-	switch {
-	case confidence >= 0.8:
-		return 1
-	case confidence >= 0.6:
-		return 2
-	case confidence >= 0.4:
-		return 3
-	default:
-		return 4
-	}
-}
-
 // formatTimestamp formats a time.Time as ISO 8601.
 // Returns empty string for zero time.
 func formatTimestamp(t time.Time) string {
@@ -216,23 +250,31 @@ func buildDescription(sig signal.RawSignal) string {
 		parts = append(parts, fmt.Sprintf("Location: %s", loc))
 	}
 
+	if sig.URL != "" {
+		parts = append(parts, fmt.Sprintf("URL: %s", sig.URL))
+	}
+
 	return strings.Join(parts, "\n\n")
 }
 
-// buildLabels combines signal tags with standard stringer labels.
+// buildLabels combines signal tags with standard stringer labels and the
+// configured label rules.
 func (b *BeadsFormatter) buildLabels(sig signal.RawSignal) []string {
-	labels := make([]string, 0, len(sig.Tags)+2)
-	labels = append(labels, sig.Tags...)
+	out := make([]string, 0, len(sig.Tags)+2)
+	out = append(out, sig.Tags...)
 	generatedLabel := "stringer-generated"
 	if b.conventions != nil && b.conventions.LabelStyle == "snake_case" {
 		generatedLabel = "stringer_generated"
 	}
-	labels = append(labels, generatedLabel)
-	if sig.Source != "" && !slices.Contains(labels, sig.Source) {
-		labels = append(labels, sig.Source)
+	out = append(out, generatedLabel)
+	if sig.Source != "" && !slices.Contains(out, sig.Source) {
+		out = append(out, sig.Source)
 	}
 	if sig.Workspace != "" {
-		labels = append(labels, "workspace:"+sig.Workspace)
+		out = append(out, "workspace:"+sig.Workspace)
+	}
+	if sig.Repo != "" {
+		out = append(out, "repo:"+sig.Repo)
 	}
-	return labels
+	return b.labelMap.Append(labels.Beads, sig, out)
 }
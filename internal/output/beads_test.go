@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/davetashner/stringer/internal/beads"
+	"github.com/davetashner/stringer/internal/labels"
 	"github.com/davetashner/stringer/internal/signal"
 )
 
@@ -118,6 +119,7 @@ func TestKindToTypeMapping(t *testing.T) {
 		{"github-bug", "bug"},
 		{"todo", "task"},
 		{"TODO", "task"},
+		{"epic", "epic"},
 		{"hack", "chore"},
 		{"HACK", "chore"},
 		{"xxx", "chore"},
@@ -144,34 +146,6 @@ func TestKindToTypeMapping(t *testing.T) {
 	}
 }
 
-func TestConfidenceToPriority(t *testing.T) {
-	cases := []struct {
-		confidence float64
-		wantP      int
-	}{
-		{1.0, 1},
-		{0.9, 1},
-		{0.8, 1},
-		{0.79, 2},
-		{0.7, 2},
-		{0.6, 2},
-		{0.59, 3},
-		{0.5, 3},
-		{0.4, 3},
-		{0.39, 4},
-		{0.2, 4},
-		{0.0, 4},
-	}
-	for _, tc := range cases {
-		t.Run("", func(t *testing.T) {
-			got := mapConfidenceToPriority(tc.confidence)
-			if got != tc.wantP {
-				t.Errorf("mapConfidenceToPriority(%v) = %d, want %d", tc.confidence, got, tc.wantP)
-			}
-		})
-	}
-}
-
 func TestIDDeterminism(t *testing.T) {
 	sig := testSignal()
 
@@ -429,6 +403,18 @@ func TestDescriptionVariants(t *testing.T) {
 			t.Errorf("Description = %q, want %q", rec.Description, want)
 		}
 	})
+	t.Run("url", func(t *testing.T) {
+		sig := signal.RawSignal{
+			FilePath: "api.go",
+			Line:     100,
+			URL:      "https://github.com/o/r/blob/abc123/api.go#L100",
+		}
+		rec := NewBeadsFormatter().signalToBead(sig)
+		want := "Location: api.go:100\n\nURL: https://github.com/o/r/blob/abc123/api.go#L100"
+		if rec.Description != want {
+			t.Errorf("Description = %q, want %q", rec.Description, want)
+		}
+	})
 }
 
 func TestLabelsWithNoSource(t *testing.T) {
@@ -821,6 +807,43 @@ func TestPreClosedSignal_StatusClosed(t *testing.T) {
 	}
 }
 
+func TestEpicSignal_Children(t *testing.T) {
+	sig := signal.RawSignal{
+		Source:     "todos",
+		Kind:       "epic",
+		FilePath:   "internal/collectors",
+		Title:      `Epic: TODOs mentioning "retry logic"`,
+		Confidence: 0.5,
+		Children:   []string{"str-aaaa1111", "str-bbbb2222"},
+	}
+
+	var buf bytes.Buffer
+	if err := NewBeadsFormatter().Format([]signal.RawSignal{sig}, &buf); err != nil {
+		t.Fatalf("Format: %v", err)
+	}
+	var rec map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &rec); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if rec["type"] != "epic" {
+		t.Errorf("type = %v, want epic", rec["type"])
+	}
+	children, _ := rec["children"].([]any)
+	if len(children) != 2 || children[0] != "str-aaaa1111" {
+		t.Errorf("children = %v, want [str-aaaa1111 str-bbbb2222]", rec["children"])
+	}
+
+	// Signals without children omit the field.
+	buf.Reset()
+	sig.Children = nil
+	if err := NewBeadsFormatter().Format([]signal.RawSignal{sig}, &buf); err != nil {
+		t.Fatalf("Format: %v", err)
+	}
+	if strings.Contains(buf.String(), "children") {
+		t.Errorf("children should be omitted: %s", buf.String())
+	}
+}
+
 func TestPreClosedSignal_MergedPR(t *testing.T) {
 	sig := signal.RawSignal{
 		Source:     "github",
@@ -1118,3 +1141,106 @@ func TestBeadsFormatter_NoWorkspaceNoScopeInID(t *testing.T) {
 		}
 	}
 }
+
+func TestBeadsFormatter_LabelMap(t *testing.T) {
+	f := NewBeadsFormatter()
+	f.SetLabelMap(labels.Map{
+		{Tags: []string{"security"}, Labels: []string{"security", "sec-review"}},
+		{Collectors: []string{"todos"}, Labels: []string{"todo"}, Exporters: map[string][]string{labels.Beads: {"tech-debt"}}},
+	})
+
+	var buf bytes.Buffer
+	if err := f.Format([]signal.RawSignal{testSignal()}, &buf); err != nil {
+		t.Fatalf("Format() error: %v", err)
+	}
+	var rec struct {
+		Labels []string `json:"labels"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &rec); err != nil {
+		t.Fatalf("unmarshal error: %v", err)
+	}
+	want := []string{"security", "performance", "stringer-generated", "todos", "sec-review", "tech-debt"}
+	if strings.Join(rec.Labels, ",") != strings.Join(want, ",") {
+		t.Errorf("labels = %v, want %v", rec.Labels, want)
+	}
+
+	// Clearing the map restores the default labels.
+	f.SetLabelMap(nil)
+	if got := f.buildLabels(testSignal()); len(got) != 4 {
+		t.Errorf("labels after reset = %v, want 4 defaults", got)
+	}
+}
+
+func TestEstimatedMinutes(t *testing.T) {
+	tests := []struct {
+		effort string
+		want   any
+	}{
+		{"S", float64(60)},
+		{"M", float64(240)},
+		{"L", float64(960)},
+		{"", nil},
+	}
+	for _, tt := range tests {
+		sig := signal.RawSignal{Source: "todos", Kind: "todo", Title: "Fix it", Effort: tt.effort}
+		var buf bytes.Buffer
+		if err := NewBeadsFormatter().Format([]signal.RawSignal{sig}, &buf); err != nil {
+			t.Fatalf("Format: %v", err)
+		}
+		var rec map[string]any
+		if err := json.Unmarshal(buf.Bytes(), &rec); err != nil {
+			t.Fatalf("unmarshal: %v", err)
+		}
+		if rec["estimated_minutes"] != tt.want {
+			t.Errorf("effort %q: estimated_minutes = %v, want %v", tt.effort, rec["estimated_minutes"], tt.want)
+		}
+	}
+}
+
+func TestBeadsOwners(t *testing.T) {
+	sig := signal.RawSignal{Source: "todos", Kind: "todo", Title: "Fix it", FilePath: "api/h.go", Owners: []string{"@org/api", "dev@example.com"}}
+	var buf bytes.Buffer
+	if err := NewBeadsFormatter().Format([]signal.RawSignal{sig}, &buf); err != nil {
+		t.Fatalf("Format: %v", err)
+	}
+	var rec beadRecord
+	if err := json.Unmarshal(buf.Bytes(), &rec); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if len(rec.Owners) != 2 || rec.Owners[0] != "@org/api" || rec.Owners[1] != "dev@example.com" {
+		t.Errorf("owners = %v, want [@org/api dev@example.com]", rec.Owners)
+	}
+
+	sig.Owners = nil
+	buf.Reset()
+	if err := NewBeadsFormatter().Format([]signal.RawSignal{sig}, &buf); err != nil {
+		t.Fatalf("Format: %v", err)
+	}
+	if bytes.Contains(buf.Bytes(), []byte(`"owners"`)) {
+		t.Errorf("owners should be omitted when empty: %s", buf.String())
+	}
+}
+
+func TestBeadsFormatter_FormatStreamMatchesFormat(t *testing.T) {
+	second := testSignal()
+	second.Line = 7
+	sigs := []signal.RawSignal{testSignal(), second}
+
+	var want bytes.Buffer
+	if err := NewBeadsFormatter().Format(sigs, &want); err != nil {
+		t.Fatalf("Format: %v", err)
+	}
+
+	ch := make(chan signal.RawSignal, len(sigs))
+	for _, sig := range sigs {
+		ch <- sig
+	}
+	close(ch)
+	var got bytes.Buffer
+	if err := NewBeadsFormatter().FormatStream(ch, &got); err != nil {
+		t.Fatalf("FormatStream: %v", err)
+	}
+	if got.String() != want.String() {
+		t.Errorf("FormatStream output differs from Format:\ngot:  %s\nwant: %s", got.String(), want.String())
+	}
+}
@@ -0,0 +1,223 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package output
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+func init() {
+	RegisterFormatter(NewCSVFormatter())
+}
+
+// DefaultCSVColumns are the columns the csv format writes unless others are
+// configured.
+var DefaultCSVColumns = []string{"id", "kind", "confidence", "path", "line", "title", "author", "age", "module", "tags"}
+
+// csvColumns maps every column name to the function that renders its cell.
+// now is the time ages are measured from.
+var csvColumns = map[string]func(sig signal.RawSignal, now time.Time) string{
+	"id":             func(sig signal.RawSignal, _ time.Time) string { return SignalID(sig, "str-") },
+	"kind":           func(sig signal.RawSignal, _ time.Time) string { return sig.Kind },
+	"collector":      func(sig signal.RawSignal, _ time.Time) string { return sig.Source },
+	"confidence":     func(sig signal.RawSignal, _ time.Time) string { return strconv.FormatFloat(sig.Confidence, 'f', 2, 64) },
+	"priority":       func(sig signal.RawSignal, _ time.Time) string { return strconv.Itoa(signal.EffectivePriority(sig)) },
+	"path":           func(sig signal.RawSignal, _ time.Time) string { return sig.FilePath },
+	"line":           func(sig signal.RawSignal, _ time.Time) string { return csvInt(sig.Line) },
+	"title":          func(sig signal.RawSignal, _ time.Time) string { return sig.Title },
+	"description":    func(sig signal.RawSignal, _ time.Time) string { return sig.Description },
+	"author":         func(sig signal.RawSignal, _ time.Time) string { return sig.Author },
+	"function_owner": func(sig signal.RawSignal, _ time.Time) string { return sig.FunctionOwner },
+	"age":            csvAge,
+	"module":         func(sig signal.RawSignal, _ time.Time) string { return SignalModule(sig.FilePath) },
+	"repo":           func(sig signal.RawSignal, _ time.Time) string { return sig.Repo },
+	"tags":           func(sig signal.RawSignal, _ time.Time) string { return strings.Join(sig.Tags, ",") },
+	"owners":         func(sig signal.RawSignal, _ time.Time) string { return strings.Join(sig.Owners, ",") },
+	"effort":         func(sig signal.RawSignal, _ time.Time) string { return sig.Effort },
+	"url":            func(sig signal.RawSignal, _ time.Time) string { return sig.URL },
+}
+
+// CSVColumnNames returns every column the csv format can write, sorted.
+func CSVColumnNames() []string {
+	names := make([]string, 0, len(csvColumns))
+	for name := range csvColumns {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// ParseCSVColumns splits a comma-separated column list, trimming and
+// lowercasing each name. It fails on unknown, duplicate, or no columns.
+func ParseCSVColumns(s string) ([]string, error) {
+	return ValidateCSVColumns(strings.Split(s, ","))
+}
+
+// ValidateCSVColumns returns cols trimmed and lowercased, or an error naming
+// the first unknown or repeated column.
+func ValidateCSVColumns(cols []string) ([]string, error) {
+	out := make([]string, 0, len(cols))
+	for _, c := range cols {
+		c = strings.ToLower(strings.TrimSpace(c))
+		if c == "" {
+			continue
+		}
+		if _, ok := csvColumns[c]; !ok {
+			return nil, fmt.Errorf("unknown column %q (available: %s)", c, strings.Join(CSVColumnNames(), ", "))
+		}
+		if slices.Contains(out, c) {
+			return nil, fmt.Errorf("column %q listed twice", c)
+		}
+		out = append(out, c)
+	}
+	if len(out) == 0 {
+		return nil, fmt.Errorf("no columns given")
+	}
+	return out, nil
+}
+
+// CSVFormatter writes signals as RFC 4180 CSV for spreadsheets: a header
+// row, then one row per signal. Cells that a spreadsheet would evaluate as
+// a formula are prefixed with an apostrophe.
+type CSVFormatter struct {
+	columns []string
+
+	// nowFunc is used for testing to override the current time.
+	nowFunc func() time.Time
+}
+
+// Compile-time interface checks.
+var _ Formatter = (*CSVFormatter)(nil)
+var _ StreamFormatter = (*CSVFormatter)(nil)
+
+// NewCSVFormatter returns a CSVFormatter writing DefaultCSVColumns.
+func NewCSVFormatter() *CSVFormatter {
+	return &CSVFormatter{}
+}
+
+// Name returns the format name.
+func (f *CSVFormatter) Name() string {
+	return "csv"
+}
+
+// SetColumns selects the columns to write, in order. Passing nil restores
+// DefaultCSVColumns.
+func (f *CSVFormatter) SetColumns(cols []string) error {
+	if cols == nil {
+		f.columns = nil
+		return nil
+	}
+	valid, err := ValidateCSVColumns(cols)
+	if err != nil {
+		return err
+	}
+	f.columns = valid
+	return nil
+}
+
+// Format writes the header row and one row per signal to w.
+func (f *CSVFormatter) Format(signals []signal.RawSignal, w io.Writer) error {
+	return f.write(w, func(row func(signal.RawSignal) error) error {
+		for _, sig := range signals {
+			if err := row(sig); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// FormatStream writes the header row, then a row for each signal received
+// from signals, to w.
+func (f *CSVFormatter) FormatStream(signals <-chan signal.RawSignal, w io.Writer) error {
+	return f.write(w, func(row func(signal.RawSignal) error) error {
+		for sig := range signals {
+			if err := row(sig); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// write writes the header row, then calls rows with the function that
+// writes one signal's row.
+func (f *CSVFormatter) write(w io.Writer, rows func(row func(signal.RawSignal) error) error) error {
+	cols := f.columns
+	if cols == nil {
+		cols = DefaultCSVColumns
+	}
+	now := time.Now()
+	if f.nowFunc != nil {
+		now = f.nowFunc()
+	}
+
+	cw := csv.NewWriter(w)
+	cw.UseCRLF = true
+	if err := cw.Write(cols); err != nil {
+		return fmt.Errorf("write csv header: %w", err)
+	}
+	cells := make([]string, len(cols))
+	err := rows(func(sig signal.RawSignal) error {
+		for i, c := range cols {
+			cells[i] = csvSafe(csvColumns[c](sig, now))
+		}
+		if err := cw.Write(cells); err != nil {
+			return fmt.Errorf("write csv row: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	cw.Flush()
+	if err := cw.Error(); err != nil {
+		return fmt.Errorf("write csv: %w", err)
+	}
+	return nil
+}
+
+// csvAge returns the signal's age in whole days, or "" when it has no
+// timestamp.
+func csvAge(sig signal.RawSignal, now time.Time) string {
+	if sig.Timestamp.IsZero() {
+		return ""
+	}
+	return strconv.Itoa(max(int(now.Sub(sig.Timestamp).Hours()/24), 0))
+}
+
+// csvInt returns n, or "" for zero.
+func csvInt(n int) string {
+	if n == 0 {
+		return ""
+	}
+	return strconv.Itoa(n)
+}
+
+// csvSafe guards against formula injection: spreadsheets evaluate a cell
+// starting with =, +, -, or @ (or a tab or carriage return before one), so
+// a title such as "=HYPERLINK(...)" from a code comment would run when the
+// file is opened. Such cells are prefixed with an apostrophe, which
+// spreadsheets hide and treat as "this is text". Numbers are left alone.
+func csvSafe(s string) string {
+	if s == "" {
+		return s
+	}
+	switch s[0] {
+	case '=', '+', '-', '@', '\t', '\r':
+		if _, err := strconv.ParseFloat(s, 64); err == nil {
+			return s
+		}
+		return "'" + s
+	}
+	return s
+}
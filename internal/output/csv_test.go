@@ -0,0 +1,188 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package output
+
+import (
+	"bytes"
+	"encoding/csv"
+	"testing"
+	"time"
+
+	"github.com/davetashner/stringer/internal/signal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var csvTestNow = time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+func newTestCSVFormatter() *CSVFormatter {
+	f := NewCSVFormatter()
+	f.nowFunc = func() time.Time { return csvTestNow }
+	return f
+}
+
+// readCSV parses out back into records, failing the test on malformed CSV.
+func readCSV(t *testing.T, out []byte) [][]string {
+	t.Helper()
+	records, err := csv.NewReader(bytes.NewReader(out)).ReadAll()
+	require.NoError(t, err)
+	return records
+}
+
+func TestCSVFormatter_Registration(t *testing.T) {
+	f, err := GetFormatter("csv")
+	require.NoError(t, err)
+	assert.Equal(t, "csv", f.Name())
+}
+
+func TestCSVFormatter_DefaultColumns(t *testing.T) {
+	sig := signal.RawSignal{
+		Source:     "todos",
+		Kind:       "todo",
+		FilePath:   "internal/a.go",
+		Line:       12,
+		Title:      "TODO: handle retries",
+		Author:     "alice",
+		Timestamp:  csvTestNow.Add(-10 * 24 * time.Hour),
+		Confidence: 0.8,
+		Workspace:  "api", // the module comes from the path, not the workspace
+		Tags:       []string{"todo", "stringer-generated"},
+	}
+	var buf bytes.Buffer
+	require.NoError(t, newTestCSVFormatter().Format([]signal.RawSignal{sig}, &buf))
+
+	records := readCSV(t, buf.Bytes())
+	require.Len(t, records, 2)
+	assert.Equal(t, DefaultCSVColumns, records[0])
+	assert.Equal(t, []string{
+		SignalID(sig, "str-"), "todo", "0.80", "internal/a.go", "12", "TODO: handle retries",
+		"alice", "10", "internal", "todo,stringer-generated",
+	}, records[1])
+	assert.Contains(t, buf.String(), "\r\n", "rows end with CRLF per RFC 4180")
+}
+
+func TestCSVFormatter_CommasQuotesAndNewlines(t *testing.T) {
+	signals := []signal.RawSignal{
+		{Kind: "todo", Title: "TODO: split on commas, then trim"},
+		{Kind: "todo", Title: `FIXME: "quoted" value`},
+		{Kind: "todo", Title: "HACK: first line\nsecond line"},
+	}
+	f := newTestCSVFormatter()
+	require.NoError(t, f.SetColumns([]string{"title", "kind"}))
+	var buf bytes.Buffer
+	require.NoError(t, f.Format(signals, &buf))
+
+	assert.Contains(t, buf.String(), `"TODO: split on commas, then trim",todo`)
+	assert.Contains(t, buf.String(), `"FIXME: ""quoted"" value",todo`)
+	records := readCSV(t, buf.Bytes())
+	require.Len(t, records, 4)
+	assert.Equal(t, []string{"TODO: split on commas, then trim", "todo"}, records[1])
+	assert.Equal(t, []string{`FIXME: "quoted" value`, "todo"}, records[2])
+	assert.Contains(t, buf.String(), "\"HACK: first line\r\nsecond line\",todo")
+	assert.Equal(t, "HACK: first line\nsecond line", records[3][0], "embedded newlines stay inside the quoted cell")
+}
+
+func TestCSVFormatter_FormulaInjection(t *testing.T) {
+	signals := []signal.RawSignal{
+		{Kind: "todo", Title: `=HYPERLINK("http://evil.example","click")`},
+		{Kind: "todo", Title: "+1 for this", Author: "@bob"},
+		{Kind: "todo", Title: "-0.5", Author: "-"},
+		{Kind: "todo", Title: "plain = fine"},
+	}
+	f := newTestCSVFormatter()
+	require.NoError(t, f.SetColumns([]string{"title", "author"}))
+	var buf bytes.Buffer
+	require.NoError(t, f.Format(signals, &buf))
+
+	records := readCSV(t, buf.Bytes())
+	assert.Equal(t, `'=HYPERLINK("http://evil.example","click")`, records[1][0])
+	assert.Equal(t, []string{"'+1 for this", "'@bob"}, records[2])
+	assert.Equal(t, []string{"-0.5", "'-"}, records[3], "numbers are left alone")
+	assert.Equal(t, "plain = fine", records[4][0])
+}
+
+func TestCSVFormatter_EmptyCells(t *testing.T) {
+	f := newTestCSVFormatter()
+	require.NoError(t, f.SetColumns([]string{"line", "age", "module", "tags"}))
+	var buf bytes.Buffer
+	require.NoError(t, f.Format([]signal.RawSignal{{Kind: "churn", Title: "a"}}, &buf))
+
+	records := readCSV(t, buf.Bytes())
+	assert.Equal(t, []string{"", "", "", ""}, records[1])
+}
+
+func TestCSVFormatter_AllColumns(t *testing.T) {
+	p := 2
+	sig := signal.RawSignal{
+		Source: "todos", Kind: "todo", Title: "t", Description: "d", Priority: &p,
+		Owners: []string{"@a", "@b"}, Effort: "S", URL: "https://example.com/x",
+	}
+	f := newTestCSVFormatter()
+	require.NoError(t, f.SetColumns([]string{"collector", "priority", "description", "owners", "effort", "url"}))
+	var buf bytes.Buffer
+	require.NoError(t, f.Format([]signal.RawSignal{sig}, &buf))
+
+	records := readCSV(t, buf.Bytes())
+	// Owners starting with @ are guarded like any other cell.
+	assert.Equal(t, []string{"todos", "2", "d", "'@a,@b", "S", "https://example.com/x"}, records[1])
+}
+
+func TestCSVFormatter_NoSignals(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, newTestCSVFormatter().Format(nil, &buf))
+	assert.Equal(t, "id,kind,confidence,path,line,title,author,age,module,tags\r\n", buf.String())
+}
+
+func TestCSVFormatter_SetColumns(t *testing.T) {
+	f := newTestCSVFormatter()
+	require.NoError(t, f.SetColumns([]string{" Title ", "ID"}))
+	assert.Equal(t, []string{"title", "id"}, f.columns)
+
+	require.Error(t, f.SetColumns([]string{"severity"}))
+	assert.Equal(t, []string{"title", "id"}, f.columns, "a rejected selection leaves the columns unchanged")
+
+	require.NoError(t, f.SetColumns(nil))
+	assert.Nil(t, f.columns)
+}
+
+func TestParseCSVColumns(t *testing.T) {
+	cols, err := ParseCSVColumns("id, kind ,,title")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"id", "kind", "title"}, cols)
+
+	_, err = ParseCSVColumns("id,severity")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `unknown column "severity"`)
+	assert.Contains(t, err.Error(), "available: age, author, collector")
+
+	_, err = ParseCSVColumns("id,ID")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `column "id" listed twice`)
+
+	_, err = ParseCSVColumns(" , ")
+	require.Error(t, err)
+}
+
+func TestCSVFormatter_WriteError(t *testing.T) {
+	err := newTestCSVFormatter().Format([]signal.RawSignal{{Kind: "todo", Title: "a"}}, &failWriter{})
+	assert.Error(t, err)
+}
+
+func TestCSVFormatter_FormatStreamMatchesFormat(t *testing.T) {
+	sigs := []signal.RawSignal{
+		{Source: "todos", Kind: "todo", FilePath: "a.go", Line: 1, Title: "TODO: one", Confidence: 0.5},
+		{Source: "todos", Kind: "fixme", FilePath: "b.go", Line: 2, Title: "=cmd()", Confidence: 0.7},
+	}
+	var want bytes.Buffer
+	require.NoError(t, newTestCSVFormatter().Format(sigs, &want))
+
+	ch := make(chan signal.RawSignal, len(sigs))
+	for _, sig := range sigs {
+		ch <- sig
+	}
+	close(ch)
+	var got bytes.Buffer
+	require.NoError(t, newTestCSVFormatter().FormatStream(ch, &got))
+	assert.Equal(t, want.String(), got.String())
+}
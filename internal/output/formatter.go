@@ -11,6 +11,9 @@ import (
 	"sort"
 	"sync"
 
+	"github.com/davetashner/stringer/internal/coverage"
+	"github.com/davetashner/stringer/internal/i18n"
+	"github.com/davetashner/stringer/internal/labels"
 	"github.com/davetashner/stringer/internal/signal"
 )
 
@@ -23,6 +26,18 @@ type Formatter interface {
 	Format(signals []signal.RawSignal, w io.Writer) error
 }
 
+// StreamFormatter is implemented by formatters that can write signals one
+// at a time, as a streaming scan produces them, instead of needing them all
+// in memory first.
+type StreamFormatter interface {
+	Formatter
+
+	// FormatStream writes each signal received from signals to w and
+	// returns once signals is closed. The output is what Format writes
+	// for the same signals.
+	FormatStream(signals <-chan signal.RawSignal, w io.Writer) error
+}
+
 // DirectoryFormatter extends Formatter for formats that produce a directory
 // of files (e.g., index.html + assets/) instead of a single stream.
 type DirectoryFormatter interface {
@@ -30,6 +45,30 @@ type DirectoryFormatter interface {
 	FormatDir(signals []signal.RawSignal, dir string) error
 }
 
+// LabelMapper is implemented by formatters that attach configured labels
+// to each signal.
+type LabelMapper interface {
+	SetLabelMap(m labels.Map)
+}
+
+// Localizer is implemented by formatters whose headings and summary text
+// can be translated. Signal titles and descriptions are left as they are.
+type Localizer interface {
+	SetCatalog(c *i18n.Catalog)
+}
+
+// QuadrantReporter is implemented by formatters that show where files fall
+// on the churn × coverage grid built from --coverage reports.
+type QuadrantReporter interface {
+	SetQuadrants(q *coverage.Quadrants)
+}
+
+// ResultsReporter is implemented by formatters that report which collectors
+// failed and why, alongside the signals.
+type ResultsReporter interface {
+	SetResults(results []signal.CollectorResult)
+}
+
 var (
 	fmtMu       sync.RWMutex
 	fmtRegistry = make(map[string]Formatter)
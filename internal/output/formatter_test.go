@@ -37,10 +37,15 @@ func TestFormatterInterface(t *testing.T) {
 func restoreFormatters() {
 	resetFmtForTesting()
 	RegisterFormatter(NewBeadsFormatter())
+	RegisterFormatter(NewCSVFormatter())
+	RegisterFormatter(NewJUnitFormatter())
+	RegisterFormatter(NewRDJSONFormatter())
+	RegisterFormatter(NewGitHubIssuesFormatter())
 	RegisterFormatter(NewHTMLFormatter())
 	RegisterFormatter(NewHTMLDirFormatter())
 	RegisterFormatter(NewJSONFormatter())
 	RegisterFormatter(NewMarkdownFormatter())
+	RegisterFormatter(NewPRCommentFormatter())
 	RegisterFormatter(NewSARIFFormatter())
 	RegisterFormatter(NewTasksFormatter())
 }
@@ -0,0 +1,175 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/davetashner/stringer/internal/labels"
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+func init() {
+	RegisterFormatter(NewGitHubIssuesFormatter())
+}
+
+// DefaultGitHubIssueLabel is the label every exported GitHub issue carries,
+// the same one beads output gives stringer's beads.
+const DefaultGitHubIssueLabel = "stringer-generated"
+
+// issueMarkerPattern matches the marker IssueMarker writes.
+var issueMarkerPattern = regexp.MustCompile(`<!-- stringer:id=(str-[0-9a-f]{8}) -->`)
+
+// IssueMarker returns the hidden HTML comment that ends every exported
+// issue body. It records the signal ID so a later export updates the issue
+// instead of filing a duplicate.
+func IssueMarker(id string) string {
+	return "<!-- stringer:id=" + id + " -->"
+}
+
+// IssueMarkerID returns the signal ID recorded by IssueMarker in body, or ""
+// when body has no marker.
+func IssueMarkerID(body string) string {
+	if m := issueMarkerPattern.FindStringSubmatch(body); m != nil {
+		return m[1]
+	}
+	return ""
+}
+
+// GitHubIssue is an issue to file for a signal.
+type GitHubIssue struct {
+	ID     string   `json:"id"`
+	Title  string   `json:"title"`
+	Body   string   `json:"body"`
+	Labels []string `json:"labels"`
+}
+
+// GitHubIssuesFormatter writes one GitHub issue per open signal as JSON
+// Lines, in the shape `stringer export github` files them. Each issue is
+// labeled with Label and the configured github label rules; a signal no
+// rule matches is labeled with its kind.
+type GitHubIssuesFormatter struct {
+	// Label marks every issue as stringer's. Empty means
+	// DefaultGitHubIssueLabel.
+	Label string
+
+	labelMap labels.Map
+}
+
+// Compile-time interface checks.
+var (
+	_ Formatter   = (*GitHubIssuesFormatter)(nil)
+	_ LabelMapper = (*GitHubIssuesFormatter)(nil)
+)
+
+// NewGitHubIssuesFormatter returns a new GitHubIssuesFormatter.
+func NewGitHubIssuesFormatter() *GitHubIssuesFormatter {
+	return &GitHubIssuesFormatter{}
+}
+
+// Name returns the format name.
+func (f *GitHubIssuesFormatter) Name() string {
+	return "github-issues"
+}
+
+// SetLabelMap configures the label rules applied to each issue. Passing nil
+// removes them.
+func (f *GitHubIssuesFormatter) SetLabelMap(m labels.Map) {
+	f.labelMap = m
+}
+
+// Format writes an issue for every open signal to w, one JSON object per
+// line. Closed signals are skipped: there is no work left to file.
+func (f *GitHubIssuesFormatter) Format(signals []signal.RawSignal, w io.Writer) error {
+	for _, sig := range signals {
+		if !sig.ClosedAt.IsZero() {
+			continue
+		}
+		data, err := json.Marshal(f.Issue(sig))
+		if err != nil {
+			return fmt.Errorf("marshal issue: %w", err)
+		}
+		if _, err := fmt.Fprintf(w, "%s\n", data); err != nil {
+			return fmt.Errorf("write issue: %w", err)
+		}
+	}
+	return nil
+}
+
+// Issue returns the issue filed for sig.
+func (f *GitHubIssuesFormatter) Issue(sig signal.RawSignal) GitHubIssue {
+	label := f.Label
+	if label == "" {
+		label = DefaultGitHubIssueLabel
+	}
+	issueLabels := []string{label}
+	mapped := f.labelMap.For(labels.GitHub, sig)
+	if len(mapped) == 0 && sig.Kind != "" {
+		mapped = []string{sig.Kind}
+	}
+	for _, l := range mapped {
+		if !slices.Contains(issueLabels, l) {
+			issueLabels = append(issueLabels, l)
+		}
+	}
+
+	id := SignalID(sig, "str-")
+	return GitHubIssue{
+		ID:     id,
+		Title:  sig.Title,
+		Body:   issueBody(sig, id),
+		Labels: issueLabels,
+	}
+}
+
+// issueBody renders the Markdown body of sig's issue, ending with its
+// marker.
+func issueBody(sig signal.RawSignal, id string) string {
+	var b strings.Builder
+	if sig.Description != "" {
+		b.WriteString(strings.TrimSpace(sig.Description))
+		b.WriteString("\n\n")
+	}
+
+	if loc := issueLocation(sig); loc != "" {
+		fmt.Fprintf(&b, "**Location:** %s\n", loc)
+	}
+	fmt.Fprintf(&b, "**Kind:** `%s`", sig.Kind)
+	if sig.Source != "" {
+		fmt.Fprintf(&b, " · **Collector:** `%s`", sig.Source)
+	}
+	fmt.Fprintf(&b, " · **Priority:** P%d", signal.EffectivePriority(sig))
+	if sig.Confidence > 0 {
+		fmt.Fprintf(&b, " · **Confidence:** %.0f%%", sig.Confidence*100)
+	}
+	b.WriteString("\n")
+	if len(sig.Owners) > 0 {
+		fmt.Fprintf(&b, "**Owners:** %s\n", strings.Join(sig.Owners, ", "))
+	}
+
+	fmt.Fprintf(&b, "\n_Filed by stringer as `%s`._\n%s\n", id, IssueMarker(id))
+	return b.String()
+}
+
+// issueLocation returns sig's file and line, linked to its forge URL when
+// it has one.
+func issueLocation(sig signal.RawSignal) string {
+	loc := sig.FilePath
+	if loc != "" && sig.Line > 0 {
+		loc = fmt.Sprintf("%s:%d", sig.FilePath, sig.Line)
+	}
+	switch {
+	case loc != "" && sig.URL != "":
+		return fmt.Sprintf("[`%s`](%s)", loc, sig.URL)
+	case loc != "":
+		return "`" + loc + "`"
+	default:
+		return sig.URL
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/davetashner/stringer/internal/labels"
+	"github.com/davetashner/stringer/internal/signal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGitHubIssuesFormatter_Registration(t *testing.T) {
+	f, err := GetFormatter("github-issues")
+	require.NoError(t, err)
+	assert.Equal(t, "github-issues", f.Name())
+}
+
+func TestGitHubIssuesFormatter_Issue(t *testing.T) {
+	sig := signal.RawSignal{
+		Source:      "todos",
+		Kind:        "todo",
+		FilePath:    "internal/a.go",
+		Line:        12,
+		Title:       "TODO: handle retries",
+		Description: "Retries are not implemented.",
+		Confidence:  0.8,
+		URL:         "https://github.com/o/r/blob/abc/internal/a.go#L12",
+		Owners:      []string{"@team/core"},
+	}
+	issue := NewGitHubIssuesFormatter().Issue(sig)
+
+	id := SignalID(sig, "str-")
+	assert.Equal(t, id, issue.ID)
+	assert.Equal(t, "TODO: handle retries", issue.Title)
+	assert.Equal(t, []string{DefaultGitHubIssueLabel, "todo"}, issue.Labels)
+	assert.True(t, strings.HasPrefix(issue.Body, "Retries are not implemented.\n\n"))
+	assert.Contains(t, issue.Body, "**Location:** [`internal/a.go:12`](https://github.com/o/r/blob/abc/internal/a.go#L12)")
+	assert.Contains(t, issue.Body, "**Kind:** `todo` · **Collector:** `todos` · **Priority:** P1 · **Confidence:** 80%")
+	assert.Contains(t, issue.Body, "**Owners:** @team/core")
+	assert.True(t, strings.HasSuffix(issue.Body, IssueMarker(id)+"\n"))
+	assert.Equal(t, id, IssueMarkerID(issue.Body))
+}
+
+func TestGitHubIssuesFormatter_LabelMap(t *testing.T) {
+	f := NewGitHubIssuesFormatter()
+	f.Label = "tech-debt"
+	f.SetLabelMap(labels.Map{
+		{Kinds: []string{"todo"}, Labels: []string{"debt"}, Exporters: map[string][]string{labels.GitHub: {"kind/todo", "tech-debt"}}},
+	})
+
+	mapped := f.Issue(signal.RawSignal{Kind: "todo", Title: "a"})
+	assert.Equal(t, []string{"tech-debt", "kind/todo"}, mapped.Labels)
+
+	unmapped := f.Issue(signal.RawSignal{Kind: "churn", Title: "b"})
+	assert.Equal(t, []string{"tech-debt", "churn"}, unmapped.Labels, "a signal no rule matches is labeled with its kind")
+}
+
+func TestGitHubIssuesFormatter_Location(t *testing.T) {
+	f := NewGitHubIssuesFormatter()
+
+	fileOnly := f.Issue(signal.RawSignal{Kind: "churn", FilePath: "a.go", Title: "a"})
+	assert.Contains(t, fileOnly.Body, "**Location:** `a.go`\n")
+
+	urlOnly := f.Issue(signal.RawSignal{Kind: "github-issue", URL: "https://github.com/o/r/issues/1", Title: "b"})
+	assert.Contains(t, urlOnly.Body, "**Location:** https://github.com/o/r/issues/1\n")
+
+	none := f.Issue(signal.RawSignal{Kind: "low-coverage", Title: "c"})
+	assert.NotContains(t, none.Body, "**Location:**")
+	assert.True(t, strings.HasPrefix(none.Body, "**Kind:**"))
+}
+
+func TestGitHubIssuesFormatter_Format(t *testing.T) {
+	signals := []signal.RawSignal{
+		{Source: "todos", Kind: "todo", FilePath: "a.go", Line: 1, Title: "open"},
+		{Source: "todos", Kind: "todo", FilePath: "b.go", Line: 2, Title: "closed", ClosedAt: time.Now()},
+	}
+	var buf bytes.Buffer
+	require.NoError(t, NewGitHubIssuesFormatter().Format(signals, &buf))
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 1, "closed signals are skipped")
+	var issue GitHubIssue
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &issue))
+	assert.Equal(t, "open", issue.Title)
+	assert.Equal(t, SignalID(signals[0], "str-"), issue.ID)
+}
+
+func TestGitHubIssuesFormatter_FormatEmpty(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, NewGitHubIssuesFormatter().Format(nil, &buf))
+	assert.Empty(t, buf.String())
+}
+
+func TestGitHubIssuesFormatter_WriteError(t *testing.T) {
+	err := NewGitHubIssuesFormatter().Format([]signal.RawSignal{{Kind: "todo", Title: "a"}}, &failWriter{})
+	assert.Error(t, err)
+}
+
+func TestIssueMarkerID(t *testing.T) {
+	assert.Equal(t, "str-0123abcd", IssueMarkerID("text\n<!-- stringer:id=str-0123abcd -->\n"))
+	assert.Empty(t, IssueMarkerID("mentions str-0123abcd without a marker"))
+	assert.Empty(t, IssueMarkerID(""))
+}
@@ -10,9 +10,12 @@ import (
 	"io"
 	"path/filepath"
 	"sort"
+	"strings"
 	"sync"
 	"time"
 
+	"github.com/davetashner/stringer/internal/coverage"
+	"github.com/davetashner/stringer/internal/i18n"
 	"github.com/davetashner/stringer/internal/signal"
 )
 
@@ -22,11 +25,17 @@ func init() {
 
 // HTMLFormatter writes signals as a self-contained HTML dashboard.
 type HTMLFormatter struct {
-	nowFunc func() time.Time
+	nowFunc   func() time.Time
+	catalog   *i18n.Catalog
+	quadrants *coverage.Quadrants
 }
 
-// Compile-time interface check.
-var _ Formatter = (*HTMLFormatter)(nil)
+// Compile-time interface checks.
+var (
+	_ Formatter        = (*HTMLFormatter)(nil)
+	_ Localizer        = (*HTMLFormatter)(nil)
+	_ QuadrantReporter = (*HTMLFormatter)(nil)
+)
 
 // NewHTMLFormatter returns a new HTMLFormatter.
 func NewHTMLFormatter() *HTMLFormatter {
@@ -38,6 +47,18 @@ func (h *HTMLFormatter) Name() string {
 	return "html"
 }
 
+// SetCatalog selects the language of the dashboard's labels. Passing nil
+// restores English.
+func (h *HTMLFormatter) SetCatalog(c *i18n.Catalog) {
+	h.catalog = c
+}
+
+// SetQuadrants adds the churn × coverage grid to the charts. Passing nil
+// removes it.
+func (h *HTMLFormatter) SetQuadrants(q *coverage.Quadrants) {
+	h.quadrants = q
+}
+
 var (
 	htmlTmplOnce sync.Once
 	htmlTmpl     *template.Template
@@ -63,7 +84,8 @@ func (h *HTMLFormatter) Format(signals []signal.RawSignal, w io.Writer) error {
 		now = h.nowFunc()
 	}
 
-	data := buildHTMLData(signals, now)
+	data := buildHTMLData(signals, now, h.catalog)
+	data.Quadrants = summarizeQuadrants(h.quadrants)
 
 	if err := htmlTmpl.Execute(w, data); err != nil {
 		return fmt.Errorf("execute html template: %w", err)
@@ -76,14 +98,26 @@ type htmlData struct {
 	GeneratedAt    string
 	TotalSignals   int
 	Collectors     []string
+	Kinds          []string
+	Modules        []string
+	Authors        []string
 	PriorityDist   [4]int
 	CollectorDist  []collectorCount
 	ChurnFiles     []churnEntry
 	LotteryRisk    []lotteryEntry
 	TodoAgeBuckets []ageBucket
+	Quadrants      *quadrantSummary
 	SignalRows     []signalRow
 	ChartData      map[string]any
 	HasWorkspaces  bool
+	Lang           string
+
+	catalog *i18n.Catalog
+}
+
+// T returns the dashboard label for key in the data's language.
+func (d htmlData) T(key string, args ...any) string {
+	return d.catalog.T(key, args...)
 }
 
 type collectorCount struct {
@@ -107,32 +141,42 @@ type ageBucket struct {
 }
 
 type signalRow struct {
+	ID          string
 	Title       string
 	Kind        string
 	Source      string
 	Location    string
+	URL         string
 	Confidence  float64
 	Priority    int
 	Description string
 	Workspace   string
+	Module      string
+	Author      string
 }
 
-func buildHTMLData(signals []signal.RawSignal, now time.Time) htmlData {
+func buildHTMLData(signals []signal.RawSignal, now time.Time, c *i18n.Catalog) htmlData {
 	groups := groupByCollector(signals)
 	collectors := sortedCollectorNames(groups)
 	prioDist := priorityDistribution(signals)
+	rows := buildSignalRows(signals)
 
 	data := htmlData{
 		GeneratedAt:    now.UTC().Format("2006-01-02 15:04 UTC"),
 		TotalSignals:   len(signals),
 		Collectors:     collectors,
+		Kinds:          distinctRowValues(rows, func(r signalRow) string { return r.Kind }),
+		Modules:        distinctRowValues(rows, func(r signalRow) string { return r.Module }),
+		Authors:        distinctRowValues(rows, func(r signalRow) string { return r.Author }),
 		PriorityDist:   prioDist,
 		CollectorDist:  buildCollectorDist(groups, collectors),
 		ChurnFiles:     buildChurnEntries(signals),
 		LotteryRisk:    buildLotteryEntries(signals),
-		TodoAgeBuckets: buildTodoAgeBuckets(signals, now),
-		SignalRows:     buildSignalRows(signals),
+		TodoAgeBuckets: buildTodoAgeBuckets(signals, now, c),
+		SignalRows:     rows,
 		HasWorkspaces:  hasMultipleWorkspaces(signals),
+		Lang:           c.Lang(),
+		catalog:        c,
 	}
 
 	data.ChartData = buildHTMLChartData(data)
@@ -196,7 +240,7 @@ func buildLotteryEntries(signals []signal.RawSignal) []lotteryEntry {
 	return entries
 }
 
-func buildTodoAgeBuckets(signals []signal.RawSignal, now time.Time) []ageBucket {
+func buildTodoAgeBuckets(signals []signal.RawSignal, now time.Time, c *i18n.Catalog) []ageBucket {
 	buckets := [5]int{} // <1w, 1-4w, 1-3m, 3-12m, >1y
 	found := false
 	for _, s := range signals {
@@ -223,10 +267,10 @@ func buildTodoAgeBuckets(signals []signal.RawSignal, now time.Time) []ageBucket
 	if !found {
 		return nil
 	}
-	labels := []string{"<1 week", "1-4 weeks", "1-3 months", "3-12 months", ">1 year"}
-	result := make([]ageBucket, len(labels))
-	for i, l := range labels {
-		result[i] = ageBucket{Label: l, Count: buckets[i]}
+	keys := []string{"html.age_week", "html.age_weeks", "html.age_months", "html.age_year", "html.age_years"}
+	result := make([]ageBucket, len(keys))
+	for i, key := range keys {
+		result[i] = ageBucket{Label: c.T(key), Count: buckets[i]}
 	}
 	return result
 }
@@ -234,24 +278,63 @@ func buildTodoAgeBuckets(signals []signal.RawSignal, now time.Time) []ageBucket
 func buildSignalRows(signals []signal.RawSignal) []signalRow {
 	rows := make([]signalRow, len(signals))
 	for i, s := range signals {
-		p := mapConfidenceToPriority(s.Confidence)
+		p := signal.PriorityFromConfidence(s.Confidence)
 		if s.Priority != nil {
 			p = *s.Priority
 		}
 		rows[i] = signalRow{
+			ID:          SignalID(s, "str-"),
 			Title:       s.Title,
 			Kind:        s.Kind,
 			Source:      s.Source,
 			Location:    formatLocation(s.FilePath, s.Line),
+			URL:         s.URL,
 			Confidence:  s.Confidence,
 			Priority:    p,
 			Description: s.Description,
 			Workspace:   s.Workspace,
+			Module:      SignalModule(s.FilePath),
+			Author:      s.Author,
 		}
 	}
 	return rows
 }
 
+// SignalModule returns the module a signal's file belongs to: its first
+// two directories, or "(root)" for files at the top of the repository.
+// Signals without a file have no module.
+func SignalModule(filePath string) string {
+	if filePath == "" {
+		return ""
+	}
+	dir := filepath.ToSlash(filepath.Dir(filePath))
+	if dir == "." || dir == "/" {
+		return "(root)"
+	}
+	parts := strings.Split(dir, "/")
+	if len(parts) > 2 {
+		parts = parts[:2]
+	}
+	return strings.Join(parts, "/")
+}
+
+// distinctRowValues returns the sorted non-empty values of field across
+// rows, for the dashboard's filter menus.
+func distinctRowValues(rows []signalRow, field func(signalRow) string) []string {
+	seen := make(map[string]bool)
+	var values []string
+	for _, r := range rows {
+		v := field(r)
+		if v == "" || seen[v] {
+			continue
+		}
+		seen[v] = true
+		values = append(values, v)
+	}
+	sort.Strings(values)
+	return values
+}
+
 // hasMultipleWorkspaces returns true if signals come from more than one workspace.
 func hasMultipleWorkspaces(signals []signal.RawSignal) bool {
 	seen := ""
@@ -320,11 +403,21 @@ func buildHTMLChartData(data htmlData) map[string]any {
 
 func (h *HTMLFormatter) writeEmpty(w io.Writer) error {
 	const emptyHTML = `<!DOCTYPE html>
-<html lang="en"><head><meta charset="utf-8"><title>Stringer Dashboard</title>
+<html lang="%s"><head><meta charset="utf-8"><title>%s</title>
 <style>body{font-family:sans-serif;display:flex;justify-content:center;align-items:center;height:100vh;color:#6c757d;}</style>
-</head><body><p>No signals found.</p></body></html>`
-	if _, err := io.WriteString(w, emptyHTML); err != nil {
+</head><body><p>%s</p></body></html>`
+	if _, err := fmt.Fprintf(w, emptyHTML, emptyHTMLArgs(h.catalog)...); err != nil {
 		return fmt.Errorf("write empty html: %w", err)
 	}
 	return nil
 }
+
+// emptyHTMLArgs returns the escaped language, title, and message for the
+// page written when there are no signals.
+func emptyHTMLArgs(c *i18n.Catalog) []any {
+	return []any{
+		template.HTMLEscapeString(c.Lang()),
+		template.HTMLEscapeString(c.T("html.title")),
+		template.HTMLEscapeString(c.T("html.no_signals")),
+	}
+}
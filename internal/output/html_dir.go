@@ -13,6 +13,8 @@ import (
 	"sync"
 	"time"
 
+	"github.com/davetashner/stringer/internal/coverage"
+	"github.com/davetashner/stringer/internal/i18n"
 	"github.com/davetashner/stringer/internal/signal"
 )
 
@@ -23,13 +25,17 @@ func init() {
 // HTMLDirFormatter writes signals as an HTML dashboard with external CSS and JS
 // in a directory structure: index.html + assets/dashboard.{css,js}.
 type HTMLDirFormatter struct {
-	nowFunc func() time.Time
+	nowFunc   func() time.Time
+	catalog   *i18n.Catalog
+	quadrants *coverage.Quadrants
 }
 
 // Compile-time interface checks.
 var (
 	_ Formatter          = (*HTMLDirFormatter)(nil)
 	_ DirectoryFormatter = (*HTMLDirFormatter)(nil)
+	_ Localizer          = (*HTMLDirFormatter)(nil)
+	_ QuadrantReporter   = (*HTMLDirFormatter)(nil)
 )
 
 // NewHTMLDirFormatter returns a new HTMLDirFormatter.
@@ -42,6 +48,18 @@ func (h *HTMLDirFormatter) Name() string {
 	return "html-dir"
 }
 
+// SetCatalog selects the language of the dashboard's labels. Passing nil
+// restores English.
+func (h *HTMLDirFormatter) SetCatalog(c *i18n.Catalog) {
+	h.catalog = c
+}
+
+// SetQuadrants adds the churn × coverage grid to the charts. Passing nil
+// removes it.
+func (h *HTMLDirFormatter) SetQuadrants(q *coverage.Quadrants) {
+	h.quadrants = q
+}
+
 // Format returns an error directing users to use --output (-o) with html-dir.
 func (h *HTMLDirFormatter) Format(_ []signal.RawSignal, _ io.Writer) error {
 	return fmt.Errorf("html-dir format requires --output (-o) flag to specify output directory")
@@ -87,7 +105,8 @@ func (h *HTMLDirFormatter) FormatDir(signals []signal.RawSignal, dir string) err
 		now = h.nowFunc()
 	}
 
-	data := buildHTMLData(signals, now)
+	data := buildHTMLData(signals, now, h.catalog)
+	data.Quadrants = summarizeQuadrants(h.quadrants)
 
 	indexPath := filepath.Join(dir, "index.html")
 	f, err := os.Create(indexPath) //nolint:gosec // path is user-specified output directory
@@ -104,10 +123,11 @@ func (h *HTMLDirFormatter) FormatDir(signals []signal.RawSignal, dir string) err
 
 func (h *HTMLDirFormatter) writeEmptyDir(path string) error {
 	const emptyHTML = `<!DOCTYPE html>
-<html lang="en"><head><meta charset="utf-8"><title>Stringer Dashboard</title>
+<html lang="%s"><head><meta charset="utf-8"><title>%s</title>
 <link rel="stylesheet" href="assets/dashboard.css">
-</head><body><p style="text-align:center;margin-top:40vh;color:var(--muted)">No signals found.</p></body></html>`
-	if err := os.WriteFile(path, []byte(emptyHTML), 0o600); err != nil { //nolint:gosec // user-specified output path
+</head><body><p style="text-align:center;margin-top:40vh;color:var(--muted)">%s</p></body></html>`
+	page := fmt.Sprintf(emptyHTML, emptyHTMLArgs(h.catalog)...)
+	if err := os.WriteFile(path, []byte(page), 0o600); err != nil { //nolint:gosec // user-specified output path
 		return fmt.Errorf("write empty index.html: %w", err)
 	}
 	return nil
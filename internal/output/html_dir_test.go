@@ -9,6 +9,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/davetashner/stringer/internal/i18n"
 	"github.com/davetashner/stringer/internal/signal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -276,3 +277,21 @@ func readFile(t *testing.T, path string) string {
 	require.NoError(t, err)
 	return string(data)
 }
+
+func TestHTMLDirFormatter_Localized(t *testing.T) {
+	ja, err := i18n.Load("ja")
+	require.NoError(t, err)
+	dir := t.TempDir()
+	f := NewHTMLDirFormatter()
+	f.SetCatalog(ja)
+	signals := []signal.RawSignal{
+		{Source: "todos", Kind: "todo", Title: "Fix this", FilePath: "main.go", Line: 10, Confidence: 0.5},
+	}
+
+	require.NoError(t, f.FormatDir(signals, dir))
+	html := readFile(t, filepath.Join(dir, "index.html"))
+	assert.Contains(t, html, `<html lang="ja">`)
+	assert.Contains(t, html, "<h1>Stringer ダッシュボード</h1>")
+	assert.Contains(t, html, `<a href="#summary">概要</a>`)
+	assert.Contains(t, html, "1 件のシグナル (1 個のコレクター)")
+}
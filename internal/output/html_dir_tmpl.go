@@ -57,6 +57,9 @@ tr.detail-row td { padding: .75rem 1rem; font-size: .8125rem; color: var(--muted
 .priority-3 { color: var(--p3); }
 .priority-4 { color: var(--p4); }
 .sort-arrow { font-size: .625rem; margin-left: .25rem; }
+.quadrant-grid { margin-bottom: 1rem; }
+.quadrant-grid td { text-align: center; }
+.quadrant-danger { color: var(--p1); font-weight: 700; }
 `
 
 const htmlDirJS = `function svgEl(tag, attrs) {
@@ -112,9 +115,17 @@ function renderDoughnut(id, labels, values, colors) {
   c.appendChild(svg);
 }
 
+function filterValue(id) {
+  var el = document.getElementById(id);
+  return el ? el.value : "";
+}
+
 function applyFilters() {
-  var col = document.getElementById("filter-collector").value;
-  var pri = document.getElementById("filter-priority").value;
+  var col = filterValue("filter-collector");
+  var kind = filterValue("filter-kind");
+  var mod = filterValue("filter-module");
+  var author = filterValue("filter-author");
+  var pri = filterValue("filter-priority");
   var conf = document.getElementById("filter-confidence").value / 100;
   var search = document.getElementById("filter-search").value.toLowerCase();
   var rows = document.querySelectorAll("tr.signal-row");
@@ -122,6 +133,9 @@ function applyFilters() {
     var r = rows[i];
     var show = true;
     if (col && r.dataset.source !== col) show = false;
+    if (kind && r.dataset.kind !== kind) show = false;
+    if (mod && r.dataset.module !== mod) show = false;
+    if (author && r.dataset.author !== author) show = false;
     if (pri && r.dataset.priority !== pri) show = false;
     if (parseFloat(r.dataset.confidence) < conf) show = false;
     if (search && r.textContent.toLowerCase().indexOf(search) === -1) show = false;
@@ -150,7 +164,7 @@ function toggleDetail(row) { row.nextElementSibling.classList.toggle("hidden");
       return function(){
         var col = th.dataset.col;
         if (sortCol === col) sortAsc = !sortAsc; else { sortCol = col; sortAsc = true; }
-        var tbody = document.querySelector("tbody");
+        var tbody = document.querySelector("#signals tbody");
         var pairs = [];
         var srows = tbody.querySelectorAll("tr.signal-row");
         for (var j = 0; j < srows.length; j++) {
@@ -179,77 +193,105 @@ function toggleDetail(row) { row.nextElementSibling.classList.toggle("hidden");
 `
 
 const htmlDirPageTemplate = `<!DOCTYPE html>
-<html lang="en">
+<html lang="{{.Lang}}">
 <head>
 <meta charset="utf-8">
 <meta name="viewport" content="width=device-width, initial-scale=1">
-<title>Stringer Dashboard</title>
+<title>{{$.T "html.title"}}</title>
 <link rel="stylesheet" href="assets/dashboard.css">
 </head>
 <body>
 <nav>
-  <a href="#summary">Summary</a>
-  <a href="#charts">Charts</a>
-  <a href="#signals">Signals</a>
+  <a href="#summary">{{$.T "html.nav_summary"}}</a>
+  <a href="#charts">{{$.T "html.nav_charts"}}</a>
+  <a href="#signals">{{$.T "html.nav_signals"}}</a>
 </nav>
 
 <header>
-  <h1>Stringer Dashboard</h1>
-  <p>Generated {{.GeneratedAt}} &middot; {{.TotalSignals}} signals from {{len .Collectors}} collector(s)</p>
+  <h1>{{$.T "html.title"}}</h1>
+  <p>{{$.T "html.generated" .GeneratedAt}} &middot; {{$.T "html.signals_from" .TotalSignals (len .Collectors)}}</p>
 </header>
 
 <section class="cards" id="summary">
-  <div class="card"><div class="value">{{.TotalSignals}}</div><div class="label">Total</div></div>
-  <div class="card card-p1"><div class="value">{{index .PriorityDist 0}}</div><div class="label">P1 Critical</div></div>
-  <div class="card card-p2"><div class="value">{{index .PriorityDist 1}}</div><div class="label">P2 High</div></div>
-  <div class="card card-p3"><div class="value">{{index .PriorityDist 2}}</div><div class="label">P3 Medium</div></div>
-  <div class="card card-p4"><div class="value">{{index .PriorityDist 3}}</div><div class="label">P4 Low</div></div>
+  <div class="card"><div class="value">{{.TotalSignals}}</div><div class="label">{{$.T "html.total"}}</div></div>
+  <div class="card card-p1"><div class="value">{{index .PriorityDist 0}}</div><div class="label">{{$.T "html.p1"}}</div></div>
+  <div class="card card-p2"><div class="value">{{index .PriorityDist 1}}</div><div class="label">{{$.T "html.p2"}}</div></div>
+  <div class="card card-p3"><div class="value">{{index .PriorityDist 2}}</div><div class="label">{{$.T "html.p3"}}</div></div>
+  <div class="card card-p4"><div class="value">{{index .PriorityDist 3}}</div><div class="label">{{$.T "html.p4"}}</div></div>
 </section>
 
 <section class="charts" id="charts">
-  <div class="chart-box"><h3>Priority Distribution</h3><div id="chart-priority"></div></div>
-  <div class="chart-box"><h3>Signal Sources</h3><div id="chart-sources"></div></div>
-  {{if .ChurnFiles}}<div class="chart-box"><h3>Top File Churn</h3><div id="chart-churn"></div></div>{{end}}
-  {{if .LotteryRisk}}<div class="chart-box"><h3>Lottery Risk</h3><div id="chart-lottery"></div></div>{{end}}
-  {{if .TodoAgeBuckets}}<div class="chart-box"><h3>TODO Age</h3><div id="chart-todo-age"></div></div>{{end}}
+  <div class="chart-box"><h3>{{$.T "html.chart_priority"}}</h3><div id="chart-priority"></div></div>
+  <div class="chart-box"><h3>{{$.T "html.chart_sources"}}</h3><div id="chart-sources"></div></div>
+  {{if .ChurnFiles}}<div class="chart-box"><h3>{{$.T "html.chart_churn"}}</h3><div id="chart-churn"></div></div>{{end}}
+  {{if .LotteryRisk}}<div class="chart-box"><h3>{{$.T "html.chart_lottery"}}</h3><div id="chart-lottery"></div></div>{{end}}
+  {{if .TodoAgeBuckets}}<div class="chart-box"><h3>{{$.T "html.chart_todo_age"}}</h3><div id="chart-todo-age"></div></div>{{end}}
+  {{with .Quadrants}}<div class="chart-box" id="quadrants"><h3>{{$.T "quadrant.heading"}}</h3>
+    <table class="quadrant-grid">
+    <tr><th></th><th>{{$.T "quadrant.low_coverage" .LowCoveragePct}}</th><th>{{$.T "quadrant.high_coverage"}}</th></tr>
+    <tr><th>{{$.T "quadrant.high_churn" .ChurnCutoff}}</th><td class="quadrant-danger">{{$.T "quadrant.danger"}}: {{.Danger}}</td><td>{{$.T "quadrant.guarded"}}: {{.Guarded}}</td></tr>
+    <tr><th>{{$.T "quadrant.low_churn"}}</th><td>{{$.T "quadrant.untested"}}: {{.Untested}}</td><td>{{$.T "quadrant.stable"}}: {{.Stable}}</td></tr>
+    </table>
+    {{if .TopDanger}}<h3>{{$.T "quadrant.top_danger"}}</h3>
+    <table>
+    <tr><th>{{$.T "quadrant.file"}}</th><th>{{$.T "quadrant.changes"}}</th><th>{{$.T "quadrant.coverage"}}</th></tr>
+    {{range .TopDanger}}<tr><td>{{.Path}}</td><td>{{.Changes}}</td><td>{{.Coverage}}</td></tr>
+    {{end}}</table>{{end}}
+  </div>{{end}}
 </section>
 
 <section id="filters" class="filters">
   <select id="filter-collector" onchange="applyFilters()">
-    <option value="">All Collectors</option>
+    <option value="">{{$.T "html.all_collectors"}}</option>
     {{range .Collectors}}<option value="{{.}}">{{.}}</option>{{end}}
   </select>
+  <select id="filter-kind" onchange="applyFilters()">
+    <option value="">{{$.T "html.all_kinds"}}</option>
+    {{range .Kinds}}<option value="{{.}}">{{.}}</option>{{end}}
+  </select>
+  {{if .Modules}}<select id="filter-module" onchange="applyFilters()">
+    <option value="">{{$.T "html.all_modules"}}</option>
+    {{range .Modules}}<option value="{{.}}">{{.}}</option>{{end}}
+  </select>{{end}}
+  {{if .Authors}}<select id="filter-author" onchange="applyFilters()">
+    <option value="">{{$.T "html.all_authors"}}</option>
+    {{range .Authors}}<option value="{{.}}">{{.}}</option>{{end}}
+  </select>{{end}}
   <select id="filter-priority" onchange="applyFilters()">
-    <option value="">All Priorities</option>
+    <option value="">{{$.T "html.all_priorities"}}</option>
     <option value="1">P1</option><option value="2">P2</option>
     <option value="3">P3</option><option value="4">P4</option>
   </select>
-  <input type="range" id="filter-confidence" min="0" max="100" value="0" oninput="applyFilters();this.title='Min confidence: '+(this.value/100).toFixed(2)">
-  <input type="text" id="filter-search" placeholder="Search..." oninput="applyFilters()">
+  <input type="range" id="filter-confidence" min="0" max="100" value="0" oninput="applyFilters();this.title='{{$.T "html.min_confidence"}} '+(this.value/100).toFixed(2)">
+  <input type="text" id="filter-search" placeholder="{{$.T "html.search"}}" oninput="applyFilters()">
 </section>
 
 <section id="signals">
 <table>
 <thead><tr>
-  <th data-col="title">Title</th>
-  <th data-col="kind">Kind</th>
-  <th data-col="source">Source</th>
-  {{if .HasWorkspaces}}<th data-col="workspace">Workspace</th>{{end}}
-  <th data-col="location">Location</th>
-  <th data-col="confidence">Confidence</th>
-  <th data-col="priority">Priority</th>
+  <th data-col="title">{{$.T "html.col_title"}}</th>
+  <th data-col="kind">{{$.T "html.col_kind"}}</th>
+  <th data-col="source">{{$.T "html.col_source"}}</th>
+  {{if .HasWorkspaces}}<th data-col="workspace">{{$.T "html.col_workspace"}}</th>{{end}}
+  <th data-col="module">{{$.T "html.col_module"}}</th>
+  <th data-col="location">{{$.T "html.col_location"}}</th>
+  <th data-col="author">{{$.T "html.col_author"}}</th>
+  <th data-col="confidence">{{$.T "html.col_confidence"}}</th>
+  <th data-col="priority">{{$.T "html.col_priority"}}</th>
 </tr></thead>
 <tbody>
 {{$hasWs := .HasWorkspaces}}
 {{range .SignalRows}}
-<tr class="signal-row" data-source="{{.Source}}" data-priority="{{.Priority}}" data-confidence="{{.Confidence}}" onclick="toggleDetail(this)">
+<tr class="signal-row" id="{{.ID}}" data-source="{{.Source}}" data-kind="{{.Kind}}" data-module="{{.Module}}" data-author="{{.Author}}" data-priority="{{.Priority}}" data-confidence="{{.Confidence}}" onclick="toggleDetail(this)">
   <td>{{.Title}}</td><td>{{.Kind}}</td><td>{{.Source}}</td>
   {{if $hasWs}}<td>{{.Workspace}}</td>{{end}}
-  <td>{{.Location}}</td>
+  <td>{{.Module}}</td>
+  <td>{{if .URL}}<a href="{{.URL}}" onclick="event.stopPropagation()">{{.Location}}</a>{{else}}{{.Location}}{{end}}</td>
+  <td>{{.Author}}</td>
   <td>{{printf "%.2f" .Confidence}}</td>
   <td><span class="priority priority-{{.Priority}}">P{{.Priority}}</span></td>
 </tr>
-<tr class="detail-row hidden"><td colspan="{{if $hasWs}}7{{else}}6{{end}}">{{.Description}}</td></tr>
+<tr class="detail-row hidden"><td colspan="{{if $hasWs}}9{{else}}8{{end}}"><code>{{.ID}}</code> {{.Description}}</td></tr>
 {{end}}
 </tbody>
 </table>
@@ -6,10 +6,14 @@ package output
 import (
 	"bytes"
 	"errors"
+	"flag"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/davetashner/stringer/internal/i18n"
 	"github.com/davetashner/stringer/internal/signal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -18,6 +22,8 @@ import (
 // Compile-time interface check.
 var _ Formatter = (*HTMLFormatter)(nil)
 
+var updateGolden = flag.Bool("update", false, "rewrite golden files in testdata")
+
 func TestHTMLFormatter_Name(t *testing.T) {
 	f := NewHTMLFormatter()
 	assert.Equal(t, "html", f.Name())
@@ -302,7 +308,7 @@ func TestBuildTodoAgeBuckets(t *testing.T) {
 		{Source: "todos"}, // no timestamp, skipped
 		{Source: "gitlog", Timestamp: now.Add(-2 * 24 * time.Hour), Kind: "churn"}, // not todos
 	}
-	buckets := buildTodoAgeBuckets(signals, now)
+	buckets := buildTodoAgeBuckets(signals, now, nil)
 	require.Len(t, buckets, 5)
 	assert.Equal(t, 1, buckets[0].Count) // <1w
 	assert.Equal(t, 1, buckets[1].Count) // 1-4w
@@ -313,7 +319,7 @@ func TestBuildTodoAgeBuckets(t *testing.T) {
 
 func TestBuildTodoAgeBuckets_NoTodos(t *testing.T) {
 	now := time.Now()
-	buckets := buildTodoAgeBuckets(nil, now)
+	buckets := buildTodoAgeBuckets(nil, now, nil)
 	assert.Nil(t, buckets)
 }
 
@@ -388,6 +394,10 @@ func TestHTMLFormatter_Description(t *testing.T) {
 	out := buf.String()
 	assert.Contains(t, out, "This needs careful refactoring of the error paths")
 	assert.Contains(t, out, "detail-row")
+
+	id := SignalID(signals[0], "str-")
+	assert.Contains(t, out, `<tr class="signal-row" id="`+id+`"`, "rows are anchored by signal ID")
+	assert.Contains(t, out, "<code>"+id+"</code>")
 }
 
 func TestHTMLFormatter_MultipleSignals(t *testing.T) {
@@ -413,3 +423,107 @@ func TestHTMLFormatter_MultipleSignals(t *testing.T) {
 	count := strings.Count(out, `class="signal-row"`)
 	assert.Equal(t, 50, count)
 }
+
+func TestHTMLFormatter_Localized(t *testing.T) {
+	de, err := i18n.Load("de")
+	require.NoError(t, err)
+	now := time.Date(2026, 2, 12, 10, 0, 0, 0, time.UTC)
+	f := &HTMLFormatter{nowFunc: func() time.Time { return now }}
+	f.SetCatalog(de)
+	signals := []signal.RawSignal{
+		{Source: "todos", Kind: "todo", Title: "Fix this", FilePath: "main.go", Line: 10, Confidence: 0.5, Timestamp: now.AddDate(0, 0, -2)},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, f.Format(signals, &buf))
+	out := buf.String()
+	assert.Contains(t, out, `<html lang="de">`)
+	assert.Contains(t, out, "<title>Stringer-Dashboard</title>")
+	assert.Contains(t, out, "Erstellt am 2026-02-12 10:00 UTC &middot; 1 Signale aus 1 Kollektor(en)")
+	assert.Contains(t, out, `<div class="label">P1 Kritisch</div>`)
+	assert.Contains(t, out, `<th data-col="location">Ort</th>`)
+	assert.Contains(t, out, `placeholder="Suchen …"`)
+	assert.Contains(t, out, `\u003c1 Woche`, "age labels are translated in the chart data")
+	assert.Contains(t, out, "<td>Fix this</td>", "signal titles are not translated")
+
+	buf.Reset()
+	require.NoError(t, f.Format(nil, &buf))
+	assert.Contains(t, buf.String(), `<html lang="de">`)
+	assert.Contains(t, buf.String(), "Keine Signale gefunden.")
+}
+
+func TestHTMLFormatter_Golden(t *testing.T) {
+	now := time.Date(2026, 2, 12, 10, 0, 0, 0, time.UTC)
+	f := &HTMLFormatter{nowFunc: func() time.Time { return now }}
+	p1 := 1
+	signals := []signal.RawSignal{
+		{Source: "todos", Kind: "todo", Title: "Parse flags properly", FilePath: "cmd/app/main.go", Line: 12, Confidence: 0.55, Author: "Alice", Timestamp: now.AddDate(0, -2, 0)},
+		{Source: "todos", Kind: "fixme", Title: "Handle <nil> config", Description: "Crashes when the config is missing.", FilePath: "internal/config/deep/load.go", Line: 40, Confidence: 0.8, Author: "Bob"},
+		{Source: "patterns", Kind: "large-file", Title: "Large file: server.go", FilePath: "server.go", Confidence: 0.4},
+		{Source: "github", Kind: "github-issue", Title: "Crash on startup", URL: "https://example.com/issues/7", Confidence: 0.9, Priority: &p1},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, f.Format(signals, &buf))
+
+	golden := filepath.Join("testdata", "html.golden")
+	if *updateGolden {
+		require.NoError(t, os.MkdirAll("testdata", 0o750))
+		require.NoError(t, os.WriteFile(golden, buf.Bytes(), 0o600))
+	}
+	want, err := os.ReadFile(golden) //nolint:gosec // test fixture
+	require.NoError(t, err, "run go test ./internal/output -run TestHTMLFormatter_Golden -update to create it")
+	assert.Equal(t, string(want), buf.String())
+}
+
+func TestHTMLFormatter_KindModuleAuthorFilters(t *testing.T) {
+	f := &HTMLFormatter{}
+	signals := []signal.RawSignal{
+		{Source: "todos", Kind: "todo", Title: "A", FilePath: "internal/output/html.go", Confidence: 0.5, Author: "Bob"},
+		{Source: "todos", Kind: "fixme", Title: "B", FilePath: "main.go", Confidence: 0.5, Author: "Alice"},
+		{Source: "github", Kind: "github-issue", Title: "C", Confidence: 0.5},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, f.Format(signals, &buf))
+	out := buf.String()
+	assert.Contains(t, out, `<option value="">All Kinds</option>`)
+	assert.Contains(t, out, `<option value="fixme">fixme</option><option value="github-issue">github-issue</option><option value="todo">todo</option>`)
+	assert.Contains(t, out, `<option value="(root)">(root)</option><option value="internal/output">internal/output</option>`)
+	assert.Contains(t, out, `<option value="Alice">Alice</option><option value="Bob">Bob</option>`)
+	assert.Contains(t, out, `data-kind="todo" data-module="internal/output" data-author="Bob"`)
+	assert.Contains(t, out, `data-kind="github-issue" data-module="" data-author=""`)
+	assert.Contains(t, out, `<th data-col="module">Module</th>`)
+	assert.Contains(t, out, `<th data-col="author">Author</th>`)
+	assert.Contains(t, out, `document.querySelector("#signals tbody")`, "sorting must not pick the quadrant table")
+}
+
+func TestHTMLFormatter_NoAuthorsHidesAuthorFilter(t *testing.T) {
+	f := &HTMLFormatter{}
+	signals := []signal.RawSignal{
+		{Source: "github", Kind: "github-issue", Title: "C", Confidence: 0.5},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, f.Format(signals, &buf))
+	out := buf.String()
+	assert.NotContains(t, out, `id="filter-author"`)
+	assert.NotContains(t, out, `id="filter-module"`)
+	assert.Contains(t, out, `id="filter-kind"`)
+}
+
+func TestSignalModule(t *testing.T) {
+	tests := []struct {
+		path string
+		want string
+	}{
+		{"", ""},
+		{"main.go", "(root)"},
+		{"cmd/main.go", "cmd"},
+		{"internal/output/html.go", "internal/output"},
+		{"internal/collectors/testdata/x/y.go", "internal/collectors"},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, SignalModule(tt.path), tt.path)
+	}
+}
@@ -4,6 +4,7 @@
 package output
 
 import (
+	"bufio"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -20,8 +21,25 @@ func init() {
 
 // JSONEnvelope wraps signals with metadata for the JSON output format.
 type JSONEnvelope struct {
-	Signals  []signal.RawSignal `json:"signals"`
-	Metadata JSONMetadata       `json:"metadata"`
+	Signals  []JSONSignal `json:"signals"`
+	Metadata JSONMetadata `json:"metadata"`
+}
+
+// JSONSignal is a signal with its stable ID. The ID is the same one the
+// beads, tasks, and markdown formats show and baselines and feedback refer
+// to, so consumers can track a signal across runs.
+type JSONSignal struct {
+	ID string `json:"id"`
+	signal.RawSignal
+}
+
+// NewJSONSignals pairs each signal with its "str-" prefixed ID.
+func NewJSONSignals(signals []signal.RawSignal) []JSONSignal {
+	out := make([]JSONSignal, len(signals))
+	for i, sig := range signals {
+		out[i] = JSONSignal{ID: SignalID(sig, "str-"), RawSignal: sig}
+	}
+	return out
 }
 
 // JSONMetadata contains information about the scan that produced these signals.
@@ -29,6 +47,19 @@ type JSONMetadata struct {
 	TotalCount  int      `json:"total_count"`
 	Collectors  []string `json:"collectors"`
 	GeneratedAt string   `json:"generated_at"`
+
+	// Errors lists the collectors that failed, in run order.
+	Errors []JSONCollectorError `json:"errors,omitempty"`
+
+	// ErrorCounts is the number of failed collectors per error category.
+	ErrorCounts map[signal.ErrorCategory]int `json:"error_counts,omitempty"`
+}
+
+// JSONCollectorError describes one failed collector.
+type JSONCollectorError struct {
+	Collector string               `json:"collector"`
+	Category  signal.ErrorCategory `json:"category"`
+	Message   string               `json:"message"`
 }
 
 // JSONFormatter writes signals as a JSON object with metadata envelope.
@@ -39,10 +70,17 @@ type JSONFormatter struct {
 
 	// nowFunc is used for testing to override the current time.
 	nowFunc func() time.Time
+
+	// results are the collector results whose failures go in the metadata.
+	results []signal.CollectorResult
 }
 
-// Compile-time interface check.
-var _ Formatter = (*JSONFormatter)(nil)
+// Compile-time interface checks.
+var (
+	_ Formatter       = (*JSONFormatter)(nil)
+	_ ResultsReporter = (*JSONFormatter)(nil)
+	_ StreamFormatter = (*JSONFormatter)(nil)
+)
 
 // NewJSONFormatter returns a new JSONFormatter with default settings.
 func NewJSONFormatter() *JSONFormatter {
@@ -54,55 +92,139 @@ func (f *JSONFormatter) Name() string {
 	return "json"
 }
 
+// SetResults sets the collector results whose failures are listed in the
+// metadata. Passing nil clears them.
+func (f *JSONFormatter) SetResults(results []signal.CollectorResult) {
+	f.results = results
+}
+
 // Format writes all signals as a JSON document with a metadata envelope to w.
 // If Compact is false and w is a TTY (an *os.File connected to a terminal),
 // or Compact is explicitly false, output is pretty-printed. If Compact is true,
 // output is a single line.
 func (f *JSONFormatter) Format(signals []signal.RawSignal, w io.Writer) error {
-	if signals == nil {
-		signals = []signal.RawSignal{}
-	}
+	return f.write(w, func(item func(signal.RawSignal) error) error {
+		for _, sig := range signals {
+			if err := item(sig); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
 
-	// Collect unique collector names from the signals.
-	collectors := extractCollectors(signals)
+// FormatStream writes the document Format writes, one signal at a time as
+// they are received from signals. The metadata, which counts them, comes
+// last. Collector results must be set before signals is closed.
+func (f *JSONFormatter) FormatStream(signals <-chan signal.RawSignal, w io.Writer) error {
+	return f.write(w, func(item func(signal.RawSignal) error) error {
+		for sig := range signals {
+			if err := item(sig); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
 
-	now := time.Now()
-	if f.nowFunc != nil {
-		now = f.nowFunc()
+// write writes the envelope, calling items with the function that writes
+// one signal. Its output is byte for byte what marshalling a JSONEnvelope
+// holding every signal produces.
+func (f *JSONFormatter) write(w io.Writer, items func(item func(signal.RawSignal) error) error) error {
+	compact := f.shouldCompact(w)
+	marshal := func(v any, prefix string) ([]byte, error) {
+		if compact {
+			return json.Marshal(v)
+		}
+		return json.MarshalIndent(v, prefix, "  ")
 	}
-
-	envelope := JSONEnvelope{
-		Signals: signals,
-		Metadata: JSONMetadata{
-			TotalCount:  len(signals),
-			Collectors:  collectors,
-			GeneratedAt: now.UTC().Format("2006-01-02T15:04:05Z"),
-		},
+	head, mid, tail, indent := `{"signals":[`, `],"metadata":`, "}", ""
+	if !compact {
+		head, mid, tail, indent = "{\n  \"signals\": [", "],\n  \"metadata\": ", "\n}", "\n    "
 	}
 
-	compact := f.shouldCompact(w)
-
-	var data []byte
-	var err error
-	if compact {
-		data, err = json.Marshal(envelope)
-	} else {
-		data, err = json.MarshalIndent(envelope, "", "  ")
+	// bufio errors are sticky and surface at Flush.
+	bw := bufio.NewWriter(w)
+	_, _ = bw.WriteString(head)
+	count := 0
+	collectors := make(map[string]bool)
+	err := items(func(sig signal.RawSignal) error {
+		data, err := marshal(JSONSignal{ID: SignalID(sig, "str-"), RawSignal: sig}, "    ")
+		if err != nil {
+			return fmt.Errorf("marshal json: %w", err)
+		}
+		if count > 0 {
+			_ = bw.WriteByte(',')
+		}
+		_, _ = bw.WriteString(indent)
+		_, _ = bw.Write(data)
+		count++
+		if sig.Source != "" {
+			collectors[sig.Source] = true
+		}
+		return nil
+	})
+	if err != nil {
+		return err
 	}
+
+	data, err := marshal(f.metadata(count, collectors), "  ")
 	if err != nil {
 		return fmt.Errorf("marshal json: %w", err)
 	}
-
-	if _, err := w.Write(data); err != nil {
+	if !compact && count > 0 {
+		_, _ = bw.WriteString("\n  ")
+	}
+	_, _ = bw.WriteString(mid)
+	_, _ = bw.Write(data)
+	_, _ = bw.WriteString(tail)
+	if err := bw.Flush(); err != nil {
 		return fmt.Errorf("write json: %w", err)
 	}
 	if _, err := w.Write([]byte("\n")); err != nil {
 		return fmt.Errorf("write json trailing newline: %w", err)
 	}
-
 	return nil
 }
 
+// metadata returns the envelope metadata for count signals from the
+// named collectors.
+func (f *JSONFormatter) metadata(count int, collectors map[string]bool) JSONMetadata {
+	now := time.Now()
+	if f.nowFunc != nil {
+		now = f.nowFunc()
+	}
+
+	names := make([]string, 0, len(collectors))
+	for name := range collectors {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	if len(names) == 0 {
+		names = nil
+	}
+
+	meta := JSONMetadata{
+		TotalCount:  count,
+		Collectors:  names,
+		GeneratedAt: now.UTC().Format("2006-01-02T15:04:05Z"),
+	}
+	for _, cr := range f.results {
+		if cr.Err == nil {
+			continue
+		}
+		meta.Errors = append(meta.Errors, JSONCollectorError{
+			Collector: cr.Collector,
+			Category:  cr.Category(),
+			Message:   cr.Err.Error(),
+		})
+	}
+	if len(meta.Errors) > 0 {
+		meta.ErrorCounts = (&signal.ScanResult{Results: f.results}).ErrorCounts()
+	}
+	return meta
+}
+
 // shouldCompact determines whether to use compact mode.
 // If Compact is explicitly set, use that value.
 // Otherwise, auto-detect: pretty-print for TTYs, compact for pipes.
@@ -134,6 +134,32 @@ func TestJSONFormatter_Metadata(t *testing.T) {
 	assert.Equal(t, []string{"gitlog", "patterns", "todos"}, envelope.Metadata.Collectors)
 }
 
+func TestJSONFormatter_MetadataErrors(t *testing.T) {
+	f := NewJSONFormatter()
+	f.SetResults([]signal.CollectorResult{
+		{Collector: "todos"},
+		{Collector: "github", Err: errors.New("401 Bad credentials"), ErrCategory: signal.CategoryAuth},
+		{Collector: "gitlog", Err: errors.New("object not found"), ErrCategory: signal.CategoryCorruptRepo},
+	})
+
+	var buf bytes.Buffer
+	require.NoError(t, f.Format(nil, &buf))
+
+	var envelope JSONEnvelope
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &envelope))
+	assert.Equal(t, []JSONCollectorError{
+		{Collector: "github", Category: signal.CategoryAuth, Message: "401 Bad credentials"},
+		{Collector: "gitlog", Category: signal.CategoryCorruptRepo, Message: "object not found"},
+	}, envelope.Metadata.Errors)
+	assert.Equal(t, map[signal.ErrorCategory]int{signal.CategoryAuth: 1, signal.CategoryCorruptRepo: 1}, envelope.Metadata.ErrorCounts)
+
+	// Clearing the results drops the error fields entirely.
+	f.SetResults(nil)
+	buf.Reset()
+	require.NoError(t, f.Format(nil, &buf))
+	assert.NotContains(t, buf.String(), "error")
+}
+
 func TestJSONFormatter_MetadataNoSource(t *testing.T) {
 	f := newTestJSONFormatter()
 
@@ -344,6 +370,9 @@ func TestJSONFormatter_RoundTrip(t *testing.T) {
 	require.Len(t, envelope.Signals, 1)
 	got := envelope.Signals[0]
 
+	assert.Equal(t, SignalID(original, "str-"), got.ID)
+	assert.Contains(t, buf.String(), `"id": "`+got.ID+`"`)
+
 	assert.Equal(t, original.Source, got.Source)
 	assert.Equal(t, original.Kind, got.Kind)
 	assert.Equal(t, original.FilePath, got.FilePath)
@@ -377,6 +406,55 @@ func TestJSONFormatter_WriteFailure(t *testing.T) {
 	})
 }
 
+func TestJSONFormatter_FormatStreamMatchesEnvelope(t *testing.T) {
+	sigs := []signal.RawSignal{
+		{Source: "todos", Kind: "todo", FilePath: "a.go", Line: 1, Title: "TODO: one", Confidence: 0.5},
+		{Source: "patterns", Kind: "large-file", FilePath: "b.go", Title: "Large file <b.go>", Confidence: 0.7},
+	}
+	results := []signal.CollectorResult{{Collector: "gitlog", Err: errors.New("boom")}}
+
+	for _, tc := range []struct {
+		name    string
+		signals []signal.RawSignal
+		compact bool
+	}{
+		{"pretty", sigs, false},
+		{"compact", sigs, true},
+		{"pretty_empty", nil, false},
+		{"compact_empty", nil, true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			f := newTestJSONFormatter()
+			f.Compact = tc.compact
+			f.SetResults(results)
+
+			envelope := JSONEnvelope{Signals: NewJSONSignals(tc.signals), Metadata: f.metadata(len(tc.signals), map[string]bool{})}
+			envelope.Metadata.Collectors = extractCollectors(tc.signals)
+			if envelope.Signals == nil {
+				envelope.Signals = []JSONSignal{}
+			}
+			want, err := json.MarshalIndent(envelope, "", "  ")
+			if tc.compact {
+				want, err = json.Marshal(envelope)
+			}
+			require.NoError(t, err)
+
+			ch := make(chan signal.RawSignal, len(tc.signals))
+			for _, sig := range tc.signals {
+				ch <- sig
+			}
+			close(ch)
+			var got bytes.Buffer
+			require.NoError(t, f.FormatStream(ch, &got))
+			assert.Equal(t, string(want)+"\n", got.String())
+
+			var formatted bytes.Buffer
+			require.NoError(t, f.Format(tc.signals, &formatted))
+			assert.Equal(t, got.String(), formatted.String())
+		})
+	}
+}
+
 func TestJSONFormatter_ShouldCompact(t *testing.T) {
 	t.Run("compact_true_always_compact", func(t *testing.T) {
 		f := &JSONFormatter{Compact: true}
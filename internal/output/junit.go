@@ -0,0 +1,188 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package output
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+func init() {
+	RegisterFormatter(NewJUnitFormatter())
+}
+
+// JUnitFormatter writes signals as a JUnit XML report so CI systems such as
+// Jenkins and GitLab show them in their test report views. Each collector
+// becomes a test suite and each signal a test case: open signals fail,
+// closed ones pass, and signals below SkipBelow confidence are skipped.
+type JUnitFormatter struct {
+	// SkipBelow is the confidence below which an open signal is reported
+	// as skipped rather than failed. Zero fails every open signal.
+	SkipBelow float64
+}
+
+// Compile-time interface check.
+var _ Formatter = (*JUnitFormatter)(nil)
+
+// NewJUnitFormatter returns a new JUnitFormatter that fails every open signal.
+func NewJUnitFormatter() *JUnitFormatter {
+	return &JUnitFormatter{}
+}
+
+// Name returns the format name.
+func (f *JUnitFormatter) Name() string {
+	return "junit"
+}
+
+// JUnit document types, following the schema Jenkins and GitLab read.
+
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Name     string           `xml:"name,attr"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Skipped  int              `xml:"skipped,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name     string          `xml:"name,attr"`
+	Tests    int             `xml:"tests,attr"`
+	Failures int             `xml:"failures,attr"`
+	Skipped  int             `xml:"skipped,attr"`
+	Cases    []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	File      string        `xml:"file,attr,omitempty"`
+	Line      int           `xml:"line,attr,omitempty"`
+	Failure   *junitFailure `xml:"failure"`
+	Skipped   *junitSkipped `xml:"skipped"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Text    string `xml:",chardata"`
+}
+
+type junitSkipped struct {
+	Message string `xml:"message,attr"`
+}
+
+// Format writes the signals as a JUnit XML document to w.
+func (f *JUnitFormatter) Format(signals []signal.RawSignal, w io.Writer) error {
+	doc := f.buildDocument(signals)
+
+	data, err := xml.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal junit: %w", err)
+	}
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return fmt.Errorf("write junit: %w", err)
+	}
+	if _, err := w.Write(data); err != nil {
+		return fmt.Errorf("write junit: %w", err)
+	}
+	if _, err := w.Write([]byte("\n")); err != nil {
+		return fmt.Errorf("write junit trailing newline: %w", err)
+	}
+	return nil
+}
+
+// buildDocument groups signals into one suite per collector, sorted by
+// name, keeping the signal order within each suite.
+func (f *JUnitFormatter) buildDocument(signals []signal.RawSignal) junitTestSuites {
+	doc := junitTestSuites{Name: "stringer"}
+	index := make(map[string]int)
+	for _, sig := range signals {
+		name := sig.Source
+		if name == "" {
+			name = "stringer"
+		}
+		i, ok := index[name]
+		if !ok {
+			i = len(doc.Suites)
+			index[name] = i
+			doc.Suites = append(doc.Suites, junitTestSuite{Name: name})
+		}
+		suite := &doc.Suites[i]
+		tc := f.testCase(sig)
+		suite.Tests++
+		switch {
+		case tc.Failure != nil:
+			suite.Failures++
+		case tc.Skipped != nil:
+			suite.Skipped++
+		}
+		suite.Cases = append(suite.Cases, tc)
+	}
+	slices.SortFunc(doc.Suites, func(a, b junitTestSuite) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	for _, s := range doc.Suites {
+		doc.Tests += s.Tests
+		doc.Failures += s.Failures
+		doc.Skipped += s.Skipped
+	}
+	return doc
+}
+
+// testCase converts a signal to a test case. The class name is the file,
+// so CI views group a file's signals together.
+func (f *JUnitFormatter) testCase(sig signal.RawSignal) junitTestCase {
+	tc := junitTestCase{
+		Name:      sig.Title,
+		ClassName: sig.FilePath,
+		File:      sig.FilePath,
+		Line:      sig.Line,
+	}
+	if tc.ClassName == "" {
+		tc.ClassName = sig.Kind
+	}
+	switch {
+	case !sig.ClosedAt.IsZero():
+		// Resolved signals pass.
+	case sig.Confidence < f.SkipBelow:
+		tc.Skipped = &junitSkipped{
+			Message: fmt.Sprintf("confidence %.2f is below %.2f", sig.Confidence, f.SkipBelow),
+		}
+	default:
+		tc.Failure = &junitFailure{
+			Message: sig.Title,
+			Type:    sig.Kind,
+			Text:    junitDetails(sig),
+		}
+	}
+	return tc
+}
+
+// junitDetails returns the failure text: the signal's ID, location,
+// confidence, and description.
+func junitDetails(sig signal.RawSignal) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "ID: %s\n", SignalID(sig, "str-"))
+	if sig.FilePath != "" {
+		loc := sig.FilePath
+		if sig.Line > 0 {
+			loc = fmt.Sprintf("%s:%d", sig.FilePath, sig.Line)
+		}
+		fmt.Fprintf(&b, "Location: %s\n", loc)
+	}
+	fmt.Fprintf(&b, "Confidence: %.2f\n", sig.Confidence)
+	if sig.URL != "" {
+		fmt.Fprintf(&b, "URL: %s\n", sig.URL)
+	}
+	if sig.Description != "" {
+		fmt.Fprintf(&b, "\n%s\n", sig.Description)
+	}
+	return b.String()
+}
@@ -0,0 +1,116 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package output
+
+import (
+	"bytes"
+	"encoding/xml"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/davetashner/stringer/internal/signal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// parseJUnit decodes a JUnit report, failing the test on malformed XML.
+func parseJUnit(t *testing.T, out []byte) junitTestSuites {
+	t.Helper()
+	var doc junitTestSuites
+	require.NoError(t, xml.Unmarshal(out, &doc), "output: %s", out)
+	return doc
+}
+
+func TestJUnitFormatter_Registration(t *testing.T) {
+	f, err := GetFormatter("junit")
+	require.NoError(t, err)
+	assert.Equal(t, "junit", f.Name())
+}
+
+func TestJUnitFormatter_GroupsByCollector(t *testing.T) {
+	signals := []signal.RawSignal{
+		{Source: "todos", Kind: "todo", FilePath: "b.go", Line: 4, Title: "TODO: second", Confidence: 0.7},
+		{Source: "gitlog", Kind: "revert", Title: "Reverted commit abc", Confidence: 0.8},
+		{Source: "todos", Kind: "fixme", FilePath: "a.go", Line: 9, Title: "FIXME: first", Confidence: 0.9,
+			Description: "Found in a.go", URL: "https://example.com/a.go#L9"},
+	}
+	var buf bytes.Buffer
+	require.NoError(t, NewJUnitFormatter().Format(signals, &buf))
+	assert.True(t, strings.HasPrefix(buf.String(), xml.Header))
+
+	doc := parseJUnit(t, buf.Bytes())
+	assert.Equal(t, "stringer", doc.Name)
+	assert.Equal(t, 3, doc.Tests)
+	assert.Equal(t, 3, doc.Failures)
+	assert.Zero(t, doc.Skipped)
+
+	require.Len(t, doc.Suites, 2)
+	assert.Equal(t, "gitlog", doc.Suites[0].Name, "suites are sorted by collector")
+	assert.Equal(t, "revert", doc.Suites[0].Cases[0].ClassName, "signals without a file are classed by kind")
+
+	todos := doc.Suites[1]
+	assert.Equal(t, "todos", todos.Name)
+	assert.Equal(t, 2, todos.Tests)
+	assert.Equal(t, 2, todos.Failures)
+	require.Len(t, todos.Cases, 2)
+	assert.Equal(t, "TODO: second", todos.Cases[0].Name, "cases keep the signal order")
+
+	tc := todos.Cases[1]
+	assert.Equal(t, "FIXME: first", tc.Name)
+	assert.Equal(t, "a.go", tc.ClassName)
+	assert.Equal(t, "a.go", tc.File)
+	assert.Equal(t, 9, tc.Line)
+	require.NotNil(t, tc.Failure)
+	assert.Equal(t, "FIXME: first", tc.Failure.Message)
+	assert.Equal(t, "fixme", tc.Failure.Type)
+	assert.Equal(t, "ID: "+SignalID(signals[2], "str-")+"\nLocation: a.go:9\nConfidence: 0.90\n"+
+		"URL: https://example.com/a.go#L9\n\nFound in a.go\n", tc.Failure.Text)
+}
+
+func TestJUnitFormatter_SkipBelowAndClosed(t *testing.T) {
+	signals := []signal.RawSignal{
+		{Source: "todos", Kind: "todo", Title: "strong", Confidence: 0.8},
+		{Source: "todos", Kind: "todo", Title: "weak", Confidence: 0.3},
+		{Source: "todos", Kind: "todo", Title: "resolved", Confidence: 0.8, ClosedAt: time.Now()},
+	}
+	var buf bytes.Buffer
+	require.NoError(t, (&JUnitFormatter{SkipBelow: 0.5}).Format(signals, &buf))
+
+	doc := parseJUnit(t, buf.Bytes())
+	assert.Equal(t, 3, doc.Tests)
+	assert.Equal(t, 1, doc.Failures)
+	assert.Equal(t, 1, doc.Skipped)
+
+	cases := doc.Suites[0].Cases
+	assert.NotNil(t, cases[0].Failure)
+	require.NotNil(t, cases[1].Skipped)
+	assert.Nil(t, cases[1].Failure)
+	assert.Equal(t, "confidence 0.30 is below 0.50", cases[1].Skipped.Message)
+	assert.Nil(t, cases[2].Failure, "closed signals pass")
+	assert.Nil(t, cases[2].Skipped)
+}
+
+func TestJUnitFormatter_EscapesText(t *testing.T) {
+	sig := signal.RawSignal{Source: "todos", Kind: "todo", Title: `TODO: handle <nil> & "empty" input`}
+	var buf bytes.Buffer
+	require.NoError(t, NewJUnitFormatter().Format([]signal.RawSignal{sig}, &buf))
+
+	doc := parseJUnit(t, buf.Bytes())
+	assert.Equal(t, sig.Title, doc.Suites[0].Cases[0].Name)
+}
+
+func TestJUnitFormatter_NoSignals(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, NewJUnitFormatter().Format(nil, &buf))
+
+	doc := parseJUnit(t, buf.Bytes())
+	assert.Zero(t, doc.Tests)
+	assert.Empty(t, doc.Suites)
+}
+
+func TestJUnitFormatter_WriteError(t *testing.T) {
+	err := NewJUnitFormatter().Format([]signal.RawSignal{{Kind: "todo", Title: "a"}}, &failWriter{})
+	assert.Error(t, err)
+}
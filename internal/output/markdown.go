@@ -8,6 +8,8 @@ import (
 	"io"
 	"sort"
 
+	"github.com/davetashner/stringer/internal/coverage"
+	"github.com/davetashner/stringer/internal/i18n"
 	"github.com/davetashner/stringer/internal/signal"
 )
 
@@ -16,10 +18,17 @@ func init() {
 }
 
 // MarkdownFormatter writes signals as a human-readable Markdown summary.
-type MarkdownFormatter struct{}
+type MarkdownFormatter struct {
+	catalog   *i18n.Catalog
+	quadrants *coverage.Quadrants
+}
 
-// Compile-time interface check.
-var _ Formatter = (*MarkdownFormatter)(nil)
+// Compile-time interface checks.
+var (
+	_ Formatter        = (*MarkdownFormatter)(nil)
+	_ Localizer        = (*MarkdownFormatter)(nil)
+	_ QuadrantReporter = (*MarkdownFormatter)(nil)
+)
 
 // NewMarkdownFormatter returns a new MarkdownFormatter.
 func NewMarkdownFormatter() *MarkdownFormatter {
@@ -31,6 +40,18 @@ func (m *MarkdownFormatter) Name() string {
 	return "markdown"
 }
 
+// SetCatalog selects the language of the headings and summary lines.
+// Passing nil restores English.
+func (m *MarkdownFormatter) SetCatalog(c *i18n.Catalog) {
+	m.catalog = c
+}
+
+// SetQuadrants adds the churn × coverage grid after the priority table.
+// Passing nil removes it.
+func (m *MarkdownFormatter) SetQuadrants(q *coverage.Quadrants) {
+	m.quadrants = q
+}
+
 // Format writes all signals as a grouped Markdown document to w.
 //
 // When signals span multiple workspaces, output is grouped by workspace first,
@@ -49,15 +70,22 @@ func (m *MarkdownFormatter) Format(signals []signal.RawSignal, w io.Writer) erro
 	prioDist := priorityDistribution(signals)
 
 	// Write header.
-	if err := writeHeader(w, len(signals), collectorNames); err != nil {
+	if err := writeHeader(w, m.catalog, len(signals), collectorNames); err != nil {
 		return err
 	}
 
 	// Write priority table.
-	if err := writePriorityTable(w, prioDist); err != nil {
+	if err := writePriorityTable(w, m.catalog, prioDist); err != nil {
 		return err
 	}
 
+	// Write churn × coverage grid.
+	if qs := summarizeQuadrants(m.quadrants); qs != nil {
+		if err := writeQuadrantTable(w, m.catalog, qs); err != nil {
+			return err
+		}
+	}
+
 	// Check if signals span multiple workspaces.
 	wsGroups := groupByWorkspace(signals)
 	if len(wsGroups) > 1 {
@@ -68,7 +96,7 @@ func (m *MarkdownFormatter) Format(signals []signal.RawSignal, w io.Writer) erro
 			}
 			wsCollGroups := groupByCollector(wsGroups[wsName])
 			for _, name := range sortedCollectorNames(wsCollGroups) {
-				if err := writeCollectorSection(w, name, wsCollGroups[name]); err != nil {
+				if err := writeCollectorSection(w, m.catalog, name, wsCollGroups[name]); err != nil {
 					return err
 				}
 			}
@@ -78,7 +106,7 @@ func (m *MarkdownFormatter) Format(signals []signal.RawSignal, w io.Writer) erro
 
 	// Single workspace or non-monorepo: group by collector only.
 	for _, name := range collectorNames {
-		if err := writeCollectorSection(w, name, groups[name]); err != nil {
+		if err := writeCollectorSection(w, m.catalog, name, groups[name]); err != nil {
 			return err
 		}
 	}
@@ -137,7 +165,7 @@ func sortedCollectorNames(groups map[string][]signal.RawSignal) []string {
 func priorityDistribution(signals []signal.RawSignal) [4]int {
 	var dist [4]int
 	for _, sig := range signals {
-		p := mapConfidenceToPriority(sig.Confidence)
+		p := signal.PriorityFromConfidence(sig.Confidence)
 		idx := p - 1
 		if idx >= 0 && idx < len(dist) {
 			dist[idx]++
@@ -147,8 +175,8 @@ func priorityDistribution(signals []signal.RawSignal) [4]int {
 }
 
 // writeHeader writes the Markdown title and summary line.
-func writeHeader(w io.Writer, total int, collectorNames []string) error {
-	if _, err := fmt.Fprintf(w, "# Stringer Scan Results\n\n"); err != nil {
+func writeHeader(w io.Writer, c *i18n.Catalog, total int, collectorNames []string) error {
+	if _, err := fmt.Fprintf(w, "# %s\n\n", c.T("markdown.title")); err != nil {
 		return fmt.Errorf("write header: %w", err)
 	}
 
@@ -161,7 +189,7 @@ func writeHeader(w io.Writer, total int, collectorNames []string) error {
 		collectorList += name
 	}
 
-	if _, err := fmt.Fprintf(w, "**Total signals:** %d | **Collectors:** %s\n\n", total, collectorList); err != nil {
+	if _, err := fmt.Fprintf(w, "%s\n\n", c.T("markdown.summary", total, collectorList)); err != nil {
 		return fmt.Errorf("write summary: %w", err)
 	}
 
@@ -169,8 +197,8 @@ func writeHeader(w io.Writer, total int, collectorNames []string) error {
 }
 
 // writePriorityTable writes the priority distribution table.
-func writePriorityTable(w io.Writer, dist [4]int) error {
-	if _, err := fmt.Fprintf(w, "| Priority | Count |\n"); err != nil {
+func writePriorityTable(w io.Writer, c *i18n.Catalog, dist [4]int) error {
+	if _, err := fmt.Fprintf(w, "| %s | %s |\n", c.T("markdown.priority"), c.T("markdown.count")); err != nil {
 		return fmt.Errorf("write priority table: %w", err)
 	}
 	if _, err := fmt.Fprintf(w, "|----------|-------|\n"); err != nil {
@@ -188,14 +216,14 @@ func writePriorityTable(w io.Writer, dist [4]int) error {
 }
 
 // writeCollectorSection writes a single collector's signals section.
-func writeCollectorSection(w io.Writer, name string, signals []signal.RawSignal) error {
-	if _, err := fmt.Fprintf(w, "## %s (%d signals)\n\n", name, len(signals)); err != nil {
+func writeCollectorSection(w io.Writer, c *i18n.Catalog, name string, signals []signal.RawSignal) error {
+	if _, err := fmt.Fprintf(w, "## %s\n\n", c.T("markdown.collector_heading", name, len(signals))); err != nil {
 		return fmt.Errorf("write collector heading: %w", err)
 	}
 
 	for _, sig := range signals {
-		loc := formatLocation(sig.FilePath, sig.Line)
-		if _, err := fmt.Fprintf(w, "- **%s** — `%s` (confidence: %.2f)\n", sig.Title, loc, sig.Confidence); err != nil {
+		// The ID is language-neutral, so it stays outside the catalog message.
+		if _, err := fmt.Fprintf(w, "- %s `%s`\n", c.T("markdown.signal", sig.Title, markdownLocation(sig), sig.Confidence), SignalID(sig, "str-")); err != nil {
 			return fmt.Errorf("write signal: %w", err)
 		}
 	}
@@ -219,3 +247,13 @@ func formatLocation(filePath string, line int) string {
 	}
 	return filePath
 }
+
+// markdownLocation formats a signal's location as inline code, linked to
+// the signal's forge URL when it has one.
+func markdownLocation(sig signal.RawSignal) string {
+	loc := "`" + formatLocation(sig.FilePath, sig.Line) + "`"
+	if sig.URL == "" {
+		return loc
+	}
+	return "[" + loc + "](" + sig.URL + ")"
+}
@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/davetashner/stringer/internal/i18n"
 	"github.com/davetashner/stringer/internal/signal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -189,7 +190,27 @@ func TestMarkdownFormat_SignalLine(t *testing.T) {
 	require.NoError(t, err)
 
 	output := buf.String()
-	assert.Contains(t, output, "- **Add rate limiting** — `internal/server/handler.go:42` (confidence: 0.85)")
+	assert.Contains(t, output, "- **Add rate limiting** — `internal/server/handler.go:42` (confidence: 0.85) `"+SignalID(signals[0], "str-")+"`\n")
+}
+
+func TestMarkdownFormat_SignalLine_URL(t *testing.T) {
+	f := NewMarkdownFormatter()
+	signals := []signal.RawSignal{
+		{
+			Source:     "todos",
+			Kind:       "todo",
+			Title:      "Add rate limiting",
+			FilePath:   "handler.go",
+			Line:       42,
+			Confidence: 0.85,
+			URL:        "https://github.com/o/r/blob/abc123/handler.go#L42",
+		},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, f.Format(signals, &buf))
+	assert.Contains(t, buf.String(),
+		"- **Add rate limiting** — [`handler.go:42`](https://github.com/o/r/blob/abc123/handler.go#L42) (confidence: 0.85)")
 }
 
 func TestMarkdownFormat_SignalLine_NoLine(t *testing.T) {
@@ -657,3 +678,26 @@ func TestMarkdownFormat_PriorityBoundaries(t *testing.T) {
 	assert.Contains(t, output, "| P3       | 2     |")
 	assert.Contains(t, output, "| P4       | 1     |")
 }
+
+func TestMarkdownFormat_Localized(t *testing.T) {
+	de, err := i18n.Load("de")
+	require.NoError(t, err)
+	f := NewMarkdownFormatter()
+	f.SetCatalog(de)
+	signals := []signal.RawSignal{
+		{Source: "todos", Kind: "todo", Title: "Fix this", FilePath: "main.go", Line: 3, Confidence: 0.5},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, f.Format(signals, &buf))
+	out := buf.String()
+	assert.True(t, strings.HasPrefix(out, "# Stringer-Scanergebnisse\n\n**Signale gesamt:** 1 | **Kollektoren:** todos\n"))
+	assert.Contains(t, out, "| Priorität | Anzahl |\n")
+	assert.Contains(t, out, "## todos (Signale: 1)\n")
+	assert.Contains(t, out, "- **Fix this** — `main.go:3` (Konfidenz: 0.50) `str-4f516237`\n", "signal titles are not translated")
+
+	f.SetCatalog(nil)
+	buf.Reset()
+	require.NoError(t, f.Format(signals, &buf))
+	assert.True(t, strings.HasPrefix(buf.String(), "# Stringer Scan Results\n"))
+}
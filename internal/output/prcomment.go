@@ -0,0 +1,251 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package output
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/davetashner/stringer/internal/i18n"
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+func init() {
+	RegisterFormatter(NewPRCommentFormatter())
+}
+
+// PRCommentMarker is an HTML comment emitted as the first line of every PR
+// comment so CI bots can find and update their previous comment instead of
+// posting a new one.
+const PRCommentMarker = "<!-- stringer:pr-comment -->"
+
+// maxPRCommentBytes keeps the comment safely under GitHub's 65,536
+// character limit for issue and pull request comment bodies.
+const maxPRCommentBytes = 60000
+
+// prCommentTopN is the number of highest-priority new signals shown inline.
+const prCommentTopN = 5
+
+// prCommentTitleMax truncates long signal titles in tables and lists.
+const prCommentTitleMax = 120
+
+// PRCommentFormatter writes a compact Markdown summary designed to be posted
+// on a pull request by a CI bot: new and resolved signal counts, budget
+// status, the top new items, and collapsible full lists. Output never
+// exceeds GitHub's comment size limit; overflowing lists are truncated with
+// a count of omitted items.
+type PRCommentFormatter struct {
+	// Resolved holds signals present in the previous scan but absent now.
+	// Populated by the scan command in --delta mode.
+	Resolved []signal.RawSignal
+
+	// Delta reports whether the signals passed to Format are only those new
+	// since the previous scan. When false, all signals are treated as new.
+	Delta bool
+
+	// Budget is the maximum number of new signals allowed. Zero means no
+	// budget is configured.
+	Budget int
+
+	// Scope, when set, replaces the description of what was compared shown
+	// next to the counts (default "full scan" or "since previous scan").
+	Scope string
+
+	catalog *i18n.Catalog
+}
+
+// Compile-time interface checks.
+var (
+	_ Formatter = (*PRCommentFormatter)(nil)
+	_ Localizer = (*PRCommentFormatter)(nil)
+)
+
+// NewPRCommentFormatter returns a new PRCommentFormatter.
+func NewPRCommentFormatter() *PRCommentFormatter {
+	return &PRCommentFormatter{}
+}
+
+// Name returns the format name.
+func (f *PRCommentFormatter) Name() string {
+	return "pr-comment"
+}
+
+// SetCatalog selects the language of the comment's headings and summary
+// lines. Passing nil restores English.
+func (f *PRCommentFormatter) SetCatalog(c *i18n.Catalog) {
+	f.catalog = c
+}
+
+// Format writes the PR comment to w.
+func (f *PRCommentFormatter) Format(signals []signal.RawSignal, w io.Writer) error {
+	var newSignals []signal.RawSignal
+	for _, sig := range signals {
+		// Pre-closed signals (e.g. resolved TODOs in delta mode) are not new work.
+		if sig.ClosedAt.IsZero() {
+			newSignals = append(newSignals, sig)
+		}
+	}
+	sortByPriority(newSignals)
+
+	resolved := make([]signal.RawSignal, len(f.Resolved))
+	copy(resolved, f.Resolved)
+	sortByLocation(resolved)
+
+	c := f.catalog
+	b := &prCommentBuilder{limit: maxPRCommentBytes, catalog: c}
+	b.line(PRCommentMarker)
+	b.line("### Stringer")
+	b.line("")
+
+	scope := c.T("prcomment.scope_full")
+	if f.Delta {
+		scope = c.T("prcomment.scope_delta")
+	}
+	if f.Scope != "" {
+		scope = f.Scope
+	}
+	b.line(c.T("prcomment.counts", len(newSignals), len(resolved), scope))
+	b.line("")
+	b.line(f.budgetStatus(len(newSignals)))
+	b.line("")
+
+	if len(newSignals) > 0 {
+		top := newSignals
+		if len(top) > prCommentTopN {
+			top = top[:prCommentTopN]
+		}
+		b.line("#### " + c.T("prcomment.top_new", len(top)))
+		b.line("")
+		b.line(fmt.Sprintf("| %s | %s | %s | %s |", c.T("prcomment.priority"), c.T("prcomment.kind"),
+			c.T("prcomment.location"), c.T("prcomment.title")))
+		b.line("|----------|------|----------|-------|")
+		for _, sig := range top {
+			b.line(fmt.Sprintf("| P%d | %s | %s | %s |",
+				signal.EffectivePriority(sig), sig.Kind, markdownLocation(sig),
+				escapeTableCell(truncateTitle(sig.Title))))
+		}
+		b.line("")
+	}
+
+	// Reserve room for closing both sections and the footer so truncation
+	// never leaves an unclosed <details> block.
+	footer := "<sub>" + c.T("prcomment.footer") + "</sub>\n"
+	b.reserve = 2*len(detailsClose) + len(footer) + 64
+
+	b.section(c.T("prcomment.new_signals", len(newSignals)), newSignals)
+	b.section(c.T("prcomment.resolved_signals", len(resolved)), resolved)
+
+	b.reserve = 0
+	b.line(strings.TrimSuffix(footer, "\n"))
+
+	_, err := io.WriteString(w, b.String())
+	if err != nil {
+		return fmt.Errorf("write pr comment: %w", err)
+	}
+	return nil
+}
+
+// budgetStatus renders the budget line for n new signals.
+func (f *PRCommentFormatter) budgetStatus(n int) string {
+	switch {
+	case f.Budget <= 0:
+		return f.catalog.T("prcomment.budget_none")
+	case n <= f.Budget:
+		return ":white_check_mark: " + f.catalog.T("prcomment.budget_within", n, f.Budget)
+	default:
+		return ":x: " + f.catalog.T("prcomment.budget_over", n, f.Budget, n-f.Budget)
+	}
+}
+
+const detailsClose = "\n</details>\n\n"
+
+// prCommentBuilder accumulates the comment body and enforces the size limit.
+type prCommentBuilder struct {
+	strings.Builder
+	limit   int
+	reserve int
+	catalog *i18n.Catalog
+}
+
+// line appends s and a newline.
+func (b *prCommentBuilder) line(s string) {
+	b.WriteString(s)
+	b.WriteByte('\n')
+}
+
+// fits reports whether n more bytes can be written without eating into the
+// reserved tail.
+func (b *prCommentBuilder) fits(n int) bool {
+	return b.Len()+n+b.reserve <= b.limit
+}
+
+// section writes a collapsible list of signals, truncating when the comment
+// would exceed its size limit. Empty lists are omitted.
+func (b *prCommentBuilder) section(summary string, signals []signal.RawSignal) {
+	if len(signals) == 0 {
+		return
+	}
+	open := fmt.Sprintf("<details>\n<summary>%s</summary>\n\n", summary)
+	if !b.fits(len(open) + len(detailsClose)) {
+		return
+	}
+	b.WriteString(open)
+	for i, sig := range signals {
+		entry := fmt.Sprintf("- %s %s (%s) `%s`\n",
+			markdownLocation(sig), truncateTitle(sig.Title), sig.Kind, SignalID(sig, "str-"))
+		// Leave room for the "omitted" note in case this is the last entry that fits.
+		if !b.fits(len(entry) + len(detailsClose) + 48) {
+			b.line("- " + b.catalog.T("prcomment.more", len(signals)-i))
+			break
+		}
+		b.WriteString(entry)
+	}
+	b.WriteString(detailsClose)
+}
+
+// sortByPriority orders signals by priority, then confidence descending,
+// then location for stable output.
+func sortByPriority(signals []signal.RawSignal) {
+	sort.SliceStable(signals, func(i, j int) bool {
+		pi, pj := signal.EffectivePriority(signals[i]), signal.EffectivePriority(signals[j])
+		if pi != pj {
+			return pi < pj
+		}
+		if signals[i].Confidence != signals[j].Confidence {
+			return signals[i].Confidence > signals[j].Confidence
+		}
+		if signals[i].FilePath != signals[j].FilePath {
+			return signals[i].FilePath < signals[j].FilePath
+		}
+		return signals[i].Line < signals[j].Line
+	})
+}
+
+// sortByLocation orders signals by file path, then line.
+func sortByLocation(signals []signal.RawSignal) {
+	sort.SliceStable(signals, func(i, j int) bool {
+		if signals[i].FilePath != signals[j].FilePath {
+			return signals[i].FilePath < signals[j].FilePath
+		}
+		return signals[i].Line < signals[j].Line
+	})
+}
+
+// truncateTitle shortens a title to prCommentTitleMax runes and flattens
+// newlines.
+func truncateTitle(title string) string {
+	title = strings.Join(strings.Fields(title), " ")
+	runes := []rune(title)
+	if len(runes) > prCommentTitleMax {
+		return string(runes[:prCommentTitleMax-1]) + "…"
+	}
+	return title
+}
+
+// escapeTableCell escapes characters that would break a Markdown table cell.
+func escapeTableCell(s string) string {
+	return strings.ReplaceAll(s, "|", `\|`)
+}
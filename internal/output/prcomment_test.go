@@ -0,0 +1,195 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package output
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/davetashner/stringer/internal/i18n"
+	"github.com/davetashner/stringer/internal/signal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPRCommentFormatter_Name(t *testing.T) {
+	f := NewPRCommentFormatter()
+	assert.Equal(t, "pr-comment", f.Name())
+}
+
+func TestPRCommentFormatter_Registered(t *testing.T) {
+	f, err := GetFormatter("pr-comment")
+	require.NoError(t, err)
+	assert.IsType(t, &PRCommentFormatter{}, f)
+}
+
+func TestPRCommentFormatter_Empty(t *testing.T) {
+	f := NewPRCommentFormatter()
+	var buf bytes.Buffer
+	require.NoError(t, f.Format(nil, &buf))
+
+	out := buf.String()
+	assert.True(t, strings.HasPrefix(out, PRCommentMarker+"\n"))
+	assert.Contains(t, out, "**0 new** · **0 resolved** (full scan)")
+	assert.Contains(t, out, "Budget: not configured")
+	assert.NotContains(t, out, "Top")
+	assert.NotContains(t, out, "<details>")
+}
+
+func TestPRCommentFormatter_TopItemsRankedByPriority(t *testing.T) {
+	p1 := 1
+	signals := []signal.RawSignal{
+		{Kind: "todo", FilePath: "a.go", Line: 1, Title: "low", Confidence: 0.3},
+		{Kind: "fixme", FilePath: "b.go", Line: 2, Title: "explicit p1", Confidence: 0.3, Priority: &p1},
+		{Kind: "todo", FilePath: "c.go", Line: 3, Title: "high", Confidence: 0.9},
+		{Kind: "todo", FilePath: "d.go", Line: 4, Title: "mid", Confidence: 0.65},
+		{Kind: "todo", FilePath: "e.go", Line: 5, Title: "mid2", Confidence: 0.61},
+		{Kind: "todo", FilePath: "f.go", Line: 6, Title: "lowest", Confidence: 0.1},
+	}
+
+	f := NewPRCommentFormatter()
+	var buf bytes.Buffer
+	require.NoError(t, f.Format(signals, &buf))
+	out := buf.String()
+
+	assert.Contains(t, out, "#### Top 5 new")
+	table := out[strings.Index(out, "| Priority"):strings.Index(out, "<details>")]
+	rows := strings.Split(strings.TrimSpace(table), "\n")[2:]
+	require.Len(t, rows, 5)
+	assert.Contains(t, rows[0], "high")
+	assert.Contains(t, rows[1], "explicit p1")
+	assert.Contains(t, rows[2], "| P2 | todo | `d.go:4` | mid |")
+	assert.Contains(t, rows[3], "mid2")
+	assert.Contains(t, rows[4], "| P4 | todo | `a.go:1` | low |")
+	assert.NotContains(t, table, "lowest")
+
+	// The full list still includes every new signal.
+	assert.Contains(t, out, "<summary>New signals (6)</summary>")
+	assert.Contains(t, out, "- `f.go:6` lowest (todo) `"+SignalID(signals[5], "str-")+"`\n", "entries carry the signal ID")
+}
+
+func TestPRCommentFormatter_ResolvedAndDelta(t *testing.T) {
+	f := NewPRCommentFormatter()
+	f.Delta = true
+	f.Resolved = []signal.RawSignal{
+		{Kind: "todo", FilePath: "z.go", Line: 9, Title: "gone later"},
+		{Kind: "todo", FilePath: "a.go", Line: 3, Title: "gone first"},
+	}
+	signals := []signal.RawSignal{
+		{Kind: "todo", FilePath: "new.go", Line: 1, Title: "fresh", Confidence: 0.5},
+		// Pre-closed resolved TODOs are not counted as new work.
+		{Kind: "todo", FilePath: "old.go", Line: 1, Title: "closed", ClosedAt: time.Now()},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, f.Format(signals, &buf))
+	out := buf.String()
+
+	assert.Contains(t, out, "**1 new** · **2 resolved** (since previous scan)")
+	assert.Contains(t, out, "<summary>Resolved signals (2)</summary>")
+	assert.Less(t, strings.Index(out, "gone first"), strings.Index(out, "gone later"))
+	assert.NotContains(t, out, "closed")
+}
+
+func TestPRCommentFormatter_Scope(t *testing.T) {
+	f := NewPRCommentFormatter()
+	f.Scope = "3 changed files"
+	var buf bytes.Buffer
+	require.NoError(t, f.Format(nil, &buf))
+	assert.Contains(t, buf.String(), "**0 new** · **0 resolved** (3 changed files)")
+}
+
+func TestPRCommentFormatter_Budget(t *testing.T) {
+	signals := []signal.RawSignal{
+		{Kind: "todo", FilePath: "a.go", Line: 1, Title: "one"},
+		{Kind: "todo", FilePath: "b.go", Line: 2, Title: "two"},
+	}
+
+	tests := []struct {
+		name   string
+		budget int
+		want   string
+	}{
+		{"within", 2, ":white_check_mark: **Within budget:** 2 of 2 new signals"},
+		{"over", 1, ":x: **Over budget:** 2 new signals exceed the budget of 1 by 1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := NewPRCommentFormatter()
+			f.Budget = tt.budget
+			var buf bytes.Buffer
+			require.NoError(t, f.Format(signals, &buf))
+			assert.Contains(t, buf.String(), tt.want)
+		})
+	}
+}
+
+func TestPRCommentFormatter_EscapesTableCells(t *testing.T) {
+	signals := []signal.RawSignal{
+		{Kind: "todo", FilePath: "a.go", Line: 1, Title: "a | b\nsecond line"},
+	}
+	f := NewPRCommentFormatter()
+	var buf bytes.Buffer
+	require.NoError(t, f.Format(signals, &buf))
+	assert.Contains(t, buf.String(), `| a \| b second line |`)
+}
+
+func TestPRCommentFormatter_TruncatesLongTitles(t *testing.T) {
+	signals := []signal.RawSignal{
+		{Kind: "todo", FilePath: "a.go", Line: 1, Title: strings.Repeat("x", 500)},
+	}
+	f := NewPRCommentFormatter()
+	var buf bytes.Buffer
+	require.NoError(t, f.Format(signals, &buf))
+	assert.Contains(t, buf.String(), strings.Repeat("x", prCommentTitleMax-1)+"…")
+	assert.NotContains(t, buf.String(), strings.Repeat("x", prCommentTitleMax))
+}
+
+func TestPRCommentFormatter_StaysUnderSizeLimit(t *testing.T) {
+	var signals, resolved []signal.RawSignal
+	for i := 0; i < 2000; i++ {
+		title := fmt.Sprintf("signal %d %s", i, strings.Repeat("y", 80))
+		signals = append(signals, signal.RawSignal{Kind: "todo", FilePath: "pkg/file.go", Line: i + 1, Title: title})
+		resolved = append(resolved, signal.RawSignal{Kind: "todo", FilePath: "pkg/old.go", Line: i + 1, Title: title})
+	}
+
+	f := NewPRCommentFormatter()
+	f.Resolved = resolved
+	var buf bytes.Buffer
+	require.NoError(t, f.Format(signals, &buf))
+	out := buf.String()
+
+	assert.LessOrEqual(t, len(out), maxPRCommentBytes)
+	assert.Contains(t, out, "<summary>New signals (2000)</summary>")
+	assert.Contains(t, out, "more\n")
+	assert.Equal(t, strings.Count(out, "<details>"), strings.Count(out, "</details>"))
+	assert.True(t, strings.HasSuffix(out, "<sub>Generated by stringer</sub>\n"))
+}
+
+func TestPRCommentFormatter_Localized(t *testing.T) {
+	ja, err := i18n.Load("ja")
+	require.NoError(t, err)
+	f := NewPRCommentFormatter()
+	f.SetCatalog(ja)
+	f.Delta = true
+	f.Budget = 1
+	signals := []signal.RawSignal{
+		{Kind: "todo", FilePath: "a.go", Line: 1, Title: "one"},
+		{Kind: "todo", FilePath: "b.go", Line: 2, Title: "two"},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, f.Format(signals, &buf))
+	out := buf.String()
+	assert.Contains(t, out, "**新規 2 件** · **解決 0 件** (前回のスキャン以降)")
+	assert.Contains(t, out, ":x: **予算超過:** 新規シグナル 2 件が予算 1 件を 1 件超えています")
+	assert.Contains(t, out, "#### 新規シグナル上位 2 件")
+	assert.Contains(t, out, "| 優先度 | 種類 | 場所 | タイトル |")
+	assert.Contains(t, out, "<summary>新規シグナル (2)</summary>")
+	assert.True(t, strings.HasSuffix(out, "<sub>stringer により生成</sub>\n"))
+	assert.True(t, strings.HasPrefix(out, PRCommentMarker+"\n### Stringer\n"), "the marker and heading stay fixed for bots")
+}
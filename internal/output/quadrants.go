@@ -0,0 +1,91 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package output
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/davetashner/stringer/internal/coverage"
+	"github.com/davetashner/stringer/internal/i18n"
+)
+
+// quadrantTopN is how many danger-quadrant files the reports list.
+const quadrantTopN = 10
+
+// quadrantSummary is the churn × coverage grid as the reports show it: a
+// file count per quadrant and the riskiest files.
+type quadrantSummary struct {
+	ChurnCutoff    int
+	LowCoveragePct int
+	Danger         int
+	Untested       int
+	Guarded        int
+	Stable         int
+	TopDanger      []quadrantFile
+}
+
+type quadrantFile struct {
+	Path     string
+	Changes  int
+	Coverage string
+}
+
+// summarizeQuadrants returns the report view of qs, or nil when there is
+// nothing to show.
+func summarizeQuadrants(qs *coverage.Quadrants) *quadrantSummary {
+	if qs == nil || len(qs.Files) == 0 {
+		return nil
+	}
+	s := &quadrantSummary{
+		ChurnCutoff:    qs.ChurnCutoff,
+		LowCoveragePct: int(coverage.LowCoverage * 100),
+		Danger:         qs.Count(coverage.Danger),
+		Untested:       qs.Count(coverage.Untested),
+		Guarded:        qs.Count(coverage.Guarded),
+		Stable:         qs.Count(coverage.Stable),
+	}
+	for _, f := range qs.Files {
+		if f.Quadrant != coverage.Danger || len(s.TopDanger) == quadrantTopN {
+			continue
+		}
+		s.TopDanger = append(s.TopDanger, quadrantFile{
+			Path:     f.Path,
+			Changes:  f.Changes,
+			Coverage: fmt.Sprintf("%.0f%%", f.Coverage*100),
+		})
+	}
+	return s
+}
+
+// writeQuadrantTable writes the churn × coverage grid and the riskiest
+// files as Markdown tables.
+func writeQuadrantTable(w io.Writer, c *i18n.Catalog, s *quadrantSummary) error {
+	lines := []string{
+		fmt.Sprintf("## %s\n\n", c.T("quadrant.heading")),
+		fmt.Sprintf("| | %s | %s |\n", c.T("quadrant.low_coverage", s.LowCoveragePct), c.T("quadrant.high_coverage")),
+		"|---|---|---|\n",
+		fmt.Sprintf("| **%s** | **%s: %d** | %s: %d |\n", c.T("quadrant.high_churn", s.ChurnCutoff),
+			c.T("quadrant.danger"), s.Danger, c.T("quadrant.guarded"), s.Guarded),
+		fmt.Sprintf("| **%s** | %s: %d | %s: %d |\n\n", c.T("quadrant.low_churn"),
+			c.T("quadrant.untested"), s.Untested, c.T("quadrant.stable"), s.Stable),
+	}
+	if len(s.TopDanger) > 0 {
+		lines = append(lines,
+			fmt.Sprintf("### %s\n\n", c.T("quadrant.top_danger")),
+			fmt.Sprintf("| %s | %s | %s |\n", c.T("quadrant.file"), c.T("quadrant.changes"), c.T("quadrant.coverage")),
+			"|------|---------|----------|\n",
+		)
+		for _, f := range s.TopDanger {
+			lines = append(lines, fmt.Sprintf("| `%s` | %d | %s |\n", f.Path, f.Changes, f.Coverage))
+		}
+		lines = append(lines, "\n")
+	}
+	for _, line := range lines {
+		if _, err := io.WriteString(w, line); err != nil {
+			return fmt.Errorf("write quadrant table: %w", err)
+		}
+	}
+	return nil
+}
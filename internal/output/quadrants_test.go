@@ -0,0 +1,121 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package output
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davetashner/stringer/internal/coverage"
+	"github.com/davetashner/stringer/internal/i18n"
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+func testQuadrants() *coverage.Quadrants {
+	return &coverage.Quadrants{
+		ChurnCutoff: 8,
+		Files: []coverage.FileQuadrant{
+			{Path: "internal/db/db.go", Changes: 21, Coverage: 0.125, Quadrant: coverage.Danger},
+			{Path: "internal/db/pool.go", Changes: 9, Coverage: 0.4, Quadrant: coverage.Danger},
+			{Path: "cmd/main.go", Changes: 2, Coverage: 0.1, Quadrant: coverage.Untested},
+			{Path: "internal/api/api.go", Changes: 12, Coverage: 0.9, Quadrant: coverage.Guarded},
+			{Path: "internal/api/util.go", Changes: 1, Coverage: 0.8, Quadrant: coverage.Stable},
+			{Path: "internal/api/types.go", Changes: 1, Coverage: 1, Quadrant: coverage.Stable},
+		},
+	}
+}
+
+var quadrantSignals = []signal.RawSignal{
+	{Source: "coverage", Kind: "risk-quadrant", Title: "High churn, low coverage: internal/db/db.go", FilePath: "internal/db/db.go", Confidence: 0.8},
+}
+
+func TestSummarizeQuadrants(t *testing.T) {
+	assert.Nil(t, summarizeQuadrants(nil))
+	assert.Nil(t, summarizeQuadrants(&coverage.Quadrants{ChurnCutoff: 3}))
+
+	s := summarizeQuadrants(testQuadrants())
+	require.NotNil(t, s)
+	assert.Equal(t, 8, s.ChurnCutoff)
+	assert.Equal(t, 50, s.LowCoveragePct)
+	assert.Equal(t, [4]int{2, 1, 1, 2}, [4]int{s.Danger, s.Untested, s.Guarded, s.Stable})
+	assert.Equal(t, []quadrantFile{
+		{Path: "internal/db/db.go", Changes: 21, Coverage: "12%"},
+		{Path: "internal/db/pool.go", Changes: 9, Coverage: "40%"},
+	}, s.TopDanger)
+
+	qs := &coverage.Quadrants{ChurnCutoff: 3}
+	for i := range quadrantTopN + 5 {
+		qs.Files = append(qs.Files, coverage.FileQuadrant{Path: fmt.Sprintf("f%d.go", i), Changes: 10, Quadrant: coverage.Danger})
+	}
+	assert.Len(t, summarizeQuadrants(qs).TopDanger, quadrantTopN)
+}
+
+func TestMarkdownFormat_Quadrants(t *testing.T) {
+	f := NewMarkdownFormatter()
+	f.SetQuadrants(testQuadrants())
+	defer f.SetQuadrants(nil)
+
+	var buf bytes.Buffer
+	require.NoError(t, f.Format(quadrantSignals, &buf))
+	out := buf.String()
+	assert.Contains(t, out, "## Churn × Coverage\n\n"+
+		"| | Low coverage (<50%) | High coverage |\n"+
+		"|---|---|---|\n"+
+		"| **High churn (≥8 changes)** | **Danger: 2** | Guarded: 1 |\n"+
+		"| **Low churn** | Untested: 1 | Stable: 2 |\n")
+	assert.Contains(t, out, "### Highest-risk files\n\n| File | Changes | Coverage |\n|------|---------|----------|\n"+
+		"| `internal/db/db.go` | 21 | 12% |\n| `internal/db/pool.go` | 9 | 40% |\n")
+
+	f.SetQuadrants(nil)
+	buf.Reset()
+	require.NoError(t, f.Format(quadrantSignals, &buf))
+	assert.NotContains(t, buf.String(), "Churn × Coverage")
+}
+
+func TestMarkdownFormat_QuadrantsLocalized(t *testing.T) {
+	de, err := i18n.Load("de")
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	require.NoError(t, writeQuadrantTable(&buf, de, summarizeQuadrants(testQuadrants())))
+	assert.Contains(t, buf.String(), "| **Häufig geändert (≥8 Änderungen)** | **Gefahr: 2** | Abgesichert: 1 |")
+}
+
+func TestMarkdownFormat_QuadrantsWriteFailure(t *testing.T) {
+	err := writeQuadrantTable(&mdFailWriter{failAfter: 2}, nil, summarizeQuadrants(testQuadrants()))
+	assert.ErrorContains(t, err, "write quadrant table")
+}
+
+func TestHTMLFormatter_Quadrants(t *testing.T) {
+	f := NewHTMLFormatter()
+	f.SetQuadrants(testQuadrants())
+
+	var buf bytes.Buffer
+	require.NoError(t, f.Format(quadrantSignals, &buf))
+	out := buf.String()
+	assert.Contains(t, out, `<div class="chart-box" id="quadrants"><h3>Churn × Coverage</h3>`)
+	assert.Contains(t, out, `<td class="quadrant-danger">Danger: 2</td><td>Guarded: 1</td>`)
+	assert.Contains(t, out, "<tr><td>internal/db/pool.go</td><td>9</td><td>40%</td></tr>")
+
+	f.SetQuadrants(nil)
+	buf.Reset()
+	require.NoError(t, f.Format(quadrantSignals, &buf))
+	assert.NotContains(t, buf.String(), `id="quadrants"`)
+}
+
+func TestHTMLDirFormatter_Quadrants(t *testing.T) {
+	dir := t.TempDir()
+	f := NewHTMLDirFormatter()
+	f.SetQuadrants(testQuadrants())
+	require.NoError(t, f.FormatDir(quadrantSignals, dir))
+
+	index, err := os.ReadFile(filepath.Join(dir, "index.html")) //nolint:gosec // test path
+	require.NoError(t, err)
+	assert.Contains(t, string(index), `<td class="quadrant-danger">Danger: 2</td>`)
+}
@@ -0,0 +1,136 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+func init() {
+	RegisterFormatter(NewRDJSONFormatter())
+}
+
+// RDJSONFormatter writes signals in Reviewdog's diagnostic format (rdjson),
+// so `reviewdog -f=rdjson` can post them as inline pull request comments.
+// Reviewdog filters the diagnostics down to the lines a pull request
+// changed. Only open signals with a file are written, since a comment
+// needs a place in the diff.
+type RDJSONFormatter struct{}
+
+// Compile-time interface check.
+var _ Formatter = (*RDJSONFormatter)(nil)
+
+// NewRDJSONFormatter returns a new RDJSONFormatter.
+func NewRDJSONFormatter() *RDJSONFormatter {
+	return &RDJSONFormatter{}
+}
+
+// Name returns the format name.
+func (f *RDJSONFormatter) Name() string {
+	return "rdjson"
+}
+
+// rdjson document types, following reviewdog's DiagnosticResult message.
+
+type rdjsonResult struct {
+	Source      rdjsonSource       `json:"source"`
+	Diagnostics []rdjsonDiagnostic `json:"diagnostics"`
+}
+
+type rdjsonSource struct {
+	Name string `json:"name"`
+	URL  string `json:"url,omitempty"`
+}
+
+type rdjsonDiagnostic struct {
+	Message  string         `json:"message"`
+	Location rdjsonLocation `json:"location"`
+	Severity string         `json:"severity"`
+	Code     rdjsonCode     `json:"code"`
+}
+
+type rdjsonLocation struct {
+	Path  string       `json:"path"`
+	Range *rdjsonRange `json:"range,omitempty"`
+}
+
+type rdjsonRange struct {
+	Start rdjsonPosition `json:"start"`
+}
+
+type rdjsonPosition struct {
+	Line int `json:"line"`
+}
+
+type rdjsonCode struct {
+	Value string `json:"value"`
+}
+
+// Format writes the signals as one rdjson document to w.
+func (f *RDJSONFormatter) Format(signals []signal.RawSignal, w io.Writer) error {
+	doc := rdjsonResult{
+		Source: rdjsonSource{
+			Name: "stringer",
+			URL:  "https://github.com/davetashner/stringer",
+		},
+		Diagnostics: []rdjsonDiagnostic{},
+	}
+	for _, sig := range signals {
+		if sig.FilePath == "" || !sig.ClosedAt.IsZero() {
+			continue
+		}
+		doc.Diagnostics = append(doc.Diagnostics, rdjsonDiagnosticFor(sig))
+	}
+
+	data, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal rdjson: %w", err)
+	}
+	if _, err := w.Write(data); err != nil {
+		return fmt.Errorf("write rdjson: %w", err)
+	}
+	if _, err := w.Write([]byte("\n")); err != nil {
+		return fmt.Errorf("write rdjson trailing newline: %w", err)
+	}
+	return nil
+}
+
+// rdjsonDiagnosticFor converts a signal to a diagnostic. Its code is the
+// signal's stable ID, so a comment can be traced back to the signal in
+// every other format and in baselines. A signal without a line is attached
+// to the whole file.
+func rdjsonDiagnosticFor(sig signal.RawSignal) rdjsonDiagnostic {
+	msg := sig.Title
+	if sig.Description != "" {
+		msg += "\n\n" + sig.Description
+	}
+	d := rdjsonDiagnostic{
+		Message:  msg,
+		Location: rdjsonLocation{Path: sig.FilePath},
+		Severity: priorityToRDJSONSeverity(signal.EffectivePriority(sig)),
+		Code:     rdjsonCode{Value: SignalID(sig, "str-")},
+	}
+	if sig.Line > 0 {
+		d.Location.Range = &rdjsonRange{Start: rdjsonPosition{Line: sig.Line}}
+	}
+	return d
+}
+
+// priorityToRDJSONSeverity maps a P1-P4 priority to an rdjson severity,
+// matching the SARIF levels: P1 is an error, P2 a warning, and P3 and P4
+// are informational.
+func priorityToRDJSONSeverity(priority int) string {
+	switch priority {
+	case 1:
+		return "ERROR"
+	case 2:
+		return "WARNING"
+	default:
+		return "INFO"
+	}
+}
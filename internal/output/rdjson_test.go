@@ -0,0 +1,101 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/davetashner/stringer/internal/signal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRDJSONFormatter_Registration(t *testing.T) {
+	f, err := GetFormatter("rdjson")
+	require.NoError(t, err)
+	assert.Equal(t, "rdjson", f.Name())
+}
+
+func TestRDJSONFormatter_Format(t *testing.T) {
+	p4 := 4
+	signals := []signal.RawSignal{
+		{Source: "todos", Kind: "todo", FilePath: "main.go", Line: 12, Title: "TODO: handle errors",
+			Description: "Left by alice", Confidence: 0.85},
+		{Source: "lotteryrisk", Kind: "low-lottery-risk", FilePath: "internal/auth", Title: "One owner", Confidence: 0.6},
+		{Source: "todos", Kind: "todo", FilePath: "a.go", Line: 1, Title: "TODO: later", Confidence: 0.9, Priority: &p4},
+		{Source: "gitlog", Kind: "revert", Title: "Reverted commit", Confidence: 0.9},
+		{Source: "todos", Kind: "todo", FilePath: "b.go", Line: 2, Title: "TODO: done", Confidence: 0.9, ClosedAt: time.Now()},
+	}
+	var buf bytes.Buffer
+	require.NoError(t, NewRDJSONFormatter().Format(signals, &buf))
+
+	var doc rdjsonResult
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &doc))
+	assert.Equal(t, rdjsonSource{Name: "stringer", URL: "https://github.com/davetashner/stringer"}, doc.Source)
+	assert.Equal(t, []rdjsonDiagnostic{
+		{
+			Message:  "TODO: handle errors\n\nLeft by alice",
+			Location: rdjsonLocation{Path: "main.go", Range: &rdjsonRange{Start: rdjsonPosition{Line: 12}}},
+			Severity: "ERROR",
+			Code:     rdjsonCode{Value: SignalID(signals[0], "str-")},
+		},
+		{
+			Message:  "One owner",
+			Location: rdjsonLocation{Path: "internal/auth"},
+			Severity: "WARNING",
+			Code:     rdjsonCode{Value: SignalID(signals[1], "str-")},
+		},
+		{
+			Message:  "TODO: later",
+			Location: rdjsonLocation{Path: "a.go", Range: &rdjsonRange{Start: rdjsonPosition{Line: 1}}},
+			Severity: "INFO",
+			Code:     rdjsonCode{Value: SignalID(signals[2], "str-")},
+		},
+	}, doc.Diagnostics)
+}
+
+func TestRDJSONFormatter_CodeIsSignalID(t *testing.T) {
+	sig := signal.RawSignal{Source: "todos", Kind: "todo", FilePath: "main.go", Line: 3, Title: "TODO: retry"}
+	var buf bytes.Buffer
+	require.NoError(t, NewRDJSONFormatter().Format([]signal.RawSignal{sig}, &buf))
+
+	var doc struct {
+		Diagnostics []struct {
+			Code struct {
+				Value string `json:"value"`
+			} `json:"code"`
+		} `json:"diagnostics"`
+	}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &doc))
+	require.Len(t, doc.Diagnostics, 1)
+	assert.Equal(t, "str-"+signal.Hash(sig), doc.Diagnostics[0].Code.Value)
+
+	var csv bytes.Buffer
+	require.NoError(t, NewCSVFormatter().Format([]signal.RawSignal{sig}, &csv))
+	assert.Contains(t, csv.String(), doc.Diagnostics[0].Code.Value, "the same ID as the other formats")
+}
+
+func TestRDJSONFormatter_NoSignals(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, NewRDJSONFormatter().Format(nil, &buf))
+
+	var doc map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &doc))
+	assert.Equal(t, []any{}, doc["diagnostics"], "an empty scan still writes a diagnostics array")
+}
+
+func TestPriorityToRDJSONSeverity(t *testing.T) {
+	assert.Equal(t, "ERROR", priorityToRDJSONSeverity(1))
+	assert.Equal(t, "WARNING", priorityToRDJSONSeverity(2))
+	assert.Equal(t, "INFO", priorityToRDJSONSeverity(3))
+	assert.Equal(t, "INFO", priorityToRDJSONSeverity(4))
+}
+
+func TestRDJSONFormatter_WriteError(t *testing.T) {
+	err := NewRDJSONFormatter().Format([]signal.RawSignal{{Kind: "todo", FilePath: "a.go", Title: "a"}}, &failWriter{})
+	assert.Error(t, err)
+}
@@ -8,12 +8,14 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"net/url"
 	"os"
 	"path/filepath"
 	"slices"
 	"strings"
 
 	"github.com/davetashner/stringer/internal/baseline"
+	"github.com/davetashner/stringer/internal/labels"
 	"github.com/davetashner/stringer/internal/signal"
 	"github.com/google/uuid"
 )
@@ -49,6 +51,8 @@ type SARIFFormatter struct {
 	// baseline comparison. When set, results receive baselineState
 	// values (new, unchanged, absent) per SARIF §3.27.24.
 	SARIFBaseline *sarifDocument
+
+	labelMap labels.Map
 }
 
 // Compile-time interface check.
@@ -62,6 +66,12 @@ func NewSARIFFormatter() *SARIFFormatter {
 // Name returns the format name.
 func (f *SARIFFormatter) Name() string { return "sarif" }
 
+// SetLabelMap configures the label rules appended to each result's tags.
+// Passing nil removes them.
+func (f *SARIFFormatter) SetLabelMap(m labels.Map) {
+	f.labelMap = m
+}
+
 // Format writes all signals as a SARIF v2.1.0 document to w.
 func (f *SARIFFormatter) Format(signals []signal.RawSignal, w io.Writer) error {
 	if signals == nil {
@@ -234,22 +244,39 @@ func (f *SARIFFormatter) buildAutomationDetails() *sarifRunAutomation {
 	}
 }
 
-// buildRules collects unique signal kinds into SARIF rule objects.
-// Returns the rules and a map from kind to rule index.
+// buildRules collects unique signal kinds into SARIF rule objects, along
+// with the rules of the SARIF baseline's results so absent results still
+// reference a rule in this run. Returns the rules and a map from kind to
+// rule index.
 func (f *SARIFFormatter) buildRules(signals []signal.RawSignal) ([]sarifRule, map[string]int, error) {
 	ruleIndex := make(map[string]int)
-	var rules []sarifRule
 
 	// Collect unique kinds in stable order.
 	var kinds []string
+	addKind := func(kind string) {
+		if _, exists := ruleIndex[kind]; !exists {
+			ruleIndex[kind] = -1 // placeholder
+			kinds = append(kinds, kind)
+		}
+	}
 	for _, sig := range signals {
-		if _, exists := ruleIndex[sig.Kind]; !exists {
-			ruleIndex[sig.Kind] = -1 // placeholder
-			kinds = append(kinds, sig.Kind)
+		addKind(sig.Kind)
+	}
+	if f.SARIFBaseline != nil {
+		for _, run := range f.SARIFBaseline.Runs {
+			for _, prev := range run.Results {
+				if prev.RuleID != "" {
+					addKind(prev.RuleID)
+				}
+			}
 		}
 	}
 	slices.Sort(kinds)
 
+	// The schema requires an array, so an empty run has no rules rather
+	// than null ones.
+	rules := make([]sarifRule, 0, len(kinds))
+
 	// Build rules in sorted order and update index.
 	for i, kind := range kinds {
 		ruleIndex[kind] = i
@@ -288,7 +315,7 @@ func (f *SARIFFormatter) buildResults(signals []signal.RawSignal, ruleIndex map[
 	fileCache := make(map[string][]string)
 
 	for _, sig := range signals {
-		priority := mapConfidenceToPriority(sig.Confidence)
+		priority := signal.PriorityFromConfidence(sig.Confidence)
 		if sig.Priority != nil {
 			priority = *sig.Priority
 		}
@@ -310,7 +337,7 @@ func (f *SARIFFormatter) buildResults(signals []signal.RawSignal, ruleIndex map[
 			loc := sarifLocation{
 				PhysicalLocation: sarifPhysicalLocation{
 					ArtifactLocation: sarifArtifactLocation{
-						URI:       sig.FilePath,
+						URI:       artifactURI(sig.FilePath),
 						URIBaseID: "%SRCROOT%",
 					},
 				},
@@ -329,7 +356,14 @@ func (f *SARIFFormatter) buildResults(signals []signal.RawSignal, ruleIndex map[
 			result.Locations = []sarifLocation{loc}
 		}
 
+		// The "str-" ID matches the other formats; the stringer/v1 fingerprint
+		// is the same hash without the prefix.
 		props := make(map[string]json.RawMessage)
+		idData, err := marshalJSON(SignalID(sig, "str-"))
+		if err != nil {
+			return nil, fmt.Errorf("marshal id for signal %q: %w", sig.Title, err)
+		}
+		props["id"] = idData
 		if sig.Source != "" {
 			data, err := marshalJSON(sig.Source)
 			if err != nil {
@@ -344,16 +378,21 @@ func (f *SARIFFormatter) buildResults(signals []signal.RawSignal, ruleIndex map[
 			}
 			props["author"] = data
 		}
-		if len(sig.Tags) > 0 {
-			data, err := marshalJSON(sig.Tags)
+		if sig.URL != "" {
+			data, err := marshalJSON(sig.URL)
+			if err != nil {
+				return nil, fmt.Errorf("marshal url for signal %q: %w", sig.Title, err)
+			}
+			props["url"] = data
+		}
+		if tags := f.labelMap.Append(labels.SARIF, sig, slices.Clone(sig.Tags)); len(tags) > 0 {
+			data, err := marshalJSON(tags)
 			if err != nil {
 				return nil, fmt.Errorf("marshal tags for signal %q: %w", sig.Title, err)
 			}
 			props["tags"] = data
 		}
-		if len(props) > 0 {
-			result.Properties = props
-		}
+		result.Properties = props
 
 		// SA5.1: Map baseline suppressions to SARIF suppressions.
 		sigID := SignalID(sig, f.BaselinePrefix)
@@ -382,7 +421,7 @@ func (f *SARIFFormatter) buildResults(signals []signal.RawSignal, ruleIndex map[
 		for _, r := range results {
 			currentFPs[r.PartialFingerprints["stringer/v1"]] = true
 		}
-		absentResults := f.buildAbsentResults(prevFingerprints, currentFPs)
+		absentResults := f.buildAbsentResults(prevFingerprints, currentFPs, ruleIndex)
 		results = append(results, absentResults...)
 	}
 
@@ -435,8 +474,9 @@ func extractFingerprints(doc *sarifDocument) map[string]bool {
 }
 
 // buildAbsentResults creates placeholder results for fingerprints that existed
-// in the previous SARIF baseline but are absent from the current scan.
-func (f *SARIFFormatter) buildAbsentResults(prevFPs map[string]bool, currentFPs map[string]bool) []sarifResult {
+// in the previous SARIF baseline but are absent from the current scan. Rule
+// indexes are remapped to this run's rules.
+func (f *SARIFFormatter) buildAbsentResults(prevFPs map[string]bool, currentFPs map[string]bool, ruleIndex map[string]int) []sarifResult {
 	if f.SARIFBaseline == nil {
 		return nil
 	}
@@ -448,10 +488,14 @@ func (f *SARIFFormatter) buildAbsentResults(prevFPs map[string]bool, currentFPs
 			if fp == "" || currentFPs[fp] {
 				continue
 			}
+			idx, ok := ruleIndex[prev.RuleID]
+			if !ok {
+				idx = -1 // SARIF's "no rule index"
+			}
 			// Clone the previous result and mark as absent.
 			r := sarifResult{
 				RuleID:        prev.RuleID,
-				RuleIndex:     prev.RuleIndex,
+				RuleIndex:     idx,
 				Level:         prev.Level,
 				Rank:          prev.Rank,
 				BaselineState: "absent",
@@ -468,6 +512,13 @@ func (f *SARIFFormatter) buildAbsentResults(prevFPs map[string]bool, currentFPs
 	return absent
 }
 
+// artifactURI returns a repository-relative file path as the relative URI
+// reference SARIF requires, percent-encoding characters such as spaces and
+// '#' that would otherwise change its meaning.
+func artifactURI(filePath string) string {
+	return (&url.URL{Path: filePath}).EscapedPath()
+}
+
 // extractSnippet reads up to 3 lines of context around the target line.
 // Returns the joined lines or empty string if the file cannot be read.
 // Uses fileCache to avoid re-reading the same file.
@@ -544,40 +595,62 @@ func priorityToSARIFLevel(priority int) string {
 // ruleDescription returns a human-readable description for a signal kind.
 func ruleDescription(kind string) string {
 	descriptions := map[string]string{
-		"todo":                  "Unresolved TODO comment in source code",
-		"fixme":                 "FIXME comment indicating a known issue",
-		"hack":                  "HACK comment indicating a workaround",
-		"xxx":                   "XXX comment flagging problematic code",
-		"optimize":              "OPTIMIZE comment suggesting performance improvement",
-		"bug":                   "BUG comment marking a known defect",
-		"revert":                "Git revert commit detected",
-		"churn":                 "High file churn detected in recent history",
-		"stale-branch":          "Stale branch with no recent activity",
-		"large-file":            "Source file exceeds size threshold",
-		"missing-tests":         "Source file has no corresponding test file",
-		"low-test-ratio":        "Directory has low test-to-source file ratio",
-		"low-lottery-risk":      "File has concentrated code ownership",
-		"review-concentration":  "Code reviews concentrated among few reviewers",
-		"vuln":                  "Known vulnerability in dependency",
-		"complexity":            "High cyclomatic complexity detected",
-		"deadcode":              "Potentially unused code detected",
-		"merge-conflict-marker": "Unresolved merge conflict marker in file",
-		"committed-secret":      "Potential secret committed to repository",
-		"large-binary":          "Large binary file committed to repository",
-		"mixed-line-endings":    "File has inconsistent line endings",
-		"stale-doc":             "Documentation may be outdated",
-		"undocumented-route":    "API route without documentation",
-		"unimplemented-route":   "Documented API route without implementation",
-		"stale-api-version":     "API version with no recent changes",
-		"env-var-drift":         "Environment variable referenced but not documented",
-		"dead-config-key":       "Configuration key defined but not referenced",
-		"inconsistent-defaults": "Configuration defaults differ across locations",
-		"deprecated-dependency": "Dependency is deprecated by its maintainer",
-		"archived-dependency":   "Dependency repository is archived",
-		"stale-dependency":      "Dependency has not been updated recently",
-		"yanked-dependency":     "Dependency version has been yanked",
-		"local-replace":         "Go module uses a local replace directive",
-		"retracted-version":     "Go module uses a retracted version",
+		"todo":                   "Unresolved TODO comment in source code",
+		"fixme":                  "FIXME comment indicating a known issue",
+		"hack":                   "HACK comment indicating a workaround",
+		"xxx":                    "XXX comment flagging problematic code",
+		"optimize":               "OPTIMIZE comment suggesting performance improvement",
+		"bug":                    "BUG comment marking a known defect",
+		"revert":                 "Git revert commit detected",
+		"churn":                  "High file churn detected in recent history",
+		"churn-quality":          "File history shows fix-up streaks, revert chains, or force pushes",
+		"stale-branch":           "Stale branch with no recent activity",
+		"large-file":             "Source file exceeds size threshold",
+		"missing-tests":          "Source file has no corresponding test file",
+		"low-test-ratio":         "Directory has low test-to-source file ratio",
+		"low-lottery-risk":       "File has concentrated code ownership",
+		"review-concentration":   "Code reviews concentrated among few reviewers",
+		"knowledge-split":        "Tests and production code are written by different people",
+		"vuln":                   "Known vulnerability in dependency",
+		"complexity":             "High cyclomatic complexity detected",
+		"deadcode":               "Potentially unused code detected",
+		"merge-conflict-marker":  "Unresolved merge conflict marker in file",
+		"committed-secret":       "Potential secret committed to repository",
+		"large-binary":           "Large binary file committed to repository",
+		"mixed-line-endings":     "File has inconsistent line endings",
+		"tooling-hygiene":        "Stringer state file is tracked in git",
+		"stale-doc":              "Documentation may be outdated",
+		"undocumented-route":     "API route without documentation",
+		"unimplemented-route":    "Documented API route without implementation",
+		"stale-api-version":      "API version with no recent changes",
+		"env-var-drift":          "Environment variable referenced but not documented",
+		"dead-config-key":        "Configuration key defined but not referenced",
+		"inconsistent-defaults":  "Configuration defaults differ across locations",
+		"deprecated-dependency":  "Dependency is deprecated by its maintainer",
+		"archived-dependency":    "Dependency repository is archived",
+		"stale-dependency":       "Dependency has not been updated recently",
+		"yanked-dependency":      "Dependency version has been yanked",
+		"local-replace":          "Go module uses a local replace directive",
+		"retracted-version":      "Go module uses a retracted version",
+		"version-skew":           "Dependency declared at different versions across workspaces",
+		"slow-test":              "Test exceeds its package latency budget",
+		"architecture-violation": "Import violates the architecture rules",
+		"large-batch-pattern":    "Merged pull requests in module are typically oversized",
+		"risk-quadrant":          "Frequently changed file has low test coverage",
+		"ci-risk":                "GitHub Actions workflow uses a risky pattern",
+		"vet-finding":            "go vet reported a suspicious construct",
+		"staticcheck-bug":        "staticcheck reported a likely bug",
+		"staticcheck-unused":     "staticcheck reported unused code",
+		"staticcheck-simplify":   "staticcheck suggests a simplification",
+		"staticcheck-style":      "staticcheck reported a style issue",
+		"lint-finding":           "Linter report contains a finding",
+		"low-coverage":           "File has low measured test coverage",
+		"deprecated-usage":       "Code uses a deprecated API",
+		"repo-hygiene":           "Large binary, generated artifact, or history bloat in git",
+		"release-hygiene":        "Unreleased work, missing changelog entries, or a tag without release notes",
+		"breaking-change":        "Exported Go API removed or changed incompatibly since the last release",
+		"test-debt":              "Test skipped for a long time, without assertions, or excluded from the build",
+		"perf-regression":        "Benchmark slower or allocating more than at the baseline commit",
 	}
 	if desc, ok := descriptions[kind]; ok {
 		return desc
@@ -604,14 +677,15 @@ func kindToCollector(kind string) string {
 	collectorMap := map[string]string{
 		"todo": "todos", "fixme": "todos", "hack": "todos",
 		"xxx": "todos", "optimize": "todos", "bug": "todos",
-		"revert": "gitlog", "churn": "gitlog", "stale-branch": "gitlog",
+		"revert": "gitlog", "churn": "gitlog", "churn-quality": "gitlog", "stale-branch": "gitlog",
 		"large-file": "patterns", "missing-tests": "patterns", "low-test-ratio": "patterns",
 		"low-lottery-risk": "lotteryrisk", "review-concentration": "lotteryrisk",
+		"knowledge-split":       "lotteryrisk",
 		"vuln":                  "vuln",
 		"complexity":            "complexity",
 		"deadcode":              "deadcode",
 		"merge-conflict-marker": "githygiene", "committed-secret": "githygiene",
-		"large-binary": "githygiene", "mixed-line-endings": "githygiene",
+		"large-binary": "githygiene", "mixed-line-endings": "githygiene", "tooling-hygiene": "githygiene",
 		"stale-doc":          "docstale",
 		"undocumented-route": "apidrift", "unimplemented-route": "apidrift",
 		"stale-api-version": "apidrift",
@@ -619,7 +693,17 @@ func kindToCollector(kind string) string {
 		"inconsistent-defaults": "configdrift",
 		"deprecated-dependency": "dephealth", "archived-dependency": "dephealth",
 		"stale-dependency": "dephealth", "yanked-dependency": "dephealth",
-		"local-replace": "dephealth", "retracted-version": "dephealth",
+		"local-replace": "dephealth", "retracted-version": "dephealth", "version-skew": "dephealth",
+		"slow-test": "testtiming", "large-batch-pattern": "github",
+		"architecture-violation": "architecture",
+		"ci-risk":                "workflows",
+		"vet-finding":            "lint", "staticcheck-bug": "lint", "staticcheck-unused": "lint",
+		"staticcheck-simplify": "lint", "staticcheck-style": "lint",
+		"lint-finding": "lint-report", "low-coverage": "coverage",
+		"deprecated-usage": "deprecation", "repo-hygiene": "assets",
+		"release-hygiene": "releases", "breaking-change": "apicompat",
+		"test-debt":       "testquality",
+		"perf-regression": "bench",
 	}
 	return collectorMap[kind]
 }
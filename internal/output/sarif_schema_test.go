@@ -0,0 +1,248 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/davetashner/stringer/internal/baseline"
+	"github.com/davetashner/stringer/internal/labels"
+	"github.com/davetashner/stringer/internal/signal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Enumerations from the SARIF 2.1.0 JSON schema
+// (https://json.schemastore.org/sarif-2.1.0.json).
+var (
+	sarifLevels          = []string{"none", "note", "warning", "error"}
+	sarifBaselineStates  = []string{"new", "unchanged", "updated", "absent"}
+	sarifSuppressionKind = []string{"inSource", "external"}
+	sarifSuppressionStat = []string{"accepted", "underReview", "rejected"}
+)
+
+// validateSARIF checks a document against the SARIF 2.1.0 schema rules that
+// stringer's output exercises, plus the cross-references GitHub code
+// scanning enforces on upload. It decodes into generic values so fields the
+// formatter's own types would drop are checked too. It returns every
+// violation found.
+func validateSARIF(data []byte) []string {
+	var errs []string
+	fail := func(format string, args ...any) { errs = append(errs, fmt.Sprintf(format, args...)) }
+
+	var doc map[string]any
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return []string{"not JSON: " + err.Error()}
+	}
+	if doc["version"] != "2.1.0" {
+		fail("version is %v, want 2.1.0", doc["version"])
+	}
+	if s, _ := doc["$schema"].(string); s == "" {
+		fail("$schema is missing")
+	}
+	runs, ok := doc["runs"].([]any)
+	if !ok {
+		return append(errs, "runs is not an array")
+	}
+
+	for ri, r := range runs {
+		run, _ := r.(map[string]any)
+		driver, _ := dig(run, "tool", "driver").(map[string]any)
+		if name, _ := driver["name"].(string); name == "" {
+			fail("runs[%d].tool.driver.name is missing", ri)
+		}
+		rules, ok := driver["rules"].([]any)
+		if !ok {
+			fail("runs[%d].tool.driver.rules is not an array", ri)
+		}
+		ruleIDs := make(map[string]int)
+		for i, ru := range rules {
+			rule, _ := ru.(map[string]any)
+			id, _ := rule["id"].(string)
+			if id == "" {
+				fail("rules[%d].id is missing", i)
+			}
+			if prev, dup := ruleIDs[id]; dup {
+				fail("rules[%d].id %q duplicates rules[%d]", i, id, prev)
+			}
+			ruleIDs[id] = i
+			if text, _ := dig(rule, "shortDescription", "text").(string); text == "" {
+				fail("rules[%d].shortDescription.text is missing", i)
+			}
+			if lvl, ok := dig(rule, "defaultConfiguration", "level").(string); ok && !contains(sarifLevels, lvl) {
+				fail("rules[%d].defaultConfiguration.level %q is not a SARIF level", i, lvl)
+			}
+		}
+
+		results, ok := run["results"].([]any)
+		if !ok {
+			fail("runs[%d].results is not an array", ri)
+		}
+		for i, re := range results {
+			res, _ := re.(map[string]any)
+			if text, ok := dig(res, "message", "text").(string); !ok || text == "" {
+				fail("results[%d].message.text is missing", i)
+			}
+			ruleID, _ := res["ruleId"].(string)
+			idx, _ := res["ruleIndex"].(float64)
+			switch want, known := ruleIDs[ruleID]; {
+			case idx < -1:
+				fail("results[%d].ruleIndex %v is below -1", i, idx)
+			case idx >= 0 && int(idx) >= len(rules):
+				fail("results[%d].ruleIndex %v is out of range (%d rules)", i, idx, len(rules))
+			case idx >= 0 && (!known || int(idx) != want):
+				fail("results[%d].ruleIndex %v does not point at rule %q", i, idx, ruleID)
+			}
+			if lvl, ok := res["level"].(string); ok && !contains(sarifLevels, lvl) {
+				fail("results[%d].level %q is not a SARIF level", i, lvl)
+			}
+			if rank, ok := res["rank"].(float64); ok && (rank < -1 || rank > 100) {
+				fail("results[%d].rank %v is outside -1..100", i, rank)
+			}
+			if bs, ok := res["baselineState"].(string); ok && !contains(sarifBaselineStates, bs) {
+				fail("results[%d].baselineState %q is not a SARIF baseline state", i, bs)
+			}
+			if fps, ok := res["partialFingerprints"]; ok {
+				for k, v := range fps.(map[string]any) {
+					if _, ok := v.(string); !ok {
+						fail("results[%d].partialFingerprints[%q] is not a string", i, k)
+					}
+				}
+			}
+			if props, ok := res["properties"]; ok {
+				if _, ok := props.(map[string]any); !ok {
+					fail("results[%d].properties is not an object", i)
+				}
+			}
+			sups, _ := res["suppressions"].([]any)
+			for j, su := range sups {
+				sup, _ := su.(map[string]any)
+				if k, _ := sup["kind"].(string); !contains(sarifSuppressionKind, k) {
+					fail("results[%d].suppressions[%d].kind %q is not a SARIF suppression kind", i, j, k)
+				}
+				if st, ok := sup["status"].(string); ok && !contains(sarifSuppressionStat, st) {
+					fail("results[%d].suppressions[%d].status %q is not a SARIF suppression status", i, j, st)
+				}
+			}
+			locs, _ := res["locations"].([]any)
+			for j, lo := range locs {
+				phys, _ := dig(lo, "physicalLocation").(map[string]any)
+				uri, _ := dig(phys, "artifactLocation", "uri").(string)
+				if u, err := url.Parse(uri); uri == "" || err != nil || u.IsAbs() || strings.HasPrefix(uri, "/") || u.Fragment != "" || u.RawQuery != "" {
+					fail("results[%d].locations[%d] uri %q is not a relative URI reference to a file", i, j, uri)
+				}
+				if region, ok := phys["region"]; ok {
+					if line, _ := dig(region, "startLine").(float64); line < 1 {
+						fail("results[%d].locations[%d].region.startLine %v is below 1", i, j, line)
+					}
+				}
+			}
+		}
+	}
+	return errs
+}
+
+// dig walks nested JSON objects by key, returning nil when a key is absent.
+func dig(v any, keys ...string) any {
+	for _, k := range keys {
+		m, ok := v.(map[string]any)
+		if !ok {
+			return nil
+		}
+		v = m[k]
+	}
+	return v
+}
+
+func contains(values []string, v string) bool {
+	for _, s := range values {
+		if s == v {
+			return true
+		}
+	}
+	return false
+}
+
+func TestSARIFSchema_Empty(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, NewSARIFFormatter().Format(nil, &buf))
+	assert.Empty(t, validateSARIF(buf.Bytes()))
+}
+
+func TestSARIFSchema_AllFeatures(t *testing.T) {
+	p1 := 1
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "pkg"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "pkg", "a.go"), []byte("package pkg\n// TODO: one\nfunc A() {}\n"), 0o600))
+
+	prevSignals := []signal.RawSignal{
+		{Source: "todos", Kind: "todo", FilePath: "pkg/a.go", Line: 2, Title: "TODO: one", Confidence: 0.5},
+		{Source: "gitlog", Kind: "revert", FilePath: "gone.go", Line: 4, Title: "Reverted change", Confidence: 0.7},
+	}
+	var prevBuf bytes.Buffer
+	require.NoError(t, NewSARIFFormatter().Format(prevSignals, &prevBuf))
+	prevPath := filepath.Join(dir, "prev.sarif")
+	require.NoError(t, os.WriteFile(prevPath, prevBuf.Bytes(), 0o600))
+	prev, err := ParseSARIFBaseline(prevPath)
+	require.NoError(t, err)
+
+	signals := []signal.RawSignal{
+		prevSignals[0],
+		{Source: "patterns", Kind: "large-file", FilePath: "docs/user guide#2.md", Title: "Large file", Confidence: 1.0, Tags: []string{"size"}},
+		{Source: "vuln", Kind: "vuln", FilePath: "go.mod", Line: 9, Title: "CVE-2024-1", Confidence: 0.2, Priority: &p1, Author: "osv", URL: "https://osv.dev/x"},
+		{Source: "gitlog", Kind: "stale-branch", Title: "Branch old", Confidence: 0},
+	}
+	f := NewSARIFFormatter()
+	f.Version = "1.2.3"
+	f.RepoPath = dir
+	f.GitHead = "abc1234def"
+	f.SARIFBaseline = prev
+	f.BaselinePrefix = "str-"
+	f.Baseline = &baseline.BaselineState{Suppressions: []baseline.Suppression{
+		{SignalID: SignalID(signals[2], "str-"), Reason: baseline.ReasonWontFix, SuppressedAt: time.Now()},
+		{SignalID: SignalID(signals[3], "str-"), Reason: "other", SuppressedAt: time.Now()},
+	}}
+	f.SetLabelMap(labels.Map{{Kinds: []string{"vuln"}, Labels: []string{"security"}}})
+
+	var buf bytes.Buffer
+	require.NoError(t, f.Format(signals, &buf))
+	assert.Empty(t, validateSARIF(buf.Bytes()))
+
+	var doc sarifDocument
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &doc))
+	results := doc.Runs[0].Results
+	require.Len(t, results, 5)
+	assert.Equal(t, "absent", results[4].BaselineState)
+	assert.Equal(t, "revert", doc.Runs[0].Tool.Driver.Rules[results[4].RuleIndex].ID,
+		"an absent result's kind keeps a rule in the new run")
+	assert.Equal(t, "docs/user%20guide%232.md", results[1].Locations[0].PhysicalLocation.ArtifactLocation.URI)
+}
+
+func TestValidateSARIF_CatchesViolations(t *testing.T) {
+	doc := `{"version":"2.0.0","runs":[{"tool":{"driver":{"name":"x","rules":[
+		{"id":"a","shortDescription":{"text":"A"}},{"id":"a","shortDescription":{"text":"A"}}]}},
+		"results":[
+		{"ruleId":"a","ruleIndex":3,"level":"fatal","rank":150,"message":{"text":"m"},
+		 "locations":[{"physicalLocation":{"artifactLocation":{"uri":"/abs/path.go"},"region":{"startLine":0}}}],
+		 "suppressions":[{"kind":"inline"}],"baselineState":"gone"}]}]}`
+	errs := validateSARIF([]byte(doc))
+	for _, want := range []string{"version", "$schema", "duplicates", "out of range", "not a SARIF level",
+		"outside -1..100", "baseline state", "relative URI", "startLine", "suppression kind"} {
+		found := false
+		for _, e := range errs {
+			if strings.Contains(e, want) {
+				found = true
+			}
+		}
+		assert.True(t, found, "expected a violation mentioning %q in %v", want, errs)
+	}
+}
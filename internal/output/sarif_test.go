@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/davetashner/stringer/internal/baseline"
+	"github.com/davetashner/stringer/internal/labels"
 	"github.com/davetashner/stringer/internal/signal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -94,10 +95,14 @@ func TestSARIFFormatter_BasicSignal(t *testing.T) {
 	assert.Equal(t, 42, loc.Region.StartLine)
 
 	// Fingerprint
-	assert.NotEmpty(t, result.PartialFingerprints["stringer/v1"])
+	fp := result.PartialFingerprints["stringer/v1"]
+	assert.NotEmpty(t, fp)
 
 	// Properties
-	assert.NotNil(t, result.Properties)
+	require.NotNil(t, result.Properties)
+	var id string
+	require.NoError(t, json.Unmarshal(result.Properties["id"], &id))
+	assert.Equal(t, "str-"+fp, id, "the ID and the fingerprint share one hash")
 }
 
 func TestSARIFFormatter_NoLineOmitsRegion(t *testing.T) {
@@ -924,3 +929,34 @@ func TestParseSARIFBaseline_InvalidJSON(t *testing.T) {
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "parse sarif baseline")
 }
+
+func TestSARIFFormatter_LabelMap(t *testing.T) {
+	f := NewSARIFFormatter()
+	f.SetLabelMap(labels.Map{{Kinds: []string{"todo"}, Labels: []string{"tech-debt"}}})
+	t.Cleanup(func() { f.SetLabelMap(nil) })
+
+	sig := signal.RawSignal{Source: "todos", Kind: "todo", Title: "TODO: x", FilePath: "a.go", Confidence: 0.5}
+	var buf bytes.Buffer
+	require.NoError(t, f.Format([]signal.RawSignal{sig}, &buf))
+
+	var doc sarifDocument
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &doc))
+	var tags []string
+	require.NoError(t, json.Unmarshal(doc.Runs[0].Results[0].Properties["tags"], &tags))
+	assert.Equal(t, []string{"tech-debt"}, tags, "labels become tags even without signal tags")
+}
+
+func TestSARIFFormatter_URLProperty(t *testing.T) {
+	sig := signal.RawSignal{
+		Source: "todos", Kind: "todo", Title: "TODO: x", FilePath: "a.go", Line: 3, Confidence: 0.5,
+		URL: "https://bitbucket.org/o/r/src/abc123/a.go#lines-3",
+	}
+	var buf bytes.Buffer
+	require.NoError(t, NewSARIFFormatter().Format([]signal.RawSignal{sig}, &buf))
+
+	var doc sarifDocument
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &doc))
+	var u string
+	require.NoError(t, json.Unmarshal(doc.Runs[0].Results[0].Properties["url"], &u))
+	assert.Equal(t, sig.URL, u)
+}
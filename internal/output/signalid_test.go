@@ -85,7 +85,7 @@ func TestSignalID_FieldSensitivity(t *testing.T) {
 // encoding) fails loudly. Signal IDs are persisted in the beads JSONL,
 // baselines, and report output — changing them orphans existing records.
 // Do NOT update these values without a planned migration path. See the
-// stability contract in signalid.go.
+// stability contract on signal.Hash.
 func TestSignalID_StabilityContract(t *testing.T) {
 	cases := []struct {
 		name string
@@ -116,7 +116,7 @@ func TestSignalID_StabilityContract(t *testing.T) {
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
 			got := SignalID(tc.sig, "str-")
-			assert.Equal(t, tc.want, got, "hash composition is part of the stability contract; see signalid.go")
+			assert.Equal(t, tc.want, got, "hash composition is part of the stability contract; see signal.Hash")
 		})
 	}
 }
@@ -11,6 +11,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/davetashner/stringer/internal/labels"
 	"github.com/davetashner/stringer/internal/signal"
 )
 
@@ -41,6 +42,8 @@ type TasksFormatter struct {
 
 	// nowFunc is used for testing to override the current time.
 	nowFunc func() time.Time
+
+	labelMap labels.Map
 }
 
 // Compile-time interface check.
@@ -56,6 +59,12 @@ func (f *TasksFormatter) Name() string {
 	return "tasks"
 }
 
+// SetLabelMap configures the label rules recorded in each task's "labels"
+// metadata. Passing nil removes them.
+func (f *TasksFormatter) SetLabelMap(m labels.Map) {
+	f.labelMap = m
+}
+
 // Format writes all signals as a tasks JSON document to w.
 func (f *TasksFormatter) Format(signals []signal.RawSignal, w io.Writer) error {
 	if signals == nil {
@@ -71,7 +80,11 @@ func (f *TasksFormatter) Format(signals []signal.RawSignal, w io.Writer) error {
 
 	tasks := make([]taskRecord, 0, len(signals))
 	for _, s := range signals {
-		tasks = append(tasks, signalToTask(s))
+		task := signalToTask(s)
+		if l := f.labelMap.For(labels.Tasks, s); len(l) > 0 {
+			task.Metadata["labels"] = strings.Join(l, ",")
+		}
+		tasks = append(tasks, task)
 	}
 
 	envelope := TasksEnvelope{
@@ -213,12 +226,18 @@ func descriptionForSignal(s signal.RawSignal) string {
 			fmt.Fprintf(&b, "File: %s\n", s.FilePath)
 		}
 	}
+	if s.URL != "" {
+		fmt.Fprintf(&b, "URL: %s\n", s.URL)
+	}
 	if s.Author != "" {
 		fmt.Fprintf(&b, "Author: %s\n", s.Author)
 	}
+	if s.FunctionOwner != "" && s.FunctionOwner != s.Author {
+		fmt.Fprintf(&b, "Function owner: %s\n", s.FunctionOwner)
+	}
 	if s.Confidence > 0 {
 		fmt.Fprintf(&b, "Confidence: %.0f%%\n", s.Confidence*100)
-		priority := mapConfidenceToPriority(s.Confidence)
+		priority := signal.PriorityFromConfidence(s.Confidence)
 		fmt.Fprintf(&b, "Priority: P%d\n", priority)
 	}
 	if len(s.Tags) > 0 {
@@ -243,6 +262,9 @@ func metadataForSignal(s signal.RawSignal) map[string]string {
 	if s.Line > 0 {
 		m["line"] = fmt.Sprintf("%d", s.Line)
 	}
+	if s.URL != "" {
+		m["url"] = s.URL
+	}
 	if s.Confidence > 0 {
 		m["confidence"] = fmt.Sprintf("%.2f", s.Confidence)
 	}
@@ -252,6 +274,9 @@ func metadataForSignal(s signal.RawSignal) map[string]string {
 	if s.Author != "" {
 		m["author"] = s.Author
 	}
+	if s.FunctionOwner != "" {
+		m["function_owner"] = s.FunctionOwner
+	}
 	if !s.Timestamp.IsZero() {
 		m["timestamp"] = s.Timestamp.UTC().Format("2006-01-02T15:04:05Z")
 	}
@@ -261,6 +286,12 @@ func metadataForSignal(s signal.RawSignal) map[string]string {
 	if s.Workspace != "" {
 		m["workspace"] = s.Workspace
 	}
+	if s.Repo != "" {
+		m["repo"] = s.Repo
+	}
+	if s.Effort != "" {
+		m["effort"] = s.Effort
+	}
 
 	return m
 }
@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/davetashner/stringer/internal/labels"
 	"github.com/davetashner/stringer/internal/signal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -142,19 +143,21 @@ func TestTasksFormatter_ActiveFormCamelCase(t *testing.T) {
 func TestTasksFormatter_Description(t *testing.T) {
 	t.Run("full_signal", func(t *testing.T) {
 		s := signal.RawSignal{
-			Description: "This needs fixing urgently",
-			Source:      "todos",
-			FilePath:    "main.go",
-			Line:        42,
-			Author:      "alice",
-			Confidence:  0.85,
-			Tags:        []string{"security", "performance"},
+			Description:   "This needs fixing urgently",
+			Source:        "todos",
+			FilePath:      "main.go",
+			Line:          42,
+			Author:        "alice",
+			FunctionOwner: "bob",
+			Confidence:    0.85,
+			Tags:          []string{"security", "performance"},
 		}
 		got := descriptionForSignal(s)
 		assert.Contains(t, got, "This needs fixing urgently")
 		assert.Contains(t, got, "Source: todos collector")
 		assert.Contains(t, got, "File: main.go:42")
 		assert.Contains(t, got, "Author: alice")
+		assert.Contains(t, got, "Function owner: bob")
 		assert.Contains(t, got, "Confidence: 85%")
 		assert.Contains(t, got, "Priority: P1")
 		assert.Contains(t, got, "Tags: security, performance")
@@ -228,6 +231,25 @@ func TestTasksFormatter_Metadata(t *testing.T) {
 	assert.Equal(t, "security,perf", m["tags"])
 	assert.Equal(t, "alice", m["author"])
 	assert.Equal(t, "2026-01-15T10:30:00Z", m["timestamp"])
+
+	s.Effort = "M"
+	assert.Equal(t, "M", metadataForSignal(s)["effort"])
+	s.FunctionOwner = "bob"
+	assert.Equal(t, "bob", metadataForSignal(s)["function_owner"])
+}
+
+func TestTasksFormatter_URL(t *testing.T) {
+	s := signal.RawSignal{
+		Source:   "todos",
+		Kind:     "todo",
+		Title:    "Fix it",
+		FilePath: "main.go",
+		Line:     10,
+		URL:      "https://gitlab.com/g/r/-/blob/abc123/main.go#L10",
+	}
+
+	assert.Equal(t, s.URL, metadataForSignal(s)["url"])
+	assert.Contains(t, descriptionForSignal(s), "URL: https://gitlab.com/g/r/-/blob/abc123/main.go#L10")
 }
 
 func TestTasksFormatter_MetadataMinimal(t *testing.T) {
@@ -248,6 +270,8 @@ func TestTasksFormatter_MetadataMinimal(t *testing.T) {
 	assert.False(t, hasTimestamp)
 	_, hasClosedAt := m["closed_at"]
 	assert.False(t, hasClosedAt)
+	_, hasEffort := m["effort"]
+	assert.False(t, hasEffort)
 }
 
 func TestTasksFormatter_MetadataClosedAt(t *testing.T) {
@@ -549,3 +573,20 @@ func TestTasksFormatter_AutoDetectPipe(t *testing.T) {
 func newTestTasksFormatter() *TasksFormatter {
 	return &TasksFormatter{nowFunc: fixedNow}
 }
+
+func TestTasksFormatter_LabelMap(t *testing.T) {
+	f := newTestTasksFormatter()
+	f.SetLabelMap(labels.Map{
+		{Tags: []string{"security"}, Labels: []string{"security", "sec-review"}},
+		{Collectors: []string{"todos"}, Labels: []string{"todo"}, Exporters: map[string][]string{labels.Tasks: {"todo-task"}}},
+	})
+
+	var buf bytes.Buffer
+	require.NoError(t, f.Format([]signal.RawSignal{testSignal(), {Source: "gitlog", Kind: "revert", Title: "x"}}, &buf))
+
+	var envelope TasksEnvelope
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &envelope))
+	require.Len(t, envelope.Tasks, 2)
+	assert.Equal(t, "security,sec-review,todo-task", envelope.Tasks[0].Metadata["labels"])
+	assert.NotContains(t, envelope.Tasks[1].Metadata, "labels")
+}
@@ -4,6 +4,7 @@
 package pipeline
 
 import (
+	"reflect"
 	"testing"
 
 	"github.com/davetashner/stringer/internal/signal"
@@ -164,6 +165,21 @@ func TestDeduplicateSignals_UpdatesConfidenceHigher(t *testing.T) {
 	}
 }
 
+func TestDeduplicateSignals_KeepsFactorsOfHigherConfidence(t *testing.T) {
+	high := []signal.ConfidenceFactor{{Name: "base", Delta: 0.9}}
+	signals := []signal.RawSignal{
+		{Source: "todos", Kind: "todo", FilePath: "a.go", Line: 1, Title: "Same", Confidence: 0.5,
+			Factors: []signal.ConfidenceFactor{{Name: "base", Delta: 0.5}}},
+		{Source: "todos", Kind: "todo", FilePath: "a.go", Line: 1, Title: "Same", Confidence: 0.9, Factors: high},
+	}
+
+	result := DeduplicateSignals(signals)
+
+	if !reflect.DeepEqual(result[0].Factors, high) {
+		t.Errorf("Factors = %+v, want %+v", result[0].Factors, high)
+	}
+}
+
 func TestDeduplicateSignals_DoesNotDowngradeConfidence(t *testing.T) {
 	signals := []signal.RawSignal{
 		{Source: "todos", Kind: "todo", FilePath: "a.go", Line: 1, Title: "Same", Confidence: 0.9},
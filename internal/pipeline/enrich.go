@@ -50,12 +50,17 @@ func BoostColocatedSignals(signals []signal.RawSignal) {
 		}
 
 		var totalBoost float64
+		room := 1.0 - s.Confidence // boosts beyond the cap are not recorded
 		for _, rule := range boostRules {
 			if s.Kind == rule.Kind {
 				continue // no self-boost
 			}
 			if kinds[rule.Kind] {
 				totalBoost += rule.Boost
+				if applied := min(rule.Boost, room); applied > 0 {
+					s.AddFactor("co-location", applied, "shares a file with a "+rule.Kind+" signal")
+					room -= applied
+				}
 			}
 		}
 
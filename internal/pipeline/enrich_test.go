@@ -4,6 +4,7 @@
 package pipeline
 
 import (
+	"reflect"
 	"testing"
 
 	"github.com/davetashner/stringer/internal/signal"
@@ -72,6 +73,26 @@ func TestBoostColocatedSignals_AllThreeBoostsStack(t *testing.T) {
 	}
 }
 
+func TestBoostColocatedSignals_RecordsFactors(t *testing.T) {
+	signals := []signal.RawSignal{
+		{Kind: "todo", FilePath: "main.go", Confidence: 0.88},
+		{Kind: "churn", FilePath: "main.go", Confidence: 0.60},
+		{Kind: "vulnerable-dependency", FilePath: "main.go", Confidence: 0.70},
+	}
+	BoostColocatedSignals(signals)
+
+	want := []signal.ConfidenceFactor{
+		{Name: "co-location", Delta: 0.10, Detail: "shares a file with a churn signal"},
+		{Name: "co-location", Delta: 0.02, Detail: "shares a file with a vulnerable-dependency signal"},
+	}
+	if !reflect.DeepEqual(signals[0].Factors, want) {
+		t.Errorf("todo factors = %+v, want %+v (the last boost clipped at 1.0)", signals[0].Factors, want)
+	}
+	if len(signals[1].Factors) != 1 || signals[1].Factors[0].Detail != "shares a file with a vulnerable-dependency signal" {
+		t.Errorf("churn factors = %+v, want only the vulnerable-dependency boost (no self-boost)", signals[1].Factors)
+	}
+}
+
 func TestBoostColocatedSignals_CapAt1(t *testing.T) {
 	signals := []signal.RawSignal{
 		{Kind: "todo", FilePath: "main.go", Confidence: 0.95},
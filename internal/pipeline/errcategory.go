@@ -0,0 +1,111 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package pipeline
+
+import (
+	"context"
+	"errors"
+	"net"
+	"regexp"
+	"strings"
+	"syscall"
+
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+// authStatus matches HTTP 401 and 403 statuses in the wording API clients
+// report them with ("returned 401", "status code: 403", "HTTP/1.1 401",
+// "GET https://...: 401 Bad credentials"), not any 401 or 403 in a message,
+// such as a count, a line number, or a process's exit status.
+var authStatus = regexp.MustCompile(`(?:\bstatus(?: code)?\s*[:=]|\bstatus code|\bhttp(?:/[\d.]+)?(?: status)?|\breturned|\bresponded with|https?://\S+:)\s*40[13]\b`)
+
+// authMessages, networkMessages, and corruptRepoMessages match failures
+// that only surface as text, such as errors relayed from the git CLI or
+// wrapped without %w.
+var (
+	authMessages = []string{
+		"bad credentials",
+		"unauthorized",
+		"forbidden",
+		"authentication",
+		"invalid token",
+		"requires authentication",
+	}
+	networkMessages = []string{
+		"connection refused",
+		"connection reset",
+		"no such host",
+		"network is unreachable",
+		"no route to host",
+		"tls:",
+	}
+	corruptRepoMessages = []string{
+		"not a git repository",
+		"repository does not exist",
+		"object not found",
+		"bad object",
+		"corrupt",
+		"packfile",
+		"invalid object",
+		"reference not found",
+	}
+)
+
+// networkErrnos are system errors from reaching a remote host.
+var networkErrnos = []error{
+	syscall.ECONNREFUSED,
+	syscall.ECONNRESET,
+	syscall.EHOSTUNREACH,
+	syscall.ENETUNREACH,
+}
+
+// Categorize classifies a collector error. A category set with
+// signal.NewCollectorError wins; otherwise it is inferred from the error
+// chain and then the message, and anything unrecognized is internal.
+// Returns "" for a nil error.
+func Categorize(err error) signal.ErrorCategory {
+	if err == nil {
+		return ""
+	}
+	if cat, ok := signal.CategoryOf(err); ok {
+		return cat
+	}
+
+	var netErr net.Error
+	if errors.Is(err, context.DeadlineExceeded) || (errors.As(err, &netErr) && netErr.Timeout()) {
+		return signal.CategoryTimeout
+	}
+	msg := strings.ToLower(err.Error())
+	if authStatus.MatchString(msg) || containsAny(msg, authMessages) {
+		return signal.CategoryAuth
+	}
+	var dnsErr *net.DNSError
+	var opErr *net.OpError
+	if errors.As(err, &dnsErr) || errors.As(err, &opErr) {
+		return signal.CategoryNetwork
+	}
+	for _, errno := range networkErrnos {
+		if errors.Is(err, errno) {
+			return signal.CategoryNetwork
+		}
+	}
+	switch {
+	case strings.Contains(msg, "i/o timeout") || strings.Contains(msg, "timed out") || strings.Contains(msg, "deadline exceeded"):
+		return signal.CategoryTimeout
+	case containsAny(msg, networkMessages):
+		return signal.CategoryNetwork
+	case containsAny(msg, corruptRepoMessages):
+		return signal.CategoryCorruptRepo
+	}
+	return signal.CategoryInternal
+}
+
+func containsAny(s string, substrs []string) bool {
+	for _, sub := range substrs {
+		if strings.Contains(s, sub) {
+			return true
+		}
+	}
+	return false
+}
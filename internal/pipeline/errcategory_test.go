@@ -0,0 +1,52 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package pipeline
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"syscall"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+func TestCategorize(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want signal.ErrorCategory
+	}{
+		{"nil", nil, ""},
+		{"explicit category wins", signal.NewCollectorError(signal.CategoryCorruptRepo, errors.New("401")), signal.CategoryCorruptRepo},
+		{"deadline exceeded", fmt.Errorf("fetching issues: %w", context.DeadlineExceeded), signal.CategoryTimeout},
+		{"net timeout", &net.DNSError{Err: "lookup", IsTimeout: true}, signal.CategoryTimeout},
+		{"timeout text", errors.New("git log: signal: killed after timed out"), signal.CategoryTimeout},
+		{"http 401", errors.New("GET https://api.github.com/repos/o/r/issues: 401 Bad credentials []"), signal.CategoryAuth},
+		{"http 403", errors.New("gitlab API returned 403"), signal.CategoryAuth},
+		{"auth text", errors.New("authentication required"), signal.CategoryAuth},
+		{"dns error", fmt.Errorf("listing issues: %w", &net.DNSError{Err: "no such host", Name: "gitlab.example.com"}), signal.CategoryNetwork},
+		{"connection refused", fmt.Errorf("dial: %w", syscall.ECONNREFUSED), signal.CategoryNetwork},
+		{"network text", errors.New("dial tcp 10.0.0.1:443: connect: connection refused"), signal.CategoryNetwork},
+		{"not a repository", errors.New("repository does not exist"), signal.CategoryCorruptRepo},
+		{"bad object", errors.New("reading commit: object not found"), signal.CategoryCorruptRepo},
+		{"unknown", errors.New("unexpected nil pointer"), signal.CategoryInternal},
+		{"port is not a status", errors.New("parse 127.0.0.1:4010 failed"), signal.CategoryInternal},
+		{"status code", errors.New("request failed: status code: 403"), signal.CategoryAuth},
+		{"http version and status", errors.New("unexpected response HTTP/1.1 401"), signal.CategoryAuth},
+		{"server returned", errors.New("fetching https://example.com/policy.yaml: server returned 401"), signal.CategoryAuth},
+		{"count is not a status", errors.New("found 401 unparsable files"), signal.CategoryInternal},
+		{"line is not a status", errors.New("config.yaml: line 403: did not find expected key"), signal.CategoryInternal},
+		{"exit status is not a status", errors.New("plugin failed: exit status 401"), signal.CategoryInternal},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Categorize(tt.err))
+		})
+	}
+}
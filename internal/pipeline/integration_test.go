@@ -817,3 +817,37 @@ func TestIntegration_ParallelSignalFieldIntegrity(t *testing.T) {
 		}
 	}
 }
+
+// --------------------------------------------------------------------------
+// Scan cache
+// --------------------------------------------------------------------------
+
+func TestIntegration_ScanCache(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "main.go")
+	require.NoError(t, os.WriteFile(path, []byte("package main\n\n// TODO: cache me\n"), 0o600))
+	old := time.Now().Add(-time.Hour)
+	require.NoError(t, os.Chtimes(path, old, old))
+	cachePath := filepath.Join(dir, ".stringer", "scan-cache.json.gz")
+
+	run := func(cfg signal.ScanConfig) []signal.RawSignal {
+		t.Helper()
+		p, err := New(cfg)
+		require.NoError(t, err)
+		result, err := p.Run(context.Background())
+		require.NoError(t, err)
+		return result.Signals
+	}
+
+	cfg := signal.ScanConfig{RepoPath: dir, Collectors: []string{"todos"}, NoCache: true}
+	uncached := run(cfg)
+	require.Len(t, uncached, 1)
+	assert.NoFileExists(t, cachePath)
+
+	cfg.NoCache = false
+	first := run(cfg)
+	assert.FileExists(t, cachePath)
+	second := run(cfg)
+	assert.Equal(t, uncached, first)
+	assert.Equal(t, first, second, "replayed signals match a fresh scan")
+}
@@ -5,8 +5,10 @@ package pipeline
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
+	"log/slog"
 	"sort"
 	"sync"
 	"time"
@@ -14,14 +16,73 @@ import (
 	"golang.org/x/sync/errgroup"
 
 	"github.com/davetashner/stringer/internal/collector"
+	"github.com/davetashner/stringer/internal/gitignore"
+	stringerlog "github.com/davetashner/stringer/internal/log"
 	"github.com/davetashner/stringer/internal/redact"
+	"github.com/davetashner/stringer/internal/scancache"
 	"github.com/davetashner/stringer/internal/signal"
+	"github.com/davetashner/stringer/internal/tracing"
 )
 
 // Pipeline orchestrates the execution of collectors and aggregates results.
 type Pipeline struct {
 	config     signal.ScanConfig
 	collectors []collector.Collector
+	stop       func(signal.RawSignal) bool
+	budget     time.Duration
+	cache      *scancache.Cache
+	checkpoint Checkpointer
+
+	ignoreMu sync.Mutex
+	ignores  map[string]*gitignore.Matcher // by root, shared by the collectors
+}
+
+// errStopped cancels the remaining collectors once a stop signal is found.
+var errStopped = errors.New("scan stopped early")
+
+// stoppedReason is the SkipReason of collectors cancelled by StopWhen.
+const stoppedReason = "scan stopped early: a matching signal was found"
+
+// StopWhen makes Run stop as soon as any collector reports a signal for
+// which stop returns true. Collectors still running are cancelled and
+// reported with a SkipReason, and the result has StoppedEarly set.
+func (p *Pipeline) StopWhen(stop func(signal.RawSignal) bool) {
+	p.stop = stop
+}
+
+// OverBudgetReason is the SkipReason of collectors cut off by StopAfter.
+const OverBudgetReason = "time budget exceeded"
+
+// budgetGrace is how long Run waits, once the budget is spent, for
+// cancelled collectors to return before reporting them as skipped anyway.
+var budgetGrace = 500 * time.Millisecond
+
+// StopAfter bounds Run to roughly d. Collectors still running when it
+// elapses are cancelled and reported with a SkipReason; the signals of
+// collectors that finished are kept. Collectors that ignore cancellation
+// are abandoned after a short grace period, so Run returns within about
+// d plus that grace. A zero d means no budget.
+func (p *Pipeline) StopAfter(d time.Duration) {
+	p.budget = d
+}
+
+// Checkpointer persists the results of collectors as they complete, so an
+// interrupted scan can be resumed without running them again.
+type Checkpointer interface {
+	// Completed returns the result stored for a collector by an earlier,
+	// interrupted run.
+	Completed(collector string) (signal.CollectorResult, bool)
+
+	// Record stores the result of a collector that ran to completion.
+	Record(result signal.CollectorResult) error
+}
+
+// Checkpoint makes Run reuse the results cp holds instead of running those
+// collectors, and record in cp the result of every collector that runs to
+// completion. Collectors cut off by cancellation, the time budget, or
+// StopWhen are not recorded.
+func (p *Pipeline) Checkpoint(cp Checkpointer) {
+	p.checkpoint = cp
 }
 
 // New creates a Pipeline from the given ScanConfig. It resolves collectors
@@ -52,7 +113,8 @@ func NewWithCollectors(config signal.ScanConfig, collectors []collector.Collecto
 // deduplicates signals, and returns the aggregated ScanResult. Each collector
 // runs in its own goroutine using errgroup with context cancellation. Results
 // are collected with proper synchronization and returned in deterministic order
-// matching the input collector list.
+// matching the input collector list. With ScanConfig.Jobs set, at most that
+// many collectors run at a time and the rest wait their turn in list order.
 //
 // Error handling is controlled per-collector via ErrorMode in CollectorOpts:
 //   - Skip: errors are silently ignored
@@ -64,6 +126,10 @@ func NewWithCollectors(config signal.ScanConfig, collectors []collector.Collecto
 // confidence is updated if a later duplicate has a higher value.
 //
 // Invalid signals are logged and skipped.
+//
+// Before any collector runs, collectors implementing collector.CapabilityChecker
+// are asked whether their prerequisites are met. Those that report a reason are
+// not run; their result carries the reason in SkipReason instead of an error.
 func (p *Pipeline) Run(ctx context.Context) (*signal.ScanResult, error) {
 	start := time.Now()
 
@@ -76,45 +142,103 @@ func (p *Pipeline) Run(ctx context.Context) (*signal.ScanResult, error) {
 	}
 
 	var (
-		mu      sync.Mutex
-		results = make([]signal.CollectorResult, len(p.collectors))
+		mu       sync.Mutex
+		results  = make([]signal.CollectorResult, len(p.collectors))
+		finished = make([]bool, len(p.collectors))
+		late     = make([]bool, len(p.collectors)) // finished after the budget ran out
 	)
 
-	g, gctx := errgroup.WithContext(ctx)
+	runCtx := ctx
+	if p.budget > 0 {
+		var cancel context.CancelFunc
+		runCtx, cancel = context.WithTimeout(ctx, p.budget)
+		defer cancel()
+	}
+
+	if !p.config.NoCache && p.config.RepoPath != "" {
+		p.cache = scancache.Open(p.config.RepoPath)
+		defer p.saveCache()
+	}
+
+	skipReasons := p.checkCapabilities(runCtx)
+
+	g, gctx := errgroup.WithContext(runCtx)
+	slots := p.jobSlots()
 
 	for i, c := range p.collectors {
 		i, c := i, c // capture loop variables
+		if reason := skipReasons[i]; reason != "" {
+			results[i] = signal.CollectorResult{Collector: c.Name(), SkipReason: reason}
+			finished[i] = true
+			continue
+		}
+		if p.checkpoint != nil {
+			if result, ok := p.checkpoint.Completed(c.Name()); ok {
+				slog.Info("collector resumed from checkpoint", "name", c.Name(), "signals", len(result.Signals))
+				results[i] = result
+				finished[i] = true
+				continue
+			}
+		}
 		g.Go(func() error {
-			result := p.runCollector(gctx, c)
+			var result signal.CollectorResult
+			if release, err := acquire(gctx, slots); err != nil {
+				result = signal.CollectorResult{Collector: c.Name(), Err: err, ErrCategory: Categorize(err)}
+			} else {
+				result = p.runCollector(gctx, c, nil)
+				release()
+			}
 
 			mu.Lock()
 			results[i] = result
+			finished[i] = true
+			late[i] = runCtx.Err() != nil
 			mu.Unlock()
 
-			if result.Err != nil {
-				mode := p.errorMode(c.Name())
-				switch mode {
-				case signal.ErrorModeFail:
-					return fmt.Errorf("collector %q failed: %w", c.Name(), result.Err)
-				case signal.ErrorModeSkip:
-					// Silently ignore.
-				default:
-					// ErrorModeWarn (default).
-					log.Printf("collector %q returned error: %v", result.Collector, redact.String(result.Err.Error()))
+			if p.checkpoint != nil && gctx.Err() == nil {
+				if err := p.checkpoint.Record(result); err != nil {
+					slog.Warn("cannot save scan checkpoint", "error", err)
 				}
 			}
-			return nil
+
+			if p.stopsOn(result) {
+				return errStopped
+			}
+			return p.handleErr(result)
 		})
 	}
 
-	// Wait for all collectors to finish.
-	if err := g.Wait(); err != nil {
+	// Wait for all collectors to finish, or for the budget to run out.
+	err := p.wait(ctx, runCtx, g)
+	budgetSpent := p.budget > 0 && ctx.Err() == nil && errors.Is(runCtx.Err(), context.DeadlineExceeded)
+
+	mu.Lock()
+	results = append([]signal.CollectorResult(nil), results...)
+	finished = append([]bool(nil), finished...)
+	late = append([]bool(nil), late...)
+	mu.Unlock()
+
+	stopped := false
+	switch {
+	case errors.Is(err, errStopped):
+		stopped = true
+		markStopped(results)
+	case budgetSpent:
+		stopped = true
+		p.markOverBudget(results, finished, late)
+	case err != nil:
 		return &signal.ScanResult{
 			Results:  results,
 			Duration: time.Since(start),
 		}, err
 	}
 
+	// Secrets found by any collector are masked in every collector's
+	// signals, so a TODO quoting a committed key does not repeat it.
+	for i := range results {
+		redactSignals(results[i].Signals)
+	}
+
 	// Collect valid signals from all results in deterministic order.
 	var allSignals []signal.RawSignal
 	for i, result := range results {
@@ -128,6 +252,9 @@ func (p *Pipeline) Run(ctx context.Context) (*signal.ScanResult, error) {
 					p.collectors[i].Name(), redact.String(s.Title), errs)
 				continue
 			}
+			if len(s.Factors) == 0 {
+				s.AddFactor("base", s.Confidence, s.Source+" score for "+s.Kind)
+			}
 			allSignals = append(allSignals, s)
 		}
 	}
@@ -139,8 +266,8 @@ func (p *Pipeline) Run(ctx context.Context) (*signal.ScanResult, error) {
 	// Sort by priority first so the most actionable signals survive truncation.
 	if p.config.MaxIssues > 0 && len(allSignals) > p.config.MaxIssues {
 		sort.SliceStable(allSignals, func(i, j int) bool {
-			pi := effectivePriority(allSignals[i])
-			pj := effectivePriority(allSignals[j])
+			pi := signal.EffectivePriority(allSignals[i])
+			pj := signal.EffectivePriority(allSignals[j])
 			if pi != pj {
 				return pi < pj // P1 < P2 < P3 < P4
 			}
@@ -157,30 +284,146 @@ func (p *Pipeline) Run(ctx context.Context) (*signal.ScanResult, error) {
 		}
 	}
 
-	return &signal.ScanResult{
-		Signals:  allSignals,
-		Results:  results,
-		Duration: time.Since(start),
-		Metrics:  metrics,
-	}, nil
+	scan := &signal.ScanResult{
+		Signals:      allSignals,
+		Results:      results,
+		Duration:     time.Since(start),
+		Metrics:      metrics,
+		StoppedEarly: stopped,
+	}
+	if counts := scan.ErrorCounts(); len(counts) > 0 {
+		metrics[signal.ErrorsMetric] = counts
+	}
+	return scan, nil
 }
 
-// effectivePriority returns the signal's priority for sorting.
-// Uses the LLM-inferred priority if set, otherwise maps confidence to P1-P4.
-func effectivePriority(s signal.RawSignal) int {
-	if s.Priority != nil {
-		return *s.Priority
+// redactSignals replaces secret values in the free-text fields of signals.
+func redactSignals(signals []signal.RawSignal) {
+	for i := range signals {
+		signals[i].Title = redact.String(signals[i].Title)
+		signals[i].Description = redact.String(signals[i].Description)
 	}
-	switch {
-	case s.Confidence >= 0.8:
-		return 1
-	case s.Confidence >= 0.6:
-		return 2
-	case s.Confidence >= 0.4:
-		return 3
+}
+
+// jobSlots returns a semaphore with one slot per concurrent collector, or
+// nil when ScanConfig.Jobs does not limit them.
+func (p *Pipeline) jobSlots() chan struct{} {
+	if p.config.Jobs <= 0 || p.config.Jobs >= len(p.collectors) {
+		return nil
+	}
+	return make(chan struct{}, p.config.Jobs)
+}
+
+// acquire waits for a free slot and returns the function that frees it.
+// Collectors waiting for a slot still honor cancellation, so a stopped or
+// over-budget scan does not start them. A nil slots never waits.
+func acquire(ctx context.Context, slots chan struct{}) (release func(), err error) {
+	if slots == nil {
+		return func() {}, nil
+	}
+	select {
+	case slots <- struct{}{}:
+		return func() { <-slots }, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// saveCache writes back the scan cache opened by Run. Failing to save it
+// only costs the next scan time, so the error is logged.
+func (p *Pipeline) saveCache() {
+	if n := p.cache.Hits(); n > 0 {
+		slog.Info("scan cache", "files_replayed", n)
+	}
+	if err := p.cache.Save(); err != nil {
+		slog.Warn("failed to save scan cache", "error", err)
+	}
+}
+
+// wait waits for the collectors in g. With a budget, it gives up on
+// collectors that are still running budgetGrace after runCtx expires.
+func (p *Pipeline) wait(ctx, runCtx context.Context, g *errgroup.Group) error {
+	if p.budget <= 0 {
+		return g.Wait()
+	}
+	done := make(chan error, 1)
+	go func() { done <- g.Wait() }()
+	select {
+	case err := <-done:
+		return err
+	case <-runCtx.Done():
+	}
+	if ctx.Err() != nil {
+		return <-done
+	}
+	grace := time.NewTimer(budgetGrace)
+	defer grace.Stop()
+	select {
+	case err := <-done:
+		return err
+	case <-grace.C:
+		return context.DeadlineExceeded
+	}
+}
+
+// markOverBudget reports the collectors cut off by StopAfter as skipped,
+// so they are not counted as failures. A collector that failed after the
+// budget ran out is assumed to have failed because of it.
+func (p *Pipeline) markOverBudget(results []signal.CollectorResult, finished, late []bool) {
+	for i := range results {
+		switch {
+		case !finished[i]:
+			results[i] = signal.CollectorResult{Collector: p.collectors[i].Name(), Duration: p.budget, SkipReason: OverBudgetReason}
+		case late[i] && results[i].Err != nil:
+			results[i].Err = nil
+			results[i].Signals = nil
+			results[i].SkipReason = OverBudgetReason
+		}
+	}
+}
+
+// stopsOn reports whether result holds a signal that should stop the scan.
+func (p *Pipeline) stopsOn(result signal.CollectorResult) bool {
+	if p.stop == nil || result.Err != nil {
+		return false
+	}
+	for _, s := range result.Signals {
+		if p.stop(s) {
+			return true
+		}
+	}
+	return false
+}
+
+// markStopped turns the cancellation errors of collectors interrupted by
+// StopWhen into skip reasons, so they are not counted as failures.
+func markStopped(results []signal.CollectorResult) {
+	for i := range results {
+		if errors.Is(results[i].Err, context.Canceled) {
+			results[i].Err = nil
+			results[i].Signals = nil
+			results[i].SkipReason = stoppedReason
+		}
+	}
+}
+
+// handleErr applies the collector's ErrorMode to a failed result: the
+// error is returned with ErrorModeFail, to abort the scan, and logged with
+// ErrorModeWarn.
+func (p *Pipeline) handleErr(result signal.CollectorResult) error {
+	if result.Err == nil {
+		return nil
+	}
+	switch p.errorMode(result.Collector) {
+	case signal.ErrorModeFail:
+		return fmt.Errorf("collector %q failed: %w", result.Collector, result.Err)
+	case signal.ErrorModeSkip:
+		// Silently ignore.
 	default:
-		return 4
+		// ErrorModeWarn (default).
+		log.Printf("collector %q returned error: %v", result.Collector, redact.String(result.Err.Error()))
 	}
+	return nil
 }
 
 // errorMode returns the ErrorMode for a given collector, defaulting to Warn.
@@ -191,32 +434,131 @@ func (p *Pipeline) errorMode(collectorName string) signal.ErrorMode {
 	return signal.ErrorModeWarn
 }
 
-// runCollector executes a single collector and captures its result and timing.
-func (p *Pipeline) runCollector(ctx context.Context, c collector.Collector) signal.CollectorResult {
-	opts := p.config.CollectorOpts[c.Name()]
+// checkCapabilities runs the capability check phase. It returns, per
+// collector index, the reason the collector cannot run, or "" if it can.
+func (p *Pipeline) checkCapabilities(ctx context.Context) []string {
+	reasons := make([]string, len(p.collectors))
+	for i, c := range p.collectors {
+		cc, ok := c.(collector.CapabilityChecker)
+		if !ok {
+			continue
+		}
+		if reason := cc.CheckCapabilities(ctx, p.config.RepoPath, p.collectorOpts(c.Name())); reason != "" {
+			slog.Info("collector skipped", "name", c.Name(), "reason", reason)
+			reasons[i] = reason
+		}
+	}
+	return reasons
+}
+
+// collectorOpts returns the options for the named collector with scan-wide
+// settings applied.
+func (p *Pipeline) collectorOpts(name string) signal.CollectorOpts {
+	opts := p.config.CollectorOpts[name]
 
 	// Prepend global exclude patterns so they apply to every collector.
+	// Copy first: this runs once per phase, concurrently across collectors.
 	if len(p.config.ExcludePatterns) > 0 {
-		opts.ExcludePatterns = append(p.config.ExcludePatterns, opts.ExcludePatterns...)
+		merged := make([]string, 0, len(p.config.ExcludePatterns)+len(opts.ExcludePatterns))
+		merged = append(merged, p.config.ExcludePatterns...)
+		opts.ExcludePatterns = append(merged, opts.ExcludePatterns...)
 	}
 
-	// Apply per-collector timeout if configured.
-	if opts.Timeout > 0 {
-		var cancel context.CancelFunc
-		ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
-		defer cancel()
+	// Fall back to the scan-wide scope.
+	if opts.Scope.IsZero() {
+		opts.Scope = p.config.Scope
+	}
+
+	// Fall back to the scan-wide author identity map.
+	if opts.Identities == nil {
+		opts.Identities = p.config.Identities
+	}
+
+	if opts.Cache == nil && p.cache != nil {
+		opts.Cache = p.cache
 	}
 
+	if opts.Ignore == nil && !p.config.NoGitignore && p.config.RepoPath != "" {
+		root := opts.GitRoot
+		if root == "" {
+			root = p.config.RepoPath
+		}
+		// A scan of an ignored directory was asked for explicitly.
+		if m := p.gitignore(root); !m.Ignored(p.config.RepoPath, true) {
+			opts.Ignore = m
+		}
+	}
+	return opts
+}
+
+// gitignore returns the matcher for the repository at root, creating it
+// on first use so collectors share the ignore files it has read.
+func (p *Pipeline) gitignore(root string) *gitignore.Matcher {
+	p.ignoreMu.Lock()
+	defer p.ignoreMu.Unlock()
+	m, ok := p.ignores[root]
+	if !ok {
+		if p.ignores == nil {
+			p.ignores = make(map[string]*gitignore.Matcher)
+		}
+		m = gitignore.New(root)
+		p.ignores[root] = m
+	}
+	return m
+}
+
+// runCollector executes a single collector and captures its result and timing.
+// A collector that fails with a transient error (see IsTransient) is retried
+// once after a short delay; the first error is kept in RetryReason.
+// Warnings the collector logs with its context are kept in Warnings.
+//
+// With emit set, a collector.Streamer passes its signals to emit as it
+// finds them instead of returning them, and is not retried.
+func (p *Pipeline) runCollector(ctx context.Context, c collector.Collector, emit func(signal.RawSignal) error) signal.CollectorResult {
+	opts := p.collectorOpts(c.Name())
+	ctx, warnings := stringerlog.WithCollector(ctx, c.Name())
+
+	ctx, span := tracing.Start(ctx, "collector "+c.Name(), tracing.String("stringer.collector", c.Name()))
+	defer span.End()
 	start := time.Now()
 
-	signals, err := c.Collect(ctx, p.config.RepoPath, opts)
+	var (
+		signals     []signal.RawSignal
+		err         error
+		retryReason string
+	)
+	if st, ok := c.(collector.Streamer); ok && emit != nil {
+		err = p.streamOnce(ctx, st, opts, emit)
+	} else {
+		signals, err = p.collectOnce(ctx, c, opts)
+		if IsTransient(err) && sleepCtx(ctx, retryDelay) {
+			retryReason = redact.String(err.Error())
+			slog.WarnContext(ctx, "collector failed with a transient error, retrying once", "error", retryReason)
+			span.SetAttributes(tracing.String("stringer.retry_reason", retryReason))
+			signals, err = p.collectOnce(ctx, c, opts)
+		}
+	}
+
+	// Collectors that do not walk the tree (git history, GitHub) may report
+	// paths outside the scope; drop them so every signal kind honors it.
+	if !opts.Scope.IsZero() {
+		signals = filterScope(signals, opts.Scope, signal.ScanRoot(opts.GitRoot, p.config.RepoPath))
+	}
 
 	result := signal.CollectorResult{
-		Collector: c.Name(),
-		Signals:   signals,
-		Duration:  time.Since(start),
-		Err:       err,
+		Collector:   c.Name(),
+		Signals:     signals,
+		Duration:    time.Since(start),
+		Err:         err,
+		ErrCategory: Categorize(err),
+		RetryReason: retryReason,
+		Warnings:    warnings.List(),
 	}
+	for i, w := range result.Warnings {
+		result.Warnings[i] = redact.String(w)
+	}
+	span.SetAttributes(tracing.Int("stringer.signals", len(signals)))
+	span.RecordError(err)
 
 	// If the collector provides metrics and collection succeeded, capture them.
 	if err == nil {
@@ -228,6 +570,31 @@ func (p *Pipeline) runCollector(ctx context.Context, c collector.Collector) sign
 	return result
 }
 
+// collectOnce runs one collection attempt under the per-collector timeout,
+// if configured.
+func (p *Pipeline) collectOnce(ctx context.Context, c collector.Collector, opts signal.CollectorOpts) ([]signal.RawSignal, error) {
+	if opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+	}
+	return c.Collect(ctx, p.config.RepoPath, opts)
+}
+
+// filterScope returns the signals whose FilePath is inside scope. Paths may
+// be relative to the scan root or, from collectors that read git history,
+// to the git root, with root the scan root's path below it. Signals without
+// a path are kept, and no path is rewritten.
+func filterScope(signals []signal.RawSignal, scope signal.Scope, root string) []signal.RawSignal {
+	kept := signals[:0]
+	for _, s := range signals {
+		if s.FilePath == "" || scope.Contains(s.FilePath) || (root != "" && scope.ContainsFrom(root, s.FilePath)) {
+			kept = append(kept, s)
+		}
+	}
+	return kept
+}
+
 // resolveCollectors looks up collectors by name from the global registry.
 // If names is empty, all registered collectors are returned in sorted order.
 func resolveCollectors(names []string) ([]collector.Collector, error) {
@@ -0,0 +1,116 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package pipeline
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+// trackedStaleBoost is the confidence added to a TODO whose issue is stale
+// or closed: the issue no longer drives the work, so the TODO is the only
+// reminder left.
+const trackedStaleBoost = 0.15
+
+// ReferenceLinker builds a web link for a reference a TODO makes, such as
+// "#123" or "PROJ-456". It returns "" when the reference cannot be linked.
+type ReferenceLinker func(ref string) string
+
+// ResolveReferences matches the issue references of TODO signals ("#123")
+// against the issues and pull requests the github and gitlab collectors
+// found. A TODO whose issue is open and active is already tracked and is
+// dropped. A TODO whose issue is stale or closed gets a confidence boost
+// and is kept. Every referenced issue or tracker key that can be linked,
+// from the matched signal or from link, is listed with its URL in the
+// TODO's description. It returns the remaining signals, the number of
+// tracked TODOs dropped, and the number boosted.
+func ResolveReferences(signals []signal.RawSignal, link ReferenceLinker) (kept []signal.RawSignal, tracked, boosted int) {
+	issues := make(map[string]signal.RawSignal)
+	for _, s := range signals {
+		if s.Source != "github" && s.Source != "gitlab" {
+			continue
+		}
+		if n, ok := issueNumber(s.FilePath); ok {
+			// An issue wins over a pull request of the same number.
+			if _, seen := issues[n]; !seen || strings.Contains(s.FilePath, "/issues/") {
+				issues[n] = s
+			}
+		}
+	}
+
+	kept = signals[:0:0]
+	for _, s := range signals {
+		if s.Source != "todos" || len(s.References) == 0 {
+			kept = append(kept, s)
+			continue
+		}
+		var lines []string
+		active, stale := false, false
+		for _, ref := range s.References {
+			line := "Tracked in " + ref
+			url := ""
+			if issue, ok := issues[strings.TrimPrefix(ref, "#")]; ok && strings.HasPrefix(ref, "#") {
+				state := "open"
+				switch {
+				case slices.Contains(issue.Tags, "pre-closed"):
+					state, stale = "closed", true
+				case strings.HasSuffix(issue.Kind, "-stale-issue"):
+					state, stale = "stale", true
+				default:
+					active = true
+				}
+				line += fmt.Sprintf(" (%s: %s)", state, issue.Title)
+				url = issue.URL
+			} else {
+				if link != nil {
+					url = link(ref)
+				}
+				if url == "" {
+					continue // file references are noted by the collector
+				}
+			}
+			if url != "" {
+				line += ": " + url
+			}
+			lines = append(lines, line)
+		}
+
+		if active {
+			tracked++
+			continue
+		}
+		if stale {
+			if room := 1.0 - s.Confidence; room > 0 {
+				s.AddFactor("tracked-stale", min(trackedStaleBoost, room), "its issue is stale or closed")
+			}
+			s.Confidence = min(s.Confidence+trackedStaleBoost, 1.0)
+			boosted++
+		}
+		if len(lines) > 0 {
+			if s.Description != "" {
+				s.Description += "\n"
+			}
+			s.Description += strings.Join(lines, "\n")
+		}
+		kept = append(kept, s)
+	}
+	return kept, tracked, boosted
+}
+
+// issueNumber returns the number of a github or gitlab issue or pull
+// request signal from its path, e.g. "github/issues/123".
+func issueNumber(filePath string) (string, bool) {
+	parts := strings.Split(filePath, "/")
+	if len(parts) != 3 || (parts[1] != "issues" && parts[1] != "prs") {
+		return "", false
+	}
+	if _, err := strconv.Atoi(parts[2]); err != nil {
+		return "", false
+	}
+	return parts[2], true
+}
@@ -0,0 +1,88 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package pipeline
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+func refTodo(title string, refs ...string) signal.RawSignal {
+	return signal.RawSignal{Source: "todos", Kind: "todo", FilePath: "main.go", Title: title, Confidence: 0.5, References: refs}
+}
+
+func issueSig(kind string, n string, tags ...string) signal.RawSignal {
+	return signal.RawSignal{
+		Source: "github", Kind: kind, FilePath: "github/issues/" + n, Title: "Issue " + n,
+		URL: "https://github.com/o/r/issues/" + n, Tags: append([]string{kind}, tags...),
+	}
+}
+
+func TestResolveReferences(t *testing.T) {
+	signals := []signal.RawSignal{
+		issueSig("github-issue", "1"),
+		issueSig("github-stale-issue", "2"),
+		issueSig("github-closed-issue", "3", "pre-closed"),
+		refTodo("TODO: active", "#1"),
+		refTodo("TODO: stale", "#2"),
+		refTodo("TODO: closed", "#3", "PROJ-9"),
+		refTodo("TODO: unknown", "#4", "main.go:3"),
+		refTodo("TODO: plain"),
+	}
+	link := func(ref string) string {
+		if ref == "PROJ-9" {
+			return "https://jira.example.com/browse/PROJ-9"
+		}
+		return ""
+	}
+
+	kept, tracked, boosted := ResolveReferences(signals, link)
+	assert.Equal(t, 1, tracked)
+	assert.Equal(t, 2, boosted)
+	require.Len(t, kept, 7)
+	for _, s := range kept {
+		assert.NotEqual(t, "TODO: active", s.Title, "TODOs of active issues are already tracked")
+	}
+
+	stale := kept[3]
+	assert.Equal(t, "TODO: stale", stale.Title)
+	assert.InDelta(t, 0.65, stale.Confidence, 0.001)
+	assert.Equal(t, "tracked-stale", stale.Factors[len(stale.Factors)-1].Name)
+	assert.Equal(t, "Tracked in #2 (stale: Issue 2): https://github.com/o/r/issues/2", stale.Description)
+
+	closed := kept[4]
+	assert.Equal(t, "Tracked in #3 (closed: Issue 3): https://github.com/o/r/issues/3\n"+
+		"Tracked in PROJ-9: https://jira.example.com/browse/PROJ-9", closed.Description)
+
+	unknown := kept[5]
+	assert.InDelta(t, 0.5, unknown.Confidence, 0.001)
+	assert.Empty(t, unknown.Description, "unfetched issues without a link are left alone")
+	assert.Equal(t, "TODO: plain", kept[6].Title)
+}
+
+func TestResolveReferences_Links(t *testing.T) {
+	signals := []signal.RawSignal{refTodo("TODO: retry", "#4")}
+	signals[0].Description = "Context."
+
+	kept, tracked, boosted := ResolveReferences(signals, func(ref string) string {
+		return "https://github.com/o/r/issues/" + ref[1:]
+	})
+	assert.Zero(t, tracked)
+	assert.Zero(t, boosted)
+	assert.Equal(t, "Context.\nTracked in #4: https://github.com/o/r/issues/4", kept[0].Description)
+	assert.Equal(t, "Context.", signals[0].Description, "the input slice is not modified")
+}
+
+func TestResolveReferences_PullRequests(t *testing.T) {
+	pr := signal.RawSignal{Source: "github", Kind: "github-pr-pending", FilePath: "github/prs/7", Title: "Add retries"}
+	signals := []signal.RawSignal{pr, refTodo("TODO: retry", "#7")}
+
+	kept, tracked, _ := ResolveReferences(signals, nil)
+	assert.Equal(t, 1, tracked, "an open pull request tracks the TODO too")
+	assert.Len(t, kept, 1)
+}
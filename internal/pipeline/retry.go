@@ -0,0 +1,77 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package pipeline
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// retryDelay is how long to wait before retrying a collector after a
+// transient failure, long enough for a concurrent git process to release
+// index.lock. Overridden in tests.
+var retryDelay = 500 * time.Millisecond
+
+// transientErrnos are system errors that usually succeed on a second try.
+var transientErrnos = []error{
+	syscall.EAGAIN,
+	syscall.ECONNRESET,
+	syscall.ETIMEDOUT,
+}
+
+// transientMessages match transient failures that only surface as text,
+// such as errors relayed from the git CLI or wrapped without %w.
+var transientMessages = []string{
+	"index.lock",
+	"i/o timeout",
+	"tls handshake timeout",
+	"connection reset by peer",
+	"resource temporarily unavailable",
+	"try again",
+}
+
+// IsTransient reports whether err is worth retrying once: network timeouts,
+// EAGAIN, connection resets, and git lock contention. Context cancellation
+// and deadlines are never transient; the collector's time budget is spent.
+func IsTransient(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+	for _, errno := range transientErrnos {
+		if errors.Is(err, errno) {
+			return true
+		}
+	}
+	msg := strings.ToLower(err.Error())
+	for _, m := range transientMessages {
+		if strings.Contains(msg, m) {
+			return true
+		}
+	}
+	return false
+}
+
+// sleepCtx waits for d or until ctx is done, reporting whether the full
+// delay elapsed.
+func sleepCtx(ctx context.Context, d time.Duration) bool {
+	if d <= 0 {
+		return ctx.Err() == nil
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-t.C:
+		return true
+	}
+}
@@ -0,0 +1,128 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package pipeline
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davetashner/stringer/internal/collector"
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+// noRetryDelay disables the retry delay for the duration of a test.
+func noRetryDelay(t *testing.T) {
+	t.Helper()
+	orig := retryDelay
+	retryDelay = 0
+	t.Cleanup(func() { retryDelay = orig })
+}
+
+func TestIsTransient(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"nil", nil, false},
+		{"plain", errors.New("parse error in go.mod"), false},
+		{"EAGAIN", fmt.Errorf("read: %w", syscall.EAGAIN), true},
+		{"ECONNRESET", &os.SyscallError{Syscall: "read", Err: syscall.ECONNRESET}, true},
+		{"net timeout", &net.OpError{Op: "dial", Err: &timeoutError{}}, true},
+		{"index lock", errors.New("git blame: fatal: Unable to create '/repo/.git/index.lock': File exists."), true},
+		{"i/o timeout text", errors.New("Get \"https://api.osv.dev\": dial tcp: i/o timeout"), true},
+		{"deadline", fmt.Errorf("collect: %w", context.DeadlineExceeded), false},
+		{"canceled", context.Canceled, false},
+		{"url timeout wrapping deadline", &net.OpError{Op: "read", Err: context.DeadlineExceeded}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, IsTransient(tt.err))
+		})
+	}
+}
+
+// timeoutError is a net.Error that reports a timeout.
+type timeoutError struct{}
+
+func (*timeoutError) Error() string   { return "timeout" }
+func (*timeoutError) Timeout() bool   { return true }
+func (*timeoutError) Temporary() bool { return true }
+
+func TestPipeline_RetriesTransientFailureOnce(t *testing.T) {
+	noRetryDelay(t)
+	calls := 0
+	c := &funcCollector{name: "flaky", fn: func(context.Context) ([]signal.RawSignal, error) {
+		calls++
+		if calls == 1 {
+			return nil, fmt.Errorf("git log: %w", syscall.EAGAIN)
+		}
+		return []signal.RawSignal{{Source: "flaky", Title: "ok", FilePath: "a.go", Confidence: 0.5}}, nil
+	}}
+
+	result, err := NewWithCollectors(signal.ScanConfig{RepoPath: "/tmp/repo"}, []collector.Collector{c}).Run(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, calls)
+	require.Len(t, result.Results, 1)
+	assert.NoError(t, result.Results[0].Err)
+	assert.Contains(t, result.Results[0].RetryReason, "resource temporarily unavailable")
+	assert.Len(t, result.Signals, 1)
+}
+
+func TestPipeline_RetryFailsAgain(t *testing.T) {
+	noRetryDelay(t)
+	calls := 0
+	c := &funcCollector{name: "locked", fn: func(context.Context) ([]signal.RawSignal, error) {
+		calls++
+		return nil, fmt.Errorf("attempt %d: index.lock exists", calls)
+	}}
+
+	result, err := NewWithCollectors(signal.ScanConfig{RepoPath: "/tmp/repo"}, []collector.Collector{c}).Run(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, calls, "retried exactly once")
+	assert.EqualError(t, result.Results[0].Err, "attempt 2: index.lock exists")
+	assert.Equal(t, "attempt 1: index.lock exists", result.Results[0].RetryReason)
+}
+
+func TestPipeline_NoRetryForPermanentFailure(t *testing.T) {
+	noRetryDelay(t)
+	calls := 0
+	c := &funcCollector{name: "broken", fn: func(context.Context) ([]signal.RawSignal, error) {
+		calls++
+		return nil, errors.New("invalid manifest")
+	}}
+
+	result, err := NewWithCollectors(signal.ScanConfig{RepoPath: "/tmp/repo"}, []collector.Collector{c}).Run(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, calls)
+	assert.Empty(t, result.Results[0].RetryReason)
+}
+
+func TestPipeline_NoRetryWhenCancelledDuringDelay(t *testing.T) {
+	orig := retryDelay
+	retryDelay = time.Hour
+	t.Cleanup(func() { retryDelay = orig })
+
+	ctx, cancel := context.WithCancel(context.Background())
+	calls := 0
+	c := &funcCollector{name: "flaky", fn: func(context.Context) ([]signal.RawSignal, error) {
+		calls++
+		cancel()
+		return nil, syscall.ECONNRESET
+	}}
+
+	result, _ := NewWithCollectors(signal.ScanConfig{RepoPath: "/tmp/repo"}, []collector.Collector{c}).Run(ctx)
+	assert.Equal(t, 1, calls)
+	assert.ErrorIs(t, result.Results[0].Err, syscall.ECONNRESET)
+	assert.Empty(t, result.Results[0].RetryReason)
+}
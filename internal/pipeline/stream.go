@@ -0,0 +1,235 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package pipeline
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/davetashner/stringer/internal/collector"
+	"github.com/davetashner/stringer/internal/redact"
+	"github.com/davetashner/stringer/internal/scancache"
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+// StreamBuffer is the number of signals a streaming scan should buffer
+// between the collectors and the consumer. Beyond it, collectors wait.
+const StreamBuffer = 256
+
+// streamSeenLimit is the number of recently sent signal hashes a stream
+// remembers to drop duplicates, so that memory stays bounded too. Duplicates
+// come from one collector reporting a spot twice or from two collectors
+// sharing a source, and arrive close together.
+const streamSeenLimit = 1 << 16
+
+// Stream runs the collectors like Run, but sends each signal to out as soon
+// as it is found instead of collecting them, so memory use does not grow
+// with the number of signals. Collectors that implement collector.Streamer
+// hand over signals while they run; the signals of the others are sent when
+// they finish. While out is full, collectors wait for the consumer to catch
+// up. Stream does not close out, so several pipelines can feed one stream.
+//
+// Signals are redacted, validated, and deduplicated like Run's, except that
+// of two duplicates the first is kept even when the later one has the
+// higher confidence: it has already been sent. Only the last 65536 signals
+// sent are remembered, so a duplicate arriving later than that is sent again. Collectors implementing
+// collector.SecretFinder run first and their signals are held until all of
+// them finish, so the secrets they find are masked in every signal sent.
+// A Streamer is not retried after a transient error, since part of its
+// output has already been sent. MaxIssues, StopAfter, StopWhen, and
+// Checkpoint do not apply.
+//
+// The returned ScanResult holds no signals; each CollectorResult counts the
+// signals it sent in Streamed.
+func (p *Pipeline) Stream(ctx context.Context, out chan<- signal.RawSignal) (*signal.ScanResult, error) {
+	start := time.Now()
+	results := make([]signal.CollectorResult, len(p.collectors))
+
+	if !p.config.NoCache && p.config.RepoPath != "" {
+		p.cache = scancache.Open(p.config.RepoPath)
+		defer p.saveCache()
+	}
+
+	skipReasons := p.checkCapabilities(ctx)
+	var finders, rest []int
+	for i, c := range p.collectors {
+		if reason := skipReasons[i]; reason != "" {
+			results[i] = signal.CollectorResult{Collector: c.Name(), SkipReason: reason}
+			continue
+		}
+		if _, ok := c.(collector.SecretFinder); ok {
+			finders = append(finders, i)
+		} else {
+			rest = append(rest, i)
+		}
+	}
+
+	s := &stream{out: out, seen: make(map[string]struct{}), limit: streamSeenLimit}
+	slots := p.jobSlots()
+	err := p.streamPhase(ctx, finders, slots, results, s, false)
+	if err == nil {
+		err = p.streamPhase(ctx, rest, slots, results, s, true)
+	}
+
+	metrics := make(map[string]any)
+	for _, result := range results {
+		if result.Metrics != nil {
+			metrics[result.Collector] = result.Metrics
+		}
+	}
+	scan := &signal.ScanResult{
+		Results:  results,
+		Duration: time.Since(start),
+		Metrics:  metrics,
+	}
+	if counts := scan.ErrorCounts(); len(counts) > 0 {
+		metrics[signal.ErrorsMetric] = counts
+	}
+	return scan, err
+}
+
+// streamPhase runs the collectors at indexes idx concurrently and sends
+// their signals to s. With live set, Streamers send signals as they find
+// them; otherwise every collector's signals are sent once all of idx have
+// finished.
+func (p *Pipeline) streamPhase(ctx context.Context, idx []int, slots chan struct{}, results []signal.CollectorResult, s *stream, live bool) error {
+	g, gctx := errgroup.WithContext(ctx)
+	for _, i := range idx {
+		c := p.collectors[i]
+		g.Go(func() error {
+			var sent int
+			emit := func(sig signal.RawSignal) error {
+				ok, err := s.send(gctx, c.Name(), sig)
+				if ok {
+					sent++
+				}
+				return err
+			}
+
+			var result signal.CollectorResult
+			if release, err := acquire(gctx, slots); err != nil {
+				result = signal.CollectorResult{Collector: c.Name(), Err: err, ErrCategory: Categorize(err)}
+			} else {
+				var liveEmit func(signal.RawSignal) error
+				if live {
+					liveEmit = emit
+				}
+				result = p.runCollector(gctx, c, liveEmit)
+				release()
+			}
+
+			if live && result.Err == nil {
+				for _, sig := range result.Signals {
+					if err := emit(sig); err != nil {
+						result.Err, result.ErrCategory = err, Categorize(err)
+						break
+					}
+				}
+				result.Signals = nil
+			}
+			result.Streamed = sent
+			results[i] = result // each goroutine writes its own element
+			return p.handleErr(result)
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return err
+	}
+
+	// Held signals are sent in collector order, once every collector of
+	// the phase has registered its secrets.
+	for _, i := range idx {
+		if results[i].Err != nil {
+			continue
+		}
+		for _, sig := range results[i].Signals {
+			ok, err := s.send(ctx, results[i].Collector, sig)
+			if err != nil {
+				return err
+			}
+			if ok {
+				results[i].Streamed++
+			}
+		}
+		results[i].Signals = nil
+	}
+	return nil
+}
+
+// streamOnce runs one streaming collection under the per-collector
+// timeout, if configured, dropping signals outside the scope.
+func (p *Pipeline) streamOnce(ctx context.Context, st collector.Streamer, opts signal.CollectorOpts, emit func(signal.RawSignal) error) error {
+	if opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+	}
+	return st.Stream(ctx, p.config.RepoPath, opts, func(sig signal.RawSignal) error {
+		if !opts.Scope.IsZero() && !opts.Scope.Contains(sig.FilePath) {
+			return nil
+		}
+		return emit(sig)
+	})
+}
+
+// stream is the output side of Stream: it prepares each signal the way Run
+// does and sends it unless an identical one was already sent.
+type stream struct {
+	out chan<- signal.RawSignal
+
+	mu    sync.Mutex
+	seen  map[string]struct{} // hashes of the last limit signals sent
+	order []string            // the hashes in seen, a ring oldest-first from next
+	next  int
+	limit int
+}
+
+// send redacts, validates, and deduplicates sig from the named collector,
+// then sends it, waiting while the stream is full. It reports whether sig
+// was sent and fails only when ctx is cancelled.
+func (s *stream) send(ctx context.Context, collector string, sig signal.RawSignal) (bool, error) {
+	sig.Title = redact.String(sig.Title)
+	sig.Description = redact.String(sig.Description)
+	if errs := ValidateSignal(sig); len(errs) > 0 {
+		log.Printf("skipping invalid signal from %q (title=%q): %v", collector, sig.Title, errs)
+		return false, nil
+	}
+	if len(sig.Factors) == 0 {
+		sig.AddFactor("base", sig.Confidence, sig.Source+" score for "+sig.Kind)
+	}
+
+	if !s.remember(SignalHash(sig)) {
+		return false, nil
+	}
+
+	select {
+	case s.out <- sig:
+		return true, nil
+	case <-ctx.Done():
+		return false, ctx.Err()
+	}
+}
+
+// remember records hash as sent, forgetting the oldest hash once limit are
+// remembered. It reports false if hash was already remembered.
+func (s *stream) remember(hash string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, dup := s.seen[hash]; dup {
+		return false
+	}
+	s.seen[hash] = struct{}{}
+	if len(s.order) < s.limit {
+		s.order = append(s.order, hash)
+		return true
+	}
+	delete(s.seen, s.order[s.next])
+	s.order[s.next] = hash
+	s.next = (s.next + 1) % s.limit
+	return true
+}
@@ -2,7 +2,7 @@
 // SPDX-License-Identifier: MIT
 
 // Package pipeline provides the scan orchestration engine for stringer.
-// It resolves collectors, runs them sequentially, validates their output,
+// It resolves collectors, runs them concurrently, validates their output,
 // and aggregates results into a ScanResult.
 package pipeline
 
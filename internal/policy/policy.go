@@ -0,0 +1,218 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+// Package policy fetches and enforces signed organization policy documents.
+//
+// A policy is a YAML document served over HTTPS alongside a detached
+// Ed25519 signature at the same URL with a ".sig" suffix. It can pin
+// configuration above the repository's .stringer.yaml, require collectors
+// that local setups may not disable, restrict the baseline suppression
+// reasons that are honored, and cap the new-signal budget.
+package policy
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/davetashner/stringer/internal/baseline"
+	"github.com/davetashner/stringer/internal/config"
+)
+
+// KeyEnvVar is the environment variable holding the base64-encoded Ed25519
+// public key used to verify policy signatures when no key flag is given.
+const KeyEnvVar = "STRINGER_POLICY_KEY"
+
+// SignatureSuffix is appended to the policy URL to locate its signature.
+const SignatureSuffix = ".sig"
+
+// maxPolicyBytes caps the size of a fetched policy or signature.
+const maxPolicyBytes = 1 << 20 // 1 MiB
+
+// schemaVersion is the only policy document version understood.
+const schemaVersion = 1
+
+// Policy is an organization policy document.
+type Policy struct {
+	Version int `yaml:"version"`
+
+	// Config is merged above the local .stringer.yaml; its non-zero values
+	// win.
+	Config config.Config `yaml:"config,omitempty"`
+
+	// MandatoryCollectors must run on every scan. A scan whose flags or
+	// local config would disable one of them fails.
+	MandatoryCollectors []string `yaml:"mandatory_collectors,omitempty"`
+
+	// AllowedSuppressionReasons limits which baseline suppressions are
+	// honored. Suppressions with any other reason are ignored. Empty means
+	// all reasons are allowed.
+	AllowedSuppressionReasons []baseline.Reason `yaml:"allowed_suppression_reasons,omitempty"`
+
+	// Budget is the largest new-signal budget a scan may use. A larger or
+	// unset local --budget is lowered to it. Zero means no policy budget.
+	Budget int `yaml:"budget,omitempty"`
+}
+
+// ParsePublicKey decodes a base64-encoded Ed25519 public key.
+func ParsePublicKey(s string) (ed25519.PublicKey, error) {
+	raw, err := base64.StdEncoding.DecodeString(strings.TrimSpace(s))
+	if err != nil {
+		return nil, fmt.Errorf("decode policy key: %w", err)
+	}
+	if len(raw) != ed25519.PublicKeySize {
+		return nil, fmt.Errorf("policy key must be %d bytes, got %d", ed25519.PublicKeySize, len(raw))
+	}
+	return ed25519.PublicKey(raw), nil
+}
+
+// Fetch downloads the policy at url and its detached signature at
+// url+SignatureSuffix, verifies the signature with key, and parses and
+// validates the document. If client is nil, a client with a 30s timeout is
+// used.
+func Fetch(ctx context.Context, client *http.Client, url string, key ed25519.PublicKey) (*Policy, error) {
+	if client == nil {
+		client = &http.Client{Timeout: 30 * time.Second}
+	}
+
+	doc, err := get(ctx, client, url)
+	if err != nil {
+		return nil, err
+	}
+	sigText, err := get(ctx, client, url+SignatureSuffix)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(sigText)))
+	if err != nil {
+		return nil, fmt.Errorf("decode policy signature: %w", err)
+	}
+	if !ed25519.Verify(key, doc, sig) {
+		return nil, fmt.Errorf("policy signature verification failed for %s", url)
+	}
+
+	return Parse(doc)
+}
+
+// get fetches url and returns its body, refusing responses larger than
+// maxPolicyBytes.
+func get(ctx context.Context, client *http.Client, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("creating request: %w", err)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("fetching %s: %w", url, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetching %s: server returned %d", url, resp.StatusCode)
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxPolicyBytes+1))
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", url, err)
+	}
+	if len(body) > maxPolicyBytes {
+		return nil, fmt.Errorf("fetching %s: response exceeds %d bytes", url, maxPolicyBytes)
+	}
+	return body, nil
+}
+
+// Parse decodes and validates a policy document. Unknown fields are
+// rejected so that a typo cannot silently drop a requirement.
+func Parse(data []byte) (*Policy, error) {
+	var p Policy
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(&p); err != nil {
+		return nil, fmt.Errorf("parse policy: %w", err)
+	}
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+// validate checks the policy and returns all errors at once.
+func (p *Policy) validate() error {
+	var errs []string
+	if p.Version != schemaVersion {
+		errs = append(errs, fmt.Sprintf("version: unsupported policy version %d (want %d)", p.Version, schemaVersion))
+	}
+	if err := config.Validate(&p.Config); err != nil {
+		errs = append(errs, fmt.Sprintf("config: %v", err))
+	}
+	for _, name := range p.MandatoryCollectors {
+		if cc, ok := p.Config.Collectors[name]; ok && cc.Enabled != nil && !*cc.Enabled {
+			errs = append(errs, fmt.Sprintf("mandatory_collectors: %s is also disabled in config", name))
+		}
+	}
+	for _, r := range p.AllowedSuppressionReasons {
+		if err := baseline.ValidateReason(r); err != nil {
+			errs = append(errs, fmt.Sprintf("allowed_suppression_reasons: %v", err))
+		}
+	}
+	if p.Budget < 0 {
+		errs = append(errs, fmt.Sprintf("budget: must be non-negative, got %d", p.Budget))
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("policy validation failed:\n  %s", strings.Join(errs, "\n  "))
+	}
+	return nil
+}
+
+// CheckMandatory reports every mandatory collector that the local setup
+// would disable. selected is the collector list requested on the command
+// line after exclusions; empty means all collectors. local is the
+// repository config before the policy is applied.
+func (p *Policy) CheckMandatory(selected []string, local *config.Config) error {
+	var errs []string
+	for _, name := range p.MandatoryCollectors {
+		if len(selected) > 0 && !slices.Contains(selected, name) {
+			errs = append(errs, fmt.Sprintf("collector %s is mandatory but was not selected by --collectors/--exclude-collectors", name))
+		}
+		if cc, ok := local.Collectors[name]; ok && cc.Enabled != nil && !*cc.Enabled {
+			errs = append(errs, fmt.Sprintf("collector %s is mandatory but disabled in %s", name, config.FileName))
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("policy violation:\n  %s", strings.Join(errs, "\n  "))
+	}
+	return nil
+}
+
+// FilterSuppressions returns a copy of st without suppressions whose reason
+// the policy does not allow, and the number removed. A nil st is returned
+// unchanged.
+func (p *Policy) FilterSuppressions(st *baseline.BaselineState) (*baseline.BaselineState, int) {
+	if st == nil || len(p.AllowedSuppressionReasons) == 0 {
+		return st, 0
+	}
+	filtered := &baseline.BaselineState{Version: st.Version}
+	for _, s := range st.Suppressions {
+		if slices.Contains(p.AllowedSuppressionReasons, s.Reason) {
+			filtered.Suppressions = append(filtered.Suppressions, s)
+		}
+	}
+	return filtered, len(st.Suppressions) - len(filtered.Suppressions)
+}
+
+// EffectiveBudget returns the budget to enforce given the locally requested
+// one: the policy budget when local is unset or more lenient, else local.
+func (p *Policy) EffectiveBudget(local int) int {
+	if p.Budget > 0 && (local == 0 || local > p.Budget) {
+		return p.Budget
+	}
+	return local
+}
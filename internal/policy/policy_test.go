@@ -0,0 +1,197 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package policy
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davetashner/stringer/internal/baseline"
+	"github.com/davetashner/stringer/internal/config"
+)
+
+const testPolicy = `version: 1
+config:
+  max_issues: 50
+mandatory_collectors: [todos, gitlog]
+allowed_suppression_reasons: [false-positive]
+budget: 10
+`
+
+func newKey(t *testing.T) (ed25519.PublicKey, ed25519.PrivateKey) {
+	t.Helper()
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	return pub, priv
+}
+
+// serve starts a server publishing doc at /policy.yaml and sig at
+// /policy.yaml.sig.
+func serve(t *testing.T, doc, sig string) string {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.HandleFunc("/policy.yaml", func(w http.ResponseWriter, _ *http.Request) { _, _ = w.Write([]byte(doc)) })
+	mux.HandleFunc("/policy.yaml.sig", func(w http.ResponseWriter, _ *http.Request) { _, _ = w.Write([]byte(sig)) })
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return srv.URL + "/policy.yaml"
+}
+
+func sign(priv ed25519.PrivateKey, doc string) string {
+	return base64.StdEncoding.EncodeToString(ed25519.Sign(priv, []byte(doc))) + "\n"
+}
+
+func TestFetch_Valid(t *testing.T) {
+	pub, priv := newKey(t)
+	url := serve(t, testPolicy, sign(priv, testPolicy))
+
+	p, err := Fetch(context.Background(), nil, url, pub)
+	require.NoError(t, err)
+	assert.Equal(t, 50, p.Config.MaxIssues)
+	assert.Equal(t, []string{"todos", "gitlog"}, p.MandatoryCollectors)
+	assert.Equal(t, []baseline.Reason{baseline.ReasonFalsePositive}, p.AllowedSuppressionReasons)
+	assert.Equal(t, 10, p.Budget)
+}
+
+func TestFetch_WrongKey(t *testing.T) {
+	_, priv := newKey(t)
+	other, _ := newKey(t)
+	url := serve(t, testPolicy, sign(priv, testPolicy))
+
+	_, err := Fetch(context.Background(), nil, url, other)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "signature verification failed")
+}
+
+func TestFetch_TamperedDocument(t *testing.T) {
+	pub, priv := newKey(t)
+	tampered := strings.Replace(testPolicy, "budget: 10", "budget: 1000", 1)
+	url := serve(t, tampered, sign(priv, testPolicy))
+
+	_, err := Fetch(context.Background(), nil, url, pub)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "signature verification failed")
+}
+
+func TestFetch_MissingSignature(t *testing.T) {
+	pub, _ := newKey(t)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/policy.yaml" {
+			_, _ = w.Write([]byte(testPolicy))
+			return
+		}
+		http.NotFound(w, r)
+	}))
+	t.Cleanup(srv.Close)
+
+	_, err := Fetch(context.Background(), nil, srv.URL+"/policy.yaml", pub)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "server returned 404")
+}
+
+func TestFetch_BadSignatureEncoding(t *testing.T) {
+	pub, _ := newKey(t)
+	url := serve(t, testPolicy, "not base64!")
+
+	_, err := Fetch(context.Background(), nil, url, pub)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "decode policy signature")
+}
+
+func TestFetch_TooLarge(t *testing.T) {
+	pub, _ := newKey(t)
+	url := serve(t, strings.Repeat("#", maxPolicyBytes+1), "")
+
+	_, err := Fetch(context.Background(), nil, url, pub)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "exceeds")
+}
+
+func TestParse_Invalid(t *testing.T) {
+	tests := []struct {
+		name, doc, want string
+	}{
+		{"unknown field", "version: 1\nmandatory_colectors: [todos]\n", "field mandatory_colectors not found"},
+		{"bad version", "version: 2\n", "unsupported policy version 2"},
+		{"bad reason", "version: 1\nallowed_suppression_reasons: [ignored]\n", `invalid suppression reason "ignored"`},
+		{"negative budget", "version: 1\nbudget: -1\n", "budget: must be non-negative"},
+		{"bad config", "version: 1\nconfig:\n  max_issues: -5\n", "max_issues: must be non-negative"},
+		{"mandatory and disabled", "version: 1\nmandatory_collectors: [todos]\nconfig:\n  collectors:\n    todos:\n      enabled: false\n", "todos is also disabled in config"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse([]byte(tt.doc))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.want)
+		})
+	}
+}
+
+func TestParsePublicKey(t *testing.T) {
+	pub, _ := newKey(t)
+	got, err := ParsePublicKey(" " + base64.StdEncoding.EncodeToString(pub) + "\n")
+	require.NoError(t, err)
+	assert.Equal(t, pub, got)
+
+	_, err = ParsePublicKey("%%%")
+	assert.Error(t, err)
+
+	_, err = ParsePublicKey(base64.StdEncoding.EncodeToString([]byte("short")))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "must be 32 bytes")
+}
+
+func TestCheckMandatory(t *testing.T) {
+	p := &Policy{MandatoryCollectors: []string{"todos", "gitlog"}}
+	disabled := false
+
+	assert.NoError(t, p.CheckMandatory(nil, &config.Config{}))
+	assert.NoError(t, p.CheckMandatory([]string{"todos", "gitlog", "patterns"}, &config.Config{}))
+
+	err := p.CheckMandatory([]string{"todos"}, &config.Config{
+		Collectors: map[string]config.CollectorConfig{"todos": {Enabled: &disabled}},
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "gitlog is mandatory but was not selected")
+	assert.Contains(t, err.Error(), "todos is mandatory but disabled in .stringer.yaml")
+}
+
+func TestFilterSuppressions(t *testing.T) {
+	st := &baseline.BaselineState{Version: "1", Suppressions: []baseline.Suppression{
+		{SignalID: "a", Reason: baseline.ReasonFalsePositive},
+		{SignalID: "b", Reason: baseline.ReasonWontFix},
+		{SignalID: "c", Reason: baseline.ReasonAcknowledged},
+	}}
+
+	p := &Policy{AllowedSuppressionReasons: []baseline.Reason{baseline.ReasonFalsePositive}}
+	filtered, dropped := p.FilterSuppressions(st)
+	assert.Equal(t, 2, dropped)
+	require.Len(t, filtered.Suppressions, 1)
+	assert.Equal(t, "a", filtered.Suppressions[0].SignalID)
+	assert.Len(t, st.Suppressions, 3, "input is not modified")
+
+	all, dropped := (&Policy{}).FilterSuppressions(st)
+	assert.Same(t, st, all)
+	assert.Zero(t, dropped)
+
+	none, _ := p.FilterSuppressions(nil)
+	assert.Nil(t, none)
+}
+
+func TestEffectiveBudget(t *testing.T) {
+	p := &Policy{Budget: 10}
+	assert.Equal(t, 10, p.EffectiveBudget(0))
+	assert.Equal(t, 10, p.EffectiveBudget(25))
+	assert.Equal(t, 5, p.EffectiveBudget(5))
+	assert.Equal(t, 7, (&Policy{}).EffectiveBudget(7))
+}
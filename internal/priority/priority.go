@@ -0,0 +1,113 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+// Package priority assigns each signal an explicit priority, P1 (most
+// urgent) to P4, so downstream triage can sort on one field instead of raw
+// confidence. A signal's score starts at its confidence and grows with its
+// age and with how much churn its file sees; tunable thresholds map the
+// score to a priority. With no age or churn information the mapping matches
+// the confidence mapping the formatters already use. P0 is left for people
+// to assign: Beads reserves it for drop-everything work, which a scan cannot
+// recognize.
+package priority
+
+import (
+	"math"
+	"time"
+
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+// Config tunes the score and how it maps to priorities.
+type Config struct {
+	// P1, P2, and P3 are the minimum scores for each priority; lower scores
+	// are P4. They must decrease from P1 to P3.
+	P1, P2, P3 float64
+
+	// AgeWeight is the most a signal's age adds to its score, reached at
+	// AgeDays old. Zero ignores age.
+	AgeWeight float64
+	AgeDays   int
+
+	// HotspotWeight scales the confidence of the strongest churn or hotspot
+	// signal on the same file into a boost. Zero ignores churn.
+	HotspotWeight float64
+}
+
+// DefaultConfig returns the default thresholds and weights.
+func DefaultConfig() Config {
+	return Config{
+		P1:            0.8,
+		P2:            0.6,
+		P3:            0.4,
+		AgeWeight:     0.1,
+		AgeDays:       365,
+		HotspotWeight: 0.1,
+	}
+}
+
+// heatKinds are the signal kinds that mark a file as frequently changed.
+var heatKinds = map[string]bool{
+	"churn":   true,
+	"hotspot": true,
+}
+
+// Annotate sets the priority of every signal that has none, scoring it
+// against now. Priorities already set, by the LLM or a plugin, are kept. It
+// returns how many signals it annotated.
+func Annotate(signals []signal.RawSignal, cfg Config, now time.Time) int {
+	heat := fileHeat(signals)
+	n := 0
+	for i := range signals {
+		if signals[i].Priority != nil {
+			continue
+		}
+		p := cfg.Map(cfg.Score(signals[i], heat[signals[i].FilePath], now))
+		signals[i].Priority = &p
+		n++
+	}
+	return n
+}
+
+// Score returns sig's score given the heat of its file, the highest
+// confidence of a churn or hotspot signal on it.
+func (c Config) Score(sig signal.RawSignal, heat float64, now time.Time) float64 {
+	score := sig.Confidence
+	if c.AgeWeight > 0 && c.AgeDays > 0 && !sig.Timestamp.IsZero() && sig.Timestamp.Before(now) {
+		days := now.Sub(sig.Timestamp).Hours() / 24
+		score += c.AgeWeight * math.Min(1, days/float64(c.AgeDays))
+	}
+	// A churn signal is about its own file; boosting it by itself would
+	// count the churn twice.
+	if c.HotspotWeight > 0 && !heatKinds[sig.Kind] {
+		score += c.HotspotWeight * heat
+	}
+	return score
+}
+
+// Map converts a score to a priority from 1 to 4.
+func (c Config) Map(score float64) int {
+	switch {
+	case score >= c.P1:
+		return 1
+	case score >= c.P2:
+		return 2
+	case score >= c.P3:
+		return 3
+	default:
+		return 4
+	}
+}
+
+// fileHeat returns, per file, the highest confidence of the churn and
+// hotspot signals on it.
+func fileHeat(signals []signal.RawSignal) map[string]float64 {
+	heat := make(map[string]float64)
+	for _, sig := range signals {
+		if !heatKinds[sig.Kind] || sig.FilePath == "" {
+			continue
+		}
+		heat[sig.FilePath] = math.Max(heat[sig.FilePath], sig.Confidence)
+	}
+	return heat
+}
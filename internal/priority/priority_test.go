@@ -0,0 +1,75 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package priority
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+var now = time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+
+func intPtr(v int) *int { return &v }
+
+func TestMap_DefaultMatchesConfidenceMapping(t *testing.T) {
+	c := DefaultConfig()
+	for score, want := range map[float64]int{0.95: 1, 0.8: 1, 0.79: 2, 0.6: 2, 0.5: 3, 0.4: 3, 0.39: 4, 0: 4} {
+		assert.Equal(t, want, c.Map(score), "score %g", score)
+	}
+}
+
+func TestScore_Age(t *testing.T) {
+	c := DefaultConfig()
+	sig := signal.RawSignal{Kind: "todo", Confidence: 0.5}
+	assert.InDelta(t, 0.5, c.Score(sig, 0, now), 1e-9, "no timestamp, no boost")
+
+	sig.Timestamp = now.AddDate(0, 0, -73)
+	assert.InDelta(t, 0.52, c.Score(sig, 0, now), 1e-9, "a fifth of age_days")
+
+	sig.Timestamp = now.AddDate(-3, 0, 0)
+	assert.InDelta(t, 0.6, c.Score(sig, 0, now), 1e-9, "the boost caps at age_weight")
+
+	sig.Timestamp = now.AddDate(0, 0, 1)
+	assert.InDelta(t, 0.5, c.Score(sig, 0, now), 1e-9, "future timestamps add nothing")
+}
+
+func TestScore_Hotspot(t *testing.T) {
+	c := DefaultConfig()
+	assert.InDelta(t, 0.58, c.Score(signal.RawSignal{Kind: "todo", Confidence: 0.5}, 0.8, now), 1e-9)
+	assert.InDelta(t, 0.8, c.Score(signal.RawSignal{Kind: "churn", Confidence: 0.8}, 0.8, now), 1e-9,
+		"churn is not boosted by itself")
+
+	c.HotspotWeight = 0
+	assert.InDelta(t, 0.5, c.Score(signal.RawSignal{Kind: "todo", Confidence: 0.5}, 0.8, now), 1e-9)
+}
+
+func TestAnnotate(t *testing.T) {
+	signals := []signal.RawSignal{
+		{Kind: "todo", FilePath: "a.go", Confidence: 0.55, Timestamp: now.AddDate(0, -1, 0)},
+		{Kind: "todo", FilePath: "b.go", Confidence: 0.55},
+		{Kind: "churn", FilePath: "a.go", Confidence: 0.7},
+		{Kind: "hotspot", FilePath: "a.go", Confidence: 0.9},
+		{Kind: "fixme", FilePath: "c.go", Confidence: 0.9, Priority: intPtr(4)},
+		{Kind: "todo", FilePath: "d.go", Confidence: 0.3, Timestamp: now.AddDate(-2, 0, 0)},
+	}
+
+	n := Annotate(signals, DefaultConfig(), now)
+	assert.Equal(t, 5, n)
+	assert.Equal(t, intPtr(2), signals[0].Priority, "recent TODO in a hotspot rises to P2")
+	assert.Equal(t, intPtr(3), signals[1].Priority, "same TODO in a quiet file stays P3")
+	assert.Equal(t, intPtr(2), signals[2].Priority)
+	assert.Equal(t, intPtr(1), signals[3].Priority)
+	assert.Equal(t, intPtr(4), signals[4].Priority, "existing priorities are kept")
+	assert.Equal(t, intPtr(3), signals[5].Priority, "an old low-confidence TODO rises to P3")
+}
+
+func TestAnnotate_CustomThresholds(t *testing.T) {
+	signals := []signal.RawSignal{{Kind: "todo", Confidence: 0.7}}
+	Annotate(signals, Config{P1: 0.7, P2: 0.5, P3: 0.3}, now)
+	assert.Equal(t, intPtr(1), signals[0].Priority)
+}
@@ -0,0 +1,142 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+// Package promexport writes scan results in the Prometheus text exposition
+// format, so signal counts and collector health can be graphed over time.
+// The output suits the node_exporter textfile collector and the /metrics
+// endpoint scrapers expect.
+package promexport
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/davetashner/stringer/internal/output"
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+// ContentType is the media type of the text exposition format.
+const ContentType = "text/plain; version=0.0.4; charset=utf-8"
+
+// signalKey identifies one stringer_signals series.
+type signalKey struct {
+	collector, kind, module string
+}
+
+// collectorStats aggregates a collector's runs; in a monorepo scan each
+// workspace runs every collector.
+type collectorStats struct {
+	seconds float64
+	errors  map[string]int // by error category
+}
+
+// Write writes the metrics of result to w:
+//
+//	stringer_signals{collector,kind,module}          signals found
+//	stringer_collector_duration_seconds{collector}   time each collector ran
+//	stringer_collector_errors{collector,category}    failed collector runs
+//	stringer_scan_duration_seconds                   total scan time
+//
+// Modules are the first two directories of a signal's file. Series are
+// sorted, so the same result always produces the same output.
+func Write(w io.Writer, result *signal.ScanResult) error {
+	signals := make(map[signalKey]int)
+	for _, sig := range result.Signals {
+		signals[signalKey{sig.Source, sig.Kind, output.SignalModule(sig.FilePath)}]++
+	}
+	collectors := make(map[string]*collectorStats)
+	for _, cr := range result.Results {
+		cs, ok := collectors[cr.Collector]
+		if !ok {
+			cs = &collectorStats{errors: make(map[string]int)}
+			collectors[cr.Collector] = cs
+		}
+		cs.seconds += cr.Duration.Seconds()
+		if cr.Err != nil {
+			cs.errors[string(cr.Category())]++
+		}
+	}
+
+	var b strings.Builder
+	header(&b, "stringer_signals", "Signals found by the scan, by collector, kind, and module.")
+	keys := make([]signalKey, 0, len(signals))
+	for k := range signals {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		a, c := keys[i], keys[j]
+		if a.collector != c.collector {
+			return a.collector < c.collector
+		}
+		if a.kind != c.kind {
+			return a.kind < c.kind
+		}
+		return a.module < c.module
+	})
+	for _, k := range keys {
+		sample(&b, "stringer_signals", float64(signals[k]), "collector", k.collector, "kind", k.kind, "module", k.module)
+	}
+
+	names := make([]string, 0, len(collectors))
+	for name := range collectors {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	header(&b, "stringer_collector_duration_seconds", "How long each collector ran.")
+	for _, name := range names {
+		sample(&b, "stringer_collector_duration_seconds", collectors[name].seconds, "collector", name)
+	}
+
+	header(&b, "stringer_collector_errors", "Collector runs that failed, by error category.")
+	for _, name := range names {
+		errs := collectors[name].errors
+		cats := make([]string, 0, len(errs))
+		for cat := range errs {
+			cats = append(cats, cat)
+		}
+		sort.Strings(cats)
+		for _, cat := range cats {
+			sample(&b, "stringer_collector_errors", float64(errs[cat]), "collector", name, "category", cat)
+		}
+	}
+
+	header(&b, "stringer_scan_duration_seconds", "Total scan time.")
+	sample(&b, "stringer_scan_duration_seconds", result.Duration.Seconds())
+
+	if _, err := io.WriteString(w, b.String()); err != nil {
+		return fmt.Errorf("write metrics: %w", err)
+	}
+	return nil
+}
+
+// header writes the HELP and TYPE lines of a gauge.
+func header(b *strings.Builder, name, help string) {
+	_, _ = fmt.Fprintf(b, "# HELP %s %s\n# TYPE %s gauge\n", name, help, name)
+}
+
+// sample writes one series; labels alternate names and values.
+func sample(b *strings.Builder, name string, value float64, labels ...string) {
+	b.WriteString(name)
+	if len(labels) > 0 {
+		b.WriteByte('{')
+		for i := 0; i+1 < len(labels); i += 2 {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+			b.WriteString(labels[i] + `="` + escapeLabel(labels[i+1]) + `"`)
+		}
+		b.WriteByte('}')
+	}
+	b.WriteString(" " + strconv.FormatFloat(value, 'g', -1, 64) + "\n")
+}
+
+// labelEscaper escapes label values as the exposition format requires.
+var labelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+func escapeLabel(v string) string {
+	return labelEscaper.Replace(v)
+}
@@ -0,0 +1,65 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package promexport
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+func TestWrite(t *testing.T) {
+	result := &signal.ScanResult{
+		Signals: []signal.RawSignal{
+			{Source: "todos", Kind: "todo", FilePath: "internal/api/handler.go"},
+			{Source: "todos", Kind: "todo", FilePath: "internal/api/deep/x.go"},
+			{Source: "todos", Kind: "fixme", FilePath: "main.go"},
+			{Source: "github", Kind: "github-issue", Title: `Crash on "start"`},
+		},
+		Results: []signal.CollectorResult{
+			{Collector: "todos", Duration: 1500 * time.Millisecond},
+			{Collector: "github", Duration: 250 * time.Millisecond, Err: errors.New("timed out"), ErrCategory: signal.CategoryTimeout},
+			{Collector: "todos", Duration: 500 * time.Millisecond},
+			{Collector: "github", Err: errors.New("boom")},
+		},
+		Duration: 3 * time.Second,
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, Write(&buf, result))
+	assert.Equal(t, `# HELP stringer_signals Signals found by the scan, by collector, kind, and module.
+# TYPE stringer_signals gauge
+stringer_signals{collector="github",kind="github-issue",module=""} 1
+stringer_signals{collector="todos",kind="fixme",module="(root)"} 1
+stringer_signals{collector="todos",kind="todo",module="internal/api"} 2
+# HELP stringer_collector_duration_seconds How long each collector ran.
+# TYPE stringer_collector_duration_seconds gauge
+stringer_collector_duration_seconds{collector="github"} 0.25
+stringer_collector_duration_seconds{collector="todos"} 2
+# HELP stringer_collector_errors Collector runs that failed, by error category.
+# TYPE stringer_collector_errors gauge
+stringer_collector_errors{collector="github",category="internal"} 1
+stringer_collector_errors{collector="github",category="timeout"} 1
+# HELP stringer_scan_duration_seconds Total scan time.
+# TYPE stringer_scan_duration_seconds gauge
+stringer_scan_duration_seconds 3
+`, buf.String())
+}
+
+func TestWrite_Empty(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, Write(&buf, &signal.ScanResult{}))
+	assert.Contains(t, buf.String(), "# TYPE stringer_signals gauge\n# HELP stringer_collector_duration_seconds")
+	assert.Contains(t, buf.String(), "stringer_scan_duration_seconds 0\n")
+}
+
+func TestEscapeLabel(t *testing.T) {
+	assert.Equal(t, `a\\b\"c\nd`, escapeLabel("a\\b\"c\nd"))
+}
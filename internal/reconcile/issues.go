@@ -0,0 +1,194 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package reconcile
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/davetashner/stringer/internal/beads"
+	"github.com/davetashner/stringer/internal/output"
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+// RemoteIssue is a GitHub issue as the issue export sees it.
+type RemoteIssue struct {
+	Number int
+	Title  string
+	Body   string
+	State  string
+	URL    string
+	Labels []string
+}
+
+// IssueAPI abstracts the GitHub calls the issue export makes, enabling
+// test mocking.
+type IssueAPI interface {
+	// ListIssues returns every issue, open or closed, carrying label. Pull
+	// requests are left out.
+	ListIssues(ctx context.Context, label string) ([]RemoteIssue, error)
+
+	// CreateIssue files issue and returns it as created.
+	CreateIssue(ctx context.Context, issue output.GitHubIssue) (RemoteIssue, error)
+
+	// UpdateIssue replaces the title, body, and labels of issue number.
+	UpdateIssue(ctx context.Context, number int, issue output.GitHubIssue) error
+}
+
+// IssueChange is one issue the export creates or updates.
+type IssueChange struct {
+	ID     string `json:"id"`
+	Number int    `json:"number,omitempty"`
+	Title  string `json:"title"`
+	URL    string `json:"url,omitempty"`
+
+	// Fields lists the fields an update changed.
+	Fields []string `json:"fields,omitempty"`
+
+	issue output.GitHubIssue
+}
+
+// IssuesSync is the set of changes that brings a repository's issues in
+// line with a scan.
+type IssuesSync struct {
+	// Created are signals no issue matched, open or closed.
+	Created []IssueChange `json:"created"`
+
+	// Updated are open issues whose signal is still detected but whose
+	// title, body, or labels changed.
+	Updated []IssueChange `json:"updated"`
+
+	// Unchanged is the number of matched issues left as they are, closed
+	// issues included.
+	Unchanged int `json:"unchanged"`
+}
+
+// Changed reports whether applying the sync changes any issue.
+func (s *IssuesSync) Changed() bool {
+	return len(s.Created)+len(s.Updated) > 0
+}
+
+// PlanGitHubIssues matches signals, formatted by f, against the issues
+// stringer filed earlier. An issue is identified by the signal ID in its
+// marker, or else the first signal ID its body mentions, and signals match
+// it by that ID, then by normalized title, the same way Build matches
+// items. Issues filed by hand for a signal are not duplicated.
+//
+//   - a signal that matches no issue, open or closed, gets a new issue
+//     unless it is below minConfidence;
+//   - an open issue whose signal is still detected gets the signal's
+//     current title and body, and any labels it lacks. Labels added on
+//     GitHub are kept.
+//
+// Closed issues are never changed, so won't-fix decisions stick, and
+// issues whose signal is gone are left for reconcile to report.
+func PlanGitHubIssues(existing []RemoteIssue, signals []signal.RawSignal, f *output.GitHubIssuesFormatter, minConfidence float64) *IssuesSync {
+	s := &IssuesSync{Created: []IssueChange{}, Updated: []IssueChange{}}
+
+	keys := make([]beads.Bead, len(existing))
+	for i, is := range existing {
+		id := output.IssueMarkerID(is.Body)
+		if id == "" {
+			id = stringerIDPattern.FindString(is.Body)
+		}
+		if id == "" {
+			id = fmt.Sprintf("#%d", is.Number)
+		}
+		keys[i] = beads.Bead{ID: id, Title: is.Title}
+	}
+	m := beads.NewMatcher(keys)
+
+	matched := make(map[int]bool)
+	created := make(map[string]bool)
+	for _, sig := range signals {
+		if !sig.ClosedAt.IsZero() {
+			continue
+		}
+		issue := f.Issue(sig)
+		i, ok := m.Match(sig)
+		if !ok {
+			if created[issue.ID] || sig.Confidence < minConfidence {
+				continue
+			}
+			created[issue.ID] = true
+			s.Created = append(s.Created, IssueChange{ID: issue.ID, Title: issue.Title, issue: issue})
+			continue
+		}
+		if matched[i] {
+			continue
+		}
+		matched[i] = true
+
+		is := existing[i]
+		if is.State == "closed" {
+			s.Unchanged++
+			continue
+		}
+		change := IssueChange{ID: issue.ID, Number: is.Number, Title: issue.Title, URL: is.URL}
+		if is.Title != issue.Title {
+			change.Fields = append(change.Fields, "title")
+		}
+		if strings.TrimSpace(is.Body) != strings.TrimSpace(issue.Body) {
+			change.Fields = append(change.Fields, "body")
+		}
+		issueLabels := slices.Clone(is.Labels)
+		for _, l := range issue.Labels {
+			if !slices.Contains(issueLabels, l) {
+				issueLabels = append(issueLabels, l)
+			}
+		}
+		if len(issueLabels) != len(is.Labels) {
+			change.Fields = append(change.Fields, "labels")
+		}
+		if len(change.Fields) == 0 {
+			s.Unchanged++
+			continue
+		}
+		issue.Labels = issueLabels
+		change.issue = issue
+		s.Updated = append(s.Updated, change)
+	}
+	return s
+}
+
+// ApplyGitHubIssues creates and updates the issues in s through api,
+// recording the number and URL of each created issue. It stops at the
+// first failure; the changes made before it are kept in s.
+func ApplyGitHubIssues(ctx context.Context, api IssueAPI, s *IssuesSync) error {
+	for i := range s.Created {
+		c := &s.Created[i]
+		is, err := api.CreateIssue(ctx, c.issue)
+		if err != nil {
+			s.Created = s.Created[:i]
+			return fmt.Errorf("create issue %q: %w", c.Title, err)
+		}
+		c.Number, c.URL = is.Number, is.URL
+	}
+	for i := range s.Updated {
+		c := &s.Updated[i]
+		if err := api.UpdateIssue(ctx, c.Number, c.issue); err != nil {
+			s.Updated = s.Updated[:i]
+			return fmt.Errorf("update issue #%d: %w", c.Number, err)
+		}
+	}
+	return nil
+}
+
+// RenderIssuesSync writes the sync as a plain-text list of changes.
+func RenderIssuesSync(s *IssuesSync, w io.Writer) error {
+	for _, c := range s.Created {
+		ref := c.ID
+		if c.Number > 0 {
+			ref = fmt.Sprintf("#%d", c.Number)
+		}
+		_, _ = fmt.Fprintf(w, "  create  %s  %s\n", ref, c.Title)
+	}
+	for _, c := range s.Updated {
+		_, _ = fmt.Fprintf(w, "  update  #%d  %s (%s)\n", c.Number, c.Title, strings.Join(c.Fields, ", "))
+	}
+	return nil
+}
@@ -0,0 +1,174 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+//go:build !nonetwork
+
+package reconcile
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/google/go-github/v68/github"
+
+	"github.com/davetashner/stringer/internal/collectors"
+	"github.com/davetashner/stringer/internal/output"
+)
+
+// Rate limit handling for the issue export. Filing many issues at once
+// trips GitHub's secondary rate limit on content creation, which asks
+// clients to wait a minute or so; the primary limit resets hourly and is
+// only waited for when the reset is near.
+const (
+	// maxRateLimitWait is the longest a request waits for a limit to lift.
+	maxRateLimitWait = 2 * time.Minute
+
+	// defaultAbuseRetryAfter is waited when a secondary rate limit
+	// response does not say how long to wait.
+	defaultAbuseRetryAfter = time.Minute
+
+	// rateLimitRetries is the number of times a request is retried.
+	rateLimitRetries = 3
+)
+
+// sleepCtx waits for d or until ctx is done. Replaced in tests.
+var sleepCtx = func(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// githubIssueAPI implements IssueAPI against the GitHub API.
+type githubIssueAPI struct {
+	client *github.Client
+	owner  string
+	repo   string
+}
+
+// NewGitHubIssueAPI returns an IssueAPI for repoPath's origin remote,
+// authenticated with GITHUB_TOKEN.
+func NewGitHubIssueAPI(repoPath string) (IssueAPI, error) {
+	token := os.Getenv("GITHUB_TOKEN")
+	if token == "" {
+		return nil, fmt.Errorf("GITHUB_TOKEN is not set")
+	}
+	owner, repo, err := collectors.ParseGitHubRemote(repoPath)
+	if err != nil {
+		return nil, err
+	}
+	return &githubIssueAPI{client: collectors.NewGitHubClient(token), owner: owner, repo: repo}, nil
+}
+
+func (g *githubIssueAPI) ListIssues(ctx context.Context, label string) ([]RemoteIssue, error) {
+	opts := &github.IssueListByRepoOptions{
+		State:       "all",
+		Labels:      []string{label},
+		ListOptions: github.ListOptions{PerPage: 100},
+	}
+	var all []RemoteIssue
+	for {
+		var resp *github.Response
+		issues, err := withRateLimit(ctx, func() (issues []*github.Issue, err error) {
+			issues, resp, err = g.client.Issues.ListByRepo(ctx, g.owner, g.repo, opts)
+			return issues, err
+		})
+		if err != nil {
+			return nil, fmt.Errorf("list issues: %w", err)
+		}
+		for _, is := range issues {
+			if !is.IsPullRequest() {
+				all = append(all, remoteIssue(is))
+			}
+		}
+		if resp == nil || resp.NextPage == 0 {
+			return all, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+func (g *githubIssueAPI) CreateIssue(ctx context.Context, issue output.GitHubIssue) (RemoteIssue, error) {
+	created, err := withRateLimit(ctx, func() (*github.Issue, error) {
+		is, _, err := g.client.Issues.Create(ctx, g.owner, g.repo, issueRequest(issue))
+		return is, err
+	})
+	if err != nil {
+		return RemoteIssue{}, err
+	}
+	return remoteIssue(created), nil
+}
+
+func (g *githubIssueAPI) UpdateIssue(ctx context.Context, number int, issue output.GitHubIssue) error {
+	_, err := withRateLimit(ctx, func() (*github.Issue, error) {
+		is, _, err := g.client.Issues.Edit(ctx, g.owner, g.repo, number, issueRequest(issue))
+		return is, err
+	})
+	return err
+}
+
+// issueRequest converts issue to the API's request body.
+func issueRequest(issue output.GitHubIssue) *github.IssueRequest {
+	return &github.IssueRequest{
+		Title:  github.Ptr(issue.Title),
+		Body:   github.Ptr(issue.Body),
+		Labels: &issue.Labels,
+	}
+}
+
+// remoteIssue converts an API issue to a RemoteIssue.
+func remoteIssue(is *github.Issue) RemoteIssue {
+	r := RemoteIssue{
+		Number: is.GetNumber(),
+		Title:  is.GetTitle(),
+		Body:   is.GetBody(),
+		State:  is.GetState(),
+		URL:    is.GetHTMLURL(),
+	}
+	for _, l := range is.Labels {
+		r.Labels = append(r.Labels, l.GetName())
+	}
+	return r
+}
+
+// withRateLimit calls fn, waiting and retrying when GitHub answers with a
+// rate limit error that lifts within maxRateLimitWait.
+func withRateLimit[T any](ctx context.Context, fn func() (T, error)) (T, error) {
+	for attempt := 0; ; attempt++ {
+		v, err := fn()
+		wait, limited := rateLimitWait(err, time.Now())
+		if !limited || attempt >= rateLimitRetries || wait > maxRateLimitWait {
+			return v, err
+		}
+		slog.Warn("GitHub rate limit reached, waiting", "wait", wait.Round(time.Second))
+		if sleepErr := sleepCtx(ctx, wait); sleepErr != nil {
+			return v, err
+		}
+	}
+}
+
+// rateLimitWait returns how long to wait before retrying after err, and
+// whether err is a rate limit error at all.
+func rateLimitWait(err error, now time.Time) (time.Duration, bool) {
+	var rle *github.RateLimitError
+	if errors.As(err, &rle) {
+		// A second of slack covers clock skew with GitHub.
+		return max(rle.Rate.Reset.Sub(now), 0) + time.Second, true
+	}
+	var are *github.AbuseRateLimitError
+	if errors.As(err, &are) {
+		if are.RetryAfter != nil {
+			return *are.RetryAfter, true
+		}
+		return defaultAbuseRetryAfter, true
+	}
+	return 0, false
+}
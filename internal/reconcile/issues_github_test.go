@@ -0,0 +1,191 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+//go:build !nonetwork
+
+package reconcile
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v68/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davetashner/stringer/internal/output"
+)
+
+// newTestIssueAPI returns a githubIssueAPI talking to handler.
+func newTestIssueAPI(t *testing.T, handler http.Handler) *githubIssueAPI {
+	t.Helper()
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+	client := github.NewClient(nil)
+	client.BaseURL, _ = url.Parse(srv.URL + "/")
+	return &githubIssueAPI{client: client, owner: "o", repo: "r"}
+}
+
+// noSleep replaces sleepCtx for the test and records the waits.
+func noSleep(t *testing.T) *[]time.Duration {
+	t.Helper()
+	var waits []time.Duration
+	orig := sleepCtx
+	sleepCtx = func(_ context.Context, d time.Duration) error {
+		waits = append(waits, d)
+		return nil
+	}
+	t.Cleanup(func() { sleepCtx = orig })
+	return &waits
+}
+
+func TestGitHubIssueAPI_ListIssues(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /repos/o/r/issues", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "all", r.URL.Query().Get("state"))
+		assert.Equal(t, "stringer-generated", r.URL.Query().Get("labels"))
+		if r.URL.Query().Get("page") == "" {
+			w.Header().Set("Link", `<http://example.com/repos/o/r/issues?page=2>; rel="next"`)
+			_, _ = fmt.Fprint(w, `[{"number":1,"title":"A","body":"b","state":"open","html_url":"u1","labels":[{"name":"todo"}]},
+				{"number":2,"title":"PR","pull_request":{}}]`)
+			return
+		}
+		_, _ = fmt.Fprint(w, `[{"number":3,"title":"C","state":"closed"}]`)
+	})
+	api := newTestIssueAPI(t, mux)
+
+	issues, err := api.ListIssues(context.Background(), "stringer-generated")
+	require.NoError(t, err)
+	assert.Equal(t, []RemoteIssue{
+		{Number: 1, Title: "A", Body: "b", State: "open", URL: "u1", Labels: []string{"todo"}},
+		{Number: 3, Title: "C", State: "closed"},
+	}, issues)
+}
+
+func TestGitHubIssueAPI_CreateAndUpdate(t *testing.T) {
+	var edited github.IssueRequest
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /repos/o/r/issues", func(w http.ResponseWriter, r *http.Request) {
+		var req github.IssueRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "T", req.GetTitle())
+		assert.Equal(t, []string{"stringer-generated", "todo"}, *req.Labels)
+		w.WriteHeader(http.StatusCreated)
+		_, _ = fmt.Fprint(w, `{"number":42,"title":"T","state":"open","html_url":"https://github.com/o/r/issues/42"}`)
+	})
+	mux.HandleFunc("PATCH /repos/o/r/issues/7", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&edited))
+		_, _ = fmt.Fprint(w, `{"number":7}`)
+	})
+	api := newTestIssueAPI(t, mux)
+	issue := output.GitHubIssue{Title: "T", Body: "B", Labels: []string{"stringer-generated", "todo"}}
+
+	created, err := api.CreateIssue(context.Background(), issue)
+	require.NoError(t, err)
+	assert.Equal(t, 42, created.Number)
+	assert.Equal(t, "https://github.com/o/r/issues/42", created.URL)
+
+	require.NoError(t, api.UpdateIssue(context.Background(), 7, issue))
+	assert.Equal(t, "B", edited.GetBody())
+}
+
+func TestGitHubIssueAPI_RetriesSecondaryRateLimit(t *testing.T) {
+	waits := noSleep(t)
+	calls := 0
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /repos/o/r/issues", func(w http.ResponseWriter, _ *http.Request) {
+		calls++
+		if calls == 1 {
+			// No Retry-After: go-github refuses requests until such a
+			// deadline has really passed, which the test cannot fake.
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = fmt.Fprint(w, `{"message":"You have exceeded a secondary rate limit","documentation_url":"https://docs.github.com/rest/overview/rate-limits-for-the-rest-api#about-secondary-rate-limits"}`)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		_, _ = fmt.Fprint(w, `{"number":5}`)
+	})
+	api := newTestIssueAPI(t, mux)
+
+	created, err := api.CreateIssue(context.Background(), output.GitHubIssue{Title: "T"})
+	require.NoError(t, err)
+	assert.Equal(t, 5, created.Number)
+	assert.Equal(t, 2, calls)
+	assert.Equal(t, []time.Duration{defaultAbuseRetryAfter}, *waits)
+}
+
+func TestGitHubIssueAPI_GivesUpOnLongRateLimit(t *testing.T) {
+	waits := noSleep(t)
+	mux := http.NewServeMux()
+	mux.HandleFunc("PATCH /repos/o/r/issues/1", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("X-RateLimit-Limit", "5000")
+		w.Header().Set("X-RateLimit-Remaining", "0")
+		w.Header().Set("X-RateLimit-Reset", fmt.Sprint(time.Now().Add(time.Hour).Unix()))
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = fmt.Fprint(w, `{"message":"API rate limit exceeded"}`)
+	})
+	api := newTestIssueAPI(t, mux)
+
+	err := api.UpdateIssue(context.Background(), 1, output.GitHubIssue{Title: "T"})
+	var rle *github.RateLimitError
+	require.ErrorAs(t, err, &rle)
+	assert.Empty(t, *waits, "a limit resetting in an hour is not waited for")
+}
+
+func TestWithRateLimit_StopsAfterRetries(t *testing.T) {
+	waits := noSleep(t)
+	retryAfter := time.Second
+	calls := 0
+	_, err := withRateLimit(context.Background(), func() (int, error) {
+		calls++
+		return 0, &github.AbuseRateLimitError{RetryAfter: &retryAfter}
+	})
+	require.Error(t, err)
+	assert.Equal(t, rateLimitRetries+1, calls)
+	assert.Len(t, *waits, rateLimitRetries)
+}
+
+func TestWithRateLimit_OtherErrorsAreNotRetried(t *testing.T) {
+	waits := noSleep(t)
+	calls := 0
+	_, err := withRateLimit(context.Background(), func() (int, error) {
+		calls++
+		return 0, errors.New("boom")
+	})
+	require.Error(t, err)
+	assert.Equal(t, 1, calls)
+	assert.Empty(t, *waits)
+}
+
+func TestRateLimitWait(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	wait, ok := rateLimitWait(&github.RateLimitError{Rate: github.Rate{Reset: github.Timestamp{Time: now.Add(20 * time.Second)}}}, now)
+	assert.True(t, ok)
+	assert.Equal(t, 21*time.Second, wait)
+
+	wait, ok = rateLimitWait(&github.RateLimitError{Rate: github.Rate{Reset: github.Timestamp{Time: now.Add(-time.Minute)}}}, now)
+	assert.True(t, ok)
+	assert.Equal(t, time.Second, wait, "a reset in the past waits only the slack")
+
+	retryAfter := 30 * time.Second
+	wait, ok = rateLimitWait(&github.AbuseRateLimitError{RetryAfter: &retryAfter}, now)
+	assert.True(t, ok)
+	assert.Equal(t, retryAfter, wait)
+
+	wait, ok = rateLimitWait(&github.AbuseRateLimitError{}, now)
+	assert.True(t, ok)
+	assert.Equal(t, defaultAbuseRetryAfter, wait)
+
+	_, ok = rateLimitWait(fmt.Errorf("wrapped: %w", errors.New("boom")), now)
+	assert.False(t, ok)
+	_, ok = rateLimitWait(nil, now)
+	assert.False(t, ok)
+}
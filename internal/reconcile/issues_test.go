@@ -0,0 +1,152 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package reconcile
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davetashner/stringer/internal/output"
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+// mockIssueAPI records the calls ApplyGitHubIssues makes.
+type mockIssueAPI struct {
+	next      int
+	created   []output.GitHubIssue
+	updated   map[int]output.GitHubIssue
+	createErr error
+}
+
+func (m *mockIssueAPI) ListIssues(context.Context, string) ([]RemoteIssue, error) {
+	return nil, nil
+}
+
+func (m *mockIssueAPI) CreateIssue(_ context.Context, issue output.GitHubIssue) (RemoteIssue, error) {
+	if m.createErr != nil && len(m.created) == 1 {
+		return RemoteIssue{}, m.createErr
+	}
+	m.created = append(m.created, issue)
+	m.next++
+	return RemoteIssue{Number: 100 + m.next, URL: "https://github.com/o/r/issues/new"}, nil
+}
+
+func (m *mockIssueAPI) UpdateIssue(_ context.Context, number int, issue output.GitHubIssue) error {
+	if m.updated == nil {
+		m.updated = make(map[int]output.GitHubIssue)
+	}
+	m.updated[number] = issue
+	return nil
+}
+
+func TestPlanGitHubIssues(t *testing.T) {
+	f := output.NewGitHubIssuesFormatter()
+	same := signal.RawSignal{Source: "todos", Kind: "todo", FilePath: "a.go", Line: 1, Title: "TODO: same", Confidence: 0.7}
+	stale := signal.RawSignal{Source: "todos", Kind: "todo", FilePath: "b.go", Line: 2, Title: "TODO: stale", Confidence: 0.7}
+	wontFix := signal.RawSignal{Source: "todos", Kind: "todo", FilePath: "c.go", Line: 3, Title: "TODO: won't fix", Confidence: 0.7}
+	byHand := signal.RawSignal{Source: "todos", Kind: "todo", FilePath: "d.go", Line: 4, Title: "TODO: filed by hand", Confidence: 0.7}
+	fresh := signal.RawSignal{Source: "todos", Kind: "fixme", FilePath: "e.go", Line: 5, Title: "FIXME: new", Confidence: 0.7}
+	weak := signal.RawSignal{Source: "todos", Kind: "todo", FilePath: "f.go", Line: 6, Title: "TODO: weak", Confidence: 0.2}
+	closed := signal.RawSignal{Source: "todos", Kind: "todo", FilePath: "g.go", Line: 7, Title: "TODO: resolved", ClosedAt: time.Now()}
+
+	sameIssue := f.Issue(same)
+	staleIssue := f.Issue(stale)
+	existing := []RemoteIssue{
+		{Number: 1, Title: sameIssue.Title, Body: sameIssue.Body, State: "open", Labels: []string{"todo", "stringer-generated", "triaged"}},
+		{Number: 2, Title: "old title", Body: "old body\n" + output.IssueMarker(staleIssue.ID), State: "open", Labels: []string{"stringer-generated"}},
+		{Number: 3, Title: wontFix.Title, Body: "body", State: "closed"},
+		{Number: 4, Title: "Filed by hand", Body: "Tracks " + output.SignalID(byHand, "str-") + ".", State: "open"},
+	}
+
+	s := PlanGitHubIssues(existing, []signal.RawSignal{same, stale, wontFix, byHand, fresh, fresh, weak, closed}, f, 0.5)
+
+	require.Len(t, s.Created, 1, "duplicates, weak, and closed signals are not filed")
+	assert.Equal(t, f.Issue(fresh).ID, s.Created[0].ID)
+	assert.Equal(t, "FIXME: new", s.Created[0].Title)
+
+	require.Len(t, s.Updated, 2)
+	assert.Equal(t, 2, s.Updated[0].Number)
+	assert.Equal(t, []string{"title", "body", "labels"}, s.Updated[0].Fields)
+	assert.Equal(t, []string{"stringer-generated", "todo"}, s.Updated[0].issue.Labels)
+	assert.Equal(t, 4, s.Updated[1].Number, "an issue mentioning the signal ID matches it")
+	assert.Equal(t, []string{"title", "body", "labels"}, s.Updated[1].Fields)
+
+	assert.Equal(t, 2, s.Unchanged, "the up-to-date and the closed issue are left alone")
+	assert.True(t, s.Changed())
+}
+
+func TestPlanGitHubIssues_KeepsLabelsAddedOnGitHub(t *testing.T) {
+	f := output.NewGitHubIssuesFormatter()
+	sig := signal.RawSignal{Source: "todos", Kind: "todo", FilePath: "a.go", Line: 1, Title: "TODO: a"}
+	issue := f.Issue(sig)
+	existing := []RemoteIssue{{Number: 7, Title: issue.Title, Body: issue.Body, State: "open", Labels: []string{"stringer-generated", "p1"}}}
+
+	s := PlanGitHubIssues(existing, []signal.RawSignal{sig}, f, 0)
+
+	require.Len(t, s.Updated, 1)
+	assert.Equal(t, []string{"labels"}, s.Updated[0].Fields)
+	assert.Equal(t, []string{"stringer-generated", "p1", "todo"}, s.Updated[0].issue.Labels)
+}
+
+func TestPlanGitHubIssues_NothingToDo(t *testing.T) {
+	s := PlanGitHubIssues(nil, nil, output.NewGitHubIssuesFormatter(), 0)
+	assert.False(t, s.Changed())
+	assert.Empty(t, s.Created)
+	assert.Empty(t, s.Updated)
+}
+
+func TestApplyGitHubIssues(t *testing.T) {
+	f := output.NewGitHubIssuesFormatter()
+	a := signal.RawSignal{Kind: "todo", FilePath: "a.go", Line: 1, Title: "TODO: a"}
+	b := signal.RawSignal{Kind: "todo", FilePath: "b.go", Line: 2, Title: "TODO: b"}
+	existing := []RemoteIssue{{Number: 9, Title: "TODO: b", Body: "stale", State: "open"}}
+
+	s := PlanGitHubIssues(existing, []signal.RawSignal{a, b}, f, 0)
+	api := &mockIssueAPI{}
+	require.NoError(t, ApplyGitHubIssues(context.Background(), api, s))
+
+	require.Len(t, api.created, 1)
+	assert.Equal(t, f.Issue(a), api.created[0])
+	assert.Equal(t, 101, s.Created[0].Number)
+	assert.Equal(t, "https://github.com/o/r/issues/new", s.Created[0].URL)
+	require.Contains(t, api.updated, 9)
+	assert.Equal(t, f.Issue(b).Body, api.updated[9].Body)
+
+	var buf bytes.Buffer
+	require.NoError(t, RenderIssuesSync(s, &buf))
+	assert.Equal(t, "  create  #101  TODO: a\n  update  #9  TODO: b (body, labels)\n", buf.String())
+}
+
+func TestApplyGitHubIssues_StopsAtFirstFailure(t *testing.T) {
+	f := output.NewGitHubIssuesFormatter()
+	signals := []signal.RawSignal{
+		{Kind: "todo", FilePath: "a.go", Line: 1, Title: "TODO: a"},
+		{Kind: "todo", FilePath: "b.go", Line: 2, Title: "TODO: b"},
+		{Kind: "todo", FilePath: "c.go", Line: 3, Title: "TODO: c"},
+	}
+	s := PlanGitHubIssues(nil, signals, f, 0)
+	api := &mockIssueAPI{createErr: errors.New("boom")}
+
+	err := ApplyGitHubIssues(context.Background(), api, s)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `create issue "TODO: b"`)
+	require.Len(t, s.Created, 1, "only the issues actually filed are kept")
+	assert.Equal(t, 101, s.Created[0].Number)
+}
+
+func TestRenderIssuesSync_DryRun(t *testing.T) {
+	f := output.NewGitHubIssuesFormatter()
+	sig := signal.RawSignal{Kind: "todo", FilePath: "a.go", Line: 1, Title: "TODO: a"}
+	s := PlanGitHubIssues(nil, []signal.RawSignal{sig}, f, 0)
+
+	var buf bytes.Buffer
+	require.NoError(t, RenderIssuesSync(s, &buf))
+	assert.Equal(t, "  create  "+f.Issue(sig).ID+"  TODO: a\n", buf.String())
+}
@@ -0,0 +1,18 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+//go:build nonetwork
+
+package reconcile
+
+import "errors"
+
+// NewGitHubLister fails in builds with the nonetwork tag.
+func NewGitHubLister(string) (IssueLister, error) {
+	return nil, errors.New("stringer was built without network support (nonetwork tag)")
+}
+
+// NewGitHubIssueAPI fails in builds with the nonetwork tag.
+func NewGitHubIssueAPI(string) (IssueAPI, error) {
+	return nil, errors.New("stringer was built without network support (nonetwork tag)")
+}
@@ -0,0 +1,214 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+// Package reconcile compares the signals of a fresh scan with the items
+// stringer exported to an issue tracker earlier, so a backlog can be synced
+// with the code without guessing which issues are still relevant.
+package reconcile
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/davetashner/stringer/internal/beads"
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+// Item is one issue stringer exported to a tracker.
+type Item struct {
+	// ID is the tracker's identifier: a bead ID or a GitHub "#123".
+	ID string `json:"id"`
+
+	// Ref is the stringer signal ID recorded in the item (str-XXXXXXXX),
+	// when it differs from ID.
+	Ref string `json:"ref,omitempty"`
+
+	Title string `json:"title"`
+
+	// Status is "open" or "closed".
+	Status string `json:"status"`
+
+	URL string `json:"url,omitempty"`
+
+	// Sources name where the item's signal came from: a bead's labels,
+	// which include its collector, or the collector a GitHub issue body
+	// records. Empty when the tracker does not say.
+	Sources []string `json:"-"`
+}
+
+// Open reports whether the item is still open.
+func (i Item) Open() bool { return i.Status != "closed" }
+
+// Finding is a signal from the current scan.
+type Finding struct {
+	Kind       string  `json:"kind"`
+	Collector  string  `json:"collector"`
+	Title      string  `json:"title"`
+	FilePath   string  `json:"file_path,omitempty"`
+	Line       int     `json:"line,omitempty"`
+	Confidence float64 `json:"confidence"`
+}
+
+// Location returns "file:line", "file", or "" for findings not tied to a file.
+func (f Finding) Location() string {
+	if f.FilePath != "" && f.Line > 0 {
+		return fmt.Sprintf("%s:%d", f.FilePath, f.Line)
+	}
+	return f.FilePath
+}
+
+// Tracked is an open item whose signal is still detected.
+type Tracked struct {
+	Item    Item    `json:"item"`
+	Finding Finding `json:"finding"`
+}
+
+// Report is the result of reconciling a scan with a tracker.
+type Report struct {
+	Tracker string `json:"tracker"`
+
+	// StillOpen are open items whose signal is still detected.
+	StillOpen []Tracked `json:"still_open"`
+
+	// CloseCandidates are open items whose signal is no longer detected:
+	// the code they describe has been fixed or removed.
+	CloseCandidates []Item `json:"close_candidates"`
+
+	// Unfiled are detected signals that match no item, open or closed.
+	Unfiled []Finding `json:"unfiled"`
+
+	// Unchecked are open items whose signal is not detected but whose
+	// collector failed, was skipped, or did not run, so the scan cannot
+	// tell whether they are resolved.
+	Unchecked []Item `json:"unchecked"`
+
+	// Closed is the number of closed items. A closed item whose signal is
+	// still detected counts as filed, so won't-fix decisions stick.
+	Closed int `json:"closed"`
+}
+
+// Build matches signals against items. A signal matches an item by its
+// stringer ID, then by normalized title, the same way scan deduplicates
+// against an existing beads backlog. collectors are the collectors that ran
+// without error; an open item whose signal is gone is a close candidate
+// only when one of its Sources is among them, or it has no Sources.
+func Build(tracker string, signals []signal.RawSignal, items []Item, collectors map[string]bool) *Report {
+	r := &Report{
+		Tracker:         tracker,
+		StillOpen:       []Tracked{},
+		CloseCandidates: []Item{},
+		Unfiled:         []Finding{},
+		Unchecked:       []Item{},
+	}
+
+	keys := make([]beads.Bead, len(items))
+	for i, it := range items {
+		id := it.ID
+		if it.Ref != "" {
+			id = it.Ref
+		}
+		keys[i] = beads.Bead{ID: id, Title: it.Title}
+	}
+	m := beads.NewMatcher(keys)
+
+	detected := make(map[int]Finding)
+	for _, s := range signals {
+		f := findingOf(s)
+		i, ok := m.Match(s)
+		if !ok {
+			r.Unfiled = append(r.Unfiled, f)
+			continue
+		}
+		if _, seen := detected[i]; !seen {
+			detected[i] = f
+		}
+	}
+
+	for i, it := range items {
+		switch f, ok := detected[i]; {
+		case !it.Open():
+			r.Closed++
+		case ok:
+			r.StillOpen = append(r.StillOpen, Tracked{Item: it, Finding: f})
+		case len(it.Sources) > 0 && !slices.ContainsFunc(it.Sources, func(s string) bool { return collectors[s] }):
+			r.Unchecked = append(r.Unchecked, it)
+		default:
+			r.CloseCandidates = append(r.CloseCandidates, it)
+		}
+	}
+
+	sort.SliceStable(r.Unfiled, func(a, b int) bool {
+		if r.Unfiled[a].Confidence != r.Unfiled[b].Confidence {
+			return r.Unfiled[a].Confidence > r.Unfiled[b].Confidence
+		}
+		return r.Unfiled[a].Location() < r.Unfiled[b].Location()
+	})
+	return r
+}
+
+// findingOf converts a signal to a Finding.
+func findingOf(s signal.RawSignal) Finding {
+	return Finding{
+		Kind:       s.Kind,
+		Collector:  s.Source,
+		Title:      s.Title,
+		FilePath:   s.FilePath,
+		Line:       s.Line,
+		Confidence: s.Confidence,
+	}
+}
+
+// Render writes the report as a plain-text sync checklist.
+func Render(r *Report, w io.Writer) error {
+	title := fmt.Sprintf("Tracker Reconciliation (%s)", r.Tracker)
+	_, _ = fmt.Fprintf(w, "%s\n%s\n\n", title, strings.Repeat("-", len(title)))
+	_, _ = fmt.Fprintf(w, "  %d open and still detected\n", len(r.StillOpen))
+	_, _ = fmt.Fprintf(w, "  %d open but resolved in code (close candidates)\n", len(r.CloseCandidates))
+	_, _ = fmt.Fprintf(w, "  %d detected but never filed\n", len(r.Unfiled))
+	_, _ = fmt.Fprintf(w, "  %d closed\n", r.Closed)
+	if len(r.Unchecked) > 0 {
+		_, _ = fmt.Fprintf(w, "  %d open but not checked (collector did not run)\n", len(r.Unchecked))
+	}
+
+	if len(r.CloseCandidates) > 0 {
+		_, _ = fmt.Fprintf(w, "\n  Close candidates:\n")
+		for _, it := range r.CloseCandidates {
+			_, _ = fmt.Fprintf(w, "    %s  %s\n", it.ID, it.Title)
+		}
+	}
+	if len(r.Unfiled) > 0 {
+		_, _ = fmt.Fprintf(w, "\n  Detected but never filed:\n")
+		for _, f := range r.Unfiled {
+			loc := f.Location()
+			if loc == "" {
+				loc = "-"
+			}
+			_, _ = fmt.Fprintf(w, "    %-20s %s  %s\n", f.Kind, loc, f.Title)
+		}
+	}
+	if len(r.Unchecked) > 0 {
+		_, _ = fmt.Fprintf(w, "\n  Not checked:\n")
+		for _, it := range r.Unchecked {
+			_, _ = fmt.Fprintf(w, "    %s  %s\n", it.ID, it.Title)
+		}
+	}
+	if len(r.StillOpen) > 0 {
+		_, _ = fmt.Fprintf(w, "\n  Still open and still detected:\n")
+		for _, t := range r.StillOpen {
+			_, _ = fmt.Fprintf(w, "    %s  %s\n", t.Item.ID, t.Item.Title)
+		}
+	}
+	_, err := fmt.Fprintf(w, "\n")
+	return err
+}
+
+// RenderJSON writes the report as indented JSON.
+func RenderJSON(r *Report, w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(r)
+}
@@ -0,0 +1,132 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package reconcile
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davetashner/stringer/internal/output"
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+func testSignals() []signal.RawSignal {
+	return []signal.RawSignal{
+		{Source: "todos", Kind: "todo", FilePath: "main.go", Line: 4, Title: "TODO: Add CLI parsing", Confidence: 0.5},
+		{Source: "todos", Kind: "fixme", FilePath: "main.go", Line: 9, Title: "FIXME: Panics on nil", Confidence: 0.7},
+		{Source: "patterns", Kind: "large-file", FilePath: "big.go", Title: "Large file: big.go", Confidence: 0.4},
+		{Source: "gitlog", Kind: "revert", Title: "Reverted commit abc", Confidence: 0.4},
+	}
+}
+
+func TestBuild(t *testing.T) {
+	sigs := testSignals()
+	items := []Item{
+		{ID: output.SignalID(sigs[0], "str-"), Title: "Renamed in tracker", Status: "open"},
+		{ID: "str-00000000", Title: "Old TODO that was fixed", Status: "open"},
+		{ID: "#7", Title: "fixme: panics on nil", Status: "closed"},
+		{ID: "#8", Ref: output.SignalID(sigs[2], "str-"), Title: "Split big.go", Status: "open"},
+	}
+
+	r := Build("beads", sigs, items, nil)
+
+	require.Len(t, r.StillOpen, 2)
+	assert.Equal(t, "Renamed in tracker", r.StillOpen[0].Item.Title, "matched by signal ID")
+	assert.Equal(t, "main.go:4", r.StillOpen[0].Finding.Location())
+	assert.Equal(t, "#8", r.StillOpen[1].Item.ID, "matched by the ID in the issue body")
+
+	assert.Equal(t, []Item{items[1]}, r.CloseCandidates)
+
+	require.Len(t, r.Unfiled, 1, "signals matching closed items count as filed")
+	assert.Equal(t, "Reverted commit abc", r.Unfiled[0].Title)
+	assert.Equal(t, 1, r.Closed)
+}
+
+func TestBuild_Empty(t *testing.T) {
+	r := Build("github", nil, nil, nil)
+
+	var buf bytes.Buffer
+	require.NoError(t, RenderJSON(r, &buf))
+	var decoded map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &decoded))
+	assert.Equal(t, []any{}, decoded["close_candidates"], "empty lists encode as []")
+	assert.Equal(t, []any{}, decoded["unfiled"])
+	assert.Equal(t, []any{}, decoded["unchecked"])
+}
+
+func TestBuild_OnlyClosesItemsOfCollectorsThatRan(t *testing.T) {
+	items := []Item{
+		{ID: "str-00000001", Title: "Fixed TODO", Status: "open", Sources: []string{"stringer-generated", "todos"}},
+		{ID: "str-00000002", Title: "Old vulnerability", Status: "open", Sources: []string{"stringer-generated", "vuln"}},
+		{ID: "#3", Title: "Unknown origin", Status: "open"},
+	}
+
+	r := Build("beads", nil, items, map[string]bool{"todos": true})
+	assert.Equal(t, []Item{items[0], items[2]}, r.CloseCandidates, "items without sources are judged as before")
+	assert.Equal(t, []Item{items[1]}, r.Unchecked, "vuln failed or did not run")
+
+	var buf bytes.Buffer
+	require.NoError(t, Render(r, &buf))
+	assert.Contains(t, buf.String(), "  1 open but not checked (collector did not run)\n")
+	assert.Contains(t, buf.String(), "  Not checked:\n    str-00000002  Old vulnerability\n")
+}
+
+func TestRender(t *testing.T) {
+	sigs := testSignals()
+	r := Build("beads", sigs[:3], []Item{
+		{ID: "str-00000000", Title: "Old TODO that was fixed", Status: "open"},
+		{ID: "str-11111111", Title: "add cli parsing", Status: "open"},
+	}, nil)
+
+	var buf bytes.Buffer
+	require.NoError(t, Render(r, &buf))
+	assert.Equal(t, `Tracker Reconciliation (beads)
+------------------------------
+
+  1 open and still detected
+  1 open but resolved in code (close candidates)
+  2 detected but never filed
+  0 closed
+
+  Close candidates:
+    str-00000000  Old TODO that was fixed
+
+  Detected but never filed:
+    fixme                main.go:9  FIXME: Panics on nil
+    large-file           big.go  Large file: big.go
+
+  Still open and still detected:
+    str-11111111  add cli parsing
+
+`, buf.String())
+}
+
+func TestBeadsItems(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, ".beads"), 0o750))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".beads", "issues.jsonl"), []byte(
+		`{"id":"str-0a1b2c3d","title":"Exported","status":"open"}
+{"id":"app-12","title":"Imported with a new ID","status":"in_progress","labels":["todo","stringer_generated"]}
+{"id":"app-13","title":"Filed by hand","status":"open"}
+{"id":"str-99999999","title":"Done","status":"closed"}
+`), 0o600))
+
+	items, err := BeadsItems(dir)
+	require.NoError(t, err)
+	assert.Equal(t, []Item{
+		{ID: "str-0a1b2c3d", Title: "Exported", Status: "open"},
+		{ID: "app-12", Title: "Imported with a new ID", Status: "open", Sources: []string{"todo", "stringer_generated"}},
+		{ID: "str-99999999", Title: "Done", Status: "closed"},
+	}, items)
+
+	items, err = BeadsItems(t.TempDir())
+	require.NoError(t, err)
+	assert.Empty(t, items)
+}
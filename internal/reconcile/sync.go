@@ -0,0 +1,233 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package reconcile
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/davetashner/stringer/internal/beads"
+	"github.com/davetashner/stringer/internal/output"
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+// SyncCloseReason is the close_reason given to beads whose signal is gone.
+const SyncCloseReason = "resolved: no longer detected by stringer"
+
+// SyncOptions controls which changes SyncBeads makes.
+type SyncOptions struct {
+	// MinConfidence leaves signals below it unfiled. They still keep their
+	// existing beads open.
+	MinConfidence float64
+
+	// Collectors are the collectors that ran without error. Only exported
+	// beads labeled with one of them are closed, so a scan with fewer
+	// collectors does not close what it never looked for.
+	Collectors map[string]bool
+
+	// Now is the time recorded on closed and updated beads.
+	Now time.Time
+}
+
+// SyncChange is one bead SyncBeads creates, closes, or updates.
+type SyncChange struct {
+	ID    string `json:"id"`
+	Title string `json:"title"`
+
+	// Fields lists the fields an update changed.
+	Fields []string `json:"fields,omitempty"`
+}
+
+// BeadsSync is the result of syncing a beads issues.jsonl with a scan.
+type BeadsSync struct {
+	// Created are beads for signals no bead matched, open or closed.
+	Created []SyncChange `json:"created"`
+
+	// Closed are open exported beads whose signal is no longer detected.
+	Closed []SyncChange `json:"closed"`
+
+	// Updated are open exported beads whose signal is still detected but
+	// whose priority or description changed.
+	Updated []SyncChange `json:"updated"`
+
+	lines [][]byte
+}
+
+// Changed reports whether the sync changes the file.
+func (s *BeadsSync) Changed() bool {
+	return len(s.Created)+len(s.Closed)+len(s.Updated) > 0
+}
+
+// Bytes returns the synced issues.jsonl. Untouched lines are kept byte for
+// byte; changed beads keep every field stringer does not manage.
+func (s *BeadsSync) Bytes() []byte {
+	var buf bytes.Buffer
+	for _, line := range s.lines {
+		buf.Write(line)
+		buf.WriteByte('\n')
+	}
+	return buf.Bytes()
+}
+
+// syncedFields are the fields an update refreshes from the signal.
+var syncedFields = []string{"priority", "description"}
+
+// SyncBeads reconciles the beads in data, the contents of an issues.jsonl,
+// with the signals of a scan, formatted by f:
+//
+//   - a signal that matches no bead, open or closed, gets a new bead;
+//   - an open exported bead whose signal is gone is closed;
+//   - an open exported bead whose signal is still detected gets the
+//     signal's current priority and description.
+//
+// Signals match beads the same way Build matches them: by ID, then by
+// normalized title. Beads filed by hand and closed beads are never
+// changed, so won't-fix decisions stick.
+func SyncBeads(data []byte, signals []signal.RawSignal, f *output.BeadsFormatter, opts SyncOptions) (*BeadsSync, error) {
+	s := &BeadsSync{Created: []SyncChange{}, Closed: []SyncChange{}, Updated: []SyncChange{}}
+
+	var (
+		existing []beads.Bead
+		records  []map[string]json.RawMessage
+		lineOf   []int // index into s.lines of each existing bead
+	)
+	sc := bufio.NewScanner(bytes.NewReader(data))
+	sc.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for n := 1; sc.Scan(); n++ {
+		line := slices.Clone(sc.Bytes())
+		s.lines = append(s.lines, line)
+		if len(bytes.TrimSpace(line)) == 0 {
+			continue
+		}
+		var b beads.Bead
+		var rec map[string]json.RawMessage
+		if err := json.Unmarshal(line, &b); err != nil {
+			return nil, fmt.Errorf("parse bead at line %d: %w", n, err)
+		}
+		if err := json.Unmarshal(line, &rec); err != nil {
+			return nil, fmt.Errorf("parse bead at line %d: %w", n, err)
+		}
+		existing = append(existing, b)
+		records = append(records, rec)
+		lineOf = append(lineOf, len(s.lines)-1)
+	}
+	if err := sc.Err(); err != nil {
+		return nil, fmt.Errorf("read beads: %w", err)
+	}
+
+	byID := make(map[string]int, len(existing))
+	for i, b := range existing {
+		if _, ok := byID[b.ID]; !ok {
+			byID[b.ID] = i
+		}
+	}
+	m := beads.NewMatcher(existing)
+	created := make(map[string]bool)
+	detected := make(map[int]map[string]json.RawMessage)
+
+	for _, sig := range signals {
+		bead, err := f.Bead(sig)
+		if err != nil {
+			return nil, fmt.Errorf("format signal %q: %w", sig.Title, err)
+		}
+		var rec map[string]json.RawMessage
+		if err := json.Unmarshal(bead, &rec); err != nil {
+			return nil, err
+		}
+		var id string
+		_ = json.Unmarshal(rec["id"], &id)
+
+		i, ok := byID[id]
+		if !ok {
+			i, ok = m.Match(sig)
+		}
+		if ok {
+			if _, seen := detected[i]; !seen {
+				detected[i] = rec
+			}
+			continue
+		}
+		if created[id] || sig.Confidence < opts.MinConfidence {
+			continue
+		}
+		created[id] = true
+		s.lines = append(s.lines, bead)
+		s.Created = append(s.Created, SyncChange{ID: id, Title: sig.Title})
+	}
+
+	now, err := json.Marshal(opts.Now.UTC().Format("2006-01-02T15:04:05Z"))
+	if err != nil {
+		return nil, err
+	}
+	for i, b := range existing {
+		if b.Status == "closed" || !exportedBead(b) {
+			continue
+		}
+		rec := records[i]
+		change := SyncChange{ID: b.ID, Title: b.Title}
+
+		if found, ok := detected[i]; ok {
+			for _, field := range syncedFields {
+				if v, ok := found[field]; ok && !jsonEqual(rec[field], v) {
+					rec[field] = v
+					change.Fields = append(change.Fields, field)
+				}
+			}
+			if len(change.Fields) == 0 {
+				continue
+			}
+			s.Updated = append(s.Updated, change)
+		} else {
+			if !slices.ContainsFunc(b.Labels, func(l string) bool { return opts.Collectors[l] }) {
+				continue
+			}
+			rec["status"] = json.RawMessage(`"closed"`)
+			rec["closed_at"] = now
+			rec["close_reason"] = json.RawMessage(`"` + SyncCloseReason + `"`)
+			s.Closed = append(s.Closed, change)
+		}
+
+		rec["updated_at"] = now
+		line, err := json.Marshal(rec)
+		if err != nil {
+			return nil, fmt.Errorf("marshal bead %s: %w", b.ID, err)
+		}
+		s.lines[lineOf[i]] = line
+	}
+	return s, nil
+}
+
+// jsonEqual reports whether two JSON values are equal, ignoring formatting.
+func jsonEqual(a, b json.RawMessage) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	var va, vb any
+	if json.Unmarshal(a, &va) != nil || json.Unmarshal(b, &vb) != nil {
+		return bytes.Equal(a, b)
+	}
+	ja, _ := json.Marshal(va)
+	jb, _ := json.Marshal(vb)
+	return bytes.Equal(ja, jb)
+}
+
+// RenderSync writes the sync as a plain-text list of changes.
+func RenderSync(s *BeadsSync, w io.Writer) error {
+	for _, c := range s.Created {
+		_, _ = fmt.Fprintf(w, "  create  %s  %s\n", c.ID, c.Title)
+	}
+	for _, c := range s.Closed {
+		_, _ = fmt.Fprintf(w, "  close   %s  %s\n", c.ID, c.Title)
+	}
+	for _, c := range s.Updated {
+		_, _ = fmt.Fprintf(w, "  update  %s  %s (%s)\n", c.ID, c.Title, strings.Join(c.Fields, ", "))
+	}
+	return nil
+}
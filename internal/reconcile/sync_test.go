@@ -0,0 +1,112 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package reconcile
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davetashner/stringer/internal/output"
+)
+
+var syncNow = time.Date(2026, 5, 4, 12, 0, 0, 0, time.UTC)
+
+// syncedBeads parses the synced file into beads keyed by ID.
+func syncedBeads(t *testing.T, s *BeadsSync) map[string]map[string]any {
+	t.Helper()
+	out := make(map[string]map[string]any)
+	for _, line := range bytes.Split(bytes.TrimSpace(s.Bytes()), []byte("\n")) {
+		if len(bytes.TrimSpace(line)) == 0 {
+			continue
+		}
+		var b map[string]any
+		require.NoError(t, json.Unmarshal(line, &b))
+		out[b["id"].(string)] = b
+	}
+	return out
+}
+
+func TestSyncBeads(t *testing.T) {
+	sigs := testSignals()
+	todoID := output.SignalID(sigs[0], "str-")
+	fixmeID := output.SignalID(sigs[1], "str-")
+	hand := `{"id":"app-1","title":"Write release notes","status":"open","assignee":"sam"}`
+	data := strings.Join([]string{
+		// Still detected, but its priority and description are stale.
+		`{"id":"` + todoID + `","title":"TODO: Add CLI parsing","status":"in_progress","priority":1,"labels":["stringer-generated","todos"],"assignee":"kim"}`,
+		// Gone, and todos ran: closed.
+		`{"id":"str-00000000","title":"TODO: Remove the legacy flag","status":"open","labels":["stringer-generated","todos"]}`,
+		// Gone, but gitlog did not run: left open.
+		`{"id":"str-11111111","title":"High churn: old.go","status":"open","labels":["stringer-generated","gitlog"]}`,
+		// Closed as won't-fix: not refiled.
+		`{"id":"str-22222222","title":"fixme: panics on nil","status":"closed"}`,
+		// Filed by hand: never touched.
+		hand,
+		"",
+	}, "\n")
+
+	s, err := SyncBeads([]byte(data), sigs, output.NewBeadsFormatter(), SyncOptions{
+		MinConfidence: 0.4,
+		Collectors:    map[string]bool{"todos": true, "patterns": true},
+		Now:           syncNow,
+	})
+	require.NoError(t, err)
+
+	require.Len(t, s.Created, 2)
+	assert.Equal(t, "Large file: big.go", s.Created[0].Title)
+	assert.Equal(t, "Reverted commit abc", s.Created[1].Title)
+	require.Len(t, s.Closed, 1)
+	assert.Equal(t, "str-00000000", s.Closed[0].ID)
+	require.Len(t, s.Updated, 1)
+	assert.Equal(t, todoID, s.Updated[0].ID)
+	assert.Equal(t, []string{"priority", "description"}, s.Updated[0].Fields)
+	assert.True(t, s.Changed())
+
+	got := syncedBeads(t, s)
+	assert.Len(t, got, 7)
+	assert.NotContains(t, got, fixmeID, "closed beads are not refiled")
+
+	updated := got[todoID]
+	assert.Equal(t, 3.0, updated["priority"])
+	assert.Equal(t, "Location: main.go:4", updated["description"])
+	assert.Equal(t, "in_progress", updated["status"])
+	assert.Equal(t, "kim", updated["assignee"], "fields stringer does not manage are kept")
+	assert.Equal(t, "2026-05-04T12:00:00Z", updated["updated_at"])
+
+	closed := got["str-00000000"]
+	assert.Equal(t, "closed", closed["status"])
+	assert.Equal(t, "2026-05-04T12:00:00Z", closed["closed_at"])
+	assert.Equal(t, SyncCloseReason, closed["close_reason"])
+	assert.Equal(t, "open", got["str-11111111"]["status"])
+
+	assert.Contains(t, string(s.Bytes()), hand+"\n", "untouched lines are kept byte for byte")
+
+	var buf bytes.Buffer
+	require.NoError(t, RenderSync(s, &buf))
+	assert.Contains(t, buf.String(), "  close   str-00000000  TODO: Remove the legacy flag\n")
+	assert.Contains(t, buf.String(), "(priority, description)")
+}
+
+func TestSyncBeads_Idempotent(t *testing.T) {
+	opts := SyncOptions{Collectors: map[string]bool{"todos": true}, Now: syncNow}
+	first, err := SyncBeads(nil, testSignals(), output.NewBeadsFormatter(), opts)
+	require.NoError(t, err)
+	require.Len(t, first.Created, 4)
+
+	second, err := SyncBeads(first.Bytes(), testSignals(), output.NewBeadsFormatter(), opts)
+	require.NoError(t, err)
+	assert.False(t, second.Changed())
+	assert.Equal(t, first.Bytes(), second.Bytes())
+}
+
+func TestSyncBeads_InvalidLine(t *testing.T) {
+	_, err := SyncBeads([]byte("{}\nnot json\n"), nil, output.NewBeadsFormatter(), SyncOptions{})
+	assert.ErrorContains(t, err, "line 2")
+}
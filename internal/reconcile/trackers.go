@@ -0,0 +1,62 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package reconcile
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/davetashner/stringer/internal/beads"
+)
+
+// DefaultGitHubLabel is the label beads output gives stringer's issues, and
+// the one GitHub issues are selected by unless another is configured.
+const DefaultGitHubLabel = "stringer-generated"
+
+// stringerIDPattern matches a stringer signal ID in an issue body.
+var stringerIDPattern = regexp.MustCompile(`\bstr-[0-9a-f]{8}\b`)
+
+// BeadsItems returns the beads in repoPath's .beads/issues.jsonl that
+// stringer exported: those labeled stringer-generated (in either label
+// style) or with a str- ID. Beads filed by hand are left out so they are
+// never reported as close candidates.
+func BeadsItems(repoPath string) ([]Item, error) {
+	all, err := beads.LoadBeads(repoPath)
+	if err != nil {
+		return nil, err
+	}
+	var items []Item
+	for _, b := range all {
+		if !exportedBead(b) {
+			continue
+		}
+		status := "open"
+		if b.Status == "closed" {
+			status = "closed"
+		}
+		items = append(items, Item{ID: b.ID, Title: b.Title, Status: status, Sources: b.Labels})
+	}
+	return items, nil
+}
+
+// exportedBead reports whether b was written by stringer's beads output.
+func exportedBead(b beads.Bead) bool {
+	if strings.HasPrefix(b.ID, "str-") {
+		return true
+	}
+	for _, l := range b.Labels {
+		if l == "stringer-generated" || l == "stringer_generated" {
+			return true
+		}
+	}
+	return false
+}
+
+// IssueLister lists the GitHub issues stringer filed.
+type IssueLister interface {
+	// ListIssues returns every issue, open or closed, carrying label, as
+	// Items. Pull requests are left out.
+	ListIssues(ctx context.Context, label string) ([]Item, error)
+}
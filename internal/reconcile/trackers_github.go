@@ -0,0 +1,90 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+//go:build !nonetwork
+
+package reconcile
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+
+	"github.com/google/go-github/v68/github"
+
+	"github.com/davetashner/stringer/internal/collectors"
+)
+
+// issueCollectorPattern matches the collector the github-issues format
+// records in an issue body.
+var issueCollectorPattern = regexp.MustCompile("\\*\\*Collector:\\*\\* `([^`]+)`")
+
+// githubLister implements IssueLister against the GitHub API.
+type githubLister struct {
+	client *github.Client
+	owner  string
+	repo   string
+}
+
+func (g *githubLister) ListIssues(ctx context.Context, label string) ([]Item, error) {
+	opts := &github.IssueListByRepoOptions{
+		State:       "all",
+		Labels:      []string{label},
+		ListOptions: github.ListOptions{PerPage: 100},
+	}
+	var all []*github.Issue
+	for {
+		issues, resp, err := g.client.Issues.ListByRepo(ctx, g.owner, g.repo, opts)
+		if err != nil {
+			return nil, fmt.Errorf("list issues: %w", err)
+		}
+		all = append(all, issues...)
+		if resp == nil || resp.NextPage == 0 {
+			return GitHubItems(all), nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+// NewGitHubLister returns an IssueLister for repoPath's origin remote,
+// authenticated with GITHUB_TOKEN.
+func NewGitHubLister(repoPath string) (IssueLister, error) {
+	token := os.Getenv("GITHUB_TOKEN")
+	if token == "" {
+		return nil, fmt.Errorf("GITHUB_TOKEN is not set")
+	}
+	owner, repo, err := collectors.ParseGitHubRemote(repoPath)
+	if err != nil {
+		return nil, err
+	}
+	return &githubLister{
+		client: github.NewClient(nil).WithAuthToken(token),
+		owner:  owner,
+		repo:   repo,
+	}, nil
+}
+
+// GitHubItems converts issues to Items, dropping pull requests. An issue
+// whose body mentions a stringer signal ID (str-XXXXXXXX) is matched by it,
+// and the collector its body records is its source.
+func GitHubItems(issues []*github.Issue) []Item {
+	var items []Item
+	for _, is := range issues {
+		if is.IsPullRequest() {
+			continue
+		}
+		it := Item{
+			ID:     fmt.Sprintf("#%d", is.GetNumber()),
+			Ref:    stringerIDPattern.FindString(is.GetBody()),
+			Title:  is.GetTitle(),
+			Status: is.GetState(),
+			URL:    is.GetHTMLURL(),
+		}
+		if m := issueCollectorPattern.FindStringSubmatch(is.GetBody()); m != nil {
+			it.Sources = []string{m[1]}
+		}
+		items = append(items, it)
+	}
+	return items
+}
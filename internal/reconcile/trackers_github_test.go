@@ -0,0 +1,31 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+//go:build !nonetwork
+
+package reconcile
+
+import (
+	"testing"
+
+	"github.com/google/go-github/v68/github"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGitHubItems(t *testing.T) {
+	items := GitHubItems([]*github.Issue{
+		{
+			Number:  github.Ptr(12),
+			Title:   github.Ptr("TODO: Add CLI parsing"),
+			State:   github.Ptr("open"),
+			Body:    github.Ptr("Found by stringer (str-0a1b2c3d).\n\n**Kind:** `todo` · **Collector:** `todos` · **Priority:** P3\n\nLocation: main.go:4"),
+			HTMLURL: github.Ptr("https://github.com/o/r/issues/12"),
+		},
+		{Number: github.Ptr(13), Title: github.Ptr("A PR"), PullRequestLinks: &github.PullRequestLinks{}},
+		{Number: github.Ptr(14), Title: github.Ptr("No ID"), State: github.Ptr("closed")},
+	})
+	assert.Equal(t, []Item{
+		{ID: "#12", Ref: "str-0a1b2c3d", Title: "TODO: Add CLI parsing", Status: "open", URL: "https://github.com/o/r/issues/12", Sources: []string{"todos"}},
+		{ID: "#14", Title: "No ID", Status: "closed"},
+	}, items)
+}
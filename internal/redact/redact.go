@@ -26,6 +26,16 @@ var (
 	cacheOnce     sync.Once
 )
 
+// minRegisteredLen is the shortest value Register accepts. Shorter values
+// are too likely to occur in unrelated text.
+const minRegisteredLen = 8
+
+var (
+	registeredMu sync.RWMutex
+	registered   []string
+	seen         = make(map[string]bool)
+)
+
 func loadSecrets() {
 	for _, envVar := range sensitiveEnvVars {
 		val := os.Getenv(envVar)
@@ -40,6 +50,24 @@ func loadSecrets() {
 func resetCache() {
 	cachedSecrets = nil
 	cacheOnce = sync.Once{}
+	registeredMu.Lock()
+	registered = nil
+	seen = make(map[string]bool)
+	registeredMu.Unlock()
+}
+
+// Register adds secret values found while scanning, such as credentials
+// committed to the repository, to the values String replaces. It is safe
+// for concurrent use. Values shorter than 8 characters are ignored.
+func Register(values ...string) {
+	registeredMu.Lock()
+	defer registeredMu.Unlock()
+	for _, v := range values {
+		if len(v) >= minRegisteredLen && !seen[v] {
+			seen[v] = true
+			registered = append(registered, v)
+		}
+	}
 }
 
 // ResetForTest resets the cached secrets so tests in other packages can
@@ -47,12 +75,18 @@ func resetCache() {
 func ResetForTest() { resetCache() }
 
 // String replaces any occurrence of a known sensitive environment variable
-// value with "[REDACTED]". Returns the original string if no secrets are found.
-// Secret values are cached on first call for performance.
+// value, or of a value passed to Register, with "[REDACTED]". Returns the
+// original string if no secrets are found. Environment values are cached
+// on first call for performance.
 func String(s string) string {
 	cacheOnce.Do(loadSecrets)
 	for _, secret := range cachedSecrets {
 		s = strings.ReplaceAll(s, secret, "[REDACTED]")
 	}
+	registeredMu.RLock()
+	defer registeredMu.RUnlock()
+	for _, secret := range registered {
+		s = strings.ReplaceAll(s, secret, "[REDACTED]")
+	}
 	return s
 }
@@ -0,0 +1,57 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+//go:build !nonetwork
+
+package remote
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/google/go-github/v68/github"
+
+	"github.com/davetashner/stringer/internal/collectors"
+	"github.com/davetashner/stringer/internal/tracing"
+)
+
+// Download unpacks the tarball of spec into dir, authenticated with
+// GITHUB_TOKEN when it is set. Public repositories need no token.
+func Download(ctx context.Context, spec Spec, dir string) error {
+	client := github.NewClient(&http.Client{Transport: tracing.Transport(nil)})
+	if token := os.Getenv("GITHUB_TOKEN"); token != "" {
+		client = collectors.NewGitHubClient(token)
+	}
+	return download(ctx, client, spec, dir)
+}
+
+// download asks the API for the tarball's location and unpacks it.
+func download(ctx context.Context, client *github.Client, spec Spec, dir string) error {
+	var opts *github.RepositoryContentGetOptions
+	if spec.Ref != "" {
+		opts = &github.RepositoryContentGetOptions{Ref: spec.Ref}
+	}
+	link, _, err := client.Repositories.GetArchiveLink(ctx, spec.Owner, spec.Repo, github.Tarball, opts, 1)
+	if err != nil {
+		return fmt.Errorf("locating the tarball of %s: %w", spec, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, link.String(), nil)
+	if err != nil {
+		return err
+	}
+	resp, err := client.Client().Do(req)
+	if err != nil {
+		return fmt.Errorf("downloading %s: %w", spec, err)
+	}
+	defer resp.Body.Close() //nolint:errcheck // read-only
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("downloading %s: %s", spec, resp.Status)
+	}
+	if err := Extract(resp.Body, dir); err != nil {
+		return fmt.Errorf("unpacking %s: %w", spec, err)
+	}
+	return nil
+}
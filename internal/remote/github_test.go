@@ -0,0 +1,49 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+//go:build !nonetwork
+
+package remote
+
+import (
+	"archive/tar"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-github/v68/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDownload(t *testing.T) {
+	body := tarball(t,
+		tarEntry{name: "acme-api-1a2b3c4/go.mod", typeflag: tar.TypeReg, mode: 0o644, body: "module acme/api\n"},
+	).Bytes()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/repos/acme/api/tarball/v1.2":
+			http.Redirect(w, r, "http://"+r.Host+"/codeload/acme-api-v1.2.tar.gz", http.StatusFound)
+		case "/codeload/acme-api-v1.2.tar.gz":
+			_, _ = w.Write(body)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+	client := github.NewClient(nil)
+	client.BaseURL, _ = url.Parse(srv.URL + "/")
+
+	dir := t.TempDir()
+	require.NoError(t, download(context.Background(), client, Spec{Owner: "acme", Repo: "api", Ref: "v1.2"}, dir))
+	data, err := os.ReadFile(filepath.Join(dir, "go.mod"))
+	require.NoError(t, err)
+	assert.Equal(t, "module acme/api\n", string(data))
+
+	err = download(context.Background(), client, Spec{Owner: "acme", Repo: "gone"}, t.TempDir())
+	assert.ErrorContains(t, err, "locating the tarball of acme/gone")
+}
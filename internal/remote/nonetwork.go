@@ -0,0 +1,16 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+//go:build nonetwork
+
+package remote
+
+import (
+	"context"
+	"errors"
+)
+
+// Download fails in builds with the nonetwork tag.
+func Download(context.Context, Spec, string) error {
+	return errors.New("stringer was built without network support (nonetwork tag)")
+}
@@ -0,0 +1,122 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+// Package remote fetches the files of a GitHub repository without cloning
+// it, for scan --remote. The repository's tarball is downloaded through the
+// GitHub API and unpacked into a directory that is scanned like a checkout
+// without git history.
+package remote
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// MaxTarballSize caps the bytes unpacked from a tarball, so a scan of a
+// repository full of generated or vendored data cannot fill the disk.
+const MaxTarballSize = 2 << 30
+
+// Spec names a GitHub repository and, optionally, the branch, tag, or
+// commit to read.
+type Spec struct {
+	Owner string
+	Repo  string
+	Ref   string // default branch when empty
+}
+
+// String returns the spec as ParseSpec reads it.
+func (s Spec) String() string {
+	if s.Ref == "" {
+		return s.Owner + "/" + s.Repo
+	}
+	return s.Owner + "/" + s.Repo + "@" + s.Ref
+}
+
+// namePart matches a GitHub owner or repository name.
+var namePart = regexp.MustCompile(`^[A-Za-z0-9_.-]+$`)
+
+// ParseSpec reads "owner/repo" or "owner/repo@ref".
+func ParseSpec(s string) (Spec, error) {
+	name, ref, _ := strings.Cut(s, "@")
+	owner, repo, ok := strings.Cut(name, "/")
+	if !ok || !namePart.MatchString(owner) || !namePart.MatchString(repo) || repo == "." || repo == ".." {
+		return Spec{}, fmt.Errorf("%q is not owner/repo or owner/repo@ref", s)
+	}
+	if strings.Contains(s, "@") && ref == "" {
+		return Spec{}, fmt.Errorf("%q has an empty ref", s)
+	}
+	return Spec{Owner: owner, Repo: repo, Ref: ref}, nil
+}
+
+// Extract unpacks a gzipped tarball into dir, dropping the top-level
+// directory GitHub wraps the files in. Only directories and regular files
+// are written; symbolic links and other entries are skipped, and an entry
+// that would land outside dir is an error.
+func Extract(r io.Reader, dir string) error {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return fmt.Errorf("reading tarball: %w", err)
+	}
+	defer gz.Close() //nolint:errcheck // read-only
+
+	tr := tar.NewReader(gz)
+	var total int64
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("reading tarball: %w", err)
+		}
+
+		_, rel, _ := strings.Cut(hdr.Name, "/")
+		if rel = path.Clean(rel); rel == "." {
+			continue
+		}
+		if !filepath.IsLocal(rel) {
+			return fmt.Errorf("tarball entry %q is outside the repository", hdr.Name)
+		}
+		target := filepath.Join(dir, filepath.FromSlash(rel))
+
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(target, 0o750); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			total += hdr.Size
+			if total > MaxTarballSize {
+				return fmt.Errorf("tarball is larger than %d bytes", int64(MaxTarballSize))
+			}
+			if err := writeFile(target, tr, hdr); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// writeFile writes the content of a tarball entry to target. Files are
+// never executable, so nothing downloaded can be run by accident.
+func writeFile(target string, r io.Reader, hdr *tar.Header) error {
+	if err := os.MkdirAll(filepath.Dir(target), 0o750); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o600) //nolint:gosec // target is checked to be inside dir
+	if err != nil {
+		return err
+	}
+	if _, err := io.CopyN(f, r, hdr.Size); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("writing %s: %w", hdr.Name, err)
+	}
+	return f.Close()
+}
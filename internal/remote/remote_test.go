@@ -0,0 +1,94 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package remote
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSpec(t *testing.T) {
+	spec, err := ParseSpec("acme/api")
+	require.NoError(t, err)
+	assert.Equal(t, Spec{Owner: "acme", Repo: "api"}, spec)
+	assert.Equal(t, "acme/api", spec.String())
+
+	spec, err = ParseSpec("acme/api@release/1.2")
+	require.NoError(t, err)
+	assert.Equal(t, Spec{Owner: "acme", Repo: "api", Ref: "release/1.2"}, spec)
+	assert.Equal(t, "acme/api@release/1.2", spec.String())
+
+	for _, bad := range []string{"", "api", "acme/", "/api", "acme/api/web", "acme/..", "acme/api@", "https://github.com/acme/api"} {
+		_, err := ParseSpec(bad)
+		assert.Error(t, err, bad)
+	}
+}
+
+// tarEntry is one entry of a test tarball.
+type tarEntry struct {
+	name     string
+	typeflag byte
+	mode     int64
+	body     string
+}
+
+func tarball(t *testing.T, entries ...tarEntry) *bytes.Buffer {
+	t.Helper()
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	for _, e := range entries {
+		hdr := &tar.Header{Name: e.name, Typeflag: e.typeflag, Mode: e.mode, Size: int64(len(e.body))}
+		if e.typeflag == tar.TypeSymlink {
+			hdr.Linkname, hdr.Size = e.body, 0
+		}
+		require.NoError(t, tw.WriteHeader(hdr))
+		if e.typeflag == tar.TypeReg {
+			_, err := tw.Write([]byte(e.body))
+			require.NoError(t, err)
+		}
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, gz.Close())
+	return &buf
+}
+
+func TestExtract(t *testing.T) {
+	dir := t.TempDir()
+	err := Extract(tarball(t,
+		tarEntry{name: "acme-api-1a2b3c4/", typeflag: tar.TypeDir, mode: 0o755},
+		tarEntry{name: "acme-api-1a2b3c4/main.go", typeflag: tar.TypeReg, mode: 0o644, body: "package main\n"},
+		tarEntry{name: "acme-api-1a2b3c4/scripts/build.sh", typeflag: tar.TypeReg, mode: 0o755, body: "#!/bin/sh\n"},
+		tarEntry{name: "acme-api-1a2b3c4/etc", typeflag: tar.TypeSymlink, body: "/etc"},
+	), dir)
+	require.NoError(t, err)
+
+	data, err := os.ReadFile(filepath.Join(dir, "main.go"))
+	require.NoError(t, err)
+	assert.Equal(t, "package main\n", string(data))
+	info, err := os.Stat(filepath.Join(dir, "scripts", "build.sh"))
+	require.NoError(t, err)
+	assert.Zero(t, info.Mode()&0o111, "nothing downloaded is executable")
+	_, err = os.Lstat(filepath.Join(dir, "etc"))
+	assert.True(t, os.IsNotExist(err), "symbolic links are skipped")
+}
+
+func TestExtract_OutsideDir(t *testing.T) {
+	err := Extract(tarball(t,
+		tarEntry{name: "acme-api-1a2b3c4/../../evil", typeflag: tar.TypeReg, mode: 0o644, body: "x"},
+	), t.TempDir())
+	assert.ErrorContains(t, err, "outside the repository")
+}
+
+func TestExtract_NotGzip(t *testing.T) {
+	err := Extract(bytes.NewReader([]byte("not a tarball")), t.TempDir())
+	assert.ErrorContains(t, err, "reading tarball")
+}
@@ -64,8 +64,12 @@ func (s *lotteryRiskSection) Render(w io.Writer) error {
 	)
 
 	for _, d := range s.dirs {
+		dir := d.Path
+		if d.Module != "" {
+			dir = fmt.Sprintf("%s (%s)", d.Path, d.Module)
+		}
 		tbl.AddRow(
-			d.Path,
+			dir,
 			fmt.Sprintf("%d", d.LotteryRisk),
 			topContributors(d.Authors, 3),
 			riskLevel(d.LotteryRisk),
@@ -10,6 +10,8 @@ import (
 	"sort"
 	"strings"
 
+	"github.com/davetashner/stringer/internal/collectors"
+	"github.com/davetashner/stringer/internal/gradle"
 	"github.com/davetashner/stringer/internal/signal"
 )
 
@@ -26,7 +28,8 @@ type moduleSummary struct {
 	TopKinds       []string // up to 3 most frequent kinds
 }
 
-// moduleSummarySection groups signals by directory/package and produces per-module health scores.
+// moduleSummarySection groups signals by Gradle module or, outside a Gradle
+// multi-project build, by directory, and produces per-module health scores.
 type moduleSummarySection struct {
 	modules []moduleSummary
 	total   int
@@ -57,18 +60,21 @@ func extractModule(filePath string, depth int) string {
 	return strings.Join(parts, "/")
 }
 
-// mapConfidenceToPriorityLocal mirrors the confidence→priority mapping from output/beads.go.
-func mapConfidenceToPriorityLocal(confidence float64) int {
-	switch {
-	case confidence >= 0.8:
-		return 1
-	case confidence >= 0.6:
-		return 2
-	case confidence >= 0.4:
-		return 3
-	default:
-		return 4
+// gradleModules returns the Gradle modules found by the patterns or
+// lotteryrisk collector, or nil when neither saw a multi-project build.
+func gradleModules(result *signal.ScanResult) []gradle.Module {
+	if pm, ok := result.Metrics["patterns"].(*collectors.PatternsMetrics); ok && pm != nil && len(pm.GradleModules) > 0 {
+		return pm.GradleModules
+	}
+	var mods []gradle.Module
+	if lm, ok := result.Metrics["lotteryrisk"].(*collectors.LotteryRiskMetrics); ok && lm != nil {
+		for _, d := range lm.Directories {
+			if d.Module != "" {
+				mods = append(mods, gradle.Module{Name: d.Module, Dir: filepath.ToSlash(d.Path)})
+			}
+		}
 	}
+	return mods
 }
 
 func (s *moduleSummarySection) Analyze(result *signal.ScanResult) error {
@@ -90,9 +96,13 @@ func (s *moduleSummarySection) Analyze(result *signal.ScanResult) error {
 	}
 
 	groups := make(map[string]*moduleStats)
+	mods := gradleModules(result)
 
 	for _, sig := range result.Signals {
 		mod := extractModule(sig.FilePath, depth)
+		if m, ok := gradle.Containing(mods, sig.FilePath); ok {
+			mod = m.Name
+		}
 		stats, ok := groups[mod]
 		if !ok {
 			stats = &moduleStats{kindCounts: make(map[string]int)}
@@ -100,7 +110,7 @@ func (s *moduleSummarySection) Analyze(result *signal.ScanResult) error {
 		}
 		stats.total++
 
-		priority := mapConfidenceToPriorityLocal(sig.Confidence)
+		priority := signal.PriorityFromConfidence(sig.Confidence)
 		if sig.Priority != nil {
 			priority = *sig.Priority
 		}
@@ -9,6 +9,8 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/davetashner/stringer/internal/collectors"
+	"github.com/davetashner/stringer/internal/gradle"
 	"github.com/davetashner/stringer/internal/signal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -50,6 +52,37 @@ func TestModuleSummary_GroupsByDirectory(t *testing.T) {
 	assert.Equal(t, 1, cmd.Total)
 }
 
+func TestModuleSummary_GroupsByGradleModule(t *testing.T) {
+	s := &moduleSummarySection{}
+	result := &signal.ScanResult{
+		Signals: []signal.RawSignal{
+			{FilePath: "core/data/src/main/kotlin/Repo.kt", Kind: "todo", Confidence: 0.5},
+			{FilePath: "core/data/src/test/kotlin/RepoTest.kt", Kind: "todo", Confidence: 0.5},
+			{FilePath: "core/data", Kind: "low-test-ratio", Confidence: 0.5},
+			{FilePath: "buildSrc/src/main/kotlin/Conventions.kt", Kind: "todo", Confidence: 0.5},
+		},
+		Metrics: map[string]any{
+			"patterns": &collectors.PatternsMetrics{GradleModules: []gradle.Module{{Name: ":core:data", Dir: "core/data"}}},
+		},
+	}
+
+	require.NoError(t, s.Analyze(result))
+	totals := make(map[string]int)
+	for _, m := range s.modules {
+		totals[m.Module] = m.Total
+	}
+	assert.Equal(t, map[string]int{":core:data": 3, "buildSrc/src": 1}, totals)
+
+	// Modules reported by lotteryrisk work when patterns did not run.
+	result.Metrics = map[string]any{
+		"lotteryrisk": &collectors.LotteryRiskMetrics{Directories: []collectors.DirectoryOwnership{
+			{Path: "core/data", Module: ":core:data"}, {Path: "buildSrc"},
+		}},
+	}
+	require.NoError(t, s.Analyze(result))
+	assert.Equal(t, ":core:data", s.modules[0].Module)
+}
+
 func TestModuleSummary_RootFiles(t *testing.T) {
 	s := &moduleSummarySection{}
 	result := &signal.ScanResult{
@@ -0,0 +1,385 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package report
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/davetashner/stringer/internal/baseline"
+	"github.com/davetashner/stringer/internal/output"
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+// Noise analysis thresholds.
+const (
+	// noiseMinSignals is the smallest population a recommendation is based on.
+	noiseMinSignals = 5
+
+	// noiseDisableRate is the noise rate at which disabling a collector is
+	// recommended.
+	noiseDisableRate = 0.8
+
+	// noiseExcludeMin is the number of dismissed signals a directory needs
+	// before excluding it is recommended.
+	noiseExcludeMin = 3
+
+	// noiseExcludeRate is the share of a directory's signals that must be
+	// dismissed before excluding it is recommended.
+	noiseExcludeRate = 0.8
+
+	// noiseMaxKeptLoss is the largest share of kept signals a recommended
+	// min_confidence may drop.
+	noiseMaxKeptLoss = 0.2
+
+	// lowValueConfidence is the confidence below which a signal maps to P4.
+	lowValueConfidence = 0.4
+)
+
+// NoiseRow is the noise profile of one signal kind or collector.
+type NoiseRow struct {
+	Name           string  `json:"name"`
+	Collector      string  `json:"collector,omitempty"` // set for kind rows
+	Signals        int     `json:"signals"`
+	FalsePositives int     `json:"false_positives"`
+	WontFix        int     `json:"wont_fix"`
+	Acknowledged   int     `json:"acknowledged"`
+	LowValue       int     `json:"low_value"` // unsuppressed signals below P3 confidence
+	NoiseRate      float64 `json:"noise_rate"`
+
+	// MedianDaysToDismiss is the median time from a signal's timestamp to
+	// its false-positive or won't-fix suppression. Nil when unknown.
+	MedianDaysToDismiss *float64 `json:"median_days_to_dismiss,omitempty"`
+}
+
+// Dismissed returns the false-positive and won't-fix suppressions.
+func (r NoiseRow) Dismissed() int {
+	return r.FalsePositives + r.WontFix
+}
+
+// NoiseRecommendation is a suggested .stringer.yaml change.
+type NoiseRecommendation struct {
+	Collector string `json:"collector"`
+	Setting   string `json:"setting"`
+	Value     string `json:"value"`
+	Reason    string `json:"reason"`
+}
+
+// NoiseReport ranks kinds and collectors by how often their signals are
+// dismissed, derived from the current scan and the suppression baseline.
+type NoiseReport struct {
+	Signals         int                   `json:"signals"`
+	Dismissed       int                   `json:"dismissed"`
+	Acknowledged    int                   `json:"acknowledged"`
+	Stale           int                   `json:"stale_suppressions"` // suppressions matching no current signal
+	ByKind          []NoiseRow            `json:"by_kind"`
+	ByCollector     []NoiseRow            `json:"by_collector"`
+	Recommendations []NoiseRecommendation `json:"recommendations"`
+}
+
+// noiseAcc accumulates per-group data while building a NoiseReport.
+type noiseAcc struct {
+	row       NoiseRow
+	dismissAt []float64
+	dismissed []signal.RawSignal
+	kept      []signal.RawSignal
+}
+
+// BuildNoise matches signals against the baseline's suppressions. Dismissed
+// signals (false-positive and won't-fix) count as noise; acknowledged ones
+// are accepted work and reported separately. Expired suppressions are
+// ignored.
+func BuildNoise(signals []signal.RawSignal, bl *baseline.BaselineState, now time.Time) *NoiseReport {
+	suppressions := make(map[string]baseline.Suppression)
+	if bl != nil {
+		for _, s := range bl.Suppressions {
+			if s.ExpiresAt != nil && s.ExpiresAt.Before(now) {
+				continue
+			}
+			suppressions[s.SignalID] = s
+		}
+	}
+
+	r := &NoiseReport{Signals: len(signals)}
+	kinds := make(map[string]*noiseAcc)
+	colls := make(map[string]*noiseAcc)
+	matched := make(map[string]bool)
+
+	for _, sig := range signals {
+		id := output.SignalID(sig, "str-")
+		sup, suppressed := suppressions[id]
+		if suppressed {
+			matched[id] = true
+		}
+		kindKey := sig.Source + "\x00" + sig.Kind
+		if kinds[kindKey] == nil {
+			kinds[kindKey] = &noiseAcc{row: NoiseRow{Name: sig.Kind, Collector: sig.Source}}
+		}
+		if colls[sig.Source] == nil {
+			colls[sig.Source] = &noiseAcc{row: NoiseRow{Name: sig.Source}}
+		}
+
+		for _, acc := range []*noiseAcc{kinds[kindKey], colls[sig.Source]} {
+			acc.row.Signals++
+			switch {
+			case !suppressed:
+				acc.kept = append(acc.kept, sig)
+				if sig.Confidence < lowValueConfidence {
+					acc.row.LowValue++
+				}
+			case sup.Reason == baseline.ReasonAcknowledged:
+				acc.row.Acknowledged++
+			default:
+				if sup.Reason == baseline.ReasonFalsePositive {
+					acc.row.FalsePositives++
+				} else {
+					acc.row.WontFix++
+				}
+				acc.dismissed = append(acc.dismissed, sig)
+				if !sig.Timestamp.IsZero() && sup.SuppressedAt.After(sig.Timestamp) {
+					acc.dismissAt = append(acc.dismissAt, sup.SuppressedAt.Sub(sig.Timestamp).Hours()/24)
+				}
+			}
+		}
+
+		if suppressed {
+			if sup.Reason == baseline.ReasonAcknowledged {
+				r.Acknowledged++
+			} else {
+				r.Dismissed++
+			}
+		}
+	}
+	r.Stale = len(suppressions) - len(matched)
+
+	r.ByKind = finishNoiseRows(kinds)
+	r.ByCollector = finishNoiseRows(colls)
+
+	names := make([]string, 0, len(colls))
+	for name := range colls {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if rec, ok := recommendNoise(name, colls[name]); ok {
+			r.Recommendations = append(r.Recommendations, rec)
+		}
+	}
+	if r.Recommendations == nil {
+		r.Recommendations = []NoiseRecommendation{}
+	}
+	return r
+}
+
+// finishNoiseRows computes rates and medians and ranks rows noisiest first.
+func finishNoiseRows(accs map[string]*noiseAcc) []NoiseRow {
+	rows := make([]NoiseRow, 0, len(accs))
+	for _, acc := range accs {
+		row := acc.row
+		row.NoiseRate = float64(row.Dismissed()) / float64(row.Signals)
+		if len(acc.dismissAt) > 0 {
+			m := median(acc.dismissAt)
+			row.MedianDaysToDismiss = &m
+		}
+		rows = append(rows, row)
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		a, b := rows[i], rows[j]
+		if a.NoiseRate != b.NoiseRate {
+			return a.NoiseRate > b.NoiseRate
+		}
+		if a.Dismissed() != b.Dismissed() {
+			return a.Dismissed() > b.Dismissed()
+		}
+		if a.Collector != b.Collector {
+			return a.Collector < b.Collector
+		}
+		return a.Name < b.Name
+	})
+	return rows
+}
+
+// recommendNoise suggests the least drastic config change that removes most
+// of a collector's dismissed signals: a min_confidence threshold, an
+// excluded directory, or, failing those, disabling the collector.
+func recommendNoise(name string, acc *noiseAcc) (NoiseRecommendation, bool) {
+	row := acc.row
+	if row.Signals < noiseMinSignals || len(acc.dismissed) < noiseExcludeMin {
+		return NoiseRecommendation{}, false
+	}
+
+	// A confidence threshold above every dismissed signal that keeps most of
+	// the rest.
+	maxDismissed := 0.0
+	for _, s := range acc.dismissed {
+		maxDismissed = math.Max(maxDismissed, s.Confidence)
+	}
+	threshold := math.Floor(maxDismissed*20+1) / 20 // next 0.05 step above
+	if threshold <= 1.0 {
+		lost := 0
+		for _, s := range acc.kept {
+			if s.Confidence < threshold {
+				lost++
+			}
+		}
+		if float64(lost) <= noiseMaxKeptLoss*float64(len(acc.kept)) {
+			return NoiseRecommendation{
+				Collector: name,
+				Setting:   "min_confidence",
+				Value:     fmt.Sprintf("%.2f", threshold),
+				Reason: fmt.Sprintf("all %d dismissed signals have confidence <= %.2f; %d kept signals would be dropped",
+					len(acc.dismissed), maxDismissed, lost),
+			}, true
+		}
+	}
+
+	// A directory where nearly everything is dismissed.
+	if dir, n := noisiestDir(acc); dir != "" {
+		return NoiseRecommendation{
+			Collector: name,
+			Setting:   "exclude_patterns",
+			Value:     fmt.Sprintf("[%q]", dir+"/**"),
+			Reason:    fmt.Sprintf("%d of the dismissed signals are under %s/", n, dir),
+		}, true
+	}
+
+	if rate := float64(row.Dismissed()) / float64(row.Signals); rate >= noiseDisableRate {
+		return NoiseRecommendation{
+			Collector: name,
+			Setting:   "enabled",
+			Value:     "false",
+			Reason:    fmt.Sprintf("%.0f%% of its signals are dismissed", rate*100),
+		}, true
+	}
+	return NoiseRecommendation{}, false
+}
+
+// noisiestDir returns the top-level directory with the most dismissed
+// signals, provided enough of its signals were dismissed.
+func noisiestDir(acc *noiseAcc) (string, int) {
+	dismissed := make(map[string]int)
+	for _, s := range acc.dismissed {
+		if d := topDir(s.FilePath); d != "" {
+			dismissed[d]++
+		}
+	}
+	kept := make(map[string]int)
+	for _, s := range acc.kept {
+		if d := topDir(s.FilePath); d != "" {
+			kept[d]++
+		}
+	}
+
+	best, bestN := "", 0
+	for d, n := range dismissed {
+		if n < noiseExcludeMin || float64(n) < noiseExcludeRate*float64(n+kept[d]) {
+			continue
+		}
+		if n > bestN || (n == bestN && d < best) {
+			best, bestN = d, n
+		}
+	}
+	return best, bestN
+}
+
+// topDir returns the first segment of a slash-separated path, or "" for
+// files at the root.
+func topDir(p string) string {
+	if i := strings.Index(p, "/"); i > 0 {
+		return p[:i]
+	}
+	return ""
+}
+
+func median(xs []float64) float64 {
+	s := append([]float64(nil), xs...)
+	sort.Float64s(s)
+	mid := len(s) / 2
+	if len(s)%2 == 1 {
+		return s[mid]
+	}
+	return (s[mid-1] + s[mid]) / 2
+}
+
+// RenderNoise writes the noise report as terminal text.
+func RenderNoise(r *NoiseReport, w io.Writer) error {
+	title := "Signal Noise"
+	_, _ = fmt.Fprintf(w, "%s\n", SectionTitle(title))
+	_, _ = fmt.Fprintf(w, "%s\n", strings.Repeat("-", len(title)))
+	_, _ = fmt.Fprintf(w, "  %d signals, %s dismissed (false-positive or won't-fix), %d acknowledged\n",
+		r.Signals, colorRed.Sprint(r.Dismissed), r.Acknowledged)
+	if r.Stale > 0 {
+		_, _ = fmt.Fprintf(w, "  %d suppressions match no current signal (see 'stringer baseline status')\n", r.Stale)
+	}
+	_, _ = fmt.Fprintf(w, "\n")
+
+	if r.Dismissed == 0 && r.Acknowledged == 0 {
+		_, _ = fmt.Fprintf(w, "  No suppressions in the baseline yet. Suppress false positives with\n")
+		_, _ = fmt.Fprintf(w, "  'stringer baseline suppress <id> --reason false-positive' to build up noise data.\n")
+		return nil
+	}
+
+	_, _ = fmt.Fprintf(w, "  By kind:\n\n")
+	if err := renderNoiseTable(w, "Kind", r.ByKind, true); err != nil {
+		return err
+	}
+	_, _ = fmt.Fprintf(w, "\n  By collector:\n\n")
+	if err := renderNoiseTable(w, "Collector", r.ByCollector, false); err != nil {
+		return err
+	}
+
+	_, _ = fmt.Fprintf(w, "\n  Recommendations:\n")
+	if len(r.Recommendations) == 0 {
+		_, _ = fmt.Fprintf(w, "    None: no collector has enough dismissed signals to tune.\n")
+	}
+	for _, rec := range r.Recommendations {
+		_, _ = fmt.Fprintf(w, "\n    collectors.%s.%s: %s\n", rec.Collector, rec.Setting, colorGreen.Sprint(rec.Value))
+		_, _ = fmt.Fprintf(w, "      %s\n", rec.Reason)
+	}
+	_, _ = fmt.Fprintf(w, "\n")
+	return nil
+}
+
+// renderNoiseTable writes rows as a table headed by header.
+func renderNoiseTable(w io.Writer, header string, rows []NoiseRow, withCollector bool) error {
+	cols := []Column{{Header: header}}
+	if withCollector {
+		cols = append(cols, Column{Header: "Collector"})
+	}
+	cols = append(cols,
+		Column{Header: "Signals", Align: AlignRight},
+		Column{Header: "Dismissed", Align: AlignRight},
+		Column{Header: "Noise", Align: AlignRight},
+		Column{Header: "Low value", Align: AlignRight},
+		Column{Header: "Days to dismiss", Align: AlignRight},
+	)
+	tbl := NewTable(cols...)
+	for _, row := range rows {
+		days := "-"
+		if row.MedianDaysToDismiss != nil {
+			days = fmt.Sprintf("%.0f", *row.MedianDaysToDismiss)
+		}
+		cells := []string{row.Name}
+		if withCollector {
+			cells = append(cells, row.Collector)
+		}
+		cells = append(cells,
+			itoa(row.Signals), itoa(row.Dismissed()),
+			fmt.Sprintf("%.0f%%", row.NoiseRate*100), itoa(row.LowValue), days)
+		tbl.AddRow(cells...)
+	}
+	return tbl.Render(w)
+}
+
+// RenderNoiseJSON writes the noise report as indented JSON.
+func RenderNoiseJSON(r *NoiseReport, w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(r)
+}
@@ -0,0 +1,220 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package report
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davetashner/stringer/internal/baseline"
+	"github.com/davetashner/stringer/internal/output"
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+var noiseNow = time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+// noiseSignals builds n signals of one collector and kind.
+func noiseSignals(source, kind, dir string, n int, confidence float64) []signal.RawSignal {
+	sigs := make([]signal.RawSignal, n)
+	for i := range sigs {
+		path := fmt.Sprintf("%s/file%d.go", dir, i)
+		if dir == "" {
+			path = fmt.Sprintf("file%d.go", i)
+		}
+		sigs[i] = signal.RawSignal{
+			Source:     source,
+			Kind:       kind,
+			FilePath:   path,
+			Line:       i + 1,
+			Title:      fmt.Sprintf("%s %s %d", kind, dir, i),
+			Confidence: confidence,
+			Timestamp:  noiseNow.AddDate(0, 0, -30),
+		}
+	}
+	return sigs
+}
+
+// suppress returns a baseline suppressing sigs with reason, daysAfter days
+// after their timestamp.
+func suppress(sigs []signal.RawSignal, reason baseline.Reason, daysAfter int) []baseline.Suppression {
+	var out []baseline.Suppression
+	for _, s := range sigs {
+		out = append(out, baseline.Suppression{
+			SignalID:     output.SignalID(s, "str-"),
+			Reason:       reason,
+			SuppressedAt: s.Timestamp.AddDate(0, 0, daysAfter),
+		})
+	}
+	return out
+}
+
+func TestBuildNoise_CountsAndRanking(t *testing.T) {
+	todos := noiseSignals("todos", "todo", "src", 4, 0.5)
+	churn := noiseSignals("gitlog", "churn", "src", 4, 0.6)
+	low := noiseSignals("gitlog", "churn", "lib", 1, 0.3)
+
+	var sups []baseline.Suppression
+	sups = append(sups, suppress(todos[:1], baseline.ReasonFalsePositive, 2)...)
+	sups = append(sups, suppress(todos[1:2], baseline.ReasonWontFix, 4)...)
+	sups = append(sups, suppress(todos[2:3], baseline.ReasonAcknowledged, 1)...)
+	sups = append(sups, suppress(churn[:1], baseline.ReasonFalsePositive, 10)...)
+	sups = append(sups, baseline.Suppression{SignalID: "str-gone", Reason: baseline.ReasonFalsePositive, SuppressedAt: noiseNow})
+
+	all := append(append(append([]signal.RawSignal{}, todos...), churn...), low...)
+	r := BuildNoise(all, &baseline.BaselineState{Suppressions: sups}, noiseNow)
+
+	assert.Equal(t, 9, r.Signals)
+	assert.Equal(t, 3, r.Dismissed)
+	assert.Equal(t, 1, r.Acknowledged)
+	assert.Equal(t, 1, r.Stale)
+
+	require.Len(t, r.ByKind, 2)
+	todo := r.ByKind[0]
+	assert.Equal(t, "todo", todo.Name)
+	assert.Equal(t, "todos", todo.Collector)
+	assert.Equal(t, 1, todo.FalsePositives)
+	assert.Equal(t, 1, todo.WontFix)
+	assert.Equal(t, 1, todo.Acknowledged)
+	assert.InDelta(t, 0.5, todo.NoiseRate, 0.001)
+	require.NotNil(t, todo.MedianDaysToDismiss)
+	assert.InDelta(t, 3, *todo.MedianDaysToDismiss, 0.001)
+
+	churnRow := r.ByKind[1]
+	assert.Equal(t, "churn", churnRow.Name)
+	assert.Equal(t, 1, churnRow.LowValue)
+	assert.InDelta(t, 0.2, churnRow.NoiseRate, 0.001)
+
+	require.Len(t, r.ByCollector, 2)
+	assert.Equal(t, "todos", r.ByCollector[0].Name)
+	assert.Equal(t, "gitlog", r.ByCollector[1].Name)
+}
+
+func TestBuildNoise_NoBaseline(t *testing.T) {
+	r := BuildNoise(noiseSignals("todos", "todo", "src", 3, 0.5), nil, noiseNow)
+	assert.Equal(t, 3, r.Signals)
+	assert.Zero(t, r.Dismissed)
+	assert.Zero(t, r.Stale)
+	assert.Empty(t, r.Recommendations)
+	require.Len(t, r.ByKind, 1)
+	assert.Nil(t, r.ByKind[0].MedianDaysToDismiss)
+}
+
+func TestBuildNoise_ExpiredSuppressionIgnored(t *testing.T) {
+	sigs := noiseSignals("todos", "todo", "src", 1, 0.5)
+	sups := suppress(sigs, baseline.ReasonFalsePositive, 1)
+	expired := noiseNow.AddDate(0, 0, -1)
+	sups[0].ExpiresAt = &expired
+
+	r := BuildNoise(sigs, &baseline.BaselineState{Suppressions: sups}, noiseNow)
+	assert.Zero(t, r.Dismissed)
+	assert.Zero(t, r.Stale)
+}
+
+func TestBuildNoise_Recommendations(t *testing.T) {
+	tests := []struct {
+		name      string
+		dismissed []signal.RawSignal
+		kept      []signal.RawSignal
+		want      *NoiseRecommendation
+	}{
+		{
+			name:      "min confidence",
+			dismissed: noiseSignals("todos", "todo", "src", 3, 0.3),
+			kept:      noiseSignals("todos", "todo", "pkg", 3, 0.8),
+			want:      &NoiseRecommendation{Collector: "todos", Setting: "min_confidence", Value: "0.35"},
+		},
+		{
+			name:      "exclude directory",
+			dismissed: noiseSignals("todos", "todo", "vendor", 3, 0.8),
+			kept:      noiseSignals("todos", "todo", "src", 3, 0.8),
+			want:      &NoiseRecommendation{Collector: "todos", Setting: "exclude_patterns", Value: `["vendor/**"]`},
+		},
+		{
+			name:      "disable",
+			dismissed: noiseSignals("todos", "todo", "", 5, 0.9),
+			kept:      noiseSignals("todos", "fixme", "", 1, 0.9),
+			want:      &NoiseRecommendation{Collector: "todos", Setting: "enabled", Value: "false"},
+		},
+		{
+			name:      "too few signals",
+			dismissed: noiseSignals("todos", "todo", "vendor", 3, 0.3),
+			kept:      noiseSignals("todos", "todo", "src", 1, 0.8),
+		},
+		{
+			name:      "no pattern",
+			dismissed: noiseSignals("todos", "todo", "", 3, 0.8),
+			kept:      noiseSignals("todos", "fixme", "", 5, 0.8),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			all := append(append([]signal.RawSignal{}, tt.dismissed...), tt.kept...)
+			bl := &baseline.BaselineState{Suppressions: suppress(tt.dismissed, baseline.ReasonFalsePositive, 1)}
+			r := BuildNoise(all, bl, noiseNow)
+			if tt.want == nil {
+				assert.Empty(t, r.Recommendations)
+				return
+			}
+			require.Len(t, r.Recommendations, 1)
+			rec := r.Recommendations[0]
+			assert.Equal(t, tt.want.Collector, rec.Collector)
+			assert.Equal(t, tt.want.Setting, rec.Setting)
+			assert.Equal(t, tt.want.Value, rec.Value)
+			assert.NotEmpty(t, rec.Reason)
+		})
+	}
+}
+
+func TestBuildNoise_AcknowledgedIsNotNoise(t *testing.T) {
+	sigs := noiseSignals("todos", "todo", "", 6, 0.9)
+	bl := &baseline.BaselineState{Suppressions: suppress(sigs, baseline.ReasonAcknowledged, 0)}
+	r := BuildNoise(sigs, bl, noiseNow)
+	assert.Equal(t, 6, r.Acknowledged)
+	assert.Zero(t, r.Dismissed)
+	assert.Zero(t, r.ByCollector[0].NoiseRate)
+	assert.Empty(t, r.Recommendations)
+}
+
+func TestRenderNoise(t *testing.T) {
+	dismissed := noiseSignals("todos", "todo", "vendor", 3, 0.8)
+	kept := noiseSignals("todos", "todo", "src", 3, 0.8)
+	bl := &baseline.BaselineState{Suppressions: suppress(dismissed, baseline.ReasonWontFix, 5)}
+	r := BuildNoise(append(dismissed, kept...), bl, noiseNow)
+
+	var buf bytes.Buffer
+	require.NoError(t, RenderNoise(r, &buf))
+	out := buf.String()
+	assert.Contains(t, out, "Signal Noise")
+	assert.Contains(t, out, "6 signals, 3 dismissed")
+	assert.Contains(t, out, "By kind:")
+	assert.Contains(t, out, "50%")
+	assert.Contains(t, out, `collectors.todos.exclude_patterns: ["vendor/**"]`)
+}
+
+func TestRenderNoise_NoSuppressions(t *testing.T) {
+	r := BuildNoise(noiseSignals("todos", "todo", "src", 2, 0.5), nil, noiseNow)
+	var buf bytes.Buffer
+	require.NoError(t, RenderNoise(r, &buf))
+	assert.Contains(t, buf.String(), "No suppressions in the baseline yet")
+	assert.NotContains(t, buf.String(), "By kind:")
+}
+
+func TestRenderNoiseJSON(t *testing.T) {
+	r := BuildNoise(noiseSignals("todos", "todo", "src", 2, 0.5), nil, noiseNow)
+	var buf bytes.Buffer
+	require.NoError(t, RenderNoiseJSON(r, &buf))
+
+	var decoded NoiseReport
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &decoded))
+	assert.Equal(t, 2, decoded.Signals)
+	require.Len(t, decoded.ByKind, 1)
+	assert.Equal(t, "todo", decoded.ByKind[0].Name)
+	assert.NotNil(t, decoded.Recommendations)
+}
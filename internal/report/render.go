@@ -19,8 +19,10 @@ type ReportJSON struct {
 	Generated  string                `json:"generated"`
 	Duration   string                `json:"duration"`
 	Collectors []CollectorResultJSON `json:"collectors"`
-	Signals    SignalSummaryJSON     `json:"signals"`
-	Sections   []SectionJSON         `json:"sections,omitempty"`
+	// ErrorCounts is the number of failed collectors per error category.
+	ErrorCounts map[signal.ErrorCategory]int `json:"error_counts,omitempty"`
+	Signals     SignalSummaryJSON            `json:"signals"`
+	Sections    []SectionJSON                `json:"sections,omitempty"`
 }
 
 // CollectorResultJSON is the JSON representation of a single collector result.
@@ -29,6 +31,9 @@ type CollectorResultJSON struct {
 	Signals    int    `json:"signals"`
 	Duration   string `json:"duration"`
 	Error      string `json:"error,omitempty"`
+	Category   string `json:"error_category,omitempty"`
+	Skipped    string `json:"skipped,omitempty"`
+	Retried    string `json:"retried,omitempty"`
 	HasMetrics bool   `json:"has_metrics"`
 }
 
@@ -53,6 +58,9 @@ func RenderJSON(result *signal.ScanResult, repoPath string, collectorNames []str
 		Generated:  time.Now().Format(time.RFC3339),
 		Duration:   result.Duration.Round(time.Millisecond).String(),
 	}
+	if counts := result.ErrorCounts(); len(counts) > 0 {
+		out.ErrorCounts = counts
+	}
 
 	// Collector results.
 	for _, cr := range result.Results {
@@ -64,7 +72,10 @@ func RenderJSON(result *signal.ScanResult, repoPath string, collectorNames []str
 		}
 		if cr.Err != nil {
 			cj.Error = cr.Err.Error()
+			cj.Category = string(cr.Category())
 		}
+		cj.Skipped = cr.SkipReason
+		cj.Retried = cr.RetryReason
 		out.Collectors = append(out.Collectors, cj)
 	}
 
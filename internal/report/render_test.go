@@ -6,6 +6,7 @@ package report
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"io"
 	"testing"
 	"time"
@@ -31,6 +32,25 @@ func TestRenderJSON_EmptyResult(t *testing.T) {
 	assert.Equal(t, 0, parsed.Signals.Total)
 }
 
+func TestRenderJSON_ErrorCategory(t *testing.T) {
+	result := &signal.ScanResult{
+		Results: []signal.CollectorResult{
+			{Collector: "todos"},
+			{Collector: "gitlab", Err: errors.New("no such host"), ErrCategory: signal.CategoryNetwork},
+		},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, RenderJSON(result, "/test/repo", nil, nil, &buf))
+
+	var parsed ReportJSON
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &parsed))
+	require.Len(t, parsed.Collectors, 2)
+	assert.Empty(t, parsed.Collectors[0].Category)
+	assert.Equal(t, "network", parsed.Collectors[1].Category)
+	assert.Equal(t, map[signal.ErrorCategory]int{signal.CategoryNetwork: 1}, parsed.ErrorCounts)
+}
+
 func TestRenderJSON_WithSignals(t *testing.T) {
 	result := &signal.ScanResult{
 		Signals: []signal.RawSignal{
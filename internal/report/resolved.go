@@ -0,0 +1,365 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package report
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/davetashner/stringer/internal/collectors"
+	"github.com/davetashner/stringer/internal/gitcli"
+	"github.com/davetashner/stringer/internal/state"
+)
+
+// ResolvedItem is one piece of debt paid down in the period.
+type ResolvedItem struct {
+	FilePath string `json:"file_path"`
+	Title    string `json:"title"`
+}
+
+// KindDelta is a signal kind whose count fell between two recorded scans.
+type KindDelta struct {
+	Kind   string `json:"kind"`
+	Before int    `json:"before"`
+	After  int    `json:"after"`
+}
+
+// ResolvedReport lists debt resolved since a git ref or point in time,
+// derived from the git diff over the period and from scan history.
+type ResolvedReport struct {
+	Since        string         `json:"since"`
+	BaseCommit   string         `json:"base_commit"`
+	BaseTime     time.Time      `json:"base_time"`
+	HeadCommit   string         `json:"head_commit"`
+	TodosRemoved []ResolvedItem `json:"todos_removed"`
+	TestsAdded   []ResolvedItem `json:"tests_added"`
+	DepsUpdated  []ResolvedItem `json:"deps_updated"`
+	KindDeltas   []KindDelta    `json:"kind_deltas,omitempty"`
+}
+
+// Total returns the number of resolved items found in the diff.
+func (r *ResolvedReport) Total() int {
+	return len(r.TodosRemoved) + len(r.TestsAdded) + len(r.DepsUpdated)
+}
+
+// BuildResolved compares HEAD against since, which is a git ref, a date
+// (2006-01-02), or a duration such as "14d" or "2w". Paths are relative to
+// repoPath, and only changes beneath it are considered.
+func BuildResolved(ctx context.Context, repoPath, since string, now time.Time) (*ResolvedReport, error) {
+	base, err := resolveSinceCommit(ctx, repoPath, since, now)
+	if err != nil {
+		return nil, err
+	}
+	head, err := gitcli.Exec(ctx, repoPath, "rev-parse", "HEAD")
+	if err != nil {
+		return nil, fmt.Errorf("resolving HEAD: %w", err)
+	}
+	baseTime, err := gitcli.Exec(ctx, repoPath, "show", "-s", "--format=%cI", base)
+	if err != nil {
+		return nil, fmt.Errorf("reading commit time of %s: %w", since, err)
+	}
+	diff, err := gitcli.Exec(ctx, repoPath, "diff", "--relative", "--no-color", "--no-ext-diff", "--unified=0", base, "HEAD")
+	if err != nil {
+		return nil, fmt.Errorf("diffing %s..HEAD: %w", since, err)
+	}
+
+	r := parseResolvedDiff(diff)
+	r.Since = since
+	r.BaseCommit = base
+	r.HeadCommit = strings.TrimSpace(head)
+	r.BaseTime, _ = time.Parse(time.RFC3339, strings.TrimSpace(baseTime))
+
+	h, err := state.LoadHistory(repoPath)
+	if err != nil {
+		return nil, fmt.Errorf("loading scan history: %w", err)
+	}
+	r.KindDeltas = historyKindDeltas(h, r.BaseTime)
+	return r, nil
+}
+
+// resolveSinceCommit returns the commit hash that since refers to. Dates and
+// durations resolve to the last commit on HEAD before that time.
+func resolveSinceCommit(ctx context.Context, repoPath, since string, now time.Time) (string, error) {
+	var cutoff time.Time
+	if t, err := time.Parse("2006-01-02", since); err == nil {
+		cutoff = t
+	} else if d, err := collectors.ParseDuration(since); err == nil {
+		cutoff = now.Add(-d)
+	}
+
+	if cutoff.IsZero() {
+		out, err := gitcli.Exec(ctx, repoPath, "rev-parse", "--verify", "--quiet", since+"^{commit}")
+		if err != nil {
+			return "", fmt.Errorf("--since %q is not a date, duration, or git ref", since)
+		}
+		return strings.TrimSpace(out), nil
+	}
+
+	out, err := gitcli.Exec(ctx, repoPath, "rev-list", "-1", "--before="+cutoff.Format(time.RFC3339), "HEAD")
+	if err != nil {
+		return "", fmt.Errorf("finding commit before %s: %w", cutoff.Format("2006-01-02"), err)
+	}
+	base := strings.TrimSpace(out)
+	if base == "" {
+		return "", fmt.Errorf("no commits before %s", cutoff.Format("2006-01-02"))
+	}
+	return base, nil
+}
+
+// goModRequirePattern matches a requirement line in go.mod, inside or
+// outside a require block.
+var goModRequirePattern = regexp.MustCompile(`^\s*(?:require\s+)?([^\s()]+)\s+(v[^\s]+)`)
+
+// packageJSONDepPattern matches a "name": "version" dependency entry.
+var packageJSONDepPattern = regexp.MustCompile(`^\s*"([^"]+)"\s*:\s*"([~^<>=]*\d[^"]*)"`)
+
+// diffFile accumulates the changes to one file in a unified diff.
+type diffFile struct {
+	path    string
+	isNew   bool
+	removed []string
+	added   []string
+}
+
+// parseResolvedDiff extracts resolved debt from a unified diff: TODO-style
+// comments removed and not re-added elsewhere, new test files, and direct
+// dependency version changes in go.mod and package.json.
+func parseResolvedDiff(diff string) *ResolvedReport {
+	files := parseUnifiedDiff(diff)
+	r := &ResolvedReport{
+		TodosRemoved: []ResolvedItem{},
+		TestsAdded:   []ResolvedItem{},
+		DepsUpdated:  []ResolvedItem{},
+	}
+
+	// A TODO removed in one place and added back in another was moved,
+	// not resolved.
+	readded := make(map[string]int)
+	for _, f := range files {
+		for _, line := range f.added {
+			if keyword, message, ok := collectors.ParseTODO(line); ok {
+				readded[keyword+": "+message]++
+			}
+		}
+	}
+
+	for _, f := range files {
+		if isVendoredPath(f.path) {
+			continue
+		}
+		for _, line := range f.removed {
+			keyword, message, ok := collectors.ParseTODO(line)
+			if !ok {
+				continue
+			}
+			title := keyword + ": " + message
+			if readded[title] > 0 {
+				readded[title]--
+				continue
+			}
+			r.TodosRemoved = append(r.TodosRemoved, ResolvedItem{FilePath: f.path, Title: title})
+		}
+		if f.isNew && collectors.IsTestFile(f.path) {
+			r.TestsAdded = append(r.TestsAdded, ResolvedItem{FilePath: f.path, Title: "Added " + path.Base(f.path)})
+		}
+		switch path.Base(f.path) {
+		case "go.mod":
+			r.DepsUpdated = append(r.DepsUpdated, versionChanges(f, goModRequirePattern, "// indirect")...)
+		case "package.json":
+			r.DepsUpdated = append(r.DepsUpdated, versionChanges(f, packageJSONDepPattern, "")...)
+		}
+	}
+	return r
+}
+
+// parseUnifiedDiff splits git diff output into per-file removed and added
+// lines.
+func parseUnifiedDiff(diff string) []*diffFile {
+	var files []*diffFile
+	var cur *diffFile
+	inHunk := false
+
+	scanner := bufio.NewScanner(strings.NewReader(diff))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case strings.HasPrefix(line, "diff --git "):
+			cur = &diffFile{}
+			files = append(files, cur)
+			inHunk = false
+		case cur == nil:
+			continue
+		case !inHunk && strings.HasPrefix(line, "new file mode"):
+			cur.isNew = true
+		case !inHunk && strings.HasPrefix(line, "--- "):
+			if p := strings.TrimPrefix(line, "--- "); p != "/dev/null" {
+				cur.path = strings.TrimPrefix(p, "a/")
+			}
+		case !inHunk && strings.HasPrefix(line, "+++ "):
+			if p := strings.TrimPrefix(line, "+++ "); p != "/dev/null" {
+				cur.path = strings.TrimPrefix(p, "b/")
+			}
+		case strings.HasPrefix(line, "@@"):
+			inHunk = true
+		case inHunk && strings.HasPrefix(line, "-"):
+			cur.removed = append(cur.removed, line[1:])
+		case inHunk && strings.HasPrefix(line, "+"):
+			cur.added = append(cur.added, line[1:])
+		}
+	}
+	return files
+}
+
+// versionChanges returns dependencies whose version changed in a manifest
+// diff. Lines containing skip (when non-empty) are ignored.
+func versionChanges(f *diffFile, pattern *regexp.Regexp, skip string) []ResolvedItem {
+	versions := func(lines []string) map[string]string {
+		out := make(map[string]string)
+		for _, line := range lines {
+			if skip != "" && strings.Contains(line, skip) {
+				continue
+			}
+			if m := pattern.FindStringSubmatch(line); m != nil && m[1] != "version" {
+				out[m[1]] = m[2]
+			}
+		}
+		return out
+	}
+	before := versions(f.removed)
+	after := versions(f.added)
+
+	var items []ResolvedItem
+	for name, old := range before {
+		if updated, ok := after[name]; ok && updated != old {
+			items = append(items, ResolvedItem{
+				FilePath: f.path,
+				Title:    fmt.Sprintf("%s %s -> %s", name, old, updated),
+			})
+		}
+	}
+	sort.Slice(items, func(i, j int) bool { return items[i].Title < items[j].Title })
+	return items
+}
+
+// isVendoredPath reports whether p lies in a vendored dependency tree.
+func isVendoredPath(p string) bool {
+	for _, seg := range strings.Split(p, "/") {
+		if seg == "vendor" || seg == "node_modules" {
+			return true
+		}
+	}
+	return false
+}
+
+// historyKindDeltas compares the last recorded scan at or before since with
+// the most recent scan and returns the signal kinds whose count fell. When
+// no scan predates since, the oldest recorded scan is used.
+func historyKindDeltas(h *state.ScanHistory, since time.Time) []KindDelta {
+	if h == nil || len(h.Entries) < 2 {
+		return nil
+	}
+	entries := make([]state.HistoryEntry, len(h.Entries))
+	copy(entries, h.Entries)
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Timestamp.Before(entries[j].Timestamp)
+	})
+
+	before := entries[0]
+	for _, e := range entries {
+		if e.Timestamp.After(since) {
+			break
+		}
+		before = e
+	}
+	after := entries[len(entries)-1]
+	if !after.Timestamp.After(before.Timestamp) {
+		return nil
+	}
+
+	var deltas []KindDelta
+	for _, kind := range state.SortedKeys(before.KindCounts) {
+		if now := after.KindCounts[kind]; now < before.KindCounts[kind] {
+			deltas = append(deltas, KindDelta{Kind: kind, Before: before.KindCounts[kind], After: now})
+		}
+	}
+	return deltas
+}
+
+// RenderResolved writes the resolved-debt summary as text.
+func RenderResolved(r *ResolvedReport, w io.Writer) error {
+	title := fmt.Sprintf("Debt Paid Down since %s", r.Since)
+	_, _ = fmt.Fprintf(w, "%s\n", SectionTitle(title))
+	_, _ = fmt.Fprintf(w, "%s\n", strings.Repeat("-", len(title)))
+	_, _ = fmt.Fprintf(w, "  %s..%s", shortCommit(r.BaseCommit), shortCommit(r.HeadCommit))
+	if !r.BaseTime.IsZero() {
+		_, _ = fmt.Fprintf(w, " (from %s)", r.BaseTime.Format("2006-01-02"))
+	}
+	_, _ = fmt.Fprintf(w, "\n\n")
+
+	if r.Total() == 0 && len(r.KindDeltas) == 0 {
+		_, _ = fmt.Fprintf(w, "  No resolved debt found in this period.\n")
+		return nil
+	}
+
+	_, _ = fmt.Fprintf(w, "  %s TODOs removed\n", colorGreen.Sprint(len(r.TodosRemoved)))
+	_, _ = fmt.Fprintf(w, "  %s test files added\n", colorGreen.Sprint(len(r.TestsAdded)))
+	_, _ = fmt.Fprintf(w, "  %s dependencies updated\n", colorGreen.Sprint(len(r.DepsUpdated)))
+
+	renderResolvedItems(w, "TODOs removed", r.TodosRemoved)
+	renderResolvedItems(w, "Tests added", r.TestsAdded)
+	renderResolvedItems(w, "Dependencies updated", r.DepsUpdated)
+
+	if len(r.KindDeltas) > 0 {
+		_, _ = fmt.Fprintf(w, "\n  Signals down since then (scan history):\n\n")
+		tbl := NewTable(
+			Column{Header: "Kind"},
+			Column{Header: "Before", Align: AlignRight},
+			Column{Header: "Now", Align: AlignRight},
+			Column{Header: "Delta", Align: AlignRight},
+		)
+		for _, d := range r.KindDeltas {
+			tbl.AddRow(d.Kind, itoa(d.Before), itoa(d.After), formatDelta(d.After-d.Before))
+		}
+		if err := tbl.Render(w); err != nil {
+			return err
+		}
+	}
+	_, _ = fmt.Fprintf(w, "\n")
+	return nil
+}
+
+// renderResolvedItems writes one titled list of resolved items.
+func renderResolvedItems(w io.Writer, title string, items []ResolvedItem) {
+	if len(items) == 0 {
+		return
+	}
+	_, _ = fmt.Fprintf(w, "\n  %s:\n", title)
+	for _, it := range items {
+		_, _ = fmt.Fprintf(w, "    %s  %s\n", it.FilePath, it.Title)
+	}
+}
+
+// RenderResolvedJSON writes the resolved-debt summary as indented JSON.
+func RenderResolvedJSON(r *ResolvedReport, w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(r)
+}
+
+// shortCommit abbreviates a commit hash for display.
+func shortCommit(hash string) string {
+	if len(hash) > 7 {
+		return hash[:7]
+	}
+	return hash
+}
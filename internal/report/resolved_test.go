@@ -0,0 +1,215 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package report
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davetashner/stringer/internal/state"
+)
+
+const resolvedDiff = `diff --git a/internal/api/handler.go b/internal/api/handler.go
+index 1111111..2222222 100644
+--- a/internal/api/handler.go
++++ b/internal/api/handler.go
+@@ -3 +2,0 @@ package api
+-// TODO: validate input
+@@ -10 +9 @@ func Handle() {
+-	// FIXME(alice): retry on timeout
++	// FIXME(alice): retry on timeout
+diff --git a/internal/api/handler_test.go b/internal/api/handler_test.go
+new file mode 100644
+index 0000000..3333333
+--- /dev/null
++++ b/internal/api/handler_test.go
+@@ -0,0 +1,3 @@
++package api
++
++// TODO: cover error paths
+diff --git a/legacy.py b/legacy.py
+deleted file mode 100644
+index 4444444..0000000
+--- a/legacy.py
++++ /dev/null
+@@ -1,2 +0,0 @@
+-# HACK: works around the old parser
+-print("hi")
+diff --git a/go.mod b/go.mod
+index 5555555..6666666 100644
+--- a/go.mod
++++ b/go.mod
+@@ -5,3 +5,3 @@ require (
+-	github.com/spf13/cobra v1.8.0
+-	golang.org/x/sys v0.20.0 // indirect
+-	gopkg.in/yaml.v3 v3.0.1
++	github.com/spf13/cobra v1.9.1
++	golang.org/x/sys v0.30.0 // indirect
++	gopkg.in/yaml.v3 v3.0.1
+diff --git a/web/package.json b/web/package.json
+index 7777777..8888888 100644
+--- a/web/package.json
++++ b/web/package.json
+@@ -2 +2 @@
+-  "version": "1.0.0",
++  "version": "1.1.0",
+@@ -8 +8 @@
+-    "react": "^18.2.0"
++    "react": "^19.0.0"
+diff --git a/vendor/lib/lib.go b/vendor/lib/lib.go
+index 9999999..aaaaaaa 100644
+--- a/vendor/lib/lib.go
++++ b/vendor/lib/lib.go
+@@ -1 +0,0 @@
+-// TODO: vendored
+`
+
+func TestParseResolvedDiff(t *testing.T) {
+	r := parseResolvedDiff(resolvedDiff)
+
+	assert.Equal(t, []ResolvedItem{
+		{FilePath: "internal/api/handler.go", Title: "TODO: validate input"},
+		{FilePath: "legacy.py", Title: "HACK: works around the old parser"},
+	}, r.TodosRemoved, "re-added and vendored TODOs are not resolved")
+	assert.Equal(t, []ResolvedItem{
+		{FilePath: "internal/api/handler_test.go", Title: "Added handler_test.go"},
+	}, r.TestsAdded)
+	assert.Equal(t, []ResolvedItem{
+		{FilePath: "go.mod", Title: "github.com/spf13/cobra v1.8.0 -> v1.9.1"},
+		{FilePath: "web/package.json", Title: "react ^18.2.0 -> ^19.0.0"},
+	}, r.DepsUpdated, "indirect requirements and the package version are ignored")
+	assert.Equal(t, 5, r.Total())
+}
+
+func TestParseResolvedDiff_Empty(t *testing.T) {
+	r := parseResolvedDiff("")
+	assert.Equal(t, 0, r.Total())
+	assert.NotNil(t, r.TodosRemoved, "empty lists encode as [] rather than null")
+}
+
+func TestHistoryKindDeltas(t *testing.T) {
+	base := time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)
+	h := &state.ScanHistory{Entries: []state.HistoryEntry{
+		{Timestamp: base.Add(-48 * time.Hour), KindCounts: map[string]int{"todo": 50}},
+		{Timestamp: base.Add(-24 * time.Hour), KindCounts: map[string]int{"todo": 40, "churn": 3, "fixme": 2}},
+		{Timestamp: base.Add(24 * time.Hour), KindCounts: map[string]int{"todo": 35}},
+		{Timestamp: base.Add(72 * time.Hour), KindCounts: map[string]int{"todo": 30, "churn": 5}},
+	}}
+
+	assert.Equal(t, []KindDelta{
+		{Kind: "fixme", Before: 2, After: 0},
+		{Kind: "todo", Before: 40, After: 30},
+	}, historyKindDeltas(h, base))
+
+	deltas := historyKindDeltas(h, base.Add(-30*24*time.Hour))
+	assert.Equal(t, []KindDelta{{Kind: "todo", Before: 50, After: 30}}, deltas,
+		"falls back to the oldest scan when none predates since")
+
+	assert.Nil(t, historyKindDeltas(h, base.Add(100*time.Hour)), "no scan after since")
+	assert.Nil(t, historyKindDeltas(nil, base))
+}
+
+// gitResolved runs git in dir with a fixed identity and date.
+func gitResolved(t *testing.T, dir, date string, args ...string) {
+	t.Helper()
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(),
+		"GIT_AUTHOR_NAME=Test", "GIT_AUTHOR_EMAIL=test@example.com",
+		"GIT_COMMITTER_NAME=Test", "GIT_COMMITTER_EMAIL=test@example.com",
+		"GIT_AUTHOR_DATE="+date, "GIT_COMMITTER_DATE="+date)
+	out, err := cmd.CombinedOutput()
+	require.NoError(t, err, "git %v: %s", args, out)
+}
+
+func writeResolvedFile(t *testing.T, dir, rel, content string) {
+	t.Helper()
+	path := filepath.Join(dir, rel)
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o750))
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+}
+
+func TestBuildResolved(t *testing.T) {
+	dir := t.TempDir()
+	gitResolved(t, dir, "2026-08-01T12:00:00Z", "init", "-q")
+	writeResolvedFile(t, dir, "main.go", "package main\n\n// TODO: handle flags\nfunc main() {}\n")
+	gitResolved(t, dir, "2026-08-01T12:00:00Z", "add", "-A")
+	gitResolved(t, dir, "2026-08-01T12:00:00Z", "commit", "-q", "-m", "initial")
+	gitResolved(t, dir, "2026-08-01T12:00:00Z", "tag", "v1.0.0")
+
+	writeResolvedFile(t, dir, "main.go", "package main\n\nfunc main() {}\n")
+	writeResolvedFile(t, dir, "main_test.go", "package main\n")
+	gitResolved(t, dir, "2026-09-15T12:00:00Z", "add", "-A")
+	gitResolved(t, dir, "2026-09-15T12:00:00Z", "commit", "-q", "-m", "pay down debt")
+
+	now := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	for _, since := range []string{"v1.0.0", "2026-09-01", "30d"} {
+		t.Run(since, func(t *testing.T) {
+			r, err := BuildResolved(context.Background(), dir, since, now)
+			require.NoError(t, err)
+			assert.Equal(t, since, r.Since)
+			assert.Equal(t, []ResolvedItem{{FilePath: "main.go", Title: "TODO: handle flags"}}, r.TodosRemoved)
+			assert.Equal(t, []ResolvedItem{{FilePath: "main_test.go", Title: "Added main_test.go"}}, r.TestsAdded)
+			assert.Equal(t, "2026-08-01", r.BaseTime.UTC().Format("2006-01-02"))
+		})
+	}
+
+	_, err := BuildResolved(context.Background(), dir, "2026-01-01", now)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no commits before 2026-01-01")
+
+	_, err = BuildResolved(context.Background(), dir, "no-such-ref", now)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `--since "no-such-ref" is not a date, duration, or git ref`)
+}
+
+func TestRenderResolved(t *testing.T) {
+	r := parseResolvedDiff(resolvedDiff)
+	r.Since = "v1.0.0"
+	r.BaseCommit = "0123456789abcdef"
+	r.HeadCommit = "fedcba9876543210"
+	r.BaseTime = time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)
+	r.KindDeltas = []KindDelta{{Kind: "todo", Before: 40, After: 30}}
+
+	var buf bytes.Buffer
+	require.NoError(t, RenderResolved(r, &buf))
+	out := buf.String()
+	assert.Contains(t, out, "Debt Paid Down since v1.0.0")
+	assert.Contains(t, out, "0123456..fedcba9 (from 2026-09-01)")
+	assert.Contains(t, out, "2 TODOs removed")
+	assert.Contains(t, out, "1 test files added")
+	assert.Contains(t, out, "2 dependencies updated")
+	assert.Contains(t, out, "legacy.py  HACK: works around the old parser")
+	assert.Contains(t, out, "github.com/spf13/cobra v1.8.0 -> v1.9.1")
+	assert.Contains(t, out, "Signals down since then")
+	assert.Contains(t, out, "-10")
+
+	buf.Reset()
+	require.NoError(t, RenderResolved(&ResolvedReport{Since: "2w"}, &buf))
+	assert.Contains(t, buf.String(), "No resolved debt found in this period.")
+}
+
+func TestRenderResolvedJSON(t *testing.T) {
+	r := parseResolvedDiff(resolvedDiff)
+	r.Since = "v1.0.0"
+
+	var buf bytes.Buffer
+	require.NoError(t, RenderResolvedJSON(r, &buf))
+
+	var decoded ResolvedReport
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &decoded))
+	assert.Equal(t, "v1.0.0", decoded.Since)
+	assert.Len(t, decoded.TodosRemoved, 2)
+	assert.Len(t, decoded.DepsUpdated, 2)
+	assert.NotContains(t, buf.String(), "kind_deltas")
+}
@@ -0,0 +1,210 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+// Package routing builds review routing tables from the lotteryrisk
+// collector's ownership and review-participation metrics. A table maps each
+// directory to ranked reviewer candidates so auto-assignment bots can route
+// pull requests in a way that spreads knowledge over time.
+package routing
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/davetashner/stringer/internal/collectors"
+)
+
+// Supported strategies.
+const (
+	// StrategySpread excludes each directory's top owner so reviews go to
+	// secondary owners and active reviewers, lowering lottery risk.
+	StrategySpread = "spread"
+
+	// StrategyExpertise ranks everyone, top owner included, for the
+	// fastest, most informed reviews.
+	StrategyExpertise = "expertise"
+)
+
+// Strategies lists the supported strategies.
+var Strategies = []string{StrategySpread, StrategyExpertise}
+
+// DefaultMaxCandidates is the number of candidates listed per path.
+const DefaultMaxCandidates = 3
+
+// Score weights. Ownership reflects knowledge of the code; review share
+// reflects recent familiarity with changes to it.
+const (
+	ownershipWeight = 0.6
+	reviewWeight    = 0.4
+)
+
+// Config controls how a routing table is built.
+type Config struct {
+	Strategy      string // StrategySpread (default) or StrategyExpertise
+	MaxCandidates int    // candidates per path; <= 0 means DefaultMaxCandidates
+}
+
+// Table is a review routing table.
+type Table struct {
+	GeneratedAt time.Time `json:"generated_at"`
+	Strategy    string    `json:"strategy"`
+	Routes      []Route   `json:"routes"`
+}
+
+// Route lists reviewer candidates for one directory. Routes are ordered
+// deepest path first, so the first route whose path is a file's directory
+// or one of its ancestors is the most specific match. The repository root
+// is ".".
+type Route struct {
+	Path        string      `json:"path"`
+	LotteryRisk int         `json:"lottery_risk"`
+	TopOwner    string      `json:"top_owner,omitempty"`
+	Candidates  []Candidate `json:"candidates"`
+}
+
+// Candidate is a ranked reviewer for a route.
+type Candidate struct {
+	Name      string  `json:"name"`
+	Score     float64 `json:"score"`
+	Ownership float64 `json:"ownership,omitempty"` // share of the directory's code
+	Reviews   int     `json:"reviews,omitempty"`   // reviews on recent merged PRs
+}
+
+// Build computes a routing table from lotteryrisk metrics. Authors are
+// matched to reviewer logins case-insensitively, so mapping git identities
+// to GitHub logins (the identities config) merges both sources. Bot
+// accounts are never candidates. Directories without any candidate are
+// omitted.
+func Build(metrics *collectors.LotteryRiskMetrics, cfg Config, now time.Time) (*Table, error) {
+	strategy := strings.ToLower(cfg.Strategy)
+	if strategy == "" {
+		strategy = StrategySpread
+	}
+	if strategy != StrategySpread && strategy != StrategyExpertise {
+		return nil, fmt.Errorf("unsupported strategy %q (supported: %s)", cfg.Strategy, strings.Join(Strategies, ", "))
+	}
+	maxCandidates := cfg.MaxCandidates
+	if maxCandidates <= 0 {
+		maxCandidates = DefaultMaxCandidates
+	}
+
+	table := &Table{GeneratedAt: now.UTC(), Strategy: strategy, Routes: []Route{}}
+	if metrics == nil {
+		return table, nil
+	}
+	for _, dir := range metrics.Directories {
+		route := buildRoute(dir, strategy, maxCandidates)
+		if len(route.Candidates) > 0 {
+			table.Routes = append(table.Routes, route)
+		}
+	}
+
+	sort.Slice(table.Routes, func(i, j int) bool {
+		di, dj := pathDepth(table.Routes[i].Path), pathDepth(table.Routes[j].Path)
+		if di != dj {
+			return di > dj
+		}
+		return table.Routes[i].Path < table.Routes[j].Path
+	})
+	return table, nil
+}
+
+// buildRoute ranks the candidates for a single directory.
+func buildRoute(dir collectors.DirectoryOwnership, strategy string, maxCandidates int) Route {
+	route := Route{Path: dir.Path, LotteryRisk: dir.LotteryRisk}
+
+	byKey := make(map[string]*Candidate)
+	var order []string
+	candidate := func(name string) *Candidate {
+		key := strings.ToLower(name)
+		c, ok := byKey[key]
+		if !ok {
+			c = &Candidate{Name: name}
+			byKey[key] = c
+			order = append(order, key)
+		}
+		return c
+	}
+
+	for _, a := range dir.Authors {
+		if a.Ownership <= 0 || isBot(a.Name) {
+			continue
+		}
+		if route.TopOwner == "" {
+			route.TopOwner = a.Name
+		}
+		candidate(a.Name).Ownership = a.Ownership
+	}
+
+	totalReviews := 0
+	for _, r := range dir.Reviewers {
+		if !isBot(r.Login) {
+			totalReviews += r.Reviews
+		}
+	}
+	for _, r := range dir.Reviewers {
+		if r.Reviews <= 0 || isBot(r.Login) {
+			continue
+		}
+		c := candidate(r.Login)
+		c.Reviews = r.Reviews
+		if c.Ownership == 0 {
+			// Prefer the GitHub login when a reviewer owns no code here.
+			c.Name = r.Login
+		}
+	}
+
+	for _, key := range order {
+		c := byKey[key]
+		if strategy == StrategySpread && strings.EqualFold(c.Name, route.TopOwner) {
+			continue
+		}
+		score := ownershipWeight * c.Ownership
+		if totalReviews > 0 {
+			score += reviewWeight * float64(c.Reviews) / float64(totalReviews)
+		}
+		c.Score = round(score)
+		c.Ownership = round(c.Ownership)
+		route.Candidates = append(route.Candidates, *c)
+	}
+
+	sort.SliceStable(route.Candidates, func(i, j int) bool {
+		if route.Candidates[i].Score != route.Candidates[j].Score {
+			return route.Candidates[i].Score > route.Candidates[j].Score
+		}
+		return route.Candidates[i].Name < route.Candidates[j].Name
+	})
+	if len(route.Candidates) > maxCandidates {
+		route.Candidates = route.Candidates[:maxCandidates]
+	}
+	return route
+}
+
+// Write encodes the table as indented JSON.
+func Write(w io.Writer, table *Table) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(table)
+}
+
+// isBot reports whether name is an automation account such as
+// "dependabot[bot]".
+func isBot(name string) bool {
+	return strings.HasSuffix(strings.ToLower(name), "[bot]")
+}
+
+func pathDepth(path string) int {
+	if path == "." || path == "" {
+		return 0
+	}
+	return strings.Count(path, "/") + 1
+}
+
+func round(v float64) float64 {
+	return math.Round(v*1000) / 1000
+}
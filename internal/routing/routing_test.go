@@ -0,0 +1,125 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package routing
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davetashner/stringer/internal/collectors"
+)
+
+var testNow = time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+func testMetrics() *collectors.LotteryRiskMetrics {
+	return &collectors.LotteryRiskMetrics{Directories: []collectors.DirectoryOwnership{
+		{
+			Path:        ".",
+			LotteryRisk: 2,
+			Authors:     []collectors.AuthorShare{{Name: "alice", Ownership: 0.5}, {Name: "bob", Ownership: 0.5}},
+		},
+		{
+			Path:        "internal/core",
+			LotteryRisk: 1,
+			Authors: []collectors.AuthorShare{
+				{Name: "alice", Ownership: 0.8},
+				{Name: "Bob", Ownership: 0.15},
+				{Name: "carol", Ownership: 0.05},
+			},
+			Reviewers: []collectors.ReviewerShare{
+				{Login: "alice", Reviews: 6},
+				{Login: "dave", Reviews: 3},
+				{Login: "bob", Reviews: 1},
+				{Login: "renovate[bot]", Reviews: 10},
+			},
+		},
+		{
+			Path:        "solo",
+			LotteryRisk: 1,
+			Authors:     []collectors.AuthorShare{{Name: "alice", Ownership: 1}},
+		},
+	}}
+}
+
+func TestBuild_Spread(t *testing.T) {
+	table, err := Build(testMetrics(), Config{}, testNow)
+	require.NoError(t, err)
+	assert.Equal(t, StrategySpread, table.Strategy)
+	assert.Equal(t, testNow, table.GeneratedAt)
+
+	// "solo" has only its top owner, so it has no route.
+	require.Len(t, table.Routes, 2)
+	core := table.Routes[0]
+	assert.Equal(t, "internal/core", core.Path, "deepest path first")
+	assert.Equal(t, 1, core.LotteryRisk)
+	assert.Equal(t, "alice", core.TopOwner)
+	// Bob's author name and login merge; the renovate bot is ignored.
+	assert.Equal(t, []Candidate{
+		{Name: "Bob", Score: 0.13, Ownership: 0.15, Reviews: 1},
+		{Name: "dave", Score: 0.12, Reviews: 3},
+		{Name: "carol", Score: 0.03, Ownership: 0.05},
+	}, core.Candidates)
+
+	root := table.Routes[1]
+	assert.Equal(t, ".", root.Path)
+	require.Len(t, root.Candidates, 1)
+	assert.Equal(t, "bob", root.Candidates[0].Name)
+}
+
+func TestBuild_Expertise(t *testing.T) {
+	table, err := Build(testMetrics(), Config{Strategy: "Expertise", MaxCandidates: 2}, testNow)
+	require.NoError(t, err)
+	assert.Equal(t, StrategyExpertise, table.Strategy)
+
+	require.Len(t, table.Routes, 3)
+	core := table.Routes[0]
+	require.Len(t, core.Candidates, 2, "capped at MaxCandidates")
+	assert.Equal(t, "alice", core.Candidates[0].Name)
+	assert.InDelta(t, 0.72, core.Candidates[0].Score, 0.001)
+	assert.Equal(t, "Bob", core.Candidates[1].Name)
+
+	assert.Equal(t, "solo", table.Routes[1].Path)
+	assert.Equal(t, ".", table.Routes[2].Path)
+}
+
+func TestBuild_NoBots(t *testing.T) {
+	table, err := Build(testMetrics(), Config{Strategy: StrategyExpertise, MaxCandidates: 10}, testNow)
+	require.NoError(t, err)
+	for _, route := range table.Routes {
+		for _, c := range route.Candidates {
+			assert.NotContains(t, c.Name, "[bot]")
+		}
+	}
+}
+
+func TestBuild_Errors(t *testing.T) {
+	_, err := Build(testMetrics(), Config{Strategy: "random"}, testNow)
+	assert.ErrorContains(t, err, `unsupported strategy "random"`)
+
+	table, err := Build(nil, Config{}, testNow)
+	require.NoError(t, err)
+	assert.Empty(t, table.Routes)
+}
+
+func TestWrite(t *testing.T) {
+	table, err := Build(testMetrics(), Config{}, testNow)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, Write(&buf, table))
+
+	var doc map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &doc))
+	assert.Equal(t, "spread", doc["strategy"])
+	assert.Equal(t, "2026-03-01T12:00:00Z", doc["generated_at"])
+	routes := doc["routes"].([]any)
+	first := routes[0].(map[string]any)
+	assert.Equal(t, "internal/core", first["path"])
+	assert.Equal(t, "alice", first["top_owner"])
+}
@@ -0,0 +1,271 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+// Package rules post-processes signals with policies defined in
+// .stringer.yaml: a rule matches signals by kind, path, author, tag,
+// collector, or confidence and then adds tags, sets or raises priority,
+// routes them to a separate output file, or drops them. It centralizes
+// policies that would otherwise need scripting downstream of a scan.
+package rules
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+// Match selects signals. Every non-empty field must match: a signal
+// matches a list when it matches any entry. The zero Match selects every
+// signal.
+type Match struct {
+	Kinds      []string // path.Match globs, e.g. "*-dependency"
+	Paths      []string // repository path globs; "**" spans directories
+	Authors    []string // case-insensitive path.Match globs
+	Tags       []string
+	Collectors []string
+
+	// FunctionOwners matches the dominant author of the function enclosing
+	// a TODO, like Authors. Signals without a function owner never match.
+	FunctionOwners []string
+
+	MinConfidence float64 // inclusive
+	MaxConfidence float64 // inclusive; 0 means no upper bound
+}
+
+// Rule applies its actions to the signals Match selects. SetPriority and
+// MinPriority use bead priorities (1 most urgent to 4); zero leaves the
+// priority alone.
+type Rule struct {
+	Match       Match
+	AddTags     []string
+	SetPriority int    // priority to assign
+	MinPriority int    // raise the priority to at least this urgency
+	Output      string // write matching signals to this file instead
+	Drop        bool
+}
+
+// Set is an ordered list of rules. Every matching rule applies, in order,
+// and later rules see the tags and priority earlier ones set. The zero Set
+// changes nothing.
+type Set []Rule
+
+// compiled holds the path patterns of each rule as regular expressions.
+type compiled struct {
+	rules []Rule
+	paths [][]*regexp.Regexp
+}
+
+// Compile checks the rules' patterns and returns a Set ready to apply.
+func Compile(rules []Rule) (Set, error) {
+	for i, r := range rules {
+		for _, k := range r.Match.Kinds {
+			if _, err := path.Match(k, ""); err != nil {
+				return nil, fmt.Errorf("rules[%d]: invalid kind pattern %q", i, k)
+			}
+		}
+		for _, a := range slices.Concat(r.Match.Authors, r.Match.FunctionOwners) {
+			if _, err := path.Match(a, ""); err != nil {
+				return nil, fmt.Errorf("rules[%d]: invalid author pattern %q", i, a)
+			}
+		}
+		for _, p := range r.Match.Paths {
+			if _, err := compilePath(p); err != nil {
+				return nil, fmt.Errorf("rules[%d]: %w", i, err)
+			}
+		}
+	}
+	return Set(rules), nil
+}
+
+// Apply adds tags, sets priorities, and drops signals as the rules say. It
+// returns the signals that remain and how many were dropped. Routing to
+// other outputs is left to Route, so routed signals still pass through the
+// scan's filters first.
+func (s Set) Apply(signals []signal.RawSignal) ([]signal.RawSignal, int) {
+	if len(s) == 0 {
+		return signals, 0
+	}
+	c := s.compile()
+	kept := signals[:0]
+	dropped := 0
+	for _, sig := range signals {
+		drop := false
+		for i, r := range c.rules {
+			if !c.matches(i, sig) {
+				continue
+			}
+			if r.Drop {
+				drop = true
+				break
+			}
+			for _, t := range r.AddTags {
+				if !slices.Contains(sig.Tags, t) {
+					sig.Tags = append(slices.Clip(sig.Tags), t)
+				}
+			}
+			switch {
+			case r.SetPriority > 0:
+				p := r.SetPriority
+				sig.Priority = &p
+			case r.MinPriority > 0 && signal.EffectivePriority(sig) > r.MinPriority:
+				p := r.MinPriority
+				sig.Priority = &p
+			}
+		}
+		if drop {
+			dropped++
+			continue
+		}
+		kept = append(kept, sig)
+	}
+	return kept, dropped
+}
+
+// Route splits off the signals a rule with an Output selects. The first
+// such rule a signal matches picks its file. It returns the signals that
+// stay in the main output and the routed signals by file, in input order.
+func (s Set) Route(signals []signal.RawSignal) ([]signal.RawSignal, map[string][]signal.RawSignal) {
+	var main []signal.RawSignal
+	var routed map[string][]signal.RawSignal
+	c := s.compile()
+	for _, sig := range signals {
+		out := ""
+		for i, r := range c.rules {
+			if r.Output != "" && c.matches(i, sig) {
+				out = r.Output
+				break
+			}
+		}
+		if out == "" {
+			main = append(main, sig)
+			continue
+		}
+		if routed == nil {
+			routed = make(map[string][]signal.RawSignal)
+		}
+		routed[out] = append(routed[out], sig)
+	}
+	return main, routed
+}
+
+// Outputs returns the files the rules route signals to, in rule order.
+func (s Set) Outputs() []string {
+	var outs []string
+	for _, r := range s {
+		if r.Output != "" && !slices.Contains(outs, r.Output) {
+			outs = append(outs, r.Output)
+		}
+	}
+	return outs
+}
+
+// compile turns the path patterns into regular expressions. Compile has
+// already rejected invalid ones.
+func (s Set) compile() compiled {
+	c := compiled{rules: s, paths: make([][]*regexp.Regexp, len(s))}
+	for i, r := range s {
+		for _, p := range r.Match.Paths {
+			if re, err := compilePath(p); err == nil {
+				c.paths[i] = append(c.paths[i], re)
+			}
+		}
+	}
+	return c
+}
+
+// matches reports whether rule i selects sig.
+func (c compiled) matches(i int, sig signal.RawSignal) bool {
+	m := c.rules[i].Match
+	if len(m.Kinds) > 0 && !slices.ContainsFunc(m.Kinds, func(k string) bool {
+		ok, _ := path.Match(k, sig.Kind)
+		return ok
+	}) {
+		return false
+	}
+	if len(m.Paths) > 0 && !matchesPath(c.paths[i], sig.FilePath) {
+		return false
+	}
+	if len(m.Authors) > 0 && !matchesAuthor(m.Authors, sig.Author) {
+		return false
+	}
+	if len(m.FunctionOwners) > 0 && (sig.FunctionOwner == "" || !matchesAuthor(m.FunctionOwners, sig.FunctionOwner)) {
+		return false
+	}
+	if len(m.Tags) > 0 && !slices.ContainsFunc(sig.Tags, func(t string) bool { return slices.Contains(m.Tags, t) }) {
+		return false
+	}
+	if len(m.Collectors) > 0 && !slices.Contains(m.Collectors, sig.Source) {
+		return false
+	}
+	if sig.Confidence < m.MinConfidence {
+		return false
+	}
+	return m.MaxConfidence == 0 || sig.Confidence <= m.MaxConfidence
+}
+
+// matchesAuthor reports whether author matches any of the case-insensitive
+// patterns.
+func matchesAuthor(patterns []string, author string) bool {
+	return slices.ContainsFunc(patterns, func(a string) bool {
+		ok, _ := path.Match(strings.ToLower(a), strings.ToLower(author))
+		return ok
+	})
+}
+
+// matchesPath reports whether p or one of its parent directories matches
+// any of patterns, so "internal/auth" covers the files below it.
+func matchesPath(patterns []*regexp.Regexp, p string) bool {
+	if p == "" {
+		return false
+	}
+	for {
+		for _, re := range patterns {
+			if re.MatchString(p) {
+				return true
+			}
+		}
+		i := strings.LastIndexByte(p, '/')
+		if i < 0 {
+			return false
+		}
+		p = p[:i]
+	}
+}
+
+// compilePath turns a path glob into a regular expression over
+// slash-separated repository paths. "*" and "?" stay within a directory,
+// "**" spans any number of them, and a pattern without a slash matches a
+// file or directory name at any depth.
+func compilePath(pattern string) (*regexp.Regexp, error) {
+	p := strings.Trim(pattern, "/")
+	if p == "" {
+		return nil, fmt.Errorf("invalid path pattern %q", pattern)
+	}
+	var b strings.Builder
+	b.WriteString("^")
+	if !strings.Contains(p, "/") {
+		b.WriteString("(?:.*/)?")
+	}
+	for i := 0; i < len(p); i++ {
+		switch c := p[i]; {
+		case strings.HasPrefix(p[i:], "**/"):
+			b.WriteString("(?:.*/)?")
+			i += 2
+		case strings.HasPrefix(p[i:], "**"):
+			b.WriteString(".*")
+			i++
+		case c == '*':
+			b.WriteString("[^/]*")
+		case c == '?':
+			b.WriteString("[^/]")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	b.WriteString("$")
+	return regexp.Compile(b.String())
+}
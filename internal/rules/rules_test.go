@@ -0,0 +1,183 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package rules
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+func testSignals() []signal.RawSignal {
+	return []signal.RawSignal{
+		{Source: "todos", Kind: "todo", FilePath: "internal/auth/token.go", Author: "Alice", Confidence: 0.5},
+		{Source: "todos", Kind: "fixme", FilePath: "cmd/main.go", Author: "bob", Confidence: 0.7, Tags: []string{"legacy"}},
+		{Source: "dephealth", Kind: "stale-dependency", FilePath: "go.mod", Confidence: 0.4},
+		{Source: "gitlog", Kind: "churn", FilePath: "internal/auth/session/store.go", Confidence: 0.9},
+	}
+}
+
+func intPtr(v int) *int { return &v }
+
+func TestApply_AddTagsAndPriority(t *testing.T) {
+	set, err := Compile([]Rule{
+		{Match: Match{Paths: []string{"internal/auth/**"}}, AddTags: []string{"security"}, MinPriority: 1},
+		{Match: Match{Tags: []string{"security"}, Kinds: []string{"todo"}}, AddTags: []string{"auth-todo"}},
+	})
+	require.NoError(t, err)
+
+	got, dropped := set.Apply(testSignals())
+	require.Len(t, got, 4)
+	assert.Zero(t, dropped)
+
+	assert.Equal(t, []string{"security", "auth-todo"}, got[0].Tags, "later rules see tags added earlier")
+	assert.Equal(t, intPtr(1), got[0].Priority)
+	assert.Equal(t, []string{"security"}, got[3].Tags)
+	assert.Nil(t, got[3].Priority, "confidence 0.9 already maps to P1")
+	assert.Equal(t, []string{"legacy"}, got[1].Tags)
+	assert.Nil(t, got[1].Priority)
+}
+
+func TestApply_MinPriorityOnlyRaises(t *testing.T) {
+	set, err := Compile([]Rule{{Match: Match{Collectors: []string{"todos"}}, MinPriority: 2}})
+	require.NoError(t, err)
+
+	sigs := testSignals()
+	sigs[1].Priority = intPtr(1)
+	got, _ := set.Apply(sigs)
+	assert.Equal(t, intPtr(2), got[0].Priority, "P3 from confidence 0.5 is raised to P2")
+	assert.Equal(t, intPtr(1), got[1].Priority, "P1 is already more urgent")
+}
+
+func TestApply_SetPriority(t *testing.T) {
+	set, err := Compile([]Rule{{Match: Match{Kinds: []string{"*-dependency"}}, SetPriority: 4}})
+	require.NoError(t, err)
+
+	got, _ := set.Apply(testSignals())
+	assert.Equal(t, intPtr(4), got[2].Priority)
+}
+
+func TestApply_Drop(t *testing.T) {
+	set, err := Compile([]Rule{
+		{Match: Match{Authors: []string{"ALICE"}}, Drop: true},
+		{Match: Match{MaxConfidence: 0.45}, Drop: true},
+	})
+	require.NoError(t, err)
+
+	got, dropped := set.Apply(testSignals())
+	assert.Equal(t, 2, dropped)
+	require.Len(t, got, 2)
+	assert.Equal(t, "fixme", got[0].Kind)
+	assert.Equal(t, "churn", got[1].Kind)
+}
+
+func TestApply_FunctionOwners(t *testing.T) {
+	sigs := testSignals()
+	sigs[0].FunctionOwner = "Carol Smith"
+	set, err := Compile([]Rule{{Match: Match{FunctionOwners: []string{"carol*"}}, AddTags: []string{"team-carol"}}})
+	require.NoError(t, err)
+
+	got, _ := set.Apply(sigs)
+	assert.Equal(t, []string{"team-carol"}, got[0].Tags)
+	assert.Equal(t, []string{"legacy"}, got[1].Tags, "signals without a function owner do not match")
+}
+
+func TestApply_EmptySet(t *testing.T) {
+	sigs := testSignals()
+	got, dropped := Set(nil).Apply(sigs)
+	assert.Equal(t, sigs, got)
+	assert.Zero(t, dropped)
+}
+
+func TestApply_DoesNotAliasTags(t *testing.T) {
+	shared := make([]string, 1, 4)
+	shared[0] = "a"
+	sigs := []signal.RawSignal{
+		{Kind: "todo", Tags: shared},
+		{Kind: "fixme", Tags: shared},
+	}
+	set, err := Compile([]Rule{{Match: Match{Kinds: []string{"todo"}}, AddTags: []string{"b"}}})
+	require.NoError(t, err)
+
+	got, _ := set.Apply(sigs)
+	assert.Equal(t, []string{"a", "b"}, got[0].Tags)
+	assert.Equal(t, []string{"a"}, got[1].Tags)
+}
+
+func TestMatch_AllFieldsMustMatch(t *testing.T) {
+	set, err := Compile([]Rule{{
+		Match:   Match{Kinds: []string{"todo", "fixme"}, MinConfidence: 0.6},
+		AddTags: []string{"hit"},
+	}})
+	require.NoError(t, err)
+
+	got, _ := set.Apply(testSignals())
+	assert.NotContains(t, got[0].Tags, "hit", "kind matches but confidence is too low")
+	assert.Contains(t, got[1].Tags, "hit")
+}
+
+func TestRoute(t *testing.T) {
+	set, err := Compile([]Rule{
+		{Match: Match{Paths: []string{"internal/auth"}}, Output: "security.jsonl"},
+		{Match: Match{Collectors: []string{"gitlog", "dephealth"}}, Output: "health.jsonl"},
+		{Match: Match{Kinds: []string{"todo"}}, AddTags: []string{"x"}},
+	})
+	require.NoError(t, err)
+
+	main, routed := set.Route(testSignals())
+	require.Len(t, main, 1)
+	assert.Equal(t, "fixme", main[0].Kind)
+	require.Len(t, routed["security.jsonl"], 2, "first output rule wins")
+	assert.Equal(t, "todo", routed["security.jsonl"][0].Kind)
+	assert.Equal(t, "churn", routed["security.jsonl"][1].Kind)
+	require.Len(t, routed["health.jsonl"], 1)
+	assert.Equal(t, []string{"security.jsonl", "health.jsonl"}, set.Outputs())
+}
+
+func TestRoute_NoOutputs(t *testing.T) {
+	main, routed := Set(nil).Route(testSignals())
+	assert.Len(t, main, 4)
+	assert.Nil(t, routed)
+}
+
+func TestMatchesPath(t *testing.T) {
+	tests := []struct {
+		pattern string
+		path    string
+		want    bool
+	}{
+		{"internal/auth/**", "internal/auth/token.go", true},
+		{"internal/auth/**", "internal/auth/a/b.go", true},
+		{"internal/auth/**", "internal/authz/x.go", false},
+		{"internal/auth", "internal/auth/token.go", true},
+		{"*.go", "cmd/main.go", true},
+		{"*.go", "go.mod", false},
+		{"vendor", "third/vendor/x.go", true},
+		{"**/testdata/**", "a/b/testdata/c.txt", true},
+		{"cmd/*.go", "cmd/sub/main.go", false},
+		{"cmd/*.go", "cmd/main.go", true},
+		{"cmd/ma?n.go", "cmd/main.go", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.pattern+" "+tt.path, func(t *testing.T) {
+			re, err := compilePath(tt.pattern)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, matchesPath([]*regexp.Regexp{re}, tt.path))
+		})
+	}
+	assert.False(t, matchesPath(nil, ""), "signals without a path never match a path rule")
+}
+
+func TestCompile_InvalidPatterns(t *testing.T) {
+	_, err := Compile([]Rule{{Match: Match{Kinds: []string{"["}}, Drop: true}})
+	assert.ErrorContains(t, err, `rules[0]: invalid kind pattern "["`)
+	_, err = Compile([]Rule{{Match: Match{Authors: []string{"["}}, Drop: true}})
+	assert.ErrorContains(t, err, "invalid author pattern")
+	_, err = Compile([]Rule{{Match: Match{Paths: []string{"/"}}, Drop: true}})
+	assert.ErrorContains(t, err, "invalid path pattern")
+}
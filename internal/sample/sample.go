@@ -0,0 +1,225 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+// Package sample draws reproducible random samples of signals for human
+// quality review, so teams can measure stringer's precision before relying
+// on it in CI gates.
+package sample
+
+import (
+	"fmt"
+	"hash/fnv"
+	"io"
+	"math"
+	"math/rand/v2"
+	"sort"
+	"strings"
+
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+// minWeight keeps zero-confidence signals eligible for sampling.
+const minWeight = 0.05
+
+// Stratify values accepted by Options.Stratify.
+const (
+	StratifyNone      = ""
+	StratifyKind      = "kind"
+	StratifyCollector = "collector"
+)
+
+// Options controls how a sample is drawn.
+type Options struct {
+	// N is the total number of signals to sample.
+	N int
+
+	// Stratify groups signals by "kind" or "collector" and spreads the
+	// sample evenly across groups. Empty samples from all signals at once.
+	Stratify string
+
+	// Seed makes the sample reproducible. The same seed and signals always
+	// produce the same sample.
+	Seed string
+}
+
+// Stratum is one group of the sample.
+type Stratum struct {
+	// Name is the kind or collector name, or "all" when not stratified.
+	Name string
+
+	// Population is the number of signals in the group.
+	Population int
+
+	// Signals are the sampled signals, in draw order.
+	Signals []signal.RawSignal
+}
+
+// Result is a drawn sample.
+type Result struct {
+	Options    Options
+	Population int
+	Strata     []Stratum
+}
+
+// Size returns the number of sampled signals.
+func (r *Result) Size() int {
+	n := 0
+	for _, s := range r.Strata {
+		n += len(s.Signals)
+	}
+	return n
+}
+
+// ValidStratify reports whether s is a supported Options.Stratify value.
+func ValidStratify(s string) bool {
+	return s == StratifyNone || s == StratifyKind || s == StratifyCollector
+}
+
+// Draw samples opts.N signals without replacement. Each signal's chance of
+// being drawn is weighted by its confidence, so the sample reflects the
+// signals a confidence-based gate would act on. With stratification the
+// sample is split evenly across groups, and groups too small for their share
+// pass the remainder on to the others.
+func Draw(signals []signal.RawSignal, opts Options) *Result {
+	rng := rand.New(rand.NewPCG(seedValue(opts.Seed), 0)) //nolint:gosec // reproducibility, not security
+
+	// Sort first so the sample does not depend on collector output order.
+	sorted := make([]signal.RawSignal, len(signals))
+	copy(sorted, signals)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return signalKey(sorted[i]) < signalKey(sorted[j])
+	})
+
+	groups := make(map[string][]signal.RawSignal)
+	for _, s := range sorted {
+		name := stratumName(s, opts.Stratify)
+		groups[name] = append(groups[name], s)
+	}
+	names := make([]string, 0, len(groups))
+	for name := range groups {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	// Weighted shuffle of every group, then deal round-robin so each group
+	// gets an equal share until it runs out.
+	shuffled := make([][]signal.RawSignal, len(names))
+	for i, name := range names {
+		shuffled[i] = weightedShuffle(groups[name], rng)
+	}
+	strata := make([]Stratum, len(names))
+	for i, name := range names {
+		strata[i] = Stratum{Name: name, Population: len(groups[name])}
+	}
+	for taken, round := 0, 0; taken < opts.N; round++ {
+		progressed := false
+		for i := range shuffled {
+			if taken >= opts.N || round >= len(shuffled[i]) {
+				continue
+			}
+			strata[i].Signals = append(strata[i].Signals, shuffled[i][round])
+			taken++
+			progressed = true
+		}
+		if !progressed {
+			break
+		}
+	}
+
+	return &Result{Options: opts, Population: len(sorted), Strata: strata}
+}
+
+// weightedShuffle orders signals by Efraimidis-Spirakis keys u^(1/w), which
+// is equivalent to repeated weighted draws without replacement.
+func weightedShuffle(signals []signal.RawSignal, rng *rand.Rand) []signal.RawSignal {
+	type keyed struct {
+		key float64
+		sig signal.RawSignal
+	}
+	ks := make([]keyed, len(signals))
+	for i, s := range signals {
+		w := math.Max(s.Confidence, minWeight)
+		ks[i] = keyed{key: math.Pow(rng.Float64(), 1/w), sig: s}
+	}
+	sort.SliceStable(ks, func(i, j int) bool { return ks[i].key > ks[j].key })
+
+	out := make([]signal.RawSignal, len(ks))
+	for i, k := range ks {
+		out[i] = k.sig
+	}
+	return out
+}
+
+// seedValue hashes a seed string to the PRNG seed.
+func seedValue(seed string) uint64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(seed))
+	return h.Sum64()
+}
+
+func stratumName(s signal.RawSignal, stratify string) string {
+	switch stratify {
+	case StratifyKind:
+		return s.Kind
+	case StratifyCollector:
+		return s.Source
+	default:
+		return "all"
+	}
+}
+
+func signalKey(s signal.RawSignal) string {
+	return fmt.Sprintf("%s\x00%s\x00%s\x00%09d\x00%s", s.Source, s.Kind, s.FilePath, s.Line, s.Title)
+}
+
+// RenderChecklist writes the sample as a Markdown review checklist. Reviewers
+// tick each signal that is a real, actionable issue; the ticked fraction is
+// stringer's precision on the sample.
+func RenderChecklist(w io.Writer, r *Result, repo, seedLabel string) error {
+	var b strings.Builder
+	b.WriteString("# Stringer audit sample\n\n")
+	fmt.Fprintf(&b, "- Repository: `%s`\n", repo)
+	fmt.Fprintf(&b, "- Seed: %s\n", seedLabel)
+	fmt.Fprintf(&b, "- Sample: %d of %d signals", r.Size(), r.Population)
+	if r.Options.Stratify != StratifyNone {
+		fmt.Fprintf(&b, ", stratified by %s", r.Options.Stratify)
+	}
+	b.WriteString(", weighted by confidence\n\n")
+
+	if r.Size() == 0 {
+		b.WriteString("No signals to sample.\n")
+		_, err := io.WriteString(w, b.String())
+		return err
+	}
+
+	b.WriteString("Check each item that is a real, actionable issue. Leave false positives unchecked.\n")
+
+	for _, st := range r.Strata {
+		if len(st.Signals) == 0 {
+			continue
+		}
+		if r.Options.Stratify != StratifyNone {
+			fmt.Fprintf(&b, "\n## %s (%d of %d)\n", st.Name, len(st.Signals), st.Population)
+		}
+		b.WriteString("\n")
+		for _, s := range st.Signals {
+			fmt.Fprintf(&b, "- [ ] **%s** — %s (%s %s, confidence %.2f)\n",
+				s.Title, location(s), s.Source, s.Kind, s.Confidence)
+		}
+	}
+
+	fmt.Fprintf(&b, "\n---\n\nPrecision = checked items / %d\n", r.Size())
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// location formats a signal's file and line for the checklist.
+func location(s signal.RawSignal) string {
+	if s.FilePath == "" {
+		return "(no file)"
+	}
+	if s.Line > 0 {
+		return fmt.Sprintf("`%s:%d`", s.FilePath, s.Line)
+	}
+	return fmt.Sprintf("`%s`", s.FilePath)
+}
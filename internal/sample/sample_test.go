@@ -0,0 +1,158 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package sample
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+// testSignals returns n signals of each given kind.
+func testSignals(n int, kinds ...string) []signal.RawSignal {
+	var out []signal.RawSignal
+	for _, kind := range kinds {
+		for i := 0; i < n; i++ {
+			out = append(out, signal.RawSignal{
+				Source:     "todos",
+				Kind:       kind,
+				FilePath:   fmt.Sprintf("%s/file%d.go", kind, i),
+				Line:       i + 1,
+				Title:      fmt.Sprintf("%s %d", kind, i),
+				Confidence: 0.5,
+			})
+		}
+	}
+	return out
+}
+
+func titles(r *Result) []string {
+	var out []string
+	for _, st := range r.Strata {
+		for _, s := range st.Signals {
+			out = append(out, s.Title)
+		}
+	}
+	return out
+}
+
+func TestDraw_Reproducible(t *testing.T) {
+	signals := testSignals(20, "todo", "fixme")
+
+	a := Draw(signals, Options{N: 10, Seed: "abc123"})
+	b := Draw(signals, Options{N: 10, Seed: "abc123"})
+	assert.Equal(t, titles(a), titles(b))
+	assert.Len(t, titles(a), 10)
+
+	// Input order does not matter.
+	reversed := make([]signal.RawSignal, len(signals))
+	for i, s := range signals {
+		reversed[len(signals)-1-i] = s
+	}
+	assert.Equal(t, titles(a), titles(Draw(reversed, Options{N: 10, Seed: "abc123"})))
+
+	c := Draw(signals, Options{N: 10, Seed: "def456"})
+	assert.NotEqual(t, titles(a), titles(c), "a different seed draws a different sample")
+}
+
+func TestDraw_CappedAtPopulation(t *testing.T) {
+	r := Draw(testSignals(3, "todo"), Options{N: 10, Seed: "s"})
+	assert.Equal(t, 3, r.Size())
+	assert.Equal(t, 3, r.Population)
+	require.Len(t, r.Strata, 1)
+	assert.Equal(t, "all", r.Strata[0].Name)
+
+	empty := Draw(nil, Options{N: 10, Seed: "s"})
+	assert.Equal(t, 0, empty.Size())
+}
+
+func TestDraw_StratifyKind(t *testing.T) {
+	signals := append(testSignals(20, "todo", "fixme"), testSignals(1, "hack")...)
+
+	r := Draw(signals, Options{N: 9, Stratify: StratifyKind, Seed: "s"})
+	require.Len(t, r.Strata, 3)
+	assert.Equal(t, 9, r.Size())
+
+	sizes := map[string]int{}
+	for _, st := range r.Strata {
+		sizes[st.Name] = len(st.Signals)
+		for _, s := range st.Signals {
+			assert.Equal(t, st.Name, s.Kind)
+		}
+	}
+	assert.Equal(t, map[string]int{"fixme": 4, "hack": 1, "todo": 4}, sizes,
+		"the small group passes its remaining share on")
+}
+
+func TestDraw_StratifyCollector(t *testing.T) {
+	signals := testSignals(5, "todo")
+	signals[0].Source = "gitlog"
+
+	r := Draw(signals, Options{N: 2, Stratify: StratifyCollector, Seed: "s"})
+	require.Len(t, r.Strata, 2)
+	assert.Equal(t, "gitlog", r.Strata[0].Name)
+	assert.Len(t, r.Strata[0].Signals, 1)
+	assert.Len(t, r.Strata[1].Signals, 1)
+}
+
+func TestDraw_WeightedByConfidence(t *testing.T) {
+	signals := testSignals(50, "todo")
+	for i := range signals {
+		if i%2 == 0 {
+			signals[i].Confidence = 0.9
+		} else {
+			signals[i].Confidence = 0
+		}
+	}
+
+	high := 0
+	for seed := 0; seed < 20; seed++ {
+		r := Draw(signals, Options{N: 10, Seed: fmt.Sprint(seed)})
+		for _, s := range r.Strata[0].Signals {
+			if s.Confidence > 0 {
+				high++
+			}
+		}
+	}
+	assert.Greater(t, high, 150, "high-confidence signals dominate the sample")
+	assert.Less(t, high, 200, "zero-confidence signals remain eligible")
+}
+
+func TestValidStratify(t *testing.T) {
+	assert.True(t, ValidStratify(""))
+	assert.True(t, ValidStratify("kind"))
+	assert.True(t, ValidStratify("collector"))
+	assert.False(t, ValidStratify("file"))
+}
+
+func TestRenderChecklist(t *testing.T) {
+	signals := testSignals(2, "todo", "fixme")
+	signals[0].FilePath = ""
+	r := Draw(signals, Options{N: 4, Stratify: StratifyKind, Seed: "s"})
+
+	var buf bytes.Buffer
+	require.NoError(t, RenderChecklist(&buf, r, "/repo", "HEAD abc1234"))
+	out := buf.String()
+	assert.Contains(t, out, "# Stringer audit sample")
+	assert.Contains(t, out, "- Repository: `/repo`")
+	assert.Contains(t, out, "- Seed: HEAD abc1234")
+	assert.Contains(t, out, "- Sample: 4 of 4 signals, stratified by kind, weighted by confidence")
+	assert.Contains(t, out, "## fixme (2 of 2)")
+	assert.Contains(t, out, "## todo (2 of 2)")
+	assert.Contains(t, out, "- [ ] **todo 1** — `todo/file1.go:2` (todos todo, confidence 0.50)")
+	assert.Contains(t, out, "(no file)")
+	assert.Contains(t, out, "Precision = checked items / 4")
+}
+
+func TestRenderChecklist_Empty(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, RenderChecklist(&buf, Draw(nil, Options{N: 10, Seed: "s"}), "/repo", "s"))
+	assert.Contains(t, buf.String(), "No signals to sample.")
+	assert.NotContains(t, buf.String(), "Precision")
+}
@@ -0,0 +1,165 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package sbom
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// CycloneDX 1.5 JSON document types. Only the fields stringer fills are
+// modeled.
+type cdxBOM struct {
+	BOMFormat       string             `json:"bomFormat"`
+	SpecVersion     string             `json:"specVersion"`
+	SerialNumber    string             `json:"serialNumber"`
+	Version         int                `json:"version"`
+	Metadata        cdxMetadata        `json:"metadata"`
+	Components      []cdxComponent     `json:"components"`
+	Dependencies    []cdxDependency    `json:"dependencies"`
+	Vulnerabilities []cdxVulnerability `json:"vulnerabilities,omitempty"`
+}
+
+type cdxMetadata struct {
+	Timestamp string       `json:"timestamp"`
+	Tools     cdxTools     `json:"tools"`
+	Component cdxComponent `json:"component"`
+}
+
+type cdxTools struct {
+	Components []cdxComponent `json:"components"`
+}
+
+type cdxComponent struct {
+	Type       string        `json:"type"`
+	BOMRef     string        `json:"bom-ref,omitempty"`
+	Name       string        `json:"name"`
+	Version    string        `json:"version,omitempty"`
+	PURL       string        `json:"purl,omitempty"`
+	Properties []cdxProperty `json:"properties,omitempty"`
+}
+
+type cdxProperty struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type cdxDependency struct {
+	Ref       string   `json:"ref"`
+	DependsOn []string `json:"dependsOn,omitempty"`
+}
+
+type cdxVulnerability struct {
+	BOMRef         string         `json:"bom-ref"`
+	ID             string         `json:"id"`
+	Source         cdxSource      `json:"source"`
+	References     []cdxReference `json:"references,omitempty"`
+	Ratings        []cdxRating    `json:"ratings,omitempty"`
+	Description    string         `json:"description,omitempty"`
+	Recommendation string         `json:"recommendation,omitempty"`
+	Affects        []cdxAffect    `json:"affects"`
+}
+
+type cdxSource struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+}
+
+type cdxReference struct {
+	ID     string    `json:"id"`
+	Source cdxSource `json:"source"`
+}
+
+type cdxRating struct {
+	Source   cdxSource `json:"source"`
+	Severity string    `json:"severity"`
+}
+
+type cdxAffect struct {
+	Ref string `json:"ref"`
+}
+
+// writeCycloneDX renders the BOM as CycloneDX 1.5 JSON. Health findings are
+// recorded as "stringer:health:<kind>" component properties and
+// vulnerabilities in the vulnerabilities array.
+func writeCycloneDX(w io.Writer, b *BOM) error {
+	rootRef := "root:" + b.Name
+	doc := cdxBOM{
+		BOMFormat:    "CycloneDX",
+		SpecVersion:  "1.5",
+		SerialNumber: "urn:uuid:" + documentID(b),
+		Version:      1,
+		Metadata: cdxMetadata{
+			Timestamp: b.Timestamp.Format(time.RFC3339),
+			Tools: cdxTools{Components: []cdxComponent{
+				{Type: "application", Name: "stringer", Version: b.ToolVersion},
+			}},
+			Component: cdxComponent{Type: "application", BOMRef: rootRef, Name: b.Name},
+		},
+		Components: []cdxComponent{},
+	}
+
+	root := cdxDependency{Ref: rootRef}
+	for i, c := range b.Components {
+		ref := c.PURL
+		if ref == "" {
+			ref = fmt.Sprintf("component-%d", i+1)
+		}
+		comp := cdxComponent{
+			Type:    "library",
+			BOMRef:  ref,
+			Name:    c.Name,
+			Version: c.Version,
+			PURL:    c.PURL,
+		}
+		comp.Properties = append(comp.Properties,
+			cdxProperty{Name: "stringer:ecosystem", Value: c.Ecosystem},
+			cdxProperty{Name: "stringer:manifest", Value: c.Manifest})
+		for _, h := range c.Health {
+			comp.Properties = append(comp.Properties, cdxProperty{Name: "stringer:health:" + h.Kind, Value: h.Detail})
+		}
+		doc.Components = append(doc.Components, comp)
+		doc.Dependencies = append(doc.Dependencies, cdxDependency{Ref: ref})
+		root.DependsOn = append(root.DependsOn, ref)
+
+		for _, v := range c.Vulns {
+			doc.Vulnerabilities = append(doc.Vulnerabilities, cdxVuln(v, ref, c))
+		}
+	}
+	doc.Dependencies = append([]cdxDependency{root}, doc.Dependencies...)
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(doc)
+}
+
+// cdxVuln converts a finding to a CycloneDX vulnerability affecting ref.
+func cdxVuln(v Vuln, ref string, c Component) cdxVulnerability {
+	osv := cdxSource{Name: "OSV", URL: osvURL(v.ID)}
+	out := cdxVulnerability{
+		BOMRef:      v.ID + "@" + ref,
+		ID:          v.ID,
+		Source:      osv,
+		Description: v.Summary,
+		Affects:     []cdxAffect{{Ref: ref}},
+	}
+	if v.CVE != "" {
+		out.References = []cdxReference{{
+			ID:     v.CVE,
+			Source: cdxSource{Name: "NVD", URL: "https://nvd.nist.gov/vuln/detail/" + v.CVE},
+		}}
+	}
+	severity := strings.ToLower(v.Severity)
+	if severity == "" {
+		severity = "unknown"
+	}
+	out.Ratings = []cdxRating{{Source: osv, Severity: severity}}
+	if v.FixedVersion != "" {
+		out.Recommendation = fmt.Sprintf("Upgrade %s from %s to %s.", c.Name, c.Version, v.FixedVersion)
+	}
+	return out
+}
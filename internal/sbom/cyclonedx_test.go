@@ -0,0 +1,62 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package sbom
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteCycloneDX(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, Write(&buf, testBOM(), FormatCycloneDX))
+
+	var doc cdxBOM
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &doc))
+	assert.Equal(t, "CycloneDX", doc.BOMFormat)
+	assert.Equal(t, "1.5", doc.SpecVersion)
+	assert.Regexp(t, `^urn:uuid:`, doc.SerialNumber)
+	assert.Equal(t, "2026-10-01T12:00:00Z", doc.Metadata.Timestamp)
+	assert.Equal(t, "stringer", doc.Metadata.Tools.Components[0].Name)
+	assert.Equal(t, "1.2.3", doc.Metadata.Tools.Components[0].Version)
+	assert.Equal(t, "myapp", doc.Metadata.Component.Name)
+
+	require.Len(t, doc.Components, 3)
+	oldLib := doc.Components[0]
+	assert.Equal(t, "library", oldLib.Type)
+	assert.Equal(t, "pkg:golang/github.com/old/lib@v1.2.0", oldLib.BOMRef)
+	assert.Contains(t, oldLib.Properties, cdxProperty{Name: "stringer:manifest", Value: "go.mod"})
+	assert.Contains(t, oldLib.Properties, cdxProperty{Name: "stringer:health:archived-dependency", Value: "GitHub repository old/lib is archived."})
+
+	require.Len(t, doc.Dependencies, 4)
+	assert.Equal(t, "root:myapp", doc.Dependencies[0].Ref)
+	assert.Len(t, doc.Dependencies[0].DependsOn, 3)
+
+	require.Len(t, doc.Vulnerabilities, 1)
+	v := doc.Vulnerabilities[0]
+	assert.Equal(t, "GO-2021-0113", v.ID)
+	assert.Equal(t, "https://osv.dev/vulnerability/GO-2021-0113", v.Source.URL)
+	assert.Equal(t, "CVE-2021-38561", v.References[0].ID)
+	assert.Equal(t, "high", v.Ratings[0].Severity)
+	assert.Equal(t, "Upgrade golang.org/x/text from v0.3.0 to v0.3.7.", v.Recommendation)
+	assert.Equal(t, []cdxAffect{{Ref: "pkg:golang/golang.org/x/text@v0.3.0"}}, v.Affects)
+}
+
+func TestWriteCycloneDX_Empty(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, Write(&buf, Build("empty", "dev", testTime, nil, nil, nil), FormatCycloneDX))
+	assert.Contains(t, buf.String(), `"components": []`)
+	assert.NotContains(t, buf.String(), "vulnerabilities")
+}
+
+func TestCdxVuln_UnknownSeverity(t *testing.T) {
+	v := cdxVuln(Vuln{ID: "OSV-1"}, "pkg:npm/x@1", Component{Name: "x", Version: "1"})
+	assert.Equal(t, "unknown", v.Ratings[0].Severity)
+	assert.Empty(t, v.References)
+	assert.Empty(t, v.Recommendation)
+}
@@ -0,0 +1,274 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+// Package sbom exports a repository's dependency inventory as a software bill
+// of materials in CycloneDX or SPDX JSON, annotated with the health and
+// vulnerability findings from the dephealth and vuln collectors.
+package sbom
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/davetashner/stringer/internal/collectors"
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+// Supported output formats.
+const (
+	FormatCycloneDX = "cyclonedx"
+	FormatSPDX      = "spdx"
+)
+
+// Formats lists the supported output formats.
+var Formats = []string{FormatCycloneDX, FormatSPDX}
+
+// healthKinds are the dephealth signal kinds that describe a single
+// dependency. Module-level findings (local replaces, retractions) are not
+// attached to components.
+var healthKinds = map[string]bool{
+	"archived-dependency":   true,
+	"deprecated-dependency": true,
+	"stale-dependency":      true,
+	"yanked-dependency":     true,
+}
+
+// BOM is a dependency inventory ready to be written in either format.
+type BOM struct {
+	// Name is the name of the scanned project, used for the root component.
+	Name string
+
+	// ToolVersion is the stringer version recorded as the generating tool.
+	ToolVersion string
+
+	// Timestamp is when the BOM was generated.
+	Timestamp time.Time
+
+	// Components are the dependencies, sorted by ecosystem, name, and version.
+	Components []Component
+}
+
+// Component is one dependency in the BOM.
+type Component struct {
+	Ecosystem string
+	Name      string
+	Version   string
+	Manifest  string
+	PURL      string
+	Health    []Health
+	Vulns     []Vuln
+}
+
+// Health is a dependency health finding, such as a deprecated or archived
+// package.
+type Health struct {
+	Kind   string // dephealth signal kind, e.g. "deprecated-dependency"
+	Detail string
+}
+
+// Vuln is a known vulnerability affecting a component.
+type Vuln struct {
+	ID           string // OSV identifier
+	CVE          string
+	Severity     string // "low", "medium", "high", or empty when unknown
+	FixedVersion string
+	Summary      string
+}
+
+// Build assembles a BOM from the dependency inventory and annotates each
+// component with matching dephealth signals and vuln findings. vulns may be
+// nil when the vuln collector did not run.
+func Build(name, toolVersion string, now time.Time, deps []collectors.Dependency, signals []signal.RawSignal, vulns *collectors.VulnMetrics) *BOM {
+	b := &BOM{Name: name, ToolVersion: toolVersion, Timestamp: now.UTC()}
+
+	for _, d := range deps {
+		c := Component{
+			Ecosystem: d.Ecosystem,
+			Name:      d.Name,
+			Version:   d.Version,
+			Manifest:  d.FilePath,
+			PURL:      PURL(d.Ecosystem, d.Name, d.Version),
+		}
+		for _, s := range signals {
+			if s.Source == "dephealth" && healthKinds[s.Kind] && healthMatches(c, s) {
+				c.Health = append(c.Health, Health{Kind: s.Kind, Detail: s.Description})
+			}
+		}
+		if vulns != nil {
+			for _, v := range vulns.Vulns {
+				if v.Module == d.Name && v.Version == d.Version {
+					c.Vulns = append(c.Vulns, Vuln{
+						ID:           v.OSVID,
+						CVE:          v.CVE,
+						Severity:     v.Severity,
+						FixedVersion: v.FixedVersion,
+						Summary:      v.Summary,
+					})
+				}
+			}
+		}
+		b.Components = append(b.Components, c)
+	}
+
+	sort.SliceStable(b.Components, func(i, j int) bool {
+		a, c := b.Components[i], b.Components[j]
+		if a.Ecosystem != c.Ecosystem {
+			return a.Ecosystem < c.Ecosystem
+		}
+		if a.Name != c.Name {
+			return a.Name < c.Name
+		}
+		return a.Version < c.Version
+	})
+	return b
+}
+
+// Write renders the BOM in the given format.
+func Write(w io.Writer, b *BOM, format string) error {
+	switch format {
+	case FormatCycloneDX:
+		return writeCycloneDX(w, b)
+	case FormatSPDX:
+		return writeSPDX(w, b)
+	default:
+		return fmt.Errorf("unsupported SBOM format %q (supported: %s)", format, strings.Join(Formats, ", "))
+	}
+}
+
+// healthMatches reports whether a dephealth signal is about component c.
+// Signal titles end in the package name ("Deprecated npm package: left-pad"),
+// optionally with a version ("Yanked crate: time@0.1.0"), or in a GitHub
+// owner/repo for archived and stale checks.
+func healthMatches(c Component, s signal.RawSignal) bool {
+	i := strings.LastIndex(s.Title, ": ")
+	if i < 0 {
+		return false
+	}
+	subject := s.Title[i+2:]
+	if at := strings.LastIndex(subject, "@"); at > 0 {
+		if subject[at+1:] != c.Version {
+			return false
+		}
+		subject = subject[:at]
+	}
+	if subject == c.Name {
+		return true
+	}
+	if s.Kind != "archived-dependency" && s.Kind != "stale-dependency" {
+		return false
+	}
+	// GitHub checks report owner/repo for Go modules and SwiftPM URLs.
+	name := strings.TrimPrefix(strings.TrimPrefix(c.Name, "https://"), "http://")
+	gh := "github.com/" + subject
+	return name == gh || strings.HasPrefix(name, gh+"/")
+}
+
+// PURL returns the package URL for a dependency, or "" for ecosystems
+// without a purl type.
+func PURL(ecosystem, name, version string) string {
+	var typ, ns, pkg string
+	switch ecosystem {
+	case "Go":
+		typ = "golang"
+		if i := strings.LastIndex(name, "/"); i >= 0 {
+			ns, pkg = name[:i], name[i+1:]
+		} else {
+			pkg = name
+		}
+	case "npm":
+		typ = "npm"
+		if strings.HasPrefix(name, "@") {
+			if i := strings.Index(name, "/"); i > 0 {
+				ns, pkg = name[:i], name[i+1:]
+				break
+			}
+		}
+		pkg = name
+	case "crates.io":
+		typ, pkg = "cargo", name
+	case "Maven":
+		typ = "maven"
+		if i := strings.Index(name, ":"); i >= 0 {
+			ns, pkg = name[:i], name[i+1:]
+		} else {
+			pkg = name
+		}
+	case "NuGet":
+		typ, pkg = "nuget", name
+	case "PyPI":
+		typ = "pypi"
+		pkg = strings.ToLower(strings.ReplaceAll(name, "_", "-"))
+	case "Packagist":
+		typ = "composer"
+		if i := strings.Index(name, "/"); i >= 0 {
+			ns, pkg = name[:i], name[i+1:]
+		} else {
+			pkg = name
+		}
+	case "SwiftURL":
+		typ = "swift"
+		trimmed := name
+		if u, err := url.Parse(name); err == nil && u.Host != "" {
+			trimmed = u.Host + u.Path
+		}
+		if i := strings.LastIndex(trimmed, "/"); i >= 0 {
+			ns, pkg = trimmed[:i], trimmed[i+1:]
+		} else {
+			pkg = trimmed
+		}
+	case "Hex":
+		typ, pkg = "hex", name
+	default:
+		return ""
+	}
+
+	var b strings.Builder
+	b.WriteString("pkg:" + typ + "/")
+	if ns != "" {
+		segs := strings.Split(ns, "/")
+		for i, seg := range segs {
+			segs[i] = purlEscape(seg)
+		}
+		b.WriteString(strings.Join(segs, "/") + "/")
+	}
+	b.WriteString(purlEscape(pkg))
+	if version != "" {
+		b.WriteString("@" + purlEscape(version))
+	}
+	return b.String()
+}
+
+// purlReplacer encodes the characters url.PathEscape leaves alone but the
+// purl spec reserves.
+var purlReplacer = strings.NewReplacer("@", "%40", "+", "%2B")
+
+// purlEscape percent-encodes a purl segment.
+func purlEscape(s string) string {
+	return purlReplacer.Replace(url.PathEscape(s))
+}
+
+// documentID derives the BOM's serial number from its name, timestamp, and
+// components, so each generated document gets its own ID while tests stay
+// deterministic.
+func documentID(b *BOM) string {
+	h := sha256.New()
+	_, _ = fmt.Fprintf(h, "%s\x00%s\x00", b.Name, b.Timestamp.Format(time.RFC3339))
+	for _, c := range b.Components {
+		_, _ = fmt.Fprintf(h, "%s\x00%s\x00%s\x00", c.Ecosystem, c.Name, c.Version)
+	}
+	sum := h.Sum(nil)
+	// Format as an RFC 4122 version 4 UUID.
+	sum[6] = (sum[6] & 0x0f) | 0x40
+	sum[8] = (sum[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", sum[0:4], sum[4:6], sum[6:8], sum[8:10], sum[10:16])
+}
+
+// osvURL links to an OSV advisory.
+func osvURL(id string) string {
+	return "https://osv.dev/vulnerability/" + id
+}
@@ -0,0 +1,119 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package sbom
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davetashner/stringer/internal/collectors"
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+var testTime = time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+
+// testBOM builds a BOM with one vulnerable, one archived, and one healthy
+// dependency.
+func testBOM() *BOM {
+	deps := []collectors.Dependency{
+		{Ecosystem: "npm", Name: "left-pad", Version: "1.3.0", FilePath: "package.json"},
+		{Ecosystem: "Go", Name: "github.com/old/lib", Version: "v1.2.0", FilePath: "go.mod"},
+		{Ecosystem: "Go", Name: "golang.org/x/text", Version: "v0.3.0", FilePath: "go.mod"},
+	}
+	signals := []signal.RawSignal{
+		{Source: "dephealth", Kind: "archived-dependency", Title: "Archived dependency: old/lib", Description: "GitHub repository old/lib is archived."},
+		{Source: "dephealth", Kind: "deprecated-dependency", Title: "Deprecated npm package: left-pad", Description: "npm package left-pad is deprecated: use String.prototype.padStart"},
+		{Source: "dephealth", Kind: "local-replace", Title: "Local replace: golang.org/x/text => ../text"},
+		{Source: "todos", Kind: "todo", Title: "TODO: left-pad"},
+	}
+	vulns := &collectors.VulnMetrics{Vulns: []collectors.VulnEntry{{
+		OSVID: "GO-2021-0113", CVE: "CVE-2021-38561", Module: "golang.org/x/text", Version: "v0.3.0",
+		FixedVersion: "v0.3.7", Summary: "Out-of-bounds read in golang.org/x/text/language", Severity: "high",
+	}}}
+	return Build("myapp", "1.2.3", testTime, deps, signals, vulns)
+}
+
+func TestBuild(t *testing.T) {
+	b := testBOM()
+	assert.Equal(t, "myapp", b.Name)
+	require.Len(t, b.Components, 3)
+
+	oldLib, text, leftPad := b.Components[0], b.Components[1], b.Components[2]
+	assert.Equal(t, "github.com/old/lib", oldLib.Name, "components are sorted by ecosystem then name")
+	assert.Equal(t, []Health{{Kind: "archived-dependency", Detail: "GitHub repository old/lib is archived."}}, oldLib.Health)
+	assert.Empty(t, oldLib.Vulns)
+
+	assert.Equal(t, "golang.org/x/text", text.Name)
+	assert.Empty(t, text.Health, "module-level findings are not attached to components")
+	require.Len(t, text.Vulns, 1)
+	assert.Equal(t, "GO-2021-0113", text.Vulns[0].ID)
+	assert.Equal(t, "v0.3.7", text.Vulns[0].FixedVersion)
+
+	assert.Equal(t, "left-pad", leftPad.Name)
+	require.Len(t, leftPad.Health, 1)
+	assert.Equal(t, "deprecated-dependency", leftPad.Health[0].Kind)
+	assert.Equal(t, "pkg:npm/left-pad@1.3.0", leftPad.PURL)
+}
+
+func TestHealthMatches(t *testing.T) {
+	crate := Component{Ecosystem: "crates.io", Name: "time", Version: "0.1.0"}
+	assert.True(t, healthMatches(crate, signal.RawSignal{Kind: "yanked-dependency", Title: "Yanked crate: time@0.1.0"}))
+	assert.False(t, healthMatches(crate, signal.RawSignal{Kind: "yanked-dependency", Title: "Yanked crate: time@0.2.0"}),
+		"a different version does not match")
+	assert.False(t, healthMatches(crate, signal.RawSignal{Kind: "yanked-dependency", Title: "Yanked crate: timer@0.1.0"}))
+
+	scoped := Component{Ecosystem: "npm", Name: "@babel/core", Version: "7.0.0"}
+	assert.True(t, healthMatches(scoped, signal.RawSignal{Kind: "deprecated-dependency", Title: "Deprecated npm package: @babel/core"}))
+
+	gomod := Component{Ecosystem: "Go", Name: "github.com/owner/repo/v2", Version: "v2.0.0"}
+	assert.True(t, healthMatches(gomod, signal.RawSignal{Kind: "stale-dependency", Title: "Stale dependency: owner/repo"}))
+	assert.False(t, healthMatches(gomod, signal.RawSignal{Kind: "stale-dependency", Title: "Stale dependency: owner/repo-fork"}))
+
+	swift := Component{Ecosystem: "SwiftURL", Name: "https://github.com/apple/swift-nio", Version: "2.0.0"}
+	assert.True(t, healthMatches(swift, signal.RawSignal{Kind: "archived-dependency", Title: "Archived dependency: apple/swift-nio"}))
+
+	assert.False(t, healthMatches(gomod, signal.RawSignal{Kind: "stale-dependency", Title: "no subject"}))
+}
+
+func TestPURL(t *testing.T) {
+	tests := []struct {
+		ecosystem, name, version, want string
+	}{
+		{"Go", "github.com/spf13/cobra", "v1.9.1", "pkg:golang/github.com/spf13/cobra@v1.9.1"},
+		{"npm", "lodash", "4.17.21", "pkg:npm/lodash@4.17.21"},
+		{"npm", "@babel/core", "7.24.0", "pkg:npm/%40babel/core@7.24.0"},
+		{"crates.io", "serde", "1.0.0", "pkg:cargo/serde@1.0.0"},
+		{"Maven", "org.apache.commons:commons-lang3", "3.14.0", "pkg:maven/org.apache.commons/commons-lang3@3.14.0"},
+		{"NuGet", "Newtonsoft.Json", "13.0.1", "pkg:nuget/Newtonsoft.Json@13.0.1"},
+		{"PyPI", "Django_Rest", "3.0", "pkg:pypi/django-rest@3.0"},
+		{"Packagist", "laravel/framework", "10.0.0", "pkg:composer/laravel/framework@10.0.0"},
+		{"SwiftURL", "https://github.com/apple/swift-nio", "2.62.0", "pkg:swift/github.com/apple/swift-nio@2.62.0"},
+		{"Hex", "phoenix", "1.7.0", "pkg:hex/phoenix@1.7.0"},
+		{"Go", "example.com/mod", "v1.0.0+incompatible", "pkg:golang/example.com/mod@v1.0.0%2Bincompatible"},
+		{"npm", "no-version", "", "pkg:npm/no-version"},
+		{"Unknown", "x", "1", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.ecosystem+"/"+tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, PURL(tt.ecosystem, tt.name, tt.version))
+		})
+	}
+}
+
+func TestWrite_UnsupportedFormat(t *testing.T) {
+	var buf bytes.Buffer
+	err := Write(&buf, testBOM(), "swid")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `unsupported SBOM format "swid" (supported: cyclonedx, spdx)`)
+}
+
+func TestDocumentID(t *testing.T) {
+	id := documentID(testBOM())
+	assert.Regexp(t, `^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`, id)
+	assert.Equal(t, id, documentID(testBOM()))
+}
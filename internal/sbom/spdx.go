@@ -0,0 +1,154 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package sbom
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// SPDX 2.3 JSON document types. Only the fields stringer fills are modeled.
+type spdxDocument struct {
+	SPDXVersion       string             `json:"spdxVersion"`
+	DataLicense       string             `json:"dataLicense"`
+	SPDXID            string             `json:"SPDXID"`
+	Name              string             `json:"name"`
+	DocumentNamespace string             `json:"documentNamespace"`
+	CreationInfo      spdxCreationInfo   `json:"creationInfo"`
+	Packages          []spdxPackage      `json:"packages"`
+	Relationships     []spdxRelationship `json:"relationships"`
+}
+
+type spdxCreationInfo struct {
+	Created  string   `json:"created"`
+	Creators []string `json:"creators"`
+}
+
+type spdxPackage struct {
+	Name             string            `json:"name"`
+	SPDXID           string            `json:"SPDXID"`
+	VersionInfo      string            `json:"versionInfo,omitempty"`
+	DownloadLocation string            `json:"downloadLocation"`
+	FilesAnalyzed    bool              `json:"filesAnalyzed"`
+	SourceInfo       string            `json:"sourceInfo,omitempty"`
+	ExternalRefs     []spdxExternalRef `json:"externalRefs,omitempty"`
+	Annotations      []spdxAnnotation  `json:"annotations,omitempty"`
+}
+
+type spdxExternalRef struct {
+	ReferenceCategory string `json:"referenceCategory"`
+	ReferenceType     string `json:"referenceType"`
+	ReferenceLocator  string `json:"referenceLocator"`
+	Comment           string `json:"comment,omitempty"`
+}
+
+type spdxAnnotation struct {
+	AnnotationType string `json:"annotationType"`
+	Annotator      string `json:"annotator"`
+	AnnotationDate string `json:"annotationDate"`
+	Comment        string `json:"comment"`
+}
+
+type spdxRelationship struct {
+	SPDXElementID      string `json:"spdxElementId"`
+	RelationshipType   string `json:"relationshipType"`
+	RelatedSPDXElement string `json:"relatedSpdxElement"`
+}
+
+// writeSPDX renders the BOM as SPDX 2.3 JSON. Vulnerabilities become
+// SECURITY advisory references and health findings become REVIEW
+// annotations on each package.
+func writeSPDX(w io.Writer, b *BOM) error {
+	created := b.Timestamp.Format(time.RFC3339)
+	tool := "Tool: stringer"
+	if b.ToolVersion != "" {
+		tool += "-" + b.ToolVersion
+	}
+	doc := spdxDocument{
+		SPDXVersion:       "SPDX-2.3",
+		DataLicense:       "CC0-1.0",
+		SPDXID:            "SPDXRef-DOCUMENT",
+		Name:              b.Name,
+		DocumentNamespace: "https://spdx.org/spdxdocs/" + url.PathEscape(b.Name) + "-" + documentID(b),
+		CreationInfo: spdxCreationInfo{
+			Created:  created,
+			Creators: []string{tool},
+		},
+	}
+
+	const rootID = "SPDXRef-Package-root"
+	doc.Packages = append(doc.Packages, spdxPackage{
+		Name:             b.Name,
+		SPDXID:           rootID,
+		DownloadLocation: "NOASSERTION",
+	})
+	doc.Relationships = append(doc.Relationships, spdxRelationship{
+		SPDXElementID: "SPDXRef-DOCUMENT", RelationshipType: "DESCRIBES", RelatedSPDXElement: rootID,
+	})
+
+	for i, c := range b.Components {
+		id := fmt.Sprintf("SPDXRef-Package-%d", i+1)
+		pkg := spdxPackage{
+			Name:             c.Name,
+			SPDXID:           id,
+			VersionInfo:      c.Version,
+			DownloadLocation: "NOASSERTION",
+			SourceInfo:       fmt.Sprintf("declared in %s (%s)", c.Manifest, c.Ecosystem),
+		}
+		if c.PURL != "" {
+			pkg.ExternalRefs = append(pkg.ExternalRefs, spdxExternalRef{
+				ReferenceCategory: "PACKAGE-MANAGER",
+				ReferenceType:     "purl",
+				ReferenceLocator:  c.PURL,
+			})
+		}
+		for _, v := range c.Vulns {
+			pkg.ExternalRefs = append(pkg.ExternalRefs, spdxExternalRef{
+				ReferenceCategory: "SECURITY",
+				ReferenceType:     "advisory",
+				ReferenceLocator:  osvURL(v.ID),
+				Comment:           spdxVulnComment(v),
+			})
+		}
+		for _, h := range c.Health {
+			pkg.Annotations = append(pkg.Annotations, spdxAnnotation{
+				AnnotationType: "REVIEW",
+				Annotator:      tool,
+				AnnotationDate: created,
+				Comment:        h.Kind + ": " + h.Detail,
+			})
+		}
+		doc.Packages = append(doc.Packages, pkg)
+		doc.Relationships = append(doc.Relationships, spdxRelationship{
+			SPDXElementID: rootID, RelationshipType: "DEPENDS_ON", RelatedSPDXElement: id,
+		})
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(doc)
+}
+
+// spdxVulnComment summarizes a vulnerability for an advisory reference.
+func spdxVulnComment(v Vuln) string {
+	parts := []string{v.ID}
+	if v.CVE != "" {
+		parts = append(parts, v.CVE)
+	}
+	if v.Severity != "" {
+		parts = append(parts, "severity "+v.Severity)
+	}
+	if v.FixedVersion != "" {
+		parts = append(parts, "fixed in "+v.FixedVersion)
+	}
+	comment := strings.Join(parts, ", ")
+	if v.Summary != "" {
+		comment += ": " + v.Summary
+	}
+	return comment
+}
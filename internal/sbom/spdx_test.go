@@ -0,0 +1,62 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package sbom
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteSPDX(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, Write(&buf, testBOM(), FormatSPDX))
+
+	var doc spdxDocument
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &doc))
+	assert.Equal(t, "SPDX-2.3", doc.SPDXVersion)
+	assert.Equal(t, "CC0-1.0", doc.DataLicense)
+	assert.Equal(t, "SPDXRef-DOCUMENT", doc.SPDXID)
+	assert.Regexp(t, `^https://spdx.org/spdxdocs/myapp-`, doc.DocumentNamespace)
+	assert.Equal(t, []string{"Tool: stringer-1.2.3"}, doc.CreationInfo.Creators)
+	assert.Equal(t, "2026-10-01T12:00:00Z", doc.CreationInfo.Created)
+
+	require.Len(t, doc.Packages, 4)
+	assert.Equal(t, "SPDXRef-Package-root", doc.Packages[0].SPDXID)
+
+	oldLib := doc.Packages[1]
+	assert.Equal(t, "github.com/old/lib", oldLib.Name)
+	assert.Equal(t, "v1.2.0", oldLib.VersionInfo)
+	assert.Equal(t, "NOASSERTION", oldLib.DownloadLocation)
+	assert.Equal(t, "declared in go.mod (Go)", oldLib.SourceInfo)
+	assert.Equal(t, "pkg:golang/github.com/old/lib@v1.2.0", oldLib.ExternalRefs[0].ReferenceLocator)
+	require.Len(t, oldLib.Annotations, 1)
+	assert.Equal(t, "REVIEW", oldLib.Annotations[0].AnnotationType)
+	assert.Equal(t, "archived-dependency: GitHub repository old/lib is archived.", oldLib.Annotations[0].Comment)
+
+	text := doc.Packages[2]
+	require.Len(t, text.ExternalRefs, 2)
+	adv := text.ExternalRefs[1]
+	assert.Equal(t, "SECURITY", adv.ReferenceCategory)
+	assert.Equal(t, "advisory", adv.ReferenceType)
+	assert.Equal(t, "https://osv.dev/vulnerability/GO-2021-0113", adv.ReferenceLocator)
+	assert.Equal(t, "GO-2021-0113, CVE-2021-38561, severity high, fixed in v0.3.7: Out-of-bounds read in golang.org/x/text/language", adv.Comment)
+
+	require.Len(t, doc.Relationships, 4)
+	assert.Equal(t, spdxRelationship{SPDXElementID: "SPDXRef-DOCUMENT", RelationshipType: "DESCRIBES", RelatedSPDXElement: "SPDXRef-Package-root"}, doc.Relationships[0])
+	assert.Equal(t, "DEPENDS_ON", doc.Relationships[1].RelationshipType)
+}
+
+func TestWriteSPDX_NoToolVersion(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, Write(&buf, Build("empty", "", testTime, nil, nil, nil), FormatSPDX))
+
+	var doc spdxDocument
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &doc))
+	assert.Equal(t, []string{"Tool: stringer"}, doc.CreationInfo.Creators)
+	assert.Len(t, doc.Packages, 1)
+}
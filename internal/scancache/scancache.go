@@ -0,0 +1,262 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+// Package scancache persists per-file collector results between scans. On
+// large monorepos the todos and patterns collectors spend most of their time
+// re-reading files that have not changed; with the cache they replay the
+// result recorded for a file whose size and modification time still match.
+//
+// The cache lives in .stringer/scan-cache.json.gz under the scanned
+// directory. It is discarded whenever it was written by a different build of
+// stringer, so a parser change never replays stale results.
+package scancache
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"runtime/debug"
+	"sync"
+	"time"
+
+	"github.com/davetashner/stringer/internal/signal"
+	"github.com/davetashner/stringer/internal/statedir"
+	"github.com/davetashner/stringer/internal/testable"
+)
+
+const (
+	// FileName is the cache file name inside the .stringer directory.
+	FileName = "scan-cache.json.gz"
+
+	// formatVersion is bumped whenever the on-disk layout changes.
+	formatVersion = 2
+
+	// racyWindow guards against files rewritten within the timestamp
+	// resolution of the file system: a file modified this close to the
+	// start of the scan could change again without its mtime moving, so
+	// its result is not stored.
+	racyWindow = 2 * time.Second
+)
+
+// FS is the file system implementation used by this package.
+// Override in tests with a testable.MockFileSystem.
+var FS testable.FileSystem = testable.DefaultFS
+
+// entry is one file's cached result for one collector.
+type entry struct {
+	Size    int64           `json:"size"`
+	ModTime int64           `json:"mtime"` // Unix nanoseconds
+	Data    json.RawMessage `json:"data"`
+}
+
+// file is the on-disk layout.
+type file struct {
+	Version    int                         `json:"version"`
+	Build      string                      `json:"build"`
+	Collectors map[string]map[string]entry `json:"collectors"`
+}
+
+// Cache holds per-file results keyed by collector name and path relative to
+// the scanned directory. It is safe for concurrent use.
+type Cache struct {
+	root    string
+	started time.Time
+
+	mu      sync.Mutex
+	data    file
+	touched map[string]map[string]bool
+	dirty   bool
+	hits    int
+}
+
+// Compile-time interface check.
+var _ signal.FileCache = (*Cache)(nil)
+
+// Path returns the cache file path for the directory root.
+func Path(root string) string {
+	return filepath.Join(root, statedir.Name, FileName)
+}
+
+// Open loads the cache for root. A missing, unreadable, or outdated cache
+// yields an empty one: the scan then reads every file and rewrites it.
+func Open(root string) *Cache {
+	c := &Cache{
+		root:    root,
+		started: time.Now(),
+		data:    file{Version: formatVersion, Build: build(), Collectors: make(map[string]map[string]entry)},
+		touched: make(map[string]map[string]bool),
+	}
+	loaded, err := load(root)
+	switch {
+	case err != nil:
+		slog.Warn("ignoring unreadable scan cache", "path", Path(root), "error", err)
+	case loaded == nil:
+	case loaded.Version != formatVersion || loaded.Build != c.data.Build:
+		slog.Debug("scan cache written by another stringer build, starting over", "path", Path(root))
+		c.dirty = true
+	default:
+		c.data.Collectors = loaded.Collectors
+	}
+	return c
+}
+
+func load(root string) (*file, error) {
+	raw, err := FS.ReadFile(Path(root))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("read scan cache: %w", err)
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(raw))
+	if err != nil {
+		return nil, fmt.Errorf("decompress scan cache: %w", err)
+	}
+	defer zr.Close() //nolint:errcheck // read-only gzip reader
+
+	var f file
+	if err := json.NewDecoder(zr).Decode(&f); err != nil {
+		return nil, fmt.Errorf("parse scan cache: %w", err)
+	}
+	if f.Collectors == nil {
+		f.Collectors = make(map[string]map[string]entry)
+	}
+	return &f, nil
+}
+
+// Get decodes the result stored for relPath into v and reports whether
+// there was one. It misses when the file's size or modification time
+// differs from when the result was stored.
+func (c *Cache) Get(collector, relPath string, info fs.FileInfo, v any) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.data.Collectors[collector][relPath]
+	if !ok || e.Size != info.Size() || e.ModTime != info.ModTime().UnixNano() {
+		return false
+	}
+	if err := json.Unmarshal(e.Data, v); err != nil {
+		slog.Debug("discarding unreadable scan cache entry", "collector", collector, "path", relPath, "error", err)
+		return false
+	}
+	c.touch(collector, relPath)
+	c.hits++
+	return true
+}
+
+// Put stores v as the result for relPath. Files modified just before the
+// scan started are not stored; see racyWindow.
+func (c *Cache) Put(collector, relPath string, info fs.FileInfo, v any) {
+	if !info.ModTime().Before(c.started.Add(-racyWindow)) {
+		return
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		slog.Debug("cannot encode scan cache entry", "collector", collector, "path", relPath, "error", err)
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entries := c.data.Collectors[collector]
+	if entries == nil {
+		entries = make(map[string]entry)
+		c.data.Collectors[collector] = entries
+	}
+	entries[relPath] = entry{Size: info.Size(), ModTime: info.ModTime().UnixNano(), Data: data}
+	c.touch(collector, relPath)
+	c.dirty = true
+}
+
+func (c *Cache) touch(collector, relPath string) {
+	if c.touched[collector] == nil {
+		c.touched[collector] = make(map[string]bool)
+	}
+	c.touched[collector][relPath] = true
+}
+
+// Hits returns how many lookups were answered from the cache.
+func (c *Cache) Hits() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.hits
+}
+
+// Save writes the cache back to root's .stringer directory when it
+// changed. Entries of collectors that used the cache this scan are dropped
+// once their file is gone; entries the scan did not visit, such as files
+// outside --paths, are kept for the next scan.
+func (c *Cache) Save() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for collector, seen := range c.touched {
+		for relPath := range c.data.Collectors[collector] {
+			if seen[relPath] {
+				continue
+			}
+			if _, err := FS.Stat(filepath.Join(c.root, relPath)); errors.Is(err, fs.ErrNotExist) {
+				delete(c.data.Collectors[collector], relPath)
+				c.dirty = true
+			}
+		}
+	}
+	if !c.dirty {
+		return nil
+	}
+
+	dir := filepath.Join(c.root, statedir.Name)
+	if err := FS.MkdirAll(dir, 0o750); err != nil {
+		return fmt.Errorf("create state directory: %w", err)
+	}
+	statedir.EnsureIgnore(FS, c.root)
+
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if err := json.NewEncoder(zw).Encode(c.data); err != nil {
+		return fmt.Errorf("encode scan cache: %w", err)
+	}
+	if err := zw.Close(); err != nil {
+		return fmt.Errorf("compress scan cache: %w", err)
+	}
+	if err := FS.WriteFile(Path(c.root), buf.Bytes(), 0o644); err != nil {
+		return fmt.Errorf("write scan cache: %w", err)
+	}
+	c.dirty = false
+	return nil
+}
+
+// Clear removes the cache for root. It reports whether there was one.
+func Clear(root string) (bool, error) {
+	err := os.Remove(Path(root))
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("remove scan cache: %w", err)
+	}
+	return true, nil
+}
+
+// build identifies the running stringer binary: its module version and,
+// for builds from a checkout, the commit it was built from.
+func build() string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return ""
+	}
+	id := info.Main.Version
+	for _, s := range info.Settings {
+		switch s.Key {
+		case "vcs.revision", "vcs.modified":
+			id += " " + s.Value
+		}
+	}
+	return id
+}
@@ -0,0 +1,169 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package scancache
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type result struct {
+	Lines int `json:"lines"`
+}
+
+// writeOld writes a file with a modification time outside racyWindow.
+func writeOld(t *testing.T, dir, rel, content string) os.FileInfo {
+	t.Helper()
+	path := filepath.Join(dir, rel)
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o750))
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	old := time.Now().Add(-time.Hour)
+	require.NoError(t, os.Chtimes(path, old, old))
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	return info
+}
+
+func TestGetPut_RoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	info := writeOld(t, dir, "a.go", "one\ntwo\n")
+
+	c := Open(dir)
+	var got result
+	assert.False(t, c.Get("patterns", "a.go", info, &got))
+
+	c.Put("patterns", "a.go", info, result{Lines: 2})
+	require.True(t, c.Get("patterns", "a.go", info, &got))
+	assert.Equal(t, 2, got.Lines)
+	assert.Equal(t, 1, c.Hits())
+
+	// Entries are per collector.
+	assert.False(t, c.Get("todos", "a.go", info, &got))
+}
+
+func TestSaveOpen(t *testing.T) {
+	dir := t.TempDir()
+	info := writeOld(t, dir, "a.go", "one\ntwo\n")
+
+	c := Open(dir)
+	c.Put("patterns", "a.go", info, result{Lines: 2})
+	require.NoError(t, c.Save())
+	assert.FileExists(t, Path(dir))
+	assert.FileExists(t, filepath.Join(dir, ".stringer", ".gitignore"))
+
+	var got result
+	require.True(t, Open(dir).Get("patterns", "a.go", info, &got))
+	assert.Equal(t, 2, got.Lines)
+}
+
+func TestSave_NothingStored(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, Open(dir).Save())
+	assert.NoDirExists(t, filepath.Join(dir, ".stringer"))
+}
+
+func TestGet_MissesChangedFile(t *testing.T) {
+	dir := t.TempDir()
+	info := writeOld(t, dir, "a.go", "one\n")
+	c := Open(dir)
+	c.Put("patterns", "a.go", info, result{Lines: 1})
+
+	resized := writeOld(t, dir, "a.go", "one\ntwo\n")
+	var got result
+	assert.False(t, c.Get("patterns", "a.go", resized, &got))
+
+	path := filepath.Join(dir, "a.go")
+	require.NoError(t, os.WriteFile(path, []byte("uno\n"), 0o600))
+	touched := time.Now().Add(-30 * time.Minute)
+	require.NoError(t, os.Chtimes(path, touched, touched))
+	retimed, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, info.Size(), retimed.Size())
+	assert.False(t, c.Get("patterns", "a.go", retimed, &got))
+}
+
+func TestPut_SkipsRecentlyModifiedFiles(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "a.go")
+	require.NoError(t, os.WriteFile(path, []byte("one\n"), 0o600))
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+
+	c := Open(dir)
+	c.Put("patterns", "a.go", info, result{Lines: 1})
+	var got result
+	assert.False(t, c.Get("patterns", "a.go", info, &got))
+}
+
+func TestOpen_DiscardsOtherBuild(t *testing.T) {
+	dir := t.TempDir()
+	info := writeOld(t, dir, "a.go", "one\n")
+	c := Open(dir)
+	c.data.Build = "v0.0.1-other"
+	c.Put("patterns", "a.go", info, result{Lines: 1})
+	require.NoError(t, c.Save())
+
+	var got result
+	assert.False(t, Open(dir).Get("patterns", "a.go", info, &got))
+}
+
+func TestOpen_Unreadable(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, ".stringer"), 0o750))
+	require.NoError(t, os.WriteFile(Path(dir), []byte("not gzip"), 0o600))
+
+	c := Open(dir)
+	require.NotNil(t, c)
+	info := writeOld(t, dir, "a.go", "one\n")
+	var got result
+	assert.False(t, c.Get("patterns", "a.go", info, &got))
+}
+
+func TestSave_PrunesDeletedFiles(t *testing.T) {
+	dir := t.TempDir()
+	a := writeOld(t, dir, "a.go", "one\n")
+	b := writeOld(t, dir, "b.go", "one\n")
+	keep := writeOld(t, dir, "other/c.go", "one\n")
+
+	c := Open(dir)
+	c.Put("patterns", "a.go", a, result{Lines: 1})
+	c.Put("patterns", "b.go", b, result{Lines: 1})
+	c.Put("todos", "other/c.go", keep, result{Lines: 1})
+	require.NoError(t, c.Save())
+
+	// The next scan visits a.go only; b.go is gone, other/c.go was not
+	// visited and still exists.
+	require.NoError(t, os.Remove(filepath.Join(dir, "b.go")))
+	c = Open(dir)
+	var got result
+	require.True(t, c.Get("patterns", "a.go", a, &got))
+	require.NoError(t, c.Save())
+
+	c = Open(dir)
+	assert.True(t, c.Get("patterns", "a.go", a, &got))
+	assert.False(t, c.Get("patterns", "b.go", b, &got))
+	assert.True(t, c.Get("todos", "other/c.go", keep, &got))
+}
+
+func TestClear(t *testing.T) {
+	dir := t.TempDir()
+	ok, err := Clear(dir)
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	info := writeOld(t, dir, "a.go", "one\n")
+	c := Open(dir)
+	c.Put("patterns", "a.go", info, result{Lines: 1})
+	require.NoError(t, c.Save())
+
+	ok, err = Clear(dir)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.NoFileExists(t, Path(dir))
+}
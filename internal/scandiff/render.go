@@ -0,0 +1,72 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package scandiff
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Render writes the comparison as markdown: the counts, then tables of the
+// added and removed signals. Persisted signals are only counted.
+func Render(r *Result, w io.Writer) error {
+	if _, err := fmt.Fprintf(w, "# Scan Diff\n\n"); err != nil {
+		return err
+	}
+	_, _ = fmt.Fprintf(w, "**%d added** · **%d removed** · %d persisted\n", len(r.Added), len(r.Removed), len(r.Persisted))
+	renderTable(w, "Added", r.Added)
+	renderTable(w, "Removed", r.Removed)
+	return nil
+}
+
+func renderTable(w io.Writer, heading string, entries []Entry) {
+	if len(entries) == 0 {
+		return
+	}
+	_, _ = fmt.Fprintf(w, "\n## %s (%d)\n\n", heading, len(entries))
+	_, _ = fmt.Fprintf(w, "| Priority | ID | Title | Location |\n")
+	_, _ = fmt.Fprintf(w, "|----------|----|-------|----------|\n")
+	for _, e := range entries {
+		loc := e.Location
+		if loc == "" {
+			loc = "-"
+		}
+		_, _ = fmt.Fprintf(w, "| P%d | %s | %s | %s |\n", e.Priority, e.ID, escapeCell(e.Title), escapeCell(loc))
+	}
+}
+
+// RenderJSON writes the comparison as indented JSON with a summary of the
+// counts.
+func RenderJSON(r *Result, w io.Writer) error {
+	out := struct {
+		Summary struct {
+			Added     int `json:"added"`
+			Removed   int `json:"removed"`
+			Persisted int `json:"persisted"`
+		} `json:"summary"`
+		*Result
+	}{Result: r}
+	out.Summary.Added = len(r.Added)
+	out.Summary.Removed = len(r.Removed)
+	out.Summary.Persisted = len(r.Persisted)
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(out)
+}
+
+// RenderSummary writes the one-line counts scan prints to stderr when it
+// compares against a baseline.
+func RenderSummary(r *Result, w io.Writer) error {
+	_, err := fmt.Fprintf(w, "Baseline comparison: +%d added, -%d removed, %d persisted\n",
+		len(r.Added), len(r.Removed), len(r.Persisted))
+	return err
+}
+
+func escapeCell(s string) string {
+	s = strings.ReplaceAll(s, "\n", " ")
+	return strings.ReplaceAll(s, "|", `\|`)
+}
@@ -0,0 +1,63 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package scandiff
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func sampleResult() *Result {
+	return &Result{
+		Added:     []Entry{{ID: "str-aaaa0001", Title: "Split | handler", Location: "api/h.go:12", Priority: 1}},
+		Removed:   []Entry{{ID: "str-aaaa0002", Title: "Old issue", Priority: 3}},
+		Persisted: []Entry{{ID: "str-aaaa0003", Title: "Still here", Priority: 2}},
+	}
+}
+
+func TestRender(t *testing.T) {
+	var buf strings.Builder
+	require.NoError(t, Render(sampleResult(), &buf))
+	out := buf.String()
+	assert.Contains(t, out, "# Scan Diff")
+	assert.Contains(t, out, "**1 added** · **1 removed** · 1 persisted")
+	assert.Contains(t, out, "## Added (1)")
+	assert.Contains(t, out, `| P1 | str-aaaa0001 | Split \| handler | api/h.go:12 |`)
+	assert.Contains(t, out, "## Removed (1)")
+	assert.Contains(t, out, "| P3 | str-aaaa0002 | Old issue | - |")
+	assert.NotContains(t, out, "Still here", "persisted signals are only counted")
+}
+
+func TestRender_NoChanges(t *testing.T) {
+	var buf strings.Builder
+	require.NoError(t, Render(&Result{}, &buf))
+	assert.Contains(t, buf.String(), "**0 added** · **0 removed** · 0 persisted")
+	assert.NotContains(t, buf.String(), "##")
+}
+
+func TestRenderJSON(t *testing.T) {
+	var buf strings.Builder
+	require.NoError(t, RenderJSON(sampleResult(), &buf))
+
+	var got struct {
+		Summary   map[string]int `json:"summary"`
+		Added     []Entry        `json:"added"`
+		Removed   []Entry        `json:"removed"`
+		Persisted []Entry        `json:"persisted"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(buf.String()), &got))
+	assert.Equal(t, map[string]int{"added": 1, "removed": 1, "persisted": 1}, got.Summary)
+	assert.Equal(t, sampleResult().Added, got.Added)
+	assert.Equal(t, "Still here", got.Persisted[0].Title)
+}
+
+func TestRenderSummary(t *testing.T) {
+	var buf strings.Builder
+	require.NoError(t, RenderSummary(sampleResult(), &buf))
+	assert.Equal(t, "Baseline comparison: +1 added, -1 removed, 1 persisted\n", buf.String())
+}
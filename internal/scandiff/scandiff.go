@@ -0,0 +1,282 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+// Package scandiff compares two scan outputs and sorts their signals into
+// added, removed, and persisted, so CI can gate on what a change introduced
+// rather than on the whole backlog.
+package scandiff
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/davetashner/stringer/internal/output"
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+// Entry is one signal of a scan output.
+type Entry struct {
+	// ID is the stringer signal ID (str-XXXXXXXX, with a workspace or
+	// convention prefix where the output had one).
+	ID string `json:"id"`
+
+	Title     string `json:"title"`
+	Kind      string `json:"kind,omitempty"`
+	Collector string `json:"collector,omitempty"`
+	Location  string `json:"location,omitempty"`
+	Priority  int    `json:"priority"`
+
+	// Confidence is nil for beads output, which records only the
+	// priority the confidence mapped to.
+	Confidence *float64 `json:"confidence,omitempty"`
+}
+
+// MinConfidence returns the entry's confidence. Entries read from beads
+// output use the lowest confidence of their priority band, so P1 counts
+// as 0.8 and P4 as 0.
+func (e Entry) MinConfidence() float64 {
+	if e.Confidence != nil {
+		return *e.Confidence
+	}
+	switch e.Priority {
+	case 1:
+		return 0.8
+	case 2:
+		return 0.6
+	case 3:
+		return 0.4
+	default:
+		return 0
+	}
+}
+
+// hash returns the content hash at the end of the entry's ID.
+func (e Entry) hash() string {
+	if i := strings.LastIndex(e.ID, "-"); i >= 0 {
+		return e.ID[i+1:]
+	}
+	return e.ID
+}
+
+// Result is the comparison of an old and a new scan.
+type Result struct {
+	// Added are signals in the new scan that the old scan did not have.
+	Added []Entry `json:"added"`
+
+	// Removed are signals of the old scan that the new scan no longer has.
+	Removed []Entry `json:"removed"`
+
+	// Persisted are signals found by both scans, as the new scan saw them.
+	Persisted []Entry `json:"persisted"`
+}
+
+// Compute compares old with cur. Signals match by the content hash of
+// their ID, then by normalized title among signals of the same kind and
+// collector, so a TODO that only moved to another line persists. Beads
+// output records neither, so an entry read from it matches by title only
+// within the same file. Each old signal matches at most one new signal.
+func Compute(old, cur []Entry) *Result {
+	matched := match(old, cur)
+	r := &Result{Added: []Entry{}, Removed: []Entry{}, Persisted: []Entry{}}
+	used := make([]bool, len(old))
+	for i, e := range cur {
+		if j := matched[i]; j >= 0 {
+			used[j] = true
+			r.Persisted = append(r.Persisted, e)
+			continue
+		}
+		r.Added = append(r.Added, e)
+	}
+	for j, e := range old {
+		if !used[j] {
+			r.Removed = append(r.Removed, e)
+		}
+	}
+	return r
+}
+
+// match returns, for each entry of cur, the index of the old entry it
+// matches or -1.
+func match(old, cur []Entry) []int {
+	byHash := make(map[string][]int)
+	byTitle := make(map[string][]int)
+	for j, e := range old {
+		byHash[e.hash()] = append(byHash[e.hash()], j)
+		t := signal.NormalizeTitle(e.Title)
+		byTitle[t] = append(byTitle[t], j)
+	}
+
+	used := make([]bool, len(old))
+	take := func(candidates []int, ok func(Entry) bool) int {
+		for _, j := range candidates {
+			if !used[j] && ok(old[j]) {
+				used[j] = true
+				return j
+			}
+		}
+		return -1
+	}
+	always := func(Entry) bool { return true }
+
+	matched := make([]int, len(cur))
+	for i, e := range cur {
+		matched[i] = take(byHash[e.hash()], always)
+	}
+	for i, e := range cur {
+		if t := signal.NormalizeTitle(e.Title); matched[i] < 0 && t != "" {
+			matched[i] = take(byTitle[t], e.sameSignal)
+		}
+	}
+	return matched
+}
+
+// sameSignal reports whether o can be the same signal as e under another
+// hash: both have the same kind and collector or, when either was read from
+// beads output, the same file.
+func (e Entry) sameSignal(o Entry) bool {
+	if e.Kind != "" && o.Kind != "" {
+		return e.Kind == o.Kind && e.Collector == o.Collector
+	}
+	return locationFile(e.Location) == locationFile(o.Location)
+}
+
+// locationFile strips the line from a "file:line" location.
+func locationFile(loc string) string {
+	if i := strings.LastIndex(loc, ":"); i >= 0 {
+		if _, err := strconv.Atoi(loc[i+1:]); err == nil {
+			return loc[:i]
+		}
+	}
+	return loc
+}
+
+// Filter drops the entries below minConfidence from every list.
+func (r *Result) Filter(minConfidence float64) {
+	keep := func(entries []Entry) []Entry {
+		out := entries[:0]
+		for _, e := range entries {
+			if e.MinConfidence() >= minConfidence {
+				out = append(out, e)
+			}
+		}
+		return out
+	}
+	r.Added = keep(r.Added)
+	r.Removed = keep(r.Removed)
+	r.Persisted = keep(r.Persisted)
+}
+
+// NewSignals returns the signals that baseline does not have, and the
+// comparison they came from.
+func NewSignals(baseline []Entry, signals []signal.RawSignal) ([]signal.RawSignal, *Result) {
+	cur := FromSignals(signals)
+	matched := match(baseline, cur)
+	var added []signal.RawSignal
+	for i, j := range matched {
+		if j < 0 {
+			added = append(added, signals[i])
+		}
+	}
+	return added, Compute(baseline, cur)
+}
+
+// FromSignals converts signals to entries with the IDs the beads format
+// gives them.
+func FromSignals(signals []signal.RawSignal) []Entry {
+	entries := make([]Entry, len(signals))
+	for i, s := range signals {
+		prefix := "str-"
+		if s.Workspace != "" {
+			prefix += s.Workspace + "-"
+		}
+		p := signal.EffectivePriority(s)
+		conf := s.Confidence
+		entries[i] = Entry{
+			ID:         output.SignalID(s, prefix),
+			Title:      s.Title,
+			Kind:       s.Kind,
+			Collector:  s.Source,
+			Location:   location(s.FilePath, s.Line),
+			Priority:   p,
+			Confidence: &conf,
+		}
+	}
+	return entries
+}
+
+// Read parses a scan output written with --format beads or --format json.
+// Closed beads, such as the resolved TODOs of a --delta scan, are skipped.
+func Read(r io.Reader) ([]Entry, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("read scan output: %w", err)
+	}
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("{")) {
+		var env struct {
+			Signals *[]signal.RawSignal `json:"signals"`
+		}
+		if json.Unmarshal(data, &env) == nil && env.Signals != nil {
+			return FromSignals(*env.Signals), nil
+		}
+	}
+	return readBeads(data)
+}
+
+// beadLine holds the fields of a beads record that a diff needs.
+type beadLine struct {
+	ID          string `json:"id"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	Priority    int    `json:"priority"`
+	Status      string `json:"status"`
+}
+
+// locationLine finds the location the beads format appends to descriptions.
+var locationLine = regexp.MustCompile(`(?m)^Location: (.+)$`)
+
+func readBeads(data []byte) ([]Entry, error) {
+	var entries []Entry
+	sc := bufio.NewScanner(bytes.NewReader(data))
+	sc.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	lineNum := 0
+	for sc.Scan() {
+		lineNum++
+		line := bytes.TrimSpace(sc.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		var b beadLine
+		if err := json.Unmarshal(line, &b); err != nil {
+			return nil, fmt.Errorf("parse line %d: not beads or json scan output: %w", lineNum, err)
+		}
+		if b.ID == "" {
+			return nil, fmt.Errorf("parse line %d: record has no id", lineNum)
+		}
+		if b.Status == "closed" {
+			continue
+		}
+		e := Entry{ID: b.ID, Title: b.Title, Priority: b.Priority}
+		if m := locationLine.FindStringSubmatch(b.Description); m != nil {
+			e.Location = m[1]
+		}
+		entries = append(entries, e)
+	}
+	if err := sc.Err(); err != nil {
+		return nil, fmt.Errorf("read scan output: %w", err)
+	}
+	return entries, nil
+}
+
+// location returns "file:line", "file", or "" for signals not tied to a file.
+func location(path string, line int) string {
+	if path != "" && line > 0 {
+		return fmt.Sprintf("%s:%d", path, line)
+	}
+	return path
+}
@@ -0,0 +1,173 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package scandiff
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davetashner/stringer/internal/output"
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+func titles(entries []Entry) []string {
+	out := make([]string, len(entries))
+	for i, e := range entries {
+		out[i] = e.Title
+	}
+	return out
+}
+
+func formatSignals(t *testing.T, format string, signals []signal.RawSignal) []byte {
+	t.Helper()
+	f, err := output.GetFormatter(format)
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	require.NoError(t, f.Format(signals, &buf))
+	return buf.Bytes()
+}
+
+var (
+	kept  = signal.RawSignal{Source: "todos", Kind: "todo", FilePath: "a.go", Line: 1, Title: "TODO: kept", Confidence: 0.5}
+	moved = signal.RawSignal{Source: "todos", Kind: "todo", FilePath: "a.go", Line: 2, Title: "TODO: moved", Confidence: 0.5}
+	gone  = signal.RawSignal{Source: "todos", Kind: "fixme", FilePath: "b.go", Line: 3, Title: "FIXME: gone", Confidence: 0.9}
+	added = signal.RawSignal{Source: "patterns", Kind: "large-file", FilePath: "c.go", Title: "Large file: c.go", Confidence: 0.85}
+)
+
+func TestCompute(t *testing.T) {
+	movedNow := moved
+	movedNow.Line = 20
+
+	res := Compute(
+		FromSignals([]signal.RawSignal{kept, moved, gone}),
+		FromSignals([]signal.RawSignal{kept, movedNow, added}),
+	)
+	assert.Equal(t, []string{"Large file: c.go"}, titles(res.Added))
+	assert.Equal(t, []string{"FIXME: gone"}, titles(res.Removed))
+	assert.Equal(t, []string{"TODO: kept", "TODO: moved"}, titles(res.Persisted))
+	assert.Equal(t, "a.go:20", res.Persisted[1].Location, "persisted signals are reported as the new scan saw them")
+}
+
+func TestCompute_DuplicatesMatchOnce(t *testing.T) {
+	dup := kept
+	dup.Line = 9
+	res := Compute(FromSignals([]signal.RawSignal{kept}), FromSignals([]signal.RawSignal{kept, dup}))
+	assert.Len(t, res.Persisted, 1)
+	assert.Len(t, res.Added, 1, "a second TODO with the same title is new")
+}
+
+func TestCompute_TitleMatchNeedsSameKind(t *testing.T) {
+	todo := signal.RawSignal{Source: "todos", Kind: "todo", FilePath: "a.go", Line: 1, Title: "TODO: retry"}
+	fixme := todo
+	fixme.Kind, fixme.Title = "fixme", "FIXME: retry"
+	res := Compute(FromSignals([]signal.RawSignal{todo}), FromSignals([]signal.RawSignal{fixme}))
+	assert.Len(t, res.Added, 1, "a different kind with the same title is a new signal")
+	assert.Len(t, res.Removed, 1)
+
+	other := todo
+	other.Source = "custom"
+	res = Compute(FromSignals([]signal.RawSignal{todo}), FromSignals([]signal.RawSignal{other}))
+	assert.Len(t, res.Added, 1, "a different collector with the same title is a new signal")
+}
+
+func TestCompute_BeadsTitleMatchNeedsSameFile(t *testing.T) {
+	old, err := Read(bytes.NewReader(formatSignals(t, "beads", []signal.RawSignal{moved})))
+	require.NoError(t, err)
+
+	movedNow := moved
+	movedNow.Line = 20
+	elsewhere := moved
+	elsewhere.FilePath = "z.go"
+	res := Compute(old, FromSignals([]signal.RawSignal{elsewhere, movedNow}))
+	require.Len(t, res.Persisted, 1)
+	assert.Equal(t, "a.go:20", res.Persisted[0].Location, "the moved TODO persists")
+	require.Len(t, res.Added, 1)
+	assert.Equal(t, "z.go:2", res.Added[0].Location, "a TODO with the same title in another file is new")
+}
+
+func TestCompute_CustomIDPrefix(t *testing.T) {
+	old := []Entry{{ID: "proj-" + strings.TrimPrefix(FromSignals([]signal.RawSignal{gone})[0].ID, "str-"), Title: "renamed"}}
+	res := Compute(old, FromSignals([]signal.RawSignal{gone}))
+	assert.Len(t, res.Persisted, 1, "signals match by the hash at the end of the ID")
+}
+
+func TestFilter(t *testing.T) {
+	res := Compute(FromSignals([]signal.RawSignal{kept, gone}), FromSignals([]signal.RawSignal{kept, added}))
+	res.Filter(0.8)
+	assert.Equal(t, []string{"Large file: c.go"}, titles(res.Added))
+	assert.Equal(t, []string{"FIXME: gone"}, titles(res.Removed))
+	assert.Empty(t, res.Persisted)
+}
+
+func TestEntry_MinConfidence(t *testing.T) {
+	conf := 0.3
+	assert.InDelta(t, 0.3, Entry{Priority: 1, Confidence: &conf}.MinConfidence(), 1e-9)
+	assert.InDelta(t, 0.8, Entry{Priority: 1}.MinConfidence(), 1e-9)
+	assert.InDelta(t, 0.6, Entry{Priority: 2}.MinConfidence(), 1e-9)
+	assert.InDelta(t, 0.4, Entry{Priority: 3}.MinConfidence(), 1e-9)
+	assert.InDelta(t, 0.0, Entry{Priority: 4}.MinConfidence(), 1e-9)
+}
+
+func TestNewSignals(t *testing.T) {
+	baseline := FromSignals([]signal.RawSignal{kept, gone})
+	sigs, res := NewSignals(baseline, []signal.RawSignal{kept, added})
+	assert.Equal(t, []signal.RawSignal{added}, sigs)
+	assert.Len(t, res.Removed, 1)
+	assert.Len(t, res.Persisted, 1)
+}
+
+func TestRead_Beads(t *testing.T) {
+	closed := gone
+	closed.Tags = []string{"pre-closed"}
+	data := formatSignals(t, "beads", []signal.RawSignal{kept, added, closed})
+
+	entries, err := Read(bytes.NewReader(data))
+	require.NoError(t, err)
+	require.Len(t, entries, 2, "closed beads are skipped")
+	want := FromSignals([]signal.RawSignal{kept})[0]
+	assert.Equal(t, want.ID, entries[0].ID)
+	assert.Equal(t, "TODO: kept", entries[0].Title)
+	assert.Equal(t, "a.go:1", entries[0].Location)
+	assert.Equal(t, 3, entries[0].Priority)
+	assert.Nil(t, entries[0].Confidence)
+	assert.Equal(t, "c.go", entries[1].Location)
+}
+
+func TestRead_JSON(t *testing.T) {
+	data := formatSignals(t, "json", []signal.RawSignal{kept, added})
+
+	entries, err := Read(bytes.NewReader(data))
+	require.NoError(t, err)
+	assert.Equal(t, FromSignals([]signal.RawSignal{kept, added}), entries)
+}
+
+func TestRead_MatchesAcrossFormats(t *testing.T) {
+	old, err := Read(bytes.NewReader(formatSignals(t, "beads", []signal.RawSignal{kept, gone})))
+	require.NoError(t, err)
+	cur, err := Read(bytes.NewReader(formatSignals(t, "json", []signal.RawSignal{kept, added})))
+	require.NoError(t, err)
+
+	res := Compute(old, cur)
+	assert.Equal(t, []string{"Large file: c.go"}, titles(res.Added))
+	assert.Equal(t, []string{"FIXME: gone"}, titles(res.Removed))
+	assert.Equal(t, []string{"TODO: kept"}, titles(res.Persisted))
+}
+
+func TestRead_Empty(t *testing.T) {
+	entries, err := Read(strings.NewReader(""))
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
+func TestRead_Invalid(t *testing.T) {
+	_, err := Read(strings.NewReader("# Stringer Scan\n"))
+	assert.ErrorContains(t, err, "parse line 1")
+
+	_, err = Read(strings.NewReader(`{"title":"no id"}` + "\n"))
+	assert.ErrorContains(t, err, "record has no id")
+}
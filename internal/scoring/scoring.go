@@ -0,0 +1,175 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+// Package scoring re-weights signal confidence with named profiles, so a
+// scan can favor the work a team is focused on. Collectors still compute
+// each signal's base confidence; a profile scales it by kind or tag after
+// collection, and a weight of zero drops a kind entirely.
+package scoring
+
+import (
+	"fmt"
+	"math"
+	"path"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+// Default is the profile used when none is selected. It changes nothing.
+const Default = "default"
+
+// Weight scales the confidence of signals whose kind matches one of Kinds
+// (path.Match globs) or that carry one of Tags. A Factor of 0 drops them.
+type Weight struct {
+	Kinds  []string
+	Tags   []string
+	Factor float64
+}
+
+// Profile is a named list of weights. A signal takes the first weight it
+// matches; signals matching none keep their confidence.
+type Profile struct {
+	Name        string
+	Description string
+	Weights     []Weight
+}
+
+// builtin holds the profiles every scan can select by name.
+var builtin = map[string]Profile{
+	Default: {
+		Name:        Default,
+		Description: "confidence as the collectors scored it",
+	},
+	"security-first": {
+		Name:        "security-first",
+		Description: "secrets, vulnerabilities, and risky dependencies and CI first; style and housekeeping last",
+		Weights: []Weight{
+			{Kinds: []string{"committed-secret", "vulnerable-dependency"}, Tags: []string{"security"}, Factor: 1.3},
+			{Kinds: []string{"ci-risk", "yanked-dependency", "retracted-version", "archived-dependency", "deprecated-dependency"}, Factor: 1.2},
+			{Kinds: []string{"mixed-line-endings", "stale-branch"}, Factor: 0},
+			{Kinds: []string{"todo", "hack", "xxx", "optimize", "note"}, Tags: []string{"documentation", "tooling-hygiene"}, Factor: 0.6},
+		},
+	},
+	"refactor-focus": {
+		Name:        "refactor-focus",
+		Description: "complexity, churn, coupling, and dead code first; tracker and history signals dropped",
+		Weights: []Weight{
+			{Kinds: []string{"hotspot", "complex-function", "high-coupling", "circular-dependency", "architecture-violation"}, Factor: 1.3},
+			{Kinds: []string{"unused-*", "unreachable-code", "large-file", "churn"}, Tags: []string{"refactor-candidate", "dead-code"}, Factor: 1.2},
+			{Kinds: []string{"github-*", "gitlab-*", "stale-branch", "revert"}, Factor: 0},
+			{Tags: []string{"dephealth", "documentation"}, Factor: 0.7},
+		},
+	},
+	"docs-heavy": {
+		Name:        "docs-heavy",
+		Description: "stale and drifting docs, undocumented routes, and config drift first",
+		Weights: []Weight{
+			{Kinds: []string{"stale-doc", "doc-code-drift", "broken-doc-link", "undocumented-route"}, Tags: []string{"documentation"}, Factor: 1.4},
+			{Kinds: []string{"env-var-drift", "dead-config-key", "inconsistent-defaults", "stale-api-version"}, Factor: 1.2},
+			{Kinds: []string{"complex-function", "high-coupling", "slow-test"}, Factor: 0.7},
+		},
+	},
+}
+
+// Builtin returns the built-in profile named name.
+func Builtin(name string) (Profile, bool) {
+	p, ok := builtin[name]
+	return p, ok
+}
+
+// Names returns the built-in profile names, sorted.
+func Names() []string {
+	names := make([]string, 0, len(builtin))
+	for name := range builtin {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Resolve returns the profile named name from custom or the built-ins.
+// An empty name selects Default.
+func Resolve(name string, custom map[string]Profile) (Profile, error) {
+	if name == "" {
+		name = Default
+	}
+	if p, ok := custom[name]; ok {
+		return p, nil
+	}
+	if p, ok := builtin[name]; ok {
+		return p, nil
+	}
+	available := Names()
+	for n := range custom {
+		available = append(available, n)
+	}
+	sort.Strings(available)
+	return Profile{}, fmt.Errorf("unknown scoring profile %q (available: %s)", name, strings.Join(available, ", "))
+}
+
+// Apply weighs signals and drops those whose weight is zero. It returns the
+// signals left and how many were dropped.
+func (p Profile) Apply(signals []signal.RawSignal) ([]signal.RawSignal, int) {
+	p.Weigh(signals)
+	return p.Filter(signals)
+}
+
+// Weigh scales the confidence of signals by p in place, capped at 1.0 and
+// rounded to two decimals, and records a "profile" factor on each signal it
+// changes. Signals with a zero weight are left as they are for Filter to
+// drop. It returns how many signals changed.
+func (p Profile) Weigh(signals []signal.RawSignal) int {
+	n := 0
+	for i := range signals {
+		sig := &signals[i]
+		w, ok := p.match(*sig)
+		if !ok || w.Factor == 0 {
+			continue
+		}
+		conf := math.Round(math.Min(1, sig.Confidence*w.Factor)*100) / 100
+		if conf != sig.Confidence {
+			sig.AddFactor("profile", conf-sig.Confidence, fmt.Sprintf("%s profile weights %s signals ×%g", p.Name, sig.Kind, w.Factor))
+			sig.Confidence = conf
+			n++
+		}
+	}
+	return n
+}
+
+// Filter returns the signals whose weight is not zero in a new slice, and
+// how many it dropped.
+func (p Profile) Filter(signals []signal.RawSignal) ([]signal.RawSignal, int) {
+	if len(p.Weights) == 0 {
+		return signals, 0
+	}
+	kept := make([]signal.RawSignal, 0, len(signals))
+	dropped := 0
+	for _, sig := range signals {
+		if w, ok := p.match(sig); ok && w.Factor == 0 {
+			dropped++
+			continue
+		}
+		kept = append(kept, sig)
+	}
+	return kept, dropped
+}
+
+// match returns the first weight sig matches.
+func (p Profile) match(sig signal.RawSignal) (Weight, bool) {
+	for _, w := range p.Weights {
+		for _, k := range w.Kinds {
+			if ok, _ := path.Match(k, sig.Kind); ok {
+				return w, true
+			}
+		}
+		for _, t := range sig.Tags {
+			if slices.Contains(w.Tags, t) {
+				return w, true
+			}
+		}
+	}
+	return Weight{}, false
+}
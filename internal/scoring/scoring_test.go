@@ -0,0 +1,128 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package scoring
+
+import (
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davetashner/stringer/internal/signal"
+)
+
+func TestApply_WeightsByKindAndTag(t *testing.T) {
+	p := Profile{Name: "custom", Weights: []Weight{
+		{Kinds: []string{"committed-secret"}, Factor: 1.5},
+		{Kinds: []string{"unused-*"}, Factor: 0.5},
+		{Tags: []string{"security"}, Factor: 1.2},
+	}}
+	signals := []signal.RawSignal{
+		{Kind: "committed-secret", Confidence: 0.8},
+		{Kind: "unused-function", Confidence: 0.6},
+		{Kind: "ci-risk", Tags: []string{"ci-risk", "security"}, Confidence: 0.5},
+		{Kind: "todo", Confidence: 0.5},
+	}
+
+	kept, dropped := p.Apply(signals)
+
+	assert.Zero(t, dropped)
+	require.Len(t, kept, 4)
+	assert.Equal(t, 1.0, kept[0].Confidence, "boosts cap at 1.0")
+	assert.Equal(t, 0.3, kept[1].Confidence, "kinds match as globs")
+	assert.Equal(t, 0.6, kept[2].Confidence, "tags match too")
+	assert.Equal(t, 0.5, kept[3].Confidence, "unmatched signals keep their confidence")
+	assert.Empty(t, kept[3].Factors)
+	assert.Equal(t, []signal.ConfidenceFactor{
+		{Name: "profile", Delta: 0.2, Detail: "custom profile weights committed-secret signals ×1.5"},
+	}, kept[0].Factors)
+}
+
+func TestApply_FirstMatchingWeightWins(t *testing.T) {
+	p := Profile{Name: "custom", Weights: []Weight{
+		{Kinds: []string{"todo"}, Factor: 0.5},
+		{Tags: []string{"todo"}, Factor: 2},
+	}}
+	kept, _ := p.Apply([]signal.RawSignal{{Kind: "todo", Tags: []string{"todo"}, Confidence: 0.8}})
+	assert.Equal(t, 0.4, kept[0].Confidence)
+}
+
+func TestApply_ZeroFactorDrops(t *testing.T) {
+	p, ok := Builtin("security-first")
+	require.True(t, ok)
+	kept, dropped := p.Apply([]signal.RawSignal{
+		{Kind: "stale-branch", Confidence: 0.7},
+		{Kind: "vulnerable-dependency", Confidence: 0.7},
+		{Kind: "mixed-line-endings", Confidence: 0.4},
+	})
+	assert.Equal(t, 2, dropped)
+	require.Len(t, kept, 1)
+	assert.Equal(t, "vulnerable-dependency", kept[0].Kind)
+	assert.Equal(t, 0.91, kept[0].Confidence)
+}
+
+func TestWeigh_KeepsZeroWeightSignals(t *testing.T) {
+	p, ok := Builtin("security-first")
+	require.True(t, ok)
+	signals := []signal.RawSignal{
+		{Kind: "stale-branch", Confidence: 0.7},
+		{Kind: "vulnerable-dependency", Confidence: 0.7},
+	}
+	assert.Equal(t, 1, p.Weigh(signals))
+	assert.Equal(t, 0.7, signals[0].Confidence, "zero weights are left for Filter")
+	assert.Equal(t, 0.91, signals[1].Confidence)
+
+	kept, dropped := p.Filter(signals)
+	assert.Equal(t, 1, dropped)
+	require.Len(t, kept, 1)
+	assert.Equal(t, "vulnerable-dependency", kept[0].Kind)
+	assert.Equal(t, "stale-branch", signals[0].Kind, "the input is not compacted")
+}
+
+func TestApply_DefaultIsNoOp(t *testing.T) {
+	p, ok := Builtin(Default)
+	require.True(t, ok)
+	signals := []signal.RawSignal{{Kind: "todo", Confidence: 0.5}}
+	kept, dropped := p.Apply(signals)
+	assert.Zero(t, dropped)
+	assert.Equal(t, signals, kept)
+}
+
+func TestResolve(t *testing.T) {
+	custom := map[string]Profile{"ours": {Name: "ours"}}
+
+	p, err := Resolve("", custom)
+	require.NoError(t, err)
+	assert.Equal(t, Default, p.Name)
+
+	p, err = Resolve("ours", custom)
+	require.NoError(t, err)
+	assert.Equal(t, "ours", p.Name)
+
+	p, err = Resolve("docs-heavy", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "docs-heavy", p.Name)
+
+	_, err = Resolve("nope", custom)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `unknown scoring profile "nope"`)
+	assert.Contains(t, err.Error(), "default, docs-heavy, ours, refactor-focus, security-first")
+}
+
+func TestBuiltins_WellFormed(t *testing.T) {
+	assert.Equal(t, []string{"default", "docs-heavy", "refactor-focus", "security-first"}, Names())
+	for _, name := range Names() {
+		p, _ := Builtin(name)
+		assert.Equal(t, name, p.Name)
+		assert.NotEmpty(t, p.Description, name)
+		for _, w := range p.Weights {
+			assert.GreaterOrEqual(t, w.Factor, 0.0, name)
+			for _, k := range w.Kinds {
+				_, err := path.Match(k, "")
+				assert.NoError(t, err, "%s: kind %q", name, k)
+			}
+		}
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package signal
+
+import "errors"
+
+// ErrorCategory classifies why a collector failed, so callers can branch on
+// the kind of failure rather than its message.
+type ErrorCategory string
+
+const (
+	// CategoryAuth means credentials were missing, invalid, or lacked access.
+	CategoryAuth ErrorCategory = "auth"
+
+	// CategoryNetwork means a remote service could not be reached.
+	CategoryNetwork ErrorCategory = "network"
+
+	// CategoryTimeout means the collector ran out of time.
+	CategoryTimeout ErrorCategory = "timeout"
+
+	// CategoryCorruptRepo means the git repository could not be read.
+	CategoryCorruptRepo ErrorCategory = "corrupt-repo"
+
+	// CategoryInternal covers every other failure.
+	CategoryInternal ErrorCategory = "internal"
+)
+
+// ErrorsMetric is the ScanResult.Metrics key holding ErrorCounts. No
+// collector has this name.
+const ErrorsMetric = "collector-errors"
+
+// ErrorCategories lists the categories in the order outputs report them.
+var ErrorCategories = []ErrorCategory{CategoryAuth, CategoryNetwork, CategoryTimeout, CategoryCorruptRepo, CategoryInternal}
+
+// CollectorError is an error a collector has already classified. The
+// pipeline uses Category as is instead of inferring one from the message.
+type CollectorError struct {
+	Category ErrorCategory
+	Err      error
+}
+
+// NewCollectorError wraps err with a category. It returns nil for a nil err.
+func NewCollectorError(category ErrorCategory, err error) error {
+	if err == nil {
+		return nil
+	}
+	return &CollectorError{Category: category, Err: err}
+}
+
+func (e *CollectorError) Error() string { return e.Err.Error() }
+func (e *CollectorError) Unwrap() error { return e.Err }
+
+// CategoryOf returns the category err was wrapped with by
+// NewCollectorError, if any.
+func CategoryOf(err error) (ErrorCategory, bool) {
+	var ce *CollectorError
+	if errors.As(err, &ce) {
+		return ce.Category, true
+	}
+	return "", false
+}
+
+// Category returns the result's error category: ErrCategory, or
+// CategoryInternal for an error nothing classified. It is empty when the
+// collector succeeded.
+func (r CollectorResult) Category() ErrorCategory {
+	switch {
+	case r.Err == nil:
+		return ""
+	case r.ErrCategory == "":
+		return CategoryInternal
+	}
+	return r.ErrCategory
+}
+
+// ErrorCounts returns how many collectors failed in each category. It is
+// empty when every collector succeeded.
+func (r *ScanResult) ErrorCounts() map[ErrorCategory]int {
+	counts := make(map[ErrorCategory]int)
+	for _, cr := range r.Results {
+		if cr.Err != nil {
+			counts[cr.Category()]++
+		}
+	}
+	return counts
+}
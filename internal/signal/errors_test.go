@@ -0,0 +1,61 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package signal
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestNewCollectorError_Nil(t *testing.T) {
+	if err := NewCollectorError(CategoryAuth, nil); err != nil {
+		t.Errorf("NewCollectorError(nil) = %v, want nil", err)
+	}
+}
+
+func TestCategoryOf(t *testing.T) {
+	base := errors.New("401 Bad credentials")
+	wrapped := fmt.Errorf("listing issues: %w", NewCollectorError(CategoryAuth, base))
+
+	cat, ok := CategoryOf(wrapped)
+	if !ok || cat != CategoryAuth {
+		t.Errorf("CategoryOf = %q, %v; want %q, true", cat, ok, CategoryAuth)
+	}
+	if !errors.Is(wrapped, base) {
+		t.Error("CollectorError should unwrap to the original error")
+	}
+	if wrapped.Error() != "listing issues: 401 Bad credentials" {
+		t.Errorf("Error() = %q", wrapped.Error())
+	}
+
+	if _, ok := CategoryOf(base); ok {
+		t.Error("CategoryOf should report false for an unclassified error")
+	}
+}
+
+func TestScanResult_ErrorCounts(t *testing.T) {
+	r := &ScanResult{Results: []CollectorResult{
+		{Collector: "todos"},
+		{Collector: "github", Err: errors.New("bad credentials"), ErrCategory: CategoryAuth},
+		{Collector: "gitlab", Err: errors.New("bad credentials"), ErrCategory: CategoryAuth},
+		{Collector: "gitlog", Err: errors.New("boom")},
+	}}
+
+	counts := r.ErrorCounts()
+	if len(counts) != 2 {
+		t.Fatalf("ErrorCounts = %v, want 2 categories", counts)
+	}
+	if counts[CategoryAuth] != 2 {
+		t.Errorf("auth = %d, want 2", counts[CategoryAuth])
+	}
+	// An error without a category counts as internal.
+	if counts[CategoryInternal] != 1 {
+		t.Errorf("internal = %d, want 1", counts[CategoryInternal])
+	}
+
+	if got := (&ScanResult{}).ErrorCounts(); len(got) != 0 {
+		t.Errorf("ErrorCounts with no failures = %v, want empty", got)
+	}
+}
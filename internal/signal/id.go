@@ -0,0 +1,68 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package signal
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"regexp"
+)
+
+// Hash returns the stable content hash that identifies a signal across runs.
+//
+// It hashes these fields, in this order, separated by NUL bytes:
+//
+//	Source | Kind | FilePath | Line | Title
+//
+// where Title is OriginalTitle when set, so a title rewritten by LLM
+// enrichment keeps the ID of the collector's title, and passes through
+// StableTitle, so a signal whose title quotes a measurement ("45% covered",
+// "12 changes", "took 1.5s") keeps its ID as the measurement moves.
+//
+// using SHA-256, takes the first 4 bytes, and hex-encodes them (8 lowercase
+// hex chars). Signal IDs in every output format, baseline suppressions, scan
+// deltas, state files, and beads dedup are all derived from this hash.
+//
+// # Stability contract
+//
+// The hash is the join key that links a scanned signal to the beads issue,
+// baseline entry, or feedback record tracking it. Changing any of the
+// following breaks existing IDs silently:
+//
+//   - the set or order of hashed fields
+//   - the separator (NUL byte)
+//   - the hash algorithm or truncation length
+//   - the hex encoding case
+//
+// Treat the composition above as a fixed contract. If it ever needs to change,
+// ship both old and new hashes for a transition window and migrate callers
+// that persist IDs (the beads JSONL, baselines, report output). The regression
+// tests in id_test.go pin specific hash outputs — they will fail loudly on any
+// change here.
+func Hash(s RawSignal) string {
+	h := sha256.New()
+	// Write each field separated by null bytes to avoid collisions
+	// from field concatenation (e.g., "ab"+"c" vs "a"+"bc").
+	// sha256.Hash.Write never returns an error per the hash.Hash contract.
+	title := s.Title
+	if s.OriginalTitle != "" {
+		title = s.OriginalTitle
+	}
+	_, _ = fmt.Fprintf(h, "%s\x00%s\x00%s\x00%d\x00%s", s.Source, s.Kind, s.FilePath, s.Line, StableTitle(title))
+	sum := h.Sum(nil)
+	return fmt.Sprintf("%x", sum[:4])
+}
+
+// volatileNumber matches a number that starts a word, with any unit and
+// trailing parts of a duration: the counts, percentages, sizes, and times
+// titles quote ("12 changes", "(45% covered)", "took 1m2.5s"). Digits
+// inside an identifier, as in "v1.2.0", "#12", "/v2/", "CVE-2024-1234", or
+// "main.go:12", do not start a word and are kept.
+var volatileNumber = regexp.MustCompile(`(^|[\s(])\d+(?:[.,]\d+)*(?:[a-zµ]+\d+(?:\.\d+)*)*`)
+
+// StableTitle returns title with the volatile numbers in it replaced by
+// "#", the form signal identity and title matching use.
+func StableTitle(title string) string {
+	return volatileNumber.ReplaceAllString(title, "${1}#")
+}
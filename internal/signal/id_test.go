@@ -0,0 +1,80 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package signal
+
+import "testing"
+
+// TestHash_StabilityContract pins specific hash outputs. Do NOT update these
+// values without a planned migration path; see the stability contract on Hash.
+func TestHash_StabilityContract(t *testing.T) {
+	cases := map[string]struct {
+		sig  RawSignal
+		want string
+	}{
+		"simple":    {RawSignal{Source: "todos", Kind: "todo", FilePath: "main.go", Line: 42, Title: "Add tests"}, "5b5245ca"},
+		"empty":     {RawSignal{}, "c5c464c3"},
+		"unicode":   {RawSignal{Source: "patterns", Kind: "antipattern", FilePath: "internal/foo/bar.go", Line: 123, Title: "Complex function — 复杂"}, "e3e3ac3e"},
+		"zero_line": {RawSignal{Source: "todos", Kind: "todo", FilePath: "x.go", Title: "t"}, "ebd1a532"},
+		"measured":  {RawSignal{Source: "coverage", Kind: "low-coverage", FilePath: "a.go", Title: "Low test coverage: a.go (45% covered)"}, "9a91a57b"},
+	}
+	for name, tc := range cases {
+		if got := Hash(tc.sig); got != tc.want {
+			t.Errorf("%s: Hash = %q, want %q", name, got, tc.want)
+		}
+	}
+}
+
+func TestHash_IgnoresNonIdentityFields(t *testing.T) {
+	base := RawSignal{Source: "todos", Kind: "todo", FilePath: "main.go", Line: 42, Title: "Add tests"}
+	other := base
+	other.Description = "more context"
+	other.Confidence = 0.9
+	other.Author = "alice"
+	other.Tags = []string{"x"}
+	other.Workspace = "api"
+	if Hash(base) != Hash(other) {
+		t.Error("only Source, Kind, FilePath, Line, and Title should identify a signal")
+	}
+}
+
+func TestHash_UsesOriginalTitle(t *testing.T) {
+	base := RawSignal{Source: "todos", Kind: "todo", FilePath: "main.go", Line: 42, Title: "Add tests"}
+	enriched := base
+	enriched.OriginalTitle = base.Title
+	enriched.Title = "Add unit tests for the config loader"
+	if Hash(base) != Hash(enriched) {
+		t.Error("a rewritten title should keep the ID of the original title")
+	}
+}
+
+func TestHash_IgnoresVolatileNumbers(t *testing.T) {
+	same := [][2]string{
+		{"Low test coverage: a.go (45% covered)", "Low test coverage: a.go (52% covered)"},
+		{"Hotspot: a.go (12 changes, 3.4 KB)", "Hotspot: a.go (13 changes, 3.6 KB)"},
+		{"Workflow ci.yml disabled for 40 days", "Workflow ci.yml disabled for 41 days"},
+		{"Slow test: TestX took 1.2s (budget 500ms)", "Slow test: TestX took 1m2.5s (budget 500ms)"},
+		{"Breaking API change in x since v1.2.0: 3 removed, 1 changed", "Breaking API change in x since v1.2.0: 4 removed, 0 changed"},
+	}
+	for _, pair := range same {
+		a := RawSignal{Source: "s", Kind: "k", FilePath: "a.go", Title: pair[0]}
+		b := RawSignal{Source: "s", Kind: "k", FilePath: "a.go", Title: pair[1]}
+		if Hash(a) != Hash(b) {
+			t.Errorf("%q and %q should share an ID", pair[0], pair[1])
+		}
+	}
+
+	distinct := [][2]string{
+		{"GO-2024-1234 in golang.org/x/net", "GO-2024-5678 in golang.org/x/net"},
+		{"Review comment on PR #12: nit", "Review comment on PR #13: nit"},
+		{"Code uses /v2/ routes", "Code uses /v3/ routes"},
+		{"Breaking API change in x since v1.2.0", "Breaking API change in x since v1.3.0"},
+	}
+	for _, pair := range distinct {
+		a := RawSignal{Source: "s", Kind: "k", FilePath: "a.go", Title: pair[0]}
+		b := RawSignal{Source: "s", Kind: "k", FilePath: "a.go", Title: pair[1]}
+		if Hash(a) == Hash(b) {
+			t.Errorf("%q and %q should have different IDs", pair[0], pair[1])
+		}
+	}
+}
@@ -0,0 +1,50 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package signal
+
+import "strings"
+
+// PriorityFromConfidence maps a confidence score to a priority, P1 (most
+// urgent) to P4: >=0.8 -> P1, >=0.6 -> P2, >=0.4 -> P3, else P4. Every
+// output format, report, and state file uses this mapping, so a signal gets
+// the same priority wherever it appears.
+func PriorityFromConfidence(confidence float64) int {
+	switch {
+	case confidence >= 0.8:
+		return 1
+	case confidence >= 0.6:
+		return 2
+	case confidence >= 0.4:
+		return 3
+	default:
+		return 4
+	}
+}
+
+// EffectivePriority returns the signal's explicit priority, set by LLM
+// enrichment, the priority model, or a config rule, or else the one its
+// confidence maps to.
+func EffectivePriority(s RawSignal) int {
+	if s.Priority != nil {
+		return *s.Priority
+	}
+	return PriorityFromConfidence(s.Confidence)
+}
+
+// titleMarkers are the comment markers NormalizeTitle strips.
+var titleMarkers = []string{"todo:", "fixme:", "hack:", "xxx:", "bug:", "optimize:"}
+
+// NormalizeTitle returns the form of a title used to match signals against
+// existing issues and earlier scans: lowercased, trimmed, with volatile
+// numbers masked as StableTitle does, and without a leading TODO:, FIXME:,
+// HACK:, XXX:, BUG:, or OPTIMIZE: marker.
+func NormalizeTitle(title string) string {
+	t := StableTitle(strings.ToLower(strings.TrimSpace(title)))
+	for _, marker := range titleMarkers {
+		if strings.HasPrefix(t, marker) {
+			return strings.TrimSpace(strings.TrimPrefix(t, marker))
+		}
+	}
+	return t
+}
@@ -0,0 +1,64 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package signal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPriorityFromConfidence(t *testing.T) {
+	cases := []struct {
+		confidence float64
+		want       int
+	}{
+		{1.0, 1},
+		{0.9, 1},
+		{0.8, 1},
+		{0.79, 2},
+		{0.7, 2},
+		{0.6, 2},
+		{0.59, 3},
+		{0.5, 3},
+		{0.4, 3},
+		{0.39, 4},
+		{0.2, 4},
+		{0.0, 4},
+	}
+	for _, tc := range cases {
+		assert.Equal(t, tc.want, PriorityFromConfidence(tc.confidence), "confidence %v", tc.confidence)
+	}
+}
+
+func TestEffectivePriority(t *testing.T) {
+	p := 4
+	assert.Equal(t, 1, EffectivePriority(RawSignal{Confidence: 0.9}))
+	assert.Equal(t, 4, EffectivePriority(RawSignal{Confidence: 0.9, Priority: &p}), "an explicit priority wins")
+}
+
+func TestNormalizeTitle(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"  Fix This  ", "fix this"},
+		{"TODO: add feature", "add feature"},
+		{"FIXME: broken test", "broken test"},
+		{"HACK: workaround", "workaround"},
+		{"XXX: danger zone", "danger zone"},
+		{"BUG: null pointer", "null pointer"},
+		{"OPTIMIZE: slow query", "slow query"},
+		{"todo: Add Feature", "add feature"},
+		{"Regular title", "regular title"},
+		{"", ""},
+		{"TODO:", ""},
+		{"Low test coverage: a.go (45% covered)", "low test coverage: a.go (#% covered)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			assert.Equal(t, tt.want, NormalizeTitle(tt.input))
+		})
+	}
+}
@@ -0,0 +1,211 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package signal
+
+import (
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// Scope restricts a scan to part of the tree. Paths are relative to the scan
+// root and may be files, directories, or globs ("internal/**", "*.go"). The
+// zero value covers the whole tree.
+type Scope struct {
+	// Paths limits the scan to these files, directories, or globs. Empty
+	// means the whole tree.
+	Paths []string
+
+	// MaxDepth limits how many path segments below each scope path are
+	// scanned, like find -maxdepth: 1 covers only the entries directly
+	// inside it. 0 means unlimited.
+	MaxDepth int
+}
+
+// NewScope normalizes paths to clean, slash-separated form. Entries that name
+// the scan root itself (".", "./") are dropped since they cover everything.
+func NewScope(paths []string, maxDepth int) Scope {
+	s := Scope{MaxDepth: maxDepth}
+	for _, p := range paths {
+		p = path.Clean(filepath.ToSlash(strings.TrimSpace(p)))
+		if p == "." || p == "/" {
+			continue
+		}
+		s.Paths = append(s.Paths, strings.TrimPrefix(p, "./"))
+	}
+	return s
+}
+
+// NewFileScope returns a scope of exactly the given files, such as a list
+// of changed files. Unlike NewScope, glob metacharacters in
+// them match only themselves, so a route like "app/[id]/page.tsx" is a
+// path, not a pattern.
+func NewFileScope(paths []string) Scope {
+	s := NewScope(paths, 0)
+	for i, p := range s.Paths {
+		if hasGlobMeta(p) {
+			s.Paths[i] = globEscaper.Replace(p)
+		}
+	}
+	return s
+}
+
+// globEscaper quotes the characters path.Match treats specially.
+var globEscaper = strings.NewReplacer(`\`, `\\`, "*", `\*`, "?", `\?`, "[", `\[`)
+
+// IsZero reports whether the scope covers the whole tree.
+func (s Scope) IsZero() bool {
+	return len(s.Paths) == 0 && s.MaxDepth <= 0
+}
+
+// Contains reports whether relPath, a file or directory relative to the scan
+// root, is inside the scope.
+func (s Scope) Contains(relPath string) bool {
+	if s.IsZero() {
+		return true
+	}
+	rel := cleanRel(relPath)
+	for _, p := range s.roots() {
+		if !scopeMatch(p, rel) {
+			continue
+		}
+		if s.withinDepth(segments(rel) - segments(literalPrefix(p))) {
+			return true
+		}
+	}
+	return false
+}
+
+// ContainsFrom reports whether relPath, relative to an ancestor of the scan
+// root such as the git root, is inside the scope. root is the scan root
+// relative to that ancestor; paths outside it are outside a non-zero scope.
+func (s Scope) ContainsFrom(root, relPath string) bool {
+	if s.IsZero() {
+		return true
+	}
+	root = cleanRel(root)
+	if root == "." {
+		return s.Contains(relPath)
+	}
+	rel := cleanRel(relPath)
+	if !isUnder(rel, root) {
+		return false
+	}
+	return s.Contains(strings.TrimPrefix(strings.TrimPrefix(rel, root), "/"))
+}
+
+// ScanRoot returns repoPath relative to gitRoot in slash form, the root to
+// pass to Scope.ContainsFrom for git-root-relative paths. It returns "" when
+// they are the same directory or repoPath is not inside gitRoot.
+func ScanRoot(gitRoot, repoPath string) string {
+	if gitRoot == "" || repoPath == "" {
+		return ""
+	}
+	rel, err := filepath.Rel(gitRoot, repoPath)
+	if err != nil || rel == "." || strings.HasPrefix(rel, "..") {
+		return ""
+	}
+	return filepath.ToSlash(rel)
+}
+
+// ContainsDir reports whether a walker should descend into relDir: either
+// the directory leads to a scope path or entries inside it can be in scope.
+func (s Scope) ContainsDir(relDir string) bool {
+	if s.IsZero() {
+		return true
+	}
+	rel := cleanRel(relDir)
+	if rel == "." {
+		return true
+	}
+	for _, p := range s.roots() {
+		base := literalPrefix(p)
+		if base != "" && isUnder(base, rel) && base != rel {
+			return true // relDir is an ancestor of the scope path
+		}
+		if !isUnder(rel, base) {
+			continue
+		}
+		// Entries inside relDir sit one level deeper than relDir itself.
+		if s.MaxDepth <= 0 || segments(rel)-segments(base) < s.MaxDepth {
+			return true
+		}
+	}
+	return false
+}
+
+// roots returns the scope paths, or the scan root when none are set.
+func (s Scope) roots() []string {
+	if len(s.Paths) == 0 {
+		return []string{""}
+	}
+	return s.Paths
+}
+
+// withinDepth reports whether depth is allowed by MaxDepth.
+func (s Scope) withinDepth(depth int) bool {
+	return s.MaxDepth <= 0 || depth <= s.MaxDepth
+}
+
+// scopeMatch reports whether rel is p or lies below it. Glob entries match by
+// pattern; a pattern without a slash matches the base name at any depth, and
+// "dir/**" matches everything below dir.
+func scopeMatch(p, rel string) bool {
+	if !hasGlobMeta(p) {
+		return isUnder(rel, p)
+	}
+	if matched, err := path.Match(p, rel); err == nil && matched {
+		return true
+	}
+	if !strings.Contains(p, "/") {
+		matched, err := path.Match(p, path.Base(rel))
+		return err == nil && matched
+	}
+	if strings.HasSuffix(p, "/**") {
+		dir := strings.TrimSuffix(p, "/**")
+		if !hasGlobMeta(dir) {
+			return isUnder(rel, dir)
+		}
+	}
+	return false
+}
+
+// literalPrefix returns the leading segments of p that contain no glob
+// metacharacters. Depth is measured from there.
+func literalPrefix(p string) string {
+	if p == "" || !hasGlobMeta(p) {
+		return p
+	}
+	parts := strings.Split(p, "/")
+	var literal []string
+	for _, part := range parts {
+		if hasGlobMeta(part) {
+			break
+		}
+		literal = append(literal, part)
+	}
+	return strings.Join(literal, "/")
+}
+
+// isUnder reports whether rel equals dir or is below it. The empty dir is
+// the scan root, which contains everything.
+func isUnder(rel, dir string) bool {
+	return dir == "" || rel == dir || strings.HasPrefix(rel, dir+"/")
+}
+
+// segments counts the path segments in rel; the scan root has none.
+func segments(rel string) int {
+	if rel == "" || rel == "." {
+		return 0
+	}
+	return strings.Count(rel, "/") + 1
+}
+
+func hasGlobMeta(p string) bool {
+	return strings.ContainsAny(p, "*?[")
+}
+
+func cleanRel(rel string) string {
+	return path.Clean(filepath.ToSlash(rel))
+}
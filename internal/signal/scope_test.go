@@ -0,0 +1,112 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package signal
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNewScope(t *testing.T) {
+	s := NewScope([]string{"./internal/collectors/", " cmd ", ".", "a/../b"}, 2)
+	want := []string{"internal/collectors", "cmd", "b"}
+	if !reflect.DeepEqual(s.Paths, want) {
+		t.Errorf("Paths = %v, want %v", s.Paths, want)
+	}
+	if s.MaxDepth != 2 {
+		t.Errorf("MaxDepth = %d, want 2", s.MaxDepth)
+	}
+	if !NewScope([]string{"."}, 0).IsZero() {
+		t.Error("a scope of only the root should be zero")
+	}
+}
+
+func TestScopeContains(t *testing.T) {
+	tests := []struct {
+		name  string
+		scope Scope
+		path  string
+		want  bool
+	}{
+		{"zero scope", Scope{}, "any/file.go", true},
+		{"dir prefix", NewScope([]string{"internal/collectors"}, 0), "internal/collectors/todos.go", true},
+		{"dir itself", NewScope([]string{"internal/collectors"}, 0), "internal/collectors", true},
+		{"sibling with shared prefix", NewScope([]string{"internal/collectors"}, 0), "internal/collectorsx/a.go", false},
+		{"ancestor dir", NewScope([]string{"internal/collectors"}, 0), "internal", false},
+		{"root", NewScope([]string{"internal"}, 0), ".", false},
+		{"exact file", NewScope([]string{"src/main.go"}, 0), "src/main.go", true},
+		{"double-star glob", NewScope([]string{"foo/**"}, 0), "foo/bar/baz.go", true},
+		{"double-star glob miss", NewScope([]string{"foo/**"}, 0), "baz/qux.go", false},
+		{"base-name glob", NewScope([]string{"*.go"}, 0), "a/b/c.go", true},
+		{"path glob", NewScope([]string{"cmd/*/main.go"}, 0), "cmd/tool/main.go", true},
+		{"root depth 1", NewScope(nil, 1), "main.go", true},
+		{"root depth 1 nested", NewScope(nil, 1), "pkg/main.go", false},
+		{"depth below path", NewScope([]string{"internal"}, 1), "internal/a.go", true},
+		{"too deep below path", NewScope([]string{"internal"}, 1), "internal/x/a.go", false},
+		{"depth below glob prefix", NewScope([]string{"internal/**"}, 2), "internal/x/a.go", true},
+		{"file with brackets", NewFileScope([]string{"app/[id]/page.tsx"}), "app/[id]/page.tsx", true},
+		{"file with brackets is not a class", NewFileScope([]string{"app/[id]/page.tsx"}), "app/i/page.tsx", false},
+		{"file with star", NewFileScope([]string{"docs/*.md"}), "docs/a.md", false},
+		{"plain file scope", NewFileScope([]string{"src/main.go"}), "src/main.go", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.scope.Contains(tt.path); got != tt.want {
+				t.Errorf("Contains(%q) = %v, want %v", tt.path, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScopeContainsFrom(t *testing.T) {
+	scope := NewScope([]string{"internal"}, 0)
+	tests := []struct {
+		root, path string
+		want       bool
+	}{
+		{"", "internal/a.go", true},
+		{".", "cmd/a.go", false},
+		{"sub", "sub/internal/a.go", true},
+		{"sub", "sub/cmd/a.go", false},
+		{"sub", "internal/a.go", false},
+		{"sub", "subx/internal/a.go", false},
+	}
+	for _, tt := range tests {
+		if got := scope.ContainsFrom(tt.root, tt.path); got != tt.want {
+			t.Errorf("ContainsFrom(%q, %q) = %v, want %v", tt.root, tt.path, got, tt.want)
+		}
+	}
+	if !(Scope{}).ContainsFrom("sub", "other/a.go") {
+		t.Error("the zero scope should contain every path")
+	}
+}
+
+func TestScopeContainsDir(t *testing.T) {
+	tests := []struct {
+		name  string
+		scope Scope
+		dir   string
+		want  bool
+	}{
+		{"root always", NewScope([]string{"internal/collectors"}, 1), ".", true},
+		{"ancestor of path", NewScope([]string{"internal/collectors"}, 0), "internal", true},
+		{"the path itself", NewScope([]string{"internal/collectors"}, 0), "internal/collectors", true},
+		{"below the path", NewScope([]string{"internal/collectors"}, 0), "internal/collectors/testdata", true},
+		{"unrelated", NewScope([]string{"internal/collectors"}, 0), "cmd", false},
+		{"ancestor of file", NewScope([]string{"src/main.go"}, 0), "src", true},
+		{"max depth stops descent", NewScope([]string{"internal"}, 1), "internal/x", false},
+		{"max depth allows path", NewScope([]string{"internal"}, 1), "internal", true},
+		{"root max depth", NewScope(nil, 2), "pkg", true},
+		{"root max depth stops", NewScope(nil, 2), "pkg/sub", false},
+		{"base-name glob", NewScope([]string{"*.go"}, 0), "deep/dir", true},
+		{"glob prefix", NewScope([]string{"foo/**"}, 0), "bar", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.scope.ContainsDir(tt.dir); got != tt.want {
+				t.Errorf("ContainsDir(%q) = %v, want %v", tt.dir, got, tt.want)
+			}
+		})
+	}
+}
@@ -4,7 +4,11 @@
 // Package signal defines the core domain types for stringer.
 package signal
 
-import "time"
+import (
+	"io/fs"
+	"math"
+	"time"
+)
 
 // ErrorMode controls how the pipeline handles errors from a collector.
 type ErrorMode string
@@ -36,7 +40,50 @@ type RawSignal struct {
 	Priority    *int      // LLM-inferred priority (1-4). Nil = use confidence mapping.
 	Blocks      []string  // Bead IDs this signal blocks (downstream depends on this).
 	DependsOn   []string  // Bead IDs this signal depends on (upstream blockers).
+	Children    []string  // Bead IDs grouped under this umbrella signal (epics).
 	Workspace   string    `json:"workspace,omitempty"` // Monorepo workspace name (empty for non-monorepo).
+	URL         string    `json:"url,omitempty"`       // Forge link to the file/line at the scanned commit, or to the issue/PR.
+	Effort      string    `json:"effort,omitempty"`    // Rough effort bucket: "S", "M", or "L" (empty if not estimated).
+	Owners      []string  `json:"owners,omitempty"`    // CODEOWNERS owners of FilePath (users, teams, or emails).
+
+	// Repo is the repository the signal came from in a multi-repo scan,
+	// e.g. "acme/api". FilePath then starts with it.
+	Repo string `json:"repo,omitempty"`
+
+	// OriginalTitle is the collector's title when LLM enrichment rewrote
+	// Title. The signal's ID is still computed from it.
+	OriginalTitle string `json:"original_title,omitempty"`
+
+	// References lists what a TODO points at, as written or resolved:
+	// issues ("#123"), tracker keys ("PROJ-456"), and repository files
+	// ("internal/auth/token.go:42").
+	References []string `json:"references,omitempty"`
+
+	// FunctionOwner is the author of most lines of the function enclosing
+	// a TODO, who may still be around when Author has left. Set only when
+	// function-level blame is enabled.
+	FunctionOwner string `json:"function_owner,omitempty"`
+
+	// Factors records how Confidence was reached, in order: the
+	// collector's base score, then each later adjustment.
+	Factors []ConfidenceFactor `json:"factors,omitempty"`
+}
+
+// ConfidenceFactor is one step in how a signal's confidence was computed.
+type ConfidenceFactor struct {
+	Name   string  `json:"name"`   // Short label: "base", "recency", "co-location", etc.
+	Delta  float64 `json:"delta"`  // Change in confidence; the score itself for "base".
+	Detail string  `json:"detail"` // Why the step applied.
+}
+
+// AddFactor records a step in how the signal's confidence was computed.
+// Delta is rounded to two decimals, the precision confidence is shown at.
+func (s *RawSignal) AddFactor(name string, delta float64, detail string) {
+	s.Factors = append(s.Factors, ConfidenceFactor{
+		Name:   name,
+		Delta:  math.Round(delta*100) / 100,
+		Detail: detail,
+	})
 }
 
 // SecretPatternConfig holds a user-defined secret pattern for config wiring.
@@ -48,6 +95,15 @@ type SecretPatternConfig struct {
 	Keywords   []string
 }
 
+// DeprecatedAPIConfig is a user-listed deprecated API for the deprecation
+// collector: a Go symbol written "import/path.Name", or a regular
+// expression matched against each line of every text file.
+type DeprecatedAPIConfig struct {
+	Symbol      string
+	Pattern     string
+	Replacement string
+}
+
 // CollectorOpts holds per-collector configuration options.
 type CollectorOpts struct {
 	// MinConfidence filters signals below this threshold.
@@ -59,6 +115,10 @@ type CollectorOpts struct {
 	// ExcludePatterns skips files matching these globs.
 	ExcludePatterns []string
 
+	// Scope restricts collection to part of the tree (--paths, --max-depth).
+	// The pipeline fills it from ScanConfig.Scope when unset.
+	Scope Scope
+
 	// ErrorMode controls how errors from this collector are handled.
 	// Default (zero value or empty string) is treated as ErrorModeWarn.
 	ErrorMode ErrorMode
@@ -77,9 +137,22 @@ type CollectorOpts struct {
 	// GitSince limits commit walking to commits after this duration (e.g., "90d", "6m", "1y").
 	GitSince string
 
+	// GitHubRepo names the GitHub repository ("owner/name") the github
+	// collector reads, in place of the origin remote of GitRoot. Set by
+	// scan --remote, whose files come from a tarball without a remote.
+	GitHubRepo string
+
 	// ProgressFunc is called periodically with status messages during long operations.
 	ProgressFunc func(msg string)
 
+	// Cache replays per-file results from an earlier scan. Nil when the
+	// cache is disabled.
+	Cache FileCache
+
+	// Ignore reports the paths git ignores, which the collectors walking
+	// source files skip. Nil when gitignore handling is disabled.
+	Ignore IgnoreMatcher
+
 	// IncludeClosed includes closed/merged issues and PRs in the GitHub collector.
 	IncludeClosed bool
 
@@ -97,6 +170,11 @@ type CollectorOpts struct {
 	// 0 uses the collector default.
 	MaxIssues int
 
+	// LargeBatchThreshold is the median merged-PR size, in changed lines,
+	// above which the GitHub collector emits a large-batch-pattern signal for
+	// a module. 0 uses the collector default.
+	LargeBatchThreshold int
+
 	// Timeout is the per-collector timeout. 0 means no timeout.
 	Timeout time.Duration
 
@@ -168,6 +246,94 @@ type CollectorOpts struct {
 	// TestRatioMinFiles overrides the minimum number of source files a directory
 	// must contain before reporting a low-test-ratio signal. 0 uses default (3).
 	TestRatioMinFiles int
+
+	// MaxFileSize caps the bytes of a single file read by line scanners
+	// (todos, patterns). Larger files are scanned partially and their
+	// signals tagged truncated-scan. 0 uses default (10 MiB).
+	MaxFileSize int64
+
+	// FileTimeout bounds the time spent scanning a single file in line
+	// scanners. 0 uses default (10s).
+	FileTimeout time.Duration
+
+	// TestReports lists go test -json or JUnit XML report paths (globs
+	// allowed, relative to the repo) ingested by the testtiming collector.
+	TestReports []string
+
+	// SlowTestThreshold is the default latency budget for a single test.
+	// 0 uses default (2s).
+	SlowTestThreshold time.Duration
+
+	// SlowTestBudgets overrides SlowTestThreshold per package. A key applies
+	// to the package it names and every package beneath it.
+	SlowTestBudgets map[string]time.Duration
+
+	// LintReports lists go vet -json or staticcheck -f json output paths
+	// (globs allowed, relative to the repo) ingested by the lint collector.
+	LintReports []string
+
+	// LintTools lists the linters the lint collector runs itself: "vet",
+	// "staticcheck", or both. Empty runs none.
+	LintTools []string
+
+	// LintReportDir is the directory, relative to the repo, whose ESLint,
+	// Ruff, Clippy, and SARIF reports the lint-report collector ingests.
+	// Empty uses the default (.stringer/lint-reports), which may be absent.
+	LintReportDir string
+
+	// CoverageReports lists Go cover profile, LCOV, or Cobertura XML paths
+	// (globs allowed, relative to the repo) read by the coverage collector.
+	CoverageReports []string
+
+	// CoverageThreshold is the coverage ratio under which the coverage
+	// collector flags a file. 0 uses the default (0.5).
+	CoverageThreshold float64
+
+	// DeprecatedAPIs lists APIs the deprecation collector reports uses of,
+	// in addition to Go identifiers documented as deprecated.
+	DeprecatedAPIs []DeprecatedAPIConfig
+
+	// BenchBase is the commit or ref whose recorded benchmark results the
+	// bench collector compares HEAD's with. Empty uses the nearest
+	// first-parent ancestor with recorded results.
+	BenchBase string
+
+	// BenchThreshold is the relative growth the bench collector reports as
+	// a regression. 0 uses the default (0.10).
+	BenchThreshold float64
+
+	// ArchitectureRules is the path, relative to the repo, of the rules
+	// file checked by the architecture collector. Empty uses the default
+	// (.stringer/architecture.yaml), which may be absent.
+	ArchitectureRules string
+
+	// FunctionBlame makes the TODO collector blame the whole function
+	// enclosing each TODO and record its dominant author in FunctionOwner.
+	FunctionBlame bool
+
+	// Identities maps a canonical author name to the other names and email
+	// addresses the same person has committed under. Applied on top of the
+	// repository's .mailmap when aggregating authors.
+	Identities map[string][]string
+}
+
+// FileCache stores per-file collector results between scans, so files that
+// have not changed need not be read again. Entries are keyed by collector
+// name and path relative to the scanned directory, and are valid while the
+// file's size and modification time match info.
+type FileCache interface {
+	// Get decodes the stored result into v and reports whether there was a
+	// valid one.
+	Get(collector, relPath string, info fs.FileInfo, v any) bool
+
+	// Put stores v as the result for relPath.
+	Put(collector, relPath string, info fs.FileInfo, v any)
+}
+
+// IgnoreMatcher reports whether git ignores a path, given as the walk
+// visits it: joined onto the scanned directory.
+type IgnoreMatcher interface {
+	Ignored(path string, isDir bool) bool
 }
 
 // ScanConfig holds the overall configuration for a scan operation.
@@ -190,8 +356,30 @@ type ScanConfig struct {
 	// ExcludePatterns holds global exclude globs applied to all collectors.
 	ExcludePatterns []string
 
+	// Scope restricts every collector to part of the tree. Signals whose
+	// FilePath falls outside it are dropped after collection.
+	Scope Scope
+
 	// MaxIssues caps the number of output issues (0 = unlimited).
 	MaxIssues int
+
+	// FunctionBlame makes the TODO collector blame the whole function
+	// enclosing each TODO and record its dominant author in FunctionOwner.
+	FunctionBlame bool
+
+	// Identities maps a canonical author name to its aliases. It is passed
+	// to every collector that does not set its own.
+	Identities map[string][]string
+
+	// NoCache disables the per-file scan cache, so every file is read again.
+	NoCache bool
+
+	// NoGitignore makes collectors walk the files .gitignore and
+	// .git/info/exclude list, which they skip by default.
+	NoGitignore bool
+
+	// Jobs caps how many collectors run at once. 0 runs them all at once.
+	Jobs int
 }
 
 // CollectorResult holds the output from a single collector run.
@@ -202,12 +390,35 @@ type CollectorResult struct {
 	// Signals are the raw signals extracted.
 	Signals []RawSignal
 
+	// Streamed is how many signals the collector sent down a streaming
+	// pipeline. Signals is empty in a streamed scan; they are not kept.
+	Streamed int
+
 	// Duration is how long the collector took.
 	Duration time.Duration
 
 	// Err is any error encountered during collection.
 	Err error
 
+	// ErrCategory classifies Err. Empty when Err is nil.
+	ErrCategory ErrorCategory
+
+	// SkipReason explains why the collector was disabled by the capability
+	// check phase (e.g. "no token"). Empty when the collector ran.
+	SkipReason string
+
+	// RetryReason is the error of a first attempt that failed transiently
+	// and was retried. Empty when the collector ran once.
+	RetryReason string
+
+	// Warnings are the warnings the collector logged while it ran, as
+	// "message (key=value, ...)". The scan summary reports them.
+	Warnings []string
+
+	// Resumed is true when the result was restored from the checkpoint of
+	// an interrupted scan instead of running the collector again.
+	Resumed bool
+
 	// Metrics holds optional structured data from collectors that implement
 	// the MetricsProvider interface. Nil if the collector does not provide metrics.
 	Metrics any
@@ -225,6 +436,12 @@ type ScanResult struct {
 	Duration time.Duration
 
 	// Metrics maps collector names to their structured metrics. Only populated
-	// for collectors that implement the MetricsProvider interface.
+	// for collectors that implement the MetricsProvider interface, plus
+	// ErrorsMetric when a collector failed.
 	Metrics map[string]any
+
+	// StoppedEarly is set when a stop condition or time budget ended the
+	// scan before every collector finished (see pipeline.Pipeline.StopWhen
+	// and StopAfter).
+	StoppedEarly bool
 }
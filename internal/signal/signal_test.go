@@ -31,3 +31,17 @@ func TestCollectorResultTracksError(t *testing.T) {
 		t.Errorf("expected nil error, got %v", r.Err)
 	}
 }
+
+func TestRawSignalAddFactor(t *testing.T) {
+	var s RawSignal
+	s.AddFactor("base", 0.5, "TODO keyword")
+	s.AddFactor("co-location", 0.1+0.05-0.05, "shares a file with a churn signal")
+
+	if len(s.Factors) != 2 {
+		t.Fatalf("len(Factors) = %d, want 2", len(s.Factors))
+	}
+	want := ConfidenceFactor{Name: "co-location", Delta: 0.1, Detail: "shares a file with a churn signal"}
+	if s.Factors[1] != want {
+		t.Errorf("Factors[1] = %+v, want %+v", s.Factors[1], want)
+	}
+}
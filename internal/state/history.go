@@ -13,6 +13,7 @@ import (
 	"time"
 
 	"github.com/davetashner/stringer/internal/signal"
+	"github.com/davetashner/stringer/internal/statedir"
 )
 
 // historyFile is the filename for scan history.
@@ -31,6 +32,16 @@ type HistoryEntry struct {
 	TotalSignals    int            `json:"total_signals"`
 	CollectorCounts map[string]int `json:"collector_counts"`
 	KindCounts      map[string]int `json:"kind_counts"`
+
+	// DebtScore is the priority-weighted signal total (see DebtScore).
+	// Entries written before it was recorded have none.
+	DebtScore int `json:"debt_score,omitempty"`
+}
+
+// hasDebtScore reports whether the entry recorded a debt score. Only a scan
+// without signals has a true score of zero.
+func (e HistoryEntry) hasDebtScore() bool {
+	return e.DebtScore > 0 || e.TotalSignals == 0
 }
 
 // ScanHistory stores a time-series of scan summary entries.
@@ -79,6 +90,7 @@ func SaveHistoryWorkspace(repoPath, workspace string, h *ScanHistory) error {
 	if err := FS.MkdirAll(dir, 0o750); err != nil {
 		return fmt.Errorf("create state directory: %w", err)
 	}
+	statedir.EnsureIgnore(FS, repoPath)
 
 	data, err := json.MarshalIndent(h, "", "  ")
 	if err != nil {
@@ -132,7 +144,23 @@ func BuildHistoryEntry(repoPath string, result *signal.ScanResult) HistoryEntry
 		TotalSignals:    len(result.Signals),
 		CollectorCounts: sortedCollector,
 		KindCounts:      sortedKind,
+		DebtScore:       DebtScore(result.Signals),
+	}
+}
+
+// DebtScore weighs each signal by priority — 4 for P1 down to 1 for P4,
+// the weights of the report's module health scores — and sums them. A
+// signal's explicit priority wins over the one its confidence implies.
+func DebtScore(signals []signal.RawSignal) int {
+	score := 0
+	for _, sig := range signals {
+		p := signal.PriorityFromConfidence(sig.Confidence)
+		if sig.Priority != nil && *sig.Priority >= 1 && *sig.Priority <= 4 {
+			p = *sig.Priority
+		}
+		score += 5 - p
 	}
+	return score
 }
 
 // historyPath returns the full path to the history file for a workspace.
@@ -160,9 +160,23 @@ func TestBuildHistoryEntry(t *testing.T) {
 	assert.Equal(t, 3, entry.TotalSignals)
 	assert.Equal(t, map[string]int{"todos": 2, "gitlog": 1}, entry.CollectorCounts)
 	assert.Equal(t, map[string]int{"todo": 1, "fixme": 1, "churn": 1}, entry.KindCounts)
+	assert.Equal(t, 3, entry.DebtScore, "three zero-confidence signals are P4")
 	assert.False(t, entry.Timestamp.IsZero())
 }
 
+func TestDebtScore(t *testing.T) {
+	p2 := 2
+	signals := []signal.RawSignal{
+		{Confidence: 0.9},                // P1: 4
+		{Confidence: 0.65},               // P2: 3
+		{Confidence: 0.5},                // P3: 2
+		{Confidence: 0.1},                // P4: 1
+		{Confidence: 0.1, Priority: &p2}, // explicit P2: 3
+	}
+	assert.Equal(t, 13, DebtScore(signals))
+	assert.Zero(t, DebtScore(nil))
+}
+
 func TestHistoryFile_JSONFormat(t *testing.T) {
 	dir := t.TempDir()
 	h := &ScanHistory{
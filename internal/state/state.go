@@ -21,11 +21,12 @@ import (
 
 	"github.com/davetashner/stringer/internal/pipeline"
 	"github.com/davetashner/stringer/internal/signal"
+	"github.com/davetashner/stringer/internal/statedir"
 	"github.com/davetashner/stringer/internal/testable"
 )
 
 // stateDir is the directory name within a repo where state is stored.
-const stateDir = ".stringer"
+const stateDir = statedir.Name
 
 // stateFile is the filename for scan state.
 const stateFile = "last-scan.json"
@@ -117,6 +118,7 @@ func SaveWorkspace(repoPath, workspace string, s *ScanState) error {
 	if err := FS.MkdirAll(dir, 0o750); err != nil {
 		return fmt.Errorf("create state directory: %w", err)
 	}
+	statedir.EnsureIgnore(FS, repoPath)
 
 	data, err := json.MarshalIndent(s, "", "  ")
 	if err != nil {
@@ -54,6 +54,8 @@ func TestSave_CreatesDirectory(t *testing.T) {
 	info, err := os.Stat(filepath.Join(dir, ".stringer", "last-scan.json"))
 	require.NoError(t, err)
 	assert.False(t, info.IsDir())
+	_, err = os.Stat(filepath.Join(dir, ".stringer", ".gitignore"))
+	assert.NoError(t, err, "state directory is kept out of git")
 }
 
 func TestSave_Load_RoundTrip(t *testing.T) {
@@ -82,6 +82,26 @@ func ComputeTrends(h *ScanHistory, windowSize int) *TrendResult {
 	return result
 }
 
+// DebtTrend compares the debt score of the newest history entry with the
+// newest earlier entry that recorded one. Returns nil when there is no such
+// pair.
+func DebtTrend(h *ScanHistory) *TrendLine {
+	if h == nil || len(h.Entries) < 2 {
+		return nil
+	}
+	newest := h.Entries[len(h.Entries)-1]
+	if !newest.hasDebtScore() {
+		return nil
+	}
+	for i := len(h.Entries) - 2; i >= 0; i-- {
+		if prev := h.Entries[i]; prev.hasDebtScore() {
+			tl := computeTrendLine(prev.DebtScore, newest.DebtScore)
+			return &tl
+		}
+	}
+	return nil
+}
+
 // computeTrendLine determines direction from old→new using a 10% deadband.
 // For signal counts, fewer signals = improving (work items resolved).
 func computeTrendLine(oldVal, newVal int) TrendLine {
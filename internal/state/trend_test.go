@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestComputeTrends_NilHistory(t *testing.T) {
@@ -235,3 +236,28 @@ func TestClassifyDirection(t *testing.T) {
 		})
 	}
 }
+
+func TestDebtTrend(t *testing.T) {
+	assert.Nil(t, DebtTrend(nil))
+	assert.Nil(t, DebtTrend(&ScanHistory{Entries: []HistoryEntry{{TotalSignals: 3, DebtScore: 6}}}))
+
+	h := &ScanHistory{Entries: []HistoryEntry{
+		{TotalSignals: 5, DebtScore: 20},
+		{TotalSignals: 9}, // written before debt scores were recorded
+		{TotalSignals: 4, DebtScore: 12},
+	}}
+	tl := DebtTrend(h)
+	require.NotNil(t, tl)
+	assert.Equal(t, 20, tl.Previous)
+	assert.Equal(t, 12, tl.Current)
+	assert.Equal(t, -8, tl.Delta)
+	assert.Equal(t, Improving, tl.Direction)
+
+	h.Entries = append(h.Entries, HistoryEntry{TotalSignals: 7})
+	assert.Nil(t, DebtTrend(h), "the newest entry has no score")
+
+	h.Entries = []HistoryEntry{{TotalSignals: 0}, {TotalSignals: 2, DebtScore: 8}}
+	tl = DebtTrend(h)
+	require.NotNil(t, tl, "an empty scan scores zero")
+	assert.Equal(t, Degrading, tl.Direction)
+}
@@ -0,0 +1,82 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+// Package statedir keeps stringer's local state out of git. Scan state,
+// scan history, the scan and OSV caches, the blame index, benchmark
+// results, and the checkpoint of an interrupted scan are written under
+// .stringer/, next to files teams do commit (baseline.json, feedback.jsonl,
+// architecture.yaml), so the directory gets a .gitignore listing only the
+// local files.
+package statedir
+
+import (
+	"errors"
+	"io/fs"
+	"log/slog"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/davetashner/stringer/internal/testable"
+)
+
+// Name is the directory stringer keeps its files in, relative to the
+// scanned repository.
+const Name = ".stringer"
+
+// LocalFiles are the files stringer writes under Name that belong to one
+// checkout: they record what was found when, the scan cache mirrors the
+// working tree, the OSV cache ages out in a day, the LLM cache quotes
+// signal text, the blame index carries author names and emails, and
+// benchmark timings only mean something on the machine that recorded them,
+// a checkpoint holds the results of a scan that has not finished, and
+// the record of rules outputs names files written in this checkout.
+// An entry ending in a slash is a directory. Any workspace subdirectory
+// holds the same files.
+var LocalFiles = []string{"last-scan.json", "scan-history.json", "blame-index.json.gz", "scan-cache.json.gz", "osv-cache.json", "llm-cache.json", "history.jsonl", "bench/", "checkpoint.jsonl", "rule-outputs.json"}
+
+// ignoreHeader starts the .gitignore stringer writes; one that starts
+// with anything else was written by someone else.
+const ignoreHeader = "# Written by stringer."
+
+// ignoreContent is written to .stringer/.gitignore.
+var ignoreContent = ignoreHeader + " Scan state, history, and the blame index are local\n" +
+	"# to this checkout and would leak author identities if committed.\n" +
+	"# baseline.json, feedback.jsonl, and architecture.yaml are meant to be committed.\n" +
+	strings.Join(LocalFiles, "\n") + "\n"
+
+// IsLocal reports whether relPath (slash-separated) is one of the
+// LocalFiles inside a .stringer directory, or inside one of its local
+// directories.
+func IsLocal(relPath string) bool {
+	dir, base := path.Split(relPath)
+	if !strings.HasPrefix(dir, Name+"/") && !strings.Contains(dir, "/"+Name+"/") {
+		return false
+	}
+	for _, f := range LocalFiles {
+		if base == f || (strings.HasSuffix(f, "/") && strings.HasSuffix(dir, Name+"/"+f)) {
+			return true
+		}
+	}
+	return false
+}
+
+// EnsureIgnore writes root/.stringer/.gitignore when it is missing, or
+// when an older stringer wrote it and it lacks files added since. One
+// without the stringer header is left alone. The directory must exist.
+// Failures are logged, not returned: a missing .gitignore must not stop a
+// scan from saving its state.
+func EnsureIgnore(fsys testable.FileSystem, root string) {
+	p := filepath.Join(root, Name, ".gitignore")
+	data, err := fsys.ReadFile(p)
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+	case err != nil:
+		return
+	case !strings.HasPrefix(string(data), ignoreHeader) || string(data) == ignoreContent:
+		return
+	}
+	if err := fsys.WriteFile(p, []byte(ignoreContent), 0o644); err != nil { //nolint:gosec // .gitignore is not secret
+		slog.Debug("cannot write .stringer/.gitignore", "path", p, "error", err)
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package statedir
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davetashner/stringer/internal/testable"
+)
+
+func TestIsLocal(t *testing.T) {
+	assert.True(t, IsLocal(".stringer/last-scan.json"))
+	assert.True(t, IsLocal(".stringer/api/scan-history.json"), "workspace state")
+	assert.True(t, IsLocal("services/api/.stringer/blame-index.json.gz"))
+	assert.True(t, IsLocal(".stringer/bench/4f2a9c1e.json"), "benchmark results")
+	assert.True(t, IsLocal(".stringer/checkpoint.jsonl"), "interrupted scan")
+
+	assert.False(t, IsLocal(".stringer/baseline.json"))
+	assert.False(t, IsLocal(".stringer/architecture.yaml"))
+	assert.False(t, IsLocal("last-scan.json"))
+	assert.False(t, IsLocal("my.stringer/last-scan.json"))
+}
+
+func TestEnsureIgnore(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, Name), 0o750))
+
+	EnsureIgnore(testable.DefaultFS, dir)
+	data, err := os.ReadFile(filepath.Join(dir, Name, ".gitignore")) //nolint:gosec // test path
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "\nlast-scan.json\nscan-history.json\nblame-index.json.gz\n")
+	assert.NotContains(t, string(data), "\nbaseline.json")
+
+	// One written by an older stringer gains the files added since.
+	stale := ignoreHeader + " Scan state\nlast-scan.json\n"
+	require.NoError(t, os.WriteFile(filepath.Join(dir, Name, ".gitignore"), []byte(stale), 0o600))
+	EnsureIgnore(testable.DefaultFS, dir)
+	data, err = os.ReadFile(filepath.Join(dir, Name, ".gitignore")) //nolint:gosec // test path
+	require.NoError(t, err)
+	assert.Equal(t, ignoreContent, string(data))
+
+	// One without the stringer header is left alone.
+	require.NoError(t, os.WriteFile(filepath.Join(dir, Name, ".gitignore"), []byte("*\n"), 0o600))
+	EnsureIgnore(testable.DefaultFS, dir)
+	data, err = os.ReadFile(filepath.Join(dir, Name, ".gitignore")) //nolint:gosec // test path
+	require.NoError(t, err)
+	assert.Equal(t, "*\n", string(data))
+}
@@ -0,0 +1,110 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package tracing
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// defaultService is the service name used when OTEL_SERVICE_NAME is unset.
+const defaultService = "stringer"
+
+// OTLP/HTTP protocols, as named by OTEL_EXPORTER_OTLP_PROTOCOL.
+const (
+	ProtocolProtobuf = "http/protobuf"
+	ProtocolJSON     = "http/json"
+)
+
+// Config is the tracing configuration taken from the standard
+// OpenTelemetry environment variables.
+type Config struct {
+	// Disabled is set by OTEL_SDK_DISABLED=true or OTEL_TRACES_EXPORTER=none.
+	Disabled bool
+
+	// Endpoint is the OTLP/HTTP traces URL, from
+	// OTEL_EXPORTER_OTLP_TRACES_ENDPOINT, or OTEL_EXPORTER_OTLP_ENDPOINT
+	// with /v1/traces appended. Empty means spans are not sent anywhere.
+	Endpoint string
+
+	// Protocol is how spans are encoded for Endpoint: ProtocolProtobuf,
+	// the OpenTelemetry default, or ProtocolJSON. It comes from
+	// OTEL_EXPORTER_OTLP_TRACES_PROTOCOL or OTEL_EXPORTER_OTLP_PROTOCOL.
+	Protocol string
+
+	// Headers are sent with every export, from
+	// OTEL_EXPORTER_OTLP_TRACES_HEADERS or OTEL_EXPORTER_OTLP_HEADERS.
+	Headers map[string]string
+
+	// Service is OTEL_SERVICE_NAME, or "stringer".
+	Service string
+
+	// Resource holds OTEL_RESOURCE_ATTRIBUTES.
+	Resource map[string]string
+}
+
+// ConfigFromEnv reads the configuration through getenv, usually os.Getenv.
+// It fails when spans are to be sent with a protocol stringer cannot
+// speak, such as grpc.
+func ConfigFromEnv(getenv func(string) string) (Config, error) {
+	cfg := Config{
+		Disabled: strings.EqualFold(strings.TrimSpace(getenv("OTEL_SDK_DISABLED")), "true") ||
+			strings.EqualFold(strings.TrimSpace(getenv("OTEL_TRACES_EXPORTER")), "none"),
+		Service:  strings.TrimSpace(getenv("OTEL_SERVICE_NAME")),
+		Resource: parseList(getenv("OTEL_RESOURCE_ATTRIBUTES")),
+	}
+	if cfg.Service == "" {
+		cfg.Service = cfg.Resource["service.name"]
+	}
+	if cfg.Service == "" {
+		cfg.Service = defaultService
+	}
+
+	if ep := strings.TrimSpace(getenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT")); ep != "" {
+		cfg.Endpoint = ep
+	} else if ep := strings.TrimSpace(getenv("OTEL_EXPORTER_OTLP_ENDPOINT")); ep != "" {
+		cfg.Endpoint = strings.TrimRight(ep, "/") + "/v1/traces"
+	}
+
+	headers := getenv("OTEL_EXPORTER_OTLP_TRACES_HEADERS")
+	if strings.TrimSpace(headers) == "" {
+		headers = getenv("OTEL_EXPORTER_OTLP_HEADERS")
+	}
+	cfg.Headers = parseList(headers)
+
+	protocol := strings.TrimSpace(getenv("OTEL_EXPORTER_OTLP_TRACES_PROTOCOL"))
+	if protocol == "" {
+		protocol = strings.TrimSpace(getenv("OTEL_EXPORTER_OTLP_PROTOCOL"))
+	}
+	switch strings.ToLower(protocol) {
+	case "", ProtocolProtobuf:
+		cfg.Protocol = ProtocolProtobuf
+	case ProtocolJSON:
+		cfg.Protocol = ProtocolJSON
+	default:
+		if cfg.Endpoint != "" && !cfg.Disabled {
+			return cfg, fmt.Errorf("OTLP protocol %q is not supported (use %s or %s)", protocol, ProtocolProtobuf, ProtocolJSON)
+		}
+	}
+	return cfg, nil
+}
+
+// parseList parses the comma-separated key=value lists the OpenTelemetry
+// variables use. Values are URL-decoded; malformed entries are skipped.
+func parseList(s string) map[string]string {
+	out := make(map[string]string)
+	for _, entry := range strings.Split(s, ",") {
+		k, v, ok := strings.Cut(entry, "=")
+		k = strings.TrimSpace(k)
+		if !ok || k == "" {
+			continue
+		}
+		if dec, err := url.PathUnescape(strings.TrimSpace(v)); err == nil {
+			v = dec
+		}
+		out[k] = strings.TrimSpace(v)
+	}
+	return out
+}
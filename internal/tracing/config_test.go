@@ -0,0 +1,87 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package tracing
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func env(vars map[string]string) func(string) string {
+	return func(k string) string { return vars[k] }
+}
+
+// mustConfig reads the configuration from vars and fails the test on error.
+func mustConfig(t *testing.T, vars map[string]string) Config {
+	t.Helper()
+	cfg, err := ConfigFromEnv(env(vars))
+	require.NoError(t, err)
+	return cfg
+}
+
+func TestConfigFromEnv_Defaults(t *testing.T) {
+	cfg := mustConfig(t, nil)
+	assert.False(t, cfg.Disabled)
+	assert.Equal(t, ProtocolProtobuf, cfg.Protocol)
+	assert.Empty(t, cfg.Endpoint)
+	assert.Equal(t, "stringer", cfg.Service)
+	assert.Empty(t, cfg.Headers)
+}
+
+func TestConfigFromEnv(t *testing.T) {
+	cfg := mustConfig(t, map[string]string{
+		"OTEL_EXPORTER_OTLP_ENDPOINT": "http://collector:4318/",
+		"OTEL_EXPORTER_OTLP_HEADERS":  "api-key=abc%3D%3D, x-team = platform,bogus",
+		"OTEL_RESOURCE_ATTRIBUTES":    "service.name=from-resource,deployment.environment=ci",
+	})
+	assert.Equal(t, "http://collector:4318/v1/traces", cfg.Endpoint)
+	assert.Equal(t, map[string]string{"api-key": "abc==", "x-team": "platform"}, cfg.Headers)
+	assert.Equal(t, "from-resource", cfg.Service)
+	assert.Equal(t, "ci", cfg.Resource["deployment.environment"])
+}
+
+func TestConfigFromEnv_TracesOverrides(t *testing.T) {
+	cfg := mustConfig(t, map[string]string{
+		"OTEL_EXPORTER_OTLP_ENDPOINT":        "http://collector:4318",
+		"OTEL_EXPORTER_OTLP_TRACES_ENDPOINT": "https://traces.example.com/ingest",
+		"OTEL_EXPORTER_OTLP_HEADERS":         "a=1",
+		"OTEL_EXPORTER_OTLP_TRACES_HEADERS":  "b=2",
+		"OTEL_SERVICE_NAME":                  "scanner",
+	})
+	assert.Equal(t, "https://traces.example.com/ingest", cfg.Endpoint, "used as is")
+	assert.Equal(t, map[string]string{"b": "2"}, cfg.Headers)
+	assert.Equal(t, "scanner", cfg.Service)
+}
+
+func TestConfigFromEnv_Disabled(t *testing.T) {
+	assert.True(t, mustConfig(t, map[string]string{"OTEL_SDK_DISABLED": "TRUE"}).Disabled)
+	assert.True(t, mustConfig(t, map[string]string{"OTEL_TRACES_EXPORTER": "none"}).Disabled)
+	assert.False(t, mustConfig(t, map[string]string{"OTEL_TRACES_EXPORTER": "otlp"}).Disabled)
+}
+
+func TestConfigFromEnv_Protocol(t *testing.T) {
+	cfg := mustConfig(t, map[string]string{"OTEL_EXPORTER_OTLP_PROTOCOL": "http/json"})
+	assert.Equal(t, ProtocolJSON, cfg.Protocol)
+
+	cfg = mustConfig(t, map[string]string{
+		"OTEL_EXPORTER_OTLP_PROTOCOL":        "http/json",
+		"OTEL_EXPORTER_OTLP_TRACES_PROTOCOL": "http/protobuf",
+	})
+	assert.Equal(t, ProtocolProtobuf, cfg.Protocol, "the traces variable wins")
+
+	_, err := ConfigFromEnv(env(map[string]string{
+		"OTEL_EXPORTER_OTLP_ENDPOINT": "http://collector:4317",
+		"OTEL_EXPORTER_OTLP_PROTOCOL": "grpc",
+	}))
+	assert.ErrorContains(t, err, `OTLP protocol "grpc" is not supported`)
+
+	mustConfig(t, map[string]string{"OTEL_EXPORTER_OTLP_PROTOCOL": "grpc"})
+	mustConfig(t, map[string]string{
+		"OTEL_EXPORTER_OTLP_ENDPOINT": "http://collector:4317",
+		"OTEL_EXPORTER_OTLP_PROTOCOL": "grpc",
+		"OTEL_SDK_DISABLED":           "true",
+	})
+}
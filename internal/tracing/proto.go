@@ -0,0 +1,112 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package tracing
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// OTLP/protobuf encoding of an ExportTraceServiceRequest, written by hand
+// with the field numbers of opentelemetry/proto/trace/v1/trace.proto and
+// common/v1/common.proto so the package needs no protobuf runtime.
+
+// Wire types.
+const (
+	wireVarint  = 0
+	wireFixed64 = 1
+	wireBytes   = 2
+)
+
+// marshalProto encodes the ended spans the way WriteJSON does, as protobuf.
+func (t *Tracer) marshalProto() []byte {
+	var scope []byte
+	scope = appendString(scope, 1, scopeName) // InstrumentationScope.name
+
+	var scopeSpans []byte
+	scopeSpans = appendBytes(scopeSpans, 1, scope) // ScopeSpans.scope
+	for _, s := range t.ended() {
+		scopeSpans = appendBytes(scopeSpans, 2, t.protoSpan(s)) // ScopeSpans.spans
+	}
+
+	var resource []byte
+	for _, a := range t.resource {
+		resource = appendBytes(resource, 1, protoAttr(a)) // Resource.attributes
+	}
+
+	var rs []byte
+	rs = appendBytes(rs, 1, resource)   // ResourceSpans.resource
+	rs = appendBytes(rs, 2, scopeSpans) // ResourceSpans.scope_spans
+
+	return appendBytes(nil, 1, rs) // ExportTraceServiceRequest.resource_spans
+}
+
+func (t *Tracer) protoSpan(s *Span) []byte {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var b []byte
+	b = appendBytes(b, 1, t.traceID[:]) // trace_id
+	b = appendBytes(b, 2, s.id[:])      // span_id
+	if s.parent != [8]byte{} {
+		b = appendBytes(b, 4, s.parent[:]) // parent_span_id
+	}
+	b = appendString(b, 5, s.name)                      // name
+	b = appendVarint(b, 6, uint64(s.kind))              // kind
+	b = appendFixed64(b, 7, uint64(s.start.UnixNano())) // start_time_unix_nano
+	b = appendFixed64(b, 8, uint64(s.end.UnixNano()))   // end_time_unix_nano
+	for _, a := range s.attrs {
+		b = appendBytes(b, 9, protoAttr(a)) // attributes
+	}
+	if s.errMsg != "" {
+		var status []byte
+		status = appendString(status, 2, s.errMsg) // Status.message
+		status = appendVarint(status, 3, 2)        // Status.code = ERROR
+		b = appendBytes(b, 15, status)             // status
+	}
+	return b
+}
+
+// protoAttr encodes a KeyValue.
+func protoAttr(a Attr) []byte {
+	var v []byte
+	switch val := a.Value.(type) {
+	case string:
+		v = appendString(v, 1, val) // AnyValue.string_value
+	case bool:
+		n := uint64(0)
+		if val {
+			n = 1
+		}
+		v = appendVarint(v, 2, n) // AnyValue.bool_value
+	case int64:
+		v = appendVarint(v, 3, uint64(val)) // AnyValue.int_value
+	default:
+		v = appendString(v, 1, fmt.Sprint(val))
+	}
+	var kv []byte
+	kv = appendString(kv, 1, a.Key) // KeyValue.key
+	return appendBytes(kv, 2, v)    // KeyValue.value
+}
+
+func appendTag(b []byte, field, wire int) []byte {
+	return binary.AppendUvarint(b, uint64(field)<<3|uint64(wire))
+}
+
+func appendVarint(b []byte, field int, v uint64) []byte {
+	return binary.AppendUvarint(appendTag(b, field, wireVarint), v)
+}
+
+func appendFixed64(b []byte, field int, v uint64) []byte {
+	return binary.LittleEndian.AppendUint64(appendTag(b, field, wireFixed64), v)
+}
+
+func appendBytes(b []byte, field int, v []byte) []byte {
+	b = binary.AppendUvarint(appendTag(b, field, wireBytes), uint64(len(v)))
+	return append(b, v...)
+}
+
+func appendString(b []byte, field int, v string) []byte {
+	return appendBytes(b, field, []byte(v))
+}
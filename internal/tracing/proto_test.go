@@ -0,0 +1,116 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package tracing
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// protoField is one decoded protobuf field.
+type protoField struct {
+	num   int
+	value uint64 // varint and fixed64 fields
+	data  []byte // length-delimited fields
+}
+
+// protoFields decodes one protobuf message, failing the test on malformed
+// input.
+func protoFields(t *testing.T, b []byte) []protoField {
+	t.Helper()
+	var out []protoField
+	for len(b) > 0 {
+		tag, n := binary.Uvarint(b)
+		require.Positive(t, n, "tag")
+		b = b[n:]
+		f := protoField{num: int(tag >> 3)}
+		switch tag & 7 {
+		case wireVarint:
+			f.value, n = binary.Uvarint(b)
+			require.Positive(t, n, "varint")
+			b = b[n:]
+		case wireFixed64:
+			require.GreaterOrEqual(t, len(b), 8, "fixed64")
+			f.value, b = binary.LittleEndian.Uint64(b), b[8:]
+		case wireBytes:
+			size, n := binary.Uvarint(b)
+			require.Positive(t, n, "length")
+			b = b[n:]
+			require.GreaterOrEqual(t, uint64(len(b)), size, "bytes")
+			f.data, b = b[:size], b[size:]
+		default:
+			t.Fatalf("unexpected wire type %d", tag&7)
+		}
+		out = append(out, f)
+	}
+	return out
+}
+
+// field returns the fields numbered num.
+func field(fields []protoField, num int) []protoField {
+	var out []protoField
+	for _, f := range fields {
+		if f.num == num {
+			out = append(out, f)
+		}
+	}
+	return out
+}
+
+func TestTracer_MarshalProto(t *testing.T) {
+	tr := New("stringer-ci", map[string]string{"deployment.environment": "ci"})
+	ctx, root := Start(WithTracer(context.Background(), tr), "stringer scan")
+	_, child := Start(ctx, "collector todos", Int("stringer.signals", 3), Bool("stringer.retried", true))
+	child.RecordError(errors.New("timed out"))
+	child.End()
+	root.End()
+
+	req := protoFields(t, tr.marshalProto())
+	rs := field(req, 1)
+	require.Len(t, rs, 1)
+	rsFields := protoFields(t, rs[0].data)
+
+	resource := protoFields(t, field(rsFields, 1)[0].data)
+	attrs := field(resource, 1)
+	require.Len(t, attrs, 2)
+	kv := protoFields(t, attrs[0].data)
+	assert.Equal(t, "service.name", string(field(kv, 1)[0].data))
+	assert.Equal(t, "stringer-ci", string(field(protoFields(t, field(kv, 2)[0].data), 1)[0].data))
+
+	scopeSpans := protoFields(t, field(rsFields, 2)[0].data)
+	scope := protoFields(t, field(scopeSpans, 1)[0].data)
+	assert.Equal(t, scopeName, string(field(scope, 1)[0].data))
+
+	spans := field(scopeSpans, 2)
+	require.Len(t, spans, 2)
+	want := decode(t, tr).ResourceSpans[0].ScopeSpans[0].Spans
+	scan, todos := protoFields(t, spans[0].data), protoFields(t, spans[1].data)
+
+	assert.Equal(t, want[0].TraceID, hex.EncodeToString(field(scan, 1)[0].data))
+	assert.Equal(t, want[0].SpanID, hex.EncodeToString(field(scan, 2)[0].data))
+	assert.Empty(t, field(scan, 4), "the root span has no parent")
+	assert.Equal(t, "stringer scan", string(field(scan, 5)[0].data))
+	assert.Equal(t, uint64(kindInternal), field(scan, 6)[0].value)
+	assert.Equal(t, want[0].StartTimeUnixNano, strconv.FormatUint(field(scan, 7)[0].value, 10))
+	assert.Equal(t, want[0].EndTimeUnixNano, strconv.FormatUint(field(scan, 8)[0].value, 10))
+	assert.Empty(t, field(scan, 15))
+
+	assert.Equal(t, want[0].SpanID, hex.EncodeToString(field(todos, 4)[0].data))
+	todoAttrs := field(todos, 9)
+	require.Len(t, todoAttrs, 2)
+	signals := protoFields(t, field(protoFields(t, todoAttrs[0].data), 2)[0].data)
+	assert.Equal(t, uint64(3), field(signals, 3)[0].value)
+	retried := protoFields(t, field(protoFields(t, todoAttrs[1].data), 2)[0].data)
+	assert.Equal(t, uint64(1), field(retried, 2)[0].value)
+	status := protoFields(t, field(todos, 15)[0].data)
+	assert.Equal(t, "timed out", string(field(status, 2)[0].data))
+	assert.Equal(t, uint64(2), field(status, 3)[0].value)
+}
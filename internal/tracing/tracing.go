@@ -0,0 +1,326 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+// Package tracing records OpenTelemetry-compatible spans of a scan: the
+// scan itself, each collector run, each file walk, and each forge API call.
+//
+// Spans are kept in memory until the scan ends, then written as OTLP/JSON
+// to a file or sent to an OTLP/HTTP collector endpoint as protobuf or JSON.
+// Tracing is off unless a Tracer is attached to the context with
+// WithTracer; without one, Start returns a nil *Span whose methods do
+// nothing, so instrumented code pays almost nothing when no one is watching.
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/davetashner/stringer/internal/redact"
+)
+
+// scopeName is the instrumentation scope reported with every span.
+const scopeName = "github.com/davetashner/stringer"
+
+// Span kinds, as numbered by the OTLP protocol.
+const (
+	kindInternal = 1
+	kindClient   = 3
+)
+
+// Attr is one span or resource attribute.
+type Attr struct {
+	Key   string
+	Value any // string, int64, or bool
+}
+
+// String returns a string attribute.
+func String(key, value string) Attr { return Attr{Key: key, Value: value} }
+
+// Int returns an integer attribute.
+func Int(key string, value int) Attr { return Attr{Key: key, Value: int64(value)} }
+
+// Bool returns a boolean attribute.
+func Bool(key string, value bool) Attr { return Attr{Key: key, Value: value} }
+
+// Tracer collects the spans of one trace.
+type Tracer struct {
+	resource []Attr
+	traceID  [16]byte
+
+	mu    sync.Mutex
+	spans []*Span
+}
+
+// New returns a Tracer for a new trace. The service name and the extra
+// resource attributes describe the process that produced the spans.
+func New(service string, resource map[string]string) *Tracer {
+	t := &Tracer{resource: []Attr{String("service.name", service)}}
+	keys := make([]string, 0, len(resource))
+	for k := range resource {
+		if k != "service.name" {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		t.resource = append(t.resource, String(k, resource[k]))
+	}
+	_, _ = rand.Read(t.traceID[:])
+	return t
+}
+
+// Span is one timed operation. A nil *Span is valid and ignores every call.
+type Span struct {
+	tracer *Tracer
+	name   string
+	kind   int
+	id     [8]byte
+	parent [8]byte
+	start  time.Time
+
+	mu     sync.Mutex
+	end    time.Time
+	attrs  []Attr
+	errMsg string
+}
+
+type tracerKey struct{}
+type spanKey struct{}
+
+// WithTracer returns a context whose spans are recorded by t.
+func WithTracer(ctx context.Context, t *Tracer) context.Context {
+	return context.WithValue(ctx, tracerKey{}, t)
+}
+
+// Start starts a span named name as a child of the span in ctx, if any,
+// and returns a context carrying the new span. When ctx has no Tracer, it
+// returns ctx unchanged and a nil span.
+func Start(ctx context.Context, name string, attrs ...Attr) (context.Context, *Span) {
+	return start(ctx, name, kindInternal, attrs)
+}
+
+func start(ctx context.Context, name string, kind int, attrs []Attr) (context.Context, *Span) {
+	t, _ := ctx.Value(tracerKey{}).(*Tracer)
+	if t == nil {
+		return ctx, nil
+	}
+	s := &Span{tracer: t, name: name, kind: kind, start: time.Now(), attrs: attrs}
+	_, _ = rand.Read(s.id[:])
+	if parent, ok := ctx.Value(spanKey{}).(*Span); ok {
+		s.parent = parent.id
+	}
+	return context.WithValue(ctx, spanKey{}, s), s
+}
+
+// SetAttributes adds attributes to the span.
+func (s *Span) SetAttributes(attrs ...Attr) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	s.attrs = append(s.attrs, attrs...)
+	s.mu.Unlock()
+}
+
+// RecordError marks the span as failed with err, secrets redacted. A nil
+// err is ignored.
+func (s *Span) RecordError(err error) {
+	if s == nil || err == nil {
+		return
+	}
+	s.mu.Lock()
+	s.errMsg = redact.String(err.Error())
+	s.mu.Unlock()
+}
+
+// End ends the span and hands it to its Tracer. Only the first call counts.
+func (s *Span) End() {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	if !s.end.IsZero() {
+		s.mu.Unlock()
+		return
+	}
+	s.end = time.Now()
+	s.mu.Unlock()
+
+	s.tracer.mu.Lock()
+	s.tracer.spans = append(s.tracer.spans, s)
+	s.tracer.mu.Unlock()
+}
+
+// Len returns the number of spans ended so far.
+func (t *Tracer) Len() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return len(t.spans)
+}
+
+// WriteJSON writes the ended spans as an OTLP/JSON ExportTraceServiceRequest,
+// the format OTLP/HTTP collectors accept and otel-cli and Jaeger can import.
+func (t *Tracer) WriteJSON(w io.Writer) error {
+	data, err := json.Marshal(t.request())
+	if err != nil {
+		return fmt.Errorf("marshal trace: %w", err)
+	}
+	if _, err := w.Write(append(data, '\n')); err != nil {
+		return fmt.Errorf("write trace: %w", err)
+	}
+	return nil
+}
+
+// Export POSTs the ended spans to cfg.Endpoint, an OTLP/HTTP traces URL
+// such as http://localhost:4318/v1/traces, encoded as cfg.Protocol
+// requires (protobuf unless it is ProtocolJSON), with cfg.Headers.
+func (t *Tracer) Export(ctx context.Context, client *http.Client, cfg Config) error {
+	var buf bytes.Buffer
+	contentType := "application/x-protobuf"
+	if cfg.Protocol == ProtocolJSON {
+		contentType = "application/json"
+		if err := t.WriteJSON(&buf); err != nil {
+			return err
+		}
+	} else {
+		buf.Write(t.marshalProto())
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, cfg.Endpoint, &buf)
+	if err != nil {
+		return fmt.Errorf("export trace: %w", err)
+	}
+	req.Header.Set("Content-Type", contentType)
+	for k, v := range cfg.Headers {
+		req.Header.Set(k, v)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("export trace: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	_, _ = io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("export trace: %s returned %s", cfg.Endpoint, resp.Status)
+	}
+	return nil
+}
+
+// OTLP/JSON encoding. IDs are hex strings and 64-bit integers are decimal
+// strings, as the protocol's JSON mapping requires.
+type (
+	otlpRequest struct {
+		ResourceSpans []otlpResourceSpans `json:"resourceSpans"`
+	}
+	otlpResourceSpans struct {
+		Resource   otlpResource     `json:"resource"`
+		ScopeSpans []otlpScopeSpans `json:"scopeSpans"`
+	}
+	otlpResource struct {
+		Attributes []otlpAttr `json:"attributes"`
+	}
+	otlpScopeSpans struct {
+		Scope otlpScope  `json:"scope"`
+		Spans []otlpSpan `json:"spans"`
+	}
+	otlpScope struct {
+		Name string `json:"name"`
+	}
+	otlpSpan struct {
+		TraceID           string      `json:"traceId"`
+		SpanID            string      `json:"spanId"`
+		ParentSpanID      string      `json:"parentSpanId,omitempty"`
+		Name              string      `json:"name"`
+		Kind              int         `json:"kind"`
+		StartTimeUnixNano string      `json:"startTimeUnixNano"`
+		EndTimeUnixNano   string      `json:"endTimeUnixNano"`
+		Attributes        []otlpAttr  `json:"attributes,omitempty"`
+		Status            *otlpStatus `json:"status,omitempty"`
+	}
+	otlpStatus struct {
+		Code    int    `json:"code"` // 2 = error
+		Message string `json:"message,omitempty"`
+	}
+	otlpAttr struct {
+		Key   string    `json:"key"`
+		Value otlpValue `json:"value"`
+	}
+	otlpValue struct {
+		StringValue *string `json:"stringValue,omitempty"`
+		IntValue    *string `json:"intValue,omitempty"`
+		BoolValue   *bool   `json:"boolValue,omitempty"`
+	}
+)
+
+// ended returns the ended spans ordered by start time.
+func (t *Tracer) ended() []*Span {
+	t.mu.Lock()
+	spans := make([]*Span, len(t.spans))
+	copy(spans, t.spans)
+	t.mu.Unlock()
+	sort.SliceStable(spans, func(i, j int) bool { return spans[i].start.Before(spans[j].start) })
+	return spans
+}
+
+func (t *Tracer) request() otlpRequest {
+	spans := t.ended()
+	traceID := hex.EncodeToString(t.traceID[:])
+	out := make([]otlpSpan, 0, len(spans))
+	for _, s := range spans {
+		s.mu.Lock()
+		sp := otlpSpan{
+			TraceID:           traceID,
+			SpanID:            hex.EncodeToString(s.id[:]),
+			Name:              s.name,
+			Kind:              s.kind,
+			StartTimeUnixNano: strconv.FormatInt(s.start.UnixNano(), 10),
+			EndTimeUnixNano:   strconv.FormatInt(s.end.UnixNano(), 10),
+			Attributes:        encodeAttrs(s.attrs),
+		}
+		if s.parent != [8]byte{} {
+			sp.ParentSpanID = hex.EncodeToString(s.parent[:])
+		}
+		if s.errMsg != "" {
+			sp.Status = &otlpStatus{Code: 2, Message: s.errMsg}
+		}
+		s.mu.Unlock()
+		out = append(out, sp)
+	}
+	return otlpRequest{ResourceSpans: []otlpResourceSpans{{
+		Resource:   otlpResource{Attributes: encodeAttrs(t.resource)},
+		ScopeSpans: []otlpScopeSpans{{Scope: otlpScope{Name: scopeName}, Spans: out}},
+	}}}
+}
+
+func encodeAttrs(attrs []Attr) []otlpAttr {
+	if len(attrs) == 0 {
+		return nil
+	}
+	out := make([]otlpAttr, 0, len(attrs))
+	for _, a := range attrs {
+		var v otlpValue
+		switch val := a.Value.(type) {
+		case string:
+			v.StringValue = &val
+		case int64:
+			s := strconv.FormatInt(val, 10)
+			v.IntValue = &s
+		case bool:
+			v.BoolValue = &val
+		default:
+			s := fmt.Sprint(val)
+			v.StringValue = &s
+		}
+		out = append(out, otlpAttr{Key: a.Key, Value: v})
+	}
+	return out
+}
@@ -0,0 +1,122 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// decode parses a tracer's OTLP/JSON output.
+func decode(t *testing.T, tr *Tracer) otlpRequest {
+	t.Helper()
+	var buf bytes.Buffer
+	require.NoError(t, tr.WriteJSON(&buf))
+	var req otlpRequest
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &req))
+	return req
+}
+
+func TestStart_NoTracer(t *testing.T) {
+	ctx := context.Background()
+	got, span := Start(ctx, "scan")
+	assert.Equal(t, ctx, got)
+	assert.Nil(t, span)
+
+	// A nil span ignores every call.
+	span.SetAttributes(String("k", "v"))
+	span.RecordError(errors.New("boom"))
+	span.End()
+}
+
+func TestTracer_WriteJSON(t *testing.T) {
+	tr := New("stringer-ci", map[string]string{"deployment.environment": "ci", "service.name": "ignored"})
+	ctx := WithTracer(context.Background(), tr)
+
+	ctx, root := Start(ctx, "stringer scan", String("stringer.path", "/repo"))
+	_, child := Start(ctx, "collector todos", Int("stringer.signals", 3), Bool("stringer.retried", false))
+	child.RecordError(errors.New("timed out"))
+	child.End()
+	child.End()
+	root.End()
+	require.Equal(t, 2, tr.Len(), "a second End is ignored")
+
+	req := decode(t, tr)
+	require.Len(t, req.ResourceSpans, 1)
+	rs := req.ResourceSpans[0]
+	require.Len(t, rs.Resource.Attributes, 2)
+	assert.Equal(t, "service.name", rs.Resource.Attributes[0].Key)
+	assert.Equal(t, "stringer-ci", *rs.Resource.Attributes[0].Value.StringValue)
+	assert.Equal(t, "deployment.environment", rs.Resource.Attributes[1].Key)
+
+	require.Len(t, rs.ScopeSpans, 1)
+	assert.Equal(t, scopeName, rs.ScopeSpans[0].Scope.Name)
+	spans := rs.ScopeSpans[0].Spans
+	require.Len(t, spans, 2)
+
+	// Spans are ordered by start time.
+	scan, todos := spans[0], spans[1]
+	assert.Equal(t, "stringer scan", scan.Name)
+	assert.Equal(t, kindInternal, scan.Kind)
+	assert.Len(t, scan.TraceID, 32)
+	assert.Len(t, scan.SpanID, 16)
+	assert.Empty(t, scan.ParentSpanID)
+	assert.Nil(t, scan.Status)
+	assert.Equal(t, scan.TraceID, todos.TraceID)
+	assert.Equal(t, scan.SpanID, todos.ParentSpanID)
+	assert.NotEmpty(t, todos.StartTimeUnixNano)
+	assert.GreaterOrEqual(t, todos.EndTimeUnixNano, todos.StartTimeUnixNano)
+
+	require.NotNil(t, todos.Status)
+	assert.Equal(t, 2, todos.Status.Code)
+	assert.Equal(t, "timed out", todos.Status.Message)
+	require.Len(t, todos.Attributes, 2)
+	assert.Equal(t, "3", *todos.Attributes[0].Value.IntValue)
+	assert.False(t, *todos.Attributes[1].Value.BoolValue)
+}
+
+func TestTracer_Export(t *testing.T) {
+	var got []byte
+	var contentType, auth string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		contentType = r.Header.Get("Content-Type")
+		auth = r.Header.Get("Authorization")
+		got, _ = io.ReadAll(r.Body)
+	}))
+	defer srv.Close()
+
+	tr := New("stringer", nil)
+	_, span := Start(WithTracer(context.Background(), tr), "scan")
+	span.End()
+
+	cfg := Config{Endpoint: srv.URL + "/v1/traces", Protocol: ProtocolJSON, Headers: map[string]string{"Authorization": "Bearer x"}}
+	require.NoError(t, tr.Export(context.Background(), srv.Client(), cfg))
+	assert.Equal(t, "application/json", contentType)
+	assert.Equal(t, "Bearer x", auth)
+	assert.Contains(t, string(got), `"name":"scan"`)
+
+	cfg.Protocol = ProtocolProtobuf
+	require.NoError(t, tr.Export(context.Background(), srv.Client(), cfg))
+	assert.Equal(t, "application/x-protobuf", contentType)
+	assert.Equal(t, tr.marshalProto(), got)
+}
+
+func TestTracer_ExportRejected(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer srv.Close()
+
+	err := New("stringer", nil).Export(context.Background(), srv.Client(), Config{Endpoint: srv.URL})
+	assert.ErrorContains(t, err, "401")
+}
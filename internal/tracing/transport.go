@@ -0,0 +1,63 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package tracing
+
+import (
+	"encoding/hex"
+	"net/http"
+)
+
+// transport records a client span for each request made with a traced
+// context.
+type transport struct {
+	base http.RoundTripper
+}
+
+// Transport wraps base, or http.DefaultTransport when base is nil, so that
+// every request whose context carries a Tracer gets an "HTTP <method>" span
+// with the host, path, and status code, and a W3C traceparent header naming
+// that span. The query string is left out, since it can carry tokens.
+func Transport(base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &transport{base: base}
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	_, span := start(req.Context(), "HTTP "+req.Method, kindClient, []Attr{
+		String("http.request.method", req.Method),
+		String("server.address", req.URL.Host),
+		String("url.path", req.URL.Path),
+	})
+	if span == nil {
+		return t.base.RoundTrip(req)
+	}
+	defer span.End()
+
+	// A RoundTripper must not modify the caller's request.
+	req = req.Clone(req.Context())
+	req.Header.Set("traceparent", span.traceparent())
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	span.SetAttributes(Int("http.response.status_code", resp.StatusCode))
+	if resp.StatusCode >= 400 {
+		span.RecordError(httpStatusError(resp.Status))
+	}
+	return resp, nil
+}
+
+// httpStatusError reports a failed response on its span.
+type httpStatusError string
+
+func (e httpStatusError) Error() string { return string(e) }
+
+// traceparent returns the W3C Trace Context header identifying the span as
+// the sampled parent of the remote operation.
+func (s *Span) traceparent() string {
+	return "00-" + hex.EncodeToString(s.tracer.traceID[:]) + "-" + hex.EncodeToString(s.id[:]) + "-01"
+}
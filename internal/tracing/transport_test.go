@@ -0,0 +1,66 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package tracing
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransport(t *testing.T) {
+	var traceparents []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceparents = append(traceparents, r.Header.Get("traceparent"))
+		if r.URL.Path == "/missing" {
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+	client := &http.Client{Transport: Transport(srv.Client().Transport)}
+
+	get := func(ctx context.Context, path string) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL+path, nil)
+		require.NoError(t, err)
+		resp, err := client.Do(req)
+		require.NoError(t, err)
+		_ = resp.Body.Close()
+	}
+
+	// Untraced requests pass straight through.
+	get(context.Background(), "/repos?token=secret")
+
+	tr := New("stringer", nil)
+	ctx, parent := Start(WithTracer(context.Background(), tr), "collector github")
+	get(ctx, "/repos?token=secret")
+	get(ctx, "/missing")
+	parent.End()
+
+	spans := decode(t, tr).ResourceSpans[0].ScopeSpans[0].Spans
+	require.Len(t, spans, 3)
+	ok, missing := spans[1], spans[2]
+	assert.Equal(t, "HTTP GET", ok.Name)
+	assert.Equal(t, kindClient, ok.Kind)
+	assert.Equal(t, spans[0].SpanID, ok.ParentSpanID)
+	assert.Nil(t, ok.Status)
+	attrs := make(map[string]otlpValue)
+	for _, a := range ok.Attributes {
+		attrs[a.Key] = a.Value
+	}
+	assert.Equal(t, "/repos", *attrs["url.path"].StringValue)
+	assert.Equal(t, "200", *attrs["http.response.status_code"].IntValue)
+	assert.NotContains(t, *attrs["url.path"].StringValue, "secret")
+
+	require.NotNil(t, missing.Status)
+	assert.Equal(t, "404 Not Found", missing.Status.Message)
+
+	require.Len(t, traceparents, 3)
+	assert.Empty(t, traceparents[0], "untraced requests get no traceparent")
+	assert.Equal(t, "00-"+ok.TraceID+"-"+ok.SpanID+"-01", traceparents[1], "the server sees the client span as its parent")
+	assert.Equal(t, "00-"+missing.TraceID+"-"+missing.SpanID+"-01", traceparents[2])
+}
@@ -0,0 +1,189 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+// Package versioncheck compares the running stringer binary against the
+// published GitHub releases, so teams rolling stringer out across many
+// repositories can tell when a machine is behind and whether upgrading
+// means touching .stringer.yaml.
+package versioncheck
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"golang.org/x/mod/semver"
+)
+
+// ReleasesURL lists stringer's releases, newest first.
+const ReleasesURL = "https://api.github.com/repos/davetashner/stringer/releases?per_page=100"
+
+// maxResponseBytes caps the size of the releases listing.
+const maxResponseBytes = 4 << 20 // 4 MiB
+
+// Release is a published stringer release.
+type Release struct {
+	Tag        string `json:"tag_name"`
+	URL        string `json:"html_url"`
+	Body       string `json:"body"`
+	Draft      bool   `json:"draft"`
+	Prerelease bool   `json:"prerelease"`
+}
+
+// ConfigNote is the configuration guidance from one release's upgrade notes.
+type ConfigNote struct {
+	Version string `json:"version"`
+	Note    string `json:"note"`
+}
+
+// Result is the outcome of a version check.
+type Result struct {
+	Current string `json:"current"`
+	Latest  string `json:"latest"`
+	URL     string `json:"url"`
+
+	// Development is true when Current is not a release version, such as
+	// "dev" for a source build, and cannot be compared.
+	Development bool `json:"development"`
+
+	// Outdated is true when a newer release than Current exists.
+	Outdated bool `json:"outdated"`
+
+	// ConfigNotes lists, oldest first, the releases between Current and
+	// Latest whose upgrade notes call for configuration changes.
+	ConfigNotes []ConfigNote `json:"config_notes,omitempty"`
+}
+
+// Check fetches the release listing at url and compares current against
+// the newest stable release. Drafts and prereleases are ignored. If client
+// is nil, a client with a 10s timeout is used. A GITHUB_TOKEN in the
+// environment is sent to raise the API rate limit.
+func Check(ctx context.Context, client *http.Client, url, current string) (*Result, error) {
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+	releases, err := fetchReleases(ctx, client, url)
+	if err != nil {
+		return nil, err
+	}
+
+	var stable []Release
+	for _, r := range releases {
+		if !r.Draft && !r.Prerelease && semver.IsValid(canonical(r.Tag)) {
+			stable = append(stable, r)
+		}
+	}
+	if len(stable) == 0 {
+		return nil, fmt.Errorf("no releases found at %s", url)
+	}
+	sort.Slice(stable, func(i, j int) bool {
+		return semver.Compare(canonical(stable[i].Tag), canonical(stable[j].Tag)) < 0
+	})
+	latest := stable[len(stable)-1]
+
+	res := &Result{Current: current, Latest: latest.Tag, URL: latest.URL}
+	cur := canonical(current)
+	if !semver.IsValid(cur) {
+		res.Development = true
+		return res, nil
+	}
+	res.Outdated = semver.Compare(cur, canonical(latest.Tag)) < 0
+	for _, r := range stable {
+		if semver.Compare(canonical(r.Tag), cur) <= 0 {
+			continue
+		}
+		if note := configNote(r.Body); note != "" {
+			res.ConfigNotes = append(res.ConfigNotes, ConfigNote{Version: r.Tag, Note: note})
+		}
+	}
+	return res, nil
+}
+
+// canonical returns v with the "v" prefix semver requires. Release builds
+// are stamped without it.
+func canonical(v string) string {
+	if v == "" || strings.HasPrefix(v, "v") {
+		return v
+	}
+	return "v" + v
+}
+
+// fetchReleases downloads and decodes the release listing at url.
+func fetchReleases(ctx context.Context, client *http.Client, url string) ([]Release, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("creating request: %w", err)
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+	if token := os.Getenv("GITHUB_TOKEN"); token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("fetching %s: %w", url, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetching %s: server returned %d", url, resp.StatusCode)
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseBytes+1))
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", url, err)
+	}
+	if len(body) > maxResponseBytes {
+		return nil, fmt.Errorf("fetching %s: response exceeds %d bytes", url, maxResponseBytes)
+	}
+	var releases []Release
+	if err := json.Unmarshal(body, &releases); err != nil {
+		return nil, fmt.Errorf("decoding releases: %w", err)
+	}
+	return releases, nil
+}
+
+// configNote returns the prose of a release's "Upgrading" (or "Upgrade")
+// section, with code blocks dropped, when it mentions configuration. Notes
+// saying no configuration changes are needed return "".
+func configNote(body string) string {
+	var lines []string
+	inSection, inFence := false, false
+	sc := bufio.NewScanner(strings.NewReader(body))
+	for sc.Scan() {
+		line := strings.TrimSpace(sc.Text())
+		if strings.HasPrefix(line, "```") {
+			inFence = !inFence
+			continue
+		}
+		if inFence {
+			continue // shell comments in code blocks are not headings
+		}
+		if strings.HasPrefix(line, "#") {
+			if inSection {
+				break
+			}
+			heading := strings.ToLower(strings.TrimSpace(strings.TrimLeft(line, "#")))
+			inSection = strings.HasPrefix(heading, "upgrad")
+			continue
+		}
+		if inSection && line != "" {
+			lines = append(lines, line)
+		}
+	}
+
+	note := strings.Join(lines, " ")
+	lower := strings.ToLower(note)
+	if strings.Contains(lower, "no configuration changes") || strings.Contains(lower, "no config changes") {
+		return ""
+	}
+	if !strings.Contains(lower, "config") && !strings.Contains(lower, ".stringer.yaml") {
+		return ""
+	}
+	return note
+}
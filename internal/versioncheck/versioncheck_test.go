@@ -0,0 +1,110 @@
+// Copyright 2026 The Stringer Authors
+// SPDX-License-Identifier: MIT
+
+package versioncheck
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func serveReleases(t *testing.T, releases []Release) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(releases)
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+var testReleases = []Release{
+	{Tag: "v1.6.0-rc.1", Prerelease: true, Body: "## Upgrading\n\nRename `foo` in your config."},
+	{Tag: "v1.5.0", URL: "https://example.com/v1.5.0", Body: "## Highlights\n\nFaster.\n\n## Upgrading\n\n```bash\n# or\ngo install example.com@v1.5.0\n```\n\nThe `output.dir` key in `.stringer.yaml` moved to `output.path`.\nUpdate your config before upgrading.\n\n## Thanks\n\nEveryone."},
+	{Tag: "v1.4.0", Body: "## Upgrading\n\nNo configuration changes required."},
+	{Tag: "v1.3.1", Body: "### Upgrade\n\nCollector `foo` now reads `collectors.foo.limit` from config instead of `foo_limit`."},
+	{Tag: "v1.3.0", Body: "## Upgrading\n\nNo breaking changes. Safe to upgrade with no configuration changes."},
+	{Tag: "nightly"},
+	{Tag: "v9.0.0", Draft: true},
+}
+
+func TestCheck_Outdated(t *testing.T) {
+	srv := serveReleases(t, testReleases)
+
+	res, err := Check(context.Background(), srv.Client(), srv.URL, "1.3.0")
+	require.NoError(t, err)
+	assert.Equal(t, "v1.5.0", res.Latest, "drafts, prereleases, and non-semver tags are ignored")
+	assert.Equal(t, "https://example.com/v1.5.0", res.URL)
+	assert.True(t, res.Outdated)
+	assert.False(t, res.Development)
+	assert.Equal(t, []ConfigNote{
+		{Version: "v1.3.1", Note: "Collector `foo` now reads `collectors.foo.limit` from config instead of `foo_limit`."},
+		{Version: "v1.5.0", Note: "The `output.dir` key in `.stringer.yaml` moved to `output.path`. Update your config before upgrading."},
+	}, res.ConfigNotes)
+}
+
+func TestCheck_UpToDate(t *testing.T) {
+	srv := serveReleases(t, testReleases)
+
+	for _, current := range []string{"v1.5.0", "v1.5.1-test"} {
+		res, err := Check(context.Background(), srv.Client(), srv.URL, current)
+		require.NoError(t, err)
+		assert.False(t, res.Outdated, current)
+		assert.Empty(t, res.ConfigNotes, current)
+	}
+}
+
+func TestCheck_Development(t *testing.T) {
+	srv := serveReleases(t, testReleases)
+
+	res, err := Check(context.Background(), srv.Client(), srv.URL, "dev")
+	require.NoError(t, err)
+	assert.True(t, res.Development)
+	assert.False(t, res.Outdated)
+	assert.Equal(t, "v1.5.0", res.Latest)
+}
+
+func TestCheck_Errors(t *testing.T) {
+	srv := serveReleases(t, []Release{{Tag: "nightly"}})
+	_, err := Check(context.Background(), srv.Client(), srv.URL, "v1.0.0")
+	assert.ErrorContains(t, err, "no releases found")
+
+	down := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer down.Close()
+	_, err = Check(context.Background(), down.Client(), down.URL, "v1.0.0")
+	assert.ErrorContains(t, err, "server returned 403")
+}
+
+func TestCheck_SendsToken(t *testing.T) {
+	t.Setenv("GITHUB_TOKEN", "secret")
+	var auth string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth = r.Header.Get("Authorization")
+		_ = json.NewEncoder(w).Encode([]Release{{Tag: "v1.0.0"}})
+	}))
+	defer srv.Close()
+
+	_, err := Check(context.Background(), srv.Client(), srv.URL, "v1.0.0")
+	require.NoError(t, err)
+	assert.Equal(t, "Bearer secret", auth)
+
+	require.NoError(t, os.Unsetenv("GITHUB_TOKEN"))
+	_, err = Check(context.Background(), srv.Client(), srv.URL, "v1.0.0")
+	require.NoError(t, err)
+	assert.Empty(t, auth)
+}
+
+func TestConfigNote(t *testing.T) {
+	assert.Empty(t, configNote(""))
+	assert.Empty(t, configNote("## Fixes\n\nConfig loading is faster."), "only the upgrade section counts")
+	assert.Empty(t, configNote("## Upgrading\n\nRun brew upgrade."), "notes that do not mention config are skipped")
+	assert.Equal(t, "Remove `legacy` from .stringer.yaml.", configNote("# Upgrading\nRemove `legacy` from .stringer.yaml.\n# Next\nconfig"))
+}
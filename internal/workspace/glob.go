@@ -4,13 +4,20 @@
 package workspace
 
 import (
+	"log/slog"
 	"path/filepath"
 	"sort"
+	"strings"
 )
 
 // expandGlobs resolves a list of glob patterns relative to root into absolute
 // directory paths. Non-directory matches are silently skipped. Results are
 // sorted and deduplicated.
+//
+// Matches are keyed by their canonical directory: pnpm and yarn workspaces
+// often expose internal packages through symlinks, so a package reachable
+// both directly and via a link is returned once, under its real path.
+// Symlinks that resolve outside root are skipped.
 func expandGlobs(root string, patterns []string) ([]string, error) {
 	seen := make(map[string]bool)
 	var dirs []string
@@ -27,13 +34,15 @@ func expandGlobs(root string, patterns []string) ([]string, error) {
 		}
 
 		for _, m := range matches {
-			if seen[m] {
+			if !dirExists(m) {
 				continue
 			}
-			if dirExists(m) {
-				seen[m] = true
-				dirs = append(dirs, m)
+			canonical, ok := canonicalDir(root, m)
+			if !ok || seen[canonical] {
+				continue
 			}
+			seen[canonical] = true
+			dirs = append(dirs, canonical)
 		}
 	}
 
@@ -41,6 +50,21 @@ func expandGlobs(root string, patterns []string) ([]string, error) {
 	return dirs, nil
 }
 
+// canonicalDir resolves symlinks in dir and reports whether the result still
+// lies within root. root is expected to be canonical already (see Detect).
+func canonicalDir(root, dir string) (string, bool) {
+	resolved, err := filepath.EvalSymlinks(dir)
+	if err != nil {
+		slog.Debug("skipping unresolvable workspace path", "path", dir, "error", err)
+		return "", false
+	}
+	if resolved != root && !strings.HasPrefix(resolved, root+string(filepath.Separator)) {
+		slog.Debug("skipping workspace link outside monorepo root", "path", dir, "target", resolved)
+		return "", false
+	}
+	return resolved, true
+}
+
 // dirsToWorkspaces converts absolute directory paths into Workspace structs
 // relative to root, using the directory basename as the workspace name.
 func dirsToWorkspaces(root string, dirs []string) []Workspace {
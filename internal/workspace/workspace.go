@@ -56,6 +56,11 @@ func Detect(rootPath string) (*Layout, error) {
 	if err != nil {
 		return nil, err
 	}
+	// Workspace directories are keyed by their symlink-resolved path, so
+	// the root must be resolved too for relative paths to line up.
+	if resolved, evalErr := filepath.EvalSymlinks(abs); evalErr == nil {
+		abs = resolved
+	}
 
 	for _, fn := range detectors {
 		layout, err := fn(abs)
@@ -280,6 +280,59 @@ func TestExpandGlobs_Dedup(t *testing.T) {
 	assert.Len(t, dirs, 1, "duplicates should be removed")
 }
 
+func TestDetect_Pnpm_SymlinkedPackageCountedOnce(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "pnpm-workspace.yaml"), `packages:
+  - "packages/*"
+  - "libs/*"
+`)
+	mkdirAll(t, filepath.Join(dir, "libs", "ui"))
+	mkdirAll(t, filepath.Join(dir, "packages", "app"))
+	if err := os.Symlink(filepath.Join("..", "libs", "ui"), filepath.Join(dir, "packages", "ui")); err != nil {
+		t.Skip("symlinks not supported on this OS")
+	}
+
+	layout, err := Detect(dir)
+	require.NoError(t, err)
+	require.NotNil(t, layout)
+	require.Len(t, layout.Workspaces, 2, "the linked package is listed once")
+
+	rels := []string{layout.Workspaces[0].Rel, layout.Workspaces[1].Rel}
+	assert.ElementsMatch(t, []string{filepath.Join("libs", "ui"), filepath.Join("packages", "app")}, rels)
+}
+
+func TestDetect_Npm_SymlinkOnlyPackageUsesCanonicalPath(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "package.json"), `{"workspaces": ["packages/*"]}`)
+	mkdirAll(t, filepath.Join(dir, "shared", "ui"))
+	mkdirAll(t, filepath.Join(dir, "packages"))
+	if err := os.Symlink(filepath.Join("..", "shared", "ui"), filepath.Join(dir, "packages", "ui")); err != nil {
+		t.Skip("symlinks not supported on this OS")
+	}
+
+	layout, err := Detect(dir)
+	require.NoError(t, err)
+	require.NotNil(t, layout)
+	require.Len(t, layout.Workspaces, 1)
+	ws := layout.Workspaces[0]
+	assert.Equal(t, "ui", ws.Name)
+	assert.Equal(t, filepath.Join(dir, "shared", "ui"), ws.Path, "walks must start at a real directory")
+	assert.Equal(t, filepath.Join("shared", "ui"), ws.Rel)
+}
+
+func TestExpandGlobs_SkipsLinksOutsideRoot(t *testing.T) {
+	dir := t.TempDir()
+	outside := t.TempDir()
+	mkdirAll(t, filepath.Join(dir, "packages", "real"))
+	if err := os.Symlink(outside, filepath.Join(dir, "packages", "external")); err != nil {
+		t.Skip("symlinks not supported on this OS")
+	}
+
+	dirs, err := expandGlobs(dir, []string{"packages/*"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{filepath.Join(dir, "packages", "real")}, dirs)
+}
+
 func TestExpandGlobs_SkipsFiles(t *testing.T) {
 	dir := t.TempDir()
 	writeFile(t, filepath.Join(dir, "packages", "readme.txt"), "not a dir")